@@ -9,6 +9,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/middleware"
+	"github.com/hatchet-dev/hatchet/internal/auth/token"
 	"github.com/hatchet-dev/hatchet/internal/config/server"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 )
@@ -62,7 +63,7 @@ func (a *AuthN) authenticate(c echo.Context, r *middleware.RouteInfo) error {
 	}
 
 	if err != nil && r.Security.BearerAuth() {
-		err = a.handleBearerAuth(c)
+		err = a.handleBearerAuth(c, r)
 		c.Set("auth_strategy", "bearer")
 
 		if err == nil {
@@ -144,7 +145,16 @@ func (a *AuthN) handleCookieAuth(c echo.Context) error {
 	return nil
 }
 
-func (a *AuthN) handleBearerAuth(c echo.Context) error {
+// apiTokenOperations read, list or write other API tokens, which bearer tokens can only do with the tokens scope
+var apiTokenOperations = []string{
+	"ApiTokenList",
+	"ApiTokenGet",
+	"ApiTokenCreate",
+	"ApiTokenUpdateRevoke",
+	"ApiTokenUpdateRotate",
+}
+
+func (a *AuthN) handleBearerAuth(c echo.Context, r *middleware.RouteInfo) error {
 	forbidden := echo.NewHTTPError(http.StatusForbidden, "Please provide valid credentials")
 
 	// a tenant id must exist in the context in order for the bearer auth to succeed, since
@@ -157,7 +167,7 @@ func (a *AuthN) handleBearerAuth(c echo.Context) error {
 		return forbidden
 	}
 
-	token, err := getBearerTokenFromRequest(c.Request())
+	bearerToken, err := getBearerTokenFromRequest(c.Request())
 
 	if err != nil {
 		a.l.Debug().Err(err).Msg("error getting bearer token from request")
@@ -165,8 +175,17 @@ func (a *AuthN) handleBearerAuth(c echo.Context) error {
 		return forbidden
 	}
 
+	// read-only requests only require the read scope
+	scope := token.ScopeWrite
+
+	if r.OperationIn(apiTokenOperations) {
+		scope = token.ScopeTokens
+	} else if c.Request().Method == http.MethodGet || c.Request().Method == http.MethodHead {
+		scope = token.ScopeRead
+	}
+
 	// Validate the token.
-	tenantId, err := a.config.Auth.JWTManager.ValidateTenantToken(token)
+	validatedToken, err := a.config.Auth.JWTManager.ValidateTenantToken(bearerToken, scope)
 
 	if err != nil {
 		a.l.Debug().Err(err).Msg("error validating tenant token")
@@ -176,12 +195,22 @@ func (a *AuthN) handleBearerAuth(c echo.Context) error {
 
 	// Verify that the tenant id which exists in the context is the same as the tenant id
 	// in the token.
-	if queriedTenant.ID != tenantId {
+	if queriedTenant.ID != validatedToken.TenantId {
 		a.l.Debug().Msgf("tenant id in token does not match tenant id in context")
 
 		return forbidden
 	}
 
+	// handlers scope their queries and the runs they create to the environment of the token
+	if validatedToken.Environment != "" {
+		c.Set("environment", validatedToken.Environment)
+	}
+
+	// names without a namespace are resolved to the namespace of the token, which is also checked by authz
+	if validatedToken.Namespace != "" {
+		c.Set("namespace", validatedToken.Namespace)
+	}
+
 	return nil
 }
 
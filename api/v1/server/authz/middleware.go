@@ -9,6 +9,7 @@ import (
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/middleware"
 	"github.com/hatchet-dev/hatchet/internal/config/server"
+	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 )
 
@@ -41,6 +42,11 @@ func (a *AuthZ) authorize(c echo.Context, r *middleware.RouteInfo) error {
 		return nil
 	}
 
+	// instance admin operations are not tenant-scoped, so they bypass tenant membership checks
+	if r.OperationIn(instanceAdminOnly) {
+		return a.handleInstanceAdminAuth(c)
+	}
+
 	var err error
 
 	switch c.Get("auth_strategy").(string) {
@@ -102,23 +108,86 @@ func (a *AuthZ) handleCookieAuth(c echo.Context, r *middleware.RouteInfo) error
 	return nil
 }
 
-var restrictedWithBearerToken = []string{
-	// bearer tokens cannot read, list, or write other bearer tokens
-	"ApiTokenList",
-	"ApiTokenCreate",
-	"ApiTokenUpdateRevoke",
-}
-
-// At the moment, there's no further bearer auth because bearer tokens are admin-scoped
-// and we check that the bearer token has access to the tenant in the authn step.
+// We check that the bearer token has access to the tenant and the scope required by the operation in the authn
+// step. Tokens with a namespace can additionally only access the workflows, runs and events of the namespace.
 func (a *AuthZ) handleBearerAuth(c echo.Context, r *middleware.RouteInfo) error {
-	if operationIn(r.OperationID, restrictedWithBearerToken) {
-		return echo.NewHTTPError(http.StatusUnauthorized, "Not authorized to perform this operation")
+	namespace, ok := c.Get("namespace").(string)
+
+	if !ok || namespace == "" {
+		return nil
+	}
+
+	if name, ok := namespacedResourceName(c); ok && !repository.InNamespace(namespace, name) {
+		a.l.Debug().Msgf("resource %s is outside the namespace %s of the token", name, namespace)
+
+		return echo.NewHTTPError(http.StatusUnauthorized, "Not authorized to access resources outside the namespace of the token")
 	}
 
 	return nil
 }
 
+// namespacedResourceName returns the workflow name or event key of the resource of the request, which determines
+// the namespace of the resource.
+func namespacedResourceName(c echo.Context) (string, bool) {
+	if workflow, ok := c.Get("workflow").(*db.WorkflowModel); ok {
+		return workflow.Name, true
+	}
+
+	if workflowRun, ok := c.Get("workflow-run").(*db.WorkflowRunModel); ok {
+		return workflowRun.WorkflowVersion().Workflow().Name, true
+	}
+
+	if event, ok := c.Get("event").(*db.EventModel); ok {
+		return event.Key, true
+	}
+
+	return "", false
+}
+
+var instanceAdminOnly = []string{
+	"AdminTenantList",
+	"AdminTenantUpdateIngestion",
+	"AdminTenantFeatureFlagList",
+	"AdminTenantFeatureFlagUpdate",
+	"AdminWorkflowRunUpdateFail",
+	"AdminQueueList",
+	"AdminMaintenanceCreate",
+	"AdminGithubAppCreate",
+	"AdminGithubAppCreateCallback",
+}
+
+// handleInstanceAdminAuth only permits users with a verified email which is listed in the instance admin
+// emails. Bearer tokens are tenant-scoped and can never access instance admin operations.
+func (a *AuthZ) handleInstanceAdminAuth(c echo.Context) error {
+	unauthorized := echo.NewHTTPError(http.StatusUnauthorized, "Not authorized to perform this operation")
+
+	if strategy, ok := c.Get("auth_strategy").(string); !ok || strategy != "cookie" {
+		return unauthorized
+	}
+
+	user, ok := c.Get("user").(*db.UserModel)
+
+	if !ok || user == nil {
+		a.l.Debug().Msgf("user not found in context")
+
+		return unauthorized
+	}
+
+	if !user.EmailVerified {
+		return echo.NewHTTPError(http.StatusForbidden, "Please verify your email before continuing")
+	}
+
+	for _, email := range a.config.Auth.ConfigFile.InstanceAdminEmails {
+		if strings.EqualFold(email, user.Email) {
+			return nil
+		}
+	}
+
+	a.l.Debug().Msgf("user is not an instance admin")
+
+	return unauthorized
+}
+
 var permittedWithUnverifiedEmail = []string{
 	"UserGetCurrent",
 	"UserUpdateLogout",
@@ -131,7 +200,7 @@ func (a *AuthZ) ensureVerifiedEmail(c echo.Context, r *middleware.RouteInfo) err
 		return nil
 	}
 
-	if operationIn(r.OperationID, permittedWithUnverifiedEmail) {
+	if r.OperationIn(permittedWithUnverifiedEmail) {
 		return nil
 	}
 
@@ -150,8 +219,20 @@ var adminAndOwnerOnly = []string{
 	"TenantMemberList",
 	// members cannot create API tokens for a tenant, because they have admin permissions
 	"ApiTokenList",
+	"ApiTokenGet",
 	"ApiTokenCreate",
 	"ApiTokenUpdateRevoke",
+	"ApiTokenUpdateRotate",
+	"TenantUpdate",
+	"TenantUpdatePause",
+	"TenantDeletePause",
+	"TenantUpdateEngineSettings",
+	"SubjectDeletionCreate",
+	// exports contain the data of the whole tenant
+	"TenantExportList",
+	"TenantExportGet",
+	"TenantExportCreate",
+	"TenantExportCreateDownloadUrl",
 }
 
 func (a *AuthZ) authorizeTenantOperations(tenant *db.TenantModel, tenantMember *db.TenantMemberModel, r *middleware.RouteInfo) error {
@@ -167,7 +248,7 @@ func (a *AuthZ) authorizeTenantOperations(tenant *db.TenantModel, tenantMember *
 	}
 
 	// at the moment, tenant members are only restricted from creating other tenant users.
-	if operationIn(r.OperationID, adminAndOwnerOnly) {
+	if r.OperationIn(adminAndOwnerOnly) {
 		return echo.NewHTTPError(http.StatusUnauthorized, "Not authorized to perform this operation")
 	}
 
@@ -175,13 +256,3 @@ func (a *AuthZ) authorizeTenantOperations(tenant *db.TenantModel, tenantMember *
 	// operations at the moment. If there is, we should modify this logic.
 	return nil
 }
-
-func operationIn(operationId string, operationIds []string) bool {
-	for _, id := range operationIds {
-		if strings.EqualFold(operationId, id) {
-			return true
-		}
-	}
-
-	return false
-}
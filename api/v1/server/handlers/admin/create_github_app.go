@@ -0,0 +1,87 @@
+package admin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/url"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/authn"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/integrations/vcs/github"
+)
+
+// githubAppStateKey is the integration which the state of the Github app manifest flow is stored under in the session.
+const githubAppStateKey = "github_app_manifest"
+
+// maxGithubAppNameLength is the maximum length of a Github app name.
+const maxGithubAppNameLength = 34
+
+// githubAppFormTemplate submits the app manifest to Github, which requires the manifest to be posted from a form.
+var githubAppFormTemplate = template.Must(template.New("github-app").Parse(`<!DOCTYPE html>
+<html>
+  <body>
+    <form id="manifest" action="{{ .URL }}" method="post">
+      <input type="hidden" name="manifest" value="{{ .Manifest }}">
+      <noscript><input type="submit" value="Create Github app"></noscript>
+    </form>
+    <script>document.getElementById("manifest").submit();</script>
+  </body>
+</html>
+`))
+
+func (a *AdminService) AdminGithubAppCreate(ctx echo.Context, request gen.AdminGithubAppCreateRequestObject) (gen.AdminGithubAppCreateResponseObject, error) {
+	serverURL, err := url.Parse(a.config.Runtime.ServerURL)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not parse server url: %w", err)
+	}
+
+	// Github app names are unique, so the default name includes the host of the instance. The name can be changed
+	// on Github before the app is created.
+	name := "hatchet-" + serverURL.Hostname()
+
+	if len(name) > maxGithubAppNameLength {
+		name = name[:maxGithubAppNameLength]
+	}
+
+	manifest, err := json.Marshal(github.NewAppManifest(name, a.config.Runtime.ServerURL))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal github app manifest: %w", err)
+	}
+
+	state, err := authn.NewSessionHelpers(a.config).SaveOAuthState(ctx, githubAppStateKey)
+
+	if err != nil {
+		return gen.AdminGithubAppCreate400JSONResponse(
+			apierrors.NewAPIErrors("Could not get cookie. Please make sure cookies are enabled."),
+		), nil
+	}
+
+	var organization string
+
+	if request.Params.Organization != nil {
+		organization = *request.Params.Organization
+	}
+
+	var buf bytes.Buffer
+
+	err = githubAppFormTemplate.Execute(&buf, map[string]string{
+		"URL":      github.AppManifestURL(organization, state),
+		"Manifest": string(manifest),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not render github app form: %w", err)
+	}
+
+	return gen.AdminGithubAppCreate200TexthtmlResponse{
+		Body:          &buf,
+		ContentLength: int64(buf.Len()),
+	}, nil
+}
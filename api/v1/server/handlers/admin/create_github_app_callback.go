@@ -0,0 +1,68 @@
+package admin
+
+import (
+	"fmt"
+
+	githubsdk "github.com/google/go-github/v57/github"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/authn"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+)
+
+// Note: we want all errors to redirect, otherwise the user will be greeted with raw JSON in the middle of the flow.
+func (a *AdminService) AdminGithubAppCreateCallback(ctx echo.Context, _ gen.AdminGithubAppCreateCallbackRequestObject) (gen.AdminGithubAppCreateCallbackResponseObject, error) {
+	isValid, _, err := authn.NewSessionHelpers(a.config).ValidateOAuthState(ctx, githubAppStateKey)
+
+	if err != nil || !isValid {
+		return nil, authn.GetRedirectWithError(ctx, a.config.Logger, err, "Could not create Github app. Please try again and make sure cookies are enabled.")
+	}
+
+	// exchange the temporary code for the credentials of the app which was created
+	appConfig, _, err := githubsdk.NewClient(nil).Apps.CompleteAppManifest(ctx.Request().Context(), ctx.Request().URL.Query().Get("code"))
+
+	if err != nil {
+		return nil, authn.GetRedirectWithError(ctx, a.config.Logger, err, "Could not create Github app.")
+	}
+
+	clientSecret, err := a.config.Encryption.Encrypt([]byte(appConfig.GetClientSecret()), "github_app_client_secret")
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt client secret: %w", err)
+	}
+
+	webhookSecret, err := a.config.Encryption.Encrypt([]byte(appConfig.GetWebhookSecret()), "github_app_webhook_secret")
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt webhook secret: %w", err)
+	}
+
+	privateKey, err := a.config.Encryption.Encrypt([]byte(appConfig.GetPEM()), "github_app_private_key")
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt private key: %w", err)
+	}
+
+	_, err = a.config.Repository.Github().CreateGithubAppCredentials(&repository.CreateGithubAppCredentialsOpts{
+		AppID:         int(appConfig.GetID()),
+		AppSlug:       appConfig.GetSlug(),
+		SettingsURL:   appConfig.GetHTMLURL(),
+		ClientID:      appConfig.GetClientID(),
+		ClientSecret:  clientSecret,
+		WebhookSecret: webhookSecret,
+		PrivateKey:    privateKey,
+	})
+
+	if err != nil {
+		return nil, authn.GetRedirectWithError(ctx, a.config.Logger, err, "Internal error.")
+	}
+
+	a.config.Logger.Info().Msgf("created github app %s, restart the Hatchet API to use it", appConfig.GetSlug())
+
+	return gen.AdminGithubAppCreateCallback302Response{
+		Headers: gen.AdminGithubAppCreateCallback302ResponseHeaders{
+			Location: appConfig.GetHTMLURL(),
+		},
+	}, nil
+}
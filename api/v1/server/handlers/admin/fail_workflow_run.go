@@ -0,0 +1,72 @@
+package admin
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+)
+
+const forceFailedError = "step run was force-failed by an instance admin"
+
+func (a *AdminService) AdminWorkflowRunUpdateFail(ctx echo.Context, request gen.AdminWorkflowRunUpdateFailRequestObject) (gen.AdminWorkflowRunUpdateFailResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	workflowRun := ctx.Get("workflow-run").(*db.WorkflowRunModel)
+
+	// the populated workflow run does not include step runs
+	workflowRun, err := a.config.Repository.WorkflowRun().GetWorkflowRunById(tenant.ID, workflowRun.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	failedAt := time.Now().UTC()
+	count := 0
+
+	for _, jobRun := range workflowRun.JobRuns() {
+		for _, stepRun := range jobRun.StepRuns() {
+			switch stepRun.Status {
+			case db.StepRunStatusSucceeded, db.StepRunStatusFailed, db.StepRunStatusCancelled:
+				continue
+			}
+
+			// the jobs controller resolves the job run and workflow run status once the step run is failed
+			err := a.config.MessageQueue.AddMessage(
+				ctx.Request().Context(),
+				msgqueue.JOB_PROCESSING_QUEUE,
+				tasktypes.StepRunForceFailedToTask(tenant.ID, stepRun.ID, failedAt, forceFailedError),
+			)
+
+			if err != nil {
+				return nil, fmt.Errorf("could not add step run failed task to queue: %w", err)
+			}
+
+			count++
+		}
+	}
+
+	if count == 0 {
+		return gen.AdminWorkflowRunUpdateFail400JSONResponse(
+			apierrors.NewAPIErrors("workflow run has no unfinished step runs"),
+		), nil
+	}
+
+	res, err := transformers.ToWorkflowRun(workflowRun)
+
+	if err != nil {
+		return nil, err
+	}
+
+	transformers.RedactWorkflowRun(res, transformers.RedactionRules(tenant))
+
+	return gen.AdminWorkflowRunUpdateFail200JSONResponse(
+		*res,
+	), nil
+}
@@ -0,0 +1,34 @@
+package admin
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/features"
+)
+
+func (a *AdminService) AdminTenantFeatureFlagList(ctx echo.Context, request gen.AdminTenantFeatureFlagListRequestObject) (gen.AdminTenantFeatureFlagListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// the overrides are read from the database rather than the cache, so changes are shown right away
+	overrides, err := a.config.Repository.FeatureFlag().GetTenantFeatureFlagOverrides(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.AdminFeatureFlag, len(features.Definitions))
+
+	for i := range features.Definitions {
+		def := &features.Definitions[i]
+		rows[i] = *transformers.ToAdminFeatureFlag(def, overrides, a.config.FeatureFlags.InstanceEnabled(def.Flag))
+	}
+
+	return gen.AdminTenantFeatureFlagList200JSONResponse(
+		gen.AdminFeatureFlagList{
+			Rows: &rows,
+		},
+	), nil
+}
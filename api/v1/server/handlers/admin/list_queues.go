@@ -0,0 +1,28 @@
+package admin
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+)
+
+func (a *AdminService) AdminQueueList(ctx echo.Context, request gen.AdminQueueListRequestObject) (gen.AdminQueueListResponseObject, error) {
+	queues, err := a.config.Repository.StepRun().ListStepRunQueues()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.AdminQueue, len(queues))
+
+	for i := range queues {
+		rows[i] = *transformers.ToAdminQueue(queues[i])
+	}
+
+	return gen.AdminQueueList200JSONResponse(
+		gen.AdminQueueList{
+			Rows: &rows,
+		},
+	), nil
+}
@@ -0,0 +1,36 @@
+package admin
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+)
+
+func (a *AdminService) AdminTenantList(ctx echo.Context, request gen.AdminTenantListRequestObject) (gen.AdminTenantListResponseObject, error) {
+	createdAfter := time.Now().UTC().Add(-24 * time.Hour)
+
+	if request.Params.CreatedAfter != nil {
+		createdAfter = *request.Params.CreatedAfter
+	}
+
+	tenants, err := a.config.Repository.Tenant().ListTenantsWithRunCounts(createdAfter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.AdminTenant, len(tenants))
+
+	for i := range tenants {
+		rows[i] = *transformers.ToAdminTenant(tenants[i])
+	}
+
+	return gen.AdminTenantList200JSONResponse(
+		gen.AdminTenantList{
+			Rows: &rows,
+		},
+	), nil
+}
@@ -0,0 +1,15 @@
+package admin
+
+import (
+	"github.com/hatchet-dev/hatchet/internal/config/server"
+)
+
+type AdminService struct {
+	config *server.ServerConfig
+}
+
+func NewAdminService(config *server.ServerConfig) *AdminService {
+	return &AdminService{
+		config: config,
+	}
+}
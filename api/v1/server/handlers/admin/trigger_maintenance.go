@@ -0,0 +1,39 @@
+package admin
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+)
+
+func (a *AdminService) AdminMaintenanceCreate(ctx echo.Context, request gen.AdminMaintenanceCreateRequestObject) (gen.AdminMaintenanceCreateResponseObject, error) {
+	var job string
+
+	switch request.Body.Job {
+	case gen.STEPRUNREQUEUE:
+		job = tasktypes.MaintenanceJobStepRunRequeue
+	case gen.STEPRUNREASSIGN:
+		job = tasktypes.MaintenanceJobStepRunReassign
+	default:
+		return gen.AdminMaintenanceCreate400JSONResponse(
+			apierrors.NewAPIErrors(fmt.Sprintf("unknown maintenance job %s", request.Body.Job)),
+		), nil
+	}
+
+	err := a.config.MessageQueue.AddMessage(
+		ctx.Request().Context(),
+		msgqueue.JOB_PROCESSING_QUEUE,
+		tasktypes.MaintenanceJobToTask(job),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not add maintenance job to queue: %w", err)
+	}
+
+	return gen.AdminMaintenanceCreate200Response{}, nil
+}
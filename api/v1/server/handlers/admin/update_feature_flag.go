@@ -0,0 +1,53 @@
+package admin
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/features"
+)
+
+func (a *AdminService) AdminTenantFeatureFlagUpdate(ctx echo.Context, request gen.AdminTenantFeatureFlagUpdateRequestObject) (gen.AdminTenantFeatureFlagUpdateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	def, ok := features.Lookup(request.Body.Name)
+
+	if !ok {
+		return gen.AdminTenantFeatureFlagUpdate400JSONResponse(
+			apierrors.NewAPIErrors(fmt.Sprintf("unknown feature flag %s", request.Body.Name)),
+		), nil
+	}
+
+	// omitting enabled removes the override, so the tenant uses the setting of the instance again
+	if request.Body.Enabled != nil {
+		_, err := a.config.Repository.FeatureFlag().UpsertTenantFeatureFlag(tenant.ID, string(def.Flag), *request.Body.Enabled)
+
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		err := a.config.Repository.FeatureFlag().DeleteTenantFeatureFlag(tenant.ID, string(def.Flag))
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// other instances pick up the change once their cached overrides expire
+	a.config.FeatureFlags.Invalidate(tenant.ID)
+
+	overrides, err := a.config.Repository.FeatureFlag().GetTenantFeatureFlagOverrides(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.AdminTenantFeatureFlagUpdate200JSONResponse(
+		*transformers.ToAdminFeatureFlag(def, overrides, a.config.FeatureFlags.InstanceEnabled(def.Flag)),
+	), nil
+}
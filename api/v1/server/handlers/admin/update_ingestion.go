@@ -0,0 +1,26 @@
+package admin
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (a *AdminService) AdminTenantUpdateIngestion(ctx echo.Context, request gen.AdminTenantUpdateIngestionRequestObject) (gen.AdminTenantUpdateIngestionResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	updated, err := a.config.Repository.Tenant().UpdateTenant(tenant.ID, &repository.UpdateTenantOpts{
+		IngestionPaused: repository.BoolPtr(request.Body.Paused),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.AdminTenantUpdateIngestion200JSONResponse(
+		*transformers.ToTenant(updated),
+	), nil
+}
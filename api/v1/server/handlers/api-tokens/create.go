@@ -1,9 +1,15 @@
 package apitokens
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/labstack/echo/v4"
 
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/serverutils"
+	"github.com/hatchet-dev/hatchet/internal/auth/token"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 )
 
@@ -17,7 +23,47 @@ func (a *APITokenService) ApiTokenCreate(ctx echo.Context, request gen.ApiTokenC
 		return gen.ApiTokenCreate400JSONResponse(*apiErrors), nil
 	}
 
-	token, err := a.config.Auth.JWTManager.GenerateTenantToken(tenant.ID, request.Body.Name)
+	opts := []token.GenerateTokenOpt{}
+
+	if request.Body.ExpiresIn != nil {
+		expiresIn, err := time.ParseDuration(*request.Body.ExpiresIn)
+
+		if err != nil || expiresIn <= 0 {
+			return gen.ApiTokenCreate400JSONResponse(
+				apierrors.NewAPIErrors(fmt.Sprintf("expiresIn must be a positive duration such as 720h, got %s", *request.Body.ExpiresIn)),
+			), nil
+		}
+
+		opts = append(opts, token.WithExpiresIn(expiresIn))
+	}
+
+	if request.Body.Scopes != nil {
+		scopes := make([]string, len(*request.Body.Scopes))
+
+		for i, scope := range *request.Body.Scopes {
+			scopes[i] = string(scope)
+		}
+
+		if err := token.ValidateScopes(scopes); err != nil {
+			return gen.ApiTokenCreate400JSONResponse(
+				apierrors.NewAPIErrors(err.Error()),
+			), nil
+		}
+
+		opts = append(opts, token.WithScopes(scopes))
+	}
+
+	// tokens which are created with an environment token can't leave its environment
+	if environment := serverutils.GetEnvironment(ctx, request.Body.Environment); environment != nil {
+		opts = append(opts, token.WithEnvironment(*environment))
+	}
+
+	// the same applies to the namespace of a namespace token
+	if namespace := serverutils.GetNamespace(ctx, request.Body.Namespace); namespace != nil {
+		opts = append(opts, token.WithNamespace(*namespace))
+	}
+
+	tok, err := a.config.Auth.JWTManager.GenerateTenantToken(tenant.ID, request.Body.Name, opts...)
 
 	if err != nil {
 		return nil, err
@@ -25,6 +71,6 @@ func (a *APITokenService) ApiTokenCreate(ctx echo.Context, request gen.ApiTokenC
 
 	// This is the only time the token is sent over the API
 	return gen.ApiTokenCreate200JSONResponse{
-		Token: token,
+		Token: tok,
 	}, nil
 }
@@ -0,0 +1,17 @@
+package apitokens
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (a *APITokenService) ApiTokenGet(ctx echo.Context, request gen.ApiTokenGetRequestObject) (gen.ApiTokenGetResponseObject, error) {
+	apiToken := ctx.Get("api-token").(*db.APITokenModel)
+
+	return gen.ApiTokenGet200JSONResponse(
+		*transformers.ToAPIToken(apiToken),
+	), nil
+}
@@ -5,13 +5,17 @@ import (
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/api/v1/server/serverutils"
+	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 )
 
 func (a *APITokenService) ApiTokenList(ctx echo.Context, request gen.ApiTokenListRequestObject) (gen.ApiTokenListResponseObject, error) {
 	tenant := ctx.Get("tenant").(*db.TenantModel)
 
-	tokens, err := a.config.Repository.APIToken().ListAPITokensByTenant(tenant.ID)
+	tokens, err := a.config.Repository.APIToken().ListAPITokensByTenant(tenant.ID, &repository.ListAPITokensOpts{
+		Environment: serverutils.GetEnvironment(ctx, request.Params.Environment),
+	})
 
 	if err != nil {
 		return nil, err
@@ -0,0 +1,60 @@
+package apitokens
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/auth/token"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (a *APITokenService) ApiTokenUpdateRotate(ctx echo.Context, request gen.ApiTokenUpdateRotateRequestObject) (gen.ApiTokenUpdateRotateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	apiToken := ctx.Get("api-token").(*db.APITokenModel)
+
+	if apiToken.Revoked {
+		return gen.ApiTokenUpdateRotate400JSONResponse(
+			apierrors.NewAPIErrors("the API token has been revoked"),
+		), nil
+	}
+
+	name, _ := apiToken.Name()
+
+	// the new token is valid for as long as the rotated token was
+	expiresIn := token.DefaultTokenExpiry
+
+	if expiresAt, ok := apiToken.ExpiresAt(); ok {
+		expiresIn = expiresAt.Sub(apiToken.CreatedAt)
+	}
+
+	opts := []token.GenerateTokenOpt{
+		token.WithExpiresIn(expiresIn),
+		token.WithScopes(apiToken.Scopes),
+	}
+
+	if environment, ok := apiToken.Environment(); ok {
+		opts = append(opts, token.WithEnvironment(environment))
+	}
+
+	if namespace, ok := apiToken.Namespace(); ok {
+		opts = append(opts, token.WithNamespace(namespace))
+	}
+
+	tok, err := a.config.Auth.JWTManager.GenerateTenantToken(tenant.ID, name, opts...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the rotated token is only revoked once the new token has been created, so that a failure doesn't leave
+	// the caller without a valid token
+	if err := a.config.Repository.APIToken().RevokeAPIToken(apiToken.ID); err != nil {
+		return nil, err
+	}
+
+	// This is the only time the token is sent over the API
+	return gen.ApiTokenUpdateRotate200JSONResponse{
+		Token: tok,
+	}, nil
+}
@@ -0,0 +1,90 @@
+package clientcertificates
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/auth/clientcert"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (c *ClientCertificateService) ClientCertificateCreate(ctx echo.Context, request gen.ClientCertificateCreateRequestObject) (gen.ClientCertificateCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// validate the request
+	if apiErrors, err := c.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.ClientCertificateCreate400JSONResponse(*apiErrors), nil
+	}
+
+	if (request.Body.Certificate == nil) == (request.Body.Fingerprint == nil) {
+		return gen.ClientCertificateCreate400JSONResponse(
+			apierrors.NewAPIErrors("Either the certificate or its fingerprint is required."),
+		), nil
+	}
+
+	opts := &repository.CreateClientCertificateOpts{
+		Name: request.Body.Name,
+	}
+
+	if request.Body.Certificate != nil {
+		cert, err := clientcert.ParseCertificate(*request.Body.Certificate)
+
+		if err != nil {
+			return gen.ClientCertificateCreate400JSONResponse(
+				apierrors.NewAPIErrors(err.Error()),
+			), nil
+		}
+
+		subject := cert.Subject.String()
+
+		opts.Fingerprint = clientcert.Fingerprint(cert)
+		opts.Subject = &subject
+		opts.ExpiresAt = &cert.NotAfter
+	} else {
+		fingerprint, err := clientcert.ParseFingerprint(*request.Body.Fingerprint)
+
+		if err != nil {
+			return gen.ClientCertificateCreate400JSONResponse(
+				apierrors.NewAPIErrors(err.Error()),
+			), nil
+		}
+
+		opts.Fingerprint = fingerprint
+	}
+
+	// determine if a certificate with the name or fingerprint is already pinned
+	existing, err := c.config.Repository.ClientCertificate().ListClientCertificates(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cert := range existing {
+		if cert.Name == opts.Name {
+			return gen.ClientCertificateCreate400JSONResponse(
+				apierrors.NewAPIErrors("Client certificate with the name already exists."),
+			), nil
+		}
+
+		if cert.Fingerprint == opts.Fingerprint {
+			return gen.ClientCertificateCreate400JSONResponse(
+				apierrors.NewAPIErrors("Client certificate is already pinned."),
+			), nil
+		}
+	}
+
+	cert, err := c.config.Repository.ClientCertificate().CreateClientCertificate(tenant.ID, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.ClientCertificateCreate201JSONResponse(
+		*transformers.ToClientCertificate(cert),
+	), nil
+}
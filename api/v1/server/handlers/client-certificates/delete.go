@@ -0,0 +1,21 @@
+package clientcertificates
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (c *ClientCertificateService) ClientCertificateDelete(ctx echo.Context, request gen.ClientCertificateDeleteRequestObject) (gen.ClientCertificateDeleteResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	cert := ctx.Get("client-certificate").(*db.ClientCertificateModel)
+
+	err := c.config.Repository.ClientCertificate().DeleteClientCertificate(tenant.ID, cert.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.ClientCertificateDelete204Response{}, nil
+}
@@ -0,0 +1,31 @@
+package clientcertificates
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (c *ClientCertificateService) ClientCertificateList(ctx echo.Context, request gen.ClientCertificateListRequestObject) (gen.ClientCertificateListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	certs, err := c.config.Repository.ClientCertificate().ListClientCertificates(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.ClientCertificate, len(certs))
+
+	for i := range certs {
+		rows[i] = *transformers.ToClientCertificate(&certs[i])
+	}
+
+	return gen.ClientCertificateList200JSONResponse(
+		gen.ListClientCertificates{
+			Rows: rows,
+		},
+	), nil
+}
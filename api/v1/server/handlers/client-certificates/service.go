@@ -0,0 +1,15 @@
+package clientcertificates
+
+import (
+	"github.com/hatchet-dev/hatchet/internal/config/server"
+)
+
+type ClientCertificateService struct {
+	config *server.ServerConfig
+}
+
+func NewClientCertificateService(config *server.ServerConfig) *ClientCertificateService {
+	return &ClientCertificateService{
+		config: config,
+	}
+}
@@ -0,0 +1,36 @@
+package eventbus
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (e *EventBusService) EventBusSubscriptionAck(ctx echo.Context, request gen.EventBusSubscriptionAckRequestObject) (gen.EventBusSubscriptionAckResponseObject, error) {
+	subscription := ctx.Get("event-bus-subscription").(*db.EventBusSubscriptionModel)
+
+	// validate the request
+	if apiErrors, err := e.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.EventBusSubscriptionAck400JSONResponse(*apiErrors), nil
+	}
+
+	err := e.config.Repository.EventBus().AckEventBusSubscription(ctx.Request().Context(), subscription.ID, request.Body.Cursor)
+
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err = e.config.Repository.EventBus().GetEventBusSubscriptionById(subscription.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.EventBusSubscriptionAck200JSONResponse(
+		*transformers.ToEventBusSubscription(subscription),
+	), nil
+}
@@ -0,0 +1,61 @@
+package eventbus
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (e *EventBusService) EventBusSubscriptionCreate(ctx echo.Context, request gen.EventBusSubscriptionCreateRequestObject) (gen.EventBusSubscriptionCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// validate the request
+	if apiErrors, err := e.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.EventBusSubscriptionCreate400JSONResponse(*apiErrors), nil
+	}
+
+	// determine if a subscription with the name already exists
+	existing, err := e.config.Repository.EventBus().ListEventBusSubscriptions(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, subscription := range existing {
+		if subscription.Name == request.Body.Name {
+			return gen.EventBusSubscriptionCreate400JSONResponse(
+				apierrors.NewAPIErrors("Event bus subscription with the name already exists."),
+			), nil
+		}
+	}
+
+	// deduplicate the topics, so each record is only returned once
+	topics := []string{}
+	seen := map[gen.EventBusTopic]bool{}
+
+	for _, topic := range request.Body.Topics {
+		if !seen[topic] {
+			seen[topic] = true
+			topics = append(topics, string(topic))
+		}
+	}
+
+	subscription, err := e.config.Repository.EventBus().CreateEventBusSubscription(tenant.ID, &repository.CreateEventBusSubscriptionOpts{
+		Name:   request.Body.Name,
+		Topics: topics,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.EventBusSubscriptionCreate201JSONResponse(
+		*transformers.ToEventBusSubscription(subscription),
+	), nil
+}
@@ -0,0 +1,21 @@
+package eventbus
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (e *EventBusService) EventBusSubscriptionDelete(ctx echo.Context, request gen.EventBusSubscriptionDeleteRequestObject) (gen.EventBusSubscriptionDeleteResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	subscription := ctx.Get("event-bus-subscription").(*db.EventBusSubscriptionModel)
+
+	err := e.config.Repository.EventBus().DeleteEventBusSubscription(tenant.ID, subscription.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.EventBusSubscriptionDelete204Response{}, nil
+}
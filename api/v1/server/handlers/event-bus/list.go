@@ -0,0 +1,31 @@
+package eventbus
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (e *EventBusService) EventBusSubscriptionList(ctx echo.Context, request gen.EventBusSubscriptionListRequestObject) (gen.EventBusSubscriptionListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	subscriptions, err := e.config.Repository.EventBus().ListEventBusSubscriptions(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.EventBusSubscription, len(subscriptions))
+
+	for i := range subscriptions {
+		rows[i] = *transformers.ToEventBusSubscription(&subscriptions[i])
+	}
+
+	return gen.EventBusSubscriptionList200JSONResponse(
+		gen.ListEventBusSubscriptions{
+			Rows: rows,
+		},
+	), nil
+}
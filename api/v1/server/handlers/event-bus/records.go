@@ -0,0 +1,94 @@
+package eventbus
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+const (
+	defaultRecordsLimit = 100
+	maxRecordsLimit     = 1000
+
+	// maxWait is the longest time a request waits for records, which stays below common proxy timeouts
+	maxWait = 30 * time.Second
+
+	// pollInterval is how often records are listed again while a request waits for them
+	pollInterval = time.Second
+)
+
+func (e *EventBusService) EventBusSubscriptionListRecords(ctx echo.Context, request gen.EventBusSubscriptionListRecordsRequestObject) (gen.EventBusSubscriptionListRecordsResponseObject, error) {
+	subscription := ctx.Get("event-bus-subscription").(*db.EventBusSubscriptionModel)
+
+	limit := defaultRecordsLimit
+
+	if request.Params.Limit != nil {
+		limit = int(*request.Params.Limit)
+	}
+
+	if limit < 1 || limit > maxRecordsLimit {
+		return gen.EventBusSubscriptionListRecords400JSONResponse(
+			apierrors.NewAPIErrors("limit must be between 1 and 1000"),
+		), nil
+	}
+
+	var wait time.Duration
+
+	if request.Params.Wait != nil {
+		wait = time.Duration(*request.Params.Wait) * time.Second
+	}
+
+	if wait < 0 || wait > maxWait {
+		return gen.EventBusSubscriptionListRecords400JSONResponse(
+			apierrors.NewAPIErrors("wait must be between 0 and 30 seconds"),
+		), nil
+	}
+
+	reqCtx := ctx.Request().Context()
+	deadline := time.Now().Add(wait)
+
+	var records []*dbsqlc.EventBusRecord
+
+	for {
+		var err error
+
+		records, err = e.config.Repository.EventBus().ListEventBusRecords(reqCtx, subscription.ID, limit)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(records) > 0 || !time.Now().Add(pollInterval).Before(deadline) {
+			break
+		}
+
+		select {
+		case <-reqCtx.Done():
+			return nil, reqCtx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+
+	rows := make([]gen.EventBusRecord, len(records))
+
+	// when no records are returned, acknowledging the cursor is a no-op
+	cursor := int64(subscription.AckedRecordID)
+
+	for i := range records {
+		rows[i] = *transformers.ToEventBusRecord(records[i])
+		cursor = records[i].ID
+	}
+
+	return gen.EventBusSubscriptionListRecords200JSONResponse(
+		gen.ListEventBusRecords{
+			Rows:   rows,
+			Cursor: cursor,
+		},
+	), nil
+}
@@ -0,0 +1,15 @@
+package eventbus
+
+import (
+	"github.com/hatchet-dev/hatchet/internal/config/server"
+)
+
+type EventBusService struct {
+	config *server.ServerConfig
+}
+
+func NewEventBusService(config *server.ServerConfig) *EventBusService {
+	return &EventBusService{
+		config: config,
+	}
+}
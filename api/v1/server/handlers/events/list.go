@@ -8,6 +8,7 @@ import (
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/api/v1/server/serverutils"
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 )
@@ -19,8 +20,10 @@ func (t *EventService) EventList(ctx echo.Context, request gen.EventListRequestO
 	offset := 0
 
 	listOpts := &repository.ListEventOpts{
-		Limit:  &limit,
-		Offset: &offset,
+		Limit:       &limit,
+		Offset:      &offset,
+		Environment: serverutils.GetEnvironment(ctx, request.Params.Environment),
+		Namespace:   serverutils.GetNamespace(ctx, request.Params.Namespace),
 	}
 
 	if request.Params.Search != nil {
@@ -4,14 +4,22 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/labstack/echo/v4"
 
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 )
 
 func (t *EventService) EventUpdateReplay(ctx echo.Context, request gen.EventUpdateReplayRequestObject) (gen.EventUpdateReplayResponseObject, error) {
 	tenant := ctx.Get("tenant").(*db.TenantModel)
 
+	if tenant.IngestionPaused {
+		return gen.EventUpdateReplay400JSONResponse(
+			apierrors.NewAPIErrors("event ingestion is paused for this tenant"),
+		), nil
+	}
+
 	eventIds := make([]string, len(request.Body.EventIds))
 
 	for i := range request.Body.EventIds {
@@ -24,6 +32,17 @@ func (t *EventService) EventUpdateReplay(ctx echo.Context, request gen.EventUpda
 		return nil, err
 	}
 
+	// tokens with a namespace can only replay the events of the namespace
+	if namespace, ok := ctx.Get("namespace").(string); ok && namespace != "" {
+		for i := range events {
+			if !repository.InNamespace(namespace, events[i].Key) {
+				return gen.EventUpdateReplay403JSONResponse(
+					apierrors.NewAPIErrors(repository.ErrOutsideNamespace.Error()),
+				), nil
+			}
+		}
+	}
+
 	newEvents := make([]db.EventModel, len(events))
 
 	var allErrs error
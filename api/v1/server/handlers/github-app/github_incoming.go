@@ -1,13 +1,19 @@
 package githubapp
 
 import (
+	"errors"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/internal/integrations/vcs/github"
 	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/webhooks"
 
 	githubsdk "github.com/google/go-github/v57/github"
 )
@@ -18,24 +24,42 @@ func (g *GithubAppService) GithubUpdateTenantWebhook(ctx echo.Context, req gen.G
 	webhook, err := g.config.Repository.Github().ReadGithubWebhookById(webhookId)
 
 	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, apierrors.NotFound("Github webhook not found").Wrap(err)
+		}
+
 		return nil, err
 	}
 
 	signingSecret, err := g.config.Encryption.Decrypt(webhook.SigningSecret, "github_signing_secret")
 
 	if err != nil {
-		return nil, err
+		return nil, apierrors.Internal(err)
 	}
 
 	// validate the payload using the github webhook signing secret
 	payload, err := githubsdk.ValidatePayload(ctx.Request(), signingSecret)
 
 	if err != nil {
-		return nil, err
+		return nil, newInvalidSignatureError(err)
 	}
 
 	event, err := githubsdk.ParseWebHook(githubsdk.WebHookType(ctx.Request()), payload)
 
+	if err != nil {
+		return nil, newInvalidEventError(err)
+	}
+
+	delivery, err := g.config.WebhookReceiver.Receive(ctx.Request().Context(), &webhooks.Delivery{
+		Source:     dbsqlc.WebhookDeliverySourceGITHUB,
+		TenantId:   webhook.TenantID,
+		WebhookId:  webhookId,
+		DeliveryId: githubsdk.DeliveryID(ctx.Request()),
+		Event:      githubsdk.WebHookType(ctx.Request()),
+		Payload:    payload,
+		SentAt:     githubEventSentAt(event),
+	})
+
 	if err != nil {
 		return nil, err
 	}
@@ -45,14 +69,44 @@ func (g *GithubAppService) GithubUpdateTenantWebhook(ctx echo.Context, req gen.G
 		err = g.processPullRequestEvent(webhook.TenantID, event, ctx.Request())
 	}
 
-	return nil, nil
+	if finishErr := g.config.WebhookReceiver.Finish(ctx.Request().Context(), delivery, err); finishErr != nil {
+		g.config.Logger.Err(finishErr).Msg("could not record the result of a Github webhook delivery")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.GithubUpdateTenantWebhook200Response{}, nil
+}
+
+// githubEventSentAt returns the time a delivery was sent at, which is the time the pull request of a pull request
+// event was last updated. Other events don't have a timestamp.
+func githubEventSentAt(event interface{}) *time.Time {
+	switch event := event.(type) { // nolint: gocritic
+	case *githubsdk.PullRequestEvent:
+		if updatedAt := event.GetPullRequest().GetUpdatedAt(); !updatedAt.IsZero() {
+			return &updatedAt.Time
+		}
+	}
+
+	return nil
 }
 
 func (g *GithubAppService) processPullRequestEvent(tenantId string, event *githubsdk.PullRequestEvent, r *http.Request) error {
+	if err := g.triggerPreviewEnvironments(r.Context(), tenantId, event); err != nil {
+		return err
+	}
+
 	pr := github.ToVCSRepositoryPullRequest(*event.GetRepo().GetOwner().Login, event.GetRepo().GetName(), event.GetPullRequest())
 
 	dbPR, err := g.config.Repository.Github().GetPullRequest(tenantId, pr.GetRepoOwner(), pr.GetRepoName(), int(pr.GetPRNumber()))
 
+	// pull requests which were not opened by Hatchet are ignored
+	if errors.Is(err, db.ErrNotFound) {
+		return nil
+	}
+
 	if err != nil {
 		return err
 	}
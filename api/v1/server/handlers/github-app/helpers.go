@@ -1,7 +1,7 @@
 package githubapp
 
 import (
-	"fmt"
+	"net/http"
 
 	"github.com/labstack/echo/v4"
 
@@ -19,13 +19,13 @@ func GetGithubProvider(config *server.ServerConfig) (res github.GithubVCSProvide
 	vcsProvider, exists := config.VCSProviders[vcs.VCSRepositoryKindGithub]
 
 	if !exists {
-		return res, fmt.Errorf("No Github app set up on this Hatchet instance.")
+		return res, apierrors.NewError(http.StatusBadRequest, apierrors.CodeGithubAppNotConfigured, "No Github app set up on this Hatchet instance.")
 	}
 
 	res, err := github.ToGithubVCSProvider(vcsProvider)
 
 	if err != nil {
-		return res, fmt.Errorf("Github app is improperly set up on this Hatchet instance.")
+		return res, apierrors.NewError(http.StatusInternalServerError, apierrors.CodeGithubAppNotConfigured, "Github app is improperly set up on this Hatchet instance.").Wrap(err)
 	}
 
 	return res, nil
@@ -41,6 +41,14 @@ func GetGithubAppConfig(config *server.ServerConfig) (*github.GithubAppConf, err
 	return githubFact.GetGithubAppConfig(), nil
 }
 
+func newInvalidSignatureError(err error) *apierrors.Error {
+	return apierrors.NewError(http.StatusBadRequest, apierrors.CodeGithubWebhookSignatureInvalid, "Github webhook signature is invalid").Wrap(err)
+}
+
+func newInvalidEventError(err error) *apierrors.Error {
+	return apierrors.NewError(http.StatusBadRequest, apierrors.CodeGithubWebhookEventInvalid, "Github webhook event could not be parsed").Wrap(err)
+}
+
 // GetGithubAppClientFromRequest gets the github app installation id from the request and authenticates
 // using it and the private key
 func GetGithubAppClientFromRequest(ctx echo.Context, config *server.ServerConfig) (*githubsdk.Client, *gen.APIErrors) {
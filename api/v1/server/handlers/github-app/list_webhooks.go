@@ -0,0 +1,31 @@
+package githubapp
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (g *GithubAppService) GithubWebhookList(ctx echo.Context, req gen.GithubWebhookListRequestObject) (gen.GithubWebhookListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	webhooks, err := g.config.Repository.Github().ListGithubWebhooks(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.GithubWebhook, len(webhooks))
+
+	for i := range webhooks {
+		rows[i] = *transformers.ToGithubWebhook(&webhooks[i])
+	}
+
+	return gen.GithubWebhookList200JSONResponse(
+		gen.ListGithubWebhooks{
+			Rows: rows,
+		},
+	), nil
+}
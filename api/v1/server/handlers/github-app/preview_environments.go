@@ -0,0 +1,171 @@
+package githubapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	githubsdk "github.com/google/go-github/v57/github"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+)
+
+// previewEnvironmentInput is the input of the workflows which provision or tear down the preview environment of a
+// pull request.
+type previewEnvironmentInput struct {
+	PullRequest previewEnvironmentPullRequest `json:"pullRequest"`
+}
+
+type previewEnvironmentPullRequest struct {
+	Action     string `json:"action"`
+	Number     int    `json:"number"`
+	Title      string `json:"title"`
+	URL        string `json:"url"`
+	Author     string `json:"author"`
+	RepoOwner  string `json:"repoOwner"`
+	RepoName   string `json:"repoName"`
+	HeadBranch string `json:"headBranch"`
+	HeadSHA    string `json:"headSha"`
+	BaseBranch string `json:"baseBranch"`
+	Merged     bool   `json:"merged"`
+}
+
+// previewEnvironmentHook returns the preview environment hook whose workflows are triggered by the action of a pull
+// request event, if any.
+func previewEnvironmentHook(action string) (db.PreviewEnvironmentHook, bool) {
+	switch action {
+	case "opened", "reopened", "synchronize":
+		return db.PreviewEnvironmentHookProvision, true
+	case "closed":
+		return db.PreviewEnvironmentHookTeardown, true
+	default:
+		return "", false
+	}
+}
+
+// triggerPreviewEnvironments triggers the workflows which provision or tear down the preview environments of the pull
+// request of the event. The runs are linked to the pull request, so that the workflows controller can report the
+// environments on the pull request once the runs finish.
+func (g *GithubAppService) triggerPreviewEnvironments(ctx context.Context, tenantId string, event *githubsdk.PullRequestEvent) error {
+	hook, ok := previewEnvironmentHook(event.GetAction())
+
+	if !ok {
+		return nil
+	}
+
+	repoOwner := event.GetRepo().GetOwner().GetLogin()
+	repoName := event.GetRepo().GetName()
+	pr := event.GetPullRequest()
+
+	workflows, err := g.config.Repository.Workflow().ListPreviewEnvironmentWorkflows(tenantId, repoOwner, repoName, hook)
+
+	if err != nil {
+		return fmt.Errorf("could not list preview environment workflows: %w", err)
+	}
+
+	if len(workflows) == 0 {
+		return nil
+	}
+
+	dbPR, err := g.config.Repository.Github().UpsertPullRequest(tenantId, &repository.UpsertPullRequestOpts{
+		RepoOwner:         repoOwner,
+		RepoName:          repoName,
+		PullRequestID:     int(pr.GetID()),
+		PullRequestNumber: pr.GetNumber(),
+		Title:             pr.GetTitle(),
+		State:             pr.GetState(),
+		HeadBranch:        pr.GetHead().GetRef(),
+		BaseBranch:        pr.GetBase().GetRef(),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not store pull request: %w", err)
+	}
+
+	input, err := json.Marshal(previewEnvironmentInput{
+		PullRequest: previewEnvironmentPullRequest{
+			Action:     event.GetAction(),
+			Number:     pr.GetNumber(),
+			Title:      pr.GetTitle(),
+			URL:        pr.GetHTMLURL(),
+			Author:     pr.GetUser().GetLogin(),
+			RepoOwner:  repoOwner,
+			RepoName:   repoName,
+			HeadBranch: pr.GetHead().GetRef(),
+			HeadSHA:    pr.GetHead().GetSHA(),
+			BaseBranch: pr.GetBase().GetRef(),
+			Merged:     pr.GetMerged(),
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	// a workflow which can't be triggered doesn't stop the other workflows, since Github would redeliver the event
+	// to all of them
+	for i := range workflows {
+		workflow := &workflows[i]
+
+		workflowRunId, err := g.triggerPreviewEnvironmentWorkflow(ctx, tenantId, workflow, input)
+
+		if err != nil {
+			g.config.Logger.Error().Err(err).Msgf("could not trigger preview environment workflow %s", workflow.ID)
+			continue
+		}
+
+		if err := g.config.Repository.Github().AddPullRequestWorkflowRun(tenantId, dbPR.ID, workflowRunId); err != nil {
+			g.config.Logger.Error().Err(err).Msgf("could not link workflow run %s to pull request %s", workflowRunId, dbPR.ID)
+		}
+	}
+
+	return nil
+}
+
+func (g *GithubAppService) triggerPreviewEnvironmentWorkflow(ctx context.Context, tenantId string, workflow *db.WorkflowModel, input []byte) (string, error) {
+	versions := workflow.Versions()
+
+	if len(versions) == 0 {
+		return "", fmt.Errorf("workflow has no versions")
+	}
+
+	// the latest version only receives part of the triggers while it's rolled out
+	resolved, err := g.config.Repository.Workflow().ResolveWorkflowVersions(ctx, tenantId, []string{versions[0].ID})
+
+	if err != nil {
+		return "", err
+	}
+
+	workflowVersion, err := g.config.Repository.Workflow().GetWorkflowVersionById(tenantId, resolved[0])
+
+	if err != nil {
+		return "", err
+	}
+
+	createOpts, err := repository.GetCreateWorkflowRunOptsFromManual(workflowVersion, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	workflowRun, err := g.config.Repository.WorkflowRun().CreateNewWorkflowRun(ctx, tenantId, createOpts)
+
+	if err != nil {
+		return "", fmt.Errorf("could not create workflow run: %w", err)
+	}
+
+	err = g.config.MessageQueue.AddMessage(
+		ctx,
+		msgqueue.WORKFLOW_PROCESSING_QUEUE,
+		tasktypes.WorkflowRunQueuedToTask(workflowRun),
+	)
+
+	if err != nil {
+		return "", fmt.Errorf("could not add workflow run to queue: %w", err)
+	}
+
+	return workflowRun.ID, nil
+}
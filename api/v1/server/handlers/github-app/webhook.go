@@ -24,13 +24,13 @@ func (g *GithubAppService) GithubUpdateGlobalWebhook(ctx echo.Context, req gen.G
 	payload, err := githubsdk.ValidatePayload(ctx.Request(), []byte(ghApp.GetWebhookSecret()))
 
 	if err != nil {
-		return nil, err
+		return nil, newInvalidSignatureError(err)
 	}
 
 	event, err := githubsdk.ParseWebHook(githubsdk.WebHookType(ctx.Request()), payload)
 
 	if err != nil {
-		return nil, err
+		return nil, newInvalidEventError(err)
 	}
 
 	switch e := event.(type) {
@@ -52,7 +52,7 @@ func (g *GithubAppService) GithubUpdateGlobalWebhook(ctx echo.Context, req gen.G
 		return nil, err
 	}
 
-	return nil, nil
+	return gen.GithubUpdateGlobalWebhook200Response{}, nil
 }
 
 func (g *GithubAppService) handleInstallationEvent(senderID int64, i *githubsdk.Installation) error {
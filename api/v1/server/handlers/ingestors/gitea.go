@@ -0,0 +1,159 @@
+package ingestors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/vcs"
+	"github.com/hatchet-dev/hatchet/internal/integrations/vcs/gitea"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/webhooks"
+)
+
+// giteaPullRequestEventData is the data of the Hatchet events which are ingested for Gitea pull request events.
+type giteaPullRequestEventData struct {
+	PullRequest giteaPullRequest `json:"pullRequest"`
+}
+
+type giteaPullRequest struct {
+	Action     string `json:"action"`
+	Number     int64  `json:"number"`
+	Title      string `json:"title"`
+	State      string `json:"state"`
+	URL        string `json:"url"`
+	Author     string `json:"author"`
+	RepoOwner  string `json:"repoOwner"`
+	RepoName   string `json:"repoName"`
+	HeadBranch string `json:"headBranch"`
+	HeadSHA    string `json:"headSha"`
+	BaseBranch string `json:"baseBranch"`
+	BaseSHA    string `json:"baseSha"`
+	Merged     bool   `json:"merged"`
+}
+
+func (i *IngestorsService) GiteaUpdateWebhook(ctx echo.Context, req gen.GiteaUpdateWebhookRequestObject) (gen.GiteaUpdateWebhookResponseObject, error) {
+	webhook, err := i.config.Repository.Gitea().GetGiteaWebhookById(req.Webhook.String())
+
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, apierrors.NotFound("Gitea webhook not found").Wrap(err)
+		}
+
+		return nil, err
+	}
+
+	signingSecret, err := i.config.Encryption.Decrypt(webhook.SigningSecret, "gitea_signing_secret")
+
+	if err != nil {
+		return nil, apierrors.Internal(err)
+	}
+
+	payload, err := gitea.ValidatePayload(ctx.Request(), signingSecret)
+
+	if err != nil {
+		return nil, apierrors.NewError(http.StatusBadRequest, apierrors.CodeGiteaWebhookSignatureInvalid, "Gitea webhook signature is invalid").Wrap(err)
+	}
+
+	eventType := gitea.WebHookType(ctx.Request())
+
+	var event *gitea.PullRequestEvent
+
+	if eventType == gitea.EventTypePullRequest {
+		event, err = gitea.ParsePullRequestEvent(payload)
+
+		if err != nil {
+			return nil, apierrors.NewError(http.StatusBadRequest, apierrors.CodeGiteaWebhookEventInvalid, "Gitea webhook event could not be parsed").Wrap(err)
+		}
+	}
+
+	delivery, err := i.config.WebhookReceiver.Receive(ctx.Request().Context(), &webhooks.Delivery{
+		Source:     dbsqlc.WebhookDeliverySourceGITEA,
+		TenantId:   webhook.TenantID,
+		WebhookId:  webhook.ID,
+		DeliveryId: gitea.DeliveryID(ctx.Request()),
+		Event:      eventType,
+		Payload:    payload,
+		SentAt:     giteaEventSentAt(event),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.ingestGiteaPullRequestEvent(ctx.Request().Context(), webhook, event)
+
+	if finishErr := i.config.WebhookReceiver.Finish(ctx.Request().Context(), delivery, err); finishErr != nil {
+		i.config.Logger.Err(finishErr).Msg("could not record the result of a Gitea webhook delivery")
+	}
+
+	if errors.Is(err, ingestor.ErrIngestionPaused) {
+		return gen.GiteaUpdateWebhook400JSONResponse(
+			apierrors.NewAPIErrors(err.Error()),
+		), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return gen.GiteaUpdateWebhook200Response{}, nil
+}
+
+// ingestGiteaPullRequestEvent ingests a Hatchet event for a pull request event. Only pull request events are
+// ingested, other events are acknowledged so that Gitea doesn't retry them.
+func (i *IngestorsService) ingestGiteaPullRequestEvent(ctx context.Context, webhook *db.GiteaWebhookModel, event *gitea.PullRequestEvent) error {
+	if event == nil {
+		return nil
+	}
+
+	// events from repositories other than the one the webhook was created for are ignored
+	if event.Repository.Owner.Login != webhook.RepositoryOwner || event.Repository.Name != webhook.RepositoryName {
+		return nil
+	}
+
+	pr := gitea.ToVCSRepositoryPullRequest(event.Repository.Owner.Login, event.Repository.Name, &event.PullRequest)
+
+	data := giteaPullRequestEventData{
+		PullRequest: toGiteaPullRequest(event, pr),
+	}
+
+	_, err := i.config.Ingestor.IngestEvent(ctx, webhook.TenantID, fmt.Sprintf("gitea:pull_request:%s", event.Action), data)
+
+	return err
+}
+
+// giteaEventSentAt returns the time a delivery was sent at, which is the time the pull request of a pull request
+// event was last updated.
+func giteaEventSentAt(event *gitea.PullRequestEvent) *time.Time {
+	if event == nil {
+		return nil
+	}
+
+	return event.PullRequest.UpdatedAt
+}
+
+func toGiteaPullRequest(event *gitea.PullRequestEvent, pr vcs.VCSRepositoryPullRequest) giteaPullRequest {
+	return giteaPullRequest{
+		Action:     event.Action,
+		Number:     pr.GetPRNumber(),
+		Title:      pr.GetTitle(),
+		State:      pr.GetState(),
+		URL:        event.PullRequest.HTMLURL,
+		Author:     event.PullRequest.User.Login,
+		RepoOwner:  pr.GetRepoOwner(),
+		RepoName:   pr.GetRepoName(),
+		HeadBranch: pr.GetHeadBranch(),
+		HeadSHA:    pr.GetHeadSHA(),
+		BaseBranch: pr.GetBaseBranch(),
+		BaseSHA:    pr.GetBaseSHA(),
+		Merged:     event.PullRequest.Merged,
+	}
+}
@@ -0,0 +1,43 @@
+package ingestors
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (i *IngestorsService) GiteaWebhookCreate(ctx echo.Context, req gen.GiteaWebhookCreateRequestObject) (gen.GiteaWebhookCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// validate the request
+	if apiErrors, err := i.config.Validator.ValidateAPI(req.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.GiteaWebhookCreate400JSONResponse(*apiErrors), nil
+	}
+
+	opts, signingSecret, err := repository.NewGiteaWebhookCreateOpts(i.config.Encryption, req.Body.RepoOwner, req.Body.RepoName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := i.config.Repository.Gitea().CreateGiteaWebhook(tenant.ID, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := transformers.ToGiteaWebhook(webhook, i.config.Runtime.ServerURL)
+
+	// the signing secret is only returned once, when the webhook is created
+	resp.SigningSecret = &signingSecret
+
+	return gen.GiteaWebhookCreate201JSONResponse(
+		*resp,
+	), nil
+}
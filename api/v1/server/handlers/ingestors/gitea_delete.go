@@ -0,0 +1,22 @@
+package ingestors
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (i *IngestorsService) GiteaWebhookDelete(ctx echo.Context, req gen.GiteaWebhookDeleteRequestObject) (gen.GiteaWebhookDeleteResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	webhook := ctx.Get("gitea-webhook").(*db.GiteaWebhookModel)
+
+	err := i.config.Repository.Gitea().DeleteGiteaWebhook(tenant.ID, webhook.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.GiteaWebhookDelete204Response{}, nil
+}
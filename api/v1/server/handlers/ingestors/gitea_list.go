@@ -0,0 +1,34 @@
+package ingestors
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (i *IngestorsService) GiteaWebhookList(ctx echo.Context, req gen.GiteaWebhookListRequestObject) (gen.GiteaWebhookListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	webhooks, err := i.config.Repository.Gitea().ListGiteaWebhooks(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.GiteaWebhook, len(webhooks))
+
+	serverUrl := i.config.Runtime.ServerURL
+
+	for i := range webhooks {
+		rows[i] = *transformers.ToGiteaWebhook(&webhooks[i], serverUrl)
+	}
+
+	return gen.GiteaWebhookList200JSONResponse(
+		gen.ListGiteaWebhooks{
+			Rows: rows,
+		},
+	), nil
+}
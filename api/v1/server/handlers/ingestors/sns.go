@@ -2,14 +2,17 @@ package ingestors
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 
 	"github.com/hatchet-dev/hatchet/internal/integrations/ingestors/sns"
+	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
 )
 
 func (i *IngestorsService) SnsUpdate(ctx echo.Context, req gen.SnsUpdateRequestObject) (gen.SnsUpdateResponseObject, error) {
@@ -60,7 +63,11 @@ func (i *IngestorsService) SnsUpdate(ctx echo.Context, req gen.SnsUpdateRequestO
 	default:
 		_, err := i.config.Ingestor.IngestEvent(ctx.Request().Context(), req.Tenant.String(), req.Event, payload)
 
-		if err != nil {
+		if errors.Is(err, ingestor.ErrIngestionPaused) {
+			return gen.SnsUpdate400JSONResponse(
+				apierrors.NewAPIErrors(err.Error()),
+			), nil
+		} else if err != nil {
 			return nil, err
 		}
 	}
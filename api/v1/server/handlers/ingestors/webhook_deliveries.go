@@ -0,0 +1,53 @@
+package ingestors
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+const maxWebhookDeliveriesLimit = 1000
+
+func (i *IngestorsService) WebhookDeliveryList(ctx echo.Context, req gen.WebhookDeliveryListRequestObject) (gen.WebhookDeliveryListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	opts := &repository.ListWebhookDeliveriesOpts{}
+
+	if req.Params.Webhook != nil {
+		webhookId := req.Params.Webhook.String()
+		opts.WebhookId = &webhookId
+	}
+
+	if req.Params.Limit != nil {
+		if *req.Params.Limit < 1 || *req.Params.Limit > maxWebhookDeliveriesLimit {
+			return gen.WebhookDeliveryList400JSONResponse(
+				apierrors.NewAPIErrors("limit must be between 1 and 1000"),
+			), nil
+		}
+
+		limit := int(*req.Params.Limit)
+		opts.Limit = &limit
+	}
+
+	deliveries, err := i.config.Repository.WebhookDelivery().ListWebhookDeliveries(ctx.Request().Context(), tenant.ID, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.WebhookDelivery, len(deliveries))
+
+	for i := range deliveries {
+		rows[i] = *transformers.ToWebhookDelivery(deliveries[i])
+	}
+
+	return gen.WebhookDeliveryList200JSONResponse(
+		gen.ListWebhookDeliveries{
+			Rows: rows,
+		},
+	), nil
+}
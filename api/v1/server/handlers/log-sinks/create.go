@@ -0,0 +1,130 @@
+package logsinks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	sinks "github.com/hatchet-dev/hatchet/internal/integrations/logsinks"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (l *LogSinkService) LogSinkCreate(ctx echo.Context, request gen.LogSinkCreateRequestObject) (gen.LogSinkCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// validate the request
+	if apiErrors, err := l.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.LogSinkCreate400JSONResponse(*apiErrors), nil
+	}
+
+	kind := sinks.Kind(request.Body.Kind)
+	sinkConfig := toSinkConfig(request.Body)
+
+	if err := sinkConfig.Validate(kind); err != nil {
+		return gen.LogSinkCreate400JSONResponse(
+			apierrors.NewAPIErrors(err.Error()),
+		), nil
+	}
+
+	// determine if a log sink with the name already exists
+	existing, err := l.config.Repository.LogSink().ListLogSinks(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, logSink := range existing {
+		if logSink.Name == request.Body.Name {
+			return gen.LogSinkCreate400JSONResponse(
+				apierrors.NewAPIErrors("Log sink with the name already exists."),
+			), nil
+		}
+	}
+
+	configBytes, err := json.Marshal(sinkConfig)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal log sink config: %w", err)
+	}
+
+	// the config contains credentials, so it is only stored encrypted
+	encryptedConfig, err := l.config.Encryption.Encrypt(configBytes, sinks.ConfigDataId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not encrypt log sink config: %w", err)
+	}
+
+	logSink, err := l.config.Repository.LogSink().CreateLogSink(tenant.ID, &repository.CreateLogSinkOpts{
+		Name:        request.Body.Name,
+		Kind:        string(kind),
+		Destination: sinkConfig.Destination(kind),
+		Config:      encryptedConfig,
+		BatchSize:   request.Body.BatchSize,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.LogSinkCreate201JSONResponse(
+		*transformers.ToLogSink(logSink),
+	), nil
+}
+
+func toSinkConfig(body *gen.LogSinkCreateJSONRequestBody) *sinks.Config {
+	res := &sinks.Config{}
+
+	if body.Http != nil {
+		res.HTTP = &sinks.HTTPConfig{
+			URL: body.Http.Url,
+		}
+
+		if body.Http.Headers != nil {
+			res.HTTP.Headers = *body.Http.Headers
+		}
+
+		if body.Http.Secret != nil {
+			res.HTTP.Secret = *body.Http.Secret
+		}
+	}
+
+	if body.S3 != nil {
+		res.S3 = &sinks.S3Config{
+			Bucket:          body.S3.Bucket,
+			Region:          body.S3.Region,
+			AccessKeyId:     body.S3.AccessKeyId,
+			SecretAccessKey: body.S3.SecretAccessKey,
+		}
+
+		if body.S3.Prefix != nil {
+			res.S3.Prefix = *body.S3.Prefix
+		}
+
+		if body.S3.Endpoint != nil {
+			res.S3.Endpoint = *body.S3.Endpoint
+		}
+	}
+
+	if body.Datadog != nil {
+		res.Datadog = &sinks.DatadogConfig{
+			APIKey: body.Datadog.ApiKey,
+		}
+
+		if body.Datadog.Site != nil {
+			res.Datadog.Site = *body.Datadog.Site
+		}
+
+		if body.Datadog.Service != nil {
+			res.Datadog.Service = *body.Datadog.Service
+		}
+	}
+
+	return res
+}
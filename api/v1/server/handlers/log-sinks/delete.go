@@ -0,0 +1,21 @@
+package logsinks
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (l *LogSinkService) LogSinkDelete(ctx echo.Context, request gen.LogSinkDeleteRequestObject) (gen.LogSinkDeleteResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	logSink := ctx.Get("log-sink").(*db.LogSinkModel)
+
+	err := l.config.Repository.LogSink().DeleteLogSink(tenant.ID, logSink.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.LogSinkDelete204Response{}, nil
+}
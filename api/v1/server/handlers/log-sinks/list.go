@@ -0,0 +1,31 @@
+package logsinks
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (l *LogSinkService) LogSinkList(ctx echo.Context, request gen.LogSinkListRequestObject) (gen.LogSinkListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	logSinks, err := l.config.Repository.LogSink().ListLogSinks(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.LogSink, len(logSinks))
+
+	for i := range logSinks {
+		rows[i] = *transformers.ToLogSink(&logSinks[i])
+	}
+
+	return gen.LogSinkList200JSONResponse(
+		gen.ListLogSinks{
+			Rows: rows,
+		},
+	), nil
+}
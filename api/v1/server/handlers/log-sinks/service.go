@@ -0,0 +1,15 @@
+package logsinks
+
+import (
+	"github.com/hatchet-dev/hatchet/internal/config/server"
+)
+
+type LogSinkService struct {
+	config *server.ServerConfig
+}
+
+func NewLogSinkService(config *server.ServerConfig) *LogSinkService {
+	return &LogSinkService{
+		config: config,
+	}
+}
@@ -0,0 +1,27 @@
+package metadata
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/attestation"
+)
+
+func (u *MetadataService) MetadataGetAttestationKeys(ctx echo.Context, request gen.MetadataGetAttestationKeysRequestObject) (gen.MetadataGetAttestationKeysResponseObject, error) {
+	jwks, err := attestation.PublicKeys(u.config.Encryption.GetPublicJWTHandle())
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get attestation keys: %w", err)
+	}
+
+	res := gen.APIMetaAttestationKeys{}
+
+	if err := json.Unmarshal(jwks, &res); err != nil {
+		return nil, fmt.Errorf("could not unmarshal attestation keys: %w", err)
+	}
+
+	return gen.MetadataGetAttestationKeys200JSONResponse(res), nil
+}
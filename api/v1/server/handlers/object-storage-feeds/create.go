@@ -0,0 +1,113 @@
+package objectstoragefeeds
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/integrations/objectstorage"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (o *ObjectStorageFeedService) ObjectStorageFeedCreate(ctx echo.Context, request gen.ObjectStorageFeedCreateRequestObject) (gen.ObjectStorageFeedCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// validate the request
+	if apiErrors, err := o.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.ObjectStorageFeedCreate400JSONResponse(*apiErrors), nil
+	}
+
+	kind := objectstorage.Kind(request.Body.Kind)
+	feedConfig := toFeedConfig(request.Body)
+
+	if err := feedConfig.Validate(kind); err != nil {
+		return gen.ObjectStorageFeedCreate400JSONResponse(
+			apierrors.NewAPIErrors(err.Error()),
+		), nil
+	}
+
+	// determine if a feed with the name already exists
+	existing, err := o.config.Repository.ObjectStorageFeed().ListObjectStorageFeeds(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, feed := range existing {
+		if feed.Name == request.Body.Name {
+			return gen.ObjectStorageFeedCreate400JSONResponse(
+				apierrors.NewAPIErrors("Object storage feed with the name already exists."),
+			), nil
+		}
+	}
+
+	configBytes, err := json.Marshal(feedConfig)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal object storage feed config: %w", err)
+	}
+
+	// the config contains credentials, so it is only stored encrypted
+	encryptedConfig, err := o.config.Encryption.Encrypt(configBytes, objectstorage.ConfigDataId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not encrypt object storage feed config: %w", err)
+	}
+
+	feed, err := o.config.Repository.ObjectStorageFeed().CreateObjectStorageFeed(tenant.ID, &repository.CreateObjectStorageFeedOpts{
+		Name:      request.Body.Name,
+		Kind:      string(kind),
+		Source:    feedConfig.Source(kind),
+		Config:    encryptedConfig,
+		EventKey:  request.Body.EventKey,
+		KeyPrefix: request.Body.KeyPrefix,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.ObjectStorageFeedCreate201JSONResponse(
+		*transformers.ToObjectStorageFeed(feed),
+	), nil
+}
+
+func toFeedConfig(body *gen.ObjectStorageFeedCreateJSONRequestBody) *objectstorage.Config {
+	res := &objectstorage.Config{}
+
+	if body.Sqs != nil {
+		res.SQS = &objectstorage.SQSConfig{
+			QueueURL:        body.Sqs.QueueUrl,
+			Region:          body.Sqs.Region,
+			AccessKeyId:     body.Sqs.AccessKeyId,
+			SecretAccessKey: body.Sqs.SecretAccessKey,
+		}
+
+		if body.Sqs.Endpoint != nil {
+			res.SQS.Endpoint = *body.Sqs.Endpoint
+		}
+	}
+
+	if body.Pubsub != nil {
+		res.PubSub = &objectstorage.PubSubConfig{
+			Subscription: body.Pubsub.Subscription,
+		}
+
+		if body.Pubsub.CredentialsJson != nil {
+			res.PubSub.CredentialsJSON = *body.Pubsub.CredentialsJson
+		}
+
+		if body.Pubsub.Endpoint != nil {
+			res.PubSub.Endpoint = *body.Pubsub.Endpoint
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,21 @@
+package objectstoragefeeds
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (o *ObjectStorageFeedService) ObjectStorageFeedDelete(ctx echo.Context, request gen.ObjectStorageFeedDeleteRequestObject) (gen.ObjectStorageFeedDeleteResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	feed := ctx.Get("object-storage-feed").(*db.ObjectStorageFeedModel)
+
+	err := o.config.Repository.ObjectStorageFeed().DeleteObjectStorageFeed(tenant.ID, feed.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.ObjectStorageFeedDelete204Response{}, nil
+}
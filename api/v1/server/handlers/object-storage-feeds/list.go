@@ -0,0 +1,31 @@
+package objectstoragefeeds
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (o *ObjectStorageFeedService) ObjectStorageFeedList(ctx echo.Context, request gen.ObjectStorageFeedListRequestObject) (gen.ObjectStorageFeedListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	feeds, err := o.config.Repository.ObjectStorageFeed().ListObjectStorageFeeds(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.ObjectStorageFeed, len(feeds))
+
+	for i := range feeds {
+		rows[i] = *transformers.ToObjectStorageFeed(&feeds[i])
+	}
+
+	return gen.ObjectStorageFeedList200JSONResponse(
+		gen.ListObjectStorageFeeds{
+			Rows: rows,
+		},
+	), nil
+}
@@ -0,0 +1,15 @@
+package objectstoragefeeds
+
+import (
+	"github.com/hatchet-dev/hatchet/internal/config/server"
+)
+
+type ObjectStorageFeedService struct {
+	config *server.ServerConfig
+}
+
+func NewObjectStorageFeedService(config *server.ServerConfig) *ObjectStorageFeedService {
+	return &ObjectStorageFeedService{
+		config: config,
+	}
+}
@@ -0,0 +1,68 @@
+package piirules
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/datautils/redact"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (p *PIIRuleService) PiiRuleCreate(ctx echo.Context, request gen.PiiRuleCreateRequestObject) (gen.PiiRuleCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// validate the request
+	if apiErrors, err := p.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.PiiRuleCreate400JSONResponse(*apiErrors), nil
+	}
+
+	path := strings.TrimSpace(request.Body.Path)
+
+	if _, err := redact.Compile([]string{path}); err != nil {
+		return gen.PiiRuleCreate400JSONResponse(
+			apierrors.NewAPIErrors(err.Error()),
+		), nil
+	}
+
+	// a rule which matches the whole payload would purge the run skeleton as well
+	if path == "$" {
+		return gen.PiiRuleCreate400JSONResponse(
+			apierrors.NewAPIErrors("The path must match a field of the payload."),
+		), nil
+	}
+
+	// determine if a rule with the path already exists
+	existing, err := p.config.Repository.PIIRule().ListPIIRules(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range existing {
+		if rule.Path == path {
+			return gen.PiiRuleCreate400JSONResponse(
+				apierrors.NewAPIErrors("PII rule with the path already exists."),
+			), nil
+		}
+	}
+
+	rule, err := p.config.Repository.PIIRule().CreatePIIRule(tenant.ID, &repository.CreatePIIRuleOpts{
+		Path:             path,
+		RetentionSeconds: request.Body.RetentionSeconds,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.PiiRuleCreate201JSONResponse(
+		*transformers.ToPIIRule(rule),
+	), nil
+}
@@ -0,0 +1,21 @@
+package piirules
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (p *PIIRuleService) PiiRuleDelete(ctx echo.Context, request gen.PiiRuleDeleteRequestObject) (gen.PiiRuleDeleteResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	rule := ctx.Get("pii-rule").(*db.PIIRuleModel)
+
+	err := p.config.Repository.PIIRule().DeletePIIRule(tenant.ID, rule.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.PiiRuleDelete204Response{}, nil
+}
@@ -0,0 +1,31 @@
+package piirules
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (p *PIIRuleService) PiiRuleList(ctx echo.Context, request gen.PiiRuleListRequestObject) (gen.PiiRuleListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	rules, err := p.config.Repository.PIIRule().ListPIIRules(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.PIIRule, len(rules))
+
+	for i := range rules {
+		rows[i] = *transformers.ToPIIRule(&rules[i])
+	}
+
+	return gen.PiiRuleList200JSONResponse(
+		gen.ListPIIRules{
+			Rows: rows,
+		},
+	), nil
+}
@@ -0,0 +1,15 @@
+package piirules
+
+import (
+	"github.com/hatchet-dev/hatchet/internal/config/server"
+)
+
+type PIIRuleService struct {
+	config *server.ServerConfig
+}
+
+func NewPIIRuleService(config *server.ServerConfig) *PIIRuleService {
+	return &PIIRuleService{
+		config: config,
+	}
+}
@@ -0,0 +1,103 @@
+package querytriggers
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/integrations/dbquery"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (q *QueryTriggerService) QueryTriggerCreate(ctx echo.Context, request gen.QueryTriggerCreateRequestObject) (gen.QueryTriggerCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// validate the request
+	if apiErrors, err := q.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.QueryTriggerCreate400JSONResponse(*apiErrors), nil
+	}
+
+	triggerConfig := &dbquery.Config{
+		ConnectionString: request.Body.ConnectionString,
+	}
+
+	if err := triggerConfig.Validate(); err != nil {
+		return gen.QueryTriggerCreate400JSONResponse(
+			apierrors.NewAPIErrors(err.Error()),
+		), nil
+	}
+
+	if err := dbquery.ValidateQuery(request.Body.Query); err != nil {
+		return gen.QueryTriggerCreate400JSONResponse(
+			apierrors.NewAPIErrors(err.Error()),
+		), nil
+	}
+
+	schedule, err := dbquery.ParseSchedule(request.Body.Cron)
+
+	if err != nil {
+		return gen.QueryTriggerCreate400JSONResponse(
+			apierrors.NewAPIErrors(err.Error()),
+		), nil
+	}
+
+	mode := dbquery.ModePerRow
+
+	if request.Body.Mode != nil {
+		mode = dbquery.Mode(*request.Body.Mode)
+	}
+
+	// determine if a trigger with the name already exists
+	existing, err := q.config.Repository.QueryTrigger().ListQueryTriggers(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, trigger := range existing {
+		if trigger.Name == request.Body.Name {
+			return gen.QueryTriggerCreate400JSONResponse(
+				apierrors.NewAPIErrors("Query trigger with the name already exists."),
+			), nil
+		}
+	}
+
+	configBytes, err := json.Marshal(triggerConfig)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal query trigger config: %w", err)
+	}
+
+	// the connection string contains credentials, so it is only stored encrypted
+	encryptedConfig, err := q.config.Encryption.Encrypt(configBytes, dbquery.ConfigDataId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not encrypt query trigger config: %w", err)
+	}
+
+	trigger, err := q.config.Repository.QueryTrigger().CreateQueryTrigger(tenant.ID, &repository.CreateQueryTriggerOpts{
+		Name:      request.Body.Name,
+		Config:    encryptedConfig,
+		Query:     request.Body.Query,
+		Cron:      request.Body.Cron,
+		Mode:      string(mode),
+		EventKey:  request.Body.EventKey,
+		NextRunAt: schedule.Next(time.Now().UTC()),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.QueryTriggerCreate201JSONResponse(
+		*transformers.ToQueryTrigger(trigger),
+	), nil
+}
@@ -0,0 +1,21 @@
+package querytriggers
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (q *QueryTriggerService) QueryTriggerDelete(ctx echo.Context, request gen.QueryTriggerDeleteRequestObject) (gen.QueryTriggerDeleteResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	trigger := ctx.Get("query-trigger").(*db.QueryTriggerModel)
+
+	err := q.config.Repository.QueryTrigger().DeleteQueryTrigger(tenant.ID, trigger.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.QueryTriggerDelete204Response{}, nil
+}
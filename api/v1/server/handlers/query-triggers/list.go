@@ -0,0 +1,31 @@
+package querytriggers
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (q *QueryTriggerService) QueryTriggerList(ctx echo.Context, request gen.QueryTriggerListRequestObject) (gen.QueryTriggerListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	triggers, err := q.config.Repository.QueryTrigger().ListQueryTriggers(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.QueryTrigger, len(triggers))
+
+	for i := range triggers {
+		rows[i] = *transformers.ToQueryTrigger(&triggers[i])
+	}
+
+	return gen.QueryTriggerList200JSONResponse(
+		gen.ListQueryTriggers{
+			Rows: rows,
+		},
+	), nil
+}
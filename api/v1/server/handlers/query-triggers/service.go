@@ -0,0 +1,15 @@
+package querytriggers
+
+import (
+	"github.com/hatchet-dev/hatchet/internal/config/server"
+)
+
+type QueryTriggerService struct {
+	config *server.ServerConfig
+}
+
+func NewQueryTriggerService(config *server.ServerConfig) *QueryTriggerService {
+	return &QueryTriggerService{
+		config: config,
+	}
+}
@@ -0,0 +1,126 @@
+package recurringtasks
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/recurring"
+)
+
+func (r *RecurringTaskService) RecurringTaskCreate(ctx echo.Context, request gen.RecurringTaskCreateRequestObject) (gen.RecurringTaskCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// validate the request
+	if apiErrors, err := r.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.RecurringTaskCreate400JSONResponse(*apiErrors), nil
+	}
+
+	schedule, err := recurring.ParseCron(request.Body.Cron)
+
+	if err != nil {
+		return gen.RecurringTaskCreate400JSONResponse(
+			apierrors.NewAPIErrors(err.Error()),
+		), nil
+	}
+
+	opts := &repository.CreateRecurringTaskOpts{
+		Name:      request.Body.Name,
+		Kind:      string(request.Body.Kind),
+		Cron:      request.Body.Cron,
+		NextRunAt: schedule.Next(time.Now().UTC()),
+	}
+
+	switch recurring.Kind(request.Body.Kind) {
+	case recurring.KindPurgeWorkflowRuns:
+		if request.Body.WorkflowId == nil || request.Body.Retention == nil {
+			return gen.RecurringTaskCreate400JSONResponse(
+				apierrors.NewAPIErrors("workflowId and retention are required for PURGE_WORKFLOW_RUNS tasks"),
+			), nil
+		}
+
+		retention, err := recurring.ParseRetention(*request.Body.Retention)
+
+		if err != nil {
+			return gen.RecurringTaskCreate400JSONResponse(
+				apierrors.NewAPIErrors(err.Error()),
+			), nil
+		}
+
+		workflowId := request.Body.WorkflowId.String()
+
+		workflow, err := r.config.Repository.Workflow().GetWorkflowById(workflowId)
+
+		if err != nil && !errors.Is(err, db.ErrNotFound) {
+			return nil, err
+		}
+
+		if workflow == nil || workflow.TenantID != tenant.ID {
+			return gen.RecurringTaskCreate400JSONResponse(
+				apierrors.NewAPIErrors("workflow not found"),
+			), nil
+		}
+
+		retentionSeconds := int(retention.Seconds())
+
+		opts.WorkflowId = &workflowId
+		opts.RetentionSeconds = &retentionSeconds
+	case recurring.KindEmitEvent:
+		if request.Body.EventKey == nil || *request.Body.EventKey == "" {
+			return gen.RecurringTaskCreate400JSONResponse(
+				apierrors.NewAPIErrors("eventKey is required for EMIT_EVENT tasks"),
+			), nil
+		}
+
+		opts.EventKey = request.Body.EventKey
+
+		if request.Body.EventData != nil {
+			eventData, err := json.Marshal(request.Body.EventData)
+
+			if err != nil {
+				return nil, fmt.Errorf("could not marshal event data: %w", err)
+			}
+
+			opts.EventData = eventData
+		}
+	default:
+		return gen.RecurringTaskCreate400JSONResponse(
+			apierrors.NewAPIErrors(fmt.Sprintf("unknown recurring task kind %s", request.Body.Kind)),
+		), nil
+	}
+
+	// determine if a task with the name already exists
+	existing, err := r.config.Repository.RecurringTask().ListRecurringTasks(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, task := range existing {
+		if task.Name == request.Body.Name {
+			return gen.RecurringTaskCreate400JSONResponse(
+				apierrors.NewAPIErrors("Recurring task with the name already exists."),
+			), nil
+		}
+	}
+
+	task, err := r.config.Repository.RecurringTask().CreateRecurringTask(tenant.ID, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.RecurringTaskCreate201JSONResponse(
+		*transformers.ToRecurringTask(task),
+	), nil
+}
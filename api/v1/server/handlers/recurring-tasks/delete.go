@@ -0,0 +1,21 @@
+package recurringtasks
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (r *RecurringTaskService) RecurringTaskDelete(ctx echo.Context, request gen.RecurringTaskDeleteRequestObject) (gen.RecurringTaskDeleteResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	task := ctx.Get("recurring-task").(*db.RecurringTaskModel)
+
+	err := r.config.Repository.RecurringTask().DeleteRecurringTask(tenant.ID, task.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.RecurringTaskDelete204Response{}, nil
+}
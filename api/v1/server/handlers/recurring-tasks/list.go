@@ -0,0 +1,31 @@
+package recurringtasks
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (r *RecurringTaskService) RecurringTaskList(ctx echo.Context, request gen.RecurringTaskListRequestObject) (gen.RecurringTaskListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	tasks, err := r.config.Repository.RecurringTask().ListRecurringTasks(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.RecurringTask, len(tasks))
+
+	for i := range tasks {
+		rows[i] = *transformers.ToRecurringTask(&tasks[i])
+	}
+
+	return gen.RecurringTaskList200JSONResponse(
+		gen.ListRecurringTasks{
+			Rows: rows,
+		},
+	), nil
+}
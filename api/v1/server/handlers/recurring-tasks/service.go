@@ -0,0 +1,15 @@
+package recurringtasks
+
+import (
+	"github.com/hatchet-dev/hatchet/internal/config/server"
+)
+
+type RecurringTaskService struct {
+	config *server.ServerConfig
+}
+
+func NewRecurringTaskService(config *server.ServerConfig) *RecurringTaskService {
+	return &RecurringTaskService{
+		config: config,
+	}
+}
@@ -11,6 +11,7 @@ import (
 )
 
 func (t *StepRunService) StepRunGet(ctx echo.Context, request gen.StepRunGetRequestObject) (gen.StepRunGetResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
 	stepRun := ctx.Get("step-run").(*db.StepRunModel)
 
 	res, err := transformers.ToStepRun(stepRun)
@@ -19,6 +20,8 @@ func (t *StepRunService) StepRunGet(ctx echo.Context, request gen.StepRunGetRequ
 		return nil, fmt.Errorf("could not transform step run: %w", err)
 	}
 
+	transformers.RedactStepRun(res, transformers.RedactionRules(tenant))
+
 	return gen.StepRunGet200JSONResponse(
 		*res,
 	), nil
@@ -0,0 +1,61 @@
+package stepruns
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *StepRunService) StepRunList(ctx echo.Context, request gen.StepRunListRequestObject) (gen.StepRunListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	listOpts := &repository.ListStepRunsOpts{}
+
+	if request.Params.WorkflowRunId != nil {
+		workflowRunIdStr := request.Params.WorkflowRunId.String()
+		listOpts.WorkflowRunId = &workflowRunIdStr
+	}
+
+	if request.Params.JobRunId != nil {
+		jobRunIdStr := request.Params.JobRunId.String()
+		listOpts.JobRunId = &jobRunIdStr
+	}
+
+	if request.Params.Status != nil {
+		status := db.StepRunStatus(*request.Params.Status)
+		listOpts.Status = &status
+	}
+
+	stepRuns, err := t.config.Repository.StepRun().ListStepRuns(tenant.ID, listOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.StepRun, len(stepRuns))
+	rules := transformers.RedactionRules(tenant)
+
+	for i, stepRun := range stepRuns {
+		stepRunCp := stepRun
+		res, err := transformers.ToStepRun(&stepRunCp)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not transform step run: %w", err)
+		}
+
+		transformers.RedactStepRun(res, rules)
+
+		rows[i] = *res
+	}
+
+	return gen.StepRunList200JSONResponse(
+		gen.StepRunList{
+			Rows: &rows,
+		},
+	), nil
+}
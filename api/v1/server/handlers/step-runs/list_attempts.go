@@ -0,0 +1,34 @@
+package stepruns
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *StepRunService) StepRunListAttempts(ctx echo.Context, request gen.StepRunListAttemptsRequestObject) (gen.StepRunListAttemptsResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	stepRun := ctx.Get("step-run").(*db.StepRunModel)
+
+	archived, err := t.config.Repository.StepRun().ListArchivedStepRunResults(tenant.ID, stepRun.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rules := transformers.RedactionRules(tenant)
+	rows := make([]gen.StepRunAttempt, len(archived))
+
+	for i := range archived {
+		rows[i] = *transformers.ToStepRunAttempt(&archived[i])
+		transformers.RedactStepRunAttempt(&rows[i], rules)
+	}
+
+	return gen.StepRunListAttempts200JSONResponse(
+		gen.StepRunAttemptList{
+			Rows: &rows,
+		},
+	), nil
+}
@@ -0,0 +1,75 @@
+package stepruns
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *StepRunService) StepRunListCostCenterUsage(ctx echo.Context, request gen.StepRunListCostCenterUsageRequestObject) (gen.StepRunListCostCenterUsageResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	until := time.Now().UTC()
+
+	if request.Params.Until != nil {
+		until = *request.Params.Until
+	}
+
+	since := until.Add(-7 * 24 * time.Hour)
+
+	if request.Params.Since != nil {
+		since = *request.Params.Since
+	}
+
+	if !since.Before(until) {
+		return gen.StepRunListCostCenterUsage400JSONResponse(
+			apierrors.NewAPIErrors("since must be before until"),
+		), nil
+	}
+
+	bucket := gen.Day
+
+	if request.Params.Bucket != nil {
+		bucket = *request.Params.Bucket
+	}
+
+	switch bucket {
+	case gen.Hour, gen.Day, gen.Week:
+	default:
+		return gen.StepRunListCostCenterUsage400JSONResponse(
+			apierrors.NewAPIErrors("bucket must be one of hour, day or week"),
+		), nil
+	}
+
+	usage, err := t.config.Repository.StepRun().ListCostCenterUsage(tenant.ID, &repository.ListCostCenterUsageOpts{
+		Bucket:     string(bucket),
+		Since:      since,
+		Until:      until,
+		CostCenter: request.Params.CostCenter,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.CostCenterUsageRow, len(usage))
+
+	for i := range usage {
+		rows[i] = *transformers.ToCostCenterUsageRow(usage[i])
+	}
+
+	return gen.StepRunListCostCenterUsage200JSONResponse(
+		gen.CostCenterUsage{
+			Since:  since,
+			Until:  until,
+			Bucket: bucket,
+			Rows:   rows,
+		},
+	), nil
+}
@@ -0,0 +1,34 @@
+package stepruns
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *StepRunService) StepRunListDiagnostics(ctx echo.Context, request gen.StepRunListDiagnosticsRequestObject) (gen.StepRunListDiagnosticsResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	stepRun := ctx.Get("step-run").(*db.StepRunModel)
+
+	diagnostics, err := t.config.Repository.StepRun().ListStepRunDiagnostics(tenant.ID, stepRun.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rules := transformers.RedactionRules(tenant)
+	rows := make([]gen.StepRunDiagnostic, len(diagnostics))
+
+	for i := range diagnostics {
+		rows[i] = *transformers.ToStepRunDiagnostic(diagnostics[i])
+		transformers.RedactStepRunDiagnostic(&rows[i], rules)
+	}
+
+	return gen.StepRunListDiagnostics200JSONResponse(
+		gen.StepRunDiagnosticList{
+			Rows: &rows,
+		},
+	), nil
+}
@@ -0,0 +1,53 @@
+package stepruns
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *StepRunService) StepRunListMetrics(ctx echo.Context, request gen.StepRunListMetricsRequestObject) (gen.StepRunListMetricsResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	since := time.Now().UTC().Add(-1 * time.Hour)
+
+	if request.Params.Since != nil {
+		since = *request.Params.Since
+	}
+
+	metrics, err := t.config.Repository.StepRun().ListStepRunPhaseMetrics(tenant.ID, since)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.StepRunActionMetrics, len(metrics))
+
+	for i := range metrics {
+		rows[i] = *transformers.ToStepRunActionMetrics(metrics[i])
+	}
+
+	waiting, err := t.config.Repository.StepRun().ListStepRunsWaitingForWorker(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	noCapacity := make([]gen.StepRunNoCapacityMetrics, len(waiting))
+
+	for i := range waiting {
+		noCapacity[i] = *transformers.ToStepRunNoCapacityMetrics(waiting[i])
+	}
+
+	return gen.StepRunListMetrics200JSONResponse(
+		gen.StepRunMetrics{
+			Since:      since,
+			Rows:       rows,
+			NoCapacity: noCapacity,
+		},
+	), nil
+}
@@ -0,0 +1,45 @@
+package stepruns
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *StepRunService) StepRunListStreamEvents(ctx echo.Context, request gen.StepRunListStreamEventsRequestObject) (gen.StepRunListStreamEventsResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	stepRun := ctx.Get("step-run").(*db.StepRunModel)
+
+	listOpts := &repository.ListStreamEventsOpts{}
+
+	if request.Params.After != nil {
+		after := int(*request.Params.After)
+		listOpts.After = &after
+	}
+
+	if request.Params.Limit != nil {
+		limit := int(*request.Params.Limit)
+		listOpts.Limit = &limit
+	}
+
+	streamEvents, err := t.config.Repository.StreamEvent().ListStreamEvents(ctx.Request().Context(), tenant.ID, stepRun.ID, listOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.StepRunStreamEvent, len(streamEvents))
+
+	for i := range streamEvents {
+		rows[i] = *transformers.ToStepRunStreamEvent(streamEvents[i])
+	}
+
+	return gen.StepRunListStreamEvents200JSONResponse(
+		gen.StepRunStreamEventList{
+			Rows: &rows,
+		},
+	), nil
+}
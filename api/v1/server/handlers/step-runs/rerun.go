@@ -109,6 +109,8 @@ func (t *StepRunService) StepRunUpdateRerun(ctx echo.Context, request gen.StepRu
 		return nil, fmt.Errorf("could not transform step run: %w", err)
 	}
 
+	transformers.RedactStepRun(res, transformers.RedactionRules(tenant))
+
 	return gen.StepRunUpdateRerun200JSONResponse(
 		*res,
 	), nil
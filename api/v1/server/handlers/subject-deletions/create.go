@@ -0,0 +1,51 @@
+package subjectdeletions
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+// maxSubjectWorkflowRuns is the maximum number of workflow runs which are deleted by a single request, so that a
+// deletion doesn't hold a transaction for too long. The report says if there are more.
+const maxSubjectWorkflowRuns = 1000
+
+func (s *SubjectDeletionService) SubjectDeletionCreate(ctx echo.Context, request gen.SubjectDeletionCreateRequestObject) (gen.SubjectDeletionCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// validate the request
+	if apiErrors, err := s.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.SubjectDeletionCreate400JSONResponse(*apiErrors), nil
+	}
+
+	dryRun := request.Body.DryRun != nil && *request.Body.DryRun
+
+	report, err := s.config.Repository.SubjectDeletion().DeleteSubject(ctx.Request().Context(), tenant.ID, &repository.DeleteSubjectOpts{
+		Key:    request.Body.Key,
+		Value:  request.Body.Value,
+		Limit:  maxSubjectWorkflowRuns,
+		DryRun: dryRun,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !dryRun {
+		s.config.Logger.Info().Msgf(
+			"deleted data of subject with metadata key %s from %d workflow runs of tenant %s",
+			request.Body.Key,
+			len(report.WorkflowRunIds),
+			tenant.ID,
+		)
+	}
+
+	return gen.SubjectDeletionCreate200JSONResponse(
+		*transformers.ToSubjectDeletionReport(request.Body.Key, dryRun, report),
+	), nil
+}
@@ -0,0 +1,15 @@
+package subjectdeletions
+
+import (
+	"github.com/hatchet-dev/hatchet/internal/config/server"
+)
+
+type SubjectDeletionService struct {
+	config *server.ServerConfig
+}
+
+func NewSubjectDeletionService(config *server.ServerConfig) *SubjectDeletionService {
+	return &SubjectDeletionService{
+		config: config,
+	}
+}
@@ -0,0 +1,54 @@
+package tenantexports
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+)
+
+func (t *TenantExportService) TenantExportCreate(ctx echo.Context, request gen.TenantExportCreateRequestObject) (gen.TenantExportCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	exports, err := t.config.Repository.TenantExport().ListTenantExports(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// exports read every run of the tenant, so only one runs at a time
+	for _, export := range exports {
+		if export.Status == db.TenantExportStatusPending || export.Status == db.TenantExportStatusRunning {
+			return gen.TenantExportCreate400JSONResponse(
+				apierrors.NewAPIErrors(fmt.Sprintf("export %s is still in progress", export.ID)),
+			), nil
+		}
+	}
+
+	export, err := t.config.Repository.TenantExport().CreateTenantExport(tenant.ID)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create tenant export: %w", err)
+	}
+
+	// the archive is written by the jobs controller
+	err = t.config.MessageQueue.AddMessage(
+		ctx.Request().Context(),
+		msgqueue.JOB_PROCESSING_QUEUE,
+		tasktypes.TenantExportToTask(tenant.ID, export.ID),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not add tenant export task to task queue: %w", err)
+	}
+
+	return gen.TenantExportCreate200JSONResponse(
+		*transformers.ToTenantExport(export),
+	), nil
+}
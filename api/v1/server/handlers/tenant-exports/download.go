@@ -0,0 +1,145 @@
+package tenantexports
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/blobstore"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/tenantexport"
+)
+
+// downloadURLExpiry is how long a signed download URL can be used. URLs are meant to be handed to a tool
+// which downloads the archive right away.
+const downloadURLExpiry = 15 * time.Minute
+
+func (t *TenantExportService) TenantExportCreateDownloadUrl(ctx echo.Context, request gen.TenantExportCreateDownloadUrlRequestObject) (gen.TenantExportCreateDownloadUrlResponseObject, error) {
+	export := ctx.Get("tenant-export").(*db.TenantExportModel)
+
+	if export.Status != db.TenantExportStatusSucceeded {
+		return gen.TenantExportCreateDownloadUrl400JSONResponse(
+			apierrors.NewAPIErrors("only exports which succeeded can be downloaded"),
+		), nil
+	}
+
+	expiresAt := time.Now().UTC().Add(downloadURLExpiry)
+
+	// the URL can't outlive the archive
+	if exportExpiresAt, ok := export.ExpiresAt(); ok {
+		if !exportExpiresAt.After(time.Now()) {
+			return gen.TenantExportCreateDownloadUrl400JSONResponse(
+				apierrors.NewAPIErrors("the export has expired"),
+			), nil
+		}
+
+		if exportExpiresAt.Before(expiresAt) {
+			expiresAt = exportExpiresAt
+		}
+	}
+
+	token, err := tenantexport.SignDownloadToken(
+		t.config.Encryption.GetPrivateJWTHandle(),
+		t.config.Runtime.ServerURL,
+		export.TenantID,
+		export.ID,
+		expiresAt,
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not sign download token: %w", err)
+	}
+
+	return gen.TenantExportCreateDownloadUrl200JSONResponse(
+		gen.TenantExportDownloadURL{
+			Url:       fmt.Sprintf("%s/api/v1/tenant-exports/%s/download?token=%s", t.config.Runtime.ServerURL, export.ID, url.QueryEscape(token)),
+			ExpiresAt: expiresAt,
+		},
+	), nil
+}
+
+func (t *TenantExportService) TenantExportDownload(ctx echo.Context, request gen.TenantExportDownloadRequestObject) (gen.TenantExportDownloadResponseObject, error) {
+	exportId, err := tenantexport.VerifyDownloadToken(
+		t.config.Encryption.GetPublicJWTHandle(),
+		t.config.Runtime.ServerURL,
+		request.Params.Token,
+	)
+
+	if err != nil || exportId != request.TenantExport.String() {
+		return gen.TenantExportDownload403JSONResponse(
+			apierrors.NewAPIErrors("invalid or expired download URL"),
+		), nil
+	}
+
+	export, err := t.config.Repository.TenantExport().GetTenantExportById(exportId)
+
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return gen.TenantExportDownload404JSONResponse(
+				apierrors.NewAPIErrors("the export was not found"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	if export.Status != db.TenantExportStatusSucceeded {
+		return gen.TenantExportDownload400JSONResponse(
+			apierrors.NewAPIErrors("only exports which succeeded can be downloaded"),
+		), nil
+	}
+
+	ctx.Response().Header().Set(
+		echo.HeaderContentDisposition,
+		fmt.Sprintf("attachment; filename=\"hatchet-export-%s.tar.gz\"", export.ID),
+	)
+
+	if archiveKey, ok := export.ArchiveKey(); ok {
+		if t.config.ObjectStore == nil {
+			return nil, fmt.Errorf("the archive of export %s is in the object store, but no object store is configured", export.ID)
+		}
+
+		body, err := t.config.ObjectStore.Get(ctx.Request().Context(), archiveKey)
+
+		if err != nil {
+			if errors.Is(err, blobstore.ErrNotFound) {
+				return gen.TenantExportDownload404JSONResponse(
+					apierrors.NewAPIErrors("the archive of the export was not found"),
+				), nil
+			}
+
+			return nil, err
+		}
+
+		archiveSize, _ := export.ArchiveSize()
+
+		return gen.TenantExportDownload200ApplicationgzipResponse{
+			Body:          body,
+			ContentLength: int64(archiveSize),
+		}, nil
+	}
+
+	archive, err := t.config.Repository.TenantExport().GetTenantExportArchive(export.ID)
+
+	if err != nil {
+		// the archive is deleted together with the export once it expires
+		if errors.Is(err, db.ErrNotFound) {
+			return gen.TenantExportDownload404JSONResponse(
+				apierrors.NewAPIErrors("the archive of the export was not found"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	return gen.TenantExportDownload200ApplicationgzipResponse{
+		Body:          bytes.NewReader(archive),
+		ContentLength: int64(len(archive)),
+	}, nil
+}
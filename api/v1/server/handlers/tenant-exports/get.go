@@ -0,0 +1,17 @@
+package tenantexports
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *TenantExportService) TenantExportGet(ctx echo.Context, request gen.TenantExportGetRequestObject) (gen.TenantExportGetResponseObject, error) {
+	export := ctx.Get("tenant-export").(*db.TenantExportModel)
+
+	return gen.TenantExportGet200JSONResponse(
+		*transformers.ToTenantExport(export),
+	), nil
+}
@@ -0,0 +1,31 @@
+package tenantexports
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *TenantExportService) TenantExportList(ctx echo.Context, request gen.TenantExportListRequestObject) (gen.TenantExportListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	exports, err := t.config.Repository.TenantExport().ListTenantExports(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.TenantExport, len(exports))
+
+	for i := range exports {
+		rows[i] = *transformers.ToTenantExport(&exports[i])
+	}
+
+	return gen.TenantExportList200JSONResponse(
+		gen.ListTenantExports{
+			Rows: rows,
+		},
+	), nil
+}
@@ -0,0 +1,15 @@
+package tenantexports
+
+import (
+	"github.com/hatchet-dev/hatchet/internal/config/server"
+)
+
+type TenantExportService struct {
+	config *server.ServerConfig
+}
+
+func NewTenantExportService(config *server.ServerConfig) *TenantExportService {
+	return &TenantExportService{
+		config: config,
+	}
+}
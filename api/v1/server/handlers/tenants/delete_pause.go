@@ -0,0 +1,46 @@
+package tenants
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+)
+
+func (t *TenantService) TenantDeletePause(ctx echo.Context, request gen.TenantDeletePauseRequestObject) (gen.TenantDeletePauseResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	released, err := t.config.Repository.Tenant().ResumeTenant(ctx.Request().Context(), tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// queue the runs which were buffered while the tenant was paused
+	for _, workflowRun := range released {
+		err = t.config.MessageQueue.AddMessage(
+			ctx.Request().Context(),
+			msgqueue.WORKFLOW_PROCESSING_QUEUE,
+			tasktypes.ReleasedWorkflowRunToTask(workflowRun),
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not add workflow run to queue: %w", err)
+		}
+	}
+
+	tenant, err = t.config.Repository.Tenant().GetTenantByID(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.TenantDeletePause200JSONResponse(
+		*transformers.ToTenant(tenant),
+	), nil
+}
@@ -0,0 +1,17 @@
+package tenants
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *TenantService) TenantGet(ctx echo.Context, request gen.TenantGetRequestObject) (gen.TenantGetResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	return gen.TenantGet200JSONResponse(
+		*transformers.ToTenant(tenant),
+	), nil
+}
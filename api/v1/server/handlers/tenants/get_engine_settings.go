@@ -0,0 +1,26 @@
+package tenants
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *TenantService) TenantGetEngineSettings(ctx echo.Context, request gen.TenantGetEngineSettingsRequestObject) (gen.TenantGetEngineSettingsResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	engineSettings, err := t.config.Repository.EngineSettings().GetTenantEngineSettings(tenant.ID)
+
+	// tenants which never overrode a setting use the defaults
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		return nil, err
+	}
+
+	return gen.TenantGetEngineSettings200JSONResponse(
+		*transformers.ToTenantEngineSettings(engineSettings),
+	), nil
+}
@@ -0,0 +1,66 @@
+package tenants
+
+import (
+	"encoding/json"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/datautils/redact"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *TenantService) TenantUpdate(ctx echo.Context, request gen.TenantUpdateRequestObject) (gen.TenantUpdateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.TenantUpdate400JSONResponse(*apiErrors), nil
+	}
+
+	opts := &repository.UpdateTenantOpts{
+		Name: request.Body.Name,
+	}
+
+	if request.Body.RedactionRules != nil {
+		if _, err := redact.Compile(*request.Body.RedactionRules); err != nil {
+			return gen.TenantUpdate400JSONResponse(
+				apierrors.NewAPIErrors(err.Error()),
+			), nil
+		}
+
+		opts.RedactionRules = *request.Body.RedactionRules
+	}
+
+	if request.Body.AssignmentStrategy != nil {
+		assignmentStrategy := string(*request.Body.AssignmentStrategy)
+		opts.AssignmentStrategy = &assignmentStrategy
+	}
+
+	if request.Body.DependencyHealth != nil {
+		dependencyHealth, err := json.Marshal(request.Body.DependencyHealth)
+
+		if err != nil {
+			return gen.TenantUpdate400JSONResponse(
+				apierrors.NewAPIErrors("Invalid dependency health"),
+			), nil
+		}
+
+		opts.DependencyHealth = dependencyHealth
+	}
+
+	tenant, err := t.config.Repository.Tenant().UpdateTenant(tenant.ID, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.TenantUpdate200JSONResponse(
+		*transformers.ToTenant(tenant),
+	), nil
+}
@@ -0,0 +1,41 @@
+package tenants
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *TenantService) TenantUpdateEngineSettings(ctx echo.Context, request gen.TenantUpdateEngineSettingsRequestObject) (gen.TenantUpdateEngineSettingsResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// settings which are left out of the request use the defaults again
+	opts := &repository.UpdateTenantEngineSettingsOpts{
+		DefaultStepTimeout: request.Body.DefaultStepTimeout,
+		ScheduleTimeout:    request.Body.ScheduleTimeout,
+		DefaultStepRetries: request.Body.DefaultStepRetries,
+		MaxFanOut:          request.Body.MaxFanOut,
+	}
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(opts); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.TenantUpdateEngineSettings400JSONResponse(*apiErrors), nil
+	}
+
+	engineSettings, err := t.config.Repository.EngineSettings().UpdateTenantEngineSettings(tenant.ID, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	t.config.EngineSettings.Invalidate(tenant.ID)
+
+	return gen.TenantUpdateEngineSettings200JSONResponse(
+		*transformers.ToTenantEngineSettings(engineSettings),
+	), nil
+}
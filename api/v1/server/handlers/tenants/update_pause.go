@@ -0,0 +1,68 @@
+package tenants
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+)
+
+func (t *TenantService) TenantUpdatePause(ctx echo.Context, request gen.TenantUpdatePauseRequestObject) (gen.TenantUpdatePauseResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	opts := &repository.PauseOpts{}
+
+	if request.Body.TriggerBehavior != nil {
+		triggerBehavior := string(*request.Body.TriggerBehavior)
+		opts.TriggerBehavior = &triggerBehavior
+	}
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(opts); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.TenantUpdatePause400JSONResponse(*apiErrors), nil
+	}
+
+	err := t.config.Repository.Tenant().PauseTenant(ctx.Request().Context(), tenant.ID, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Body.CancelRuns != nil && *request.Body.CancelRuns {
+		stepRunIds, err := t.config.Repository.StepRun().ListCancellableStepRuns(ctx.Request().Context(), tenant.ID, nil)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not list step runs to cancel: %w", err)
+		}
+
+		for _, stepRunId := range stepRunIds {
+			err = t.config.MessageQueue.AddMessage(
+				ctx.Request().Context(),
+				msgqueue.JOB_PROCESSING_QUEUE,
+				tasktypes.StepRunNotifyCancelToTask(tenant.ID, stepRunId, "CANCELLED_BY_PAUSE"),
+			)
+
+			if err != nil {
+				return nil, fmt.Errorf("could not add step run cancellation to queue: %w", err)
+			}
+		}
+	}
+
+	tenant, err = t.config.Repository.Tenant().GetTenantByID(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.TenantUpdatePause200JSONResponse(
+		*transformers.ToTenant(tenant),
+	), nil
+}
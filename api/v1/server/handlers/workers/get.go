@@ -9,6 +9,7 @@ import (
 )
 
 func (t *WorkerService) WorkerGet(ctx echo.Context, request gen.WorkerGetRequestObject) (gen.WorkerGetResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
 	worker := ctx.Get("worker").(*db.WorkerModel)
 
 	stepRuns, err := t.config.Repository.Worker().ListRecentWorkerStepRuns(worker.TenantID, worker.ID)
@@ -18,6 +19,7 @@ func (t *WorkerService) WorkerGet(ctx echo.Context, request gen.WorkerGetRequest
 	}
 
 	respStepRuns := make([]gen.StepRun, len(stepRuns))
+	rules := transformers.RedactionRules(tenant)
 
 	for i := range stepRuns {
 		genStepRun, err := transformers.ToStepRun(&stepRuns[i])
@@ -26,6 +28,8 @@ func (t *WorkerService) WorkerGet(ctx echo.Context, request gen.WorkerGetRequest
 			return nil, err
 		}
 
+		transformers.RedactStepRun(genStepRun, rules)
+
 		respStepRuns[i] = *genStepRun
 	}
 
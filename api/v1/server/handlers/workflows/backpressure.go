@@ -0,0 +1,18 @@
+package workflows
+
+import (
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/internal/services/shared/backpressure"
+)
+
+const headerQueueDepth = "X-Hatchet-Queue-Depth"
+
+// setBackpressureHeaders sets the backpressure hint as response headers, so that clients can slow down
+// triggers even when the trigger is accepted.
+func setBackpressureHeaders(ctx echo.Context, hint *backpressure.Hint) {
+	ctx.Response().Header().Set(echo.HeaderRetryAfter, strconv.FormatInt(hint.RetryAfterSeconds(), 10))
+	ctx.Response().Header().Set(headerQueueDepth, strconv.FormatInt(hint.QueueDepth, 10))
+}
@@ -0,0 +1,79 @@
+package workflows
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+)
+
+func (t *WorkflowService) WorkflowRunBulkRetry(ctx echo.Context, request gen.WorkflowRunBulkRetryRequestObject) (gen.WorkflowRunBulkRetryResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	if request.Body.CreatedAfter != nil && request.Body.CreatedBefore != nil && !request.Body.CreatedAfter.Before(*request.Body.CreatedBefore) {
+		return gen.WorkflowRunBulkRetry400JSONResponse(
+			apierrors.NewAPIErrors("createdAfter must be before createdBefore"),
+		), nil
+	}
+
+	filter := repository.WorkflowRunBulkRetryFilter{
+		CreatedAfter:  request.Body.CreatedAfter,
+		CreatedBefore: request.Body.CreatedBefore,
+		ErrorContains: request.Body.ErrorContains,
+	}
+
+	if request.Body.WorkflowId != nil {
+		workflowIdStr := request.Body.WorkflowId.String()
+		filter.WorkflowId = &workflowIdStr
+	}
+
+	bulkRetry, err := t.config.Repository.WorkflowRun().CreateWorkflowRunBulkRetry(tenant.ID, &repository.CreateWorkflowRunBulkRetryOpts{
+		Filter: filter,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create bulk retry: %w", err)
+	}
+
+	// the matching runs are re-queued in batches by the jobs controller
+	err = t.config.MessageQueue.AddMessage(
+		ctx.Request().Context(),
+		msgqueue.JOB_PROCESSING_QUEUE,
+		tasktypes.WorkflowRunBulkRetryToTask(tenant.ID, bulkRetry.ID),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not add bulk retry task to task queue: %w", err)
+	}
+
+	res, err := transformers.ToWorkflowRunBulkRetry(bulkRetry)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkflowRunBulkRetry200JSONResponse(
+		*res,
+	), nil
+}
+
+func (t *WorkflowService) WorkflowRunGetBulkRetry(ctx echo.Context, request gen.WorkflowRunGetBulkRetryRequestObject) (gen.WorkflowRunGetBulkRetryResponseObject, error) {
+	bulkRetry := ctx.Get("bulk-retry").(*db.WorkflowRunBulkRetryModel)
+
+	res, err := transformers.ToWorkflowRunBulkRetry(bulkRetry)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkflowRunGetBulkRetry200JSONResponse(
+		*res,
+	), nil
+}
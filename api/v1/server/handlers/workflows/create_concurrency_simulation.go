@@ -0,0 +1,102 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/concurrency"
+)
+
+// maxConcurrencySimulationRuns is the maximum number of runs which are simulated, so that a simulation of a busy
+// workflow doesn't read all of its runs.
+const maxConcurrencySimulationRuns = 10000
+
+func (t *WorkflowService) WorkflowCreateConcurrencySimulation(ctx echo.Context, request gen.WorkflowCreateConcurrencySimulationRequestObject) (gen.WorkflowCreateConcurrencySimulationResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	workflow := ctx.Get("workflow").(*db.WorkflowModel)
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.WorkflowCreateConcurrencySimulation400JSONResponse(*apiErrors), nil
+	}
+
+	var key *concurrency.KeyExpression
+
+	if request.Body.KeyExpression != nil {
+		var err error
+
+		key, err = concurrency.CompileKey(*request.Body.KeyExpression)
+
+		if err != nil {
+			return gen.WorkflowCreateConcurrencySimulation400JSONResponse(
+				apierrors.NewAPIErrors(fmt.Sprintf("invalid key expression: %s", err.Error())),
+			), nil
+		}
+	}
+
+	windowEnd := time.Now().UTC()
+	windowStart := windowEnd.Add(-time.Duration(request.Body.Hours) * time.Hour)
+
+	rows, err := t.config.Repository.WorkflowRun().ListWorkflowRunsForConcurrencySimulation(
+		ctx.Request().Context(),
+		tenant.ID,
+		workflow.ID,
+		windowStart,
+		maxConcurrencySimulationRuns,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// if there are more runs than can be simulated, the simulation ends when the last simulated run was created, as
+	// the runs which were created after it would have competed for the same slots
+	truncated := len(rows) == maxConcurrencySimulationRuns
+
+	if truncated {
+		windowEnd = rows[len(rows)-1].CreatedAt.Time
+	}
+
+	res := concurrency.SimulateRows(
+		concurrency.Policy{
+			Strategy: concurrency.Strategy(request.Body.LimitStrategy),
+			MaxRuns:  request.Body.MaxRuns,
+		},
+		rows,
+		key,
+		windowEnd,
+	)
+
+	var avgQueueWait float64
+
+	if started := res.Queued - res.StillQueued; started > 0 {
+		avgQueueWait = res.TotalQueueWait.Seconds() / float64(started)
+	}
+
+	return gen.WorkflowCreateConcurrencySimulation200JSONResponse(
+		gen.ConcurrencySimulation{
+			WindowStart:         windowStart,
+			WindowEnd:           windowEnd,
+			Truncated:           truncated,
+			Runs:                res.Runs,
+			Groups:              res.Groups,
+			Started:             res.Started,
+			Cancelled:           res.Cancelled,
+			Queued:              res.Queued,
+			StillQueued:         res.StillQueued,
+			KeyErrors:           res.KeyErrors,
+			EstimatedDurations:  res.EstimatedDurations,
+			MaxRunning:          res.MaxRunning,
+			MaxQueued:           res.MaxQueued,
+			AvgQueueWaitSeconds: avgQueueWait,
+			MaxQueueWaitSeconds: res.MaxQueueWait.Seconds(),
+		},
+	), nil
+}
@@ -0,0 +1,62 @@
+package workflows
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/maintenance"
+)
+
+func (t *WorkflowService) MaintenanceWindowCreate(ctx echo.Context, request gen.MaintenanceWindowCreateRequestObject) (gen.MaintenanceWindowCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	workflow := ctx.Get("workflow").(*db.WorkflowModel)
+
+	opts := &repository.CreateWorkflowMaintenanceWindowOpts{
+		Name:            request.Body.Name,
+		Cron:            request.Body.Cron,
+		DurationSeconds: request.Body.DurationSeconds,
+	}
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(opts); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.MaintenanceWindowCreate400JSONResponse(*apiErrors), nil
+	}
+
+	// the validator only checks the format of the cron expression, so parse it like the ticker does
+	if _, err := maintenance.ParseCron(opts.Cron); err != nil {
+		return gen.MaintenanceWindowCreate400JSONResponse(
+			apierrors.NewAPIErrors(err.Error()),
+		), nil
+	}
+
+	// determine if a maintenance window with the name already exists
+	existing, err := t.config.Repository.Workflow().ListWorkflowMaintenanceWindows(workflow.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, window := range existing {
+		if window.Name == opts.Name {
+			return gen.MaintenanceWindowCreate400JSONResponse(
+				apierrors.NewAPIErrors("Maintenance window with the name already exists."),
+			), nil
+		}
+	}
+
+	window, err := t.config.Repository.Workflow().CreateWorkflowMaintenanceWindow(tenant.ID, workflow.ID, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.MaintenanceWindowCreate201JSONResponse(
+		*transformers.ToMaintenanceWindow(window),
+	), nil
+}
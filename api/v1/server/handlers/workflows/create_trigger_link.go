@@ -0,0 +1,85 @@
+package workflows
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/limits"
+)
+
+func (t *WorkflowService) TriggerLinkCreate(ctx echo.Context, request gen.TriggerLinkCreateRequestObject) (gen.TriggerLinkCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	workflow := ctx.Get("workflow").(*db.WorkflowModel)
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.TriggerLinkCreate400JSONResponse(*apiErrors), nil
+	}
+
+	// determine if a trigger link with the name already exists
+	existing, err := t.config.Repository.TriggerLink().ListTriggerLinks(tenant.ID, workflow.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, link := range existing {
+		if link.Name == request.Body.Name {
+			return gen.TriggerLinkCreate400JSONResponse(
+				apierrors.NewAPIErrors("Trigger link with the name already exists."),
+			), nil
+		}
+	}
+
+	var defaultInput []byte
+
+	if request.Body.DefaultInput != nil {
+		defaultInput, err = json.Marshal(request.Body.DefaultInput)
+
+		if err != nil {
+			return gen.TriggerLinkCreate400JSONResponse(
+				apierrors.NewAPIErrors("Invalid default input"),
+			), nil
+		}
+
+		if err := t.config.PayloadLimits.Check(limits.PayloadKindWorkflowInput, len(defaultInput)); err != nil {
+			return nil, err
+		}
+	}
+
+	opts, token, err := repository.NewTriggerLinkCreateOpts(request.Body.Name, defaultInput)
+
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := t.config.Repository.TriggerLink().CreateTriggerLink(tenant.ID, workflow.ID, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := transformers.ToTriggerLink(link)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the token is only returned here, since only its hash is stored
+	return gen.TriggerLinkCreate201JSONResponse(
+		gen.CreateTriggerLinkResponse{
+			TriggerLink: *res,
+			Token:       token,
+			Url:         fmt.Sprintf("%s/api/v1/trigger-links/%s/trigger?token=%s", t.config.Runtime.ServerURL, link.ID, token),
+		},
+	), nil
+}
@@ -4,7 +4,9 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 )
 
 func (t *WorkflowService) WorkflowDelete(ctx echo.Context, request gen.WorkflowDeleteRequestObject) (gen.WorkflowDeleteResponseObject, error) {
@@ -17,5 +19,16 @@ func (t *WorkflowService) WorkflowDelete(ctx echo.Context, request gen.WorkflowD
 		return nil, err
 	}
 
+	// drop the cached versions of the workflow in the controllers
+	err = t.config.MessageQueue.AddMessage(
+		ctx.Request().Context(),
+		msgqueue.WORKFLOW_PROCESSING_QUEUE,
+		tasktypes.WorkflowUpdatedToTask(tenant.ID, workflow.ID),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
 	return gen.WorkflowDelete204Response{}, nil
 }
@@ -0,0 +1,20 @@
+package workflows
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) MaintenanceWindowDelete(ctx echo.Context, request gen.MaintenanceWindowDeleteRequestObject) (gen.MaintenanceWindowDeleteResponseObject, error) {
+	window := ctx.Get("maintenance-window").(*db.WorkflowMaintenanceWindowModel)
+
+	err := t.config.Repository.Workflow().DeleteWorkflowMaintenanceWindow(window.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.MaintenanceWindowDelete204Response{}, nil
+}
@@ -0,0 +1,51 @@
+package workflows
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+)
+
+func (t *WorkflowService) WorkflowDeletePause(ctx echo.Context, request gen.WorkflowDeletePauseRequestObject) (gen.WorkflowDeletePauseResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	workflow := ctx.Get("workflow").(*db.WorkflowModel)
+
+	released, err := t.config.Repository.Workflow().ResumeWorkflow(ctx.Request().Context(), tenant.ID, workflow.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// queue the runs which were buffered while the workflow was paused
+	for _, workflowRun := range released {
+		err = t.config.MessageQueue.AddMessage(
+			ctx.Request().Context(),
+			msgqueue.WORKFLOW_PROCESSING_QUEUE,
+			tasktypes.ReleasedWorkflowRunToTask(workflowRun),
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not add workflow run to queue: %w", err)
+		}
+	}
+
+	workflow, err = t.config.Repository.Workflow().GetWorkflowById(workflow.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := transformers.ToWorkflow(workflow, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkflowDeletePause200JSONResponse(*resp), nil
+}
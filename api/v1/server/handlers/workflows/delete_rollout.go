@@ -0,0 +1,29 @@
+package workflows
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) WorkflowDeleteRollout(ctx echo.Context, request gen.WorkflowDeleteRolloutRequestObject) (gen.WorkflowDeleteRolloutResponseObject, error) {
+	workflow := ctx.Get("workflow").(*db.WorkflowModel)
+
+	_, err := t.config.Repository.Workflow().DeleteWorkflowRollout(workflow.ID)
+
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return gen.WorkflowDeleteRollout404JSONResponse(
+				apierrors.NewAPIErrors("workflow does not have a rollout policy"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	return gen.WorkflowDeleteRollout204Response{}, nil
+}
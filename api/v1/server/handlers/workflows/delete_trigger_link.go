@@ -0,0 +1,21 @@
+package workflows
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) TriggerLinkDelete(ctx echo.Context, request gen.TriggerLinkDeleteRequestObject) (gen.TriggerLinkDeleteResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	link := ctx.Get("trigger-link").(*db.WorkflowTriggerLinkModel)
+
+	err := t.config.Repository.TriggerLink().DeleteTriggerLink(tenant.ID, link.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.TriggerLinkDelete204Response{}, nil
+}
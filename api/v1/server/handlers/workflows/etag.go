@@ -0,0 +1,49 @@
+package workflows
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// resourceETag returns a weak ETag for a resource. The raw query is part of the ETag since query
+// parameters such as include and fields change the representation of the same resource.
+func resourceETag(ctx echo.Context, id string, updatedAt time.Time) string {
+	h := sha256.Sum256([]byte(fmt.Sprintf("%s:%d:%s", id, updatedAt.UnixNano(), ctx.Request().URL.RawQuery)))
+
+	return fmt.Sprintf(`W/"%s"`, hex.EncodeToString(h[:16]))
+}
+
+// etagMatches reports whether the If-None-Match header matches the ETag, using weak comparison.
+func etagMatches(ifNoneMatch *string, etag string) bool {
+	if ifNoneMatch == nil {
+		return false
+	}
+
+	for _, candidate := range strings.Split(*ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// latestUpdatedAt returns the latest of the given times.
+func latestUpdatedAt(times ...time.Time) time.Time {
+	var res time.Time
+
+	for _, t := range times {
+		if t.After(res) {
+			res = t
+		}
+	}
+
+	return res
+}
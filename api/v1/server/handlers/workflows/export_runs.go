@@ -0,0 +1,260 @@
+package workflows
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+)
+
+// workflowRunExportPageSize is the number of runs read from the database per query while streaming
+// an export.
+const workflowRunExportPageSize = 500
+
+var workflowRunExportCSVHeader = []string{
+	"cursor",
+	"id",
+	"workflowId",
+	"workflowName",
+	"workflowVersionId",
+	"status",
+	"displayName",
+	"concurrencyGroupId",
+	"error",
+	"createdAt",
+	"startedAt",
+	"finishedAt",
+}
+
+func (t *WorkflowService) WorkflowRunExport(ctx echo.Context, request gen.WorkflowRunExportRequestObject) (gen.WorkflowRunExportResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	format := gen.Ndjson
+
+	if request.Params.Format != nil {
+		format = *request.Params.Format
+	}
+
+	if format != gen.Ndjson && format != gen.Csv {
+		return gen.WorkflowRunExport400JSONResponse(
+			apierrors.NewAPIErrors(fmt.Sprintf("unsupported export format %s", format)),
+		), nil
+	}
+
+	opts := &repository.ListWorkflowRunsForExportOpts{
+		CreatedAfter:  request.Params.CreatedAfter,
+		CreatedBefore: request.Params.CreatedBefore,
+		Limit:         workflowRunExportPageSize,
+	}
+
+	if request.Params.WorkflowId != nil {
+		workflowIdStr := request.Params.WorkflowId.String()
+		opts.WorkflowId = &workflowIdStr
+	}
+
+	if request.Params.Status != nil {
+		status := db.WorkflowRunStatus(*request.Params.Status)
+		opts.Status = &status
+	}
+
+	if request.Params.Cursor != nil {
+		cursor, err := decodeWorkflowRunExportCursor(*request.Params.Cursor)
+
+		if err != nil {
+			return gen.WorkflowRunExport400JSONResponse(
+				apierrors.NewAPIErrors("invalid cursor"),
+			), nil
+		}
+
+		opts.After = cursor
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	// read the first page before starting the stream, so that errors can still be returned with a
+	// proper status code
+	rows, err := t.config.Repository.WorkflowRun().ListWorkflowRunsForExport(reqCtx, tenant.ID, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+
+	go func() {
+		pw.CloseWithError(t.streamWorkflowRunExport(reqCtx, tenant.ID, opts, rows, format, pw))
+	}()
+
+	if format == gen.Csv {
+		return gen.WorkflowRunExport200TextcsvResponse{
+			Body: pr,
+		}, nil
+	}
+
+	return gen.WorkflowRunExport200ApplicationxNdjsonResponse{
+		Body: pr,
+	}, nil
+}
+
+func (t *WorkflowService) streamWorkflowRunExport(
+	ctx context.Context,
+	tenantId string,
+	opts *repository.ListWorkflowRunsForExportOpts,
+	rows []*dbsqlc.ListWorkflowRunsForExportRow,
+	format gen.WorkflowRunExportFormat,
+	w io.Writer,
+) error {
+	buf := bufio.NewWriter(w)
+
+	var writeRow func(row *gen.WorkflowRunExportRow) error
+	var flush func() error
+
+	switch format {
+	case gen.Csv:
+		csvWriter := csv.NewWriter(buf)
+
+		if err := csvWriter.Write(workflowRunExportCSVHeader); err != nil {
+			return err
+		}
+
+		writeRow = func(row *gen.WorkflowRunExportRow) error {
+			return csvWriter.Write(workflowRunExportCSVRecord(row))
+		}
+
+		flush = func() error {
+			csvWriter.Flush()
+
+			if err := csvWriter.Error(); err != nil {
+				return err
+			}
+
+			return buf.Flush()
+		}
+	default:
+		enc := json.NewEncoder(buf)
+
+		writeRow = func(row *gen.WorkflowRunExportRow) error {
+			return enc.Encode(row)
+		}
+
+		flush = buf.Flush
+	}
+
+	for {
+		for _, row := range rows {
+			cursor := &repository.WorkflowRunExportCursor{
+				CreatedAt: row.WorkflowRun.CreatedAt.Time,
+				ID:        sqlchelpers.UUIDToStr(row.WorkflowRun.ID),
+			}
+
+			if err := writeRow(transformers.ToWorkflowRunExportRow(row, encodeWorkflowRunExportCursor(cursor))); err != nil {
+				return err
+			}
+
+			opts.After = cursor
+		}
+
+		// flush after each page so clients receive rows while the next page is being read
+		if err := flush(); err != nil {
+			return err
+		}
+
+		if len(rows) < opts.Limit {
+			return nil
+		}
+
+		var err error
+
+		rows, err = t.config.Repository.WorkflowRun().ListWorkflowRunsForExport(ctx, tenantId, opts)
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func workflowRunExportCSVRecord(row *gen.WorkflowRunExportRow) []string {
+	strOrEmpty := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+
+		return *s
+	}
+
+	timeOrEmpty := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+
+		return t.UTC().Format(time.RFC3339Nano)
+	}
+
+	return []string{
+		row.Cursor,
+		row.Id,
+		row.WorkflowId,
+		row.WorkflowName,
+		row.WorkflowVersionId,
+		string(row.Status),
+		strOrEmpty(row.DisplayName),
+		strOrEmpty(row.ConcurrencyGroupId),
+		strOrEmpty(row.Error),
+		timeOrEmpty(&row.CreatedAt),
+		timeOrEmpty(row.StartedAt),
+		timeOrEmpty(row.FinishedAt),
+	}
+}
+
+// encodeWorkflowRunExportCursor encodes a cursor as an opaque, url-safe string.
+func encodeWorkflowRunExportCursor(cursor *repository.WorkflowRunExportCursor) string {
+	raw := fmt.Sprintf("%d:%s", cursor.CreatedAt.UnixNano(), cursor.ID)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeWorkflowRunExportCursor(encoded string) (*repository.WorkflowRunExportCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+
+	if err != nil {
+		return nil, err
+	}
+
+	nanosStr, id, found := strings.Cut(string(raw), ":")
+
+	if !found {
+		return nil, fmt.Errorf("malformed cursor")
+	}
+
+	nanos, err := strconv.ParseInt(nanosStr, 10, 64)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, err
+	}
+
+	return &repository.WorkflowRunExportCursor{
+		CreatedAt: time.Unix(0, nanos).UTC(),
+		ID:        id,
+	}, nil
+}
@@ -0,0 +1,116 @@
+package workflows
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+)
+
+const stepRunFieldPrefix = "stepRuns."
+
+// workflowRunFields is a sparse fieldset for a workflow run. A nil set means every field is returned.
+type workflowRunFields struct {
+	run     map[string]bool
+	stepRun map[string]bool
+}
+
+// parseWorkflowRunFields parses the fields query parameter. Only optional fields can be selected, since
+// required fields are always returned.
+func parseWorkflowRunFields(fields []string) (*workflowRunFields, error) {
+	res := &workflowRunFields{
+		run: map[string]bool{},
+	}
+
+	runFields := optionalJSONFields(gen.WorkflowRun{})
+	stepRunFields := optionalJSONFields(gen.StepRun{})
+
+	for _, field := range fields {
+		if stepRunField, ok := strings.CutPrefix(field, stepRunFieldPrefix); ok {
+			if !stepRunFields[stepRunField] {
+				return nil, fmt.Errorf("unknown step run field %s", stepRunField)
+			}
+
+			if res.stepRun == nil {
+				res.stepRun = map[string]bool{}
+			}
+
+			res.stepRun[stepRunField] = true
+
+			// step runs are nested in job runs
+			res.run["jobRuns"] = true
+
+			continue
+		}
+
+		if !runFields[field] {
+			return nil, fmt.Errorf("unknown workflow run field %s", field)
+		}
+
+		res.run[field] = true
+	}
+
+	return res, nil
+}
+
+// apply clears every optional field on the workflow run and its step runs which was not selected.
+func (f *workflowRunFields) apply(run *gen.WorkflowRun) {
+	clearUnselectedFields(run, f.run)
+
+	if f.stepRun == nil || run.JobRuns == nil {
+		return
+	}
+
+	for i := range *run.JobRuns {
+		jobRun := &(*run.JobRuns)[i]
+
+		if jobRun.StepRuns == nil {
+			continue
+		}
+
+		for j := range *jobRun.StepRuns {
+			clearUnselectedFields(&(*jobRun.StepRuns)[j], f.stepRun)
+		}
+	}
+}
+
+// optionalJSONFields returns the json names of the optional fields of a generated API type. Optional
+// fields are generated as pointers.
+func optionalJSONFields(v interface{}) map[string]bool {
+	res := map[string]bool{}
+	t := reflect.TypeOf(v)
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if field.Type.Kind() != reflect.Ptr {
+			continue
+		}
+
+		res[jsonFieldName(field)] = true
+	}
+
+	return res
+}
+
+func clearUnselectedFields(v interface{}, selected map[string]bool) {
+	val := reflect.ValueOf(v).Elem()
+	t := val.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if field.Type.Kind() != reflect.Ptr || selected[jsonFieldName(field)] {
+			continue
+		}
+
+		val.Field(i).Set(reflect.Zero(field.Type))
+	}
+}
+
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+	return name
+}
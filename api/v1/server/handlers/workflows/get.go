@@ -11,11 +11,33 @@ import (
 func (t *WorkflowService) WorkflowGet(ctx echo.Context, request gen.WorkflowGetRequestObject) (gen.WorkflowGetResponseObject, error) {
 	workflow := ctx.Get("workflow").(*db.WorkflowModel)
 
+	// creating a version doesn't update the workflow, so versions are part of the etag
+	updatedAt := workflow.UpdatedAt
+
+	for _, version := range workflow.Versions() {
+		updatedAt = latestUpdatedAt(updatedAt, version.UpdatedAt)
+	}
+
+	etag := resourceETag(ctx, workflow.ID, updatedAt)
+
+	if etagMatches(request.Params.IfNoneMatch, etag) {
+		return gen.WorkflowGet304Response{
+			Headers: gen.WorkflowGet304ResponseHeaders{
+				ETag: etag,
+			},
+		}, nil
+	}
+
 	resp, err := transformers.ToWorkflow(workflow, nil)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return gen.WorkflowGet200JSONResponse(*resp), nil
+	return gen.WorkflowGet200JSONResponse{
+		Body: *resp,
+		Headers: gen.WorkflowGet200ResponseHeaders{
+			ETag: etag,
+		},
+	}, nil
 }
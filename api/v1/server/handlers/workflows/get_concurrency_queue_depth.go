@@ -0,0 +1,82 @@
+package workflows
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+// maxConcurrencyQueueDepthValues is the maximum number of groups, and of values of each component of the group
+// keys, which are returned.
+const maxConcurrencyQueueDepthValues = 50
+
+func (t *WorkflowService) WorkflowGetConcurrencyQueueDepth(ctx echo.Context, request gen.WorkflowGetConcurrencyQueueDepthRequestObject) (gen.WorkflowGetConcurrencyQueueDepthResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	workflow := ctx.Get("workflow").(*db.WorkflowModel)
+
+	rows, err := t.config.Repository.WorkflowRun().ListWorkflowConcurrencyQueueDepth(
+		ctx.Request().Context(),
+		tenant.ID,
+		workflow.ID,
+		maxConcurrencyQueueDepthValues,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the components are labelled with the key expressions of the latest version, as runs of older versions which
+	// are still queued may have been computed from different expressions
+	var keyExpressions []string
+
+	if versions := workflow.Versions(); len(versions) > 0 {
+		workflowVersion, err := t.config.Repository.Workflow().GetWorkflowVersionById(tenant.ID, versions[0].ID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if workflowConcurrency, ok := workflowVersion.Concurrency(); ok {
+			keyExpressions = workflowConcurrency.KeyExpressions
+		}
+	}
+
+	res := gen.WorkflowConcurrencyQueueDepth{
+		Groups:     []gen.WorkflowConcurrencyQueueDepthValue{},
+		Components: []gen.WorkflowConcurrencyQueueDepthComponent{},
+	}
+
+	// the rows are ordered by position
+	for _, row := range rows {
+		value := gen.WorkflowConcurrencyQueueDepthValue{
+			Value:   row.Value,
+			Queued:  row.Queued,
+			Running: row.Running,
+		}
+
+		if row.Position == 0 {
+			res.Groups = append(res.Groups, value)
+			continue
+		}
+
+		position := int(row.Position)
+
+		if len(res.Components) == 0 || res.Components[len(res.Components)-1].Position != position {
+			component := gen.WorkflowConcurrencyQueueDepthComponent{
+				Position: position,
+				Values:   []gen.WorkflowConcurrencyQueueDepthValue{},
+			}
+
+			if position <= len(keyExpressions) {
+				component.Expression = &keyExpressions[position-1]
+			}
+
+			res.Components = append(res.Components, component)
+		}
+
+		res.Components[len(res.Components)-1].Values = append(res.Components[len(res.Components)-1].Values, value)
+	}
+
+	return gen.WorkflowGetConcurrencyQueueDepth200JSONResponse(res), nil
+}
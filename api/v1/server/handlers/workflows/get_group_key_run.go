@@ -0,0 +1,32 @@
+package workflows
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) WorkflowRunGetGroupKeyRun(ctx echo.Context, request gen.WorkflowRunGetGroupKeyRunRequestObject) (gen.WorkflowRunGetGroupKeyRunResponseObject, error) {
+	run := ctx.Get("workflow-run").(*db.WorkflowRunModel)
+
+	if run.RelationsWorkflowRun.GetGroupKeyRun == nil {
+		return gen.WorkflowRunGetGroupKeyRun404JSONResponse(
+			apierrors.NewAPIErrors("group key run not found"),
+		), nil
+	}
+
+	getGroupKeyRun, ok := run.GetGroupKeyRun()
+
+	if !ok {
+		return gen.WorkflowRunGetGroupKeyRun404JSONResponse(
+			apierrors.NewAPIErrors("group key run not found"),
+		), nil
+	}
+
+	return gen.WorkflowRunGetGroupKeyRun200JSONResponse(
+		*transformers.ToGetGroupKeyRun(getGroupKeyRun),
+	), nil
+}
@@ -0,0 +1,32 @@
+package workflows
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) WorkflowGetRollout(ctx echo.Context, request gen.WorkflowGetRolloutRequestObject) (gen.WorkflowGetRolloutResponseObject, error) {
+	workflow := ctx.Get("workflow").(*db.WorkflowModel)
+
+	rollout, err := t.config.Repository.Workflow().GetWorkflowRollout(workflow.ID)
+
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return gen.WorkflowGetRollout404JSONResponse(
+				apierrors.NewAPIErrors("workflow does not have a rollout policy"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	return gen.WorkflowGetRollout200JSONResponse(
+		*transformers.ToWorkflowRollout(rollout),
+	), nil
+}
@@ -1,23 +1,100 @@
 package workflows
 
 import (
+	"fmt"
+
 	"github.com/labstack/echo/v4"
 
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 )
 
 func (t *WorkflowService) WorkflowRunGet(ctx echo.Context, request gen.WorkflowRunGetRequestObject) (gen.WorkflowRunGetResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
 	run := ctx.Get("workflow-run").(*db.WorkflowRunModel)
 
+	// by default, step runs and the triggering event are returned
+	opts := &repository.GetWorkflowRunOpts{
+		StepRuns: true,
+		Events:   true,
+	}
+
+	if request.Params.Include != nil {
+		opts = &repository.GetWorkflowRunOpts{}
+
+		for _, include := range *request.Params.Include {
+			switch include {
+			case gen.StepRuns:
+				opts.StepRuns = true
+			case gen.Events:
+				opts.Events = true
+			case gen.Logs:
+				opts.Logs = true
+			default:
+				return gen.WorkflowRunGet400JSONResponse(
+					apierrors.NewAPIErrors(fmt.Sprintf("unknown include %s", include)),
+				), nil
+			}
+		}
+	}
+
+	var fields *workflowRunFields
+
+	if request.Params.Fields != nil {
+		var err error
+
+		fields, err = parseWorkflowRunFields(*request.Params.Fields)
+
+		if err != nil {
+			return gen.WorkflowRunGet400JSONResponse(
+				apierrors.NewAPIErrors(err.Error()),
+			), nil
+		}
+	}
+
+	// step runs and logs don't update the workflow run, so the etag uses the latest update across all of them
+	lastUpdatedAt, err := t.config.Repository.WorkflowRun().GetWorkflowRunLastUpdatedAt(ctx.Request().Context(), tenant.ID, run.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the tenant is included since its redaction rules change the response
+	etag := resourceETag(ctx, run.ID, latestUpdatedAt(run.UpdatedAt, lastUpdatedAt, tenant.UpdatedAt))
+
+	if etagMatches(request.Params.IfNoneMatch, etag) {
+		return gen.WorkflowRunGet304Response{
+			Headers: gen.WorkflowRunGet304ResponseHeaders{
+				ETag: etag,
+			},
+		}, nil
+	}
+
+	run, err = t.config.Repository.WorkflowRun().GetWorkflowRun(tenant.ID, run.ID, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := transformers.ToWorkflowRun(run)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return gen.WorkflowRunGet200JSONResponse(
-		*resp,
-	), nil
+	transformers.RedactWorkflowRun(resp, transformers.RedactionRules(tenant))
+
+	if fields != nil {
+		fields.apply(resp)
+	}
+
+	return gen.WorkflowRunGet200JSONResponse{
+		Body: *resp,
+		Headers: gen.WorkflowRunGet200ResponseHeaders{
+			ETag: etag,
+		},
+	}, nil
 }
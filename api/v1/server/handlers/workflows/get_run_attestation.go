@@ -0,0 +1,57 @@
+package workflows
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/attestation"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) WorkflowRunGetAttestation(ctx echo.Context, request gen.WorkflowRunGetAttestationRequestObject) (gen.WorkflowRunGetAttestationResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	run := ctx.Get("workflow-run").(*db.WorkflowRunModel)
+
+	// the outputs of runs which are in progress can still change, so only finished runs are attested
+	if run.Status != db.WorkflowRunStatusSucceeded && run.Status != db.WorkflowRunStatusFailed {
+		return gen.WorkflowRunGetAttestation400JSONResponse(
+			apierrors.NewAPIErrors("workflow run has not finished"),
+		), nil
+	}
+
+	run, err := t.config.Repository.WorkflowRun().GetWorkflowRun(tenant.ID, run.ID, &repository.GetWorkflowRunOpts{
+		StepRuns: true,
+		Workers:  true,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := t.getWorkflowRunInput(tenant.ID, run)
+
+	if err != nil {
+		return nil, err
+	}
+
+	statement, err := transformers.ToAttestationStatement(run, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not build attestation statement: %w", err)
+	}
+
+	signed, err := attestation.Sign(t.config.Encryption.GetPrivateJWTHandle(), t.config.Runtime.ServerURL, statement)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not sign attestation: %w", err)
+	}
+
+	return gen.WorkflowRunGetAttestation200JSONResponse(
+		*transformers.ToWorkflowRunAttestation(signed, statement),
+	), nil
+}
@@ -0,0 +1,64 @@
+package workflows
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) WorkflowRunGetBundle(ctx echo.Context, request gen.WorkflowRunGetBundleRequestObject) (gen.WorkflowRunGetBundleResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	run := ctx.Get("workflow-run").(*db.WorkflowRunModel)
+
+	// runs which are in progress cannot be restored, so only finished runs are exported
+	if run.Status != db.WorkflowRunStatusSucceeded && run.Status != db.WorkflowRunStatusFailed {
+		return gen.WorkflowRunGetBundle400JSONResponse(
+			apierrors.NewAPIErrors("workflow run has not finished"),
+		), nil
+	}
+
+	run, err := t.config.Repository.WorkflowRun().GetWorkflowRun(tenant.ID, run.ID, &repository.GetWorkflowRunOpts{
+		StepRuns: true,
+		Events:   true,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	workflowVersion, err := t.config.Repository.Workflow().GetWorkflowVersionById(tenant.ID, run.WorkflowVersionID)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get workflow version: %w", err)
+	}
+
+	rawDefinition, err := transformers.ToWorkflowYAMLBytes(run.WorkflowVersion().Workflow(), workflowVersion)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := t.getWorkflowRunInput(tenant.ID, run)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := transformers.ToWorkflowRunBundle(run, rawDefinition, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not transform workflow run bundle: %w", err)
+	}
+
+	transformers.RedactWorkflowRunBundle(res, transformers.RedactionRules(tenant))
+
+	return gen.WorkflowRunGetBundle200JSONResponse(
+		*res,
+	), nil
+}
@@ -0,0 +1,27 @@
+package workflows
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) WorkflowRunGetDag(ctx echo.Context, request gen.WorkflowRunGetDagRequestObject) (gen.WorkflowRunGetDagResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	run := ctx.Get("workflow-run").(*db.WorkflowRunModel)
+
+	run, err := t.config.Repository.WorkflowRun().GetWorkflowRun(tenant.ID, run.ID, &repository.GetWorkflowRunOpts{
+		StepRuns: true,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkflowRunGetDag200JSONResponse(
+		*transformers.ToWorkflowRunDag(run),
+	), nil
+}
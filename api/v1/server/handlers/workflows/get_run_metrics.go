@@ -0,0 +1,86 @@
+package workflows
+
+import (
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) WorkflowRunGetMetrics(ctx echo.Context, request gen.WorkflowRunGetMetricsRequestObject) (gen.WorkflowRunGetMetricsResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	until := time.Now().UTC()
+
+	if request.Params.Until != nil {
+		until = *request.Params.Until
+	}
+
+	since := until.Add(-7 * 24 * time.Hour)
+
+	if request.Params.Since != nil {
+		since = *request.Params.Since
+	}
+
+	// purged runs are rolled up by the hour, so the range starts at the hour to count them in the same buckets
+	// as the runs which were not purged
+	since = since.Truncate(time.Hour)
+
+	if !since.Before(until) {
+		return gen.WorkflowRunGetMetrics400JSONResponse(
+			apierrors.NewAPIErrors("since must be before until"),
+		), nil
+	}
+
+	bucket := gen.DAY
+
+	if request.Params.Bucket != nil {
+		bucket = *request.Params.Bucket
+	}
+
+	switch bucket {
+	case gen.HOUR, gen.DAY:
+	default:
+		return gen.WorkflowRunGetMetrics400JSONResponse(
+			apierrors.NewAPIErrors("bucket must be one of HOUR or DAY"),
+		), nil
+	}
+
+	opts := &repository.ListWorkflowRunMetricsOpts{
+		Bucket: strings.ToLower(string(bucket)),
+		Since:  since,
+		Until:  until,
+	}
+
+	if request.Params.WorkflowId != nil {
+		workflowId := request.Params.WorkflowId.String()
+		opts.WorkflowId = &workflowId
+	}
+
+	metrics, err := t.config.Repository.WorkflowRun().ListWorkflowRunMetrics(tenant.ID, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.WorkflowRunMetricsRow, len(metrics))
+
+	for i := range metrics {
+		rows[i] = *transformers.ToWorkflowRunMetricsRow(metrics[i])
+	}
+
+	return gen.WorkflowRunGetMetrics200JSONResponse(
+		gen.WorkflowRunMetrics{
+			Since:  since,
+			Until:  until,
+			Bucket: bucket,
+			Rows:   rows,
+		},
+	), nil
+}
@@ -0,0 +1,118 @@
+package workflows
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) WorkflowRunGetRootCause(ctx echo.Context, request gen.WorkflowRunGetRootCauseRequestObject) (gen.WorkflowRunGetRootCauseResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	run := ctx.Get("workflow-run").(*db.WorkflowRunModel)
+
+	if run.Status != db.WorkflowRunStatusFailed {
+		return gen.WorkflowRunGetRootCause400JSONResponse(
+			apierrors.NewAPIErrors("workflow run has not failed"),
+		), nil
+	}
+
+	run, err := t.config.Repository.WorkflowRun().GetWorkflowRun(tenant.ID, run.ID, &repository.GetWorkflowRunOpts{
+		StepRuns: true,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	stepRun, failedAt := getRootCauseStepRun(run)
+
+	if stepRun == nil {
+		return gen.WorkflowRunGetRootCause404JSONResponse(
+			apierrors.NewAPIErrors("no step run of the workflow run failed"),
+		), nil
+	}
+
+	archived, err := t.config.Repository.StepRun().ListArchivedStepRunResults(tenant.ID, stepRun.ID)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list archived step run results: %w", err)
+	}
+
+	var worker *db.WorkerModel
+
+	if workerId, ok := stepRun.WorkerID(); ok {
+		worker, err = t.config.Repository.Worker().GetWorkerById(workerId)
+
+		if err != nil && !errors.Is(err, db.ErrNotFound) {
+			return nil, fmt.Errorf("could not get worker: %w", err)
+		}
+	}
+
+	res, err := transformers.ToWorkflowRunRootCause(run, stepRun, failedAt, archived, worker)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not transform root cause: %w", err)
+	}
+
+	transformers.RedactStepRun(&res.StepRun, transformers.RedactionRules(tenant))
+
+	return gen.WorkflowRunGetRootCause200JSONResponse(
+		*res,
+	), nil
+}
+
+// getRootCauseStepRun returns the step run which failed or timed out first, and the time at which it did. Step runs
+// which were cancelled because an earlier step timed out or was cancelled are consequences, not causes, so they are
+// skipped.
+func getRootCauseStepRun(run *db.WorkflowRunModel) (*db.StepRunModel, time.Time) {
+	var res *db.StepRunModel
+	var resFailedAt time.Time
+
+	for _, jobRun := range run.JobRuns() {
+		stepRuns := jobRun.StepRuns()
+
+		for i := range stepRuns {
+			failedAt, ok := getStepRunFailedAt(&stepRuns[i])
+
+			if ok && (res == nil || failedAt.Before(resFailedAt)) {
+				res = &stepRuns[i]
+				resFailedAt = failedAt
+			}
+		}
+	}
+
+	return res, resFailedAt
+}
+
+func getStepRunFailedAt(stepRun *db.StepRunModel) (time.Time, bool) {
+	switch stepRun.Status {
+	case db.StepRunStatusFailed:
+		if finishedAt, ok := stepRun.FinishedAt(); ok {
+			return finishedAt, true
+		}
+
+		return stepRun.UpdatedAt, true
+	case db.StepRunStatusCancelled:
+		switch reason, _ := stepRun.CancelledReason(); reason {
+		case "TIMED_OUT", "SCHEDULING_TIMED_OUT":
+		default:
+			return time.Time{}, false
+		}
+
+		if cancelledAt, ok := stepRun.CancelledAt(); ok {
+			return cancelledAt, true
+		}
+
+		return stepRun.UpdatedAt, true
+	}
+
+	return time.Time{}, false
+}
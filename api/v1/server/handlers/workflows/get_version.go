@@ -31,6 +31,24 @@ func (t *WorkflowService) WorkflowVersionGet(ctx echo.Context, request gen.Workf
 		workflowVersionId = versions[0].ID
 	}
 
+	// the versions on the populated workflow are enough to check the etag before hydrating the version
+	var etag string
+
+	for _, version := range workflow.Versions() {
+		if version.ID == workflowVersionId {
+			etag = resourceETag(ctx, version.ID, version.UpdatedAt)
+			break
+		}
+	}
+
+	if etag != "" && etagMatches(request.Params.IfNoneMatch, etag) {
+		return gen.WorkflowVersionGet304Response{
+			Headers: gen.WorkflowVersionGet304ResponseHeaders{
+				ETag: etag,
+			},
+		}, nil
+	}
+
 	workflowVersion, err := t.config.Repository.Workflow().GetWorkflowVersionById(tenant.ID, workflowVersionId)
 
 	if err != nil {
@@ -49,5 +67,10 @@ func (t *WorkflowService) WorkflowVersionGet(ctx echo.Context, request gen.Workf
 		return nil, err
 	}
 
-	return gen.WorkflowVersionGet200JSONResponse(*resp), nil
+	return gen.WorkflowVersionGet200JSONResponse{
+		Body: *resp,
+		Headers: gen.WorkflowVersionGet200ResponseHeaders{
+			ETag: resourceETag(ctx, workflowVersion.ID, workflowVersion.UpdatedAt),
+		},
+	}, nil
 }
@@ -0,0 +1,200 @@
+package workflows
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/admin"
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
+)
+
+func (t *WorkflowService) WorkflowRunImport(ctx echo.Context, request gen.WorkflowRunImportRequestObject) (gen.WorkflowRunImportResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	bundle := request.Body
+
+	if bundle.BundleVersion != transformers.WorkflowRunBundleVersion {
+		return gen.WorkflowRunImport400JSONResponse(
+			apierrors.NewAPIErrors(fmt.Sprintf("unsupported bundle version %s", bundle.BundleVersion)),
+		), nil
+	}
+
+	definition, err := types.ParseYAML(ctx.Request().Context(), []byte(bundle.RawDefinition))
+
+	if err != nil {
+		return gen.WorkflowRunImport400JSONResponse(
+			apierrors.NewAPIErrors(fmt.Sprintf("could not parse workflow definition: %s", err.Error())),
+		), nil
+	}
+
+	createWorkflowOpts, err := admin.CreateWorkflowOptsFromDefinition(&definition)
+
+	if err != nil {
+		return gen.WorkflowRunImport400JSONResponse(
+			apierrors.NewAPIErrors(fmt.Sprintf("invalid workflow definition: %s", err.Error())),
+		), nil
+	}
+
+	if apiErrors, err := t.config.Validator.ValidateAPI(createWorkflowOpts); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.WorkflowRunImport400JSONResponse(*apiErrors), nil
+	}
+
+	restoreOpts := toCreateWorkflowRunRestoreOpts(bundle)
+
+	if apiErrors, err := t.config.Validator.ValidateAPI(restoreOpts); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.WorkflowRunImport400JSONResponse(*apiErrors), nil
+	}
+
+	// put the workflow version, which is a no-op if the latest version has the same definition
+	workflowVersion, err := admin.PutWorkflowVersion(ctx.Request().Context(), t.config.Repository, t.config.MessageQueue, tenant.ID, createWorkflowOpts)
+
+	if err != nil {
+		if errors.Is(err, admin.ErrNoTickersAvailable) {
+			return gen.WorkflowRunImport400JSONResponse(
+				apierrors.NewAPIErrors("the workflow has cron or scheduled triggers, but there are no active tickers"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	workflowVersion, err = t.config.Repository.Workflow().GetWorkflowVersionById(tenant.ID, workflowVersion.ID)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get workflow version: %w", err)
+	}
+
+	inputBytes, err := json.Marshal(bundle.Input)
+
+	if err != nil {
+		return gen.WorkflowRunImport400JSONResponse(
+			apierrors.NewAPIErrors("Invalid input"),
+		), nil
+	}
+
+	createOpts, err := repository.GetCreateWorkflowRunOptsFromManual(workflowVersion, inputBytes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the restored run is never queued, so it does not need a group key
+	createOpts.GetGroupKeyRun = nil
+	createOpts.Restore = restoreOpts
+
+	if bundle.DisplayName != nil {
+		createOpts.DisplayName = bundle.DisplayName
+	}
+
+	if bundle.Event != nil {
+		// the event is only stored, not pushed, so that it does not trigger other workflows
+		event, err := t.createBundleEvent(ctx, tenant.ID, bundle.Event)
+
+		if err != nil {
+			return nil, err
+		}
+
+		createOpts.ManualTriggerInput = nil
+		createOpts.TriggeringEventId = &event.ID
+		createOpts.TriggeredBy = string(datautils.TriggeredByEvent)
+	}
+
+	restored, err := t.config.Repository.WorkflowRun().CreateNewWorkflowRun(ctx.Request().Context(), tenant.ID, createOpts)
+
+	if errors.Is(err, repository.ErrWorkflowPaused) {
+		return gen.WorkflowRunImport400JSONResponse(
+			apierrors.NewAPIErrors(err.Error()),
+		), nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not restore workflow run: %w", err)
+	}
+
+	res, err := transformers.ToWorkflowRun(restored)
+
+	if err != nil {
+		return nil, err
+	}
+
+	transformers.RedactWorkflowRun(res, transformers.RedactionRules(tenant))
+
+	return gen.WorkflowRunImport200JSONResponse(
+		*res,
+	), nil
+}
+
+func (t *WorkflowService) createBundleEvent(ctx echo.Context, tenantId string, bundleEvent *gen.WorkflowRunBundleEvent) (*db.EventModel, error) {
+	var data interface{} = map[string]interface{}{}
+
+	if bundleEvent.Data != nil {
+		data = *bundleEvent.Data
+	}
+
+	jsonType, err := datautils.ToJSONType(data)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not convert event data to JSON: %w", err)
+	}
+
+	event, err := t.config.Repository.Event().CreateEvent(ctx.Request().Context(), &repository.CreateEventOpts{
+		TenantId: tenantId,
+		Key:      bundleEvent.Key,
+		Data:     jsonType,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create event: %w", err)
+	}
+
+	return event, nil
+}
+
+func toCreateWorkflowRunRestoreOpts(bundle *gen.WorkflowRunBundle) *repository.CreateWorkflowRunRestoreOpts {
+	res := &repository.CreateWorkflowRunRestoreOpts{
+		Status:     string(bundle.Status),
+		Error:      bundle.Error,
+		StartedAt:  bundle.StartedAt,
+		FinishedAt: bundle.FinishedAt,
+		StepRuns:   make([]repository.CreateWorkflowRunRestoreStepRunOpts, 0, len(bundle.StepRuns)),
+	}
+
+	for _, stepRun := range bundle.StepRuns {
+		stepRunOpts := repository.CreateWorkflowRunRestoreStepRunOpts{
+			JobName:         stepRun.JobName,
+			StepReadableId:  stepRun.StepReadableId,
+			Status:          string(stepRun.Status),
+			Error:           stepRun.Error,
+			CancelledReason: stepRun.CancelledReason,
+			StartedAt:       stepRun.StartedAt,
+			FinishedAt:      stepRun.FinishedAt,
+			CancelledAt:     stepRun.CancelledAt,
+			RetryCount:      stepRun.RetryCount,
+		}
+
+		// the values were unmarshalled from JSON, so they can always be marshalled
+		if stepRun.Input != nil {
+			stepRunOpts.Input, _ = json.Marshal(*stepRun.Input) // nolint: errcheck
+		}
+
+		if stepRun.Output != nil {
+			stepRunOpts.Output, _ = json.Marshal(*stepRun.Output) // nolint: errcheck
+		}
+
+		res.StepRuns = append(res.StepRuns, stepRunOpts)
+	}
+
+	return res
+}
@@ -32,15 +32,19 @@ func (t *WorkflowService) WorkflowUpdateLinkGithub(ctx echo.Context, request gen
 		), nil
 	}
 
-	_, err = t.config.Repository.Workflow().UpsertWorkflowDeploymentConfig(
-		workflow.ID,
-		&repository.UpsertWorkflowDeploymentConfigOpts{
-			GithubAppInstallationId: installationId,
-			GitRepoName:             request.Body.GitRepoName,
-			GitRepoOwner:            request.Body.GitRepoOwner,
-			GitRepoBranch:           request.Body.GitRepoBranch,
-		},
-	)
+	opts := &repository.UpsertWorkflowDeploymentConfigOpts{
+		GithubAppInstallationId: installationId,
+		GitRepoName:             request.Body.GitRepoName,
+		GitRepoOwner:            request.Body.GitRepoOwner,
+		GitRepoBranch:           request.Body.GitRepoBranch,
+	}
+
+	if request.Body.PreviewEnvironmentHook != nil {
+		hook := db.PreviewEnvironmentHook(*request.Body.PreviewEnvironmentHook)
+		opts.PreviewEnvironmentHook = &hook
+	}
+
+	_, err = t.config.Repository.Workflow().UpsertWorkflowDeploymentConfig(workflow.ID, opts)
 
 	if err != nil {
 		return nil, err
@@ -1,12 +1,15 @@
 package workflows
 
 import (
+	"errors"
 	"math"
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/api/v1/server/serverutils"
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 )
@@ -14,12 +17,27 @@ import (
 func (t *WorkflowService) WorkflowList(ctx echo.Context, request gen.WorkflowListRequestObject) (gen.WorkflowListResponseObject, error) {
 	tenant := ctx.Get("tenant").(*db.TenantModel)
 
+	// names are unique within a tenant, so a name lookup returns at most one workflow
+	if request.Params.Name != nil {
+		name, err := serverutils.ResolveNamespace(ctx, *request.Params.Name)
+
+		if err != nil {
+			return gen.WorkflowList403JSONResponse(
+				apierrors.NewAPIErrors(err.Error()),
+			), nil
+		}
+
+		return t.getWorkflowListByName(tenant.ID, name)
+	}
+
 	limit := 50
 	offset := 0
 
 	listOpts := &repository.ListWorkflowsOpts{
-		Limit:  &limit,
-		Offset: &offset,
+		Limit:       &limit,
+		Offset:      &offset,
+		Environment: serverutils.GetEnvironment(ctx, request.Params.Environment),
+		Namespace:   serverutils.GetNamespace(ctx, request.Params.Namespace),
 	}
 
 	listResp, err := t.config.Repository.Workflow().ListWorkflows(tenant.ID, listOpts)
@@ -60,3 +78,37 @@ func (t *WorkflowService) WorkflowList(ctx echo.Context, request gen.WorkflowLis
 		},
 	), nil
 }
+
+func (t *WorkflowService) getWorkflowListByName(tenantId, name string) (gen.WorkflowListResponseObject, error) {
+	rows := make([]gen.Workflow, 0)
+
+	workflow, err := t.config.Repository.Workflow().GetWorkflowByName(tenantId, name)
+
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		return nil, err
+	}
+
+	if err == nil {
+		resp, err := transformers.ToWorkflow(workflow, nil)
+
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, *resp)
+	}
+
+	numPages := int64(1)
+	currPage := int64(1)
+
+	return gen.WorkflowList200JSONResponse(
+		gen.WorkflowList{
+			Rows: &rows,
+			Pagination: &gen.PaginationResponse{
+				NumPages:    &numPages,
+				CurrentPage: &currPage,
+				NextPage:    &currPage,
+			},
+		},
+	), nil
+}
@@ -0,0 +1,31 @@
+package workflows
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) MaintenanceWindowList(ctx echo.Context, request gen.MaintenanceWindowListRequestObject) (gen.MaintenanceWindowListResponseObject, error) {
+	workflow := ctx.Get("workflow").(*db.WorkflowModel)
+
+	windows, err := t.config.Repository.Workflow().ListWorkflowMaintenanceWindows(workflow.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.MaintenanceWindow, len(windows))
+
+	for i := range windows {
+		rows[i] = *transformers.ToMaintenanceWindow(&windows[i])
+	}
+
+	return gen.MaintenanceWindowList200JSONResponse(
+		gen.ListMaintenanceWindows{
+			Rows: rows,
+		},
+	), nil
+}
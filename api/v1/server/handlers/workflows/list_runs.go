@@ -7,6 +7,7 @@ import (
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/api/v1/server/serverutils"
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 )
@@ -18,8 +19,9 @@ func (t *WorkflowService) WorkflowRunList(ctx echo.Context, request gen.Workflow
 	offset := 0
 
 	listOpts := &repository.ListWorkflowRunsOpts{
-		Limit:  &limit,
-		Offset: &offset,
+		Limit:       &limit,
+		Offset:      &offset,
+		Environment: serverutils.GetEnvironment(ctx, request.Params.Environment),
 	}
 
 	if request.Params.Limit != nil {
@@ -42,6 +44,20 @@ func (t *WorkflowService) WorkflowRunList(ctx echo.Context, request gen.Workflow
 		listOpts.EventId = &eventIdStr
 	}
 
+	if request.Params.Status != nil {
+		status := db.WorkflowRunStatus(*request.Params.Status)
+		listOpts.Status = &status
+	}
+
+	if request.Params.CreatedAfter != nil {
+		listOpts.CreatedAfter = request.Params.CreatedAfter
+	}
+
+	if request.Params.CancelledSource != nil {
+		cancelledSource := db.CancellationSource(*request.Params.CancelledSource)
+		listOpts.CancelledSource = &cancelledSource
+	}
+
 	workflowRuns, err := t.config.Repository.WorkflowRun().ListWorkflowRuns(tenant.ID, listOpts)
 
 	if err != nil {
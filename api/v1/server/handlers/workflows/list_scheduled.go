@@ -0,0 +1,62 @@
+package workflows
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) WorkflowRunListScheduled(ctx echo.Context, request gen.WorkflowRunListScheduledRequestObject) (gen.WorkflowRunListScheduledResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	limit := 50
+	offset := 0
+
+	listOpts := &repository.ListScheduledWorkflowsOpts{
+		Limit:  &limit,
+		Offset: &offset,
+	}
+
+	if request.Params.Limit != nil {
+		limit = int(*request.Params.Limit)
+		listOpts.Limit = &limit
+	}
+
+	if request.Params.Offset != nil {
+		offset = int(*request.Params.Offset)
+		listOpts.Offset = &offset
+	}
+
+	if request.Params.WorkflowId != nil {
+		workflowIdStr := request.Params.WorkflowId.String()
+		listOpts.WorkflowId = &workflowIdStr
+	}
+
+	scheduled, err := t.config.Repository.Workflow().ListScheduledWorkflows(tenant.ID, listOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.ScheduledWorkflowRun, len(scheduled))
+
+	for i, scheduledRef := range scheduled {
+		scheduledCp := scheduledRef
+		res, err := transformers.ToScheduledWorkflowRun(&scheduledCp)
+
+		if err != nil {
+			return nil, err
+		}
+
+		rows[i] = *res
+	}
+
+	return gen.WorkflowRunListScheduled200JSONResponse(
+		gen.ScheduledWorkflowRunList{
+			Rows: &rows,
+		},
+	), nil
+}
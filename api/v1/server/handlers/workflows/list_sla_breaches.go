@@ -0,0 +1,68 @@
+package workflows
+
+import (
+	"math"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) WorkflowListSlaBreaches(ctx echo.Context, request gen.WorkflowListSlaBreachesRequestObject) (gen.WorkflowListSlaBreachesResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	workflow := ctx.Get("workflow").(*db.WorkflowModel)
+
+	limit := 50
+	offset := 0
+
+	listOpts := &repository.ListWorkflowRunSLABreachesOpts{
+		Limit:  &limit,
+		Offset: &offset,
+	}
+
+	if request.Params.Limit != nil {
+		limit = int(*request.Params.Limit)
+		listOpts.Limit = &limit
+	}
+
+	if request.Params.Offset != nil {
+		offset = int(*request.Params.Offset)
+		listOpts.Offset = &offset
+	}
+
+	breaches, err := t.config.Repository.WorkflowRun().ListWorkflowRunSLABreaches(tenant.ID, workflow.ID, listOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.WorkflowRunSLABreach, len(breaches.Rows))
+
+	for i, breach := range breaches.Rows {
+		breachCp := breach
+		rows[i] = *transformers.ToWorkflowRunSLABreach(breachCp)
+	}
+
+	// use the total rows and limit to calculate the total pages
+	totalPages := int64(math.Ceil(float64(breaches.Count) / float64(limit)))
+	currPage := 1 + int64(math.Ceil(float64(offset)/float64(limit)))
+	nextPage := currPage + 1
+
+	if currPage == totalPages {
+		nextPage = currPage
+	}
+
+	return gen.WorkflowListSlaBreaches200JSONResponse(
+		gen.WorkflowRunSLABreachList{
+			Rows: &rows,
+			Pagination: &gen.PaginationResponse{
+				NumPages:    &totalPages,
+				CurrentPage: &currPage,
+				NextPage:    &nextPage,
+			},
+		},
+	), nil
+}
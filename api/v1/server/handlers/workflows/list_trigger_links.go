@@ -0,0 +1,38 @@
+package workflows
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) TriggerLinkList(ctx echo.Context, request gen.TriggerLinkListRequestObject) (gen.TriggerLinkListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	workflow := ctx.Get("workflow").(*db.WorkflowModel)
+
+	links, err := t.config.Repository.TriggerLink().ListTriggerLinks(tenant.ID, workflow.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.TriggerLink, len(links))
+
+	for i := range links {
+		row, err := transformers.ToTriggerLink(&links[i])
+
+		if err != nil {
+			return nil, err
+		}
+
+		rows[i] = *row
+	}
+
+	return gen.TriggerLinkList200JSONResponse(
+		gen.ListTriggerLinks{
+			Rows: rows,
+		},
+	), nil
+}
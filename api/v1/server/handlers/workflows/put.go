@@ -0,0 +1,83 @@
+package workflows
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/admin"
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
+)
+
+func (t *WorkflowService) WorkflowPut(ctx echo.Context, request gen.WorkflowPutRequestObject) (gen.WorkflowPutResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	definition, err := types.ParseYAML(ctx.Request().Context(), []byte(request.Body.RawDefinition))
+
+	if err != nil {
+		return gen.WorkflowPut400JSONResponse(
+			apierrors.NewAPIErrors(fmt.Sprintf("could not parse workflow definition: %s", err.Error())),
+		), nil
+	}
+
+	createOpts, err := admin.CreateWorkflowOptsFromDefinition(&definition)
+
+	if err != nil {
+		return gen.WorkflowPut400JSONResponse(
+			apierrors.NewAPIErrors(fmt.Sprintf("invalid workflow definition: %s", err.Error())),
+		), nil
+	}
+
+	// the workflow and its event triggers are registered in the namespace of the token
+	if namespace, ok := ctx.Get("namespace").(string); ok {
+		if err := repository.ResolveWorkflowNamespace(namespace, createOpts); err != nil {
+			return gen.WorkflowPut403JSONResponse(
+				apierrors.NewAPIErrors(err.Error()),
+			), nil
+		}
+	}
+
+	if apiErrors, err := t.config.Validator.ValidateAPI(createOpts); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.WorkflowPut400JSONResponse(*apiErrors), nil
+	}
+
+	workflowVersion, err := admin.PutWorkflowVersion(ctx.Request().Context(), t.config.Repository, t.config.MessageQueue, tenant.ID, createOpts)
+
+	if err != nil {
+		if errors.Is(err, admin.ErrNoTickersAvailable) {
+			return gen.WorkflowPut400JSONResponse(
+				apierrors.NewAPIErrors("the workflow has cron or scheduled triggers, but there are no active tickers"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	workflow, err := t.config.Repository.Workflow().GetWorkflowByName(tenant.ID, createOpts.Name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	workflowVersion, err = t.config.Repository.Workflow().GetWorkflowVersionById(tenant.ID, workflowVersion.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := transformers.ToWorkflowVersion(workflow, workflowVersion)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkflowPut200JSONResponse(*resp), nil
+}
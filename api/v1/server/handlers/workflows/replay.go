@@ -0,0 +1,179 @@
+package workflows
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/limits"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+)
+
+func (t *WorkflowService) WorkflowRunCreateReplay(ctx echo.Context, request gen.WorkflowRunCreateReplayRequestObject) (gen.WorkflowRunCreateReplayResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	workflowRun := ctx.Get("workflow-run").(*db.WorkflowRunModel)
+
+	// the populated workflow run does not include the job runs
+	replayedRun, err := t.config.Repository.WorkflowRun().GetWorkflowRunById(tenant.ID, workflowRun.ID)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get workflow run: %w", err)
+	}
+
+	replayOpts := &repository.CreateWorkflowRunReplayOpts{
+		WorkflowRunId: replayedRun.ID,
+	}
+
+	if request.Body.StartFromStepId != nil {
+		startFromStepId := request.Body.StartFromStepId.String()
+
+		if !hasStep(replayedRun, startFromStepId) {
+			return gen.WorkflowRunCreateReplay400JSONResponse(
+				apierrors.NewAPIErrors("startFromStepId is not a step of the workflow run"),
+			), nil
+		}
+
+		replayOpts.StartFromStepId = &startFromStepId
+	}
+
+	var inputBytes []byte
+
+	if request.Body.Input != nil {
+		// make sure input can be marshalled and unmarshalled to input type
+		inputBytes, err = json.Marshal(request.Body.Input)
+
+		if err != nil {
+			return gen.WorkflowRunCreateReplay400JSONResponse(
+				apierrors.NewAPIErrors("Invalid input"),
+			), nil
+		}
+
+		if err := t.config.PayloadLimits.Check(limits.PayloadKindWorkflowInput, len(inputBytes)); err != nil {
+			return nil, err
+		}
+	} else {
+		inputBytes, err = t.getWorkflowRunInput(tenant.ID, replayedRun)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	workflowVersion, err := t.config.Repository.Workflow().GetWorkflowVersionById(tenant.ID, replayedRun.WorkflowVersionID)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get workflow version: %w", err)
+	}
+
+	createOpts, err := repository.GetCreateWorkflowRunOptsFromManual(workflowVersion, inputBytes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	createOpts.Replay = replayOpts
+
+	if additionalMetadata, ok := replayedRun.AdditionalMetadata(); ok {
+		createOpts.AdditionalMetadata = []byte(additionalMetadata)
+	}
+
+	// the replay runs in the environment of the replayed run
+	if environment, ok := replayedRun.Environment(); ok {
+		createOpts.Environment = &environment
+	}
+
+	// the run is traced back to this request by its correlation id
+	if correlationId := msgqueue.CorrelationIDFromContext(ctx.Request().Context()); correlationId != "" {
+		createOpts.CorrelationId = &correlationId
+	}
+
+	replay, err := t.config.Repository.WorkflowRun().CreateNewWorkflowRun(ctx.Request().Context(), tenant.ID, createOpts)
+
+	if errors.Is(err, repository.ErrWorkflowPaused) {
+		return gen.WorkflowRunCreateReplay400JSONResponse(
+			apierrors.NewAPIErrors(err.Error()),
+		), nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create workflow run: %w", err)
+	}
+
+	// send to workflow processing queue
+	err = t.config.MessageQueue.AddMessage(
+		ctx.Request().Context(),
+		msgqueue.WORKFLOW_PROCESSING_QUEUE,
+		tasktypes.WorkflowRunQueuedToTask(replay),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not add workflow run to queue: %w", err)
+	}
+
+	res, err := transformers.ToWorkflowRun(replay)
+
+	if err != nil {
+		return nil, err
+	}
+
+	transformers.RedactWorkflowRun(res, transformers.RedactionRules(tenant))
+
+	return gen.WorkflowRunCreateReplay200JSONResponse(
+		*res,
+	), nil
+}
+
+// getWorkflowRunInput returns the input of a workflow run, which is stored in the lookup data of each of its
+// job runs.
+func (t *WorkflowService) getWorkflowRunInput(tenantId string, workflowRun *db.WorkflowRunModel) ([]byte, error) {
+	jobRuns := workflowRun.JobRuns()
+
+	if len(jobRuns) == 0 {
+		return []byte("{}"), nil
+	}
+
+	jobRun, err := t.config.Repository.JobRun().GetJobRunById(tenantId, jobRuns[0].ID)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get job run: %w", err)
+	}
+
+	lookupDataModel, ok := jobRun.LookupData()
+
+	if !ok {
+		return []byte("{}"), nil
+	}
+
+	lookupData := &datautils.JobRunLookupData{}
+
+	if err := json.Unmarshal(lookupDataModel.Data, lookupData); err != nil {
+		return nil, fmt.Errorf("could not unmarshal job run lookup data: %w", err)
+	}
+
+	if lookupData.Input == nil {
+		return []byte("{}"), nil
+	}
+
+	return json.Marshal(lookupData.Input)
+}
+
+func hasStep(workflowRun *db.WorkflowRunModel, stepId string) bool {
+	for _, jobRun := range workflowRun.JobRuns() {
+		for _, step := range jobRun.Job().Steps() {
+			if step.ID == stepId {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,216 @@
+package workflows
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/features"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/limits"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+)
+
+func (t *WorkflowService) TriggerLinkRun(ctx echo.Context, request gen.TriggerLinkRunRequestObject) (gen.TriggerLinkRunResponseObject, error) {
+	link, err := t.config.Repository.TriggerLink().GetTriggerLinkById(request.TriggerLink.String())
+
+	// a link which doesn't exist is indistinguishable from an invalid token, so that ids can't be probed
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		return nil, err
+	}
+
+	tokenHash := repository.HashTriggerLinkToken(request.Params.Token)
+
+	if link == nil || subtle.ConstantTimeCompare([]byte(tokenHash), []byte(link.TokenHash)) != 1 {
+		return gen.TriggerLinkRun403JSONResponse(
+			apierrors.NewAPIErrors("invalid trigger link or token"),
+		), nil
+	}
+
+	body, err := io.ReadAll(ctx.Request().Body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := mergeTriggerLinkInput(link, ctx.Request().Header.Get(echo.HeaderContentType), body)
+
+	if err != nil {
+		return gen.TriggerLinkRun400JSONResponse(
+			apierrors.NewAPIErrors(err.Error()),
+		), nil
+	}
+
+	inputBytes, err := json.Marshal(input)
+
+	if err != nil {
+		return gen.TriggerLinkRun400JSONResponse(
+			apierrors.NewAPIErrors("Invalid input"),
+		), nil
+	}
+
+	if err := t.config.PayloadLimits.Check(limits.PayloadKindWorkflowInput, len(inputBytes)); err != nil {
+		return nil, err
+	}
+
+	hint, err := t.config.Backpressure.Check(link.TenantID)
+
+	if err != nil {
+		// backpressure is best-effort, so the trigger is accepted if the queue depth can't be checked
+		t.config.Logger.Warn().Err(err).Msg("could not check backpressure")
+	}
+
+	if hint != nil {
+		setBackpressureHeaders(ctx, hint)
+
+		// trigger links can't mark triggers as priority, so they are shed like any other trigger
+		if hint.Reject(false) && t.config.FeatureFlags.Enabled(link.TenantID, features.TriggerShedding) {
+			code := uint64(apierrors.CodeTriggerShed)
+
+			return gen.TriggerLinkRun429JSONResponse{
+				Errors: []gen.APIError{
+					{
+						Code:        &code,
+						Description: "the queue of the tenant is backed up, so only priority triggers are accepted",
+					},
+				},
+			}, nil
+		}
+	}
+
+	workflow, err := t.config.Repository.Workflow().GetWorkflowById(link.WorkflowID)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get workflow of trigger link: %w", err)
+	}
+
+	versions := workflow.Versions()
+
+	if len(versions) == 0 {
+		return gen.TriggerLinkRun400JSONResponse(
+			apierrors.NewAPIErrors("workflow has no versions"),
+		), nil
+	}
+
+	// the latest version only receives part of the triggers while it's rolled out
+	resolved, err := t.config.Repository.Workflow().ResolveWorkflowVersions(ctx.Request().Context(), link.TenantID, []string{versions[0].ID})
+
+	if err != nil {
+		return nil, err
+	}
+
+	workflowVersion, err := t.config.Repository.Workflow().GetWorkflowVersionById(link.TenantID, resolved[0])
+
+	if err != nil {
+		return nil, err
+	}
+
+	createOpts, err := repository.GetCreateWorkflowRunOptsFromManual(workflowVersion, inputBytes)
+
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidWorkflowRunInput) {
+			return gen.TriggerLinkRun400JSONResponse(
+				apierrors.NewAPIErrors(err.Error()),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	// the run is traced back to this request by its correlation id
+	if correlationId := msgqueue.CorrelationIDFromContext(ctx.Request().Context()); correlationId != "" {
+		createOpts.CorrelationId = &correlationId
+	}
+
+	workflowRun, err := t.config.Repository.WorkflowRun().CreateNewWorkflowRun(ctx.Request().Context(), link.TenantID, createOpts)
+
+	if errors.Is(err, repository.ErrWorkflowPaused) {
+		return gen.TriggerLinkRun400JSONResponse(
+			apierrors.NewAPIErrors(err.Error()),
+		), nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create workflow run: %w", err)
+	}
+
+	// send to workflow processing queue
+	err = t.config.MessageQueue.AddMessage(
+		ctx.Request().Context(),
+		msgqueue.WORKFLOW_PROCESSING_QUEUE,
+		tasktypes.WorkflowRunQueuedToTask(workflowRun),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not add workflow run to queue: %w", err)
+	}
+
+	if err := t.config.Repository.TriggerLink().UpdateTriggerLinkTriggered(link.ID, time.Now().UTC()); err != nil {
+		t.config.Logger.Error().Err(err).Msgf("could not update trigger link %s", link.ID)
+	}
+
+	return gen.TriggerLinkRun200JSONResponse(
+		gen.TriggerLinkRunResult{
+			WorkflowRunId: uuid.MustParse(workflowRun.ID),
+		},
+	), nil
+}
+
+// mergeTriggerLinkInput merges the body of a trigger request onto the default input of the link. The body
+// is either a JSON object or a form, since that's what most third-party tools send. Keys in the body override
+// keys of the default input, and nested objects are not merged.
+func mergeTriggerLinkInput(link *db.WorkflowTriggerLinkModel, contentType string, body []byte) (map[string]interface{}, error) {
+	input := map[string]interface{}{}
+
+	if defaultInput, ok := link.DefaultInput(); ok && defaultInput != nil {
+		if err := json.Unmarshal(defaultInput, &input); err != nil {
+			return nil, fmt.Errorf("could not unmarshal default input: %w", err)
+		}
+	}
+
+	if len(strings.TrimSpace(string(body))) == 0 {
+		return input, nil
+	}
+
+	if strings.HasPrefix(contentType, echo.MIMEApplicationForm) {
+		values, err := url.ParseQuery(string(body))
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid form body: %w", err)
+		}
+
+		for k, v := range values {
+			if len(v) == 1 {
+				input[k] = v[0]
+			} else {
+				input[k] = v
+			}
+		}
+
+		return input, nil
+	}
+
+	bodyInput := map[string]interface{}{}
+
+	if err := json.Unmarshal(body, &bodyInput); err != nil {
+		return nil, fmt.Errorf("body must be a JSON object or a form")
+	}
+
+	for k, v := range bodyInput {
+		input[k] = v
+	}
+
+	return input, nil
+}
@@ -4,15 +4,19 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/api/v1/server/serverutils"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/features"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/limits"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 )
 
@@ -33,7 +37,54 @@ func (t *WorkflowService) WorkflowRunCreate(ctx echo.Context, request gen.Workfl
 			), nil
 		}
 
-		workflowVersionId = versions[0].ID
+		// the latest version only receives part of the triggers while it's rolled out
+		resolved, err := t.config.Repository.Workflow().ResolveWorkflowVersions(ctx.Request().Context(), tenant.ID, []string{versions[0].ID})
+
+		if err != nil {
+			return nil, err
+		}
+
+		workflowVersionId = resolved[0]
+	}
+
+	wait := request.Params.Wait != nil && *request.Params.Wait
+	timeout := defaultTriggerWaitTimeout
+
+	if request.Params.TimeoutSeconds != nil {
+		timeout = time.Duration(*request.Params.TimeoutSeconds) * time.Second
+
+		if timeout <= 0 || timeout > maxTriggerWaitTimeout {
+			return gen.WorkflowRunCreate400JSONResponse(
+				apierrors.NewAPIErrors(fmt.Sprintf("timeoutSeconds must be between 1 and %d", int(maxTriggerWaitTimeout.Seconds()))),
+			), nil
+		}
+	}
+
+	priority := request.Body.Priority != nil && *request.Body.Priority
+
+	hint, err := t.config.Backpressure.Check(tenant.ID)
+
+	if err != nil {
+		// backpressure is best-effort, so the trigger is accepted if the queue depth can't be checked
+		t.config.Logger.Warn().Err(err).Msg("could not check backpressure")
+	}
+
+	if hint != nil {
+		setBackpressureHeaders(ctx, hint)
+
+		if hint.Reject(priority) && t.config.FeatureFlags.Enabled(tenant.ID, features.TriggerShedding) {
+			code := uint64(apierrors.CodeTriggerShed)
+
+			return gen.WorkflowRunCreate429JSONResponse{
+				Errors: []gen.APIError{
+					{
+						Code:        &code,
+						Description: "the queue of the tenant is backed up, so only priority triggers are accepted",
+					},
+				},
+				Backpressure: *transformers.ToBackpressureHint(hint),
+			}, nil
+		}
 	}
 
 	workflowVersion, err := t.config.Repository.Workflow().GetWorkflowVersionById(tenant.ID, workflowVersionId)
@@ -57,18 +108,70 @@ func (t *WorkflowService) WorkflowRunCreate(ctx echo.Context, request gen.Workfl
 		), nil
 	}
 
+	if err := t.config.PayloadLimits.Check(limits.PayloadKindWorkflowInput, len(inputBytes)); err != nil {
+		return nil, err
+	}
+
 	createOpts, err := repository.GetCreateWorkflowRunOptsFromManual(workflowVersion, inputBytes)
 
 	if err != nil {
+		if errors.Is(err, repository.ErrInvalidWorkflowRunInput) {
+			return gen.WorkflowRunCreate400JSONResponse(
+				apierrors.NewAPIErrors(err.Error()),
+			), nil
+		}
+
 		return nil, err
 	}
 
+	createOpts.Environment = serverutils.GetEnvironment(ctx, request.Body.Environment)
+
+	if request.Body.AdditionalMetadata != nil {
+		createOpts.AdditionalMetadata, err = json.Marshal(request.Body.AdditionalMetadata)
+
+		if err != nil {
+			return gen.WorkflowRunCreate400JSONResponse(
+				apierrors.NewAPIErrors("Invalid additional metadata"),
+			), nil
+		}
+	}
+
+	// the run is traced back to this request by its correlation id
+	if correlationId := msgqueue.CorrelationIDFromContext(ctx.Request().Context()); correlationId != "" {
+		createOpts.CorrelationId = &correlationId
+	}
+
 	workflowRun, err := t.config.Repository.WorkflowRun().CreateNewWorkflowRun(ctx.Request().Context(), tenant.ID, createOpts)
 
+	if errors.Is(err, repository.ErrWorkflowPaused) {
+		return gen.WorkflowRunCreate400JSONResponse(
+			apierrors.NewAPIErrors(err.Error()),
+		), nil
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("could not create workflow run: %w", err)
 	}
 
+	// subscribe before the run is queued so that the finished event can't be missed
+	var finished <-chan struct{}
+
+	if wait {
+		var cleanupQueue func() error
+
+		finished, cleanupQueue, err = t.subscribeToWorkflowRunFinished(tenant.ID, workflowRun.ID)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not subscribe to workflow run events: %w", err)
+		}
+
+		defer func() {
+			if err := cleanupQueue(); err != nil {
+				t.config.Logger.Error().Err(err).Msg("could not cleanup workflow run subscription")
+			}
+		}()
+	}
+
 	// send to workflow processing queue
 	err = t.config.MessageQueue.AddMessage(
 		ctx.Request().Context(),
@@ -80,12 +183,30 @@ func (t *WorkflowService) WorkflowRunCreate(ctx echo.Context, request gen.Workfl
 		return nil, fmt.Errorf("could not add workflow run to queue: %w", err)
 	}
 
+	if wait {
+		select {
+		case <-finished:
+		case <-time.After(timeout):
+		case <-ctx.Request().Context().Done():
+		}
+
+		// re-read the run so the response contains the final status and step run outputs. if the
+		// wait timed out, this returns the run in its current state.
+		workflowRun, err = t.config.Repository.WorkflowRun().GetWorkflowRunById(tenant.ID, workflowRun.ID)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not get workflow run: %w", err)
+		}
+	}
+
 	res, err := transformers.ToWorkflowRun(workflowRun)
 
 	if err != nil {
 		return nil, err
 	}
 
+	transformers.RedactWorkflowRun(res, transformers.RedactionRules(tenant))
+
 	return gen.WorkflowRunCreate200JSONResponse(
 		*res,
 	), nil
@@ -0,0 +1,40 @@
+package workflows
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) WorkflowUpdateDiagnostics(ctx echo.Context, request gen.WorkflowUpdateDiagnosticsRequestObject) (gen.WorkflowUpdateDiagnosticsResponseObject, error) {
+	workflow := ctx.Get("workflow").(*db.WorkflowModel)
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.WorkflowUpdateDiagnostics400JSONResponse(*apiErrors), nil
+	}
+
+	workflow, err := t.config.Repository.Workflow().UpdateWorkflowDiagnostics(
+		workflow.ID,
+		&repository.UpdateWorkflowDiagnosticsOpts{
+			SampleRate: request.Body.SampleRate,
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := transformers.ToWorkflow(workflow, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkflowUpdateDiagnostics200JSONResponse(*resp), nil
+}
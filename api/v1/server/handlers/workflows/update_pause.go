@@ -0,0 +1,73 @@
+package workflows
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+)
+
+func (t *WorkflowService) WorkflowUpdatePause(ctx echo.Context, request gen.WorkflowUpdatePauseRequestObject) (gen.WorkflowUpdatePauseResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	workflow := ctx.Get("workflow").(*db.WorkflowModel)
+
+	opts := &repository.PauseOpts{}
+
+	if request.Body.TriggerBehavior != nil {
+		triggerBehavior := string(*request.Body.TriggerBehavior)
+		opts.TriggerBehavior = &triggerBehavior
+	}
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(opts); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.WorkflowUpdatePause400JSONResponse(*apiErrors), nil
+	}
+
+	err := t.config.Repository.Workflow().PauseWorkflow(ctx.Request().Context(), tenant.ID, workflow.ID, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Body.CancelRuns != nil && *request.Body.CancelRuns {
+		stepRunIds, err := t.config.Repository.StepRun().ListCancellableStepRuns(ctx.Request().Context(), tenant.ID, &workflow.ID)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not list step runs to cancel: %w", err)
+		}
+
+		for _, stepRunId := range stepRunIds {
+			err = t.config.MessageQueue.AddMessage(
+				ctx.Request().Context(),
+				msgqueue.JOB_PROCESSING_QUEUE,
+				tasktypes.StepRunNotifyCancelToTask(tenant.ID, stepRunId, "CANCELLED_BY_PAUSE"),
+			)
+
+			if err != nil {
+				return nil, fmt.Errorf("could not add step run cancellation to queue: %w", err)
+			}
+		}
+	}
+
+	workflow, err = t.config.Repository.Workflow().GetWorkflowById(workflow.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := transformers.ToWorkflow(workflow, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkflowUpdatePause200JSONResponse(*resp), nil
+}
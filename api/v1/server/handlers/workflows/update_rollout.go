@@ -0,0 +1,41 @@
+package workflows
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) WorkflowUpdateRollout(ctx echo.Context, request gen.WorkflowUpdateRolloutRequestObject) (gen.WorkflowUpdateRolloutResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	workflow := ctx.Get("workflow").(*db.WorkflowModel)
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.WorkflowUpdateRollout400JSONResponse(*apiErrors), nil
+	}
+
+	rollout, err := t.config.Repository.Workflow().UpsertWorkflowRollout(
+		tenant.ID,
+		workflow.ID,
+		&repository.UpsertWorkflowRolloutOpts{
+			Percentage:           request.Body.Percentage,
+			FailureRateThreshold: request.Body.FailureRateThreshold,
+			WindowSeconds:        request.Body.WindowSeconds,
+			MinRuns:              request.Body.MinRuns,
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkflowUpdateRollout200JSONResponse(
+		*transformers.ToWorkflowRollout(rollout),
+	), nil
+}
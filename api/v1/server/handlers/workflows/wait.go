@@ -0,0 +1,56 @@
+package workflows
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+)
+
+const (
+	defaultTriggerWaitTimeout = 30 * time.Second
+	maxTriggerWaitTimeout     = 300 * time.Second
+)
+
+// subscribeToWorkflowRunFinished subscribes to the tenant's event queue and returns a channel which is
+// closed once a workflow-run-finished message is received for the given workflow run.
+func (t *WorkflowService) subscribeToWorkflowRunFinished(tenantId, workflowRunId string) (<-chan struct{}, func() error, error) {
+	q, err := msgqueue.TenantEventConsumerQueue(tenantId)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	finished := make(chan struct{})
+	once := sync.Once{}
+
+	f := func(task *msgqueue.Message) error {
+		tasks, err := msgqueue.UnbatchTenantMessage(task)
+
+		if err != nil {
+			return err
+		}
+
+		for _, task := range tasks {
+			if task.ID != "workflow-run-finished" {
+				continue
+			}
+
+			if id, ok := task.Payload["workflow_run_id"].(string); ok && id == workflowRunId {
+				once.Do(func() {
+					close(finished)
+				})
+			}
+		}
+
+		return nil
+	}
+
+	cleanupQueue, err := t.config.MessageQueue.Subscribe(q, msgqueue.NoOpHook, f)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return finished, cleanupQueue, nil
+}
@@ -0,0 +1,204 @@
+package idempotency
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/internal/config/server"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+const (
+	HeaderIdempotencyKey = "Idempotency-Key"
+
+	// HeaderIdempotentReplayed is set on responses which were replayed from a stored response
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+
+	// KeyTTL is how long a stored response is replayed for
+	KeyTTL = 24 * time.Hour
+
+	maxKeyLength = 255
+
+	responseDataId = "idempotency_response"
+)
+
+type Idempotency struct {
+	config *server.ServerConfig
+}
+
+func NewIdempotency(config *server.ServerConfig) *Idempotency {
+	return &Idempotency{
+		config: config,
+	}
+}
+
+// Middleware replays the stored response for requests to idempotent operations which repeat an
+// Idempotency-Key. It must run after the route info and tenant have been populated.
+func (i *Idempotency) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		key := c.Request().Header.Get(HeaderIdempotencyKey)
+
+		if key == "" {
+			return next(c)
+		}
+
+		routeInfo, ok := c.Get(middleware.RouteInfoKey).(*middleware.RouteInfo)
+
+		if !ok || !routeInfo.Idempotent {
+			return next(c)
+		}
+
+		tenant, ok := c.Get("tenant").(*db.TenantModel)
+
+		if !ok {
+			return next(c)
+		}
+
+		if len(key) > maxKeyLength {
+			return c.JSON(http.StatusBadRequest, apierrors.NewAPIErrors(fmt.Sprintf("%s must be at most %d characters", HeaderIdempotencyKey, maxKeyLength)))
+		}
+
+		fingerprint, err := fingerprintRequest(c)
+
+		if err != nil {
+			return err
+		}
+
+		existing, err := i.config.Repository.IdempotencyKey().GetIdempotencyKey(tenant.ID, key)
+
+		if err != nil && !errors.Is(err, db.ErrNotFound) {
+			return fmt.Errorf("could not get idempotency key: %w", err)
+		}
+
+		if existing != nil {
+			return i.replay(c, existing, fingerprint)
+		}
+
+		_, err = i.config.Repository.IdempotencyKey().CreateIdempotencyKey(tenant.ID, &repository.CreateIdempotencyKeyOpts{
+			Key:         key,
+			Fingerprint: fingerprint,
+			ExpiresAt:   time.Now().UTC().Add(KeyTTL),
+		})
+
+		if err != nil {
+			// another request may have reserved the key concurrently
+			if existing, getErr := i.config.Repository.IdempotencyKey().GetIdempotencyKey(tenant.ID, key); getErr == nil {
+				return i.replay(c, existing, fingerprint)
+			}
+
+			return fmt.Errorf("could not create idempotency key: %w", err)
+		}
+
+		recorder := &responseRecorder{
+			ResponseWriter: c.Response().Writer,
+		}
+
+		c.Response().Writer = recorder
+
+		err = next(c)
+
+		// errors, rejected requests and server errors are not stored, so the request can be retried with the same key
+		if err != nil || c.Response().Status == http.StatusTooManyRequests || c.Response().Status >= http.StatusInternalServerError {
+			if deleteErr := i.config.Repository.IdempotencyKey().DeleteIdempotencyKey(tenant.ID, key); deleteErr != nil {
+				i.config.Logger.Err(deleteErr).Msg("could not delete idempotency key")
+			}
+
+			return err
+		}
+
+		// stored responses can contain secrets, such as newly created API tokens
+		encrypted, err := i.config.Encryption.Encrypt(recorder.body.Bytes(), responseDataId)
+
+		if err != nil {
+			return fmt.Errorf("could not encrypt idempotent response: %w", err)
+		}
+
+		contentType := c.Response().Header().Get(echo.HeaderContentType)
+
+		err = i.config.Repository.IdempotencyKey().UpdateIdempotencyKeyResponse(tenant.ID, key, &repository.UpdateIdempotencyKeyResponseOpts{
+			Status:      c.Response().Status,
+			ContentType: &contentType,
+			Body:        encrypted,
+		})
+
+		if err != nil {
+			i.config.Logger.Err(err).Msg("could not store idempotent response")
+		}
+
+		return nil
+	}
+}
+
+func (i *Idempotency) replay(c echo.Context, existing *db.IdempotencyKeyModel, fingerprint string) error {
+	if existing.Fingerprint != fingerprint {
+		return c.JSON(http.StatusUnprocessableEntity, apierrors.NewAPIErrors(fmt.Sprintf("%s was already used for a different request", HeaderIdempotencyKey)))
+	}
+
+	status, ok := existing.ResponseStatus()
+
+	if !ok {
+		return c.JSON(http.StatusConflict, apierrors.NewAPIErrors(fmt.Sprintf("a request with this %s is still in progress", HeaderIdempotencyKey)))
+	}
+
+	var body []byte
+
+	if encrypted, ok := existing.ResponseBody(); ok {
+		var err error
+
+		body, err = i.config.Encryption.Decrypt(encrypted, responseDataId)
+
+		if err != nil {
+			return fmt.Errorf("could not decrypt idempotent response: %w", err)
+		}
+	}
+
+	contentType, _ := existing.ResponseContentType()
+
+	c.Response().Header().Set(HeaderIdempotentReplayed, "true")
+
+	return c.Blob(status, contentType, body)
+}
+
+// fingerprintRequest hashes the method, path and body of the request, restoring the body so that the
+// handler can read it.
+func fingerprintRequest(c echo.Context) (string, error) {
+	req := c.Request()
+
+	body, err := io.ReadAll(req.Body)
+
+	if err != nil {
+		return "", err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	h := sha256.New()
+	h.Write([]byte(req.Method + " " + req.URL.Path + "\n"))
+	h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// responseRecorder writes the response through to the client while keeping a copy of the body.
+type responseRecorder struct {
+	http.ResponseWriter
+
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+
+	return r.ResponseWriter.Write(b)
+}
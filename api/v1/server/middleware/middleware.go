@@ -3,6 +3,7 @@ package middleware
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/getkin/kin-openapi/routers"
@@ -18,10 +19,34 @@ type SecurityRequirement interface {
 	BearerAuth() bool
 }
 
+// RouteInfoKey is the echo context key which the route info for the current request is stored under.
+const RouteInfoKey = "route-info"
+
 type RouteInfo struct {
 	OperationID string
 	Security    SecurityRequirement
 	Resources   []string
+
+	// Idempotent is true when the operation accepts an Idempotency-Key header
+	Idempotent bool
+}
+
+// OperationIn returns whether the operation is one of the operation ids, which are given as the names of the
+// generated handlers. For example, the api-token:list operation matches ApiTokenList.
+func (r *RouteInfo) OperationIn(operationIds []string) bool {
+	normalized := normalizeOperationID(r.OperationID)
+
+	for _, id := range operationIds {
+		if strings.EqualFold(normalized, normalizeOperationID(id)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func normalizeOperationID(operationId string) string {
+	return strings.NewReplacer(":", "", "-", "").Replace(operationId)
 }
 
 type securityRequirement struct {
@@ -161,18 +186,28 @@ func (m *MiddlewareHandler) Middleware() (echo.MiddlewareFunc, error) {
 					}
 				}
 
+				var isIdempotent bool
+
+				// read x-idempotent from the operation
+				if xIdempotent := route.Operation.Extensions["x-idempotent"]; xIdempotent != nil {
+					isIdempotent = xIdempotent.(bool)
+				}
+
 				routeInfo = &RouteInfo{
 					OperationID: route.Operation.OperationID,
 					Security: &securityRequirement{
 						requirements:      *security,
 						xSecurityOptional: isOptional,
 					},
-					Resources: resources,
+					Resources:  resources,
+					Idempotent: isIdempotent,
 				}
 
 				m.cache.Add(getCacheKey(req), routeInfo)
 			}
 
+			c.Set(RouteInfoKey, routeInfo)
+
 			for _, m := range m.mws {
 				if err := m(routeInfo)(c); err != nil {
 					return err
@@ -0,0 +1,186 @@
+package apierrors
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/limits"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/webhooks"
+)
+
+// Code is a machine-readable Hatchet error code, returned as the code of an APIError. Generic codes are
+// 1000 plus the HTTP status code, while codes for specific conditions start at 2000.
+type Code uint64
+
+const (
+	CodeBadRequest          Code = 1400
+	CodeUnauthorized        Code = 1401
+	CodeForbidden           Code = 1403
+	CodeNotFound            Code = 1404
+	CodeConflict            Code = 1409
+	CodeUnprocessableEntity Code = 1422
+	CodeTooManyRequests     Code = 1429
+	CodeInternal            Code = 1500
+	CodeUnavailable         Code = 1503
+
+	// CodeGithubAppNotConfigured is returned when no Github app is set up on this Hatchet instance
+	CodeGithubAppNotConfigured Code = 2000
+
+	// CodeGithubWebhookSignatureInvalid is returned when a Github webhook payload does not match its signature
+	CodeGithubWebhookSignatureInvalid Code = 2001
+
+	// CodeGithubWebhookEventInvalid is returned when a Github webhook event cannot be parsed
+	CodeGithubWebhookEventInvalid Code = 2002
+
+	// CodeTriggerShed is returned when a trigger is rejected because the engine is shedding load
+	CodeTriggerShed Code = 2003
+
+	// CodePayloadTooLarge is returned when an event payload or a workflow input exceeds its size limit
+	CodePayloadTooLarge Code = 2004
+
+	// CodeGiteaWebhookSignatureInvalid is returned when a Gitea webhook payload does not match its signature
+	CodeGiteaWebhookSignatureInvalid Code = 2005
+
+	// CodeGiteaWebhookEventInvalid is returned when a Gitea webhook event cannot be parsed
+	CodeGiteaWebhookEventInvalid Code = 2006
+
+	// CodeWebhookDeliveryReplayed is returned when a webhook already received a delivery with the same payload
+	CodeWebhookDeliveryReplayed Code = 2007
+
+	// CodeWebhookDeliveryExpired is returned when a webhook delivery was sent outside of the replay window
+	CodeWebhookDeliveryExpired Code = 2008
+)
+
+// Error is an error returned from a handler which is written as an APIErrors response with the
+// given status and code, instead of an opaque internal server error.
+type Error struct {
+	Status      int
+	Code        Code
+	Description string
+	Field       string
+
+	// Err is the underlying error, which is logged but never returned to the client
+	Err error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %s", e.Description, e.Err.Error())
+	}
+
+	return e.Description
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func NewError(status int, code Code, description string) *Error {
+	return &Error{
+		Status:      status,
+		Code:        code,
+		Description: description,
+	}
+}
+
+// Wrap sets the underlying error of the API error.
+func (e *Error) Wrap(err error) *Error {
+	e.Err = err
+	return e
+}
+
+func BadRequest(description string) *Error {
+	return NewError(http.StatusBadRequest, CodeBadRequest, description)
+}
+
+func NotFound(description string) *Error {
+	return NewError(http.StatusNotFound, CodeNotFound, description)
+}
+
+func Internal(err error) *Error {
+	return NewError(http.StatusInternalServerError, CodeInternal, "Internal error").Wrap(err)
+}
+
+// ToAPIErrors converts an error returned from a handler or middleware to a response status and body.
+// Errors which are not an *Error or *echo.HTTPError are treated as internal errors, unless they wrap
+// db.ErrNotFound or a rejected webhook delivery.
+func ToAPIErrors(err error, correlationId string) (int, gen.APIErrors) {
+	var apiErr *Error
+	var httpErr *echo.HTTPError
+	var payloadErr *limits.PayloadTooLargeError
+
+	switch {
+	case errors.As(err, &apiErr):
+	case errors.As(err, &httpErr):
+		apiErr = NewError(httpErr.Code, codeForStatus(httpErr.Code), fmt.Sprintf("%v", httpErr.Message))
+	case errors.As(err, &payloadErr):
+		apiErr = NewError(http.StatusRequestEntityTooLarge, CodePayloadTooLarge, payloadErr.Error())
+	case errors.Is(err, webhooks.ErrReplayed):
+		apiErr = NewError(http.StatusBadRequest, CodeWebhookDeliveryReplayed, err.Error())
+	case errors.Is(err, webhooks.ErrExpired):
+		apiErr = NewError(http.StatusBadRequest, CodeWebhookDeliveryExpired, err.Error())
+	case errors.Is(err, db.ErrNotFound):
+		apiErr = NotFound("Resource not found")
+	default:
+		apiErr = Internal(err)
+	}
+
+	code := uint64(apiErr.Code)
+
+	resErr := gen.APIError{
+		Code:        &code,
+		Description: apiErr.Description,
+	}
+
+	if apiErr.Field != "" {
+		resErr.Field = &apiErr.Field
+	}
+
+	if correlationId != "" {
+		resErr.CorrelationId = &correlationId
+	}
+
+	return apiErr.Status, gen.APIErrors{
+		Errors: []gen.APIError{resErr},
+	}
+}
+
+// HTTPErrorHandler writes errors as APIErrors responses. Internal errors are logged with their underlying
+// cause, which is not returned to the client.
+func HTTPErrorHandler(l *zerolog.Logger) echo.HTTPErrorHandler {
+	return func(err error, c echo.Context) {
+		if c.Response().Committed {
+			return
+		}
+
+		correlationId := c.Response().Header().Get(echo.HeaderXRequestID)
+
+		status, body := ToAPIErrors(err, correlationId)
+
+		if status >= http.StatusInternalServerError {
+			l.Err(err).Str("correlation_id", correlationId).Msgf("%s %s failed", c.Request().Method, c.Path())
+		}
+
+		var writeErr error
+
+		if c.Request().Method == http.MethodHead {
+			writeErr = c.NoContent(status)
+		} else {
+			writeErr = c.JSON(status, body)
+		}
+
+		if writeErr != nil {
+			l.Err(writeErr).Msg("could not write error response")
+		}
+	}
+}
+
+func codeForStatus(status int) Code {
+	return Code(1000 + status)
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -26,6 +27,50 @@ const (
 	CookieAuthScopes = "cookieAuth.Scopes"
 )
 
+// Defines values for APITokenScope.
+const (
+	APITokenScopeRead   APITokenScope = "read"
+	APITokenScopeTokens APITokenScope = "tokens"
+	APITokenScopeWorker APITokenScope = "worker"
+	APITokenScopeWrite  APITokenScope = "write"
+)
+
+// Defines values for AdminMaintenanceJob.
+const (
+	STEPRUNREASSIGN AdminMaintenanceJob = "STEP_RUN_REASSIGN"
+	STEPRUNREQUEUE  AdminMaintenanceJob = "STEP_RUN_REQUEUE"
+)
+
+// Defines values for CancellationSource.
+const (
+	BUDGETEXCEEDED  CancellationSource = "BUDGET_EXCEEDED"
+	CONCURRENCY     CancellationSource = "CONCURRENCY"
+	JOINSATISFIED   CancellationSource = "JOIN_SATISFIED"
+	PARENTCANCELLED CancellationSource = "PARENT_CANCELLED"
+	TIMEOUT         CancellationSource = "TIMEOUT"
+	USER            CancellationSource = "USER"
+)
+
+// Defines values for CostCenterUsageBucket.
+const (
+	Day  CostCenterUsageBucket = "day"
+	Hour CostCenterUsageBucket = "hour"
+	Week CostCenterUsageBucket = "week"
+)
+
+// Defines values for CreateConcurrencySimulationRequestLimitStrategy.
+const (
+	CreateConcurrencySimulationRequestLimitStrategyCANCELINPROGRESS CreateConcurrencySimulationRequestLimitStrategy = "CANCEL_IN_PROGRESS"
+	CreateConcurrencySimulationRequestLimitStrategyGROUPROUNDROBIN  CreateConcurrencySimulationRequestLimitStrategy = "GROUP_ROUND_ROBIN"
+)
+
+// Defines values for EventBusTopic.
+const (
+	StepRunFailed       EventBusTopic = "step-run-failed"
+	WorkerRegistered    EventBusTopic = "worker-registered"
+	WorkflowRunFinished EventBusTopic = "workflow-run-finished"
+)
+
 // Defines values for EventOrderByDirection.
 const (
 	EventOrderByDirectionAsc  EventOrderByDirection = "asc"
@@ -65,21 +110,72 @@ const (
 	LogLineOrderByFieldCreatedAt LogLineOrderByField = "createdAt"
 )
 
+// Defines values for LogSinkKind.
+const (
+	DATADOG LogSinkKind = "DATADOG"
+	HTTP    LogSinkKind = "HTTP"
+	S3      LogSinkKind = "S3"
+)
+
+// Defines values for ObjectStorageFeedKind.
+const (
+	PUBSUB ObjectStorageFeedKind = "PUBSUB"
+	SQS    ObjectStorageFeedKind = "SQS"
+)
+
+// Defines values for PausedTriggerBehavior.
+const (
+	BUFFER PausedTriggerBehavior = "BUFFER"
+	REJECT PausedTriggerBehavior = "REJECT"
+)
+
+// Defines values for PreviewEnvironmentHook.
+const (
+	PROVISION PreviewEnvironmentHook = "PROVISION"
+	TEARDOWN  PreviewEnvironmentHook = "TEARDOWN"
+)
+
 // Defines values for PullRequestState.
 const (
 	Closed PullRequestState = "closed"
 	Open   PullRequestState = "open"
 )
 
+// Defines values for QueryTriggerMode.
+const (
+	NONEMPTY QueryTriggerMode = "NON_EMPTY"
+	PERROW   QueryTriggerMode = "PER_ROW"
+)
+
+// Defines values for RecurringTaskKind.
+const (
+	EMITEVENT         RecurringTaskKind = "EMIT_EVENT"
+	PURGEWORKFLOWRUNS RecurringTaskKind = "PURGE_WORKFLOW_RUNS"
+)
+
 // Defines values for StepRunStatus.
 const (
-	StepRunStatusASSIGNED          StepRunStatus = "ASSIGNED"
-	StepRunStatusCANCELLED         StepRunStatus = "CANCELLED"
-	StepRunStatusFAILED            StepRunStatus = "FAILED"
-	StepRunStatusPENDING           StepRunStatus = "PENDING"
-	StepRunStatusPENDINGASSIGNMENT StepRunStatus = "PENDING_ASSIGNMENT"
-	StepRunStatusRUNNING           StepRunStatus = "RUNNING"
-	StepRunStatusSUCCEEDED         StepRunStatus = "SUCCEEDED"
+	StepRunStatusASSIGNED            StepRunStatus = "ASSIGNED"
+	StepRunStatusCANCELLED           StepRunStatus = "CANCELLED"
+	StepRunStatusFAILED              StepRunStatus = "FAILED"
+	StepRunStatusPENDING             StepRunStatus = "PENDING"
+	StepRunStatusPENDINGASSIGNMENT   StepRunStatus = "PENDING_ASSIGNMENT"
+	StepRunStatusRUNNING             StepRunStatus = "RUNNING"
+	StepRunStatusSUCCEEDED           StepRunStatus = "SUCCEEDED"
+	StepRunStatusWAITINGONDEPENDENCY StepRunStatus = "WAITING_ON_DEPENDENCY"
+)
+
+// Defines values for StepRunSubStatus.
+const (
+	WAITINGFORWORKER StepRunSubStatus = "WAITING_FOR_WORKER"
+)
+
+// Defines values for TenantExportStatus.
+const (
+	TenantExportStatusFAILED    TenantExportStatus = "FAILED"
+	TenantExportStatusPENDING   TenantExportStatus = "PENDING"
+	TenantExportStatusRUNNING   TenantExportStatus = "RUNNING"
+	TenantExportStatusSUCCEEDED TenantExportStatus = "SUCCEEDED"
 )
 
 // Defines values for TenantMemberRole.
@@ -89,28 +185,88 @@ const (
 	OWNER  TenantMemberRole = "OWNER"
 )
 
+// Defines values for WebhookDeliverySource.
+const (
+	GITEA  WebhookDeliverySource = "GITEA"
+	GITHUB WebhookDeliverySource = "GITHUB"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusFAILED    WebhookDeliveryStatus = "FAILED"
+	WebhookDeliveryStatusPROCESSED WebhookDeliveryStatus = "PROCESSED"
+	WebhookDeliveryStatusRECEIVED  WebhookDeliveryStatus = "RECEIVED"
+	WebhookDeliveryStatusREJECTED  WebhookDeliveryStatus = "REJECTED"
+)
+
+// Defines values for WorkerAssignmentStrategy.
+const (
+	LEASTLOADED WorkerAssignmentStrategy = "LEAST_LOADED"
+	LOCALITY    WorkerAssignmentStrategy = "LOCALITY"
+	RANDOM      WorkerAssignmentStrategy = "RANDOM"
+	ROUNDROBIN  WorkerAssignmentStrategy = "ROUND_ROBIN"
+)
+
 // Defines values for WorkflowConcurrencyLimitStrategy.
 const (
-	CANCELINPROGRESS WorkflowConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
-	DROPNEWEST       WorkflowConcurrencyLimitStrategy = "DROP_NEWEST"
-	GROUPROUNDROBIN  WorkflowConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
-	QUEUENEWEST      WorkflowConcurrencyLimitStrategy = "QUEUE_NEWEST"
+	WorkflowConcurrencyLimitStrategyCANCELINPROGRESS WorkflowConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
+	WorkflowConcurrencyLimitStrategyDROPNEWEST       WorkflowConcurrencyLimitStrategy = "DROP_NEWEST"
+	WorkflowConcurrencyLimitStrategyGROUPROUNDROBIN  WorkflowConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
+	WorkflowConcurrencyLimitStrategyQUEUENEWEST      WorkflowConcurrencyLimitStrategy = "QUEUE_NEWEST"
+)
+
+// Defines values for WorkflowRolloutStatus.
+const (
+	WorkflowRolloutStatusACTIVE     WorkflowRolloutStatus = "ACTIVE"
+	WorkflowRolloutStatusCOMPLETED  WorkflowRolloutStatus = "COMPLETED"
+	WorkflowRolloutStatusPENDING    WorkflowRolloutStatus = "PENDING"
+	WorkflowRolloutStatusROLLEDBACK WorkflowRolloutStatus = "ROLLED_BACK"
+)
+
+// Defines values for WorkflowRunBulkRetryStatus.
+const (
+	WorkflowRunBulkRetryStatusFAILED    WorkflowRunBulkRetryStatus = "FAILED"
+	WorkflowRunBulkRetryStatusPENDING   WorkflowRunBulkRetryStatus = "PENDING"
+	WorkflowRunBulkRetryStatusRUNNING   WorkflowRunBulkRetryStatus = "RUNNING"
+	WorkflowRunBulkRetryStatusSUCCEEDED WorkflowRunBulkRetryStatus = "SUCCEEDED"
+)
+
+// Defines values for WorkflowRunExportFormat.
+const (
+	Csv    WorkflowRunExportFormat = "csv"
+	Ndjson WorkflowRunExportFormat = "ndjson"
+)
+
+// Defines values for WorkflowRunInclude.
+const (
+	Events   WorkflowRunInclude = "events"
+	Logs     WorkflowRunInclude = "logs"
+	StepRuns WorkflowRunInclude = "stepRuns"
+)
+
+// Defines values for WorkflowRunMetricsBucket.
+const (
+	DAY  WorkflowRunMetricsBucket = "DAY"
+	HOUR WorkflowRunMetricsBucket = "HOUR"
 )
 
 // Defines values for WorkflowRunStatus.
 const (
-	CANCELLED WorkflowRunStatus = "CANCELLED"
-	FAILED    WorkflowRunStatus = "FAILED"
-	PENDING   WorkflowRunStatus = "PENDING"
-	RUNNING   WorkflowRunStatus = "RUNNING"
-	SUCCEEDED WorkflowRunStatus = "SUCCEEDED"
+	WorkflowRunStatusCANCELLED WorkflowRunStatus = "CANCELLED"
+	WorkflowRunStatusFAILED    WorkflowRunStatus = "FAILED"
+	WorkflowRunStatusPENDING   WorkflowRunStatus = "PENDING"
+	WorkflowRunStatusRUNNING   WorkflowRunStatus = "RUNNING"
+	WorkflowRunStatusSUCCEEDED WorkflowRunStatus = "SUCCEEDED"
 )
 
 // APIError defines model for APIError.
 type APIError struct {
-	// Code a custom Hatchet error code
+	// Code a machine-readable Hatchet error code. Generic codes are 1000 plus the HTTP status code, while codes for specific conditions start at 2000
 	Code *uint64 `json:"code,omitempty"`
 
+	// CorrelationId the id of the request which caused this error, for matching against server logs
+	CorrelationId *string `json:"correlation_id,omitempty"`
+
 	// Description a description for this error
 	Description string `json:"description"`
 
@@ -131,6 +287,11 @@ type APIMeta struct {
 	Auth *APIMetaAuth `json:"auth,omitempty"`
 }
 
+// APIMetaAttestationKeys The public keys which workflow run attestations of the instance are signed with, as a JWK set.
+type APIMetaAttestationKeys struct {
+	Keys []map[string]interface{} `json:"keys"`
+}
+
 // APIMetaAuth defines model for APIMetaAuth.
 type APIMetaAuth struct {
 	// Schemes the supported types of authentication
@@ -160,23 +321,270 @@ type APIResourceMeta struct {
 
 // APIToken defines model for APIToken.
 type APIToken struct {
+	// Environment The environment of the API token. Requests with the token are scoped to the environment.
+	Environment *string `json:"environment,omitempty"`
+
 	// ExpiresAt When the API token expires.
 	ExpiresAt time.Time       `json:"expiresAt"`
 	Metadata  APIResourceMeta `json:"metadata"`
 
 	// Name The name of the API token.
 	Name string `json:"name"`
+
+	// Namespace The namespace of the API token. Requests with the token are scoped to the namespace.
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Revoked Whether the API token has been revoked.
+	Revoked *bool `json:"revoked,omitempty"`
+
+	// Scopes The scopes of the API token. A token without scopes can be used for everything except managing API tokens.
+	Scopes *[]APITokenScope `json:"scopes,omitempty"`
 }
 
+// APITokenScope defines model for APITokenScope.
+type APITokenScope string
+
 // AcceptInviteRequest defines model for AcceptInviteRequest.
 type AcceptInviteRequest struct {
 	Invite string `json:"invite" validate:"required,uuid"`
 }
 
+// AdminFeatureFlag defines model for AdminFeatureFlag.
+type AdminFeatureFlag struct {
+	// Description What the feature flag enables.
+	Description string `json:"description"`
+
+	// Enabled Whether the feature flag is enabled for the tenant.
+	Enabled bool `json:"enabled"`
+
+	// InstanceEnabled Whether the feature flag is enabled for tenants which don't override it.
+	InstanceEnabled bool `json:"instanceEnabled"`
+
+	// Name The name of the feature flag.
+	Name string `json:"name"`
+
+	// Overridden Whether the tenant overrides the setting of the instance.
+	Overridden bool `json:"overridden"`
+}
+
+// AdminFeatureFlagList defines model for AdminFeatureFlagList.
+type AdminFeatureFlagList struct {
+	Rows *[]AdminFeatureFlag `json:"rows,omitempty"`
+}
+
+// AdminMaintenanceJob defines model for AdminMaintenanceJob.
+type AdminMaintenanceJob string
+
+// AckEventBusSubscriptionRequest defines model for AckEventBusSubscriptionRequest.
+type AckEventBusSubscriptionRequest struct {
+	// Cursor The id of the last record to acknowledge.
+	Cursor int64 `json:"cursor" validate:"min=0"`
+}
+
+// AdminQueue defines model for AdminQueue.
+type AdminQueue struct {
+	// ActionId The action id that step runs in this queue are waiting on.
+	ActionId string `json:"actionId"`
+
+	// OldestCreatedAt The creation time of the oldest step run in the queue.
+	OldestCreatedAt *time.Time `json:"oldestCreatedAt,omitempty"`
+
+	// PendingAssignment The number of step runs waiting to be assigned to a worker.
+	PendingAssignment int `json:"pendingAssignment"`
+
+	// TenantId The tenant id.
+	TenantId string `json:"tenantId"`
+}
+
+// AdminQueueList defines model for AdminQueueList.
+type AdminQueueList struct {
+	Rows *[]AdminQueue `json:"rows,omitempty"`
+}
+
+// AdminTenant defines model for AdminTenant.
+type AdminTenant struct {
+	// IngestionPaused Whether ingestion of new events is paused for the tenant.
+	IngestionPaused bool                 `json:"ingestionPaused"`
+	RunCounts       AdminTenantRunCounts `json:"runCounts"`
+	Tenant          Tenant               `json:"tenant"`
+}
+
+// AdminTenantList defines model for AdminTenantList.
+type AdminTenantList struct {
+	Rows *[]AdminTenant `json:"rows,omitempty"`
+}
+
+// AdminTenantRunCounts defines model for AdminTenantRunCounts.
+type AdminTenantRunCounts struct {
+	// Failed The number of failed workflow runs.
+	Failed int `json:"failed"`
+
+	// Pending The number of pending or queued workflow runs.
+	Pending int `json:"pending"`
+
+	// Running The number of running workflow runs.
+	Running int `json:"running"`
+
+	// Succeeded The number of succeeded workflow runs.
+	Succeeded int `json:"succeeded"`
+
+	// Total The total number of workflow runs.
+	Total int `json:"total"`
+}
+
+// AdminTriggerMaintenanceRequest defines model for AdminTriggerMaintenanceRequest.
+type AdminTriggerMaintenanceRequest struct {
+	Job AdminMaintenanceJob `json:"job"`
+}
+
+// AdminUpdateTenantFeatureFlagRequest defines model for AdminUpdateTenantFeatureFlagRequest.
+type AdminUpdateTenantFeatureFlagRequest struct {
+	// Enabled Whether the feature flag is enabled for the tenant. If omitted, the override is removed and the tenant uses the setting of the instance.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Name The name of the feature flag.
+	Name string `json:"name"`
+}
+
+// AdminUpdateTenantIngestionRequest defines model for AdminUpdateTenantIngestionRequest.
+type AdminUpdateTenantIngestionRequest struct {
+	// Paused Whether ingestion of new events should be paused for the tenant.
+	Paused bool `json:"paused"`
+}
+
+// BackpressureHint A hint that the queue of the tenant is backed up, so triggers should be slowed down.
+type BackpressureHint struct {
+	// QueueDepth The number of workflow runs and step runs of the tenant which are waiting to be processed.
+	QueueDepth int64 `json:"queueDepth"`
+
+	// RetryAfterSeconds The suggested number of seconds to wait before the next trigger.
+	RetryAfterSeconds int64 `json:"retryAfterSeconds"`
+
+	// Shedding Whether triggers which are not a priority are currently rejected.
+	Shedding bool `json:"shedding"`
+}
+
+// CancellationSource The source of a cancellation. CONCURRENCY is set when a run is superseded by a newer run because of a concurrency
+// limit, PARENT_CANCELLED is set when a step run is cancelled because a previous step run was cancelled,
+// JOIN_SATISFIED is set when a step run is cancelled because the join step after it started without it, and
+// BUDGET_EXCEEDED is set when a run exceeds the step execution or retry budget of its workflow.
+type CancellationSource string
+
+// ClientCertificate defines model for ClientCertificate.
+type ClientCertificate struct {
+	// ExpiresAt When the certificate expires, if it was pinned with the certificate rather than its fingerprint.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Fingerprint The SHA-256 fingerprint of the certificate, as lowercase hex.
+	Fingerprint string          `json:"fingerprint"`
+	Metadata    APIResourceMeta `json:"metadata"`
+
+	// Name The name of the certificate.
+	Name string `json:"name"`
+
+	// Subject The subject of the certificate, if it was pinned with the certificate rather than its fingerprint.
+	Subject *string `json:"subject,omitempty"`
+
+	// TenantId The unique identifier for the tenant that the certificate is pinned for.
+	TenantId openapi_types.UUID `json:"tenantId"`
+}
+
+// ConcurrencySimulation defines model for ConcurrencySimulation.
+type ConcurrencySimulation struct {
+	// AvgQueueWaitSeconds The average number of seconds which the runs that waited for a slot would have waited before they started.
+	AvgQueueWaitSeconds float64 `json:"avgQueueWaitSeconds"`
+
+	// Cancelled The number of runs which would have been cancelled to make room for newer runs of their group.
+	Cancelled int `json:"cancelled"`
+
+	// EstimatedDurations The number of runs which never ran to completion, whose duration was estimated from the median duration of the runs which did.
+	EstimatedDurations int `json:"estimatedDurations"`
+
+	// Groups The number of distinct concurrency group keys of the runs.
+	Groups int `json:"groups"`
+
+	// KeyErrors The number of runs whose key expression couldn't be evaluated, which would fail rather than run.
+	KeyErrors int `json:"keyErrors"`
+
+	// MaxQueueWaitSeconds The maximum number of seconds which a run would have waited for a slot before it started.
+	MaxQueueWaitSeconds float64 `json:"maxQueueWaitSeconds"`
+
+	// MaxQueued The maximum number of runs which would have waited for a slot at the same time.
+	MaxQueued int `json:"maxQueued"`
+
+	// MaxRunning The maximum number of runs which would have run at the same time.
+	MaxRunning int `json:"maxRunning"`
+
+	// Queued The number of runs which would have waited for a slot, including the runs which would still be waiting.
+	Queued int `json:"queued"`
+
+	// Runs The number of runs which were simulated.
+	Runs int `json:"runs"`
+
+	// Started The number of runs which would have started.
+	Started int `json:"started"`
+
+	// StillQueued The number of runs which would still be waiting for a slot at the end of the window.
+	StillQueued int `json:"stillQueued"`
+
+	// Truncated Whether the workflow had more runs in the window than can be simulated, in which case only the oldest runs were simulated.
+	Truncated bool `json:"truncated"`
+
+	// WindowEnd The time until which the runs were simulated.
+	WindowEnd time.Time `json:"windowEnd"`
+
+	// WindowStart The time from which the runs were simulated.
+	WindowStart time.Time `json:"windowStart"`
+}
+
+// CostCenterUsage defines model for CostCenterUsage.
+type CostCenterUsage struct {
+	Bucket CostCenterUsageBucket `json:"bucket"`
+	Rows   []CostCenterUsageRow  `json:"rows"`
+	Since  time.Time             `json:"since"`
+	Until  time.Time             `json:"until"`
+}
+
+// CostCenterUsageBucket defines model for CostCenterUsageBucket.
+type CostCenterUsageBucket string
+
+// CostCenterUsageRow defines model for CostCenterUsageRow.
+type CostCenterUsageRow struct {
+	// Bucket The start of the time bucket.
+	Bucket time.Time `json:"bucket"`
+
+	// ComputeSeconds The total time which the step runs spent running on workers, in seconds.
+	ComputeSeconds float64 `json:"computeSeconds"`
+
+	// CostCenter The cost center of the step runs, which is empty for step runs whose step and workflow have no cost center.
+	CostCenter   string `json:"costCenter"`
+	StepRunCount int64  `json:"stepRunCount"`
+
+	// WorkflowRunCount The number of distinct workflow runs of the step runs in the bucket.
+	WorkflowRunCount int64 `json:"workflowRunCount"`
+}
+
 // CreateAPITokenRequest defines model for CreateAPITokenRequest.
 type CreateAPITokenRequest struct {
+	// Environment The environment of the API token, such as staging. Workers which register with the token only receive the runs
+	// of the environment, the runs and events which are created with the token belong to it, and list requests with
+	// the token only return the resources of the environment.
+	Environment *string `json:"environment,omitempty" validate:"omitnil,hatchetName"`
+
+	// ExpiresIn How long the API token is valid for, as a duration such as 720h. Defaults to 90 days.
+	ExpiresIn *string `json:"expiresIn,omitempty"`
+
 	// Name A name for the API token.
 	Name string `json:"name"`
+
+	// Namespace The namespace of the API token, such as payments. Workflow names and event keys without a namespace are
+	// resolved to the namespace, and the token can only access the workflows, runs and events of the namespace.
+	Namespace *string `json:"namespace,omitempty" validate:"omitnil,hatchetName"`
+
+	// Scopes The scopes of the API token. read allows read-only REST requests, write allows all REST requests, worker allows
+	// requests to the gRPC API, and tokens allows managing API tokens. A token without scopes can be used for everything
+	// except managing API tokens.
+	Scopes *[]APITokenScope `json:"scopes,omitempty"`
 }
 
 // CreateAPITokenResponse defines model for CreateAPITokenResponse.
@@ -185,17 +593,167 @@ type CreateAPITokenResponse struct {
 	Token string `json:"token"`
 }
 
+// CreateClientCertificateRequest defines model for CreateClientCertificateRequest.
+type CreateClientCertificateRequest struct {
+	// Certificate The PEM-encoded certificate to pin. Either the certificate or its fingerprint is required.
+	Certificate *string `json:"certificate,omitempty"`
+
+	// Fingerprint The SHA-256 fingerprint of the certificate to pin, as hex which can be separated with colons. Either the certificate or its fingerprint is required.
+	Fingerprint *string `json:"fingerprint,omitempty"`
+
+	// Name The name of the certificate.
+	Name string `json:"name" validate:"required,hatchetName"`
+}
+
+// CreateConcurrencySimulationRequest defines model for CreateConcurrencySimulationRequest.
+type CreateConcurrencySimulationRequest struct {
+	// Hours The number of hours of past runs which are simulated.
+	Hours int `json:"hours" validate:"min=1,max=168"`
+
+	// KeyExpression An expression which computes the concurrency group key of each run from its input or additional metadata, like input.customer_id or additional_metadata.region. If it is not set, runs keep the group key which they were assigned.
+	KeyExpression *string `json:"keyExpression,omitempty" validate:"omitnil,min=1,max=1024"`
+
+	// LimitStrategy The strategy of the simulated concurrency limit.
+	LimitStrategy CreateConcurrencySimulationRequestLimitStrategy `json:"limitStrategy"`
+
+	// MaxRuns The maximum number of concurrent workflow runs of the simulated concurrency limit.
+	MaxRuns int `json:"maxRuns" validate:"min=1"`
+}
+
+// CreateConcurrencySimulationRequestLimitStrategy The strategy of the simulated concurrency limit.
+type CreateConcurrencySimulationRequestLimitStrategy string
+
+// CreateEventBusSubscriptionRequest defines model for CreateEventBusSubscriptionRequest.
+type CreateEventBusSubscriptionRequest struct {
+	// Name The name of the subscription.
+	Name string `json:"name" validate:"required,hatchetName"`
+
+	// Topics The topics of the subscription.
+	Topics []EventBusTopic `json:"topics" validate:"required,min=1,dive,oneof=workflow-run-finished step-run-failed worker-registered"`
+}
+
+// CreateGiteaWebhookRequest defines model for CreateGiteaWebhookRequest.
+type CreateGiteaWebhookRequest struct {
+	// RepoName The name of the Gitea repository.
+	RepoName string `json:"repoName" validate:"required,min=1,max=255"`
+
+	// RepoOwner The owner of the Gitea repository.
+	RepoOwner string `json:"repoOwner" validate:"required,min=1,max=255"`
+}
+
+// CreateLogSinkRequest defines model for CreateLogSinkRequest.
+type CreateLogSinkRequest struct {
+	// BatchSize The maximum number of records which are sent in one delivery. Defaults to 100.
+	BatchSize *int                  `json:"batchSize,omitempty" validate:"omitnil,min=1,max=1000"`
+	Datadog   *LogSinkDatadogConfig `json:"datadog,omitempty"`
+	Http      *LogSinkHTTPConfig    `json:"http,omitempty"`
+	Kind      LogSinkKind           `json:"kind"`
+
+	// Name The name of the log sink.
+	Name string           `json:"name" validate:"required,hatchetName"`
+	S3   *LogSinkS3Config `json:"s3,omitempty"`
+}
+
+// CreateMaintenanceWindowRequest defines model for CreateMaintenanceWindowRequest.
+type CreateMaintenanceWindowRequest struct {
+	// Cron The cron expression of the times at which the maintenance window starts, in UTC.
+	Cron string `json:"cron" validate:"required,cron"`
+
+	// DurationSeconds How long the maintenance window lasts, in seconds.
+	DurationSeconds int `json:"durationSeconds" validate:"required,min=1"`
+
+	// Name The name of the maintenance window, which is unique within the workflow.
+	Name string `json:"name" validate:"required,hatchetName"`
+}
+
+// CreateObjectStorageFeedRequest defines model for CreateObjectStorageFeedRequest.
+type CreateObjectStorageFeedRequest struct {
+	// EventKey The key of the events which are created for created objects.
+	EventKey string `json:"eventKey" validate:"required"`
+
+	// KeyPrefix If set, only objects whose key starts with the prefix create events.
+	KeyPrefix *string               `json:"keyPrefix,omitempty" validate:"omitnil,min=1"`
+	Kind      ObjectStorageFeedKind `json:"kind"`
+
+	// Name The name of the feed.
+	Name   string                         `json:"name" validate:"required,hatchetName"`
+	Pubsub *ObjectStorageFeedPubSubConfig `json:"pubsub,omitempty"`
+	Sqs    *ObjectStorageFeedSQSConfig    `json:"sqs,omitempty"`
+}
+
+// CreatePIIRuleRequest defines model for CreatePIIRuleRequest.
+type CreatePIIRuleRequest struct {
+	// Path The JSONPath expression which matches the personal data in step run inputs and outputs.
+	Path string `json:"path" validate:"required,max=256"`
+
+	// RetentionSeconds How long the matched values are kept after a step run finished, in seconds. Must be at least 60 seconds.
+	RetentionSeconds int `json:"retentionSeconds" validate:"required,min=60"`
+}
+
 // CreatePullRequestFromStepRun defines model for CreatePullRequestFromStepRun.
 type CreatePullRequestFromStepRun struct {
 	BranchName string `json:"branchName"`
 }
 
+// CreateQueryTriggerRequest defines model for CreateQueryTriggerRequest.
+type CreateQueryTriggerRequest struct {
+	// ConnectionString The connection string of the Postgres database which the query runs against, which is stored encrypted. It should be for a user which can only read the tables which the query reads.
+	ConnectionString string `json:"connectionString" validate:"required"`
+
+	// Cron The cron expression of the schedule of the query, evaluated in UTC.
+	Cron string `json:"cron" validate:"required"`
+
+	// EventKey The key of the events which are created for the rows which the query returns.
+	EventKey string            `json:"eventKey" validate:"required"`
+	Mode     *QueryTriggerMode `json:"mode,omitempty"`
+
+	// Name The name of the trigger.
+	Name string `json:"name" validate:"required,hatchetName"`
+
+	// Query The read-only SQL query which is run. Only the first 100 rows of the result are used.
+	Query string `json:"query" validate:"required"`
+}
+
+// CreateRecurringTaskRequest defines model for CreateRecurringTaskRequest.
+type CreateRecurringTaskRequest struct {
+	// Cron The cron expression of the schedule of the task, evaluated in UTC.
+	Cron string `json:"cron" validate:"required"`
+
+	// EventData The data of the event which is emitted, for EMIT_EVENT tasks.
+	EventData *map[string]interface{} `json:"eventData,omitempty"`
+
+	// EventKey The key of the event which is emitted. Required for EMIT_EVENT tasks.
+	EventKey *string           `json:"eventKey,omitempty"`
+	Kind     RecurringTaskKind `json:"kind"`
+
+	// Name The name of the task.
+	Name string `json:"name" validate:"required,hatchetName"`
+
+	// Retention How long finished runs are kept before they're purged, like 168h. Required for PURGE_WORKFLOW_RUNS tasks, and must be at least 1h.
+	Retention *string `json:"retention,omitempty"`
+
+	// WorkflowId The workflow whose finished runs are purged. Required for PURGE_WORKFLOW_RUNS tasks.
+	WorkflowId *openapi_types.UUID `json:"workflowId,omitempty"`
+}
+
 // CreateSNSIntegrationRequest defines model for CreateSNSIntegrationRequest.
 type CreateSNSIntegrationRequest struct {
 	// TopicArn The Amazon Resource Name (ARN) of the SNS topic.
 	TopicArn string `json:"topicArn" validate:"required,min=1,max=256"`
 }
 
+// CreateSubjectDeletionRequest defines model for CreateSubjectDeletionRequest.
+type CreateSubjectDeletionRequest struct {
+	// DryRun Whether to only report what would be deleted, without deleting anything.
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// Key The run metadata key which identifies the subject, like customer_id.
+	Key string `json:"key" validate:"required,max=256"`
+
+	// Value The value of the key for the subject. Numbers in the run metadata are matched by their decimal representation.
+	Value string `json:"value" validate:"required,max=1024"`
+}
+
 // CreateTenantInviteRequest defines model for CreateTenantInviteRequest.
 type CreateTenantInviteRequest struct {
 	// Email The email of the user to invite.
@@ -212,18 +770,78 @@ type CreateTenantRequest struct {
 	Slug string `json:"slug" validate:"required,hatchetName"`
 }
 
+// CreateTriggerLinkRequest defines model for CreateTriggerLinkRequest.
+type CreateTriggerLinkRequest struct {
+	// DefaultInput The input which the body of every trigger request is merged onto. Keys in the body override the default input.
+	DefaultInput *map[string]interface{} `json:"defaultInput,omitempty"`
+
+	// Name The name of the trigger link, which is unique within the workflow.
+	Name string `json:"name" validate:"required,hatchetName"`
+}
+
+// CreateTriggerLinkResponse defines model for CreateTriggerLinkResponse.
+type CreateTriggerLinkResponse struct {
+	// Token The token of the trigger link.
+	Token       string      `json:"token"`
+	TriggerLink TriggerLink `json:"triggerLink"`
+
+	// Url The URL which triggers the workflow, including the token. The token is only returned when the trigger link is created.
+	Url string `json:"url"`
+}
+
 // Event defines model for Event.
 type Event struct {
+	// Environment The environment of the event. Workflow runs which are triggered by the event belong to it.
+	Environment *string `json:"environment,omitempty"`
+
 	// Key The key for the event.
 	Key      string          `json:"key"`
 	Metadata APIResourceMeta `json:"metadata"`
-	Tenant   *Tenant         `json:"tenant,omitempty"`
+
+	// Namespace The namespace of the event, which prefixes its key. It is not set for events in the default namespace.
+	Namespace *string `json:"namespace,omitempty"`
+	Tenant    *Tenant `json:"tenant,omitempty"`
 
 	// TenantId The ID of the tenant associated with this event.
 	TenantId           string                   `json:"tenantId"`
 	WorkflowRunSummary *EventWorkflowRunSummary `json:"workflowRunSummary,omitempty"`
 }
 
+// EventBusRecord defines model for EventBusRecord.
+type EventBusRecord struct {
+	// CreatedAt When the record was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Data The data of the record.
+	Data map[string]interface{} `json:"data"`
+
+	// Id The id of the record, which is used as the cursor to acknowledge it.
+	Id    int64         `json:"id"`
+	Topic EventBusTopic `json:"topic"`
+}
+
+// EventBusSubscription defines model for EventBusSubscription.
+type EventBusSubscription struct {
+	// AckedCursor The id of the last record which was acknowledged.
+	AckedCursor int64 `json:"ackedCursor"`
+
+	// LastPolledAt When the records of the subscription were last listed.
+	LastPolledAt *time.Time      `json:"lastPolledAt,omitempty"`
+	Metadata     APIResourceMeta `json:"metadata"`
+
+	// Name The name of the subscription.
+	Name string `json:"name"`
+
+	// TenantId The unique identifier for the tenant that the subscription belongs to.
+	TenantId openapi_types.UUID `json:"tenantId"`
+
+	// Topics The topics of the subscription.
+	Topics []EventBusTopic `json:"topics"`
+}
+
+// EventBusTopic defines model for EventBusTopic.
+type EventBusTopic string
+
 // EventData defines model for EventData.
 type EventData struct {
 	// Data The data for the event (JSON bytes).
@@ -269,11 +887,53 @@ type EventWorkflowRunSummary struct {
 	Succeeded *int64 `json:"succeeded,omitempty"`
 }
 
+// GetGroupKeyRun defines model for GetGroupKeyRun.
+type GetGroupKeyRun struct {
+	CancelledAt     *time.Time      `json:"cancelledAt,omitempty"`
+	CancelledError  *string         `json:"cancelledError,omitempty"`
+	CancelledReason *string         `json:"cancelledReason,omitempty"`
+	Error           *string         `json:"error,omitempty"`
+	FinishedAt      *time.Time      `json:"finishedAt,omitempty"`
+	Input           *string         `json:"input,omitempty"`
+	Metadata        APIResourceMeta `json:"metadata"`
+
+	// Output The computed group key.
+	Output            *string       `json:"output,omitempty"`
+	RequeueAfter      *time.Time    `json:"requeueAfter,omitempty"`
+	ScheduleTimeoutAt *time.Time    `json:"scheduleTimeoutAt,omitempty"`
+	StartedAt         *time.Time    `json:"startedAt,omitempty"`
+	Status            StepRunStatus `json:"status"`
+	TenantId          string        `json:"tenantId"`
+	TimeoutAt         *time.Time    `json:"timeoutAt,omitempty"`
+	WorkerId          *string       `json:"workerId,omitempty"`
+	WorkflowRunId     string        `json:"workflowRunId"`
+}
+
 // GetStepRunDiffResponse defines model for GetStepRunDiffResponse.
 type GetStepRunDiffResponse struct {
 	Diffs []StepRunDiff `json:"diffs"`
 }
 
+// GiteaWebhook defines model for GiteaWebhook.
+type GiteaWebhook struct {
+	Metadata APIResourceMeta `json:"metadata"`
+
+	// RepoName The name of the Gitea repository.
+	RepoName string `json:"repoName"`
+
+	// RepoOwner The owner of the Gitea repository.
+	RepoOwner string `json:"repoOwner"`
+
+	// SigningSecret The secret to configure as the secret of the webhook in Gitea. Only returned when the webhook is created.
+	SigningSecret *string `json:"signingSecret,omitempty"`
+
+	// TenantId The unique identifier for the tenant that the Gitea webhook belongs to.
+	TenantId openapi_types.UUID `json:"tenantId"`
+
+	// WebhookUrl The URL to configure as the target URL of the webhook in Gitea.
+	WebhookUrl string `json:"webhookUrl"`
+}
+
 // GithubAppInstallation defines model for GithubAppInstallation.
 type GithubAppInstallation struct {
 	AccountAvatarUrl        string          `json:"account_avatar_url"`
@@ -294,6 +954,43 @@ type GithubRepo struct {
 	RepoOwner string `json:"repo_owner"`
 }
 
+// GithubWebhook defines model for GithubWebhook.
+type GithubWebhook struct {
+	// FailedDeliveries The recent failed deliveries of the webhook, as reported by Github at the last reconciliation. Most recent first.
+	FailedDeliveries []GithubWebhookDelivery `json:"failed_deliveries"`
+
+	// HookId The id of the webhook on Github. Set once the webhook was reconciled.
+	HookId   *int64          `json:"hook_id,omitempty"`
+	Metadata APIResourceMeta `json:"metadata"`
+
+	// ReconcileError The error of the last reconciliation, if it failed.
+	ReconcileError *string `json:"reconcile_error,omitempty"`
+
+	// ReconciledAt When the webhook was last reconciled with Github.
+	ReconciledAt *time.Time `json:"reconciled_at,omitempty"`
+	RepoName     string     `json:"repo_name"`
+	RepoOwner    string     `json:"repo_owner"`
+}
+
+// GithubWebhookDelivery defines model for GithubWebhookDelivery.
+type GithubWebhookDelivery struct {
+	// Action The action of the event which was delivered.
+	Action      *string   `json:"action,omitempty"`
+	DeliveredAt time.Time `json:"delivered_at"`
+
+	// Event The event which was delivered.
+	Event string `json:"event"`
+
+	// Id The id of the delivery on Github.
+	Id int64 `json:"id"`
+
+	// Status The status of the delivery, as reported by Github.
+	Status string `json:"status"`
+
+	// StatusCode The status code which the webhook responded with, or 0 if the delivery did not get a response.
+	StatusCode int `json:"status_code"`
+}
+
 // Job defines model for Job.
 type Job struct {
 	// Description The description of the job.
@@ -344,6 +1041,11 @@ type LinkGithubRepositoryRequest struct {
 
 	// InstallationId The repository name.
 	InstallationId string `json:"installationId"`
+
+	// PreviewEnvironmentHook Whether the workflow provisions or tears down the preview environments of pull requests on the linked
+	// repository. Provision workflows are triggered when a pull request is opened, reopened or updated, and teardown
+	// workflows when it's closed.
+	PreviewEnvironmentHook *PreviewEnvironmentHook `json:"previewEnvironmentHook,omitempty"`
 }
 
 // ListAPIMetaIntegration defines model for ListAPIMetaIntegration.
@@ -355,10 +1057,35 @@ type ListAPITokensResponse struct {
 	Rows       *[]APIToken         `json:"rows,omitempty"`
 }
 
-// ListGithubAppInstallationsResponse defines model for ListGithubAppInstallationsResponse.
-type ListGithubAppInstallationsResponse struct {
-	Pagination PaginationResponse      `json:"pagination"`
-	Rows       []GithubAppInstallation `json:"rows"`
+// ListClientCertificates defines model for ListClientCertificates.
+type ListClientCertificates struct {
+	Pagination PaginationResponse  `json:"pagination"`
+	Rows       []ClientCertificate `json:"rows"`
+}
+
+// ListEventBusRecords defines model for ListEventBusRecords.
+type ListEventBusRecords struct {
+	// Cursor The cursor which acknowledges the returned records. If no records were returned, this is the last acknowledged cursor.
+	Cursor int64            `json:"cursor"`
+	Rows   []EventBusRecord `json:"rows"`
+}
+
+// ListEventBusSubscriptions defines model for ListEventBusSubscriptions.
+type ListEventBusSubscriptions struct {
+	Pagination PaginationResponse     `json:"pagination"`
+	Rows       []EventBusSubscription `json:"rows"`
+}
+
+// ListGiteaWebhooks defines model for ListGiteaWebhooks.
+type ListGiteaWebhooks struct {
+	Pagination PaginationResponse `json:"pagination"`
+	Rows       []GiteaWebhook     `json:"rows"`
+}
+
+// ListGithubAppInstallationsResponse defines model for ListGithubAppInstallationsResponse.
+type ListGithubAppInstallationsResponse struct {
+	Pagination PaginationResponse      `json:"pagination"`
+	Rows       []GithubAppInstallation `json:"rows"`
 }
 
 // ListGithubBranchesResponse defines model for ListGithubBranchesResponse.
@@ -367,17 +1094,76 @@ type ListGithubBranchesResponse = []GithubBranch
 // ListGithubReposResponse defines model for ListGithubReposResponse.
 type ListGithubReposResponse = []GithubRepo
 
+// ListGithubWebhooks defines model for ListGithubWebhooks.
+type ListGithubWebhooks struct {
+	Pagination PaginationResponse `json:"pagination"`
+	Rows       []GithubWebhook    `json:"rows"`
+}
+
+// ListLogSinks defines model for ListLogSinks.
+type ListLogSinks struct {
+	Pagination PaginationResponse `json:"pagination"`
+	Rows       []LogSink          `json:"rows"`
+}
+
+// ListMaintenanceWindows defines model for ListMaintenanceWindows.
+type ListMaintenanceWindows struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       []MaintenanceWindow `json:"rows"`
+}
+
+// ListObjectStorageFeeds defines model for ListObjectStorageFeeds.
+type ListObjectStorageFeeds struct {
+	Pagination PaginationResponse  `json:"pagination"`
+	Rows       []ObjectStorageFeed `json:"rows"`
+}
+
+// ListPIIRules defines model for ListPIIRules.
+type ListPIIRules struct {
+	Pagination PaginationResponse `json:"pagination"`
+	Rows       []PIIRule          `json:"rows"`
+}
+
 // ListPullRequestsResponse defines model for ListPullRequestsResponse.
 type ListPullRequestsResponse struct {
 	PullRequests []PullRequest `json:"pullRequests"`
 }
 
+// ListQueryTriggers defines model for ListQueryTriggers.
+type ListQueryTriggers struct {
+	Pagination PaginationResponse `json:"pagination"`
+	Rows       []QueryTrigger     `json:"rows"`
+}
+
+// ListRecurringTasks defines model for ListRecurringTasks.
+type ListRecurringTasks struct {
+	Pagination PaginationResponse `json:"pagination"`
+	Rows       []RecurringTask    `json:"rows"`
+}
+
 // ListSNSIntegrations defines model for ListSNSIntegrations.
 type ListSNSIntegrations struct {
 	Pagination PaginationResponse `json:"pagination"`
 	Rows       []SNSIntegration   `json:"rows"`
 }
 
+// ListTenantExports defines model for ListTenantExports.
+type ListTenantExports struct {
+	Pagination PaginationResponse `json:"pagination"`
+	Rows       []TenantExport     `json:"rows"`
+}
+
+// ListTriggerLinks defines model for ListTriggerLinks.
+type ListTriggerLinks struct {
+	Pagination PaginationResponse `json:"pagination"`
+	Rows       []TriggerLink      `json:"rows"`
+}
+
+// ListWebhookDeliveries defines model for ListWebhookDeliveries.
+type ListWebhookDeliveries struct {
+	Rows []WebhookDelivery `json:"rows"`
+}
+
 // LogLine defines model for LogLine.
 type LogLine struct {
 	// CreatedAt The creation date of the log line.
@@ -411,6 +1197,184 @@ type LogLineOrderByField string
 // LogLineSearch defines model for LogLineSearch.
 type LogLineSearch = string
 
+// LogSink defines model for LogSink.
+type LogSink struct {
+	// BatchSize The maximum number of records which are sent in one delivery.
+	BatchSize int `json:"batchSize"`
+
+	// Destination Where records are sent, without any secrets.
+	Destination string `json:"destination"`
+
+	// Enabled Whether records are forwarded to the log sink.
+	Enabled bool `json:"enabled"`
+
+	// Failures The number of deliveries which failed in a row.
+	Failures int         `json:"failures"`
+	Kind     LogSinkKind `json:"kind"`
+
+	// LastDeliveredAt When records were last delivered to the log sink.
+	LastDeliveredAt *time.Time `json:"lastDeliveredAt,omitempty"`
+
+	// LastError The error of the last failed delivery, which is cleared by the next successful delivery.
+	LastError *string         `json:"lastError,omitempty"`
+	Metadata  APIResourceMeta `json:"metadata"`
+
+	// Name The name of the log sink.
+	Name string `json:"name"`
+
+	// TenantId The unique identifier for the tenant that the log sink belongs to.
+	TenantId openapi_types.UUID `json:"tenantId"`
+}
+
+// LogSinkDatadogConfig defines model for LogSinkDatadogConfig.
+type LogSinkDatadogConfig struct {
+	// ApiKey The API key which is used to send logs.
+	ApiKey string `json:"apiKey" validate:"required"`
+
+	// Service The service of the logs. Defaults to hatchet.
+	Service *string `json:"service,omitempty"`
+
+	// Site The Datadog site. Defaults to datadoghq.com.
+	Site *string `json:"site,omitempty"`
+}
+
+// LogSinkHTTPConfig defines model for LogSinkHTTPConfig.
+type LogSinkHTTPConfig struct {
+	// Headers Headers which are sent with every request, for example for authorization.
+	Headers *map[string]string `json:"headers,omitempty"`
+
+	// Secret The secret which is used to sign the request body in the X-Hatchet-Signature header.
+	Secret *string `json:"secret,omitempty"`
+
+	// Url The URL which batches of records are posted to.
+	Url string `json:"url" validate:"required,url"`
+}
+
+// LogSinkKind defines model for LogSinkKind.
+type LogSinkKind string
+
+// LogSinkS3Config defines model for LogSinkS3Config.
+type LogSinkS3Config struct {
+	// AccessKeyId The access key id which is used to write to the bucket.
+	AccessKeyId string `json:"accessKeyId" validate:"required"`
+
+	// Bucket The bucket which batches of records are written to.
+	Bucket string `json:"bucket" validate:"required"`
+
+	// Endpoint The endpoint of an S3-compatible service. Defaults to the AWS endpoint of the region.
+	Endpoint *string `json:"endpoint,omitempty" validate:"omitempty,url"`
+
+	// Prefix The prefix of the object keys.
+	Prefix *string `json:"prefix,omitempty"`
+
+	// Region The region of the bucket.
+	Region string `json:"region" validate:"required"`
+
+	// SecretAccessKey The secret access key which is used to write to the bucket.
+	SecretAccessKey string `json:"secretAccessKey" validate:"required"`
+}
+
+// MaintenanceWindow defines model for MaintenanceWindow.
+type MaintenanceWindow struct {
+	// Cron The cron expression of the times at which the maintenance window starts, in UTC.
+	Cron string `json:"cron"`
+
+	// DurationSeconds How long the maintenance window lasts, in seconds.
+	DurationSeconds int             `json:"durationSeconds"`
+	Metadata        APIResourceMeta `json:"metadata"`
+
+	// Name The name of the maintenance window.
+	Name string `json:"name"`
+
+	// TenantId The unique identifier for the tenant that the maintenance window belongs to.
+	TenantId openapi_types.UUID `json:"tenantId"`
+
+	// WorkflowId The unique identifier for the workflow which the maintenance window applies to.
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+}
+
+// ObjectStorageFeed defines model for ObjectStorageFeed.
+type ObjectStorageFeed struct {
+	// Enabled Whether the feed is polled.
+	Enabled bool `json:"enabled"`
+
+	// EventKey The key of the events which are created for created objects.
+	EventKey string `json:"eventKey"`
+
+	// Failures The number of polls which failed in a row.
+	Failures int `json:"failures"`
+
+	// KeyPrefix If set, only objects whose key starts with the prefix create events.
+	KeyPrefix *string               `json:"keyPrefix,omitempty"`
+	Kind      ObjectStorageFeedKind `json:"kind"`
+
+	// LastError The error of the last failed poll, which is cleared by the next successful poll.
+	LastError *string `json:"lastError,omitempty"`
+
+	// LastReceivedAt When notifications were last received from the feed.
+	LastReceivedAt *time.Time      `json:"lastReceivedAt,omitempty"`
+	Metadata       APIResourceMeta `json:"metadata"`
+
+	// Name The name of the feed.
+	Name string `json:"name"`
+
+	// Source The queue or subscription which notifications are received from.
+	Source string `json:"source"`
+
+	// TenantId The unique identifier for the tenant that the feed belongs to.
+	TenantId openapi_types.UUID `json:"tenantId"`
+}
+
+// ObjectStorageFeedKind defines model for ObjectStorageFeedKind.
+type ObjectStorageFeedKind string
+
+// ObjectStorageFeedPubSubConfig defines model for ObjectStorageFeedPubSubConfig.
+type ObjectStorageFeedPubSubConfig struct {
+	// CredentialsJson The JSON key of the service account which is used to pull and acknowledge messages. Only optional if an endpoint is set.
+	CredentialsJson *string `json:"credentialsJson,omitempty"`
+
+	// Endpoint The endpoint of a Pub/Sub-compatible service like the Pub/Sub emulator. Defaults to the Google endpoint.
+	Endpoint *string `json:"endpoint,omitempty" validate:"omitempty,url"`
+
+	// Subscription The Pub/Sub subscription which GCS notifications are pulled from, like projects/my-project/subscriptions/my-subscription.
+	Subscription string `json:"subscription" validate:"required"`
+}
+
+// ObjectStorageFeedSQSConfig defines model for ObjectStorageFeedSQSConfig.
+type ObjectStorageFeedSQSConfig struct {
+	// AccessKeyId The access key id which is used to receive and delete messages.
+	AccessKeyId string `json:"accessKeyId" validate:"required"`
+
+	// Endpoint The endpoint of an SQS-compatible service. Defaults to the host of the queue url.
+	Endpoint *string `json:"endpoint,omitempty" validate:"omitempty,url"`
+
+	// QueueUrl The url of the SQS queue which S3 event notifications are sent to.
+	QueueUrl string `json:"queueUrl" validate:"required,url"`
+
+	// Region The region of the queue.
+	Region string `json:"region" validate:"required"`
+
+	// SecretAccessKey The secret access key which is used to receive and delete messages.
+	SecretAccessKey string `json:"secretAccessKey" validate:"required"`
+}
+
+// PIIRule defines model for PIIRule.
+type PIIRule struct {
+	Metadata APIResourceMeta `json:"metadata"`
+
+	// Path The JSONPath expression which matches the personal data in step run inputs and outputs.
+	Path string `json:"path"`
+
+	// PurgedUntil The step runs which finished up to this time have been purged.
+	PurgedUntil *time.Time `json:"purgedUntil,omitempty"`
+
+	// RetentionSeconds How long the matched values are kept after a step run finished, in seconds.
+	RetentionSeconds int `json:"retentionSeconds"`
+
+	// TenantId The unique identifier for the tenant that the rule belongs to.
+	TenantId openapi_types.UUID `json:"tenantId"`
+}
+
 // PaginationResponse defines model for PaginationResponse.
 type PaginationResponse struct {
 	// CurrentPage the current page
@@ -423,6 +1387,23 @@ type PaginationResponse struct {
 	NumPages *int64 `json:"num_pages,omitempty"`
 }
 
+// PauseRequest defines model for PauseRequest.
+type PauseRequest struct {
+	// CancelRuns Whether to cancel the step runs which are queued or running when pausing.
+	CancelRuns *bool `json:"cancelRuns,omitempty"`
+
+	// TriggerBehavior What happens to triggers of a paused workflow or tenant. `REJECT` rejects the triggers, and `BUFFER` creates the workflow runs but only starts them once the workflow or tenant is resumed.
+	TriggerBehavior *PausedTriggerBehavior `json:"triggerBehavior,omitempty"`
+}
+
+// PausedTriggerBehavior What happens to triggers of a paused workflow or tenant. `REJECT` rejects the triggers, and `BUFFER` creates the workflow runs but only starts them once the workflow or tenant is resumed.
+type PausedTriggerBehavior string
+
+// PreviewEnvironmentHook Whether the workflow provisions or tears down the preview environments of pull requests on the linked
+// repository. Provision workflows are triggered when a pull request is opened, reopened or updated, and teardown
+// workflows when it's closed.
+type PreviewEnvironmentHook string
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
 	PullRequestBaseBranch string           `json:"pullRequestBaseBranch"`
@@ -438,6 +1419,82 @@ type PullRequest struct {
 // PullRequestState defines model for PullRequestState.
 type PullRequestState string
 
+// QueryTrigger defines model for QueryTrigger.
+type QueryTrigger struct {
+	// Cron The cron expression of the schedule of the query, evaluated in UTC.
+	Cron string `json:"cron"`
+
+	// Enabled Whether the query runs on its schedule.
+	Enabled bool `json:"enabled"`
+
+	// EventKey The key of the events which are created for the rows which the query returns.
+	EventKey string `json:"eventKey"`
+
+	// LastError The error of the last run, if it failed.
+	LastError *string `json:"lastError,omitempty"`
+
+	// LastRunAt When the query last ran.
+	LastRunAt *time.Time       `json:"lastRunAt,omitempty"`
+	Metadata  APIResourceMeta  `json:"metadata"`
+	Mode      QueryTriggerMode `json:"mode"`
+
+	// Name The name of the trigger.
+	Name string `json:"name"`
+
+	// NextRunAt When the query runs next.
+	NextRunAt time.Time `json:"nextRunAt"`
+
+	// Query The read-only SQL query which is run.
+	Query string `json:"query"`
+
+	// TenantId The unique identifier for the tenant that the trigger belongs to.
+	TenantId openapi_types.UUID `json:"tenantId"`
+}
+
+// QueryTriggerMode defines model for QueryTriggerMode.
+type QueryTriggerMode string
+
+// RecurringTask defines model for RecurringTask.
+type RecurringTask struct {
+	// Cron The cron expression of the schedule of the task, evaluated in UTC.
+	Cron string `json:"cron"`
+
+	// Enabled Whether the task runs on its schedule.
+	Enabled bool `json:"enabled"`
+
+	// EventData The data of the event which is emitted, for EMIT_EVENT tasks.
+	EventData *map[string]interface{} `json:"eventData,omitempty"`
+
+	// EventKey The key of the event which is emitted, for EMIT_EVENT tasks.
+	EventKey *string           `json:"eventKey,omitempty"`
+	Kind     RecurringTaskKind `json:"kind"`
+
+	// LastError The error of the last run, if it failed.
+	LastError *string `json:"lastError,omitempty"`
+
+	// LastRunAt When the task last ran.
+	LastRunAt *time.Time      `json:"lastRunAt,omitempty"`
+	Metadata  APIResourceMeta `json:"metadata"`
+
+	// Name The name of the task.
+	Name string `json:"name"`
+
+	// NextRunAt When the task runs next.
+	NextRunAt time.Time `json:"nextRunAt"`
+
+	// Retention How long finished runs are kept before they're purged, for PURGE_WORKFLOW_RUNS tasks.
+	Retention *string `json:"retention,omitempty"`
+
+	// TenantId The unique identifier for the tenant that the task belongs to.
+	TenantId openapi_types.UUID `json:"tenantId"`
+
+	// WorkflowId The workflow whose finished runs are purged, for PURGE_WORKFLOW_RUNS tasks.
+	WorkflowId *openapi_types.UUID `json:"workflowId,omitempty"`
+}
+
+// RecurringTaskKind defines model for RecurringTaskKind.
+type RecurringTaskKind string
+
 // RejectInviteRequest defines model for RejectInviteRequest.
 type RejectInviteRequest struct {
 	Invite string `json:"invite" validate:"required,uuid"`
@@ -448,6 +1505,16 @@ type ReplayEventRequest struct {
 	EventIds []openapi_types.UUID `json:"eventIds"`
 }
 
+// ReplayWorkflowRunRequest defines model for ReplayWorkflowRunRequest.
+type ReplayWorkflowRunRequest struct {
+	// Input The input of the replay. If omitted, the input of the replayed run is used.
+	Input *map[string]interface{} `json:"input,omitempty"`
+
+	// StartFromStepId The step to start the replay from. The step runs which succeeded in the replayed run are copied to the replay,
+	// along with their outputs, except for this step and the steps after it. If omitted, every step is run.
+	StartFromStepId *openapi_types.UUID `json:"startFromStepId,omitempty"`
+}
+
 // RerunStepRunRequest defines model for RerunStepRunRequest.
 type RerunStepRunRequest struct {
 	Input map[string]interface{} `json:"input"`
@@ -466,13 +1533,40 @@ type SNSIntegration struct {
 	TopicArn string `json:"topicArn"`
 }
 
+// ScheduledWorkflowRun defines model for ScheduledWorkflowRun.
+type ScheduledWorkflowRun struct {
+	Input    *map[string]interface{} `json:"input,omitempty"`
+	Metadata APIResourceMeta         `json:"metadata"`
+
+	// TriggerAt The time at which the workflow will be triggered.
+	TriggerAt    time.Time `json:"triggerAt"`
+	WorkflowId   string    `json:"workflowId"`
+	WorkflowName string    `json:"workflowName"`
+
+	// WorkflowRunId The id of the workflow run which was created by this schedule, if it has been triggered.
+	WorkflowRunId     *string `json:"workflowRunId,omitempty"`
+	WorkflowVersionId string  `json:"workflowVersionId"`
+}
+
+// ScheduledWorkflowRunList defines model for ScheduledWorkflowRunList.
+type ScheduledWorkflowRunList struct {
+	Pagination *PaginationResponse     `json:"pagination,omitempty"`
+	Rows       *[]ScheduledWorkflowRun `json:"rows,omitempty"`
+}
+
 // Step defines model for Step.
 type Step struct {
-	Action   string          `json:"action"`
-	Children *[]string       `json:"children,omitempty"`
-	JobId    string          `json:"jobId"`
-	Metadata APIResourceMeta `json:"metadata"`
-	Parents  *[]string       `json:"parents,omitempty"`
+	Action   string    `json:"action"`
+	Children *[]string `json:"children,omitempty"`
+	JobId    string    `json:"jobId"`
+
+	// JoinQuorum The number of parents which must succeed before the step runs, for the QUORUM join strategy.
+	JoinQuorum *int `json:"joinQuorum,omitempty"`
+
+	// JoinStrategy How many parents must succeed before the step runs, one of ALL, ANY or QUORUM.
+	JoinStrategy *string         `json:"joinStrategy,omitempty"`
+	Metadata     APIResourceMeta `json:"metadata"`
+	Parents      *[]string       `json:"parents,omitempty"`
 
 	// ReadableId The readable id of the step.
 	ReadableId string `json:"readableId"`
@@ -484,31 +1578,114 @@ type Step struct {
 
 // StepRun defines model for StepRun.
 type StepRun struct {
-	CancelledAt      *time.Time              `json:"cancelledAt,omitempty"`
-	CancelledAtEpoch *int                    `json:"cancelledAtEpoch,omitempty"`
-	CancelledError   *string                 `json:"cancelledError,omitempty"`
-	CancelledReason  *string                 `json:"cancelledReason,omitempty"`
-	Children         *[]string               `json:"children,omitempty"`
-	Error            *string                 `json:"error,omitempty"`
-	FinishedAt       *time.Time              `json:"finishedAt,omitempty"`
-	FinishedAtEpoch  *int                    `json:"finishedAtEpoch,omitempty"`
-	Input            *string                 `json:"input,omitempty"`
-	JobRun           *JobRun                 `json:"jobRun,omitempty"`
-	JobRunId         string                  `json:"jobRunId"`
-	Metadata         APIResourceMeta         `json:"metadata"`
-	Output           *string                 `json:"output,omitempty"`
-	Parents          *[]string               `json:"parents,omitempty"`
-	RequeueAfter     *time.Time              `json:"requeueAfter,omitempty"`
-	Result           *map[string]interface{} `json:"result,omitempty"`
-	StartedAt        *time.Time              `json:"startedAt,omitempty"`
-	StartedAtEpoch   *int                    `json:"startedAtEpoch,omitempty"`
-	Status           StepRunStatus           `json:"status"`
-	Step             *Step                   `json:"step,omitempty"`
-	StepId           string                  `json:"stepId"`
-	TenantId         string                  `json:"tenantId"`
-	TimeoutAt        *time.Time              `json:"timeoutAt,omitempty"`
-	TimeoutAtEpoch   *int                    `json:"timeoutAtEpoch,omitempty"`
-	WorkerId         *string                 `json:"workerId,omitempty"`
+	CancelledAt      *time.Time          `json:"cancelledAt,omitempty"`
+	CancelledAtEpoch *int                `json:"cancelledAtEpoch,omitempty"`
+	CancelledError   *string             `json:"cancelledError,omitempty"`
+	CancelledReason  *string             `json:"cancelledReason,omitempty"`
+	CancelledSource  *CancellationSource `json:"cancelledSource,omitempty"`
+	Children         *[]string           `json:"children,omitempty"`
+	Error            *string             `json:"error,omitempty"`
+	FinishedAt       *time.Time          `json:"finishedAt,omitempty"`
+	FinishedAtEpoch  *int                `json:"finishedAtEpoch,omitempty"`
+	Input            *string             `json:"input,omitempty"`
+	JobRun           *JobRun             `json:"jobRun,omitempty"`
+	JobRunId         string              `json:"jobRunId"`
+
+	// Logs The logs of the step run. Only returned when logs are included.
+	Logs           *[]LogLine              `json:"logs,omitempty"`
+	Metadata       APIResourceMeta         `json:"metadata"`
+	Output         *string                 `json:"output,omitempty"`
+	Parents        *[]string               `json:"parents,omitempty"`
+	RequeueAfter   *time.Time              `json:"requeueAfter,omitempty"`
+	Result         *map[string]interface{} `json:"result,omitempty"`
+	StartedAt      *time.Time              `json:"startedAt,omitempty"`
+	StartedAtEpoch *int                    `json:"startedAtEpoch,omitempty"`
+	Status         StepRunStatus           `json:"status"`
+	Step           *Step                   `json:"step,omitempty"`
+	StepId         string                  `json:"stepId"`
+
+	// SubStatus Why a step run in a pending state hasn't progressed.
+	SubStatus *StepRunSubStatus `json:"subStatus,omitempty"`
+	TenantId  string            `json:"tenantId"`
+
+	// Timeline The timeline of the latest attempt of a step run. Phases which have not happened yet are not set.
+	Timeline       *StepRunTimeline `json:"timeline,omitempty"`
+	TimeoutAt      *time.Time       `json:"timeoutAt,omitempty"`
+	TimeoutAtEpoch *int             `json:"timeoutAtEpoch,omitempty"`
+
+	// ToleratedByJoin Whether the failure or cancellation of the step run is tolerated, because it was on a branch of a join step which could still run.
+	ToleratedByJoin *bool   `json:"toleratedByJoin,omitempty"`
+	WorkerId        *string `json:"workerId,omitempty"`
+}
+
+// StepRunActionMetrics defines model for StepRunActionMetrics.
+type StepRunActionMetrics struct {
+	ActionId string `json:"actionId"`
+
+	// Count The number of step runs which the latencies are computed from.
+	Count       int64               `json:"count"`
+	Dispatch    StepRunPhaseLatency `json:"dispatch"`
+	Execution   StepRunPhaseLatency `json:"execution"`
+	Persistence StepRunPhaseLatency `json:"persistence"`
+	Queue       StepRunPhaseLatency `json:"queue"`
+	SlotWait    StepRunPhaseLatency `json:"slotWait"`
+}
+
+// StepRunAttempt A previous attempt of a step run, which was archived when the step run was retried or rerun.
+type StepRunAttempt struct {
+	CancelledAt     *time.Time      `json:"cancelledAt,omitempty"`
+	CancelledError  *string         `json:"cancelledError,omitempty"`
+	CancelledReason *string         `json:"cancelledReason,omitempty"`
+	Error           *string         `json:"error,omitempty"`
+	FinishedAt      *time.Time      `json:"finishedAt,omitempty"`
+	Input           *string         `json:"input,omitempty"`
+	Metadata        APIResourceMeta `json:"metadata"`
+	Output          *string         `json:"output,omitempty"`
+
+	// RetryCount The retry count of the step run when the attempt ran. The first attempt has a retry count of 0.
+	RetryCount int        `json:"retryCount"`
+	StartedAt  *time.Time `json:"startedAt,omitempty"`
+	StepRunId  string     `json:"stepRunId"`
+	TimeoutAt  *time.Time `json:"timeoutAt,omitempty"`
+
+	// WorkerId The worker which ran the attempt.
+	WorkerId *string `json:"workerId,omitempty"`
+}
+
+// StepRunAttemptList defines model for StepRunAttemptList.
+type StepRunAttemptList struct {
+	Rows *[]StepRunAttempt `json:"rows,omitempty"`
+}
+
+// StepRunDiagnostic A snapshot of an attempt of a step run, which was captured when the attempt succeeded or failed because its workflow run was sampled for diagnostics.
+type StepRunDiagnostic struct {
+	Error      *string         `json:"error,omitempty"`
+	FinishedAt *time.Time      `json:"finishedAt,omitempty"`
+	Input      *string         `json:"input,omitempty"`
+	Metadata   APIResourceMeta `json:"metadata"`
+	Output     *string         `json:"output,omitempty"`
+
+	// RetryCount The retry count of the step run when the attempt ran. The first attempt has a retry count of 0.
+	RetryCount int `json:"retryCount"`
+
+	// RunTraceParent The W3C traceparent of the span which created the workflow run. Not set if tracing was disabled when the run was created.
+	RunTraceParent *string    `json:"runTraceParent,omitempty"`
+	StartedAt      *time.Time `json:"startedAt,omitempty"`
+	StepRunId      string     `json:"stepRunId"`
+
+	// Timeline The timeline of the latest attempt of a step run. Phases which have not happened yet are not set.
+	Timeline *StepRunTimeline `json:"timeline,omitempty"`
+
+	// TraceParent The W3C traceparent of the span which captured the attempt.
+	TraceParent *string `json:"traceParent,omitempty"`
+
+	// WorkerId The worker which ran the attempt.
+	WorkerId *string `json:"workerId,omitempty"`
+}
+
+// StepRunDiagnosticList defines model for StepRunDiagnosticList.
+type StepRunDiagnosticList struct {
+	Rows *[]StepRunDiagnostic `json:"rows,omitempty"`
 }
 
 // StepRunDiff defines model for StepRunDiff.
@@ -518,20 +1695,211 @@ type StepRunDiff struct {
 	Original string `json:"original"`
 }
 
+// StepRunList defines model for StepRunList.
+type StepRunList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]StepRun          `json:"rows,omitempty"`
+}
+
+// StepRunMetrics defines model for StepRunMetrics.
+type StepRunMetrics struct {
+	// NoCapacity The actions which have step runs waiting for a worker with a free slot, which are not included in the latencies until they are assigned.
+	NoCapacity []StepRunNoCapacityMetrics `json:"noCapacity"`
+	Rows       []StepRunActionMetrics     `json:"rows"`
+	Since      time.Time                  `json:"since"`
+}
+
+// StepRunNoCapacityMetrics defines model for StepRunNoCapacityMetrics.
+type StepRunNoCapacityMetrics struct {
+	ActionId string `json:"actionId"`
+
+	// Count The number of step runs of the action which are waiting for a worker with a free slot.
+	Count int64 `json:"count"`
+
+	// WaitingSince When the step run which has waited the longest started waiting.
+	WaitingSince time.Time `json:"waitingSince"`
+}
+
+// StepRunPhaseLatency defines model for StepRunPhaseLatency.
+type StepRunPhaseLatency struct {
+	// P50 The median latency in milliseconds.
+	P50 float64 `json:"p50"`
+
+	// P95 The 95th percentile latency in milliseconds.
+	P95 float64 `json:"p95"`
+}
+
 // StepRunStatus defines model for StepRunStatus.
 type StepRunStatus string
 
+// StepRunStreamEvent A chunk of output which a step run streamed before it finished.
+type StepRunStreamEvent struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Index The index of the stream event in the output stream of the step run. Indexes continue across the retries of a step run.
+	Index   int    `json:"index"`
+	Message string `json:"message"`
+
+	// RetryCount The retry count of the step run when the stream event was sent.
+	RetryCount int `json:"retryCount"`
+}
+
+// StepRunStreamEventList defines model for StepRunStreamEventList.
+type StepRunStreamEventList struct {
+	Rows *[]StepRunStreamEvent `json:"rows,omitempty"`
+}
+
+// StepRunSubStatus Why a step run in a pending state hasn't progressed.
+type StepRunSubStatus string
+
+// StepRunTimeline The timeline of the latest attempt of a step run. Phases which have not happened yet are not set.
+type StepRunTimeline struct {
+	AssignedAt *time.Time `json:"assignedAt,omitempty"`
+
+	// DispatchMs The time from the assignment to the worker starting the step run.
+	DispatchMs *int `json:"dispatchMs,omitempty"`
+
+	// ExecutionMs The time the worker spent running the step.
+	ExecutionMs *int `json:"executionMs,omitempty"`
+
+	// PersistenceMs The time from the worker finishing the step run to its result being written.
+	PersistenceMs *int `json:"persistenceMs,omitempty"`
+
+	// QueueMs The time from queueing the step run to the first assignment attempt which found no free slot, or to the assignment.
+	QueueMs           *int       `json:"queueMs,omitempty"`
+	QueuedAt          *time.Time `json:"queuedAt,omitempty"`
+	ResultPersistedAt *time.Time `json:"resultPersistedAt,omitempty"`
+
+	// SlotWaitMs The time spent waiting for a worker with a free slot.
+	SlotWaitMs *int `json:"slotWaitMs,omitempty"`
+
+	// SlotWaitStartedAt When the step run first found no worker with a free slot. Not set if a worker was available right away.
+	SlotWaitStartedAt *time.Time `json:"slotWaitStartedAt,omitempty"`
+}
+
+// SubjectDeletionReport defines model for SubjectDeletionReport.
+type SubjectDeletionReport struct {
+	// DryRun Whether nothing was deleted, because the deletion was a dry run.
+	DryRun bool `json:"dryRun"`
+
+	// Events The number of event payloads which were deleted.
+	Events int `json:"events"`
+
+	// GetGroupKeyRuns The number of get group key run inputs and outputs which were deleted.
+	GetGroupKeyRuns int `json:"getGroupKeyRuns"`
+
+	// HasMore Whether the subject has more workflow runs than were deleted at once, so the deletion should be repeated.
+	HasMore bool `json:"hasMore"`
+
+	// JobRunLookupData The number of job run inputs and step outputs which were deleted.
+	JobRunLookupData int `json:"jobRunLookupData"`
+
+	// Key The run metadata key which identifies the subject.
+	Key string `json:"key"`
+
+	// LogLines The number of log lines which were deleted.
+	LogLines int `json:"logLines"`
+
+	// SkippedWorkflowRunIds The workflow runs of the subject which are still running, and whose data was not deleted.
+	SkippedWorkflowRunIds []openapi_types.UUID `json:"skippedWorkflowRunIds"`
+
+	// StepRunResultArchives The number of inputs, outputs and errors of previous step run attempts which were deleted.
+	StepRunResultArchives int `json:"stepRunResultArchives"`
+
+	// StepRuns The number of step run inputs, outputs and errors which were deleted.
+	StepRuns int `json:"stepRuns"`
+
+	// StreamEvents The number of streamed step run events which were deleted.
+	StreamEvents int `json:"streamEvents"`
+
+	// WorkflowRunIds The workflow runs of the subject whose data was deleted.
+	WorkflowRunIds []openapi_types.UUID `json:"workflowRunIds"`
+
+	// WorkflowRunTriggers The number of workflow run trigger inputs which were deleted.
+	WorkflowRunTriggers int `json:"workflowRunTriggers"`
+}
+
 // Tenant defines model for Tenant.
 type Tenant struct {
-	Metadata APIResourceMeta `json:"metadata"`
+	AssignmentStrategy *WorkerAssignmentStrategy `json:"assignmentStrategy,omitempty"`
+
+	// DependencyHealth The health of the dependencies of the tenant, which the expression preflight checks of steps are evaluated against.
+	DependencyHealth *map[string]interface{} `json:"dependencyHealth,omitempty"`
+	Metadata         APIResourceMeta         `json:"metadata"`
 
 	// Name The name of the tenant.
 	Name string `json:"name"`
 
+	// Paused Whether the tenant is paused, in which case no workflow runs of the tenant are started.
+	Paused *bool `json:"paused,omitempty"`
+
+	// PausedTriggerBehavior What happens to triggers of a paused workflow or tenant. `REJECT` rejects the triggers, and `BUFFER` creates the workflow runs but only starts them once the workflow or tenant is resumed.
+	PausedTriggerBehavior *PausedTriggerBehavior `json:"pausedTriggerBehavior,omitempty"`
+
+	// RedactionRules JSONPath expressions for the values which are redacted from step run inputs and outputs.
+	RedactionRules *[]string `json:"redactionRules,omitempty"`
+
 	// Slug The slug of the tenant.
 	Slug string `json:"slug"`
 }
 
+// TenantEngineSettings defines model for TenantEngineSettings.
+type TenantEngineSettings struct {
+	// DefaultStepRetries The number of times step runs are retried if their step doesn't set retries.
+	DefaultStepRetries int `json:"defaultStepRetries"`
+
+	// DefaultStepTimeout The timeout of step runs whose step doesn't set one.
+	DefaultStepTimeout string `json:"defaultStepTimeout"`
+
+	// MaxFanOut The maximum number of workflow runs which a single event triggers, or 0 for no limit.
+	MaxFanOut int                               `json:"maxFanOut"`
+	Overrides UpdateTenantEngineSettingsRequest `json:"overrides"`
+
+	// ScheduleTimeout How long a step run waits to be assigned to a worker if its step doesn't set a schedule timeout.
+	ScheduleTimeout string `json:"scheduleTimeout"`
+}
+
+// TenantExport defines model for TenantExport.
+type TenantExport struct {
+	// ArchiveSha256 The hex-encoded SHA-256 of the archive, once the export succeeded.
+	ArchiveSha256 *string `json:"archiveSha256,omitempty"`
+
+	// ArchiveSize The size of the archive in bytes, once the export succeeded.
+	ArchiveSize *int    `json:"archiveSize,omitempty"`
+	Error       *string `json:"error,omitempty"`
+
+	// ExpiresAt The time after which the export and its archive are deleted.
+	ExpiresAt  *time.Time      `json:"expiresAt,omitempty"`
+	FinishedAt *time.Time      `json:"finishedAt,omitempty"`
+	Metadata   APIResourceMeta `json:"metadata"`
+
+	// RecordCount The number of records which were written so far.
+	RecordCount int `json:"recordCount"`
+
+	// SectionsDone The number of sections of the archive which were written so far.
+	SectionsDone int `json:"sectionsDone"`
+
+	// SectionsTotal The number of sections of the archive.
+	SectionsTotal int                `json:"sectionsTotal"`
+	StartedAt     *time.Time         `json:"startedAt,omitempty"`
+	Status        TenantExportStatus `json:"status"`
+
+	// TenantId The unique identifier for the tenant that the export belongs to.
+	TenantId openapi_types.UUID `json:"tenantId"`
+}
+
+// TenantExportDownloadURL defines model for TenantExportDownloadURL.
+type TenantExportDownloadURL struct {
+	// ExpiresAt The time after which the URL can't be used anymore.
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Url The signed URL which the archive of the export can be downloaded from without authentication.
+	Url string `json:"url"`
+}
+
+// TenantExportStatus defines model for TenantExportStatus.
+type TenantExportStatus string
+
 // TenantInvite defines model for TenantInvite.
 type TenantInvite struct {
 	// Email The email of the user to invite.
@@ -555,6 +1923,12 @@ type TenantInviteList struct {
 	Rows       *[]TenantInvite     `json:"rows,omitempty"`
 }
 
+// TenantList defines model for TenantList.
+type TenantList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]Tenant           `json:"rows,omitempty"`
+}
+
 // TenantMember defines model for TenantMember.
 type TenantMember struct {
 	Metadata APIResourceMeta  `json:"metadata"`
@@ -572,18 +1946,111 @@ type TenantMemberList struct {
 // TenantMemberRole defines model for TenantMemberRole.
 type TenantMemberRole string
 
-// TriggerWorkflowRunRequest defines model for TriggerWorkflowRunRequest.
-type TriggerWorkflowRunRequest struct {
-	Input map[string]interface{} `json:"input"`
-}
+// TriggerLink defines model for TriggerLink.
+type TriggerLink struct {
+	// DefaultInput The input which the body of every trigger request is merged onto.
+	DefaultInput *map[string]interface{} `json:"defaultInput,omitempty"`
 
-// UpdateTenantInviteRequest defines model for UpdateTenantInviteRequest.
-type UpdateTenantInviteRequest struct {
-	Role TenantMemberRole `json:"role"`
-}
+	// LastTriggeredAt When the trigger link last triggered a workflow run.
+	LastTriggeredAt *time.Time      `json:"lastTriggeredAt,omitempty"`
+	Metadata        APIResourceMeta `json:"metadata"`
 
-// User defines model for User.
-type User struct {
+	// Name The name of the trigger link.
+	Name string `json:"name"`
+
+	// TenantId The unique identifier for the tenant that the trigger link belongs to.
+	TenantId openapi_types.UUID `json:"tenantId"`
+
+	// WorkflowId The unique identifier for the workflow which the trigger link runs.
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+}
+
+// TriggerLinkRunResult defines model for TriggerLinkRunResult.
+type TriggerLinkRunResult struct {
+	// WorkflowRunId The id of the workflow run which was triggered.
+	WorkflowRunId openapi_types.UUID `json:"workflowRunId"`
+}
+
+// TriggerWorkflowRunRejected defines model for TriggerWorkflowRunRejected.
+type TriggerWorkflowRunRejected struct {
+	Backpressure BackpressureHint `json:"backpressure"`
+	Errors       []APIError       `json:"errors"`
+}
+
+// TriggerWorkflowRunRequest defines model for TriggerWorkflowRunRequest.
+type TriggerWorkflowRunRequest struct {
+	// AdditionalMetadata Metadata, such as a user id or a trace id, which is passed to every step run and the get group key run.
+	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
+
+	// Environment The environment of the run. It is ignored for requests with an environment API token, whose runs always belong
+	// to the environment of the token.
+	Environment *string                `json:"environment,omitempty" validate:"omitnil,hatchetName"`
+	Input       map[string]interface{} `json:"input"`
+
+	// Priority If true, the trigger is not rejected when the engine is shedding load.
+	Priority *bool `json:"priority,omitempty"`
+}
+
+// UpdateTenantEngineSettingsRequest defines model for UpdateTenantEngineSettingsRequest.
+type UpdateTenantEngineSettingsRequest struct {
+	// DefaultStepRetries The number of times step runs are retried if their step doesn't set retries.
+	DefaultStepRetries *int `json:"defaultStepRetries,omitempty"`
+
+	// DefaultStepTimeout The timeout of step runs whose step doesn't set one.
+	DefaultStepTimeout *string `json:"defaultStepTimeout,omitempty"`
+
+	// MaxFanOut The maximum number of workflow runs which a single event triggers, or 0 for no limit.
+	MaxFanOut *int `json:"maxFanOut,omitempty"`
+
+	// ScheduleTimeout How long a step run waits to be assigned to a worker if its step doesn't set a schedule timeout.
+	ScheduleTimeout *string `json:"scheduleTimeout,omitempty"`
+}
+
+// UpdateTenantInviteRequest defines model for UpdateTenantInviteRequest.
+type UpdateTenantInviteRequest struct {
+	Role TenantMemberRole `json:"role"`
+}
+
+// UpdateTenantRequest defines model for UpdateTenantRequest.
+type UpdateTenantRequest struct {
+	AssignmentStrategy *WorkerAssignmentStrategy `json:"assignmentStrategy,omitempty"`
+
+	// DependencyHealth The health of the dependencies of the tenant, which replaces the existing health. Expression preflight checks of
+	// steps are evaluated against it as `health`, for example `health.db.status == "up"`.
+	DependencyHealth *map[string]interface{} `json:"dependencyHealth,omitempty"`
+
+	// Name The name of the tenant.
+	Name *string `json:"name,omitempty" validate:"omitempty,min=1"`
+
+	// RedactionRules JSONPath expressions for the values which are redacted from step run inputs and outputs, which replace the existing
+	// rules. Expressions start with `$`, and support child names (`.token` or `['token']`), array indexes (`[0]`), wildcards
+	// (`.*` or `[*]`) and recursive descent (`..token`).
+	RedactionRules *[]string `json:"redactionRules,omitempty" validate:"omitempty,max=100,dive,required,max=256"`
+}
+
+// UpdateWorkflowDiagnosticsRequest defines model for UpdateWorkflowDiagnosticsRequest.
+type UpdateWorkflowDiagnosticsRequest struct {
+	// SampleRate The percentage of the new runs of the workflow which are sampled to capture extended diagnostics. A sample rate of 0 turns diagnostics off.
+	SampleRate int `json:"sampleRate" validate:"min=0,max=100"`
+}
+
+// UpdateWorkflowRolloutRequest defines model for UpdateWorkflowRolloutRequest.
+type UpdateWorkflowRolloutRequest struct {
+	// FailureRateThreshold The failure rate of the new version, between 0 and 1, above which the rollout is rolled back.
+	FailureRateThreshold float64 `json:"failureRateThreshold" validate:"min=0,max=1"`
+
+	// MinRuns The minimum number of finished runs of the new version in the window before the failure rate is checked.
+	MinRuns int `json:"minRuns" validate:"min=1"`
+
+	// Percentage The percentage of triggers which run the new version while it is rolled out. Setting the percentage of an active rollout to 100 completes the rollout.
+	Percentage int `json:"percentage" validate:"min=0,max=100"`
+
+	// WindowSeconds The number of seconds over which the failure rate of the new version is computed.
+	WindowSeconds int `json:"windowSeconds" validate:"min=1"`
+}
+
+// User defines model for User.
+type User struct {
 	// Email The email address of the user.
 	Email openapi_types.Email `json:"email"`
 
@@ -631,11 +2098,53 @@ type UserTenantPublic struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// DeliveryId The id of the delivery which was assigned by the source.
+	DeliveryId *string `json:"deliveryId,omitempty"`
+
+	// Error The reason the delivery failed or was rejected.
+	Error *string `json:"error,omitempty"`
+
+	// Event The event type of the delivery.
+	Event    string          `json:"event"`
+	Metadata APIResourceMeta `json:"metadata"`
+
+	// Nonce The SHA-256 digest of the payload of the delivery, which is unique for each delivery of the webhook.
+	Nonce string `json:"nonce"`
+
+	// SentAt When the delivery was sent, read from its payload.
+	SentAt *time.Time            `json:"sentAt,omitempty"`
+	Source WebhookDeliverySource `json:"source"`
+	Status WebhookDeliveryStatus `json:"status"`
+
+	// TenantId The unique identifier for the tenant that the delivery belongs to.
+	TenantId openapi_types.UUID `json:"tenantId"`
+
+	// WebhookId The id of the webhook which received the delivery.
+	WebhookId openapi_types.UUID `json:"webhookId"`
+}
+
+// WebhookDeliverySource defines model for WebhookDeliverySource.
+type WebhookDeliverySource string
+
+// WebhookDeliveryStatus defines model for WebhookDeliveryStatus.
+type WebhookDeliveryStatus string
+
 // Worker defines model for Worker.
 type Worker struct {
 	// Actions The actions this worker can perform.
 	Actions *[]string `json:"actions,omitempty"`
 
+	// BuildId The build id of the code of the worker, such as a commit sha.
+	BuildId *string `json:"buildId,omitempty"`
+
+	// Environment The environment of the worker, which is set by the API token the worker registered with.
+	Environment *string `json:"environment,omitempty"`
+
+	// Labels The labels of the worker, such as its region or host.
+	Labels *map[string]string `json:"labels,omitempty"`
+
 	// LastHeartbeatAt The time this worker last sent a heartbeat.
 	LastHeartbeatAt *time.Time      `json:"lastHeartbeatAt,omitempty"`
 	Metadata        APIResourceMeta `json:"metadata"`
@@ -647,6 +2156,9 @@ type Worker struct {
 	RecentStepRuns *[]StepRun `json:"recentStepRuns,omitempty"`
 }
 
+// WorkerAssignmentStrategy defines model for WorkerAssignmentStrategy.
+type WorkerAssignmentStrategy string
+
 // WorkerList defines model for WorkerList.
 type WorkerList struct {
 	Pagination *PaginationResponse `json:"pagination,omitempty"`
@@ -660,24 +2172,40 @@ type Workflow struct {
 	// Description The description of the workflow.
 	Description *string `json:"description,omitempty"`
 
+	// DiagnosticsSampleRate The percentage of the runs of the workflow which are sampled to capture extended diagnostics.
+	DiagnosticsSampleRate *int `json:"diagnosticsSampleRate,omitempty"`
+
 	// Jobs The jobs of the workflow.
-	Jobs     *[]Job          `json:"jobs,omitempty"`
-	LastRun  *WorkflowRun    `json:"lastRun,omitempty"`
-	Metadata APIResourceMeta `json:"metadata"`
+	Jobs    *[]Job       `json:"jobs,omitempty"`
+	LastRun *WorkflowRun `json:"lastRun,omitempty"`
+
+	// MaintenanceUntil The end of the maintenance window which is in progress, until which runs of the workflow are buffered.
+	MaintenanceUntil *time.Time      `json:"maintenanceUntil,omitempty"`
+	Metadata         APIResourceMeta `json:"metadata"`
 
 	// Name The name of the workflow.
 	Name string `json:"name"`
 
-	// Tags The tags of the workflow.
-	Tags     *[]WorkflowTag         `json:"tags,omitempty"`
-	Versions *[]WorkflowVersionMeta `json:"versions,omitempty"`
+	// Namespace The namespace of the workflow, which prefixes its name. It is not set for workflows in the default namespace.
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Paused Whether the workflow is paused, in which case none of its runs are started.
+	Paused *bool `json:"paused,omitempty"`
+
+	// PausedTriggerBehavior What happens to triggers of a paused workflow or tenant. `REJECT` rejects the triggers, and `BUFFER` creates the workflow runs but only starts them once the workflow or tenant is resumed.
+	PausedTriggerBehavior *PausedTriggerBehavior `json:"pausedTriggerBehavior,omitempty"`
+	Tags                  *[]WorkflowTag         `json:"tags,omitempty"`
+	Versions              *[]WorkflowVersionMeta `json:"versions,omitempty"`
 }
 
 // WorkflowConcurrency defines model for WorkflowConcurrency.
 type WorkflowConcurrency struct {
-	// GetConcurrencyGroup An action which gets the concurrency group for the WorkflowRun.
+	// GetConcurrencyGroup An action which gets the concurrency group for the WorkflowRun. This is empty if the group key is computed from key expressions.
 	GetConcurrencyGroup string `json:"getConcurrencyGroup"`
 
+	// KeyExpressions The expressions which the concurrency group key of the WorkflowRun is computed from, instead of an action.
+	KeyExpressions *[]string `json:"keyExpressions,omitempty"`
+
 	// LimitStrategy The strategy to use when the concurrency limit is reached.
 	LimitStrategy WorkflowConcurrencyLimitStrategy `json:"limitStrategy"`
 
@@ -688,6 +2216,39 @@ type WorkflowConcurrency struct {
 // WorkflowConcurrencyLimitStrategy The strategy to use when the concurrency limit is reached.
 type WorkflowConcurrencyLimitStrategy string
 
+// WorkflowConcurrencyQueueDepth defines model for WorkflowConcurrencyQueueDepth.
+type WorkflowConcurrencyQueueDepth struct {
+	// Components The queue depth aggregated along each component of the group keys, for groups which are computed from multiple key expressions.
+	Components []WorkflowConcurrencyQueueDepthComponent `json:"components"`
+
+	// Groups The concurrency groups with the most queued runs.
+	Groups []WorkflowConcurrencyQueueDepthValue `json:"groups"`
+}
+
+// WorkflowConcurrencyQueueDepthComponent defines model for WorkflowConcurrencyQueueDepthComponent.
+type WorkflowConcurrencyQueueDepthComponent struct {
+	// Expression The key expression at the position in the latest version of the workflow.
+	Expression *string `json:"expression,omitempty"`
+
+	// Position The position of the key expression which computes the component, starting from 1.
+	Position int `json:"position"`
+
+	// Values The values of the component with the most queued runs.
+	Values []WorkflowConcurrencyQueueDepthValue `json:"values"`
+}
+
+// WorkflowConcurrencyQueueDepthValue defines model for WorkflowConcurrencyQueueDepthValue.
+type WorkflowConcurrencyQueueDepthValue struct {
+	// Queued The number of queued runs with the value.
+	Queued int64 `json:"queued"`
+
+	// Running The number of running runs with the value.
+	Running int64 `json:"running"`
+
+	// Value The group key, or the value of a component of the group key.
+	Value string `json:"value"`
+}
+
 // WorkflowDeploymentConfig defines model for WorkflowDeploymentConfig.
 type WorkflowDeploymentConfig struct {
 	// GitRepoBranch The repository branch.
@@ -703,6 +2264,11 @@ type WorkflowDeploymentConfig struct {
 	// GithubAppInstallationId The id of the Github App installation.
 	GithubAppInstallationId openapi_types.UUID `json:"githubAppInstallationId"`
 	Metadata                APIResourceMeta    `json:"metadata"`
+
+	// PreviewEnvironmentHook Whether the workflow provisions or tears down the preview environments of pull requests on the linked
+	// repository. Provision workflows are triggered when a pull request is opened, reopened or updated, and teardown
+	// workflows when it's closed.
+	PreviewEnvironmentHook *PreviewEnvironmentHook `json:"previewEnvironmentHook,omitempty"`
 }
 
 // WorkflowID A workflow ID.
@@ -715,28 +2281,378 @@ type WorkflowList struct {
 	Rows       *[]Workflow         `json:"rows,omitempty"`
 }
 
+// WorkflowRollout defines model for WorkflowRollout.
+type WorkflowRollout struct {
+	// FailureRate The failure rate of the new version when the rollout was rolled back.
+	FailureRate *float64 `json:"failureRate,omitempty"`
+
+	// FailureRateThreshold The failure rate of the new version, between 0 and 1, above which the rollout is rolled back.
+	FailureRateThreshold float64 `json:"failureRateThreshold"`
+
+	// FinishedAt When the rollout was completed or rolled back.
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// FromVersionId The version which receives the triggers which don't run the new version.
+	FromVersionId *openapi_types.UUID `json:"fromVersionId,omitempty"`
+	Metadata      APIResourceMeta     `json:"metadata"`
+
+	// MinRuns The minimum number of finished runs of the new version in the window before the failure rate is checked.
+	MinRuns int `json:"minRuns"`
+
+	// Percentage The percentage of triggers which run the new version while it is rolled out.
+	Percentage int `json:"percentage"`
+
+	// StartedAt When the new version started to be rolled out.
+	StartedAt *time.Time            `json:"startedAt,omitempty"`
+	Status    WorkflowRolloutStatus `json:"status"`
+
+	// ToVersionId The new version which is rolled out.
+	ToVersionId *openapi_types.UUID `json:"toVersionId,omitempty"`
+
+	// WindowSeconds The number of seconds over which the failure rate of the new version is computed.
+	WindowSeconds int `json:"windowSeconds"`
+
+	// WorkflowId The unique identifier for the workflow that the rollout policy belongs to.
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+}
+
+// WorkflowRolloutStatus defines model for WorkflowRolloutStatus.
+type WorkflowRolloutStatus string
+
 // WorkflowRun defines model for WorkflowRun.
 type WorkflowRun struct {
-	DisplayName       *string                 `json:"displayName,omitempty"`
-	Error             *string                 `json:"error,omitempty"`
-	FinishedAt        *time.Time              `json:"finishedAt,omitempty"`
-	Input             *map[string]interface{} `json:"input,omitempty"`
-	JobRuns           *[]JobRun               `json:"jobRuns,omitempty"`
-	Metadata          APIResourceMeta         `json:"metadata"`
-	StartedAt         *time.Time              `json:"startedAt,omitempty"`
-	Status            WorkflowRunStatus       `json:"status"`
-	TenantId          string                  `json:"tenantId"`
-	TriggeredBy       WorkflowRunTriggeredBy  `json:"triggeredBy"`
-	WorkflowVersion   *WorkflowVersion        `json:"workflowVersion,omitempty"`
-	WorkflowVersionId string                  `json:"workflowVersionId"`
+	// AdditionalMetadata The metadata which was set when the run was triggered, and is passed to every step run.
+	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
+
+	// BuildId The build id which the run is pinned to. The step runs of the run are only assigned to workers of the build.
+	BuildId         *string             `json:"buildId,omitempty"`
+	CancelledSource *CancellationSource `json:"cancelledSource,omitempty"`
+
+	// Debug Whether the run is a debug run, such as a replay. Debug runs are excluded from workflow run metrics.
+	Debug       *bool   `json:"debug,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Environment The environment of the run. The step runs of the run are only assigned to workers of the environment.
+	Environment *string                 `json:"environment,omitempty"`
+	Error       *string                 `json:"error,omitempty"`
+	FinishedAt  *time.Time              `json:"finishedAt,omitempty"`
+	Input       *map[string]interface{} `json:"input,omitempty"`
+	JobRuns     *[]JobRun               `json:"jobRuns,omitempty"`
+	Metadata    APIResourceMeta         `json:"metadata"`
+
+	// Progress The number of step runs of a workflow run by status.
+	Progress *WorkflowRunProgress `json:"progress,omitempty"`
+
+	// ReplayOfId The id of the run which this run replays.
+	ReplayOfId        *openapi_types.UUID    `json:"replayOfId,omitempty"`
+	StartedAt         *time.Time             `json:"startedAt,omitempty"`
+	Status            WorkflowRunStatus      `json:"status"`
+	TenantId          string                 `json:"tenantId"`
+	TriggeredBy       WorkflowRunTriggeredBy `json:"triggeredBy"`
+	WorkflowVersion   *WorkflowVersion       `json:"workflowVersion,omitempty"`
+	WorkflowVersionId string                 `json:"workflowVersionId"`
+}
+
+// WorkflowRunAttestation A signed attestation of a finished workflow run. The attestation is a JWT which is signed with the keys of the instance, and
+// can be verified offline with the keys from /api/v1/meta/attestation-keys.
+type WorkflowRunAttestation struct {
+	// Attestation The signed attestation, as a compact JWT.
+	Attestation string                          `json:"attestation"`
+	Statement   WorkflowRunAttestationStatement `json:"statement"`
+}
+
+// WorkflowRunAttestationStatement The statement which an attestation signs. Inputs and outputs are attested by the hex-encoded SHA-256 of their canonical JSON
+// encoding, which has sorted object keys and no whitespace.
+type WorkflowRunAttestationStatement struct {
+	CreatedAt   time.Time                       `json:"createdAt"`
+	FinishedAt  *time.Time                      `json:"finishedAt,omitempty"`
+	InputSha256 string                          `json:"inputSha256"`
+	StartedAt   *time.Time                      `json:"startedAt,omitempty"`
+	Status      WorkflowRunStatus               `json:"status"`
+	StepRuns    []WorkflowRunAttestationStepRun `json:"stepRuns"`
+	TenantId    string                          `json:"tenantId"`
+
+	// Version The version of the attestation format.
+	Version           string  `json:"version"`
+	WorkflowName      string  `json:"workflowName"`
+	WorkflowRunId     string  `json:"workflowRunId"`
+	WorkflowVersion   *string `json:"workflowVersion,omitempty"`
+	WorkflowVersionId string  `json:"workflowVersionId"`
+}
+
+// WorkflowRunAttestationStepRun defines model for WorkflowRunAttestationStepRun.
+type WorkflowRunAttestationStepRun struct {
+	FinishedAt     *time.Time                    `json:"finishedAt,omitempty"`
+	InputSha256    *string                       `json:"inputSha256,omitempty"`
+	JobName        string                        `json:"jobName"`
+	OutputSha256   *string                       `json:"outputSha256,omitempty"`
+	RetryCount     int                           `json:"retryCount"`
+	StartedAt      *time.Time                    `json:"startedAt,omitempty"`
+	Status         StepRunStatus                 `json:"status"`
+	StepReadableId *string                       `json:"stepReadableId,omitempty"`
+	StepRunId      string                        `json:"stepRunId"`
+	Worker         *WorkflowRunAttestationWorker `json:"worker,omitempty"`
+}
+
+// WorkflowRunAttestationWorker defines model for WorkflowRunAttestationWorker.
+type WorkflowRunAttestationWorker struct {
+	BuildId  *string `json:"buildId,omitempty"`
+	Name     string  `json:"name"`
+	WorkerId string  `json:"workerId"`
+}
+
+// WorkflowRunBulkRetry defines model for WorkflowRunBulkRetry.
+type WorkflowRunBulkRetry struct {
+	Error *string `json:"error,omitempty"`
+
+	// FailedCount The number of matched runs which could not be re-queued.
+	FailedCount int                         `json:"failedCount"`
+	Filter      WorkflowRunBulkRetryRequest `json:"filter"`
+	FinishedAt  *time.Time                  `json:"finishedAt,omitempty"`
+
+	// MatchedCount The number of failed runs which matched the filter so far.
+	MatchedCount int             `json:"matchedCount"`
+	Metadata     APIResourceMeta `json:"metadata"`
+
+	// RetriedCount The number of matched runs which were re-queued.
+	RetriedCount int                        `json:"retriedCount"`
+	StartedAt    *time.Time                 `json:"startedAt,omitempty"`
+	Status       WorkflowRunBulkRetryStatus `json:"status"`
+	TenantId     string                     `json:"tenantId"`
+}
+
+// WorkflowRunBulkRetryRequest A filter for the failed workflow runs to retry. Only runs which have already failed when the bulk retry is created are retried.
+type WorkflowRunBulkRetryRequest struct {
+	// CreatedAfter Only retry runs created at or after this time.
+	CreatedAfter *time.Time `json:"createdAfter,omitempty"`
+
+	// CreatedBefore Only retry runs created before this time.
+	CreatedBefore *time.Time `json:"createdBefore,omitempty"`
+
+	// ErrorContains Only retry runs where the workflow run error or a failed step run error contains this substring.
+	ErrorContains *string `json:"errorContains,omitempty"`
+
+	// WorkflowId Only retry runs of this workflow.
+	WorkflowId *openapi_types.UUID `json:"workflowId,omitempty"`
+}
+
+// WorkflowRunBulkRetryStatus defines model for WorkflowRunBulkRetryStatus.
+type WorkflowRunBulkRetryStatus string
+
+// WorkflowRunBundle A portable export of a finished workflow run, which can be imported into another tenant or Hatchet instance. Step runs are
+// identified by the name of their job and the readable id of their step, since ids are not portable.
+type WorkflowRunBundle struct {
+	// BundleVersion The version of the bundle format.
+	BundleVersion string                  `json:"bundleVersion"`
+	DisplayName   *string                 `json:"displayName,omitempty"`
+	Error         *string                 `json:"error,omitempty"`
+	Event         *WorkflowRunBundleEvent `json:"event,omitempty"`
+	ExportedAt    time.Time               `json:"exportedAt"`
+	FinishedAt    *time.Time              `json:"finishedAt,omitempty"`
+	Input         map[string]interface{}  `json:"input"`
+
+	// RawDefinition The definition of the workflow version of the run, as YAML.
+	RawDefinition string                     `json:"rawDefinition"`
+	StartedAt     *time.Time                 `json:"startedAt,omitempty"`
+	Status        WorkflowRunStatus          `json:"status"`
+	StepRuns      []WorkflowRunBundleStepRun `json:"stepRuns"`
+}
+
+// WorkflowRunBundleEvent defines model for WorkflowRunBundleEvent.
+type WorkflowRunBundleEvent struct {
+	Data *map[string]interface{} `json:"data,omitempty"`
+	Key  string                  `json:"key"`
+}
+
+// WorkflowRunBundleStepRun defines model for WorkflowRunBundleStepRun.
+type WorkflowRunBundleStepRun struct {
+	CancelledAt     *time.Time              `json:"cancelledAt,omitempty"`
+	CancelledReason *string                 `json:"cancelledReason,omitempty"`
+	Error           *string                 `json:"error,omitempty"`
+	FinishedAt      *time.Time              `json:"finishedAt,omitempty"`
+	Input           *map[string]interface{} `json:"input,omitempty"`
+	JobName         string                  `json:"jobName"`
+	Output          *map[string]interface{} `json:"output,omitempty"`
+	RetryCount      int                     `json:"retryCount"`
+	StartedAt       *time.Time              `json:"startedAt,omitempty"`
+	Status          StepRunStatus           `json:"status"`
+	StepReadableId  string                  `json:"stepReadableId"`
+}
+
+// WorkflowRunDag defines model for WorkflowRunDag.
+type WorkflowRunDag struct {
+	Edges         []WorkflowRunDagEdge `json:"edges"`
+	Nodes         []WorkflowRunDagNode `json:"nodes"`
+	Status        WorkflowRunStatus    `json:"status"`
+	WorkflowRunId openapi_types.UUID   `json:"workflowRunId"`
+}
+
+// WorkflowRunDagEdge defines model for WorkflowRunDagEdge.
+type WorkflowRunDagEdge struct {
+	// Source The id of the parent step run.
+	Source openapi_types.UUID `json:"source"`
+
+	// Target The id of the child step run.
+	Target openapi_types.UUID `json:"target"`
+}
+
+// WorkflowRunDagNode defines model for WorkflowRunDagNode.
+type WorkflowRunDagNode struct {
+	Action string `json:"action"`
+
+	// Depth The number of steps on the longest path from a root of the DAG to this step. Roots have a depth of 0.
+	Depth int `json:"depth"`
+
+	// DurationMs The time from the start to the finish of the step run. Not set if the step run has not finished.
+	DurationMs *int       `json:"durationMs,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// Id The id of the step run.
+	Id       openapi_types.UUID `json:"id"`
+	JobName  string             `json:"jobName"`
+	JobRunId openapi_types.UUID `json:"jobRunId"`
+
+	// ReadableId The readable id of the step, which is unique within the job.
+	ReadableId string             `json:"readableId"`
+	RetryCount int                `json:"retryCount"`
+	StartedAt  *time.Time         `json:"startedAt,omitempty"`
+	Status     StepRunStatus      `json:"status"`
+	StepId     openapi_types.UUID `json:"stepId"`
+}
+
+// WorkflowRunExportFormat defines model for WorkflowRunExportFormat.
+type WorkflowRunExportFormat string
+
+// WorkflowRunExportRow A single workflow run in an export. Each NDJSON line and CSV record contains one row.
+type WorkflowRunExportRow struct {
+	ConcurrencyGroupId *string   `json:"concurrencyGroupId,omitempty"`
+	CreatedAt          time.Time `json:"createdAt"`
+
+	// Cursor An opaque cursor which can be passed to the export endpoint to resume the export after this row.
+	Cursor            string            `json:"cursor"`
+	DisplayName       *string           `json:"displayName,omitempty"`
+	Error             *string           `json:"error,omitempty"`
+	FinishedAt        *time.Time        `json:"finishedAt,omitempty"`
+	Id                string            `json:"id"`
+	StartedAt         *time.Time        `json:"startedAt,omitempty"`
+	Status            WorkflowRunStatus `json:"status"`
+	WorkflowId        string            `json:"workflowId"`
+	WorkflowName      string            `json:"workflowName"`
+	WorkflowVersionId string            `json:"workflowVersionId"`
 }
 
+// WorkflowRunInclude A relation which is hydrated on a workflow run.
+type WorkflowRunInclude string
+
 // WorkflowRunList defines model for WorkflowRunList.
 type WorkflowRunList struct {
 	Pagination *PaginationResponse `json:"pagination,omitempty"`
 	Rows       *[]WorkflowRun      `json:"rows,omitempty"`
 }
 
+// WorkflowRunMetrics defines model for WorkflowRunMetrics.
+type WorkflowRunMetrics struct {
+	Bucket WorkflowRunMetricsBucket `json:"bucket"`
+	Rows   []WorkflowRunMetricsRow  `json:"rows"`
+	Since  time.Time                `json:"since"`
+	Until  time.Time                `json:"until"`
+}
+
+// WorkflowRunMetricsBucket defines model for WorkflowRunMetricsBucket.
+type WorkflowRunMetricsBucket string
+
+// WorkflowRunMetricsRow defines model for WorkflowRunMetricsRow.
+type WorkflowRunMetricsRow struct {
+	// Bucket The start of the time bucket.
+	Bucket time.Time `json:"bucket"`
+	Failed int64     `json:"failed"`
+
+	// FailureRate The share of the finished runs which failed, between 0 and 1.
+	FailureRate float64 `json:"failureRate"`
+
+	// MeanDurationSeconds The mean duration of the finished runs, in seconds.
+	MeanDurationSeconds float64            `json:"meanDurationSeconds"`
+	Succeeded           int64              `json:"succeeded"`
+	WorkflowId          openapi_types.UUID `json:"workflowId"`
+	WorkflowName        string             `json:"workflowName"`
+}
+
+// WorkflowRunProgress The number of step runs of a workflow run by status.
+type WorkflowRunProgress struct {
+	Cancelled int `json:"cancelled"`
+	Failed    int `json:"failed"`
+
+	// Running The number of step runs which are assigned or running.
+	Running   int `json:"running"`
+	Succeeded int `json:"succeeded"`
+
+	// Total The number of step runs of the workflow run.
+	Total int `json:"total"`
+}
+
+// WorkflowRunRootCause defines model for WorkflowRunRootCause.
+type WorkflowRunRootCause struct {
+	// Error The error of the step run. If the step run timed out, this is the reason it was cancelled.
+	Error string `json:"error"`
+
+	// FailedAt The time at which the step run failed or was cancelled.
+	FailedAt time.Time `json:"failedAt"`
+
+	// PrecedingEvents The timeouts and cancellations of other step runs in the workflow run, up to the time the step run failed, oldest first.
+	PrecedingEvents []WorkflowRunRootCauseEvent `json:"precedingEvents"`
+
+	// Retries The previous attempts of the step run, oldest first.
+	Retries       []WorkflowRunRootCauseRetry `json:"retries"`
+	Status        WorkflowRunStatus           `json:"status"`
+	StepRun       StepRun                     `json:"stepRun"`
+	Worker        *Worker                     `json:"worker,omitempty"`
+	WorkflowRunId openapi_types.UUID          `json:"workflowRunId"`
+}
+
+// WorkflowRunRootCauseEvent defines model for WorkflowRunRootCauseEvent.
+type WorkflowRunRootCauseEvent struct {
+	Error      *string   `json:"error,omitempty"`
+	OccurredAt time.Time `json:"occurredAt"`
+
+	// ReadableId The readable id of the step of the step run.
+	ReadableId string `json:"readableId"`
+
+	// Reason The reason the step run was cancelled, for example TIMED_OUT or CANCELLED_BY_CONCURRENCY_LIMIT.
+	Reason    string             `json:"reason"`
+	StepRunId openapi_types.UUID `json:"stepRunId"`
+}
+
+// WorkflowRunRootCauseRetry defines model for WorkflowRunRootCauseRetry.
+type WorkflowRunRootCauseRetry struct {
+	CancelledAt     *time.Time `json:"cancelledAt,omitempty"`
+	CancelledReason *string    `json:"cancelledReason,omitempty"`
+	Error           *string    `json:"error,omitempty"`
+	FinishedAt      *time.Time `json:"finishedAt,omitempty"`
+	StartedAt       *time.Time `json:"startedAt,omitempty"`
+}
+
+// WorkflowRunSLABreach defines model for WorkflowRunSLABreach.
+type WorkflowRunSLABreach struct {
+	// BreachedAt When the breach was detected.
+	BreachedAt time.Time       `json:"breachedAt"`
+	Metadata   APIResourceMeta `json:"metadata"`
+
+	// Sla The SLA of the workflow version when the run breached it.
+	Sla                   string             `json:"sla"`
+	WorkflowId            openapi_types.UUID `json:"workflowId"`
+	WorkflowRunCreatedAt  time.Time          `json:"workflowRunCreatedAt"`
+	WorkflowRunFinishedAt *time.Time         `json:"workflowRunFinishedAt,omitempty"`
+	WorkflowRunId         openapi_types.UUID `json:"workflowRunId"`
+	WorkflowRunStatus     WorkflowRunStatus  `json:"workflowRunStatus"`
+	WorkflowVersionId     openapi_types.UUID `json:"workflowVersionId"`
+}
+
+// WorkflowRunSLABreachList defines model for WorkflowRunSLABreachList.
+type WorkflowRunSLABreachList struct {
+	Pagination *PaginationResponse     `json:"pagination,omitempty"`
+	Rows       *[]WorkflowRunSLABreach `json:"rows,omitempty"`
+}
+
 // WorkflowRunStatus defines model for WorkflowRunStatus.
 type WorkflowRunStatus string
 
@@ -785,11 +2701,26 @@ type WorkflowTriggers struct {
 
 // WorkflowVersion defines model for WorkflowVersion.
 type WorkflowVersion struct {
+	// Checksum The checksum of the workflow version's definition. It only changes when the definition changes, so it can be
+	// used to detect drift from a declared definition.
+	Checksum    *string              `json:"checksum,omitempty"`
 	Concurrency *WorkflowConcurrency `json:"concurrency,omitempty"`
-	Jobs        *[]Job               `json:"jobs,omitempty"`
-	Metadata    APIResourceMeta      `json:"metadata"`
-	Order       int32                `json:"order"`
-	Triggers    *WorkflowTriggers    `json:"triggers,omitempty"`
+
+	// CostCenter The cost center which the compute of the runs of the workflow version is attributed to.
+	CostCenter *string `json:"costCenter,omitempty"`
+	Jobs       *[]Job  `json:"jobs,omitempty"`
+
+	// MaxStepExecutions The maximum number of step runs a run may queue, including retries, after which the run fails.
+	MaxStepExecutions *int32 `json:"maxStepExecutions,omitempty"`
+
+	// MaxStepRetries The maximum number of retries of the step runs of a run, after which the run fails.
+	MaxStepRetries *int32          `json:"maxStepRetries,omitempty"`
+	Metadata       APIResourceMeta `json:"metadata"`
+	Order          int32           `json:"order"`
+
+	// Sla The expected maximum duration of a workflow run, after which the run breaches its SLA.
+	Sla      *string           `json:"sla,omitempty"`
+	Triggers *WorkflowTriggers `json:"triggers,omitempty"`
 
 	// Version The version of the workflow.
 	Version    string    `json:"version"`
@@ -814,6 +2745,27 @@ type WorkflowVersionMeta struct {
 	WorkflowId string    `json:"workflowId"`
 }
 
+// AdminGithubAppCreateParams defines parameters for AdminGithubAppCreate.
+type AdminGithubAppCreateParams struct {
+	// Organization The Github organization to create the app in. Defaults to the personal account of the user.
+	Organization *string `form:"organization,omitempty" json:"organization,omitempty"`
+}
+
+// AdminTenantListParams defines parameters for AdminTenantList.
+type AdminTenantListParams struct {
+	// CreatedAfter Only count workflow runs created after this time. Defaults to the last 24 hours.
+	CreatedAfter *time.Time `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+}
+
+// EventBusSubscriptionListRecordsParams defines parameters for EventBusSubscriptionListRecords.
+type EventBusSubscriptionListRecordsParams struct {
+	// Limit The maximum number of records to return
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Wait The number of seconds to wait for records, if there are none
+	Wait *int64 `form:"wait,omitempty" json:"wait,omitempty"`
+}
+
 // LogLineListParams defines parameters for LogLineList.
 type LogLineListParams struct {
 	// Offset The number to skip
@@ -835,6 +2787,40 @@ type LogLineListParams struct {
 	OrderByDirection *LogLineOrderByDirection `form:"orderByDirection,omitempty" json:"orderByDirection,omitempty"`
 }
 
+// TenantExportDownloadParams defines parameters for TenantExportDownload.
+type TenantExportDownloadParams struct {
+	// Token The signed token of the download URL
+	Token string `form:"token" json:"token"`
+}
+
+// ApiTokenListParams defines parameters for ApiTokenList.
+type ApiTokenListParams struct {
+	// Environment Only return the API tokens of this environment. It is ignored for requests with an environment API token, which only return the API tokens of the environment of the token.
+	Environment *string `form:"environment,omitempty" json:"environment,omitempty"`
+}
+
+// ApiTokenCreateParams defines parameters for ApiTokenCreate.
+type ApiTokenCreateParams struct {
+	// IdempotencyKey A client-generated key which makes the request safe to retry. A repeated request with the same key within 24 hours
+	// returns the original response instead of repeating the request.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// StepRunListCostCenterUsageParams defines parameters for StepRunListCostCenterUsage.
+type StepRunListCostCenterUsageParams struct {
+	// Since Only include step runs which finished at or after this time. Defaults to seven days ago.
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only include step runs which finished before this time. Defaults to now.
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Bucket The size of the time buckets. Defaults to day.
+	Bucket *CostCenterUsageBucket `form:"bucket,omitempty" json:"bucket,omitempty"`
+
+	// CostCenter Only include step runs of this cost center
+	CostCenter *string `form:"costCenter,omitempty" json:"costCenter,omitempty"`
+}
+
 // EventListParams defines parameters for EventList.
 type EventListParams struct {
 	// Offset The number to skip
@@ -855,6 +2841,12 @@ type EventListParams struct {
 	// Search The search query to filter for
 	Search *EventSearch `form:"search,omitempty" json:"search,omitempty"`
 
+	// Environment Only return the events of this environment. It is ignored for requests with an environment API token, which only return the events of the environment of the token.
+	Environment *string `form:"environment,omitempty" json:"environment,omitempty"`
+
+	// Namespace Only return the events of this namespace. It is ignored for requests with a namespace API token, which only return the events of the namespace of the token.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
 	// OrderByField What to order by
 	OrderByField *EventOrderByField `form:"orderByField,omitempty" json:"orderByField,omitempty"`
 
@@ -862,12 +2854,80 @@ type EventListParams struct {
 	OrderByDirection *EventOrderByDirection `form:"orderByDirection,omitempty" json:"orderByDirection,omitempty"`
 }
 
+// StepRunListMetricsParams defines parameters for StepRunListMetrics.
+type StepRunListMetricsParams struct {
+	// Since Only include step runs which finished after this time. Defaults to one hour ago.
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+}
+
+// StepRunListParams defines parameters for StepRunList.
+type StepRunListParams struct {
+	// WorkflowRunId The workflow run id to get step runs for.
+	WorkflowRunId *openapi_types.UUID `form:"workflowRunId,omitempty" json:"workflowRunId,omitempty"`
+
+	// JobRunId The job run id to get step runs for.
+	JobRunId *openapi_types.UUID `form:"jobRunId,omitempty" json:"jobRunId,omitempty"`
+
+	// Status The status to filter step runs by.
+	Status *StepRunStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// StepRunListStreamEventsParams defines parameters for StepRunListStreamEvents.
+type StepRunListStreamEventsParams struct {
+	// After Only list the stream events with an index greater than this index
+	After *int64 `form:"after,omitempty" json:"after,omitempty"`
+
+	// Limit The number of stream events to list
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// WebhookDeliveryListParams defines parameters for WebhookDeliveryList.
+type WebhookDeliveryListParams struct {
+	// Limit The maximum number of deliveries to return
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Webhook The id of the webhook to list deliveries for
+	Webhook *openapi_types.UUID `form:"webhook,omitempty" json:"webhook,omitempty"`
+}
+
+// WorkerListParams defines parameters for WorkerList.
+type WorkerListParams struct {
+	// Environment Only return the workers of this environment. It is ignored for requests with an environment API token, which only return the workers of the environment of the token.
+	Environment *string `form:"environment,omitempty" json:"environment,omitempty"`
+}
+
+// WorkflowRunGetParams defines parameters for WorkflowRunGet.
+type WorkflowRunGetParams struct {
+	// Include The relations to hydrate, as a comma-separated list. Logs are returned on each step run and imply stepRuns. If omitted,
+	// step runs and the triggering event are returned.
+	Include *[]WorkflowRunInclude `form:"include,omitempty" json:"include,omitempty"`
+
+	// Fields A comma-separated list of the optional fields to return. Workflow run fields are given by name, and step run fields are
+	// prefixed with `stepRuns.`. Required fields are always returned.
+	Fields *[]string `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// IfNoneMatch An ETag from a previous response. If it still matches, a 304 is returned without a body.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
 // WorkflowRunListPullRequestsParams defines parameters for WorkflowRunListPullRequests.
 type WorkflowRunListPullRequestsParams struct {
 	// State The pull request state
 	State *PullRequestState `form:"state,omitempty" json:"state,omitempty"`
 }
 
+// WorkflowListParams defines parameters for WorkflowList.
+type WorkflowListParams struct {
+	// Name Only return the workflow with this name. Names are unique within a tenant.
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// Environment Only return the workflows which have a worker of this environment. It is ignored for requests with an environment API token, which only return the workflows which have a worker of the environment of the token.
+	Environment *string `form:"environment,omitempty" json:"environment,omitempty"`
+
+	// Namespace Only return the workflows of this namespace. It is ignored for requests with a namespace API token, which only return the workflows of the namespace of the token.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
 // WorkflowRunListParams defines parameters for WorkflowRunList.
 type WorkflowRunListParams struct {
 	// Offset The number to skip
@@ -881,18 +2941,112 @@ type WorkflowRunListParams struct {
 
 	// WorkflowId The workflow id to get runs for.
 	WorkflowId *openapi_types.UUID `form:"workflowId,omitempty" json:"workflowId,omitempty"`
+
+	// Status The status to get runs for.
+	Status *WorkflowRunStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// CreatedAfter Only get runs created at or after this time.
+	CreatedAfter *time.Time `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// CancelledSource The cancellation source to get failed runs for.
+	CancelledSource *CancellationSource `form:"cancelledSource,omitempty" json:"cancelledSource,omitempty"`
+
+	// Environment Only return the runs of this environment. It is ignored for requests with an environment API token, which only return the runs of the environment of the token.
+	Environment *string `form:"environment,omitempty" json:"environment,omitempty"`
+}
+
+// WorkflowRunExportParams defines parameters for WorkflowRunExport.
+type WorkflowRunExportParams struct {
+	// Format The format of the export. Defaults to ndjson.
+	Format *WorkflowRunExportFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Cursor The cursor of the last row which was received, to resume an export.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// WorkflowId The workflow id to export runs for.
+	WorkflowId *openapi_types.UUID `form:"workflowId,omitempty" json:"workflowId,omitempty"`
+
+	// Status The status to export runs for.
+	Status *WorkflowRunStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// CreatedAfter Only export runs created at or after this time.
+	CreatedAfter *time.Time `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// CreatedBefore Only export runs created before this time.
+	CreatedBefore *time.Time `form:"createdBefore,omitempty" json:"createdBefore,omitempty"`
+}
+
+// WorkflowRunGetMetricsParams defines parameters for WorkflowRunGetMetrics.
+type WorkflowRunGetMetricsParams struct {
+	// Since Only include runs which finished at or after this time. Defaults to seven days ago.
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only include runs which finished before this time. Defaults to now.
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Bucket The size of the time buckets. Defaults to DAY.
+	Bucket *WorkflowRunMetricsBucket `form:"bucket,omitempty" json:"bucket,omitempty"`
+
+	// WorkflowId Only include runs of this workflow
+	WorkflowId *openapi_types.UUID `form:"workflowId,omitempty" json:"workflowId,omitempty"`
+}
+
+// WorkflowRunListScheduledParams defines parameters for WorkflowRunListScheduled.
+type WorkflowRunListScheduledParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// WorkflowId The workflow id to get scheduled runs for.
+	WorkflowId *openapi_types.UUID `form:"workflowId,omitempty" json:"workflowId,omitempty"`
+}
+
+// TriggerLinkRunParams defines parameters for TriggerLinkRun.
+type TriggerLinkRunParams struct {
+	// Token The token of the trigger link
+	Token string `form:"token" json:"token"`
+}
+
+// WorkflowGetParams defines parameters for WorkflowGet.
+type WorkflowGetParams struct {
+	// IfNoneMatch An ETag from a previous response. If it still matches, a 304 is returned without a body.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// WorkflowListSlaBreachesParams defines parameters for WorkflowListSlaBreaches.
+type WorkflowListSlaBreachesParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
 // WorkflowRunCreateParams defines parameters for WorkflowRunCreate.
 type WorkflowRunCreateParams struct {
 	// Version The workflow version. If not supplied, the latest version is fetched.
 	Version *openapi_types.UUID `form:"version,omitempty" json:"version,omitempty"`
+
+	// Wait If true, the request blocks until the workflow run finishes or the timeout is reached, and the finished run is returned.
+	Wait *bool `form:"wait,omitempty" json:"wait,omitempty"`
+
+	// TimeoutSeconds The maximum number of seconds to wait for the workflow run to finish when wait is set. Defaults to 30 seconds.
+	TimeoutSeconds *int `form:"timeoutSeconds,omitempty" json:"timeoutSeconds,omitempty"`
+
+	// IdempotencyKey A client-generated key which makes the request safe to retry. A repeated request with the same key within 24 hours
+	// returns the original response instead of repeating the request.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
 }
 
 // WorkflowVersionGetParams defines parameters for WorkflowVersionGet.
 type WorkflowVersionGetParams struct {
 	// Version The workflow version. If not supplied, the latest version is fetched.
 	Version *openapi_types.UUID `form:"version,omitempty" json:"version,omitempty"`
+
+	// IfNoneMatch An ETag from a previous response. If it still matches, a 304 is returned without a body.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
 }
 
 // WorkflowVersionGetDefinitionParams defines parameters for WorkflowVersionGetDefinition.
@@ -901,30 +3055,90 @@ type WorkflowVersionGetDefinitionParams struct {
 	Version *openapi_types.UUID `form:"version,omitempty" json:"version,omitempty"`
 }
 
+// AdminMaintenanceCreateJSONRequestBody defines body for AdminMaintenanceCreate for application/json ContentType.
+type AdminMaintenanceCreateJSONRequestBody = AdminTriggerMaintenanceRequest
+
+// AdminTenantFeatureFlagUpdateJSONRequestBody defines body for AdminTenantFeatureFlagUpdate for application/json ContentType.
+type AdminTenantFeatureFlagUpdateJSONRequestBody = AdminUpdateTenantFeatureFlagRequest
+
+// AdminTenantUpdateIngestionJSONRequestBody defines body for AdminTenantUpdateIngestion for application/json ContentType.
+type AdminTenantUpdateIngestionJSONRequestBody = AdminUpdateTenantIngestionRequest
+
+// EventBusSubscriptionAckJSONRequestBody defines body for EventBusSubscriptionAck for application/json ContentType.
+type EventBusSubscriptionAckJSONRequestBody = AckEventBusSubscriptionRequest
+
 // StepRunUpdateCreatePrJSONRequestBody defines body for StepRunUpdateCreatePr for application/json ContentType.
 type StepRunUpdateCreatePrJSONRequestBody = CreatePullRequestFromStepRun
 
 // TenantCreateJSONRequestBody defines body for TenantCreate for application/json ContentType.
 type TenantCreateJSONRequestBody = CreateTenantRequest
 
+// TenantUpdateJSONRequestBody defines body for TenantUpdate for application/json ContentType.
+type TenantUpdateJSONRequestBody = UpdateTenantRequest
+
 // ApiTokenCreateJSONRequestBody defines body for ApiTokenCreate for application/json ContentType.
 type ApiTokenCreateJSONRequestBody = CreateAPITokenRequest
 
+// ClientCertificateCreateJSONRequestBody defines body for ClientCertificateCreate for application/json ContentType.
+type ClientCertificateCreateJSONRequestBody = CreateClientCertificateRequest
+
+// TenantUpdateEngineSettingsJSONRequestBody defines body for TenantUpdateEngineSettings for application/json ContentType.
+type TenantUpdateEngineSettingsJSONRequestBody = UpdateTenantEngineSettingsRequest
+
+// EventBusSubscriptionCreateJSONRequestBody defines body for EventBusSubscriptionCreate for application/json ContentType.
+type EventBusSubscriptionCreateJSONRequestBody = CreateEventBusSubscriptionRequest
+
 // EventUpdateReplayJSONRequestBody defines body for EventUpdateReplay for application/json ContentType.
 type EventUpdateReplayJSONRequestBody = ReplayEventRequest
 
+// GiteaWebhookCreateJSONRequestBody defines body for GiteaWebhookCreate for application/json ContentType.
+type GiteaWebhookCreateJSONRequestBody = CreateGiteaWebhookRequest
+
 // TenantInviteCreateJSONRequestBody defines body for TenantInviteCreate for application/json ContentType.
 type TenantInviteCreateJSONRequestBody = CreateTenantInviteRequest
 
 // TenantInviteUpdateJSONRequestBody defines body for TenantInviteUpdate for application/json ContentType.
 type TenantInviteUpdateJSONRequestBody = UpdateTenantInviteRequest
 
+// LogSinkCreateJSONRequestBody defines body for LogSinkCreate for application/json ContentType.
+type LogSinkCreateJSONRequestBody = CreateLogSinkRequest
+
+// ObjectStorageFeedCreateJSONRequestBody defines body for ObjectStorageFeedCreate for application/json ContentType.
+type ObjectStorageFeedCreateJSONRequestBody = CreateObjectStorageFeedRequest
+
+// TenantUpdatePauseJSONRequestBody defines body for TenantUpdatePause for application/json ContentType.
+type TenantUpdatePauseJSONRequestBody = PauseRequest
+
+// PiiRuleCreateJSONRequestBody defines body for PiiRuleCreate for application/json ContentType.
+type PiiRuleCreateJSONRequestBody = CreatePIIRuleRequest
+
+// QueryTriggerCreateJSONRequestBody defines body for QueryTriggerCreate for application/json ContentType.
+type QueryTriggerCreateJSONRequestBody = CreateQueryTriggerRequest
+
+// RecurringTaskCreateJSONRequestBody defines body for RecurringTaskCreate for application/json ContentType.
+type RecurringTaskCreateJSONRequestBody = CreateRecurringTaskRequest
+
 // SnsCreateJSONRequestBody defines body for SnsCreate for application/json ContentType.
 type SnsCreateJSONRequestBody = CreateSNSIntegrationRequest
 
 // StepRunUpdateRerunJSONRequestBody defines body for StepRunUpdateRerun for application/json ContentType.
 type StepRunUpdateRerunJSONRequestBody = RerunStepRunRequest
 
+// SubjectDeletionCreateJSONRequestBody defines body for SubjectDeletionCreate for application/json ContentType.
+type SubjectDeletionCreateJSONRequestBody = CreateSubjectDeletionRequest
+
+// WorkflowRunBulkRetryJSONRequestBody defines body for WorkflowRunBulkRetry for application/json ContentType.
+type WorkflowRunBulkRetryJSONRequestBody = WorkflowRunBulkRetryRequest
+
+// WorkflowRunImportJSONRequestBody defines body for WorkflowRunImport for application/json ContentType.
+type WorkflowRunImportJSONRequestBody = WorkflowRunBundle
+
+// WorkflowRunCreateReplayJSONRequestBody defines body for WorkflowRunCreateReplay for application/json ContentType.
+type WorkflowRunCreateReplayJSONRequestBody = ReplayWorkflowRunRequest
+
+// WorkflowPutJSONRequestBody defines body for WorkflowPut for application/json ContentType.
+type WorkflowPutJSONRequestBody = WorkflowVersionDefinition
+
 // TenantInviteAcceptJSONRequestBody defines body for TenantInviteAccept for application/json ContentType.
 type TenantInviteAcceptJSONRequestBody = AcceptInviteRequest
 
@@ -937,12 +3151,30 @@ type UserUpdateLoginJSONRequestBody = UserLoginRequest
 // UserCreateJSONRequestBody defines body for UserCreate for application/json ContentType.
 type UserCreateJSONRequestBody = UserRegisterRequest
 
+// WorkflowCreateConcurrencySimulationJSONRequestBody defines body for WorkflowCreateConcurrencySimulation for application/json ContentType.
+type WorkflowCreateConcurrencySimulationJSONRequestBody = CreateConcurrencySimulationRequest
+
+// WorkflowUpdateDiagnosticsJSONRequestBody defines body for WorkflowUpdateDiagnostics for application/json ContentType.
+type WorkflowUpdateDiagnosticsJSONRequestBody = UpdateWorkflowDiagnosticsRequest
+
 // WorkflowUpdateLinkGithubJSONRequestBody defines body for WorkflowUpdateLinkGithub for application/json ContentType.
 type WorkflowUpdateLinkGithubJSONRequestBody = LinkGithubRepositoryRequest
 
+// MaintenanceWindowCreateJSONRequestBody defines body for MaintenanceWindowCreate for application/json ContentType.
+type MaintenanceWindowCreateJSONRequestBody = CreateMaintenanceWindowRequest
+
+// WorkflowUpdatePauseJSONRequestBody defines body for WorkflowUpdatePause for application/json ContentType.
+type WorkflowUpdatePauseJSONRequestBody = PauseRequest
+
+// WorkflowUpdateRolloutJSONRequestBody defines body for WorkflowUpdateRollout for application/json ContentType.
+type WorkflowUpdateRolloutJSONRequestBody = UpdateWorkflowRolloutRequest
+
 // WorkflowRunCreateJSONRequestBody defines body for WorkflowRunCreate for application/json ContentType.
 type WorkflowRunCreateJSONRequestBody = TriggerWorkflowRunRequest
 
+// TriggerLinkCreateJSONRequestBody defines body for TriggerLinkCreate for application/json ContentType.
+type TriggerLinkCreateJSONRequestBody = CreateTriggerLinkRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Get liveness
@@ -951,12 +3183,63 @@ type ServerInterface interface {
 	// Get readiness
 	// (GET /api/ready)
 	ReadinessGet(ctx echo.Context) error
+	// Create Github app (admin)
+	// (GET /api/v1/admin/github-app)
+	AdminGithubAppCreate(ctx echo.Context, params AdminGithubAppCreateParams) error
+	// Complete Github app creation (admin)
+	// (GET /api/v1/admin/github-app/callback)
+	AdminGithubAppCreateCallback(ctx echo.Context) error
+	// Trigger maintenance job (admin)
+	// (POST /api/v1/admin/maintenance)
+	AdminMaintenanceCreate(ctx echo.Context) error
+	// List queues (admin)
+	// (GET /api/v1/admin/queues)
+	AdminQueueList(ctx echo.Context) error
+	// List tenants (admin)
+	// (GET /api/v1/admin/tenants)
+	AdminTenantList(ctx echo.Context, params AdminTenantListParams) error
+	// List tenant feature flags (admin)
+	// (GET /api/v1/admin/tenants/{tenant}/feature-flags)
+	AdminTenantFeatureFlagList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Update tenant feature flag (admin)
+	// (PUT /api/v1/admin/tenants/{tenant}/feature-flags)
+	AdminTenantFeatureFlagUpdate(ctx echo.Context, tenant openapi_types.UUID) error
+	// Update tenant ingestion (admin)
+	// (PUT /api/v1/admin/tenants/{tenant}/ingestion)
+	AdminTenantUpdateIngestion(ctx echo.Context, tenant openapi_types.UUID) error
+	// Force-fail workflow run (admin)
+	// (POST /api/v1/admin/tenants/{tenant}/workflow-runs/{workflow-run}/fail)
+	AdminWorkflowRunUpdateFail(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error
+	// Get API Token
+	// (GET /api/v1/api-tokens/{api-token})
+	ApiTokenGet(ctx echo.Context, apiToken openapi_types.UUID) error
 	// Revoke API Token
 	// (POST /api/v1/api-tokens/{api-token})
 	ApiTokenUpdateRevoke(ctx echo.Context, apiToken openapi_types.UUID) error
+	// Rotate API Token
+	// (POST /api/v1/api-tokens/{api-token}/rotate)
+	ApiTokenUpdateRotate(ctx echo.Context, apiToken openapi_types.UUID) error
+	// Delete client certificate
+	// (DELETE /api/v1/client-certificates/{client-certificate})
+	ClientCertificateDelete(ctx echo.Context, clientCertificate openapi_types.UUID) error
+	// Delete event bus subscription
+	// (DELETE /api/v1/event-bus-subscriptions/{event-bus-subscription})
+	EventBusSubscriptionDelete(ctx echo.Context, eventBusSubscription openapi_types.UUID) error
+	// Acknowledge event bus records
+	// (POST /api/v1/event-bus-subscriptions/{event-bus-subscription}/ack)
+	EventBusSubscriptionAck(ctx echo.Context, eventBusSubscription openapi_types.UUID) error
+	// List event bus records
+	// (GET /api/v1/event-bus-subscriptions/{event-bus-subscription}/records)
+	EventBusSubscriptionListRecords(ctx echo.Context, eventBusSubscription openapi_types.UUID, params EventBusSubscriptionListRecordsParams) error
 	// Get event data
 	// (GET /api/v1/events/{event}/data)
 	EventDataGet(ctx echo.Context, event openapi_types.UUID) error
+	// Delete Gitea webhook
+	// (DELETE /api/v1/gitea-webhooks/{gitea-webhook})
+	GiteaWebhookDelete(ctx echo.Context, giteaWebhook openapi_types.UUID) error
+	// Gitea webhook
+	// (POST /api/v1/gitea/webhook/{webhook})
+	GiteaUpdateWebhook(ctx echo.Context, webhook openapi_types.UUID) error
 	// List Github App installations
 	// (GET /api/v1/github-app/installations)
 	GithubAppListInstallations(ctx echo.Context) error
@@ -972,12 +3255,33 @@ type ServerInterface interface {
 	// Github app tenant webhook
 	// (POST /api/v1/github/webhook/{webhook})
 	GithubUpdateTenantWebhook(ctx echo.Context, webhook openapi_types.UUID) error
+	// Delete log sink
+	// (DELETE /api/v1/log-sinks/{log-sink})
+	LogSinkDelete(ctx echo.Context, logSink openapi_types.UUID) error
+	// Delete maintenance window
+	// (DELETE /api/v1/maintenance-windows/{maintenance-window})
+	MaintenanceWindowDelete(ctx echo.Context, maintenanceWindow openapi_types.UUID) error
 	// Get metadata
 	// (GET /api/v1/meta)
 	MetadataGet(ctx echo.Context) error
+	// Get attestation keys
+	// (GET /api/v1/meta/attestation-keys)
+	MetadataGetAttestationKeys(ctx echo.Context) error
 	// List integrations
 	// (GET /api/v1/meta/integrations)
 	MetadataListIntegrations(ctx echo.Context) error
+	// Delete object storage feed
+	// (DELETE /api/v1/object-storage-feeds/{object-storage-feed})
+	ObjectStorageFeedDelete(ctx echo.Context, objectStorageFeed openapi_types.UUID) error
+	// Delete PII rule
+	// (DELETE /api/v1/pii-rules/{pii-rule})
+	PiiRuleDelete(ctx echo.Context, piiRule openapi_types.UUID) error
+	// Delete query trigger
+	// (DELETE /api/v1/query-triggers/{query-trigger})
+	QueryTriggerDelete(ctx echo.Context, queryTrigger openapi_types.UUID) error
+	// Delete recurring task
+	// (DELETE /api/v1/recurring-tasks/{recurring-task})
+	RecurringTaskDelete(ctx echo.Context, recurringTask openapi_types.UUID) error
 	// Delete SNS integration
 	// (DELETE /api/v1/sns/{sns})
 	SnsDelete(ctx echo.Context, sns openapi_types.UUID) error
@@ -993,15 +3297,51 @@ type ServerInterface interface {
 	// List log lines
 	// (GET /api/v1/step-runs/{step-run}/logs)
 	LogLineList(ctx echo.Context, stepRun openapi_types.UUID, params LogLineListParams) error
+	// Get tenant export
+	// (GET /api/v1/tenant-exports/{tenant-export})
+	TenantExportGet(ctx echo.Context, tenantExport openapi_types.UUID) error
+	// Download tenant export
+	// (GET /api/v1/tenant-exports/{tenant-export}/download)
+	TenantExportDownload(ctx echo.Context, tenantExport openapi_types.UUID, params TenantExportDownloadParams) error
+	// Create tenant export download URL
+	// (POST /api/v1/tenant-exports/{tenant-export}/download-url)
+	TenantExportCreateDownloadUrl(ctx echo.Context, tenantExport openapi_types.UUID) error
 	// Create tenant
 	// (POST /api/v1/tenants)
 	TenantCreate(ctx echo.Context) error
+	// Get tenant
+	// (GET /api/v1/tenants/{tenant})
+	TenantGet(ctx echo.Context, tenant openapi_types.UUID) error
+	// Update tenant
+	// (PATCH /api/v1/tenants/{tenant})
+	TenantUpdate(ctx echo.Context, tenant openapi_types.UUID) error
 	// List API Tokens
 	// (GET /api/v1/tenants/{tenant}/api-tokens)
-	ApiTokenList(ctx echo.Context, tenant openapi_types.UUID) error
+	ApiTokenList(ctx echo.Context, tenant openapi_types.UUID, params ApiTokenListParams) error
 	// Create API Token
 	// (POST /api/v1/tenants/{tenant}/api-tokens)
-	ApiTokenCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	ApiTokenCreate(ctx echo.Context, tenant openapi_types.UUID, params ApiTokenCreateParams) error
+	// List client certificates
+	// (GET /api/v1/tenants/{tenant}/client-certificates)
+	ClientCertificateList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create client certificate
+	// (POST /api/v1/tenants/{tenant}/client-certificates)
+	ClientCertificateCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// List cost center usage
+	// (GET /api/v1/tenants/{tenant}/cost-center-usage)
+	StepRunListCostCenterUsage(ctx echo.Context, tenant openapi_types.UUID, params StepRunListCostCenterUsageParams) error
+	// Get tenant engine settings
+	// (GET /api/v1/tenants/{tenant}/engine-settings)
+	TenantGetEngineSettings(ctx echo.Context, tenant openapi_types.UUID) error
+	// Update tenant engine settings
+	// (PUT /api/v1/tenants/{tenant}/engine-settings)
+	TenantUpdateEngineSettings(ctx echo.Context, tenant openapi_types.UUID) error
+	// List event bus subscriptions
+	// (GET /api/v1/tenants/{tenant}/event-bus-subscriptions)
+	EventBusSubscriptionList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create event bus subscription
+	// (POST /api/v1/tenants/{tenant}/event-bus-subscriptions)
+	EventBusSubscriptionCreate(ctx echo.Context, tenant openapi_types.UUID) error
 	// List events
 	// (GET /api/v1/tenants/{tenant}/events)
 	EventList(ctx echo.Context, tenant openapi_types.UUID, params EventListParams) error
@@ -1011,6 +3351,21 @@ type ServerInterface interface {
 	// Replay events
 	// (POST /api/v1/tenants/{tenant}/events/replay)
 	EventUpdateReplay(ctx echo.Context, tenant openapi_types.UUID) error
+	// List tenant exports
+	// (GET /api/v1/tenants/{tenant}/exports)
+	TenantExportList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create tenant export
+	// (POST /api/v1/tenants/{tenant}/exports)
+	TenantExportCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// List Gitea webhooks
+	// (GET /api/v1/tenants/{tenant}/gitea-webhooks)
+	GiteaWebhookList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create Gitea webhook
+	// (POST /api/v1/tenants/{tenant}/gitea-webhooks)
+	GiteaWebhookCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// List Github webhooks
+	// (GET /api/v1/tenants/{tenant}/github-webhooks)
+	GithubWebhookList(ctx echo.Context, tenant openapi_types.UUID) error
 	// List tenant invites
 	// (GET /api/v1/tenants/{tenant}/invites)
 	TenantInviteList(ctx echo.Context, tenant openapi_types.UUID) error
@@ -1023,39 +3378,141 @@ type ServerInterface interface {
 	// Update invite
 	// (PATCH /api/v1/tenants/{tenant}/invites/{tenant-invite})
 	TenantInviteUpdate(ctx echo.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID) error
+	// List log sinks
+	// (GET /api/v1/tenants/{tenant}/log-sinks)
+	LogSinkList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create log sink
+	// (POST /api/v1/tenants/{tenant}/log-sinks)
+	LogSinkCreate(ctx echo.Context, tenant openapi_types.UUID) error
 	// List tenant members
 	// (GET /api/v1/tenants/{tenant}/members)
 	TenantMemberList(ctx echo.Context, tenant openapi_types.UUID) error
+	// List object storage feeds
+	// (GET /api/v1/tenants/{tenant}/object-storage-feeds)
+	ObjectStorageFeedList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create object storage feed
+	// (POST /api/v1/tenants/{tenant}/object-storage-feeds)
+	ObjectStorageFeedCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// Resume tenant
+	// (DELETE /api/v1/tenants/{tenant}/pause)
+	TenantDeletePause(ctx echo.Context, tenant openapi_types.UUID) error
+	// Pause tenant
+	// (PUT /api/v1/tenants/{tenant}/pause)
+	TenantUpdatePause(ctx echo.Context, tenant openapi_types.UUID) error
+	// List PII rules
+	// (GET /api/v1/tenants/{tenant}/pii-rules)
+	PiiRuleList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create PII rule
+	// (POST /api/v1/tenants/{tenant}/pii-rules)
+	PiiRuleCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// List query triggers
+	// (GET /api/v1/tenants/{tenant}/query-triggers)
+	QueryTriggerList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create query trigger
+	// (POST /api/v1/tenants/{tenant}/query-triggers)
+	QueryTriggerCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// List recurring tasks
+	// (GET /api/v1/tenants/{tenant}/recurring-tasks)
+	RecurringTaskList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create recurring task
+	// (POST /api/v1/tenants/{tenant}/recurring-tasks)
+	RecurringTaskCreate(ctx echo.Context, tenant openapi_types.UUID) error
 	// List SNS integrations
 	// (GET /api/v1/tenants/{tenant}/sns)
 	SnsList(ctx echo.Context, tenant openapi_types.UUID) error
 	// Create SNS integration
 	// (POST /api/v1/tenants/{tenant}/sns)
 	SnsCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// List step run metrics
+	// (GET /api/v1/tenants/{tenant}/step-run-metrics)
+	StepRunListMetrics(ctx echo.Context, tenant openapi_types.UUID, params StepRunListMetricsParams) error
+	// List step runs
+	// (GET /api/v1/tenants/{tenant}/step-runs)
+	StepRunList(ctx echo.Context, tenant openapi_types.UUID, params StepRunListParams) error
 	// Get step run
 	// (GET /api/v1/tenants/{tenant}/step-runs/{step-run})
 	StepRunGet(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error
+	// List step run attempts
+	// (GET /api/v1/tenants/{tenant}/step-runs/{step-run}/attempts)
+	StepRunListAttempts(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error
+	// List step run diagnostics
+	// (GET /api/v1/tenants/{tenant}/step-runs/{step-run}/diagnostics)
+	StepRunListDiagnostics(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error
+	// List step run stream events
+	// (GET /api/v1/tenants/{tenant}/step-runs/{step-run}/stream-events)
+	StepRunListStreamEvents(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, params StepRunListStreamEventsParams) error
 	// Rerun step run
 	// (POST /api/v1/tenants/{tenant}/step-runs/{step-run}/rerun)
 	StepRunUpdateRerun(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error
 	// Get step run schema
 	// (GET /api/v1/tenants/{tenant}/step-runs/{step-run}/schema)
 	StepRunGetSchema(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error
+	// Delete subject data
+	// (POST /api/v1/tenants/{tenant}/subject-deletions)
+	SubjectDeletionCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// List webhook deliveries
+	// (GET /api/v1/tenants/{tenant}/webhook-deliveries)
+	WebhookDeliveryList(ctx echo.Context, tenant openapi_types.UUID, params WebhookDeliveryListParams) error
 	// Get workers
 	// (GET /api/v1/tenants/{tenant}/worker)
-	WorkerList(ctx echo.Context, tenant openapi_types.UUID) error
+	WorkerList(ctx echo.Context, tenant openapi_types.UUID, params WorkerListParams) error
+	// Bulk retry workflow runs
+	// (POST /api/v1/tenants/{tenant}/workflow-runs/bulk-retry)
+	WorkflowRunBulkRetry(ctx echo.Context, tenant openapi_types.UUID) error
+	// Get bulk retry
+	// (GET /api/v1/tenants/{tenant}/workflow-runs/bulk-retry/{bulk-retry})
+	WorkflowRunGetBulkRetry(ctx echo.Context, tenant openapi_types.UUID, bulkRetry openapi_types.UUID) error
+	// Import workflow run bundle
+	// (POST /api/v1/tenants/{tenant}/workflow-runs/import)
+	WorkflowRunImport(ctx echo.Context, tenant openapi_types.UUID) error
 	// Get workflow run
 	// (GET /api/v1/tenants/{tenant}/workflow-runs/{workflow-run})
-	WorkflowRunGet(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error
+	WorkflowRunGet(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params WorkflowRunGetParams) error
+	// Get workflow run attestation
+	// (GET /api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/attestation)
+	WorkflowRunGetAttestation(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error
+	// Export workflow run bundle
+	// (GET /api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/bundle)
+	WorkflowRunGetBundle(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error
+	// Get workflow run DAG
+	// (GET /api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/dag)
+	WorkflowRunGetDag(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error
+	// Get group key run
+	// (GET /api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/group-key-run)
+	WorkflowRunGetGroupKeyRun(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error
 	// List pull requests
 	// (GET /api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/prs)
 	WorkflowRunListPullRequests(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params WorkflowRunListPullRequestsParams) error
+	// Replay workflow run
+	// (POST /api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/replay)
+	WorkflowRunCreateReplay(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error
+	// Get workflow run root cause
+	// (GET /api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/root-cause)
+	WorkflowRunGetRootCause(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error
 	// Get workflows
 	// (GET /api/v1/tenants/{tenant}/workflows)
-	WorkflowList(ctx echo.Context, tenant openapi_types.UUID) error
+	WorkflowList(ctx echo.Context, tenant openapi_types.UUID, params WorkflowListParams) error
+	// Put workflow
+	// (PUT /api/v1/tenants/{tenant}/workflows)
+	WorkflowPut(ctx echo.Context, tenant openapi_types.UUID) error
 	// Get workflow runs
 	// (GET /api/v1/tenants/{tenant}/workflows/runs)
 	WorkflowRunList(ctx echo.Context, tenant openapi_types.UUID, params WorkflowRunListParams) error
+	// Export workflow runs
+	// (GET /api/v1/tenants/{tenant}/workflows/runs/export)
+	WorkflowRunExport(ctx echo.Context, tenant openapi_types.UUID, params WorkflowRunExportParams) error
+	// Get workflow run metrics
+	// (GET /api/v1/tenants/{tenant}/workflows/runs/metrics)
+	WorkflowRunGetMetrics(ctx echo.Context, tenant openapi_types.UUID, params WorkflowRunGetMetricsParams) error
+	// Get scheduled workflow runs
+	// (GET /api/v1/tenants/{tenant}/workflows/schedules)
+	WorkflowRunListScheduled(ctx echo.Context, tenant openapi_types.UUID, params WorkflowRunListScheduledParams) error
+	// Delete trigger link
+	// (DELETE /api/v1/trigger-links/{trigger-link})
+	TriggerLinkDelete(ctx echo.Context, triggerLink openapi_types.UUID) error
+	// Run trigger link
+	// (POST /api/v1/trigger-links/{trigger-link}/trigger)
+	TriggerLinkRun(ctx echo.Context, triggerLink openapi_types.UUID, params TriggerLinkRunParams) error
 	// Get current user
 	// (GET /api/v1/users/current)
 	UserGetCurrent(ctx echo.Context) error
@@ -1100,13 +3557,52 @@ type ServerInterface interface {
 	WorkflowDelete(ctx echo.Context, workflow openapi_types.UUID) error
 	// Get workflow
 	// (GET /api/v1/workflows/{workflow})
-	WorkflowGet(ctx echo.Context, workflow openapi_types.UUID) error
+	WorkflowGet(ctx echo.Context, workflow openapi_types.UUID, params WorkflowGetParams) error
+	// Get workflow concurrency queue depth
+	// (GET /api/v1/workflows/{workflow}/concurrency-queue-depth)
+	WorkflowGetConcurrencyQueueDepth(ctx echo.Context, workflow openapi_types.UUID) error
+	// Simulate concurrency limit
+	// (POST /api/v1/workflows/{workflow}/concurrency-simulations)
+	WorkflowCreateConcurrencySimulation(ctx echo.Context, workflow openapi_types.UUID) error
+	// Update workflow diagnostics
+	// (PUT /api/v1/workflows/{workflow}/diagnostics)
+	WorkflowUpdateDiagnostics(ctx echo.Context, workflow openapi_types.UUID) error
 	// Link github repository
 	// (POST /api/v1/workflows/{workflow}/link-github)
 	WorkflowUpdateLinkGithub(ctx echo.Context, workflow openapi_types.UUID) error
+	// List maintenance windows
+	// (GET /api/v1/workflows/{workflow}/maintenance-windows)
+	MaintenanceWindowList(ctx echo.Context, workflow openapi_types.UUID) error
+	// Create maintenance window
+	// (POST /api/v1/workflows/{workflow}/maintenance-windows)
+	MaintenanceWindowCreate(ctx echo.Context, workflow openapi_types.UUID) error
+	// Resume workflow
+	// (DELETE /api/v1/workflows/{workflow}/pause)
+	WorkflowDeletePause(ctx echo.Context, workflow openapi_types.UUID) error
+	// Pause workflow
+	// (PUT /api/v1/workflows/{workflow}/pause)
+	WorkflowUpdatePause(ctx echo.Context, workflow openapi_types.UUID) error
+	// Delete workflow rollout
+	// (DELETE /api/v1/workflows/{workflow}/rollout)
+	WorkflowDeleteRollout(ctx echo.Context, workflow openapi_types.UUID) error
+	// Get workflow rollout
+	// (GET /api/v1/workflows/{workflow}/rollout)
+	WorkflowGetRollout(ctx echo.Context, workflow openapi_types.UUID) error
+	// Update workflow rollout
+	// (PUT /api/v1/workflows/{workflow}/rollout)
+	WorkflowUpdateRollout(ctx echo.Context, workflow openapi_types.UUID) error
+	// List SLA breaches
+	// (GET /api/v1/workflows/{workflow}/sla-breaches)
+	WorkflowListSlaBreaches(ctx echo.Context, workflow openapi_types.UUID, params WorkflowListSlaBreachesParams) error
 	// Trigger workflow run
 	// (POST /api/v1/workflows/{workflow}/trigger)
 	WorkflowRunCreate(ctx echo.Context, workflow openapi_types.UUID, params WorkflowRunCreateParams) error
+	// List trigger links
+	// (GET /api/v1/workflows/{workflow}/trigger-links)
+	TriggerLinkList(ctx echo.Context, workflow openapi_types.UUID) error
+	// Create trigger link
+	// (POST /api/v1/workflows/{workflow}/trigger-links)
+	TriggerLinkCreate(ctx echo.Context, workflow openapi_types.UUID) error
 	// Get workflow version
 	// (GET /api/v1/workflows/{workflow}/versions)
 	WorkflowVersionGet(ctx echo.Context, workflow openapi_types.UUID, params WorkflowVersionGetParams) error
@@ -1138,165 +3634,208 @@ func (w *ServerInterfaceWrapper) ReadinessGet(ctx echo.Context) error {
 	return err
 }
 
-// ApiTokenUpdateRevoke converts echo context to params.
-func (w *ServerInterfaceWrapper) ApiTokenUpdateRevoke(ctx echo.Context) error {
+// AdminGithubAppCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminGithubAppCreate(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "api-token" -------------
-	var apiToken openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "api-token", runtime.ParamLocationPath, ctx.Param("api-token"), &apiToken)
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminGithubAppCreateParams
+	// ------------- Optional query parameter "organization" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "organization", ctx.QueryParams(), &params.Organization)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter api-token: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organization: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AdminGithubAppCreate(ctx, params)
+	return err
+}
+
+// AdminGithubAppCreateCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminGithubAppCreateCallback(ctx echo.Context) error {
+	var err error
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ApiTokenUpdateRevoke(ctx, apiToken)
+	err = w.Handler.AdminGithubAppCreateCallback(ctx)
 	return err
 }
 
-// EventDataGet converts echo context to params.
-func (w *ServerInterfaceWrapper) EventDataGet(ctx echo.Context) error {
+// AdminMaintenanceCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminMaintenanceCreate(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "event" -------------
-	var event openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "event", runtime.ParamLocationPath, ctx.Param("event"), &event)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event: %s", err))
-	}
-
-	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventDataGet(ctx, event)
+	err = w.Handler.AdminMaintenanceCreate(ctx)
 	return err
 }
 
-// GithubAppListInstallations converts echo context to params.
-func (w *ServerInterfaceWrapper) GithubAppListInstallations(ctx echo.Context) error {
+// AdminQueueList converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminQueueList(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GithubAppListInstallations(ctx)
+	err = w.Handler.AdminQueueList(ctx)
 	return err
 }
 
-// GithubAppListRepos converts echo context to params.
-func (w *ServerInterfaceWrapper) GithubAppListRepos(ctx echo.Context) error {
+// AdminTenantList converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminTenantList(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "gh-installation" -------------
-	var ghInstallation openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "gh-installation", runtime.ParamLocationPath, ctx.Param("gh-installation"), &ghInstallation)
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminTenantListParams
+	// ------------- Optional query parameter "createdAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", ctx.QueryParams(), &params.CreatedAfter)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gh-installation: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdAfter: %s", err))
 	}
 
-	ctx.Set(CookieAuthScopes, []string{})
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GithubAppListRepos(ctx, ghInstallation)
+	err = w.Handler.AdminTenantList(ctx, params)
 	return err
 }
 
-// GithubAppListBranches converts echo context to params.
-func (w *ServerInterfaceWrapper) GithubAppListBranches(ctx echo.Context) error {
+// AdminTenantFeatureFlagList converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminTenantFeatureFlagList(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "gh-installation" -------------
-	var ghInstallation openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "gh-installation", runtime.ParamLocationPath, ctx.Param("gh-installation"), &ghInstallation)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gh-installation: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "gh-repo-owner" -------------
-	var ghRepoOwner string
+	ctx.Set(CookieAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "gh-repo-owner", runtime.ParamLocationPath, ctx.Param("gh-repo-owner"), &ghRepoOwner)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gh-repo-owner: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AdminTenantFeatureFlagList(ctx, tenant)
+	return err
+}
 
-	// ------------- Path parameter "gh-repo-name" -------------
-	var ghRepoName string
+// AdminTenantFeatureFlagUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminTenantFeatureFlagUpdate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "gh-repo-name", runtime.ParamLocationPath, ctx.Param("gh-repo-name"), &ghRepoName)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gh-repo-name: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GithubAppListBranches(ctx, ghInstallation, ghRepoOwner, ghRepoName)
+	err = w.Handler.AdminTenantFeatureFlagUpdate(ctx, tenant)
 	return err
 }
 
-// GithubUpdateGlobalWebhook converts echo context to params.
-func (w *ServerInterfaceWrapper) GithubUpdateGlobalWebhook(ctx echo.Context) error {
+// AdminTenantUpdateIngestion converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminTenantUpdateIngestion(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GithubUpdateGlobalWebhook(ctx)
+	err = w.Handler.AdminTenantUpdateIngestion(ctx, tenant)
 	return err
 }
 
-// GithubUpdateTenantWebhook converts echo context to params.
-func (w *ServerInterfaceWrapper) GithubUpdateTenantWebhook(ctx echo.Context) error {
+// AdminWorkflowRunUpdateFail converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminWorkflowRunUpdateFail(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "webhook" -------------
-	var webhook openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "webhook", runtime.ParamLocationPath, ctx.Param("webhook"), &webhook)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhook: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
 	}
 
+	ctx.Set(CookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GithubUpdateTenantWebhook(ctx, webhook)
+	err = w.Handler.AdminWorkflowRunUpdateFail(ctx, tenant, workflowRun)
 	return err
 }
 
-// MetadataGet converts echo context to params.
-func (w *ServerInterfaceWrapper) MetadataGet(ctx echo.Context) error {
+// ApiTokenGet converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiTokenGet(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "api-token" -------------
+	var apiToken openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "api-token", runtime.ParamLocationPath, ctx.Param("api-token"), &apiToken)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter api-token: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.MetadataGet(ctx)
+	err = w.Handler.ApiTokenGet(ctx, apiToken)
 	return err
 }
 
-// MetadataListIntegrations converts echo context to params.
-func (w *ServerInterfaceWrapper) MetadataListIntegrations(ctx echo.Context) error {
+// ApiTokenUpdateRevoke converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiTokenUpdateRevoke(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "api-token" -------------
+	var apiToken openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "api-token", runtime.ParamLocationPath, ctx.Param("api-token"), &apiToken)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter api-token: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.MetadataListIntegrations(ctx)
+	err = w.Handler.ApiTokenUpdateRevoke(ctx, apiToken)
 	return err
 }
 
-// SnsDelete converts echo context to params.
-func (w *ServerInterfaceWrapper) SnsDelete(ctx echo.Context) error {
+// ApiTokenUpdateRotate converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiTokenUpdateRotate(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "sns" -------------
-	var sns openapi_types.UUID
+	// ------------- Path parameter "api-token" -------------
+	var apiToken openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "sns", runtime.ParamLocationPath, ctx.Param("sns"), &sns)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "api-token", runtime.ParamLocationPath, ctx.Param("api-token"), &apiToken)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sns: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter api-token: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -1304,43 +3843,39 @@ func (w *ServerInterfaceWrapper) SnsDelete(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SnsDelete(ctx, sns)
+	err = w.Handler.ApiTokenUpdateRotate(ctx, apiToken)
 	return err
 }
 
-// SnsUpdate converts echo context to params.
-func (w *ServerInterfaceWrapper) SnsUpdate(ctx echo.Context) error {
+// ClientCertificateDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) ClientCertificateDelete(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// ------------- Path parameter "client-certificate" -------------
+	var clientCertificate openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "client-certificate", runtime.ParamLocationPath, ctx.Param("client-certificate"), &clientCertificate)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter client-certificate: %s", err))
 	}
 
-	// ------------- Path parameter "event" -------------
-	var event string
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "event", runtime.ParamLocationPath, ctx.Param("event"), &event)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SnsUpdate(ctx, tenant, event)
+	err = w.Handler.ClientCertificateDelete(ctx, clientCertificate)
 	return err
 }
 
-// StepRunUpdateCreatePr converts echo context to params.
-func (w *ServerInterfaceWrapper) StepRunUpdateCreatePr(ctx echo.Context) error {
+// EventBusSubscriptionDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) EventBusSubscriptionDelete(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "step-run" -------------
-	var stepRun openapi_types.UUID
+	// ------------- Path parameter "event-bus-subscription" -------------
+	var eventBusSubscription openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "event-bus-subscription", runtime.ParamLocationPath, ctx.Param("event-bus-subscription"), &eventBusSubscription)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event-bus-subscription: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -1348,19 +3883,19 @@ func (w *ServerInterfaceWrapper) StepRunUpdateCreatePr(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.StepRunUpdateCreatePr(ctx, stepRun)
+	err = w.Handler.EventBusSubscriptionDelete(ctx, eventBusSubscription)
 	return err
 }
 
-// StepRunGetDiff converts echo context to params.
-func (w *ServerInterfaceWrapper) StepRunGetDiff(ctx echo.Context) error {
+// EventBusSubscriptionAck converts echo context to params.
+func (w *ServerInterfaceWrapper) EventBusSubscriptionAck(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "step-run" -------------
-	var stepRun openapi_types.UUID
+	// ------------- Path parameter "event-bus-subscription" -------------
+	var eventBusSubscription openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "event-bus-subscription", runtime.ParamLocationPath, ctx.Param("event-bus-subscription"), &eventBusSubscription)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event-bus-subscription: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -1368,19 +3903,19 @@ func (w *ServerInterfaceWrapper) StepRunGetDiff(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.StepRunGetDiff(ctx, stepRun)
+	err = w.Handler.EventBusSubscriptionAck(ctx, eventBusSubscription)
 	return err
 }
 
-// LogLineList converts echo context to params.
-func (w *ServerInterfaceWrapper) LogLineList(ctx echo.Context) error {
+// EventBusSubscriptionListRecords converts echo context to params.
+func (w *ServerInterfaceWrapper) EventBusSubscriptionListRecords(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "step-run" -------------
-	var stepRun openapi_types.UUID
+	// ------------- Path parameter "event-bus-subscription" -------------
+	var eventBusSubscription openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "event-bus-subscription", runtime.ParamLocationPath, ctx.Param("event-bus-subscription"), &eventBusSubscription)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event-bus-subscription: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -1388,12 +3923,12 @@ func (w *ServerInterfaceWrapper) LogLineList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params LogLineListParams
-	// ------------- Optional query parameter "offset" -------------
+	var params EventBusSubscriptionListRecordsParams
+	// ------------- Optional query parameter "wait" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "wait", ctx.QueryParams(), &params.Wait)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter wait: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
@@ -1403,179 +3938,184 @@ func (w *ServerInterfaceWrapper) LogLineList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Optional query parameter "levels" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "levels", ctx.QueryParams(), &params.Levels)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter levels: %s", err))
-	}
-
-	// ------------- Optional query parameter "search" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EventBusSubscriptionListRecords(ctx, eventBusSubscription, params)
+	return err
+}
 
-	// ------------- Optional query parameter "orderByField" -------------
+// EventDataGet converts echo context to params.
+func (w *ServerInterfaceWrapper) EventDataGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "event" -------------
+	var event openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "event", runtime.ParamLocationPath, ctx.Param("event"), &event)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event: %s", err))
 	}
 
-	// ------------- Optional query parameter "orderByDirection" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.LogLineList(ctx, stepRun, params)
+	err = w.Handler.EventDataGet(ctx, event)
 	return err
 }
 
-// TenantCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantCreate(ctx echo.Context) error {
+// GiteaWebhookDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) GiteaWebhookDelete(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "gitea-webhook" -------------
+	var giteaWebhook openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "gitea-webhook", runtime.ParamLocationPath, ctx.Param("gitea-webhook"), &giteaWebhook)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gitea-webhook: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantCreate(ctx)
+	err = w.Handler.GiteaWebhookDelete(ctx, giteaWebhook)
 	return err
 }
 
-// ApiTokenList converts echo context to params.
-func (w *ServerInterfaceWrapper) ApiTokenList(ctx echo.Context) error {
+// GiteaUpdateWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) GiteaUpdateWebhook(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// ------------- Path parameter "webhook" -------------
+	var webhook openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhook", runtime.ParamLocationPath, ctx.Param("webhook"), &webhook)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhook: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GiteaUpdateWebhook(ctx, webhook)
+	return err
+}
+
+// GithubAppListInstallations converts echo context to params.
+func (w *ServerInterfaceWrapper) GithubAppListInstallations(ctx echo.Context) error {
+	var err error
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ApiTokenList(ctx, tenant)
+	err = w.Handler.GithubAppListInstallations(ctx)
 	return err
 }
 
-// ApiTokenCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) ApiTokenCreate(ctx echo.Context) error {
+// GithubAppListRepos converts echo context to params.
+func (w *ServerInterfaceWrapper) GithubAppListRepos(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// ------------- Path parameter "gh-installation" -------------
+	var ghInstallation openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "gh-installation", runtime.ParamLocationPath, ctx.Param("gh-installation"), &ghInstallation)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gh-installation: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ApiTokenCreate(ctx, tenant)
+	err = w.Handler.GithubAppListRepos(ctx, ghInstallation)
 	return err
 }
 
-// EventList converts echo context to params.
-func (w *ServerInterfaceWrapper) EventList(ctx echo.Context) error {
+// GithubAppListBranches converts echo context to params.
+func (w *ServerInterfaceWrapper) GithubAppListBranches(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// ------------- Path parameter "gh-installation" -------------
+	var ghInstallation openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "gh-installation", runtime.ParamLocationPath, ctx.Param("gh-installation"), &ghInstallation)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gh-installation: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
-	ctx.Set(CookieAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params EventListParams
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Path parameter "gh-repo-owner" -------------
+	var ghRepoOwner string
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "gh-repo-owner", runtime.ParamLocationPath, ctx.Param("gh-repo-owner"), &ghRepoOwner)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gh-repo-owner: %s", err))
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Path parameter "gh-repo-name" -------------
+	var ghRepoName string
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "gh-repo-name", runtime.ParamLocationPath, ctx.Param("gh-repo-name"), &ghRepoName)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gh-repo-name: %s", err))
 	}
 
-	// ------------- Optional query parameter "keys" -------------
+	ctx.Set(CookieAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "keys", ctx.QueryParams(), &params.Keys)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keys: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GithubAppListBranches(ctx, ghInstallation, ghRepoOwner, ghRepoName)
+	return err
+}
 
-	// ------------- Optional query parameter "workflows" -------------
+// GithubUpdateGlobalWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) GithubUpdateGlobalWebhook(ctx echo.Context) error {
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "workflows", ctx.QueryParams(), &params.Workflows)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflows: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GithubUpdateGlobalWebhook(ctx)
+	return err
+}
 
-	// ------------- Optional query parameter "statuses" -------------
+// GithubUpdateTenantWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) GithubUpdateTenantWebhook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "webhook" -------------
+	var webhook openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhook", runtime.ParamLocationPath, ctx.Param("webhook"), &webhook)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhook: %s", err))
 	}
 
-	// ------------- Optional query parameter "search" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GithubUpdateTenantWebhook(ctx, webhook)
+	return err
+}
 
-	// ------------- Optional query parameter "orderByField" -------------
+// LogSinkDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) LogSinkDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "log-sink" -------------
+	var logSink openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "log-sink", runtime.ParamLocationPath, ctx.Param("log-sink"), &logSink)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter log-sink: %s", err))
 	}
 
-	// ------------- Optional query parameter "orderByDirection" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventList(ctx, tenant, params)
+	err = w.Handler.LogSinkDelete(ctx, logSink)
 	return err
 }
 
-// EventKeyList converts echo context to params.
-func (w *ServerInterfaceWrapper) EventKeyList(ctx echo.Context) error {
+// MaintenanceWindowDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) MaintenanceWindowDelete(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// ------------- Path parameter "maintenance-window" -------------
+	var maintenanceWindow openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "maintenance-window", runtime.ParamLocationPath, ctx.Param("maintenance-window"), &maintenanceWindow)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maintenance-window: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -1583,59 +4123,50 @@ func (w *ServerInterfaceWrapper) EventKeyList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventKeyList(ctx, tenant)
+	err = w.Handler.MaintenanceWindowDelete(ctx, maintenanceWindow)
 	return err
 }
 
-// EventUpdateReplay converts echo context to params.
-func (w *ServerInterfaceWrapper) EventUpdateReplay(ctx echo.Context) error {
+// MetadataGet converts echo context to params.
+func (w *ServerInterfaceWrapper) MetadataGet(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
-	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.MetadataGet(ctx)
+	return err
+}
 
-	ctx.Set(CookieAuthScopes, []string{})
+// MetadataGetAttestationKeys converts echo context to params.
+func (w *ServerInterfaceWrapper) MetadataGetAttestationKeys(ctx echo.Context) error {
+	var err error
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventUpdateReplay(ctx, tenant)
+	err = w.Handler.MetadataGetAttestationKeys(ctx)
 	return err
 }
 
-// TenantInviteList converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantInviteList(ctx echo.Context) error {
+// MetadataListIntegrations converts echo context to params.
+func (w *ServerInterfaceWrapper) MetadataListIntegrations(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
-	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantInviteList(ctx, tenant)
+	err = w.Handler.MetadataListIntegrations(ctx)
 	return err
 }
 
-// TenantInviteCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantInviteCreate(ctx echo.Context) error {
+// ObjectStorageFeedDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) ObjectStorageFeedDelete(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// ------------- Path parameter "object-storage-feed" -------------
+	var objectStorageFeed openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "object-storage-feed", runtime.ParamLocationPath, ctx.Param("object-storage-feed"), &objectStorageFeed)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter object-storage-feed: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -1643,27 +4174,19 @@ func (w *ServerInterfaceWrapper) TenantInviteCreate(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantInviteCreate(ctx, tenant)
+	err = w.Handler.ObjectStorageFeedDelete(ctx, objectStorageFeed)
 	return err
 }
 
-// TenantInviteDelete converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantInviteDelete(ctx echo.Context) error {
+// PiiRuleDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) PiiRuleDelete(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
-	}
+	// ------------- Path parameter "pii-rule" -------------
+	var piiRule openapi_types.UUID
 
-	// ------------- Path parameter "tenant-invite" -------------
-	var tenantInvite openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, ctx.Param("tenant-invite"), &tenantInvite)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "pii-rule", runtime.ParamLocationPath, ctx.Param("pii-rule"), &piiRule)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-invite: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pii-rule: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -1671,27 +4194,19 @@ func (w *ServerInterfaceWrapper) TenantInviteDelete(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantInviteDelete(ctx, tenant, tenantInvite)
+	err = w.Handler.PiiRuleDelete(ctx, piiRule)
 	return err
 }
 
-// TenantInviteUpdate converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantInviteUpdate(ctx echo.Context) error {
+// QueryTriggerDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) QueryTriggerDelete(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
-	}
-
-	// ------------- Path parameter "tenant-invite" -------------
-	var tenantInvite openapi_types.UUID
+	// ------------- Path parameter "query-trigger" -------------
+	var queryTrigger openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, ctx.Param("tenant-invite"), &tenantInvite)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "query-trigger", runtime.ParamLocationPath, ctx.Param("query-trigger"), &queryTrigger)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-invite: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter query-trigger: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -1699,19 +4214,19 @@ func (w *ServerInterfaceWrapper) TenantInviteUpdate(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantInviteUpdate(ctx, tenant, tenantInvite)
+	err = w.Handler.QueryTriggerDelete(ctx, queryTrigger)
 	return err
 }
 
-// TenantMemberList converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantMemberList(ctx echo.Context) error {
+// RecurringTaskDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) RecurringTaskDelete(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// ------------- Path parameter "recurring-task" -------------
+	var recurringTask openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "recurring-task", runtime.ParamLocationPath, ctx.Param("recurring-task"), &recurringTask)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter recurring-task: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -1719,19 +4234,19 @@ func (w *ServerInterfaceWrapper) TenantMemberList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantMemberList(ctx, tenant)
+	err = w.Handler.RecurringTaskDelete(ctx, recurringTask)
 	return err
 }
 
-// SnsList converts echo context to params.
-func (w *ServerInterfaceWrapper) SnsList(ctx echo.Context) error {
+// SnsDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) SnsDelete(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// ------------- Path parameter "sns" -------------
+	var sns openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "sns", runtime.ParamLocationPath, ctx.Param("sns"), &sns)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sns: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -1739,12 +4254,12 @@ func (w *ServerInterfaceWrapper) SnsList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SnsList(ctx, tenant)
+	err = w.Handler.SnsDelete(ctx, sns)
 	return err
 }
 
-// SnsCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) SnsCreate(ctx echo.Context) error {
+// SnsUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) SnsUpdate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -1754,26 +4269,22 @@ func (w *ServerInterfaceWrapper) SnsCreate(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Path parameter "event" -------------
+	var event string
 
-	ctx.Set(CookieAuthScopes, []string{})
+	err = runtime.BindStyledParameterWithLocation("simple", false, "event", runtime.ParamLocationPath, ctx.Param("event"), &event)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SnsCreate(ctx, tenant)
+	err = w.Handler.SnsUpdate(ctx, tenant, event)
 	return err
 }
 
-// StepRunGet converts echo context to params.
-func (w *ServerInterfaceWrapper) StepRunGet(ctx echo.Context) error {
+// StepRunUpdateCreatePr converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunUpdateCreatePr(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
-	}
-
 	// ------------- Path parameter "step-run" -------------
 	var stepRun openapi_types.UUID
 
@@ -1787,21 +4298,13 @@ func (w *ServerInterfaceWrapper) StepRunGet(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.StepRunGet(ctx, tenant, stepRun)
+	err = w.Handler.StepRunUpdateCreatePr(ctx, stepRun)
 	return err
 }
 
-// StepRunUpdateRerun converts echo context to params.
-func (w *ServerInterfaceWrapper) StepRunUpdateRerun(ctx echo.Context) error {
+// StepRunGetDiff converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunGetDiff(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
-	}
-
 	// ------------- Path parameter "step-run" -------------
 	var stepRun openapi_types.UUID
 
@@ -1815,21 +4318,13 @@ func (w *ServerInterfaceWrapper) StepRunUpdateRerun(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.StepRunUpdateRerun(ctx, tenant, stepRun)
+	err = w.Handler.StepRunGetDiff(ctx, stepRun)
 	return err
 }
 
-// StepRunGetSchema converts echo context to params.
-func (w *ServerInterfaceWrapper) StepRunGetSchema(ctx echo.Context) error {
+// LogLineList converts echo context to params.
+func (w *ServerInterfaceWrapper) LogLineList(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
-	}
-
 	// ------------- Path parameter "step-run" -------------
 	var stepRun openapi_types.UUID
 
@@ -1842,20 +4337,64 @@ func (w *ServerInterfaceWrapper) StepRunGetSchema(ctx echo.Context) error {
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.StepRunGetSchema(ctx, tenant, stepRun)
-	return err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LogLineListParams
+	// ------------- Optional query parameter "offset" -------------
 
-// WorkerList converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkerList(ctx echo.Context) error {
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "levels" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "levels", ctx.QueryParams(), &params.Levels)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter levels: %s", err))
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderByField" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderByDirection" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LogLineList(ctx, stepRun, params)
+	return err
+}
+
+// TenantExportGet converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantExportGet(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// ------------- Path parameter "tenant-export" -------------
+	var tenantExport openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-export", runtime.ParamLocationPath, ctx.Param("tenant-export"), &tenantExport)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-export: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -1863,27 +4402,44 @@ func (w *ServerInterfaceWrapper) WorkerList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkerList(ctx, tenant)
+	err = w.Handler.TenantExportGet(ctx, tenantExport)
 	return err
 }
 
-// WorkflowRunGet converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunGet(ctx echo.Context) error {
+// TenantExportDownload converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantExportDownload(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// ------------- Path parameter "tenant-export" -------------
+	var tenantExport openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-export", runtime.ParamLocationPath, ctx.Param("tenant-export"), &tenantExport)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-export: %s", err))
 	}
 
-	// ------------- Path parameter "workflow-run" -------------
-	var workflowRun openapi_types.UUID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TenantExportDownloadParams
+	// ------------- Required query parameter "token" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	err = runtime.BindQueryParameter("form", true, true, "token", ctx.QueryParams(), &params.Token)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantExportDownload(ctx, tenantExport, params)
+	return err
+}
+
+// TenantExportCreateDownloadUrl converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantExportCreateDownloadUrl(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant-export" -------------
+	var tenantExport openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-export", runtime.ParamLocationPath, ctx.Param("tenant-export"), &tenantExport)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-export: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -1891,12 +4447,25 @@ func (w *ServerInterfaceWrapper) WorkflowRunGet(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunGet(ctx, tenant, workflowRun)
+	err = w.Handler.TenantExportCreateDownloadUrl(ctx, tenantExport)
 	return err
 }
 
-// WorkflowRunListPullRequests converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunListPullRequests(ctx echo.Context) error {
+// TenantCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantCreate(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantCreate(ctx)
+	return err
+}
+
+// TenantGet converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantGet(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -1906,34 +4475,17 @@ func (w *ServerInterfaceWrapper) WorkflowRunListPullRequests(ctx echo.Context) e
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "workflow-run" -------------
-	var workflowRun openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkflowRunListPullRequestsParams
-	// ------------- Optional query parameter "state" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "state", ctx.QueryParams(), &params.State)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter state: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunListPullRequests(ctx, tenant, workflowRun, params)
+	err = w.Handler.TenantGet(ctx, tenant)
 	return err
 }
 
-// WorkflowList converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowList(ctx echo.Context) error {
+// TenantUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantUpdate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -1948,12 +4500,12 @@ func (w *ServerInterfaceWrapper) WorkflowList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowList(ctx, tenant)
+	err = w.Handler.TenantUpdate(ctx, tenant)
 	return err
 }
 
-// WorkflowRunList converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunList(ctx echo.Context) error {
+// ApiTokenList converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiTokenList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -1968,177 +4520,329 @@ func (w *ServerInterfaceWrapper) WorkflowRunList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkflowRunListParams
-	// ------------- Optional query parameter "offset" -------------
+	var params ApiTokenListParams
+	// ------------- Optional query parameter "environment" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "environment", ctx.QueryParams(), &params.Environment)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter environment: %s", err))
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiTokenList(ctx, tenant, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+// ApiTokenCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiTokenCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "eventId" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "eventId", ctx.QueryParams(), &params.EventId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventId: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
-	// ------------- Optional query parameter "workflowId" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApiTokenCreateParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
 
-	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
-	}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
 
+		params.IdempotencyKey = &IdempotencyKey
+	}
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunList(ctx, tenant, params)
+	err = w.Handler.ApiTokenCreate(ctx, tenant, params)
 	return err
 }
 
-// UserGetCurrent converts echo context to params.
-func (w *ServerInterfaceWrapper) UserGetCurrent(ctx echo.Context) error {
+// ClientCertificateList converts echo context to params.
+func (w *ServerInterfaceWrapper) ClientCertificateList(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserGetCurrent(ctx)
+	err = w.Handler.ClientCertificateList(ctx, tenant)
 	return err
 }
 
-// UserUpdateGithubOauthCallback converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdateGithubOauthCallback(ctx echo.Context) error {
+// ClientCertificateCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ClientCertificateCreate(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdateGithubOauthCallback(ctx)
+	err = w.Handler.ClientCertificateCreate(ctx, tenant)
 	return err
 }
 
-// UserUpdateGithubOauthStart converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdateGithubOauthStart(ctx echo.Context) error {
+// StepRunListCostCenterUsage converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunListCostCenterUsage(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdateGithubOauthStart(ctx)
-	return err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StepRunListCostCenterUsageParams
+	// ------------- Optional query parameter "since" -------------
 
-// UserUpdateGoogleOauthCallback converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdateGoogleOauthCallback(ctx echo.Context) error {
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdateGoogleOauthCallback(ctx)
-	return err
-}
+	// ------------- Optional query parameter "until" -------------
 
-// UserUpdateGoogleOauthStart converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdateGoogleOauthStart(ctx echo.Context) error {
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdateGoogleOauthStart(ctx)
-	return err
-}
+	// ------------- Optional query parameter "bucket" -------------
 
-// UserListTenantInvites converts echo context to params.
-func (w *ServerInterfaceWrapper) UserListTenantInvites(ctx echo.Context) error {
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "bucket", ctx.QueryParams(), &params.Bucket)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bucket: %s", err))
+	}
 
-	ctx.Set(CookieAuthScopes, []string{})
+	// ------------- Optional query parameter "costCenter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "costCenter", ctx.QueryParams(), &params.CostCenter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter costCenter: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserListTenantInvites(ctx)
+	err = w.Handler.StepRunListCostCenterUsage(ctx, tenant, params)
 	return err
 }
 
-// TenantInviteAccept converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantInviteAccept(ctx echo.Context) error {
+// TenantGetEngineSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantGetEngineSettings(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantInviteAccept(ctx)
+	err = w.Handler.TenantGetEngineSettings(ctx, tenant)
 	return err
 }
 
-// TenantInviteReject converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantInviteReject(ctx echo.Context) error {
+// TenantUpdateEngineSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantUpdateEngineSettings(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantInviteReject(ctx)
+	err = w.Handler.TenantUpdateEngineSettings(ctx, tenant)
 	return err
 }
 
-// UserUpdateLogin converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdateLogin(ctx echo.Context) error {
+// EventBusSubscriptionList converts echo context to params.
+func (w *ServerInterfaceWrapper) EventBusSubscriptionList(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdateLogin(ctx)
-	return err
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
 
-// UserUpdateLogout converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdateLogout(ctx echo.Context) error {
-	var err error
+	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdateLogout(ctx)
+	err = w.Handler.EventBusSubscriptionList(ctx, tenant)
 	return err
 }
 
-// TenantMembershipsList converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantMembershipsList(ctx echo.Context) error {
+// EventBusSubscriptionCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) EventBusSubscriptionCreate(ctx echo.Context) error {
 	var err error
-
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantMembershipsList(ctx)
+	err = w.Handler.EventBusSubscriptionCreate(ctx, tenant)
 	return err
 }
 
-// UserCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) UserCreate(ctx echo.Context) error {
+// EventList converts echo context to params.
+func (w *ServerInterfaceWrapper) EventList(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EventListParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "keys" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "keys", ctx.QueryParams(), &params.Keys)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keys: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflows" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflows", ctx.QueryParams(), &params.Workflows)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflows: %s", err))
+	}
+
+	// ------------- Optional query parameter "statuses" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
+	}
+
+	// ------------- Optional query parameter "environment" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "environment", ctx.QueryParams(), &params.Environment)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter environment: %s", err))
+	}
+
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderByField" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderByDirection" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserCreate(ctx)
+	err = w.Handler.EventList(ctx, tenant, params)
 	return err
 }
 
-// WorkerGet converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkerGet(ctx echo.Context) error {
+// EventKeyList converts echo context to params.
+func (w *ServerInterfaceWrapper) EventKeyList(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "worker" -------------
-	var worker openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "worker", runtime.ParamLocationPath, ctx.Param("worker"), &worker)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -2146,19 +4850,19 @@ func (w *ServerInterfaceWrapper) WorkerGet(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkerGet(ctx, worker)
+	err = w.Handler.EventKeyList(ctx, tenant)
 	return err
 }
 
-// WorkflowDelete converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowDelete(ctx echo.Context) error {
+// EventUpdateReplay converts echo context to params.
+func (w *ServerInterfaceWrapper) EventUpdateReplay(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "workflow" -------------
-	var workflow openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -2166,19 +4870,19 @@ func (w *ServerInterfaceWrapper) WorkflowDelete(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowDelete(ctx, workflow)
+	err = w.Handler.EventUpdateReplay(ctx, tenant)
 	return err
 }
 
-// WorkflowGet converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowGet(ctx echo.Context) error {
+// TenantExportList converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantExportList(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "workflow" -------------
-	var workflow openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -2186,19 +4890,19 @@ func (w *ServerInterfaceWrapper) WorkflowGet(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowGet(ctx, workflow)
+	err = w.Handler.TenantExportList(ctx, tenant)
 	return err
 }
 
-// WorkflowUpdateLinkGithub converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowUpdateLinkGithub(ctx echo.Context) error {
+// TenantExportCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantExportCreate(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "workflow" -------------
-	var workflow openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -2206,3766 +4910,11878 @@ func (w *ServerInterfaceWrapper) WorkflowUpdateLinkGithub(ctx echo.Context) erro
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowUpdateLinkGithub(ctx, workflow)
+	err = w.Handler.TenantExportCreate(ctx, tenant)
 	return err
 }
 
-// WorkflowRunCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunCreate(ctx echo.Context) error {
+// GiteaWebhookList converts echo context to params.
+func (w *ServerInterfaceWrapper) GiteaWebhookList(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "workflow" -------------
-	var workflow openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkflowRunCreateParams
-	// ------------- Optional query parameter "version" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GiteaWebhookList(ctx, tenant)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+// GiteaWebhookCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) GiteaWebhookCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunCreate(ctx, workflow, params)
+	err = w.Handler.GiteaWebhookCreate(ctx, tenant)
 	return err
 }
 
-// WorkflowVersionGet converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowVersionGet(ctx echo.Context) error {
+// GithubWebhookList converts echo context to params.
+func (w *ServerInterfaceWrapper) GithubWebhookList(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "workflow" -------------
-	var workflow openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkflowVersionGetParams
-	// ------------- Optional query parameter "version" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GithubWebhookList(ctx, tenant)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+// TenantInviteList converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantInviteList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowVersionGet(ctx, workflow, params)
+	err = w.Handler.TenantInviteList(ctx, tenant)
 	return err
 }
 
-// WorkflowVersionGetDefinition converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowVersionGetDefinition(ctx echo.Context) error {
+// TenantInviteCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantInviteCreate(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "workflow" -------------
-	var workflow openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkflowVersionGetDefinitionParams
-	// ------------- Optional query parameter "version" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantInviteCreate(ctx, tenant)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+// TenantInviteDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantInviteDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "tenant-invite" -------------
+	var tenantInvite openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, ctx.Param("tenant-invite"), &tenantInvite)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-invite: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowVersionGetDefinition(ctx, workflow, params)
+	err = w.Handler.TenantInviteDelete(ctx, tenant, tenantInvite)
 	return err
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+// TenantInviteUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantInviteUpdate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "tenant-invite" -------------
+	var tenantInvite openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, ctx.Param("tenant-invite"), &tenantInvite)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-invite: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantInviteUpdate(ctx, tenant, tenantInvite)
+	return err
+}
+
+// LogSinkList converts echo context to params.
+func (w *ServerInterfaceWrapper) LogSinkList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LogSinkList(ctx, tenant)
+	return err
+}
+
+// LogSinkCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) LogSinkCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LogSinkCreate(ctx, tenant)
+	return err
+}
+
+// TenantMemberList converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantMemberList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantMemberList(ctx, tenant)
+	return err
+}
+
+// ObjectStorageFeedList converts echo context to params.
+func (w *ServerInterfaceWrapper) ObjectStorageFeedList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ObjectStorageFeedList(ctx, tenant)
+	return err
+}
+
+// ObjectStorageFeedCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ObjectStorageFeedCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ObjectStorageFeedCreate(ctx, tenant)
+	return err
+}
+
+// TenantDeletePause converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantDeletePause(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantDeletePause(ctx, tenant)
+	return err
+}
+
+// TenantUpdatePause converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantUpdatePause(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantUpdatePause(ctx, tenant)
+	return err
+}
+
+// PiiRuleList converts echo context to params.
+func (w *ServerInterfaceWrapper) PiiRuleList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PiiRuleList(ctx, tenant)
+	return err
+}
+
+// PiiRuleCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) PiiRuleCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PiiRuleCreate(ctx, tenant)
+	return err
+}
+
+// QueryTriggerList converts echo context to params.
+func (w *ServerInterfaceWrapper) QueryTriggerList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.QueryTriggerList(ctx, tenant)
+	return err
+}
+
+// QueryTriggerCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) QueryTriggerCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.QueryTriggerCreate(ctx, tenant)
+	return err
+}
+
+// RecurringTaskList converts echo context to params.
+func (w *ServerInterfaceWrapper) RecurringTaskList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RecurringTaskList(ctx, tenant)
+	return err
+}
+
+// RecurringTaskCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) RecurringTaskCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RecurringTaskCreate(ctx, tenant)
+	return err
+}
+
+// SnsList converts echo context to params.
+func (w *ServerInterfaceWrapper) SnsList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SnsList(ctx, tenant)
+	return err
+}
+
+// SnsCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) SnsCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SnsCreate(ctx, tenant)
+	return err
+}
+
+// StepRunListMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunListMetrics(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StepRunListMetricsParams
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StepRunListMetrics(ctx, tenant, params)
+	return err
+}
+
+// StepRunList converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StepRunListParams
+	// ------------- Optional query parameter "workflowRunId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflowRunId", ctx.QueryParams(), &params.WorkflowRunId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowRunId: %s", err))
+	}
+
+	// ------------- Optional query parameter "jobRunId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "jobRunId", ctx.QueryParams(), &params.JobRunId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter jobRunId: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StepRunList(ctx, tenant, params)
+	return err
+}
+
+// StepRunGet converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StepRunGet(ctx, tenant, stepRun)
+	return err
+}
+
+// StepRunListAttempts converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunListAttempts(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StepRunListAttempts(ctx, tenant, stepRun)
+	return err
+}
+
+// StepRunListDiagnostics converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunListDiagnostics(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StepRunListDiagnostics(ctx, tenant, stepRun)
+	return err
+}
+
+// StepRunListStreamEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunListStreamEvents(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StepRunListStreamEventsParams
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", ctx.QueryParams(), &params.After)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StepRunListStreamEvents(ctx, tenant, stepRun, params)
+	return err
+}
+
+// StepRunUpdateRerun converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunUpdateRerun(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StepRunUpdateRerun(ctx, tenant, stepRun)
+	return err
+}
+
+// StepRunGetSchema converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunGetSchema(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StepRunGetSchema(ctx, tenant, stepRun)
+	return err
+}
+
+// SubjectDeletionCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) SubjectDeletionCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SubjectDeletionCreate(ctx, tenant)
+	return err
+}
+
+// WebhookDeliveryList converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookDeliveryList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WebhookDeliveryListParams
+	// ------------- Optional query parameter "webhook" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "webhook", ctx.QueryParams(), &params.Webhook)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhook: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhookDeliveryList(ctx, tenant, params)
+	return err
+}
+
+// WorkerList converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkerList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkerListParams
+	// ------------- Optional query parameter "environment" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "environment", ctx.QueryParams(), &params.Environment)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter environment: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkerList(ctx, tenant, params)
+	return err
+}
+
+// WorkflowRunBulkRetry converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunBulkRetry(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunBulkRetry(ctx, tenant)
+	return err
+}
+
+// WorkflowRunGetBulkRetry converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunGetBulkRetry(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "bulk-retry" -------------
+	var bulkRetry openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "bulk-retry", runtime.ParamLocationPath, ctx.Param("bulk-retry"), &bulkRetry)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bulk-retry: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunGetBulkRetry(ctx, tenant, bulkRetry)
+	return err
+}
+
+// WorkflowRunImport converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunImport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunImport(ctx, tenant)
+	return err
+}
+
+// WorkflowRunGet converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowRunGetParams
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "include", ctx.QueryParams(), &params.Include)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunGet(ctx, tenant, workflowRun, params)
+	return err
+}
+
+// WorkflowRunGetAttestation converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunGetAttestation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunGetAttestation(ctx, tenant, workflowRun)
+	return err
+}
+
+// WorkflowRunGetBundle converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunGetBundle(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunGetBundle(ctx, tenant, workflowRun)
+	return err
+}
+
+// WorkflowRunGetDag converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunGetDag(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunGetDag(ctx, tenant, workflowRun)
+	return err
+}
+
+// WorkflowRunGetGroupKeyRun converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunGetGroupKeyRun(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunGetGroupKeyRun(ctx, tenant, workflowRun)
+	return err
+}
+
+// WorkflowRunListPullRequests converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunListPullRequests(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowRunListPullRequestsParams
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", ctx.QueryParams(), &params.State)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter state: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunListPullRequests(ctx, tenant, workflowRun, params)
+	return err
+}
+
+// WorkflowRunCreateReplay converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunCreateReplay(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunCreateReplay(ctx, tenant, workflowRun)
+	return err
+}
+
+// WorkflowRunGetRootCause converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunGetRootCause(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunGetRootCause(ctx, tenant, workflowRun)
+	return err
+}
+
+// WorkflowList converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowListParams
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Optional query parameter "environment" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "environment", ctx.QueryParams(), &params.Environment)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter environment: %s", err))
+	}
+
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowList(ctx, tenant, params)
+	return err
+}
+
+// WorkflowPut converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowPut(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowPut(ctx, tenant)
+	return err
+}
+
+// WorkflowRunList converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowRunListParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "eventId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "eventId", ctx.QueryParams(), &params.EventId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventId: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflowId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "createdAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", ctx.QueryParams(), &params.CreatedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdAfter: %s", err))
+	}
+
+	// ------------- Optional query parameter "cancelledSource" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cancelledSource", ctx.QueryParams(), &params.CancelledSource)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cancelledSource: %s", err))
+	}
+
+	// ------------- Optional query parameter "environment" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "environment", ctx.QueryParams(), &params.Environment)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter environment: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunList(ctx, tenant, params)
+	return err
+}
+
+// WorkflowRunExport converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunExport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowRunExportParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflowId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "createdAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", ctx.QueryParams(), &params.CreatedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdAfter: %s", err))
+	}
+
+	// ------------- Optional query parameter "createdBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdBefore", ctx.QueryParams(), &params.CreatedBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdBefore: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunExport(ctx, tenant, params)
+	return err
+}
+
+// WorkflowRunGetMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunGetMetrics(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowRunGetMetricsParams
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+	}
+
+	// ------------- Optional query parameter "bucket" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bucket", ctx.QueryParams(), &params.Bucket)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bucket: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflowId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunGetMetrics(ctx, tenant, params)
+	return err
+}
+
+// WorkflowRunListScheduled converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunListScheduled(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowRunListScheduledParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflowId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunListScheduled(ctx, tenant, params)
+	return err
+}
+
+// TriggerLinkDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) TriggerLinkDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "trigger-link" -------------
+	var triggerLink openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "trigger-link", runtime.ParamLocationPath, ctx.Param("trigger-link"), &triggerLink)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter trigger-link: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TriggerLinkDelete(ctx, triggerLink)
+	return err
+}
+
+// TriggerLinkRun converts echo context to params.
+func (w *ServerInterfaceWrapper) TriggerLinkRun(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "trigger-link" -------------
+	var triggerLink openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "trigger-link", runtime.ParamLocationPath, ctx.Param("trigger-link"), &triggerLink)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter trigger-link: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TriggerLinkRunParams
+	// ------------- Required query parameter "token" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "token", ctx.QueryParams(), &params.Token)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TriggerLinkRun(ctx, triggerLink, params)
+	return err
+}
+
+// UserGetCurrent converts echo context to params.
+func (w *ServerInterfaceWrapper) UserGetCurrent(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserGetCurrent(ctx)
+	return err
+}
+
+// UserUpdateGithubOauthCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateGithubOauthCallback(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateGithubOauthCallback(ctx)
+	return err
+}
+
+// UserUpdateGithubOauthStart converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateGithubOauthStart(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateGithubOauthStart(ctx)
+	return err
+}
+
+// UserUpdateGoogleOauthCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateGoogleOauthCallback(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateGoogleOauthCallback(ctx)
+	return err
+}
+
+// UserUpdateGoogleOauthStart converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateGoogleOauthStart(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateGoogleOauthStart(ctx)
+	return err
+}
+
+// UserListTenantInvites converts echo context to params.
+func (w *ServerInterfaceWrapper) UserListTenantInvites(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserListTenantInvites(ctx)
+	return err
+}
+
+// TenantInviteAccept converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantInviteAccept(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantInviteAccept(ctx)
+	return err
+}
+
+// TenantInviteReject converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantInviteReject(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantInviteReject(ctx)
+	return err
+}
+
+// UserUpdateLogin converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateLogin(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateLogin(ctx)
+	return err
+}
+
+// UserUpdateLogout converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateLogout(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateLogout(ctx)
+	return err
+}
+
+// TenantMembershipsList converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantMembershipsList(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantMembershipsList(ctx)
+	return err
+}
+
+// UserCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) UserCreate(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserCreate(ctx)
+	return err
+}
+
+// WorkerGet converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkerGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "worker" -------------
+	var worker openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "worker", runtime.ParamLocationPath, ctx.Param("worker"), &worker)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkerGet(ctx, worker)
+	return err
+}
+
+// WorkflowDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowDelete(ctx, workflow)
+	return err
+}
+
+// WorkflowGet converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowGetParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowGet(ctx, workflow, params)
+	return err
+}
+
+// WorkflowGetConcurrencyQueueDepth converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowGetConcurrencyQueueDepth(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowGetConcurrencyQueueDepth(ctx, workflow)
+	return err
+}
+
+// WorkflowCreateConcurrencySimulation converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowCreateConcurrencySimulation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowCreateConcurrencySimulation(ctx, workflow)
+	return err
+}
+
+// WorkflowUpdateDiagnostics converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowUpdateDiagnostics(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowUpdateDiagnostics(ctx, workflow)
+	return err
+}
+
+// WorkflowUpdateLinkGithub converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowUpdateLinkGithub(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowUpdateLinkGithub(ctx, workflow)
+	return err
+}
+
+// MaintenanceWindowList converts echo context to params.
+func (w *ServerInterfaceWrapper) MaintenanceWindowList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.MaintenanceWindowList(ctx, workflow)
+	return err
+}
+
+// MaintenanceWindowCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) MaintenanceWindowCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.MaintenanceWindowCreate(ctx, workflow)
+	return err
+}
+
+// WorkflowDeletePause converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowDeletePause(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowDeletePause(ctx, workflow)
+	return err
+}
+
+// WorkflowUpdatePause converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowUpdatePause(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowUpdatePause(ctx, workflow)
+	return err
+}
+
+// WorkflowDeleteRollout converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowDeleteRollout(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowDeleteRollout(ctx, workflow)
+	return err
+}
+
+// WorkflowGetRollout converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowGetRollout(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowGetRollout(ctx, workflow)
+	return err
+}
+
+// WorkflowUpdateRollout converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowUpdateRollout(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowUpdateRollout(ctx, workflow)
+	return err
+}
+
+// WorkflowListSlaBreaches converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowListSlaBreaches(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowListSlaBreachesParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowListSlaBreaches(ctx, workflow, params)
+	return err
+}
+
+// WorkflowRunCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowRunCreateParams
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// ------------- Optional query parameter "wait" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "wait", ctx.QueryParams(), &params.Wait)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter wait: %s", err))
+	}
+
+	// ------------- Optional query parameter "timeoutSeconds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timeoutSeconds", ctx.QueryParams(), &params.TimeoutSeconds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter timeoutSeconds: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunCreate(ctx, workflow, params)
+	return err
+}
+
+// TriggerLinkList converts echo context to params.
+func (w *ServerInterfaceWrapper) TriggerLinkList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TriggerLinkList(ctx, workflow)
+	return err
+}
+
+// TriggerLinkCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) TriggerLinkCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TriggerLinkCreate(ctx, workflow)
+	return err
+}
+
+// WorkflowVersionGet converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowVersionGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowVersionGetParams
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowVersionGet(ctx, workflow, params)
+	return err
+}
+
+// WorkflowVersionGetDefinition converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowVersionGetDefinition(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowVersionGetDefinitionParams
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowVersionGetDefinition(ctx, workflow, params)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 }
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/api/live", wrapper.LivenessGet)
+	router.GET(baseURL+"/api/ready", wrapper.ReadinessGet)
+	router.GET(baseURL+"/api/v1/admin/github-app", wrapper.AdminGithubAppCreate)
+	router.GET(baseURL+"/api/v1/admin/github-app/callback", wrapper.AdminGithubAppCreateCallback)
+	router.POST(baseURL+"/api/v1/admin/maintenance", wrapper.AdminMaintenanceCreate)
+	router.GET(baseURL+"/api/v1/admin/queues", wrapper.AdminQueueList)
+	router.GET(baseURL+"/api/v1/admin/tenants", wrapper.AdminTenantList)
+	router.GET(baseURL+"/api/v1/admin/tenants/:tenant/feature-flags", wrapper.AdminTenantFeatureFlagList)
+	router.PUT(baseURL+"/api/v1/admin/tenants/:tenant/feature-flags", wrapper.AdminTenantFeatureFlagUpdate)
+	router.PUT(baseURL+"/api/v1/admin/tenants/:tenant/ingestion", wrapper.AdminTenantUpdateIngestion)
+	router.POST(baseURL+"/api/v1/admin/tenants/:tenant/workflow-runs/:workflow-run/fail", wrapper.AdminWorkflowRunUpdateFail)
+	router.GET(baseURL+"/api/v1/api-tokens/:api-token", wrapper.ApiTokenGet)
+	router.POST(baseURL+"/api/v1/api-tokens/:api-token", wrapper.ApiTokenUpdateRevoke)
+	router.POST(baseURL+"/api/v1/api-tokens/:api-token/rotate", wrapper.ApiTokenUpdateRotate)
+	router.DELETE(baseURL+"/api/v1/client-certificates/:client-certificate", wrapper.ClientCertificateDelete)
+	router.DELETE(baseURL+"/api/v1/event-bus-subscriptions/:event-bus-subscription", wrapper.EventBusSubscriptionDelete)
+	router.POST(baseURL+"/api/v1/event-bus-subscriptions/:event-bus-subscription/ack", wrapper.EventBusSubscriptionAck)
+	router.GET(baseURL+"/api/v1/event-bus-subscriptions/:event-bus-subscription/records", wrapper.EventBusSubscriptionListRecords)
+	router.GET(baseURL+"/api/v1/events/:event/data", wrapper.EventDataGet)
+	router.DELETE(baseURL+"/api/v1/gitea-webhooks/:gitea-webhook", wrapper.GiteaWebhookDelete)
+	router.POST(baseURL+"/api/v1/gitea/webhook/:webhook", wrapper.GiteaUpdateWebhook)
+	router.GET(baseURL+"/api/v1/github-app/installations", wrapper.GithubAppListInstallations)
+	router.GET(baseURL+"/api/v1/github-app/installations/:gh-installation/repos", wrapper.GithubAppListRepos)
+	router.GET(baseURL+"/api/v1/github-app/installations/:gh-installation/repos/:gh-repo-owner/:gh-repo-name/branches", wrapper.GithubAppListBranches)
+	router.POST(baseURL+"/api/v1/github/webhook", wrapper.GithubUpdateGlobalWebhook)
+	router.POST(baseURL+"/api/v1/github/webhook/:webhook", wrapper.GithubUpdateTenantWebhook)
+	router.DELETE(baseURL+"/api/v1/log-sinks/:log-sink", wrapper.LogSinkDelete)
+	router.DELETE(baseURL+"/api/v1/maintenance-windows/:maintenance-window", wrapper.MaintenanceWindowDelete)
+	router.GET(baseURL+"/api/v1/meta", wrapper.MetadataGet)
+	router.GET(baseURL+"/api/v1/meta/attestation-keys", wrapper.MetadataGetAttestationKeys)
+	router.GET(baseURL+"/api/v1/meta/integrations", wrapper.MetadataListIntegrations)
+	router.DELETE(baseURL+"/api/v1/object-storage-feeds/:object-storage-feed", wrapper.ObjectStorageFeedDelete)
+	router.DELETE(baseURL+"/api/v1/pii-rules/:pii-rule", wrapper.PiiRuleDelete)
+	router.DELETE(baseURL+"/api/v1/query-triggers/:query-trigger", wrapper.QueryTriggerDelete)
+	router.DELETE(baseURL+"/api/v1/recurring-tasks/:recurring-task", wrapper.RecurringTaskDelete)
+	router.DELETE(baseURL+"/api/v1/sns/:sns", wrapper.SnsDelete)
+	router.POST(baseURL+"/api/v1/sns/:tenant/:event", wrapper.SnsUpdate)
+	router.POST(baseURL+"/api/v1/step-runs/:step-run/create-pr", wrapper.StepRunUpdateCreatePr)
+	router.GET(baseURL+"/api/v1/step-runs/:step-run/diff", wrapper.StepRunGetDiff)
+	router.GET(baseURL+"/api/v1/step-runs/:step-run/logs", wrapper.LogLineList)
+	router.GET(baseURL+"/api/v1/tenant-exports/:tenant-export", wrapper.TenantExportGet)
+	router.GET(baseURL+"/api/v1/tenant-exports/:tenant-export/download", wrapper.TenantExportDownload)
+	router.POST(baseURL+"/api/v1/tenant-exports/:tenant-export/download-url", wrapper.TenantExportCreateDownloadUrl)
+	router.POST(baseURL+"/api/v1/tenants", wrapper.TenantCreate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant", wrapper.TenantGet)
+	router.PATCH(baseURL+"/api/v1/tenants/:tenant", wrapper.TenantUpdate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/api-tokens", wrapper.ApiTokenList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/api-tokens", wrapper.ApiTokenCreate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/client-certificates", wrapper.ClientCertificateList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/client-certificates", wrapper.ClientCertificateCreate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/cost-center-usage", wrapper.StepRunListCostCenterUsage)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/engine-settings", wrapper.TenantGetEngineSettings)
+	router.PUT(baseURL+"/api/v1/tenants/:tenant/engine-settings", wrapper.TenantUpdateEngineSettings)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/event-bus-subscriptions", wrapper.EventBusSubscriptionList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/event-bus-subscriptions", wrapper.EventBusSubscriptionCreate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/events", wrapper.EventList)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/events/keys", wrapper.EventKeyList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/events/replay", wrapper.EventUpdateReplay)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/exports", wrapper.TenantExportList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/exports", wrapper.TenantExportCreate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/gitea-webhooks", wrapper.GiteaWebhookList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/gitea-webhooks", wrapper.GiteaWebhookCreate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/github-webhooks", wrapper.GithubWebhookList)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/invites", wrapper.TenantInviteList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/invites", wrapper.TenantInviteCreate)
+	router.DELETE(baseURL+"/api/v1/tenants/:tenant/invites/:tenant-invite", wrapper.TenantInviteDelete)
+	router.PATCH(baseURL+"/api/v1/tenants/:tenant/invites/:tenant-invite", wrapper.TenantInviteUpdate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/log-sinks", wrapper.LogSinkList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/log-sinks", wrapper.LogSinkCreate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/members", wrapper.TenantMemberList)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/object-storage-feeds", wrapper.ObjectStorageFeedList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/object-storage-feeds", wrapper.ObjectStorageFeedCreate)
+	router.DELETE(baseURL+"/api/v1/tenants/:tenant/pause", wrapper.TenantDeletePause)
+	router.PUT(baseURL+"/api/v1/tenants/:tenant/pause", wrapper.TenantUpdatePause)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/pii-rules", wrapper.PiiRuleList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/pii-rules", wrapper.PiiRuleCreate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/query-triggers", wrapper.QueryTriggerList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/query-triggers", wrapper.QueryTriggerCreate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/recurring-tasks", wrapper.RecurringTaskList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/recurring-tasks", wrapper.RecurringTaskCreate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/sns", wrapper.SnsList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/sns", wrapper.SnsCreate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/step-run-metrics", wrapper.StepRunListMetrics)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/step-runs", wrapper.StepRunList)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/step-runs/:step-run", wrapper.StepRunGet)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/step-runs/:step-run/attempts", wrapper.StepRunListAttempts)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/step-runs/:step-run/diagnostics", wrapper.StepRunListDiagnostics)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/step-runs/:step-run/stream-events", wrapper.StepRunListStreamEvents)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/step-runs/:step-run/rerun", wrapper.StepRunUpdateRerun)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/step-runs/:step-run/schema", wrapper.StepRunGetSchema)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/subject-deletions", wrapper.SubjectDeletionCreate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/webhook-deliveries", wrapper.WebhookDeliveryList)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/worker", wrapper.WorkerList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/workflow-runs/bulk-retry", wrapper.WorkflowRunBulkRetry)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/workflow-runs/bulk-retry/:bulk-retry", wrapper.WorkflowRunGetBulkRetry)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/workflow-runs/import", wrapper.WorkflowRunImport)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/workflow-runs/:workflow-run", wrapper.WorkflowRunGet)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/workflow-runs/:workflow-run/attestation", wrapper.WorkflowRunGetAttestation)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/workflow-runs/:workflow-run/bundle", wrapper.WorkflowRunGetBundle)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/workflow-runs/:workflow-run/dag", wrapper.WorkflowRunGetDag)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/workflow-runs/:workflow-run/group-key-run", wrapper.WorkflowRunGetGroupKeyRun)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/workflow-runs/:workflow-run/prs", wrapper.WorkflowRunListPullRequests)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/workflow-runs/:workflow-run/replay", wrapper.WorkflowRunCreateReplay)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/workflow-runs/:workflow-run/root-cause", wrapper.WorkflowRunGetRootCause)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows", wrapper.WorkflowList)
+	router.PUT(baseURL+"/api/v1/tenants/:tenant/workflows", wrapper.WorkflowPut)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/runs", wrapper.WorkflowRunList)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/runs/export", wrapper.WorkflowRunExport)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/runs/metrics", wrapper.WorkflowRunGetMetrics)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/schedules", wrapper.WorkflowRunListScheduled)
+	router.DELETE(baseURL+"/api/v1/trigger-links/:trigger-link", wrapper.TriggerLinkDelete)
+	router.POST(baseURL+"/api/v1/trigger-links/:trigger-link/trigger", wrapper.TriggerLinkRun)
+	router.GET(baseURL+"/api/v1/users/current", wrapper.UserGetCurrent)
+	router.GET(baseURL+"/api/v1/users/github/callback", wrapper.UserUpdateGithubOauthCallback)
+	router.GET(baseURL+"/api/v1/users/github/start", wrapper.UserUpdateGithubOauthStart)
+	router.GET(baseURL+"/api/v1/users/google/callback", wrapper.UserUpdateGoogleOauthCallback)
+	router.GET(baseURL+"/api/v1/users/google/start", wrapper.UserUpdateGoogleOauthStart)
+	router.GET(baseURL+"/api/v1/users/invites", wrapper.UserListTenantInvites)
+	router.POST(baseURL+"/api/v1/users/invites/accept", wrapper.TenantInviteAccept)
+	router.POST(baseURL+"/api/v1/users/invites/reject", wrapper.TenantInviteReject)
+	router.POST(baseURL+"/api/v1/users/login", wrapper.UserUpdateLogin)
+	router.POST(baseURL+"/api/v1/users/logout", wrapper.UserUpdateLogout)
+	router.GET(baseURL+"/api/v1/users/memberships", wrapper.TenantMembershipsList)
+	router.POST(baseURL+"/api/v1/users/register", wrapper.UserCreate)
+	router.GET(baseURL+"/api/v1/workers/:worker", wrapper.WorkerGet)
+	router.DELETE(baseURL+"/api/v1/workflows/:workflow", wrapper.WorkflowDelete)
+	router.GET(baseURL+"/api/v1/workflows/:workflow", wrapper.WorkflowGet)
+	router.GET(baseURL+"/api/v1/workflows/:workflow/concurrency-queue-depth", wrapper.WorkflowGetConcurrencyQueueDepth)
+	router.POST(baseURL+"/api/v1/workflows/:workflow/concurrency-simulations", wrapper.WorkflowCreateConcurrencySimulation)
+	router.PUT(baseURL+"/api/v1/workflows/:workflow/diagnostics", wrapper.WorkflowUpdateDiagnostics)
+	router.POST(baseURL+"/api/v1/workflows/:workflow/link-github", wrapper.WorkflowUpdateLinkGithub)
+	router.GET(baseURL+"/api/v1/workflows/:workflow/maintenance-windows", wrapper.MaintenanceWindowList)
+	router.POST(baseURL+"/api/v1/workflows/:workflow/maintenance-windows", wrapper.MaintenanceWindowCreate)
+	router.DELETE(baseURL+"/api/v1/workflows/:workflow/pause", wrapper.WorkflowDeletePause)
+	router.PUT(baseURL+"/api/v1/workflows/:workflow/pause", wrapper.WorkflowUpdatePause)
+	router.DELETE(baseURL+"/api/v1/workflows/:workflow/rollout", wrapper.WorkflowDeleteRollout)
+	router.GET(baseURL+"/api/v1/workflows/:workflow/rollout", wrapper.WorkflowGetRollout)
+	router.PUT(baseURL+"/api/v1/workflows/:workflow/rollout", wrapper.WorkflowUpdateRollout)
+	router.GET(baseURL+"/api/v1/workflows/:workflow/sla-breaches", wrapper.WorkflowListSlaBreaches)
+	router.POST(baseURL+"/api/v1/workflows/:workflow/trigger", wrapper.WorkflowRunCreate)
+	router.GET(baseURL+"/api/v1/workflows/:workflow/trigger-links", wrapper.TriggerLinkList)
+	router.POST(baseURL+"/api/v1/workflows/:workflow/trigger-links", wrapper.TriggerLinkCreate)
+	router.GET(baseURL+"/api/v1/workflows/:workflow/versions", wrapper.WorkflowVersionGet)
+	router.GET(baseURL+"/api/v1/workflows/:workflow/versions/definition", wrapper.WorkflowVersionGetDefinition)
+
+}
+
+type LivenessGetRequestObject struct {
+}
+
+type LivenessGetResponseObject interface {
+	VisitLivenessGetResponse(w http.ResponseWriter) error
+}
+
+type LivenessGet200Response struct {
+}
+
+func (response LivenessGet200Response) VisitLivenessGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type LivenessGet500Response struct {
+}
+
+func (response LivenessGet500Response) VisitLivenessGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ReadinessGetRequestObject struct {
+}
+
+type ReadinessGetResponseObject interface {
+	VisitReadinessGetResponse(w http.ResponseWriter) error
+}
+
+type ReadinessGet200Response struct {
+}
+
+func (response ReadinessGet200Response) VisitReadinessGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type ReadinessGet500Response struct {
+}
+
+func (response ReadinessGet500Response) VisitReadinessGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type AdminGithubAppCreateRequestObject struct {
+	Params AdminGithubAppCreateParams
+}
+
+type AdminGithubAppCreateResponseObject interface {
+	VisitAdminGithubAppCreateResponse(w http.ResponseWriter) error
+}
+
+type AdminGithubAppCreate200TexthtmlResponse struct {
+	Body io.Reader
+
+	ContentLength int64
+}
+
+func (response AdminGithubAppCreate200TexthtmlResponse) VisitAdminGithubAppCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/html")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type AdminGithubAppCreate400JSONResponse APIErrors
+
+func (response AdminGithubAppCreate400JSONResponse) VisitAdminGithubAppCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminGithubAppCreate403JSONResponse APIErrors
+
+func (response AdminGithubAppCreate403JSONResponse) VisitAdminGithubAppCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminGithubAppCreateCallbackRequestObject struct {
+}
+
+type AdminGithubAppCreateCallbackResponseObject interface {
+	VisitAdminGithubAppCreateCallbackResponse(w http.ResponseWriter) error
+}
+
+type AdminGithubAppCreateCallback302ResponseHeaders struct {
+	Location string
+}
+
+type AdminGithubAppCreateCallback302Response struct {
+	Headers AdminGithubAppCreateCallback302ResponseHeaders
+}
+
+func (response AdminGithubAppCreateCallback302Response) VisitAdminGithubAppCreateCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type AdminMaintenanceCreateRequestObject struct {
+	Body *AdminMaintenanceCreateJSONRequestBody
+}
+
+type AdminMaintenanceCreateResponseObject interface {
+	VisitAdminMaintenanceCreateResponse(w http.ResponseWriter) error
+}
+
+type AdminMaintenanceCreate200Response struct {
+}
+
+func (response AdminMaintenanceCreate200Response) VisitAdminMaintenanceCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type AdminMaintenanceCreate400JSONResponse APIErrors
+
+func (response AdminMaintenanceCreate400JSONResponse) VisitAdminMaintenanceCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminMaintenanceCreate403JSONResponse APIErrors
+
+func (response AdminMaintenanceCreate403JSONResponse) VisitAdminMaintenanceCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminQueueListRequestObject struct {
+}
+
+type AdminQueueListResponseObject interface {
+	VisitAdminQueueListResponse(w http.ResponseWriter) error
+}
+
+type AdminQueueList200JSONResponse AdminQueueList
+
+func (response AdminQueueList200JSONResponse) VisitAdminQueueListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminQueueList400JSONResponse APIErrors
+
+func (response AdminQueueList400JSONResponse) VisitAdminQueueListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminQueueList403JSONResponse APIErrors
+
+func (response AdminQueueList403JSONResponse) VisitAdminQueueListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminTenantListRequestObject struct {
+	Params AdminTenantListParams
+}
+
+type AdminTenantListResponseObject interface {
+	VisitAdminTenantListResponse(w http.ResponseWriter) error
+}
+
+type AdminTenantList200JSONResponse AdminTenantList
+
+func (response AdminTenantList200JSONResponse) VisitAdminTenantListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminTenantList400JSONResponse APIErrors
+
+func (response AdminTenantList400JSONResponse) VisitAdminTenantListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminTenantList403JSONResponse APIErrors
+
+func (response AdminTenantList403JSONResponse) VisitAdminTenantListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminTenantFeatureFlagListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type AdminTenantFeatureFlagListResponseObject interface {
+	VisitAdminTenantFeatureFlagListResponse(w http.ResponseWriter) error
+}
+
+type AdminTenantFeatureFlagList200JSONResponse AdminFeatureFlagList
+
+func (response AdminTenantFeatureFlagList200JSONResponse) VisitAdminTenantFeatureFlagListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminTenantFeatureFlagList400JSONResponse APIErrors
+
+func (response AdminTenantFeatureFlagList400JSONResponse) VisitAdminTenantFeatureFlagListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminTenantFeatureFlagList403JSONResponse APIErrors
+
+func (response AdminTenantFeatureFlagList403JSONResponse) VisitAdminTenantFeatureFlagListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminTenantFeatureFlagUpdateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *AdminTenantFeatureFlagUpdateJSONRequestBody
+}
+
+type AdminTenantFeatureFlagUpdateResponseObject interface {
+	VisitAdminTenantFeatureFlagUpdateResponse(w http.ResponseWriter) error
+}
+
+type AdminTenantFeatureFlagUpdate200JSONResponse AdminFeatureFlag
+
+func (response AdminTenantFeatureFlagUpdate200JSONResponse) VisitAdminTenantFeatureFlagUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminTenantFeatureFlagUpdate400JSONResponse APIErrors
+
+func (response AdminTenantFeatureFlagUpdate400JSONResponse) VisitAdminTenantFeatureFlagUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminTenantFeatureFlagUpdate403JSONResponse APIErrors
+
+func (response AdminTenantFeatureFlagUpdate403JSONResponse) VisitAdminTenantFeatureFlagUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminTenantUpdateIngestionRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *AdminTenantUpdateIngestionJSONRequestBody
+}
+
+type AdminTenantUpdateIngestionResponseObject interface {
+	VisitAdminTenantUpdateIngestionResponse(w http.ResponseWriter) error
+}
+
+type AdminTenantUpdateIngestion200JSONResponse Tenant
+
+func (response AdminTenantUpdateIngestion200JSONResponse) VisitAdminTenantUpdateIngestionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminTenantUpdateIngestion400JSONResponse APIErrors
+
+func (response AdminTenantUpdateIngestion400JSONResponse) VisitAdminTenantUpdateIngestionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminTenantUpdateIngestion403JSONResponse APIErrors
+
+func (response AdminTenantUpdateIngestion403JSONResponse) VisitAdminTenantUpdateIngestionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminWorkflowRunUpdateFailRequestObject struct {
+	Tenant      openapi_types.UUID `json:"tenant"`
+	WorkflowRun openapi_types.UUID `json:"workflow-run"`
+}
+
+type AdminWorkflowRunUpdateFailResponseObject interface {
+	VisitAdminWorkflowRunUpdateFailResponse(w http.ResponseWriter) error
+}
+
+type AdminWorkflowRunUpdateFail200JSONResponse WorkflowRun
+
+func (response AdminWorkflowRunUpdateFail200JSONResponse) VisitAdminWorkflowRunUpdateFailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminWorkflowRunUpdateFail400JSONResponse APIErrors
+
+func (response AdminWorkflowRunUpdateFail400JSONResponse) VisitAdminWorkflowRunUpdateFailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminWorkflowRunUpdateFail403JSONResponse APIErrors
+
+func (response AdminWorkflowRunUpdateFail403JSONResponse) VisitAdminWorkflowRunUpdateFailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenGetRequestObject struct {
+	ApiToken openapi_types.UUID `json:"api-token"`
+}
+
+type ApiTokenGetResponseObject interface {
+	VisitApiTokenGetResponse(w http.ResponseWriter) error
+}
+
+type ApiTokenGet200JSONResponse APIToken
+
+func (response ApiTokenGet200JSONResponse) VisitApiTokenGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenGet400JSONResponse APIErrors
+
+func (response ApiTokenGet400JSONResponse) VisitApiTokenGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenGet403JSONResponse APIErrors
+
+func (response ApiTokenGet403JSONResponse) VisitApiTokenGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenGet404JSONResponse APIErrors
+
+func (response ApiTokenGet404JSONResponse) VisitApiTokenGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenUpdateRevokeRequestObject struct {
+	ApiToken openapi_types.UUID `json:"api-token"`
+}
+
+type ApiTokenUpdateRevokeResponseObject interface {
+	VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error
+}
+
+type ApiTokenUpdateRevoke204Response struct {
+}
+
+func (response ApiTokenUpdateRevoke204Response) VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ApiTokenUpdateRevoke400JSONResponse APIErrors
+
+func (response ApiTokenUpdateRevoke400JSONResponse) VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenUpdateRevoke403JSONResponse APIErrors
+
+func (response ApiTokenUpdateRevoke403JSONResponse) VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenUpdateRotateRequestObject struct {
+	ApiToken openapi_types.UUID `json:"api-token"`
+}
+
+type ApiTokenUpdateRotateResponseObject interface {
+	VisitApiTokenUpdateRotateResponse(w http.ResponseWriter) error
+}
+
+type ApiTokenUpdateRotate200JSONResponse CreateAPITokenResponse
+
+func (response ApiTokenUpdateRotate200JSONResponse) VisitApiTokenUpdateRotateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenUpdateRotate400JSONResponse APIErrors
+
+func (response ApiTokenUpdateRotate400JSONResponse) VisitApiTokenUpdateRotateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenUpdateRotate403JSONResponse APIErrors
+
+func (response ApiTokenUpdateRotate403JSONResponse) VisitApiTokenUpdateRotateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClientCertificateDeleteRequestObject struct {
+	ClientCertificate openapi_types.UUID `json:"client-certificate"`
+}
+
+type ClientCertificateDeleteResponseObject interface {
+	VisitClientCertificateDeleteResponse(w http.ResponseWriter) error
+}
+
+type ClientCertificateDelete204Response struct {
+}
+
+func (response ClientCertificateDelete204Response) VisitClientCertificateDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ClientCertificateDelete400JSONResponse APIErrors
+
+func (response ClientCertificateDelete400JSONResponse) VisitClientCertificateDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClientCertificateDelete403JSONResponse APIErrors
+
+func (response ClientCertificateDelete403JSONResponse) VisitClientCertificateDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventBusSubscriptionDeleteRequestObject struct {
+	EventBusSubscription openapi_types.UUID `json:"event-bus-subscription"`
+}
+
+type EventBusSubscriptionDeleteResponseObject interface {
+	VisitEventBusSubscriptionDeleteResponse(w http.ResponseWriter) error
+}
+
+type EventBusSubscriptionDelete204Response struct {
+}
+
+func (response EventBusSubscriptionDelete204Response) VisitEventBusSubscriptionDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type EventBusSubscriptionDelete400JSONResponse APIErrors
+
+func (response EventBusSubscriptionDelete400JSONResponse) VisitEventBusSubscriptionDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventBusSubscriptionDelete403JSONResponse APIErrors
+
+func (response EventBusSubscriptionDelete403JSONResponse) VisitEventBusSubscriptionDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventBusSubscriptionAckRequestObject struct {
+	EventBusSubscription openapi_types.UUID `json:"event-bus-subscription"`
+	Body                 *EventBusSubscriptionAckJSONRequestBody
+}
+
+type EventBusSubscriptionAckResponseObject interface {
+	VisitEventBusSubscriptionAckResponse(w http.ResponseWriter) error
+}
+
+type EventBusSubscriptionAck200JSONResponse EventBusSubscription
+
+func (response EventBusSubscriptionAck200JSONResponse) VisitEventBusSubscriptionAckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventBusSubscriptionAck400JSONResponse APIErrors
+
+func (response EventBusSubscriptionAck400JSONResponse) VisitEventBusSubscriptionAckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventBusSubscriptionAck403JSONResponse APIErrors
+
+func (response EventBusSubscriptionAck403JSONResponse) VisitEventBusSubscriptionAckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventBusSubscriptionListRecordsRequestObject struct {
+	EventBusSubscription openapi_types.UUID `json:"event-bus-subscription"`
+	Params               EventBusSubscriptionListRecordsParams
+}
+
+type EventBusSubscriptionListRecordsResponseObject interface {
+	VisitEventBusSubscriptionListRecordsResponse(w http.ResponseWriter) error
+}
+
+type EventBusSubscriptionListRecords200JSONResponse ListEventBusRecords
+
+func (response EventBusSubscriptionListRecords200JSONResponse) VisitEventBusSubscriptionListRecordsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventBusSubscriptionListRecords400JSONResponse APIErrors
+
+func (response EventBusSubscriptionListRecords400JSONResponse) VisitEventBusSubscriptionListRecordsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventBusSubscriptionListRecords403JSONResponse APIErrors
+
+func (response EventBusSubscriptionListRecords403JSONResponse) VisitEventBusSubscriptionListRecordsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventDataGetRequestObject struct {
+	Event openapi_types.UUID `json:"event"`
+}
+
+type EventDataGetResponseObject interface {
+	VisitEventDataGetResponse(w http.ResponseWriter) error
+}
+
+type EventDataGet200JSONResponse EventData
+
+func (response EventDataGet200JSONResponse) VisitEventDataGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventDataGet400JSONResponse APIErrors
+
+func (response EventDataGet400JSONResponse) VisitEventDataGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventDataGet403JSONResponse APIErrors
+
+func (response EventDataGet403JSONResponse) VisitEventDataGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GiteaWebhookDeleteRequestObject struct {
+	GiteaWebhook openapi_types.UUID `json:"gitea-webhook"`
+}
+
+type GiteaWebhookDeleteResponseObject interface {
+	VisitGiteaWebhookDeleteResponse(w http.ResponseWriter) error
+}
+
+type GiteaWebhookDelete204Response struct {
+}
+
+func (response GiteaWebhookDelete204Response) VisitGiteaWebhookDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type GiteaWebhookDelete400JSONResponse APIErrors
+
+func (response GiteaWebhookDelete400JSONResponse) VisitGiteaWebhookDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GiteaWebhookDelete401JSONResponse APIErrors
+
+func (response GiteaWebhookDelete401JSONResponse) VisitGiteaWebhookDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GiteaWebhookDelete405JSONResponse APIErrors
+
+func (response GiteaWebhookDelete405JSONResponse) VisitGiteaWebhookDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GiteaUpdateWebhookRequestObject struct {
+	Webhook openapi_types.UUID `json:"webhook"`
+}
+
+type GiteaUpdateWebhookResponseObject interface {
+	VisitGiteaUpdateWebhookResponse(w http.ResponseWriter) error
+}
+
+type GiteaUpdateWebhook200Response struct {
+}
+
+func (response GiteaUpdateWebhook200Response) VisitGiteaUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GiteaUpdateWebhook400JSONResponse APIErrors
+
+func (response GiteaUpdateWebhook400JSONResponse) VisitGiteaUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GiteaUpdateWebhook401JSONResponse APIErrors
+
+func (response GiteaUpdateWebhook401JSONResponse) VisitGiteaUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GiteaUpdateWebhook405JSONResponse APIErrors
+
+func (response GiteaUpdateWebhook405JSONResponse) VisitGiteaUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GithubAppListInstallationsRequestObject struct {
+}
+
+type GithubAppListInstallationsResponseObject interface {
+	VisitGithubAppListInstallationsResponse(w http.ResponseWriter) error
+}
+
+type GithubAppListInstallations200JSONResponse ListGithubAppInstallationsResponse
+
+func (response GithubAppListInstallations200JSONResponse) VisitGithubAppListInstallationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GithubAppListInstallations400JSONResponse APIErrors
+
+func (response GithubAppListInstallations400JSONResponse) VisitGithubAppListInstallationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GithubAppListInstallations401JSONResponse APIErrors
+
+func (response GithubAppListInstallations401JSONResponse) VisitGithubAppListInstallationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GithubAppListInstallations405JSONResponse APIErrors
+
+func (response GithubAppListInstallations405JSONResponse) VisitGithubAppListInstallationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GithubAppListReposRequestObject struct {
+	GhInstallation openapi_types.UUID `json:"gh-installation"`
+}
+
+type GithubAppListReposResponseObject interface {
+	VisitGithubAppListReposResponse(w http.ResponseWriter) error
+}
+
+type GithubAppListRepos200JSONResponse ListGithubReposResponse
+
+func (response GithubAppListRepos200JSONResponse) VisitGithubAppListReposResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GithubAppListRepos400JSONResponse APIErrors
+
+func (response GithubAppListRepos400JSONResponse) VisitGithubAppListReposResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GithubAppListRepos401JSONResponse APIErrors
+
+func (response GithubAppListRepos401JSONResponse) VisitGithubAppListReposResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GithubAppListRepos405JSONResponse APIErrors
+
+func (response GithubAppListRepos405JSONResponse) VisitGithubAppListReposResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GithubAppListBranchesRequestObject struct {
+	GhInstallation openapi_types.UUID `json:"gh-installation"`
+	GhRepoOwner    string             `json:"gh-repo-owner"`
+	GhRepoName     string             `json:"gh-repo-name"`
+}
+
+type GithubAppListBranchesResponseObject interface {
+	VisitGithubAppListBranchesResponse(w http.ResponseWriter) error
+}
+
+type GithubAppListBranches200JSONResponse ListGithubBranchesResponse
+
+func (response GithubAppListBranches200JSONResponse) VisitGithubAppListBranchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GithubAppListBranches400JSONResponse APIErrors
+
+func (response GithubAppListBranches400JSONResponse) VisitGithubAppListBranchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GithubAppListBranches401JSONResponse APIErrors
+
+func (response GithubAppListBranches401JSONResponse) VisitGithubAppListBranchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GithubAppListBranches405JSONResponse APIErrors
+
+func (response GithubAppListBranches405JSONResponse) VisitGithubAppListBranchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GithubUpdateGlobalWebhookRequestObject struct {
+}
+
+type GithubUpdateGlobalWebhookResponseObject interface {
+	VisitGithubUpdateGlobalWebhookResponse(w http.ResponseWriter) error
+}
+
+type GithubUpdateGlobalWebhook200Response struct {
+}
+
+func (response GithubUpdateGlobalWebhook200Response) VisitGithubUpdateGlobalWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GithubUpdateGlobalWebhook400JSONResponse APIErrors
+
+func (response GithubUpdateGlobalWebhook400JSONResponse) VisitGithubUpdateGlobalWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GithubUpdateGlobalWebhook401JSONResponse APIErrors
+
+func (response GithubUpdateGlobalWebhook401JSONResponse) VisitGithubUpdateGlobalWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GithubUpdateGlobalWebhook405JSONResponse APIErrors
+
+func (response GithubUpdateGlobalWebhook405JSONResponse) VisitGithubUpdateGlobalWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GithubUpdateTenantWebhookRequestObject struct {
+	Webhook openapi_types.UUID `json:"webhook"`
+}
+
+type GithubUpdateTenantWebhookResponseObject interface {
+	VisitGithubUpdateTenantWebhookResponse(w http.ResponseWriter) error
+}
+
+type GithubUpdateTenantWebhook200Response struct {
+}
+
+func (response GithubUpdateTenantWebhook200Response) VisitGithubUpdateTenantWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GithubUpdateTenantWebhook400JSONResponse APIErrors
+
+func (response GithubUpdateTenantWebhook400JSONResponse) VisitGithubUpdateTenantWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GithubUpdateTenantWebhook401JSONResponse APIErrors
+
+func (response GithubUpdateTenantWebhook401JSONResponse) VisitGithubUpdateTenantWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GithubUpdateTenantWebhook405JSONResponse APIErrors
+
+func (response GithubUpdateTenantWebhook405JSONResponse) VisitGithubUpdateTenantWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogSinkDeleteRequestObject struct {
+	LogSink openapi_types.UUID `json:"log-sink"`
+}
+
+type LogSinkDeleteResponseObject interface {
+	VisitLogSinkDeleteResponse(w http.ResponseWriter) error
+}
+
+type LogSinkDelete204Response struct {
+}
+
+func (response LogSinkDelete204Response) VisitLogSinkDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type LogSinkDelete400JSONResponse APIErrors
+
+func (response LogSinkDelete400JSONResponse) VisitLogSinkDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogSinkDelete403JSONResponse APIErrors
+
+func (response LogSinkDelete403JSONResponse) VisitLogSinkDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MaintenanceWindowDeleteRequestObject struct {
+	MaintenanceWindow openapi_types.UUID `json:"maintenance-window"`
+}
+
+type MaintenanceWindowDeleteResponseObject interface {
+	VisitMaintenanceWindowDeleteResponse(w http.ResponseWriter) error
+}
+
+type MaintenanceWindowDelete204Response struct {
+}
+
+func (response MaintenanceWindowDelete204Response) VisitMaintenanceWindowDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type MaintenanceWindowDelete400JSONResponse APIErrors
+
+func (response MaintenanceWindowDelete400JSONResponse) VisitMaintenanceWindowDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MaintenanceWindowDelete403JSONResponse APIErrors
+
+func (response MaintenanceWindowDelete403JSONResponse) VisitMaintenanceWindowDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MetadataGetRequestObject struct {
+}
+
+type MetadataGetResponseObject interface {
+	VisitMetadataGetResponse(w http.ResponseWriter) error
+}
+
+type MetadataGet200JSONResponse APIMeta
+
+func (response MetadataGet200JSONResponse) VisitMetadataGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MetadataGet400JSONResponse APIErrors
+
+func (response MetadataGet400JSONResponse) VisitMetadataGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MetadataGetAttestationKeysRequestObject struct {
+}
+
+type MetadataGetAttestationKeysResponseObject interface {
+	VisitMetadataGetAttestationKeysResponse(w http.ResponseWriter) error
+}
+
+type MetadataGetAttestationKeys200JSONResponse APIMetaAttestationKeys
+
+func (response MetadataGetAttestationKeys200JSONResponse) VisitMetadataGetAttestationKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MetadataGetAttestationKeys400JSONResponse APIErrors
+
+func (response MetadataGetAttestationKeys400JSONResponse) VisitMetadataGetAttestationKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MetadataListIntegrationsRequestObject struct {
+}
+
+type MetadataListIntegrationsResponseObject interface {
+	VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error
+}
+
+type MetadataListIntegrations200JSONResponse ListAPIMetaIntegration
+
+func (response MetadataListIntegrations200JSONResponse) VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MetadataListIntegrations400JSONResponse APIErrors
+
+func (response MetadataListIntegrations400JSONResponse) VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ObjectStorageFeedDeleteRequestObject struct {
+	ObjectStorageFeed openapi_types.UUID `json:"object-storage-feed"`
+}
+
+type ObjectStorageFeedDeleteResponseObject interface {
+	VisitObjectStorageFeedDeleteResponse(w http.ResponseWriter) error
+}
+
+type ObjectStorageFeedDelete204Response struct {
+}
+
+func (response ObjectStorageFeedDelete204Response) VisitObjectStorageFeedDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ObjectStorageFeedDelete400JSONResponse APIErrors
+
+func (response ObjectStorageFeedDelete400JSONResponse) VisitObjectStorageFeedDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ObjectStorageFeedDelete403JSONResponse APIErrors
+
+func (response ObjectStorageFeedDelete403JSONResponse) VisitObjectStorageFeedDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PiiRuleDeleteRequestObject struct {
+	PiiRule openapi_types.UUID `json:"pii-rule"`
+}
+
+type PiiRuleDeleteResponseObject interface {
+	VisitPiiRuleDeleteResponse(w http.ResponseWriter) error
+}
+
+type PiiRuleDelete204Response struct {
+}
+
+func (response PiiRuleDelete204Response) VisitPiiRuleDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PiiRuleDelete400JSONResponse APIErrors
+
+func (response PiiRuleDelete400JSONResponse) VisitPiiRuleDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PiiRuleDelete403JSONResponse APIErrors
+
+func (response PiiRuleDelete403JSONResponse) VisitPiiRuleDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTriggerDeleteRequestObject struct {
+	QueryTrigger openapi_types.UUID `json:"query-trigger"`
+}
+
+type QueryTriggerDeleteResponseObject interface {
+	VisitQueryTriggerDeleteResponse(w http.ResponseWriter) error
+}
+
+type QueryTriggerDelete204Response struct {
+}
+
+func (response QueryTriggerDelete204Response) VisitQueryTriggerDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type QueryTriggerDelete400JSONResponse APIErrors
+
+func (response QueryTriggerDelete400JSONResponse) VisitQueryTriggerDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTriggerDelete403JSONResponse APIErrors
+
+func (response QueryTriggerDelete403JSONResponse) VisitQueryTriggerDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecurringTaskDeleteRequestObject struct {
+	RecurringTask openapi_types.UUID `json:"recurring-task"`
+}
+
+type RecurringTaskDeleteResponseObject interface {
+	VisitRecurringTaskDeleteResponse(w http.ResponseWriter) error
+}
+
+type RecurringTaskDelete204Response struct {
+}
+
+func (response RecurringTaskDelete204Response) VisitRecurringTaskDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RecurringTaskDelete400JSONResponse APIErrors
+
+func (response RecurringTaskDelete400JSONResponse) VisitRecurringTaskDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecurringTaskDelete403JSONResponse APIErrors
+
+func (response RecurringTaskDelete403JSONResponse) VisitRecurringTaskDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsDeleteRequestObject struct {
+	Sns openapi_types.UUID `json:"sns"`
+}
+
+type SnsDeleteResponseObject interface {
+	VisitSnsDeleteResponse(w http.ResponseWriter) error
+}
+
+type SnsDelete204Response struct {
+}
+
+func (response SnsDelete204Response) VisitSnsDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type SnsDelete400JSONResponse APIErrors
+
+func (response SnsDelete400JSONResponse) VisitSnsDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsDelete401JSONResponse APIErrors
+
+func (response SnsDelete401JSONResponse) VisitSnsDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsDelete405JSONResponse APIErrors
+
+func (response SnsDelete405JSONResponse) VisitSnsDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsUpdateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Event  string             `json:"event"`
+}
+
+type SnsUpdateResponseObject interface {
+	VisitSnsUpdateResponse(w http.ResponseWriter) error
+}
+
+type SnsUpdate200Response struct {
+}
+
+func (response SnsUpdate200Response) VisitSnsUpdateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type SnsUpdate400JSONResponse APIErrors
+
+func (response SnsUpdate400JSONResponse) VisitSnsUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsUpdate401JSONResponse APIErrors
+
+func (response SnsUpdate401JSONResponse) VisitSnsUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsUpdate405JSONResponse APIErrors
+
+func (response SnsUpdate405JSONResponse) VisitSnsUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunUpdateCreatePrRequestObject struct {
+	StepRun openapi_types.UUID `json:"step-run"`
+	Body    *StepRunUpdateCreatePrJSONRequestBody
+}
+
+type StepRunUpdateCreatePrResponseObject interface {
+	VisitStepRunUpdateCreatePrResponse(w http.ResponseWriter) error
+}
+
+type StepRunUpdateCreatePr200JSONResponse CreatePullRequestFromStepRun
+
+func (response StepRunUpdateCreatePr200JSONResponse) VisitStepRunUpdateCreatePrResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunUpdateCreatePr400JSONResponse APIErrors
+
+func (response StepRunUpdateCreatePr400JSONResponse) VisitStepRunUpdateCreatePrResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunUpdateCreatePr403JSONResponse APIErrors
+
+func (response StepRunUpdateCreatePr403JSONResponse) VisitStepRunUpdateCreatePrResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunUpdateCreatePr404JSONResponse APIErrors
+
+func (response StepRunUpdateCreatePr404JSONResponse) VisitStepRunUpdateCreatePrResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunGetDiffRequestObject struct {
+	StepRun openapi_types.UUID `json:"step-run"`
+}
+
+type StepRunGetDiffResponseObject interface {
+	VisitStepRunGetDiffResponse(w http.ResponseWriter) error
+}
+
+type StepRunGetDiff200JSONResponse GetStepRunDiffResponse
+
+func (response StepRunGetDiff200JSONResponse) VisitStepRunGetDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunGetDiff400JSONResponse APIErrors
+
+func (response StepRunGetDiff400JSONResponse) VisitStepRunGetDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunGetDiff403JSONResponse APIErrors
+
+func (response StepRunGetDiff403JSONResponse) VisitStepRunGetDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunGetDiff404JSONResponse APIErrors
+
+func (response StepRunGetDiff404JSONResponse) VisitStepRunGetDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogLineListRequestObject struct {
+	StepRun openapi_types.UUID `json:"step-run"`
+	Params  LogLineListParams
+}
+
+type LogLineListResponseObject interface {
+	VisitLogLineListResponse(w http.ResponseWriter) error
+}
+
+type LogLineList200JSONResponse LogLineList
+
+func (response LogLineList200JSONResponse) VisitLogLineListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogLineList400JSONResponse APIErrors
+
+func (response LogLineList400JSONResponse) VisitLogLineListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogLineList403JSONResponse APIErrors
+
+func (response LogLineList403JSONResponse) VisitLogLineListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantExportGetRequestObject struct {
+	TenantExport openapi_types.UUID `json:"tenant-export"`
+}
+
+type TenantExportGetResponseObject interface {
+	VisitTenantExportGetResponse(w http.ResponseWriter) error
+}
+
+type TenantExportGet200JSONResponse TenantExport
+
+func (response TenantExportGet200JSONResponse) VisitTenantExportGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantExportGet400JSONResponse APIErrors
+
+func (response TenantExportGet400JSONResponse) VisitTenantExportGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantExportGet403JSONResponse APIErrors
+
+func (response TenantExportGet403JSONResponse) VisitTenantExportGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantExportGet404JSONResponse APIErrors
+
+func (response TenantExportGet404JSONResponse) VisitTenantExportGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantExportDownloadRequestObject struct {
+	TenantExport openapi_types.UUID `json:"tenant-export"`
+	Params       TenantExportDownloadParams
+}
+
+type TenantExportDownloadResponseObject interface {
+	VisitTenantExportDownloadResponse(w http.ResponseWriter) error
+}
+
+type TenantExportDownload200ApplicationgzipResponse struct {
+	Body io.Reader
+
+	ContentLength int64
+}
+
+func (response TenantExportDownload200ApplicationgzipResponse) VisitTenantExportDownloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/gzip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type TenantExportDownload400JSONResponse APIErrors
+
+func (response TenantExportDownload400JSONResponse) VisitTenantExportDownloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantExportDownload403JSONResponse APIErrors
+
+func (response TenantExportDownload403JSONResponse) VisitTenantExportDownloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantExportDownload404JSONResponse APIErrors
+
+func (response TenantExportDownload404JSONResponse) VisitTenantExportDownloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantExportCreateDownloadUrlRequestObject struct {
+	TenantExport openapi_types.UUID `json:"tenant-export"`
+}
+
+type TenantExportCreateDownloadUrlResponseObject interface {
+	VisitTenantExportCreateDownloadUrlResponse(w http.ResponseWriter) error
+}
+
+type TenantExportCreateDownloadUrl200JSONResponse TenantExportDownloadURL
+
+func (response TenantExportCreateDownloadUrl200JSONResponse) VisitTenantExportCreateDownloadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantExportCreateDownloadUrl400JSONResponse APIErrors
+
+func (response TenantExportCreateDownloadUrl400JSONResponse) VisitTenantExportCreateDownloadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantExportCreateDownloadUrl403JSONResponse APIErrors
+
+func (response TenantExportCreateDownloadUrl403JSONResponse) VisitTenantExportCreateDownloadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantExportCreateDownloadUrl404JSONResponse APIErrors
+
+func (response TenantExportCreateDownloadUrl404JSONResponse) VisitTenantExportCreateDownloadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantCreateRequestObject struct {
+	Body *TenantCreateJSONRequestBody
+}
+
+type TenantCreateResponseObject interface {
+	VisitTenantCreateResponse(w http.ResponseWriter) error
+}
+
+type TenantCreate200JSONResponse Tenant
+
+func (response TenantCreate200JSONResponse) VisitTenantCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantCreate400JSONResponse APIErrors
+
+func (response TenantCreate400JSONResponse) VisitTenantCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantCreate403JSONResponse APIError
+
+func (response TenantCreate403JSONResponse) VisitTenantCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantGetRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type TenantGetResponseObject interface {
+	VisitTenantGetResponse(w http.ResponseWriter) error
+}
+
+type TenantGet200JSONResponse Tenant
+
+func (response TenantGet200JSONResponse) VisitTenantGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantGet400JSONResponse APIErrors
+
+func (response TenantGet400JSONResponse) VisitTenantGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantGet403JSONResponse APIErrors
+
+func (response TenantGet403JSONResponse) VisitTenantGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantUpdateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *TenantUpdateJSONRequestBody
+}
+
+type TenantUpdateResponseObject interface {
+	VisitTenantUpdateResponse(w http.ResponseWriter) error
+}
+
+type TenantUpdate200JSONResponse Tenant
+
+func (response TenantUpdate200JSONResponse) VisitTenantUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantUpdate400JSONResponse APIErrors
+
+func (response TenantUpdate400JSONResponse) VisitTenantUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantUpdate403JSONResponse APIErrors
+
+func (response TenantUpdate403JSONResponse) VisitTenantUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params ApiTokenListParams
+}
+
+type ApiTokenListResponseObject interface {
+	VisitApiTokenListResponse(w http.ResponseWriter) error
+}
+
+type ApiTokenList200JSONResponse ListAPITokensResponse
+
+func (response ApiTokenList200JSONResponse) VisitApiTokenListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenList400JSONResponse APIErrors
+
+func (response ApiTokenList400JSONResponse) VisitApiTokenListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenList403JSONResponse APIErrors
+
+func (response ApiTokenList403JSONResponse) VisitApiTokenListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params ApiTokenCreateParams
+	Body   *ApiTokenCreateJSONRequestBody
+}
+
+type ApiTokenCreateResponseObject interface {
+	VisitApiTokenCreateResponse(w http.ResponseWriter) error
+}
+
+type ApiTokenCreate200JSONResponse CreateAPITokenResponse
+
+func (response ApiTokenCreate200JSONResponse) VisitApiTokenCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenCreate400JSONResponse APIErrors
+
+func (response ApiTokenCreate400JSONResponse) VisitApiTokenCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenCreate403JSONResponse APIErrors
+
+func (response ApiTokenCreate403JSONResponse) VisitApiTokenCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClientCertificateListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type ClientCertificateListResponseObject interface {
+	VisitClientCertificateListResponse(w http.ResponseWriter) error
+}
+
+type ClientCertificateList200JSONResponse ListClientCertificates
+
+func (response ClientCertificateList200JSONResponse) VisitClientCertificateListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClientCertificateList400JSONResponse APIErrors
+
+func (response ClientCertificateList400JSONResponse) VisitClientCertificateListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClientCertificateList403JSONResponse APIErrors
+
+func (response ClientCertificateList403JSONResponse) VisitClientCertificateListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClientCertificateCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *ClientCertificateCreateJSONRequestBody
+}
+
+type ClientCertificateCreateResponseObject interface {
+	VisitClientCertificateCreateResponse(w http.ResponseWriter) error
+}
+
+type ClientCertificateCreate201JSONResponse ClientCertificate
+
+func (response ClientCertificateCreate201JSONResponse) VisitClientCertificateCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClientCertificateCreate400JSONResponse APIErrors
+
+func (response ClientCertificateCreate400JSONResponse) VisitClientCertificateCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClientCertificateCreate403JSONResponse APIErrors
+
+func (response ClientCertificateCreate403JSONResponse) VisitClientCertificateCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListCostCenterUsageRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params StepRunListCostCenterUsageParams
+}
+
+type StepRunListCostCenterUsageResponseObject interface {
+	VisitStepRunListCostCenterUsageResponse(w http.ResponseWriter) error
+}
+
+type StepRunListCostCenterUsage200JSONResponse CostCenterUsage
+
+func (response StepRunListCostCenterUsage200JSONResponse) VisitStepRunListCostCenterUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListCostCenterUsage400JSONResponse APIErrors
+
+func (response StepRunListCostCenterUsage400JSONResponse) VisitStepRunListCostCenterUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListCostCenterUsage403JSONResponse APIErrors
+
+func (response StepRunListCostCenterUsage403JSONResponse) VisitStepRunListCostCenterUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantGetEngineSettingsRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type TenantGetEngineSettingsResponseObject interface {
+	VisitTenantGetEngineSettingsResponse(w http.ResponseWriter) error
+}
+
+type TenantGetEngineSettings200JSONResponse TenantEngineSettings
+
+func (response TenantGetEngineSettings200JSONResponse) VisitTenantGetEngineSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantGetEngineSettings400JSONResponse APIErrors
+
+func (response TenantGetEngineSettings400JSONResponse) VisitTenantGetEngineSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantGetEngineSettings403JSONResponse APIErrors
+
+func (response TenantGetEngineSettings403JSONResponse) VisitTenantGetEngineSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantUpdateEngineSettingsRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *TenantUpdateEngineSettingsJSONRequestBody
+}
+
+type TenantUpdateEngineSettingsResponseObject interface {
+	VisitTenantUpdateEngineSettingsResponse(w http.ResponseWriter) error
+}
+
+type TenantUpdateEngineSettings200JSONResponse TenantEngineSettings
+
+func (response TenantUpdateEngineSettings200JSONResponse) VisitTenantUpdateEngineSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantUpdateEngineSettings400JSONResponse APIErrors
+
+func (response TenantUpdateEngineSettings400JSONResponse) VisitTenantUpdateEngineSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantUpdateEngineSettings403JSONResponse APIErrors
+
+func (response TenantUpdateEngineSettings403JSONResponse) VisitTenantUpdateEngineSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventBusSubscriptionListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type EventBusSubscriptionListResponseObject interface {
+	VisitEventBusSubscriptionListResponse(w http.ResponseWriter) error
+}
+
+type EventBusSubscriptionList200JSONResponse ListEventBusSubscriptions
+
+func (response EventBusSubscriptionList200JSONResponse) VisitEventBusSubscriptionListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventBusSubscriptionList400JSONResponse APIErrors
+
+func (response EventBusSubscriptionList400JSONResponse) VisitEventBusSubscriptionListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventBusSubscriptionList403JSONResponse APIErrors
+
+func (response EventBusSubscriptionList403JSONResponse) VisitEventBusSubscriptionListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventBusSubscriptionCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *EventBusSubscriptionCreateJSONRequestBody
+}
+
+type EventBusSubscriptionCreateResponseObject interface {
+	VisitEventBusSubscriptionCreateResponse(w http.ResponseWriter) error
+}
+
+type EventBusSubscriptionCreate201JSONResponse EventBusSubscription
+
+func (response EventBusSubscriptionCreate201JSONResponse) VisitEventBusSubscriptionCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventBusSubscriptionCreate400JSONResponse APIErrors
+
+func (response EventBusSubscriptionCreate400JSONResponse) VisitEventBusSubscriptionCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventBusSubscriptionCreate403JSONResponse APIErrors
+
+func (response EventBusSubscriptionCreate403JSONResponse) VisitEventBusSubscriptionCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params EventListParams
+}
+
+type EventListResponseObject interface {
+	VisitEventListResponse(w http.ResponseWriter) error
+}
+
+type EventList200JSONResponse EventList
+
+func (response EventList200JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventList400JSONResponse APIErrors
+
+func (response EventList400JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventList403JSONResponse APIErrors
+
+func (response EventList403JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventKeyListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type EventKeyListResponseObject interface {
+	VisitEventKeyListResponse(w http.ResponseWriter) error
+}
+
+type EventKeyList200JSONResponse EventKeyList
+
+func (response EventKeyList200JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventKeyList400JSONResponse APIErrors
+
+func (response EventKeyList400JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventKeyList403JSONResponse APIErrors
+
+func (response EventKeyList403JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventUpdateReplayRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *EventUpdateReplayJSONRequestBody
+}
+
+type EventUpdateReplayResponseObject interface {
+	VisitEventUpdateReplayResponse(w http.ResponseWriter) error
+}
+
+type EventUpdateReplay200JSONResponse EventList
+
+func (response EventUpdateReplay200JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventUpdateReplay400JSONResponse APIErrors
+
+func (response EventUpdateReplay400JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventUpdateReplay403JSONResponse APIErrors
+
+func (response EventUpdateReplay403JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantExportListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type TenantExportListResponseObject interface {
+	VisitTenantExportListResponse(w http.ResponseWriter) error
+}
+
+type TenantExportList200JSONResponse ListTenantExports
+
+func (response TenantExportList200JSONResponse) VisitTenantExportListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantExportList400JSONResponse APIErrors
+
+func (response TenantExportList400JSONResponse) VisitTenantExportListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantExportList403JSONResponse APIErrors
+
+func (response TenantExportList403JSONResponse) VisitTenantExportListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantExportCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type TenantExportCreateResponseObject interface {
+	VisitTenantExportCreateResponse(w http.ResponseWriter) error
+}
+
+type TenantExportCreate200JSONResponse TenantExport
+
+func (response TenantExportCreate200JSONResponse) VisitTenantExportCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantExportCreate400JSONResponse APIErrors
+
+func (response TenantExportCreate400JSONResponse) VisitTenantExportCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantExportCreate403JSONResponse APIErrors
+
+func (response TenantExportCreate403JSONResponse) VisitTenantExportCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GiteaWebhookListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type GiteaWebhookListResponseObject interface {
+	VisitGiteaWebhookListResponse(w http.ResponseWriter) error
+}
+
+type GiteaWebhookList200JSONResponse ListGiteaWebhooks
+
+func (response GiteaWebhookList200JSONResponse) VisitGiteaWebhookListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GiteaWebhookList400JSONResponse APIErrors
+
+func (response GiteaWebhookList400JSONResponse) VisitGiteaWebhookListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GiteaWebhookList401JSONResponse APIErrors
+
+func (response GiteaWebhookList401JSONResponse) VisitGiteaWebhookListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GiteaWebhookList405JSONResponse APIErrors
+
+func (response GiteaWebhookList405JSONResponse) VisitGiteaWebhookListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GiteaWebhookCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *GiteaWebhookCreateJSONRequestBody
+}
+
+type GiteaWebhookCreateResponseObject interface {
+	VisitGiteaWebhookCreateResponse(w http.ResponseWriter) error
+}
+
+type GiteaWebhookCreate201JSONResponse GiteaWebhook
+
+func (response GiteaWebhookCreate201JSONResponse) VisitGiteaWebhookCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GiteaWebhookCreate400JSONResponse APIErrors
+
+func (response GiteaWebhookCreate400JSONResponse) VisitGiteaWebhookCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GiteaWebhookCreate401JSONResponse APIErrors
+
+func (response GiteaWebhookCreate401JSONResponse) VisitGiteaWebhookCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GiteaWebhookCreate405JSONResponse APIErrors
+
+func (response GiteaWebhookCreate405JSONResponse) VisitGiteaWebhookCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GithubWebhookListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type GithubWebhookListResponseObject interface {
+	VisitGithubWebhookListResponse(w http.ResponseWriter) error
+}
+
+type GithubWebhookList200JSONResponse ListGithubWebhooks
+
+func (response GithubWebhookList200JSONResponse) VisitGithubWebhookListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GithubWebhookList400JSONResponse APIErrors
+
+func (response GithubWebhookList400JSONResponse) VisitGithubWebhookListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GithubWebhookList403JSONResponse APIErrors
+
+func (response GithubWebhookList403JSONResponse) VisitGithubWebhookListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type TenantInviteListResponseObject interface {
+	VisitTenantInviteListResponse(w http.ResponseWriter) error
+}
+
+type TenantInviteList200JSONResponse TenantInviteList
+
+func (response TenantInviteList200JSONResponse) VisitTenantInviteListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteList400JSONResponse APIErrors
+
+func (response TenantInviteList400JSONResponse) VisitTenantInviteListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteList403JSONResponse APIError
+
+func (response TenantInviteList403JSONResponse) VisitTenantInviteListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *TenantInviteCreateJSONRequestBody
+}
+
+type TenantInviteCreateResponseObject interface {
+	VisitTenantInviteCreateResponse(w http.ResponseWriter) error
+}
+
+type TenantInviteCreate201JSONResponse TenantInvite
+
+func (response TenantInviteCreate201JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteCreate400JSONResponse APIErrors
+
+func (response TenantInviteCreate400JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteCreate403JSONResponse APIError
+
+func (response TenantInviteCreate403JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteDeleteRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	TenantInvite openapi_types.UUID `json:"tenant-invite"`
+}
+
+type TenantInviteDeleteResponseObject interface {
+	VisitTenantInviteDeleteResponse(w http.ResponseWriter) error
+}
+
+type TenantInviteDelete200JSONResponse TenantInvite
+
+func (response TenantInviteDelete200JSONResponse) VisitTenantInviteDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteDelete400JSONResponse APIErrors
+
+func (response TenantInviteDelete400JSONResponse) VisitTenantInviteDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteUpdateRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	TenantInvite openapi_types.UUID `json:"tenant-invite"`
+	Body         *TenantInviteUpdateJSONRequestBody
+}
+
+type TenantInviteUpdateResponseObject interface {
+	VisitTenantInviteUpdateResponse(w http.ResponseWriter) error
+}
+
+type TenantInviteUpdate200JSONResponse TenantInvite
+
+func (response TenantInviteUpdate200JSONResponse) VisitTenantInviteUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteUpdate400JSONResponse APIErrors
+
+func (response TenantInviteUpdate400JSONResponse) VisitTenantInviteUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogSinkListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type LogSinkListResponseObject interface {
+	VisitLogSinkListResponse(w http.ResponseWriter) error
+}
+
+type LogSinkList200JSONResponse ListLogSinks
+
+func (response LogSinkList200JSONResponse) VisitLogSinkListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogSinkList400JSONResponse APIErrors
+
+func (response LogSinkList400JSONResponse) VisitLogSinkListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogSinkList403JSONResponse APIErrors
+
+func (response LogSinkList403JSONResponse) VisitLogSinkListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogSinkCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *LogSinkCreateJSONRequestBody
+}
+
+type LogSinkCreateResponseObject interface {
+	VisitLogSinkCreateResponse(w http.ResponseWriter) error
+}
+
+type LogSinkCreate201JSONResponse LogSink
+
+func (response LogSinkCreate201JSONResponse) VisitLogSinkCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogSinkCreate400JSONResponse APIErrors
+
+func (response LogSinkCreate400JSONResponse) VisitLogSinkCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogSinkCreate403JSONResponse APIErrors
+
+func (response LogSinkCreate403JSONResponse) VisitLogSinkCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMemberListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type TenantMemberListResponseObject interface {
+	VisitTenantMemberListResponse(w http.ResponseWriter) error
+}
+
+type TenantMemberList200JSONResponse TenantMemberList
+
+func (response TenantMemberList200JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMemberList400JSONResponse APIErrors
+
+func (response TenantMemberList400JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMemberList403JSONResponse APIError
+
+func (response TenantMemberList403JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ObjectStorageFeedListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type ObjectStorageFeedListResponseObject interface {
+	VisitObjectStorageFeedListResponse(w http.ResponseWriter) error
+}
+
+type ObjectStorageFeedList200JSONResponse ListObjectStorageFeeds
+
+func (response ObjectStorageFeedList200JSONResponse) VisitObjectStorageFeedListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ObjectStorageFeedList400JSONResponse APIErrors
+
+func (response ObjectStorageFeedList400JSONResponse) VisitObjectStorageFeedListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ObjectStorageFeedList403JSONResponse APIErrors
+
+func (response ObjectStorageFeedList403JSONResponse) VisitObjectStorageFeedListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ObjectStorageFeedCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *ObjectStorageFeedCreateJSONRequestBody
+}
+
+type ObjectStorageFeedCreateResponseObject interface {
+	VisitObjectStorageFeedCreateResponse(w http.ResponseWriter) error
+}
+
+type ObjectStorageFeedCreate201JSONResponse ObjectStorageFeed
+
+func (response ObjectStorageFeedCreate201JSONResponse) VisitObjectStorageFeedCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ObjectStorageFeedCreate400JSONResponse APIErrors
+
+func (response ObjectStorageFeedCreate400JSONResponse) VisitObjectStorageFeedCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ObjectStorageFeedCreate403JSONResponse APIErrors
+
+func (response ObjectStorageFeedCreate403JSONResponse) VisitObjectStorageFeedCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantDeletePauseRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type TenantDeletePauseResponseObject interface {
+	VisitTenantDeletePauseResponse(w http.ResponseWriter) error
+}
+
+type TenantDeletePause200JSONResponse Tenant
+
+func (response TenantDeletePause200JSONResponse) VisitTenantDeletePauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantDeletePause400JSONResponse APIErrors
+
+func (response TenantDeletePause400JSONResponse) VisitTenantDeletePauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantDeletePause403JSONResponse APIErrors
+
+func (response TenantDeletePause403JSONResponse) VisitTenantDeletePauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantDeletePause404JSONResponse APIErrors
+
+func (response TenantDeletePause404JSONResponse) VisitTenantDeletePauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantUpdatePauseRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *TenantUpdatePauseJSONRequestBody
+}
+
+type TenantUpdatePauseResponseObject interface {
+	VisitTenantUpdatePauseResponse(w http.ResponseWriter) error
+}
+
+type TenantUpdatePause200JSONResponse Tenant
+
+func (response TenantUpdatePause200JSONResponse) VisitTenantUpdatePauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantUpdatePause400JSONResponse APIErrors
+
+func (response TenantUpdatePause400JSONResponse) VisitTenantUpdatePauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantUpdatePause403JSONResponse APIErrors
+
+func (response TenantUpdatePause403JSONResponse) VisitTenantUpdatePauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantUpdatePause404JSONResponse APIErrors
+
+func (response TenantUpdatePause404JSONResponse) VisitTenantUpdatePauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PiiRuleListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type PiiRuleListResponseObject interface {
+	VisitPiiRuleListResponse(w http.ResponseWriter) error
+}
+
+type PiiRuleList200JSONResponse ListPIIRules
+
+func (response PiiRuleList200JSONResponse) VisitPiiRuleListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PiiRuleList400JSONResponse APIErrors
+
+func (response PiiRuleList400JSONResponse) VisitPiiRuleListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PiiRuleList403JSONResponse APIErrors
+
+func (response PiiRuleList403JSONResponse) VisitPiiRuleListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PiiRuleCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *PiiRuleCreateJSONRequestBody
+}
+
+type PiiRuleCreateResponseObject interface {
+	VisitPiiRuleCreateResponse(w http.ResponseWriter) error
+}
+
+type PiiRuleCreate201JSONResponse PIIRule
+
+func (response PiiRuleCreate201JSONResponse) VisitPiiRuleCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PiiRuleCreate400JSONResponse APIErrors
+
+func (response PiiRuleCreate400JSONResponse) VisitPiiRuleCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PiiRuleCreate403JSONResponse APIErrors
+
+func (response PiiRuleCreate403JSONResponse) VisitPiiRuleCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTriggerListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type QueryTriggerListResponseObject interface {
+	VisitQueryTriggerListResponse(w http.ResponseWriter) error
+}
+
+type QueryTriggerList200JSONResponse ListQueryTriggers
+
+func (response QueryTriggerList200JSONResponse) VisitQueryTriggerListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTriggerList400JSONResponse APIErrors
+
+func (response QueryTriggerList400JSONResponse) VisitQueryTriggerListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTriggerList403JSONResponse APIErrors
+
+func (response QueryTriggerList403JSONResponse) VisitQueryTriggerListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTriggerCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *QueryTriggerCreateJSONRequestBody
+}
+
+type QueryTriggerCreateResponseObject interface {
+	VisitQueryTriggerCreateResponse(w http.ResponseWriter) error
+}
+
+type QueryTriggerCreate201JSONResponse QueryTrigger
+
+func (response QueryTriggerCreate201JSONResponse) VisitQueryTriggerCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTriggerCreate400JSONResponse APIErrors
+
+func (response QueryTriggerCreate400JSONResponse) VisitQueryTriggerCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTriggerCreate403JSONResponse APIErrors
+
+func (response QueryTriggerCreate403JSONResponse) VisitQueryTriggerCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecurringTaskListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type RecurringTaskListResponseObject interface {
+	VisitRecurringTaskListResponse(w http.ResponseWriter) error
+}
+
+type RecurringTaskList200JSONResponse ListRecurringTasks
+
+func (response RecurringTaskList200JSONResponse) VisitRecurringTaskListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecurringTaskList400JSONResponse APIErrors
+
+func (response RecurringTaskList400JSONResponse) VisitRecurringTaskListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecurringTaskList403JSONResponse APIErrors
+
+func (response RecurringTaskList403JSONResponse) VisitRecurringTaskListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecurringTaskCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *RecurringTaskCreateJSONRequestBody
+}
+
+type RecurringTaskCreateResponseObject interface {
+	VisitRecurringTaskCreateResponse(w http.ResponseWriter) error
+}
+
+type RecurringTaskCreate201JSONResponse RecurringTask
+
+func (response RecurringTaskCreate201JSONResponse) VisitRecurringTaskCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecurringTaskCreate400JSONResponse APIErrors
+
+func (response RecurringTaskCreate400JSONResponse) VisitRecurringTaskCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecurringTaskCreate403JSONResponse APIErrors
+
+func (response RecurringTaskCreate403JSONResponse) VisitRecurringTaskCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type SnsListResponseObject interface {
+	VisitSnsListResponse(w http.ResponseWriter) error
+}
+
+type SnsList200JSONResponse ListSNSIntegrations
+
+func (response SnsList200JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsList400JSONResponse APIErrors
+
+func (response SnsList400JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsList401JSONResponse APIErrors
+
+func (response SnsList401JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsList405JSONResponse APIErrors
+
+func (response SnsList405JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *SnsCreateJSONRequestBody
+}
+
+type SnsCreateResponseObject interface {
+	VisitSnsCreateResponse(w http.ResponseWriter) error
+}
+
+type SnsCreate201JSONResponse SNSIntegration
+
+func (response SnsCreate201JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsCreate400JSONResponse APIErrors
+
+func (response SnsCreate400JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsCreate401JSONResponse APIErrors
+
+func (response SnsCreate401JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsCreate405JSONResponse APIErrors
+
+func (response SnsCreate405JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListMetricsRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params StepRunListMetricsParams
+}
+
+type StepRunListMetricsResponseObject interface {
+	VisitStepRunListMetricsResponse(w http.ResponseWriter) error
+}
+
+type StepRunListMetrics200JSONResponse StepRunMetrics
+
+func (response StepRunListMetrics200JSONResponse) VisitStepRunListMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListMetrics400JSONResponse APIErrors
+
+func (response StepRunListMetrics400JSONResponse) VisitStepRunListMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListMetrics403JSONResponse APIErrors
+
+func (response StepRunListMetrics403JSONResponse) VisitStepRunListMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params StepRunListParams
+}
+
+type StepRunListResponseObject interface {
+	VisitStepRunListResponse(w http.ResponseWriter) error
+}
+
+type StepRunList200JSONResponse StepRunList
+
+func (response StepRunList200JSONResponse) VisitStepRunListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunList400JSONResponse APIErrors
+
+func (response StepRunList400JSONResponse) VisitStepRunListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunList403JSONResponse APIErrors
+
+func (response StepRunList403JSONResponse) VisitStepRunListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunGetRequestObject struct {
+	Tenant  openapi_types.UUID `json:"tenant"`
+	StepRun openapi_types.UUID `json:"step-run"`
+}
+
+type StepRunGetResponseObject interface {
+	VisitStepRunGetResponse(w http.ResponseWriter) error
+}
+
+type StepRunGet200JSONResponse StepRun
+
+func (response StepRunGet200JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunGet400JSONResponse APIErrors
+
+func (response StepRunGet400JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunGet403JSONResponse APIErrors
+
+func (response StepRunGet403JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunGet404JSONResponse APIErrors
+
+func (response StepRunGet404JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListAttemptsRequestObject struct {
+	Tenant  openapi_types.UUID `json:"tenant"`
+	StepRun openapi_types.UUID `json:"step-run"`
+}
+
+type StepRunListAttemptsResponseObject interface {
+	VisitStepRunListAttemptsResponse(w http.ResponseWriter) error
+}
+
+type StepRunListAttempts200JSONResponse StepRunAttemptList
+
+func (response StepRunListAttempts200JSONResponse) VisitStepRunListAttemptsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListAttempts400JSONResponse APIErrors
+
+func (response StepRunListAttempts400JSONResponse) VisitStepRunListAttemptsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListAttempts403JSONResponse APIErrors
+
+func (response StepRunListAttempts403JSONResponse) VisitStepRunListAttemptsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListAttempts404JSONResponse APIErrors
+
+func (response StepRunListAttempts404JSONResponse) VisitStepRunListAttemptsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListDiagnosticsRequestObject struct {
+	Tenant  openapi_types.UUID `json:"tenant"`
+	StepRun openapi_types.UUID `json:"step-run"`
+}
+
+type StepRunListDiagnosticsResponseObject interface {
+	VisitStepRunListDiagnosticsResponse(w http.ResponseWriter) error
+}
+
+type StepRunListDiagnostics200JSONResponse StepRunDiagnosticList
+
+func (response StepRunListDiagnostics200JSONResponse) VisitStepRunListDiagnosticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListDiagnostics400JSONResponse APIErrors
+
+func (response StepRunListDiagnostics400JSONResponse) VisitStepRunListDiagnosticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListDiagnostics403JSONResponse APIErrors
+
+func (response StepRunListDiagnostics403JSONResponse) VisitStepRunListDiagnosticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListDiagnostics404JSONResponse APIErrors
+
+func (response StepRunListDiagnostics404JSONResponse) VisitStepRunListDiagnosticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListStreamEventsRequestObject struct {
+	Tenant  openapi_types.UUID `json:"tenant"`
+	StepRun openapi_types.UUID `json:"step-run"`
+	Params  StepRunListStreamEventsParams
+}
+
+type StepRunListStreamEventsResponseObject interface {
+	VisitStepRunListStreamEventsResponse(w http.ResponseWriter) error
+}
+
+type StepRunListStreamEvents200JSONResponse StepRunStreamEventList
+
+func (response StepRunListStreamEvents200JSONResponse) VisitStepRunListStreamEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListStreamEvents400JSONResponse APIErrors
+
+func (response StepRunListStreamEvents400JSONResponse) VisitStepRunListStreamEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListStreamEvents403JSONResponse APIErrors
+
+func (response StepRunListStreamEvents403JSONResponse) VisitStepRunListStreamEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListStreamEvents404JSONResponse APIErrors
+
+func (response StepRunListStreamEvents404JSONResponse) VisitStepRunListStreamEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunUpdateRerunRequestObject struct {
+	Tenant  openapi_types.UUID `json:"tenant"`
+	StepRun openapi_types.UUID `json:"step-run"`
+	Body    *StepRunUpdateRerunJSONRequestBody
+}
+
+type StepRunUpdateRerunResponseObject interface {
+	VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error
+}
+
+type StepRunUpdateRerun200JSONResponse StepRun
+
+func (response StepRunUpdateRerun200JSONResponse) VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunUpdateRerun400JSONResponse APIErrors
+
+func (response StepRunUpdateRerun400JSONResponse) VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunUpdateRerun403JSONResponse APIErrors
+
+func (response StepRunUpdateRerun403JSONResponse) VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunGetSchemaRequestObject struct {
+	Tenant  openapi_types.UUID `json:"tenant"`
+	StepRun openapi_types.UUID `json:"step-run"`
+}
+
+type StepRunGetSchemaResponseObject interface {
+	VisitStepRunGetSchemaResponse(w http.ResponseWriter) error
+}
+
+type StepRunGetSchema200JSONResponse map[string]interface{}
+
+func (response StepRunGetSchema200JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunGetSchema400JSONResponse APIErrors
+
+func (response StepRunGetSchema400JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunGetSchema403JSONResponse APIErrors
+
+func (response StepRunGetSchema403JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunGetSchema404JSONResponse APIErrors
+
+func (response StepRunGetSchema404JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubjectDeletionCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *SubjectDeletionCreateJSONRequestBody
+}
+
+type SubjectDeletionCreateResponseObject interface {
+	VisitSubjectDeletionCreateResponse(w http.ResponseWriter) error
+}
+
+type SubjectDeletionCreate200JSONResponse SubjectDeletionReport
+
+func (response SubjectDeletionCreate200JSONResponse) VisitSubjectDeletionCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubjectDeletionCreate400JSONResponse APIErrors
+
+func (response SubjectDeletionCreate400JSONResponse) VisitSubjectDeletionCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubjectDeletionCreate403JSONResponse APIErrors
+
+func (response SubjectDeletionCreate403JSONResponse) VisitSubjectDeletionCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookDeliveryListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params WebhookDeliveryListParams
+}
+
+type WebhookDeliveryListResponseObject interface {
+	VisitWebhookDeliveryListResponse(w http.ResponseWriter) error
+}
+
+type WebhookDeliveryList200JSONResponse ListWebhookDeliveries
+
+func (response WebhookDeliveryList200JSONResponse) VisitWebhookDeliveryListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookDeliveryList400JSONResponse APIErrors
+
+func (response WebhookDeliveryList400JSONResponse) VisitWebhookDeliveryListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookDeliveryList403JSONResponse APIErrors
+
+func (response WebhookDeliveryList403JSONResponse) VisitWebhookDeliveryListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkerListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params WorkerListParams
+}
+
+type WorkerListResponseObject interface {
+	VisitWorkerListResponse(w http.ResponseWriter) error
+}
+
+type WorkerList200JSONResponse WorkerList
+
+func (response WorkerList200JSONResponse) VisitWorkerListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkerList400JSONResponse APIErrors
+
+func (response WorkerList400JSONResponse) VisitWorkerListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkerList403JSONResponse APIErrors
+
+func (response WorkerList403JSONResponse) VisitWorkerListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunBulkRetryRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *WorkflowRunBulkRetryJSONRequestBody
+}
+
+type WorkflowRunBulkRetryResponseObject interface {
+	VisitWorkflowRunBulkRetryResponse(w http.ResponseWriter) error
+}
+
+type WorkflowRunBulkRetry200JSONResponse WorkflowRunBulkRetry
+
+func (response WorkflowRunBulkRetry200JSONResponse) VisitWorkflowRunBulkRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunBulkRetry400JSONResponse APIErrors
+
+func (response WorkflowRunBulkRetry400JSONResponse) VisitWorkflowRunBulkRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunBulkRetry403JSONResponse APIErrors
+
+func (response WorkflowRunBulkRetry403JSONResponse) VisitWorkflowRunBulkRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetBulkRetryRequestObject struct {
+	Tenant    openapi_types.UUID `json:"tenant"`
+	BulkRetry openapi_types.UUID `json:"bulk-retry"`
+}
+
+type WorkflowRunGetBulkRetryResponseObject interface {
+	VisitWorkflowRunGetBulkRetryResponse(w http.ResponseWriter) error
+}
+
+type WorkflowRunGetBulkRetry200JSONResponse WorkflowRunBulkRetry
+
+func (response WorkflowRunGetBulkRetry200JSONResponse) VisitWorkflowRunGetBulkRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetBulkRetry400JSONResponse APIErrors
+
+func (response WorkflowRunGetBulkRetry400JSONResponse) VisitWorkflowRunGetBulkRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetBulkRetry403JSONResponse APIErrors
+
+func (response WorkflowRunGetBulkRetry403JSONResponse) VisitWorkflowRunGetBulkRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetBulkRetry404JSONResponse APIErrors
+
+func (response WorkflowRunGetBulkRetry404JSONResponse) VisitWorkflowRunGetBulkRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunImportRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *WorkflowRunImportJSONRequestBody
+}
+
+type WorkflowRunImportResponseObject interface {
+	VisitWorkflowRunImportResponse(w http.ResponseWriter) error
+}
+
+type WorkflowRunImport200JSONResponse WorkflowRun
+
+func (response WorkflowRunImport200JSONResponse) VisitWorkflowRunImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunImport400JSONResponse APIErrors
+
+func (response WorkflowRunImport400JSONResponse) VisitWorkflowRunImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunImport403JSONResponse APIErrors
+
+func (response WorkflowRunImport403JSONResponse) VisitWorkflowRunImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetRequestObject struct {
+	Tenant      openapi_types.UUID `json:"tenant"`
+	WorkflowRun openapi_types.UUID `json:"workflow-run"`
+	Params      WorkflowRunGetParams
+}
+
+type WorkflowRunGetResponseObject interface {
+	VisitWorkflowRunGetResponse(w http.ResponseWriter) error
+}
+
+type WorkflowRunGet200ResponseHeaders struct {
+	ETag string
+}
+
+type WorkflowRunGet200JSONResponse struct {
+	Body    WorkflowRun
+	Headers WorkflowRunGet200ResponseHeaders
+}
+
+func (response WorkflowRunGet200JSONResponse) VisitWorkflowRunGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type WorkflowRunGet304ResponseHeaders struct {
+	ETag string
+}
+
+type WorkflowRunGet304Response struct {
+	Headers WorkflowRunGet304ResponseHeaders
+}
+
+func (response WorkflowRunGet304Response) VisitWorkflowRunGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type WorkflowRunGet400JSONResponse APIErrors
+
+func (response WorkflowRunGet400JSONResponse) VisitWorkflowRunGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGet403JSONResponse APIErrors
+
+func (response WorkflowRunGet403JSONResponse) VisitWorkflowRunGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetAttestationRequestObject struct {
+	Tenant      openapi_types.UUID `json:"tenant"`
+	WorkflowRun openapi_types.UUID `json:"workflow-run"`
+}
+
+type WorkflowRunGetAttestationResponseObject interface {
+	VisitWorkflowRunGetAttestationResponse(w http.ResponseWriter) error
+}
+
+type WorkflowRunGetAttestation200JSONResponse WorkflowRunAttestation
+
+func (response WorkflowRunGetAttestation200JSONResponse) VisitWorkflowRunGetAttestationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetAttestation400JSONResponse APIErrors
+
+func (response WorkflowRunGetAttestation400JSONResponse) VisitWorkflowRunGetAttestationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetAttestation403JSONResponse APIErrors
+
+func (response WorkflowRunGetAttestation403JSONResponse) VisitWorkflowRunGetAttestationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetBundleRequestObject struct {
+	Tenant      openapi_types.UUID `json:"tenant"`
+	WorkflowRun openapi_types.UUID `json:"workflow-run"`
+}
+
+type WorkflowRunGetBundleResponseObject interface {
+	VisitWorkflowRunGetBundleResponse(w http.ResponseWriter) error
+}
+
+type WorkflowRunGetBundle200JSONResponse WorkflowRunBundle
+
+func (response WorkflowRunGetBundle200JSONResponse) VisitWorkflowRunGetBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetBundle400JSONResponse APIErrors
+
+func (response WorkflowRunGetBundle400JSONResponse) VisitWorkflowRunGetBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetBundle403JSONResponse APIErrors
+
+func (response WorkflowRunGetBundle403JSONResponse) VisitWorkflowRunGetBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetDagRequestObject struct {
+	Tenant      openapi_types.UUID `json:"tenant"`
+	WorkflowRun openapi_types.UUID `json:"workflow-run"`
+}
+
+type WorkflowRunGetDagResponseObject interface {
+	VisitWorkflowRunGetDagResponse(w http.ResponseWriter) error
+}
+
+type WorkflowRunGetDag200JSONResponse WorkflowRunDag
+
+func (response WorkflowRunGetDag200JSONResponse) VisitWorkflowRunGetDagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetDag400JSONResponse APIErrors
+
+func (response WorkflowRunGetDag400JSONResponse) VisitWorkflowRunGetDagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetDag403JSONResponse APIErrors
+
+func (response WorkflowRunGetDag403JSONResponse) VisitWorkflowRunGetDagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetGroupKeyRunRequestObject struct {
+	Tenant      openapi_types.UUID `json:"tenant"`
+	WorkflowRun openapi_types.UUID `json:"workflow-run"`
+}
+
+type WorkflowRunGetGroupKeyRunResponseObject interface {
+	VisitWorkflowRunGetGroupKeyRunResponse(w http.ResponseWriter) error
+}
+
+type WorkflowRunGetGroupKeyRun200JSONResponse GetGroupKeyRun
+
+func (response WorkflowRunGetGroupKeyRun200JSONResponse) VisitWorkflowRunGetGroupKeyRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetGroupKeyRun400JSONResponse APIErrors
+
+func (response WorkflowRunGetGroupKeyRun400JSONResponse) VisitWorkflowRunGetGroupKeyRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetGroupKeyRun403JSONResponse APIErrors
+
+func (response WorkflowRunGetGroupKeyRun403JSONResponse) VisitWorkflowRunGetGroupKeyRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetGroupKeyRun404JSONResponse APIErrors
+
+func (response WorkflowRunGetGroupKeyRun404JSONResponse) VisitWorkflowRunGetGroupKeyRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunListPullRequestsRequestObject struct {
+	Tenant      openapi_types.UUID `json:"tenant"`
+	WorkflowRun openapi_types.UUID `json:"workflow-run"`
+	Params      WorkflowRunListPullRequestsParams
+}
+
+type WorkflowRunListPullRequestsResponseObject interface {
+	VisitWorkflowRunListPullRequestsResponse(w http.ResponseWriter) error
+}
+
+type WorkflowRunListPullRequests200JSONResponse ListPullRequestsResponse
+
+func (response WorkflowRunListPullRequests200JSONResponse) VisitWorkflowRunListPullRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunListPullRequests400JSONResponse APIErrors
+
+func (response WorkflowRunListPullRequests400JSONResponse) VisitWorkflowRunListPullRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunListPullRequests403JSONResponse APIErrors
+
+func (response WorkflowRunListPullRequests403JSONResponse) VisitWorkflowRunListPullRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunCreateReplayRequestObject struct {
+	Tenant      openapi_types.UUID `json:"tenant"`
+	WorkflowRun openapi_types.UUID `json:"workflow-run"`
+	Body        *WorkflowRunCreateReplayJSONRequestBody
+}
+
+type WorkflowRunCreateReplayResponseObject interface {
+	VisitWorkflowRunCreateReplayResponse(w http.ResponseWriter) error
+}
+
+type WorkflowRunCreateReplay200JSONResponse WorkflowRun
+
+func (response WorkflowRunCreateReplay200JSONResponse) VisitWorkflowRunCreateReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunCreateReplay400JSONResponse APIErrors
+
+func (response WorkflowRunCreateReplay400JSONResponse) VisitWorkflowRunCreateReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunCreateReplay403JSONResponse APIErrors
+
+func (response WorkflowRunCreateReplay403JSONResponse) VisitWorkflowRunCreateReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunCreateReplay404JSONResponse APIErrors
+
+func (response WorkflowRunCreateReplay404JSONResponse) VisitWorkflowRunCreateReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetRootCauseRequestObject struct {
+	Tenant      openapi_types.UUID `json:"tenant"`
+	WorkflowRun openapi_types.UUID `json:"workflow-run"`
+}
+
+type WorkflowRunGetRootCauseResponseObject interface {
+	VisitWorkflowRunGetRootCauseResponse(w http.ResponseWriter) error
+}
+
+type WorkflowRunGetRootCause200JSONResponse WorkflowRunRootCause
+
+func (response WorkflowRunGetRootCause200JSONResponse) VisitWorkflowRunGetRootCauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetRootCause400JSONResponse APIErrors
+
+func (response WorkflowRunGetRootCause400JSONResponse) VisitWorkflowRunGetRootCauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetRootCause403JSONResponse APIErrors
+
+func (response WorkflowRunGetRootCause403JSONResponse) VisitWorkflowRunGetRootCauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetRootCause404JSONResponse APIErrors
+
+func (response WorkflowRunGetRootCause404JSONResponse) VisitWorkflowRunGetRootCauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params WorkflowListParams
+}
+
+type WorkflowListResponseObject interface {
+	VisitWorkflowListResponse(w http.ResponseWriter) error
+}
+
+type WorkflowList200JSONResponse WorkflowList
+
+func (response WorkflowList200JSONResponse) VisitWorkflowListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowList400JSONResponse APIErrors
+
+func (response WorkflowList400JSONResponse) VisitWorkflowListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowList403JSONResponse APIErrors
+
+func (response WorkflowList403JSONResponse) VisitWorkflowListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowPutRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *WorkflowPutJSONRequestBody
+}
+
+type WorkflowPutResponseObject interface {
+	VisitWorkflowPutResponse(w http.ResponseWriter) error
+}
+
+type WorkflowPut200JSONResponse WorkflowVersion
+
+func (response WorkflowPut200JSONResponse) VisitWorkflowPutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowPut400JSONResponse APIErrors
+
+func (response WorkflowPut400JSONResponse) VisitWorkflowPutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowPut403JSONResponse APIErrors
+
+func (response WorkflowPut403JSONResponse) VisitWorkflowPutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params WorkflowRunListParams
+}
+
+type WorkflowRunListResponseObject interface {
+	VisitWorkflowRunListResponse(w http.ResponseWriter) error
+}
+
+type WorkflowRunList200JSONResponse WorkflowRunList
+
+func (response WorkflowRunList200JSONResponse) VisitWorkflowRunListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunList400JSONResponse APIErrors
+
+func (response WorkflowRunList400JSONResponse) VisitWorkflowRunListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunList403JSONResponse APIErrors
+
+func (response WorkflowRunList403JSONResponse) VisitWorkflowRunListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunExportRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params WorkflowRunExportParams
+}
+
+type WorkflowRunExportResponseObject interface {
+	VisitWorkflowRunExportResponse(w http.ResponseWriter) error
+}
+
+type WorkflowRunExport200ApplicationxNdjsonResponse struct {
+	Body io.Reader
+
+	ContentLength int64
+}
+
+func (response WorkflowRunExport200ApplicationxNdjsonResponse) VisitWorkflowRunExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type WorkflowRunExport200TextcsvResponse struct {
+	Body io.Reader
+
+	ContentLength int64
+}
+
+func (response WorkflowRunExport200TextcsvResponse) VisitWorkflowRunExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type WorkflowRunExport400JSONResponse APIErrors
+
+func (response WorkflowRunExport400JSONResponse) VisitWorkflowRunExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunExport403JSONResponse APIErrors
+
+func (response WorkflowRunExport403JSONResponse) VisitWorkflowRunExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetMetricsRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params WorkflowRunGetMetricsParams
+}
+
+type WorkflowRunGetMetricsResponseObject interface {
+	VisitWorkflowRunGetMetricsResponse(w http.ResponseWriter) error
+}
+
+type WorkflowRunGetMetrics200JSONResponse WorkflowRunMetrics
+
+func (response WorkflowRunGetMetrics200JSONResponse) VisitWorkflowRunGetMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetMetrics400JSONResponse APIErrors
+
+func (response WorkflowRunGetMetrics400JSONResponse) VisitWorkflowRunGetMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunGetMetrics403JSONResponse APIErrors
+
+func (response WorkflowRunGetMetrics403JSONResponse) VisitWorkflowRunGetMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunListScheduledRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params WorkflowRunListScheduledParams
+}
+
+type WorkflowRunListScheduledResponseObject interface {
+	VisitWorkflowRunListScheduledResponse(w http.ResponseWriter) error
+}
+
+type WorkflowRunListScheduled200JSONResponse ScheduledWorkflowRunList
+
+func (response WorkflowRunListScheduled200JSONResponse) VisitWorkflowRunListScheduledResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunListScheduled400JSONResponse APIErrors
+
+func (response WorkflowRunListScheduled400JSONResponse) VisitWorkflowRunListScheduledResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunListScheduled403JSONResponse APIErrors
+
+func (response WorkflowRunListScheduled403JSONResponse) VisitWorkflowRunListScheduledResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TriggerLinkDeleteRequestObject struct {
+	TriggerLink openapi_types.UUID `json:"trigger-link"`
+}
+
+type TriggerLinkDeleteResponseObject interface {
+	VisitTriggerLinkDeleteResponse(w http.ResponseWriter) error
+}
+
+type TriggerLinkDelete204Response struct {
+}
+
+func (response TriggerLinkDelete204Response) VisitTriggerLinkDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type TriggerLinkDelete400JSONResponse APIErrors
+
+func (response TriggerLinkDelete400JSONResponse) VisitTriggerLinkDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TriggerLinkDelete403JSONResponse APIErrors
+
+func (response TriggerLinkDelete403JSONResponse) VisitTriggerLinkDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TriggerLinkRunRequestObject struct {
+	TriggerLink openapi_types.UUID `json:"trigger-link"`
+	Params      TriggerLinkRunParams
+}
+
+type TriggerLinkRunResponseObject interface {
+	VisitTriggerLinkRunResponse(w http.ResponseWriter) error
+}
+
+type TriggerLinkRun200JSONResponse TriggerLinkRunResult
+
+func (response TriggerLinkRun200JSONResponse) VisitTriggerLinkRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TriggerLinkRun400JSONResponse APIErrors
+
+func (response TriggerLinkRun400JSONResponse) VisitTriggerLinkRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TriggerLinkRun403JSONResponse APIErrors
+
+func (response TriggerLinkRun403JSONResponse) VisitTriggerLinkRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TriggerLinkRun429JSONResponse APIErrors
+
+func (response TriggerLinkRun429JSONResponse) VisitTriggerLinkRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserGetCurrentRequestObject struct {
+}
+
+type UserGetCurrentResponseObject interface {
+	VisitUserGetCurrentResponse(w http.ResponseWriter) error
+}
+
+type UserGetCurrent200JSONResponse User
+
+func (response UserGetCurrent200JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserGetCurrent400JSONResponse APIErrors
+
+func (response UserGetCurrent400JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserGetCurrent401JSONResponse APIErrors
+
+func (response UserGetCurrent401JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserGetCurrent405JSONResponse APIErrors
+
+func (response UserGetCurrent405JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateGithubOauthCallbackRequestObject struct {
+}
+
+type UserUpdateGithubOauthCallbackResponseObject interface {
+	VisitUserUpdateGithubOauthCallbackResponse(w http.ResponseWriter) error
+}
+
+type UserUpdateGithubOauthCallback302ResponseHeaders struct {
+	Location string
+}
+
+type UserUpdateGithubOauthCallback302Response struct {
+	Headers UserUpdateGithubOauthCallback302ResponseHeaders
+}
+
+func (response UserUpdateGithubOauthCallback302Response) VisitUserUpdateGithubOauthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type UserUpdateGithubOauthStartRequestObject struct {
+}
+
+type UserUpdateGithubOauthStartResponseObject interface {
+	VisitUserUpdateGithubOauthStartResponse(w http.ResponseWriter) error
+}
+
+type UserUpdateGithubOauthStart302ResponseHeaders struct {
+	Location string
+}
+
+type UserUpdateGithubOauthStart302Response struct {
+	Headers UserUpdateGithubOauthStart302ResponseHeaders
+}
+
+func (response UserUpdateGithubOauthStart302Response) VisitUserUpdateGithubOauthStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type UserUpdateGoogleOauthCallbackRequestObject struct {
+}
+
+type UserUpdateGoogleOauthCallbackResponseObject interface {
+	VisitUserUpdateGoogleOauthCallbackResponse(w http.ResponseWriter) error
+}
+
+type UserUpdateGoogleOauthCallback302ResponseHeaders struct {
+	Location string
+}
+
+type UserUpdateGoogleOauthCallback302Response struct {
+	Headers UserUpdateGoogleOauthCallback302ResponseHeaders
+}
+
+func (response UserUpdateGoogleOauthCallback302Response) VisitUserUpdateGoogleOauthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type UserUpdateGoogleOauthStartRequestObject struct {
+}
+
+type UserUpdateGoogleOauthStartResponseObject interface {
+	VisitUserUpdateGoogleOauthStartResponse(w http.ResponseWriter) error
+}
+
+type UserUpdateGoogleOauthStart302ResponseHeaders struct {
+	Location string
+}
+
+type UserUpdateGoogleOauthStart302Response struct {
+	Headers UserUpdateGoogleOauthStart302ResponseHeaders
+}
+
+func (response UserUpdateGoogleOauthStart302Response) VisitUserUpdateGoogleOauthStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type UserListTenantInvitesRequestObject struct {
+}
+
+type UserListTenantInvitesResponseObject interface {
+	VisitUserListTenantInvitesResponse(w http.ResponseWriter) error
+}
+
+type UserListTenantInvites200JSONResponse TenantInviteList
+
+func (response UserListTenantInvites200JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserListTenantInvites400JSONResponse APIErrors
+
+func (response UserListTenantInvites400JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserListTenantInvites403JSONResponse APIErrors
+
+func (response UserListTenantInvites403JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteAcceptRequestObject struct {
+	Body *TenantInviteAcceptJSONRequestBody
+}
+
+type TenantInviteAcceptResponseObject interface {
+	VisitTenantInviteAcceptResponse(w http.ResponseWriter) error
+}
+
+type TenantInviteAccept200Response struct {
+}
+
+func (response TenantInviteAccept200Response) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type TenantInviteAccept400JSONResponse APIErrors
+
+func (response TenantInviteAccept400JSONResponse) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteAccept403JSONResponse APIError
+
+func (response TenantInviteAccept403JSONResponse) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteRejectRequestObject struct {
+	Body *TenantInviteRejectJSONRequestBody
+}
+
+type TenantInviteRejectResponseObject interface {
+	VisitTenantInviteRejectResponse(w http.ResponseWriter) error
+}
+
+type TenantInviteReject200Response struct {
+}
+
+func (response TenantInviteReject200Response) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type TenantInviteReject400JSONResponse APIErrors
+
+func (response TenantInviteReject400JSONResponse) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteReject403JSONResponse APIError
+
+func (response TenantInviteReject403JSONResponse) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLoginRequestObject struct {
+	Body *UserUpdateLoginJSONRequestBody
+}
+
+type UserUpdateLoginResponseObject interface {
+	VisitUserUpdateLoginResponse(w http.ResponseWriter) error
+}
+
+type UserUpdateLogin200JSONResponse User
+
+func (response UserUpdateLogin200JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLogin400JSONResponse APIErrors
+
+func (response UserUpdateLogin400JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLogin401JSONResponse APIErrors
+
+func (response UserUpdateLogin401JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLogin405JSONResponse APIErrors
+
+func (response UserUpdateLogin405JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLogoutRequestObject struct {
+}
+
+type UserUpdateLogoutResponseObject interface {
+	VisitUserUpdateLogoutResponse(w http.ResponseWriter) error
+}
+
+type UserUpdateLogout200JSONResponse User
+
+func (response UserUpdateLogout200JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLogout400JSONResponse APIErrors
+
+func (response UserUpdateLogout400JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLogout401JSONResponse APIErrors
+
+func (response UserUpdateLogout401JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLogout405JSONResponse APIErrors
+
+func (response UserUpdateLogout405JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMembershipsListRequestObject struct {
+}
+
+type TenantMembershipsListResponseObject interface {
+	VisitTenantMembershipsListResponse(w http.ResponseWriter) error
+}
+
+type TenantMembershipsList200JSONResponse UserTenantMembershipsList
+
+func (response TenantMembershipsList200JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMembershipsList400JSONResponse APIErrors
+
+func (response TenantMembershipsList400JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMembershipsList403JSONResponse APIErrors
+
+func (response TenantMembershipsList403JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserCreateRequestObject struct {
+	Body *UserCreateJSONRequestBody
+}
+
+type UserCreateResponseObject interface {
+	VisitUserCreateResponse(w http.ResponseWriter) error
+}
+
+type UserCreate200JSONResponse User
+
+func (response UserCreate200JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserCreate400JSONResponse APIErrors
+
+func (response UserCreate400JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserCreate401JSONResponse APIErrors
+
+func (response UserCreate401JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserCreate405JSONResponse APIErrors
+
+func (response UserCreate405JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkerGetRequestObject struct {
+	Worker openapi_types.UUID `json:"worker"`
+}
+
+type WorkerGetResponseObject interface {
+	VisitWorkerGetResponse(w http.ResponseWriter) error
+}
+
+type WorkerGet200JSONResponse Worker
+
+func (response WorkerGet200JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkerGet400JSONResponse APIErrors
+
+func (response WorkerGet400JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkerGet403JSONResponse APIErrors
+
+func (response WorkerGet403JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowDeleteRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+}
+
+type WorkflowDeleteResponseObject interface {
+	VisitWorkflowDeleteResponse(w http.ResponseWriter) error
+}
+
+type WorkflowDelete204Response struct {
+}
+
+func (response WorkflowDelete204Response) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type WorkflowDelete400JSONResponse APIErrors
+
+func (response WorkflowDelete400JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowDelete403JSONResponse APIErrors
+
+func (response WorkflowDelete403JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowDelete404JSONResponse APIErrors
+
+func (response WorkflowDelete404JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowGetRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Params   WorkflowGetParams
+}
+
+type WorkflowGetResponseObject interface {
+	VisitWorkflowGetResponse(w http.ResponseWriter) error
+}
+
+type WorkflowGet200ResponseHeaders struct {
+	ETag string
+}
+
+type WorkflowGet200JSONResponse struct {
+	Body    Workflow
+	Headers WorkflowGet200ResponseHeaders
+}
+
+func (response WorkflowGet200JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type WorkflowGet304ResponseHeaders struct {
+	ETag string
+}
+
+type WorkflowGet304Response struct {
+	Headers WorkflowGet304ResponseHeaders
+}
+
+func (response WorkflowGet304Response) VisitWorkflowGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type WorkflowGet400JSONResponse APIErrors
+
+func (response WorkflowGet400JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowGet403JSONResponse APIErrors
+
+func (response WorkflowGet403JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowGetConcurrencyQueueDepthRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+}
+
+type WorkflowGetConcurrencyQueueDepthResponseObject interface {
+	VisitWorkflowGetConcurrencyQueueDepthResponse(w http.ResponseWriter) error
+}
+
+type WorkflowGetConcurrencyQueueDepth200JSONResponse WorkflowConcurrencyQueueDepth
+
+func (response WorkflowGetConcurrencyQueueDepth200JSONResponse) VisitWorkflowGetConcurrencyQueueDepthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowGetConcurrencyQueueDepth400JSONResponse APIErrors
+
+func (response WorkflowGetConcurrencyQueueDepth400JSONResponse) VisitWorkflowGetConcurrencyQueueDepthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowGetConcurrencyQueueDepth403JSONResponse APIErrors
+
+func (response WorkflowGetConcurrencyQueueDepth403JSONResponse) VisitWorkflowGetConcurrencyQueueDepthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowCreateConcurrencySimulationRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Body     *WorkflowCreateConcurrencySimulationJSONRequestBody
+}
+
+type WorkflowCreateConcurrencySimulationResponseObject interface {
+	VisitWorkflowCreateConcurrencySimulationResponse(w http.ResponseWriter) error
+}
+
+type WorkflowCreateConcurrencySimulation200JSONResponse ConcurrencySimulation
+
+func (response WorkflowCreateConcurrencySimulation200JSONResponse) VisitWorkflowCreateConcurrencySimulationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowCreateConcurrencySimulation400JSONResponse APIErrors
+
+func (response WorkflowCreateConcurrencySimulation400JSONResponse) VisitWorkflowCreateConcurrencySimulationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowCreateConcurrencySimulation403JSONResponse APIErrors
+
+func (response WorkflowCreateConcurrencySimulation403JSONResponse) VisitWorkflowCreateConcurrencySimulationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowCreateConcurrencySimulation404JSONResponse APIErrors
+
+func (response WorkflowCreateConcurrencySimulation404JSONResponse) VisitWorkflowCreateConcurrencySimulationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowUpdateDiagnosticsRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Body     *WorkflowUpdateDiagnosticsJSONRequestBody
+}
+
+type WorkflowUpdateDiagnosticsResponseObject interface {
+	VisitWorkflowUpdateDiagnosticsResponse(w http.ResponseWriter) error
+}
+
+type WorkflowUpdateDiagnostics200JSONResponse Workflow
+
+func (response WorkflowUpdateDiagnostics200JSONResponse) VisitWorkflowUpdateDiagnosticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowUpdateDiagnostics400JSONResponse APIErrors
+
+func (response WorkflowUpdateDiagnostics400JSONResponse) VisitWorkflowUpdateDiagnosticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowUpdateDiagnostics403JSONResponse APIErrors
+
+func (response WorkflowUpdateDiagnostics403JSONResponse) VisitWorkflowUpdateDiagnosticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowUpdateDiagnostics404JSONResponse APIErrors
+
+func (response WorkflowUpdateDiagnostics404JSONResponse) VisitWorkflowUpdateDiagnosticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowUpdateLinkGithubRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Body     *WorkflowUpdateLinkGithubJSONRequestBody
+}
+
+type WorkflowUpdateLinkGithubResponseObject interface {
+	VisitWorkflowUpdateLinkGithubResponse(w http.ResponseWriter) error
+}
+
+type WorkflowUpdateLinkGithub200JSONResponse Workflow
+
+func (response WorkflowUpdateLinkGithub200JSONResponse) VisitWorkflowUpdateLinkGithubResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowUpdateLinkGithub400JSONResponse APIErrors
+
+func (response WorkflowUpdateLinkGithub400JSONResponse) VisitWorkflowUpdateLinkGithubResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowUpdateLinkGithub403JSONResponse APIErrors
+
+func (response WorkflowUpdateLinkGithub403JSONResponse) VisitWorkflowUpdateLinkGithubResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowUpdateLinkGithub404JSONResponse APIErrors
+
+func (response WorkflowUpdateLinkGithub404JSONResponse) VisitWorkflowUpdateLinkGithubResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MaintenanceWindowListRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
 }
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+type MaintenanceWindowListResponseObject interface {
+	VisitMaintenanceWindowListResponse(w http.ResponseWriter) error
+}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
+type MaintenanceWindowList200JSONResponse ListMaintenanceWindows
 
-	router.GET(baseURL+"/api/live", wrapper.LivenessGet)
-	router.GET(baseURL+"/api/ready", wrapper.ReadinessGet)
-	router.POST(baseURL+"/api/v1/api-tokens/:api-token", wrapper.ApiTokenUpdateRevoke)
-	router.GET(baseURL+"/api/v1/events/:event/data", wrapper.EventDataGet)
-	router.GET(baseURL+"/api/v1/github-app/installations", wrapper.GithubAppListInstallations)
-	router.GET(baseURL+"/api/v1/github-app/installations/:gh-installation/repos", wrapper.GithubAppListRepos)
-	router.GET(baseURL+"/api/v1/github-app/installations/:gh-installation/repos/:gh-repo-owner/:gh-repo-name/branches", wrapper.GithubAppListBranches)
-	router.POST(baseURL+"/api/v1/github/webhook", wrapper.GithubUpdateGlobalWebhook)
-	router.POST(baseURL+"/api/v1/github/webhook/:webhook", wrapper.GithubUpdateTenantWebhook)
-	router.GET(baseURL+"/api/v1/meta", wrapper.MetadataGet)
-	router.GET(baseURL+"/api/v1/meta/integrations", wrapper.MetadataListIntegrations)
-	router.DELETE(baseURL+"/api/v1/sns/:sns", wrapper.SnsDelete)
-	router.POST(baseURL+"/api/v1/sns/:tenant/:event", wrapper.SnsUpdate)
-	router.POST(baseURL+"/api/v1/step-runs/:step-run/create-pr", wrapper.StepRunUpdateCreatePr)
-	router.GET(baseURL+"/api/v1/step-runs/:step-run/diff", wrapper.StepRunGetDiff)
-	router.GET(baseURL+"/api/v1/step-runs/:step-run/logs", wrapper.LogLineList)
-	router.POST(baseURL+"/api/v1/tenants", wrapper.TenantCreate)
-	router.GET(baseURL+"/api/v1/tenants/:tenant/api-tokens", wrapper.ApiTokenList)
-	router.POST(baseURL+"/api/v1/tenants/:tenant/api-tokens", wrapper.ApiTokenCreate)
-	router.GET(baseURL+"/api/v1/tenants/:tenant/events", wrapper.EventList)
-	router.GET(baseURL+"/api/v1/tenants/:tenant/events/keys", wrapper.EventKeyList)
-	router.POST(baseURL+"/api/v1/tenants/:tenant/events/replay", wrapper.EventUpdateReplay)
-	router.GET(baseURL+"/api/v1/tenants/:tenant/invites", wrapper.TenantInviteList)
-	router.POST(baseURL+"/api/v1/tenants/:tenant/invites", wrapper.TenantInviteCreate)
-	router.DELETE(baseURL+"/api/v1/tenants/:tenant/invites/:tenant-invite", wrapper.TenantInviteDelete)
-	router.PATCH(baseURL+"/api/v1/tenants/:tenant/invites/:tenant-invite", wrapper.TenantInviteUpdate)
-	router.GET(baseURL+"/api/v1/tenants/:tenant/members", wrapper.TenantMemberList)
-	router.GET(baseURL+"/api/v1/tenants/:tenant/sns", wrapper.SnsList)
-	router.POST(baseURL+"/api/v1/tenants/:tenant/sns", wrapper.SnsCreate)
-	router.GET(baseURL+"/api/v1/tenants/:tenant/step-runs/:step-run", wrapper.StepRunGet)
-	router.POST(baseURL+"/api/v1/tenants/:tenant/step-runs/:step-run/rerun", wrapper.StepRunUpdateRerun)
-	router.GET(baseURL+"/api/v1/tenants/:tenant/step-runs/:step-run/schema", wrapper.StepRunGetSchema)
-	router.GET(baseURL+"/api/v1/tenants/:tenant/worker", wrapper.WorkerList)
-	router.GET(baseURL+"/api/v1/tenants/:tenant/workflow-runs/:workflow-run", wrapper.WorkflowRunGet)
-	router.GET(baseURL+"/api/v1/tenants/:tenant/workflow-runs/:workflow-run/prs", wrapper.WorkflowRunListPullRequests)
-	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows", wrapper.WorkflowList)
-	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/runs", wrapper.WorkflowRunList)
-	router.GET(baseURL+"/api/v1/users/current", wrapper.UserGetCurrent)
-	router.GET(baseURL+"/api/v1/users/github/callback", wrapper.UserUpdateGithubOauthCallback)
-	router.GET(baseURL+"/api/v1/users/github/start", wrapper.UserUpdateGithubOauthStart)
-	router.GET(baseURL+"/api/v1/users/google/callback", wrapper.UserUpdateGoogleOauthCallback)
-	router.GET(baseURL+"/api/v1/users/google/start", wrapper.UserUpdateGoogleOauthStart)
-	router.GET(baseURL+"/api/v1/users/invites", wrapper.UserListTenantInvites)
-	router.POST(baseURL+"/api/v1/users/invites/accept", wrapper.TenantInviteAccept)
-	router.POST(baseURL+"/api/v1/users/invites/reject", wrapper.TenantInviteReject)
-	router.POST(baseURL+"/api/v1/users/login", wrapper.UserUpdateLogin)
-	router.POST(baseURL+"/api/v1/users/logout", wrapper.UserUpdateLogout)
-	router.GET(baseURL+"/api/v1/users/memberships", wrapper.TenantMembershipsList)
-	router.POST(baseURL+"/api/v1/users/register", wrapper.UserCreate)
-	router.GET(baseURL+"/api/v1/workers/:worker", wrapper.WorkerGet)
-	router.DELETE(baseURL+"/api/v1/workflows/:workflow", wrapper.WorkflowDelete)
-	router.GET(baseURL+"/api/v1/workflows/:workflow", wrapper.WorkflowGet)
-	router.POST(baseURL+"/api/v1/workflows/:workflow/link-github", wrapper.WorkflowUpdateLinkGithub)
-	router.POST(baseURL+"/api/v1/workflows/:workflow/trigger", wrapper.WorkflowRunCreate)
-	router.GET(baseURL+"/api/v1/workflows/:workflow/versions", wrapper.WorkflowVersionGet)
-	router.GET(baseURL+"/api/v1/workflows/:workflow/versions/definition", wrapper.WorkflowVersionGetDefinition)
+func (response MaintenanceWindowList200JSONResponse) VisitMaintenanceWindowListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
+	return json.NewEncoder(w).Encode(response)
 }
 
-type LivenessGetRequestObject struct {
+type MaintenanceWindowList400JSONResponse APIErrors
+
+func (response MaintenanceWindowList400JSONResponse) VisitMaintenanceWindowListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type LivenessGetResponseObject interface {
-	VisitLivenessGetResponse(w http.ResponseWriter) error
+type MaintenanceWindowList403JSONResponse APIErrors
+
+func (response MaintenanceWindowList403JSONResponse) VisitMaintenanceWindowListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type LivenessGet200Response struct {
+type MaintenanceWindowCreateRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Body     *MaintenanceWindowCreateJSONRequestBody
 }
 
-func (response LivenessGet200Response) VisitLivenessGetResponse(w http.ResponseWriter) error {
+type MaintenanceWindowCreateResponseObject interface {
+	VisitMaintenanceWindowCreateResponse(w http.ResponseWriter) error
+}
+
+type MaintenanceWindowCreate201JSONResponse MaintenanceWindow
+
+func (response MaintenanceWindowCreate201JSONResponse) VisitMaintenanceWindowCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MaintenanceWindowCreate400JSONResponse APIErrors
+
+func (response MaintenanceWindowCreate400JSONResponse) VisitMaintenanceWindowCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MaintenanceWindowCreate403JSONResponse APIErrors
+
+func (response MaintenanceWindowCreate403JSONResponse) VisitMaintenanceWindowCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowDeletePauseRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+}
+
+type WorkflowDeletePauseResponseObject interface {
+	VisitWorkflowDeletePauseResponse(w http.ResponseWriter) error
+}
+
+type WorkflowDeletePause200JSONResponse Workflow
+
+func (response WorkflowDeletePause200JSONResponse) VisitWorkflowDeletePauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type LivenessGet500Response struct {
+type WorkflowDeletePause400JSONResponse APIErrors
+
+func (response WorkflowDeletePause400JSONResponse) VisitWorkflowDeletePauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response LivenessGet500Response) VisitLivenessGetResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
+type WorkflowDeletePause403JSONResponse APIErrors
+
+func (response WorkflowDeletePause403JSONResponse) VisitWorkflowDeletePauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadinessGetRequestObject struct {
+type WorkflowDeletePause404JSONResponse APIErrors
+
+func (response WorkflowDeletePause404JSONResponse) VisitWorkflowDeletePauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadinessGetResponseObject interface {
-	VisitReadinessGetResponse(w http.ResponseWriter) error
+type WorkflowUpdatePauseRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Body     *WorkflowUpdatePauseJSONRequestBody
 }
 
-type ReadinessGet200Response struct {
+type WorkflowUpdatePauseResponseObject interface {
+	VisitWorkflowUpdatePauseResponse(w http.ResponseWriter) error
 }
 
-func (response ReadinessGet200Response) VisitReadinessGetResponse(w http.ResponseWriter) error {
+type WorkflowUpdatePause200JSONResponse Workflow
+
+func (response WorkflowUpdatePause200JSONResponse) VisitWorkflowUpdatePauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadinessGet500Response struct {
+type WorkflowUpdatePause400JSONResponse APIErrors
+
+func (response WorkflowUpdatePause400JSONResponse) VisitWorkflowUpdatePauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response ReadinessGet500Response) VisitReadinessGetResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
+type WorkflowUpdatePause403JSONResponse APIErrors
+
+func (response WorkflowUpdatePause403JSONResponse) VisitWorkflowUpdatePauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenUpdateRevokeRequestObject struct {
-	ApiToken openapi_types.UUID `json:"api-token"`
+type WorkflowUpdatePause404JSONResponse APIErrors
+
+func (response WorkflowUpdatePause404JSONResponse) VisitWorkflowUpdatePauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenUpdateRevokeResponseObject interface {
-	VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error
+type WorkflowDeleteRolloutRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
 }
 
-type ApiTokenUpdateRevoke204Response struct {
+type WorkflowDeleteRolloutResponseObject interface {
+	VisitWorkflowDeleteRolloutResponse(w http.ResponseWriter) error
 }
 
-func (response ApiTokenUpdateRevoke204Response) VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error {
+type WorkflowDeleteRollout204Response struct {
+}
+
+func (response WorkflowDeleteRollout204Response) VisitWorkflowDeleteRolloutResponse(w http.ResponseWriter) error {
 	w.WriteHeader(204)
 	return nil
 }
 
-type ApiTokenUpdateRevoke400JSONResponse APIErrors
+type WorkflowDeleteRollout400JSONResponse APIErrors
 
-func (response ApiTokenUpdateRevoke400JSONResponse) VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error {
+func (response WorkflowDeleteRollout400JSONResponse) VisitWorkflowDeleteRolloutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenUpdateRevoke403JSONResponse APIErrors
+type WorkflowDeleteRollout403JSONResponse APIErrors
+
+func (response WorkflowDeleteRollout403JSONResponse) VisitWorkflowDeleteRolloutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowDeleteRollout404JSONResponse APIErrors
+
+func (response WorkflowDeleteRollout404JSONResponse) VisitWorkflowDeleteRolloutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowGetRolloutRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+}
+
+type WorkflowGetRolloutResponseObject interface {
+	VisitWorkflowGetRolloutResponse(w http.ResponseWriter) error
+}
+
+type WorkflowGetRollout200JSONResponse WorkflowRollout
+
+func (response WorkflowGetRollout200JSONResponse) VisitWorkflowGetRolloutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowGetRollout400JSONResponse APIErrors
+
+func (response WorkflowGetRollout400JSONResponse) VisitWorkflowGetRolloutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowGetRollout403JSONResponse APIErrors
+
+func (response WorkflowGetRollout403JSONResponse) VisitWorkflowGetRolloutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowGetRollout404JSONResponse APIErrors
+
+func (response WorkflowGetRollout404JSONResponse) VisitWorkflowGetRolloutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowUpdateRolloutRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Body     *WorkflowUpdateRolloutJSONRequestBody
+}
+
+type WorkflowUpdateRolloutResponseObject interface {
+	VisitWorkflowUpdateRolloutResponse(w http.ResponseWriter) error
+}
+
+type WorkflowUpdateRollout200JSONResponse WorkflowRollout
+
+func (response WorkflowUpdateRollout200JSONResponse) VisitWorkflowUpdateRolloutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowUpdateRollout400JSONResponse APIErrors
+
+func (response WorkflowUpdateRollout400JSONResponse) VisitWorkflowUpdateRolloutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowUpdateRollout403JSONResponse APIErrors
+
+func (response WorkflowUpdateRollout403JSONResponse) VisitWorkflowUpdateRolloutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowUpdateRollout404JSONResponse APIErrors
 
-func (response ApiTokenUpdateRevoke403JSONResponse) VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdateRollout404JSONResponse) VisitWorkflowUpdateRolloutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventDataGetRequestObject struct {
-	Event openapi_types.UUID `json:"event"`
+type WorkflowListSlaBreachesRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Params   WorkflowListSlaBreachesParams
 }
 
-type EventDataGetResponseObject interface {
-	VisitEventDataGetResponse(w http.ResponseWriter) error
+type WorkflowListSlaBreachesResponseObject interface {
+	VisitWorkflowListSlaBreachesResponse(w http.ResponseWriter) error
 }
 
-type EventDataGet200JSONResponse EventData
+type WorkflowListSlaBreaches200JSONResponse WorkflowRunSLABreachList
 
-func (response EventDataGet200JSONResponse) VisitEventDataGetResponse(w http.ResponseWriter) error {
+func (response WorkflowListSlaBreaches200JSONResponse) VisitWorkflowListSlaBreachesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventDataGet400JSONResponse APIErrors
+type WorkflowListSlaBreaches400JSONResponse APIErrors
 
-func (response EventDataGet400JSONResponse) VisitEventDataGetResponse(w http.ResponseWriter) error {
+func (response WorkflowListSlaBreaches400JSONResponse) VisitWorkflowListSlaBreachesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventDataGet403JSONResponse APIErrors
+type WorkflowListSlaBreaches403JSONResponse APIErrors
 
-func (response EventDataGet403JSONResponse) VisitEventDataGetResponse(w http.ResponseWriter) error {
+func (response WorkflowListSlaBreaches403JSONResponse) VisitWorkflowListSlaBreachesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GithubAppListInstallationsRequestObject struct {
+type WorkflowRunCreateRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Params   WorkflowRunCreateParams
+	Body     *WorkflowRunCreateJSONRequestBody
 }
 
-type GithubAppListInstallationsResponseObject interface {
-	VisitGithubAppListInstallationsResponse(w http.ResponseWriter) error
+type WorkflowRunCreateResponseObject interface {
+	VisitWorkflowRunCreateResponse(w http.ResponseWriter) error
 }
 
-type GithubAppListInstallations200JSONResponse ListGithubAppInstallationsResponse
+type WorkflowRunCreate200JSONResponse WorkflowRun
 
-func (response GithubAppListInstallations200JSONResponse) VisitGithubAppListInstallationsResponse(w http.ResponseWriter) error {
+func (response WorkflowRunCreate200JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GithubAppListInstallations400JSONResponse APIErrors
+type WorkflowRunCreate400JSONResponse APIErrors
 
-func (response GithubAppListInstallations400JSONResponse) VisitGithubAppListInstallationsResponse(w http.ResponseWriter) error {
+func (response WorkflowRunCreate400JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GithubAppListInstallations401JSONResponse APIErrors
+type WorkflowRunCreate403JSONResponse APIErrors
 
-func (response GithubAppListInstallations401JSONResponse) VisitGithubAppListInstallationsResponse(w http.ResponseWriter) error {
+func (response WorkflowRunCreate403JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GithubAppListInstallations405JSONResponse APIErrors
+type WorkflowRunCreate404JSONResponse APIErrors
 
-func (response GithubAppListInstallations405JSONResponse) VisitGithubAppListInstallationsResponse(w http.ResponseWriter) error {
+func (response WorkflowRunCreate404JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GithubAppListReposRequestObject struct {
-	GhInstallation openapi_types.UUID `json:"gh-installation"`
-}
-
-type GithubAppListReposResponseObject interface {
-	VisitGithubAppListReposResponse(w http.ResponseWriter) error
-}
-
-type GithubAppListRepos200JSONResponse ListGithubReposResponse
+type WorkflowRunCreate429JSONResponse TriggerWorkflowRunRejected
 
-func (response GithubAppListRepos200JSONResponse) VisitGithubAppListReposResponse(w http.ResponseWriter) error {
+func (response WorkflowRunCreate429JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(429)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GithubAppListRepos400JSONResponse APIErrors
+type TriggerLinkListRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+}
 
-func (response GithubAppListRepos400JSONResponse) VisitGithubAppListReposResponse(w http.ResponseWriter) error {
+type TriggerLinkListResponseObject interface {
+	VisitTriggerLinkListResponse(w http.ResponseWriter) error
+}
+
+type TriggerLinkList200JSONResponse ListTriggerLinks
+
+func (response TriggerLinkList200JSONResponse) VisitTriggerLinkListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GithubAppListRepos401JSONResponse APIErrors
+type TriggerLinkList400JSONResponse APIErrors
 
-func (response GithubAppListRepos401JSONResponse) VisitGithubAppListReposResponse(w http.ResponseWriter) error {
+func (response TriggerLinkList400JSONResponse) VisitTriggerLinkListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GithubAppListRepos405JSONResponse APIErrors
+type TriggerLinkList403JSONResponse APIErrors
 
-func (response GithubAppListRepos405JSONResponse) VisitGithubAppListReposResponse(w http.ResponseWriter) error {
+func (response TriggerLinkList403JSONResponse) VisitTriggerLinkListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GithubAppListBranchesRequestObject struct {
-	GhInstallation openapi_types.UUID `json:"gh-installation"`
-	GhRepoOwner    string             `json:"gh-repo-owner"`
-	GhRepoName     string             `json:"gh-repo-name"`
+type TriggerLinkCreateRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Body     *TriggerLinkCreateJSONRequestBody
 }
 
-type GithubAppListBranchesResponseObject interface {
-	VisitGithubAppListBranchesResponse(w http.ResponseWriter) error
+type TriggerLinkCreateResponseObject interface {
+	VisitTriggerLinkCreateResponse(w http.ResponseWriter) error
 }
 
-type GithubAppListBranches200JSONResponse ListGithubBranchesResponse
+type TriggerLinkCreate201JSONResponse CreateTriggerLinkResponse
 
-func (response GithubAppListBranches200JSONResponse) VisitGithubAppListBranchesResponse(w http.ResponseWriter) error {
+func (response TriggerLinkCreate201JSONResponse) VisitTriggerLinkCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GithubAppListBranches400JSONResponse APIErrors
+type TriggerLinkCreate400JSONResponse APIErrors
 
-func (response GithubAppListBranches400JSONResponse) VisitGithubAppListBranchesResponse(w http.ResponseWriter) error {
+func (response TriggerLinkCreate400JSONResponse) VisitTriggerLinkCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GithubAppListBranches401JSONResponse APIErrors
+type TriggerLinkCreate403JSONResponse APIErrors
 
-func (response GithubAppListBranches401JSONResponse) VisitGithubAppListBranchesResponse(w http.ResponseWriter) error {
+func (response TriggerLinkCreate403JSONResponse) VisitTriggerLinkCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GithubAppListBranches405JSONResponse APIErrors
+type WorkflowVersionGetRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Params   WorkflowVersionGetParams
+}
 
-func (response GithubAppListBranches405JSONResponse) VisitGithubAppListBranchesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+type WorkflowVersionGetResponseObject interface {
+	VisitWorkflowVersionGetResponse(w http.ResponseWriter) error
+}
 
-	return json.NewEncoder(w).Encode(response)
+type WorkflowVersionGet200ResponseHeaders struct {
+	ETag string
 }
 
-type GithubUpdateGlobalWebhookRequestObject struct {
+type WorkflowVersionGet200JSONResponse struct {
+	Body    WorkflowVersion
+	Headers WorkflowVersionGet200ResponseHeaders
 }
 
-type GithubUpdateGlobalWebhookResponseObject interface {
-	VisitGithubUpdateGlobalWebhookResponse(w http.ResponseWriter) error
+func (response WorkflowVersionGet200JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GithubUpdateGlobalWebhook200Response struct {
+type WorkflowVersionGet304ResponseHeaders struct {
+	ETag string
 }
 
-func (response GithubUpdateGlobalWebhook200Response) VisitGithubUpdateGlobalWebhookResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+type WorkflowVersionGet304Response struct {
+	Headers WorkflowVersionGet304ResponseHeaders
+}
+
+func (response WorkflowVersionGet304Response) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
 	return nil
 }
 
-type GithubUpdateGlobalWebhook400JSONResponse APIErrors
+type WorkflowVersionGet400JSONResponse APIErrors
 
-func (response GithubUpdateGlobalWebhook400JSONResponse) VisitGithubUpdateGlobalWebhookResponse(w http.ResponseWriter) error {
+func (response WorkflowVersionGet400JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GithubUpdateGlobalWebhook401JSONResponse APIErrors
+type WorkflowVersionGet403JSONResponse APIErrors
 
-func (response GithubUpdateGlobalWebhook401JSONResponse) VisitGithubUpdateGlobalWebhookResponse(w http.ResponseWriter) error {
+func (response WorkflowVersionGet403JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GithubUpdateGlobalWebhook405JSONResponse APIErrors
+type WorkflowVersionGet404JSONResponse APIErrors
 
-func (response GithubUpdateGlobalWebhook405JSONResponse) VisitGithubUpdateGlobalWebhookResponse(w http.ResponseWriter) error {
+func (response WorkflowVersionGet404JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GithubUpdateTenantWebhookRequestObject struct {
-	Webhook openapi_types.UUID `json:"webhook"`
+type WorkflowVersionGetDefinitionRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Params   WorkflowVersionGetDefinitionParams
 }
 
-type GithubUpdateTenantWebhookResponseObject interface {
-	VisitGithubUpdateTenantWebhookResponse(w http.ResponseWriter) error
+type WorkflowVersionGetDefinitionResponseObject interface {
+	VisitWorkflowVersionGetDefinitionResponse(w http.ResponseWriter) error
 }
 
-type GithubUpdateTenantWebhook200Response struct {
-}
+type WorkflowVersionGetDefinition200JSONResponse WorkflowVersionDefinition
 
-func (response GithubUpdateTenantWebhook200Response) VisitGithubUpdateTenantWebhookResponse(w http.ResponseWriter) error {
+func (response WorkflowVersionGetDefinition200JSONResponse) VisitWorkflowVersionGetDefinitionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GithubUpdateTenantWebhook400JSONResponse APIErrors
+type WorkflowVersionGetDefinition400JSONResponse APIErrors
 
-func (response GithubUpdateTenantWebhook400JSONResponse) VisitGithubUpdateTenantWebhookResponse(w http.ResponseWriter) error {
+func (response WorkflowVersionGetDefinition400JSONResponse) VisitWorkflowVersionGetDefinitionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GithubUpdateTenantWebhook401JSONResponse APIErrors
+type WorkflowVersionGetDefinition403JSONResponse APIErrors
 
-func (response GithubUpdateTenantWebhook401JSONResponse) VisitGithubUpdateTenantWebhookResponse(w http.ResponseWriter) error {
+func (response WorkflowVersionGetDefinition403JSONResponse) VisitWorkflowVersionGetDefinitionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GithubUpdateTenantWebhook405JSONResponse APIErrors
+type WorkflowVersionGetDefinition404JSONResponse APIErrors
 
-func (response GithubUpdateTenantWebhook405JSONResponse) VisitGithubUpdateTenantWebhookResponse(w http.ResponseWriter) error {
+func (response WorkflowVersionGetDefinition404JSONResponse) VisitWorkflowVersionGetDefinitionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MetadataGetRequestObject struct {
-}
+type StrictServerInterface interface {
+	LivenessGet(ctx echo.Context, request LivenessGetRequestObject) (LivenessGetResponseObject, error)
 
-type MetadataGetResponseObject interface {
-	VisitMetadataGetResponse(w http.ResponseWriter) error
-}
+	ReadinessGet(ctx echo.Context, request ReadinessGetRequestObject) (ReadinessGetResponseObject, error)
 
-type MetadataGet200JSONResponse APIMeta
+	AdminGithubAppCreate(ctx echo.Context, request AdminGithubAppCreateRequestObject) (AdminGithubAppCreateResponseObject, error)
 
-func (response MetadataGet200JSONResponse) VisitMetadataGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	AdminGithubAppCreateCallback(ctx echo.Context, request AdminGithubAppCreateCallbackRequestObject) (AdminGithubAppCreateCallbackResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	AdminMaintenanceCreate(ctx echo.Context, request AdminMaintenanceCreateRequestObject) (AdminMaintenanceCreateResponseObject, error)
 
-type MetadataGet400JSONResponse APIErrors
+	AdminQueueList(ctx echo.Context, request AdminQueueListRequestObject) (AdminQueueListResponseObject, error)
 
-func (response MetadataGet400JSONResponse) VisitMetadataGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	AdminTenantList(ctx echo.Context, request AdminTenantListRequestObject) (AdminTenantListResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	AdminTenantFeatureFlagList(ctx echo.Context, request AdminTenantFeatureFlagListRequestObject) (AdminTenantFeatureFlagListResponseObject, error)
 
-type MetadataListIntegrationsRequestObject struct {
-}
+	AdminTenantFeatureFlagUpdate(ctx echo.Context, request AdminTenantFeatureFlagUpdateRequestObject) (AdminTenantFeatureFlagUpdateResponseObject, error)
 
-type MetadataListIntegrationsResponseObject interface {
-	VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error
-}
+	AdminTenantUpdateIngestion(ctx echo.Context, request AdminTenantUpdateIngestionRequestObject) (AdminTenantUpdateIngestionResponseObject, error)
 
-type MetadataListIntegrations200JSONResponse ListAPIMetaIntegration
+	AdminWorkflowRunUpdateFail(ctx echo.Context, request AdminWorkflowRunUpdateFailRequestObject) (AdminWorkflowRunUpdateFailResponseObject, error)
 
-func (response MetadataListIntegrations200JSONResponse) VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ApiTokenGet(ctx echo.Context, request ApiTokenGetRequestObject) (ApiTokenGetResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ApiTokenUpdateRevoke(ctx echo.Context, request ApiTokenUpdateRevokeRequestObject) (ApiTokenUpdateRevokeResponseObject, error)
 
-type MetadataListIntegrations400JSONResponse APIErrors
+	ApiTokenUpdateRotate(ctx echo.Context, request ApiTokenUpdateRotateRequestObject) (ApiTokenUpdateRotateResponseObject, error)
 
-func (response MetadataListIntegrations400JSONResponse) VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ClientCertificateDelete(ctx echo.Context, request ClientCertificateDeleteRequestObject) (ClientCertificateDeleteResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	EventBusSubscriptionDelete(ctx echo.Context, request EventBusSubscriptionDeleteRequestObject) (EventBusSubscriptionDeleteResponseObject, error)
 
-type SnsDeleteRequestObject struct {
-	Sns openapi_types.UUID `json:"sns"`
-}
+	EventBusSubscriptionAck(ctx echo.Context, request EventBusSubscriptionAckRequestObject) (EventBusSubscriptionAckResponseObject, error)
 
-type SnsDeleteResponseObject interface {
-	VisitSnsDeleteResponse(w http.ResponseWriter) error
-}
+	EventBusSubscriptionListRecords(ctx echo.Context, request EventBusSubscriptionListRecordsRequestObject) (EventBusSubscriptionListRecordsResponseObject, error)
 
-type SnsDelete204Response struct {
-}
+	EventDataGet(ctx echo.Context, request EventDataGetRequestObject) (EventDataGetResponseObject, error)
 
-func (response SnsDelete204Response) VisitSnsDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	GiteaWebhookDelete(ctx echo.Context, request GiteaWebhookDeleteRequestObject) (GiteaWebhookDeleteResponseObject, error)
 
-type SnsDelete400JSONResponse APIErrors
+	GiteaUpdateWebhook(ctx echo.Context, request GiteaUpdateWebhookRequestObject) (GiteaUpdateWebhookResponseObject, error)
 
-func (response SnsDelete400JSONResponse) VisitSnsDeleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	GithubAppListInstallations(ctx echo.Context, request GithubAppListInstallationsRequestObject) (GithubAppListInstallationsResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	GithubAppListRepos(ctx echo.Context, request GithubAppListReposRequestObject) (GithubAppListReposResponseObject, error)
 
-type SnsDelete401JSONResponse APIErrors
+	GithubAppListBranches(ctx echo.Context, request GithubAppListBranchesRequestObject) (GithubAppListBranchesResponseObject, error)
 
-func (response SnsDelete401JSONResponse) VisitSnsDeleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	GithubUpdateGlobalWebhook(ctx echo.Context, request GithubUpdateGlobalWebhookRequestObject) (GithubUpdateGlobalWebhookResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	GithubUpdateTenantWebhook(ctx echo.Context, request GithubUpdateTenantWebhookRequestObject) (GithubUpdateTenantWebhookResponseObject, error)
 
-type SnsDelete405JSONResponse APIErrors
+	LogSinkDelete(ctx echo.Context, request LogSinkDeleteRequestObject) (LogSinkDeleteResponseObject, error)
 
-func (response SnsDelete405JSONResponse) VisitSnsDeleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	MaintenanceWindowDelete(ctx echo.Context, request MaintenanceWindowDeleteRequestObject) (MaintenanceWindowDeleteResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	MetadataGet(ctx echo.Context, request MetadataGetRequestObject) (MetadataGetResponseObject, error)
 
-type SnsUpdateRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Event  string             `json:"event"`
-}
+	MetadataGetAttestationKeys(ctx echo.Context, request MetadataGetAttestationKeysRequestObject) (MetadataGetAttestationKeysResponseObject, error)
 
-type SnsUpdateResponseObject interface {
-	VisitSnsUpdateResponse(w http.ResponseWriter) error
-}
+	MetadataListIntegrations(ctx echo.Context, request MetadataListIntegrationsRequestObject) (MetadataListIntegrationsResponseObject, error)
 
-type SnsUpdate200Response struct {
-}
+	ObjectStorageFeedDelete(ctx echo.Context, request ObjectStorageFeedDeleteRequestObject) (ObjectStorageFeedDeleteResponseObject, error)
+
+	PiiRuleDelete(ctx echo.Context, request PiiRuleDeleteRequestObject) (PiiRuleDeleteResponseObject, error)
+
+	QueryTriggerDelete(ctx echo.Context, request QueryTriggerDeleteRequestObject) (QueryTriggerDeleteResponseObject, error)
+
+	RecurringTaskDelete(ctx echo.Context, request RecurringTaskDeleteRequestObject) (RecurringTaskDeleteResponseObject, error)
+
+	SnsDelete(ctx echo.Context, request SnsDeleteRequestObject) (SnsDeleteResponseObject, error)
+
+	SnsUpdate(ctx echo.Context, request SnsUpdateRequestObject) (SnsUpdateResponseObject, error)
+
+	StepRunUpdateCreatePr(ctx echo.Context, request StepRunUpdateCreatePrRequestObject) (StepRunUpdateCreatePrResponseObject, error)
 
-func (response SnsUpdate200Response) VisitSnsUpdateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	StepRunGetDiff(ctx echo.Context, request StepRunGetDiffRequestObject) (StepRunGetDiffResponseObject, error)
 
-type SnsUpdate400JSONResponse APIErrors
+	LogLineList(ctx echo.Context, request LogLineListRequestObject) (LogLineListResponseObject, error)
 
-func (response SnsUpdate400JSONResponse) VisitSnsUpdateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	TenantExportGet(ctx echo.Context, request TenantExportGetRequestObject) (TenantExportGetResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	TenantExportDownload(ctx echo.Context, request TenantExportDownloadRequestObject) (TenantExportDownloadResponseObject, error)
 
-type SnsUpdate401JSONResponse APIErrors
+	TenantExportCreateDownloadUrl(ctx echo.Context, request TenantExportCreateDownloadUrlRequestObject) (TenantExportCreateDownloadUrlResponseObject, error)
 
-func (response SnsUpdate401JSONResponse) VisitSnsUpdateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	TenantCreate(ctx echo.Context, request TenantCreateRequestObject) (TenantCreateResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	TenantGet(ctx echo.Context, request TenantGetRequestObject) (TenantGetResponseObject, error)
 
-type SnsUpdate405JSONResponse APIErrors
+	TenantUpdate(ctx echo.Context, request TenantUpdateRequestObject) (TenantUpdateResponseObject, error)
 
-func (response SnsUpdate405JSONResponse) VisitSnsUpdateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	ApiTokenList(ctx echo.Context, request ApiTokenListRequestObject) (ApiTokenListResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ApiTokenCreate(ctx echo.Context, request ApiTokenCreateRequestObject) (ApiTokenCreateResponseObject, error)
 
-type StepRunUpdateCreatePrRequestObject struct {
-	StepRun openapi_types.UUID `json:"step-run"`
-	Body    *StepRunUpdateCreatePrJSONRequestBody
-}
+	ClientCertificateList(ctx echo.Context, request ClientCertificateListRequestObject) (ClientCertificateListResponseObject, error)
 
-type StepRunUpdateCreatePrResponseObject interface {
-	VisitStepRunUpdateCreatePrResponse(w http.ResponseWriter) error
-}
+	ClientCertificateCreate(ctx echo.Context, request ClientCertificateCreateRequestObject) (ClientCertificateCreateResponseObject, error)
 
-type StepRunUpdateCreatePr200JSONResponse CreatePullRequestFromStepRun
+	StepRunListCostCenterUsage(ctx echo.Context, request StepRunListCostCenterUsageRequestObject) (StepRunListCostCenterUsageResponseObject, error)
 
-func (response StepRunUpdateCreatePr200JSONResponse) VisitStepRunUpdateCreatePrResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	TenantGetEngineSettings(ctx echo.Context, request TenantGetEngineSettingsRequestObject) (TenantGetEngineSettingsResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	TenantUpdateEngineSettings(ctx echo.Context, request TenantUpdateEngineSettingsRequestObject) (TenantUpdateEngineSettingsResponseObject, error)
 
-type StepRunUpdateCreatePr400JSONResponse APIErrors
+	EventBusSubscriptionList(ctx echo.Context, request EventBusSubscriptionListRequestObject) (EventBusSubscriptionListResponseObject, error)
 
-func (response StepRunUpdateCreatePr400JSONResponse) VisitStepRunUpdateCreatePrResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	EventBusSubscriptionCreate(ctx echo.Context, request EventBusSubscriptionCreateRequestObject) (EventBusSubscriptionCreateResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	EventList(ctx echo.Context, request EventListRequestObject) (EventListResponseObject, error)
 
-type StepRunUpdateCreatePr403JSONResponse APIErrors
+	EventKeyList(ctx echo.Context, request EventKeyListRequestObject) (EventKeyListResponseObject, error)
 
-func (response StepRunUpdateCreatePr403JSONResponse) VisitStepRunUpdateCreatePrResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	EventUpdateReplay(ctx echo.Context, request EventUpdateReplayRequestObject) (EventUpdateReplayResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	TenantExportList(ctx echo.Context, request TenantExportListRequestObject) (TenantExportListResponseObject, error)
 
-type StepRunUpdateCreatePr404JSONResponse APIErrors
+	TenantExportCreate(ctx echo.Context, request TenantExportCreateRequestObject) (TenantExportCreateResponseObject, error)
 
-func (response StepRunUpdateCreatePr404JSONResponse) VisitStepRunUpdateCreatePrResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	GiteaWebhookList(ctx echo.Context, request GiteaWebhookListRequestObject) (GiteaWebhookListResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	GiteaWebhookCreate(ctx echo.Context, request GiteaWebhookCreateRequestObject) (GiteaWebhookCreateResponseObject, error)
 
-type StepRunGetDiffRequestObject struct {
-	StepRun openapi_types.UUID `json:"step-run"`
-}
+	GithubWebhookList(ctx echo.Context, request GithubWebhookListRequestObject) (GithubWebhookListResponseObject, error)
 
-type StepRunGetDiffResponseObject interface {
-	VisitStepRunGetDiffResponse(w http.ResponseWriter) error
-}
+	TenantInviteList(ctx echo.Context, request TenantInviteListRequestObject) (TenantInviteListResponseObject, error)
 
-type StepRunGetDiff200JSONResponse GetStepRunDiffResponse
+	TenantInviteCreate(ctx echo.Context, request TenantInviteCreateRequestObject) (TenantInviteCreateResponseObject, error)
 
-func (response StepRunGetDiff200JSONResponse) VisitStepRunGetDiffResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	TenantInviteDelete(ctx echo.Context, request TenantInviteDeleteRequestObject) (TenantInviteDeleteResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	TenantInviteUpdate(ctx echo.Context, request TenantInviteUpdateRequestObject) (TenantInviteUpdateResponseObject, error)
 
-type StepRunGetDiff400JSONResponse APIErrors
+	LogSinkList(ctx echo.Context, request LogSinkListRequestObject) (LogSinkListResponseObject, error)
 
-func (response StepRunGetDiff400JSONResponse) VisitStepRunGetDiffResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	LogSinkCreate(ctx echo.Context, request LogSinkCreateRequestObject) (LogSinkCreateResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	TenantMemberList(ctx echo.Context, request TenantMemberListRequestObject) (TenantMemberListResponseObject, error)
 
-type StepRunGetDiff403JSONResponse APIErrors
+	ObjectStorageFeedList(ctx echo.Context, request ObjectStorageFeedListRequestObject) (ObjectStorageFeedListResponseObject, error)
 
-func (response StepRunGetDiff403JSONResponse) VisitStepRunGetDiffResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	ObjectStorageFeedCreate(ctx echo.Context, request ObjectStorageFeedCreateRequestObject) (ObjectStorageFeedCreateResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	TenantDeletePause(ctx echo.Context, request TenantDeletePauseRequestObject) (TenantDeletePauseResponseObject, error)
 
-type StepRunGetDiff404JSONResponse APIErrors
+	TenantUpdatePause(ctx echo.Context, request TenantUpdatePauseRequestObject) (TenantUpdatePauseResponseObject, error)
 
-func (response StepRunGetDiff404JSONResponse) VisitStepRunGetDiffResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	PiiRuleList(ctx echo.Context, request PiiRuleListRequestObject) (PiiRuleListResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	PiiRuleCreate(ctx echo.Context, request PiiRuleCreateRequestObject) (PiiRuleCreateResponseObject, error)
 
-type LogLineListRequestObject struct {
-	StepRun openapi_types.UUID `json:"step-run"`
-	Params  LogLineListParams
-}
+	QueryTriggerList(ctx echo.Context, request QueryTriggerListRequestObject) (QueryTriggerListResponseObject, error)
 
-type LogLineListResponseObject interface {
-	VisitLogLineListResponse(w http.ResponseWriter) error
-}
+	QueryTriggerCreate(ctx echo.Context, request QueryTriggerCreateRequestObject) (QueryTriggerCreateResponseObject, error)
 
-type LogLineList200JSONResponse LogLineList
+	RecurringTaskList(ctx echo.Context, request RecurringTaskListRequestObject) (RecurringTaskListResponseObject, error)
 
-func (response LogLineList200JSONResponse) VisitLogLineListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	RecurringTaskCreate(ctx echo.Context, request RecurringTaskCreateRequestObject) (RecurringTaskCreateResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	SnsList(ctx echo.Context, request SnsListRequestObject) (SnsListResponseObject, error)
 
-type LogLineList400JSONResponse APIErrors
+	SnsCreate(ctx echo.Context, request SnsCreateRequestObject) (SnsCreateResponseObject, error)
 
-func (response LogLineList400JSONResponse) VisitLogLineListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	StepRunListMetrics(ctx echo.Context, request StepRunListMetricsRequestObject) (StepRunListMetricsResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	StepRunList(ctx echo.Context, request StepRunListRequestObject) (StepRunListResponseObject, error)
 
-type LogLineList403JSONResponse APIErrors
+	StepRunGet(ctx echo.Context, request StepRunGetRequestObject) (StepRunGetResponseObject, error)
 
-func (response LogLineList403JSONResponse) VisitLogLineListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	StepRunListAttempts(ctx echo.Context, request StepRunListAttemptsRequestObject) (StepRunListAttemptsResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	StepRunListDiagnostics(ctx echo.Context, request StepRunListDiagnosticsRequestObject) (StepRunListDiagnosticsResponseObject, error)
 
-type TenantCreateRequestObject struct {
-	Body *TenantCreateJSONRequestBody
-}
+	StepRunListStreamEvents(ctx echo.Context, request StepRunListStreamEventsRequestObject) (StepRunListStreamEventsResponseObject, error)
 
-type TenantCreateResponseObject interface {
-	VisitTenantCreateResponse(w http.ResponseWriter) error
-}
+	StepRunUpdateRerun(ctx echo.Context, request StepRunUpdateRerunRequestObject) (StepRunUpdateRerunResponseObject, error)
 
-type TenantCreate200JSONResponse Tenant
+	StepRunGetSchema(ctx echo.Context, request StepRunGetSchemaRequestObject) (StepRunGetSchemaResponseObject, error)
 
-func (response TenantCreate200JSONResponse) VisitTenantCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	SubjectDeletionCreate(ctx echo.Context, request SubjectDeletionCreateRequestObject) (SubjectDeletionCreateResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	WebhookDeliveryList(ctx echo.Context, request WebhookDeliveryListRequestObject) (WebhookDeliveryListResponseObject, error)
 
-type TenantCreate400JSONResponse APIErrors
+	WorkerList(ctx echo.Context, request WorkerListRequestObject) (WorkerListResponseObject, error)
 
-func (response TenantCreate400JSONResponse) VisitTenantCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	WorkflowRunBulkRetry(ctx echo.Context, request WorkflowRunBulkRetryRequestObject) (WorkflowRunBulkRetryResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	WorkflowRunGetBulkRetry(ctx echo.Context, request WorkflowRunGetBulkRetryRequestObject) (WorkflowRunGetBulkRetryResponseObject, error)
 
-type TenantCreate403JSONResponse APIError
+	WorkflowRunImport(ctx echo.Context, request WorkflowRunImportRequestObject) (WorkflowRunImportResponseObject, error)
 
-func (response TenantCreate403JSONResponse) VisitTenantCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	WorkflowRunGet(ctx echo.Context, request WorkflowRunGetRequestObject) (WorkflowRunGetResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	WorkflowRunGetAttestation(ctx echo.Context, request WorkflowRunGetAttestationRequestObject) (WorkflowRunGetAttestationResponseObject, error)
 
-type ApiTokenListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-}
+	WorkflowRunGetBundle(ctx echo.Context, request WorkflowRunGetBundleRequestObject) (WorkflowRunGetBundleResponseObject, error)
 
-type ApiTokenListResponseObject interface {
-	VisitApiTokenListResponse(w http.ResponseWriter) error
-}
+	WorkflowRunGetDag(ctx echo.Context, request WorkflowRunGetDagRequestObject) (WorkflowRunGetDagResponseObject, error)
 
-type ApiTokenList200JSONResponse ListAPITokensResponse
+	WorkflowRunGetGroupKeyRun(ctx echo.Context, request WorkflowRunGetGroupKeyRunRequestObject) (WorkflowRunGetGroupKeyRunResponseObject, error)
 
-func (response ApiTokenList200JSONResponse) VisitApiTokenListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	WorkflowRunListPullRequests(ctx echo.Context, request WorkflowRunListPullRequestsRequestObject) (WorkflowRunListPullRequestsResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	WorkflowRunCreateReplay(ctx echo.Context, request WorkflowRunCreateReplayRequestObject) (WorkflowRunCreateReplayResponseObject, error)
 
-type ApiTokenList400JSONResponse APIErrors
+	WorkflowRunGetRootCause(ctx echo.Context, request WorkflowRunGetRootCauseRequestObject) (WorkflowRunGetRootCauseResponseObject, error)
 
-func (response ApiTokenList400JSONResponse) VisitApiTokenListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	WorkflowList(ctx echo.Context, request WorkflowListRequestObject) (WorkflowListResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	WorkflowPut(ctx echo.Context, request WorkflowPutRequestObject) (WorkflowPutResponseObject, error)
 
-type ApiTokenList403JSONResponse APIErrors
+	WorkflowRunList(ctx echo.Context, request WorkflowRunListRequestObject) (WorkflowRunListResponseObject, error)
 
-func (response ApiTokenList403JSONResponse) VisitApiTokenListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	WorkflowRunExport(ctx echo.Context, request WorkflowRunExportRequestObject) (WorkflowRunExportResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	WorkflowRunGetMetrics(ctx echo.Context, request WorkflowRunGetMetricsRequestObject) (WorkflowRunGetMetricsResponseObject, error)
 
-type ApiTokenCreateRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *ApiTokenCreateJSONRequestBody
-}
+	WorkflowRunListScheduled(ctx echo.Context, request WorkflowRunListScheduledRequestObject) (WorkflowRunListScheduledResponseObject, error)
 
-type ApiTokenCreateResponseObject interface {
-	VisitApiTokenCreateResponse(w http.ResponseWriter) error
-}
+	TriggerLinkDelete(ctx echo.Context, request TriggerLinkDeleteRequestObject) (TriggerLinkDeleteResponseObject, error)
 
-type ApiTokenCreate200JSONResponse CreateAPITokenResponse
+	TriggerLinkRun(ctx echo.Context, request TriggerLinkRunRequestObject) (TriggerLinkRunResponseObject, error)
 
-func (response ApiTokenCreate200JSONResponse) VisitApiTokenCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	UserGetCurrent(ctx echo.Context, request UserGetCurrentRequestObject) (UserGetCurrentResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	UserUpdateGithubOauthCallback(ctx echo.Context, request UserUpdateGithubOauthCallbackRequestObject) (UserUpdateGithubOauthCallbackResponseObject, error)
 
-type ApiTokenCreate400JSONResponse APIErrors
+	UserUpdateGithubOauthStart(ctx echo.Context, request UserUpdateGithubOauthStartRequestObject) (UserUpdateGithubOauthStartResponseObject, error)
 
-func (response ApiTokenCreate400JSONResponse) VisitApiTokenCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	UserUpdateGoogleOauthCallback(ctx echo.Context, request UserUpdateGoogleOauthCallbackRequestObject) (UserUpdateGoogleOauthCallbackResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	UserUpdateGoogleOauthStart(ctx echo.Context, request UserUpdateGoogleOauthStartRequestObject) (UserUpdateGoogleOauthStartResponseObject, error)
 
-type ApiTokenCreate403JSONResponse APIErrors
+	UserListTenantInvites(ctx echo.Context, request UserListTenantInvitesRequestObject) (UserListTenantInvitesResponseObject, error)
 
-func (response ApiTokenCreate403JSONResponse) VisitApiTokenCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	TenantInviteAccept(ctx echo.Context, request TenantInviteAcceptRequestObject) (TenantInviteAcceptResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	TenantInviteReject(ctx echo.Context, request TenantInviteRejectRequestObject) (TenantInviteRejectResponseObject, error)
 
-type EventListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Params EventListParams
-}
+	UserUpdateLogin(ctx echo.Context, request UserUpdateLoginRequestObject) (UserUpdateLoginResponseObject, error)
 
-type EventListResponseObject interface {
-	VisitEventListResponse(w http.ResponseWriter) error
-}
+	UserUpdateLogout(ctx echo.Context, request UserUpdateLogoutRequestObject) (UserUpdateLogoutResponseObject, error)
 
-type EventList200JSONResponse EventList
+	TenantMembershipsList(ctx echo.Context, request TenantMembershipsListRequestObject) (TenantMembershipsListResponseObject, error)
 
-func (response EventList200JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	UserCreate(ctx echo.Context, request UserCreateRequestObject) (UserCreateResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	WorkerGet(ctx echo.Context, request WorkerGetRequestObject) (WorkerGetResponseObject, error)
 
-type EventList400JSONResponse APIErrors
+	WorkflowDelete(ctx echo.Context, request WorkflowDeleteRequestObject) (WorkflowDeleteResponseObject, error)
 
-func (response EventList400JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	WorkflowGet(ctx echo.Context, request WorkflowGetRequestObject) (WorkflowGetResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	WorkflowGetConcurrencyQueueDepth(ctx echo.Context, request WorkflowGetConcurrencyQueueDepthRequestObject) (WorkflowGetConcurrencyQueueDepthResponseObject, error)
 
-type EventList403JSONResponse APIErrors
+	WorkflowCreateConcurrencySimulation(ctx echo.Context, request WorkflowCreateConcurrencySimulationRequestObject) (WorkflowCreateConcurrencySimulationResponseObject, error)
 
-func (response EventList403JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	WorkflowUpdateDiagnostics(ctx echo.Context, request WorkflowUpdateDiagnosticsRequestObject) (WorkflowUpdateDiagnosticsResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	WorkflowUpdateLinkGithub(ctx echo.Context, request WorkflowUpdateLinkGithubRequestObject) (WorkflowUpdateLinkGithubResponseObject, error)
 
-type EventKeyListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-}
+	MaintenanceWindowList(ctx echo.Context, request MaintenanceWindowListRequestObject) (MaintenanceWindowListResponseObject, error)
 
-type EventKeyListResponseObject interface {
-	VisitEventKeyListResponse(w http.ResponseWriter) error
-}
+	MaintenanceWindowCreate(ctx echo.Context, request MaintenanceWindowCreateRequestObject) (MaintenanceWindowCreateResponseObject, error)
 
-type EventKeyList200JSONResponse EventKeyList
+	WorkflowDeletePause(ctx echo.Context, request WorkflowDeletePauseRequestObject) (WorkflowDeletePauseResponseObject, error)
 
-func (response EventKeyList200JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	WorkflowUpdatePause(ctx echo.Context, request WorkflowUpdatePauseRequestObject) (WorkflowUpdatePauseResponseObject, error)
+
+	WorkflowDeleteRollout(ctx echo.Context, request WorkflowDeleteRolloutRequestObject) (WorkflowDeleteRolloutResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	WorkflowGetRollout(ctx echo.Context, request WorkflowGetRolloutRequestObject) (WorkflowGetRolloutResponseObject, error)
 
-type EventKeyList400JSONResponse APIErrors
+	WorkflowUpdateRollout(ctx echo.Context, request WorkflowUpdateRolloutRequestObject) (WorkflowUpdateRolloutResponseObject, error)
 
-func (response EventKeyList400JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	WorkflowListSlaBreaches(ctx echo.Context, request WorkflowListSlaBreachesRequestObject) (WorkflowListSlaBreachesResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	WorkflowRunCreate(ctx echo.Context, request WorkflowRunCreateRequestObject) (WorkflowRunCreateResponseObject, error)
 
-type EventKeyList403JSONResponse APIErrors
+	TriggerLinkList(ctx echo.Context, request TriggerLinkListRequestObject) (TriggerLinkListResponseObject, error)
 
-func (response EventKeyList403JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	TriggerLinkCreate(ctx echo.Context, request TriggerLinkCreateRequestObject) (TriggerLinkCreateResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	WorkflowVersionGet(ctx echo.Context, request WorkflowVersionGetRequestObject) (WorkflowVersionGetResponseObject, error)
 
-type EventUpdateReplayRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *EventUpdateReplayJSONRequestBody
+	WorkflowVersionGetDefinition(ctx echo.Context, request WorkflowVersionGetDefinitionRequestObject) (WorkflowVersionGetDefinitionResponseObject, error)
 }
+type StrictHandlerFunc func(ctx echo.Context, args interface{}) (interface{}, error)
+type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
 
-type EventUpdateReplayResponseObject interface {
-	VisitEventUpdateReplayResponse(w http.ResponseWriter) error
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
 }
 
-type EventUpdateReplay200JSONResponse EventList
-
-func (response EventUpdateReplay200JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
 }
 
-type EventUpdateReplay400JSONResponse APIErrors
+// LivenessGet operation middleware
+func (sh *strictHandler) LivenessGet(ctx echo.Context) error {
+	var request LivenessGetRequestObject
 
-func (response EventUpdateReplay400JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.LivenessGet(ctx, request.(LivenessGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LivenessGet")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(LivenessGetResponseObject); ok {
+		return validResponse.VisitLivenessGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type EventUpdateReplay403JSONResponse APIErrors
+// ReadinessGet operation middleware
+func (sh *strictHandler) ReadinessGet(ctx echo.Context) error {
+	var request ReadinessGetRequestObject
 
-func (response EventUpdateReplay403JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReadinessGet(ctx, request.(ReadinessGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReadinessGet")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type TenantInviteListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ReadinessGetResponseObject); ok {
+		return validResponse.VisitReadinessGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type TenantInviteListResponseObject interface {
-	VisitTenantInviteListResponse(w http.ResponseWriter) error
-}
+// AdminGithubAppCreate operation middleware
+func (sh *strictHandler) AdminGithubAppCreate(ctx echo.Context, params AdminGithubAppCreateParams) error {
+	var request AdminGithubAppCreateRequestObject
 
-type TenantInviteList200JSONResponse TenantInviteList
+	request.Params = params
 
-func (response TenantInviteList200JSONResponse) VisitTenantInviteListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminGithubAppCreate(ctx, request.(AdminGithubAppCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminGithubAppCreate")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AdminGithubAppCreateResponseObject); ok {
+		return validResponse.VisitAdminGithubAppCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type TenantInviteList400JSONResponse APIErrors
+// AdminGithubAppCreateCallback operation middleware
+func (sh *strictHandler) AdminGithubAppCreateCallback(ctx echo.Context) error {
+	var request AdminGithubAppCreateCallbackRequestObject
 
-func (response TenantInviteList400JSONResponse) VisitTenantInviteListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminGithubAppCreateCallback(ctx, request.(AdminGithubAppCreateCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminGithubAppCreateCallback")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AdminGithubAppCreateCallbackResponseObject); ok {
+		return validResponse.VisitAdminGithubAppCreateCallbackResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type TenantInviteList403JSONResponse APIError
+// AdminMaintenanceCreate operation middleware
+func (sh *strictHandler) AdminMaintenanceCreate(ctx echo.Context) error {
+	var request AdminMaintenanceCreateRequestObject
 
-func (response TenantInviteList403JSONResponse) VisitTenantInviteListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var body AdminMaintenanceCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminMaintenanceCreate(ctx, request.(AdminMaintenanceCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminMaintenanceCreate")
+	}
 
-type TenantInviteCreateRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *TenantInviteCreateJSONRequestBody
-}
+	response, err := handler(ctx, request)
 
-type TenantInviteCreateResponseObject interface {
-	VisitTenantInviteCreateResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AdminMaintenanceCreateResponseObject); ok {
+		return validResponse.VisitAdminMaintenanceCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type TenantInviteCreate201JSONResponse TenantInvite
+// AdminQueueList operation middleware
+func (sh *strictHandler) AdminQueueList(ctx echo.Context) error {
+	var request AdminQueueListRequestObject
 
-func (response TenantInviteCreate201JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminQueueList(ctx, request.(AdminQueueListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminQueueList")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AdminQueueListResponseObject); ok {
+		return validResponse.VisitAdminQueueListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type TenantInviteCreate400JSONResponse APIErrors
+// AdminTenantList operation middleware
+func (sh *strictHandler) AdminTenantList(ctx echo.Context, params AdminTenantListParams) error {
+	var request AdminTenantListRequestObject
 
-func (response TenantInviteCreate400JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminTenantList(ctx, request.(AdminTenantListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminTenantList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AdminTenantListResponseObject); ok {
+		return validResponse.VisitAdminTenantListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type TenantInviteCreate403JSONResponse APIError
+// AdminTenantFeatureFlagList operation middleware
+func (sh *strictHandler) AdminTenantFeatureFlagList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request AdminTenantFeatureFlagListRequestObject
 
-func (response TenantInviteCreate403JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.Tenant = tenant
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminTenantFeatureFlagList(ctx, request.(AdminTenantFeatureFlagListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminTenantFeatureFlagList")
+	}
 
-type TenantInviteDeleteRequestObject struct {
-	Tenant       openapi_types.UUID `json:"tenant"`
-	TenantInvite openapi_types.UUID `json:"tenant-invite"`
-}
+	response, err := handler(ctx, request)
 
-type TenantInviteDeleteResponseObject interface {
-	VisitTenantInviteDeleteResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AdminTenantFeatureFlagListResponseObject); ok {
+		return validResponse.VisitAdminTenantFeatureFlagListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type TenantInviteDelete200JSONResponse TenantInvite
+// AdminTenantFeatureFlagUpdate operation middleware
+func (sh *strictHandler) AdminTenantFeatureFlagUpdate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request AdminTenantFeatureFlagUpdateRequestObject
 
-func (response TenantInviteDelete200JSONResponse) VisitTenantInviteDeleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Tenant = tenant
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body AdminTenantFeatureFlagUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type TenantInviteDelete400JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminTenantFeatureFlagUpdate(ctx, request.(AdminTenantFeatureFlagUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminTenantFeatureFlagUpdate")
+	}
 
-func (response TenantInviteDelete400JSONResponse) VisitTenantInviteDeleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AdminTenantFeatureFlagUpdateResponseObject); ok {
+		return validResponse.VisitAdminTenantFeatureFlagUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type TenantInviteUpdateRequestObject struct {
-	Tenant       openapi_types.UUID `json:"tenant"`
-	TenantInvite openapi_types.UUID `json:"tenant-invite"`
-	Body         *TenantInviteUpdateJSONRequestBody
-}
+// AdminTenantUpdateIngestion operation middleware
+func (sh *strictHandler) AdminTenantUpdateIngestion(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request AdminTenantUpdateIngestionRequestObject
 
-type TenantInviteUpdateResponseObject interface {
-	VisitTenantInviteUpdateResponse(w http.ResponseWriter) error
-}
+	request.Tenant = tenant
 
-type TenantInviteUpdate200JSONResponse TenantInvite
+	var body AdminTenantUpdateIngestionJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminTenantUpdateIngestion(ctx, request.(AdminTenantUpdateIngestionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminTenantUpdateIngestion")
+	}
 
-func (response TenantInviteUpdate200JSONResponse) VisitTenantInviteUpdateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AdminTenantUpdateIngestionResponseObject); ok {
+		return validResponse.VisitAdminTenantUpdateIngestionResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type TenantInviteUpdate400JSONResponse APIErrors
+// AdminWorkflowRunUpdateFail operation middleware
+func (sh *strictHandler) AdminWorkflowRunUpdateFail(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error {
+	var request AdminWorkflowRunUpdateFailRequestObject
 
-func (response TenantInviteUpdate400JSONResponse) VisitTenantInviteUpdateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Tenant = tenant
+	request.WorkflowRun = workflowRun
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminWorkflowRunUpdateFail(ctx, request.(AdminWorkflowRunUpdateFailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminWorkflowRunUpdateFail")
+	}
 
-type TenantMemberListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-}
+	response, err := handler(ctx, request)
 
-type TenantMemberListResponseObject interface {
-	VisitTenantMemberListResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AdminWorkflowRunUpdateFailResponseObject); ok {
+		return validResponse.VisitAdminWorkflowRunUpdateFailResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type TenantMemberList200JSONResponse TenantMemberList
-
-func (response TenantMemberList200JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ApiTokenGet operation middleware
+func (sh *strictHandler) ApiTokenGet(ctx echo.Context, apiToken openapi_types.UUID) error {
+	var request ApiTokenGetRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.ApiToken = apiToken
 
-type TenantMemberList400JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ApiTokenGet(ctx, request.(ApiTokenGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApiTokenGet")
+	}
 
-func (response TenantMemberList400JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ApiTokenGetResponseObject); ok {
+		return validResponse.VisitApiTokenGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type TenantMemberList403JSONResponse APIError
+// ApiTokenUpdateRevoke operation middleware
+func (sh *strictHandler) ApiTokenUpdateRevoke(ctx echo.Context, apiToken openapi_types.UUID) error {
+	var request ApiTokenUpdateRevokeRequestObject
 
-func (response TenantMemberList403JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.ApiToken = apiToken
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ApiTokenUpdateRevoke(ctx, request.(ApiTokenUpdateRevokeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApiTokenUpdateRevoke")
+	}
 
-type SnsListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-}
+	response, err := handler(ctx, request)
 
-type SnsListResponseObject interface {
-	VisitSnsListResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ApiTokenUpdateRevokeResponseObject); ok {
+		return validResponse.VisitApiTokenUpdateRevokeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type SnsList200JSONResponse ListSNSIntegrations
-
-func (response SnsList200JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ApiTokenUpdateRotate operation middleware
+func (sh *strictHandler) ApiTokenUpdateRotate(ctx echo.Context, apiToken openapi_types.UUID) error {
+	var request ApiTokenUpdateRotateRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.ApiToken = apiToken
 
-type SnsList400JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ApiTokenUpdateRotate(ctx, request.(ApiTokenUpdateRotateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApiTokenUpdateRotate")
+	}
 
-func (response SnsList400JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ApiTokenUpdateRotateResponseObject); ok {
+		return validResponse.VisitApiTokenUpdateRotateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type SnsList401JSONResponse APIErrors
-
-func (response SnsList401JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// ClientCertificateDelete operation middleware
+func (sh *strictHandler) ClientCertificateDelete(ctx echo.Context, clientCertificate openapi_types.UUID) error {
+	var request ClientCertificateDeleteRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.ClientCertificate = clientCertificate
 
-type SnsList405JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ClientCertificateDelete(ctx, request.(ClientCertificateDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ClientCertificateDelete")
+	}
 
-func (response SnsList405JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ClientCertificateDeleteResponseObject); ok {
+		return validResponse.VisitClientCertificateDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type SnsCreateRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *SnsCreateJSONRequestBody
-}
+// EventBusSubscriptionDelete operation middleware
+func (sh *strictHandler) EventBusSubscriptionDelete(ctx echo.Context, eventBusSubscription openapi_types.UUID) error {
+	var request EventBusSubscriptionDeleteRequestObject
 
-type SnsCreateResponseObject interface {
-	VisitSnsCreateResponse(w http.ResponseWriter) error
-}
+	request.EventBusSubscription = eventBusSubscription
 
-type SnsCreate201JSONResponse SNSIntegration
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventBusSubscriptionDelete(ctx, request.(EventBusSubscriptionDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventBusSubscriptionDelete")
+	}
 
-func (response SnsCreate201JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventBusSubscriptionDeleteResponseObject); ok {
+		return validResponse.VisitEventBusSubscriptionDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type SnsCreate400JSONResponse APIErrors
+// EventBusSubscriptionAck operation middleware
+func (sh *strictHandler) EventBusSubscriptionAck(ctx echo.Context, eventBusSubscription openapi_types.UUID) error {
+	var request EventBusSubscriptionAckRequestObject
 
-func (response SnsCreate400JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.EventBusSubscription = eventBusSubscription
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body EventBusSubscriptionAckJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type SnsCreate401JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventBusSubscriptionAck(ctx, request.(EventBusSubscriptionAckRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventBusSubscriptionAck")
+	}
 
-func (response SnsCreate401JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventBusSubscriptionAckResponseObject); ok {
+		return validResponse.VisitEventBusSubscriptionAckResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type SnsCreate405JSONResponse APIErrors
+// EventBusSubscriptionListRecords operation middleware
+func (sh *strictHandler) EventBusSubscriptionListRecords(ctx echo.Context, eventBusSubscription openapi_types.UUID, params EventBusSubscriptionListRecordsParams) error {
+	var request EventBusSubscriptionListRecordsRequestObject
 
-func (response SnsCreate405JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	request.EventBusSubscription = eventBusSubscription
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventBusSubscriptionListRecords(ctx, request.(EventBusSubscriptionListRecordsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventBusSubscriptionListRecords")
+	}
 
-type StepRunGetRequestObject struct {
-	Tenant  openapi_types.UUID `json:"tenant"`
-	StepRun openapi_types.UUID `json:"step-run"`
-}
+	response, err := handler(ctx, request)
 
-type StepRunGetResponseObject interface {
-	VisitStepRunGetResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventBusSubscriptionListRecordsResponseObject); ok {
+		return validResponse.VisitEventBusSubscriptionListRecordsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type StepRunGet200JSONResponse StepRun
-
-func (response StepRunGet200JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// EventDataGet operation middleware
+func (sh *strictHandler) EventDataGet(ctx echo.Context, event openapi_types.UUID) error {
+	var request EventDataGetRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Event = event
 
-type StepRunGet400JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventDataGet(ctx, request.(EventDataGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventDataGet")
+	}
 
-func (response StepRunGet400JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventDataGetResponseObject); ok {
+		return validResponse.VisitEventDataGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type StepRunGet403JSONResponse APIErrors
+// GiteaWebhookDelete operation middleware
+func (sh *strictHandler) GiteaWebhookDelete(ctx echo.Context, giteaWebhook openapi_types.UUID) error {
+	var request GiteaWebhookDeleteRequestObject
 
-func (response StepRunGet403JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.GiteaWebhook = giteaWebhook
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GiteaWebhookDelete(ctx, request.(GiteaWebhookDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GiteaWebhookDelete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GiteaWebhookDeleteResponseObject); ok {
+		return validResponse.VisitGiteaWebhookDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type StepRunGet404JSONResponse APIErrors
+// GiteaUpdateWebhook operation middleware
+func (sh *strictHandler) GiteaUpdateWebhook(ctx echo.Context, webhook openapi_types.UUID) error {
+	var request GiteaUpdateWebhookRequestObject
 
-func (response StepRunGet404JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Webhook = webhook
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GiteaUpdateWebhook(ctx, request.(GiteaUpdateWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GiteaUpdateWebhook")
+	}
 
-type StepRunUpdateRerunRequestObject struct {
-	Tenant  openapi_types.UUID `json:"tenant"`
-	StepRun openapi_types.UUID `json:"step-run"`
-	Body    *StepRunUpdateRerunJSONRequestBody
-}
+	response, err := handler(ctx, request)
 
-type StepRunUpdateRerunResponseObject interface {
-	VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GiteaUpdateWebhookResponseObject); ok {
+		return validResponse.VisitGiteaUpdateWebhookResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type StepRunUpdateRerun200JSONResponse StepRun
+// GithubAppListInstallations operation middleware
+func (sh *strictHandler) GithubAppListInstallations(ctx echo.Context) error {
+	var request GithubAppListInstallationsRequestObject
 
-func (response StepRunUpdateRerun200JSONResponse) VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GithubAppListInstallations(ctx, request.(GithubAppListInstallationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GithubAppListInstallations")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type StepRunUpdateRerun400JSONResponse APIErrors
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GithubAppListInstallationsResponseObject); ok {
+		return validResponse.VisitGithubAppListInstallationsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-func (response StepRunUpdateRerun400JSONResponse) VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GithubAppListRepos operation middleware
+func (sh *strictHandler) GithubAppListRepos(ctx echo.Context, ghInstallation openapi_types.UUID) error {
+	var request GithubAppListReposRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.GhInstallation = ghInstallation
 
-type StepRunUpdateRerun403JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GithubAppListRepos(ctx, request.(GithubAppListReposRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GithubAppListRepos")
+	}
 
-func (response StepRunUpdateRerun403JSONResponse) VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GithubAppListReposResponseObject); ok {
+		return validResponse.VisitGithubAppListReposResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type StepRunGetSchemaRequestObject struct {
-	Tenant  openapi_types.UUID `json:"tenant"`
-	StepRun openapi_types.UUID `json:"step-run"`
-}
+// GithubAppListBranches operation middleware
+func (sh *strictHandler) GithubAppListBranches(ctx echo.Context, ghInstallation openapi_types.UUID, ghRepoOwner string, ghRepoName string) error {
+	var request GithubAppListBranchesRequestObject
 
-type StepRunGetSchemaResponseObject interface {
-	VisitStepRunGetSchemaResponse(w http.ResponseWriter) error
-}
+	request.GhInstallation = ghInstallation
+	request.GhRepoOwner = ghRepoOwner
+	request.GhRepoName = ghRepoName
 
-type StepRunGetSchema200JSONResponse map[string]interface{}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GithubAppListBranches(ctx, request.(GithubAppListBranchesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GithubAppListBranches")
+	}
 
-func (response StepRunGetSchema200JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GithubAppListBranchesResponseObject); ok {
+		return validResponse.VisitGithubAppListBranchesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type StepRunGetSchema400JSONResponse APIErrors
+// GithubUpdateGlobalWebhook operation middleware
+func (sh *strictHandler) GithubUpdateGlobalWebhook(ctx echo.Context) error {
+	var request GithubUpdateGlobalWebhookRequestObject
 
-func (response StepRunGetSchema400JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GithubUpdateGlobalWebhook(ctx, request.(GithubUpdateGlobalWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GithubUpdateGlobalWebhook")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type StepRunGetSchema403JSONResponse APIErrors
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GithubUpdateGlobalWebhookResponseObject); ok {
+		return validResponse.VisitGithubUpdateGlobalWebhookResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-func (response StepRunGetSchema403JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// GithubUpdateTenantWebhook operation middleware
+func (sh *strictHandler) GithubUpdateTenantWebhook(ctx echo.Context, webhook openapi_types.UUID) error {
+	var request GithubUpdateTenantWebhookRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Webhook = webhook
 
-type StepRunGetSchema404JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GithubUpdateTenantWebhook(ctx, request.(GithubUpdateTenantWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GithubUpdateTenantWebhook")
+	}
 
-func (response StepRunGetSchema404JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GithubUpdateTenantWebhookResponseObject); ok {
+		return validResponse.VisitGithubUpdateTenantWebhookResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkerListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-}
+// LogSinkDelete operation middleware
+func (sh *strictHandler) LogSinkDelete(ctx echo.Context, logSink openapi_types.UUID) error {
+	var request LogSinkDeleteRequestObject
 
-type WorkerListResponseObject interface {
-	VisitWorkerListResponse(w http.ResponseWriter) error
-}
+	request.LogSink = logSink
 
-type WorkerList200JSONResponse WorkerList
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.LogSinkDelete(ctx, request.(LogSinkDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LogSinkDelete")
+	}
 
-func (response WorkerList200JSONResponse) VisitWorkerListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(LogSinkDeleteResponseObject); ok {
+		return validResponse.VisitLogSinkDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkerList400JSONResponse APIErrors
+// MaintenanceWindowDelete operation middleware
+func (sh *strictHandler) MaintenanceWindowDelete(ctx echo.Context, maintenanceWindow openapi_types.UUID) error {
+	var request MaintenanceWindowDeleteRequestObject
 
-func (response WorkerList400JSONResponse) VisitWorkerListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.MaintenanceWindow = maintenanceWindow
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MaintenanceWindowDelete(ctx, request.(MaintenanceWindowDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MaintenanceWindowDelete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(MaintenanceWindowDeleteResponseObject); ok {
+		return validResponse.VisitMaintenanceWindowDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkerList403JSONResponse APIErrors
+// MetadataGet operation middleware
+func (sh *strictHandler) MetadataGet(ctx echo.Context) error {
+	var request MetadataGetRequestObject
 
-func (response WorkerList403JSONResponse) VisitWorkerListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MetadataGet(ctx, request.(MetadataGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MetadataGet")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type WorkflowRunGetRequestObject struct {
-	Tenant      openapi_types.UUID `json:"tenant"`
-	WorkflowRun openapi_types.UUID `json:"workflow-run"`
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(MetadataGetResponseObject); ok {
+		return validResponse.VisitMetadataGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowRunGetResponseObject interface {
-	VisitWorkflowRunGetResponse(w http.ResponseWriter) error
-}
+// MetadataGetAttestationKeys operation middleware
+func (sh *strictHandler) MetadataGetAttestationKeys(ctx echo.Context) error {
+	var request MetadataGetAttestationKeysRequestObject
 
-type WorkflowRunGet200JSONResponse WorkflowRun
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MetadataGetAttestationKeys(ctx, request.(MetadataGetAttestationKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MetadataGetAttestationKeys")
+	}
 
-func (response WorkflowRunGet200JSONResponse) VisitWorkflowRunGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(MetadataGetAttestationKeysResponseObject); ok {
+		return validResponse.VisitMetadataGetAttestationKeysResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowRunGet400JSONResponse APIErrors
+// MetadataListIntegrations operation middleware
+func (sh *strictHandler) MetadataListIntegrations(ctx echo.Context) error {
+	var request MetadataListIntegrationsRequestObject
 
-func (response WorkflowRunGet400JSONResponse) VisitWorkflowRunGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MetadataListIntegrations(ctx, request.(MetadataListIntegrationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MetadataListIntegrations")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(MetadataListIntegrationsResponseObject); ok {
+		return validResponse.VisitMetadataListIntegrationsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowRunGet403JSONResponse APIErrors
+// ObjectStorageFeedDelete operation middleware
+func (sh *strictHandler) ObjectStorageFeedDelete(ctx echo.Context, objectStorageFeed openapi_types.UUID) error {
+	var request ObjectStorageFeedDeleteRequestObject
 
-func (response WorkflowRunGet403JSONResponse) VisitWorkflowRunGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.ObjectStorageFeed = objectStorageFeed
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ObjectStorageFeedDelete(ctx, request.(ObjectStorageFeedDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ObjectStorageFeedDelete")
+	}
 
-type WorkflowRunListPullRequestsRequestObject struct {
-	Tenant      openapi_types.UUID `json:"tenant"`
-	WorkflowRun openapi_types.UUID `json:"workflow-run"`
-	Params      WorkflowRunListPullRequestsParams
-}
+	response, err := handler(ctx, request)
 
-type WorkflowRunListPullRequestsResponseObject interface {
-	VisitWorkflowRunListPullRequestsResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ObjectStorageFeedDeleteResponseObject); ok {
+		return validResponse.VisitObjectStorageFeedDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowRunListPullRequests200JSONResponse ListPullRequestsResponse
-
-func (response WorkflowRunListPullRequests200JSONResponse) VisitWorkflowRunListPullRequestsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PiiRuleDelete operation middleware
+func (sh *strictHandler) PiiRuleDelete(ctx echo.Context, piiRule openapi_types.UUID) error {
+	var request PiiRuleDeleteRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.PiiRule = piiRule
 
-type WorkflowRunListPullRequests400JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PiiRuleDelete(ctx, request.(PiiRuleDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PiiRuleDelete")
+	}
 
-func (response WorkflowRunListPullRequests400JSONResponse) VisitWorkflowRunListPullRequestsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PiiRuleDeleteResponseObject); ok {
+		return validResponse.VisitPiiRuleDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowRunListPullRequests403JSONResponse APIErrors
+// QueryTriggerDelete operation middleware
+func (sh *strictHandler) QueryTriggerDelete(ctx echo.Context, queryTrigger openapi_types.UUID) error {
+	var request QueryTriggerDeleteRequestObject
 
-func (response WorkflowRunListPullRequests403JSONResponse) VisitWorkflowRunListPullRequestsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.QueryTrigger = queryTrigger
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.QueryTriggerDelete(ctx, request.(QueryTriggerDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QueryTriggerDelete")
+	}
 
-type WorkflowListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-}
+	response, err := handler(ctx, request)
 
-type WorkflowListResponseObject interface {
-	VisitWorkflowListResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(QueryTriggerDeleteResponseObject); ok {
+		return validResponse.VisitQueryTriggerDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowList200JSONResponse WorkflowList
-
-func (response WorkflowList200JSONResponse) VisitWorkflowListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// RecurringTaskDelete operation middleware
+func (sh *strictHandler) RecurringTaskDelete(ctx echo.Context, recurringTask openapi_types.UUID) error {
+	var request RecurringTaskDeleteRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RecurringTask = recurringTask
 
-type WorkflowList400JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RecurringTaskDelete(ctx, request.(RecurringTaskDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RecurringTaskDelete")
+	}
 
-func (response WorkflowList400JSONResponse) VisitWorkflowListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RecurringTaskDeleteResponseObject); ok {
+		return validResponse.VisitRecurringTaskDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowList403JSONResponse APIErrors
+// SnsDelete operation middleware
+func (sh *strictHandler) SnsDelete(ctx echo.Context, sns openapi_types.UUID) error {
+	var request SnsDeleteRequestObject
 
-func (response WorkflowList403JSONResponse) VisitWorkflowListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.Sns = sns
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SnsDelete(ctx, request.(SnsDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SnsDelete")
+	}
 
-type WorkflowRunListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Params WorkflowRunListParams
-}
+	response, err := handler(ctx, request)
 
-type WorkflowRunListResponseObject interface {
-	VisitWorkflowRunListResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SnsDeleteResponseObject); ok {
+		return validResponse.VisitSnsDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowRunList200JSONResponse WorkflowRunList
-
-func (response WorkflowRunList200JSONResponse) VisitWorkflowRunListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// SnsUpdate operation middleware
+func (sh *strictHandler) SnsUpdate(ctx echo.Context, tenant openapi_types.UUID, event string) error {
+	var request SnsUpdateRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Tenant = tenant
+	request.Event = event
 
-type WorkflowRunList400JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SnsUpdate(ctx, request.(SnsUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SnsUpdate")
+	}
 
-func (response WorkflowRunList400JSONResponse) VisitWorkflowRunListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SnsUpdateResponseObject); ok {
+		return validResponse.VisitSnsUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowRunList403JSONResponse APIErrors
+// StepRunUpdateCreatePr operation middleware
+func (sh *strictHandler) StepRunUpdateCreatePr(ctx echo.Context, stepRun openapi_types.UUID) error {
+	var request StepRunUpdateCreatePrRequestObject
 
-func (response WorkflowRunList403JSONResponse) VisitWorkflowRunListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.StepRun = stepRun
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body StepRunUpdateCreatePrJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type UserGetCurrentRequestObject struct {
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StepRunUpdateCreatePr(ctx, request.(StepRunUpdateCreatePrRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StepRunUpdateCreatePr")
+	}
 
-type UserGetCurrentResponseObject interface {
-	VisitUserGetCurrentResponse(w http.ResponseWriter) error
-}
+	response, err := handler(ctx, request)
 
-type UserGetCurrent200JSONResponse User
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StepRunUpdateCreatePrResponseObject); ok {
+		return validResponse.VisitStepRunUpdateCreatePrResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-func (response UserGetCurrent200JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// StepRunGetDiff operation middleware
+func (sh *strictHandler) StepRunGetDiff(ctx echo.Context, stepRun openapi_types.UUID) error {
+	var request StepRunGetDiffRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.StepRun = stepRun
 
-type UserGetCurrent400JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StepRunGetDiff(ctx, request.(StepRunGetDiffRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StepRunGetDiff")
+	}
 
-func (response UserGetCurrent400JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StepRunGetDiffResponseObject); ok {
+		return validResponse.VisitStepRunGetDiffResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type UserGetCurrent401JSONResponse APIErrors
-
-func (response UserGetCurrent401JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// LogLineList operation middleware
+func (sh *strictHandler) LogLineList(ctx echo.Context, stepRun openapi_types.UUID, params LogLineListParams) error {
+	var request LogLineListRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.StepRun = stepRun
+	request.Params = params
 
-type UserGetCurrent405JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.LogLineList(ctx, request.(LogLineListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LogLineList")
+	}
 
-func (response UserGetCurrent405JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(LogLineListResponseObject); ok {
+		return validResponse.VisitLogLineListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type UserUpdateGithubOauthCallbackRequestObject struct {
-}
+// TenantExportGet operation middleware
+func (sh *strictHandler) TenantExportGet(ctx echo.Context, tenantExport openapi_types.UUID) error {
+	var request TenantExportGetRequestObject
 
-type UserUpdateGithubOauthCallbackResponseObject interface {
-	VisitUserUpdateGithubOauthCallbackResponse(w http.ResponseWriter) error
-}
+	request.TenantExport = tenantExport
 
-type UserUpdateGithubOauthCallback302ResponseHeaders struct {
-	Location string
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantExportGet(ctx, request.(TenantExportGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantExportGet")
+	}
 
-type UserUpdateGithubOauthCallback302Response struct {
-	Headers UserUpdateGithubOauthCallback302ResponseHeaders
-}
+	response, err := handler(ctx, request)
 
-func (response UserUpdateGithubOauthCallback302Response) VisitUserUpdateGithubOauthCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(302)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantExportGetResponseObject); ok {
+		return validResponse.VisitTenantExportGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type UserUpdateGithubOauthStartRequestObject struct {
-}
+// TenantExportDownload operation middleware
+func (sh *strictHandler) TenantExportDownload(ctx echo.Context, tenantExport openapi_types.UUID, params TenantExportDownloadParams) error {
+	var request TenantExportDownloadRequestObject
 
-type UserUpdateGithubOauthStartResponseObject interface {
-	VisitUserUpdateGithubOauthStartResponse(w http.ResponseWriter) error
-}
+	request.TenantExport = tenantExport
+	request.Params = params
 
-type UserUpdateGithubOauthStart302ResponseHeaders struct {
-	Location string
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantExportDownload(ctx, request.(TenantExportDownloadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantExportDownload")
+	}
 
-type UserUpdateGithubOauthStart302Response struct {
-	Headers UserUpdateGithubOauthStart302ResponseHeaders
-}
+	response, err := handler(ctx, request)
 
-func (response UserUpdateGithubOauthStart302Response) VisitUserUpdateGithubOauthStartResponse(w http.ResponseWriter) error {
-	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(302)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantExportDownloadResponseObject); ok {
+		return validResponse.VisitTenantExportDownloadResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type UserUpdateGoogleOauthCallbackRequestObject struct {
-}
+// TenantExportCreateDownloadUrl operation middleware
+func (sh *strictHandler) TenantExportCreateDownloadUrl(ctx echo.Context, tenantExport openapi_types.UUID) error {
+	var request TenantExportCreateDownloadUrlRequestObject
 
-type UserUpdateGoogleOauthCallbackResponseObject interface {
-	VisitUserUpdateGoogleOauthCallbackResponse(w http.ResponseWriter) error
-}
+	request.TenantExport = tenantExport
 
-type UserUpdateGoogleOauthCallback302ResponseHeaders struct {
-	Location string
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantExportCreateDownloadUrl(ctx, request.(TenantExportCreateDownloadUrlRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantExportCreateDownloadUrl")
+	}
 
-type UserUpdateGoogleOauthCallback302Response struct {
-	Headers UserUpdateGoogleOauthCallback302ResponseHeaders
-}
+	response, err := handler(ctx, request)
 
-func (response UserUpdateGoogleOauthCallback302Response) VisitUserUpdateGoogleOauthCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(302)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantExportCreateDownloadUrlResponseObject); ok {
+		return validResponse.VisitTenantExportCreateDownloadUrlResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type UserUpdateGoogleOauthStartRequestObject struct {
-}
+// TenantCreate operation middleware
+func (sh *strictHandler) TenantCreate(ctx echo.Context) error {
+	var request TenantCreateRequestObject
 
-type UserUpdateGoogleOauthStartResponseObject interface {
-	VisitUserUpdateGoogleOauthStartResponse(w http.ResponseWriter) error
-}
+	var body TenantCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type UserUpdateGoogleOauthStart302ResponseHeaders struct {
-	Location string
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantCreate(ctx, request.(TenantCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantCreate")
+	}
 
-type UserUpdateGoogleOauthStart302Response struct {
-	Headers UserUpdateGoogleOauthStart302ResponseHeaders
-}
+	response, err := handler(ctx, request)
 
-func (response UserUpdateGoogleOauthStart302Response) VisitUserUpdateGoogleOauthStartResponse(w http.ResponseWriter) error {
-	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(302)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantCreateResponseObject); ok {
+		return validResponse.VisitTenantCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type UserListTenantInvitesRequestObject struct {
-}
+// TenantGet operation middleware
+func (sh *strictHandler) TenantGet(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantGetRequestObject
 
-type UserListTenantInvitesResponseObject interface {
-	VisitUserListTenantInvitesResponse(w http.ResponseWriter) error
-}
+	request.Tenant = tenant
 
-type UserListTenantInvites200JSONResponse TenantInviteList
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantGet(ctx, request.(TenantGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantGet")
+	}
 
-func (response UserListTenantInvites200JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantGetResponseObject); ok {
+		return validResponse.VisitTenantGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type UserListTenantInvites400JSONResponse APIErrors
+// TenantUpdate operation middleware
+func (sh *strictHandler) TenantUpdate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantUpdateRequestObject
 
-func (response UserListTenantInvites400JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Tenant = tenant
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body TenantUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type UserListTenantInvites403JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantUpdate(ctx, request.(TenantUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantUpdate")
+	}
 
-func (response UserListTenantInvites403JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantUpdateResponseObject); ok {
+		return validResponse.VisitTenantUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type TenantInviteAcceptRequestObject struct {
-	Body *TenantInviteAcceptJSONRequestBody
-}
+// ApiTokenList operation middleware
+func (sh *strictHandler) ApiTokenList(ctx echo.Context, tenant openapi_types.UUID, params ApiTokenListParams) error {
+	var request ApiTokenListRequestObject
 
-type TenantInviteAcceptResponseObject interface {
-	VisitTenantInviteAcceptResponse(w http.ResponseWriter) error
-}
+	request.Tenant = tenant
+	request.Params = params
 
-type TenantInviteAccept200Response struct {
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ApiTokenList(ctx, request.(ApiTokenListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApiTokenList")
+	}
 
-func (response TenantInviteAccept200Response) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ApiTokenListResponseObject); ok {
+		return validResponse.VisitApiTokenListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type TenantInviteAccept400JSONResponse APIErrors
+// ApiTokenCreate operation middleware
+func (sh *strictHandler) ApiTokenCreate(ctx echo.Context, tenant openapi_types.UUID, params ApiTokenCreateParams) error {
+	var request ApiTokenCreateRequestObject
 
-func (response TenantInviteAccept400JSONResponse) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Tenant = tenant
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body ApiTokenCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type TenantInviteAccept403JSONResponse APIError
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ApiTokenCreate(ctx, request.(ApiTokenCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApiTokenCreate")
+	}
 
-func (response TenantInviteAccept403JSONResponse) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ApiTokenCreateResponseObject); ok {
+		return validResponse.VisitApiTokenCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type TenantInviteRejectRequestObject struct {
-	Body *TenantInviteRejectJSONRequestBody
-}
+// ClientCertificateList operation middleware
+func (sh *strictHandler) ClientCertificateList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request ClientCertificateListRequestObject
 
-type TenantInviteRejectResponseObject interface {
-	VisitTenantInviteRejectResponse(w http.ResponseWriter) error
-}
+	request.Tenant = tenant
 
-type TenantInviteReject200Response struct {
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ClientCertificateList(ctx, request.(ClientCertificateListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ClientCertificateList")
+	}
 
-func (response TenantInviteReject200Response) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ClientCertificateListResponseObject); ok {
+		return validResponse.VisitClientCertificateListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type TenantInviteReject400JSONResponse APIErrors
+// ClientCertificateCreate operation middleware
+func (sh *strictHandler) ClientCertificateCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request ClientCertificateCreateRequestObject
 
-func (response TenantInviteReject400JSONResponse) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Tenant = tenant
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body ClientCertificateCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type TenantInviteReject403JSONResponse APIError
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ClientCertificateCreate(ctx, request.(ClientCertificateCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ClientCertificateCreate")
+	}
 
-func (response TenantInviteReject403JSONResponse) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ClientCertificateCreateResponseObject); ok {
+		return validResponse.VisitClientCertificateCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type UserUpdateLoginRequestObject struct {
-	Body *UserUpdateLoginJSONRequestBody
-}
+// StepRunListCostCenterUsage operation middleware
+func (sh *strictHandler) StepRunListCostCenterUsage(ctx echo.Context, tenant openapi_types.UUID, params StepRunListCostCenterUsageParams) error {
+	var request StepRunListCostCenterUsageRequestObject
 
-type UserUpdateLoginResponseObject interface {
-	VisitUserUpdateLoginResponse(w http.ResponseWriter) error
-}
+	request.Tenant = tenant
+	request.Params = params
 
-type UserUpdateLogin200JSONResponse User
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StepRunListCostCenterUsage(ctx, request.(StepRunListCostCenterUsageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StepRunListCostCenterUsage")
+	}
 
-func (response UserUpdateLogin200JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StepRunListCostCenterUsageResponseObject); ok {
+		return validResponse.VisitStepRunListCostCenterUsageResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type UserUpdateLogin400JSONResponse APIErrors
+// TenantGetEngineSettings operation middleware
+func (sh *strictHandler) TenantGetEngineSettings(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantGetEngineSettingsRequestObject
 
-func (response UserUpdateLogin400JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Tenant = tenant
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantGetEngineSettings(ctx, request.(TenantGetEngineSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantGetEngineSettings")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantGetEngineSettingsResponseObject); ok {
+		return validResponse.VisitTenantGetEngineSettingsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type UserUpdateLogin401JSONResponse APIErrors
+// TenantUpdateEngineSettings operation middleware
+func (sh *strictHandler) TenantUpdateEngineSettings(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantUpdateEngineSettingsRequestObject
 
-func (response UserUpdateLogin401JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.Tenant = tenant
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body TenantUpdateEngineSettingsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type UserUpdateLogin405JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantUpdateEngineSettings(ctx, request.(TenantUpdateEngineSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantUpdateEngineSettings")
+	}
 
-func (response UserUpdateLogin405JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantUpdateEngineSettingsResponseObject); ok {
+		return validResponse.VisitTenantUpdateEngineSettingsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type UserUpdateLogoutRequestObject struct {
-}
+// EventBusSubscriptionList operation middleware
+func (sh *strictHandler) EventBusSubscriptionList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request EventBusSubscriptionListRequestObject
 
-type UserUpdateLogoutResponseObject interface {
-	VisitUserUpdateLogoutResponse(w http.ResponseWriter) error
-}
+	request.Tenant = tenant
 
-type UserUpdateLogout200JSONResponse User
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventBusSubscriptionList(ctx, request.(EventBusSubscriptionListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventBusSubscriptionList")
+	}
 
-func (response UserUpdateLogout200JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventBusSubscriptionListResponseObject); ok {
+		return validResponse.VisitEventBusSubscriptionListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type UserUpdateLogout400JSONResponse APIErrors
+// EventBusSubscriptionCreate operation middleware
+func (sh *strictHandler) EventBusSubscriptionCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request EventBusSubscriptionCreateRequestObject
 
-func (response UserUpdateLogout400JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Tenant = tenant
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body EventBusSubscriptionCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type UserUpdateLogout401JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventBusSubscriptionCreate(ctx, request.(EventBusSubscriptionCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventBusSubscriptionCreate")
+	}
 
-func (response UserUpdateLogout401JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventBusSubscriptionCreateResponseObject); ok {
+		return validResponse.VisitEventBusSubscriptionCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type UserUpdateLogout405JSONResponse APIErrors
+// EventList operation middleware
+func (sh *strictHandler) EventList(ctx echo.Context, tenant openapi_types.UUID, params EventListParams) error {
+	var request EventListRequestObject
 
-func (response UserUpdateLogout405JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	request.Tenant = tenant
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventList(ctx, request.(EventListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventList")
+	}
 
-type TenantMembershipsListRequestObject struct {
-}
+	response, err := handler(ctx, request)
 
-type TenantMembershipsListResponseObject interface {
-	VisitTenantMembershipsListResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventListResponseObject); ok {
+		return validResponse.VisitEventListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type TenantMembershipsList200JSONResponse UserTenantMembershipsList
+// EventKeyList operation middleware
+func (sh *strictHandler) EventKeyList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request EventKeyListRequestObject
 
-func (response TenantMembershipsList200JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Tenant = tenant
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventKeyList(ctx, request.(EventKeyListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventKeyList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventKeyListResponseObject); ok {
+		return validResponse.VisitEventKeyListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type TenantMembershipsList400JSONResponse APIErrors
+// EventUpdateReplay operation middleware
+func (sh *strictHandler) EventUpdateReplay(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request EventUpdateReplayRequestObject
 
-func (response TenantMembershipsList400JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Tenant = tenant
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body EventUpdateReplayJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type TenantMembershipsList403JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventUpdateReplay(ctx, request.(EventUpdateReplayRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventUpdateReplay")
+	}
 
-func (response TenantMembershipsList403JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventUpdateReplayResponseObject); ok {
+		return validResponse.VisitEventUpdateReplayResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type UserCreateRequestObject struct {
-	Body *UserCreateJSONRequestBody
-}
+// TenantExportList operation middleware
+func (sh *strictHandler) TenantExportList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantExportListRequestObject
 
-type UserCreateResponseObject interface {
-	VisitUserCreateResponse(w http.ResponseWriter) error
-}
+	request.Tenant = tenant
 
-type UserCreate200JSONResponse User
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantExportList(ctx, request.(TenantExportListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantExportList")
+	}
 
-func (response UserCreate200JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantExportListResponseObject); ok {
+		return validResponse.VisitTenantExportListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type UserCreate400JSONResponse APIErrors
-
-func (response UserCreate400JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// TenantExportCreate operation middleware
+func (sh *strictHandler) TenantExportCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantExportCreateRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Tenant = tenant
 
-type UserCreate401JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantExportCreate(ctx, request.(TenantExportCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantExportCreate")
+	}
 
-func (response UserCreate401JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantExportCreateResponseObject); ok {
+		return validResponse.VisitTenantExportCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type UserCreate405JSONResponse APIErrors
+// GiteaWebhookList operation middleware
+func (sh *strictHandler) GiteaWebhookList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request GiteaWebhookListRequestObject
 
-func (response UserCreate405JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	request.Tenant = tenant
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GiteaWebhookList(ctx, request.(GiteaWebhookListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GiteaWebhookList")
+	}
 
-type WorkerGetRequestObject struct {
-	Worker openapi_types.UUID `json:"worker"`
-}
+	response, err := handler(ctx, request)
 
-type WorkerGetResponseObject interface {
-	VisitWorkerGetResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GiteaWebhookListResponseObject); ok {
+		return validResponse.VisitGiteaWebhookListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkerGet200JSONResponse Worker
+// GiteaWebhookCreate operation middleware
+func (sh *strictHandler) GiteaWebhookCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request GiteaWebhookCreateRequestObject
 
-func (response WorkerGet200JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Tenant = tenant
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body GiteaWebhookCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type WorkerGet400JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GiteaWebhookCreate(ctx, request.(GiteaWebhookCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GiteaWebhookCreate")
+	}
 
-func (response WorkerGet400JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GiteaWebhookCreateResponseObject); ok {
+		return validResponse.VisitGiteaWebhookCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkerGet403JSONResponse APIErrors
+// GithubWebhookList operation middleware
+func (sh *strictHandler) GithubWebhookList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request GithubWebhookListRequestObject
 
-func (response WorkerGet403JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.Tenant = tenant
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GithubWebhookList(ctx, request.(GithubWebhookListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GithubWebhookList")
+	}
 
-type WorkflowDeleteRequestObject struct {
-	Workflow openapi_types.UUID `json:"workflow"`
-}
+	response, err := handler(ctx, request)
 
-type WorkflowDeleteResponseObject interface {
-	VisitWorkflowDeleteResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GithubWebhookListResponseObject); ok {
+		return validResponse.VisitGithubWebhookListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowDelete204Response struct {
-}
+// TenantInviteList operation middleware
+func (sh *strictHandler) TenantInviteList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantInviteListRequestObject
 
-func (response WorkflowDelete204Response) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantInviteList(ctx, request.(TenantInviteListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantInviteList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantInviteListResponseObject); ok {
+		return validResponse.VisitTenantInviteListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type WorkflowDelete400JSONResponse APIErrors
+// TenantInviteCreate operation middleware
+func (sh *strictHandler) TenantInviteCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantInviteCreateRequestObject
 
-func (response WorkflowDelete400JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Tenant = tenant
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body TenantInviteCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type WorkflowDelete403JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantInviteCreate(ctx, request.(TenantInviteCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantInviteCreate")
+	}
 
-func (response WorkflowDelete403JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantInviteCreateResponseObject); ok {
+		return validResponse.VisitTenantInviteCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowDelete404JSONResponse APIErrors
+// TenantInviteDelete operation middleware
+func (sh *strictHandler) TenantInviteDelete(ctx echo.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID) error {
+	var request TenantInviteDeleteRequestObject
 
-func (response WorkflowDelete404JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Tenant = tenant
+	request.TenantInvite = tenantInvite
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantInviteDelete(ctx, request.(TenantInviteDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantInviteDelete")
+	}
 
-type WorkflowGetRequestObject struct {
-	Workflow openapi_types.UUID `json:"workflow"`
-}
+	response, err := handler(ctx, request)
 
-type WorkflowGetResponseObject interface {
-	VisitWorkflowGetResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantInviteDeleteResponseObject); ok {
+		return validResponse.VisitTenantInviteDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowGet200JSONResponse Workflow
+// TenantInviteUpdate operation middleware
+func (sh *strictHandler) TenantInviteUpdate(ctx echo.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID) error {
+	var request TenantInviteUpdateRequestObject
 
-func (response WorkflowGet200JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Tenant = tenant
+	request.TenantInvite = tenantInvite
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body TenantInviteUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type WorkflowGet400JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantInviteUpdate(ctx, request.(TenantInviteUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantInviteUpdate")
+	}
 
-func (response WorkflowGet400JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantInviteUpdateResponseObject); ok {
+		return validResponse.VisitTenantInviteUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowGet403JSONResponse APIErrors
+// LogSinkList operation middleware
+func (sh *strictHandler) LogSinkList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request LogSinkListRequestObject
 
-func (response WorkflowGet403JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.Tenant = tenant
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.LogSinkList(ctx, request.(LogSinkListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LogSinkList")
+	}
 
-type WorkflowUpdateLinkGithubRequestObject struct {
-	Workflow openapi_types.UUID `json:"workflow"`
-	Body     *WorkflowUpdateLinkGithubJSONRequestBody
-}
+	response, err := handler(ctx, request)
 
-type WorkflowUpdateLinkGithubResponseObject interface {
-	VisitWorkflowUpdateLinkGithubResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(LogSinkListResponseObject); ok {
+		return validResponse.VisitLogSinkListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowUpdateLinkGithub200JSONResponse Workflow
+// LogSinkCreate operation middleware
+func (sh *strictHandler) LogSinkCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request LogSinkCreateRequestObject
 
-func (response WorkflowUpdateLinkGithub200JSONResponse) VisitWorkflowUpdateLinkGithubResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Tenant = tenant
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body LogSinkCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type WorkflowUpdateLinkGithub400JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.LogSinkCreate(ctx, request.(LogSinkCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LogSinkCreate")
+	}
 
-func (response WorkflowUpdateLinkGithub400JSONResponse) VisitWorkflowUpdateLinkGithubResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(LogSinkCreateResponseObject); ok {
+		return validResponse.VisitLogSinkCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowUpdateLinkGithub403JSONResponse APIErrors
-
-func (response WorkflowUpdateLinkGithub403JSONResponse) VisitWorkflowUpdateLinkGithubResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// TenantMemberList operation middleware
+func (sh *strictHandler) TenantMemberList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantMemberListRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Tenant = tenant
 
-type WorkflowUpdateLinkGithub404JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantMemberList(ctx, request.(TenantMemberListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantMemberList")
+	}
 
-func (response WorkflowUpdateLinkGithub404JSONResponse) VisitWorkflowUpdateLinkGithubResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantMemberListResponseObject); ok {
+		return validResponse.VisitTenantMemberListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowRunCreateRequestObject struct {
-	Workflow openapi_types.UUID `json:"workflow"`
-	Params   WorkflowRunCreateParams
-	Body     *WorkflowRunCreateJSONRequestBody
-}
+// ObjectStorageFeedList operation middleware
+func (sh *strictHandler) ObjectStorageFeedList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request ObjectStorageFeedListRequestObject
 
-type WorkflowRunCreateResponseObject interface {
-	VisitWorkflowRunCreateResponse(w http.ResponseWriter) error
-}
+	request.Tenant = tenant
 
-type WorkflowRunCreate200JSONResponse WorkflowRun
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ObjectStorageFeedList(ctx, request.(ObjectStorageFeedListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ObjectStorageFeedList")
+	}
 
-func (response WorkflowRunCreate200JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ObjectStorageFeedListResponseObject); ok {
+		return validResponse.VisitObjectStorageFeedListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowRunCreate400JSONResponse APIErrors
+// ObjectStorageFeedCreate operation middleware
+func (sh *strictHandler) ObjectStorageFeedCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request ObjectStorageFeedCreateRequestObject
 
-func (response WorkflowRunCreate400JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Tenant = tenant
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body ObjectStorageFeedCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type WorkflowRunCreate403JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ObjectStorageFeedCreate(ctx, request.(ObjectStorageFeedCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ObjectStorageFeedCreate")
+	}
 
-func (response WorkflowRunCreate403JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ObjectStorageFeedCreateResponseObject); ok {
+		return validResponse.VisitObjectStorageFeedCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowRunCreate404JSONResponse APIErrors
+// TenantDeletePause operation middleware
+func (sh *strictHandler) TenantDeletePause(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantDeletePauseRequestObject
 
-func (response WorkflowRunCreate404JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Tenant = tenant
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantDeletePause(ctx, request.(TenantDeletePauseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantDeletePause")
+	}
 
-type WorkflowVersionGetRequestObject struct {
-	Workflow openapi_types.UUID `json:"workflow"`
-	Params   WorkflowVersionGetParams
-}
+	response, err := handler(ctx, request)
 
-type WorkflowVersionGetResponseObject interface {
-	VisitWorkflowVersionGetResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantDeletePauseResponseObject); ok {
+		return validResponse.VisitTenantDeletePauseResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowVersionGet200JSONResponse WorkflowVersion
+// TenantUpdatePause operation middleware
+func (sh *strictHandler) TenantUpdatePause(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantUpdatePauseRequestObject
 
-func (response WorkflowVersionGet200JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Tenant = tenant
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body TenantUpdatePauseJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type WorkflowVersionGet400JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantUpdatePause(ctx, request.(TenantUpdatePauseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantUpdatePause")
+	}
 
-func (response WorkflowVersionGet400JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantUpdatePauseResponseObject); ok {
+		return validResponse.VisitTenantUpdatePauseResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowVersionGet403JSONResponse APIErrors
-
-func (response WorkflowVersionGet403JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// PiiRuleList operation middleware
+func (sh *strictHandler) PiiRuleList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request PiiRuleListRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Tenant = tenant
 
-type WorkflowVersionGet404JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PiiRuleList(ctx, request.(PiiRuleListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PiiRuleList")
+	}
 
-func (response WorkflowVersionGet404JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PiiRuleListResponseObject); ok {
+		return validResponse.VisitPiiRuleListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowVersionGetDefinitionRequestObject struct {
-	Workflow openapi_types.UUID `json:"workflow"`
-	Params   WorkflowVersionGetDefinitionParams
-}
+// PiiRuleCreate operation middleware
+func (sh *strictHandler) PiiRuleCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request PiiRuleCreateRequestObject
 
-type WorkflowVersionGetDefinitionResponseObject interface {
-	VisitWorkflowVersionGetDefinitionResponse(w http.ResponseWriter) error
-}
+	request.Tenant = tenant
 
-type WorkflowVersionGetDefinition200JSONResponse WorkflowVersionDefinition
+	var body PiiRuleCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-func (response WorkflowVersionGetDefinition200JSONResponse) VisitWorkflowVersionGetDefinitionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PiiRuleCreate(ctx, request.(PiiRuleCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PiiRuleCreate")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PiiRuleCreateResponseObject); ok {
+		return validResponse.VisitPiiRuleCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowVersionGetDefinition400JSONResponse APIErrors
+// QueryTriggerList operation middleware
+func (sh *strictHandler) QueryTriggerList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request QueryTriggerListRequestObject
 
-func (response WorkflowVersionGetDefinition400JSONResponse) VisitWorkflowVersionGetDefinitionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Tenant = tenant
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.QueryTriggerList(ctx, request.(QueryTriggerListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QueryTriggerList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(QueryTriggerListResponseObject); ok {
+		return validResponse.VisitQueryTriggerListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowVersionGetDefinition403JSONResponse APIErrors
+// QueryTriggerCreate operation middleware
+func (sh *strictHandler) QueryTriggerCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request QueryTriggerCreateRequestObject
 
-func (response WorkflowVersionGetDefinition403JSONResponse) VisitWorkflowVersionGetDefinitionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.Tenant = tenant
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body QueryTriggerCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type WorkflowVersionGetDefinition404JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.QueryTriggerCreate(ctx, request.(QueryTriggerCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QueryTriggerCreate")
+	}
 
-func (response WorkflowVersionGetDefinition404JSONResponse) VisitWorkflowVersionGetDefinitionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(QueryTriggerCreateResponseObject); ok {
+		return validResponse.VisitQueryTriggerCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type StrictServerInterface interface {
-	LivenessGet(ctx echo.Context, request LivenessGetRequestObject) (LivenessGetResponseObject, error)
+// RecurringTaskList operation middleware
+func (sh *strictHandler) RecurringTaskList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request RecurringTaskListRequestObject
 
-	ReadinessGet(ctx echo.Context, request ReadinessGetRequestObject) (ReadinessGetResponseObject, error)
+	request.Tenant = tenant
 
-	ApiTokenUpdateRevoke(ctx echo.Context, request ApiTokenUpdateRevokeRequestObject) (ApiTokenUpdateRevokeResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RecurringTaskList(ctx, request.(RecurringTaskListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RecurringTaskList")
+	}
 
-	EventDataGet(ctx echo.Context, request EventDataGetRequestObject) (EventDataGetResponseObject, error)
+	response, err := handler(ctx, request)
 
-	GithubAppListInstallations(ctx echo.Context, request GithubAppListInstallationsRequestObject) (GithubAppListInstallationsResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RecurringTaskListResponseObject); ok {
+		return validResponse.VisitRecurringTaskListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	GithubAppListRepos(ctx echo.Context, request GithubAppListReposRequestObject) (GithubAppListReposResponseObject, error)
+// RecurringTaskCreate operation middleware
+func (sh *strictHandler) RecurringTaskCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request RecurringTaskCreateRequestObject
 
-	GithubAppListBranches(ctx echo.Context, request GithubAppListBranchesRequestObject) (GithubAppListBranchesResponseObject, error)
+	request.Tenant = tenant
 
-	GithubUpdateGlobalWebhook(ctx echo.Context, request GithubUpdateGlobalWebhookRequestObject) (GithubUpdateGlobalWebhookResponseObject, error)
+	var body RecurringTaskCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	GithubUpdateTenantWebhook(ctx echo.Context, request GithubUpdateTenantWebhookRequestObject) (GithubUpdateTenantWebhookResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RecurringTaskCreate(ctx, request.(RecurringTaskCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RecurringTaskCreate")
+	}
 
-	MetadataGet(ctx echo.Context, request MetadataGetRequestObject) (MetadataGetResponseObject, error)
+	response, err := handler(ctx, request)
 
-	MetadataListIntegrations(ctx echo.Context, request MetadataListIntegrationsRequestObject) (MetadataListIntegrationsResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RecurringTaskCreateResponseObject); ok {
+		return validResponse.VisitRecurringTaskCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	SnsDelete(ctx echo.Context, request SnsDeleteRequestObject) (SnsDeleteResponseObject, error)
+// SnsList operation middleware
+func (sh *strictHandler) SnsList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request SnsListRequestObject
 
-	SnsUpdate(ctx echo.Context, request SnsUpdateRequestObject) (SnsUpdateResponseObject, error)
+	request.Tenant = tenant
 
-	StepRunUpdateCreatePr(ctx echo.Context, request StepRunUpdateCreatePrRequestObject) (StepRunUpdateCreatePrResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SnsList(ctx, request.(SnsListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SnsList")
+	}
 
-	StepRunGetDiff(ctx echo.Context, request StepRunGetDiffRequestObject) (StepRunGetDiffResponseObject, error)
+	response, err := handler(ctx, request)
 
-	LogLineList(ctx echo.Context, request LogLineListRequestObject) (LogLineListResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SnsListResponseObject); ok {
+		return validResponse.VisitSnsListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	TenantCreate(ctx echo.Context, request TenantCreateRequestObject) (TenantCreateResponseObject, error)
+// SnsCreate operation middleware
+func (sh *strictHandler) SnsCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request SnsCreateRequestObject
 
-	ApiTokenList(ctx echo.Context, request ApiTokenListRequestObject) (ApiTokenListResponseObject, error)
+	request.Tenant = tenant
 
-	ApiTokenCreate(ctx echo.Context, request ApiTokenCreateRequestObject) (ApiTokenCreateResponseObject, error)
+	var body SnsCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	EventList(ctx echo.Context, request EventListRequestObject) (EventListResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SnsCreate(ctx, request.(SnsCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SnsCreate")
+	}
 
-	EventKeyList(ctx echo.Context, request EventKeyListRequestObject) (EventKeyListResponseObject, error)
+	response, err := handler(ctx, request)
 
-	EventUpdateReplay(ctx echo.Context, request EventUpdateReplayRequestObject) (EventUpdateReplayResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SnsCreateResponseObject); ok {
+		return validResponse.VisitSnsCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	TenantInviteList(ctx echo.Context, request TenantInviteListRequestObject) (TenantInviteListResponseObject, error)
+// StepRunListMetrics operation middleware
+func (sh *strictHandler) StepRunListMetrics(ctx echo.Context, tenant openapi_types.UUID, params StepRunListMetricsParams) error {
+	var request StepRunListMetricsRequestObject
 
-	TenantInviteCreate(ctx echo.Context, request TenantInviteCreateRequestObject) (TenantInviteCreateResponseObject, error)
+	request.Tenant = tenant
+	request.Params = params
 
-	TenantInviteDelete(ctx echo.Context, request TenantInviteDeleteRequestObject) (TenantInviteDeleteResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StepRunListMetrics(ctx, request.(StepRunListMetricsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StepRunListMetrics")
+	}
 
-	TenantInviteUpdate(ctx echo.Context, request TenantInviteUpdateRequestObject) (TenantInviteUpdateResponseObject, error)
+	response, err := handler(ctx, request)
 
-	TenantMemberList(ctx echo.Context, request TenantMemberListRequestObject) (TenantMemberListResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StepRunListMetricsResponseObject); ok {
+		return validResponse.VisitStepRunListMetricsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	SnsList(ctx echo.Context, request SnsListRequestObject) (SnsListResponseObject, error)
+// StepRunList operation middleware
+func (sh *strictHandler) StepRunList(ctx echo.Context, tenant openapi_types.UUID, params StepRunListParams) error {
+	var request StepRunListRequestObject
 
-	SnsCreate(ctx echo.Context, request SnsCreateRequestObject) (SnsCreateResponseObject, error)
+	request.Tenant = tenant
+	request.Params = params
 
-	StepRunGet(ctx echo.Context, request StepRunGetRequestObject) (StepRunGetResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StepRunList(ctx, request.(StepRunListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StepRunList")
+	}
 
-	StepRunUpdateRerun(ctx echo.Context, request StepRunUpdateRerunRequestObject) (StepRunUpdateRerunResponseObject, error)
+	response, err := handler(ctx, request)
 
-	StepRunGetSchema(ctx echo.Context, request StepRunGetSchemaRequestObject) (StepRunGetSchemaResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StepRunListResponseObject); ok {
+		return validResponse.VisitStepRunListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	WorkerList(ctx echo.Context, request WorkerListRequestObject) (WorkerListResponseObject, error)
+// StepRunGet operation middleware
+func (sh *strictHandler) StepRunGet(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error {
+	var request StepRunGetRequestObject
 
-	WorkflowRunGet(ctx echo.Context, request WorkflowRunGetRequestObject) (WorkflowRunGetResponseObject, error)
+	request.Tenant = tenant
+	request.StepRun = stepRun
 
-	WorkflowRunListPullRequests(ctx echo.Context, request WorkflowRunListPullRequestsRequestObject) (WorkflowRunListPullRequestsResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StepRunGet(ctx, request.(StepRunGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StepRunGet")
+	}
 
-	WorkflowList(ctx echo.Context, request WorkflowListRequestObject) (WorkflowListResponseObject, error)
+	response, err := handler(ctx, request)
 
-	WorkflowRunList(ctx echo.Context, request WorkflowRunListRequestObject) (WorkflowRunListResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StepRunGetResponseObject); ok {
+		return validResponse.VisitStepRunGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	UserGetCurrent(ctx echo.Context, request UserGetCurrentRequestObject) (UserGetCurrentResponseObject, error)
+// StepRunListAttempts operation middleware
+func (sh *strictHandler) StepRunListAttempts(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error {
+	var request StepRunListAttemptsRequestObject
 
-	UserUpdateGithubOauthCallback(ctx echo.Context, request UserUpdateGithubOauthCallbackRequestObject) (UserUpdateGithubOauthCallbackResponseObject, error)
+	request.Tenant = tenant
+	request.StepRun = stepRun
 
-	UserUpdateGithubOauthStart(ctx echo.Context, request UserUpdateGithubOauthStartRequestObject) (UserUpdateGithubOauthStartResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StepRunListAttempts(ctx, request.(StepRunListAttemptsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StepRunListAttempts")
+	}
 
-	UserUpdateGoogleOauthCallback(ctx echo.Context, request UserUpdateGoogleOauthCallbackRequestObject) (UserUpdateGoogleOauthCallbackResponseObject, error)
+	response, err := handler(ctx, request)
 
-	UserUpdateGoogleOauthStart(ctx echo.Context, request UserUpdateGoogleOauthStartRequestObject) (UserUpdateGoogleOauthStartResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StepRunListAttemptsResponseObject); ok {
+		return validResponse.VisitStepRunListAttemptsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	UserListTenantInvites(ctx echo.Context, request UserListTenantInvitesRequestObject) (UserListTenantInvitesResponseObject, error)
+// StepRunListDiagnostics operation middleware
+func (sh *strictHandler) StepRunListDiagnostics(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error {
+	var request StepRunListDiagnosticsRequestObject
 
-	TenantInviteAccept(ctx echo.Context, request TenantInviteAcceptRequestObject) (TenantInviteAcceptResponseObject, error)
+	request.Tenant = tenant
+	request.StepRun = stepRun
 
-	TenantInviteReject(ctx echo.Context, request TenantInviteRejectRequestObject) (TenantInviteRejectResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StepRunListDiagnostics(ctx, request.(StepRunListDiagnosticsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StepRunListDiagnostics")
+	}
 
-	UserUpdateLogin(ctx echo.Context, request UserUpdateLoginRequestObject) (UserUpdateLoginResponseObject, error)
+	response, err := handler(ctx, request)
 
-	UserUpdateLogout(ctx echo.Context, request UserUpdateLogoutRequestObject) (UserUpdateLogoutResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StepRunListDiagnosticsResponseObject); ok {
+		return validResponse.VisitStepRunListDiagnosticsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	TenantMembershipsList(ctx echo.Context, request TenantMembershipsListRequestObject) (TenantMembershipsListResponseObject, error)
+// StepRunListStreamEvents operation middleware
+func (sh *strictHandler) StepRunListStreamEvents(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, params StepRunListStreamEventsParams) error {
+	var request StepRunListStreamEventsRequestObject
 
-	UserCreate(ctx echo.Context, request UserCreateRequestObject) (UserCreateResponseObject, error)
+	request.Tenant = tenant
+	request.StepRun = stepRun
+	request.Params = params
 
-	WorkerGet(ctx echo.Context, request WorkerGetRequestObject) (WorkerGetResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StepRunListStreamEvents(ctx, request.(StepRunListStreamEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StepRunListStreamEvents")
+	}
 
-	WorkflowDelete(ctx echo.Context, request WorkflowDeleteRequestObject) (WorkflowDeleteResponseObject, error)
+	response, err := handler(ctx, request)
 
-	WorkflowGet(ctx echo.Context, request WorkflowGetRequestObject) (WorkflowGetResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StepRunListStreamEventsResponseObject); ok {
+		return validResponse.VisitStepRunListStreamEventsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	WorkflowUpdateLinkGithub(ctx echo.Context, request WorkflowUpdateLinkGithubRequestObject) (WorkflowUpdateLinkGithubResponseObject, error)
+// StepRunUpdateRerun operation middleware
+func (sh *strictHandler) StepRunUpdateRerun(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error {
+	var request StepRunUpdateRerunRequestObject
 
-	WorkflowRunCreate(ctx echo.Context, request WorkflowRunCreateRequestObject) (WorkflowRunCreateResponseObject, error)
+	request.Tenant = tenant
+	request.StepRun = stepRun
 
-	WorkflowVersionGet(ctx echo.Context, request WorkflowVersionGetRequestObject) (WorkflowVersionGetResponseObject, error)
+	var body StepRunUpdateRerunJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	WorkflowVersionGetDefinition(ctx echo.Context, request WorkflowVersionGetDefinitionRequestObject) (WorkflowVersionGetDefinitionResponseObject, error)
-}
-type StrictHandlerFunc func(ctx echo.Context, args interface{}) (interface{}, error)
-type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StepRunUpdateRerun(ctx, request.(StepRunUpdateRerunRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StepRunUpdateRerun")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StepRunUpdateRerunResponseObject); ok {
+		return validResponse.VisitStepRunUpdateRerunResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-}
+// StepRunGetSchema operation middleware
+func (sh *strictHandler) StepRunGetSchema(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error {
+	var request StepRunGetSchemaRequestObject
 
-// LivenessGet operation middleware
-func (sh *strictHandler) LivenessGet(ctx echo.Context) error {
-	var request LivenessGetRequestObject
+	request.Tenant = tenant
+	request.StepRun = stepRun
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.LivenessGet(ctx, request.(LivenessGetRequestObject))
+		return sh.ssi.StepRunGetSchema(ctx, request.(StepRunGetSchemaRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "LivenessGet")
+		handler = middleware(handler, "StepRunGetSchema")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(LivenessGetResponseObject); ok {
-		return validResponse.VisitLivenessGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(StepRunGetSchemaResponseObject); ok {
+		return validResponse.VisitStepRunGetSchemaResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ReadinessGet operation middleware
-func (sh *strictHandler) ReadinessGet(ctx echo.Context) error {
-	var request ReadinessGetRequestObject
+// SubjectDeletionCreate operation middleware
+func (sh *strictHandler) SubjectDeletionCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request SubjectDeletionCreateRequestObject
+
+	request.Tenant = tenant
+
+	var body SubjectDeletionCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ReadinessGet(ctx, request.(ReadinessGetRequestObject))
+		return sh.ssi.SubjectDeletionCreate(ctx, request.(SubjectDeletionCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ReadinessGet")
+		handler = middleware(handler, "SubjectDeletionCreate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ReadinessGetResponseObject); ok {
-		return validResponse.VisitReadinessGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(SubjectDeletionCreateResponseObject); ok {
+		return validResponse.VisitSubjectDeletionCreateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ApiTokenUpdateRevoke operation middleware
-func (sh *strictHandler) ApiTokenUpdateRevoke(ctx echo.Context, apiToken openapi_types.UUID) error {
-	var request ApiTokenUpdateRevokeRequestObject
+// WebhookDeliveryList operation middleware
+func (sh *strictHandler) WebhookDeliveryList(ctx echo.Context, tenant openapi_types.UUID, params WebhookDeliveryListParams) error {
+	var request WebhookDeliveryListRequestObject
 
-	request.ApiToken = apiToken
+	request.Tenant = tenant
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ApiTokenUpdateRevoke(ctx, request.(ApiTokenUpdateRevokeRequestObject))
+		return sh.ssi.WebhookDeliveryList(ctx, request.(WebhookDeliveryListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ApiTokenUpdateRevoke")
+		handler = middleware(handler, "WebhookDeliveryList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ApiTokenUpdateRevokeResponseObject); ok {
-		return validResponse.VisitApiTokenUpdateRevokeResponse(ctx.Response())
+	} else if validResponse, ok := response.(WebhookDeliveryListResponseObject); ok {
+		return validResponse.VisitWebhookDeliveryListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// EventDataGet operation middleware
-func (sh *strictHandler) EventDataGet(ctx echo.Context, event openapi_types.UUID) error {
-	var request EventDataGetRequestObject
+// WorkerList operation middleware
+func (sh *strictHandler) WorkerList(ctx echo.Context, tenant openapi_types.UUID, params WorkerListParams) error {
+	var request WorkerListRequestObject
 
-	request.Event = event
+	request.Tenant = tenant
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.EventDataGet(ctx, request.(EventDataGetRequestObject))
+		return sh.ssi.WorkerList(ctx, request.(WorkerListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "EventDataGet")
+		handler = middleware(handler, "WorkerList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(EventDataGetResponseObject); ok {
-		return validResponse.VisitEventDataGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkerListResponseObject); ok {
+		return validResponse.VisitWorkerListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GithubAppListInstallations operation middleware
-func (sh *strictHandler) GithubAppListInstallations(ctx echo.Context) error {
-	var request GithubAppListInstallationsRequestObject
+// WorkflowRunBulkRetry operation middleware
+func (sh *strictHandler) WorkflowRunBulkRetry(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request WorkflowRunBulkRetryRequestObject
+
+	request.Tenant = tenant
+
+	var body WorkflowRunBulkRetryJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GithubAppListInstallations(ctx, request.(GithubAppListInstallationsRequestObject))
+		return sh.ssi.WorkflowRunBulkRetry(ctx, request.(WorkflowRunBulkRetryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GithubAppListInstallations")
+		handler = middleware(handler, "WorkflowRunBulkRetry")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GithubAppListInstallationsResponseObject); ok {
-		return validResponse.VisitGithubAppListInstallationsResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowRunBulkRetryResponseObject); ok {
+		return validResponse.VisitWorkflowRunBulkRetryResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GithubAppListRepos operation middleware
-func (sh *strictHandler) GithubAppListRepos(ctx echo.Context, ghInstallation openapi_types.UUID) error {
-	var request GithubAppListReposRequestObject
+// WorkflowRunGetBulkRetry operation middleware
+func (sh *strictHandler) WorkflowRunGetBulkRetry(ctx echo.Context, tenant openapi_types.UUID, bulkRetry openapi_types.UUID) error {
+	var request WorkflowRunGetBulkRetryRequestObject
 
-	request.GhInstallation = ghInstallation
+	request.Tenant = tenant
+	request.BulkRetry = bulkRetry
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GithubAppListRepos(ctx, request.(GithubAppListReposRequestObject))
+		return sh.ssi.WorkflowRunGetBulkRetry(ctx, request.(WorkflowRunGetBulkRetryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GithubAppListRepos")
+		handler = middleware(handler, "WorkflowRunGetBulkRetry")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GithubAppListReposResponseObject); ok {
-		return validResponse.VisitGithubAppListReposResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowRunGetBulkRetryResponseObject); ok {
+		return validResponse.VisitWorkflowRunGetBulkRetryResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GithubAppListBranches operation middleware
-func (sh *strictHandler) GithubAppListBranches(ctx echo.Context, ghInstallation openapi_types.UUID, ghRepoOwner string, ghRepoName string) error {
-	var request GithubAppListBranchesRequestObject
+// WorkflowRunImport operation middleware
+func (sh *strictHandler) WorkflowRunImport(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request WorkflowRunImportRequestObject
 
-	request.GhInstallation = ghInstallation
-	request.GhRepoOwner = ghRepoOwner
-	request.GhRepoName = ghRepoName
+	request.Tenant = tenant
+
+	var body WorkflowRunImportJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GithubAppListBranches(ctx, request.(GithubAppListBranchesRequestObject))
+		return sh.ssi.WorkflowRunImport(ctx, request.(WorkflowRunImportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GithubAppListBranches")
+		handler = middleware(handler, "WorkflowRunImport")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GithubAppListBranchesResponseObject); ok {
-		return validResponse.VisitGithubAppListBranchesResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowRunImportResponseObject); ok {
+		return validResponse.VisitWorkflowRunImportResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GithubUpdateGlobalWebhook operation middleware
-func (sh *strictHandler) GithubUpdateGlobalWebhook(ctx echo.Context) error {
-	var request GithubUpdateGlobalWebhookRequestObject
+// WorkflowRunGet operation middleware
+func (sh *strictHandler) WorkflowRunGet(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params WorkflowRunGetParams) error {
+	var request WorkflowRunGetRequestObject
+
+	request.Tenant = tenant
+	request.WorkflowRun = workflowRun
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GithubUpdateGlobalWebhook(ctx, request.(GithubUpdateGlobalWebhookRequestObject))
+		return sh.ssi.WorkflowRunGet(ctx, request.(WorkflowRunGetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GithubUpdateGlobalWebhook")
+		handler = middleware(handler, "WorkflowRunGet")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GithubUpdateGlobalWebhookResponseObject); ok {
-		return validResponse.VisitGithubUpdateGlobalWebhookResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowRunGetResponseObject); ok {
+		return validResponse.VisitWorkflowRunGetResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GithubUpdateTenantWebhook operation middleware
-func (sh *strictHandler) GithubUpdateTenantWebhook(ctx echo.Context, webhook openapi_types.UUID) error {
-	var request GithubUpdateTenantWebhookRequestObject
+// WorkflowRunGetAttestation operation middleware
+func (sh *strictHandler) WorkflowRunGetAttestation(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error {
+	var request WorkflowRunGetAttestationRequestObject
 
-	request.Webhook = webhook
+	request.Tenant = tenant
+	request.WorkflowRun = workflowRun
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GithubUpdateTenantWebhook(ctx, request.(GithubUpdateTenantWebhookRequestObject))
+		return sh.ssi.WorkflowRunGetAttestation(ctx, request.(WorkflowRunGetAttestationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GithubUpdateTenantWebhook")
+		handler = middleware(handler, "WorkflowRunGetAttestation")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GithubUpdateTenantWebhookResponseObject); ok {
-		return validResponse.VisitGithubUpdateTenantWebhookResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowRunGetAttestationResponseObject); ok {
+		return validResponse.VisitWorkflowRunGetAttestationResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// MetadataGet operation middleware
-func (sh *strictHandler) MetadataGet(ctx echo.Context) error {
-	var request MetadataGetRequestObject
+// WorkflowRunGetBundle operation middleware
+func (sh *strictHandler) WorkflowRunGetBundle(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error {
+	var request WorkflowRunGetBundleRequestObject
+
+	request.Tenant = tenant
+	request.WorkflowRun = workflowRun
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.MetadataGet(ctx, request.(MetadataGetRequestObject))
+		return sh.ssi.WorkflowRunGetBundle(ctx, request.(WorkflowRunGetBundleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "MetadataGet")
+		handler = middleware(handler, "WorkflowRunGetBundle")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(MetadataGetResponseObject); ok {
-		return validResponse.VisitMetadataGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowRunGetBundleResponseObject); ok {
+		return validResponse.VisitWorkflowRunGetBundleResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// MetadataListIntegrations operation middleware
-func (sh *strictHandler) MetadataListIntegrations(ctx echo.Context) error {
-	var request MetadataListIntegrationsRequestObject
+// WorkflowRunGetDag operation middleware
+func (sh *strictHandler) WorkflowRunGetDag(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error {
+	var request WorkflowRunGetDagRequestObject
+
+	request.Tenant = tenant
+	request.WorkflowRun = workflowRun
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.MetadataListIntegrations(ctx, request.(MetadataListIntegrationsRequestObject))
+		return sh.ssi.WorkflowRunGetDag(ctx, request.(WorkflowRunGetDagRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "MetadataListIntegrations")
+		handler = middleware(handler, "WorkflowRunGetDag")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(MetadataListIntegrationsResponseObject); ok {
-		return validResponse.VisitMetadataListIntegrationsResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowRunGetDagResponseObject); ok {
+		return validResponse.VisitWorkflowRunGetDagResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// SnsDelete operation middleware
-func (sh *strictHandler) SnsDelete(ctx echo.Context, sns openapi_types.UUID) error {
-	var request SnsDeleteRequestObject
+// WorkflowRunGetGroupKeyRun operation middleware
+func (sh *strictHandler) WorkflowRunGetGroupKeyRun(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error {
+	var request WorkflowRunGetGroupKeyRunRequestObject
 
-	request.Sns = sns
+	request.Tenant = tenant
+	request.WorkflowRun = workflowRun
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.SnsDelete(ctx, request.(SnsDeleteRequestObject))
+		return sh.ssi.WorkflowRunGetGroupKeyRun(ctx, request.(WorkflowRunGetGroupKeyRunRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SnsDelete")
+		handler = middleware(handler, "WorkflowRunGetGroupKeyRun")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SnsDeleteResponseObject); ok {
-		return validResponse.VisitSnsDeleteResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowRunGetGroupKeyRunResponseObject); ok {
+		return validResponse.VisitWorkflowRunGetGroupKeyRunResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// SnsUpdate operation middleware
-func (sh *strictHandler) SnsUpdate(ctx echo.Context, tenant openapi_types.UUID, event string) error {
-	var request SnsUpdateRequestObject
+// WorkflowRunListPullRequests operation middleware
+func (sh *strictHandler) WorkflowRunListPullRequests(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params WorkflowRunListPullRequestsParams) error {
+	var request WorkflowRunListPullRequestsRequestObject
 
 	request.Tenant = tenant
-	request.Event = event
+	request.WorkflowRun = workflowRun
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.SnsUpdate(ctx, request.(SnsUpdateRequestObject))
+		return sh.ssi.WorkflowRunListPullRequests(ctx, request.(WorkflowRunListPullRequestsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SnsUpdate")
+		handler = middleware(handler, "WorkflowRunListPullRequests")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SnsUpdateResponseObject); ok {
-		return validResponse.VisitSnsUpdateResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowRunListPullRequestsResponseObject); ok {
+		return validResponse.VisitWorkflowRunListPullRequestsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// StepRunUpdateCreatePr operation middleware
-func (sh *strictHandler) StepRunUpdateCreatePr(ctx echo.Context, stepRun openapi_types.UUID) error {
-	var request StepRunUpdateCreatePrRequestObject
+// WorkflowRunCreateReplay operation middleware
+func (sh *strictHandler) WorkflowRunCreateReplay(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error {
+	var request WorkflowRunCreateReplayRequestObject
 
-	request.StepRun = stepRun
+	request.Tenant = tenant
+	request.WorkflowRun = workflowRun
 
-	var body StepRunUpdateCreatePrJSONRequestBody
+	var body WorkflowRunCreateReplayJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.StepRunUpdateCreatePr(ctx, request.(StepRunUpdateCreatePrRequestObject))
+		return sh.ssi.WorkflowRunCreateReplay(ctx, request.(WorkflowRunCreateReplayRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "StepRunUpdateCreatePr")
+		handler = middleware(handler, "WorkflowRunCreateReplay")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(StepRunUpdateCreatePrResponseObject); ok {
-		return validResponse.VisitStepRunUpdateCreatePrResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowRunCreateReplayResponseObject); ok {
+		return validResponse.VisitWorkflowRunCreateReplayResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// StepRunGetDiff operation middleware
-func (sh *strictHandler) StepRunGetDiff(ctx echo.Context, stepRun openapi_types.UUID) error {
-	var request StepRunGetDiffRequestObject
+// WorkflowRunGetRootCause operation middleware
+func (sh *strictHandler) WorkflowRunGetRootCause(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error {
+	var request WorkflowRunGetRootCauseRequestObject
 
-	request.StepRun = stepRun
+	request.Tenant = tenant
+	request.WorkflowRun = workflowRun
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.StepRunGetDiff(ctx, request.(StepRunGetDiffRequestObject))
+		return sh.ssi.WorkflowRunGetRootCause(ctx, request.(WorkflowRunGetRootCauseRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "StepRunGetDiff")
+		handler = middleware(handler, "WorkflowRunGetRootCause")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(StepRunGetDiffResponseObject); ok {
-		return validResponse.VisitStepRunGetDiffResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowRunGetRootCauseResponseObject); ok {
+		return validResponse.VisitWorkflowRunGetRootCauseResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// LogLineList operation middleware
-func (sh *strictHandler) LogLineList(ctx echo.Context, stepRun openapi_types.UUID, params LogLineListParams) error {
-	var request LogLineListRequestObject
+// WorkflowList operation middleware
+func (sh *strictHandler) WorkflowList(ctx echo.Context, tenant openapi_types.UUID, params WorkflowListParams) error {
+	var request WorkflowListRequestObject
 
-	request.StepRun = stepRun
+	request.Tenant = tenant
 	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.LogLineList(ctx, request.(LogLineListRequestObject))
+		return sh.ssi.WorkflowList(ctx, request.(WorkflowListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "LogLineList")
+		handler = middleware(handler, "WorkflowList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(LogLineListResponseObject); ok {
-		return validResponse.VisitLogLineListResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowListResponseObject); ok {
+		return validResponse.VisitWorkflowListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantCreate operation middleware
-func (sh *strictHandler) TenantCreate(ctx echo.Context) error {
-	var request TenantCreateRequestObject
+// WorkflowPut operation middleware
+func (sh *strictHandler) WorkflowPut(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request WorkflowPutRequestObject
 
-	var body TenantCreateJSONRequestBody
+	request.Tenant = tenant
+
+	var body WorkflowPutJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantCreate(ctx, request.(TenantCreateRequestObject))
+		return sh.ssi.WorkflowPut(ctx, request.(WorkflowPutRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantCreate")
+		handler = middleware(handler, "WorkflowPut")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantCreateResponseObject); ok {
-		return validResponse.VisitTenantCreateResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowPutResponseObject); ok {
+		return validResponse.VisitWorkflowPutResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ApiTokenList operation middleware
-func (sh *strictHandler) ApiTokenList(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request ApiTokenListRequestObject
+// WorkflowRunList operation middleware
+func (sh *strictHandler) WorkflowRunList(ctx echo.Context, tenant openapi_types.UUID, params WorkflowRunListParams) error {
+	var request WorkflowRunListRequestObject
 
 	request.Tenant = tenant
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ApiTokenList(ctx, request.(ApiTokenListRequestObject))
+		return sh.ssi.WorkflowRunList(ctx, request.(WorkflowRunListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ApiTokenList")
+		handler = middleware(handler, "WorkflowRunList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ApiTokenListResponseObject); ok {
-		return validResponse.VisitApiTokenListResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowRunListResponseObject); ok {
+		return validResponse.VisitWorkflowRunListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ApiTokenCreate operation middleware
-func (sh *strictHandler) ApiTokenCreate(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request ApiTokenCreateRequestObject
+// WorkflowRunExport operation middleware
+func (sh *strictHandler) WorkflowRunExport(ctx echo.Context, tenant openapi_types.UUID, params WorkflowRunExportParams) error {
+	var request WorkflowRunExportRequestObject
 
 	request.Tenant = tenant
-
-	var body ApiTokenCreateJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ApiTokenCreate(ctx, request.(ApiTokenCreateRequestObject))
+		return sh.ssi.WorkflowRunExport(ctx, request.(WorkflowRunExportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ApiTokenCreate")
+		handler = middleware(handler, "WorkflowRunExport")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ApiTokenCreateResponseObject); ok {
-		return validResponse.VisitApiTokenCreateResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowRunExportResponseObject); ok {
+		return validResponse.VisitWorkflowRunExportResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// EventList operation middleware
-func (sh *strictHandler) EventList(ctx echo.Context, tenant openapi_types.UUID, params EventListParams) error {
-	var request EventListRequestObject
+// WorkflowRunGetMetrics operation middleware
+func (sh *strictHandler) WorkflowRunGetMetrics(ctx echo.Context, tenant openapi_types.UUID, params WorkflowRunGetMetricsParams) error {
+	var request WorkflowRunGetMetricsRequestObject
 
 	request.Tenant = tenant
 	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.EventList(ctx, request.(EventListRequestObject))
+		return sh.ssi.WorkflowRunGetMetrics(ctx, request.(WorkflowRunGetMetricsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "EventList")
+		handler = middleware(handler, "WorkflowRunGetMetrics")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(EventListResponseObject); ok {
-		return validResponse.VisitEventListResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowRunGetMetricsResponseObject); ok {
+		return validResponse.VisitWorkflowRunGetMetricsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// EventKeyList operation middleware
-func (sh *strictHandler) EventKeyList(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request EventKeyListRequestObject
+// WorkflowRunListScheduled operation middleware
+func (sh *strictHandler) WorkflowRunListScheduled(ctx echo.Context, tenant openapi_types.UUID, params WorkflowRunListScheduledParams) error {
+	var request WorkflowRunListScheduledRequestObject
 
 	request.Tenant = tenant
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.EventKeyList(ctx, request.(EventKeyListRequestObject))
+		return sh.ssi.WorkflowRunListScheduled(ctx, request.(WorkflowRunListScheduledRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "EventKeyList")
+		handler = middleware(handler, "WorkflowRunListScheduled")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(EventKeyListResponseObject); ok {
-		return validResponse.VisitEventKeyListResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowRunListScheduledResponseObject); ok {
+		return validResponse.VisitWorkflowRunListScheduledResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// EventUpdateReplay operation middleware
-func (sh *strictHandler) EventUpdateReplay(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request EventUpdateReplayRequestObject
-
-	request.Tenant = tenant
+// TriggerLinkDelete operation middleware
+func (sh *strictHandler) TriggerLinkDelete(ctx echo.Context, triggerLink openapi_types.UUID) error {
+	var request TriggerLinkDeleteRequestObject
 
-	var body EventUpdateReplayJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.TriggerLink = triggerLink
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.EventUpdateReplay(ctx, request.(EventUpdateReplayRequestObject))
+		return sh.ssi.TriggerLinkDelete(ctx, request.(TriggerLinkDeleteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "EventUpdateReplay")
+		handler = middleware(handler, "TriggerLinkDelete")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(EventUpdateReplayResponseObject); ok {
-		return validResponse.VisitEventUpdateReplayResponse(ctx.Response())
+	} else if validResponse, ok := response.(TriggerLinkDeleteResponseObject); ok {
+		return validResponse.VisitTriggerLinkDeleteResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantInviteList operation middleware
-func (sh *strictHandler) TenantInviteList(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request TenantInviteListRequestObject
+// TriggerLinkRun operation middleware
+func (sh *strictHandler) TriggerLinkRun(ctx echo.Context, triggerLink openapi_types.UUID, params TriggerLinkRunParams) error {
+	var request TriggerLinkRunRequestObject
 
-	request.Tenant = tenant
+	request.TriggerLink = triggerLink
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantInviteList(ctx, request.(TenantInviteListRequestObject))
+		return sh.ssi.TriggerLinkRun(ctx, request.(TriggerLinkRunRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantInviteList")
+		handler = middleware(handler, "TriggerLinkRun")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantInviteListResponseObject); ok {
-		return validResponse.VisitTenantInviteListResponse(ctx.Response())
+	} else if validResponse, ok := response.(TriggerLinkRunResponseObject); ok {
+		return validResponse.VisitTriggerLinkRunResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantInviteCreate operation middleware
-func (sh *strictHandler) TenantInviteCreate(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request TenantInviteCreateRequestObject
-
-	request.Tenant = tenant
-
-	var body TenantInviteCreateJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+// UserGetCurrent operation middleware
+func (sh *strictHandler) UserGetCurrent(ctx echo.Context) error {
+	var request UserGetCurrentRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantInviteCreate(ctx, request.(TenantInviteCreateRequestObject))
+		return sh.ssi.UserGetCurrent(ctx, request.(UserGetCurrentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantInviteCreate")
+		handler = middleware(handler, "UserGetCurrent")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantInviteCreateResponseObject); ok {
-		return validResponse.VisitTenantInviteCreateResponse(ctx.Response())
+	} else if validResponse, ok := response.(UserGetCurrentResponseObject); ok {
+		return validResponse.VisitUserGetCurrentResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantInviteDelete operation middleware
-func (sh *strictHandler) TenantInviteDelete(ctx echo.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID) error {
-	var request TenantInviteDeleteRequestObject
-
-	request.Tenant = tenant
-	request.TenantInvite = tenantInvite
+// UserUpdateGithubOauthCallback operation middleware
+func (sh *strictHandler) UserUpdateGithubOauthCallback(ctx echo.Context) error {
+	var request UserUpdateGithubOauthCallbackRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantInviteDelete(ctx, request.(TenantInviteDeleteRequestObject))
+		return sh.ssi.UserUpdateGithubOauthCallback(ctx, request.(UserUpdateGithubOauthCallbackRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantInviteDelete")
+		handler = middleware(handler, "UserUpdateGithubOauthCallback")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantInviteDeleteResponseObject); ok {
-		return validResponse.VisitTenantInviteDeleteResponse(ctx.Response())
+	} else if validResponse, ok := response.(UserUpdateGithubOauthCallbackResponseObject); ok {
+		return validResponse.VisitUserUpdateGithubOauthCallbackResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantInviteUpdate operation middleware
-func (sh *strictHandler) TenantInviteUpdate(ctx echo.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID) error {
-	var request TenantInviteUpdateRequestObject
-
-	request.Tenant = tenant
-	request.TenantInvite = tenantInvite
-
-	var body TenantInviteUpdateJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+// UserUpdateGithubOauthStart operation middleware
+func (sh *strictHandler) UserUpdateGithubOauthStart(ctx echo.Context) error {
+	var request UserUpdateGithubOauthStartRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantInviteUpdate(ctx, request.(TenantInviteUpdateRequestObject))
+		return sh.ssi.UserUpdateGithubOauthStart(ctx, request.(UserUpdateGithubOauthStartRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantInviteUpdate")
+		handler = middleware(handler, "UserUpdateGithubOauthStart")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantInviteUpdateResponseObject); ok {
-		return validResponse.VisitTenantInviteUpdateResponse(ctx.Response())
+	} else if validResponse, ok := response.(UserUpdateGithubOauthStartResponseObject); ok {
+		return validResponse.VisitUserUpdateGithubOauthStartResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantMemberList operation middleware
-func (sh *strictHandler) TenantMemberList(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request TenantMemberListRequestObject
-
-	request.Tenant = tenant
+// UserUpdateGoogleOauthCallback operation middleware
+func (sh *strictHandler) UserUpdateGoogleOauthCallback(ctx echo.Context) error {
+	var request UserUpdateGoogleOauthCallbackRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantMemberList(ctx, request.(TenantMemberListRequestObject))
+		return sh.ssi.UserUpdateGoogleOauthCallback(ctx, request.(UserUpdateGoogleOauthCallbackRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantMemberList")
+		handler = middleware(handler, "UserUpdateGoogleOauthCallback")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantMemberListResponseObject); ok {
-		return validResponse.VisitTenantMemberListResponse(ctx.Response())
+	} else if validResponse, ok := response.(UserUpdateGoogleOauthCallbackResponseObject); ok {
+		return validResponse.VisitUserUpdateGoogleOauthCallbackResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// SnsList operation middleware
-func (sh *strictHandler) SnsList(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request SnsListRequestObject
-
-	request.Tenant = tenant
+// UserUpdateGoogleOauthStart operation middleware
+func (sh *strictHandler) UserUpdateGoogleOauthStart(ctx echo.Context) error {
+	var request UserUpdateGoogleOauthStartRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.SnsList(ctx, request.(SnsListRequestObject))
+		return sh.ssi.UserUpdateGoogleOauthStart(ctx, request.(UserUpdateGoogleOauthStartRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SnsList")
+		handler = middleware(handler, "UserUpdateGoogleOauthStart")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SnsListResponseObject); ok {
-		return validResponse.VisitSnsListResponse(ctx.Response())
+	} else if validResponse, ok := response.(UserUpdateGoogleOauthStartResponseObject); ok {
+		return validResponse.VisitUserUpdateGoogleOauthStartResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// SnsCreate operation middleware
-func (sh *strictHandler) SnsCreate(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request SnsCreateRequestObject
-
-	request.Tenant = tenant
-
-	var body SnsCreateJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+// UserListTenantInvites operation middleware
+func (sh *strictHandler) UserListTenantInvites(ctx echo.Context) error {
+	var request UserListTenantInvitesRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.SnsCreate(ctx, request.(SnsCreateRequestObject))
+		return sh.ssi.UserListTenantInvites(ctx, request.(UserListTenantInvitesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SnsCreate")
+		handler = middleware(handler, "UserListTenantInvites")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SnsCreateResponseObject); ok {
-		return validResponse.VisitSnsCreateResponse(ctx.Response())
+	} else if validResponse, ok := response.(UserListTenantInvitesResponseObject); ok {
+		return validResponse.VisitUserListTenantInvitesResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// StepRunGet operation middleware
-func (sh *strictHandler) StepRunGet(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error {
-	var request StepRunGetRequestObject
+// TenantInviteAccept operation middleware
+func (sh *strictHandler) TenantInviteAccept(ctx echo.Context) error {
+	var request TenantInviteAcceptRequestObject
 
-	request.Tenant = tenant
-	request.StepRun = stepRun
+	var body TenantInviteAcceptJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.StepRunGet(ctx, request.(StepRunGetRequestObject))
+		return sh.ssi.TenantInviteAccept(ctx, request.(TenantInviteAcceptRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "StepRunGet")
+		handler = middleware(handler, "TenantInviteAccept")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(StepRunGetResponseObject); ok {
-		return validResponse.VisitStepRunGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(TenantInviteAcceptResponseObject); ok {
+		return validResponse.VisitTenantInviteAcceptResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// StepRunUpdateRerun operation middleware
-func (sh *strictHandler) StepRunUpdateRerun(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error {
-	var request StepRunUpdateRerunRequestObject
-
-	request.Tenant = tenant
-	request.StepRun = stepRun
+// TenantInviteReject operation middleware
+func (sh *strictHandler) TenantInviteReject(ctx echo.Context) error {
+	var request TenantInviteRejectRequestObject
 
-	var body StepRunUpdateRerunJSONRequestBody
+	var body TenantInviteRejectJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.StepRunUpdateRerun(ctx, request.(StepRunUpdateRerunRequestObject))
+		return sh.ssi.TenantInviteReject(ctx, request.(TenantInviteRejectRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "StepRunUpdateRerun")
+		handler = middleware(handler, "TenantInviteReject")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(StepRunUpdateRerunResponseObject); ok {
-		return validResponse.VisitStepRunUpdateRerunResponse(ctx.Response())
+	} else if validResponse, ok := response.(TenantInviteRejectResponseObject); ok {
+		return validResponse.VisitTenantInviteRejectResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// StepRunGetSchema operation middleware
-func (sh *strictHandler) StepRunGetSchema(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error {
-	var request StepRunGetSchemaRequestObject
+// UserUpdateLogin operation middleware
+func (sh *strictHandler) UserUpdateLogin(ctx echo.Context) error {
+	var request UserUpdateLoginRequestObject
 
-	request.Tenant = tenant
-	request.StepRun = stepRun
+	var body UserUpdateLoginJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.StepRunGetSchema(ctx, request.(StepRunGetSchemaRequestObject))
+		return sh.ssi.UserUpdateLogin(ctx, request.(UserUpdateLoginRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "StepRunGetSchema")
+		handler = middleware(handler, "UserUpdateLogin")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(StepRunGetSchemaResponseObject); ok {
-		return validResponse.VisitStepRunGetSchemaResponse(ctx.Response())
+	} else if validResponse, ok := response.(UserUpdateLoginResponseObject); ok {
+		return validResponse.VisitUserUpdateLoginResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// WorkerList operation middleware
-func (sh *strictHandler) WorkerList(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request WorkerListRequestObject
-
-	request.Tenant = tenant
+// UserUpdateLogout operation middleware
+func (sh *strictHandler) UserUpdateLogout(ctx echo.Context) error {
+	var request UserUpdateLogoutRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.WorkerList(ctx, request.(WorkerListRequestObject))
+		return sh.ssi.UserUpdateLogout(ctx, request.(UserUpdateLogoutRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "WorkerList")
+		handler = middleware(handler, "UserUpdateLogout")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(WorkerListResponseObject); ok {
-		return validResponse.VisitWorkerListResponse(ctx.Response())
+	} else if validResponse, ok := response.(UserUpdateLogoutResponseObject); ok {
+		return validResponse.VisitUserUpdateLogoutResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// WorkflowRunGet operation middleware
-func (sh *strictHandler) WorkflowRunGet(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error {
-	var request WorkflowRunGetRequestObject
-
-	request.Tenant = tenant
-	request.WorkflowRun = workflowRun
+// TenantMembershipsList operation middleware
+func (sh *strictHandler) TenantMembershipsList(ctx echo.Context) error {
+	var request TenantMembershipsListRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.WorkflowRunGet(ctx, request.(WorkflowRunGetRequestObject))
+		return sh.ssi.TenantMembershipsList(ctx, request.(TenantMembershipsListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "WorkflowRunGet")
+		handler = middleware(handler, "TenantMembershipsList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(WorkflowRunGetResponseObject); ok {
-		return validResponse.VisitWorkflowRunGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(TenantMembershipsListResponseObject); ok {
+		return validResponse.VisitTenantMembershipsListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// WorkflowRunListPullRequests operation middleware
-func (sh *strictHandler) WorkflowRunListPullRequests(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params WorkflowRunListPullRequestsParams) error {
-	var request WorkflowRunListPullRequestsRequestObject
+// UserCreate operation middleware
+func (sh *strictHandler) UserCreate(ctx echo.Context) error {
+	var request UserCreateRequestObject
 
-	request.Tenant = tenant
-	request.WorkflowRun = workflowRun
-	request.Params = params
+	var body UserCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.WorkflowRunListPullRequests(ctx, request.(WorkflowRunListPullRequestsRequestObject))
+		return sh.ssi.UserCreate(ctx, request.(UserCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "WorkflowRunListPullRequests")
+		handler = middleware(handler, "UserCreate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(WorkflowRunListPullRequestsResponseObject); ok {
-		return validResponse.VisitWorkflowRunListPullRequestsResponse(ctx.Response())
+	} else if validResponse, ok := response.(UserCreateResponseObject); ok {
+		return validResponse.VisitUserCreateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// WorkflowList operation middleware
-func (sh *strictHandler) WorkflowList(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request WorkflowListRequestObject
+// WorkerGet operation middleware
+func (sh *strictHandler) WorkerGet(ctx echo.Context, worker openapi_types.UUID) error {
+	var request WorkerGetRequestObject
 
-	request.Tenant = tenant
+	request.Worker = worker
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.WorkflowList(ctx, request.(WorkflowListRequestObject))
+		return sh.ssi.WorkerGet(ctx, request.(WorkerGetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "WorkflowList")
+		handler = middleware(handler, "WorkerGet")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(WorkflowListResponseObject); ok {
-		return validResponse.VisitWorkflowListResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkerGetResponseObject); ok {
+		return validResponse.VisitWorkerGetResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// WorkflowRunList operation middleware
-func (sh *strictHandler) WorkflowRunList(ctx echo.Context, tenant openapi_types.UUID, params WorkflowRunListParams) error {
-	var request WorkflowRunListRequestObject
+// WorkflowDelete operation middleware
+func (sh *strictHandler) WorkflowDelete(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request WorkflowDeleteRequestObject
 
-	request.Tenant = tenant
-	request.Params = params
+	request.Workflow = workflow
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.WorkflowRunList(ctx, request.(WorkflowRunListRequestObject))
+		return sh.ssi.WorkflowDelete(ctx, request.(WorkflowDeleteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "WorkflowRunList")
+		handler = middleware(handler, "WorkflowDelete")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(WorkflowRunListResponseObject); ok {
-		return validResponse.VisitWorkflowRunListResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowDeleteResponseObject); ok {
+		return validResponse.VisitWorkflowDeleteResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// UserGetCurrent operation middleware
-func (sh *strictHandler) UserGetCurrent(ctx echo.Context) error {
-	var request UserGetCurrentRequestObject
+// WorkflowGet operation middleware
+func (sh *strictHandler) WorkflowGet(ctx echo.Context, workflow openapi_types.UUID, params WorkflowGetParams) error {
+	var request WorkflowGetRequestObject
+
+	request.Workflow = workflow
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UserGetCurrent(ctx, request.(UserGetCurrentRequestObject))
+		return sh.ssi.WorkflowGet(ctx, request.(WorkflowGetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UserGetCurrent")
+		handler = middleware(handler, "WorkflowGet")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(UserGetCurrentResponseObject); ok {
-		return validResponse.VisitUserGetCurrentResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowGetResponseObject); ok {
+		return validResponse.VisitWorkflowGetResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// UserUpdateGithubOauthCallback operation middleware
-func (sh *strictHandler) UserUpdateGithubOauthCallback(ctx echo.Context) error {
-	var request UserUpdateGithubOauthCallbackRequestObject
+// WorkflowGetConcurrencyQueueDepth operation middleware
+func (sh *strictHandler) WorkflowGetConcurrencyQueueDepth(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request WorkflowGetConcurrencyQueueDepthRequestObject
+
+	request.Workflow = workflow
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UserUpdateGithubOauthCallback(ctx, request.(UserUpdateGithubOauthCallbackRequestObject))
+		return sh.ssi.WorkflowGetConcurrencyQueueDepth(ctx, request.(WorkflowGetConcurrencyQueueDepthRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UserUpdateGithubOauthCallback")
+		handler = middleware(handler, "WorkflowGetConcurrencyQueueDepth")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(UserUpdateGithubOauthCallbackResponseObject); ok {
-		return validResponse.VisitUserUpdateGithubOauthCallbackResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowGetConcurrencyQueueDepthResponseObject); ok {
+		return validResponse.VisitWorkflowGetConcurrencyQueueDepthResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// UserUpdateGithubOauthStart operation middleware
-func (sh *strictHandler) UserUpdateGithubOauthStart(ctx echo.Context) error {
-	var request UserUpdateGithubOauthStartRequestObject
+// WorkflowCreateConcurrencySimulation operation middleware
+func (sh *strictHandler) WorkflowCreateConcurrencySimulation(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request WorkflowCreateConcurrencySimulationRequestObject
+
+	request.Workflow = workflow
+
+	var body WorkflowCreateConcurrencySimulationJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UserUpdateGithubOauthStart(ctx, request.(UserUpdateGithubOauthStartRequestObject))
+		return sh.ssi.WorkflowCreateConcurrencySimulation(ctx, request.(WorkflowCreateConcurrencySimulationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UserUpdateGithubOauthStart")
+		handler = middleware(handler, "WorkflowCreateConcurrencySimulation")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(UserUpdateGithubOauthStartResponseObject); ok {
-		return validResponse.VisitUserUpdateGithubOauthStartResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowCreateConcurrencySimulationResponseObject); ok {
+		return validResponse.VisitWorkflowCreateConcurrencySimulationResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// UserUpdateGoogleOauthCallback operation middleware
-func (sh *strictHandler) UserUpdateGoogleOauthCallback(ctx echo.Context) error {
-	var request UserUpdateGoogleOauthCallbackRequestObject
+// WorkflowUpdateDiagnostics operation middleware
+func (sh *strictHandler) WorkflowUpdateDiagnostics(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request WorkflowUpdateDiagnosticsRequestObject
+
+	request.Workflow = workflow
+
+	var body WorkflowUpdateDiagnosticsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UserUpdateGoogleOauthCallback(ctx, request.(UserUpdateGoogleOauthCallbackRequestObject))
+		return sh.ssi.WorkflowUpdateDiagnostics(ctx, request.(WorkflowUpdateDiagnosticsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UserUpdateGoogleOauthCallback")
+		handler = middleware(handler, "WorkflowUpdateDiagnostics")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(UserUpdateGoogleOauthCallbackResponseObject); ok {
-		return validResponse.VisitUserUpdateGoogleOauthCallbackResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowUpdateDiagnosticsResponseObject); ok {
+		return validResponse.VisitWorkflowUpdateDiagnosticsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// UserUpdateGoogleOauthStart operation middleware
-func (sh *strictHandler) UserUpdateGoogleOauthStart(ctx echo.Context) error {
-	var request UserUpdateGoogleOauthStartRequestObject
+// WorkflowUpdateLinkGithub operation middleware
+func (sh *strictHandler) WorkflowUpdateLinkGithub(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request WorkflowUpdateLinkGithubRequestObject
+
+	request.Workflow = workflow
+
+	var body WorkflowUpdateLinkGithubJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UserUpdateGoogleOauthStart(ctx, request.(UserUpdateGoogleOauthStartRequestObject))
+		return sh.ssi.WorkflowUpdateLinkGithub(ctx, request.(WorkflowUpdateLinkGithubRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UserUpdateGoogleOauthStart")
+		handler = middleware(handler, "WorkflowUpdateLinkGithub")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(UserUpdateGoogleOauthStartResponseObject); ok {
-		return validResponse.VisitUserUpdateGoogleOauthStartResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowUpdateLinkGithubResponseObject); ok {
+		return validResponse.VisitWorkflowUpdateLinkGithubResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// UserListTenantInvites operation middleware
-func (sh *strictHandler) UserListTenantInvites(ctx echo.Context) error {
-	var request UserListTenantInvitesRequestObject
+// MaintenanceWindowList operation middleware
+func (sh *strictHandler) MaintenanceWindowList(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request MaintenanceWindowListRequestObject
+
+	request.Workflow = workflow
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UserListTenantInvites(ctx, request.(UserListTenantInvitesRequestObject))
+		return sh.ssi.MaintenanceWindowList(ctx, request.(MaintenanceWindowListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UserListTenantInvites")
+		handler = middleware(handler, "MaintenanceWindowList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(UserListTenantInvitesResponseObject); ok {
-		return validResponse.VisitUserListTenantInvitesResponse(ctx.Response())
+	} else if validResponse, ok := response.(MaintenanceWindowListResponseObject); ok {
+		return validResponse.VisitMaintenanceWindowListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantInviteAccept operation middleware
-func (sh *strictHandler) TenantInviteAccept(ctx echo.Context) error {
-	var request TenantInviteAcceptRequestObject
+// MaintenanceWindowCreate operation middleware
+func (sh *strictHandler) MaintenanceWindowCreate(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request MaintenanceWindowCreateRequestObject
 
-	var body TenantInviteAcceptJSONRequestBody
+	request.Workflow = workflow
+
+	var body MaintenanceWindowCreateJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantInviteAccept(ctx, request.(TenantInviteAcceptRequestObject))
+		return sh.ssi.MaintenanceWindowCreate(ctx, request.(MaintenanceWindowCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantInviteAccept")
+		handler = middleware(handler, "MaintenanceWindowCreate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantInviteAcceptResponseObject); ok {
-		return validResponse.VisitTenantInviteAcceptResponse(ctx.Response())
+	} else if validResponse, ok := response.(MaintenanceWindowCreateResponseObject); ok {
+		return validResponse.VisitMaintenanceWindowCreateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantInviteReject operation middleware
-func (sh *strictHandler) TenantInviteReject(ctx echo.Context) error {
-	var request TenantInviteRejectRequestObject
+// WorkflowDeletePause operation middleware
+func (sh *strictHandler) WorkflowDeletePause(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request WorkflowDeletePauseRequestObject
 
-	var body TenantInviteRejectJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.Workflow = workflow
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantInviteReject(ctx, request.(TenantInviteRejectRequestObject))
+		return sh.ssi.WorkflowDeletePause(ctx, request.(WorkflowDeletePauseRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantInviteReject")
+		handler = middleware(handler, "WorkflowDeletePause")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantInviteRejectResponseObject); ok {
-		return validResponse.VisitTenantInviteRejectResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowDeletePauseResponseObject); ok {
+		return validResponse.VisitWorkflowDeletePauseResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// UserUpdateLogin operation middleware
-func (sh *strictHandler) UserUpdateLogin(ctx echo.Context) error {
-	var request UserUpdateLoginRequestObject
+// WorkflowUpdatePause operation middleware
+func (sh *strictHandler) WorkflowUpdatePause(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request WorkflowUpdatePauseRequestObject
 
-	var body UserUpdateLoginJSONRequestBody
+	request.Workflow = workflow
+
+	var body WorkflowUpdatePauseJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UserUpdateLogin(ctx, request.(UserUpdateLoginRequestObject))
+		return sh.ssi.WorkflowUpdatePause(ctx, request.(WorkflowUpdatePauseRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UserUpdateLogin")
+		handler = middleware(handler, "WorkflowUpdatePause")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(UserUpdateLoginResponseObject); ok {
-		return validResponse.VisitUserUpdateLoginResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowUpdatePauseResponseObject); ok {
+		return validResponse.VisitWorkflowUpdatePauseResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// UserUpdateLogout operation middleware
-func (sh *strictHandler) UserUpdateLogout(ctx echo.Context) error {
-	var request UserUpdateLogoutRequestObject
+// WorkflowDeleteRollout operation middleware
+func (sh *strictHandler) WorkflowDeleteRollout(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request WorkflowDeleteRolloutRequestObject
+
+	request.Workflow = workflow
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UserUpdateLogout(ctx, request.(UserUpdateLogoutRequestObject))
+		return sh.ssi.WorkflowDeleteRollout(ctx, request.(WorkflowDeleteRolloutRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UserUpdateLogout")
+		handler = middleware(handler, "WorkflowDeleteRollout")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(UserUpdateLogoutResponseObject); ok {
-		return validResponse.VisitUserUpdateLogoutResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowDeleteRolloutResponseObject); ok {
+		return validResponse.VisitWorkflowDeleteRolloutResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantMembershipsList operation middleware
-func (sh *strictHandler) TenantMembershipsList(ctx echo.Context) error {
-	var request TenantMembershipsListRequestObject
+// WorkflowGetRollout operation middleware
+func (sh *strictHandler) WorkflowGetRollout(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request WorkflowGetRolloutRequestObject
+
+	request.Workflow = workflow
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantMembershipsList(ctx, request.(TenantMembershipsListRequestObject))
+		return sh.ssi.WorkflowGetRollout(ctx, request.(WorkflowGetRolloutRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantMembershipsList")
+		handler = middleware(handler, "WorkflowGetRollout")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantMembershipsListResponseObject); ok {
-		return validResponse.VisitTenantMembershipsListResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowGetRolloutResponseObject); ok {
+		return validResponse.VisitWorkflowGetRolloutResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// UserCreate operation middleware
-func (sh *strictHandler) UserCreate(ctx echo.Context) error {
-	var request UserCreateRequestObject
+// WorkflowUpdateRollout operation middleware
+func (sh *strictHandler) WorkflowUpdateRollout(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request WorkflowUpdateRolloutRequestObject
 
-	var body UserCreateJSONRequestBody
+	request.Workflow = workflow
+
+	var body WorkflowUpdateRolloutJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UserCreate(ctx, request.(UserCreateRequestObject))
+		return sh.ssi.WorkflowUpdateRollout(ctx, request.(WorkflowUpdateRolloutRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UserCreate")
+		handler = middleware(handler, "WorkflowUpdateRollout")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(UserCreateResponseObject); ok {
-		return validResponse.VisitUserCreateResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowUpdateRolloutResponseObject); ok {
+		return validResponse.VisitWorkflowUpdateRolloutResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// WorkerGet operation middleware
-func (sh *strictHandler) WorkerGet(ctx echo.Context, worker openapi_types.UUID) error {
-	var request WorkerGetRequestObject
+// WorkflowListSlaBreaches operation middleware
+func (sh *strictHandler) WorkflowListSlaBreaches(ctx echo.Context, workflow openapi_types.UUID, params WorkflowListSlaBreachesParams) error {
+	var request WorkflowListSlaBreachesRequestObject
 
-	request.Worker = worker
+	request.Workflow = workflow
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.WorkerGet(ctx, request.(WorkerGetRequestObject))
+		return sh.ssi.WorkflowListSlaBreaches(ctx, request.(WorkflowListSlaBreachesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "WorkerGet")
+		handler = middleware(handler, "WorkflowListSlaBreaches")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(WorkerGetResponseObject); ok {
-		return validResponse.VisitWorkerGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowListSlaBreachesResponseObject); ok {
+		return validResponse.VisitWorkflowListSlaBreachesResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// WorkflowDelete operation middleware
-func (sh *strictHandler) WorkflowDelete(ctx echo.Context, workflow openapi_types.UUID) error {
-	var request WorkflowDeleteRequestObject
+// WorkflowRunCreate operation middleware
+func (sh *strictHandler) WorkflowRunCreate(ctx echo.Context, workflow openapi_types.UUID, params WorkflowRunCreateParams) error {
+	var request WorkflowRunCreateRequestObject
 
 	request.Workflow = workflow
+	request.Params = params
 
-	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.WorkflowDelete(ctx, request.(WorkflowDeleteRequestObject))
-	}
-	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "WorkflowDelete")
-	}
-
-	response, err := handler(ctx, request)
-
-	if err != nil {
+	var body WorkflowRunCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
 		return err
-	} else if validResponse, ok := response.(WorkflowDeleteResponseObject); ok {
-		return validResponse.VisitWorkflowDeleteResponse(ctx.Response())
-	} else if response != nil {
-		return fmt.Errorf("Unexpected response type: %T", response)
 	}
-	return nil
-}
-
-// WorkflowGet operation middleware
-func (sh *strictHandler) WorkflowGet(ctx echo.Context, workflow openapi_types.UUID) error {
-	var request WorkflowGetRequestObject
-
-	request.Workflow = workflow
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.WorkflowGet(ctx, request.(WorkflowGetRequestObject))
+		return sh.ssi.WorkflowRunCreate(ctx, request.(WorkflowRunCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "WorkflowGet")
+		handler = middleware(handler, "WorkflowRunCreate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(WorkflowGetResponseObject); ok {
-		return validResponse.VisitWorkflowGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowRunCreateResponseObject); ok {
+		return validResponse.VisitWorkflowRunCreateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// WorkflowUpdateLinkGithub operation middleware
-func (sh *strictHandler) WorkflowUpdateLinkGithub(ctx echo.Context, workflow openapi_types.UUID) error {
-	var request WorkflowUpdateLinkGithubRequestObject
+// TriggerLinkList operation middleware
+func (sh *strictHandler) TriggerLinkList(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request TriggerLinkListRequestObject
 
 	request.Workflow = workflow
 
-	var body WorkflowUpdateLinkGithubJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
-
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.WorkflowUpdateLinkGithub(ctx, request.(WorkflowUpdateLinkGithubRequestObject))
+		return sh.ssi.TriggerLinkList(ctx, request.(TriggerLinkListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "WorkflowUpdateLinkGithub")
+		handler = middleware(handler, "TriggerLinkList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(WorkflowUpdateLinkGithubResponseObject); ok {
-		return validResponse.VisitWorkflowUpdateLinkGithubResponse(ctx.Response())
+	} else if validResponse, ok := response.(TriggerLinkListResponseObject); ok {
+		return validResponse.VisitTriggerLinkListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// WorkflowRunCreate operation middleware
-func (sh *strictHandler) WorkflowRunCreate(ctx echo.Context, workflow openapi_types.UUID, params WorkflowRunCreateParams) error {
-	var request WorkflowRunCreateRequestObject
+// TriggerLinkCreate operation middleware
+func (sh *strictHandler) TriggerLinkCreate(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request TriggerLinkCreateRequestObject
 
 	request.Workflow = workflow
-	request.Params = params
 
-	var body WorkflowRunCreateJSONRequestBody
+	var body TriggerLinkCreateJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.WorkflowRunCreate(ctx, request.(WorkflowRunCreateRequestObject))
+		return sh.ssi.TriggerLinkCreate(ctx, request.(TriggerLinkCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "WorkflowRunCreate")
+		handler = middleware(handler, "TriggerLinkCreate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(WorkflowRunCreateResponseObject); ok {
-		return validResponse.VisitWorkflowRunCreateResponse(ctx.Response())
+	} else if validResponse, ok := response.(TriggerLinkCreateResponseObject); ok {
+		return validResponse.VisitTriggerLinkCreateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
@@ -6027,125 +16843,503 @@ func (sh *strictHandler) WorkflowVersionGetDefinition(ctx echo.Context, workflow
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9WXPbOLbwX2Hx+x5mquQ1SU9fV82DE7vTnnHkXMnu1L1dLhdMQhLaFMEGQDuelP77",
-	"LWwkSAIkKEuK3NGTF2I5ODsOzgG+hRGeZziFKaPhybeQRjM4B+LX088X54Rgwn/PCM4gYQiKLxGOIf8Z",
-	"QxoRlDGE0/AkBEGUU4bnwa+ARTPIAsh7B6LxIIRfwTxLYHhy9PbwcBBOMJkDFp6EOUrZT2/DQcieMxie",
-	"hChlcApJuBhUh2/OZvwdTDAJ2AxROac5XXhaNnyECqY5pBRMYTkrZQSlUzEpjuhdgtIH25T8/wHDAZvB",
-	"IMZRPocpAxYABgGaBIgF8CuijFbAmSI2y+/3Izw/mEk87cXwUf9ug2iCYBI3oeEwiE8BmwFmTB4gGgBK",
-	"cYQAg3HwhNhMwAOyLEERuE8q5AhTMLcgYjEICfwzRwTG4cnvlalvi8b4/g8YMQ6j5hXaZBZY/B8xOBe/",
-	"/H8CJ+FJ+P8OSt47UIx3UHDdopgGEAKeGyCpcR3QfIIMNGEBOZt5AMA7n/Kmi4V79FM1VnUGMYr8tUku",
-	"mmcZJpwofFAa4EnAIYIpQ5FgI5Mwv4f3gKIoHIRTjKcJ5CstMNhgkgaqXGBfcPkiQAtVjVYpZw8Lsz3N",
-	"IJtBxeKoHILzmuoU4FTIBUopA2lk8NQ9xgkEKQdCMJsVN/wLR4gcooSxKTudzKo4Wi/GwSEjSHFOImjn",
-	"lIhALj2nzA4tQ3NoyB1RYwVPgAaqawXy48Pj472j472jN9fHhyeHP528/Xn/559//t/Q0IQxYHCPD2xT",
-	"AsihAVAskWYAMQhQGtzcXJwFamgTkPv746O3Px/+Y+/47U9w7+0b8G4PHL+L994e/eOno/gomkz+C5pA",
-	"5TniK5mDr5cwnXKOf/PTIJyj1PyzAW2exctiLwGUBar/KlFY4xGxqpLIJsgOfrnGD9AmMl8zRCC1LfXL",
-	"DEqROP18ETDePVCt973pPocMxEByaIfWqjC0U9aua7JWwLZfJfPxu3ddOCxgGxQiVyDDisQoghm7SB8R",
-	"gyP4Zw4pa+ITic8Ssz2Ztg+TDsKvexhkaI+7J1OY7sGvjIA9BqYCikeQIE6X8KRY8UCIwqLBSBJe23o/",
-	"CPbSrONcsZ1Op5JK0q94EZnE+D7w0QynFDYBZJrzm5xUAasdDDmKG47PeZIoHP1C8HzMYDbKLQJ3T0Aa",
-	"zYYKae1zGm1vi4nGw7FhBJ1kYThD0SlxLXwO/oPTQMtcwOcI/nY6Gv5dC9Z4OA7EGE3ULMF8c5T+82gw",
-	"B1//efzupyYXFsC68XsNU5B2SR+cA5TYVyw+6cXllDsDOJDcv5IVyqnFwnACu/SdXM0nOL+HZMTbN9xD",
-	"MZwarAsrPWWzrkOZGGQVWBDLoEk+tU/Kv6x+0oHafAg5WTi8KQGUDY/njzC1YO4BPtvX8ACfC60Ged/9",
-	"Fds9iRg/BirbX8R2cC/Oqgivb63Uxsu5kCdMHiYJfhrl6TifzwF57oJMIPRLs1uL+eXINhZyq8lyBmy+",
-	"rcZrc7H8S5U4wd/+Nb4aBvfPDNK/dyt5MXQx/b9fxgN6jEtkE80MTFFa7GPaEPq5aFnYOKFlnvx3pcVy",
-	"mlstDei2QNkC4hWJIXn/fIYIjDRIMM3nnHKA8q0mJ5Uh5TVaqP6/6ICE7lv60c6uYwhINLNuXV383sDl",
-	"BCDr5lSo45xbAi6qslVA8rTqZrvjTBlMYw5Lx8CqWZ+RSZ6mHiOrZn1GpnkUQRh3o6No6D8655ePkCkP",
-	"7AxNJm7fMEaTiT+DGkN2xnfkyFyXfBTb/tMsu0gpA0niCF6AKMJ5yu7AI2CA3OUksbKbbpbaPchBiIxZ",
-	"7ihkDKVT6hxuaUPl1uZuAGrQD2xrttloicH3wht2edQtCKF3MZyAPGHG5yKoY3W5NXxGVzdcI5jhJlQE",
-	"ZtgNk/iKn1JIuncBRtuBMawNoH/hewuPt8Whhdk0ItHKWfgD3++vaT/fGJMymPWTwabwVd2gZngRzSHO",
-	"mX356mPX0h8hoQin1hncwlCAZQ5QBBzk0h2UtG4fI5BGMEl0cMovClN0Kg5E3E1GEFDJKJZIfororN/U",
-	"f0iObKMoZ1rZ0kG9FzAdgbQq9yWGKQOE9VsMZYDl1GM93A2QbRV/j/K0t5lZgsujB0jaRaDPcg3fvwtk",
-	"w/+p9VxeXqqDaAYpqOCWmnFBJu3hfT4fnl0MP4aDcHQzHMrfxjcfPpyfn52fhYPwl9OLS/HLh9Phh/NL",
-	"/rvNFbxE6UOp8ylimDw7995TxHir0mo1NQ8pRgmk3bEqHjXQ0LmXN4bheqVtkCttclpHEcbGOoxp211b",
-	"Tgs4veLvjQhlZcoqPmoLG9SwbuMRvtGxHyb5HvDVu1rkVE0iIpPU7X5udHtVHAJYd1gcYqunui3g293o",
-	"LjfcAFHN5+IJ08mElUX3AE/xnYMjDN2x5PjC13SMbkSg22hmtPKe3Bi6G+PmBLcKtmrQmn5nVqpCsyoe",
-	"wtNLlMJeZ7FcXYrP3PXmtlg7oQmeBglKYZ+TNpkTYp2DD6cadLr1rt6yhdFdL72GLfNUskxUKWa4LVF1",
-	"CR9hYprps/P3N9w0Xwx/uQoH4ZfT0TAchOej0dXIbo+NcYqojhcHVCCwyZP6/v2DYpqt7EpbfnxBYKw6",
-	"Qs/QmOrcEhyzIMA8Gv0WRjkhMGV3meDd40GYwq/6rzeDMM3n4g8anhwdLgZ1yap0th3VqxZBJrmwmPjY",
-	"K0plwGLN+YBfmyO/8Ru5XJc1wwAzkJixO95UhJwTRJk8Jikz0g59gmIWjWVq9TY78R5QWLqxDRobLX+F",
-	"IPZreXFmtDCDmWWToVh+ZzPu7cMeBky2r45xjVjiDtRIZ3bYFsuRTa78Azpmh8YsdUxZYLVhykWKgYOY",
-	"FjTeVtmiwK3WBziD3AhGCaaV1KQSGyPI2evHyZIYwSwBz+IQwH0szb9exFWlv+mkpvZsRA3hrVgS4Zto",
-	"EYRoIWGW2wIrDczxZnzUmtNlGXAKKbshjvP7m9FlwHBAYRqL3ATlWtCA4fWcwLq2t3mK/sxhgGKYMjRB",
-	"kBRHgeqEVWWGyRQKM9nwHiY4nWqI6+RsEmx9GRx+AZjWrAwRh7UcYmjiNiOMM5TEBFZ32h1cuqaoYAaI",
-	"Thj3h4RAEIP7BLrDHvJ7kdMIA8pgZmXOlQWrHTO4yWusokJrHVxTBJS+z0XsJP0agtOn7DzDFc/BMPgr",
-	"CmEvx4TQOecyIfGyT8t66+q1ElH3CMiq84Oi/eqFCOfMBeKS8vVnDnN4OmHSh/JD5soD/LJLC2X8DgGU",
-	"jFRPAXzPtnhbl3Lw0Bx9Vlx0aVnxEyaucwUvO1JwYLGy1iC+ecruystqMjKOuTG24wUTxLehSfcCZCZS",
-	"0d4Y97aErO18Qf12dzoeX3wcfjofXoeDUP4hDhhedP5wXaSGVZGy9ixrV7Lei7P9unOynYl7ZkLo+jJB",
-	"F0VWuNseG7UAcpiN5skvl27a5eUqXxbF9oRB/dmNNdnCfWilRqhkiS/BJZU82ZJWZjZhB+9sQYyvwsp1",
-	"08g3sVO8JwU1HPFxxf7UpOlKVcLLGMo/cZWLXlfrGwqJ7PE5v09Q1MYKYryWjGkT5q0huqLfMkQfKTpp",
-	"I3T1ZXg+4tbm7NPFMByEn84/vT+3B86vCZpOITFO7Ve3z78RlUhe6foryZR30vuG2gSj0zCAOCaQUtNA",
-	"VPS41jhNO8E//AZJ4Yc0yqlUDaKyOjNAg0fVnP8XkSoE+9YSxLXY+hjRLAHPFZuvF95bFVfx4KLMJZ6i",
-	"dPlCjuWo9KK6jgxQ+oSJw2Dqr+3oWwKAYtqFq0akaOHC9QhOEWX85ytCt59n6uDSLaSWrjH0JZqp+OgM",
-	"ZfS12qyGDd+gTl6HypOT2cj2RWyUXVFRxw5CfZR+tdxqBxFIgwwSvj4Oj38QJQHi5IewewjYKWvds5TT",
-	"iaJlClMWgGCme++vtgJ87XtUuZZ9e5AogqkuCKCu8C1vIwKqotSgvBKjHPhl2ZsdW103Q22B4CvOtiYh",
-	"aC/SlnmeJfh5Drt3BXqMs6LHB5xO0LTzHhVH/rrOHd135CQ7mIB/sQ3hhSOVx2wTyf4ZtBsRFyeGtIWz",
-	"6A4wXR5Deo3XwKq8VIJ8P67k4/0mOxbnd6sQOz7uB5zKBJLIUs41hcz4/pHgPLOUwadKvwdPMxTNgilk",
-	"VOAuKrsGU963CIIYjGClTYLmiI0ZAQxOHfWJVH0NGOY2LXjSNzmYs4pxAnF/BYhmMBb6Xm0lZRjy7mJ4",
-	"93l09XF0Ph6Hg/BsdPX5bnj+5Xx8HQ7C/745vzkv//w4urr5fDe6uhme3Y2u3l8MrfvOOfjq1sBz8BXN",
-	"87mR9lKAywpes5aBvTm2Z7xU6K6mriNwYCVkG1c0dNSPkfo9dZWxLZW0ax3NFYwsT1TleMFplgVmXrjX",
-	"efoaSt16pKK7l3xr8JZMi6pfpVEw/8WZlTS6t91ReNFB+YZ9DOFHeN0H9aVanFIv6xTOvTNla7UHukWI",
-	"DMQx4igAyWcDHEZyaFmAPBXzR095olu3my8g8NqKoMyC6OIMtP3wUsYkYfz+ucfg10Yvo/BIeQQ9HQjL",
-	"CC8vX/rNKPtTuKsu9radu7fE+zd8017CubZirMYcGlF9l2TwZ02wHHxmyfDH6WeR8+Dgbd5gzJ2s3JFm",
-	"Ch89NknF/QgqW289aVE9Ob7o1MbG3OG3XMOZYLKaHd2Ltzz2WJ2EsHVhki0+EC5dEztntKTI3CEHsrsm",
-	"VPmmE0eu6Z0rTeKF01L7CvtrkhrebDlXj40Uoh4DF/hZrbmU6t2OvlLj36mNbH80G2arLiuVnagPJszN",
-	"qxH0eEko4yXZYiSupXW59m2FcexLc2rEEOzKQH30UilPRlTL12vVfXqqUA2zxlJloNtudjmD3Gu1Z1UT",
-	"8FT9bNn9gafgf04/XQZx0bC/xqzO4wG0/d7SDXHYD8Al3FOHUU4Qex6Xl/reQ0Ag0Xf/ytt+wxP173KB",
-	"M8ZEImKE8QOCujniGJL/0tGzk7Bx8zPIkLhpaiF2RhNsR7K+ZPv08wXvKutvwup/CyqFR/uH+4eCyBlM",
-	"QYbCk/DN/tH+ofA/2Ews7QBk6CBBj1AF55rzftTBN94qhZQGhT/OebAIQYSX6vtHsS6i3GYxy/HhYXPg",
-	"XyFI2EyoyHe270PMijkrlAlPfr8dhFTfGMUhLBvqMOzvavxoBqOH8Jb3F2slEMTP3YvlzVDbake6wSqX",
-	"K4ALGA6AuDU1YARMJip/p231BbSdy3884j/2xL2c9OBb8ftCaBVMLTgZwUf8AAOQGlfaTjAJgMo8a6Dm",
-	"NEOiZF7mtMju0ucFc8iEifq99V5RcR1SeCK4tJSZAtbQlHYZJ5Aao6LHlqn3Wdw2KPm2iZBxHkWQ0kme",
-	"JM8BEcuLZSaevijgrSRwhFOmdijqHnY+wsEfKs++BNrnbnR1LlyPcs1BwpcM4wCT4B7EASkrzt8evtkM",
-	"GL9gco/iGMqboUreVKzDCXutKKfZs/zf7SD8uqevgxbfCr4qSV7hYOnlHnwTPxcH2vS5JFre3K9vOQRp",
-	"eftglW+L2xOlSHfyq7wrUXCZhV3l5nSTrLo6nivvkbQQu8b+jCD4qARAYkTQYycFFQ1tYKaUARmaaOF/",
-	"yUMV3pfx8D2QZQdmLJ86BeASUeY6AWiateLogXe7qDVdG795XKPSjxGri9wmXjzaDBg3KcjZDBP0HxjL",
-	"id9tZuJPkM1wHKSYBSBJ8JO+3rj0Xr5VHOTfbxcVd6aLXbXsyCZ+snHwbTrbM/+zOBCHd94yUxz1Idgh",
-	"MuKaGh/jYYLjtCE1sF+pNXFd4tNPpCs02En065XomjDVBbphDetC8CKRF//nv+2JM/tF+TcXucXBvbrJ",
-	"yls1FB1a1cL7stVr0wwDn9wHJ5AlqltB7DupvmnWPadq4T/lZjRg46a0fkqw4LadAny9CtBQGatQfgdP",
-	"8H6G8YM7gmPMPU3wPUgC3cWutGTg5qNo+qVo2R3iqjBuRjD/A8bFZDue3SaerQYRJYcAG4d0e9yaAw++",
-	"qV8WXryoill9eFFWQpS82GlE1aBO+/lksPVGPeqdxPzlJKbBx20SM4ftwUpaXBpZ5Dbr8x3jkcWqpHxS",
-	"PdxHEatCn0rt7OOyFIdx28LMHWcpZl6aouOn8hrOGiUPUO1+VveeASRJUGntoqKMvFUartUxtd3N3IvC",
-	"CV8enlRXt03UrnpiNSK0E5nyrSRN6UJSNYHMkjJ1Jv5fv7msQeBxSmVLHwNWvwbNZcioYpBtOQ+TOIob",
-	"yNiZsu9vygo5cDKsFobxcNx2LsGZrikm8vNCn8u5fUA+rz4ea4iIdPh8RKS4bcUuGQW0G42MyIMeeSPS",
-	"UqeC9Xc+DSCOdl7mzsv08jIpg9keyYXxUr8uDuS11HsZcUumfB8zAEGWJ4mmjMr2KLK2GkIra3el4Kp3",
-	"XYmPAOuyYbdxU7Cv28LJO36xTAxaCRO0Pm9r4QsZ+M1yFjCsHhOvUaGBg8Ua/cK+4Fc0jLr+XPiGlRX8",
-	"2DkBfNa3m5l1iLnQ5mnd7ivxrrGVViRFumWb5dcS2a1uYnUVYXtaDppMlH4ptME9ZE9Q1d7OMWX6lgH+",
-	"DaSSryaIUPGffZc6+giZuAzxNemhNUmz413Hfru8WL3fuJPg7ynBXG5iydZrEtsET9sjGbR4Z4XWJLcp",
-	"i+aLIK9EEActT6syHNAHlGnY/swheS6Bw5MJFRE4CyjuFybap5P3Ddw/O6YUn18642kRwUngI0won3eC",
-	"EgZJy8SiZWVm35dj5HMpjpVT8SpKIGYz4Jhg4gBEdugLiHp8xQLEF3ErKQ5EuYB7/dh8+qXn5JVnYxx4",
-	"kNPHxds0rVCcGc2WgaTsv+ZjcEMbdBkfzpJmVindZZTW4piFFjZswSWe9jcD8jPt2hXSAAQpfHJl/csj",
-	"Otk0XOemSk5UPKxm30vpVyX0Zmqjuyd9W2uPfRIrLnj9C/N4HxZXW5WC2TSHK9w2mNzG0WVIsqx5aT+k",
-	"KUpQqF+Ji69j871DlbfrP0OqvdrZb3OhY0s7LV/X8kWdDO1XPHO7GHTE+HrXcxW6/XWw+7rsj+Z1wwKt",
-	"PxRXTrqTr1XJlxKEJavT2g1OeRFDyz4aJIlybisC6KhMey225kfeQD/AZ6/tM29XmdXrggnBBqJMvHmd",
-	"kBsm4x40L9hKXdEbQONCtuVAJHmqCq6hF6y6rffG137/0XcKRgh6fp9QhJh6CwIRJhybCkOU2nQXhHip",
-	"e6rQ4l3T6mM1D4R29DSdUuV6mM9/w+fdbq20IUvxv0D2TgZsMhAok75KOSDildy2mzn4dxqAwpDKjg4J",
-	"0PdxiEF/3F2c5e1hRxBRX/AgXBGi8ba5OKK/oZLA7UyV8x4S8WrFao2VfMaNtifzl6JZefyNOsLnxuNq",
-	"P7idauCjX8Cjhu1dXL1isRq82BVd940qVs+I1AStvL4LKhqHWtWX39qPttSjmX1OuI7WIp1LnHNpxtiJ",
-	"pfW4q5Qbf7n0sFT6H3vyb4+SFlpsqnxE2b+4ZStDlFW5aodtr0DHa7etndKrC3q2V3ptpS0FfVypEFU6",
-	"CrsGmO3dEblr6icJr7yGZQslYfV21/3iqq/dzTWVN51Z4im5Er5XI7mSIP0lt83yzeVDkD33aLqXXcSN",
-	"t5B3e7QqPpbao2ls75xB2x6t5MXV+IK0KwWqVhRKbTWaO+aXaU/j4bhSqe/P/w0s74owt6g+2iUIXuXR",
-	"nZlXHvcE7KIiAgFV+WpNuFodz1Yn9Y5u7C482GKBdkqep0S3WlRLFVVr3aNZ6vgsJddVwfhqt5B/9ZJK",
-	"31roqsersbKro9xUHWWFF58AFTrCVVhZkMfQC/xfnNDLVtW064kDAol6itNxwi9eVQcmaC2XL4jmO52x",
-	"jTkHJE8VqTrCTMUtEPLSa9tyF1uh2HYZB60ZBzKVdeMKpVxT670LslmtfrvFERnLYXeq5fu5I/WnypZx",
-	"PBTdd/7HVvsfmkpr0RpPmDzIJ//cG5QkCWSzjjLIL6LRLhoo6wqWCoLvKrMcLyYpBqxddALJstt0jWhl",
-	"Mc0/u7brlfKUToFQ5SWvefdeWbDzCm0Dg69Yaosn2pcS291u3i65BW763VNU4anl5fkgIx63MZvXoNHa",
-	"JYdWd9hgFz6IcT0e3Yn6ugCs3ERJmTwWcdUCQu/CM4N4Y9Fx/aeFJr8sWcetExUqrLvTP7WTuyp21q6B",
-	"qJczLVr6eQ87h7o0zTuXevWGuZ9MeArBAbfD3pIQiLi2ry+9u/pgW68+MMvk+JxTyArS7jsmFu0v4nBT",
-	"no0/ZLrLSoHb0AbmBYpS4GWnLN27mBcozJxCQg+inBC1FHceKieJahjwbg2NeEMh+QjZBzXYGvmKz9ST",
-	"mQTEu6SX1/MsIWfyGrtpHhfkt7Cxeu4tAklyD6IHJzt/wPNM1g9xzrji8wfWpwP4ROq1QTH0FcflBz18",
-	"jcHfHB53vG0RqXnj5rwzCGKVCZ5gSQzr8Uqhthe9kKlXXJ3UE5+UAeLWDWP+dTlMiq790Sjg+Q5IFOD2",
-	"xCDG0wSuhyPF0FvMkatgQIm+FTNgibitY8CX8ltX0X95O021xrp4zK/TwPMRzDKftb7/1rvK3rgR5ocq",
-	"sfdxH3u9B9xZgu/kvQMQRTBj7qSxU/G9X8Wi7LOme5vl4I0iu0Xvt6vkynel5K2bF4ntzlJyN38RKDJM",
-	"WpIS+fd+/CX7hOvKruODr4C/5Mp3/NWR2saRtAR/JXiKWnJdL/GUBigNgLCN+y0OxqUYaE1lwdwE8/E3",
-	"dL2v1047wdMpjAO0qyrZ4rf6BNf47qQTPMU56xAGnDM/aeBDbQmPclB2TPp6okCSe3zZVlUjz1DWYwtk",
-	"dPLbBpl15aKbOv9ZK4PbJ+2/HzJRtNsTLbMnMjHYzZIETjkNSJu/KlvQVmW61pdr+AQajG1yLDTydjH8",
-	"V+FiaBbqVtcqe1bmxUHik+FqUcQy49Yzk1WO0ZpDJqZ4vendSxyvbpk8bU1ed4+07oFmnQaDy7yTIvXT",
-	"40I7M8PTK/nE/047I92gPYlyoyLwtiPiYd7uVgC4qw76zq+8KmY1OGaZFEZxE4lPWYOXJPSwAtsnBqvP",
-	"uFky1WZnDexZNsuzeIdNOEhQ+rAnD9pbwi0ofQhAIJsFBGaYIoblqy/ABNIuGyoQg9IHefj+qgRl9bud",
-	"EhGjApO+Ve+JgxIbLYL3FnIOrZLwJsQ7M/qdzaiQahsnrUnVMIKm07ZIxLVsoC5JX6qs0P9msG1QMO2J",
-	"uY+QUITT/eBiIrbANOf8AeOBrHUBDFKmGwWIBhPIohmMXdm7qmW49fpRsYFB1T53gtRK1DavFX2qJc07",
-	"33e1ktukFLUO6qjS7LptoIdaVHJJfaustcR7qcTfZONXtDv5K+jENWsYRdRlyxn0one65jvrmkodRcmK",
-	"a3K/tJ45iOEEpUgnh/ZROWXPvtrnrJxzp4f+YnrIoO3LNJLBXzvltI3KySTQ8nqqfvB9DwGBpDj4HliP",
-	"wiF51PoiJ0l4EoaL28X/BQAA//+cwM5yqhMBAA==",
+	"H4sIAAAAAAAC/+z9aXMbObIoDP8VBN8n4pxzg1rsbveZ6Yj5IEtqt6ZtSy3K4zt37HCDLJBEqwjUACjJ",
+	"nA7/9zeQWApVhdooapvhJ1ssLIlEZiKRyOWP0YyvMs4IU3L04x8jOVuSFYb/Hl2cnQrBhf5/JnhGhKIE",
+	"vsx4QvS/CZEzQTNFORv9OMJohWdLysieIDjB05Sgn7GaLYlCRI+DdLd99IYwIugM/pIIC4JeHB4eoizN",
+	"JVJLgn6+urpAUmGVS2gzRrdLmhLbfs4FkhmZ0TkMwRKqZ5e6g1AIK/Ty8PBwNB6Rr3iVpWT044vvDw/H",
+	"ozkXK6xGP45yytQP34/GI7XOyOjHEWWKLIgYfRuPZlwIkmI93hea1NengaMJ4nMAU5B/5kQqDdxsiWY4",
+	"lyRBakmlWewYIF3p9VO2QHiBKZMKSSJuiEApX8gQyNF0+vLF9386/N+9l9//QPa+/w6/2sMvXyV737/4",
+	"3x9eJC9m8/mfSQG0VIKyhYa5BGF9Q4K/AZ4CvtLsR0XDG2I3a0WkxIv4pHwmv6SUXcem1L8jxQFHCZ/l",
+	"K8IUjgAwRnSOqELkK5WqjIwFVct8uj/jq4OlIaC9hNy4/8cgmlOSNuwYfEJqiVUwOaISYSn5jGJFEnRL",
+	"1RLgwVmW0pkm3RJADK8iiPg2HmkioIIkox//UZr6s2/Mp7+TmdIwOnaSdX4i/neqyAr+8/8JMh/9OPr/",
+	"HRTseWB588CNNPrmp8FC4HUNJDtuAzTviMJ1WHCulj0A0J2PdNNv35pHP1KKSLP7v5C1rG/Q1ZKgLJ+m",
+	"dIauyVpaZrrl4nqe8lskcoZwMYZ0vKdZCbMZAekh6YL5PcQSYfTXj78gSdT+aFxZ2zVZl7FcA7wVndC9",
+	"BZlHFnXlSQFpRMapU+ZZxoWmQT0oLFBvAGGKzmDNIR3+YzTFks5G49GC80VKNCzVpRQ8UVtKE9hnWgQK",
+	"7GRIhTSZ5oYIb90uiVoSy9G0GEKzlu2EOCttV8FCU85TgpkGAngrihv9pdjxAsa6qOjkTcvAbjENe3hJ",
+	"JM/FjMQZYyaIFhZHKg6toisSiBlhx0K3WCLbtQT5y8OXL/devNx78d3Vy8MfD3/48fs/7f/pT3/6f6Pg",
+	"sEqwInt64JjM6zqiAiDGiDL04cPZCbJDb3D0FCdoTvVKVvjrW8IWmuK/+2E8WlEW/lmDNs+STbGXYqmQ",
+	"7b9NFFZoBFZVbHIIcgO9XPFrEmWZGyo40wdfXOIFDRx9H12cIaWH20eXRrGQINHgI3wwsm7GM5K44zUY",
+	"Zz9GIeRrRgWRMZx/XBJWnhjZ1vu9CXBFFE6wwj1OixJnNTL9VYXpC6SU6e3lq1cRcHRPmeFZy8Dw+U4o",
+	"96NEES7IDb+OCcuPXliGGF9iiaaEMGT77UcFJADQcHaab5EVHdkp9IJ4rlzDGWZoShDoqlodIzdErBVo",
+	"qOTrjGQKrTDDC/23Hwwooq9eAiwx0ZN1nqaefMZePHt6bWM4MzrwWb7SAwmCNeveCqr0KFp7IEKjEqAf",
+	"fY5s1NFML/aM3VBF7ObX+ZjCZ/2/wcJyiHAcj77ucZzRPX3BWRC2R74qgfcUXgAUNzilmg1HP3rsjUEE",
+	"f6sJMANvFHez69MbwtTrXE7yqaeixqXPciG5iNNccQUCwSzIjAvgDzy7Zvw2JcmClIRIw42r/7pXlP3l",
+	"sL5eC2R0vcmKsp8IVrkgP6V4UV9h68XpozmICJqbIdA8xQur1ci4qG1Sk0LOL40WqEnmYkSQIgwzFZcC",
+	"Toc6vfNEMIlTtRPO/kshfkOEoAlBtGH2fvI6nDaKJTtPQlg7/AZGD5axC0iilBZMlTtADOC49hfOV+xY",
+	"HbUlOPtQ11sa4yHBbwdc6SpD9lTgda93WHMU0yv4K5+GgnFydXrx5fLD+y+Xp79+OP1wOhqHPx1NJmdv",
+	"3sfFox7315zkpL4sPNMIPEvi5GC+ahkBypxUJNO3OH1DMJrdP/WocLDeYmr2k8VpJU2IVMfNSreeDtQ1",
+	"PSHoj5YyTE8/t5mamJn7azcZYQlliyOp75fNuhzLV1Mi9NTFWt3KFNeHLZb2hqpFJDKn037UAGXIvgm1",
+	"5iuiyX6nKusHGhfbFVtRI3HD3m+LrGGwAQR9BdDHzuMFkXo1F2BsaxYhvqHeFkZutZqjRR6VKMNe9+mS",
+	"uCJnxzxnqt8iDdCXvo/fzq7edrXxLQTpVF51CNjndhRuawMdiAN38DJEYBmGOaYpaaDzgqNMq5I5SMY5",
+	"x5J214C2GeLCSINeY4ucsR5j22Z9RpT5bEZI0o0A37DPqIornMZHhE/BuJ2jVYkRhi7QXCAlXMzYbWsz",
+	"WQq6WBARnFiNGujvfNqLNiunXxVyPUwjOB/ggm+INTh7G2HappKHzuaIr6hSJBnD74UOJpEgK35DEoRZ",
+	"EvTR17bBqtCWdLeYRtULr2dOfDViNdtQmsslz9NEn7C9hXplFXbm2Dpe49l1JoiUuSA/09jhf4SWlCln",
+	"s7LqhcOmO6slmuLZNUlQno2R5EgZBgiBlym/JQlK+C2rG6xh0BOSqWUdgLKoKLE0EE6hj5SBMop/qIEZ",
+	"PSUTfEakJElJR2p+LhNEifXRXBExIfohrslEkS/0/pEklGumg55Yw4CmZM4FASAZ+aocmnoCIpckiUt/",
+	"z5cO7cXaGVcIo0xQLqhaw0+zXAjCVLpGgmg6iBtkKjQU7FAMJQF0MTI71mybmjfHCVjIGpAI3+B1AM2C",
+	"Pvvo+Pz98YfLy9P3x3/X5CaJ3mDCEDaqr9QvDERIOESma4Q1BxEBH6cEXi3tqJyZ9c/Wn1hKV1SN0cXR",
+	"5en7qy/HR++PT9++PT2pTFAo2NIBRRI/qkYuuaE8l0XDWxy0HH9ifz0/e/9lcnR1NvnpbODwmlZ+55SZ",
+	"ZljjHFFlnoFJ4u1eehmYJZ/Y6w8nb06vvpz+3+PT05PaXHoabQAjiRWwelDylcxyI3gEgq1F0zxZEDDa",
+	"UiU9z+2Pxv7OFezHaDz6MDm9HI1HV2fvTs8/XI3GoypKR+NRGQmj8agCavSGdpxSwtQxEUo/hGMVuaj1",
+	"sf7OigGc/de9y+q9yihzT2u15gLbIw8zQMZcy2mRCcpUiW9bL1lBpzjhT34+2nv56odwdCfOAmDg3U/L",
+	"UTHDkqAl+br/KAbrAKQoADI3nN8gKOFjdHlb2ZIaOO33zZzRf+YE0YQwPQMRlQO2OPpCGKgHcc7LAty+",
+	"G7UrFoFROLjEWgNOSC1RaVrIsAld5WnDkya+WcCV9COmqvXowjdE4AWJHFzmHNFrhwMWMKFPMrNsLbxS",
+	"rtAtnPFLfEPcx+KgWztRVWYWnhvfA7s2M7Nem5eAPe4hxTu6nx+eGfwY+uBd4WuCBOcrgNifCk5boAIt",
+	"BM+z+F2DSEVXWJHkJDePsnIAWIzc6Lkw02Bo9kuJ7qL9fLgkKLFDArn7idBc8BVgfEUSilnRzPJLMEFC",
+	"kzjYsKJOUBMqFWUzFZ6JBhnGSSGYMD7NNVkXnh49kKJXfU3WWgILIqVe1ExvnTbNTgkiNzjNMVwUwn3V",
+	"l60Sy4ucxeFZ4a/9CH6Fv9JVvmokeHNQ1sk6oHlL4cVR3JO+HYxJX8jidF4HyMooqcW0PoYaUXTZds/v",
+	"O7/xWOkz4z9bVjt4lWNE2SzNwbhRYQbTTSqapmhqulK2aDR1DGHkWwLuNyBpSQPLWSrYbJ0BCcVGpmn6",
+	"62ZYrKIjQjCE+XeuW8oSfhuHQomc6YOvwyrgr2dLnKCV5pDCKu4mMFxsn2g9WvXWeidDSRBn6To0c5u1",
+	"Ne1EYAcwk5yyBmyBBT1niqbV060+eD/lzkw40XvYMiXI9a3MWFElwunD1Yd7ZknenwwFvYYnrmfWMtGF",
+	"gj56JJbESijixlEdJC6o44qOVMeEKSI+gI9mTcWZ5rNr0ml6rgzz2nT6Nh5mIq6Mcslv65bi8UhSZi63",
+	"/WgHCLFv88rGm6ncGGOHDLusHvh87bHnbnVLnovReJTgtSYlQq7jl7I6Jlp2JnID0LTnrTWaOUzr/iyn",
+	"tydXpPWQN8ZgGL5gu8JYJDPClLdmc2ZfrCTIIasO9NVZPT7ikOjvaAYN3Ko9HE7XoRKRVabWxvG7eGID",
+	"pQn+1rauQLzeEMR4OHT8JqZI5t4nSmTWbGZyc4TdeqmRZdNcdaHuDIhsdRMsVT+IAs0BsVdIobLkyHKi",
+	"jAHPr87zpsUwfgePt7F+5ljqG7xU4Hq0jz4akrMkIMiCSkVE1TsLTkJBZoTeEH92fGJ2/GDKsf8KxGLt",
+	"x4Ut0Dr9VcefkpQb66i1IqGU6gM3dBb7xGrwqFyYHXVOjBLVQer2aOvvHqOfERhNx9Y//j1eEaARa9A5",
+	"izhb/MxvkVlcuBOa2WBczWzWl9pfs9wm/e/Lw+U+OiFznKcKTLh/PkQJXsf9YuJ2kyNjNXH2hAfy8ysI",
+	"LcNrvQnSUBrwJnQryMO6o1srIg5GxYJ8Ynpr05uIW+C4eLMBjM6wpQs8mxEpS7qgHNdoks/Lwz0EmWzk",
+	"XygIThBO9Srg/3uwyMvTyZXnjzECjzzXCqdp7TuwuW3wibkPDqmLy4tjPafFqZ5YutFiborDnR4/sQf3",
+	"emx8OauKWplxJiOqnXJOx/XdKvFRh4cIjNIMR82+3OwqWLZB16G6OH23R5gmz6RkKVQcZZTto1Pqb0nh",
+	"Zy6qNkzzMmrWsH+P1mQLGQjAJfnqL1/mXkYyLIrTYsZTzuQ2F7EFS/MGHqVloTCMZqNm10Z60ap0p5EB",
+	"Gun/ZNhfcf1x3WZxGOZU+mK8wl//8uKHPwF/6Nuct8BFDi0WGugsURgly8j1qLlQL4JgrcXkzNx1NUFQ",
+	"luVKUwdOTAAjTpEzfY9RSq+JabI/y6XiKyK+0KTc/Itrvi/IAl4Cz+CNgEp42pRE2dPlmpDMiFMPklf7",
+	"1+am7TzU9kd3P10CtB6+/B7wCq+JE6W5ZrFuuvmYr147dltcQioMVHpsg2e0L2fvv1xcnr+5PJ1MRuPR",
+	"m8vzDxdfLs8/vD/5cnn++izu4mhu5r3toB6MJnW+HeC7UWmdI8soLVYztuzVzKyD3MD7ySIZDHUPwmg8",
+	"Ujyjs8bbrP7WBEqvA9yh5EoPVTvAN4Df8EBCb8iYM8Lnf3EksydytjenjMolMQ4a5pfCz42IPXfdIUmD",
+	"JPYIad7lN1QR/JFMl5xfN+6uIBl/32uHYTik20uquFhvZZcLSfHy1SuroGT8/JY1mQu4/vSgIFWwX8A3",
+	"LpDXvAlv+WJCWTP+p5rMJ/RfpPeTA4RalI5CwhSi+opBUEJSqjXb8uXsxeHhnQRQTK4fHsJ26dMn4Ysu",
+	"9rJoODGtjzmbU5DAS6Wynn11SoCi4zVlSc+Ov+imvZWqlC+QpOz6XoSY/K4nzJPv3FLjzA/Lb6a6wCHy",
+	"I9i8mzV3wVmTWz0vKTuBSVIirAK74aqYzb1igBXTmAs/XB1vBZcAKZCctUY0GjhLho0IcDpkqWbK3Jg5",
+	"yoIDIOxHaXXIAnundbzQ9wv3PhQ4Gj2Aqj82pFFHdzPVncPfE8UFXpCfCEkaqQ4sHb+QBkXQasx60Y1m",
+	"On2Hd/83cMhtIMbdAS4EmdOvdfDO5karBkuHnTd4vDdkX9gQMxjGAmoXszUF28DaQw7W9mWYRJwTkmwD",
+	"tzVpmOVTmU8HQ3+RTyf5tDgK5D/l4DEmv056CNhxQajNVH9xdnaZp6TFtbnJcfevk/P3F1gt61dKSBlj",
+	"b5QZERLuhvqoRc7Z0cQ0ZbkypkOeK/3/7ShAoPr8YDGjCBsibDXciTYg5zavzzXJlHXNDNw5nf5bksLo",
+	"XS7B2QUrlBJ96f/hcPsi+odIFCnsUWS1Lbuep6nd8p8EX03Mu0pEvxOYzZZOw263xwVtP/uJfs2JWNuY",
+	"ieZTnDNGIMhrYoZueGpzrZCBwHH4BZdqIYgEEptiGT4K/lPPb23UJn9RcEpJxQVJEGEzsc60MQadqcCt",
+	"3ThU5JKIwH5mX0iwtZBDOG19PoKT7Qn0wUqOlhtJnnoRCECNCyesLeo1AOB2zkPdQD9vR7Cp36O2h8+V",
+	"Tf3VJnJDun2n2/c+bwLH/60fOYCPOAjFC8bk17cWcZ7QtVsdOndeN3MqpNK3KoNuC7cgMk8V7Eout3Nk",
+	"NutmVYZ3S/NqW4+T65JoIxVliyssr7d3Q6gyj8Ly+r5558R6dBe20YtgEUrkZBxZAJyoIWeF/gY2LEsz",
+	"1um7s6svp387fX8FiwkZqcDpMB6uzWQSkOhVdU1Z2C/7KH+lTR6m+OmJ74UL/Tnbok54C5k5epwmEThP",
+	"/5cgKMvFgiTWWv7ihz8tK2i8+HD55vTLx/PLX356e/5RB71PDD7Ne+KqqnC8WEYR7e5eTW7y7ru9CtRh",
+	"N3D2BW64y3xZawWWbeb7yftJkO6rkfHBwHgkmt4ZV/hfnCEXI4H05qL/Prp8/z+OfCZ6OXqMrZvlfqjL",
+	"RQ9sy7JNbMUJSUnruhOxvswjq/aunNzpMBkXmpGx8/KfggGOGC9t+/4MP0D2R2bem+OOmddNgkNry+6Z",
+	"J3i48REZ0tm89dosIwQPRlu/EYBqHwcVPrnd16A6pcRCt4/egxnTuzuV1oZFcX+Yrm34QUJmdIVTjWtB",
+	"pMsiubVF2eepeoq/kVtoMzm50NbW9EFkhWlDPDZ8ctgCHVlxZNL3bGV9ZmpYG09JvwwE74jen0vdvooU",
+	"M5wdrAsrd3xMqgXv3k1DkGnecCPSX7Y/aS/rGgDVgkejBL9teztIjIn/TJsB4uszT8zFdWDKE/MYrd8I",
+	"nKLts9hSiVZEn1OIM8X3kU7X6T0ToacLT9e/2NntG3VMIxqk7kPO2KdkAO25Nxu46MCn2PLjAXrFbJ1M",
+	"HDTV/tOiQfR8uHzriMIFRYcIrgaSWHevAnQqQz9H/WrpYknD1ehm9nrawxcpAN1APm7xT4LX2u24oII2",
+	"HvgAVvxMLFz+TDLtS26hcdW87SLgzkUz9z1EqQ5xjQQgHOMZgzWR4KByTdZg0CncSZzrHCTSYSUx0J4U",
+	"cVganK6o1LOTstCupnG2SZ4bsRu4PU/y1QqLdRdkQHAf691aQleNFuEX8tmR7etcXsIb7qDssj5a26ba",
+	"CzLK9o8McBTVfhM2M0QlOk260gGazqEclyRB2DpHQbK+SppAy0E9nO9Bxx/oyNGV39WMaVHTKGoqvjKx",
+	"hGjXJDkenDDRhqNhGSKkb+4NPdAFT9NeBBP1jjGeXwCQ9mofQkr3Hkbf7lC0xcD1EkKMXJdI8R538Ed2",
+	"ShoUNm8hHZcotY3erxyzOT+7qP+SDSkJPJh88tXQhynmfHca2u0qim27nCodn+i/9TMamq4Vkf/TrWcY",
+	"PnfT/3K3U9qNEc+xlmlvcp9+oG2fL3xLr08ODcDzy6lTiQP0qUDZAuK5SIh4vT6hwhi3Q/rDcmbzdzaT",
+	"k+3/k6sE4foWEr+x64RgMVtGk+g3Hf53y2jnUgf0kPQDM9sNGHlgXrsBI2+Q36736Jpe3hD1Rjsz/0LW",
+	"0YdXH7l7pPpHnPpOvuZNc5NLgiVn0TaksbeTm0OAou5+v81D2DgMNL0Tg0N7UniLNyQ6h4hoSHXVfzXu",
+	"deiKrgjP1RBE2LDsgV1U3imW7Ov9xDSu6Ba1MdVwyM2J2DBecBuJtuh1zJcH8QuPnfBviLILPqHzebMF",
+	"I6HzeX/RHgzZqamYkfUpHPoo1yG4A31v0695617JmjDpQkvUCZmJxkhw+Gby42hXpRyiNJAqPtkJbw3+",
+	"EGVmbvtSXbfO+IZthpntadYGEW7Sgaq17fahzYYVQ43CYkEUfG5Cz4YJqKKe3yVAo9wGxWmOsuyMSYXT",
+	"xnxUs5kOwP6Cb7DC4os13dWw4pqxuEORzWNuZ/liU4TKxuE2ZrBmhDUDUIF+HFtzMwZfg3NUk4NVC0Lk",
+	"F2uiCj43JXIMByt1bYbrkmQ8HlbRDBN85U6ctNNi0HYcDNsMUKM0NXrnFxsiQJvCfQWZEaacllq0rrAT",
+	"REaaJ1BjHDWzu8w53srBZjSlNk/lOy6VH58KqXrfhEtLO7FBDrEUI/r7l24zlZMJnFm499GEKMTZjJS+",
+	"32LpV9HbMHOnY8vO9cXrkPVlwKeaOalAtEsVOMcO6AgFujV9wW3GoxAPpZmcpdVir7fdaHuMEcicBh4Z",
+	"R0i+k288cTUUPWgteRDx8NGYs/M37IX/areiHyLJTfMrx6Dpu5nF9l8H3NLzDui172ieG5XL6hQNUmW/",
+	"Wbf/Eq9DGkyhGwRvoI6kBSi+ia8ayAU61IxTWnFCE3j7WBCFsO0iSY9s6dR7j4/KkLq/RpWNjxGmLeUx",
+	"oGjNFQDvf3Ho/Z1P7ysZajSrzrBrQ0yO97mBNScU47nqWvoNEdLXMNlEHSwG8EZWs/SGnXxyVopNbBE9",
+	"MvJDBn5o2bB7dzoeZVmRKzB8b8YBs3WFbcCmUBp8M96AymfNRoMNjRDWRNAFcmDsvDcLhSGQVktFCfWB",
+	"Offi9P3J2fs3o/Ho8sP79+Z/kw/HNlX2ePTT0ZlJq12k2I7ZfbWzQaHEm8t6o7PNgirdqriGxDRnNwoy",
+	"F4mo4LEDNRsngmG0XGkbpMUkEYwCmtF+3LRYXNbOks6BHDgDy35CDnhye1o4X/xsLyitzw3xXvWCd6Ul",
+	"lPFbQdS4sosxmtOvJPGauH0TAVW7RvjeTgJZfmSzBe5B32YcPPHnGQ1xLR+QfGSQawB1Wh4D8FpSQerF",
+	"ln015LDyiOabcyMqnvalfZG3hjn7NA9pWxgvQvuJKBqNbXFlWdz2ggETO1Xf557Bj3UeA52ohbHHbQUZ",
+	"Q7SG7hTyKbxKVmDaIiWFNu7HXmoIy3aXWDdyPhXBFgVu64s3RwopLXoAeKZ701kRaCkbjq/7to/+dCi0",
+	"AGaLu2QTazz28iwYW1xYLdfHYy+xBlC/o6NpfbXY/cdeXw2gLW6mTSbw2Eu0YGxzYUW8fNu5ELTqD2zR",
+	"qRvgcILPFrYwVPmxMR/CskX0l6JAH3uNJWC2uMhyXONjr7IMzRaXabzkT79mXKjHXmQIyzaXWESDPPoK",
+	"yzE1W1pg+bGJxgT+ICg7X0Z7n7h88ZYyMig2oVSEO4GUt0UutZQyMsStXLpiFvU59HC2QedrRlNv0yIS",
+	"3FBBUBgj4IAKZvhcoOotuSFpaJ08OX39QVskz97/dD4ajz4eXb4fjUenl5fnl3EzZDCO91ztq0wWEMSU",
+	"e/v98R1/HVnFbUvm4x2cf8sjDHT/tZ1bHICd0v5g2RujxpuESBXsWO3FXhSxHm7gIgwds7X1Gounkugs",
+	"axwOPefiFoukSH0fSZoYxLbrV/hckO6aa4WvicGP9UGhUJ2zqfjSBpkgtRXtxD2+NgbPlMxxukvxkB5b",
+	"dz8Bp8c5HeLaUfbDWQeRVbOU4CAwEYrmgjO1lPM8jRHTA4bvNKfR3KKDoZtkmG/hkLAZm1AjZL2CV8YB",
+	"/wdU3nCs1vOf1j1MMtoYkaLz+gfpH2xgneKazxONie2ld5JE3NDGKsTmY7DPspxs1kZWN3i9NhUIsJhB",
+	"ukV5PJthdvnP/RlfdftuWhy2bEKQSLa2A0uCE3sJjKcS+iPmv1NKYGNGqEp4cJYyYfc23N6kFiJfsa59",
+	"Cf/HuVpyQf9VzXFRrEB2uwvX6YMuXCAgzGtC+W307v/d+9ls196ELpip/m5wEN2/HhHlU5u6MDjsNBIy",
+	"DuW/Fa+Pu0H0voajFrXf5D8aCv9AKdBkoN+NvxuNRydHV0cn52+a1INSRt6Y6y6R8heybhJopgFwL03q",
+	"G2QqpNgTpagAtRVWbqs0Zr61b5uGTRG2pX0DkAhLMk6bI/TNVw0IZmjy3Z4+lbCi09TLnrJ8gKI0Hyel",
+	"nobcF3dMFcNXVEHdMUtv8JIdTQt7VeR6tbMb8tN7LhscMBeNDlzmmxtpyxRhxMSRo9lWWRIQ7gNSbdUn",
+	"21fuMygblxiuvqCYCKibpJ9KIuyHymf9EDpfHbR71f4imBgaY9KR1q0ZliDhW8vm4yxLKdmmWhpAPN4g",
+	"T3f96SKSQ6XjIuhSQkOVd0g/EL/1PVCO7wH3Sw3tsKvlAycCv2s+700vlxox/S+WuvV+09320pRibL5V",
+	"M26ddbSJPrhb2xqOQY13l3f8iSSkiKdB1ycqDBYfACJkEReVtBuA6TImsCBlHNyr7AQGvu9bs0VMIArC",
+	"u3PrfTlO4oH2Pvl1MhqPLj68nnx4HVXb29PGx8zbgDWcyr/KJk0AMk0EksvdhW0gW11J0s+NkGg0zHdj",
+	"zcnShmnyzNb+oqDxejWWSiQbrtEDVGh0kU8PJvk0okabXJGQ/9u0QQTqV3FRV7DfcL5Ii6G3q1XLSmqd",
+	"SPlAC2CEi94cTyKcpBFv+cjmxMwEByF9sFrv2f8fhMPBh21Xsaprs6W19qL8olTB1m+eVt4AhZrEpQVx",
+	"Ps5179dJr/vekksV5GbPCcpFul2ihGEbo49z4VN3Tn6dWBgMfiff2QCsOlWCJWirJpAhF0kA8sndI++f",
+	"Bisc6Df2TjdK5yGzzZwFj1spRAMAWao/uLL7kf0Maq+DFu0SXeeZYU0qTVF5KME+JYS5zNcDAkQfsOJI",
+	"PM3clnQskafkHnSsIbVKIi+3P/4xsjZn68QuCFNfMnh0fzkeaWXf/vXdeMTyFfwhRz++OPw2rpB6uXMV",
+	"WTbhn26BMvN87id+2ctbPYAlNrj+XBv5u34jF+uKjay4wml4cdRNYZ9TqOe/CGd8cdivWn9kc3LZUlIZ",
+	"Ivji5UmDTOSmmdFGK8ypeQGEHVSNdSmcIBlIhnPZmIrcpjx9TZb4hnLRJchgGclVpVPzimtNI8vDCi1x",
+	"lhFmTnzTQRqFNoNBCguM5j6Tuhn9dnn619Pjq9+QIOZCHqSktRn/f3v94aefTi9/s1fxcuJbg71prsy1",
+	"3l7i1ZKsgswAtXlNNWeZr4yYczcUA8toPDIzRi8pF42RUc22Fw9AJvgNlaBcACxYSJTwW+YMDnrkMOMt",
+	"4A9uJL7IOjeNdbZeknxiRajXPrpwo/sJZSUhLlASLo2oUcEzAmErgpj/aeDyTMv8xGyBhlTD+YkVI8NY",
+	"VP2XNj5wWUbkxeX5384mZ+fai+bq9Ojy5PxjvJxv6Aja5lr6GktSxPFFTkHfUj/m9Wt5dhK0CFO3FU1M",
+	"8vvOZjrckQzweTXty2NcUZU2Z1gwW/wer7qanPdPURJ2qM1SxVQE1himmrZi3LCZETR+LpOFx62jLU2i",
+	"o/HIEF2UqEoOudsvjtO7slQfH51KvS7OII2zm/I+zbTDS09tYq4UeZ/cJmCGzFlrUlwDnBkUswexMj5S",
+	"0SyrR/VDCJCNbt4fI3epqHWfFk6LkPszclbqba1Mco+oqbPYgJiSXtv0UqT75ZfL84+j8ej9+fsvp+8u",
+	"rv4elVJll/rHq+HVV0rp8YYKqedf42v8AKW9HlGowqY+qEy9Sx2znoKxoNRhcnH7Nc46S4bdnyzVONjy",
+	"23rPkmnjbVdK6/NwZUV6XwFe58NQgtdB19EAXgY0iHM9dkdtKVMrqmTQGU2nL198/6fD/917+f0PZO/7",
+	"7/CrPfzyVbL3/Yv//eFF8mI2n/+ZDEzgsYltOqcRw6uFN47BLMVriMNvrxl+lpRjDgavvEosA1OZtAbV",
+	"eAg/+yUFiXRa9rGjfpN3aNMjQrII7s4TFW9k+MiZ2eOupAoLX7q4iUnBpKS4sYQE45sHahQzCRfpvCkL",
+	"eliI4N7AM1q40JvP408Mg0h0HhNUOEv1GJGvM5IpK52g6jDJjCXBzi+tuZeqMnaMvy00LzTOLnEBeydy",
+	"ZtM2dW9beyCRaaYpohIQGBlwQaTqSngL7t66tKJ7JYk+KN3tWN3WuaHBpMWSN6qucT8FKPudCq1FJSdW",
+	"Y00+lvNqNVBJm9ZaG/sue2cuEkct2fHKTovFQUzTFE0DC19/Zad82Dd+bjQ51RKMteZuDay1QZZLZ46Y",
+	"rq2UsPvjlNslluZFqrS+RlD+tlF+wJJ/XmnZsZHD3epLYk8gmC9K+bHDsb4gRbK2xKq13ZgtaZoIUs6/",
+	"1XEqt+Ue/J1T9mvORb6KU1n45iMCexcUCrZHW6CfF6ff2AvBXz+cX354h/RMSCqBFVk0BPTpJhPbIn5L",
+	"WOmwPQdJDxg4g0vP0du3Y3T0/u+ICwvOtk8IC9OwbREEJ1qjbs40Z74HvK7X1nm1uVN+0IYZ2pIN+1WU",
+	"DguXz9BSs7k0nCVxxja6xbbzgR6p04yX3ioCattS1lDfZuLdHltTtJnmxjPZ9NiYrbdbVqXo04K05tor",
+	"v/uMrt3JQ4v2DQSro+gao9ZlSKqgxcbqO0BDLIit3WlOt7vFZm+pwExttRsKj02qzWw9YaxQHRSzYcUZ",
+	"aU/GPrmSpb+x1SHMp5NBAPj2fWRqajND9Bj4yjXfLFet79KCacVTIrAiyev1XzllHRELxttYn4izQCRV",
+	"WQvyLLpxx2hKZjiXBFEFKiZnCNvUrsYXwR7yJLNqwgzKwEulNenyA0dgzG4pBtTrVuIliaeE1iS6dkOO",
+	"4Fh6R5SwFQtjOljD3oN3c2cdr4ohwBiYFWEzah2zfF0p597ew1MnoTLTLl49ye5iiSV5C7OaI+MrmeV9",
+	"1OKG/hkRkkr9J9lwBJBaG/aVKVcfMVUbda/GRc98hlyznQ60YJoA3SHqymhoozEFTrR1Sjky/ig8lwib",
+	"NoZ/HM2Mw0qoYrakN+5YKzHnLRQHUIJalyZieWxX+W07B7PG7fq4mdnhOwLyqQlOv1tug/UbEFgIodKL",
+	"/1lfw3F1pMP9pvoRw49ooMT7Kd0Wf8UgLtGvwCUMDLpVFHCXtqGb2eL2gGH3+dKA/W/yUO4NLxiXis7q",
+	"+DlCkuFMLrnz7u9k/RnOVC5C1nddCqsyFy5qrTicZcUmhCWS8C5gnFMSD6SsS4sdx26TY0XOrgSekQtQ",
+	"8eMwffzuGCndyNwDPFwZduY8Z8qrWvv20Xtbh57OYQhwaMUSJVTCU12xJkcHbXXu7kW8bKojbwFrjnva",
+	"ZdATFmmFNNmeVCvGHCjY5vM6ANfG9aPO3Dyhc0riVMEF1SbXtFvfvwa/Id8+GPdzAdlTMAA3VTRpQWjj",
+	"zYPxY5zhGVXrttJa7lYBgSXBVQNTpYUApM/xpEvVEmE0F4QgrduOA6dFxpW3kLgXyuKWkuvQF/3bGhpj",
+	"KemCDTCl2LW+90tyq44ZNDY5okuXuMigktqbSh9xVg1NhL5jl8g/2JcWlq0v9V6vllbqmSGDbe1FBz2v",
+	"nHasiUNlg2NQcI4awjTUaOWvfugkUiF7xjgA+z6pdV/eSlC2bFDpUlgXG68O48hfkYRiZlkD0kWtaJrS",
+	"IHCqWAbPp2mwBrNvcHv+86v46H9+pZYoI2JGNMORO01TQZVekZm5BSltJYXs/74cTSZnb96/0y46Oonn",
+	"2ZX+8fz9l5NT3eL0/fHfR+ORaXR6ctdSRB4uQfDqNF7a7wjNljm71jxgNEJH/wUpSuhfPA9R5R2rIvfk",
+	"MKNrX/U2IV+bXFUS8rXQMTUc1gvSSlkLs/1UM2mf6f5EohlnirJc87jg0hdMcRVIi8U2JbnxCWTvR1ku",
+	"LQ1uGoSpGCw1PxCNuhIQ42iW2Vay9eSxPQUpGHTQgT4JTc1VGbkOqRKSrGSEJVpCS4UV0dKS/ZdCmeAL",
+	"QWQl4Mcx20/nl+A41xA8VdWiG1/79NfC5VWR4G5TpigEwrKkajDuQtFIgtZEeSXC5maoHHVWYRjCU87k",
+	"9k42r6BIimJmWJlAcn9BIsIcNNRGxbbziLfstU4Zjp3p+VwEYeTVNBg8MBX2W5Gdw8ip6gL0Iqky8XWp",
+	"QlMCNz6TlC4+Pxg1u2eGZrHZVHH/LVDtyMVGPfOcJYjxUMPkwnUuurUAOIhCzOIvLGIHdXWm3VaEmO3t",
+	"rULVl+RmmYQX6i61ySDZ47JpwvDKX4ClTRI3mKbgLiDoYqkQvsXrAepVXazl8N8TkhJzOYKc9vXyq2Jt",
+	"333jj02Mq6U3S+ixwnckW1uWGO1VLwIlJuymJe6hM68VtEIZXqcc+9zSkFnJAhDftQVRbwTPs1/IOh5m",
+	"XJ5lQRRa6PYQ5xDPKtB77iWW77gg7W920uwIqNYrLqpxumqJWWkqhE0F7zGSvIxquYSHuSlBgmRVg1CA",
+	"b/O09pbz6zw7acwkX+Dkdz6tYgLoeyg6rptCSvTgzqQS5s9wvpcyRFQ8cMO87ndur8vV3x9oeU2zrOQE",
+	"dpbIDmf/8BLntjfIlOseTvU5Y4KFTXQALF/ziz55A6C8ntPtS1q9KDvvXi1aj8yTUyeOzDaP/fZqAMF+",
+	"DGvyT1xexNljYwBKg9qyfS7EbRD1n9Prfz3mtfcLD0ApKrR7stu7E0uJHrZCCwFQYRWgNkSEMPpQQysF",
+	"+uEiavqzh0sNT03MVgjS+Cr8CRIRbfUDIKC/JgYJ5EmFcmLXFlMdJmIR8ipS6P7YVZCYiKN6P61AE323",
+	"0PaDnwlOm1LoLOFbUW/e9rHXysJ7fRy4LwRxkZkg8xT0jNmSzK6l40Lj2VAERuIFpkyqaMjF/Qe6mTwY",
+	"MZo3WTM6gjJ9OgvTGlLluLcFSZyWVuNN289IcNAA46dr1pT+Y4P0IuORIIkxi/mCaeWVRXImSe+ka9MF",
+	"FUePGc2ln+xInNTfa02m+SK+b/pL5741P664ivN6/GbWO2ULqGyitGYfMc0mJq8a3KSNjaVL8Jmsx4VB",
+	"1uDOuGTQuY3cgc8JJ3DHl0TZFrKprokH4qqn427oa6TPg9qE3BQdKsLDvjs8lFEfaPz1J8zOc9W3gkuZ",
+	"A7wFjjLIjwiaeJFxhgt0CDTHOErpijbcn/gNEYImpNNk8wHyqMT2NiiA5wIfGpHp409x6FpDTXq9afHs",
+	"of/0Vy4IopB1TOMiVtxuUBnxr1adZB0hgPoqxjFiDfcvRGMLR3yNX+us19FkiV+++qHpBPm6R9iMJyRB",
+	"k5+P9l6++sG/R5je4yJNEIF5Cr+FqFB2kzYWEJL0X6Qyh5bJ07Uist9kAZU1ezqQrxkVRLbHDEGEXels",
+	"1HNqyajpwkGHy1rPUFfs/paNOxympsDBcZ+Xp3K9JtDpXEkEydEciwad2lSwkiecka4pXNvqPm845ZXO",
+	"XbbhnFv0wurjgxxyZNwL+S7Bh5ZAt5//wy6ussvVHSiTWZdAOuG3TFtvPly+rcumTdhTR4vOsBbSU2Jy",
+	"bWK2XnExoBZfY7EXezoUNV9CsuXzEPszzDQAiV2e0698VbJcLfVGznA8426sxEsorrrQ2vbO1/peF3tv",
+	"MAOf+aj7yiatcFPWTPjkEJNLk7jPRMNHjwW7vlbbPBA5lXYYZLs8SNINwVPSj7XfES1wLrmtJNzK2O7y",
+	"kWj1g88o3KZsNDiV/nMz1kyL940XJTsC3JfKMmOQ4m322WKh2Ktgec1EaWjnCTjwlEi5em3RCR8WfM9c",
+	"OUeXelyIijedngz0A+E2tLjVfL13Y4T+q9Qio/NmIIkwPS7yaaqd3ZpJGMaz4DcTq4H5yWy33b9NNv3S",
+	"7pM7A84/vj+9HI1HRyfvznRSyXen7143vDGH1X+bbs9nXfk7ikMSSq+ZFxux9nbDIHnmiogFSRBnpaQO",
+	"xdak2BdH7nhnc4Pr3J4mKVORuROXvWqfUKamAOgHyQ6XDq4eueWqQSVAtEnh/ioFRVm9oG9v7K0T+jbS",
+	"M8SzSvRaYHn6lmWUsu3ob7ECR1M8uwaDYC46pffroO3PlCl/je4vvY4uzkxIUWf2IDPuuAxg39U2JKkp",
+	"so68C3i3fwY912usjQtLBC/WoMFSiMTAxiUd0SQoH5RhaXP7B/l34DnMpuypvSRHRV2QubipdoRv4GjP",
+	"eJKBJKULxoUNAPH5jo13ASt11YVVFb8mbGyNicaumd7itbSi4ROzvh2RKaHrfjmT1MtXr+5WioLRdGzr",
+	"qIJC+y0SWlJgKhOUi6j/9Nkcwa6WRA01z6jCckjh2kbAoqi/a2MMeGvpS1vMmN+Y5KjbQvkUjNDWrKuT",
+	"tx9C0i/z1+HOPN2FjWdhXK5xSEiVHVn1NlPqK/zQqFqHgDQL7WfwPApp22bW/4R8NWUR7Cj76LT14fQT",
+	"a3k5RVTpY+Y3M9Rv5SrJ9tf9ZLpvrHDoL39Bn0Z59mn0W/QQudu76Sb1g1aU/eWFJYhHeaCs7FBpgz4x",
+	"oWEJN0ja5H5wNP72//1m3G5knhn7nU7UAriS6L9/24fD7jctN377x3/BH//1+bf/GSPQaIwLODT8xyH8",
+	"fEvTZIZFIj+x//5t///Yjv/n82//A5MIMsuF1EZDjRjClJ7CzvE/pXfW0tn6Q1xOnpnGINFrV8TBu4i/",
+	"/kWPlOhXHcfZ8Kt+HvrWImScVlbEnTWffCZI9RI3FUW3ERJ44SmVkfLTe+VGAU/wNvJVcRcRiMhXpdk5",
+	"KUXCoiPbFAmsYIJDBLnaw1aIz+eRV4H+KNXscOjQGSmHVmDgcydKL3ma8rxZbtocH3q0q6UgcsnThtuK",
+	"belX7lB7Y1KzaYdNdUsIQ4dAqC/GCE+5f5NRkOweYIG8klAEFWm1vU/Ayka4A4GyoqzZOcwe24EqUE6p",
+	"W1+lC8zwtXN9RrESeqg0kjv6rDhsMWYZBVX3onpX/MVKtZzV1nG7pCnEuhSboTUFZDVPpGqDYgYhZDfF",
+	"PiqOXhweQoqSlLi6MPbjVhlgPDL4bixvVXup082QftsOyK+DgGHTbLaVrexaNciq2MJxnO2qyyyoN8rn",
+	"Mmaa7XxSwUmiT7HwaaXEgc5WX39h0R/+RoQP2m12itKDggfyjW1urxclCOL+TvdiLtPhIjoDb6i/uIUP",
+	"fsQo46FpZ97yBW02MdzTLm2QdtoMBCIGS3nLRYP0d1/b0bcBAH7aGsO4NfoWTbi+JAsqFRHPCt39tOwG",
+	"Kn2Cu2Wd63pvWngflEuayef6alJ7RXpAmXwfIs9MFtu2j2S65Pz6hKT0xhawqVqlzJdug7drGeawckYO",
+	"W0LdCPco8ZPmWh0C8kyV57C5b7iJgXI2vPjIN82mU2MIWmekuohtJ4plnDXVRnc+dAmFSHkLiA1jqsIV",
+	"2Jft+wrYBfBsWeDGdrk1WxtdiSRMtT6bFZtpQ3vHehvsrVvbqCx8/R/NZK88qRV6LFKl9nOlqnbfujeV",
+	"x8vQxzIDWI9nI9PQGy9sJfwqbW7FgcvVpS+Ac9ziCLY1o2J8r4IH5jdnVz9/eD0a6/+cHkUfluMbFoxx",
+	"eXp8evY38Em6uDw/Pp1MyvkETHnJBlclYw9sSsUh2xOtgL+NNcxq/62MCI31YY7v05ymSdOuw8dg7/WZ",
+	"HVo0iAjfm2Z8taIKySWOi7kN3oncJF6mSKKcrPbvQUFLJKxaZn2SGkokTUkqm9/XIlirA2sGaUKGCYU2",
+	"Rb0FVEFv9BL4mWChpgSrVrfBcK91L1OiHKOl6122t788fPly78XLvRffXb08/PHwhx+//9P+n/70p//3",
+	"dDwIzFqi+yPIDEzjbSF2pk3wgOOLjhQD3zlFUltIR1TeNFn3Q3Fx9P7k/N1oPHp7ejS5+vL2/Mj4M16e",
+	"f3h/8uXy/DV4urw9Pz56e9ZQQ85M8wRUVwNIBHUWSG0LjClsWcrXTgx0jQ8mWt/jmLM5rXNkVBktfqka",
+	"Yffj6R28MXUy2Ny7JVNvQ/WBaQMT6C+xtfXavL/yaew8sPXj+m6NZZ0V1gAzzGakpSo9Yf4kCTo4u6aX",
+	"8ZT5fCNjm+nL2xTrWNb4nebz+bBCKA8i3xppTTeUGZ61jAOfq4O5gzATZE6/EnPO6NbOmcImPAFpWBRt",
+	"pk5lhgfyYviNAxA98ltCEE0qFzgI3dP/AwYdOntC5DjFi82ZxpH9FY4qU9aqO0yCBqVmHIlt5STS4x5z",
+	"Zkr7x3KKLYgKvkOEcSSZFSsncVsQW659VnS1TkLuShLIBp2mlEpTWTNTa+v0ETgVBfZvc3XTPwbvrPGa",
+	"m2QdvIs2yJqiQWCOrwMd1AIN4K7BpelbKoKT4FXCBEL0V7XBc6O5jowp0ma+6pMil6Rw+QkBh3EQFLPH",
+	"s2U5JZPJXvbl7P2Xi8vzN5enk8loPDq5PL/48v704+nkajQe/frh9MNp8eeby/MPF19C9eNz/N225Umr",
+	"5t3iwVVlR5dqZr/vXnZH3LupqwgcRwm4Jzf8mpOcnJBMLet8UfBpfLmQGAglujPCi4UgC+MXAd40YOnw",
+	"IzjS8rRm6w/B36Ua3SUmWOWpovrFN8INg+RKdMXHrn2MSA1k8YXXuEf6MoBoxaUymEn8Tt8d1L9p74pO",
+	"iWhhHoc7N5gOCqzE4rrsDjTXEy7aIGuJgcr6wfOtzWjmXv766AluiPi0fgI7VAUMexobwnIy265xXKQh",
+	"A4J7EVc9jXNLfHbzrbAMOIp/EhThEefXMJgezEQ1WjAL6noNDpZdIAQg6Znb1ObW6ZrHNrvDRDdumfVp",
+	"vNAyWdPcwCYXX7OI67b2mzldhrVRsdq2TapdAuv6DFWXJOOvoe5Lk9kAKINr+yg0i7KdHag5RC0YBrTv",
+	"lkHObxkRnaNw3appmGU+PcqyMyYVttVwujjoTbRT02jdNl8zHjrKMkSDjr0My3cpXifIDSW3p4Vd8GfO",
+	"rzvvBPFeLWp0uN+VjRtXqKoZhZ8DWj07iWWF9ZrQ2Ul0q13vuGXnLnh8aKOQXkW/HKUVl7FWX7GNXMQK",
+	"Fdp5EN1i733U1xXs2/iZ+KzVcjQ0vJ6FuHCuVKZUT9N8rWkhBF+VyrBGtAW/G8GrkQzDHZw2nHDtVB5x",
+	"H7tvafMEvfYe0AmvO6NEAymFY9v2NoKgPPo2E1JUpEbwiso76LCCCWNwbAC08an00f0CtxPc6N+MnTTI",
+	"eEpn621l4igFNt7FEbH9jTdKCtFEEkfHV2d/Ox2NR8fn7y7enl7Zdxed6/3L66PjXxofWxqLht81ag8k",
+	"i+0ZeKVIouoFcnxcpnH8b4nfiz419nvnDc4gYwTLKDMxQKbgUK3Ag26GBUFcFzoNQ4bMM5xvBzPstxZ0",
+	"u0ud2oRM80W7zdouCCNoi6CiVfF2DUEY63104j7aqJevtgKJyYYSRsyuTBmNuDHbekI11lHfOGbyTpsQ",
+	"DNru4rTdEluDKuqb9Jf91c2icO8Wa+K656cBr2AXrgsIRL355/Pui1UReg1v1/pP01n2OonuLeNTsLC+",
+	"ZWedeHq9HjD4VdCrXtN/4OtJZITNi7fWBwoSSoWLbT2UTLE+3S9qxTtyKZpw0cqYV7x2WS6rdrUkpbYg",
+	"0P768SpwkDEDeluQtjk7aoO7O+Tdxiz5xGzOJ+8vz+dzqMBQ7guS7wBn9ODmxYHG1UEAwN41MaRaORfb",
+	"Fh1kpgrajb0PUYZnSq+pqRScIkN8B8pbMPHdq1QQghxO0397JyFokSW7z87qz0o7qTEidXWVWqp2KHAF",
+	"LQs/1eYUhxR8wTijM5wiHbn4iUFDSNRdVECSHBR1syaz0djm919SVTwP37kSzcYnR5Hh8ZHFXpjre5AF",
+	"pEoeDY5GHYL1ppCFzddqy+AhQRm87LclRmlUT2p5RBpbBJK6q00fWewWGxXFrkZhCfwOSR0W7gnJKtjW",
+	"IRwOXSJGqnsg8t/5tHF/jGho6VyuoPSAqSKj9fIvCU50AZAGWmqv1XnrXWSH85xzUKsGs/oJCzQHNNNR",
+	"A7N1qnoWm+Le1RhJ0Vr4szvlDrTs4QxymbPXeXp9qZdXB7RF+YdQhl5pWFeQCyUJk1qYUvuMK1PVY888",
+	"+sSNGnOaqkF77dcTpFXeKEmtgbvXGg06wiW6VZuSRHoJrRlg75QRF5KnbLoXkKS2Yw8e4nD129brcjEs",
+	"2aulocqeVlBXJuq+TBPE+lX1eLvtzsZmaaRSB4ebynX76Fxf1IN9gQpmOBUEJz5yyBuApnl6bTqCVdCW",
+	"XA5S6TTraXMVe3s0s8OAAIMfUkGqKN3J5vKkqwFpaO0wr8HQ3X9WbxgfPCFIrGPOFKZMdk94uySC1DOf",
+	"wSiwcIf5omYKfJrZGQyIMp8aCGKppFaUub9fDExIV4WWzwt3ducfUrUHBNN/90Np9u9+6JVup4Ult5OK",
+	"tzQBS1IS452MCwX1wWwu4uYL8Ni7mMLNla4yc42hTHGEGTfGPhMRxQX6GSSA8vfefTQJU1F9Yt467i9W",
+	"gQsvFVA2yiVDE1aJKaw3NnfVGEFBXkQT6YsOuiXVWXMKaPjbAKXe9GjT5zutjY1Huw897C24NSy+BqXZ",
+	"sAe6EEZTqgl8e0L0kG2BAO57zXe8gmmgMCzR34/evd1/fHvbXS6eZqP6BreUibK0r1UUByet2ZX+N6mQ",
+	"eOpRIVYhqg0QL+UeqcrUa/bGK5x/hhiyub7TJUQAD2S+7XJC930x2u0Z3RQrex7e3Eo9N7rKneCIRxlJ",
+	"FmQj9jvBi9NkQWLGHsaTjcd8zxMSL5K3sZCpmXrukvI1wLxZ5tiisBv5gK7aBhQx2W2vKBkWYTBgr6cT",
+	"hcWCqK6RTVq1AQNXLQ0ugtlO140H2OKGeOCG0NRMLbvugiaPn81R4EreZ1gtjXkfI8G5f2g8OXpjauRS",
+	"k+lxH11yrqS9pVjHd52GrKFMVC5wZ9FiX1XYJLXz9Xy1RKzXHA9Ky4YfwJLNeLl2esyqsIGc7Xy6G0Rt",
+	"bcLZvHj2Yr7xSJQEZDQVRUVLtTpqNSuDfuSxHkq/82lDHO5TOho2kU40GfnOJdyNHUcF6A9tgYapNjpI",
+	"TEGTnyycxS2KJb9LmHAmb7ruSmaMS35b3+QjlyO2dImlDLIlQ7d9dKpjUN6f6NcfKBULd5jjyd9slaTi",
+	"RssZQcLcLashMOWwmgYD5gbvQTqTZCyPyhFDPMOaMk2L8k2vcHUJatYQlmScmgLrgsh8VSqyFdgxBL/d",
+	"8r1pc5HyyHeKsgli85ehAW86dsfHhhtLXmGbveR0MOAZA5+dGOsIYhyHCmm4XCcCw0soi1RbcLwblFv1",
+	"dVpTvpBdfPxEYvSDQO0BHtk5e2dcnGKPGrNrMsRuYEd6bfrdYQ12oMuYf/l4BIaY/nyUu1D1nqXoS5od",
+	"TOXGGDuU2JV97oXY1x6NjtB+Pv9wqQM2j/7eRVoBIlq2J+qGIIqc+FoVM60HeHuDlbSEtubwok73fbkE",
+	"z7V5oP+V3yzMdDWv+Z7e8CuC2YlVSFs9dnVD5FTXKDwQ4m7deXvO7gs99sRWWTr3LnDSIK0brmoxyeup",
+	"t4DYb3R5E+Mo7aD3i8Cfrk+5dGlswCUVZ7pG5iyIaCvODBPXUwuC3TjOLiwU4EJ2vaMlFy4Or+FFLaSC",
+	"+mfVqxBk1eezelR1BFErV+vQrrdhpwtMduyovhEe41ySlofk+nrsW0v1endW/gHEEvg/jW3tPIlUkWaP",
+	"2rAVB+p+s5Bqr8QYFnzyc5fz9pUm6SceM0FmRLtbtVXqt0USjNPVLHBphv017xjFllNW2/IxyjOnDtsE",
+	"UbVFjBFPEyL19ViYBFRDT1u/y97iXz1xRVshEIjf47kEv6RVpmR167cIofFq2K5tTBbG4p4ppPo7q5QF",
+	"/ratbw5yd4cJGKLYszqt9mX6Bht+832Jz+A6OeiWs6GpI2afiY0tm96LgmyeQWGUQBaUS11cnb07Pfly",
+	"/uFKywyTfgMCSv7+5fj8/fGHy8vT98d///L27N1Zk0dr4P000Lrou1aMG3Z5Jbz33dsG96Bn8j4y+Dbd",
+	"cQWavD16DWlWIlo2/N4RHWcaAf0kRPkcsPeepEqmuCGZ69ujxmfQUsyRWx6i7U6kA3XVy5wdD7caBb1/",
+	"2oAqhorZUo/J3a0tJWvJdgLqYraSJkfZAqaGfTD0Mg5J+nNPvnhaJo6CXYfaOjZ2exmPvNDvurObORzG",
+	"Bq/Nk1VVxWkIqKnLcMHZBbyVNdpzOZvYQl6b+4sU7iE3zVPdKZ1B4xKamcd3aiPsq9gj8IynTfeZoTkm",
+	"75w6MJ7/3kDYujBDFsdCs9k8ThnRbTJo+0IbkN01IZBCdEagjS9x5447TyvjKxwuUip4i/Ae8be8TQb2",
+	"+Nlu/KJxgftC2438X+y5PxzNgftaBctQOi5fxYncfW1SQP5LBs5akNISIlpnS8wWRBbqSdHIfRsjyRFV",
+	"9rnoE8vta5HRuVAi6Fy5p+6EzFIsSBIMEw9MLudOHJixyowg1TFhqikBkP6OZtCglKMQ4v5bU8sGaQKw",
+	"UoJOc5N2Yb/h8XlQPG2UGPFXfc09/UpmeUtq8nomwKD4J4Kwabw22bHGiMJDDaSvMjfSsX20K0eh65tr",
+	"v8SBHszW8qR1GO301ZujtUIar8C7ArY5M3ORGALqMU2j2k++ZqaIrFt9aOrGFdNSbLFWNTQpZydvj6KU",
+	"pgLxO0AKyqFxbq2H7G2QebpvoqLWZ9FmpaIIVzObVBroc7cALbuulkVpD89WgW/BV7XFxbVbhyjP0wNo",
+	"oMttpqcaQuD/AVSi2ZjMckHVWuviK2ttIFgQcZQbXy+ATncyPxcLXCqVmaOHX1PimlONIfOTCxT7cWTr",
+	"Vhd9cUZ/ITZ9O2VzHkeyc6o/ujjTXanSV4VR+Ve/S6MX+4f7h7DJGWE4o6MfR9/tv9g/BI1cLWFpEFyu",
+	"y2vYpMT1ed+4pMO6FSNSIm/p1DTos9iN3trvb4gxdJobJczy8vAwUgQZytTCCfcq9l07nrk5Szsz+vEf",
+	"n8cjma9WWKwNhEVDl376H3Z80HtGn3V/WCvE+XQvVjejbau9dA22uVwADoo+z2YkU0gJPJ/TWefqPbSd",
+	"y795cYCTFWUHJpXeHs6yRmRMFBYWHeAA4k8sm5IQZ1mRryD4bYUZncPLghYA6JKYiqkYZTpTljnaZD5d",
+	"UTt42AeqyJuxymehHV+QhAoyU9I9wMxwmkLGNBPYhW8wTcEyrbiPPUGwZLlf28Qj/btP2GhsMua+ildE",
+	"wWH6jxgfWmC4WGBG/2Uwo7jBEvFrokzntIG87x7cjAjJGU71DvOcqWqpLpAW/8yJWBfCIpxmZKqLr3BM",
+	"Cn6O0+GMM2VNB4p8VQdLtUq9IMMl4T+lDMPU1aFrdVgm+glTynmepusi91iFVMqEoUn/+xpIOMtSOoMu",
+	"B79bQ3UBWcdxdioEFzIG3xFa4VSvyzwoTnGCRBGn+v3hdw8Dxk9cTGmSEFbl4T9Kx8Q/Pn8rMbUhxZCp",
+	"/hto+H8CBgfi1WfY1z1hT3UJI7Xw+oFjl0amPy4Vd92c722JbMVFMRREfOGicg/OMsO2n9jd+fbYrazC",
+	"BN8dvoyItpB6XTRkhVrHoyXBidWoUz7zJtVmBvw2bJMtqkMceoTfZb+DAh+gLPJY3KzT/xEuFQTRYXf2",
+	"zZuwBWVkjHiuJE2KkhJkkadYIGmNlZtL3nfFvF72WiZ9zZP19jhUT2bXG8zp49a/RatMVbGiJbgZYxSq",
+	"m0rk5FsfBaBEcz6LUa0iy+98uhOUvXnI7mpts+7CPmAikY0SUr8hyPITtekBT9M4TW0UrBybvNw2wBV+",
+	"A6Fo61dszDaQJ11DMeo87+/IM8VMXRpASqUToRZ9OxruS8MawY6E7kK3luw6CDcgUCfoC7KDMhrubKei",
+	"7AV4w9N8ReTmhGuq5lrKbVWyYQajIJfzOPh8CZVMCTVFGwr1vfweLXkuZJNmXcrVMI4pxG3eC5/vm/0C",
+	"fA3gP0cGOwYcxICOJ7bAgQd/mP98O5gTrHJB9uYpXnQxJjg9m/YI2hul2wxl1OnbIKeq+R1yGwuaGCV7",
+	"dVfe/MnM/1OKF33Y9KqAgyaOx7RtqWAx87mmMUWZbaPUGvfOhVWcDGDF0nbuGHIThqywRG/udISnS0PY",
+	"+PvKEeMZB5fmMFqcZzsukCAr7hLmO3bbIqN9yJKeZqcnwWr3dD0zWKghp+OOVto4tzd9r2f3IiI6xUMO",
+	"C63Lh5146C0eDK3EBMQm8qH7FKcQs+9eCmPCBGphSiMsdDCstHq17adPcl1hwDislCTMXQWJwcWZh3An",
+	"RrwY8UjpECLFNkmiFGUL+aASxAA7SG7YLdpJjM0kRrHh9yIu3I11T99YD/4I//x2oB1Xmo2yP3ExI3u6",
+	"jbmo58yHQbbE542DcE1Zj1DbWMIEvq0GgT9hmj4HCTOOAVVO2tAAWrhZz/TSUoo67xAqtlZilWRsESEb",
+	"mrgTM33FTMG+ZXQOFjPjMiGWpU5G9xS/Jlq2+P9/a3NpQJhp5xAELSvaB7Ar/E6VJOkcUWk8PwU827tk",
+	"qKZmlbWXRcRFRq/0IMYZolM+eGDiTOhX9VzNBhdngI1O9jPOjzfuVDd9/qO5Tc/6/cPMqh1u5jxnieHx",
+	"kkONJtArS4GeZf1vLWxbkO5nU0M4wpGX5IZfk2ambOQucwib7s+Wzb7veBkVsLwdR4Tnj6dNSzpbIc/O",
+	"I+VAcGVzhzQQMnxvO12O4NprvhTnR+E9InXojSbHMZIznhETlJ/SOTFpAkCb/cT88GOfEriYEYrxA83s",
+	"d3GOWc/ugDLeFu6YKoL/uo4rwN+ONeOsaZhh26w5Sylham9GhKJzvRwiD/6o//jNcGdKYrl+TuB38MA0",
+	"JQVNfxT0r3HOMTQ5LlqYQfowT330xutWfSFP6iwyCLV+jXWc7ajfU7+hjjhhOTYwJIUCmmrjhwhplBgD",
+	"bKl701zu6bIADkR58Ef8Qz8GYcZEi6a5qTbg21TZA4IHX+dyEjTqzyHxSRq5JL6iJ8spDSjccUuVWxpp",
+	"zXEMUBl6ncs2Rmmgjjsxy4F1EY7rfUeza8ZvU0gvbaMlZlwkxjLZxEI2aRLUz/XBh7qzyVEJUaRqSda+",
+	"joM3QOAFpv048Gh2/W/DfvfwQDK7jiGt43XEbA9sXbHtD/pAEgO6U1cNgE1CGt2JoUIMBXwc8IRD1FMQ",
+	"Qw6Wbs+pXiIoqPhkiqIRViaUNVGVvGg2QZ4gVi65icZ2VthNdIupfdc1Uu43/cNvvgC9/qAvwravjoky",
+	"0NpKUoGcQ5DUtJCEIXj7vYSgxsml38NnLwzH/Wr864rbmCpAtd8iWt47Rhr8QHXPuP9nU77QOFix8Haz",
+	"0ab+WC5YAwQpXQ0G4T4tBJqIHHE5Yhrg+FaXJjuxW/Zuezh568TrtwMXI974TqS3TjcyVjwrRhukzglW",
+	"uOdzj1lrq0R5poY0j4mBTz0GI7AfO8YovbwEmKkwRCczlGl/QRXBe7dkuuT8Wh78Ufq7hzVAR+URjGyH",
+	"Gg/A14/mY/+Lf2nMRo4ogfokr/ll3DwlEn7xMGB8YDhXSy7ov5yDxKuHmfgdUUtuavriNOW3JInbFqrU",
+	"61gJfm9jpTLx1VnqwH46+CPkpaaXzhmhznXaOj+6SGKCkSAZl1RxsR5bu8CCSCVRlqepV61tNyx99gtf",
+	"BiLCkeah56Nf9pY48jF4sSuENBNc/6Gf03Z8+GT4sClLRzs7VrjMReuDm57LTt56CXYx5EeQdyLsFWET",
+	"Ezavu51Vmt7rfcLPXJq1/+tjSYMqL3JH+U+J8nsE97SQa8Aay3zajzcO/lgs98JftOko4/15xh9FlHSw",
+	"zCWM2+NkCcFpVvXKYD/Ta1DB3YCdDVm6tAc7jn6+HF1hpipD13TPKhPcieXhd/2/PX7LiPhW/K1Z7tvB",
+	"VGA2W5L+osF3aBULr4tWz00yjOOVGJxujgCPjUAWqG4FceikMEHrnLZF/ykfRgI6QthQCHpq2wnA5ysA",
+	"A5GxDeHnrtzNF+1g7kXKpzhts1st86m5Jr+Bph+Du+3uAvpvfAF1OcZqFNKtcQ8x+gS0aOPA+tCiiYIc",
+	"YLjZmWx2HPNQHFOj4zaOSfliT1Km3xzcf3t656Z8gXTzempdvphQNuCdwY3UyB0OsifrROhxsXsfq5r2",
+	"AzJxdPiWL5CmkDbDvt/yErUGifP2bilL+K08+KP+Y08KDjoi01HHgJj/FYWfKUOZrUqKpKJpigjTzjAK",
+	"8ku6vJKJ/rX++BwkcPwI4/bnijp0jfxRx8CT5ZT6qnY8U+OZCJIK7glIChmaauOjCGmUOYq0e1lI5PLT",
+	"g5uFCvK6u7D8OtHbHs3JxreFYVMYYNCV1S3nyZBdR7b0VVEewFOA+6m2kwdYKSIVwL93TdayO3d8lk9T",
+	"OkO6sZV5pWDwYECfCbnIxyAIsqWNdZDcWL97YvTXj78gSZT1kcaqPMYMs09sCiUY6JxqfMznKWVkv42M",
+	"jooRftGrun+qqs44jMiCFQNmnwux1eDuRXTg5Cf6vPvpLCGl1k17bp77Sg3v1Rpmdz2YcuCOp3p5fF5e",
+	"3VPa9bL5p7IJ7Zts6o3sScUFXpC9OSGJPPgj8mvfsCXTFdmuSHetUcI5tJmYJj8RkvTXnCLDN6pOkVU8",
+	"Wd0phrad8lRVnuLE5SjckBWydIU0YbWpTzHyKPFGRumeyFMd5+r+2/P2cXF2hgRkpP8bTnPiT1/wAE9N",
+	"cZUsF9rRvwgy0oBEmOWC0ss8HRD16iZv5Au3mCfLDG4FOw6ocYBHTUH2+idNIW207re8RODg+r/n6rUd",
+	"/FH6uyepQ5+gHkGZeH/VX21q/P4UXBqzkYxL0D5ZWi7jZ0fQVYKu0o+jaqAcZEmnjbTLZFCib6HVYb2L",
+	"ewpLbQYt/9CTwn0npDtF6m/Zz1dYDjCMlkdtJPIyxE+Wyis42pF5lcxrROTo3JMP0vTTRugVUihRutRu",
+	"KJLJPuEEk/eT8I5QI+gJk/3JuDJYIx1LJp8k8VaRsXsGe3oRBHWCdcwzeT9p4xjJZIRNXHZUG4zW/H6s",
+	"53UxYTUWeT7J0sfNkXC68vqGoXABDC9fvSoB8WL3Qr17oe71Qi0VyWxyYvffbwcmN9teJpo501YlxOUA",
+	"HZPxzdf0rTGtrgnuEgibES5EHwb21bUaDzcL+/PLxGHRkKepTb7xk+Ari6jmLOVZroIqo+VdeNCEHEPB",
+	"b6y2WFrBLvHpIyc+texdISsnSHwx7raT33Fkt7hJ6HzeHYtO53MrX7w0mBJ1S4gpXLbiUmkdXx+q+ptL",
+	"DgmpO/Qv+03i6A1RJxqC5ySH7omb3xBlkaIxsqHbMmznjoOfQOrixJD1PbFtyjvrmGn3JP38LCucux9z",
+	"a3tLGelbaewpMGJbNhrFkbymWVMV7/lckq1kmSmmg6wxaLreYlKZ2oxH/iE2JTckhVQ2c5oqIlomhpaj",
+	"cU9ad3Sge/1ESZo0rVwSLGZLZ7z0cMy5aADEdBgKyMT0igDxcYlBB+MiaVs/fH69NmsZOPl52LcBD2Z6",
+	"UwnfXM1boDgJmm0CSdH/nkNoAmkwNNXQLr9Q1R3BS+Gyl+jwY8B83iNfMy6KKjf272/t3lCYIdMuLGVp",
+	"sn9TJb0TaO1gMMEAp9C1Z44hO7adrt3qY4F/pvpaiJyhFSZCJO3UtaegrpW3pOBVs8vIbnML15ZJegDr",
+	"HiT8lqUcJ408fGIbGLdGfSbSG+IyLBpGA17GzmXRjYg+XL5tZWo38vPj7LheYpZv6hFYl84KLmIHdHcJ",
+	"gY3Muw0EvfgXzcoE7TExpQwDYNUZopYoPVCml4tFSBRwGO8ky2NKlgY7seO2vsJmAxmyl4u0y3AsC0Hx",
+	"4fKtdc5qlCwzrG09no1IguaCr0Dg8FwhbaEnTFmcjou0qTA2+ZpRQdoVCwOUw80Hke7UjCY1wyPp8u0g",
+	"+25JBu6EwtOw75ZJuHJMbV/9kH3EAlQJihe/MpCYpqP7fI4xE3VkQ7fI888wT7FSbMiB/xGVYodcjktM",
+	"UCP4OqXHKNo7M7QXXWyn6GH32sct1vy49By7w+7sPbFrZA96LgoZa+JTs2WdeG2x5KKKG9ginTe985yX",
+	"msQFQSmZK5Sz2RKzaIr6sEz5f3B18jDLRb8zJhMakYoSMLnnDoG7wuTPijlLlccH8WfLuRMUbGyPDvR1",
+	"DGW/CqN9n+KenHPdeVGyuFwe0obYUokIu6GCs5VOaY/OoJ4xXTAuSGKLRgDRSGvSYmF7FBSgNFKQd8xH",
+	"St3tT9Bgv8EYFLQfPWYWM1cVctMEZs4RbPckU32S8WUg5bDakM2VhJ1D3uBKwv469fw4/cgWGdxbEKaX",
+	"RhLtT2vZcoWvfUky2H0k8ZzY6itirZORCJKZ65FrUS5GC2NRtaQMvfweLXku5CdmGN0MzAVdUIZT5LgQ",
+	"gvcJTky5Fz24q2xmZ/AcvyQ4IaJA3llCVhlXhM3We7+AT3Czo++DeiYWlWG9ovLtCZaj3cmdnrfdHkVp",
+	"qaNF5Th4E70kUq22R+2uetXQ8LJha9a2yrVatdrnosjc92leQ8ygMk6RjdlxV+VUj+Fos5q3zef8BWUS",
+	"4chUlXLr55BCxl8gba+i+RikJBFeSbUtZ5gZjXbGGSMzsG8ar04JNgIi3EOvZcZBlaOfj7Jxr4dqDS9d",
+	"hTfru6243oAeRoDtBcrUoO6UH5ZEdjWzex7OW6uZ3XE0c6kPZqaI2MslXpA+BzNfZbkivsgiZgk44M54",
+	"bkv5qMAvt2xjGKOF4Ln2E5hquaIlJUwOgyi6Imiaz66J2kcT318f91gpQac5HEHcQlF0NlNSAZOOEQ9+",
+	"8Um2aADWEkso/NgYCACHJJfqGMb/AIh5tgYQU1g53BGjRs0po3JJEoSVRhmea1SCUURvxD46IXOcpwoM",
+	"jVI7VKIEryXCC95krZDUpKmLrCfRYWR63NHWwJ6SORekCWLGb5vABK+ALYBpnHz+5f1cAvqVZWASvG4C",
+	"xrTv7YVbocrXpnd/HDq7V8A+DYDN/EyPZnmqsuCwu18oIYxs2x0zFS21hqEgnFuTjA7d2/BkIWxBGdmT",
+	"RGnLR48EiaYDch1C5+BxcPHTTRPHWnweds1ZSqQMVVh+Q4SgibH+rJrfXE9hgImDdfcCi1kVJ8N4r7KZ",
+	"O86L+/dWsDTwoTaPFlfMUjwjcZayXFTjjn00qTQRBPEVVVrfymUr00F98tb33WfKXPf72ltGSselz2+g",
+	"4n7THuHRd6BECJ+Ad/Kg31vwnURC63EcLQzexw5b1CQv9QzP53gt8Ne5nAQ9dsbXcgH9SWkfNiqjX97K",
+	"HU81FdMv42lQSf2uZ1aJMEpygadp08ZU7B/mgBVhDWRb619zFIX7YkZn0jtsmns57cdkO6sqICCGmo4z",
+	"tmHzhvj0bs++GoN/kIdvfDU7EVGzszYgaqCM6Dx5uw5anbDdNCw/3sSZ/tk6ZP0H5UWAQg89siLY9P/F",
+	"rFSRlewlILR3yDcPFRYCr9th8sbws5NesLn2GwDoMoycnWwIon5RkAqrXJJesLq2vS2pDsLLnE2gr80x",
+	"8Cg5JmA/mzNMVB0Zrah4ECfGcK77c2AcumQ9vMzwjPRYcNF46GqLjn3W6lsPW+l9pg8BunoCyUNCOB4q",
+	"dUhxVO4Sh2zlLlW7Ot1NJTpoLZxU0YvMedpDN/qFrHeWBnlQwsVg48KTqqX0tOwJlXJN2+ADoW326+aI",
+	"WLDpr6EWqNWSTMcGDjAGRdPpP9gSYBAAGOl19aeJNC7hFm8PZ13vf1AZ4HZHVQObmi3f9mFlklz0sZSb",
+	"luWXa0ZuIR8yFVK1Zp7YnVrGPh7iZJBdvJTCYMcX1eOrgp7BWR2aLeEThUU1r1sp+GLs/6vLUUrKWfiT",
+	"yPWf9uKFWRKELfmaqAFL7aOrIDsMlehWUKUIQ9Tc4KZ4dq2dDFkyhtHqKWM4m5GAYZHUBEWSSL2vemaY",
+	"nW/IgDxzUhPGLsvcgNwTG6d8az/DFlQRvGfrAHREB7/RbZFvW2UJ+PzRfN0dWebICnEyxFOqgupdUY0n",
+	"VO8mzgtBAQ2C7xKc6waNvQcXNkhsIRAk45IqLtat/Lh7/QUEhChpDVbdHpGHU/Z+qC1R1477nxL3WzYt",
+	"79AA9u84jJf5tN9pbASCa+oUa1vix0sFWgqNTSm7NnEyXgEvX0lxytkiiHCH1y/E55+YiZlJsSmgwdmM",
+	"phSQ74poUOEqa8wxTXVyV5JSXWKeRKxRBsydrlDVFQqkDLrfPkk94SncbC07xI9pqHO1GaNSdkMV6Qhd",
+	"KIyyjnJtr/hd8gy+7phBHtTwsVHWcoftXS7DmLGnoMVBgQW983LaCVppfaeUBolEDUr6pXozuH0kD8QQ",
+	"3A1yizrC2LFl3Mzj+WY73v2Wz90Pe+bvnjW1+7Ny/1LET9LzsMxXHWm6PTqe+9nayb1h7fCnyb2xQsR+",
+	"fzpyUNt2nZlNh3HCM684/AQ54X7D7TY7dx8tvWpPzq0nWX3SnGs2ZDjntp18KV/sScp6mVF09Sto2xq7",
+	"9pYvJpTt7BXWXmHRMchS4RG9M1VECrAZzJQKsCGN4rtdytzI3eFmvmJmSudktp65yDU5Dj7xhXmL99lW",
+	"Ss/15UxhTSy0u/kBAiw2Og4fv3+Pc9+zQA666jmQd1xeu+Z51Axj87aTbkV0INNQa6TrFVdm38HX3VEn",
+	"D2r42Mga6bC9M3vErJEFLW7H6sGnv5OZ2pOKC7wge3NCkj5qoOmGbDcE3Vo1wnPoMDHtfyIk2TGM0Q1r",
+	"iBmkJcb2YXeUVFgniqSCgcwOILsFSO/BndRIFpswqlJmPE31efPPnOQQHlfpyLjNTQnJQsARs5jEeNzr",
+	"YcmNjqh0WoUZo5sBd5olIKCGlw4dM7a3j6Nu1iAfpHhG1rETHDUdNIaljSVH2zmc4VyStreGSyLzFUEY",
+	"QcukIkmkcR+nSqJpPp8TQSCfbJPOauy/FzDnTmntVypNo39Xi+kpld20LLFZhbZY4j9giIjhR3O5BLwD",
+	"b9n0mIIuFjbjuw6xtZmHCn8xfV5LxTPDlnDGJzabs+Arw7KULUyEBQcYMEhpzGYkNb2qiXuxIG4kLvQH",
+	"pjmkLXPg82Ly7Z/ysP6OMx1Eqt0C+RQrwTmZvxM+T0b4GFmx5epzGaV7Ik971Xa5ODtD0Lb13n1B6WWe",
+	"7pzl7G374uzsEvA74I7tEb3TjysX6wIzBQPo3zSK7/YS40aulGHRJHqD07zkq72CQipQDUFvF3TTv2e5",
+	"WLji9v5RhrIstyGSPFfwfx/KKIhGJuXMPc3AUEssUYalJEkTc+1u0oAAy1sdZ63f2ce5NFsgB12VHcg7",
+	"/q/djz1qhgmAtjMQ0iXtOe26x0EIHcrqeNNp+KtuemVa7o5EcySGOBl0LpbxvmOOyuFYQU/BIIBwZDF+",
+	"t2OyNEfcawGq/yBBcLIHedomv7613SDrvFQ6+S5WeIolqRQv4wxhpBGRwJEamJ+DNJu6vdDXXTMfVTYT",
+	"nGxlvt2RCQgIUdJxbpa3+nEOzxDcQSdoCfidpKgdo2X8bCAq2g5UQWa50NS8p7Ds5eTneyDo0XqkXrq2",
+	"V1juHP7smVpCyqBDtYL6Ha9UTtUqfgpe8ThHGul3O1fLs0QP1qAih/HmKx2WOjCY5/psdfbgMUrptbmU",
+	"uirWPE28J2DRUKOerCjUrkAYLQkWakqwzZ3Xzn+7YxUQUMJJx7la2erHOVhLAA86Wcvg78RF7WitIGgT",
+	"edF2uMqO+i9o8n6CINm54dm6WjxhcndsmmNz8n5yFqKqv/9gDcu7TCBPLQ9QhBF8ncT3kzvkAaoMHGOw",
+	"3bkICCjz1wOl9SlP2vtwq+7qjqGfXmqfOuf15OjWE1WRbE/kbG+lpfusz301e3UI5qnsz69QihVhszVk",
+	"eMbax3IZGLZ6FNTGkFbfPDVBX+lLpkJtYpshE5wwxsXPt5iCwm7G1bo8EUimXI1L9f5d5W3TYGwzaJJZ",
+	"bp6dWPDRpQtCGRGSSr0qvw5BZJ6q1tLb7yz2/o1LbrcV2+aMoCXPxT2U2r5PLchuoNu8YQEU/pHTcc7u",
+	"NlBWQWoI2l6pZie1usRVkwQK/L9MXRwjjcKIwTH6nU8BfC5swrE2AfBsA+vDNSMK3tULosqY2++otnSZ",
+	"a3zcM6BuOwbC+DufPgh4hkSCSksFdNPGUvqergbJK1P86WFk4waRZX7hO4nYIBHvRRQe/OH++60t4lLb",
+	"TZ1gnq6NMIpKtTfk+Qo1v8ImsByqnqn5xm7Rhoy5c2d9KHfWEi3eYolYg3/rm+A4GyQcxgUpD5cTB1gp",
+	"ssp6lQrJBLmhPJfI9THvKA7octkQc6HTl0OpXAdEK8kcqJIknbdeq44cfDtB9KQFkd2nOygLnqx2wunJ",
+	"CafybQ4XPPlQYiqheMG4VP3sU0Fra8S4JYKgGc5U7upt6nb9BNlJMBoWxJTf9GPRct0Yc18sW78AtRKv",
+	"spQkrcIumGkn7562vCu26i4iLyTrndR72lIvKTHnQwk+QXTHljKL1uE0UB2j4sUVWDRNdqLlyRV+FDmz",
+	"W9XhQAMRNe5dQ5DYcr89iYvnruxja9lHUyz+wS98xZoa7UNwREEzazTvEi5viJqYYXei5fG0FjuezUez",
+	"oUZih9spI0/ZPuR26QGlhhIEr/asHO++gZn2QVUwVbcGVS5dUKffvMJRlpCv+2ji308kYUllzCmZc+Mn",
+	"sLZP1GMkOcJollLClMvTkk81gFMC7zK4fE3T8ECpTcg/WAfbR9isqJQdV7cJ9Dx1VXx3UnC7zglNO0TV",
+	"EmFmCAYtwEtGX+0xM34K8HvDyxu4M8TfBClTP3w/AlDpKl+Nfjz0cIL7DRExQDUGWa7TBWrSLgOqOCyh",
+	"AZSUrqjqAAV/NaC8ODw8DCB7EYHsAa6/AblvdP8NcLM7a574xbe8W/dz5uQmLSYk4wJn1cYbr6sBAnY+",
+	"X2bZDmCDPjCa5VLxFRFjk46gaqErhVih2yWXxPmpmNrNS2xmgOQH7vy6Jut95K9gcuwyGowRAZyDB1lY",
+	"/rk2rXFAy/BaV3X252LpuLFBaYZTVuOgiJ3Jby2IK34BkTEpiSxO95QkvbG+dNckU/voY6lJkc5BKpqm",
+	"LrWR+eWaZlkk/cLEIPnEbtLOydc4+Zax0nF7t4SqD4TEVaZ5wMt7FdZeJbHDYishadu17K71tUIvbpc1",
+	"tkKJaX92+N/U48NWDdwrCln2UMlX3ITzEaaCCpiV8pxa2pQLCJfLcIYvI7WinMZLFnpkgmv6sZF3q7rq",
+	"bItJnhhA1s/agY4mXtibVTl1L8TznIsmHzpfqvVeobQaZKCiBtApbtMIbK6hPqgaqumlTEKUbFKYNMDB",
+	"ToxVFMAIigpRZpG/sQQDl/t2L7U0tZ75Zd/duiiBRs9WgsD91jBfEI1gBTOViLAbKjhbEZ2Q6wycaOiC",
+	"cfeObcmmuAsH7dHRxRlS/JowF0XN2yYjpb72J+je5L0atC8Jh4d8kA22f9gt1GcO3XF+2cxoiSLkdvjl",
+	"LsyuEW2sitM8vd7TO7Fue9bcg4AfaXOt28LdJeudIWiTjM5oOAt6Q5h1vjYXNfM4Kojd+QRRhqa2h449",
+	"QlM8u9bBSCzR3uXjT8wFARke0ebIPL2G7pAoFk0JZJG3zJcJvhBERuqIfywc41/n6fUlrPc/96oUQ0fH",
+	"TcnsY5HzKGdOTQFMPtylKbqVQ3IGFCS0kzSFpHldMFbJelERO/r3bQuegz+K/3/rfgo1cR0Q8Gj53VyK",
+	"gn1tYf83RD0rCRC9PARSsAmwAqXP1LNrIz4vqxQ7Tn86CaM1+5Y4tL9UGYfEPETE0BUY1Br1mjP4Xn2H",
+	"BBO1FicsSYnVa7R5nnzVrV1KXbgM6HsQ42pJBPoZ9BiFKJMKsxkxGo8f+IYISTmz8dOfmB2dSpTlypnJ",
+	"E5KlfD1GOUu1VAseaV33qhXbGcYlXmlDtH55LeK39WKoRIJIBfcTLBH+xBIyzRfmG7j86+danIJYJfBm",
+	"S+FSw7SqZ5PtG7M3VU7lKpyJuBlImWyKrXqXQfZO6TICTe9+k6plaUNxRB3OHkW96pS2BrzK/W0X0FQW",
+	"fFbIlESM2eF7U63+CP/sCj4sy74uy06hRf27BFjHQQsx+NAACpLammeKo+U6ESCZtfRGM75a4T1JNOY1",
+	"42kT6j56654i/TWZM5P+owgS0QJ8laVr+OkyZ3Ifnc0RX1Gl3y4/sSI62t257dOnfjQwxdbCGbSoJ1+z",
+	"lCdk9OMcp5LETVI2jUXJHEUVWckBcujMjvHN4w8Lgdcx9B1FMeSOTZdlAM0pSZPAzF5+jnWfsXBGjOka",
+	"6fWMbVEbklWafWKZIHP6lSTG7vebR/Jv++jS0k44LE5v8VoOxqYZIY7MCmn1wBVDp1d44fQdH0/ojhYg",
+	"EKrsi7S17IwRRt8dfo9oAXyQ7XHKkyK0fklwQkQB/Nl87z1nZO+dHulR7ZN9z7e4gdK63prlAXwajXXx",
+	"eoRuCb62OHZmE7usMUqIoDeFMqk1PalsOX6TU6dIdgOHwX4ryvRKvjv8vg7FVTAEWlovk9kSM10cAlLA",
+	"BMY6WMhTXNpOmSjbgwM6HHKPKp1qm2sUEKYsFTbAtqc2oAstIoIOxlgTLQof1JrnIjE3myWWS/Muru8q",
+	"JtpCS2FLQsEP4P2D+PwTKx19elDCjGeqwOYktJc6+9oiwD+RrMytKQTV3nf0c9tc26v5fJ5SRoo39muy",
+	"lgUo9ubXoTgdFRPsdKjnY4QKt63r4DA0tLsYDZJlIec9klyz97ImkXb61dqLotLLaOi6BZ6m7hY/LmRF",
+	"YZ6pFkt05p1xIePGhSviJ1Z1RaTKOyK61lb66V/B+9EnAHRi0Ag3A5STa/7+Tpni3pJlBQ4Xn1jVqDWG",
+	"0558heBp4zSnjUlaeeRJDqkD4XEwFxowJBXW2b73O+3x9ja8k4XPxiDfZL8qiUFvMd2JwWYxaIXKXe1D",
+	"2xOCCV40SkD3CHdy9KaStt9ZjwRhiTEagDhcCJwt99GpFkWMJ2DxDsOMMGNcwUUd5KQp+qof+MYoyY3E",
+	"AKFmn/x5zqzsA+FGkgVBGadQjcdeY7EgLHCXhzij2ZKmSSAK3/PEOmMHYU7a40AvDq77Cck0OMyttvhi",
+	"Li44ASFf+BrqwbsE3Qle7KTc85Fyers2NxFoqtnJuRZ1T+PncSQc5DveuybrPZvNoVXWQWt90wss5OVb",
+	"K428ymlbLZvlQkA6ZhijQzq80W1+IevLfHcvfOpSorJdw6REiaB2ngkPGW9X5uWuAO9S60eSVVlHEVBw",
+	"zM7yNHU7KSMiqk3yQF3oPE2t/5/cyZ77AjDcJeNv0ZKFmPROQhxs3gQ6PkAd8WJKeWknGigE3btciXR3",
+	"+lIl6KOMnceRQMbbp807XH+v3gVBBfK+RoEZDFyVqpavIJDXGafsG1Vh6TJwwGepsFCfmL3ySUWysb6r",
+	"GTvZDEvzpuUrnBc3NJ+ogprn7BnPaPhS5T2b9DWxTWq6Smu6+U5iPuVMXnqHgo3rlc7LPPNjYe0I/rnS",
+	"7vbT9MYq18UDUHe65QPqluVwmBbV0grMJ/COKzhXezOcS9J5C9ZNETS1D7j1GCDrdVo0rCaYthVsTE8u",
+	"wBcAHm5dBHPJpQSMgfCYMS6nh/UiXBrPArANjsPaQVTBBmBpn58VL44RPSnXx4L+YYbZjKTO3YrPkXkC",
+	"KZyhKKsbdvLM5fszJZGUXVKX+e+Sc3UMyN4dGM/GCFhs2jD9tswvuweQx4tHKORPZCMs63bZKovdvH9J",
+	"LXvFYUPLfv66/1ax2LAl9jJBJbhl7qP3eGXfc3JG/5kbBx3KPGqaAqbhnzbfs94guRQ+S3xDigp4DxY0",
+	"3gHC/YWSD0CQQ4aeQWZ4Rnqgomg8BA92gUXfPiv2rR/dNXUXPL/9F6ehcazjUZY319zlwrmzBlYPMCJo",
+	"pbRw9BkjmhCmjPOgfTv27TXBOf0yDN06Qozc+mafmA8dk4bk3T3PuTUGneHlyRtOJNf3QIKVq7jvbI9L",
+	"uJygeS5A2yXzOZmpZu31It+FbfHbv5ltOPHI7rwHluiAFcYvs0xtISvSGhTXwT273z8uiPqxGOJRzA52",
+	"zb1ND54vylJpJ5QKoXSRF0LpHgLA5EFrFc6qBlmvxdn1VPRs76420ZbikFCxQQng87kkQzNrdUwHybrQ",
+	"dL3FXF7RGU2QVlGNs6sQJ7S//zqcntT6Q+a6PGSR0D5wDawOGnCOrxAa15f95E6QYgWx5ZUSzw1g2U5H",
+	"zRmU2+o5R/ES2sWQDR6ySLLmuy5c2RFIMoHevZF2HMxsu/a4Zbgksvd/2ypmer75uUJxvrmD2+6u0WIx",
+	"kvd2th8Yr+rGI97kApcdx3yYr6uWrUuW0v+DeNFywJSZB1dewW+RRjamUO5olgup4wXcA6xJzIWl1CPg",
+	"2bWJtJX5itiS/3oF7tUVK+PC22o+N17Sz1b5MEN6uQGLKVfsZ4mm0ibJYUHa4OQxiPvJ9G+S9rB9RV0I",
+	"qWCDi9QngsyIDukcBxupBadZR9MJAKMOsx5FFAZLLE9RZ+gJ2r2pDeH8T0FzaATKVwfpB89raD4coGHn",
+	"5tc9w3Plk8FPNKUMi3VklvFIka/qYCZvhvZsP2nB4cCes1bG7w7YtjCZezxjV0SJfsVNi/zNoWZYskVX",
+	"zlypN50kmldZYtXpsS0q4ILnBNgWfa2BFcHMx8OMjV9q2WpkGuvn6Gk+uyZqX5eCKJVbzXKxMH2wlue5",
+	"gCAdheU1PJzYRB3GbAiHsl6HxQMSZIX1e8pslgNoU7LmRWpOwlSQnEpAcpET5/pl3mXIVzN81xv5O4v4",
+	"Z/tcZfEYlnXw0aJx2VxSA6S+laNEJwfBC954olA2I9sS2G0Q1wR3CVjGb5sgzJmi6ZYuo5L+q3jCKUhc",
+	"loE5Ofp7EzCm/SYHsCXH12aAXhh0t9DAyPdg+soDXRwdk94hOMoJ2N0J1+x0sPKycPunnIY0yVPSbSp2",
+	"LZM7GI0nboyd9fipWo8jZtpi5x/l8nWvddPc0u5mDmvgjZ1UK0u1JjRtLthMKoy9lLJrLd6CP78ZSQa1",
+	"nBqrlWGXTAPpLmOrkrkCk9JYccGOxTjSVXWI8D0s5GV5d2U+vqXs2szRS9AFMDSLu2BtD+pNGckl1liG",
+	"KlzJjvhr9adK6CmI3hIN0lTT5kNYIoHefOA+Ngfu2Pk1O8S8I83NNQDdppDhydpkcfjr5Pw9MvWN4V4D",
+	"Vk73bkIlWhG4cXJmvaUTo63bGAs7XWmCNr7qGxb9hJgqetAa2RJZfcPZCu1bgQyAevnqVQmqFw97rJa3",
+	"6xIKkHUeqaUSizsv6ZKX9Ms/P1z8CuT69oRplXAJ7zckQXlmZJu2HFG1Hv34j8+lmJac9RNzofjKJRHy",
+	"wCRJUG0XEWNqsw2R7laTFB8kEW+IOraD3SOR65kG6okA8VMi5hcPA8YHhnO15IL+iyRm4lcPM/E7opY8",
+	"gQgsnKb8liRV6tVQ8GtKjnItJ//x+dvnqtZaITdHzrD9ETJeQMnGgxlOU80yjeR8zFdZUTP3XM+PrJ2o",
+	"TtEfwJvOVIM817g8dsNXCPy7w5cd+trMzpvU5w3yvaZ85tN6tqVkHYJMt+LypD3xCa8iLY/dWKjNMAld",
+	"h6MxfKV5SCQCuAMxyPkiJfdDkTD0E6bIbRCgQd+WCbBA3JMjwLvSG2U3VHUWv9U2RaddmA4+hXTnAa9H",
+	"uIK+Z3au+1Rmg4l62YaCeqblBe6uxL3FnEZ0FXuBKhmxBZVo7wDPZiRrKflzBN9l8SZrOtYvnsHmmz6j",
+	"+wkvMIObiYLcBA3e/S3UZ1Yeo79/c/IbYpAx2K7tfX/6EgRqrLckQ9Hfh9GX6TO6rwQYevAt0JdZ+Y6+",
+	"WunLYHsD+kr5grJmsoIKLxDQqpvvtygYb2Gg+6ElOIL1+N2E9HA37ZQvFpDCenfBflIX7PKxrqmm7006",
+	"5Queqw5mMAVnenADz9XoidCoBmVHpM/HCmSopy/Zroh+s5dLmg24AgWd+l2DzBHyruhmg/LulcDjkw6/",
+	"D4Uo2t2JNrkThRjsJklBFnoPRJu+alrIVmFqwt7vUatwYDwlxcIhb2fDfxYqhiOhbnFt6z6ZZGhE9CmQ",
+	"GRHEH+HnnoUwXV6ylpRZMMVzTZY1+EXMrnh3CNTcP0vEa3HbkWAqRuDGzdPn++vhFlXKZdLHubO/p1Pg",
+	"XNieM+7JejjtUlk8lULyllg3yqFR5GODFD99qiL34oQBp8Bjs8GuDGwpmmHDGIZd/ddd/dfHjhHZXPJ1",
+	"qAoHQeWaPfAK24NyWJ05gotYSOhlAhxFzpiONHTBUYFs1ckFqkVyTKLe6doU+PJ4cyTjS3K4UDWTxX2V",
+	"5cpR3CpPFc1SKM6qA2kFkZLyiNN2IL2PCzB+1aCfwHqfrWazfTkZR9Aw4RnutXE2NGS14+94DFgTvh6E",
+	"7yVd5TYvdrP1aGIaEbTUEcglgE3c0i3P08RkJFVhevq6LOA3RBSHxZLnQo69SgHnlctiWJ+l4rneyOjG",
+	"jBWQ8sQv8lnx+vZtcC2Y6SiYUN8NxZElHvKgSQvj+9olohyoSZy2dve9x77veRlT35t7E4QJxQvGpbIp",
+	"IKKpYCdW6cmImBGm8MKrtYzcxiRcoa9IqJ8McZYznKkc8iMownROiGDqcdBFEyuSDGdyyZUsVb+XQfl7",
+	"OfZR8illRBZVDwSeAQp17hLbnQaFDhplpnlHPQkQ8p8tKQ0+vAmswEuHnAz2FfFMWYX04VO6dgrEMIFr",
+	"Cej5zhD2pASjocSC15ISj96TZNSxPXvGNb/FQUPH3mFkmiFBMi6p4mJtijF3qmnWdYOya+Ou/x8ucQpE",
+	"XHpM9qpiBdkD4jvxNEWPhtbVSK1BvJM3jyxvgKtjlHRPomaFKYN+M7J3S1nSVhmlyMcV9EK2V1kPq4md",
+	"d0WPj9Chb5rrf3fbj8ZDDTlyiNtPZDN21p6KY08MRwVPBfhHZgN6v27Fz2Zz05elJHB1ECo1hMc6+Zxu",
+	"aS4ksUx3cEuZ5vM5OMz4AophnQo7NGGJ7GZC73K0M8vUcNNx+ke2U980Qx+utoN/e35FNcAH1bCsL2Mn",
+	"O4KYRsBUDElbEB5dR3PmSkY2eZRc2hzBCFomgSAxEkSasEmqZCEzWs0Q5tX/om/1xP+UZ5keLzB6I3Y+",
+	"LE9LlbbssQ0flqhtEvgE4RrbCRudxoVlO8OCyicCgmObZ+Zn+4hrkr8JvjJcS9nCvNEaKxYGsQ1FG0yv",
+	"onZqYb20I3HhXoM77v7Pj8+3f/YDDjpOepCuT9ucaA+Anfx5SvLHyIf7dyQRPE25E1CtvqemZC60RhlP",
+	"6WxdvrWXc/SZd2JFpEKuOp4tUWCCa8rZtbq0iksL5X+EI2sZyTtWfGLurG5/7sWttQeT6WSXrryuKXVR",
+	"eOvZnnxuS6+H/NfmXPXs+esekmhblAytKb7j3afEu+WM3Xdn3FY/g3bGtbq2Xj+BdPgKHBG+FgdkxV43",
+	"1tto27kmPnPnlIDHJtf125BZVJu6/hwZ/L49AyxOOhT4Ckc/hgbfVxTJKB3uhNBTcwO4sxzqUuplivem",
+	"Qrtnd+T6qlcMtBLG9jaH2uTtUV02rbhUoNIzheZUyGYJBOUFUvzaAfRcw3D+3YoMPFApksnbI7P1QzMS",
+	"aLLzVLx7Vyi/SZaQc2+CpG+CcoRLFfEhWXnf6iPP6xmxvTKHVdMgII9xhWQO6p4pHla1h1CJ5kRpKdtU",
+	"tKO4ud0b+GdzBAiy0V5A5Wia8tm1RFAhql4YyJaeAhu1c2XV6gZEGsKpMfZOrb5Mle4YhCI2rfgW02jh",
+	"3SnnKcGsaQNW+Ctd5asgvEmSGWcJ6Nl6TB8DV1qJ4hZA8wIODalEklQqf3536MZrgtviYGJajSq53zVs",
+	"ox+/OzyE/TF/vehzBhyhWUoJU3sLwjT7kARCplxl2GsiS/sm8ZyY+p9KrPfRERIkM6+1rkVhN8ArE36l",
+	"f6EMvfzeRHR8YmaLzMBc0AVlOPWhg4gyqQhONIrN4C7mw87QHFSakFXGFUSu/ELWo7b0+A90HbDCK5BF",
+	"fZ32qpT0ONeCnA16rd/l7X/kS8F2qwXEqNfkf2wiX6IjtAhIuCVJEs25KceBtHblAaiJQcYoE5RDJpey",
+	"BuJO/QoLVJUQXeDSKCLUMb9y5/H2lBNTWqWH32FY/KDL4zAok7HzNSx8DQO0DPIyLKF+p8tXE4eVsDO8",
+	"+tAgn8JwspoPoTcvYvTh8q3RFrCthwMVZ2eYIc6KkjBRR8M2bto5DXqnwbAUT7veUdqzx3EUjIBsZhqk",
+	"guyqkLW6Ct6xCtmAs9PeLGWPxGrhxbbfpf5vpvFzTrnzzG/1/9kZgyz9bVr8uNifXQKhXQKh/8SH8oID",
+	"7smubCeQBwnRBjhXCGfISVT0HHoonRRz7o6nhzieHlDmB3t7N+kf0NfOVvYUhVO4QZvLqWqS7ynBggif",
+	"5HscTftNxI2TF7lIRz+ORt8+f/v/DwADeNH1JboDAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
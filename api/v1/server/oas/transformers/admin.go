@@ -0,0 +1,56 @@
+package transformers
+
+import (
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/features"
+)
+
+func ToAdminTenant(row *dbsqlc.ListTenantsWithRunCountsRow) *gen.AdminTenant {
+	return &gen.AdminTenant{
+		Tenant: gen.Tenant{
+			Metadata: *toAPIMetadata(pgUUIDToStr(row.Tenant.ID), row.Tenant.CreatedAt.Time, row.Tenant.UpdatedAt.Time),
+			Name:     row.Tenant.Name,
+			Slug:     row.Tenant.Slug,
+		},
+		IngestionPaused: row.Tenant.IngestionPaused,
+		RunCounts: gen.AdminTenantRunCounts{
+			Total:     int(row.TotalRuns),
+			Pending:   int(row.PendingRuns),
+			Running:   int(row.RunningRuns),
+			Succeeded: int(row.SucceededRuns),
+			Failed:    int(row.FailedRuns),
+		},
+	}
+}
+
+func ToAdminQueue(row *dbsqlc.ListStepRunQueuesRow) *gen.AdminQueue {
+	res := &gen.AdminQueue{
+		TenantId:          pgUUIDToStr(row.TenantId),
+		ActionId:          row.ActionId,
+		PendingAssignment: int(row.PendingAssignment),
+	}
+
+	if row.OldestCreatedAt.Valid {
+		res.OldestCreatedAt = &row.OldestCreatedAt.Time
+	}
+
+	return res
+}
+
+// ToAdminFeatureFlag returns the flag as it applies to a tenant with the given overrides.
+func ToAdminFeatureFlag(def *features.Definition, overrides map[string]bool, instanceEnabled bool) *gen.AdminFeatureFlag {
+	enabled, overridden := overrides[string(def.Flag)]
+
+	if !overridden {
+		enabled = instanceEnabled
+	}
+
+	return &gen.AdminFeatureFlag{
+		Name:            string(def.Flag),
+		Description:     def.Description,
+		Enabled:         enabled,
+		InstanceEnabled: instanceEnabled,
+		Overridden:      overridden,
+	}
+}
@@ -8,6 +8,7 @@ import (
 func ToAPIToken(token *db.APITokenModel) *gen.APIToken {
 	res := &gen.APIToken{
 		Metadata: *toAPIMetadata(token.ID, token.CreatedAt, token.UpdatedAt),
+		Revoked:  &token.Revoked,
 	}
 
 	if expiresAt, ok := token.ExpiresAt(); ok {
@@ -18,5 +19,23 @@ func ToAPIToken(token *db.APITokenModel) *gen.APIToken {
 		res.Name = name
 	}
 
+	if len(token.Scopes) > 0 {
+		scopes := make([]gen.APITokenScope, len(token.Scopes))
+
+		for i, scope := range token.Scopes {
+			scopes[i] = gen.APITokenScope(scope)
+		}
+
+		res.Scopes = &scopes
+	}
+
+	if environment, ok := token.Environment(); ok {
+		res.Environment = &environment
+	}
+
+	if namespace, ok := token.Namespace(); ok {
+		res.Namespace = &namespace
+	}
+
 	return res
 }
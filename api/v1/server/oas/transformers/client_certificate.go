@@ -0,0 +1,27 @@
+package transformers
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func ToClientCertificate(cert *db.ClientCertificateModel) *gen.ClientCertificate {
+	res := &gen.ClientCertificate{
+		Metadata:    *toAPIMetadata(cert.ID, cert.CreatedAt, cert.UpdatedAt),
+		TenantId:    uuid.MustParse(cert.TenantID),
+		Name:        cert.Name,
+		Fingerprint: cert.Fingerprint,
+	}
+
+	if subject, ok := cert.Subject(); ok {
+		res.Subject = &subject
+	}
+
+	if expiresAt, ok := cert.ExpiresAt(); ok {
+		res.ExpiresAt = &expiresAt
+	}
+
+	return res
+}
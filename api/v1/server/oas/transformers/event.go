@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
 
@@ -12,9 +13,14 @@ import (
 
 func ToEvent(event *db.EventModel) *gen.Event {
 	res := &gen.Event{
-		Metadata: *toAPIMetadata(event.ID, event.CreatedAt, event.UpdatedAt),
-		Key:      event.Key,
-		TenantId: event.TenantID,
+		Metadata:  *toAPIMetadata(event.ID, event.CreatedAt, event.UpdatedAt),
+		Key:       event.Key,
+		Namespace: toNamespace(event.Key),
+		TenantId:  event.TenantID,
+	}
+
+	if environment, ok := event.Environment(); ok {
+		res.Environment = &environment
 	}
 
 	return res
@@ -24,9 +30,14 @@ func ToEventFromSQLC(eventRow *dbsqlc.ListEventsRow) *gen.Event {
 	event := eventRow.Event
 
 	res := &gen.Event{
-		Metadata: *toAPIMetadata(pgUUIDToStr(event.ID), event.CreatedAt.Time, event.UpdatedAt.Time),
-		Key:      event.Key,
-		TenantId: pgUUIDToStr(event.TenantId),
+		Metadata:  *toAPIMetadata(pgUUIDToStr(event.ID), event.CreatedAt.Time, event.UpdatedAt.Time),
+		Key:       event.Key,
+		Namespace: toNamespace(event.Key),
+		TenantId:  pgUUIDToStr(event.TenantId),
+	}
+
+	if event.Environment.Valid {
+		res.Environment = &event.Environment.String
 	}
 
 	res.WorkflowRunSummary = &gen.EventWorkflowRunSummary{
@@ -39,6 +50,15 @@ func ToEventFromSQLC(eventRow *dbsqlc.ListEventsRow) *gen.Event {
 	return res
 }
 
+// toNamespace returns the namespace of a workflow name or an event key, which is nil for the default namespace.
+func toNamespace(name string) *string {
+	if namespace, _ := repository.SplitNamespace(name); namespace != "" {
+		return &namespace
+	}
+
+	return nil
+}
+
 func pgUUIDToStr(uuid pgtype.UUID) string {
 	return fmt.Sprintf("%x-%x-%x-%x-%x", uuid.Bytes[0:4], uuid.Bytes[4:6], uuid.Bytes[6:8], uuid.Bytes[8:10], uuid.Bytes[10:16])
 }
@@ -0,0 +1,52 @@
+package transformers
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+func ToEventBusSubscription(subscription *db.EventBusSubscriptionModel) *gen.EventBusSubscription {
+	topics := make([]gen.EventBusTopic, len(subscription.Topics))
+
+	for i, topic := range subscription.Topics {
+		topics[i] = gen.EventBusTopic(topic)
+	}
+
+	res := &gen.EventBusSubscription{
+		Metadata:    *toAPIMetadata(subscription.ID, subscription.CreatedAt, subscription.UpdatedAt),
+		TenantId:    uuid.MustParse(subscription.TenantID),
+		Name:        subscription.Name,
+		Topics:      topics,
+		AckedCursor: int64(subscription.AckedRecordID),
+	}
+
+	if lastPolledAt, ok := subscription.LastPolledAt(); ok {
+		res.LastPolledAt = &lastPolledAt
+	}
+
+	return res
+}
+
+func ToEventBusRecord(record *dbsqlc.EventBusRecord) *gen.EventBusRecord {
+	res := &gen.EventBusRecord{
+		Id:        record.ID,
+		CreatedAt: record.CreatedAt.Time,
+		Topic:     gen.EventBusTopic(record.Topic),
+		Data:      map[string]interface{}{},
+	}
+
+	if record.Data != nil {
+		data := map[string]interface{}{}
+
+		if err := json.Unmarshal(record.Data, &data); err == nil {
+			res.Data = data
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,20 @@
+package transformers
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func ToGiteaWebhook(webhook *db.GiteaWebhookModel, serverUrl string) *gen.GiteaWebhook {
+	return &gen.GiteaWebhook{
+		Metadata:   *toAPIMetadata(webhook.ID, webhook.CreatedAt, webhook.UpdatedAt),
+		TenantId:   uuid.MustParse(webhook.TenantID),
+		RepoOwner:  webhook.RepositoryOwner,
+		RepoName:   webhook.RepositoryName,
+		WebhookUrl: fmt.Sprintf("%s/api/v1/gitea/webhook/%s", serverUrl, webhook.ID),
+	}
+}
@@ -1,7 +1,10 @@
 package transformers
 
 import (
+	"encoding/json"
+
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/integrations/vcs/github"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 )
 
@@ -21,3 +24,50 @@ func ToInstallation(gai *db.GithubAppInstallationModel) *gen.GithubAppInstallati
 
 	return res
 }
+
+func ToGithubWebhook(gw *db.GithubWebhookModel) *gen.GithubWebhook {
+	res := &gen.GithubWebhook{
+		Metadata:         *toAPIMetadata(gw.ID, gw.CreatedAt, gw.UpdatedAt),
+		RepoOwner:        gw.RepositoryOwner,
+		RepoName:         gw.RepositoryName,
+		FailedDeliveries: make([]gen.GithubWebhookDelivery, 0),
+	}
+
+	if hookId, ok := gw.HookID(); ok {
+		id := int64(hookId)
+		res.HookId = &id
+	}
+
+	if reconciledAt, ok := gw.ReconciledAt(); ok {
+		res.ReconciledAt = &reconciledAt
+	}
+
+	if reconcileError, ok := gw.ReconcileError(); ok {
+		res.ReconcileError = &reconcileError
+	}
+
+	if failedDeliveries, ok := gw.FailedDeliveries(); ok {
+		deliveries := []github.WebhookDelivery{}
+
+		if err := json.Unmarshal(failedDeliveries, &deliveries); err == nil {
+			for _, delivery := range deliveries {
+				d := gen.GithubWebhookDelivery{
+					Id:          delivery.ID,
+					Event:       delivery.Event,
+					StatusCode:  delivery.StatusCode,
+					Status:      delivery.Status,
+					DeliveredAt: delivery.DeliveredAt,
+				}
+
+				if delivery.Action != "" {
+					action := delivery.Action
+					d.Action = &action
+				}
+
+				res.FailedDeliveries = append(res.FailedDeliveries, d)
+			}
+		}
+	}
+
+	return res
+}
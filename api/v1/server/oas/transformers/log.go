@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
 )
 
@@ -25,3 +26,22 @@ func ToLogFromSQLC(log *dbsqlc.LogLine) *gen.LogLine {
 
 	return res
 }
+
+func ToLog(log *db.LogLineModel) *gen.LogLine {
+	res := &gen.LogLine{
+		CreatedAt: log.CreatedAt,
+		Message:   log.Message,
+	}
+
+	if metadata, ok := log.Metadata(); ok {
+		meta := map[string]interface{}{}
+
+		err := json.Unmarshal(metadata, &meta)
+
+		if err == nil {
+			res.Metadata = meta
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,32 @@
+package transformers
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+// ToLogSink transforms a log sink, leaving out its config because it contains credentials.
+func ToLogSink(logSink *db.LogSinkModel) *gen.LogSink {
+	res := &gen.LogSink{
+		Metadata:    *toAPIMetadata(logSink.ID, logSink.CreatedAt, logSink.UpdatedAt),
+		TenantId:    uuid.MustParse(logSink.TenantID),
+		Name:        logSink.Name,
+		Kind:        gen.LogSinkKind(logSink.Kind),
+		Destination: logSink.Destination,
+		Enabled:     logSink.Enabled,
+		BatchSize:   logSink.BatchSize,
+		Failures:    logSink.Failures,
+	}
+
+	if lastDeliveredAt, ok := logSink.LastDeliveredAt(); ok {
+		res.LastDeliveredAt = &lastDeliveredAt
+	}
+
+	if lastError, ok := logSink.LastError(); ok {
+		res.LastError = &lastError
+	}
+
+	return res
+}
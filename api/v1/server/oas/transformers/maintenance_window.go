@@ -0,0 +1,19 @@
+package transformers
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func ToMaintenanceWindow(window *db.WorkflowMaintenanceWindowModel) *gen.MaintenanceWindow {
+	return &gen.MaintenanceWindow{
+		Metadata:        *toAPIMetadata(window.ID, window.CreatedAt, window.UpdatedAt),
+		TenantId:        uuid.MustParse(window.TenantID),
+		WorkflowId:      uuid.MustParse(window.WorkflowID),
+		Name:            window.Name,
+		Cron:            window.Cron,
+		DurationSeconds: window.DurationSeconds,
+	}
+}
@@ -0,0 +1,36 @@
+package transformers
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+// ToObjectStorageFeed transforms an object storage feed, leaving out its config because it contains credentials.
+func ToObjectStorageFeed(feed *db.ObjectStorageFeedModel) *gen.ObjectStorageFeed {
+	res := &gen.ObjectStorageFeed{
+		Metadata: *toAPIMetadata(feed.ID, feed.CreatedAt, feed.UpdatedAt),
+		TenantId: uuid.MustParse(feed.TenantID),
+		Name:     feed.Name,
+		Kind:     gen.ObjectStorageFeedKind(feed.Kind),
+		Source:   feed.Source,
+		EventKey: feed.EventKey,
+		Enabled:  feed.Enabled,
+		Failures: feed.Failures,
+	}
+
+	if keyPrefix, ok := feed.KeyPrefix(); ok {
+		res.KeyPrefix = &keyPrefix
+	}
+
+	if lastReceivedAt, ok := feed.LastReceivedAt(); ok {
+		res.LastReceivedAt = &lastReceivedAt
+	}
+
+	if lastError, ok := feed.LastError(); ok {
+		res.LastError = &lastError
+	}
+
+	return res
+}
@@ -0,0 +1,23 @@
+package transformers
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func ToPIIRule(rule *db.PIIRuleModel) *gen.PIIRule {
+	res := &gen.PIIRule{
+		Metadata:         *toAPIMetadata(rule.ID, rule.CreatedAt, rule.UpdatedAt),
+		TenantId:         uuid.MustParse(rule.TenantID),
+		Path:             rule.Path,
+		RetentionSeconds: rule.RetentionSeconds,
+	}
+
+	if purgedUntil, ok := rule.PurgedUntil(); ok {
+		res.PurgedUntil = &purgedUntil
+	}
+
+	return res
+}
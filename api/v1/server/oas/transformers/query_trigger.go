@@ -0,0 +1,33 @@
+package transformers
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+// ToQueryTrigger transforms a query trigger, leaving out its config because it contains credentials.
+func ToQueryTrigger(trigger *db.QueryTriggerModel) *gen.QueryTrigger {
+	res := &gen.QueryTrigger{
+		Metadata:  *toAPIMetadata(trigger.ID, trigger.CreatedAt, trigger.UpdatedAt),
+		TenantId:  uuid.MustParse(trigger.TenantID),
+		Name:      trigger.Name,
+		Query:     trigger.Query,
+		Cron:      trigger.Cron,
+		Mode:      gen.QueryTriggerMode(trigger.Mode),
+		EventKey:  trigger.EventKey,
+		Enabled:   trigger.Enabled,
+		NextRunAt: trigger.NextRunAt,
+	}
+
+	if lastRunAt, ok := trigger.LastRunAt(); ok {
+		res.LastRunAt = &lastRunAt
+	}
+
+	if lastError, ok := trigger.LastError(); ok {
+		res.LastError = &lastError
+	}
+
+	return res
+}
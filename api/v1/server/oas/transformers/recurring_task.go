@@ -0,0 +1,55 @@
+package transformers
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func ToRecurringTask(task *db.RecurringTaskModel) *gen.RecurringTask {
+	res := &gen.RecurringTask{
+		Metadata:  *toAPIMetadata(task.ID, task.CreatedAt, task.UpdatedAt),
+		TenantId:  uuid.MustParse(task.TenantID),
+		Name:      task.Name,
+		Kind:      gen.RecurringTaskKind(task.Kind),
+		Cron:      task.Cron,
+		Enabled:   task.Enabled,
+		NextRunAt: task.NextRunAt,
+	}
+
+	if workflowId, ok := task.WorkflowID(); ok {
+		workflowUUID := uuid.MustParse(workflowId)
+		res.WorkflowId = &workflowUUID
+	}
+
+	if retentionSeconds, ok := task.RetentionSeconds(); ok {
+		retention := (time.Duration(retentionSeconds) * time.Second).String()
+		res.Retention = &retention
+	}
+
+	if eventKey, ok := task.EventKey(); ok {
+		res.EventKey = &eventKey
+	}
+
+	if eventData, ok := task.EventData(); ok {
+		data := map[string]interface{}{}
+
+		if err := json.Unmarshal(eventData, &data); err == nil {
+			res.EventData = &data
+		}
+	}
+
+	if lastRunAt, ok := task.LastRunAt(); ok {
+		res.LastRunAt = &lastRunAt
+	}
+
+	if lastError, ok := task.LastError(); ok {
+		res.LastError = &lastError
+	}
+
+	return res
+}
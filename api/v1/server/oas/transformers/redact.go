@@ -0,0 +1,118 @@
+package transformers
+
+import (
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/datautils/redact"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+// RedactionRules returns the compiled redaction rules of a tenant. Rules are validated when they are set, so
+// invalid rules are skipped.
+func RedactionRules(tenant *db.TenantModel) *redact.Rules {
+	rules, _ := redact.Compile(tenant.RedactionRules) // nolint: errcheck
+
+	return rules
+}
+
+// RedactWorkflowRun redacts the inputs and outputs of the step runs of a workflow run, so that values which
+// were stored before a redaction rule was added are redacted as well.
+func RedactWorkflowRun(run *gen.WorkflowRun, rules *redact.Rules) {
+	if rules.Empty() || run.JobRuns == nil {
+		return
+	}
+
+	for i := range *run.JobRuns {
+		jobRun := &(*run.JobRuns)[i]
+
+		if jobRun.StepRuns == nil {
+			continue
+		}
+
+		for j := range *jobRun.StepRuns {
+			RedactStepRun(&(*jobRun.StepRuns)[j], rules)
+		}
+	}
+}
+
+// RedactStepRun redacts the input and output of a step run.
+func RedactStepRun(stepRun *gen.StepRun, rules *redact.Rules) {
+	if rules.Empty() {
+		return
+	}
+
+	if stepRun.Input != nil {
+		input := rules.RedactString(*stepRun.Input)
+		stepRun.Input = &input
+	}
+
+	if stepRun.Output != nil {
+		output := rules.RedactString(*stepRun.Output)
+		stepRun.Output = &output
+	}
+}
+
+// RedactStepRunAttempt redacts the input and output of a previous attempt of a step run.
+func RedactStepRunAttempt(attempt *gen.StepRunAttempt, rules *redact.Rules) {
+	if rules.Empty() {
+		return
+	}
+
+	if attempt.Input != nil {
+		input := rules.RedactString(*attempt.Input)
+		attempt.Input = &input
+	}
+
+	if attempt.Output != nil {
+		output := rules.RedactString(*attempt.Output)
+		attempt.Output = &output
+	}
+}
+
+// RedactStepRunDiagnostic redacts the input and output of an attempt which was captured for diagnostics.
+func RedactStepRunDiagnostic(diagnostic *gen.StepRunDiagnostic, rules *redact.Rules) {
+	if rules.Empty() {
+		return
+	}
+
+	if diagnostic.Input != nil {
+		input := rules.RedactString(*diagnostic.Input)
+		diagnostic.Input = &input
+	}
+
+	if diagnostic.Output != nil {
+		output := rules.RedactString(*diagnostic.Output)
+		diagnostic.Output = &output
+	}
+}
+
+// RedactWorkflowRunBundle redacts the input of a workflow run bundle and the inputs and outputs of its step runs,
+// since bundles are meant to leave the instance.
+func RedactWorkflowRunBundle(bundle *gen.WorkflowRunBundle, rules *redact.Rules) {
+	if rules.Empty() {
+		return
+	}
+
+	bundle.Input = redactMap(bundle.Input, rules)
+
+	for i := range bundle.StepRuns {
+		stepRun := &bundle.StepRuns[i]
+
+		if stepRun.Input != nil {
+			input := redactMap(*stepRun.Input, rules)
+			stepRun.Input = &input
+		}
+
+		if stepRun.Output != nil {
+			output := redactMap(*stepRun.Output, rules)
+			stepRun.Output = &output
+		}
+	}
+}
+
+func redactMap(m map[string]interface{}, rules *redact.Rules) map[string]interface{} {
+	if res, ok := rules.Redact(m).(map[string]interface{}); ok {
+		return res
+	}
+
+	return m
+}
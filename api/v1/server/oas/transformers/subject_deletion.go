@@ -0,0 +1,36 @@
+package transformers
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+)
+
+func ToSubjectDeletionReport(key string, dryRun bool, report *repository.SubjectDeletionReport) *gen.SubjectDeletionReport {
+	res := &gen.SubjectDeletionReport{
+		Key:                   key,
+		DryRun:                dryRun,
+		WorkflowRunIds:        make([]uuid.UUID, len(report.WorkflowRunIds)),
+		SkippedWorkflowRunIds: make([]uuid.UUID, len(report.SkippedWorkflowRunIds)),
+		HasMore:               report.HasMore,
+		WorkflowRunTriggers:   int(report.WorkflowRunTriggers),
+		Events:                int(report.Events),
+		JobRunLookupData:      int(report.JobRunLookupData),
+		GetGroupKeyRuns:       int(report.GetGroupKeyRuns),
+		StepRuns:              int(report.StepRuns),
+		StepRunResultArchives: int(report.StepRunResultArchives),
+		LogLines:              int(report.LogLines),
+		StreamEvents:          int(report.StreamEvents),
+	}
+
+	for i, id := range report.WorkflowRunIds {
+		res.WorkflowRunIds[i] = uuid.MustParse(id)
+	}
+
+	for i, id := range report.SkippedWorkflowRunIds {
+		res.SkippedWorkflowRunIds[i] = uuid.MustParse(id)
+	}
+
+	return res
+}
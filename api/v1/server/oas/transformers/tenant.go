@@ -1,14 +1,68 @@
 package transformers
 
 import (
+	"encoding/json"
+
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/settings"
 )
 
 func ToTenant(tenant *db.TenantModel) *gen.Tenant {
-	return &gen.Tenant{
+	res := &gen.Tenant{
 		Metadata: *toAPIMetadata(tenant.ID, tenant.CreatedAt, tenant.UpdatedAt),
 		Name:     tenant.Name,
 		Slug:     tenant.Slug,
 	}
+
+	if tenant.RedactionRules != nil {
+		res.RedactionRules = &tenant.RedactionRules
+	}
+
+	assignmentStrategy := gen.WorkerAssignmentStrategy(tenant.AssignmentStrategy)
+	res.AssignmentStrategy = &assignmentStrategy
+
+	pausedTriggerBehavior := gen.PausedTriggerBehavior(tenant.PausedTriggerBehavior)
+	res.Paused = &tenant.Paused
+	res.PausedTriggerBehavior = &pausedTriggerBehavior
+
+	if dependencyHealth, ok := tenant.DependencyHealth(); ok && dependencyHealth != nil {
+		health := map[string]interface{}{}
+
+		if err := json.Unmarshal(dependencyHealth, &health); err == nil {
+			res.DependencyHealth = &health
+		}
+	}
+
+	return res
+}
+
+// ToTenantEngineSettings returns the effective engine settings of a tenant, along with the settings which the tenant
+// overrides. The engine settings are nil if the tenant doesn't override any settings.
+func ToTenantEngineSettings(engineSettings *db.TenantEngineSettingsModel) *gen.TenantEngineSettings {
+	res := &gen.TenantEngineSettings{}
+
+	var overrides *settings.Overrides
+
+	if engineSettings != nil {
+		inner := engineSettings.InnerTenantEngineSettings
+
+		res.Overrides = gen.UpdateTenantEngineSettingsRequest{
+			DefaultStepTimeout: inner.DefaultStepTimeout,
+			ScheduleTimeout:    inner.ScheduleTimeout,
+			DefaultStepRetries: inner.DefaultStepRetries,
+			MaxFanOut:          inner.MaxFanOut,
+		}
+
+		overrides = settings.ParseOverrides(inner.DefaultStepTimeout, inner.ScheduleTimeout, inner.DefaultStepRetries, inner.MaxFanOut)
+	}
+
+	values := overrides.Apply(settings.Defaults())
+
+	res.DefaultStepTimeout = values.DefaultStepTimeout.String()
+	res.ScheduleTimeout = values.ScheduleTimeout.String()
+	res.DefaultStepRetries = values.DefaultStepRetries
+	res.MaxFanOut = values.MaxFanOut
+
+	return res
 }
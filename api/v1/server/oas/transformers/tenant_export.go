@@ -0,0 +1,45 @@
+package transformers
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func ToTenantExport(export *db.TenantExportModel) *gen.TenantExport {
+	res := &gen.TenantExport{
+		Metadata:      *toAPIMetadata(export.ID, export.CreatedAt, export.UpdatedAt),
+		TenantId:      uuid.MustParse(export.TenantID),
+		Status:        gen.TenantExportStatus(export.Status),
+		SectionsDone:  export.SectionsDone,
+		SectionsTotal: export.SectionsTotal,
+		RecordCount:   export.RecordCount,
+	}
+
+	if exportErr, ok := export.Error(); ok {
+		res.Error = &exportErr
+	}
+
+	if startedAt, ok := export.StartedAt(); ok {
+		res.StartedAt = &startedAt
+	}
+
+	if finishedAt, ok := export.FinishedAt(); ok {
+		res.FinishedAt = &finishedAt
+	}
+
+	if archiveSize, ok := export.ArchiveSize(); ok {
+		res.ArchiveSize = &archiveSize
+	}
+
+	if archiveSha256, ok := export.ArchiveSha256(); ok {
+		res.ArchiveSha256 = &archiveSha256
+	}
+
+	if expiresAt, ok := export.ExpiresAt(); ok {
+		res.ExpiresAt = &expiresAt
+	}
+
+	return res
+}
@@ -0,0 +1,36 @@
+package transformers
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+// ToTriggerLink transforms a trigger link, leaving out the hash of its token.
+func ToTriggerLink(link *db.WorkflowTriggerLinkModel) (*gen.TriggerLink, error) {
+	res := &gen.TriggerLink{
+		Metadata:   *toAPIMetadata(link.ID, link.CreatedAt, link.UpdatedAt),
+		TenantId:   uuid.MustParse(link.TenantID),
+		WorkflowId: uuid.MustParse(link.WorkflowID),
+		Name:       link.Name,
+	}
+
+	if defaultInput, ok := link.DefaultInput(); ok && defaultInput != nil {
+		input := map[string]interface{}{}
+
+		if err := json.Unmarshal(defaultInput, &input); err != nil {
+			return nil, err
+		}
+
+		res.DefaultInput = &input
+	}
+
+	if lastTriggeredAt, ok := link.LastTriggeredAt(); ok {
+		res.LastTriggeredAt = &lastTriggeredAt
+	}
+
+	return res, nil
+}
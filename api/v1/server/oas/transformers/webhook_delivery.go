@@ -0,0 +1,34 @@
+package transformers
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+func ToWebhookDelivery(delivery *dbsqlc.WebhookDelivery) *gen.WebhookDelivery {
+	res := &gen.WebhookDelivery{
+		Metadata:  *toAPIMetadata(pgUUIDToStr(delivery.ID), delivery.CreatedAt.Time, delivery.UpdatedAt.Time),
+		TenantId:  uuid.MustParse(pgUUIDToStr(delivery.TenantId)),
+		Source:    gen.WebhookDeliverySource(delivery.Source),
+		WebhookId: uuid.MustParse(pgUUIDToStr(delivery.WebhookId)),
+		Event:     delivery.Event,
+		Nonce:     delivery.Nonce,
+		Status:    gen.WebhookDeliveryStatus(delivery.Status),
+	}
+
+	if delivery.DeliveryId.Valid {
+		res.DeliveryId = &delivery.DeliveryId.String
+	}
+
+	if delivery.SentAt.Valid {
+		res.SentAt = &delivery.SentAt.Time
+	}
+
+	if delivery.Error.Valid {
+		res.Error = &delivery.Error.String
+	}
+
+	return res
+}
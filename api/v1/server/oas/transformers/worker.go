@@ -1,6 +1,8 @@
 package transformers
 
 import (
+	"encoding/json"
+
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
@@ -16,6 +18,18 @@ func ToWorker(worker *db.WorkerModel) *gen.Worker {
 		res.LastHeartbeatAt = &lastHeartbeatAt
 	}
 
+	if environment, ok := worker.Environment(); ok {
+		res.Environment = &environment
+	}
+
+	if buildId, ok := worker.BuildID(); ok {
+		res.BuildId = &buildId
+	}
+
+	if labelsData, ok := worker.Labels(); ok {
+		res.Labels = toWorkerLabels(labelsData)
+	}
+
 	if worker.RelationsWorker.Actions != nil {
 		if actions := worker.Actions(); actions != nil {
 			apiActions := make([]string, len(actions))
@@ -41,5 +55,28 @@ func ToWorkerSqlc(worker *dbsqlc.Worker) *gen.Worker {
 		res.LastHeartbeatAt = &worker.LastHeartbeatAt.Time
 	}
 
+	if worker.Environment.Valid {
+		res.Environment = &worker.Environment.String
+	}
+
+	if worker.BuildId.Valid {
+		res.BuildId = &worker.BuildId.String
+	}
+
+	if len(worker.Labels) > 0 {
+		res.Labels = toWorkerLabels(worker.Labels)
+	}
+
 	return res
 }
+
+func toWorkerLabels(labelsData []byte) *map[string]string {
+	labels := map[string]string{}
+
+	// labels are written by the engine, so labels which can't be read are omitted rather than failing the request
+	if err := json.Unmarshal(labelsData, &labels); err != nil {
+		return nil
+	}
+
+	return &labels
+}
@@ -2,6 +2,7 @@ package transformers
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -14,9 +15,19 @@ import (
 )
 
 func ToWorkflow(workflow *db.WorkflowModel, lastRun *db.WorkflowRunModel) (*gen.Workflow, error) {
+	pausedTriggerBehavior := gen.PausedTriggerBehavior(workflow.PausedTriggerBehavior)
+
 	res := &gen.Workflow{
-		Metadata: *toAPIMetadata(workflow.ID, workflow.CreatedAt, workflow.UpdatedAt),
-		Name:     workflow.Name,
+		Metadata:              *toAPIMetadata(workflow.ID, workflow.CreatedAt, workflow.UpdatedAt),
+		Name:                  workflow.Name,
+		Namespace:             toNamespace(workflow.Name),
+		Paused:                &workflow.Paused,
+		PausedTriggerBehavior: &pausedTriggerBehavior,
+		DiagnosticsSampleRate: &workflow.DiagnosticsSampleRate,
+	}
+
+	if maintenanceUntil, ok := workflow.MaintenanceUntil(); ok && maintenanceUntil.After(time.Now()) {
+		res.MaintenanceUntil = &maintenanceUntil
 	}
 
 	if lastRun != nil {
@@ -106,6 +117,11 @@ func ToWorkflowDeploymentConfig(deploymentConfig *db.WorkflowDeploymentConfigMod
 		res.GithubAppInstallation = apiInstallation
 	}
 
+	if hook, ok := deploymentConfig.PreviewEnvironmentHook(); ok {
+		apiHook := gen.PreviewEnvironmentHook(hook)
+		res.PreviewEnvironmentHook = &apiHook
+	}
+
 	return res, nil
 }
 
@@ -114,12 +130,31 @@ func ToWorkflowVersion(workflow *db.WorkflowModel, version *db.WorkflowVersionMo
 		Metadata:   *toAPIMetadata(version.ID, version.CreatedAt, version.UpdatedAt),
 		WorkflowId: version.WorkflowID,
 		Order:      int32(version.Order),
+		Checksum:   &version.Checksum,
 	}
 
 	if setVersion, ok := version.Version(); ok {
 		res.Version = setVersion
 	}
 
+	if sla, ok := version.Sla(); ok {
+		res.Sla = &sla
+	}
+
+	if maxStepExecutions, ok := version.MaxStepExecutions(); ok {
+		maxStepExecutions32 := int32(maxStepExecutions)
+		res.MaxStepExecutions = &maxStepExecutions32
+	}
+
+	if maxStepRetries, ok := version.MaxStepRetries(); ok {
+		maxStepRetries32 := int32(maxStepRetries)
+		res.MaxStepRetries = &maxStepRetries32
+	}
+
+	if costCenter, ok := version.CostCenter(); ok {
+		res.CostCenter = &costCenter
+	}
+
 	if version.RelationsWorkflowVersion.Jobs != nil {
 		if jobs := version.Jobs(); jobs != nil {
 			apiJobs := make([]gen.Job, len(jobs))
@@ -212,6 +247,10 @@ func ToWorkflowVersionConcurrency(concurrency *db.WorkflowConcurrencyModel) (*ge
 		res.GetConcurrencyGroup = getGroup.ActionID
 	}
 
+	if len(concurrency.KeyExpressions) > 0 {
+		res.KeyExpressions = &concurrency.KeyExpressions
+	}
+
 	return res, nil
 }
 
@@ -228,6 +267,22 @@ func ToWorkflowYAMLBytes(workflow *db.WorkflowModel, version *db.WorkflowVersion
 		res.Description = description
 	}
 
+	if sla, ok := version.Sla(); ok {
+		res.SLA = sla
+	}
+
+	if maxStepExecutions, ok := version.MaxStepExecutions(); ok {
+		res.MaxStepExecutions = int32(maxStepExecutions)
+	}
+
+	if maxStepRetries, ok := version.MaxStepRetries(); ok {
+		res.MaxStepRetries = int32(maxStepRetries)
+	}
+
+	if costCenter, ok := version.CostCenter(); ok {
+		res.CostCenter = costCenter
+	}
+
 	if triggers, ok := version.Triggers(); ok && triggers != nil {
 		triggersResp := types.WorkflowTriggers{}
 
@@ -344,6 +399,13 @@ func ToStep(step *db.StepModel) *gen.Step {
 		res.Timeout = repository.StringPtr(defaults.DefaultStepRunTimeout)
 	}
 
+	joinStrategy := string(step.JoinStrategy)
+	res.JoinStrategy = &joinStrategy
+
+	if joinQuorum, ok := step.JoinQuorum(); ok {
+		res.JoinQuorum = &joinQuorum
+	}
+
 	parents := []string{}
 
 	if step.RelationsStep.Parents != nil {
@@ -371,6 +433,7 @@ func ToWorkflowFromSQLC(row *dbsqlc.Workflow) *gen.Workflow {
 	res := &gen.Workflow{
 		Metadata:    *toAPIMetadata(pgUUIDToStr(row.ID), row.CreatedAt.Time, row.UpdatedAt.Time),
 		Name:        row.Name,
+		Namespace:   toNamespace(row.Name),
 		Description: &row.Description.String,
 	}
 
@@ -396,6 +459,59 @@ func ToWorkflowVersionFromSQLC(row *dbsqlc.WorkflowVersion, workflow *gen.Workfl
 		WorkflowId: pgUUIDToStr(row.WorkflowId),
 		Order:      int32(row.Order),
 		Workflow:   workflow,
+		Checksum:   &row.Checksum,
+	}
+
+	if row.Sla.Valid {
+		res.Sla = &row.Sla.String
+	}
+
+	if row.MaxStepExecutions.Valid {
+		res.MaxStepExecutions = &row.MaxStepExecutions.Int32
+	}
+
+	if row.MaxStepRetries.Valid {
+		res.MaxStepRetries = &row.MaxStepRetries.Int32
+	}
+
+	if row.CostCenter.Valid {
+		res.CostCenter = &row.CostCenter.String
+	}
+
+	return res
+}
+
+func ToWorkflowRollout(rollout *db.WorkflowRolloutModel) *gen.WorkflowRollout {
+	res := &gen.WorkflowRollout{
+		Metadata:             *toAPIMetadata(rollout.ID, rollout.CreatedAt, rollout.UpdatedAt),
+		WorkflowId:           uuid.MustParse(rollout.WorkflowID),
+		Percentage:           rollout.Percentage,
+		FailureRateThreshold: rollout.FailureRateThreshold,
+		WindowSeconds:        rollout.WindowSeconds,
+		MinRuns:              rollout.MinRuns,
+		Status:               gen.WorkflowRolloutStatus(rollout.Status),
+	}
+
+	if fromVersionId, ok := rollout.FromVersionID(); ok {
+		fromVersionUUID := uuid.MustParse(fromVersionId)
+		res.FromVersionId = &fromVersionUUID
+	}
+
+	if toVersionId, ok := rollout.ToVersionID(); ok {
+		toVersionUUID := uuid.MustParse(toVersionId)
+		res.ToVersionId = &toVersionUUID
+	}
+
+	if startedAt, ok := rollout.StartedAt(); ok {
+		res.StartedAt = &startedAt
+	}
+
+	if finishedAt, ok := rollout.FinishedAt(); ok {
+		res.FinishedAt = &finishedAt
+	}
+
+	if failureRate, ok := rollout.FailureRate(); ok {
+		res.FailureRate = &failureRate
 	}
 
 	return res
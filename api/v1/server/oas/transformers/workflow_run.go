@@ -2,14 +2,19 @@ package transformers
 
 import (
 	"encoding/json"
+	"fmt"
 	"sort"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/attestation"
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/backpressure"
 )
 
 func ToWorkflowRun(run *db.WorkflowRunModel) (*gen.WorkflowRun, error) {
@@ -36,6 +41,44 @@ func ToWorkflowRun(run *db.WorkflowRunModel) (*gen.WorkflowRun, error) {
 		res.Error = &runErr
 	}
 
+	res.Debug = &run.Debug
+
+	res.Progress = &gen.WorkflowRunProgress{
+		Total:     run.StepRunsTotal,
+		Running:   run.StepRunsRunning,
+		Succeeded: run.StepRunsSucceeded,
+		Failed:    run.StepRunsFailed,
+		Cancelled: run.StepRunsCancelled,
+	}
+
+	if cancelledSource, ok := run.CancelledSource(); ok {
+		genCancelledSource := gen.CancellationSource(cancelledSource)
+		res.CancelledSource = &genCancelledSource
+	}
+
+	if environment, ok := run.Environment(); ok {
+		res.Environment = &environment
+	}
+
+	if buildId, ok := run.BuildID(); ok {
+		res.BuildId = &buildId
+	}
+
+	if replayOfId, ok := run.ReplayOfID(); ok {
+		replayOfUUID := uuid.MustParse(replayOfId)
+		res.ReplayOfId = &replayOfUUID
+	}
+
+	if additionalMetadataData, ok := run.AdditionalMetadata(); ok {
+		additionalMetadata := map[string]interface{}{}
+
+		if err := json.Unmarshal(additionalMetadataData, &additionalMetadata); err != nil {
+			return nil, fmt.Errorf("could not unmarshal additional metadata of workflow run %s: %w", run.ID, err)
+		}
+
+		res.AdditionalMetadata = &additionalMetadata
+	}
+
 	if run.RelationsWorkflowRun.TriggeredBy != nil {
 		if triggeredBy, ok := run.TriggeredBy(); ok {
 			res.TriggeredBy = *ToWorkflowRunTriggeredBy(triggeredBy)
@@ -185,6 +228,20 @@ func ToStepRun(stepRun *db.StepRunModel) (*gen.StepRun, error) {
 		res.CancelledReason = &cancelledReason
 	}
 
+	if cancelledSource, ok := stepRun.CancelledSource(); ok {
+		genCancelledSource := gen.CancellationSource(cancelledSource)
+		res.CancelledSource = &genCancelledSource
+	}
+
+	if subStatus, ok := stepRun.SubStatus(); ok {
+		genSubStatus := gen.StepRunSubStatus(subStatus)
+		res.SubStatus = &genSubStatus
+	}
+
+	if stepRun.ToleratedByJoin {
+		res.ToleratedByJoin = &stepRun.ToleratedByJoin
+	}
+
 	if runErr, ok := stepRun.Error(); ok {
 		res.Error = &runErr
 	}
@@ -212,6 +269,17 @@ func ToStepRun(stepRun *db.StepRunModel) (*gen.StepRun, error) {
 		res.Output = repository.StringPtr(string(json.RawMessage(outputData)))
 	}
 
+	if stepRun.RelationsStepRun.Logs != nil {
+		logs := make([]gen.LogLine, 0)
+
+		for _, log := range stepRun.Logs() {
+			logCp := log
+			logs = append(logs, *ToLog(&logCp))
+		}
+
+		res.Logs = &logs
+	}
+
 	if jobRun := stepRun.RelationsStepRun.JobRun; jobRun != nil {
 		var err error
 
@@ -222,94 +290,1148 @@ func ToStepRun(stepRun *db.StepRunModel) (*gen.StepRun, error) {
 		}
 	}
 
+	res.Timeline = toStepRunTimeline(stepRun)
+
 	return res, nil
 }
 
-func getEpochFromTime(t time.Time) *int {
-	epoch := int(t.UnixMilli())
-	return &epoch
+// ToStepRunAttempt transforms an archived step run result to a previous attempt of the step run.
+func ToStepRunAttempt(archived *db.StepRunResultArchiveModel) *gen.StepRunAttempt {
+	res := &gen.StepRunAttempt{
+		Metadata:   *toAPIMetadata(archived.ID, archived.CreatedAt, archived.UpdatedAt),
+		StepRunId:  archived.StepRunID,
+		RetryCount: archived.RetryCount,
+	}
+
+	if workerId, ok := archived.WorkerID(); ok {
+		res.WorkerId = &workerId
+	}
+
+	if inputData, ok := archived.Input(); ok {
+		res.Input = repository.StringPtr(string(json.RawMessage(inputData)))
+	}
+
+	if outputData, ok := archived.Output(); ok {
+		res.Output = repository.StringPtr(string(json.RawMessage(outputData)))
+	}
+
+	if runErr, ok := archived.Error(); ok {
+		res.Error = &runErr
+	}
+
+	if startedAt, ok := archived.StartedAt(); ok && !startedAt.IsZero() {
+		res.StartedAt = &startedAt
+	}
+
+	if finishedAt, ok := archived.FinishedAt(); ok && !finishedAt.IsZero() {
+		res.FinishedAt = &finishedAt
+	}
+
+	if timeoutAt, ok := archived.TimeoutAt(); ok && !timeoutAt.IsZero() {
+		res.TimeoutAt = &timeoutAt
+	}
+
+	if cancelledAt, ok := archived.CancelledAt(); ok && !cancelledAt.IsZero() {
+		res.CancelledAt = &cancelledAt
+	}
+
+	if cancelledReason, ok := archived.CancelledReason(); ok {
+		res.CancelledReason = &cancelledReason
+	}
+
+	if cancelledError, ok := archived.CancelledError(); ok {
+		res.CancelledError = &cancelledError
+	}
+
+	return res
 }
 
-func ToWorkflowRunTriggeredBy(triggeredBy *db.WorkflowRunTriggeredByModel) *gen.WorkflowRunTriggeredBy {
-	res := &gen.WorkflowRunTriggeredBy{
-		Metadata: *toAPIMetadata(triggeredBy.ID, triggeredBy.CreatedAt, triggeredBy.UpdatedAt),
-		ParentId: triggeredBy.ParentID,
+func ToStepRunDiagnostic(diagnostic *dbsqlc.StepRunDiagnostic) *gen.StepRunDiagnostic {
+	res := &gen.StepRunDiagnostic{
+		Metadata:   *toAPIMetadata(sqlchelpers.UUIDToStr(diagnostic.ID), diagnostic.CreatedAt.Time, diagnostic.CreatedAt.Time),
+		StepRunId:  sqlchelpers.UUIDToStr(diagnostic.StepRunId),
+		RetryCount: int(diagnostic.RetryCount),
+		Timeline:   toStepRunDiagnosticTimeline(diagnostic),
 	}
 
-	if event, ok := triggeredBy.Event(); ok {
-		res.EventId = &event.ID
-		res.Event = ToEvent(event)
+	if diagnostic.WorkerId.Valid {
+		workerId := sqlchelpers.UUIDToStr(diagnostic.WorkerId)
+		res.WorkerId = &workerId
 	}
 
-	if cron, ok := triggeredBy.Cron(); ok {
-		res.CronSchedule = &cron.Cron
+	if len(diagnostic.Input) > 0 {
+		res.Input = repository.StringPtr(string(diagnostic.Input))
+	}
+
+	if len(diagnostic.Output) > 0 {
+		res.Output = repository.StringPtr(string(diagnostic.Output))
+	}
+
+	if diagnostic.Error.Valid {
+		res.Error = &diagnostic.Error.String
+	}
+
+	if diagnostic.StartedAt.Valid {
+		res.StartedAt = &diagnostic.StartedAt.Time
+	}
+
+	if diagnostic.FinishedAt.Valid {
+		res.FinishedAt = &diagnostic.FinishedAt.Time
+	}
+
+	if diagnostic.TraceParent.Valid {
+		res.TraceParent = &diagnostic.TraceParent.String
+	}
+
+	if diagnostic.RunTraceParent.Valid {
+		res.RunTraceParent = &diagnostic.RunTraceParent.String
 	}
 
 	return res
 }
 
-func ToWorkflowRunFromSQLC(row *dbsqlc.ListWorkflowRunsRow) *gen.WorkflowRun {
-	run := row.WorkflowRun
-	runTriggeredBy := row.WorkflowRunTriggeredBy
+// toStepRunDiagnosticTimeline returns the timeline of a captured attempt, or nil if the attempt was never queued.
+// Unlike the timeline of a step run, the attempt had finished when it was captured, so every time is from the
+// same attempt.
+func toStepRunDiagnosticTimeline(diagnostic *dbsqlc.StepRunDiagnostic) *gen.StepRunTimeline {
+	if !diagnostic.QueuedAt.Valid {
+		return nil
+	}
 
-	workflow := ToWorkflowFromSQLC(&row.Workflow)
-	workflowVersion := ToWorkflowVersionFromSQLC(&row.WorkflowVersion, workflow)
-	var startedAt *time.Time
+	queuedAt := diagnostic.QueuedAt.Time
 
-	if !run.StartedAt.Time.IsZero() {
-		startedAt = &run.StartedAt.Time
+	res := &gen.StepRunTimeline{
+		QueuedAt: &queuedAt,
 	}
 
-	var finishedAt *time.Time
+	if diagnostic.SlotWaitStartedAt.Valid {
+		res.SlotWaitStartedAt = &diagnostic.SlotWaitStartedAt.Time
+		res.QueueMs = getPhaseMs(queuedAt, diagnostic.SlotWaitStartedAt.Time)
+	}
 
-	if !run.FinishedAt.Time.IsZero() {
-		finishedAt = &run.FinishedAt.Time
+	if !diagnostic.AssignedAt.Valid {
+		return res
 	}
 
-	var event *gen.Event
+	assignedAt := diagnostic.AssignedAt.Time
+	res.AssignedAt = &assignedAt
 
-	if row.ID.Valid && row.Key.Valid {
-		event = &gen.Event{
-			Key:      row.Key.String,
-			Metadata: *toAPIMetadata(pgUUIDToStr(row.ID), row.CreatedAt.Time, row.UpdatedAt.Time),
+	if diagnostic.SlotWaitStartedAt.Valid {
+		res.SlotWaitMs = getPhaseMs(diagnostic.SlotWaitStartedAt.Time, assignedAt)
+	} else {
+		slotWaitMs := 0
+
+		res.QueueMs = getPhaseMs(queuedAt, assignedAt)
+		res.SlotWaitMs = &slotWaitMs
+	}
+
+	if !diagnostic.StartedAt.Valid {
+		return res
+	}
+
+	res.DispatchMs = getPhaseMs(assignedAt, diagnostic.StartedAt.Time)
+
+	if diagnostic.FinishedAt.Valid && diagnostic.ResultPersistedAt.Valid {
+		res.ResultPersistedAt = &diagnostic.ResultPersistedAt.Time
+		res.ExecutionMs = getPhaseMs(diagnostic.StartedAt.Time, diagnostic.FinishedAt.Time)
+		res.PersistenceMs = getPhaseMs(diagnostic.FinishedAt.Time, diagnostic.ResultPersistedAt.Time)
+	}
+
+	return res
+}
+
+// toStepRunTimeline returns the timeline of the latest attempt of the step run, or nil if the step run was never
+// queued. The start and finish times are sent by the worker, so they may be left over from a previous attempt until
+// the step run is started again.
+func toStepRunTimeline(stepRun *db.StepRunModel) *gen.StepRunTimeline {
+	queuedAt, ok := stepRun.QueuedAt()
+
+	if !ok || queuedAt.IsZero() {
+		return nil
+	}
+
+	res := &gen.StepRunTimeline{
+		QueuedAt: &queuedAt,
+	}
+
+	slotWaitStartedAt, hasSlotWait := stepRun.SlotWaitStartedAt()
+	assignedAt, hasAssigned := stepRun.AssignedAt()
+
+	if hasSlotWait {
+		res.SlotWaitStartedAt = &slotWaitStartedAt
+		res.QueueMs = getPhaseMs(queuedAt, slotWaitStartedAt)
+	}
+
+	if !hasAssigned {
+		return res
+	}
+
+	res.AssignedAt = &assignedAt
+
+	if hasSlotWait {
+		res.SlotWaitMs = getPhaseMs(slotWaitStartedAt, assignedAt)
+	} else {
+		// the step run was assigned on the first attempt, so it did not wait for a slot
+		slotWaitMs := 0
+
+		res.QueueMs = getPhaseMs(queuedAt, assignedAt)
+		res.SlotWaitMs = &slotWaitMs
+	}
+
+	switch stepRun.Status {
+	case db.StepRunStatusPending, db.StepRunStatusPendingAssignment, db.StepRunStatusWaitingOnDependency, db.StepRunStatusAssigned:
+		return res
+	}
+
+	startedAt, ok := stepRun.StartedAt()
+
+	if !ok {
+		return res
+	}
+
+	res.DispatchMs = getPhaseMs(assignedAt, startedAt)
+
+	finishedAt, hasFinished := stepRun.FinishedAt()
+	resultPersistedAt, hasPersisted := stepRun.ResultPersistedAt()
+
+	if hasFinished && hasPersisted {
+		res.ResultPersistedAt = &resultPersistedAt
+		res.ExecutionMs = getPhaseMs(startedAt, finishedAt)
+		res.PersistenceMs = getPhaseMs(finishedAt, resultPersistedAt)
+	}
+
+	return res
+}
+
+// ToWorkflowRunDag returns the DAG of a workflow run, which must have been fetched with its step runs. Nodes are
+// ordered by job, then by depth, then by the readable id of the step, and edges by their source and target node.
+func ToWorkflowRunDag(run *db.WorkflowRunModel) *gen.WorkflowRunDag {
+	res := &gen.WorkflowRunDag{
+		WorkflowRunId: uuid.MustParse(run.ID),
+		Status:        gen.WorkflowRunStatus(run.Status),
+		Nodes:         []gen.WorkflowRunDagNode{},
+		Edges:         []gen.WorkflowRunDagEdge{},
+	}
+
+	for _, jobRun := range run.JobRuns() {
+		stepRuns := jobRun.StepRuns()
+
+		// steps only depend on steps of the same job, so each job run is its own DAG
+		stepRunsByStepId := make(map[string]*db.StepRunModel, len(stepRuns))
+
+		for i := range stepRuns {
+			stepRunsByStepId[stepRuns[i].StepID] = &stepRuns[i]
+		}
+
+		depths := make(map[string]int, len(stepRuns))
+
+		for i := range stepRuns {
+			stepRun := &stepRuns[i]
+			step := stepRun.Step()
+
+			node := gen.WorkflowRunDagNode{
+				Id:         uuid.MustParse(stepRun.ID),
+				StepId:     uuid.MustParse(stepRun.StepID),
+				Action:     step.ActionID,
+				JobRunId:   uuid.MustParse(jobRun.ID),
+				JobName:    jobRun.Job().Name,
+				Depth:      getStepDepth(step.ID, stepRunsByStepId, depths),
+				Status:     gen.StepRunStatus(stepRun.Status),
+				RetryCount: stepRun.RetryCount,
+			}
+
+			if readableId, ok := step.ReadableID(); ok {
+				node.ReadableId = readableId
+			}
+
+			startedAt, hasStarted := stepRun.StartedAt()
+
+			if hasStarted {
+				node.StartedAt = &startedAt
+			}
+
+			if finishedAt, ok := stepRun.FinishedAt(); ok {
+				node.FinishedAt = &finishedAt
+
+				if hasStarted {
+					node.DurationMs = getPhaseMs(startedAt, finishedAt)
+				}
+			}
+
+			res.Nodes = append(res.Nodes, node)
+
+			for _, parent := range step.Parents() {
+				if parentStepRun, ok := stepRunsByStepId[parent.ID]; ok {
+					res.Edges = append(res.Edges, gen.WorkflowRunDagEdge{
+						Source: uuid.MustParse(parentStepRun.ID),
+						Target: node.Id,
+					})
+				}
+			}
 		}
 	}
 
-	triggeredBy := &gen.WorkflowRunTriggeredBy{
-		Metadata: *toAPIMetadata(pgUUIDToStr(runTriggeredBy.ID), runTriggeredBy.CreatedAt.Time, runTriggeredBy.UpdatedAt.Time),
-		ParentId: sqlchelpers.UUIDToStr(runTriggeredBy.ParentId),
+	sort.SliceStable(res.Nodes, func(i, j int) bool {
+		a, b := res.Nodes[i], res.Nodes[j]
+
+		if a.JobName != b.JobName {
+			return a.JobName < b.JobName
+		}
+
+		if a.JobRunId != b.JobRunId {
+			return a.JobRunId.String() < b.JobRunId.String()
+		}
+
+		if a.Depth != b.Depth {
+			return a.Depth < b.Depth
+		}
+
+		if a.ReadableId != b.ReadableId {
+			return a.ReadableId < b.ReadableId
+		}
+
+		return a.Id.String() < b.Id.String()
+	})
+
+	positions := make(map[uuid.UUID]int, len(res.Nodes))
+
+	for i, node := range res.Nodes {
+		positions[node.Id] = i
 	}
 
-	if event != nil {
-		triggeredBy.Event = event
+	sort.SliceStable(res.Edges, func(i, j int) bool {
+		a, b := res.Edges[i], res.Edges[j]
+
+		if a.Source != b.Source {
+			return positions[a.Source] < positions[b.Source]
+		}
+
+		return positions[a.Target] < positions[b.Target]
+	})
+
+	return res
+}
+
+// getStepDepth returns the length of the longest path from a root of the DAG to the step, memoized in depths.
+func getStepDepth(stepId string, stepRunsByStepId map[string]*db.StepRunModel, depths map[string]int) int {
+	if depth, ok := depths[stepId]; ok {
+		return depth
 	}
 
-	workflowRunId := sqlchelpers.UUIDToStr(run.ID)
+	// guards against cycles, which are rejected when the workflow is registered
+	depths[stepId] = 0
 
-	res := &gen.WorkflowRun{
-		Metadata:          *toAPIMetadata(workflowRunId, run.CreatedAt.Time, run.UpdatedAt.Time),
-		DisplayName:       &run.DisplayName.String,
-		TenantId:          pgUUIDToStr(run.TenantId),
-		StartedAt:         startedAt,
-		FinishedAt:        finishedAt,
-		Status:            gen.WorkflowRunStatus(run.Status),
-		WorkflowVersionId: pgUUIDToStr(run.WorkflowVersionId),
-		WorkflowVersion:   workflowVersion,
-		TriggeredBy:       *triggeredBy,
+	depth := 0
+
+	if stepRun, ok := stepRunsByStepId[stepId]; ok {
+		for _, parent := range stepRun.Step().Parents() {
+			if parentDepth := getStepDepth(parent.ID, stepRunsByStepId, depths) + 1; parentDepth > depth {
+				depth = parentDepth
+			}
+		}
+	}
+
+	depths[stepId] = depth
+
+	return depth
+}
+
+// ToWorkflowRunRootCause returns the root cause of a failed workflow run, which must have been fetched with its step
+// runs. The archived results are the previous attempts of the failed step run, newest first.
+func ToWorkflowRunRootCause(
+	run *db.WorkflowRunModel,
+	stepRun *db.StepRunModel,
+	failedAt time.Time,
+	archived []db.StepRunResultArchiveModel,
+	worker *db.WorkerModel,
+) (*gen.WorkflowRunRootCause, error) {
+	apiStepRun, err := ToStepRun(stepRun)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &gen.WorkflowRunRootCause{
+		WorkflowRunId:   uuid.MustParse(run.ID),
+		Status:          gen.WorkflowRunStatus(run.Status),
+		StepRun:         *apiStepRun,
+		FailedAt:        failedAt,
+		Retries:         make([]gen.WorkflowRunRootCauseRetry, 0, len(archived)),
+		PrecedingEvents: []gen.WorkflowRunRootCauseEvent{},
+	}
+
+	if runErr, ok := stepRun.Error(); ok {
+		res.Error = runErr
+	} else if cancelledError, ok := stepRun.CancelledError(); ok {
+		res.Error = cancelledError
+	} else if cancelledReason, ok := stepRun.CancelledReason(); ok {
+		res.Error = cancelledReason
+	}
+
+	for i := len(archived) - 1; i >= 0; i-- {
+		res.Retries = append(res.Retries, toWorkflowRunRootCauseRetry(&archived[i]))
+	}
+
+	if worker != nil {
+		res.Worker = ToWorker(worker)
+	}
+
+	for _, jobRun := range run.JobRuns() {
+		for _, other := range jobRun.StepRuns() {
+			if other.ID == stepRun.ID {
+				continue
+			}
+
+			cancelledAt, ok := other.CancelledAt()
+
+			if !ok || cancelledAt.After(failedAt) {
+				continue
+			}
+
+			event := gen.WorkflowRunRootCauseEvent{
+				StepRunId:  uuid.MustParse(other.ID),
+				OccurredAt: cancelledAt,
+			}
+
+			if readableId, ok := other.Step().ReadableID(); ok {
+				event.ReadableId = readableId
+			}
+
+			if cancelledReason, ok := other.CancelledReason(); ok {
+				event.Reason = cancelledReason
+			}
+
+			if cancelledError, ok := other.CancelledError(); ok {
+				event.Error = &cancelledError
+			}
+
+			res.PrecedingEvents = append(res.PrecedingEvents, event)
+		}
+	}
+
+	sort.SliceStable(res.PrecedingEvents, func(i, j int) bool {
+		return res.PrecedingEvents[i].OccurredAt.Before(res.PrecedingEvents[j].OccurredAt)
+	})
+
+	return res, nil
+}
+
+func toWorkflowRunRootCauseRetry(archived *db.StepRunResultArchiveModel) gen.WorkflowRunRootCauseRetry {
+	res := gen.WorkflowRunRootCauseRetry{}
+
+	if startedAt, ok := archived.StartedAt(); ok && !startedAt.IsZero() {
+		res.StartedAt = &startedAt
+	}
+
+	if finishedAt, ok := archived.FinishedAt(); ok && !finishedAt.IsZero() {
+		res.FinishedAt = &finishedAt
+	}
+
+	if cancelledAt, ok := archived.CancelledAt(); ok && !cancelledAt.IsZero() {
+		res.CancelledAt = &cancelledAt
+	}
+
+	if cancelledReason, ok := archived.CancelledReason(); ok {
+		res.CancelledReason = &cancelledReason
+	}
+
+	if runErr, ok := archived.Error(); ok {
+		res.Error = &runErr
 	}
 
 	return res
 }
 
-func ToPullRequest(pr *db.GithubPullRequestModel) *gen.PullRequest {
-	return &gen.PullRequest{
-		PullRequestBaseBranch: pr.PullRequestBaseBranch,
-		PullRequestHeadBranch: pr.PullRequestHeadBranch,
-		PullRequestID:         pr.PullRequestID,
-		PullRequestNumber:     pr.PullRequestNumber,
-		PullRequestState:      gen.PullRequestState(pr.PullRequestState),
-		PullRequestTitle:      pr.PullRequestTitle,
-		RepositoryName:        pr.RepositoryName,
+// WorkflowRunBundleVersion is the version of the bundle format which is exported. It is changed when a field
+// is changed in a way which older versions of Hatchet cannot import.
+const WorkflowRunBundleVersion = "v1"
+
+// ToWorkflowRunBundle transforms a finished workflow run, which must be populated with its step runs and the event
+// which triggered it, into a portable bundle. Step runs of steps without a readable id are skipped, since they
+// cannot be matched to a step when the bundle is imported.
+func ToWorkflowRunBundle(run *db.WorkflowRunModel, rawDefinition, input []byte) (*gen.WorkflowRunBundle, error) {
+	res := &gen.WorkflowRunBundle{
+		BundleVersion: WorkflowRunBundleVersion,
+		ExportedAt:    time.Now().UTC(),
+		RawDefinition: string(rawDefinition),
+		Status:        gen.WorkflowRunStatus(run.Status),
+		Input:         map[string]interface{}{},
+		StepRuns:      []gen.WorkflowRunBundleStepRun{},
+	}
+
+	if displayName, ok := run.DisplayName(); ok {
+		res.DisplayName = &displayName
+	}
+
+	if runErr, ok := run.Error(); ok {
+		res.Error = &runErr
+	}
+
+	if startedAt, ok := run.StartedAt(); ok && !startedAt.IsZero() {
+		res.StartedAt = &startedAt
+	}
+
+	if finishedAt, ok := run.FinishedAt(); ok && !finishedAt.IsZero() {
+		res.FinishedAt = &finishedAt
+	}
+
+	if len(input) > 0 {
+		if err := json.Unmarshal(input, &res.Input); err != nil {
+			return nil, fmt.Errorf("could not unmarshal workflow run input: %w", err)
+		}
+	}
+
+	if triggeredBy, ok := run.TriggeredBy(); ok {
+		if event, ok := triggeredBy.Event(); ok {
+			res.Event = &gen.WorkflowRunBundleEvent{
+				Key: event.Key,
+			}
+
+			if data, ok := event.Data(); ok {
+				eventData := map[string]interface{}{}
+
+				if err := json.Unmarshal(data, &eventData); err != nil {
+					return nil, fmt.Errorf("could not unmarshal event data: %w", err)
+				}
+
+				res.Event.Data = &eventData
+			}
+		}
+	}
+
+	for _, jobRun := range run.JobRuns() {
+		for _, stepRun := range jobRun.StepRuns() {
+			readableId, ok := stepRun.Step().ReadableID()
+
+			if !ok || readableId == "" {
+				continue
+			}
+
+			bundleStepRun, err := toWorkflowRunBundleStepRun(jobRun.Job().Name, readableId, &stepRun)
+
+			if err != nil {
+				return nil, err
+			}
+
+			res.StepRuns = append(res.StepRuns, *bundleStepRun)
+		}
+	}
+
+	return res, nil
+}
+
+func toWorkflowRunBundleStepRun(jobName, readableId string, stepRun *db.StepRunModel) (*gen.WorkflowRunBundleStepRun, error) {
+	res := &gen.WorkflowRunBundleStepRun{
+		JobName:        jobName,
+		StepReadableId: readableId,
+		Status:         gen.StepRunStatus(stepRun.Status),
+		RetryCount:     stepRun.RetryCount,
+	}
+
+	if inputData, ok := stepRun.Input(); ok {
+		input := map[string]interface{}{}
+
+		if err := json.Unmarshal(inputData, &input); err != nil {
+			return nil, fmt.Errorf("could not unmarshal input of step run %s: %w", stepRun.ID, err)
+		}
+
+		res.Input = &input
+	}
+
+	if outputData, ok := stepRun.Output(); ok {
+		output := map[string]interface{}{}
+
+		if err := json.Unmarshal(outputData, &output); err != nil {
+			return nil, fmt.Errorf("could not unmarshal output of step run %s: %w", stepRun.ID, err)
+		}
+
+		res.Output = &output
+	}
+
+	if runErr, ok := stepRun.Error(); ok {
+		res.Error = &runErr
+	}
+
+	if startedAt, ok := stepRun.StartedAt(); ok && !startedAt.IsZero() {
+		res.StartedAt = &startedAt
+	}
+
+	if finishedAt, ok := stepRun.FinishedAt(); ok && !finishedAt.IsZero() {
+		res.FinishedAt = &finishedAt
+	}
+
+	if cancelledAt, ok := stepRun.CancelledAt(); ok && !cancelledAt.IsZero() {
+		res.CancelledAt = &cancelledAt
+	}
+
+	if cancelledReason, ok := stepRun.CancelledReason(); ok {
+		res.CancelledReason = &cancelledReason
+	}
+
+	return res, nil
+}
+
+// ToAttestationStatement builds the statement of an attestation of a finished workflow run. The run must be fetched with
+// its step runs and their workers, and the input is the input of the run.
+func ToAttestationStatement(run *db.WorkflowRunModel, input []byte) (*attestation.Statement, error) {
+	inputHash, err := attestation.Hash(input)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not hash workflow run input: %w", err)
+	}
+
+	workflowVersion := run.WorkflowVersion()
+
+	res := &attestation.Statement{
+		Version:           attestation.Version,
+		TenantId:          run.TenantID,
+		WorkflowRunId:     run.ID,
+		WorkflowName:      workflowVersion.Workflow().Name,
+		WorkflowVersionId: workflowVersion.ID,
+		Status:            string(run.Status),
+		CreatedAt:         run.CreatedAt.UTC(),
+		InputSHA256:       inputHash,
+		StepRuns:          []attestation.StepRun{},
+	}
+
+	if version, ok := workflowVersion.Version(); ok {
+		res.WorkflowVersion = version
+	}
+
+	if startedAt, ok := run.StartedAt(); ok && !startedAt.IsZero() {
+		startedAt = startedAt.UTC()
+		res.StartedAt = &startedAt
+	}
+
+	if finishedAt, ok := run.FinishedAt(); ok && !finishedAt.IsZero() {
+		finishedAt = finishedAt.UTC()
+		res.FinishedAt = &finishedAt
+	}
+
+	for _, jobRun := range run.JobRuns() {
+		for _, stepRun := range jobRun.StepRuns() {
+			attestedStepRun, err := toAttestationStepRun(jobRun.Job().Name, &stepRun)
+
+			if err != nil {
+				return nil, err
+			}
+
+			res.StepRuns = append(res.StepRuns, *attestedStepRun)
+		}
+	}
+
+	return res, nil
+}
+
+func toAttestationStepRun(jobName string, stepRun *db.StepRunModel) (*attestation.StepRun, error) {
+	res := &attestation.StepRun{
+		StepRunId:  stepRun.ID,
+		JobName:    jobName,
+		Status:     string(stepRun.Status),
+		RetryCount: stepRun.RetryCount,
+	}
+
+	if readableId, ok := stepRun.Step().ReadableID(); ok {
+		res.StepReadableId = readableId
+	}
+
+	if inputData, ok := stepRun.Input(); ok {
+		inputHash, err := attestation.Hash(inputData)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not hash input of step run %s: %w", stepRun.ID, err)
+		}
+
+		res.InputSHA256 = inputHash
+	}
+
+	if outputData, ok := stepRun.Output(); ok {
+		outputHash, err := attestation.Hash(outputData)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not hash output of step run %s: %w", stepRun.ID, err)
+		}
+
+		res.OutputSHA256 = outputHash
+	}
+
+	if startedAt, ok := stepRun.StartedAt(); ok && !startedAt.IsZero() {
+		startedAt = startedAt.UTC()
+		res.StartedAt = &startedAt
+	}
+
+	if finishedAt, ok := stepRun.FinishedAt(); ok && !finishedAt.IsZero() {
+		finishedAt = finishedAt.UTC()
+		res.FinishedAt = &finishedAt
+	}
+
+	if worker, ok := stepRun.Worker(); ok {
+		res.Worker = &attestation.Worker{
+			WorkerId: worker.ID,
+			Name:     worker.Name,
+		}
+
+		if buildId, ok := worker.BuildID(); ok {
+			res.Worker.BuildId = buildId
+		}
+	}
+
+	return res, nil
+}
+
+func ToWorkflowRunAttestation(signed string, statement *attestation.Statement) *gen.WorkflowRunAttestation {
+	res := &gen.WorkflowRunAttestation{
+		Attestation: signed,
+		Statement: gen.WorkflowRunAttestationStatement{
+			Version:           statement.Version,
+			TenantId:          statement.TenantId,
+			WorkflowRunId:     statement.WorkflowRunId,
+			WorkflowName:      statement.WorkflowName,
+			WorkflowVersionId: statement.WorkflowVersionId,
+			Status:            gen.WorkflowRunStatus(statement.Status),
+			CreatedAt:         statement.CreatedAt,
+			StartedAt:         statement.StartedAt,
+			FinishedAt:        statement.FinishedAt,
+			InputSha256:       statement.InputSHA256,
+			StepRuns:          make([]gen.WorkflowRunAttestationStepRun, 0, len(statement.StepRuns)),
+		},
+	}
+
+	if statement.WorkflowVersion != "" {
+		res.Statement.WorkflowVersion = &statement.WorkflowVersion
+	}
+
+	for i := range statement.StepRuns {
+		stepRun := &statement.StepRuns[i]
+
+		genStepRun := gen.WorkflowRunAttestationStepRun{
+			StepRunId:  stepRun.StepRunId,
+			JobName:    stepRun.JobName,
+			Status:     gen.StepRunStatus(stepRun.Status),
+			RetryCount: stepRun.RetryCount,
+			StartedAt:  stepRun.StartedAt,
+			FinishedAt: stepRun.FinishedAt,
+		}
+
+		if stepRun.StepReadableId != "" {
+			genStepRun.StepReadableId = &stepRun.StepReadableId
+		}
+
+		if stepRun.InputSHA256 != "" {
+			genStepRun.InputSha256 = &stepRun.InputSHA256
+		}
+
+		if stepRun.OutputSHA256 != "" {
+			genStepRun.OutputSha256 = &stepRun.OutputSHA256
+		}
+
+		if stepRun.Worker != nil {
+			genStepRun.Worker = &gen.WorkflowRunAttestationWorker{
+				WorkerId: stepRun.Worker.WorkerId,
+				Name:     stepRun.Worker.Name,
+			}
+
+			if stepRun.Worker.BuildId != "" {
+				genStepRun.Worker.BuildId = &stepRun.Worker.BuildId
+			}
+		}
+
+		res.Statement.StepRuns = append(res.Statement.StepRuns, genStepRun)
+	}
+
+	return res
+}
+
+// ToStepRunStreamEvent transforms a stream event to a chunk of the output stream of a step run.
+func ToStepRunStreamEvent(streamEvent *dbsqlc.StreamEvent) *gen.StepRunStreamEvent {
+	return &gen.StepRunStreamEvent{
+		CreatedAt:  streamEvent.CreatedAt.Time,
+		Index:      int(streamEvent.Index),
+		RetryCount: int(streamEvent.RetryCount),
+		Message:    streamEvent.Message,
+	}
+}
+
+func ToStepRunActionMetrics(row *dbsqlc.ListStepRunPhaseMetricsRow) *gen.StepRunActionMetrics {
+	return &gen.StepRunActionMetrics{
+		ActionId: row.ActionId,
+		Count:    row.Count,
+		Queue: gen.StepRunPhaseLatency{
+			P50: row.QueueP50,
+			P95: row.QueueP95,
+		},
+		SlotWait: gen.StepRunPhaseLatency{
+			P50: row.SlotWaitP50,
+			P95: row.SlotWaitP95,
+		},
+		Dispatch: gen.StepRunPhaseLatency{
+			P50: row.DispatchP50,
+			P95: row.DispatchP95,
+		},
+		Execution: gen.StepRunPhaseLatency{
+			P50: row.ExecutionP50,
+			P95: row.ExecutionP95,
+		},
+		Persistence: gen.StepRunPhaseLatency{
+			P50: row.PersistenceP50,
+			P95: row.PersistenceP95,
+		},
+	}
+}
+
+func ToStepRunNoCapacityMetrics(row *dbsqlc.ListStepRunsWaitingForWorkerRow) *gen.StepRunNoCapacityMetrics {
+	return &gen.StepRunNoCapacityMetrics{
+		ActionId:     row.ActionId,
+		Count:        row.Count,
+		WaitingSince: row.WaitingSince.Time,
+	}
+}
+
+func ToCostCenterUsageRow(row *dbsqlc.ListCostCenterUsageRow) *gen.CostCenterUsageRow {
+	return &gen.CostCenterUsageRow{
+		CostCenter:       row.CostCenter,
+		Bucket:           row.Bucket.Time,
+		ComputeSeconds:   row.ComputeSeconds,
+		StepRunCount:     row.StepRunCount,
+		WorkflowRunCount: row.WorkflowRunCount,
+	}
+}
+
+func ToWorkflowRunMetricsRow(row *dbsqlc.ListWorkflowRunMetricsRow) *gen.WorkflowRunMetricsRow {
+	res := &gen.WorkflowRunMetricsRow{
+		WorkflowId:   uuid.MustParse(sqlchelpers.UUIDToStr(row.WorkflowId)),
+		WorkflowName: row.WorkflowName,
+		Bucket:       row.Bucket.Time,
+		Succeeded:    row.Succeeded,
+		Failed:       row.Failed,
+	}
+
+	if finished := row.Succeeded + row.Failed; finished > 0 {
+		res.FailureRate = float64(row.Failed) / float64(finished)
+		res.MeanDurationSeconds = row.DurationSeconds / float64(finished)
+	}
+
+	return res
+}
+
+func getEpochFromTime(t time.Time) *int {
+	epoch := int(t.UnixMilli())
+	return &epoch
+}
+
+// getPhaseMs returns the milliseconds between the start and end of a phase. Worker clocks may be skewed, so a phase
+// which ends before it starts is returned as zero.
+func getPhaseMs(start, end time.Time) *int {
+	ms := int(end.Sub(start).Milliseconds())
+
+	if ms < 0 {
+		ms = 0
+	}
+
+	return &ms
+}
+
+func ToWorkflowRunTriggeredBy(triggeredBy *db.WorkflowRunTriggeredByModel) *gen.WorkflowRunTriggeredBy {
+	res := &gen.WorkflowRunTriggeredBy{
+		Metadata: *toAPIMetadata(triggeredBy.ID, triggeredBy.CreatedAt, triggeredBy.UpdatedAt),
+		ParentId: triggeredBy.ParentID,
+	}
+
+	if eventId, ok := triggeredBy.EventID(); ok {
+		res.EventId = &eventId
+	}
+
+	if event, ok := triggeredBy.Event(); ok {
+		res.EventId = &event.ID
+		res.Event = ToEvent(event)
+	}
+
+	if cron, ok := triggeredBy.Cron(); ok {
+		res.CronSchedule = &cron.Cron
+	}
+
+	return res
+}
+
+func ToWorkflowRunFromSQLC(row *dbsqlc.ListWorkflowRunsRow) *gen.WorkflowRun {
+	run := row.WorkflowRun
+	runTriggeredBy := row.WorkflowRunTriggeredBy
+
+	workflow := ToWorkflowFromSQLC(&row.Workflow)
+	workflowVersion := ToWorkflowVersionFromSQLC(&row.WorkflowVersion, workflow)
+	var startedAt *time.Time
+
+	if !run.StartedAt.Time.IsZero() {
+		startedAt = &run.StartedAt.Time
+	}
+
+	var finishedAt *time.Time
+
+	if !run.FinishedAt.Time.IsZero() {
+		finishedAt = &run.FinishedAt.Time
+	}
+
+	var event *gen.Event
+
+	if row.ID.Valid && row.Key.Valid {
+		event = &gen.Event{
+			Key:      row.Key.String,
+			Metadata: *toAPIMetadata(pgUUIDToStr(row.ID), row.CreatedAt.Time, row.UpdatedAt.Time),
+		}
+	}
+
+	triggeredBy := &gen.WorkflowRunTriggeredBy{
+		Metadata: *toAPIMetadata(pgUUIDToStr(runTriggeredBy.ID), runTriggeredBy.CreatedAt.Time, runTriggeredBy.UpdatedAt.Time),
+		ParentId: sqlchelpers.UUIDToStr(runTriggeredBy.ParentId),
+	}
+
+	if event != nil {
+		triggeredBy.Event = event
+	}
+
+	workflowRunId := sqlchelpers.UUIDToStr(run.ID)
+
+	res := &gen.WorkflowRun{
+		Metadata:          *toAPIMetadata(workflowRunId, run.CreatedAt.Time, run.UpdatedAt.Time),
+		DisplayName:       &run.DisplayName.String,
+		TenantId:          pgUUIDToStr(run.TenantId),
+		StartedAt:         startedAt,
+		FinishedAt:        finishedAt,
+		Status:            gen.WorkflowRunStatus(run.Status),
+		WorkflowVersionId: pgUUIDToStr(run.WorkflowVersionId),
+		WorkflowVersion:   workflowVersion,
+		TriggeredBy:       *triggeredBy,
+		Debug:             &run.Debug,
+		Progress: &gen.WorkflowRunProgress{
+			Total:     int(run.StepRunsTotal),
+			Running:   int(run.StepRunsRunning),
+			Succeeded: int(run.StepRunsSucceeded),
+			Failed:    int(run.StepRunsFailed),
+			Cancelled: int(run.StepRunsCancelled),
+		},
+	}
+
+	if run.CancelledSource.Valid {
+		cancelledSource := gen.CancellationSource(run.CancelledSource.CancellationSource)
+		res.CancelledSource = &cancelledSource
+	}
+
+	if run.Environment.Valid {
+		res.Environment = &run.Environment.String
+	}
+
+	if run.BuildId.Valid {
+		res.BuildId = &run.BuildId.String
+	}
+
+	if run.ReplayOfId.Valid {
+		replayOfId := uuid.UUID(run.ReplayOfId.Bytes)
+		res.ReplayOfId = &replayOfId
+	}
+
+	return res
+}
+
+func ToPullRequest(pr *db.GithubPullRequestModel) *gen.PullRequest {
+	return &gen.PullRequest{
+		PullRequestBaseBranch: pr.PullRequestBaseBranch,
+		PullRequestHeadBranch: pr.PullRequestHeadBranch,
+		PullRequestID:         pr.PullRequestID,
+		PullRequestNumber:     pr.PullRequestNumber,
+		PullRequestState:      gen.PullRequestState(pr.PullRequestState),
+		PullRequestTitle:      pr.PullRequestTitle,
+		RepositoryName:        pr.RepositoryName,
 		RepositoryOwner:       pr.RepositoryOwner,
 	}
 }
+
+func ToGetGroupKeyRun(getGroupKeyRun *db.GetGroupKeyRunModel) *gen.GetGroupKeyRun {
+	res := &gen.GetGroupKeyRun{
+		Metadata:      *toAPIMetadata(getGroupKeyRun.ID, getGroupKeyRun.CreatedAt, getGroupKeyRun.UpdatedAt),
+		TenantId:      getGroupKeyRun.TenantID,
+		WorkflowRunId: getGroupKeyRun.WorkflowRunID,
+		Status:        gen.StepRunStatus(getGroupKeyRun.Status),
+	}
+
+	if workerId, ok := getGroupKeyRun.WorkerID(); ok {
+		res.WorkerId = &workerId
+	}
+
+	if inputData, ok := getGroupKeyRun.Input(); ok {
+		res.Input = repository.StringPtr(string(json.RawMessage(inputData)))
+	}
+
+	if output, ok := getGroupKeyRun.Output(); ok {
+		res.Output = &output
+	}
+
+	if runErr, ok := getGroupKeyRun.Error(); ok {
+		res.Error = &runErr
+	}
+
+	if requeueAfter, ok := getGroupKeyRun.RequeueAfter(); ok && !requeueAfter.IsZero() {
+		res.RequeueAfter = &requeueAfter
+	}
+
+	if scheduleTimeoutAt, ok := getGroupKeyRun.ScheduleTimeoutAt(); ok && !scheduleTimeoutAt.IsZero() {
+		res.ScheduleTimeoutAt = &scheduleTimeoutAt
+	}
+
+	if startedAt, ok := getGroupKeyRun.StartedAt(); ok && !startedAt.IsZero() {
+		res.StartedAt = &startedAt
+	}
+
+	if finishedAt, ok := getGroupKeyRun.FinishedAt(); ok && !finishedAt.IsZero() {
+		res.FinishedAt = &finishedAt
+	}
+
+	if timeoutAt, ok := getGroupKeyRun.TimeoutAt(); ok && !timeoutAt.IsZero() {
+		res.TimeoutAt = &timeoutAt
+	}
+
+	if cancelledAt, ok := getGroupKeyRun.CancelledAt(); ok && !cancelledAt.IsZero() {
+		res.CancelledAt = &cancelledAt
+	}
+
+	if cancelledReason, ok := getGroupKeyRun.CancelledReason(); ok {
+		res.CancelledReason = &cancelledReason
+	}
+
+	if cancelledError, ok := getGroupKeyRun.CancelledError(); ok {
+		res.CancelledError = &cancelledError
+	}
+
+	return res
+}
+
+func ToScheduledWorkflowRun(scheduled *db.WorkflowTriggerScheduledRefModel) (*gen.ScheduledWorkflowRun, error) {
+	// scheduled refs don't track created or updated times, so we use the trigger time for both
+	res := &gen.ScheduledWorkflowRun{
+		Metadata:          *toAPIMetadata(scheduled.ID, scheduled.TriggerAt, scheduled.TriggerAt),
+		WorkflowVersionId: scheduled.ParentID,
+		TriggerAt:         scheduled.TriggerAt,
+	}
+
+	if scheduled.RelationsWorkflowTriggerScheduledRef.Parent != nil {
+		workflowVersion := scheduled.Parent()
+
+		res.WorkflowId = workflowVersion.WorkflowID
+
+		if workflowVersion.RelationsWorkflowVersion.Workflow != nil {
+			res.WorkflowName = workflowVersion.Workflow().Name
+		}
+	}
+
+	if inputData, ok := scheduled.Input(); ok {
+		input := map[string]interface{}{}
+
+		if err := json.Unmarshal(inputData, &input); err != nil {
+			return nil, err
+		}
+
+		res.Input = &input
+	}
+
+	if scheduled.RelationsWorkflowTriggerScheduledRef.Triggered != nil {
+		if triggered, ok := scheduled.Triggered(); ok {
+			res.WorkflowRunId = &triggered.ParentID
+		}
+	}
+
+	return res, nil
+}
+
+func ToWorkflowRunExportRow(row *dbsqlc.ListWorkflowRunsForExportRow, cursor string) *gen.WorkflowRunExportRow {
+	run := row.WorkflowRun
+
+	res := &gen.WorkflowRunExportRow{
+		Cursor:            cursor,
+		Id:                sqlchelpers.UUIDToStr(run.ID),
+		WorkflowId:        sqlchelpers.UUIDToStr(row.WorkflowId),
+		WorkflowName:      row.WorkflowName,
+		WorkflowVersionId: sqlchelpers.UUIDToStr(run.WorkflowVersionId),
+		Status:            gen.WorkflowRunStatus(run.Status),
+		CreatedAt:         run.CreatedAt.Time,
+	}
+
+	if run.DisplayName.Valid {
+		res.DisplayName = &run.DisplayName.String
+	}
+
+	if run.ConcurrencyGroupId.Valid {
+		res.ConcurrencyGroupId = &run.ConcurrencyGroupId.String
+	}
+
+	if run.Error.Valid {
+		res.Error = &run.Error.String
+	}
+
+	if run.StartedAt.Valid && !run.StartedAt.Time.IsZero() {
+		res.StartedAt = &run.StartedAt.Time
+	}
+
+	if run.FinishedAt.Valid && !run.FinishedAt.Time.IsZero() {
+		res.FinishedAt = &run.FinishedAt.Time
+	}
+
+	return res
+}
+
+func ToWorkflowRunBulkRetry(bulkRetry *db.WorkflowRunBulkRetryModel) (*gen.WorkflowRunBulkRetry, error) {
+	res := &gen.WorkflowRunBulkRetry{
+		Metadata:     *toAPIMetadata(bulkRetry.ID, bulkRetry.CreatedAt, bulkRetry.UpdatedAt),
+		TenantId:     bulkRetry.TenantID,
+		Status:       gen.WorkflowRunBulkRetryStatus(bulkRetry.Status),
+		MatchedCount: bulkRetry.MatchedCount,
+		RetriedCount: bulkRetry.RetriedCount,
+		FailedCount:  bulkRetry.FailedCount,
+	}
+
+	// the stored filter uses the same field names as the API request
+	if err := json.Unmarshal(bulkRetry.Filter, &res.Filter); err != nil {
+		return nil, err
+	}
+
+	if errStr, ok := bulkRetry.Error(); ok {
+		res.Error = &errStr
+	}
+
+	if startedAt, ok := bulkRetry.StartedAt(); ok {
+		res.StartedAt = &startedAt
+	}
+
+	if finishedAt, ok := bulkRetry.FinishedAt(); ok {
+		res.FinishedAt = &finishedAt
+	}
+
+	return res, nil
+}
+
+func ToWorkflowRunSLABreach(row *dbsqlc.ListWorkflowRunSLABreachesRow) *gen.WorkflowRunSLABreach {
+	breach := row.WorkflowRunSLABreach
+
+	res := &gen.WorkflowRunSLABreach{
+		Metadata:             *toAPIMetadata(pgUUIDToStr(breach.ID), breach.CreatedAt.Time, breach.CreatedAt.Time),
+		WorkflowId:           uuid.MustParse(pgUUIDToStr(breach.WorkflowId)),
+		WorkflowVersionId:    uuid.MustParse(pgUUIDToStr(row.WorkflowVersionId)),
+		WorkflowRunId:        uuid.MustParse(pgUUIDToStr(breach.WorkflowRunId)),
+		WorkflowRunStatus:    gen.WorkflowRunStatus(row.WorkflowRunStatus),
+		WorkflowRunCreatedAt: row.WorkflowRunCreatedAt.Time,
+		Sla:                  breach.Sla,
+		BreachedAt:           breach.CreatedAt.Time,
+	}
+
+	if row.WorkflowRunFinishedAt.Valid && !row.WorkflowRunFinishedAt.Time.IsZero() {
+		res.WorkflowRunFinishedAt = &row.WorkflowRunFinishedAt.Time
+	}
+
+	return res
+}
+
+func ToBackpressureHint(hint *backpressure.Hint) *gen.BackpressureHint {
+	return &gen.BackpressureHint{
+		QueueDepth:        hint.QueueDepth,
+		RetryAfterSeconds: hint.RetryAfterSeconds(),
+		Shedding:          hint.Shedding,
+	}
+}
@@ -11,24 +11,39 @@ import (
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/authn"
 	"github.com/hatchet-dev/hatchet/api/v1/server/authz"
+	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/admin"
 	apitokens "github.com/hatchet-dev/hatchet/api/v1/server/handlers/api-tokens"
+	clientcertificates "github.com/hatchet-dev/hatchet/api/v1/server/handlers/client-certificates"
+	eventbus "github.com/hatchet-dev/hatchet/api/v1/server/handlers/event-bus"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/events"
 	githubapp "github.com/hatchet-dev/hatchet/api/v1/server/handlers/github-app"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/ingestors"
+	logsinks "github.com/hatchet-dev/hatchet/api/v1/server/handlers/log-sinks"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/logs"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/metadata"
+	objectstoragefeeds "github.com/hatchet-dev/hatchet/api/v1/server/handlers/object-storage-feeds"
+	piirules "github.com/hatchet-dev/hatchet/api/v1/server/handlers/pii-rules"
+	querytriggers "github.com/hatchet-dev/hatchet/api/v1/server/handlers/query-triggers"
+	recurringtasks "github.com/hatchet-dev/hatchet/api/v1/server/handlers/recurring-tasks"
 	stepruns "github.com/hatchet-dev/hatchet/api/v1/server/handlers/step-runs"
+	subjectdeletions "github.com/hatchet-dev/hatchet/api/v1/server/handlers/subject-deletions"
+	tenantexports "github.com/hatchet-dev/hatchet/api/v1/server/handlers/tenant-exports"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/tenants"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/users"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/workers"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/workflows"
 	hatchetmiddleware "github.com/hatchet-dev/hatchet/api/v1/server/middleware"
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/idempotency"
 	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/populator"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/internal/config/server"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository"
 )
 
 type apiService struct {
+	*admin.AdminService
 	*users.UserService
 	*tenants.TenantService
 	*events.EventService
@@ -40,21 +55,40 @@ type apiService struct {
 	*stepruns.StepRunService
 	*githubapp.GithubAppService
 	*ingestors.IngestorsService
+	*logsinks.LogSinkService
+	*objectstoragefeeds.ObjectStorageFeedService
+	*querytriggers.QueryTriggerService
+	*recurringtasks.RecurringTaskService
+	*clientcertificates.ClientCertificateService
+	*piirules.PIIRuleService
+	*subjectdeletions.SubjectDeletionService
+	*tenantexports.TenantExportService
+	*eventbus.EventBusService
 }
 
 func newAPIService(config *server.ServerConfig) *apiService {
 	return &apiService{
-		UserService:      users.NewUserService(config),
-		TenantService:    tenants.NewTenantService(config),
-		EventService:     events.NewEventService(config),
-		LogService:       logs.NewLogService(config),
-		WorkflowService:  workflows.NewWorkflowService(config),
-		WorkerService:    workers.NewWorkerService(config),
-		MetadataService:  metadata.NewMetadataService(config),
-		APITokenService:  apitokens.NewAPITokenService(config),
-		StepRunService:   stepruns.NewStepRunService(config),
-		GithubAppService: githubapp.NewGithubAppService(config),
-		IngestorsService: ingestors.NewIngestorsService(config),
+		AdminService:             admin.NewAdminService(config),
+		UserService:              users.NewUserService(config),
+		TenantService:            tenants.NewTenantService(config),
+		EventService:             events.NewEventService(config),
+		LogService:               logs.NewLogService(config),
+		WorkflowService:          workflows.NewWorkflowService(config),
+		WorkerService:            workers.NewWorkerService(config),
+		MetadataService:          metadata.NewMetadataService(config),
+		APITokenService:          apitokens.NewAPITokenService(config),
+		StepRunService:           stepruns.NewStepRunService(config),
+		GithubAppService:         githubapp.NewGithubAppService(config),
+		IngestorsService:         ingestors.NewIngestorsService(config),
+		LogSinkService:           logsinks.NewLogSinkService(config),
+		ObjectStorageFeedService: objectstoragefeeds.NewObjectStorageFeedService(config),
+		QueryTriggerService:      querytriggers.NewQueryTriggerService(config),
+		RecurringTaskService:     recurringtasks.NewRecurringTaskService(config),
+		ClientCertificateService: clientcertificates.NewClientCertificateService(config),
+		PIIRuleService:           piirules.NewPIIRuleService(config),
+		SubjectDeletionService:   subjectdeletions.NewSubjectDeletionService(config),
+		TenantExportService:      tenantexports.NewTenantExportService(config),
+		EventBusService:          eventbus.NewEventBusService(config),
 	}
 }
 
@@ -62,7 +96,19 @@ type APIServer struct {
 	config *server.ServerConfig
 }
 
+// NewAPIServer returns the API server. The handlers use the API repository of the config, so that their queries
+// don't use the connection pool of the engine.
 func NewAPIServer(config *server.ServerConfig) *APIServer {
+	if config.APIRepository != nil {
+		dc := *config.Config
+		dc.Repository = config.APIRepository
+
+		apiConfig := *config
+		apiConfig.Config = &dc
+
+		config = &apiConfig
+	}
+
 	return &APIServer{
 		config: config,
 	}
@@ -76,6 +122,9 @@ func (t *APIServer) Run() (func() error, error) {
 
 	e := echo.New()
 
+	// handler errors are returned as typed API errors rather than opaque internal server errors
+	e.HTTPErrorHandler = apierrors.HTTPErrorHandler(t.config.Logger)
+
 	// application middleware
 	populatorMW := populator.NewPopulator(t.config)
 
@@ -128,6 +177,116 @@ func (t *APIServer) Run() (func() error, error) {
 		return snsIntegration, snsIntegration.TenantID, nil
 	})
 
+	populatorMW.RegisterGetter("gitea-webhook", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		webhook, err := config.Repository.Gitea().GetGiteaWebhookById(id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return webhook, webhook.TenantID, nil
+	})
+
+	populatorMW.RegisterGetter("log-sink", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		logSink, err := config.Repository.LogSink().GetLogSinkById(id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return logSink, logSink.TenantID, nil
+	})
+
+	populatorMW.RegisterGetter("object-storage-feed", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		feed, err := config.Repository.ObjectStorageFeed().GetObjectStorageFeedById(id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return feed, feed.TenantID, nil
+	})
+
+	populatorMW.RegisterGetter("query-trigger", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		trigger, err := config.Repository.QueryTrigger().GetQueryTriggerById(id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return trigger, trigger.TenantID, nil
+	})
+
+	populatorMW.RegisterGetter("recurring-task", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		task, err := config.Repository.RecurringTask().GetRecurringTaskById(id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return task, task.TenantID, nil
+	})
+
+	populatorMW.RegisterGetter("client-certificate", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		cert, err := config.Repository.ClientCertificate().GetClientCertificateById(id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return cert, cert.TenantID, nil
+	})
+
+	populatorMW.RegisterGetter("pii-rule", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		rule, err := config.Repository.PIIRule().GetPIIRuleById(id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return rule, rule.TenantID, nil
+	})
+
+	populatorMW.RegisterGetter("tenant-export", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		export, err := config.Repository.TenantExport().GetTenantExportById(id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return export, export.TenantID, nil
+	})
+
+	populatorMW.RegisterGetter("event-bus-subscription", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		subscription, err := config.Repository.EventBus().GetEventBusSubscriptionById(id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return subscription, subscription.TenantID, nil
+	})
+
+	populatorMW.RegisterGetter("trigger-link", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		triggerLink, err := config.Repository.TriggerLink().GetTriggerLinkById(id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return triggerLink, triggerLink.TenantID, nil
+	})
+
+	populatorMW.RegisterGetter("maintenance-window", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		window, err := config.Repository.Workflow().GetWorkflowMaintenanceWindowById(id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return window, window.TenantID, nil
+	})
+
 	populatorMW.RegisterGetter("workflow", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
 		workflow, err := config.Repository.Workflow().GetWorkflowById(id)
 
@@ -139,7 +298,8 @@ func (t *APIServer) Run() (func() error, error) {
 	})
 
 	populatorMW.RegisterGetter("workflow-run", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
-		workflowRun, err := config.Repository.WorkflowRun().GetWorkflowRunById(parentId, id)
+		// relations which are expensive to hydrate are fetched by the handlers which need them
+		workflowRun, err := config.Repository.WorkflowRun().GetWorkflowRun(parentId, id, &repository.GetWorkflowRunOpts{})
 
 		if err != nil {
 			return nil, "", err
@@ -148,6 +308,16 @@ func (t *APIServer) Run() (func() error, error) {
 		return workflowRun, workflowRun.TenantID, nil
 	})
 
+	populatorMW.RegisterGetter("bulk-retry", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		bulkRetry, err := config.Repository.WorkflowRun().GetWorkflowRunBulkRetryById(parentId, id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return bulkRetry, bulkRetry.TenantID, nil
+	})
+
 	populatorMW.RegisterGetter("step-run", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
 		stepRun, err := config.Repository.StepRun().GetStepRunById(parentId, id)
 
@@ -190,6 +360,7 @@ func (t *APIServer) Run() (func() error, error) {
 
 	authnMW := authn.NewAuthN(t.config)
 	authzMW := authz.NewAuthZ(t.config)
+	idempotencyMW := idempotency.NewIdempotency(t.config)
 
 	mw, err := hatchetmiddleware.NewMiddlewareHandler(oaspec)
 
@@ -209,9 +380,17 @@ func (t *APIServer) Run() (func() error, error) {
 
 	// register echo middleware
 	e.Use(
+		// the request id is returned to the client and used as the correlation id of every message created
+		// while handling the request
+		middleware.RequestIDWithConfig(middleware.RequestIDConfig{
+			RequestIDHandler: func(c echo.Context, requestId string) {
+				c.SetRequest(c.Request().WithContext(msgqueue.WithCorrelationID(c.Request().Context(), requestId)))
+			},
+		}),
 		middleware.Logger(),
 		middleware.Recover(),
 		allHatchetMiddleware,
+		idempotencyMW.Middleware,
 	)
 
 	service := newAPIService(t.config)
@@ -227,7 +406,11 @@ func (t *APIServer) Run() (func() error, error) {
 	}()
 
 	cleanup := func() error {
-		return e.Shutdown(context.Background())
+		// stop accepting new connections and wait for in-flight requests to finish
+		ctx, cancel := context.WithTimeout(context.Background(), t.config.Runtime.ShutdownTimeout)
+		defer cancel()
+
+		return e.Shutdown(ctx)
 	}
 
 	return cleanup, nil
@@ -0,0 +1,12 @@
+package serverutils
+
+// GetEnvironment returns the environment which a request is scoped to. Requests which are authenticated with
+// an environment token are always scoped to the environment of the token, so the requested environment is
+// only used for requests which aren't.
+func GetEnvironment(ctx ParamContext, requested *string) *string {
+	if environment, ok := ctx.Get("environment").(string); ok && environment != "" {
+		return &environment
+	}
+
+	return requested
+}
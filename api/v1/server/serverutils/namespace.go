@@ -0,0 +1,22 @@
+package serverutils
+
+import "github.com/hatchet-dev/hatchet/internal/repository"
+
+// GetNamespace returns the namespace which a request is scoped to. Requests which are authenticated with a
+// namespace token are always scoped to the namespace of the token, so the requested namespace is only used for
+// requests which aren't.
+func GetNamespace(ctx ParamContext, requested *string) *string {
+	if namespace, ok := ctx.Get("namespace").(string); ok && namespace != "" {
+		return &namespace
+	}
+
+	return requested
+}
+
+// ResolveNamespace resolves a workflow name or an event key to the namespace of the token which authenticated the
+// request. It returns repository.ErrOutsideNamespace if the name belongs to a different namespace.
+func ResolveNamespace(ctx ParamContext, name string) (string, error) {
+	namespace, _ := ctx.Get("namespace").(string)
+
+	return repository.ResolveNamespace(namespace, name)
+}
@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/internal/config/loader"
+	"github.com/hatchet-dev/hatchet/internal/migrate"
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "migrate manages the migrations of the database schema.",
+}
+
+// migrateUpCmd applies the pending migrations
+var migrateUpCmd = &cobra.Command{
+	Use:   "up",
+	Short: "up applies the pending migrations to the database.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		if err := runMigrateUp(configLoader); err != nil {
+			log.Printf("Fatal: could not run migrations: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+// migrateStatusCmd prints the status of the migrations, and exits with a non-zero code on drift
+var migrateStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "status shows which migrations are applied, and whether the database has drifted.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		drift, err := runMigrateStatus(configLoader)
+
+		if err != nil {
+			log.Printf("Fatal: could not get migration status: %v", err)
+			os.Exit(1)
+		}
+
+		if drift {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(migrateCmd)
+	migrateCmd.AddCommand(migrateUpCmd)
+	migrateCmd.AddCommand(migrateStatusCmd)
+}
+
+func newMigrator(cf *loader.ConfigLoader) (*migrate.Migrator, func() error, error) {
+	dc, err := cf.LoadDatabaseConfig()
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not load database config: %w", err)
+	}
+
+	migrations, err := migrate.LoadEmbedded()
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not load migrations: %w", err)
+	}
+
+	return migrate.NewMigrator(dc.Pools["engine"], migrations), dc.Disconnect, nil
+}
+
+func runMigrateUp(cf *loader.ConfigLoader) error {
+	m, disconnect, err := newMigrator(cf)
+
+	if err != nil {
+		return err
+	}
+
+	defer disconnect() // nolint: errcheck
+
+	applied, err := m.Up(context.Background())
+
+	for _, name := range applied {
+		fmt.Printf("applied %s\n", name)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(applied) == 0 {
+		fmt.Println("no pending migrations")
+	}
+
+	return nil
+}
+
+func runMigrateStatus(cf *loader.ConfigLoader) (bool, error) {
+	m, disconnect, err := newMigrator(cf)
+
+	if err != nil {
+		return false, err
+	}
+
+	defer disconnect() // nolint: errcheck
+
+	status, err := m.Status(context.Background())
+
+	if err != nil {
+		return false, err
+	}
+
+	fmt.Println(status)
+
+	return status.HasDrift(), nil
+}
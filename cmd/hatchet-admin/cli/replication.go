@@ -0,0 +1,234 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/internal/config/loader"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/services/replicator"
+)
+
+var replicationCmd = &cobra.Command{
+	Use:   "replication",
+	Short: "replication manages the replication of a primary instance to a standby instance.",
+}
+
+// replicationEnableCmd attaches the replication log triggers on the primary
+var replicationEnableCmd = &cobra.Command{
+	Use:   "enable",
+	Short: "enable starts recording the mutations of the primary in the replication log.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		if err := runReplicationEnable(configLoader); err != nil {
+			log.Printf("Fatal: could not enable replication: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+// replicationDisableCmd detaches the replication log triggers on the primary
+var replicationDisableCmd = &cobra.Command{
+	Use:   "disable",
+	Short: "disable stops recording the mutations of the primary in the replication log.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		if err := runReplicationDisable(configLoader); err != nil {
+			log.Printf("Fatal: could not disable replication: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+// replicationStatusCmd prints the replication log of a primary and the replication state of a standby
+var replicationStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "status shows the replication log and the replication state of the instance.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		if err := runReplicationStatus(configLoader); err != nil {
+			log.Printf("Fatal: could not get replication status: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+// replicationPromoteCmd promotes a standby to a primary, and queues the runs which were queued on the old primary
+var replicationPromoteCmd = &cobra.Command{
+	Use:   "promote",
+	Short: "promote stops replicating to the standby and queues the runs which were queued on the primary.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		if err := runReplicationPromote(configLoader); err != nil {
+			log.Printf("Fatal: could not promote standby: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(replicationCmd)
+	replicationCmd.AddCommand(replicationEnableCmd)
+	replicationCmd.AddCommand(replicationDisableCmd)
+	replicationCmd.AddCommand(replicationStatusCmd)
+	replicationCmd.AddCommand(replicationPromoteCmd)
+}
+
+func runReplicationEnable(cf *loader.ConfigLoader) error {
+	dc, err := cf.LoadDatabaseConfig()
+
+	if err != nil {
+		return fmt.Errorf("could not load database config: %w", err)
+	}
+
+	defer dc.Disconnect() // nolint: errcheck
+
+	err = dc.Repository.Replication().EnableReplicationLog(context.Background())
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("replication log enabled")
+
+	return nil
+}
+
+func runReplicationDisable(cf *loader.ConfigLoader) error {
+	dc, err := cf.LoadDatabaseConfig()
+
+	if err != nil {
+		return fmt.Errorf("could not load database config: %w", err)
+	}
+
+	defer dc.Disconnect() // nolint: errcheck
+
+	err = dc.Repository.Replication().DisableReplicationLog(context.Background())
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("replication log disabled")
+
+	return nil
+}
+
+func runReplicationStatus(cf *loader.ConfigLoader) error {
+	dc, err := cf.LoadDatabaseConfig()
+
+	if err != nil {
+		return fmt.Errorf("could not load database config: %w", err)
+	}
+
+	defer dc.Disconnect() // nolint: errcheck
+
+	ctx := context.Background()
+
+	tables, err := dc.Repository.Replication().ListReplicationLogTables(ctx)
+
+	if err != nil {
+		return fmt.Errorf("could not list replicated tables: %w", err)
+	}
+
+	if len(tables) == 0 {
+		fmt.Println("replication log: disabled")
+	} else {
+		stats, err := dc.Repository.Replication().GetReplicationLogStats(ctx)
+
+		if err != nil {
+			return fmt.Errorf("could not get replication log stats: %w", err)
+		}
+
+		fmt.Printf("replication log: enabled for %s\n", strings.Join(tables, ", "))
+		fmt.Printf("pending entries: %d (last entry %d)\n", stats.Count, stats.LastEntryId)
+
+		if stats.OldestCreatedAt.Valid {
+			fmt.Printf("oldest pending entry: %s\n", stats.OldestCreatedAt.Time)
+		}
+	}
+
+	state, err := dc.Repository.Replication().GetReplicationState(ctx)
+
+	if err != nil {
+		return fmt.Errorf("could not get replication state: %w", err)
+	}
+
+	if state == nil {
+		fmt.Println("replication state: not a standby")
+		return nil
+	}
+
+	fmt.Printf("replication state: %s (last applied entry %d)\n", strings.ToLower(string(state.Role)), state.LastEntryId)
+
+	if state.LastAppliedAt.Valid {
+		fmt.Printf("last applied at: %s\n", state.LastAppliedAt.Time)
+	}
+
+	if state.PromotedAt.Valid {
+		fmt.Printf("promoted at: %s\n", state.PromotedAt.Time)
+	}
+
+	if state.RecoveredAt.Valid {
+		fmt.Printf("recovered at: %s\n", state.RecoveredAt.Time)
+	}
+
+	return nil
+}
+
+func runReplicationPromote(cf *loader.ConfigLoader) error {
+	cleanup, sc, err := cf.LoadServerConfig()
+
+	if err != nil {
+		return fmt.Errorf("could not load server config: %w", err)
+	}
+
+	defer func() {
+		if err := cleanup(); err != nil {
+			log.Printf("could not cleanup server config: %v", err)
+		}
+	}()
+
+	defer sc.Disconnect() // nolint: errcheck
+
+	ctx := context.Background()
+
+	state, err := sc.Repository.Replication().GetReplicationState(ctx)
+
+	if err != nil {
+		return fmt.Errorf("could not get replication state: %w", err)
+	}
+
+	if state != nil && state.Role == dbsqlc.ReplicationRolePRIMARY && state.RecoveredAt.Valid {
+		fmt.Println("the instance has already been promoted")
+		return nil
+	}
+
+	// promoting waits for the batch which the replicator is applying, and stops it from applying more
+	state, err = sc.Repository.Replication().PromoteReplica(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("promoted to primary at entry %d\n", state.LastEntryId)
+
+	workflowRuns, jobRuns, err := replicator.Recover(ctx, sc.Repository, sc.MessageQueue)
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("queued %d workflow runs and %d job runs\n", workflowRuns, jobRuns)
+
+	return nil
+}
@@ -7,12 +7,15 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/hatchet-dev/hatchet/internal/auth/token"
 	"github.com/hatchet-dev/hatchet/internal/config/loader"
 )
 
 var (
-	tokenTenantId string
-	tokenName     string
+	tokenTenantId    string
+	tokenName        string
+	tokenEnvironment string
+	tokenNamespace   string
 )
 
 var tokenCmd = &cobra.Command{
@@ -53,6 +56,20 @@ func init() {
 		"default",
 		"the name of the token",
 	)
+
+	tokenCreateAPICmd.PersistentFlags().StringVar(
+		&tokenEnvironment,
+		"environment",
+		"",
+		"the environment of the token",
+	)
+
+	tokenCreateAPICmd.PersistentFlags().StringVar(
+		&tokenNamespace,
+		"namespace",
+		"",
+		"the namespace of the token",
+	)
 }
 
 func runCreateAPIToken() error {
@@ -72,7 +89,17 @@ func runCreateAPIToken() error {
 
 	defer serverConf.Disconnect() // nolint: errcheck
 
-	defaultTok, err := serverConf.Auth.JWTManager.GenerateTenantToken(tokenTenantId, tokenName)
+	opts := []token.GenerateTokenOpt{}
+
+	if tokenEnvironment != "" {
+		opts = append(opts, token.WithEnvironment(tokenEnvironment))
+	}
+
+	if tokenNamespace != "" {
+		opts = append(opts, token.WithNamespace(tokenNamespace))
+	}
+
+	defaultTok, err := serverConf.Auth.JWTManager.GenerateTenantToken(tokenTenantId, tokenName, opts...)
 
 	if err != nil {
 		return err
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/run"
 	"github.com/hatchet-dev/hatchet/internal/config/loader"
+	"github.com/hatchet-dev/hatchet/internal/config/server"
 	"github.com/hatchet-dev/hatchet/internal/services/worker"
 )
 
@@ -15,6 +16,37 @@ func Start(cf *loader.ConfigLoader, interruptCh <-chan interface{}) error {
 		return fmt.Errorf("error loading server config: %w", err)
 	}
 
+	apiCleanup, err := RunWithConfig(sc)
+	if err != nil {
+		return err
+	}
+
+	sc.Logger.Debug().Msgf("api started successfully")
+
+	<-interruptCh
+
+	sc.Logger.Debug().Msgf("api is shutting down...")
+
+	if err := apiCleanup(); err != nil {
+		return err
+	}
+
+	if err := configCleanup(); err != nil {
+		return err
+	}
+
+	if err := sc.Disconnect(); err != nil {
+		return fmt.Errorf("could not disconnect from database: %w", err)
+	}
+
+	sc.Logger.Debug().Msgf("api successfully shut down")
+
+	return nil
+}
+
+// RunWithConfig starts the API server and the internal worker with a server config which has already
+// been loaded. The returned cleanup function stops them, but does not clean up the server config.
+func RunWithConfig(sc *server.ServerConfig) (func() error, error) {
 	var teardown []func() error
 
 	if sc.InternalClient != nil {
@@ -25,12 +57,12 @@ func Start(cf *loader.ConfigLoader, interruptCh <-chan interface{}) error {
 		)
 
 		if err != nil {
-			return fmt.Errorf("error creating worker: %w", err)
+			return nil, fmt.Errorf("error creating worker: %w", err)
 		}
 
 		workerCleanup, err := w.Start()
 		if err != nil {
-			return fmt.Errorf("error starting worker: %w", err)
+			return nil, fmt.Errorf("error starting worker: %w", err)
 		}
 
 		teardown = append(teardown, workerCleanup)
@@ -40,25 +72,20 @@ func Start(cf *loader.ConfigLoader, interruptCh <-chan interface{}) error {
 
 	apiCleanup, err := runner.Run()
 	if err != nil {
-		return fmt.Errorf("error starting API server: %w", err)
+		return nil, fmt.Errorf("error starting API server: %w", err)
 	}
 
 	teardown = append(teardown, apiCleanup)
-	teardown = append(teardown, configCleanup)
-
-	sc.Logger.Debug().Msgf("api started successfully")
 
-	<-interruptCh
-
-	sc.Logger.Debug().Msgf("api is shutting down...")
-
-	for _, teardown := range teardown {
-		if err := teardown(); err != nil {
-			return err
+	cleanup := func() error {
+		for _, teardown := range teardown {
+			if err := teardown(); err != nil {
+				return err
+			}
 		}
-	}
 
-	sc.Logger.Debug().Msgf("api successfully shut down")
+		return nil
+	}
 
-	return nil
+	return cleanup, nil
 }
@@ -0,0 +1,160 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/internal/attestation"
+)
+
+var (
+	attestationKeys   string
+	attestationIssuer string
+)
+
+var attestationCmd = &cobra.Command{
+	Use:   "attestation",
+	Short: "command for verifying workflow run attestations.",
+}
+
+var attestationVerifyCmd = &cobra.Command{
+	Use:   "verify <file>",
+	Short: "verify a workflow run attestation offline.",
+	Long: `verify a workflow run attestation offline, and print its statement as JSON. The file is either the response of
+the attestation endpoint of a workflow run, or the attestation itself, and - reads it from stdin. The keys are the
+response of /api/v1/meta/attestation-keys of the instance which signed the attestation. The command exits with 1 if
+the attestation is invalid.`,
+	Example: `  hatchet attestation verify attestation.json --keys attestation-keys.json --issuer https://hatchet.example.com`,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := attestationVerify(args[0]); err != nil {
+			log.Printf("Fatal: could not verify attestation: %v\n", err)
+			os.Exit(exitCodeFailed)
+		}
+	},
+}
+
+var attestationHashCmd = &cobra.Command{
+	Use:   "hash <file>",
+	Short: "hash a JSON document like attestations do.",
+	Long: `hash a JSON document like attestations do, so that it can be compared with the inputSha256 and outputSha256
+of an attestation statement. The hash is the hex-encoded SHA-256 of the canonical encoding of the document, so it
+doesn't depend on how the document is formatted. - reads the document from stdin.`,
+	Example: `  hatchet attestation hash output.json`,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := attestationHash(args[0]); err != nil {
+			log.Printf("Fatal: could not hash document: %v\n", err)
+			os.Exit(exitCodeFailed)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(attestationCmd)
+	attestationCmd.AddCommand(attestationVerifyCmd)
+	attestationCmd.AddCommand(attestationHashCmd)
+
+	attestationVerifyCmd.PersistentFlags().StringVar(
+		&attestationKeys,
+		"keys",
+		"",
+		"The path to a JSON file with the attestation keys of the instance.",
+	)
+
+	attestationVerifyCmd.PersistentFlags().StringVar(
+		&attestationIssuer,
+		"issuer",
+		"",
+		"The URL of the instance which must have signed the attestation. If not set, the issuer is not checked.",
+	)
+}
+
+func attestationVerify(path string) error {
+	if attestationKeys == "" {
+		return fmt.Errorf("--keys is required")
+	}
+
+	jwks, err := os.ReadFile(attestationKeys)
+
+	if err != nil {
+		return fmt.Errorf("could not read keys: %w", err)
+	}
+
+	data, err := readFileOrStdin(path)
+
+	if err != nil {
+		return err
+	}
+
+	signed := strings.TrimSpace(string(data))
+
+	// the response of the attestation endpoint contains the attestation next to its unverified statement
+	if strings.HasPrefix(signed, "{") {
+		res := &struct {
+			Attestation string `json:"attestation"`
+		}{}
+
+		if err := json.Unmarshal(data, res); err != nil {
+			return fmt.Errorf("could not unmarshal attestation: %w", err)
+		}
+
+		signed = res.Attestation
+	}
+
+	var issuer *string
+
+	if attestationIssuer != "" {
+		issuer = &attestationIssuer
+	}
+
+	statement, err := attestation.Verify(signed, jwks, issuer)
+
+	if err != nil {
+		return err
+	}
+
+	return printJSON(statement)
+}
+
+func attestationHash(path string) error {
+	data, err := readFileOrStdin(path)
+
+	if err != nil {
+		return err
+	}
+
+	hash, err := attestation.Hash(bytes.TrimSpace(data))
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(hash)
+
+	return nil
+}
+
+func readFileOrStdin(path string) ([]byte, error) {
+	var data []byte
+	var err error
+
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s: %w", path, err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/pkg/client/loader"
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+)
+
+type restClient struct {
+	*rest.ClientWithResponses
+
+	tenantId uuid.UUID
+}
+
+// newRestClient creates a REST client from the client config, which authenticates with the API token.
+func newRestClient() (*restClient, error) {
+	cf, err := (&loader.ConfigLoader{}).LoadClientConfig()
+
+	if err != nil {
+		return nil, fmt.Errorf("could not load client config: %w", err)
+	}
+
+	if cf.TenantId == "" {
+		return nil, fmt.Errorf("tenant id is required. Set it via the HATCHET_CLIENT_TENANT_ID environment variable.")
+	}
+
+	tenantId, err := uuid.Parse(cf.TenantId)
+
+	if err != nil {
+		return nil, fmt.Errorf("tenant id %s is not a valid uuid: %w", cf.TenantId, err)
+	}
+
+	c, err := rest.NewClientWithResponses(cf.ServerURL, rest.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+cf.Token)
+		return nil
+	}))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create rest client: %w", err)
+	}
+
+	return &restClient{
+		ClientWithResponses: c,
+		tenantId:            tenantId,
+	}, nil
+}
+
+// apiError returns an error describing a response which did not have the expected status code.
+func apiError(resp *http.Response, body []byte) error {
+	return fmt.Errorf("unexpected status %s: %s", resp.Status, string(body))
+}
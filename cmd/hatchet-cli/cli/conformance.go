@@ -0,0 +1,251 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/internal/config/loader/loaderutils"
+	"github.com/hatchet-dev/hatchet/internal/config/shared"
+	"github.com/hatchet-dev/hatchet/internal/conformance"
+)
+
+var (
+	conformancePort        int
+	conformanceTLSCertFile string
+	conformanceTLSKeyFile  string
+	conformanceToken       string
+	conformanceScenarios   []string
+	conformanceWait        time.Duration
+	conformanceOutput      string
+)
+
+var conformanceCmd = &cobra.Command{
+	Use:   "conformance",
+	Short: "run the worker SDK conformance scenarios against a worker.",
+	Long: `run the worker SDK conformance scenarios against a worker. The command serves a test dispatcher on --port,
+which a worker under test connects to like it would connect to a Hatchet engine, with the token from --token. It
+waits for a worker which registers the following actions, and then runs each scenario against it:
+
+  conformance:echo         returns the "message" of the workflow input as {"message": ...}
+  conformance:fail         fails with an error
+  conformance:wait         blocks until the step run is cancelled
+  concurrency:conformance  returns the "group" of the workflow input as the concurrency group key
+
+The scenarios check registration, heartbeats, action acks, failures, retries, concurrent step runs, cancellation,
+get group key runs and reconnecting the action stream. The command exits with 1 if any scenario fails.`,
+	Example: `  hatchet conformance --tls-cert-file server.cert --tls-key-file server.key --token test-token --scenario ack,cancellation`,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		passed, err := runConformance(cmd.Context())
+
+		if err != nil {
+			log.Printf("Fatal: conformance run failed: %v\n", err)
+			os.Exit(1)
+		}
+
+		if !passed {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(conformanceCmd)
+
+	conformanceCmd.PersistentFlags().IntVar(
+		&conformancePort,
+		"port",
+		7070,
+		"The port to serve the test dispatcher on.",
+	)
+
+	conformanceCmd.PersistentFlags().StringVar(
+		&conformanceTLSCertFile,
+		"tls-cert-file",
+		"",
+		"The TLS certificate of the test dispatcher. The worker must trust it.",
+	)
+
+	conformanceCmd.PersistentFlags().StringVar(
+		&conformanceTLSKeyFile,
+		"tls-key-file",
+		"",
+		"The TLS key of the test dispatcher.",
+	)
+
+	conformanceCmd.PersistentFlags().StringVar(
+		&conformanceToken,
+		"token",
+		"",
+		"The token which the worker must send on every call.",
+	)
+
+	conformanceCmd.PersistentFlags().StringSliceVar(
+		&conformanceScenarios,
+		"scenario",
+		nil,
+		"The scenarios to run. If not set, all scenarios are run.",
+	)
+
+	conformanceCmd.PersistentFlags().DurationVar(
+		&conformanceWait,
+		"wait",
+		2*time.Minute,
+		"How long to wait for the worker to connect.",
+	)
+
+	conformanceCmd.PersistentFlags().StringVarP(
+		&conformanceOutput,
+		"output",
+		"o",
+		outputTable,
+		"The output format, either table or json.",
+	)
+}
+
+type conformanceResult struct {
+	Scenario    string `json:"scenario"`
+	Description string `json:"description"`
+	Passed      bool   `json:"passed"`
+	Error       string `json:"error,omitempty"`
+	Duration    string `json:"duration"`
+}
+
+func runConformance(ctx context.Context) (bool, error) {
+	if err := validateOutputFormat(conformanceOutput); err != nil {
+		return false, err
+	}
+
+	if conformanceTLSCertFile == "" || conformanceTLSKeyFile == "" {
+		return false, fmt.Errorf("--tls-cert-file and --tls-key-file are required, as workers only connect over TLS")
+	}
+
+	scenarios, err := selectConformanceScenarios(conformanceScenarios)
+
+	if err != nil {
+		return false, err
+	}
+
+	tlsConfig, err := loaderutils.LoadServerTLSConfig(&shared.TLSConfigFile{
+		TLSStrategy: "tls",
+		TLSCertFile: conformanceTLSCertFile,
+		TLSKeyFile:  conformanceTLSKeyFile,
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("could not load TLS config: %w", err)
+	}
+
+	d, err := conformance.NewDriver(conformance.WithToken(conformanceToken))
+
+	if err != nil {
+		return false, err
+	}
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", conformancePort))
+
+	if err != nil {
+		return false, fmt.Errorf("could not listen on port %d: %w", conformancePort, err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		if err := d.Serve(ctx, lis, tlsConfig); err != nil {
+			log.Printf("test dispatcher stopped: %v\n", err)
+		}
+	}()
+
+	log.Printf("waiting for a worker on port %d\n", conformancePort)
+
+	// the wait only applies to the worker connecting, as each scenario has its own timeout
+	waitCtx, cancelWait := context.WithTimeout(ctx, conformanceWait)
+	defer cancelWait()
+
+	workerId, err := d.WaitForWorker(waitCtx)
+
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("running %d scenarios against worker %s\n", len(scenarios), workerId)
+
+	return printConformanceResults(scenarios, conformance.Run(ctx, d, workerId, scenarios))
+}
+
+func selectConformanceScenarios(names []string) ([]conformance.Scenario, error) {
+	all := conformance.Scenarios()
+
+	if len(names) == 0 {
+		return all, nil
+	}
+
+	byName := map[string]conformance.Scenario{}
+
+	for _, s := range all {
+		byName[s.Name] = s
+	}
+
+	res := make([]conformance.Scenario, 0, len(names))
+
+	for _, name := range names {
+		s, ok := byName[name]
+
+		if !ok {
+			return nil, fmt.Errorf("unknown scenario %s", name)
+		}
+
+		res = append(res, s)
+	}
+
+	return res, nil
+}
+
+func printConformanceResults(scenarios []conformance.Scenario, results []conformance.Result) (bool, error) {
+	passed := true
+	out := make([]conformanceResult, 0, len(results))
+
+	for i, res := range results {
+		r := conformanceResult{
+			Scenario:    res.Scenario,
+			Description: scenarios[i].Description,
+			Passed:      res.Err == nil,
+			Duration:    res.Duration.Round(time.Millisecond).String(),
+		}
+
+		if res.Err != nil {
+			passed = false
+			r.Error = res.Err.Error()
+		}
+
+		out = append(out, r)
+	}
+
+	if conformanceOutput == outputJSON {
+		return passed, printJSON(out)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "SCENARIO\tRESULT\tDURATION\tERROR")
+
+	for _, r := range out {
+		result := "PASS"
+
+		if !r.Passed {
+			result = "FAIL"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.Scenario, result, r.Duration, r.Error)
+	}
+
+	return passed, w.Flush()
+}
@@ -0,0 +1,25 @@
+package cli
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+)
+
+// The exit codes of commands which wait for a workflow run, so that scripts can tell why a run did not
+// succeed. Any other error, such as an invalid flag or a failed API request, also exits with exitCodeFailed.
+const (
+	exitCodeSucceeded = 0
+	exitCodeFailed    = 1
+	exitCodeCancelled = 2
+	exitCodeTimedOut  = 3
+)
+
+func exitCodeForStatus(status rest.WorkflowRunStatus) int {
+	switch status {
+	case rest.WorkflowRunStatusSUCCEEDED:
+		return exitCodeSucceeded
+	case rest.WorkflowRunStatusCANCELLED:
+		return exitCodeCancelled
+	default:
+		return exitCodeFailed
+	}
+}
@@ -0,0 +1,573 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"os"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
+	"github.com/hatchet-dev/hatchet/pkg/worker"
+)
+
+const (
+	stepDurationFixed       = "fixed"
+	stepDurationUniform     = "uniform"
+	stepDurationExponential = "exponential"
+)
+
+var (
+	loadtestEventsPerSecond int
+	loadtestDuration        time.Duration
+	loadtestFanOut          int
+	loadtestStepDuration    time.Duration
+	loadtestStepDist        string
+	loadtestConcurrencyKeys int
+	loadtestMaxRuns         int
+	loadtestSlots           int
+	loadtestWarmup          time.Duration
+	loadtestWait            time.Duration
+	loadtestSeed            int64
+	loadtestOutput          string
+)
+
+var loadtestCmd = &cobra.Command{
+	Use:   "loadtest",
+	Short: "generate a synthetic workload against a Hatchet instance and report throughput and latency.",
+	Long: `generate a synthetic workload against a Hatchet instance and report throughput and latency. The command
+registers a temporary workflow with a worker in this process, pushes events which trigger it at a fixed rate, and
+waits for the runs to finish. Each run has --fan-out steps which run in parallel, and each step sleeps for a
+duration drawn from --step-duration-dist with a mean of --step-duration.
+
+The report contains the throughput of runs and steps, the percentiles of the queue latency, which is the time
+between pushing an event and a step of its run starting on the worker, and the rates of failed pushes and of runs
+which did not finish within --wait.`,
+	Example: `  hatchet loadtest --events-per-second 50 --duration 1m --fan-out 3 --step-duration 200ms --concurrency-keys 10`,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := loadtest(cmd.Context()); err != nil {
+			log.Printf("Fatal: load test failed: %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(loadtestCmd)
+
+	loadtestCmd.PersistentFlags().IntVar(
+		&loadtestEventsPerSecond,
+		"events-per-second",
+		10,
+		"The number of events to push per second. Each event triggers one workflow run.",
+	)
+
+	loadtestCmd.PersistentFlags().DurationVar(
+		&loadtestDuration,
+		"duration",
+		30*time.Second,
+		"How long to push events for.",
+	)
+
+	loadtestCmd.PersistentFlags().IntVar(
+		&loadtestFanOut,
+		"fan-out",
+		1,
+		"The number of parallel steps in each workflow run.",
+	)
+
+	loadtestCmd.PersistentFlags().DurationVar(
+		&loadtestStepDuration,
+		"step-duration",
+		0,
+		"The mean time each step sleeps for, to simulate work.",
+	)
+
+	loadtestCmd.PersistentFlags().StringVar(
+		&loadtestStepDist,
+		"step-duration-dist",
+		stepDurationFixed,
+		"The distribution of step durations, either fixed, uniform (between 0 and twice the mean) or exponential.",
+	)
+
+	loadtestCmd.PersistentFlags().IntVar(
+		&loadtestConcurrencyKeys,
+		"concurrency-keys",
+		0,
+		"The number of distinct concurrency keys which runs are spread across. If 0, runs have no concurrency limit.",
+	)
+
+	loadtestCmd.PersistentFlags().IntVar(
+		&loadtestMaxRuns,
+		"max-runs-per-key",
+		1,
+		"The maximum number of concurrent runs per concurrency key, when --concurrency-keys is set.",
+	)
+
+	loadtestCmd.PersistentFlags().IntVar(
+		&loadtestSlots,
+		"slots",
+		0,
+		"The maximum number of steps the worker runs at the same time. If 0, the worker has no limit.",
+	)
+
+	loadtestCmd.PersistentFlags().DurationVar(
+		&loadtestWarmup,
+		"warmup",
+		5*time.Second,
+		"How long to wait after starting the worker before pushing events, so that it is registered.",
+	)
+
+	loadtestCmd.PersistentFlags().DurationVar(
+		&loadtestWait,
+		"wait",
+		time.Minute,
+		"How long to wait for runs to finish after the last event was pushed.",
+	)
+
+	loadtestCmd.PersistentFlags().Int64Var(
+		&loadtestSeed,
+		"seed",
+		1,
+		"The seed of the step durations, so that workloads can be repeated.",
+	)
+
+	loadtestCmd.PersistentFlags().StringVarP(
+		&loadtestOutput,
+		"output",
+		"o",
+		outputTable,
+		"The output format, either table or json.",
+	)
+}
+
+// loadtestEvent is the payload of the pushed events, and the input of the workflow runs.
+type loadtestEvent struct {
+	ID       int64     `json:"id"`
+	Key      string    `json:"key"`
+	PushedAt time.Time `json:"pushed_at"`
+}
+
+type loadtestStepOutput struct {
+	Slept string `json:"slept"`
+}
+
+func loadtest(ctx context.Context) error {
+	if err := validateOutputFormat(loadtestOutput); err != nil {
+		return err
+	}
+
+	if loadtestEventsPerSecond <= 0 {
+		return fmt.Errorf("--events-per-second must be greater than 0")
+	}
+
+	if loadtestFanOut <= 0 {
+		return fmt.Errorf("--fan-out must be greater than 0")
+	}
+
+	sample, err := newDurationSampler(loadtestStepDist, loadtestStepDuration, loadtestSeed)
+
+	if err != nil {
+		return err
+	}
+
+	c, err := client.New()
+
+	if err != nil {
+		return fmt.Errorf("could not create client: %w", err)
+	}
+
+	// the workflow and event are unique to this load test, so that concurrent or earlier load tests don't interfere
+	id := uuid.New().String()[:8]
+	workflowName := "loadtest-" + id
+	eventKey := "loadtest:" + id
+
+	workerOpts := []worker.WorkerOpt{
+		worker.WithClient(c),
+		worker.WithName(workflowName),
+	}
+
+	if loadtestSlots > 0 {
+		workerOpts = append(workerOpts, worker.WithMaxRuns(loadtestSlots))
+	}
+
+	w, err := worker.NewWorker(workerOpts...)
+
+	if err != nil {
+		return fmt.Errorf("could not create worker: %w", err)
+	}
+
+	rec := newLoadtestRecorder(loadtestFanOut)
+
+	if err := w.On(worker.Event(eventKey), loadtestWorkflow(workflowName, rec, sample)); err != nil {
+		return fmt.Errorf("could not register workflow: %w", err)
+	}
+
+	cleanup, err := w.Start()
+
+	if err != nil {
+		return fmt.Errorf("could not start worker: %w", err)
+	}
+
+	defer cleanup() // nolint: errcheck
+
+	fmt.Fprintf(os.Stderr, "registered workflow %s, pushing events in %s\n", workflowName, loadtestWarmup)
+
+	select {
+	case <-time.After(loadtestWarmup):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	rec.begin()
+
+	pushLoadtestEvents(ctx, c, eventKey, rec)
+
+	fmt.Fprintf(os.Stderr, "pushed %d events, waiting up to %s for runs to finish\n", rec.pushedCount(), loadtestWait)
+
+	waitCtx, cancel := context.WithTimeout(ctx, loadtestWait)
+	defer cancel()
+
+	rec.wait(waitCtx)
+
+	return printLoadtestReport(rec.report())
+}
+
+func loadtestWorkflow(name string, rec *loadtestRecorder, sample func() time.Duration) *worker.WorkflowJob {
+	steps := make([]*worker.WorkflowStep, loadtestFanOut)
+
+	for i := range steps {
+		steps[i] = worker.Fn(func(ctx worker.HatchetContext) (*loadtestStepOutput, error) {
+			input := &loadtestEvent{}
+
+			if err := ctx.WorkflowInput(input); err != nil {
+				return nil, err
+			}
+
+			rec.stepStarted(time.Since(input.PushedAt))
+
+			d := sample()
+
+			select {
+			case <-time.After(d):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+
+			rec.stepFinished(input.ID)
+
+			return &loadtestStepOutput{
+				Slept: d.String(),
+			}, nil
+		}).SetName(fmt.Sprintf("step-%d", i))
+	}
+
+	job := &worker.WorkflowJob{
+		Name:        name,
+		Description: "A synthetic workload created by hatchet loadtest.",
+		Steps:       steps,
+	}
+
+	if loadtestConcurrencyKeys > 0 {
+		job.Concurrency = worker.Concurrency(func(ctx worker.HatchetContext) (string, error) {
+			input := &loadtestEvent{}
+
+			if err := ctx.WorkflowInput(input); err != nil {
+				return "", err
+			}
+
+			return input.Key, nil
+		}).MaxRuns(int32(loadtestMaxRuns)).LimitStrategy(types.GroupRoundRobin)
+	}
+
+	return job
+}
+
+// pushLoadtestEvents pushes events at a fixed rate until the duration has passed or the context is done.
+func pushLoadtestEvents(ctx context.Context, c client.Client, eventKey string, rec *loadtestRecorder) {
+	ticker := time.NewTicker(time.Second / time.Duration(loadtestEventsPerSecond))
+	defer ticker.Stop()
+
+	timer := time.NewTimer(loadtestDuration)
+	defer timer.Stop()
+
+	wg := sync.WaitGroup{}
+
+	defer wg.Wait()
+
+	var id int64
+
+	for {
+		select {
+		case <-ticker.C:
+			id++
+
+			event := loadtestEvent{
+				ID:       id,
+				PushedAt: time.Now(),
+			}
+
+			if loadtestConcurrencyKeys > 0 {
+				event.Key = fmt.Sprintf("key-%d", id%int64(loadtestConcurrencyKeys))
+			}
+
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				rec.pushed(c.Event().Push(ctx, eventKey, event))
+			}()
+		case <-timer.C:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// newDurationSampler returns a function which draws step durations from the distribution with the given mean.
+func newDurationSampler(dist string, mean time.Duration, seed int64) (func() time.Duration, error) {
+	mu := sync.Mutex{}
+	r := rand.New(rand.NewSource(seed)) // nolint: gosec
+
+	var draw func() float64
+
+	switch dist {
+	case stepDurationFixed:
+		draw = func() float64 { return 1 }
+	case stepDurationUniform:
+		draw = func() float64 { return 2 * r.Float64() }
+	case stepDurationExponential:
+		draw = r.ExpFloat64
+	default:
+		return nil, fmt.Errorf("unknown step duration distribution %s: must be fixed, uniform or exponential", dist)
+	}
+
+	return func() time.Duration {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return time.Duration(draw() * float64(mean))
+	}, nil
+}
+
+// loadtestRecorder collects the results of a load test from the event pusher and the worker.
+type loadtestRecorder struct {
+	mu sync.Mutex
+
+	fanOut int
+
+	startedAt  time.Time
+	finishedAt time.Time
+
+	pushedEvents int64
+	pushErrors   int64
+
+	stepsExecuted  int64
+	queueLatencies []time.Duration
+
+	// stepsFinished is the number of finished steps by run, until all steps of the run have finished
+	stepsFinished map[int64]int
+	runsCompleted int64
+
+	// done is closed when all pushed runs have completed after pushing has finished
+	pushing bool
+	done    chan struct{}
+}
+
+func newLoadtestRecorder(fanOut int) *loadtestRecorder {
+	return &loadtestRecorder{
+		fanOut:        fanOut,
+		stepsFinished: map[int64]int{},
+		pushing:       true,
+		done:          make(chan struct{}),
+	}
+}
+
+func (r *loadtestRecorder) begin() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.startedAt = time.Now()
+}
+
+func (r *loadtestRecorder) pushed(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err != nil {
+		r.pushErrors++
+		return
+	}
+
+	r.pushedEvents++
+}
+
+func (r *loadtestRecorder) pushedCount() int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.pushedEvents
+}
+
+func (r *loadtestRecorder) stepStarted(queueLatency time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.queueLatencies = append(r.queueLatencies, queueLatency)
+}
+
+func (r *loadtestRecorder) stepFinished(runId int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.stepsExecuted++
+	r.stepsFinished[runId]++
+
+	if r.stepsFinished[runId] == r.fanOut {
+		delete(r.stepsFinished, runId)
+
+		r.runsCompleted++
+		r.finishedAt = time.Now()
+	}
+
+	r.checkDone()
+}
+
+// wait marks the end of pushing, and waits until all pushed runs have completed or the context is done.
+func (r *loadtestRecorder) wait(ctx context.Context) {
+	r.mu.Lock()
+	r.pushing = false
+	r.checkDone()
+	r.mu.Unlock()
+
+	select {
+	case <-r.done:
+	case <-ctx.Done():
+	}
+}
+
+func (r *loadtestRecorder) checkDone() {
+	if r.pushing || r.runsCompleted < r.pushedEvents {
+		return
+	}
+
+	select {
+	case <-r.done:
+	default:
+		close(r.done)
+	}
+}
+
+type loadtestLatencies struct {
+	P50 string `json:"p50"`
+	P90 string `json:"p90"`
+	P99 string `json:"p99"`
+	Max string `json:"max"`
+}
+
+type loadtestReport struct {
+	Elapsed        string            `json:"elapsed"`
+	EventsPushed   int64             `json:"eventsPushed"`
+	PushErrors     int64             `json:"pushErrors"`
+	RunsCompleted  int64             `json:"runsCompleted"`
+	StepsExecuted  int64             `json:"stepsExecuted"`
+	RunsPerSecond  float64           `json:"runsPerSecond"`
+	StepsPerSecond float64           `json:"stepsPerSecond"`
+	QueueLatency   loadtestLatencies `json:"queueLatency"`
+	PushErrorRate  float64           `json:"pushErrorRate"`
+	IncompleteRate float64           `json:"incompleteRate"`
+}
+
+func (r *loadtestRecorder) report() *loadtestReport {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// throughput is measured until the last run completed, so that time spent waiting for runs which never
+	// complete doesn't lower it
+	elapsed := r.finishedAt.Sub(r.startedAt)
+
+	if r.finishedAt.IsZero() {
+		elapsed = time.Since(r.startedAt)
+	}
+
+	latencies := append([]time.Duration{}, r.queueLatencies...)
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+	res := &loadtestReport{
+		Elapsed:        elapsed.Round(time.Millisecond).String(),
+		EventsPushed:   r.pushedEvents,
+		PushErrors:     r.pushErrors,
+		RunsCompleted:  r.runsCompleted,
+		StepsExecuted:  r.stepsExecuted,
+		RunsPerSecond:  rate(float64(r.runsCompleted), elapsed.Seconds()),
+		StepsPerSecond: rate(float64(r.stepsExecuted), elapsed.Seconds()),
+		QueueLatency: loadtestLatencies{
+			P50: percentile(latencies, 50).String(),
+			P90: percentile(latencies, 90).String(),
+			P99: percentile(latencies, 99).String(),
+			Max: percentile(latencies, 100).String(),
+		},
+		PushErrorRate:  rate(float64(r.pushErrors), float64(r.pushErrors+r.pushedEvents)),
+		IncompleteRate: rate(float64(r.pushedEvents-r.runsCompleted), float64(r.pushedEvents)),
+	}
+
+	return res
+}
+
+// percentile returns the nearest-rank percentile of sorted durations.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1].Round(time.Millisecond)
+}
+
+func rate(count, total float64) float64 {
+	if total <= 0 {
+		return 0
+	}
+
+	return math.Round(count/total*1000) / 1000
+}
+
+func printLoadtestReport(report *loadtestReport) error {
+	if loadtestOutput == outputJSON {
+		return printJSON(report)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(w, "ELAPSED\t%s\n", report.Elapsed)
+	fmt.Fprintf(w, "EVENTS PUSHED\t%d\n", report.EventsPushed)
+	fmt.Fprintf(w, "RUNS COMPLETED\t%d\n", report.RunsCompleted)
+	fmt.Fprintf(w, "STEPS EXECUTED\t%d\n", report.StepsExecuted)
+	fmt.Fprintf(w, "RUNS/S\t%.3f\n", report.RunsPerSecond)
+	fmt.Fprintf(w, "STEPS/S\t%.3f\n", report.StepsPerSecond)
+	fmt.Fprintf(w, "QUEUE LATENCY P50\t%s\n", report.QueueLatency.P50)
+	fmt.Fprintf(w, "QUEUE LATENCY P90\t%s\n", report.QueueLatency.P90)
+	fmt.Fprintf(w, "QUEUE LATENCY P99\t%s\n", report.QueueLatency.P99)
+	fmt.Fprintf(w, "QUEUE LATENCY MAX\t%s\n", report.QueueLatency.Max)
+	fmt.Fprintf(w, "PUSH ERROR RATE\t%.3f\n", report.PushErrorRate)
+	fmt.Fprintf(w, "INCOMPLETE RATE\t%.3f\n", report.IncompleteRate)
+
+	return w.Flush()
+}
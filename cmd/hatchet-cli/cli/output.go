@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+func validateOutputFormat(format string) error {
+	switch format {
+	case outputTable, outputJSON:
+		return nil
+	default:
+		return fmt.Errorf("unknown output format %s: must be table or json", format)
+	}
+}
+
+func printJSON(v interface{}) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(v)
+}
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// Version will be linked by an ldflag during build
+var Version = "v0.1.0-alpha.0"
+
+var printVersion bool
+
+// rootCmd represents the base command when called without any subcommands
+var rootCmd = &cobra.Command{
+	Use:   "hatchet",
+	Short: "hatchet interacts with a Hatchet instance through the REST API.",
+	Long: `hatchet interacts with a Hatchet instance through the REST API. It reads the same configuration as the
+Go SDK: the API token is read from HATCHET_CLIENT_TOKEN, and the tenant and server URL are read from the
+token unless HATCHET_CLIENT_TENANT_ID is set.`,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		if printVersion {
+			fmt.Println(Version)
+			os.Exit(0)
+		}
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+	},
+}
+
+// Execute adds all child commands to the root command and sets flags appropriately.
+// This is called by main.main(). It only needs to happen once to the rootCmd.
+func Execute() {
+	rootCmd.PersistentFlags().BoolVar(
+		&printVersion,
+		"version",
+		false,
+		"print version and exit.",
+	)
+
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,375 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+)
+
+var (
+	listStatus     string
+	listSince      time.Duration
+	listWorkflowId string
+	listLimit      int64
+
+	watchInterval time.Duration
+)
+
+var runCmd = &cobra.Command{
+	Use:   "run",
+	Short: "command for inspecting workflow runs.",
+}
+
+var runListCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "list the workflow runs of the tenant, most recent first.",
+	Example: `  hatchet run list --status failed --since 1h`,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := runList(cmd.Context()); err != nil {
+			log.Printf("Fatal: could not list workflow runs: %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var runWatchCmd = &cobra.Command{
+	Use:   "watch <run-id>",
+	Short: "watch a workflow run until it finishes, printing status changes and step logs.",
+	Long: `watch a workflow run until it finishes, printing status changes and step logs. The command exits
+with a non-zero exit code if the workflow run fails or is cancelled, so it can be used in CI:
+
+  1: the workflow run failed
+  2: the workflow run was cancelled`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		status, err := runWatch(cmd.Context(), args[0])
+
+		if err != nil {
+			log.Printf("Fatal: could not watch workflow run: %v\n", err)
+			os.Exit(1)
+		}
+
+		os.Exit(exitCodeForStatus(status))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(runCmd)
+	runCmd.AddCommand(runListCmd)
+	runCmd.AddCommand(runWatchCmd)
+
+	runListCmd.PersistentFlags().StringVar(
+		&listStatus,
+		"status",
+		"",
+		"Only list workflow runs with this status (pending, running, succeeded, failed or cancelled).",
+	)
+
+	runListCmd.PersistentFlags().DurationVar(
+		&listSince,
+		"since",
+		0,
+		"Only list workflow runs created within this duration, for example 1h.",
+	)
+
+	runListCmd.PersistentFlags().StringVar(
+		&listWorkflowId,
+		"workflow-id",
+		"",
+		"Only list runs of this workflow.",
+	)
+
+	runListCmd.PersistentFlags().Int64Var(
+		&listLimit,
+		"limit",
+		50,
+		"The maximum number of workflow runs to list.",
+	)
+
+	runWatchCmd.PersistentFlags().DurationVar(
+		&watchInterval,
+		"interval",
+		2*time.Second,
+		"How often to poll the workflow run.",
+	)
+}
+
+func runList(ctx context.Context) error {
+	c, err := newRestClient()
+
+	if err != nil {
+		return err
+	}
+
+	params := &rest.WorkflowRunListParams{
+		Limit: &listLimit,
+	}
+
+	if listStatus != "" {
+		status := rest.WorkflowRunStatus(strings.ToUpper(listStatus))
+
+		switch status {
+		case rest.WorkflowRunStatusPENDING, rest.WorkflowRunStatusRUNNING, rest.WorkflowRunStatusSUCCEEDED,
+			rest.WorkflowRunStatusFAILED, rest.WorkflowRunStatusCANCELLED:
+		default:
+			return fmt.Errorf("unknown status %s", listStatus)
+		}
+
+		params.Status = &status
+	}
+
+	if listSince != 0 {
+		createdAfter := time.Now().Add(-listSince)
+		params.CreatedAfter = &createdAfter
+	}
+
+	if listWorkflowId != "" {
+		workflowId, err := uuid.Parse(listWorkflowId)
+
+		if err != nil {
+			return fmt.Errorf("workflow id %s is not a valid uuid: %w", listWorkflowId, err)
+		}
+
+		params.WorkflowId = &workflowId
+	}
+
+	resp, err := c.WorkflowRunListWithResponse(ctx, c.tenantId, params)
+
+	if err != nil {
+		return err
+	}
+
+	if resp.JSON200 == nil {
+		return apiError(resp.HTTPResponse, resp.Body)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tWORKFLOW\tSTATUS\tCREATED\tFINISHED")
+
+	if resp.JSON200.Rows != nil {
+		for _, run := range *resp.JSON200.Rows {
+			workflowName := run.WorkflowVersionId
+
+			if run.WorkflowVersion != nil && run.WorkflowVersion.Workflow != nil {
+				workflowName = run.WorkflowVersion.Workflow.Name
+			}
+
+			fmt.Fprintf(
+				w,
+				"%s\t%s\t%s\t%s\t%s\n",
+				run.Metadata.Id,
+				workflowName,
+				run.Status,
+				run.Metadata.CreatedAt.Local().Format(time.RFC3339),
+				formatTime(run.FinishedAt),
+			)
+		}
+	}
+
+	return w.Flush()
+}
+
+// runWatch polls the workflow run until it reaches a final status, and returns that status.
+func runWatch(ctx context.Context, runIdStr string) (rest.WorkflowRunStatus, error) {
+	runId, err := uuid.Parse(runIdStr)
+
+	if err != nil {
+		return "", fmt.Errorf("run id %s is not a valid uuid: %w", runIdStr, err)
+	}
+
+	c, err := newRestClient()
+
+	if err != nil {
+		return "", err
+	}
+
+	var runStatus rest.WorkflowRunStatus
+
+	stepRunStatuses := map[uuid.UUID]rest.StepRunStatus{}
+
+	// logOffsets is the number of log lines which have been printed for each step run
+	logOffsets := map[uuid.UUID]int64{}
+
+	run, err := pollWorkflowRun(ctx, c, runId, watchInterval, func(run *rest.WorkflowRun) error {
+		if run.Status != runStatus {
+			runStatus = run.Status
+			printStatus("workflow run", runStatus)
+		}
+
+		for _, stepRun := range workflowRunStepRuns(run) {
+			stepRunId := stepRun.Metadata.Id
+			stepName := stepRunName(stepRun)
+
+			if prevStatus, ok := stepRunStatuses[stepRunId]; !ok || prevStatus != stepRun.Status {
+				stepRunStatuses[stepRunId] = stepRun.Status
+				printStatus(fmt.Sprintf("step %s", stepName), stepRun.Status)
+
+				if stepRun.Status == rest.StepRunStatusFAILED && stepRun.Error != nil {
+					fmt.Printf("[%s] error: %s\n", stepName, *stepRun.Error)
+				}
+			}
+
+			if err := printNewLogs(ctx, c, stepRunId, stepName, logOffsets); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return run.Status, nil
+}
+
+// pollWorkflowRun polls the workflow run every interval until it reaches a final status, and returns
+// the finished run. onChange is called whenever the workflow run or one of its step runs has changed.
+func pollWorkflowRun(
+	ctx context.Context,
+	c *restClient,
+	runId uuid.UUID,
+	interval time.Duration,
+	onChange func(run *rest.WorkflowRun) error,
+) (*rest.WorkflowRun, error) {
+	var etag *string
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		resp, err := c.WorkflowRunGetWithResponse(ctx, c.tenantId, runId, &rest.WorkflowRunGetParams{
+			Include:     &[]rest.WorkflowRunInclude{rest.StepRuns},
+			IfNoneMatch: etag,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		// a 304 means that nothing has changed since the last poll
+		if resp.StatusCode() != http.StatusNotModified {
+			if resp.JSON200 == nil {
+				return nil, apiError(resp.HTTPResponse, resp.Body)
+			}
+
+			if newETag := resp.HTTPResponse.Header.Get("ETag"); newETag != "" {
+				etag = &newETag
+			}
+
+			run := resp.JSON200
+
+			if err := onChange(run); err != nil {
+				return nil, err
+			}
+
+			if isFinalStatus(run.Status) {
+				return run, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// printNewLogs prints the log lines of the step run which were written since the last call.
+func printNewLogs(ctx context.Context, c *restClient, stepRunId uuid.UUID, stepName string, logOffsets map[uuid.UUID]int64) error {
+	orderByField := rest.LogLineOrderByFieldCreatedAt
+	orderByDirection := rest.LogLineOrderByDirectionAsc
+	limit := int64(1000)
+
+	for {
+		offset := logOffsets[stepRunId]
+
+		resp, err := c.LogLineListWithResponse(ctx, stepRunId, &rest.LogLineListParams{
+			Offset:           &offset,
+			Limit:            &limit,
+			OrderByField:     &orderByField,
+			OrderByDirection: &orderByDirection,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if resp.JSON200 == nil {
+			return apiError(resp.HTTPResponse, resp.Body)
+		}
+
+		if resp.JSON200.Rows == nil || len(*resp.JSON200.Rows) == 0 {
+			return nil
+		}
+
+		for _, line := range *resp.JSON200.Rows {
+			fmt.Printf("[%s] %s %s\n", stepName, line.CreatedAt.Local().Format(time.RFC3339), line.Message)
+		}
+
+		logOffsets[stepRunId] = offset + int64(len(*resp.JSON200.Rows))
+
+		if int64(len(*resp.JSON200.Rows)) < limit {
+			return nil
+		}
+	}
+}
+
+func workflowRunStepRuns(run *rest.WorkflowRun) []rest.StepRun {
+	res := make([]rest.StepRun, 0)
+
+	if run.JobRuns == nil {
+		return res
+	}
+
+	for _, jobRun := range *run.JobRuns {
+		if jobRun.StepRuns != nil {
+			res = append(res, *jobRun.StepRuns...)
+		}
+	}
+
+	return res
+}
+
+func stepRunName(stepRun rest.StepRun) string {
+	if stepRun.Step != nil {
+		return stepRun.Step.ReadableId
+	}
+
+	return stepRun.Metadata.Id.String()
+}
+
+func isFinalStatus(status rest.WorkflowRunStatus) bool {
+	switch status {
+	case rest.WorkflowRunStatusSUCCEEDED, rest.WorkflowRunStatusFAILED, rest.WorkflowRunStatusCANCELLED:
+		return true
+	}
+
+	return false
+}
+
+func printStatus[T ~string](name string, status T) {
+	fmt.Printf("%s %s is %s\n", time.Now().Format(time.RFC3339), name, strings.ToLower(string(status)))
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+
+	return t.Local().Format(time.RFC3339)
+}
@@ -0,0 +1,349 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+)
+
+var (
+	tokenOutput      string
+	tokenName        string
+	tokenExpiresIn   time.Duration
+	tokenScopes      []string
+	tokenEnvironment string
+	tokenNamespace   string
+)
+
+var tokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "command for managing API tokens.",
+}
+
+var tokenCreateCmd = &cobra.Command{
+	Use:     "create",
+	Short:   "create an API token for the tenant.",
+	Example: `  hatchet token create --name ci --expires-in 720h --scopes read,write --environment staging`,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := tokenCreate(cmd.Context()); err != nil {
+			log.Printf("Fatal: could not create API token: %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var tokenListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "list the API tokens of the tenant which have not been revoked.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := tokenList(cmd.Context()); err != nil {
+			log.Printf("Fatal: could not list API tokens: %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var tokenRevokeCmd = &cobra.Command{
+	Use:   "revoke <token-id>",
+	Short: "revoke an API token.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := tokenRevoke(cmd.Context(), args[0]); err != nil {
+			log.Printf("Fatal: could not revoke API token: %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var tokenRotateCmd = &cobra.Command{
+	Use:   "rotate <token-id>",
+	Short: "replace an API token with a new token with the same name, scopes and lifetime, and revoke it.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := tokenRotate(cmd.Context(), args[0]); err != nil {
+			log.Printf("Fatal: could not rotate API token: %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(tokenCmd)
+	tokenCmd.AddCommand(tokenCreateCmd)
+	tokenCmd.AddCommand(tokenListCmd)
+	tokenCmd.AddCommand(tokenRevokeCmd)
+	tokenCmd.AddCommand(tokenRotateCmd)
+
+	tokenCmd.PersistentFlags().StringVarP(
+		&tokenOutput,
+		"output",
+		"o",
+		outputTable,
+		"The output format, either table or json.",
+	)
+
+	tokenCreateCmd.PersistentFlags().StringVar(
+		&tokenName,
+		"name",
+		"default",
+		"The name of the token.",
+	)
+
+	tokenCreateCmd.PersistentFlags().DurationVar(
+		&tokenExpiresIn,
+		"expires-in",
+		0,
+		"How long the token is valid for, for example 720h. Defaults to 90 days.",
+	)
+
+	tokenCreateCmd.PersistentFlags().StringSliceVar(
+		&tokenScopes,
+		"scopes",
+		nil,
+		"The scopes of the token (read, write or worker). A token without scopes can be used for everything.",
+	)
+
+	tokenCreateCmd.PersistentFlags().StringVar(
+		&tokenEnvironment,
+		"environment",
+		"",
+		"The environment of the token, such as staging. Requests with the token are scoped to the environment.",
+	)
+
+	tokenCreateCmd.PersistentFlags().StringVar(
+		&tokenNamespace,
+		"namespace",
+		"",
+		"The namespace of the token, such as payments. Workflow names and event keys without a namespace are resolved to it.",
+	)
+
+	tokenListCmd.PersistentFlags().StringVar(
+		&tokenEnvironment,
+		"environment",
+		"",
+		"Only list the tokens of this environment.",
+	)
+}
+
+func tokenCreate(ctx context.Context) error {
+	if err := validateOutputFormat(tokenOutput); err != nil {
+		return err
+	}
+
+	c, err := newRestClient()
+
+	if err != nil {
+		return err
+	}
+
+	req := rest.CreateAPITokenRequest{
+		Name: tokenName,
+	}
+
+	if tokenExpiresIn != 0 {
+		expiresIn := tokenExpiresIn.String()
+		req.ExpiresIn = &expiresIn
+	}
+
+	if len(tokenScopes) > 0 {
+		scopes := make([]rest.APITokenScope, len(tokenScopes))
+
+		for i, scope := range tokenScopes {
+			scopes[i] = rest.APITokenScope(strings.ToLower(scope))
+		}
+
+		req.Scopes = &scopes
+	}
+
+	if tokenEnvironment != "" {
+		req.Environment = &tokenEnvironment
+	}
+
+	if tokenNamespace != "" {
+		req.Namespace = &tokenNamespace
+	}
+
+	resp, err := c.ApiTokenCreateWithResponse(ctx, c.tenantId, &rest.ApiTokenCreateParams{}, req)
+
+	if err != nil {
+		return err
+	}
+
+	if resp.JSON200 == nil {
+		return apiError(resp.HTTPResponse, resp.Body)
+	}
+
+	return printToken(resp.JSON200)
+}
+
+func tokenList(ctx context.Context) error {
+	if err := validateOutputFormat(tokenOutput); err != nil {
+		return err
+	}
+
+	c, err := newRestClient()
+
+	if err != nil {
+		return err
+	}
+
+	params := &rest.ApiTokenListParams{}
+
+	if tokenEnvironment != "" {
+		params.Environment = &tokenEnvironment
+	}
+
+	resp, err := c.ApiTokenListWithResponse(ctx, c.tenantId, params)
+
+	if err != nil {
+		return err
+	}
+
+	if resp.JSON200 == nil {
+		return apiError(resp.HTTPResponse, resp.Body)
+	}
+
+	tokens := []rest.APIToken{}
+
+	if resp.JSON200.Rows != nil {
+		tokens = *resp.JSON200.Rows
+	}
+
+	if tokenOutput == outputJSON {
+		return printJSON(tokens)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tNAME\tSCOPES\tENVIRONMENT\tNAMESPACE\tEXPIRES\tCREATED")
+
+	for _, tok := range tokens {
+		scopes := "all"
+
+		if tok.Scopes != nil && len(*tok.Scopes) > 0 {
+			scopeStrs := make([]string, len(*tok.Scopes))
+
+			for i, scope := range *tok.Scopes {
+				scopeStrs[i] = string(scope)
+			}
+
+			scopes = strings.Join(scopeStrs, ",")
+		}
+
+		environment := "-"
+
+		if tok.Environment != nil {
+			environment = *tok.Environment
+		}
+
+		namespace := "-"
+
+		if tok.Namespace != nil {
+			namespace = *tok.Namespace
+		}
+
+		fmt.Fprintf(
+			w,
+			"%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			tok.Metadata.Id,
+			tok.Name,
+			scopes,
+			environment,
+			namespace,
+			tok.ExpiresAt.Local().Format(time.RFC3339),
+			tok.Metadata.CreatedAt.Local().Format(time.RFC3339),
+		)
+	}
+
+	return w.Flush()
+}
+
+func tokenRevoke(ctx context.Context, tokenIdStr string) error {
+	tokenId, err := uuid.Parse(tokenIdStr)
+
+	if err != nil {
+		return fmt.Errorf("token id %s is not a valid uuid: %w", tokenIdStr, err)
+	}
+
+	if err := validateOutputFormat(tokenOutput); err != nil {
+		return err
+	}
+
+	c, err := newRestClient()
+
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.ApiTokenUpdateRevokeWithResponse(ctx, tokenId)
+
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode() != http.StatusNoContent {
+		return apiError(resp.HTTPResponse, resp.Body)
+	}
+
+	if tokenOutput == outputJSON {
+		return printJSON(map[string]string{"id": tokenId.String()})
+	}
+
+	fmt.Printf("revoked API token %s\n", tokenId)
+
+	return nil
+}
+
+func tokenRotate(ctx context.Context, tokenIdStr string) error {
+	tokenId, err := uuid.Parse(tokenIdStr)
+
+	if err != nil {
+		return fmt.Errorf("token id %s is not a valid uuid: %w", tokenIdStr, err)
+	}
+
+	if err := validateOutputFormat(tokenOutput); err != nil {
+		return err
+	}
+
+	c, err := newRestClient()
+
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.ApiTokenUpdateRotateWithResponse(ctx, tokenId)
+
+	if err != nil {
+		return err
+	}
+
+	if resp.JSON200 == nil {
+		return apiError(resp.HTTPResponse, resp.Body)
+	}
+
+	return printToken(resp.JSON200)
+}
+
+// printToken prints a new token. In table format only the token is printed, so that it can be captured by
+// scripts.
+func printToken(tok *rest.CreateAPITokenResponse) error {
+	if tokenOutput == outputJSON {
+		return printJSON(tok)
+	}
+
+	fmt.Println(tok.Token)
+
+	return nil
+}
@@ -0,0 +1,234 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+)
+
+var (
+	triggerInput    string
+	triggerWait     bool
+	triggerTimeout  time.Duration
+	triggerInterval time.Duration
+)
+
+var workflowCmd = &cobra.Command{
+	Use:   "workflow",
+	Short: "command for managing workflows.",
+}
+
+var workflowTriggerCmd = &cobra.Command{
+	Use:   "trigger <name>",
+	Short: "trigger a workflow by name or id.",
+	Long: `trigger a workflow by name or id, and print the id of the new workflow run. When --wait is set, the
+command waits for the workflow run to finish and prints the output of each step as JSON, keyed by the step's
+readable id. The exit code reflects the status of the workflow run:
+
+  0: the workflow run succeeded
+  1: the workflow run failed
+  2: the workflow run was cancelled
+  3: the workflow run did not finish within --timeout`,
+	Example: `  hatchet workflow trigger my-workflow --input input.json --wait --timeout 10m`,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		exitCode, err := workflowTrigger(cmd.Context(), args[0])
+
+		if err != nil {
+			log.Printf("Fatal: could not trigger workflow: %v\n", err)
+			os.Exit(exitCodeFailed)
+		}
+
+		os.Exit(exitCode)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(workflowCmd)
+	workflowCmd.AddCommand(workflowTriggerCmd)
+
+	workflowTriggerCmd.PersistentFlags().StringVar(
+		&triggerInput,
+		"input",
+		"",
+		"The path to a JSON file with the workflow input, or - to read it from stdin.",
+	)
+
+	workflowTriggerCmd.PersistentFlags().BoolVar(
+		&triggerWait,
+		"wait",
+		false,
+		"Wait for the workflow run to finish and print its output.",
+	)
+
+	workflowTriggerCmd.PersistentFlags().DurationVar(
+		&triggerTimeout,
+		"timeout",
+		10*time.Minute,
+		"How long to wait for the workflow run to finish when --wait is set.",
+	)
+
+	workflowTriggerCmd.PersistentFlags().DurationVar(
+		&triggerInterval,
+		"interval",
+		2*time.Second,
+		"How often to poll the workflow run when --wait is set.",
+	)
+}
+
+// workflowTrigger triggers the workflow and returns the exit code of the command.
+func workflowTrigger(ctx context.Context, nameOrId string) (int, error) {
+	input, err := readTriggerInput(triggerInput)
+
+	if err != nil {
+		return 0, err
+	}
+
+	c, err := newRestClient()
+
+	if err != nil {
+		return 0, err
+	}
+
+	workflowId, err := resolveWorkflowId(ctx, c, nameOrId)
+
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := c.WorkflowRunCreateWithResponse(ctx, workflowId, &rest.WorkflowRunCreateParams{}, rest.TriggerWorkflowRunRequest{
+		Input: input,
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	if resp.JSON200 == nil {
+		return 0, apiError(resp.HTTPResponse, resp.Body)
+	}
+
+	runId := resp.JSON200.Metadata.Id
+
+	if !triggerWait {
+		fmt.Println(runId.String())
+		return exitCodeSucceeded, nil
+	}
+
+	// progress is written to stderr, so that stdout only contains the output of the workflow run
+	fmt.Fprintf(os.Stderr, "triggered workflow run %s\n", runId)
+
+	waitCtx, cancel := context.WithTimeout(ctx, triggerTimeout)
+	defer cancel()
+
+	var runStatus rest.WorkflowRunStatus
+
+	run, err := pollWorkflowRun(waitCtx, c, runId, triggerInterval, func(run *rest.WorkflowRun) error {
+		if run.Status != runStatus {
+			runStatus = run.Status
+			fmt.Fprintf(os.Stderr, "workflow run is %s\n", strings.ToLower(string(runStatus)))
+		}
+
+		return nil
+	})
+
+	if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+		fmt.Fprintf(os.Stderr, "workflow run %s did not finish within %s\n", runId, triggerTimeout)
+		return exitCodeTimedOut, nil
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	if err := printWorkflowRunOutput(run); err != nil {
+		return 0, err
+	}
+
+	return exitCodeForStatus(run.Status), nil
+}
+
+func readTriggerInput(path string) (map[string]interface{}, error) {
+	input := map[string]interface{}{}
+
+	if path == "" {
+		return input, nil
+	}
+
+	var data []byte
+	var err error
+
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read input: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &input); err != nil {
+		return nil, fmt.Errorf("input must be a JSON object: %w", err)
+	}
+
+	return input, nil
+}
+
+// resolveWorkflowId returns the id of the workflow with the given name. If nameOrId is a uuid, it is
+// used as the workflow id.
+func resolveWorkflowId(ctx context.Context, c *restClient, nameOrId string) (uuid.UUID, error) {
+	if workflowId, err := uuid.Parse(nameOrId); err == nil {
+		return workflowId, nil
+	}
+
+	resp, err := c.WorkflowListWithResponse(ctx, c.tenantId, &rest.WorkflowListParams{
+		Name: &nameOrId,
+	})
+
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+
+	if resp.JSON200 == nil {
+		return uuid.UUID{}, apiError(resp.HTTPResponse, resp.Body)
+	}
+
+	if resp.JSON200.Rows != nil && len(*resp.JSON200.Rows) > 0 {
+		return (*resp.JSON200.Rows)[0].Metadata.Id, nil
+	}
+
+	return uuid.UUID{}, fmt.Errorf("workflow %s not found", nameOrId)
+}
+
+// printWorkflowRunOutput prints the output of each step run as a JSON object keyed by the step's readable id.
+func printWorkflowRunOutput(run *rest.WorkflowRun) error {
+	output := map[string]interface{}{}
+
+	for _, stepRun := range workflowRunStepRuns(run) {
+		if stepRun.Output == nil {
+			continue
+		}
+
+		var stepOutput interface{}
+
+		if err := json.Unmarshal([]byte(*stepRun.Output), &stepOutput); err != nil {
+			return fmt.Errorf("could not parse output of step %s: %w", stepRunName(stepRun), err)
+		}
+
+		output[stepRunName(stepRun)] = stepOutput
+	}
+
+	return printJSON(output)
+}
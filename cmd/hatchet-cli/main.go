@@ -0,0 +1,9 @@
+package main
+
+import (
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli"
+)
+
+func main() {
+	cli.Execute()
+}
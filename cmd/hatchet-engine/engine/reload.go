@@ -0,0 +1,35 @@
+package engine
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/config/server"
+)
+
+// reloadOnSignal reloads the server config whenever the process receives a SIGHUP, until the context is done.
+func reloadOnSignal(ctx context.Context, l *zerolog.Logger, reloader *server.Reloader) {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGHUP)
+
+	go func() {
+		defer signal.Stop(sigs)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-sigs:
+				l.Info().Msg("received SIGHUP, reloading server config")
+
+				if _, err := reloader.Reload(); err != nil {
+					l.Error().Err(err).Msg("could not reload server config")
+				}
+			}
+		}
+	}()
+}
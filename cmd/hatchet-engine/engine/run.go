@@ -8,15 +8,24 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/hatchet-dev/hatchet/internal/config/loader"
+	"github.com/hatchet-dev/hatchet/internal/config/server"
+	"github.com/hatchet-dev/hatchet/internal/integrations/vcs"
+	"github.com/hatchet-dev/hatchet/internal/integrations/vcs/github"
+	"github.com/hatchet-dev/hatchet/internal/migrate"
 	"github.com/hatchet-dev/hatchet/internal/services/admin"
 	"github.com/hatchet-dev/hatchet/internal/services/controllers/events"
 	"github.com/hatchet-dev/hatchet/internal/services/controllers/jobs"
 	"github.com/hatchet-dev/hatchet/internal/services/controllers/workflows"
 	"github.com/hatchet-dev/hatchet/internal/services/dispatcher"
+	"github.com/hatchet-dev/hatchet/internal/services/eventbus"
+	"github.com/hatchet-dev/hatchet/internal/services/githubwebhooks"
 	"github.com/hatchet-dev/hatchet/internal/services/grpc"
 	"github.com/hatchet-dev/hatchet/internal/services/health"
 	"github.com/hatchet-dev/hatchet/internal/services/heartbeat"
 	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
+	"github.com/hatchet-dev/hatchet/internal/services/logforwarder"
+	"github.com/hatchet-dev/hatchet/internal/services/objectstoragefeeds"
+	"github.com/hatchet-dev/hatchet/internal/services/replicator"
 	"github.com/hatchet-dev/hatchet/internal/services/ticker"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
 )
@@ -26,11 +35,25 @@ type Teardown struct {
 	fn   func() error
 }
 
+func NewTeardown(name string, fn func() error) Teardown {
+	return Teardown{
+		name: name,
+		fn:   fn,
+	}
+}
+
 func Run(ctx context.Context, cf *loader.ConfigLoader) error {
 	serverCleanup, sc, err := cf.LoadServerConfig()
 	if err != nil {
 		return fmt.Errorf("could not load server config: %w", err)
 	}
+
+	return RunWithConfig(ctx, sc, serverCleanup)
+}
+
+// RunWithConfig runs the engine services with a server config which has already been loaded, until the
+// context is done. The preTeardown functions are called before the engine services are torn down.
+func RunWithConfig(ctx context.Context, sc *server.ServerConfig, serverCleanup func() error, preTeardown ...Teardown) error {
 	var l = sc.Logger
 
 	shutdown, err := telemetry.InitTracer(&telemetry.TracerOpts{
@@ -41,12 +64,28 @@ func Run(ctx context.Context, cf *loader.ConfigLoader) error {
 		return fmt.Errorf("could not initialize tracer: %w", err)
 	}
 
-	var teardown []Teardown
+	// refuse to start if the schema doesn't match the migrations of this version, rather than failing at query time
+	migrations, err := migrate.LoadEmbedded()
+	if err != nil {
+		return fmt.Errorf("could not load migrations: %w", err)
+	}
+
+	var verifyOpts []migrate.VerifyOpt
+
+	if sc.Runtime.AllowUntrackedSchema {
+		verifyOpts = append(verifyOpts, migrate.WithAllowUntracked())
+	}
+
+	if err := migrate.NewMigrator(sc.Pools["engine"], migrations).Verify(ctx, verifyOpts...); err != nil {
+		return fmt.Errorf("could not verify database migrations, run `hatchet-admin migrate up`: %w", err)
+	}
+
+	teardown := append([]Teardown{}, preTeardown...)
 
 	var h *health.Health
 	healthProbes := sc.HasService("health")
 	if healthProbes {
-		h = health.New(sc.Repository, sc.MessageQueue)
+		h = health.New(sc.Repository, sc.MessageQueue, sc.Reloader, sc.Pools, sc.PayloadLimits)
 		cleanup, err := h.Start()
 		if err != nil {
 			return fmt.Errorf("could not start health: %w", err)
@@ -62,7 +101,16 @@ func Run(ctx context.Context, cf *loader.ConfigLoader) error {
 		t, err := ticker.New(
 			ticker.WithMessageQueue(sc.MessageQueue),
 			ticker.WithRepository(sc.Repository),
+			ticker.WithEncryption(sc.Encryption),
 			ticker.WithLogger(sc.Logger),
+			ticker.WithIngestor(sc.Ingestor),
+			ticker.WithAlerter(sc.SLABreachAlerter),
+			ticker.WithRolloutAlerter(sc.Alerter),
+			ticker.WithNoWorkerAlerter(sc.NoWorkerAlerter),
+			ticker.WithNoWorkerThreshold(sc.NoWorkerThreshold),
+			ticker.WithAnomalyAlerter(sc.AnomalyAlerter),
+			ticker.WithAnomalyDetection(sc.AnomalyWindow, sc.AnomalyThreshold),
+			ticker.WithObjectStore(sc.ObjectStore),
 		)
 
 		if err != nil {
@@ -84,6 +132,7 @@ func Run(ctx context.Context, cf *loader.ConfigLoader) error {
 			events.WithMessageQueue(sc.MessageQueue),
 			events.WithRepository(sc.Repository),
 			events.WithLogger(sc.Logger),
+			events.WithEngineSettings(sc.EngineSettings),
 		)
 		if err != nil {
 			return fmt.Errorf("could not create events controller: %w", err)
@@ -105,12 +154,19 @@ func Run(ctx context.Context, cf *loader.ConfigLoader) error {
 			jobs.WithMessageQueue(sc.MessageQueue),
 			jobs.WithRepository(sc.Repository),
 			jobs.WithLogger(sc.Logger),
+			jobs.WithRequeueInterval(sc.Requeue.StepRunInterval),
+			jobs.WithEngineSettings(sc.EngineSettings),
+			jobs.WithObjectStore(sc.ObjectStore),
 		)
 
 		if err != nil {
 			return fmt.Errorf("could not create jobs controller: %w", err)
 		}
 
+		sc.Reloader.OnReload(func(cf *server.ServerConfigFile) error {
+			return jc.SetRequeueInterval(cf.Requeue.StepRunInterval)
+		})
+
 		cleanup, err := jc.Start()
 		if err != nil {
 			return fmt.Errorf("could not start jobs controller: %w", err)
@@ -126,11 +182,20 @@ func Run(ctx context.Context, cf *loader.ConfigLoader) error {
 			workflows.WithMessageQueue(sc.MessageQueue),
 			workflows.WithRepository(sc.Repository),
 			workflows.WithLogger(sc.Logger),
+			workflows.WithRequeueInterval(sc.Requeue.GetGroupKeyRunInterval),
+			workflows.WithGroupKeyCacheTTL(sc.Concurrency.GroupKeyCacheTTL),
+			workflows.WithFeatureFlags(sc.FeatureFlags),
+			workflows.WithEngineSettings(sc.EngineSettings),
+			workflows.WithVCSProviders(sc.VCSProviders),
 		)
 		if err != nil {
 			return fmt.Errorf("could not create workflows controller: %w", err)
 		}
 
+		sc.Reloader.OnReload(func(cf *server.ServerConfigFile) error {
+			return wc.SetRequeueInterval(cf.Requeue.GetGroupKeyRunInterval)
+		})
+
 		cleanup, err := wc.Start()
 		if err != nil {
 			return fmt.Errorf("could not start workflows controller: %w", err)
@@ -162,12 +227,138 @@ func Run(ctx context.Context, cf *loader.ConfigLoader) error {
 		})
 	}
 
+	if sc.HasService("logforwarder") {
+		lf, err := logforwarder.New(
+			logforwarder.WithRepository(sc.Repository),
+			logforwarder.WithEncryption(sc.Encryption),
+			logforwarder.WithLogger(sc.Logger),
+		)
+
+		if err != nil {
+			return fmt.Errorf("could not create log forwarder: %w", err)
+		}
+
+		cleanup, err := lf.Start()
+		if err != nil {
+			return fmt.Errorf("could not start log forwarder: %w", err)
+		}
+		teardown = append(teardown, Teardown{
+			name: "log forwarder",
+			fn:   cleanup,
+		})
+	}
+
+	if sc.HasService("eventbus") {
+		eb, err := eventbus.New(
+			eventbus.WithRepository(sc.Repository),
+			eventbus.WithLogger(sc.Logger),
+		)
+
+		if err != nil {
+			return fmt.Errorf("could not create event bus: %w", err)
+		}
+
+		cleanup, err := eb.Start()
+		if err != nil {
+			return fmt.Errorf("could not start event bus: %w", err)
+		}
+		teardown = append(teardown, Teardown{
+			name: "event bus",
+			fn:   cleanup,
+		})
+	}
+
+	if sc.HasService("githubwebhooks") {
+		// the webhooks of linked repositories can only be reconciled if a Github app is configured
+		if provider, ok := sc.VCSProviders[vcs.VCSRepositoryKindGithub]; ok {
+			githubProvider, err := github.ToGithubVCSProvider(provider)
+
+			if err != nil {
+				return err
+			}
+
+			gw, err := githubwebhooks.New(
+				githubwebhooks.WithRepository(sc.Repository),
+				githubwebhooks.WithGithubProvider(githubProvider),
+				githubwebhooks.WithLogger(sc.Logger),
+			)
+
+			if err != nil {
+				return fmt.Errorf("could not create github webhooks: %w", err)
+			}
+
+			cleanup, err := gw.Start()
+			if err != nil {
+				return fmt.Errorf("could not start github webhooks: %w", err)
+			}
+			teardown = append(teardown, Teardown{
+				name: "github webhooks",
+				fn:   cleanup,
+			})
+		} else {
+			sc.Logger.Debug().Msg("github is not configured, not reconciling github webhooks")
+		}
+	}
+
+	if sc.HasService("objectstoragefeeds") {
+		of, err := objectstoragefeeds.New(
+			objectstoragefeeds.WithRepository(sc.Repository),
+			objectstoragefeeds.WithEncryption(sc.Encryption),
+			objectstoragefeeds.WithIngestor(sc.Ingestor),
+			objectstoragefeeds.WithLogger(sc.Logger),
+		)
+
+		if err != nil {
+			return fmt.Errorf("could not create object storage feeds: %w", err)
+		}
+
+		cleanup, err := of.Start()
+		if err != nil {
+			return fmt.Errorf("could not start object storage feeds: %w", err)
+		}
+		teardown = append(teardown, Teardown{
+			name: "object storage feeds",
+			fn:   cleanup,
+		})
+	}
+
+	if sc.HasService("replicator") {
+		if sc.Replication.PrimaryRepository == nil {
+			return fmt.Errorf("the replicator requires the database url of the primary. set replication.primaryDatabaseUrl")
+		}
+
+		r, err := replicator.New(
+			replicator.WithRepository(sc.Repository),
+			replicator.WithPrimaryRepository(sc.Replication.PrimaryRepository),
+			replicator.WithInterval(sc.Replication.ConfigFile.Interval),
+			replicator.WithBatchSize(sc.Replication.ConfigFile.BatchSize),
+			replicator.WithGapTimeout(sc.Replication.ConfigFile.GapTimeout),
+			replicator.WithLogger(sc.Logger),
+		)
+
+		if err != nil {
+			return fmt.Errorf("could not create replicator: %w", err)
+		}
+
+		cleanup, err := r.Start()
+		if err != nil {
+			return fmt.Errorf("could not start replicator: %w", err)
+		}
+		teardown = append(teardown, Teardown{
+			name: "replicator",
+			fn:   cleanup,
+		})
+	}
+
 	if sc.HasService("grpc") {
 		// create the dispatcher
 		d, err := dispatcher.New(
 			dispatcher.WithMessageQueue(sc.MessageQueue),
 			dispatcher.WithRepository(sc.Repository),
 			dispatcher.WithLogger(sc.Logger),
+			dispatcher.WithPayloadLimits(sc.PayloadLimits),
+			dispatcher.WithStepEventBatching(sc.Batching.StepEventBatchSize, sc.Batching.StepEventFlushInterval),
+			dispatcher.WithPayloadHydrationThreshold(sc.Dispatcher.PayloadHydrationThreshold),
 		)
 		if err != nil {
 			return fmt.Errorf("could not create dispatcher: %w", err)
@@ -186,6 +377,12 @@ func Run(ctx context.Context, cf *loader.ConfigLoader) error {
 			ingestor.WithLogRepository(
 				sc.Repository.Log(),
 			),
+			ingestor.WithStreamEventRepository(
+				sc.Repository.StreamEvent(),
+			),
+			ingestor.WithTenantRepository(
+				sc.Repository.Tenant(),
+			),
 			ingestor.WithMessageQueue(sc.MessageQueue),
 		)
 		if err != nil {
@@ -195,6 +392,10 @@ func Run(ctx context.Context, cf *loader.ConfigLoader) error {
 		adminSvc, err := admin.NewAdminService(
 			admin.WithRepository(sc.Repository),
 			admin.WithMessageQueue(sc.MessageQueue),
+			admin.WithBackpressure(sc.Backpressure),
+			admin.WithFeatureFlags(sc.FeatureFlags),
+			admin.WithPayloadLimits(sc.PayloadLimits),
+			admin.WithLogger(sc.Logger),
 		)
 		if err != nil {
 			return fmt.Errorf("could not create admin service: %w", err)
@@ -208,6 +409,7 @@ func Run(ctx context.Context, cf *loader.ConfigLoader) error {
 			grpc.WithLogger(sc.Logger),
 			grpc.WithTLSConfig(sc.TLSConfig),
 			grpc.WithPort(sc.Runtime.GRPCPort),
+			grpc.WithHTTPPort(sc.Runtime.GRPCWorkerHTTPPort),
 			grpc.WithBindAddress(sc.Runtime.GRPCBindAddress),
 		}
 
@@ -260,12 +462,9 @@ func Run(ctx context.Context, cf *loader.ConfigLoader) error {
 		})
 	}
 
-	teardown = append(teardown, Teardown{
-		name: "telemetry",
-		fn: func() error {
-			return shutdown(ctx)
-		},
-	})
+	// the services above stop consuming messages and wait for their in-flight handlers, after which the message
+	// queue can stop publishing and the database pools can be closed. telemetry is flushed last, so that the spans
+	// of the teardown are exported.
 	teardown = append(teardown, Teardown{
 		name: "server",
 		fn: func() error {
@@ -278,6 +477,14 @@ func Run(ctx context.Context, cf *loader.ConfigLoader) error {
 			return sc.Disconnect()
 		},
 	})
+	teardown = append(teardown, Teardown{
+		name: "telemetry",
+		fn: func() error {
+			return shutdown(context.Background())
+		},
+	})
+
+	reloadOnSignal(ctx, l, sc.Reloader)
 
 	l.Debug().Msgf("engine has started")
 
@@ -296,15 +503,11 @@ func Run(ctx context.Context, cf *loader.ConfigLoader) error {
 	l.Debug().Msgf("interrupt received, shutting down")
 
 	l.Debug().Msgf("waiting for all other services to gracefully exit...")
-	for i, t := range teardown {
-		l.Debug().Msgf("shutting down %s (%d/%d)", t.name, i+1, len(teardown))
-		err := t.fn()
 
-		if err != nil {
-			return fmt.Errorf("could not teardown %s: %w", t.name, err)
-		}
-		l.Debug().Msgf("successfully shutdown %s (%d/%d)", t.name, i+1, len(teardown))
+	if err := runTeardown(l, teardown, sc.Runtime.ShutdownTimeout); err != nil {
+		return err
 	}
+
 	l.Debug().Msgf("all services have successfully gracefully exited")
 
 	l.Debug().Msgf("successfully shutdown")
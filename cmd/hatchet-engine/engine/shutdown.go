@@ -0,0 +1,53 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// runTeardown runs the teardown functions in order. A teardown function runs even if a previous one failed, so
+// that the message queue and database pools are still closed. Once the timeout has passed, the remaining teardown
+// functions are skipped and an error is returned.
+func runTeardown(l *zerolog.Logger, teardown []Teardown, timeout time.Duration) error {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	var errs []error
+
+	for i, t := range teardown {
+		l.Debug().Msgf("shutting down %s (%d/%d)", t.name, i+1, len(teardown))
+
+		done := make(chan error, 1)
+
+		go func(t Teardown) {
+			done <- t.fn()
+		}(t)
+
+		select {
+		case err := <-done:
+			if err != nil {
+				l.Error().Err(err).Msgf("could not shutdown %s (%d/%d)", t.name, i+1, len(teardown))
+				errs = append(errs, fmt.Errorf("could not teardown %s: %w", t.name, err))
+				continue
+			}
+
+			l.Debug().Msgf("successfully shutdown %s (%d/%d)", t.name, i+1, len(teardown))
+		case <-deadline.C:
+			skipped := make([]string, 0, len(teardown)-i)
+
+			for _, t := range teardown[i:] {
+				skipped = append(skipped, t.name)
+			}
+
+			l.Error().Msgf("shutdown deadline of %s exceeded, abandoning %v", timeout, skipped)
+			errs = append(errs, fmt.Errorf("shutdown deadline of %s exceeded while shutting down %s", timeout, t.name))
+
+			return errors.Join(errs...)
+		}
+	}
+
+	return errors.Join(errs...)
+}
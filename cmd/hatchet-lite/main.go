@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-api/api"
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-engine/engine"
+	"github.com/hatchet-dev/hatchet/internal/config/loader"
+	"github.com/hatchet-dev/hatchet/internal/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/cmdutils"
+)
+
+var printVersion bool
+var configDirectory string
+var noGracefulShutdown bool
+
+// rootCmd represents the base command when called without any subcommands
+var rootCmd = &cobra.Command{
+	Use:   "hatchet-lite",
+	Short: "hatchet-lite runs the Hatchet API and engine in a single process.",
+	Long: `hatchet-lite runs the Hatchet API, dispatcher, controllers and ticker in a single process, which share
+a database connection pool and an in-memory message queue. It is intended for local development and
+small self-hosted installs: messages which have not been processed are lost when the process exits.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if printVersion {
+			fmt.Println(Version)
+
+			os.Exit(0)
+		}
+
+		cf := loader.NewConfigLoader(configDirectory)
+
+		ctx := context.Background()
+		if !noGracefulShutdown {
+			interruptCtx, cancel := cmdutils.NewInterruptContext()
+			defer cancel()
+			ctx = interruptCtx
+		}
+
+		if err := run(ctx, cf); err != nil {
+			log.Printf("lite failure: %s", err.Error())
+			os.Exit(1)
+		}
+	},
+}
+
+func run(ctx context.Context, cf *loader.ConfigLoader) error {
+	serverCleanup, sc, err := cf.LoadServerConfig(func(scf *server.ServerConfigFile) {
+		scf.MessageQueue.Kind = "inmemory"
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not load server config: %w", err)
+	}
+
+	apiCleanup, err := api.RunWithConfig(sc)
+
+	if err != nil {
+		return fmt.Errorf("could not start api: %w", err)
+	}
+
+	// the API is shut down first, so that it stops creating messages before the engine stops processing them
+	return engine.RunWithConfig(ctx, sc, serverCleanup, engine.NewTeardown("api", apiCleanup))
+}
+
+// Version will be linked by an ldflag during build
+var Version = "v0.1.0-alpha.0"
+
+func main() {
+	rootCmd.PersistentFlags().BoolVar(
+		&printVersion,
+		"version",
+		false,
+		"print version and exit.",
+	)
+
+	rootCmd.PersistentFlags().StringVar(
+		&configDirectory,
+		"config",
+		"",
+		"The path the config folder.",
+	)
+
+	rootCmd.PersistentFlags().BoolVar(
+		&noGracefulShutdown,
+		"no-graceful-shutdown",
+		false,
+		"Whether not to shut down gracefully (useful for nodemon/air).",
+	)
+
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
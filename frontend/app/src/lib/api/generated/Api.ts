@@ -13,13 +13,35 @@ import {
   APIError,
   APIErrors,
   APIMeta,
+  APIMetaAttestationKeys,
+  APIToken,
   AcceptInviteRequest,
+  AckEventBusSubscriptionRequest,
+  CancellationSource,
+  ClientCertificate,
+  ConcurrencySimulation,
+  CostCenterUsage,
+  CostCenterUsageBucket,
   CreateAPITokenRequest,
   CreateAPITokenResponse,
+  CreateClientCertificateRequest,
+  CreateConcurrencySimulationRequest,
+  CreateEventBusSubscriptionRequest,
+  CreateGiteaWebhookRequest,
+  CreateLogSinkRequest,
+  CreateMaintenanceWindowRequest,
+  CreateObjectStorageFeedRequest,
+  CreatePIIRuleRequest,
   CreatePullRequestFromStepRun,
+  CreateQueryTriggerRequest,
+  CreateRecurringTaskRequest,
   CreateSNSIntegrationRequest,
+  CreateSubjectDeletionRequest,
   CreateTenantInviteRequest,
   CreateTenantRequest,
+  CreateTriggerLinkRequest,
+  CreateTriggerLinkResponse,
+  EventBusSubscription,
   EventData,
   EventKey,
   EventKeyList,
@@ -27,32 +49,73 @@ import {
   EventOrderByDirection,
   EventOrderByField,
   EventSearch,
+  GetGroupKeyRun,
   GetStepRunDiffResponse,
+  GiteaWebhook,
   LinkGithubRepositoryRequest,
   ListAPIMetaIntegration,
   ListAPITokensResponse,
+  ListClientCertificates,
+  ListEventBusRecords,
+  ListEventBusSubscriptions,
+  ListGiteaWebhooks,
   ListGithubAppInstallationsResponse,
   ListGithubBranchesResponse,
   ListGithubReposResponse,
+  ListGithubWebhooks,
+  ListLogSinks,
+  ListMaintenanceWindows,
+  ListObjectStorageFeeds,
+  ListPIIRules,
   ListPullRequestsResponse,
+  ListQueryTriggers,
+  ListRecurringTasks,
   ListSNSIntegrations,
+  ListTenantExports,
+  ListTriggerLinks,
+  ListWebhookDeliveries,
   LogLineLevelField,
   LogLineList,
   LogLineOrderByDirection,
   LogLineOrderByField,
   LogLineSearch,
+  LogSink,
+  MaintenanceWindow,
+  ObjectStorageFeed,
+  PIIRule,
+  PauseRequest,
   PullRequestState,
+  QueryTrigger,
+  RecurringTask,
   RejectInviteRequest,
   ReplayEventRequest,
+  ReplayWorkflowRunRequest,
   RerunStepRunRequest,
   SNSIntegration,
+  ScheduledWorkflowRunList,
   StepRun,
+  StepRunAttemptList,
+  StepRunDiagnosticList,
+  StepRunList,
+  StepRunMetrics,
+  StepRunStatus,
+  StepRunStreamEventList,
+  SubjectDeletionReport,
   Tenant,
+  TenantEngineSettings,
+  TenantExport,
+  TenantExportDownloadURL,
   TenantInvite,
   TenantInviteList,
   TenantMemberList,
+  TriggerLinkRunResult,
+  TriggerWorkflowRunRejected,
   TriggerWorkflowRunRequest,
+  UpdateTenantEngineSettingsRequest,
   UpdateTenantInviteRequest,
+  UpdateTenantRequest,
+  UpdateWorkflowDiagnosticsRequest,
+  UpdateWorkflowRolloutRequest,
   User,
   UserLoginRequest,
   UserRegisterRequest,
@@ -60,10 +123,24 @@ import {
   Worker,
   WorkerList,
   Workflow,
+  WorkflowConcurrencyQueueDepth,
   WorkflowID,
   WorkflowList,
+  WorkflowRollout,
   WorkflowRun,
+  WorkflowRunAttestation,
+  WorkflowRunBulkRetry,
+  WorkflowRunBulkRetryRequest,
+  WorkflowRunBundle,
+  WorkflowRunDag,
+  WorkflowRunExportFormat,
+  WorkflowRunInclude,
   WorkflowRunList,
+  WorkflowRunMetrics,
+  WorkflowRunMetricsBucket,
+  WorkflowRunRootCause,
+  WorkflowRunSLABreachList,
+  WorkflowRunStatus,
   WorkflowRunStatusList,
   WorkflowVersion,
   WorkflowVersionDefinition,
@@ -131,6 +208,22 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: "json",
       ...params,
     });
+  /**
+   * @description Gets the public keys which workflow run attestations of the instance are signed with, as a JWK set, so that attestations can
+   * be verified offline.
+   *
+   * @tags Metadata
+   * @name MetadataGetAttestationKeys
+   * @summary Get attestation keys
+   * @request GET:/api/v1/meta/attestation-keys
+   */
+  metadataGetAttestationKeys = (params: RequestParams = {}) =>
+    this.request<APIMetaAttestationKeys, APIErrors>({
+      path: `/api/v1/meta/attestation-keys`,
+      method: "GET",
+      format: "json",
+      ...params,
+    });
   /**
    * @description Logs in a user.
    *
@@ -303,138 +396,152 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       ...params,
     });
   /**
-   * @description Gets the current user
+   * @description Receives the events of a Gitea repository, and ingests pull request events as Hatchet events
    *
-   * @tags User
-   * @name UserGetCurrent
-   * @summary Get current user
-   * @request GET:/api/v1/users/current
+   * @tags Gitea
+   * @name GiteaUpdateWebhook
+   * @summary Gitea webhook
+   * @request POST:/api/v1/gitea/webhook/{webhook}
+   */
+  giteaUpdateWebhook = (webhook: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/gitea/webhook/${webhook}`,
+      method: "POST",
+      ...params,
+    });
+  /**
+   * @description List Gitea webhooks
+   *
+   * @tags Gitea
+   * @name GiteaWebhookList
+   * @summary List Gitea webhooks
+   * @request GET:/api/v1/tenants/{tenant}/gitea-webhooks
    * @secure
    */
-  userGetCurrent = (params: RequestParams = {}) =>
-    this.request<User, APIErrors>({
-      path: `/api/v1/users/current`,
+  giteaWebhookList = (tenant: string, params: RequestParams = {}) =>
+    this.request<ListGiteaWebhooks, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/gitea-webhooks`,
       method: "GET",
       secure: true,
       format: "json",
       ...params,
     });
   /**
-   * @description Registers a user.
+   * @description Create a webhook which receives the events of a Gitea repository
    *
-   * @tags User
-   * @name UserCreate
-   * @summary Register user
-   * @request POST:/api/v1/users/register
+   * @tags Gitea
+   * @name GiteaWebhookCreate
+   * @summary Create Gitea webhook
+   * @request POST:/api/v1/tenants/{tenant}/gitea-webhooks
+   * @secure
    */
-  userCreate = (data: UserRegisterRequest, params: RequestParams = {}) =>
-    this.request<User, APIErrors>({
-      path: `/api/v1/users/register`,
+  giteaWebhookCreate = (tenant: string, data: CreateGiteaWebhookRequest, params: RequestParams = {}) =>
+    this.request<GiteaWebhook, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/gitea-webhooks`,
       method: "POST",
       body: data,
+      secure: true,
       type: ContentType.Json,
       format: "json",
       ...params,
     });
   /**
-   * @description Logs out a user.
+   * @description Delete Gitea webhook
    *
-   * @tags User
-   * @name UserUpdateLogout
-   * @summary Logout user
-   * @request POST:/api/v1/users/logout
+   * @tags Gitea
+   * @name GiteaWebhookDelete
+   * @summary Delete Gitea webhook
+   * @request DELETE:/api/v1/gitea-webhooks/{gitea-webhook}
    * @secure
    */
-  userUpdateLogout = (params: RequestParams = {}) =>
-    this.request<User, APIErrors>({
-      path: `/api/v1/users/logout`,
-      method: "POST",
+  giteaWebhookDelete = (giteaWebhook: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/gitea-webhooks/${giteaWebhook}`,
+      method: "DELETE",
       secure: true,
-      format: "json",
       ...params,
     });
   /**
-   * @description Lists all tenant memberships for the current user
+   * @description Lists the log sinks of a tenant
    *
-   * @tags User
-   * @name TenantMembershipsList
-   * @summary List tenant memberships
-   * @request GET:/api/v1/users/memberships
+   * @tags Log Sink
+   * @name LogSinkList
+   * @summary List log sinks
+   * @request GET:/api/v1/tenants/{tenant}/log-sinks
    * @secure
    */
-  tenantMembershipsList = (params: RequestParams = {}) =>
-    this.request<UserTenantMembershipsList, APIErrors>({
-      path: `/api/v1/users/memberships`,
+  logSinkList = (tenant: string, params: RequestParams = {}) =>
+    this.request<ListLogSinks, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/log-sinks`,
       method: "GET",
       secure: true,
       format: "json",
       ...params,
     });
   /**
-   * @description Lists all tenant invites for the current user
+   * @description Creates a log sink for a tenant, which receives the step run lifecycle events, step run logs and finished workflow runs of the tenant
    *
-   * @tags Tenant
-   * @name UserListTenantInvites
-   * @summary List tenant invites
-   * @request GET:/api/v1/users/invites
+   * @tags Log Sink
+   * @name LogSinkCreate
+   * @summary Create log sink
+   * @request POST:/api/v1/tenants/{tenant}/log-sinks
    * @secure
    */
-  userListTenantInvites = (params: RequestParams = {}) =>
-    this.request<TenantInviteList, APIErrors>({
-      path: `/api/v1/users/invites`,
-      method: "GET",
+  logSinkCreate = (tenant: string, data: CreateLogSinkRequest, params: RequestParams = {}) =>
+    this.request<LogSink, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/log-sinks`,
+      method: "POST",
+      body: data,
       secure: true,
+      type: ContentType.Json,
       format: "json",
       ...params,
     });
   /**
-   * @description Accepts a tenant invite
+   * @description Deletes a log sink
    *
-   * @tags Tenant
-   * @name TenantInviteAccept
-   * @summary Accept tenant invite
-   * @request POST:/api/v1/users/invites/accept
+   * @tags Log Sink
+   * @name LogSinkDelete
+   * @summary Delete log sink
+   * @request DELETE:/api/v1/log-sinks/{log-sink}
    * @secure
    */
-  tenantInviteAccept = (data: AcceptInviteRequest, params: RequestParams = {}) =>
-    this.request<void, APIErrors | APIError>({
-      path: `/api/v1/users/invites/accept`,
-      method: "POST",
-      body: data,
+  logSinkDelete = (logSink: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/log-sinks/${logSink}`,
+      method: "DELETE",
       secure: true,
-      type: ContentType.Json,
       ...params,
     });
   /**
-   * @description Rejects a tenant invite
+   * @description Lists the object storage feeds of a tenant
    *
-   * @tags Tenant
-   * @name TenantInviteReject
-   * @summary Reject tenant invite
-   * @request POST:/api/v1/users/invites/reject
+   * @tags Object Storage Feed
+   * @name ObjectStorageFeedList
+   * @summary List object storage feeds
+   * @request GET:/api/v1/tenants/{tenant}/object-storage-feeds
    * @secure
    */
-  tenantInviteReject = (data: RejectInviteRequest, params: RequestParams = {}) =>
-    this.request<void, APIErrors | APIError>({
-      path: `/api/v1/users/invites/reject`,
-      method: "POST",
-      body: data,
+  objectStorageFeedList = (tenant: string, params: RequestParams = {}) =>
+    this.request<ListObjectStorageFeeds, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/object-storage-feeds`,
+      method: "GET",
       secure: true,
-      type: ContentType.Json,
+      format: "json",
       ...params,
     });
   /**
-   * @description Creates a new tenant
+   * @description Creates an object storage feed for a tenant, which polls a queue of object storage notifications and creates an event for every created object
    *
-   * @tags Tenant
-   * @name TenantCreate
-   * @summary Create tenant
-   * @request POST:/api/v1/tenants
+   * @tags Object Storage Feed
+   * @name ObjectStorageFeedCreate
+   * @summary Create object storage feed
+   * @request POST:/api/v1/tenants/{tenant}/object-storage-feeds
    * @secure
    */
-  tenantCreate = (data: CreateTenantRequest, params: RequestParams = {}) =>
-    this.request<Tenant, APIErrors | APIError>({
-      path: `/api/v1/tenants`,
+  objectStorageFeedCreate = (tenant: string, data: CreateObjectStorageFeedRequest, params: RequestParams = {}) =>
+    this.request<ObjectStorageFeed, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/object-storage-feeds`,
       method: "POST",
       body: data,
       secure: true,
@@ -443,58 +550,51 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       ...params,
     });
   /**
-   * @description Creates a new tenant invite
+   * @description Deletes an object storage feed
    *
-   * @tags Tenant
-   * @name TenantInviteCreate
-   * @summary Create tenant invite
-   * @request POST:/api/v1/tenants/{tenant}/invites
+   * @tags Object Storage Feed
+   * @name ObjectStorageFeedDelete
+   * @summary Delete object storage feed
+   * @request DELETE:/api/v1/object-storage-feeds/{object-storage-feed}
    * @secure
    */
-  tenantInviteCreate = (tenant: string, data: CreateTenantInviteRequest, params: RequestParams = {}) =>
-    this.request<TenantInvite, APIErrors | APIError>({
-      path: `/api/v1/tenants/${tenant}/invites`,
-      method: "POST",
-      body: data,
+  objectStorageFeedDelete = (objectStorageFeed: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/object-storage-feeds/${objectStorageFeed}`,
+      method: "DELETE",
       secure: true,
-      type: ContentType.Json,
-      format: "json",
       ...params,
     });
   /**
-   * @description Gets a list of tenant invites
+   * @description Lists the query triggers of a tenant
    *
-   * @tags Tenant
-   * @name TenantInviteList
-   * @summary List tenant invites
-   * @request GET:/api/v1/tenants/{tenant}/invites
+   * @tags Query Trigger
+   * @name QueryTriggerList
+   * @summary List query triggers
+   * @request GET:/api/v1/tenants/{tenant}/query-triggers
    * @secure
    */
-  tenantInviteList = (tenant: string, params: RequestParams = {}) =>
-    this.request<TenantInviteList, APIErrors | APIError>({
-      path: `/api/v1/tenants/${tenant}/invites`,
+  queryTriggerList = (tenant: string, params: RequestParams = {}) =>
+    this.request<ListQueryTriggers, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/query-triggers`,
       method: "GET",
       secure: true,
       format: "json",
       ...params,
     });
   /**
-   * @description Updates a tenant invite
+   * @description Creates a query trigger for a tenant, which runs a read-only SQL query against a database of the tenant on a schedule and creates events for the rows which it returns
    *
-   * @name TenantInviteUpdate
-   * @summary Update invite
-   * @request PATCH:/api/v1/tenants/{tenant}/invites/{tenant-invite}
+   * @tags Query Trigger
+   * @name QueryTriggerCreate
+   * @summary Create query trigger
+   * @request POST:/api/v1/tenants/{tenant}/query-triggers
    * @secure
    */
-  tenantInviteUpdate = (
-    tenant: string,
-    tenantInvite: string,
-    data: UpdateTenantInviteRequest,
-    params: RequestParams = {},
-  ) =>
-    this.request<TenantInvite, APIErrors>({
-      path: `/api/v1/tenants/${tenant}/invites/${tenantInvite}`,
-      method: "PATCH",
+  queryTriggerCreate = (tenant: string, data: CreateQueryTriggerRequest, params: RequestParams = {}) =>
+    this.request<QueryTrigger, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/query-triggers`,
+      method: "POST",
       body: data,
       secure: true,
       type: ContentType.Json,
@@ -502,130 +602,102 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       ...params,
     });
   /**
-   * @description Deletes a tenant invite
+   * @description Deletes a query trigger
    *
-   * @name TenantInviteDelete
-   * @summary Delete invite
-   * @request DELETE:/api/v1/tenants/{tenant}/invites/{tenant-invite}
+   * @tags Query Trigger
+   * @name QueryTriggerDelete
+   * @summary Delete query trigger
+   * @request DELETE:/api/v1/query-triggers/{query-trigger}
    * @secure
    */
-  tenantInviteDelete = (tenant: string, tenantInvite: string, params: RequestParams = {}) =>
-    this.request<TenantInvite, APIErrors>({
-      path: `/api/v1/tenants/${tenant}/invites/${tenantInvite}`,
+  queryTriggerDelete = (queryTrigger: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/query-triggers/${queryTrigger}`,
       method: "DELETE",
       secure: true,
-      format: "json",
       ...params,
     });
   /**
-   * @description Create an API token for a tenant
+   * @description Lists the recurring tasks of a tenant
    *
-   * @tags API Token
-   * @name ApiTokenCreate
-   * @summary Create API Token
-   * @request POST:/api/v1/tenants/{tenant}/api-tokens
+   * @tags Recurring Task
+   * @name RecurringTaskList
+   * @summary List recurring tasks
+   * @request GET:/api/v1/tenants/{tenant}/recurring-tasks
    * @secure
    */
-  apiTokenCreate = (tenant: string, data: CreateAPITokenRequest, params: RequestParams = {}) =>
-    this.request<CreateAPITokenResponse, APIErrors>({
-      path: `/api/v1/tenants/${tenant}/api-tokens`,
-      method: "POST",
-      body: data,
+  recurringTaskList = (tenant: string, params: RequestParams = {}) =>
+    this.request<ListRecurringTasks, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/recurring-tasks`,
+      method: "GET",
       secure: true,
-      type: ContentType.Json,
       format: "json",
       ...params,
     });
   /**
-   * @description List API tokens for a tenant
+   * @description Creates a recurring task for a tenant, which the engine runs on a schedule without a workflow, like purging the old runs of a workflow or emitting a heartbeat event
    *
-   * @tags API Token
-   * @name ApiTokenList
-   * @summary List API Tokens
-   * @request GET:/api/v1/tenants/{tenant}/api-tokens
+   * @tags Recurring Task
+   * @name RecurringTaskCreate
+   * @summary Create recurring task
+   * @request POST:/api/v1/tenants/{tenant}/recurring-tasks
    * @secure
    */
-  apiTokenList = (tenant: string, params: RequestParams = {}) =>
-    this.request<ListAPITokensResponse, APIErrors>({
-      path: `/api/v1/tenants/${tenant}/api-tokens`,
-      method: "GET",
+  recurringTaskCreate = (tenant: string, data: CreateRecurringTaskRequest, params: RequestParams = {}) =>
+    this.request<RecurringTask, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/recurring-tasks`,
+      method: "POST",
+      body: data,
       secure: true,
+      type: ContentType.Json,
       format: "json",
       ...params,
     });
   /**
-   * @description Revoke an API token for a tenant
+   * @description Deletes a recurring task
    *
-   * @tags API Token
-   * @name ApiTokenUpdateRevoke
-   * @summary Revoke API Token
-   * @request POST:/api/v1/api-tokens/{api-token}
+   * @tags Recurring Task
+   * @name RecurringTaskDelete
+   * @summary Delete recurring task
+   * @request DELETE:/api/v1/recurring-tasks/{recurring-task}
    * @secure
    */
-  apiTokenUpdateRevoke = (apiToken: string, params: RequestParams = {}) =>
+  recurringTaskDelete = (recurringTask: string, params: RequestParams = {}) =>
     this.request<void, APIErrors>({
-      path: `/api/v1/api-tokens/${apiToken}`,
-      method: "POST",
+      path: `/api/v1/recurring-tasks/${recurringTask}`,
+      method: "DELETE",
       secure: true,
       ...params,
     });
   /**
-   * @description Lists all events for a tenant.
+   * @description Lists the client certificates which are pinned for a tenant
    *
-   * @tags Event
-   * @name EventList
-   * @summary List events
-   * @request GET:/api/v1/tenants/{tenant}/events
+   * @tags Client Certificate
+   * @name ClientCertificateList
+   * @summary List client certificates
+   * @request GET:/api/v1/tenants/{tenant}/client-certificates
    * @secure
    */
-  eventList = (
-    tenant: string,
-    query?: {
-      /**
-       * The number to skip
-       * @format int64
-       */
-      offset?: number;
-      /**
-       * The number to limit by
-       * @format int64
-       */
-      limit?: number;
-      /** A list of keys to filter by */
-      keys?: EventKey[];
-      /** A list of workflow IDs to filter by */
-      workflows?: WorkflowID[];
-      /** A list of workflow run statuses to filter by */
-      statuses?: WorkflowRunStatusList;
-      /** The search query to filter for */
-      search?: EventSearch;
-      /** What to order by */
-      orderByField?: EventOrderByField;
-      /** The order direction */
-      orderByDirection?: EventOrderByDirection;
-    },
-    params: RequestParams = {},
-  ) =>
-    this.request<EventList, APIErrors>({
-      path: `/api/v1/tenants/${tenant}/events`,
+  clientCertificateList = (tenant: string, params: RequestParams = {}) =>
+    this.request<ListClientCertificates, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/client-certificates`,
       method: "GET",
-      query: query,
       secure: true,
       format: "json",
       ...params,
     });
   /**
-   * @description Replays a list of events.
+   * @description Pins a client certificate for a tenant. Once a tenant pins a certificate, workers of the tenant can only connect to the dispatcher with a pinned certificate
    *
-   * @tags Event
-   * @name EventUpdateReplay
-   * @summary Replay events
-   * @request POST:/api/v1/tenants/{tenant}/events/replay
+   * @tags Client Certificate
+   * @name ClientCertificateCreate
+   * @summary Create client certificate
+   * @request POST:/api/v1/tenants/{tenant}/client-certificates
    * @secure
    */
-  eventUpdateReplay = (tenant: string, data: ReplayEventRequest, params: RequestParams = {}) =>
-    this.request<EventList, APIErrors>({
-      path: `/api/v1/tenants/${tenant}/events/replay`,
+  clientCertificateCreate = (tenant: string, data: CreateClientCertificateRequest, params: RequestParams = {}) =>
+    this.request<ClientCertificate, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/client-certificates`,
       method: "POST",
       body: data,
       secure: true,
@@ -634,130 +706,1576 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       ...params,
     });
   /**
-   * @description Gets a list of tenant members
+   * @description Deletes a pinned client certificate
    *
-   * @tags Tenant
-   * @name TenantMemberList
-   * @summary List tenant members
-   * @request GET:/api/v1/tenants/{tenant}/members
+   * @tags Client Certificate
+   * @name ClientCertificateDelete
+   * @summary Delete client certificate
+   * @request DELETE:/api/v1/client-certificates/{client-certificate}
    * @secure
    */
-  tenantMemberList = (tenant: string, params: RequestParams = {}) =>
-    this.request<TenantMemberList, APIErrors | APIError>({
-      path: `/api/v1/tenants/${tenant}/members`,
-      method: "GET",
+  clientCertificateDelete = (clientCertificate: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/client-certificates/${clientCertificate}`,
+      method: "DELETE",
       secure: true,
-      format: "json",
       ...params,
     });
   /**
-   * @description Get the data for an event.
+   * @description Lists the PII rules of a tenant
    *
-   * @tags Event
-   * @name EventDataGet
-   * @summary Get event data
-   * @request GET:/api/v1/events/{event}/data
+   * @tags PII Rule
+   * @name PiiRuleList
+   * @summary List PII rules
+   * @request GET:/api/v1/tenants/{tenant}/pii-rules
    * @secure
    */
-  eventDataGet = (event: string, params: RequestParams = {}) =>
-    this.request<EventData, APIErrors>({
-      path: `/api/v1/events/${event}/data`,
+  piiRuleList = (tenant: string, params: RequestParams = {}) =>
+    this.request<ListPIIRules, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/pii-rules`,
       method: "GET",
       secure: true,
       format: "json",
       ...params,
     });
   /**
-   * @description Lists all event keys for a tenant.
+   * @description Creates a PII rule for a tenant. The values which are matched by the rule are purged from step run inputs and outputs once the retention of the rule has passed
    *
-   * @tags Event
-   * @name EventKeyList
-   * @summary List event keys
-   * @request GET:/api/v1/tenants/{tenant}/events/keys
+   * @tags PII Rule
+   * @name PiiRuleCreate
+   * @summary Create PII rule
+   * @request POST:/api/v1/tenants/{tenant}/pii-rules
    * @secure
    */
-  eventKeyList = (tenant: string, params: RequestParams = {}) =>
-    this.request<EventKeyList, APIErrors>({
-      path: `/api/v1/tenants/${tenant}/events/keys`,
-      method: "GET",
+  piiRuleCreate = (tenant: string, data: CreatePIIRuleRequest, params: RequestParams = {}) =>
+    this.request<PIIRule, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/pii-rules`,
+      method: "POST",
+      body: data,
       secure: true,
+      type: ContentType.Json,
       format: "json",
       ...params,
     });
   /**
-   * @description Get all workflows for a tenant
+   * @description Deletes a PII rule. Values which were already purged are not restored
    *
-   * @tags Workflow
-   * @name WorkflowList
-   * @summary Get workflows
+   * @tags PII Rule
+   * @name PiiRuleDelete
+   * @summary Delete PII rule
+   * @request DELETE:/api/v1/pii-rules/{pii-rule}
+   * @secure
+   */
+  piiRuleDelete = (piiRule: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/pii-rules/${piiRule}`,
+      method: "DELETE",
+      secure: true,
+      ...params,
+    });
+  /**
+   * @description Deletes the data of a subject, like a customer, from the workflow runs of a tenant whose run metadata has the value of the key. The inputs, outputs, errors and metadata of the workflow runs, the payloads of the events which triggered them, and their logs are deleted, while the workflow runs themselves are kept. Workflow runs which are still running are skipped
+   *
+   * @tags Subject Deletion
+   * @name SubjectDeletionCreate
+   * @summary Delete subject data
+   * @request POST:/api/v1/tenants/{tenant}/subject-deletions
+   * @secure
+   */
+  subjectDeletionCreate = (tenant: string, data: CreateSubjectDeletionRequest, params: RequestParams = {}) =>
+    this.request<SubjectDeletionReport, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/subject-deletions`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Lists the exports of a tenant, newest first
+   *
+   * @tags Tenant Export
+   * @name TenantExportList
+   * @summary List tenant exports
+   * @request GET:/api/v1/tenants/{tenant}/exports
+   * @secure
+   */
+  tenantExportList = (tenant: string, params: RequestParams = {}) =>
+    this.request<ListTenantExports, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/exports`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Starts an export of the workflows, workflow versions, workflow runs, events and API token metadata of a tenant. The archive is written in the background, and can be downloaded once the export succeeded
+   *
+   * @tags Tenant Export
+   * @name TenantExportCreate
+   * @summary Create tenant export
+   * @request POST:/api/v1/tenants/{tenant}/exports
+   * @secure
+   */
+  tenantExportCreate = (tenant: string, params: RequestParams = {}) =>
+    this.request<TenantExport, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/exports`,
+      method: "POST",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Gets an export of a tenant with its progress
+   *
+   * @tags Tenant Export
+   * @name TenantExportGet
+   * @summary Get tenant export
+   * @request GET:/api/v1/tenant-exports/{tenant-export}
+   * @secure
+   */
+  tenantExportGet = (tenantExport: string, params: RequestParams = {}) =>
+    this.request<TenantExport, APIErrors>({
+      path: `/api/v1/tenant-exports/${tenantExport}`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Creates a signed URL which the archive of an export can be downloaded from without authentication, until the URL expires
+   *
+   * @tags Tenant Export
+   * @name TenantExportCreateDownloadUrl
+   * @summary Create tenant export download URL
+   * @request POST:/api/v1/tenant-exports/{tenant-export}/download-url
+   * @secure
+   */
+  tenantExportCreateDownloadUrl = (tenantExport: string, params: RequestParams = {}) =>
+    this.request<TenantExportDownloadURL, APIErrors>({
+      path: `/api/v1/tenant-exports/${tenantExport}/download-url`,
+      method: "POST",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Downloads the archive of an export with a signed download URL
+   *
+   * @tags Tenant Export
+   * @name TenantExportDownload
+   * @summary Download tenant export
+   * @request GET:/api/v1/tenant-exports/{tenant-export}/download
+   */
+  tenantExportDownload = (
+    tenantExport: string,
+    query: {
+      /**
+       * The signed token of the download URL
+       * @minLength 1
+       */
+      token: string;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<File, APIErrors>({
+      path: `/api/v1/tenant-exports/${tenantExport}/download`,
+      method: "GET",
+      query: query,
+      ...params,
+    });
+  /**
+   * @description Lists the event bus subscriptions of a tenant
+   *
+   * @tags Event Bus
+   * @name EventBusSubscriptionList
+   * @summary List event bus subscriptions
+   * @request GET:/api/v1/tenants/{tenant}/event-bus-subscriptions
+   * @secure
+   */
+  eventBusSubscriptionList = (tenant: string, params: RequestParams = {}) =>
+    this.request<ListEventBusSubscriptions, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/event-bus-subscriptions`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Creates a durable event bus subscription for a tenant, which receives the records of its topics created after it
+   *
+   * @tags Event Bus
+   * @name EventBusSubscriptionCreate
+   * @summary Create event bus subscription
+   * @request POST:/api/v1/tenants/{tenant}/event-bus-subscriptions
+   * @secure
+   */
+  eventBusSubscriptionCreate = (tenant: string, data: CreateEventBusSubscriptionRequest, params: RequestParams = {}) =>
+    this.request<EventBusSubscription, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/event-bus-subscriptions`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Deletes an event bus subscription
+   *
+   * @tags Event Bus
+   * @name EventBusSubscriptionDelete
+   * @summary Delete event bus subscription
+   * @request DELETE:/api/v1/event-bus-subscriptions/{event-bus-subscription}
+   * @secure
+   */
+  eventBusSubscriptionDelete = (eventBusSubscription: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/event-bus-subscriptions/${eventBusSubscription}`,
+      method: "DELETE",
+      secure: true,
+      ...params,
+    });
+  /**
+   * @description Lists the records of an event bus subscription which have not been acknowledged yet, oldest first. If there are no records, the request waits for up to `wait` seconds for new records. Records are returned again until they are acknowledged.
+   *
+   * @tags Event Bus
+   * @name EventBusSubscriptionListRecords
+   * @summary List event bus records
+   * @request GET:/api/v1/event-bus-subscriptions/{event-bus-subscription}/records
+   * @secure
+   */
+  eventBusSubscriptionListRecords = (
+    eventBusSubscription: string,
+    query?: {
+      /**
+       * The number of seconds to wait for records, if there are none
+       * @format int64
+       */
+      wait?: number;
+      /**
+       * The maximum number of records to return
+       * @format int64
+       */
+      limit?: number;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<ListEventBusRecords, APIErrors>({
+      path: `/api/v1/event-bus-subscriptions/${eventBusSubscription}/records`,
+      method: "GET",
+      query: query,
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Acknowledges the records of an event bus subscription up to and including the cursor, so they are not returned again
+   *
+   * @tags Event Bus
+   * @name EventBusSubscriptionAck
+   * @summary Acknowledge event bus records
+   * @request POST:/api/v1/event-bus-subscriptions/{event-bus-subscription}/ack
+   * @secure
+   */
+  eventBusSubscriptionAck = (
+    eventBusSubscription: string,
+    data: AckEventBusSubscriptionRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<EventBusSubscription, APIErrors>({
+      path: `/api/v1/event-bus-subscriptions/${eventBusSubscription}/ack`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Gets the current user
+   *
+   * @tags User
+   * @name UserGetCurrent
+   * @summary Get current user
+   * @request GET:/api/v1/users/current
+   * @secure
+   */
+  userGetCurrent = (params: RequestParams = {}) =>
+    this.request<User, APIErrors>({
+      path: `/api/v1/users/current`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Registers a user.
+   *
+   * @tags User
+   * @name UserCreate
+   * @summary Register user
+   * @request POST:/api/v1/users/register
+   */
+  userCreate = (data: UserRegisterRequest, params: RequestParams = {}) =>
+    this.request<User, APIErrors>({
+      path: `/api/v1/users/register`,
+      method: "POST",
+      body: data,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Logs out a user.
+   *
+   * @tags User
+   * @name UserUpdateLogout
+   * @summary Logout user
+   * @request POST:/api/v1/users/logout
+   * @secure
+   */
+  userUpdateLogout = (params: RequestParams = {}) =>
+    this.request<User, APIErrors>({
+      path: `/api/v1/users/logout`,
+      method: "POST",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Lists all tenant memberships for the current user
+   *
+   * @tags User
+   * @name TenantMembershipsList
+   * @summary List tenant memberships
+   * @request GET:/api/v1/users/memberships
+   * @secure
+   */
+  tenantMembershipsList = (params: RequestParams = {}) =>
+    this.request<UserTenantMembershipsList, APIErrors>({
+      path: `/api/v1/users/memberships`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Lists all tenant invites for the current user
+   *
+   * @tags Tenant
+   * @name UserListTenantInvites
+   * @summary List tenant invites
+   * @request GET:/api/v1/users/invites
+   * @secure
+   */
+  userListTenantInvites = (params: RequestParams = {}) =>
+    this.request<TenantInviteList, APIErrors>({
+      path: `/api/v1/users/invites`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Accepts a tenant invite
+   *
+   * @tags Tenant
+   * @name TenantInviteAccept
+   * @summary Accept tenant invite
+   * @request POST:/api/v1/users/invites/accept
+   * @secure
+   */
+  tenantInviteAccept = (data: AcceptInviteRequest, params: RequestParams = {}) =>
+    this.request<void, APIErrors | APIError>({
+      path: `/api/v1/users/invites/accept`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      ...params,
+    });
+  /**
+   * @description Rejects a tenant invite
+   *
+   * @tags Tenant
+   * @name TenantInviteReject
+   * @summary Reject tenant invite
+   * @request POST:/api/v1/users/invites/reject
+   * @secure
+   */
+  tenantInviteReject = (data: RejectInviteRequest, params: RequestParams = {}) =>
+    this.request<void, APIErrors | APIError>({
+      path: `/api/v1/users/invites/reject`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      ...params,
+    });
+  /**
+   * @description Creates a new tenant
+   *
+   * @tags Tenant
+   * @name TenantCreate
+   * @summary Create tenant
+   * @request POST:/api/v1/tenants
+   * @secure
+   */
+  tenantCreate = (data: CreateTenantRequest, params: RequestParams = {}) =>
+    this.request<Tenant, APIErrors | APIError>({
+      path: `/api/v1/tenants`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Get a tenant
+   *
+   * @tags Tenant
+   * @name TenantGet
+   * @summary Get tenant
+   * @request GET:/api/v1/tenants/{tenant}
+   * @secure
+   */
+  tenantGet = (tenant: string, params: RequestParams = {}) =>
+    this.request<Tenant, APIErrors>({
+      path: `/api/v1/tenants/${tenant}`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Update a tenant. Fields which are not set are left unchanged.
+   *
+   * @tags Tenant
+   * @name TenantUpdate
+   * @summary Update tenant
+   * @request PATCH:/api/v1/tenants/{tenant}
+   * @secure
+   */
+  tenantUpdate = (tenant: string, data: UpdateTenantRequest, params: RequestParams = {}) =>
+    this.request<Tenant, APIErrors>({
+      path: `/api/v1/tenants/${tenant}`,
+      method: "PATCH",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Pause a tenant, which rejects or buffers the triggers of all of its workflows and stops its queued runs from starting, and optionally cancels its step runs which are queued or running
+   *
+   * @tags Tenant
+   * @name TenantUpdatePause
+   * @summary Pause tenant
+   * @request PUT:/api/v1/tenants/{tenant}/pause
+   * @secure
+   */
+  tenantUpdatePause = (tenant: string, data: PauseRequest, params: RequestParams = {}) =>
+    this.request<Tenant, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/pause`,
+      method: "PUT",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Resume a paused tenant, which starts its buffered runs
+   *
+   * @tags Tenant
+   * @name TenantDeletePause
+   * @summary Resume tenant
+   * @request DELETE:/api/v1/tenants/{tenant}/pause
+   * @secure
+   */
+  tenantDeletePause = (tenant: string, params: RequestParams = {}) =>
+    this.request<Tenant, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/pause`,
+      method: "DELETE",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Gets the engine settings of a tenant, which are the defaults of the engine unless the tenant overrides them
+   *
+   * @tags Tenant
+   * @name TenantGetEngineSettings
+   * @summary Get tenant engine settings
+   * @request GET:/api/v1/tenants/{tenant}/engine-settings
+   * @secure
+   */
+  tenantGetEngineSettings = (tenant: string, params: RequestParams = {}) =>
+    this.request<TenantEngineSettings, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/engine-settings`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Replaces the engine settings which a tenant overrides. Settings which are omitted use the defaults of the engine again
+   *
+   * @tags Tenant
+   * @name TenantUpdateEngineSettings
+   * @summary Update tenant engine settings
+   * @request PUT:/api/v1/tenants/{tenant}/engine-settings
+   * @secure
+   */
+  tenantUpdateEngineSettings = (tenant: string, data: UpdateTenantEngineSettingsRequest, params: RequestParams = {}) =>
+    this.request<TenantEngineSettings, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/engine-settings`,
+      method: "PUT",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Creates a new tenant invite
+   *
+   * @tags Tenant
+   * @name TenantInviteCreate
+   * @summary Create tenant invite
+   * @request POST:/api/v1/tenants/{tenant}/invites
+   * @secure
+   */
+  tenantInviteCreate = (tenant: string, data: CreateTenantInviteRequest, params: RequestParams = {}) =>
+    this.request<TenantInvite, APIErrors | APIError>({
+      path: `/api/v1/tenants/${tenant}/invites`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Gets a list of tenant invites
+   *
+   * @tags Tenant
+   * @name TenantInviteList
+   * @summary List tenant invites
+   * @request GET:/api/v1/tenants/{tenant}/invites
+   * @secure
+   */
+  tenantInviteList = (tenant: string, params: RequestParams = {}) =>
+    this.request<TenantInviteList, APIErrors | APIError>({
+      path: `/api/v1/tenants/${tenant}/invites`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Updates a tenant invite
+   *
+   * @name TenantInviteUpdate
+   * @summary Update invite
+   * @request PATCH:/api/v1/tenants/{tenant}/invites/{tenant-invite}
+   * @secure
+   */
+  tenantInviteUpdate = (
+    tenant: string,
+    tenantInvite: string,
+    data: UpdateTenantInviteRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<TenantInvite, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/invites/${tenantInvite}`,
+      method: "PATCH",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Deletes a tenant invite
+   *
+   * @name TenantInviteDelete
+   * @summary Delete invite
+   * @request DELETE:/api/v1/tenants/{tenant}/invites/{tenant-invite}
+   * @secure
+   */
+  tenantInviteDelete = (tenant: string, tenantInvite: string, params: RequestParams = {}) =>
+    this.request<TenantInvite, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/invites/${tenantInvite}`,
+      method: "DELETE",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Create an API token for a tenant
+   *
+   * @tags API Token
+   * @name ApiTokenCreate
+   * @summary Create API Token
+   * @request POST:/api/v1/tenants/{tenant}/api-tokens
+   * @secure
+   */
+  apiTokenCreate = (tenant: string, data: CreateAPITokenRequest, params: RequestParams = {}) =>
+    this.request<CreateAPITokenResponse, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/api-tokens`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description List API tokens for a tenant
+   *
+   * @tags API Token
+   * @name ApiTokenList
+   * @summary List API Tokens
+   * @request GET:/api/v1/tenants/{tenant}/api-tokens
+   * @secure
+   */
+  apiTokenList = (
+    tenant: string,
+    query?: {
+      /**
+       * Only return the API tokens of this environment. It is ignored for requests with an environment API token, which only return the API tokens of the environment of the token.
+       */
+      environment?: string;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<ListAPITokensResponse, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/api-tokens`,
+      method: "GET",
+      query: query,
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Get an API token for a tenant. The token itself is only returned when it is created.
+   *
+   * @tags API Token
+   * @name ApiTokenGet
+   * @summary Get API Token
+   * @request GET:/api/v1/api-tokens/{api-token}
+   * @secure
+   */
+  apiTokenGet = (apiToken: string, params: RequestParams = {}) =>
+    this.request<APIToken, APIErrors>({
+      path: `/api/v1/api-tokens/${apiToken}`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Revoke an API token for a tenant
+   *
+   * @tags API Token
+   * @name ApiTokenUpdateRevoke
+   * @summary Revoke API Token
+   * @request POST:/api/v1/api-tokens/{api-token}
+   * @secure
+   */
+  apiTokenUpdateRevoke = (apiToken: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/api-tokens/${apiToken}`,
+      method: "POST",
+      secure: true,
+      ...params,
+    });
+  /**
+   * @description Rotate an API token for a tenant. A new token is created with the same name, scopes and lifetime as the
+   * API token, and the API token is revoked.
+   *
+   * @tags API Token
+   * @name ApiTokenUpdateRotate
+   * @summary Rotate API Token
+   * @request POST:/api/v1/api-tokens/{api-token}/rotate
+   * @secure
+   */
+  apiTokenUpdateRotate = (apiToken: string, params: RequestParams = {}) =>
+    this.request<CreateAPITokenResponse, APIErrors>({
+      path: `/api/v1/api-tokens/${apiToken}/rotate`,
+      method: "POST",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Lists all events for a tenant.
+   *
+   * @tags Event
+   * @name EventList
+   * @summary List events
+   * @request GET:/api/v1/tenants/{tenant}/events
+   * @secure
+   */
+  eventList = (
+    tenant: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+      /** A list of keys to filter by */
+      keys?: EventKey[];
+      /** A list of workflow IDs to filter by */
+      workflows?: WorkflowID[];
+      /** A list of workflow run statuses to filter by */
+      statuses?: WorkflowRunStatusList;
+      /** The search query to filter for */
+      search?: EventSearch;
+      /**
+       * Only return the events of this environment. It is ignored for requests with an environment API token, which only return the events of the environment of the token.
+       */
+      environment?: string;
+      /**
+       * Only return the events of this namespace. It is ignored for requests with a namespace API token, which only return the events of the namespace of the token.
+       */
+      namespace?: string;
+      /** What to order by */
+      orderByField?: EventOrderByField;
+      /** The order direction */
+      orderByDirection?: EventOrderByDirection;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<EventList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/events`,
+      method: "GET",
+      query: query,
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Replays a list of events.
+   *
+   * @tags Event
+   * @name EventUpdateReplay
+   * @summary Replay events
+   * @request POST:/api/v1/tenants/{tenant}/events/replay
+   * @secure
+   */
+  eventUpdateReplay = (tenant: string, data: ReplayEventRequest, params: RequestParams = {}) =>
+    this.request<EventList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/events/replay`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Gets a list of tenant members
+   *
+   * @tags Tenant
+   * @name TenantMemberList
+   * @summary List tenant members
+   * @request GET:/api/v1/tenants/{tenant}/members
+   * @secure
+   */
+  tenantMemberList = (tenant: string, params: RequestParams = {}) =>
+    this.request<TenantMemberList, APIErrors | APIError>({
+      path: `/api/v1/tenants/${tenant}/members`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Get the data for an event.
+   *
+   * @tags Event
+   * @name EventDataGet
+   * @summary Get event data
+   * @request GET:/api/v1/events/{event}/data
+   * @secure
+   */
+  eventDataGet = (event: string, params: RequestParams = {}) =>
+    this.request<EventData, APIErrors>({
+      path: `/api/v1/events/${event}/data`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Lists all event keys for a tenant.
+   *
+   * @tags Event
+   * @name EventKeyList
+   * @summary List event keys
+   * @request GET:/api/v1/tenants/{tenant}/events/keys
+   * @secure
+   */
+  eventKeyList = (tenant: string, params: RequestParams = {}) =>
+    this.request<EventKeyList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/events/keys`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Get all workflows for a tenant
+   *
+   * @tags Workflow
+   * @name WorkflowList
+   * @summary Get workflows
    * @request GET:/api/v1/tenants/{tenant}/workflows
    * @secure
    */
-  workflowList = (tenant: string, params: RequestParams = {}) =>
-    this.request<WorkflowList, APIErrors>({
-      path: `/api/v1/tenants/${tenant}/workflows`,
+  workflowList = (
+    tenant: string,
+    query?: {
+      /** Only return the workflow with this name. Names are unique within a tenant. */
+      name?: string;
+      /**
+       * Only return the workflows which have a worker of this environment. It is ignored for requests with an environment API token, which only return the workflows which have a worker of the environment of the token.
+       */
+      environment?: string;
+      /**
+       * Only return the workflows of this namespace. It is ignored for requests with a namespace API token, which only return the workflows of the namespace of the token.
+       */
+      namespace?: string;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<WorkflowList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflows`,
+      method: "GET",
+      query: query,
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Create or update a workflow from its definition, identified by the workflow name in the definition. A new workflow
+   * version is only created when the definition has changed, so repeating the request has no further effect.
+   *
+   * @tags Workflow
+   * @name WorkflowPut
+   * @summary Put workflow
+   * @request PUT:/api/v1/tenants/{tenant}/workflows
+   * @secure
+   */
+  workflowPut = (tenant: string, data: WorkflowVersionDefinition, params: RequestParams = {}) =>
+    this.request<WorkflowVersion, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflows`,
+      method: "PUT",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Get a workflow for a tenant
+   *
+   * @tags Workflow
+   * @name WorkflowGet
+   * @summary Get workflow
+   * @request GET:/api/v1/workflows/{workflow}
+   * @secure
+   */
+  workflowGet = (workflow: string, params: RequestParams = {}) =>
+    this.request<Workflow, APIErrors>({
+      path: `/api/v1/workflows/${workflow}`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Delete a workflow for a tenant
+   *
+   * @tags Workflow
+   * @name WorkflowDelete
+   * @summary Delete workflow
+   * @request DELETE:/api/v1/workflows/{workflow}
+   * @secure
+   */
+  workflowDelete = (workflow: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/workflows/${workflow}`,
+      method: "DELETE",
+      secure: true,
+      ...params,
+    });
+  /**
+   * @description Get a workflow version for a tenant
+   *
+   * @tags Workflow
+   * @name WorkflowVersionGet
+   * @summary Get workflow version
+   * @request GET:/api/v1/workflows/{workflow}/versions
+   * @secure
+   */
+  workflowVersionGet = (
+    workflow: string,
+    query?: {
+      /**
+       * The workflow version. If not supplied, the latest version is fetched.
+       * @format uuid
+       * @minLength 36
+       * @maxLength 36
+       */
+      version?: string;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<WorkflowVersion, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/versions`,
+      method: "GET",
+      query: query,
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Trigger a new workflow run for a tenant
+   *
+   * @tags Workflow Run
+   * @name WorkflowRunCreate
+   * @summary Trigger workflow run
+   * @request POST:/api/v1/workflows/{workflow}/trigger
+   * @secure
+   */
+  workflowRunCreate = (
+    workflow: string,
+    data: TriggerWorkflowRunRequest,
+    query?: {
+      /**
+       * The workflow version. If not supplied, the latest version is fetched.
+       * @format uuid
+       * @minLength 36
+       * @maxLength 36
+       */
+      version?: string;
+      /** If true, the request blocks until the workflow run finishes or the timeout is reached, and the finished run is returned. */
+      wait?: boolean;
+      /**
+       * The maximum number of seconds to wait for the workflow run to finish when wait is set. Defaults to 30 seconds.
+       * @min 1
+       * @max 300
+       */
+      timeoutSeconds?: number;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<WorkflowRun, APIErrors | TriggerWorkflowRunRejected>({
+      path: `/api/v1/workflows/${workflow}/trigger`,
+      method: "POST",
+      query: query,
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Get a workflow version definition for a tenant
+   *
+   * @tags Workflow
+   * @name WorkflowVersionGetDefinition
+   * @summary Get workflow version definition
+   * @request GET:/api/v1/workflows/{workflow}/versions/definition
+   * @secure
+   */
+  workflowVersionGetDefinition = (
+    workflow: string,
+    query?: {
+      /**
+       * The workflow version. If not supplied, the latest version is fetched.
+       * @format uuid
+       * @minLength 36
+       * @maxLength 36
+       */
+      version?: string;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<WorkflowVersionDefinition, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/versions/definition`,
+      method: "GET",
+      query: query,
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description List the workflow runs which breached the SLA of the workflow, most recent first
+   *
+   * @tags Workflow
+   * @name WorkflowListSlaBreaches
+   * @summary List SLA breaches
+   * @request GET:/api/v1/workflows/{workflow}/sla-breaches
+   * @secure
+   */
+  workflowListSlaBreaches = (
+    workflow: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<WorkflowRunSLABreachList, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/sla-breaches`,
+      method: "GET",
+      query: query,
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Simulate how a concurrency limit would have treated the runs of a workflow over the last hours, without changing the concurrency limit of the workflow
+   *
+   * @tags Workflow
+   * @name WorkflowCreateConcurrencySimulation
+   * @summary Simulate concurrency limit
+   * @request POST:/api/v1/workflows/{workflow}/concurrency-simulations
+   * @secure
+   */
+  workflowCreateConcurrencySimulation = (
+    workflow: string,
+    data: CreateConcurrencySimulationRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<ConcurrencySimulation, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/concurrency-simulations`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Get the number of queued and running runs of a workflow by concurrency group, and by each component of the group keys which are computed from multiple key expressions
+   *
+   * @tags Workflow
+   * @name WorkflowGetConcurrencyQueueDepth
+   * @summary Get workflow concurrency queue depth
+   * @request GET:/api/v1/workflows/{workflow}/concurrency-queue-depth
+   * @secure
+   */
+  workflowGetConcurrencyQueueDepth = (workflow: string, params: RequestParams = {}) =>
+    this.request<WorkflowConcurrencyQueueDepth, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/concurrency-queue-depth`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Link a github repository to a workflow
+   *
+   * @tags Workflow
+   * @name WorkflowUpdateLinkGithub
+   * @summary Link github repository
+   * @request POST:/api/v1/workflows/{workflow}/link-github
+   * @secure
+   */
+  workflowUpdateLinkGithub = (workflow: string, data: LinkGithubRepositoryRequest, params: RequestParams = {}) =>
+    this.request<Workflow, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/link-github`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Get the rollout policy of a workflow, along with the state of the rollout of its latest version
+   *
+   * @tags Workflow
+   * @name WorkflowGetRollout
+   * @summary Get workflow rollout
+   * @request GET:/api/v1/workflows/{workflow}/rollout
+   * @secure
+   */
+  workflowGetRollout = (workflow: string, params: RequestParams = {}) =>
+    this.request<WorkflowRollout, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/rollout`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Set the rollout policy of a workflow, which applies to the next version of the workflow, or to the version which is being rolled out
+   *
+   * @tags Workflow
+   * @name WorkflowUpdateRollout
+   * @summary Update workflow rollout
+   * @request PUT:/api/v1/workflows/{workflow}/rollout
+   * @secure
+   */
+  workflowUpdateRollout = (workflow: string, data: UpdateWorkflowRolloutRequest, params: RequestParams = {}) =>
+    this.request<WorkflowRollout, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/rollout`,
+      method: "PUT",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Delete the rollout policy of a workflow, after which the latest version receives all of the triggers
+   *
+   * @tags Workflow
+   * @name WorkflowDeleteRollout
+   * @summary Delete workflow rollout
+   * @request DELETE:/api/v1/workflows/{workflow}/rollout
+   * @secure
+   */
+  workflowDeleteRollout = (workflow: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/rollout`,
+      method: "DELETE",
+      secure: true,
+      ...params,
+    });
+  /**
+   * @description Pause a workflow, which rejects or buffers its triggers and stops its queued runs from starting, and optionally cancels its step runs which are queued or running
+   *
+   * @tags Workflow
+   * @name WorkflowUpdatePause
+   * @summary Pause workflow
+   * @request PUT:/api/v1/workflows/{workflow}/pause
+   * @secure
+   */
+  workflowUpdatePause = (workflow: string, data: PauseRequest, params: RequestParams = {}) =>
+    this.request<Workflow, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/pause`,
+      method: "PUT",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Resume a paused workflow, which starts its buffered runs
+   *
+   * @tags Workflow
+   * @name WorkflowDeletePause
+   * @summary Resume workflow
+   * @request DELETE:/api/v1/workflows/{workflow}/pause
+   * @secure
+   */
+  workflowDeletePause = (workflow: string, params: RequestParams = {}) =>
+    this.request<Workflow, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/pause`,
+      method: "DELETE",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Set the percentage of the new runs of a workflow which are sampled to capture extended diagnostics, which are full snapshots of the inputs and outputs, the timelines and the trace context of their step runs
+   *
+   * @tags Workflow
+   * @name WorkflowUpdateDiagnostics
+   * @summary Update workflow diagnostics
+   * @request PUT:/api/v1/workflows/{workflow}/diagnostics
+   * @secure
+   */
+  workflowUpdateDiagnostics = (
+    workflow: string,
+    data: UpdateWorkflowDiagnosticsRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<Workflow, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/diagnostics`,
+      method: "PUT",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Lists the trigger links of a workflow
+   *
+   * @tags Trigger Link
+   * @name TriggerLinkList
+   * @summary List trigger links
+   * @request GET:/api/v1/workflows/{workflow}/trigger-links
+   * @secure
+   */
+  triggerLinkList = (workflow: string, params: RequestParams = {}) =>
+    this.request<ListTriggerLinks, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/trigger-links`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Creates a trigger link for a workflow, which is a URL with a token that can only trigger runs of the workflow
+   *
+   * @tags Trigger Link
+   * @name TriggerLinkCreate
+   * @summary Create trigger link
+   * @request POST:/api/v1/workflows/{workflow}/trigger-links
+   * @secure
+   */
+  triggerLinkCreate = (workflow: string, data: CreateTriggerLinkRequest, params: RequestParams = {}) =>
+    this.request<CreateTriggerLinkResponse, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/trigger-links`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Deletes a trigger link, after which its token can no longer trigger runs
+   *
+   * @tags Trigger Link
+   * @name TriggerLinkDelete
+   * @summary Delete trigger link
+   * @request DELETE:/api/v1/trigger-links/{trigger-link}
+   * @secure
+   */
+  triggerLinkDelete = (triggerLink: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/trigger-links/${triggerLink}`,
+      method: "DELETE",
+      secure: true,
+      ...params,
+    });
+  /**
+   * @description Triggers a run of the workflow of a trigger link. The body is a JSON object or a form, which is merged onto the default input of the trigger link.
+   *
+   * @tags Trigger Link
+   * @name TriggerLinkRun
+   * @summary Run trigger link
+   * @request POST:/api/v1/trigger-links/{trigger-link}/trigger
+   */
+  triggerLinkRun = (
+    triggerLink: string,
+    query: {
+      /**
+       * The token of the trigger link
+       * @minLength 1
+       * @maxLength 255
+       */
+      token: string;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<TriggerLinkRunResult, APIErrors>({
+      path: `/api/v1/trigger-links/${triggerLink}/trigger`,
+      method: "POST",
+      query: query,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Lists the maintenance windows of a workflow
+   *
+   * @tags Maintenance Window
+   * @name MaintenanceWindowList
+   * @summary List maintenance windows
+   * @request GET:/api/v1/workflows/{workflow}/maintenance-windows
+   * @secure
+   */
+  maintenanceWindowList = (workflow: string, params: RequestParams = {}) =>
+    this.request<ListMaintenanceWindows, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/maintenance-windows`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Creates a recurring maintenance window for a workflow, during which runs of the workflow are buffered and started when the window ends
+   *
+   * @tags Maintenance Window
+   * @name MaintenanceWindowCreate
+   * @summary Create maintenance window
+   * @request POST:/api/v1/workflows/{workflow}/maintenance-windows
+   * @secure
+   */
+  maintenanceWindowCreate = (workflow: string, data: CreateMaintenanceWindowRequest, params: RequestParams = {}) =>
+    this.request<MaintenanceWindow, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/maintenance-windows`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Deletes a maintenance window. A window which is in progress still ends at its scheduled end.
+   *
+   * @tags Maintenance Window
+   * @name MaintenanceWindowDelete
+   * @summary Delete maintenance window
+   * @request DELETE:/api/v1/maintenance-windows/{maintenance-window}
+   * @secure
+   */
+  maintenanceWindowDelete = (maintenanceWindow: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/maintenance-windows/${maintenanceWindow}`,
+      method: "DELETE",
+      secure: true,
+      ...params,
+    });
+  /**
+   * @description Create a pull request for a workflow
+   *
+   * @tags Workflow
+   * @name StepRunUpdateCreatePr
+   * @summary Create pull request
+   * @request POST:/api/v1/step-runs/{step-run}/create-pr
+   * @secure
+   */
+  stepRunUpdateCreatePr = (stepRun: string, data: CreatePullRequestFromStepRun, params: RequestParams = {}) =>
+    this.request<CreatePullRequestFromStepRun, APIErrors>({
+      path: `/api/v1/step-runs/${stepRun}/create-pr`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Lists log lines for a step run.
+   *
+   * @tags Log
+   * @name LogLineList
+   * @summary List log lines
+   * @request GET:/api/v1/step-runs/{step-run}/logs
+   * @secure
+   */
+  logLineList = (
+    stepRun: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+      /** A list of levels to filter by */
+      levels?: LogLineLevelField;
+      /** The search query to filter for */
+      search?: LogLineSearch;
+      /** What to order by */
+      orderByField?: LogLineOrderByField;
+      /** The order direction */
+      orderByDirection?: LogLineOrderByDirection;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<LogLineList, APIErrors>({
+      path: `/api/v1/step-runs/${stepRun}/logs`,
       method: "GET",
+      query: query,
       secure: true,
       format: "json",
       ...params,
     });
   /**
-   * @description Get a workflow for a tenant
+   * @description Get the diff for a step run between the most recent run and the first run.
    *
    * @tags Workflow
-   * @name WorkflowGet
-   * @summary Get workflow
-   * @request GET:/api/v1/workflows/{workflow}
+   * @name StepRunGetDiff
+   * @summary Get diff
+   * @request GET:/api/v1/step-runs/{step-run}/diff
    * @secure
    */
-  workflowGet = (workflow: string, params: RequestParams = {}) =>
-    this.request<Workflow, APIErrors>({
-      path: `/api/v1/workflows/${workflow}`,
+  stepRunGetDiff = (stepRun: string, params: RequestParams = {}) =>
+    this.request<GetStepRunDiffResponse, APIErrors>({
+      path: `/api/v1/step-runs/${stepRun}/diff`,
       method: "GET",
       secure: true,
       format: "json",
       ...params,
     });
   /**
-   * @description Delete a workflow for a tenant
+   * @description Get all workflow runs for a tenant
    *
    * @tags Workflow
-   * @name WorkflowDelete
-   * @summary Delete workflow
-   * @request DELETE:/api/v1/workflows/{workflow}
+   * @name WorkflowRunList
+   * @summary Get workflow runs
+   * @request GET:/api/v1/tenants/{tenant}/workflows/runs
    * @secure
    */
-  workflowDelete = (workflow: string, params: RequestParams = {}) =>
-    this.request<void, APIErrors>({
-      path: `/api/v1/workflows/${workflow}`,
-      method: "DELETE",
+  workflowRunList = (
+    tenant: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+      /**
+       * The event id to get runs for.
+       * @format uuid
+       * @minLength 36
+       * @maxLength 36
+       */
+      eventId?: string;
+      /**
+       * The workflow id to get runs for.
+       * @format uuid
+       * @minLength 36
+       * @maxLength 36
+       */
+      workflowId?: string;
+      /** The status to get runs for. */
+      status?: WorkflowRunStatus;
+      /**
+       * Only get runs created at or after this time.
+       * @format date-time
+       */
+      createdAfter?: string;
+      /** The cancellation source to get failed runs for. */
+      cancelledSource?: CancellationSource;
+      /**
+       * Only return the runs of this environment. It is ignored for requests with an environment API token, which only return the runs of the environment of the token.
+       */
+      environment?: string;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<WorkflowRunList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflows/runs`,
+      method: "GET",
+      query: query,
       secure: true,
+      format: "json",
       ...params,
     });
   /**
-   * @description Get a workflow version for a tenant
+   * @description Streams all workflow runs for a tenant which match the given filters, ordered by creation time. Each row contains a cursor
+   * which can be passed back to resume the export from that point.
    *
    * @tags Workflow
-   * @name WorkflowVersionGet
-   * @summary Get workflow version
-   * @request GET:/api/v1/workflows/{workflow}/versions
+   * @name WorkflowRunExport
+   * @summary Export workflow runs
+   * @request GET:/api/v1/tenants/{tenant}/workflows/runs/export
    * @secure
    */
-  workflowVersionGet = (
-    workflow: string,
+  workflowRunExport = (
+    tenant: string,
     query?: {
+      /** The format of the export. Defaults to ndjson. */
+      format?: WorkflowRunExportFormat;
+      /** The cursor of the last row which was received, to resume an export. */
+      cursor?: string;
       /**
-       * The workflow version. If not supplied, the latest version is fetched.
+       * The workflow id to export runs for.
        * @format uuid
        * @minLength 36
        * @maxLength 36
        */
-      version?: string;
+      workflowId?: string;
+      /** The status to export runs for. */
+      status?: WorkflowRunStatus;
+      /**
+       * Only export runs created at or after this time.
+       * @format date-time
+       */
+      createdAfter?: string;
+      /**
+       * Only export runs created before this time.
+       * @format date-time
+       */
+      createdBefore?: string;
     },
     params: RequestParams = {},
   ) =>
-    this.request<WorkflowVersion, APIErrors>({
-      path: `/api/v1/workflows/${workflow}/versions`,
+    this.request<File, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflows/runs/export`,
+      method: "GET",
+      query: query,
+      secure: true,
+      ...params,
+    });
+  /**
+   * @description Lists the number of runs of the workflows of a tenant which succeeded and failed, their failure rate and their mean duration, grouped by workflow and time bucket. Runs which were purged by a recurring task are included, so that the metrics remain accurate beyond the retention of the runs. Debug runs are excluded.
+   *
+   * @tags Workflow
+   * @name WorkflowRunGetMetrics
+   * @summary Get workflow run metrics
+   * @request GET:/api/v1/tenants/{tenant}/workflows/runs/metrics
+   * @secure
+   */
+  workflowRunGetMetrics = (
+    tenant: string,
+    query?: {
+      /**
+       * Only include runs which finished at or after this time. Defaults to seven days ago.
+       * @format date-time
+       */
+      since?: string;
+      /**
+       * Only include runs which finished before this time. Defaults to now.
+       * @format date-time
+       */
+      until?: string;
+      /** The size of the time buckets. Defaults to DAY. */
+      bucket?: WorkflowRunMetricsBucket;
+      /**
+       * Only include runs of this workflow
+       * @format uuid
+       * @minLength 36
+       * @maxLength 36
+       */
+      workflowId?: string;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<WorkflowRunMetrics, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflows/runs/metrics`,
       method: "GET",
       query: query,
       secure: true,
@@ -765,32 +2283,156 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       ...params,
     });
   /**
-   * @description Trigger a new workflow run for a tenant
+   * @description Get all scheduled workflow runs for a tenant
    *
-   * @tags Workflow Run
-   * @name WorkflowRunCreate
-   * @summary Trigger workflow run
-   * @request POST:/api/v1/workflows/{workflow}/trigger
+   * @tags Workflow
+   * @name WorkflowRunListScheduled
+   * @summary Get scheduled workflow runs
+   * @request GET:/api/v1/tenants/{tenant}/workflows/schedules
    * @secure
    */
-  workflowRunCreate = (
-    workflow: string,
-    data: TriggerWorkflowRunRequest,
+  workflowRunListScheduled = (
+    tenant: string,
     query?: {
       /**
-       * The workflow version. If not supplied, the latest version is fetched.
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+      /**
+       * The workflow id to get scheduled runs for.
        * @format uuid
        * @minLength 36
        * @maxLength 36
        */
-      version?: string;
+      workflowId?: string;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<ScheduledWorkflowRunList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflows/schedules`,
+      method: "GET",
+      query: query,
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Re-queues every failed workflow run which matches the given filter. The runs are retried in batches by a background job,
+   * and the returned bulk retry can be polled for progress.
+   *
+   * @tags Workflow
+   * @name WorkflowRunBulkRetry
+   * @summary Bulk retry workflow runs
+   * @request POST:/api/v1/tenants/{tenant}/workflow-runs/bulk-retry
+   * @secure
+   */
+  workflowRunBulkRetry = (tenant: string, data: WorkflowRunBulkRetryRequest, params: RequestParams = {}) =>
+    this.request<WorkflowRunBulkRetry, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflow-runs/bulk-retry`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Get the status and progress of a bulk retry
+   *
+   * @tags Workflow
+   * @name WorkflowRunGetBulkRetry
+   * @summary Get bulk retry
+   * @request GET:/api/v1/tenants/{tenant}/workflow-runs/bulk-retry/{bulk-retry}
+   * @secure
+   */
+  workflowRunGetBulkRetry = (tenant: string, bulkRetry: string, params: RequestParams = {}) =>
+    this.request<WorkflowRunBulkRetry, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflow-runs/bulk-retry/${bulkRetry}`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Import a workflow run from a bundle which was exported from this or another Hatchet instance. The workflow version in the
+   * bundle is put like a deploy, unless the latest version of the workflow has the same definition. The run is restored as a
+   * debug run in its final state, so it is never queued, and it can be replayed to run it again.
+   *
+   * @tags Workflow
+   * @name WorkflowRunImport
+   * @summary Import workflow run bundle
+   * @request POST:/api/v1/tenants/{tenant}/workflow-runs/import
+   * @secure
+   */
+  workflowRunImport = (tenant: string, data: WorkflowRunBundle, params: RequestParams = {}) =>
+    this.request<WorkflowRun, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflow-runs/import`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Get a workflow run for a tenant
+   *
+   * @tags Workflow
+   * @name WorkflowRunGet
+   * @summary Get workflow run
+   * @request GET:/api/v1/tenants/{tenant}/workflow-runs/{workflow-run}
+   * @secure
+   */
+  workflowRunGet = (
+    tenant: string,
+    workflowRun: string,
+    query?: {
+      /**
+       * The relations to hydrate, as a comma-separated list. Logs are returned on each step run and imply stepRuns. If omitted,
+       * step runs and the triggering event are returned.
+       */
+      include?: WorkflowRunInclude[];
+      /**
+       * A comma-separated list of the optional fields to return. Workflow run fields are given by name, and step run fields are
+       * prefixed with `stepRuns.`. Required fields are always returned.
+       */
+      fields?: string[];
     },
     params: RequestParams = {},
   ) =>
     this.request<WorkflowRun, APIErrors>({
-      path: `/api/v1/workflows/${workflow}/trigger`,
+      path: `/api/v1/tenants/${tenant}/workflow-runs/${workflowRun}`,
+      method: "GET",
+      query: query,
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Replay a workflow run as a debug run, with the same workflow version. The input can be changed, and the replay can start
+   * from a step, in which case the outputs of the steps before it are copied from the replayed run.
+   *
+   * @tags Workflow
+   * @name WorkflowRunCreateReplay
+   * @summary Replay workflow run
+   * @request POST:/api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/replay
+   * @secure
+   */
+  workflowRunCreateReplay = (
+    tenant: string,
+    workflowRun: string,
+    data: ReplayWorkflowRunRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<WorkflowRun, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflow-runs/${workflowRun}/replay`,
       method: "POST",
-      query: query,
       body: data,
       secure: true,
       type: ContentType.Json,
@@ -798,108 +2440,96 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       ...params,
     });
   /**
-   * @description Get a workflow version definition for a tenant
+   * @description Export a finished workflow run as a portable bundle, with the definition of its workflow version, its input, the event
+   * which triggered it, and the input, output and error of each step run. The bundle can be imported into another tenant or
+   * Hatchet instance, for example to debug a production failure in staging.
    *
    * @tags Workflow
-   * @name WorkflowVersionGetDefinition
-   * @summary Get workflow version definition
-   * @request GET:/api/v1/workflows/{workflow}/versions/definition
+   * @name WorkflowRunGetBundle
+   * @summary Export workflow run bundle
+   * @request GET:/api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/bundle
    * @secure
    */
-  workflowVersionGetDefinition = (
-    workflow: string,
-    query?: {
-      /**
-       * The workflow version. If not supplied, the latest version is fetched.
-       * @format uuid
-       * @minLength 36
-       * @maxLength 36
-       */
-      version?: string;
-    },
-    params: RequestParams = {},
-  ) =>
-    this.request<WorkflowVersionDefinition, APIErrors>({
-      path: `/api/v1/workflows/${workflow}/versions/definition`,
+  workflowRunGetBundle = (tenant: string, workflowRun: string, params: RequestParams = {}) =>
+    this.request<WorkflowRunBundle, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflow-runs/${workflowRun}/bundle`,
       method: "GET",
-      query: query,
       secure: true,
       format: "json",
       ...params,
     });
   /**
-   * @description Link a github repository to a workflow
+   * @description Get a signed attestation of a finished workflow run, which records the hashes of its input and of the input and output of
+   * each step run, when they ran and which workers ran them. The attestation can be verified offline with the keys of the instance.
    *
    * @tags Workflow
-   * @name WorkflowUpdateLinkGithub
-   * @summary Link github repository
-   * @request POST:/api/v1/workflows/{workflow}/link-github
+   * @name WorkflowRunGetAttestation
+   * @summary Get workflow run attestation
+   * @request GET:/api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/attestation
    * @secure
    */
-  workflowUpdateLinkGithub = (workflow: string, data: LinkGithubRepositoryRequest, params: RequestParams = {}) =>
-    this.request<Workflow, APIErrors>({
-      path: `/api/v1/workflows/${workflow}/link-github`,
-      method: "POST",
-      body: data,
+  workflowRunGetAttestation = (tenant: string, workflowRun: string, params: RequestParams = {}) =>
+    this.request<WorkflowRunAttestation, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflow-runs/${workflowRun}/attestation`,
+      method: "GET",
       secure: true,
-      type: ContentType.Json,
       format: "json",
       ...params,
     });
   /**
-   * @description Create a pull request for a workflow
+   * @description Get the group key run for a workflow run, if the workflow has a concurrency group
    *
    * @tags Workflow
-   * @name StepRunUpdateCreatePr
-   * @summary Create pull request
-   * @request POST:/api/v1/step-runs/{step-run}/create-pr
+   * @name WorkflowRunGetGroupKeyRun
+   * @summary Get group key run
+   * @request GET:/api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/group-key-run
    * @secure
    */
-  stepRunUpdateCreatePr = (stepRun: string, data: CreatePullRequestFromStepRun, params: RequestParams = {}) =>
-    this.request<CreatePullRequestFromStepRun, APIErrors>({
-      path: `/api/v1/step-runs/${stepRun}/create-pr`,
-      method: "POST",
-      body: data,
+  workflowRunGetGroupKeyRun = (tenant: string, workflowRun: string, params: RequestParams = {}) =>
+    this.request<GetGroupKeyRun, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflow-runs/${workflowRun}/group-key-run`,
+      method: "GET",
       secure: true,
-      type: ContentType.Json,
       format: "json",
       ...params,
     });
   /**
-   * @description Lists log lines for a step run.
+   * @description Get the DAG of a workflow run for rendering as a graph. Each node is a step run, annotated with its status, duration and retry count, and each edge points from a parent step run to a child step run. Nodes are ordered by job, then by depth in the DAG, then by the readable id of the step.
    *
-   * @tags Log
-   * @name LogLineList
-   * @summary List log lines
-   * @request GET:/api/v1/step-runs/{step-run}/logs
+   * @tags Workflow
+   * @name WorkflowRunGetDag
+   * @summary Get workflow run DAG
+   * @request GET:/api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/dag
    * @secure
    */
-  logLineList = (
-    stepRun: string,
+  workflowRunGetDag = (tenant: string, workflowRun: string, params: RequestParams = {}) =>
+    this.request<WorkflowRunDag, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflow-runs/${workflowRun}/dag`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description List all pull requests for a workflow run
+   *
+   * @tags Workflow
+   * @name WorkflowRunListPullRequests
+   * @summary List pull requests
+   * @request GET:/api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/prs
+   * @secure
+   */
+  workflowRunListPullRequests = (
+    tenant: string,
+    workflowRun: string,
     query?: {
-      /**
-       * The number to skip
-       * @format int64
-       */
-      offset?: number;
-      /**
-       * The number to limit by
-       * @format int64
-       */
-      limit?: number;
-      /** A list of levels to filter by */
-      levels?: LogLineLevelField;
-      /** The search query to filter for */
-      search?: LogLineSearch;
-      /** What to order by */
-      orderByField?: LogLineOrderByField;
-      /** The order direction */
-      orderByDirection?: LogLineOrderByDirection;
+      /** The pull request state */
+      state?: PullRequestState;
     },
     params: RequestParams = {},
   ) =>
-    this.request<LogLineList, APIErrors>({
-      path: `/api/v1/step-runs/${stepRun}/logs`,
+    this.request<ListPullRequestsResponse, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflow-runs/${workflowRun}/prs`,
       method: "GET",
       query: query,
       secure: true,
@@ -907,63 +2537,44 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       ...params,
     });
   /**
-   * @description Get the diff for a step run between the most recent run and the first run.
+   * @description Get the root cause of a failed workflow run. The root cause is the step run which failed or timed out first, returned with its error, the attempts before its last retry, the worker it was assigned to, and the timeouts and cancellations of other step runs in the workflow run up to the time it failed.
    *
    * @tags Workflow
-   * @name StepRunGetDiff
-   * @summary Get diff
-   * @request GET:/api/v1/step-runs/{step-run}/diff
+   * @name WorkflowRunGetRootCause
+   * @summary Get workflow run root cause
+   * @request GET:/api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/root-cause
    * @secure
    */
-  stepRunGetDiff = (stepRun: string, params: RequestParams = {}) =>
-    this.request<GetStepRunDiffResponse, APIErrors>({
-      path: `/api/v1/step-runs/${stepRun}/diff`,
+  workflowRunGetRootCause = (tenant: string, workflowRun: string, params: RequestParams = {}) =>
+    this.request<WorkflowRunRootCause, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflow-runs/${workflowRun}/root-cause`,
       method: "GET",
       secure: true,
       format: "json",
       ...params,
     });
   /**
-   * @description Get all workflow runs for a tenant
+   * @description Lists the p50 and p95 latency of each phase of the step runs for a tenant, grouped by action. The phases are the time in the queue, the time waiting for a worker slot, the dispatch to the worker, the execution on the worker and the persistence of the result.
    *
-   * @tags Workflow
-   * @name WorkflowRunList
-   * @summary Get workflow runs
-   * @request GET:/api/v1/tenants/{tenant}/workflows/runs
+   * @tags Step Run
+   * @name StepRunListMetrics
+   * @summary List step run metrics
+   * @request GET:/api/v1/tenants/{tenant}/step-run-metrics
    * @secure
    */
-  workflowRunList = (
+  stepRunListMetrics = (
     tenant: string,
     query?: {
       /**
-       * The number to skip
-       * @format int64
-       */
-      offset?: number;
-      /**
-       * The number to limit by
-       * @format int64
-       */
-      limit?: number;
-      /**
-       * The event id to get runs for.
-       * @format uuid
-       * @minLength 36
-       * @maxLength 36
-       */
-      eventId?: string;
-      /**
-       * The workflow id to get runs for.
-       * @format uuid
-       * @minLength 36
-       * @maxLength 36
+       * Only include step runs which finished after this time. Defaults to one hour ago.
+       * @format date-time
        */
-      workflowId?: string;
+      since?: string;
     },
     params: RequestParams = {},
   ) =>
-    this.request<WorkflowRunList, APIErrors>({
-      path: `/api/v1/tenants/${tenant}/workflows/runs`,
+    this.request<StepRunMetrics, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/step-run-metrics`,
       method: "GET",
       query: query,
       secure: true,
@@ -971,42 +2582,75 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       ...params,
     });
   /**
-   * @description Get a workflow run for a tenant
+   * @description Lists the compute seconds and run counts of the step runs for a tenant, grouped by cost center and time bucket. Step runs are attributed to the cost center of their step, or of their workflow if the step has none.
    *
-   * @tags Workflow
-   * @name WorkflowRunGet
-   * @summary Get workflow run
-   * @request GET:/api/v1/tenants/{tenant}/workflow-runs/{workflow-run}
+   * @tags Step Run
+   * @name StepRunListCostCenterUsage
+   * @summary List cost center usage
+   * @request GET:/api/v1/tenants/{tenant}/cost-center-usage
    * @secure
    */
-  workflowRunGet = (tenant: string, workflowRun: string, params: RequestParams = {}) =>
-    this.request<WorkflowRun, APIErrors>({
-      path: `/api/v1/tenants/${tenant}/workflow-runs/${workflowRun}`,
+  stepRunListCostCenterUsage = (
+    tenant: string,
+    query?: {
+      /**
+       * Only include step runs which finished at or after this time. Defaults to seven days ago.
+       * @format date-time
+       */
+      since?: string;
+      /**
+       * Only include step runs which finished before this time. Defaults to now.
+       * @format date-time
+       */
+      until?: string;
+      /** The size of the time buckets. Defaults to day. */
+      bucket?: CostCenterUsageBucket;
+      /** Only include step runs of this cost center */
+      costCenter?: string;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<CostCenterUsage, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/cost-center-usage`,
       method: "GET",
+      query: query,
       secure: true,
       format: "json",
       ...params,
     });
   /**
-   * @description List all pull requests for a workflow run
+   * @description Lists step runs for a tenant, optionally filtered by workflow run, job run or status
    *
-   * @tags Workflow
-   * @name WorkflowRunListPullRequests
-   * @summary List pull requests
-   * @request GET:/api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/prs
+   * @tags Step Run
+   * @name StepRunList
+   * @summary List step runs
+   * @request GET:/api/v1/tenants/{tenant}/step-runs
    * @secure
    */
-  workflowRunListPullRequests = (
+  stepRunList = (
     tenant: string,
-    workflowRun: string,
     query?: {
-      /** The pull request state */
-      state?: PullRequestState;
+      /**
+       * The workflow run id to get step runs for.
+       * @format uuid
+       * @minLength 36
+       * @maxLength 36
+       */
+      workflowRunId?: string;
+      /**
+       * The job run id to get step runs for.
+       * @format uuid
+       * @minLength 36
+       * @maxLength 36
+       */
+      jobRunId?: string;
+      /** The status to filter step runs by. */
+      status?: StepRunStatus;
     },
     params: RequestParams = {},
   ) =>
-    this.request<ListPullRequestsResponse, APIErrors>({
-      path: `/api/v1/tenants/${tenant}/workflow-runs/${workflowRun}/prs`,
+    this.request<StepRunList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/step-runs`,
       method: "GET",
       query: query,
       secure: true,
@@ -1030,6 +2674,77 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: "json",
       ...params,
     });
+  /**
+   * @description Lists the previous attempts of a step run, newest first. The latest attempt is the step run itself.
+   *
+   * @tags Step Run
+   * @name StepRunListAttempts
+   * @summary List step run attempts
+   * @request GET:/api/v1/tenants/{tenant}/step-runs/{step-run}/attempts
+   * @secure
+   */
+  stepRunListAttempts = (tenant: string, stepRun: string, params: RequestParams = {}) =>
+    this.request<StepRunAttemptList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/step-runs/${stepRun}/attempts`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Lists the diagnostics which were captured for the attempts of a step run, newest first. Diagnostics are only captured if the workflow run of the step run was sampled.
+   *
+   * @tags Step Run
+   * @name StepRunListDiagnostics
+   * @summary List step run diagnostics
+   * @request GET:/api/v1/tenants/{tenant}/step-runs/{step-run}/diagnostics
+   * @secure
+   */
+  stepRunListDiagnostics = (tenant: string, stepRun: string, params: RequestParams = {}) =>
+    this.request<StepRunDiagnosticList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/step-runs/${stepRun}/diagnostics`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Lists the stream events of the latest attempt of a step run, ordered by index. Step runs send stream events before they finish, so a client which subscribes to a workflow run late can list the stream events which it missed.
+   *
+   * @tags Step Run
+   * @name StepRunListStreamEvents
+   * @summary List step run stream events
+   * @request GET:/api/v1/tenants/{tenant}/step-runs/{step-run}/stream-events
+   * @secure
+   */
+  stepRunListStreamEvents = (
+    tenant: string,
+    stepRun: string,
+    query?: {
+      /**
+       * Only list the stream events with an index greater than this index
+       * @format int64
+       * @min 0
+       */
+      after?: number;
+      /**
+       * The number of stream events to list
+       * @format int64
+       * @min 1
+       * @max 1000
+       */
+      limit?: number;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<StepRunStreamEventList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/step-runs/${stepRun}/stream-events`,
+      method: "GET",
+      query: query,
+      secure: true,
+      format: "json",
+      ...params,
+    });
   /**
    * @description Reruns a step run
    *
@@ -1066,6 +2781,41 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: "json",
       ...params,
     });
+  /**
+   * @description Lists the most recent deliveries of the Github and Gitea webhooks of a tenant, newest first, along with the results of processing them.
+   *
+   * @tags Webhook
+   * @name WebhookDeliveryList
+   * @summary List webhook deliveries
+   * @request GET:/api/v1/tenants/{tenant}/webhook-deliveries
+   * @secure
+   */
+  webhookDeliveryList = (
+    tenant: string,
+    query?: {
+      /**
+       * The id of the webhook to list deliveries for
+       * @format uuid
+       * @minLength 36
+       * @maxLength 36
+       */
+      webhook?: string;
+      /**
+       * The maximum number of deliveries to return
+       * @format int64
+       */
+      limit?: number;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<ListWebhookDeliveries, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/webhook-deliveries`,
+      method: "GET",
+      query: query,
+      secure: true,
+      format: "json",
+      ...params,
+    });
   /**
    * @description Get all workers for a tenant
    *
@@ -1075,10 +2825,20 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
    * @request GET:/api/v1/tenants/{tenant}/worker
    * @secure
    */
-  workerList = (tenant: string, params: RequestParams = {}) =>
+  workerList = (
+    tenant: string,
+    query?: {
+      /**
+       * Only return the workers of this environment. It is ignored for requests with an environment API token, which only return the workers of the environment of the token.
+       */
+      environment?: string;
+    },
+    params: RequestParams = {},
+  ) =>
     this.request<WorkerList, APIErrors>({
       path: `/api/v1/tenants/${tenant}/worker`,
       method: "GET",
+      query: query,
       secure: true,
       format: "json",
       ...params,
@@ -1156,4 +2916,21 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: "json",
       ...params,
     });
+  /**
+   * @description Lists the webhooks of the Github repositories which are linked to workflows of a tenant, along with the status of their last reconciliation and their recent failed deliveries.
+   *
+   * @tags Github
+   * @name GithubWebhookList
+   * @summary List Github webhooks
+   * @request GET:/api/v1/tenants/{tenant}/github-webhooks
+   * @secure
+   */
+  githubWebhookList = (tenant: string, params: RequestParams = {}) =>
+    this.request<ListGithubWebhooks, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/github-webhooks`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
 }
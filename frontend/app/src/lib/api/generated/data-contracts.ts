@@ -33,13 +33,18 @@ export interface APIMetaIntegration {
   enabled: boolean;
 }
 
+/** The public keys which workflow run attestations of the instance are signed with, as a JWK set. */
+export interface APIMetaAttestationKeys {
+  keys: object[];
+}
+
 export interface APIErrors {
   errors: APIError[];
 }
 
 export interface APIError {
   /**
-   * a custom Hatchet error code
+   * a machine-readable Hatchet error code. Generic codes are 1000 plus the HTTP status code, while codes for specific conditions start at 2000
    * @format uint64
    * @example 1400
    */
@@ -59,6 +64,11 @@ export interface APIError {
    * @example "github.com/hatchet-dev/hatchet"
    */
   docs_link?: string;
+  /**
+   * the id of the request which caused this error, for matching against server logs
+   * @example "bb214807-246e-43a5-a25d-41761d1cff9e"
+   */
+  correlation_id?: string;
 }
 
 /** @example {"next_page":3,"num_pages":10,"current_page":2} */
@@ -162,6 +172,16 @@ export interface Tenant {
   name: string;
   /** The slug of the tenant. */
   slug: string;
+  /** JSONPath expressions for the values which are redacted from step run inputs and outputs. */
+  redactionRules?: string[];
+  /** The strategy for assigning step runs to workers, which is used for workflows which don't set one. */
+  assignmentStrategy?: WorkerAssignmentStrategy;
+  /** Whether the tenant is paused, in which case no workflow runs of the tenant are started. */
+  paused?: boolean;
+  /** What happens to triggers of the workflows of the tenant while the tenant is paused. */
+  pausedTriggerBehavior?: PausedTriggerBehavior;
+  /** The health of the dependencies of the tenant, which the expression preflight checks of steps are evaluated against. */
+  dependencyHealth?: object;
 }
 
 export interface TenantMember {
@@ -185,6 +205,69 @@ export enum TenantMemberRole {
   MEMBER = "MEMBER",
 }
 
+export enum WorkerAssignmentStrategy {
+  RANDOM = "RANDOM",
+  LEAST_LOADED = "LEAST_LOADED",
+  ROUND_ROBIN = "ROUND_ROBIN",
+  LOCALITY = "LOCALITY",
+}
+
+/** What happens to triggers of a paused workflow or tenant. `REJECT` rejects the triggers, and `BUFFER` creates the workflow runs but only starts them once the workflow or tenant is resumed. */
+export enum PausedTriggerBehavior {
+  REJECT = "REJECT",
+  BUFFER = "BUFFER",
+}
+
+export interface PauseRequest {
+  /** What happens to triggers while paused. Defaults to `REJECT`. */
+  triggerBehavior?: PausedTriggerBehavior;
+  /** Whether to cancel the step runs which are queued or running when pausing. */
+  cancelRuns?: boolean;
+}
+
+export interface TenantEngineSettings {
+  /**
+   * The timeout of step runs whose step doesn't set one.
+   * @example "300s"
+   */
+  defaultStepTimeout: string;
+  /**
+   * How long a step run waits to be assigned to a worker if its step doesn't set a schedule timeout.
+   * @example "5m"
+   */
+  scheduleTimeout: string;
+  /** The number of times step runs are retried if their step doesn't set retries. */
+  defaultStepRetries: number;
+  /** The maximum number of workflow runs which a single event triggers, or 0 for no limit. */
+  maxFanOut: number;
+  /** The settings which the tenant overrides. Settings which aren't overridden use the defaults of the engine. */
+  overrides: UpdateTenantEngineSettingsRequest;
+}
+
+export interface UpdateTenantEngineSettingsRequest {
+  /**
+   * The timeout of step runs whose step doesn't set one.
+   * @example "300s"
+   */
+  defaultStepTimeout?: string;
+  /**
+   * How long a step run waits to be assigned to a worker if its step doesn't set a schedule timeout.
+   * @example "5m"
+   */
+  scheduleTimeout?: string;
+  /**
+   * The number of times step runs are retried if their step doesn't set retries.
+   * @min 0
+   * @max 100
+   */
+  defaultStepRetries?: number;
+  /**
+   * The maximum number of workflow runs which a single event triggers, or 0 for no limit.
+   * @min 0
+   */
+  maxFanOut?: number;
+}
+
 export interface CreateTenantInviteRequest {
   /** The email of the user to invite. */
   email: string;
@@ -242,6 +325,25 @@ export interface TenantList {
   rows?: Tenant[];
 }
 
+export interface UpdateTenantRequest {
+  /** The name of the tenant. */
+  name?: string;
+  /**
+   * JSONPath expressions for the values which are redacted from step run inputs and outputs, which replace the existing
+   * rules. Expressions start with `$`, and support child names (`.token` or `['token']`), array indexes (`[0]`), wildcards
+   * (`.*` or `[*]`) and recursive descent (`..token`).
+   * @maxItems 100
+   */
+  redactionRules?: string[];
+  /** The strategy for assigning step runs to workers, which is used for workflows which don't set one. */
+  assignmentStrategy?: WorkerAssignmentStrategy;
+  /**
+   * The health of the dependencies of the tenant, which replaces the existing health. Expression preflight checks of
+   * steps are evaluated against it as `health`, for example `health.db.status == "up"`.
+   */
+  dependencyHealth?: object;
+}
+
 export interface CreateTenantRequest {
   /** The name of the tenant. */
   name: string;
@@ -257,6 +359,10 @@ export interface Event {
   tenant?: Tenant;
   /** The ID of the tenant associated with this event. */
   tenantId: string;
+  /** The environment of the event. Workflow runs which are triggered by the event belong to it. */
+  environment?: string;
+  /** The namespace of the event, which prefixes its key. It is not set for events in the default namespace. */
+  namespace?: string;
   /** The workflow run summary for this event. */
   workflowRunSummary?: EventWorkflowRunSummary;
 }
@@ -324,6 +430,8 @@ export interface Workflow {
   metadata: APIResourceMeta;
   /** The name of the workflow. */
   name: string;
+  /** The namespace of the workflow, which prefixes its name. It is not set for workflows in the default namespace. */
+  namespace?: string;
   /** The description of the workflow. */
   description?: string;
   versions?: WorkflowVersionMeta[];
@@ -333,6 +441,17 @@ export interface Workflow {
   /** The jobs of the workflow. */
   jobs?: Job[];
   deployment?: WorkflowDeploymentConfig;
+  /** Whether the workflow is paused, in which case none of its runs are started. */
+  paused?: boolean;
+  /** What happens to triggers of the workflow while it is paused. */
+  pausedTriggerBehavior?: PausedTriggerBehavior;
+  /**
+   * The end of the maintenance window which is in progress, until which runs of the workflow are buffered.
+   * @format date-time
+   */
+  maintenanceUntil?: string;
+  /** The percentage of the runs of the workflow which are sampled to capture extended diagnostics. */
+  diagnosticsSampleRate?: number;
 }
 
 export interface WorkflowConcurrency {
@@ -343,8 +462,10 @@ export interface WorkflowConcurrency {
   maxRuns: number;
   /** The strategy to use when the concurrency limit is reached. */
   limitStrategy: "CANCEL_IN_PROGRESS" | "DROP_NEWEST" | "QUEUE_NEWEST" | "GROUP_ROUND_ROBIN";
-  /** An action which gets the concurrency group for the WorkflowRun. */
+  /** An action which gets the concurrency group for the WorkflowRun. This is empty if the group key is computed from key expressions. */
   getConcurrencyGroup: string;
+  /** The expressions which the concurrency group key of the WorkflowRun is computed from, instead of an action. */
+  keyExpressions?: string[];
 }
 
 export interface WorkflowDeploymentConfig {
@@ -362,6 +483,12 @@ export interface WorkflowDeploymentConfig {
    * @format uuid
    */
   githubAppInstallationId: string;
+  /**
+   * Whether the workflow provisions or tears down the preview environments of pull requests on the linked
+   * repository. Provision workflows are triggered when a pull request is opened, reopened or updated, and teardown
+   * workflows when it's closed.
+   */
+  previewEnvironmentHook?: PreviewEnvironmentHook;
 }
 
 export interface WorkflowVersionMeta {
@@ -385,6 +512,25 @@ export interface WorkflowVersion {
   concurrency?: WorkflowConcurrency;
   triggers?: WorkflowTriggers;
   jobs?: Job[];
+  /**
+   * The checksum of the workflow version's definition. It only changes when the definition changes, so it can be
+   * used to detect drift from a declared definition.
+   */
+  checksum?: string;
+  /** The expected maximum duration of a workflow run, after which the run breaches its SLA. */
+  sla?: string;
+  /**
+   * The maximum number of step runs a run may queue, including retries, after which the run fails.
+   * @format int32
+   */
+  maxStepExecutions?: number;
+  /**
+   * The maximum number of retries of the step runs of a run, after which the run fails.
+   * @format int32
+   */
+  maxStepRetries?: number;
+  /** The cost center which the compute of the runs of the workflow version is attributed to. */
+  costCenter?: string;
 }
 
 export interface WorkflowVersionDefinition {
@@ -446,6 +592,10 @@ export interface Step {
   timeout?: string;
   children?: string[];
   parents?: string[];
+  /** How many parents must succeed before the step runs, one of ALL, ANY or QUORUM. */
+  joinStrategy?: string;
+  /** The number of parents which must succeed before the step runs, for the QUORUM join strategy. */
+  joinQuorum?: number;
 }
 
 export interface WorkflowRun {
@@ -463,6 +613,23 @@ export interface WorkflowRun {
   startedAt?: string;
   /** @format date-time */
   finishedAt?: string;
+  /** Whether the run is a debug run, such as a replay. Debug runs are excluded from workflow run metrics. */
+  debug?: boolean;
+  /**
+   * The id of the run which this run replays.
+   * @format uuid
+   */
+  replayOfId?: string;
+  /** The metadata which was set when the run was triggered, and is passed to every step run. */
+  additionalMetadata?: Record<string, any>;
+  /** The number of step runs of a workflow run by status. */
+  progress?: WorkflowRunProgress;
+  /** The source of the cancellation which caused the run to fail, if the run failed because it was cancelled. */
+  cancelledSource?: CancellationSource;
+  /** The environment of the run. The step runs of the run are only assigned to workers of the environment. */
+  environment?: string;
+  /** The build id which the run is pinned to. The step runs of the run are only assigned to workers of the build. */
+  buildId?: string;
 }
 
 export interface WorkflowRunList {
@@ -478,6 +645,364 @@ export enum WorkflowRunStatus {
   CANCELLED = "CANCELLED",
 }
 
+/** The number of step runs of a workflow run by status. */
+export interface WorkflowRunProgress {
+  /** The number of step runs of the workflow run. */
+  total: number;
+  /** The number of step runs which are assigned or running. */
+  running: number;
+  succeeded: number;
+  failed: number;
+  cancelled: number;
+}
+
+export interface WorkflowRunDag {
+  /** @format uuid */
+  workflowRunId: string;
+  status: WorkflowRunStatus;
+  nodes: WorkflowRunDagNode[];
+  edges: WorkflowRunDagEdge[];
+}
+
+export interface WorkflowRunDagNode {
+  /**
+   * The id of the step run.
+   * @format uuid
+   */
+  id: string;
+  /** @format uuid */
+  stepId: string;
+  /** The readable id of the step, which is unique within the job. */
+  readableId: string;
+  action: string;
+  /** @format uuid */
+  jobRunId: string;
+  jobName: string;
+  /** The number of steps on the longest path from a root of the DAG to this step. Roots have a depth of 0. */
+  depth: number;
+  status: StepRunStatus;
+  retryCount: number;
+  /** @format date-time */
+  startedAt?: string;
+  /** @format date-time */
+  finishedAt?: string;
+  /** The time from the start to the finish of the step run. Not set if the step run has not finished. */
+  durationMs?: number;
+}
+
+export interface WorkflowRunDagEdge {
+  /**
+   * The id of the parent step run.
+   * @format uuid
+   */
+  source: string;
+  /**
+   * The id of the child step run.
+   * @format uuid
+   */
+  target: string;
+}
+
+export interface WorkflowRunRootCause {
+  /** @format uuid */
+  workflowRunId: string;
+  status: WorkflowRunStatus;
+  /** The step run which failed or timed out first. */
+  stepRun: StepRun;
+  /** The error of the step run. If the step run timed out, this is the reason it was cancelled. */
+  error: string;
+  /**
+   * The time at which the step run failed or was cancelled.
+   * @format date-time
+   */
+  failedAt: string;
+  /** The previous attempts of the step run, oldest first. */
+  retries: WorkflowRunRootCauseRetry[];
+  /** The worker which the step run was last assigned to. Not set if the step run was never assigned, or the worker was deleted. */
+  worker?: Worker;
+  /** The timeouts and cancellations of other step runs in the workflow run, up to the time the step run failed, oldest first. */
+  precedingEvents: WorkflowRunRootCauseEvent[];
+}
+
+export interface WorkflowRunRootCauseRetry {
+  /** @format date-time */
+  startedAt?: string;
+  /** @format date-time */
+  finishedAt?: string;
+  /** @format date-time */
+  cancelledAt?: string;
+  cancelledReason?: string;
+  error?: string;
+}
+
+export interface WorkflowRunRootCauseEvent {
+  /** @format uuid */
+  stepRunId: string;
+  /** The readable id of the step of the step run. */
+  readableId: string;
+  /** The reason the step run was cancelled, for example TIMED_OUT or CANCELLED_BY_CONCURRENCY_LIMIT. */
+  reason: string;
+  error?: string;
+  /** @format date-time */
+  occurredAt: string;
+}
+
+/**
+ * A portable export of a finished workflow run, which can be imported into another tenant or Hatchet instance. Step runs are
+ * identified by the name of their job and the readable id of their step, since ids are not portable.
+ */
+export interface WorkflowRunBundle {
+  /** The version of the bundle format. */
+  bundleVersion: string;
+  /** @format date-time */
+  exportedAt: string;
+  /** The definition of the workflow version of the run, as YAML. */
+  rawDefinition: string;
+  displayName?: string;
+  status: WorkflowRunStatus;
+  error?: string;
+  /** @format date-time */
+  startedAt?: string;
+  /** @format date-time */
+  finishedAt?: string;
+  input: object;
+  /** The event which triggered the run. Not set if the run was not triggered by an event. */
+  event?: WorkflowRunBundleEvent;
+  stepRuns: WorkflowRunBundleStepRun[];
+}
+
+export interface WorkflowRunBundleEvent {
+  key: string;
+  data?: object;
+}
+
+export interface WorkflowRunBundleStepRun {
+  jobName: string;
+  stepReadableId: string;
+  status: StepRunStatus;
+  input?: object;
+  output?: object;
+  error?: string;
+  /** @format date-time */
+  startedAt?: string;
+  /** @format date-time */
+  finishedAt?: string;
+  /** @format date-time */
+  cancelledAt?: string;
+  cancelledReason?: string;
+  retryCount: number;
+}
+
+/**
+ * A signed attestation of a finished workflow run. The attestation is a JWT which is signed with the keys of the instance, and
+ * can be verified offline with the keys from /api/v1/meta/attestation-keys.
+ */
+export interface WorkflowRunAttestation {
+  /** The signed attestation, as a compact JWT. */
+  attestation: string;
+  /**
+   * The statement which an attestation signs. Inputs and outputs are attested by the hex-encoded SHA-256 of their canonical JSON
+   * encoding, which has sorted object keys and no whitespace.
+   */
+  statement: WorkflowRunAttestationStatement;
+}
+
+/**
+ * The statement which an attestation signs. Inputs and outputs are attested by the hex-encoded SHA-256 of their canonical JSON
+ * encoding, which has sorted object keys and no whitespace.
+ */
+export interface WorkflowRunAttestationStatement {
+  /** The version of the attestation format. */
+  version: string;
+  tenantId: string;
+  workflowRunId: string;
+  workflowName: string;
+  workflowVersionId: string;
+  workflowVersion?: string;
+  status: WorkflowRunStatus;
+  /** @format date-time */
+  createdAt: string;
+  /** @format date-time */
+  startedAt?: string;
+  /** @format date-time */
+  finishedAt?: string;
+  inputSha256: string;
+  stepRuns: WorkflowRunAttestationStepRun[];
+}
+
+export interface WorkflowRunAttestationStepRun {
+  stepRunId: string;
+  jobName: string;
+  stepReadableId?: string;
+  status: StepRunStatus;
+  retryCount: number;
+  /** @format date-time */
+  startedAt?: string;
+  /** @format date-time */
+  finishedAt?: string;
+  inputSha256?: string;
+  outputSha256?: string;
+  /** The worker which ran the step run. Not set if the step run was never assigned to a worker. */
+  worker?: WorkflowRunAttestationWorker;
+}
+
+export interface WorkflowRunAttestationWorker {
+  workerId: string;
+  name: string;
+  buildId?: string;
+}
+
+/** A relation which is hydrated on a workflow run. */
+export enum WorkflowRunInclude {
+  StepRuns = "stepRuns",
+  Events = "events",
+  Logs = "logs",
+}
+
+export enum WorkflowRunExportFormat {
+  Ndjson = "ndjson",
+  Csv = "csv",
+}
+
+/** A single workflow run in an export. Each NDJSON line and CSV record contains one row. */
+export interface WorkflowRunExportRow {
+  /** An opaque cursor which can be passed to the export endpoint to resume the export after this row. */
+  cursor: string;
+  id: string;
+  workflowId: string;
+  workflowName: string;
+  workflowVersionId: string;
+  status: WorkflowRunStatus;
+  displayName?: string;
+  concurrencyGroupId?: string;
+  error?: string;
+  /** @format date-time */
+  createdAt: string;
+  /** @format date-time */
+  startedAt?: string;
+  /** @format date-time */
+  finishedAt?: string;
+}
+
+export enum WorkflowRunMetricsBucket {
+  HOUR = "HOUR",
+  DAY = "DAY",
+}
+
+export interface WorkflowRunMetricsRow {
+  /** @format uuid */
+  workflowId: string;
+  workflowName: string;
+  /**
+   * The start of the time bucket.
+   * @format date-time
+   */
+  bucket: string;
+  /** @format int64 */
+  succeeded: number;
+  /** @format int64 */
+  failed: number;
+  /**
+   * The share of the finished runs which failed, between 0 and 1.
+   * @format double
+   */
+  failureRate: number;
+  /**
+   * The mean duration of the finished runs, in seconds.
+   * @format double
+   */
+  meanDurationSeconds: number;
+}
+
+export interface WorkflowRunMetrics {
+  /** @format date-time */
+  since: string;
+  /** @format date-time */
+  until: string;
+  bucket: WorkflowRunMetricsBucket;
+  rows: WorkflowRunMetricsRow[];
+}
+
+/** A filter for the failed workflow runs to retry. Only runs which have already failed when the bulk retry is created are retried. */
+export interface WorkflowRunBulkRetryRequest {
+  /**
+   * Only retry runs of this workflow.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowId?: string;
+  /**
+   * Only retry runs created at or after this time.
+   * @format date-time
+   */
+  createdAfter?: string;
+  /**
+   * Only retry runs created before this time.
+   * @format date-time
+   */
+  createdBefore?: string;
+  /**
+   * Only retry runs where the workflow run error or a failed step run error contains this substring.
+   * @minLength 1
+   * @maxLength 255
+   */
+  errorContains?: string;
+}
+
+export enum WorkflowRunBulkRetryStatus {
+  PENDING = "PENDING",
+  RUNNING = "RUNNING",
+  SUCCEEDED = "SUCCEEDED",
+  FAILED = "FAILED",
+}
+
+export interface WorkflowRunBulkRetry {
+  metadata: APIResourceMeta;
+  tenantId: string;
+  status: WorkflowRunBulkRetryStatus;
+  /** A filter for the failed workflow runs to retry. Only runs which have already failed when the bulk retry is created are retried. */
+  filter: WorkflowRunBulkRetryRequest;
+  /** The number of failed runs which matched the filter so far. */
+  matchedCount: number;
+  /** The number of matched runs which were re-queued. */
+  retriedCount: number;
+  /** The number of matched runs which could not be re-queued. */
+  failedCount: number;
+  error?: string;
+  /** @format date-time */
+  startedAt?: string;
+  /** @format date-time */
+  finishedAt?: string;
+}
+
+export interface WorkflowRunSLABreach {
+  metadata: APIResourceMeta;
+  /** @format uuid */
+  workflowId: string;
+  /** @format uuid */
+  workflowVersionId: string;
+  /** @format uuid */
+  workflowRunId: string;
+  workflowRunStatus: WorkflowRunStatus;
+  /** @format date-time */
+  workflowRunCreatedAt: string;
+  /** @format date-time */
+  workflowRunFinishedAt?: string;
+  /** The SLA of the workflow version when the run breached it. */
+  sla: string;
+  /**
+   * When the breach was detected.
+   * @format date-time
+   */
+  breachedAt: string;
+}
+
+export interface WorkflowRunSLABreachList {
+  rows?: WorkflowRunSLABreach[];
+  pagination?: PaginationResponse;
+}
+
 export type WorkflowRunStatusList = WorkflowRunStatus[];
 
 export enum JobRunStatus {
@@ -491,6 +1016,7 @@ export enum JobRunStatus {
 export enum StepRunStatus {
   PENDING = "PENDING",
   PENDING_ASSIGNMENT = "PENDING_ASSIGNMENT",
+  WAITING_ON_DEPENDENCY = "WAITING_ON_DEPENDENCY",
   ASSIGNED = "ASSIGNED",
   RUNNING = "RUNNING",
   SUCCEEDED = "SUCCEEDED",
@@ -498,6 +1024,26 @@ export enum StepRunStatus {
   CANCELLED = "CANCELLED",
 }
 
+/** Why a step run in a pending state hasn't progressed. */
+export enum StepRunSubStatus {
+  WAITING_FOR_WORKER = "WAITING_FOR_WORKER",
+}
+
+/**
+ * The source of a cancellation. CONCURRENCY is set when a run is superseded by a newer run because of a concurrency
+ * limit, PARENT_CANCELLED is set when a step run is cancelled because a previous step run was cancelled,
+ * JOIN_SATISFIED is set when a step run is cancelled because the join step after it started without it, and
+ * BUDGET_EXCEEDED is set when a run exceeds the step execution or retry budget of its workflow.
+ */
+export enum CancellationSource {
+  CONCURRENCY = "CONCURRENCY",
+  USER = "USER",
+  TIMEOUT = "TIMEOUT",
+  PARENT_CANCELLED = "PARENT_CANCELLED",
+  JOIN_SATISFIED = "JOIN_SATISFIED",
+  BUDGET_EXCEEDED = "BUDGET_EXCEEDED",
+}
+
 export interface JobRun {
   metadata: APIResourceMeta;
   tenantId: string;
@@ -542,7 +1088,11 @@ export interface StepRun {
   workerId?: string;
   input?: string;
   output?: string;
+  /** The logs of the step run. Only returned when logs are included. */
+  logs?: LogLine[];
   status: StepRunStatus;
+  /** Why a step run in a pending state hasn't progressed. */
+  subStatus?: StepRunSubStatus;
   /** @format date-time */
   requeueAfter?: string;
   result?: object;
@@ -560,27 +1110,232 @@ export interface StepRun {
   cancelledAt?: string;
   cancelledAtEpoch?: number;
   cancelledReason?: string;
+  cancelledSource?: CancellationSource;
   cancelledError?: string;
+  /** Whether the failure or cancellation of the step run is tolerated, because it was on a branch of a join step which could still run. */
+  toleratedByJoin?: boolean;
+  /** The timeline of the latest attempt of a step run. Phases which have not happened yet are not set. */
+  timeline?: StepRunTimeline;
 }
 
-export interface WorkerList {
+export interface StepRunList {
+  rows?: StepRun[];
   pagination?: PaginationResponse;
-  rows?: Worker[];
 }
 
-export interface Worker {
+/** A previous attempt of a step run, which was archived when the step run was retried or rerun. */
+export interface StepRunAttempt {
   metadata: APIResourceMeta;
-  /** The name of the worker. */
-  name: string;
-  /**
-   * The time this worker last sent a heartbeat.
-   * @format date-time
-   * @example "2022-12-13T20:06:48.888Z"
-   */
-  lastHeartbeatAt?: string;
-  /** The actions this worker can perform. */
-  actions?: string[];
-  /** The recent step runs for this worker. */
+  stepRunId: string;
+  /** The retry count of the step run when the attempt ran. The first attempt has a retry count of 0. */
+  retryCount: number;
+  /** The worker which ran the attempt. */
+  workerId?: string;
+  input?: string;
+  output?: string;
+  error?: string;
+  /** @format date-time */
+  startedAt?: string;
+  /** @format date-time */
+  finishedAt?: string;
+  /** @format date-time */
+  timeoutAt?: string;
+  /** @format date-time */
+  cancelledAt?: string;
+  cancelledReason?: string;
+  cancelledError?: string;
+}
+
+export interface StepRunAttemptList {
+  rows?: StepRunAttempt[];
+}
+
+/** A snapshot of an attempt of a step run, which was captured when the attempt succeeded or failed because its workflow run was sampled for diagnostics. */
+export interface StepRunDiagnostic {
+  metadata: APIResourceMeta;
+  stepRunId: string;
+  /** The retry count of the step run when the attempt ran. The first attempt has a retry count of 0. */
+  retryCount: number;
+  /** The worker which ran the attempt. */
+  workerId?: string;
+  input?: string;
+  output?: string;
+  error?: string;
+  /** @format date-time */
+  startedAt?: string;
+  /** @format date-time */
+  finishedAt?: string;
+  /** The timeline of the latest attempt of a step run. Phases which have not happened yet are not set. */
+  timeline?: StepRunTimeline;
+  /** The W3C traceparent of the span which captured the attempt. */
+  traceParent?: string;
+  /** The W3C traceparent of the span which created the workflow run. Not set if tracing was disabled when the run was created. */
+  runTraceParent?: string;
+}
+
+export interface StepRunDiagnosticList {
+  rows?: StepRunDiagnostic[];
+}
+
+/** A chunk of output which a step run streamed before it finished. */
+export interface StepRunStreamEvent {
+  /** The index of the stream event in the output stream of the step run. Indexes continue across the retries of a step run. */
+  index: number;
+  /** The retry count of the step run when the stream event was sent. */
+  retryCount: number;
+  /** @format date-time */
+  createdAt: string;
+  message: string;
+}
+
+export interface StepRunStreamEventList {
+  rows?: StepRunStreamEvent[];
+}
+
+/** The timeline of the latest attempt of a step run. Phases which have not happened yet are not set. */
+export interface StepRunTimeline {
+  /** @format date-time */
+  queuedAt?: string;
+  /**
+   * When the step run first found no worker with a free slot. Not set if a worker was available right away.
+   * @format date-time
+   */
+  slotWaitStartedAt?: string;
+  /** @format date-time */
+  assignedAt?: string;
+  /** @format date-time */
+  resultPersistedAt?: string;
+  /** The time from queueing the step run to the first assignment attempt which found no free slot, or to the assignment. */
+  queueMs?: number;
+  /** The time spent waiting for a worker with a free slot. */
+  slotWaitMs?: number;
+  /** The time from the assignment to the worker starting the step run. */
+  dispatchMs?: number;
+  /** The time the worker spent running the step. */
+  executionMs?: number;
+  /** The time from the worker finishing the step run to its result being written. */
+  persistenceMs?: number;
+}
+
+export interface StepRunPhaseLatency {
+  /**
+   * The median latency in milliseconds.
+   * @format double
+   */
+  p50: number;
+  /**
+   * The 95th percentile latency in milliseconds.
+   * @format double
+   */
+  p95: number;
+}
+
+export interface StepRunActionMetrics {
+  actionId: string;
+  /**
+   * The number of step runs which the latencies are computed from.
+   * @format int64
+   */
+  count: number;
+  queue: StepRunPhaseLatency;
+  slotWait: StepRunPhaseLatency;
+  dispatch: StepRunPhaseLatency;
+  execution: StepRunPhaseLatency;
+  persistence: StepRunPhaseLatency;
+}
+
+export interface StepRunNoCapacityMetrics {
+  actionId: string;
+  /**
+   * The number of step runs of the action which are waiting for a worker with a free slot.
+   * @format int64
+   */
+  count: number;
+  /**
+   * When the step run which has waited the longest started waiting.
+   * @format date-time
+   */
+  waitingSince: string;
+}
+
+export interface StepRunMetrics {
+  /** @format date-time */
+  since: string;
+  rows: StepRunActionMetrics[];
+  /** The actions which have step runs waiting for a worker with a free slot, which are not included in the latencies until they are assigned. */
+  noCapacity: StepRunNoCapacityMetrics[];
+}
+
+export interface GetGroupKeyRun {
+  metadata: APIResourceMeta;
+  tenantId: string;
+  workflowRunId: string;
+  workerId?: string;
+  status: StepRunStatus;
+  input?: string;
+  /** The computed group key. */
+  output?: string;
+  error?: string;
+  /** @format date-time */
+  requeueAfter?: string;
+  /** @format date-time */
+  scheduleTimeoutAt?: string;
+  /** @format date-time */
+  startedAt?: string;
+  /** @format date-time */
+  finishedAt?: string;
+  /** @format date-time */
+  timeoutAt?: string;
+  /** @format date-time */
+  cancelledAt?: string;
+  cancelledReason?: string;
+  cancelledError?: string;
+}
+
+export interface ScheduledWorkflowRun {
+  metadata: APIResourceMeta;
+  workflowId: string;
+  workflowName: string;
+  workflowVersionId: string;
+  /**
+   * The time at which the workflow will be triggered.
+   * @format date-time
+   */
+  triggerAt: string;
+  input?: Record<string, any>;
+  /** The id of the workflow run which was created by this schedule, if it has been triggered. */
+  workflowRunId?: string;
+}
+
+export interface ScheduledWorkflowRunList {
+  rows?: ScheduledWorkflowRun[];
+  pagination?: PaginationResponse;
+}
+
+export interface WorkerList {
+  pagination?: PaginationResponse;
+  rows?: Worker[];
+}
+
+export interface Worker {
+  metadata: APIResourceMeta;
+  /** The name of the worker. */
+  name: string;
+  /**
+   * The time this worker last sent a heartbeat.
+   * @format date-time
+   * @example "2022-12-13T20:06:48.888Z"
+   */
+  lastHeartbeatAt?: string;
+  /** The environment of the worker, which is set by the API token the worker registered with. */
+  environment?: string;
+  /** The build id of the code of the worker, such as a commit sha. */
+  buildId?: string;
+  /** The labels of the worker, such as its region or host. */
+  labels?: Record<string, string>;
+  /** The actions this worker can perform. */
+  actions?: string[];
+  /** The recent step runs for this worker. */
   recentStepRuns?: StepRun[];
 }
 
@@ -596,6 +1351,21 @@ export interface APIToken {
    * @format date-time
    */
   expiresAt: string;
+  /** The scopes of the API token. A token without scopes can be used for everything except managing API tokens. */
+  scopes?: APITokenScope[];
+  /** Whether the API token has been revoked. */
+  revoked?: boolean;
+  /** The environment of the API token. Requests with the token are scoped to the environment. */
+  environment?: string;
+  /** The namespace of the API token. Requests with the token are scoped to the namespace. */
+  namespace?: string;
+}
+
+export enum APITokenScope {
+  Read = "read",
+  Write = "write",
+  Worker = "worker",
+  Tokens = "tokens",
 }
 
 export interface CreateAPITokenRequest {
@@ -604,6 +1374,27 @@ export interface CreateAPITokenRequest {
    * @maxLength 255
    */
   name: string;
+  /** How long the API token is valid for, as a duration such as 720h. Defaults to 90 days. */
+  expiresIn?: string;
+  /**
+   * The scopes of the API token. read allows read-only REST requests, write allows all REST requests, worker allows
+   * requests to the gRPC API, and tokens allows managing API tokens. A token without scopes can be used for everything
+   * except managing API tokens.
+   */
+  scopes?: APITokenScope[];
+  /**
+   * The environment of the API token, such as staging. Workers which register with the token only receive the runs
+   * of the environment, the runs and events which are created with the token belong to it, and list requests with
+   * the token only return the resources of the environment.
+   * @maxLength 255
+   */
+  environment?: string;
+  /**
+   * The namespace of the API token, such as payments. Workflow names and event keys without a namespace are
+   * resolved to the namespace, and the token can only access the workflows, runs and events of the namespace.
+   * @maxLength 255
+   */
+  namespace?: string;
 }
 
 export interface CreateAPITokenResponse {
@@ -622,6 +1413,49 @@ export interface RerunStepRunRequest {
 
 export interface TriggerWorkflowRunRequest {
   input: object;
+  /** If true, the trigger is not rejected when the engine is shedding load. */
+  priority?: boolean;
+  /** Metadata, such as a user id or a trace id, which is passed to every step run and the get group key run. */
+  additionalMetadata?: Record<string, any>;
+  /**
+   * The environment of the run. It is ignored for requests with an environment API token, whose runs always belong
+   * to the environment of the token.
+   * @maxLength 255
+   */
+  environment?: string;
+}
+
+/** A hint that the queue of the tenant is backed up, so triggers should be slowed down. */
+export interface BackpressureHint {
+  /**
+   * The number of workflow runs and step runs of the tenant which are waiting to be processed.
+   * @format int64
+   */
+  queueDepth: number;
+  /**
+   * The suggested number of seconds to wait before the next trigger.
+   * @format int64
+   */
+  retryAfterSeconds: number;
+  /** Whether triggers which are not a priority are currently rejected. */
+  shedding: boolean;
+}
+
+export interface TriggerWorkflowRunRejected {
+  errors: APIError[];
+  /** A hint that the queue of the tenant is backed up, so triggers should be slowed down. */
+  backpressure: BackpressureHint;
+}
+
+export interface ReplayWorkflowRunRequest {
+  /** The input of the replay. If omitted, the input of the replayed run is used. */
+  input?: object;
+  /**
+   * The step to start the replay from. The step runs which succeeded in the replayed run are copied to the replay,
+   * along with their outputs, except for this step and the steps after it. If omitted, every step is run.
+   * @format uuid
+   */
+  startFromStepId?: string;
 }
 
 export interface LinkGithubRepositoryRequest {
@@ -637,6 +1471,150 @@ export interface LinkGithubRepositoryRequest {
   gitRepoOwner: string;
   /** The repository branch. */
   gitRepoBranch: string;
+  /**
+   * Whether the workflow provisions or tears down the preview environments of pull requests on the linked
+   * repository. Provision workflows are triggered when a pull request is opened, reopened or updated, and teardown
+   * workflows when it's closed.
+   */
+  previewEnvironmentHook?: PreviewEnvironmentHook;
+}
+
+/**
+ * Whether the workflow provisions or tears down the preview environments of pull requests on the linked
+ * repository. Provision workflows are triggered when a pull request is opened, reopened or updated, and teardown
+ * workflows when it's closed.
+ */
+export enum PreviewEnvironmentHook {
+  PROVISION = "PROVISION",
+  TEARDOWN = "TEARDOWN",
+}
+
+export interface WorkflowRollout {
+  metadata: APIResourceMeta;
+  /**
+   * The unique identifier for the workflow that the rollout policy belongs to.
+   * @format uuid
+   */
+  workflowId: string;
+  /** The percentage of triggers which run the new version while it is rolled out. */
+  percentage: number;
+  /**
+   * The failure rate of the new version, between 0 and 1, above which the rollout is rolled back.
+   * @format double
+   */
+  failureRateThreshold: number;
+  /** The number of seconds over which the failure rate of the new version is computed. */
+  windowSeconds: number;
+  /** The minimum number of finished runs of the new version in the window before the failure rate is checked. */
+  minRuns: number;
+  status: WorkflowRolloutStatus;
+  /**
+   * The version which receives the triggers which don't run the new version.
+   * @format uuid
+   */
+  fromVersionId?: string;
+  /**
+   * The new version which is rolled out.
+   * @format uuid
+   */
+  toVersionId?: string;
+  /**
+   * When the new version started to be rolled out.
+   * @format date-time
+   */
+  startedAt?: string;
+  /**
+   * When the rollout was completed or rolled back.
+   * @format date-time
+   */
+  finishedAt?: string;
+  /**
+   * The failure rate of the new version when the rollout was rolled back.
+   * @format double
+   */
+  failureRate?: number;
+}
+
+export enum WorkflowRolloutStatus {
+  PENDING = "PENDING",
+  ACTIVE = "ACTIVE",
+  COMPLETED = "COMPLETED",
+  ROLLED_BACK = "ROLLED_BACK",
+}
+
+export interface UpdateWorkflowRolloutRequest {
+  /** The percentage of triggers which run the new version while it is rolled out. Setting the percentage of an active rollout to 100 completes the rollout. */
+  percentage: number;
+  /**
+   * The failure rate of the new version, between 0 and 1, above which the rollout is rolled back.
+   * @format double
+   */
+  failureRateThreshold: number;
+  /** The number of seconds over which the failure rate of the new version is computed. */
+  windowSeconds: number;
+  /** The minimum number of finished runs of the new version in the window before the failure rate is checked. */
+  minRuns: number;
+}
+
+export interface UpdateWorkflowDiagnosticsRequest {
+  /** The percentage of the new runs of the workflow which are sampled to capture extended diagnostics. A sample rate of 0 turns diagnostics off. */
+  sampleRate: number;
+}
+
+export interface CreateConcurrencySimulationRequest {
+  /** The strategy of the simulated concurrency limit. */
+  limitStrategy: "CANCEL_IN_PROGRESS" | "GROUP_ROUND_ROBIN";
+  /** The maximum number of concurrent workflow runs of the simulated concurrency limit. */
+  maxRuns: number;
+  /** An expression which computes the concurrency group key of each run from its input or additional metadata, like input.customer_id or additional_metadata.region. If it is not set, runs keep the group key which they were assigned. */
+  keyExpression?: string;
+  /** The number of hours of past runs which are simulated. */
+  hours: number;
+}
+
+export interface ConcurrencySimulation {
+  /**
+   * The time from which the runs were simulated.
+   * @format date-time
+   */
+  windowStart: string;
+  /**
+   * The time until which the runs were simulated.
+   * @format date-time
+   */
+  windowEnd: string;
+  /** Whether the workflow had more runs in the window than can be simulated, in which case only the oldest runs were simulated. */
+  truncated: boolean;
+  /** The number of runs which were simulated. */
+  runs: number;
+  /** The number of distinct concurrency group keys of the runs. */
+  groups: number;
+  /** The number of runs which would have started. */
+  started: number;
+  /** The number of runs which would have been cancelled to make room for newer runs of their group. */
+  cancelled: number;
+  /** The number of runs which would have waited for a slot, including the runs which would still be waiting. */
+  queued: number;
+  /** The number of runs which would still be waiting for a slot at the end of the window. */
+  stillQueued: number;
+  /** The number of runs whose key expression couldn't be evaluated, which would fail rather than run. */
+  keyErrors: number;
+  /** The number of runs which never ran to completion, whose duration was estimated from the median duration of the runs which did. */
+  estimatedDurations: number;
+  /** The maximum number of runs which would have run at the same time. */
+  maxRunning: number;
+  /** The maximum number of runs which would have waited for a slot at the same time. */
+  maxQueued: number;
+  /**
+   * The average number of seconds which the runs that waited for a slot would have waited before they started.
+   * @format double
+   */
+  avgQueueWaitSeconds: number;
+  /**
+   * The maximum number of seconds which a run would have waited for a slot before it started.
+   * @format double
+   */
+  maxQueueWaitSeconds: number;
 }
 
 export interface GithubBranch {
@@ -665,6 +1643,49 @@ export type ListGithubReposResponse = GithubRepo[];
 
 export type ListGithubBranchesResponse = GithubBranch[];
 
+export interface GithubWebhookDelivery {
+  /**
+   * The id of the delivery on Github.
+   * @format int64
+   */
+  id: number;
+  /** The event which was delivered. */
+  event: string;
+  /** The action of the event which was delivered. */
+  action?: string;
+  /** The status code which the webhook responded with, or 0 if the delivery did not get a response. */
+  status_code: number;
+  /** The status of the delivery, as reported by Github. */
+  status: string;
+  /** @format date-time */
+  delivered_at: string;
+}
+
+export interface GithubWebhook {
+  metadata: APIResourceMeta;
+  repo_owner: string;
+  repo_name: string;
+  /**
+   * The id of the webhook on Github. Set once the webhook was reconciled.
+   * @format int64
+   */
+  hook_id?: number;
+  /**
+   * When the webhook was last reconciled with Github.
+   * @format date-time
+   */
+  reconciled_at?: string;
+  /** The error of the last reconciliation, if it failed. */
+  reconcile_error?: string;
+  /** The recent failed deliveries of the webhook, as reported by Github at the last reconciliation. Most recent first. */
+  failed_deliveries: GithubWebhookDelivery[];
+}
+
+export interface ListGithubWebhooks {
+  pagination: PaginationResponse;
+  rows: GithubWebhook[];
+}
+
 export interface CreatePullRequestFromStepRun {
   branchName: string;
 }
@@ -758,3 +1779,737 @@ export interface CreateSNSIntegrationRequest {
   /** The Amazon Resource Name (ARN) of the SNS topic. */
   topicArn: string;
 }
+
+export interface GiteaWebhook {
+  metadata: APIResourceMeta;
+  /**
+   * The unique identifier for the tenant that the Gitea webhook belongs to.
+   * @format uuid
+   */
+  tenantId: string;
+  /** The owner of the Gitea repository. */
+  repoOwner: string;
+  /** The name of the Gitea repository. */
+  repoName: string;
+  /** The URL to configure as the target URL of the webhook in Gitea. */
+  webhookUrl: string;
+  /** The secret to configure as the secret of the webhook in Gitea. Only returned when the webhook is created. */
+  signingSecret?: string;
+}
+
+export interface ListGiteaWebhooks {
+  pagination: PaginationResponse;
+  rows: GiteaWebhook[];
+}
+
+export interface CreateGiteaWebhookRequest {
+  /** The owner of the Gitea repository. */
+  repoOwner: string;
+  /** The name of the Gitea repository. */
+  repoName: string;
+}
+
+export enum WebhookDeliverySource {
+  GITHUB = "GITHUB",
+  GITEA = "GITEA",
+}
+
+export enum WebhookDeliveryStatus {
+  RECEIVED = "RECEIVED",
+  PROCESSED = "PROCESSED",
+  FAILED = "FAILED",
+  REJECTED = "REJECTED",
+}
+
+export interface WebhookDelivery {
+  metadata: APIResourceMeta;
+  /**
+   * The unique identifier for the tenant that the delivery belongs to.
+   * @format uuid
+   */
+  tenantId: string;
+  source: WebhookDeliverySource;
+  /**
+   * The id of the webhook which received the delivery.
+   * @format uuid
+   */
+  webhookId: string;
+  /** The id of the delivery which was assigned by the source. */
+  deliveryId?: string;
+  /** The event type of the delivery. */
+  event: string;
+  /** The SHA-256 digest of the payload of the delivery, which is unique for each delivery of the webhook. */
+  nonce: string;
+  /**
+   * When the delivery was sent, read from its payload.
+   * @format date-time
+   */
+  sentAt?: string;
+  status: WebhookDeliveryStatus;
+  /** The reason the delivery failed or was rejected. */
+  error?: string;
+}
+
+export interface ListWebhookDeliveries {
+  rows: WebhookDelivery[];
+}
+
+export enum LogSinkKind {
+  HTTP = "HTTP",
+  S3 = "S3",
+  DATADOG = "DATADOG",
+}
+
+export interface LogSink {
+  metadata: APIResourceMeta;
+  /**
+   * The unique identifier for the tenant that the log sink belongs to.
+   * @format uuid
+   */
+  tenantId: string;
+  /** The name of the log sink. */
+  name: string;
+  kind: LogSinkKind;
+  /** Where records are sent, without any secrets. */
+  destination: string;
+  /** Whether records are forwarded to the log sink. */
+  enabled: boolean;
+  /** The maximum number of records which are sent in one delivery. */
+  batchSize: number;
+  /**
+   * When records were last delivered to the log sink.
+   * @format date-time
+   */
+  lastDeliveredAt?: string;
+  /** The error of the last failed delivery, which is cleared by the next successful delivery. */
+  lastError?: string;
+  /** The number of deliveries which failed in a row. */
+  failures: number;
+}
+
+export interface LogSinkHTTPConfig {
+  /** The URL which batches of records are posted to. */
+  url: string;
+  /** Headers which are sent with every request, for example for authorization. */
+  headers?: Record<string, string>;
+  /** The secret which is used to sign the request body in the X-Hatchet-Signature header. */
+  secret?: string;
+}
+
+export interface LogSinkS3Config {
+  /** The bucket which batches of records are written to. */
+  bucket: string;
+  /** The region of the bucket. */
+  region: string;
+  /** The prefix of the object keys. */
+  prefix?: string;
+  /** The access key id which is used to write to the bucket. */
+  accessKeyId: string;
+  /** The secret access key which is used to write to the bucket. */
+  secretAccessKey: string;
+  /** The endpoint of an S3-compatible service. Defaults to the AWS endpoint of the region. */
+  endpoint?: string;
+}
+
+export interface LogSinkDatadogConfig {
+  /** The API key which is used to send logs. */
+  apiKey: string;
+  /** The Datadog site. Defaults to datadoghq.com. */
+  site?: string;
+  /** The service of the logs. Defaults to hatchet. */
+  service?: string;
+}
+
+export interface CreateLogSinkRequest {
+  /** The name of the log sink. */
+  name: string;
+  kind: LogSinkKind;
+  /** The maximum number of records which are sent in one delivery. Defaults to 100. */
+  batchSize?: number;
+  http?: LogSinkHTTPConfig;
+  s3?: LogSinkS3Config;
+  datadog?: LogSinkDatadogConfig;
+}
+
+export interface ListLogSinks {
+  pagination: PaginationResponse;
+  rows: LogSink[];
+}
+
+export enum ObjectStorageFeedKind {
+  SQS = "SQS",
+  PUBSUB = "PUBSUB",
+}
+
+export interface ObjectStorageFeed {
+  metadata: APIResourceMeta;
+  /**
+   * The unique identifier for the tenant that the feed belongs to.
+   * @format uuid
+   */
+  tenantId: string;
+  /** The name of the feed. */
+  name: string;
+  kind: ObjectStorageFeedKind;
+  /** The queue or subscription which notifications are received from. */
+  source: string;
+  /** The key of the events which are created for created objects. */
+  eventKey: string;
+  /** If set, only objects whose key starts with the prefix create events. */
+  keyPrefix?: string;
+  /** Whether the feed is polled. */
+  enabled: boolean;
+  /**
+   * When notifications were last received from the feed.
+   * @format date-time
+   */
+  lastReceivedAt?: string;
+  /** The error of the last failed poll, which is cleared by the next successful poll. */
+  lastError?: string;
+  /** The number of polls which failed in a row. */
+  failures: number;
+}
+
+export interface ObjectStorageFeedSQSConfig {
+  /** The url of the SQS queue which S3 event notifications are sent to. */
+  queueUrl: string;
+  /** The region of the queue. */
+  region: string;
+  /** The access key id which is used to receive and delete messages. */
+  accessKeyId: string;
+  /** The secret access key which is used to receive and delete messages. */
+  secretAccessKey: string;
+  /** The endpoint of an SQS-compatible service. Defaults to the host of the queue url. */
+  endpoint?: string;
+}
+
+export interface ObjectStorageFeedPubSubConfig {
+  /** The Pub/Sub subscription which GCS notifications are pulled from, like projects/my-project/subscriptions/my-subscription. */
+  subscription: string;
+  /** The JSON key of the service account which is used to pull and acknowledge messages. Only optional if an endpoint is set. */
+  credentialsJson?: string;
+  /** The endpoint of a Pub/Sub-compatible service like the Pub/Sub emulator. Defaults to the Google endpoint. */
+  endpoint?: string;
+}
+
+export interface CreateObjectStorageFeedRequest {
+  /** The name of the feed. */
+  name: string;
+  kind: ObjectStorageFeedKind;
+  /** The key of the events which are created for created objects. */
+  eventKey: string;
+  /** If set, only objects whose key starts with the prefix create events. */
+  keyPrefix?: string;
+  sqs?: ObjectStorageFeedSQSConfig;
+  pubsub?: ObjectStorageFeedPubSubConfig;
+}
+
+export interface ListObjectStorageFeeds {
+  pagination: PaginationResponse;
+  rows: ObjectStorageFeed[];
+}
+
+export enum QueryTriggerMode {
+  PER_ROW = "PER_ROW",
+  NON_EMPTY = "NON_EMPTY",
+}
+
+export interface QueryTrigger {
+  metadata: APIResourceMeta;
+  /**
+   * The unique identifier for the tenant that the trigger belongs to.
+   * @format uuid
+   */
+  tenantId: string;
+  /** The name of the trigger. */
+  name: string;
+  /** The read-only SQL query which is run. */
+  query: string;
+  /** The cron expression of the schedule of the query, evaluated in UTC. */
+  cron: string;
+  mode: QueryTriggerMode;
+  /** The key of the events which are created for the rows which the query returns. */
+  eventKey: string;
+  /** Whether the query runs on its schedule. */
+  enabled: boolean;
+  /**
+   * When the query last ran.
+   * @format date-time
+   */
+  lastRunAt?: string;
+  /** The error of the last run, if it failed. */
+  lastError?: string;
+  /**
+   * When the query runs next.
+   * @format date-time
+   */
+  nextRunAt: string;
+}
+
+export interface CreateQueryTriggerRequest {
+  /** The name of the trigger. */
+  name: string;
+  /** The connection string of the Postgres database which the query runs against, which is stored encrypted. It should be for a user which can only read the tables which the query reads. */
+  connectionString: string;
+  /** The read-only SQL query which is run. Only the first 100 rows of the result are used. */
+  query: string;
+  /** The cron expression of the schedule of the query, evaluated in UTC. */
+  cron: string;
+  mode?: QueryTriggerMode;
+  /** The key of the events which are created for the rows which the query returns. */
+  eventKey: string;
+}
+
+export interface ListQueryTriggers {
+  pagination: PaginationResponse;
+  rows: QueryTrigger[];
+}
+
+export enum RecurringTaskKind {
+  PURGE_WORKFLOW_RUNS = "PURGE_WORKFLOW_RUNS",
+  EMIT_EVENT = "EMIT_EVENT",
+}
+
+export interface RecurringTask {
+  metadata: APIResourceMeta;
+  /**
+   * The unique identifier for the tenant that the task belongs to.
+   * @format uuid
+   */
+  tenantId: string;
+  /** The name of the task. */
+  name: string;
+  kind: RecurringTaskKind;
+  /** The cron expression of the schedule of the task, evaluated in UTC. */
+  cron: string;
+  /**
+   * The workflow whose finished runs are purged, for PURGE_WORKFLOW_RUNS tasks.
+   * @format uuid
+   */
+  workflowId?: string;
+  /** How long finished runs are kept before they're purged, for PURGE_WORKFLOW_RUNS tasks. */
+  retention?: string;
+  /** The key of the event which is emitted, for EMIT_EVENT tasks. */
+  eventKey?: string;
+  /** The data of the event which is emitted, for EMIT_EVENT tasks. */
+  eventData?: Record<string, any>;
+  /** Whether the task runs on its schedule. */
+  enabled: boolean;
+  /**
+   * When the task last ran.
+   * @format date-time
+   */
+  lastRunAt?: string;
+  /** The error of the last run, if it failed. */
+  lastError?: string;
+  /**
+   * When the task runs next.
+   * @format date-time
+   */
+  nextRunAt: string;
+}
+
+export interface CreateRecurringTaskRequest {
+  /** The name of the task. */
+  name: string;
+  kind: RecurringTaskKind;
+  /** The cron expression of the schedule of the task, evaluated in UTC. */
+  cron: string;
+  /**
+   * The workflow whose finished runs are purged. Required for PURGE_WORKFLOW_RUNS tasks.
+   * @format uuid
+   */
+  workflowId?: string;
+  /** How long finished runs are kept before they're purged, like 168h. Required for PURGE_WORKFLOW_RUNS tasks, and must be at least 1h. */
+  retention?: string;
+  /** The key of the event which is emitted. Required for EMIT_EVENT tasks. */
+  eventKey?: string;
+  /** The data of the event which is emitted, for EMIT_EVENT tasks. */
+  eventData?: Record<string, any>;
+}
+
+export interface ListRecurringTasks {
+  pagination: PaginationResponse;
+  rows: RecurringTask[];
+}
+
+export interface ClientCertificate {
+  metadata: APIResourceMeta;
+  /**
+   * The unique identifier for the tenant that the certificate is pinned for.
+   * @format uuid
+   */
+  tenantId: string;
+  /** The name of the certificate. */
+  name: string;
+  /** The SHA-256 fingerprint of the certificate, as lowercase hex. */
+  fingerprint: string;
+  /** The subject of the certificate, if it was pinned with the certificate rather than its fingerprint. */
+  subject?: string;
+  /**
+   * When the certificate expires, if it was pinned with the certificate rather than its fingerprint.
+   * @format date-time
+   */
+  expiresAt?: string;
+}
+
+export interface CreateClientCertificateRequest {
+  /** The name of the certificate. */
+  name: string;
+  /** The PEM-encoded certificate to pin. Either the certificate or its fingerprint is required. */
+  certificate?: string;
+  /** The SHA-256 fingerprint of the certificate to pin, as hex which can be separated with colons. Either the certificate or its fingerprint is required. */
+  fingerprint?: string;
+}
+
+export interface ListClientCertificates {
+  pagination: PaginationResponse;
+  rows: ClientCertificate[];
+}
+
+export interface PIIRule {
+  metadata: APIResourceMeta;
+  /**
+   * The unique identifier for the tenant that the rule belongs to.
+   * @format uuid
+   */
+  tenantId: string;
+  /** The JSONPath expression which matches the personal data in step run inputs and outputs. */
+  path: string;
+  /** How long the matched values are kept after a step run finished, in seconds. */
+  retentionSeconds: number;
+  /**
+   * The step runs which finished up to this time have been purged.
+   * @format date-time
+   */
+  purgedUntil?: string;
+}
+
+export interface CreatePIIRuleRequest {
+  /** The JSONPath expression which matches the personal data in step run inputs and outputs. */
+  path: string;
+  /** How long the matched values are kept after a step run finished, in seconds. Must be at least 60 seconds. */
+  retentionSeconds: number;
+}
+
+export interface ListPIIRules {
+  pagination: PaginationResponse;
+  rows: PIIRule[];
+}
+
+export interface CreateSubjectDeletionRequest {
+  /** The run metadata key which identifies the subject, like customer_id. */
+  key: string;
+  /** The value of the key for the subject. Numbers in the run metadata are matched by their decimal representation. */
+  value: string;
+  /** Whether to only report what would be deleted, without deleting anything. */
+  dryRun?: boolean;
+}
+
+export interface SubjectDeletionReport {
+  /** The run metadata key which identifies the subject. */
+  key: string;
+  /** Whether nothing was deleted, because the deletion was a dry run. */
+  dryRun: boolean;
+  /** The workflow runs of the subject whose data was deleted. */
+  workflowRunIds: string[];
+  /** The workflow runs of the subject which are still running, and whose data was not deleted. */
+  skippedWorkflowRunIds: string[];
+  /** Whether the subject has more workflow runs than were deleted at once, so the deletion should be repeated. */
+  hasMore: boolean;
+  /** The number of workflow run trigger inputs which were deleted. */
+  workflowRunTriggers: number;
+  /** The number of event payloads which were deleted. */
+  events: number;
+  /** The number of job run inputs and step outputs which were deleted. */
+  jobRunLookupData: number;
+  /** The number of get group key run inputs and outputs which were deleted. */
+  getGroupKeyRuns: number;
+  /** The number of step run inputs, outputs and errors which were deleted. */
+  stepRuns: number;
+  /** The number of inputs, outputs and errors of previous step run attempts which were deleted. */
+  stepRunResultArchives: number;
+  /** The number of log lines which were deleted. */
+  logLines: number;
+  /** The number of streamed step run events which were deleted. */
+  streamEvents: number;
+}
+
+export enum TenantExportStatus {
+  PENDING = "PENDING",
+  RUNNING = "RUNNING",
+  SUCCEEDED = "SUCCEEDED",
+  FAILED = "FAILED",
+}
+
+export interface TenantExport {
+  metadata: APIResourceMeta;
+  /**
+   * The unique identifier for the tenant that the export belongs to.
+   * @format uuid
+   */
+  tenantId: string;
+  status: TenantExportStatus;
+  /** The number of sections of the archive which were written so far. */
+  sectionsDone: number;
+  /** The number of sections of the archive. */
+  sectionsTotal: number;
+  /** The number of records which were written so far. */
+  recordCount: number;
+  error?: string;
+  /** @format date-time */
+  startedAt?: string;
+  /** @format date-time */
+  finishedAt?: string;
+  /** The size of the archive in bytes, once the export succeeded. */
+  archiveSize?: number;
+  /** The hex-encoded SHA-256 of the archive, once the export succeeded. */
+  archiveSha256?: string;
+  /**
+   * The time after which the export and its archive are deleted.
+   * @format date-time
+   */
+  expiresAt?: string;
+}
+
+export interface ListTenantExports {
+  pagination: PaginationResponse;
+  rows: TenantExport[];
+}
+
+export interface TenantExportDownloadURL {
+  /** The signed URL which the archive of the export can be downloaded from without authentication. */
+  url: string;
+  /**
+   * The time after which the URL can't be used anymore.
+   * @format date-time
+   */
+  expiresAt: string;
+}
+
+export enum EventBusTopic {
+  WorkflowRunFinished = "workflow-run-finished",
+  StepRunFailed = "step-run-failed",
+  WorkerRegistered = "worker-registered",
+}
+
+export interface EventBusSubscription {
+  metadata: APIResourceMeta;
+  /**
+   * The unique identifier for the tenant that the subscription belongs to.
+   * @format uuid
+   */
+  tenantId: string;
+  /** The name of the subscription. */
+  name: string;
+  /** The topics of the subscription. */
+  topics: EventBusTopic[];
+  /**
+   * The id of the last record which was acknowledged.
+   * @format int64
+   */
+  ackedCursor: number;
+  /**
+   * When the records of the subscription were last listed.
+   * @format date-time
+   */
+  lastPolledAt?: string;
+}
+
+export interface CreateEventBusSubscriptionRequest {
+  /** The name of the subscription. */
+  name: string;
+  /** The topics of the subscription. */
+  topics: EventBusTopic[];
+}
+
+export interface ListEventBusSubscriptions {
+  pagination: PaginationResponse;
+  rows: EventBusSubscription[];
+}
+
+export interface EventBusRecord {
+  /**
+   * The id of the record, which is used as the cursor to acknowledge it.
+   * @format int64
+   */
+  id: number;
+  /**
+   * When the record was created.
+   * @format date-time
+   */
+  createdAt: string;
+  topic: EventBusTopic;
+  /** The data of the record. */
+  data: object;
+}
+
+export interface ListEventBusRecords {
+  rows: EventBusRecord[];
+  /**
+   * The cursor which acknowledges the returned records. If no records were returned, this is the last acknowledged cursor.
+   * @format int64
+   */
+  cursor: number;
+}
+
+export interface AckEventBusSubscriptionRequest {
+  /**
+   * The id of the last record to acknowledge.
+   * @format int64
+   */
+  cursor: number;
+}
+
+export interface TriggerLink {
+  metadata: APIResourceMeta;
+  /**
+   * The unique identifier for the tenant that the trigger link belongs to.
+   * @format uuid
+   */
+  tenantId: string;
+  /**
+   * The unique identifier for the workflow which the trigger link runs.
+   * @format uuid
+   */
+  workflowId: string;
+  /** The name of the trigger link. */
+  name: string;
+  /** The input which the body of every trigger request is merged onto. */
+  defaultInput?: object;
+  /**
+   * When the trigger link last triggered a workflow run.
+   * @format date-time
+   */
+  lastTriggeredAt?: string;
+}
+
+export interface CreateTriggerLinkRequest {
+  /** The name of the trigger link, which is unique within the workflow. */
+  name: string;
+  /** The input which the body of every trigger request is merged onto. Keys in the body override the default input. */
+  defaultInput?: object;
+}
+
+export interface CreateTriggerLinkResponse {
+  triggerLink: TriggerLink;
+  /** The URL which triggers the workflow, including the token. The token is only returned when the trigger link is created. */
+  url: string;
+  /** The token of the trigger link. */
+  token: string;
+}
+
+export interface ListTriggerLinks {
+  pagination: PaginationResponse;
+  rows: TriggerLink[];
+}
+
+export interface TriggerLinkRunResult {
+  /**
+   * The id of the workflow run which was triggered.
+   * @format uuid
+   */
+  workflowRunId: string;
+}
+
+export interface MaintenanceWindow {
+  metadata: APIResourceMeta;
+  /**
+   * The unique identifier for the tenant that the maintenance window belongs to.
+   * @format uuid
+   */
+  tenantId: string;
+  /**
+   * The unique identifier for the workflow which the maintenance window applies to.
+   * @format uuid
+   */
+  workflowId: string;
+  /** The name of the maintenance window. */
+  name: string;
+  /** The cron expression of the times at which the maintenance window starts, in UTC. */
+  cron: string;
+  /** How long the maintenance window lasts, in seconds. */
+  durationSeconds: number;
+}
+
+export interface CreateMaintenanceWindowRequest {
+  /** The name of the maintenance window, which is unique within the workflow. */
+  name: string;
+  /** The cron expression of the times at which the maintenance window starts, in UTC. */
+  cron: string;
+  /** How long the maintenance window lasts, in seconds. */
+  durationSeconds: number;
+}
+
+export interface ListMaintenanceWindows {
+  pagination?: PaginationResponse;
+  rows: MaintenanceWindow[];
+}
+
+export interface WorkflowConcurrencyQueueDepthValue {
+  /** The group key, or the value of a component of the group key. */
+  value: string;
+  /**
+   * The number of queued runs with the value.
+   * @format int64
+   */
+  queued: number;
+  /**
+   * The number of running runs with the value.
+   * @format int64
+   */
+  running: number;
+}
+
+export interface WorkflowConcurrencyQueueDepthComponent {
+  /** The position of the key expression which computes the component, starting from 1. */
+  position: number;
+  /** The key expression at the position in the latest version of the workflow. */
+  expression?: string;
+  /** The values of the component with the most queued runs. */
+  values: WorkflowConcurrencyQueueDepthValue[];
+}
+
+export interface WorkflowConcurrencyQueueDepth {
+  /** The concurrency groups with the most queued runs. */
+  groups: WorkflowConcurrencyQueueDepthValue[];
+  /** The queue depth aggregated along each component of the group keys, for groups which are computed from multiple key expressions. */
+  components: WorkflowConcurrencyQueueDepthComponent[];
+}
+
+export enum CostCenterUsageBucket {
+  Hour = "hour",
+  Day = "day",
+  Week = "week",
+}
+
+export interface CostCenterUsageRow {
+  /** The cost center of the step runs, which is empty for step runs whose step and workflow have no cost center. */
+  costCenter: string;
+  /**
+   * The start of the time bucket.
+   * @format date-time
+   */
+  bucket: string;
+  /**
+   * The total time which the step runs spent running on workers, in seconds.
+   * @format double
+   */
+  computeSeconds: number;
+  /** @format int64 */
+  stepRunCount: number;
+  /**
+   * The number of distinct workflow runs of the step runs in the bucket.
+   * @format int64
+   */
+  workflowRunCount: number;
+}
+
+export interface CostCenterUsage {
+  /** @format date-time */
+  since: string;
+  /** @format date-time */
+  until: string;
+  bucket: CostCenterUsageBucket;
+  rows: CostCenterUsageRow[];
+}
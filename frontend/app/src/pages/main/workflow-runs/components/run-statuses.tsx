@@ -1,5 +1,10 @@
 import { Badge } from '@/components/ui/badge';
-import { JobRunStatus, StepRunStatus, WorkflowRunStatus } from '@/lib/api';
+import {
+  CancellationSource,
+  JobRunStatus,
+  StepRunStatus,
+  WorkflowRunStatus,
+} from '@/lib/api';
 import { capitalize, cn } from '@/lib/utils';
 
 type RunStatusType = `${StepRunStatus | WorkflowRunStatus | JobRunStatus}`;
@@ -7,11 +12,14 @@ type RunStatusType = `${StepRunStatus | WorkflowRunStatus | JobRunStatus}`;
 export function RunStatus({
   status,
   reason,
+  source,
 }: {
   status: RunStatusType;
   reason?: string;
+  source?: CancellationSource;
 }) {
-  let variant: 'inProgress' | 'successful' | 'failed' = 'inProgress';
+  let variant: 'inProgress' | 'successful' | 'failed' | 'secondary' =
+    'inProgress';
   let text = 'Running';
 
   switch (status) {
@@ -35,6 +43,12 @@ export function RunStatus({
           break;
       }
 
+      // runs which were superseded by a newer run are expected, so they are not shown as failures
+      if (source === CancellationSource.CONCURRENCY) {
+        variant = 'secondary';
+        text = 'Superseded';
+      }
+
       break;
     default:
       break;
@@ -26,10 +26,38 @@ export const columns: ColumnDef<WorkflowRun>[] = [
     header: ({ column }) => (
       <DataTableColumnHeader column={column} title="Status" />
     ),
-    cell: ({ row }) => <RunStatus status={row.original.status} />,
+    cell: ({ row }) => (
+      <RunStatus
+        status={row.original.status}
+        source={row.original.cancelledSource}
+      />
+    ),
     enableSorting: false,
     enableHiding: false,
   },
+  {
+    accessorKey: 'Progress',
+    header: ({ column }) => (
+      <DataTableColumnHeader column={column} title="Progress" />
+    ),
+    cell: ({ row }) => {
+      const progress = row.original.progress;
+
+      if (!progress || progress.total === 0) {
+        return <div>N/A</div>;
+      }
+
+      const finished =
+        progress.succeeded + progress.failed + progress.cancelled;
+
+      return (
+        <div className="whitespace-nowrap">
+          {finished} of {progress.total} steps
+        </div>
+      );
+    },
+    enableSorting: false,
+  },
   {
     accessorKey: 'Workflow',
     header: ({ column }) => (
@@ -0,0 +1,203 @@
+// Package attestation signs attestations of finished workflow runs, which record hashes of the input and outputs of
+// the run, when its step runs ran and which workers ran them. Attestations are JWTs which are signed with the JWT
+// keyset of the instance, so they can be verified offline with its public keys.
+package attestation
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/tink-crypto/tink-go/jwt"
+	"github.com/tink-crypto/tink-go/keyset"
+)
+
+// Version is the version of the attestation format. It is changed when a field is changed in a way which verifiers
+// of older attestations don't expect.
+const Version = "v1"
+
+// TypeHeader is the type header of attestation JWTs, which distinguishes them from API tokens.
+const TypeHeader = "hatchet-attestation+jwt"
+
+const statementClaim = "attestation"
+
+// Statement is the content of an attestation.
+type Statement struct {
+	Version string `json:"version"`
+
+	TenantId          string `json:"tenantId"`
+	WorkflowRunId     string `json:"workflowRunId"`
+	WorkflowName      string `json:"workflowName"`
+	WorkflowVersionId string `json:"workflowVersionId"`
+	WorkflowVersion   string `json:"workflowVersion,omitempty"`
+
+	Status     string     `json:"status"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	StartedAt  *time.Time `json:"startedAt,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// InputSHA256 is the hash of the input of the run, as returned by Hash
+	InputSHA256 string `json:"inputSha256"`
+
+	StepRuns []StepRun `json:"stepRuns"`
+}
+
+type StepRun struct {
+	StepRunId      string `json:"stepRunId"`
+	JobName        string `json:"jobName"`
+	StepReadableId string `json:"stepReadableId,omitempty"`
+	Status         string `json:"status"`
+	RetryCount     int    `json:"retryCount"`
+
+	StartedAt  *time.Time `json:"startedAt,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// InputSHA256 and OutputSHA256 are the hashes of the input and output of the step run, as returned by Hash.
+	// They aren't set if the step run has no input or output.
+	InputSHA256  string `json:"inputSha256,omitempty"`
+	OutputSHA256 string `json:"outputSha256,omitempty"`
+
+	// Worker is the worker which ran the step run, if it was assigned to one
+	Worker *Worker `json:"worker,omitempty"`
+}
+
+type Worker struct {
+	WorkerId string `json:"workerId"`
+	Name     string `json:"name"`
+	BuildId  string `json:"buildId,omitempty"`
+}
+
+// Hash returns the hex-encoded SHA-256 of the canonical encoding of a JSON document, which has sorted object keys,
+// no whitespace and no HTML escaping, and keeps numbers as they are written. Documents which only differ in their
+// formatting have the same hash.
+func Hash(data []byte) (string, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v interface{}
+
+	if err := dec.Decode(&v); err != nil {
+		return "", fmt.Errorf("could not decode JSON: %w", err)
+	}
+
+	buf := &bytes.Buffer{}
+
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(v); err != nil {
+		return "", fmt.Errorf("could not encode JSON: %w", err)
+	}
+
+	// the encoder terminates the document with a newline
+	sum := sha256.Sum256(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Sign signs a statement with the private JWT keyset of the instance. The issuer is the URL of the instance.
+func Sign(handle *keyset.Handle, issuer string, statement *Statement) (string, error) {
+	statementBytes, err := json.Marshal(statement)
+
+	if err != nil {
+		return "", fmt.Errorf("could not marshal statement: %w", err)
+	}
+
+	// custom claims must be plain JSON values
+	claim := map[string]interface{}{}
+
+	if err := json.Unmarshal(statementBytes, &claim); err != nil {
+		return "", fmt.Errorf("could not unmarshal statement: %w", err)
+	}
+
+	typeHeader := TypeHeader
+	subject := statement.TenantId
+	issuedAt := time.Now()
+
+	rawJWT, err := jwt.NewRawJWT(&jwt.RawJWTOptions{
+		TypeHeader:        &typeHeader,
+		Issuer:            &issuer,
+		Subject:           &subject,
+		IssuedAt:          &issuedAt,
+		WithoutExpiration: true,
+		CustomClaims: map[string]interface{}{
+			statementClaim: claim,
+		},
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("could not create raw JWT: %w", err)
+	}
+
+	signer, err := jwt.NewSigner(handle)
+
+	if err != nil {
+		return "", fmt.Errorf("could not create JWT signer: %w", err)
+	}
+
+	return signer.SignAndEncode(rawJWT)
+}
+
+// PublicKeys returns the public JWT keyset of the instance as a JWK set, which attestations are verified with.
+func PublicKeys(handle *keyset.Handle) ([]byte, error) {
+	return jwt.JWKSetFromPublicKeysetHandle(handle)
+}
+
+// Verify verifies an attestation with a JWK set, as returned by PublicKeys, and returns its statement. If the
+// issuer is set, the attestation must have been signed by the instance with that URL.
+func Verify(attestation string, jwks []byte, issuer *string) (*Statement, error) {
+	handle, err := jwt.JWKSetToPublicKeysetHandle(jwks)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read public keys: %w", err)
+	}
+
+	verifier, err := jwt.NewVerifier(handle)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create JWT verifier: %w", err)
+	}
+
+	typeHeader := TypeHeader
+
+	validator, err := jwt.NewValidator(&jwt.ValidatorOpts{
+		ExpectedTypeHeader:     &typeHeader,
+		ExpectedIssuer:         issuer,
+		IgnoreIssuer:           issuer == nil,
+		AllowMissingExpiration: true,
+		ExpectIssuedInThePast:  true,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create JWT validator: %w", err)
+	}
+
+	verified, err := verifier.VerifyAndDecode(attestation, validator)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not verify attestation: %w", err)
+	}
+
+	claim, err := verified.ObjectClaim(statementClaim)
+
+	if err != nil {
+		return nil, fmt.Errorf("attestation has no statement: %w", err)
+	}
+
+	claimBytes, err := json.Marshal(claim)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal statement: %w", err)
+	}
+
+	statement := &Statement{}
+
+	if err := json.Unmarshal(claimBytes, statement); err != nil {
+		return nil, fmt.Errorf("could not unmarshal statement: %w", err)
+	}
+
+	return statement, nil
+}
@@ -0,0 +1,149 @@
+package attestation
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/encryption"
+)
+
+func newTestEncryption(t *testing.T) encryption.EncryptionService {
+	masterKey, privateEc256, publicEc256, err := encryption.GenerateLocalKeys()
+	require.NoError(t, err)
+
+	svc, err := encryption.NewLocalEncryption(masterKey, privateEc256, publicEc256)
+	require.NoError(t, err)
+
+	return svc
+}
+
+func newTestStatement() *Statement {
+	startedAt := time.Now().Add(-time.Minute).UTC().Truncate(time.Second)
+	finishedAt := startedAt.Add(30 * time.Second)
+
+	return &Statement{
+		Version:           Version,
+		TenantId:          "707d0855-80ab-4e1f-a156-f1c4546cbf52",
+		WorkflowRunId:     "b0e4b9d2-3d9c-4b3b-9d0b-6f5d7f6a2c11",
+		WorkflowName:      "post-user-sign-up",
+		WorkflowVersionId: "5c5b4a2e-8f1d-4c1e-bd3f-0a3e1f2b7c90",
+		Status:            "SUCCEEDED",
+		CreatedAt:         startedAt,
+		StartedAt:         &startedAt,
+		FinishedAt:        &finishedAt,
+		InputSHA256:       strings.Repeat("a", 64),
+		StepRuns: []StepRun{
+			{
+				StepRunId:    "9f1c2e3d-4b5a-4c6d-8e7f-0a1b2c3d4e5f",
+				JobName:      "send-email",
+				Status:       "SUCCEEDED",
+				StartedAt:    &startedAt,
+				FinishedAt:   &finishedAt,
+				OutputSHA256: strings.Repeat("b", 64),
+				Worker: &Worker{
+					WorkerId: "3e2d1c0b-a9f8-4e7d-b6c5-a4b3c2d1e0f9",
+					Name:     "worker-1",
+				},
+			},
+		},
+	}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	enc := newTestEncryption(t)
+	statement := newTestStatement()
+
+	attestation, err := Sign(enc.GetPrivateJWTHandle(), "https://hatchet.example.com", statement)
+	require.NoError(t, err)
+
+	jwks, err := PublicKeys(enc.GetPublicJWTHandle())
+	require.NoError(t, err)
+
+	issuer := "https://hatchet.example.com"
+
+	verified, err := Verify(attestation, jwks, &issuer)
+	require.NoError(t, err)
+
+	assert.Equal(t, statement, verified)
+
+	// the issuer is optional
+	verified, err = Verify(attestation, jwks, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, statement.WorkflowRunId, verified.WorkflowRunId)
+}
+
+func TestVerifyWrongIssuer(t *testing.T) {
+	enc := newTestEncryption(t)
+
+	attestation, err := Sign(enc.GetPrivateJWTHandle(), "https://hatchet.example.com", newTestStatement())
+	require.NoError(t, err)
+
+	jwks, err := PublicKeys(enc.GetPublicJWTHandle())
+	require.NoError(t, err)
+
+	issuer := "https://other.example.com"
+
+	_, err = Verify(attestation, jwks, &issuer)
+	assert.Error(t, err)
+}
+
+func TestVerifyWrongKeys(t *testing.T) {
+	attestation, err := Sign(newTestEncryption(t).GetPrivateJWTHandle(), "https://hatchet.example.com", newTestStatement())
+	require.NoError(t, err)
+
+	jwks, err := PublicKeys(newTestEncryption(t).GetPublicJWTHandle())
+	require.NoError(t, err)
+
+	_, err = Verify(attestation, jwks, nil)
+	assert.Error(t, err)
+}
+
+func TestVerifyTampered(t *testing.T) {
+	enc := newTestEncryption(t)
+
+	attestation, err := Sign(enc.GetPrivateJWTHandle(), "https://hatchet.example.com", newTestStatement())
+	require.NoError(t, err)
+
+	jwks, err := PublicKeys(enc.GetPublicJWTHandle())
+	require.NoError(t, err)
+
+	parts := strings.Split(attestation, ".")
+	require.Len(t, parts, 3)
+
+	// replace the payload with the payload of another attestation
+	other := newTestStatement()
+	other.Status = "FAILED"
+
+	otherAttestation, err := Sign(newTestEncryption(t).GetPrivateJWTHandle(), "https://hatchet.example.com", other)
+	require.NoError(t, err)
+
+	parts[1] = strings.Split(otherAttestation, ".")[1]
+
+	_, err = Verify(strings.Join(parts, "."), jwks, nil)
+	assert.Error(t, err)
+}
+
+func TestHash(t *testing.T) {
+	a, err := Hash([]byte(`{"b": 1.50, "a": {"d": "<tag>", "c": [1, 2]}}`))
+	require.NoError(t, err)
+
+	b, err := Hash([]byte("{\n  \"a\": {\"c\": [1,2], \"d\": \"<tag>\"},\n  \"b\": 1.50\n}\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, a, b)
+	assert.Len(t, a, 64)
+
+	// numbers are hashed as they are written
+	c, err := Hash([]byte(`{"a": {"c": [1, 2], "d": "<tag>"}, "b": 1.5}`))
+	require.NoError(t, err)
+
+	assert.NotEqual(t, a, c)
+
+	_, err = Hash([]byte("not json"))
+	assert.Error(t, err)
+}
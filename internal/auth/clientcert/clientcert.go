@@ -0,0 +1,121 @@
+// Package clientcert pins the client certificates which the workers of a tenant connect with. Once a tenant pins a
+// certificate, the dispatcher only accepts the workers of the tenant which connect with a pinned certificate.
+package clientcert
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+)
+
+// pinsTTL is how long the pinned fingerprints of a tenant are cached, so that not every request reads them. Newly
+// pinned and deleted certificates take effect after at most this long.
+const pinsTTL = 10 * time.Second
+
+// Fingerprint returns the SHA-256 fingerprint of the DER encoding of a certificate, as lowercase hex.
+func Fingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// ParseFingerprint normalizes a SHA-256 fingerprint to lowercase hex. Fingerprints can be separated with colons,
+// like the output of `openssl x509 -noout -fingerprint -sha256`.
+func ParseFingerprint(fingerprint string) (string, error) {
+	fingerprint = strings.TrimPrefix(strings.TrimSpace(fingerprint), "sha256 Fingerprint=")
+	fingerprint = strings.ToLower(strings.ReplaceAll(fingerprint, ":", ""))
+
+	b, err := hex.DecodeString(fingerprint)
+
+	if err != nil || len(b) != sha256.Size {
+		return "", fmt.Errorf("fingerprint must be a hex-encoded SHA-256 hash")
+	}
+
+	return fingerprint, nil
+}
+
+// ParseCertificate parses the first certificate of a PEM-encoded certificate.
+func ParseCertificate(certPEM string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(certPEM))
+
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("certificate must be PEM-encoded")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not parse certificate: %w", err)
+	}
+
+	return cert, nil
+}
+
+// ListPinsFunc returns the fingerprints of the certificates which are pinned for a tenant.
+type ListPinsFunc func(tenantId string) ([]string, error)
+
+// Verifier checks the client certificates of requests against the certificates which are pinned for their tenant.
+type Verifier struct {
+	listPins ListPinsFunc
+
+	pins *expirable.LRU[string, map[string]bool]
+}
+
+func NewVerifier(listPins ListPinsFunc) *Verifier {
+	return &Verifier{
+		listPins: listPins,
+		pins:     expirable.NewLRU[string, map[string]bool](10000, nil, pinsTTL),
+	}
+}
+
+// Verify returns an error if the tenant pins certificates, and the certificate which the request was made with is
+// missing or not pinned. The certificate must already be verified by the TLS handshake.
+func (v *Verifier) Verify(tenantId string, cert *x509.Certificate) error {
+	pins, err := v.getPins(tenantId)
+
+	if err != nil {
+		return err
+	}
+
+	// tenants which don't pin certificates accept any certificate which the TLS config accepts
+	if len(pins) == 0 {
+		return nil
+	}
+
+	if cert == nil {
+		return fmt.Errorf("tenant requires a pinned client certificate, but none was provided")
+	}
+
+	if !pins[Fingerprint(cert)] {
+		return fmt.Errorf("client certificate %s is not pinned for the tenant", cert.Subject.String())
+	}
+
+	return nil
+}
+
+func (v *Verifier) getPins(tenantId string) (map[string]bool, error) {
+	if pins, ok := v.pins.Get(tenantId); ok {
+		return pins, nil
+	}
+
+	fingerprints, err := v.listPins(tenantId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list pinned client certificates: %w", err)
+	}
+
+	pins := make(map[string]bool, len(fingerprints))
+
+	for _, fingerprint := range fingerprints {
+		pins[fingerprint] = true
+	}
+
+	v.pins.Add(tenantId, pins)
+
+	return pins, nil
+}
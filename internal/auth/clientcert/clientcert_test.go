@@ -0,0 +1,118 @@
+package clientcert
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCertificate(t *testing.T, commonName string) (*x509.Certificate, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestParseCertificate(t *testing.T) {
+	cert, certPEM := newCertificate(t, "worker")
+
+	parsed, err := ParseCertificate(certPEM)
+	require.NoError(t, err)
+
+	assert.Equal(t, Fingerprint(cert), Fingerprint(parsed))
+	assert.Equal(t, "worker", parsed.Subject.CommonName)
+
+	_, err = ParseCertificate("not a certificate")
+	assert.Error(t, err)
+}
+
+func TestParseFingerprint(t *testing.T) {
+	cert, _ := newCertificate(t, "worker")
+	fingerprint := Fingerprint(cert)
+
+	// the format of openssl x509 -noout -fingerprint -sha256
+	pairs := make([]string, 0, len(fingerprint)/2)
+
+	for i := 0; i < len(fingerprint); i += 2 {
+		pairs = append(pairs, strings.ToUpper(fingerprint[i:i+2]))
+	}
+
+	for _, s := range []string{
+		fingerprint,
+		strings.ToUpper(fingerprint),
+		strings.Join(pairs, ":"),
+		"sha256 Fingerprint=" + strings.Join(pairs, ":") + "\n",
+	} {
+		parsed, err := ParseFingerprint(s)
+
+		require.NoError(t, err, s)
+		assert.Equal(t, fingerprint, parsed)
+	}
+
+	_, err := ParseFingerprint("abcd")
+	assert.Error(t, err)
+
+	_, err = ParseFingerprint(strings.Repeat("z", 64))
+	assert.Error(t, err)
+}
+
+func TestVerify(t *testing.T) {
+	pinned, _ := newCertificate(t, "pinned")
+	other, _ := newCertificate(t, "other")
+
+	pins := map[string][]string{
+		"pinning": {Fingerprint(pinned)},
+	}
+
+	v := NewVerifier(func(tenantId string) ([]string, error) {
+		return pins[tenantId], nil
+	})
+
+	assert.NoError(t, v.Verify("pinning", pinned))
+	assert.Error(t, v.Verify("pinning", other))
+	assert.Error(t, v.Verify("pinning", nil))
+
+	assert.NoError(t, v.Verify("not-pinning", other))
+	assert.NoError(t, v.Verify("not-pinning", nil))
+}
+
+func TestVerifyCachesPins(t *testing.T) {
+	cert, _ := newCertificate(t, "worker")
+
+	calls := 0
+
+	v := NewVerifier(func(tenantId string) ([]string, error) {
+		calls++
+		return []string{Fingerprint(cert)}, nil
+	})
+
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, v.Verify("tenant", cert))
+	}
+
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,183 @@
+// Package spiffe authenticates workers with the SPIFFE ID of their X.509 SVID, as issued by SPIRE, instead of an API
+// token. SPIFFE IDs are mapped to tenants and worker pools in the config of the engine.
+package spiffe
+
+import (
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Identity is the tenant and worker pool which a SPIFFE ID is mapped to.
+type Identity struct {
+	// ID is the SPIFFE ID of the worker, like spiffe://example.org/ns/payments/sa/worker
+	ID string
+
+	// TenantId is the tenant which the worker authenticates as
+	TenantId string
+
+	// Service is the worker pool which the worker joins, or empty if the mapping doesn't set one
+	Service string
+}
+
+type mapping struct {
+	trustDomain string
+	path        string
+
+	// prefix is whether the mapping matches every path below its path, rather than only its path
+	prefix bool
+
+	tenantId string
+	service  string
+}
+
+type Authenticator struct {
+	mappings []mapping
+}
+
+// NewAuthenticator parses the mappings of SPIFFE IDs, which have the form <spiffe id>=<tenant id>[:<service>]. A
+// SPIFFE ID which ends with /* matches every ID below it. When several mappings match an ID, the first one is used.
+func NewAuthenticator(mappings []string) (*Authenticator, error) {
+	if len(mappings) == 0 {
+		return nil, fmt.Errorf("at least one SPIFFE ID mapping is required")
+	}
+
+	res := &Authenticator{
+		mappings: make([]mapping, 0, len(mappings)),
+	}
+
+	for _, m := range mappings {
+		parsed, err := parseMapping(strings.TrimSpace(m))
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid SPIFFE ID mapping %q: %w", m, err)
+		}
+
+		res.mappings = append(res.mappings, *parsed)
+	}
+
+	return res, nil
+}
+
+func parseMapping(m string) (*mapping, error) {
+	id, target, ok := strings.Cut(m, "=")
+
+	if !ok {
+		return nil, fmt.Errorf("mapping must have the form <spiffe id>=<tenant id>[:<service>]")
+	}
+
+	tenantId, service, _ := strings.Cut(target, ":")
+
+	if _, err := uuid.Parse(tenantId); err != nil {
+		return nil, fmt.Errorf("tenant id must be a UUID")
+	}
+
+	prefix := strings.HasSuffix(id, "/*")
+
+	if prefix {
+		id = strings.TrimSuffix(id, "/*")
+	}
+
+	u, err := parseID(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !prefix && u.Path == "" {
+		return nil, fmt.Errorf("SPIFFE ID must have a path, or end with /* to match every ID of the trust domain")
+	}
+
+	return &mapping{
+		trustDomain: u.Host,
+		path:        u.Path,
+		prefix:      prefix,
+		tenantId:    tenantId,
+		service:     service,
+	}, nil
+}
+
+func (m *mapping) matches(u *url.URL) bool {
+	if u.Host != m.trustDomain {
+		return false
+	}
+
+	if m.prefix {
+		return strings.HasPrefix(u.Path, m.path+"/")
+	}
+
+	return u.Path == m.path
+}
+
+// Authenticate returns the identity which the SPIFFE ID of an X.509 SVID is mapped to. The SVID must already be
+// verified against the trust bundle by the TLS handshake.
+func (a *Authenticator) Authenticate(cert *x509.Certificate) (*Identity, error) {
+	if cert == nil {
+		return nil, fmt.Errorf("no client certificate was provided")
+	}
+
+	u, err := ID(cert)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range a.mappings {
+		if m.matches(u) {
+			return &Identity{
+				ID:       u.String(),
+				TenantId: m.tenantId,
+				Service:  m.service,
+			}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("SPIFFE ID %s is not mapped to a tenant", u.String())
+}
+
+// ID returns the SPIFFE ID of an X.509 SVID, which is its only URI SAN.
+func ID(cert *x509.Certificate) (*url.URL, error) {
+	if cert.IsCA {
+		return nil, fmt.Errorf("X.509 SVID of a workload can't be a CA certificate")
+	}
+
+	if len(cert.URIs) != 1 {
+		return nil, fmt.Errorf("X.509 SVID must have exactly one URI SAN, but has %d", len(cert.URIs))
+	}
+
+	u, err := parseID(cert.URIs[0].String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Path == "" {
+		return nil, fmt.Errorf("SPIFFE ID of a workload must have a path")
+	}
+
+	return u, nil
+}
+
+func parseID(id string) (*url.URL, error) {
+	u, err := url.Parse(id)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not parse SPIFFE ID: %w", err)
+	}
+
+	switch {
+	case u.Scheme != "spiffe":
+		return nil, fmt.Errorf("SPIFFE ID must have the spiffe scheme")
+	case u.Host == "" || u.Port() != "" || u.User != nil:
+		return nil, fmt.Errorf("SPIFFE ID must have a trust domain without a port or user info")
+	case u.RawQuery != "" || u.Fragment != "":
+		return nil, fmt.Errorf("SPIFFE ID can't have a query or fragment")
+	case strings.HasSuffix(u.Path, "/"):
+		return nil, fmt.Errorf("SPIFFE ID can't have a trailing slash")
+	}
+
+	return u, nil
+}
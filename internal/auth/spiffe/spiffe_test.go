@@ -0,0 +1,100 @@
+package spiffe
+
+import (
+	"crypto/x509"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	paymentsTenant = "707d0855-80ab-4e1f-a156-f1c4546cbf52"
+	defaultTenant  = "a5a9a9f2-3f4c-4b6b-8f59-0c8f5c8d2c11"
+)
+
+func newSVID(t *testing.T, ids ...string) *x509.Certificate {
+	cert := &x509.Certificate{}
+
+	for _, id := range ids {
+		u, err := url.Parse(id)
+		require.NoError(t, err)
+
+		cert.URIs = append(cert.URIs, u)
+	}
+
+	return cert
+}
+
+func TestAuthenticate(t *testing.T) {
+	a, err := NewAuthenticator([]string{
+		"spiffe://example.org/ns/payments/sa/worker=" + paymentsTenant + ":payments",
+		"spiffe://example.org/ns/*=" + defaultTenant,
+	})
+	require.NoError(t, err)
+
+	identity, err := a.Authenticate(newSVID(t, "spiffe://example.org/ns/payments/sa/worker"))
+	require.NoError(t, err)
+
+	assert.Equal(t, &Identity{
+		ID:       "spiffe://example.org/ns/payments/sa/worker",
+		TenantId: paymentsTenant,
+		Service:  "payments",
+	}, identity)
+
+	identity, err = a.Authenticate(newSVID(t, "spiffe://example.org/ns/billing/sa/worker"))
+	require.NoError(t, err)
+
+	assert.Equal(t, defaultTenant, identity.TenantId)
+	assert.Equal(t, "", identity.Service)
+
+	for _, id := range []string{
+		// the prefix only matches IDs below it
+		"spiffe://example.org/ns",
+		"spiffe://example.org/nsx/billing",
+		"spiffe://other.org/ns/payments/sa/worker",
+	} {
+		_, err := a.Authenticate(newSVID(t, id))
+		assert.Error(t, err, id)
+	}
+}
+
+func TestAuthenticateInvalidSVID(t *testing.T) {
+	a, err := NewAuthenticator([]string{"spiffe://example.org/*=" + defaultTenant})
+	require.NoError(t, err)
+
+	_, err = a.Authenticate(nil)
+	assert.Error(t, err)
+
+	_, err = a.Authenticate(newSVID(t))
+	assert.Error(t, err)
+
+	_, err = a.Authenticate(newSVID(t, "spiffe://example.org/a", "spiffe://example.org/b"))
+	assert.Error(t, err)
+
+	_, err = a.Authenticate(newSVID(t, "https://example.org/a"))
+	assert.Error(t, err)
+
+	ca := newSVID(t, "spiffe://example.org/a")
+	ca.IsCA = true
+
+	_, err = a.Authenticate(ca)
+	assert.Error(t, err)
+}
+
+func TestNewAuthenticatorInvalidMappings(t *testing.T) {
+	for _, m := range []string{
+		"spiffe://example.org/worker",
+		"spiffe://example.org/worker=not-a-uuid",
+		"spiffe://example.org=" + defaultTenant,
+		"spiffe://example.org:8443/worker=" + defaultTenant,
+		"https://example.org/worker=" + defaultTenant,
+	} {
+		_, err := NewAuthenticator([]string{m})
+		assert.Error(t, err, m)
+	}
+
+	_, err := NewAuthenticator(nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,59 @@
+package token
+
+import (
+	"fmt"
+)
+
+// Scope restricts what an API token can be used for. Tokens without any scopes can be used for everything
+// except managing other tokens, which is the case for all tokens created before scopes were introduced.
+type Scope string
+
+const (
+	// ScopeRead allows read-only requests to the REST API.
+	ScopeRead Scope = "read"
+
+	// ScopeWrite allows all requests to the REST API, and implies ScopeRead.
+	ScopeWrite Scope = "write"
+
+	// ScopeWorker allows requests to the gRPC API, which is used by workers and by the SDKs to push events
+	// and trigger workflows.
+	ScopeWorker Scope = "worker"
+
+	// ScopeTokens allows reading, creating, rotating and revoking the API tokens of the tenant. Unlike the
+	// other scopes, it must always be granted explicitly: a token which can create other tokens can outlive
+	// its own revocation, so tokens without scopes don't have it.
+	ScopeTokens Scope = "tokens"
+)
+
+var validScopes = map[Scope]bool{
+	ScopeRead:   true,
+	ScopeWrite:  true,
+	ScopeWorker: true,
+	ScopeTokens: true,
+}
+
+// ValidateScopes returns an error if any of the scopes is unknown.
+func ValidateScopes(scopes []string) error {
+	for _, scope := range scopes {
+		if !validScopes[Scope(scope)] {
+			return fmt.Errorf("unknown scope %s: must be one of read, write, worker or tokens", scope)
+		}
+	}
+
+	return nil
+}
+
+// hasScope returns whether a token with the given scopes is allowed to perform requests which require scope.
+func hasScope(scopes []string, scope Scope) bool {
+	if len(scopes) == 0 {
+		return scope != ScopeTokens
+	}
+
+	for _, s := range scopes {
+		if Scope(s) == scope || (Scope(s) == ScopeWrite && scope == ScopeRead) {
+			return true
+		}
+	}
+
+	return false
+}
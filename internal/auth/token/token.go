@@ -12,8 +12,70 @@ import (
 )
 
 type JWTManager interface {
-	GenerateTenantToken(tenantId, name string) (string, error)
-	ValidateTenantToken(token string) (string, error)
+	GenerateTenantToken(tenantId, name string, fs ...GenerateTokenOpt) (string, error)
+
+	// ValidateTenantToken validates the token and returns the tenant it was issued for, along with the environment
+	// and namespace of the token. The token must allow requests which require the given scope.
+	ValidateTenantToken(token string, scope Scope) (*ValidatedToken, error)
+}
+
+// ValidatedToken is a tenant token which has been validated.
+type ValidatedToken struct {
+	// TenantId is the id of the tenant the token was issued for.
+	TenantId string
+
+	// Environment is the environment of the token, or empty if the token can be used for every environment.
+	Environment string
+
+	// Namespace is the namespace of the token, or empty if the token can access every namespace.
+	Namespace string
+}
+
+// DefaultTokenExpiry is how long API tokens are valid for, unless a different expiry is set when they are
+// created.
+const DefaultTokenExpiry = 90 * 24 * time.Hour
+
+type GenerateTokenOpt func(*GenerateTokenOpts)
+
+type GenerateTokenOpts struct {
+	expiresIn   time.Duration
+	scopes      []string
+	environment *string
+	namespace   *string
+}
+
+func defaultGenerateTokenOpts() *GenerateTokenOpts {
+	return &GenerateTokenOpts{
+		expiresIn: DefaultTokenExpiry,
+	}
+}
+
+// WithExpiresIn sets how long the token is valid for.
+func WithExpiresIn(expiresIn time.Duration) GenerateTokenOpt {
+	return func(opts *GenerateTokenOpts) {
+		opts.expiresIn = expiresIn
+	}
+}
+
+// WithScopes restricts the token to the given scopes.
+func WithScopes(scopes []string) GenerateTokenOpt {
+	return func(opts *GenerateTokenOpts) {
+		opts.scopes = scopes
+	}
+}
+
+// WithEnvironment restricts the token to the given environment.
+func WithEnvironment(environment string) GenerateTokenOpt {
+	return func(opts *GenerateTokenOpts) {
+		opts.environment = &environment
+	}
+}
+
+// WithNamespace restricts the token to the given namespace.
+func WithNamespace(namespace string) GenerateTokenOpt {
+	return func(opts *GenerateTokenOpts) {
+		opts.namespace = &namespace
+	}
 }
 
 type TokenOpts struct {
@@ -45,7 +107,21 @@ func NewJWTManager(encryptionSvc encryption.EncryptionService, tokenRepo reposit
 	}, nil
 }
 
-func (j *jwtManagerImpl) GenerateTenantToken(tenantId, name string) (string, error) {
+func (j *jwtManagerImpl) GenerateTenantToken(tenantId, name string, fs ...GenerateTokenOpt) (string, error) {
+	genOpts := defaultGenerateTokenOpts()
+
+	for _, f := range fs {
+		f(genOpts)
+	}
+
+	if genOpts.expiresIn <= 0 {
+		return "", fmt.Errorf("token expiry must be positive")
+	}
+
+	if err := ValidateScopes(genOpts.scopes); err != nil {
+		return "", err
+	}
+
 	// Retrieve the JWT Signer primitive from privateKeysetHandle.
 	signer, err := jwt.NewSigner(j.encryption.GetPrivateJWTHandle())
 
@@ -53,7 +129,7 @@ func (j *jwtManagerImpl) GenerateTenantToken(tenantId, name string) (string, err
 		return "", fmt.Errorf("failed to create JWT Signer: %v", err)
 	}
 
-	tokenId, expiresAt, opts := j.getJWTOptionsForTenant(tenantId)
+	tokenId, expiresAt, opts := j.getJWTOptionsForTenant(tenantId, genOpts.expiresIn)
 
 	rawJWT, err := jwt.NewRawJWT(opts)
 
@@ -69,10 +145,13 @@ func (j *jwtManagerImpl) GenerateTenantToken(tenantId, name string) (string, err
 
 	// write the token to the database
 	_, err = j.tokenRepo.CreateAPIToken(&repository.CreateAPITokenOpts{
-		ID:        tokenId,
-		ExpiresAt: expiresAt,
-		TenantId:  &tenantId,
-		Name:      &name,
+		ID:          tokenId,
+		ExpiresAt:   expiresAt,
+		TenantId:    &tenantId,
+		Name:        &name,
+		Scopes:      genOpts.scopes,
+		Environment: genOpts.environment,
+		Namespace:   genOpts.namespace,
 	})
 
 	if err != nil {
@@ -82,7 +161,7 @@ func (j *jwtManagerImpl) GenerateTenantToken(tenantId, name string) (string, err
 	return token, nil
 }
 
-func (j *jwtManagerImpl) ValidateTenantToken(token string) (tenantId string, err error) {
+func (j *jwtManagerImpl) ValidateTenantToken(token string, scope Scope) (*ValidatedToken, error) {
 	// Verify the signed token.
 	audience := j.opts.Audience
 
@@ -94,24 +173,24 @@ func (j *jwtManagerImpl) ValidateTenantToken(token string) (tenantId string, err
 	})
 
 	if err != nil {
-		return "", fmt.Errorf("failed to create JWT Validator: %v", err)
+		return nil, fmt.Errorf("failed to create JWT Validator: %v", err)
 	}
 
 	verifiedJwt, err := j.verifier.VerifyAndDecode(token, validator)
 
 	if err != nil {
-		return "", fmt.Errorf("failed to verify and decode JWT: %v", err)
+		return nil, fmt.Errorf("failed to verify and decode JWT: %v", err)
 	}
 
 	// Read the token from the database and make sure it's not revoked
 	if hasTokenId := verifiedJwt.HasStringClaim("token_id"); !hasTokenId {
-		return "", fmt.Errorf("token does not have token_id claim")
+		return nil, fmt.Errorf("token does not have token_id claim")
 	}
 
 	tokenId, err := verifiedJwt.StringClaim("token_id")
 
 	if err != nil {
-		return "", fmt.Errorf("failed to read token_id claim: %v", err)
+		return nil, fmt.Errorf("failed to read token_id claim: %v", err)
 	}
 
 	// ensure the current server url and grpc broadcast address match the token, if present
@@ -119,11 +198,11 @@ func (j *jwtManagerImpl) ValidateTenantToken(token string) (tenantId string, err
 		serverURL, err := verifiedJwt.StringClaim("server_url")
 
 		if err != nil {
-			return "", fmt.Errorf("failed to read server_url claim: %v", err)
+			return nil, fmt.Errorf("failed to read server_url claim: %v", err)
 		}
 
 		if serverURL != j.opts.ServerURL {
-			return "", fmt.Errorf("server_url claim does not match")
+			return nil, fmt.Errorf("server_url claim does not match")
 		}
 	}
 
@@ -131,11 +210,11 @@ func (j *jwtManagerImpl) ValidateTenantToken(token string) (tenantId string, err
 		grpcBroadcastAddress, err := verifiedJwt.StringClaim("grpc_broadcast_address")
 
 		if err != nil {
-			return "", fmt.Errorf("failed to read grpc_broadcast_address claim: %v", err)
+			return nil, fmt.Errorf("failed to read grpc_broadcast_address claim: %v", err)
 		}
 
 		if grpcBroadcastAddress != j.opts.GRPCBroadcastAddress {
-			return "", fmt.Errorf("grpc_broadcast_address claim does not match")
+			return nil, fmt.Errorf("grpc_broadcast_address claim does not match")
 		}
 	}
 
@@ -143,33 +222,44 @@ func (j *jwtManagerImpl) ValidateTenantToken(token string) (tenantId string, err
 	dbToken, err := j.tokenRepo.GetAPITokenById(tokenId)
 
 	if err != nil {
-		return "", fmt.Errorf("failed to read token from database: %v", err)
+		return nil, fmt.Errorf("failed to read token from database: %v", err)
 	}
 
 	if dbToken.Revoked {
-		return "", fmt.Errorf("token has been revoked")
+		return nil, fmt.Errorf("token has been revoked")
 	}
 
 	if expiresAt, ok := dbToken.ExpiresAt(); ok && expiresAt.Before(time.Now()) {
-		return "", fmt.Errorf("token has expired")
+		return nil, fmt.Errorf("token has expired")
+	}
+
+	if !hasScope(dbToken.Scopes, scope) {
+		return nil, fmt.Errorf("token does not have the %s scope", scope)
 	}
 
 	// ensure the subject of the token matches the tenantId
 	if hasSubject := verifiedJwt.HasSubject(); !hasSubject {
-		return "", fmt.Errorf("token does not have subject claim")
+		return nil, fmt.Errorf("token does not have subject claim")
 	}
 
 	subject, err := verifiedJwt.Subject()
 
 	if err != nil {
-		return "", fmt.Errorf("failed to read subject claim: %v", err)
+		return nil, fmt.Errorf("failed to read subject claim: %v", err)
+	}
+
+	res := &ValidatedToken{
+		TenantId: subject,
 	}
 
-	return subject, nil
+	res.Environment, _ = dbToken.Environment()
+	res.Namespace, _ = dbToken.Namespace()
+
+	return res, nil
 }
 
-func (j *jwtManagerImpl) getJWTOptionsForTenant(tenantId string) (tokenId string, expiresAt time.Time, opts *jwt.RawJWTOptions) {
-	expiresAt = time.Now().Add(90 * 24 * time.Hour)
+func (j *jwtManagerImpl) getJWTOptionsForTenant(tenantId string, expiresIn time.Duration) (tokenId string, expiresAt time.Time, opts *jwt.RawJWTOptions) {
+	expiresAt = time.Now().Add(expiresIn)
 	iAt := time.Now()
 	audience := j.opts.Audience
 	subject := tenantId
@@ -39,17 +39,17 @@ func TestCreateTenantToken(t *testing.T) {
 			t.Fatal(err.Error())
 		}
 
-		token, err := jwtManager.GenerateTenantToken(tenantId, "test token")
+		tok, err := jwtManager.GenerateTenantToken(tenantId, "test token")
 
 		if err != nil {
 			t.Fatal(err.Error())
 		}
 
 		// validate the token
-		newTenantId, err := jwtManager.ValidateTenantToken(token)
+		validatedToken, err := jwtManager.ValidateTenantToken(tok, token.ScopeRead)
 
 		assert.NoError(t, err)
-		assert.Equal(t, tenantId, newTenantId)
+		assert.Equal(t, tenantId, validatedToken.TenantId)
 
 		return nil
 	})
@@ -78,19 +78,19 @@ func TestRevokeTenantToken(t *testing.T) {
 			t.Fatal(err.Error())
 		}
 
-		token, err := jwtManager.GenerateTenantToken(tenantId, "test token")
+		tok, err := jwtManager.GenerateTenantToken(tenantId, "test token")
 
 		if err != nil {
 			t.Fatal(err.Error())
 		}
 
 		// validate the token
-		_, err = jwtManager.ValidateTenantToken(token)
+		_, err = jwtManager.ValidateTenantToken(tok, token.ScopeRead)
 
 		assert.NoError(t, err)
 
 		// revoke the token
-		apiTokens, err := conf.Repository.APIToken().ListAPITokensByTenant(tenantId)
+		apiTokens, err := conf.Repository.APIToken().ListAPITokensByTenant(tenantId, &repository.ListAPITokensOpts{})
 
 		if err != nil {
 			t.Fatal(err.Error())
@@ -104,7 +104,7 @@ func TestRevokeTenantToken(t *testing.T) {
 		}
 
 		// validate the token again
-		_, err = jwtManager.ValidateTenantToken(token)
+		_, err = jwtManager.ValidateTenantToken(tok, token.ScopeRead)
 
 		assert.Error(t, err)
 
@@ -112,6 +112,132 @@ func TestRevokeTenantToken(t *testing.T) {
 	})
 }
 
+func TestScopedTenantToken(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		jwtManager := getJWTManager(t, conf)
+
+		tenantId := uuid.New().String()
+
+		// create the tenant
+		slugSuffix, err := encryption.GenerateRandomBytes(8)
+
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		_, err = conf.Repository.Tenant().CreateTenant(&repository.CreateTenantOpts{
+			ID:   &tenantId,
+			Name: "test-tenant",
+			Slug: fmt.Sprintf("test-tenant-%s", slugSuffix),
+		})
+
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		tok, err := jwtManager.GenerateTenantToken(tenantId, "test token", token.WithScopes([]string{"read"}))
+
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		// the token can only be used for read requests
+		_, err = jwtManager.ValidateTenantToken(tok, token.ScopeRead)
+
+		assert.NoError(t, err)
+
+		_, err = jwtManager.ValidateTenantToken(tok, token.ScopeWrite)
+
+		assert.Error(t, err)
+
+		_, err = jwtManager.ValidateTenantToken(tok, token.ScopeWorker)
+
+		assert.Error(t, err)
+
+		return nil
+	})
+}
+
+func TestEnvironmentTenantToken(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		jwtManager := getJWTManager(t, conf)
+
+		tenantId := uuid.New().String()
+
+		// create the tenant
+		slugSuffix, err := encryption.GenerateRandomBytes(8)
+
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		_, err = conf.Repository.Tenant().CreateTenant(&repository.CreateTenantOpts{
+			ID:   &tenantId,
+			Name: "test-tenant",
+			Slug: fmt.Sprintf("test-tenant-%s", slugSuffix),
+		})
+
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		tok, err := jwtManager.GenerateTenantToken(tenantId, "test token", token.WithEnvironment("staging"))
+
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		validatedToken, err := jwtManager.ValidateTenantToken(tok, token.ScopeRead)
+
+		assert.NoError(t, err)
+		assert.Equal(t, tenantId, validatedToken.TenantId)
+		assert.Equal(t, "staging", validatedToken.Environment)
+		assert.Empty(t, validatedToken.Namespace)
+
+		return nil
+	})
+}
+
+func TestNamespaceTenantToken(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		jwtManager := getJWTManager(t, conf)
+
+		tenantId := uuid.New().String()
+
+		// create the tenant
+		slugSuffix, err := encryption.GenerateRandomBytes(8)
+
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		_, err = conf.Repository.Tenant().CreateTenant(&repository.CreateTenantOpts{
+			ID:   &tenantId,
+			Name: "test-tenant",
+			Slug: fmt.Sprintf("test-tenant-%s", slugSuffix),
+		})
+
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		tok, err := jwtManager.GenerateTenantToken(tenantId, "test token", token.WithNamespace("payments"))
+
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		validatedToken, err := jwtManager.ValidateTenantToken(tok, token.ScopeRead)
+
+		assert.NoError(t, err)
+		assert.Equal(t, tenantId, validatedToken.TenantId)
+		assert.Equal(t, "payments", validatedToken.Namespace)
+		assert.Empty(t, validatedToken.Environment)
+
+		return nil
+	})
+}
+
 func getJWTManager(t *testing.T, conf *database.Config) token.JWTManager {
 	t.Helper()
 
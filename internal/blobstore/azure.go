@@ -0,0 +1,319 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type AzureConfig struct {
+	// AccountName is the name of the storage account
+	AccountName string
+
+	// AccountKey is the base64-encoded shared key of the storage account
+	AccountKey string
+
+	// Container is the container which objects are written to
+	Container string
+
+	// (optional) Endpoint is the blob endpoint of the storage account, which defaults to
+	// https://<account>.blob.core.windows.net. It can be set for sovereign clouds, or for Azurite in the form
+	// http://127.0.0.1:10000/<account>.
+	Endpoint string
+}
+
+func (c *AzureConfig) validate() error {
+	if c.AccountName == "" || c.AccountKey == "" {
+		return fmt.Errorf("account name and account key are required")
+	}
+
+	if c.Container == "" {
+		return fmt.Errorf("container is required")
+	}
+
+	return validateEndpoint(c.Endpoint)
+}
+
+// azureStorageVersion is the version of the Blob Storage REST API which requests are made with.
+const azureStorageVersion = "2021-08-06"
+
+// azureStore writes each object as a block blob, signed with the shared key of the storage account. Objects of a
+// known size are written in a single request, and bodies of unknown size as a list of blocks.
+type azureStore struct {
+	endpoint    string
+	accountName string
+	accountKey  []byte
+	container   string
+	client      *http.Client
+
+	// blockSize is the size of the blocks which bodies of unknown size are uploaded in
+	blockSize int
+
+	now func() time.Time
+}
+
+func newAzureStore(c *AzureConfig, client *http.Client) (*azureStore, error) {
+	accountKey, err := base64.StdEncoding.DecodeString(c.AccountKey)
+
+	if err != nil {
+		return nil, fmt.Errorf("account key must be base64-encoded: %w", err)
+	}
+
+	endpoint := c.Endpoint
+
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", c.AccountName)
+	}
+
+	return &azureStore{
+		endpoint:    strings.TrimSuffix(endpoint, "/"),
+		accountName: c.AccountName,
+		accountKey:  accountKey,
+		container:   c.Container,
+		client:      client,
+		blockSize:   defaultPartSize,
+		now:         time.Now,
+	}, nil
+}
+
+func (s *azureStore) Put(ctx context.Context, key string, body io.Reader, size int64) error {
+	res, err := s.do(ctx, http.MethodPut, key, nil, body, size)
+
+	if err != nil {
+		return fmt.Errorf("could not put blob: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	return checkResponse(res)
+}
+
+// PutStream writes a body which fits in one block with a single request. Larger bodies are uploaded block by block,
+// and committed with the list of their blocks. Blocks which are never committed are discarded by Azure after a week.
+func (s *azureStore) PutStream(ctx context.Context, key string, body io.Reader) error {
+	buf := make([]byte, s.blockSize)
+
+	n, done, err := readPart(body, buf)
+
+	if err != nil {
+		return err
+	}
+
+	if done {
+		return s.Put(ctx, key, bytes.NewReader(buf[:n]), int64(n))
+	}
+
+	blockList := &azureBlockList{}
+
+	for {
+		// block ids must have the same length within a blob
+		blockId := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%08d", len(blockList.Latest))))
+
+		res, err := s.do(ctx, http.MethodPut, key, url.Values{
+			"comp":    {"block"},
+			"blockid": {blockId},
+		}, bytes.NewReader(buf[:n]), int64(n))
+
+		if err != nil {
+			return fmt.Errorf("could not put block: %w", err)
+		}
+
+		_, _ = io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+
+		if err := checkResponse(res); err != nil {
+			return fmt.Errorf("could not put block: %w", err)
+		}
+
+		blockList.Latest = append(blockList.Latest, blockId)
+
+		if done {
+			break
+		}
+
+		n, done, err = readPart(body, buf)
+
+		if err != nil {
+			return err
+		}
+
+		// the body ended at the end of the previous block
+		if n == 0 {
+			break
+		}
+	}
+
+	data, err := xml.Marshal(blockList)
+
+	if err != nil {
+		return fmt.Errorf("could not encode block list: %w", err)
+	}
+
+	res, err := s.do(ctx, http.MethodPut, key, url.Values{"comp": {"blocklist"}}, bytes.NewReader(data), int64(len(data)))
+
+	if err != nil {
+		return fmt.Errorf("could not put block list: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	return checkResponse(res)
+}
+
+type azureBlockList struct {
+	XMLName xml.Name `xml:"BlockList"`
+	Latest  []string `xml:"Latest"`
+}
+
+func (s *azureStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	res, err := s.do(ctx, http.MethodGet, key, nil, nil, 0)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get blob: %w", err)
+	}
+
+	if err := checkResponse(res); err != nil {
+		res.Body.Close()
+		return nil, err
+	}
+
+	return res.Body, nil
+}
+
+func (s *azureStore) Delete(ctx context.Context, key string) error {
+	res, err := s.do(ctx, http.MethodDelete, key, nil, nil, 0)
+
+	if err != nil {
+		return fmt.Errorf("could not delete blob: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if err := checkResponse(res); err != nil && err != ErrNotFound {
+		return err
+	}
+
+	return nil
+}
+
+func (s *azureStore) do(ctx context.Context, method, key string, query url.Values, body io.Reader, size int64) (*http.Response, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, s.endpoint+escapePath("/"+s.container+"/"+key), body)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create request: %w", err)
+	}
+
+	req.URL.RawQuery = escapeQuery(query)
+
+	if body != nil {
+		req.ContentLength = size
+		req.Header.Set("Content-Type", "application/octet-stream")
+
+		// blocks and block lists are written to a blob which is a block blob already
+		if len(query) == 0 {
+			req.Header.Set("X-Ms-Blob-Type", "BlockBlob")
+		}
+	}
+
+	s.signRequest(req, s.now().UTC())
+
+	return s.client.Do(req)
+}
+
+func (s *azureStore) signRequest(req *http.Request, now time.Time) {
+	req.Header.Set("X-Ms-Date", now.Format(http.TimeFormat))
+	req.Header.Set("X-Ms-Version", azureStorageVersion)
+
+	contentLength := ""
+
+	if req.ContentLength > 0 {
+		contentLength = strconv.FormatInt(req.ContentLength, 10)
+	}
+
+	var msHeaders []string
+
+	for name, values := range req.Header {
+		if name := strings.ToLower(name); strings.HasPrefix(name, "x-ms-") {
+			msHeaders = append(msHeaders, name+":"+strings.Join(values, ","))
+		}
+	}
+
+	sort.Strings(msHeaders)
+
+	stringToSign := strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-Encoding"),
+		req.Header.Get("Content-Language"),
+		contentLength,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		"", // date, which is sent as x-ms-date instead
+		req.Header.Get("If-Modified-Since"),
+		req.Header.Get("If-Match"),
+		req.Header.Get("If-None-Match"),
+		req.Header.Get("If-Unmodified-Since"),
+		req.Header.Get("Range"),
+		strings.Join(msHeaders, "\n"),
+		"/" + s.accountName + req.URL.EscapedPath() + canonicalizedQuery(req.URL.Query()),
+	}, "\n")
+
+	h := hmac.New(sha256.New, s.accountKey)
+	h.Write([]byte(stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"SharedKey %s:%s",
+		s.accountName,
+		base64.StdEncoding.EncodeToString(h.Sum(nil)),
+	))
+}
+
+// canonicalizedQuery is the part of the canonicalized resource of a shared key signature which lists the query
+// parameters, sorted by their lowercase names.
+func canonicalizedQuery(query url.Values) string {
+	names := make([]string, 0, len(query))
+	values := make(map[string][]string, len(query))
+
+	for name, v := range query {
+		name = strings.ToLower(name)
+
+		if _, ok := values[name]; !ok {
+			names = append(names, name)
+		}
+
+		values[name] = append(values[name], v...)
+	}
+
+	sort.Strings(names)
+
+	var b strings.Builder
+
+	for _, name := range names {
+		v := values[name]
+		sort.Strings(v)
+
+		b.WriteString("\n" + name + ":" + strings.Join(v, ","))
+	}
+
+	return b.String()
+}
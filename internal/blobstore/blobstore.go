@@ -0,0 +1,242 @@
+// Package blobstore stores large objects, like the archives of tenant exports, outside of the database. The store
+// is configured per instance, and can be a directory on local disk, S3, GCS or Azure Blob Storage, so that
+// instances without access to a cloud provider can keep their objects on disk.
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrNotFound is returned when an object does not exist.
+var ErrNotFound = errors.New("object not found")
+
+type Kind string
+
+const (
+	KindLocal Kind = "local"
+	KindS3    Kind = "s3"
+	KindGCS   Kind = "gcs"
+	KindAzure Kind = "azure"
+)
+
+// Store reads and writes objects by key. Keys are slash-separated paths relative to the root of the store.
+type Store interface {
+	// Put writes the object with the given key, replacing it if it exists. The body must have the given size.
+	Put(ctx context.Context, key string, body io.Reader, size int64) error
+
+	// PutStream writes the object with the given key from a body of unknown size, replacing it if it exists. The
+	// body is uploaded in parts as it is read, so that only one part is held in memory at a time. The object is
+	// only visible once the body was read to the end.
+	PutStream(ctx context.Context, key string, body io.Reader) error
+
+	// Get returns the body of the object with the given key, which the caller must close. It returns ErrNotFound
+	// if the object does not exist.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete deletes the object with the given key. Deleting an object which does not exist is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+type Config struct {
+	Kind Kind
+
+	// Prefix is prepended to the keys of every object
+	Prefix string
+
+	Local LocalConfig
+
+	// S3 is the config of the S3 and GCS stores. GCS is accessed through its S3-compatible API with HMAC keys.
+	S3 S3Config
+
+	Azure AzureConfig
+}
+
+// defaultPartSize is the size of the parts which bodies of unknown size are uploaded in. S3 requires parts of at
+// least 5 MB, except for the last one.
+const defaultPartSize = 8 << 20
+
+// New returns the store of the kind of the config.
+func New(c *Config) (Store, error) {
+	client := &http.Client{
+		Timeout: 5 * time.Minute,
+	}
+
+	var s Store
+
+	switch c.Kind {
+	case KindLocal:
+		if c.Local.Dir == "" {
+			return nil, fmt.Errorf("dir is required for local object stores")
+		}
+
+		s = newLocalStore(c.Local.Dir)
+	case KindS3, KindGCS:
+		s3 := c.S3
+
+		if c.Kind == KindGCS {
+			if s3.Endpoint == "" {
+				s3.Endpoint = "https://storage.googleapis.com"
+			}
+
+			if s3.Region == "" {
+				s3.Region = "auto"
+			}
+		}
+
+		if err := s3.validate(); err != nil {
+			return nil, err
+		}
+
+		s = newS3Store(&s3, client)
+	case KindAzure:
+		if err := c.Azure.validate(); err != nil {
+			return nil, err
+		}
+
+		var err error
+
+		s, err = newAzureStore(&c.Azure, client)
+
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown object store kind %s", c.Kind)
+	}
+
+	if prefix := strings.Trim(c.Prefix, "/"); prefix != "" {
+		s = &prefixStore{prefix: prefix, s: s}
+	}
+
+	return s, nil
+}
+
+// prefixStore prepends a prefix to the keys of another store.
+type prefixStore struct {
+	prefix string
+	s      Store
+}
+
+func (p *prefixStore) Put(ctx context.Context, key string, body io.Reader, size int64) error {
+	return p.s.Put(ctx, p.prefix+"/"+key, body, size)
+}
+
+func (p *prefixStore) PutStream(ctx context.Context, key string, body io.Reader) error {
+	return p.s.PutStream(ctx, p.prefix+"/"+key, body)
+}
+
+func (p *prefixStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	return p.s.Get(ctx, p.prefix+"/"+key)
+}
+
+func (p *prefixStore) Delete(ctx context.Context, key string) error {
+	return p.s.Delete(ctx, p.prefix+"/"+key)
+}
+
+// validateKey checks that a key is a clean relative path, so that it can't escape the root of the store.
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || path.Clean(key) != key || key == ".." || strings.HasPrefix(key, "../") {
+		return fmt.Errorf("invalid object key %q", key)
+	}
+
+	return nil
+}
+
+func validateEndpoint(endpoint string) error {
+	if endpoint == "" {
+		return nil
+	}
+
+	u, err := url.Parse(endpoint)
+
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("endpoint must be an http or https url")
+	}
+
+	return nil
+}
+
+// readPart reads the next part of a body into buf. It returns the number of bytes which were read, and whether the
+// body ended.
+func readPart(body io.Reader, buf []byte) (int, bool, error) {
+	n, err := io.ReadFull(body, buf)
+
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return n, true, nil
+	}
+
+	if err != nil {
+		return n, false, fmt.Errorf("could not read body: %w", err)
+	}
+
+	return n, false, nil
+}
+
+// checkResponse returns an error if the response does not have a 2xx status, and ErrNotFound if it has a 404 status.
+func checkResponse(res *http.Response) error {
+	if res.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("object store responded with status %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+// escapePath escapes each segment of the path so that only unreserved characters are left as-is, which is the
+// encoding both AWS and Azure sign requests with.
+func escapePath(path string) string {
+	segments := strings.Split(path, "/")
+
+	for i, segment := range segments {
+		segments[i] = escape(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// escapeQuery encodes the query with its keys in sorted order, escaping keys and values like the segments of a path.
+func escapeQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+
+	for key := range query {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	var pairs []string
+
+	for _, key := range keys {
+		for _, value := range query[key] {
+			pairs = append(pairs, escape(key)+"="+escape(value))
+		}
+	}
+
+	return strings.Join(pairs, "&")
+}
+
+func escape(s string) string {
+	var b strings.Builder
+
+	for _, c := range []byte(s) {
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '-' || c == '.' || c == '_' || c == '~' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,354 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{
+			name:   "local",
+			config: Config{Kind: KindLocal, Local: LocalConfig{Dir: t.TempDir()}},
+		},
+		{
+			name:    "local without dir",
+			config:  Config{Kind: KindLocal},
+			wantErr: true,
+		},
+		{
+			name:   "s3",
+			config: Config{Kind: KindS3, S3: S3Config{Bucket: "hatchet", Region: "us-east-1", AccessKeyId: "id", SecretAccessKey: "secret"}},
+		},
+		{
+			name:    "s3 without region",
+			config:  Config{Kind: KindS3, S3: S3Config{Bucket: "hatchet", AccessKeyId: "id", SecretAccessKey: "secret"}},
+			wantErr: true,
+		},
+		{
+			name:   "gcs without region",
+			config: Config{Kind: KindGCS, S3: S3Config{Bucket: "hatchet", AccessKeyId: "id", SecretAccessKey: "secret"}},
+		},
+		{
+			name:   "azure",
+			config: Config{Kind: KindAzure, Azure: AzureConfig{AccountName: "account", AccountKey: "a2V5", Container: "hatchet"}},
+		},
+		{
+			name:    "azure with invalid key",
+			config:  Config{Kind: KindAzure, Azure: AzureConfig{AccountName: "account", AccountKey: "not base64", Container: "hatchet"}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown kind",
+			config:  Config{Kind: "ftp"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(&tt.config)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := New(&Config{Kind: KindLocal, Prefix: "/hatchet/", Local: LocalConfig{Dir: t.TempDir()}})
+
+	require.NoError(t, err)
+
+	_, err = s.Get(ctx, "exports/1.tar.gz")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, s.Put(ctx, "exports/1.tar.gz", strings.NewReader("archive"), 7))
+
+	r, err := s.Get(ctx, "exports/1.tar.gz")
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	assert.Equal(t, "archive", string(body))
+
+	assert.Error(t, s.Put(ctx, "exports/2.tar.gz", strings.NewReader("short"), 7))
+
+	_, err = s.Get(ctx, "exports/2.tar.gz")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, s.Delete(ctx, "exports/1.tar.gz"))
+	require.NoError(t, s.Delete(ctx, "exports/1.tar.gz"))
+
+	_, err = s.Get(ctx, "exports/1.tar.gz")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, s.PutStream(ctx, "exports/3.tar.gz", strings.NewReader("streamed archive")))
+
+	r, err = s.Get(ctx, "exports/3.tar.gz")
+	require.NoError(t, err)
+
+	body, err = io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	assert.Equal(t, "streamed archive", string(body))
+}
+
+func TestValidateKey(t *testing.T) {
+	for _, key := range []string{"a", "a/b.tar.gz", "a/..b"} {
+		assert.NoError(t, validateKey(key), key)
+	}
+
+	for _, key := range []string{"", "/a", "a/", "a//b", "../a", "a/../../b", ".."} {
+		assert.Error(t, validateKey(key), key)
+	}
+}
+
+func TestS3Store(t *testing.T) {
+	var gotReq *http.Request
+	var gotBody []byte
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotReq = r
+		gotBody, _ = io.ReadAll(r.Body)
+
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	s := newS3Store(&S3Config{
+		Bucket:          "hatchet",
+		Region:          "us-east-1",
+		AccessKeyId:     "id",
+		SecretAccessKey: "secret",
+		Endpoint:        srv.URL,
+	}, srv.Client())
+
+	s.now = func() time.Time {
+		return time.Date(2024, 5, 3, 10, 15, 44, 0, time.UTC)
+	}
+
+	ctx := context.Background()
+
+	require.NoError(t, s.Put(ctx, "exports/a b.tar.gz", bytes.NewReader([]byte("archive")), 7))
+
+	assert.Equal(t, http.MethodPut, gotReq.Method)
+	assert.Equal(t, "/hatchet/exports/a%20b.tar.gz", gotReq.URL.EscapedPath())
+	assert.Equal(t, unsignedPayload, gotReq.Header.Get("X-Amz-Content-Sha256"))
+	assert.True(t, strings.HasPrefix(
+		gotReq.Header.Get("Authorization"),
+		"AWS4-HMAC-SHA256 Credential=id/20240503/us-east-1/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=",
+	))
+	assert.Equal(t, "archive", string(gotBody))
+
+	_, err := s.Get(ctx, "exports/a b.tar.gz")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, sha256Hex(nil), gotReq.Header.Get("X-Amz-Content-Sha256"))
+}
+
+func TestS3StorePutStream(t *testing.T) {
+	var gotReqs []string
+	var gotParts []string
+	var gotComplete string
+
+	failPart := ""
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		q := r.URL.Query()
+
+		gotReqs = append(gotReqs, r.Method+" "+r.URL.RawQuery)
+
+		switch {
+		case r.Method == http.MethodPost && q.Has("uploads"):
+			_, _ = w.Write([]byte(`<InitiateMultipartUploadResult><UploadId>upload-1</UploadId></InitiateMultipartUploadResult>`))
+		case r.Method == http.MethodPut && q.Has("partNumber"):
+			if q.Get("partNumber") == failPart {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			gotParts = append(gotParts, string(body))
+			w.Header().Set("ETag", `"etag-`+q.Get("partNumber")+`"`)
+		case r.Method == http.MethodPost:
+			gotComplete = string(body)
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer srv.Close()
+
+	s := newS3Store(&S3Config{
+		Bucket:          "hatchet",
+		Region:          "us-east-1",
+		AccessKeyId:     "id",
+		SecretAccessKey: "secret",
+		Endpoint:        srv.URL,
+	}, srv.Client())
+
+	s.partSize = 4
+
+	ctx := context.Background()
+
+	// a body which fits in one part is written with a single request
+	require.NoError(t, s.PutStream(ctx, "exports/1.tar.gz", strings.NewReader("abc")))
+	assert.Equal(t, []string{"PUT "}, gotReqs)
+
+	gotReqs = nil
+
+	require.NoError(t, s.PutStream(ctx, "exports/2.tar.gz", strings.NewReader("abcdefghij")))
+
+	assert.Equal(t, []string{
+		"POST uploads=",
+		"PUT partNumber=1&uploadId=upload-1",
+		"PUT partNumber=2&uploadId=upload-1",
+		"PUT partNumber=3&uploadId=upload-1",
+		"POST uploadId=upload-1",
+	}, gotReqs)
+	assert.Equal(t, []string{"abcd", "efgh", "ij"}, gotParts)
+	assert.Equal(t, `<CompleteMultipartUpload>`+
+		`<Part><PartNumber>1</PartNumber><ETag>&#34;etag-1&#34;</ETag></Part>`+
+		`<Part><PartNumber>2</PartNumber><ETag>&#34;etag-2&#34;</ETag></Part>`+
+		`<Part><PartNumber>3</PartNumber><ETag>&#34;etag-3&#34;</ETag></Part>`+
+		`</CompleteMultipartUpload>`, gotComplete)
+
+	// a body which ends at the end of a part has no empty last part
+	gotReqs, gotParts = nil, nil
+
+	require.NoError(t, s.PutStream(ctx, "exports/3.tar.gz", strings.NewReader("abcdefgh")))
+	assert.Equal(t, []string{"abcd", "efgh"}, gotParts)
+	assert.Len(t, gotReqs, 4)
+
+	// the upload is aborted if a part fails
+	gotReqs, gotParts = nil, nil
+	failPart = "2"
+
+	assert.Error(t, s.PutStream(ctx, "exports/4.tar.gz", strings.NewReader("abcdefghij")))
+	assert.Equal(t, "DELETE uploadId=upload-1", gotReqs[len(gotReqs)-1])
+}
+
+func TestAzureStore(t *testing.T) {
+	var gotReq *http.Request
+	var gotBody []byte
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotReq = r
+		gotBody, _ = io.ReadAll(r.Body)
+
+		switch r.Method {
+		case http.MethodPut:
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	s, err := newAzureStore(&AzureConfig{
+		AccountName: "account",
+		AccountKey:  base64.StdEncoding.EncodeToString([]byte("key")),
+		Container:   "hatchet",
+		Endpoint:    srv.URL + "/account",
+	}, srv.Client())
+
+	require.NoError(t, err)
+
+	s.now = func() time.Time {
+		return time.Date(2024, 5, 3, 10, 15, 44, 0, time.UTC)
+	}
+
+	ctx := context.Background()
+
+	require.NoError(t, s.Put(ctx, "exports/1.tar.gz", bytes.NewReader([]byte("archive")), 7))
+
+	assert.Equal(t, "/account/hatchet/exports/1.tar.gz", gotReq.URL.Path)
+	assert.Equal(t, "BlockBlob", gotReq.Header.Get("X-Ms-Blob-Type"))
+	assert.Equal(t, "Fri, 03 May 2024 10:15:44 GMT", gotReq.Header.Get("X-Ms-Date"))
+	assert.Equal(t, azureStorageVersion, gotReq.Header.Get("X-Ms-Version"))
+	assert.True(t, strings.HasPrefix(gotReq.Header.Get("Authorization"), "SharedKey account:"))
+	assert.Equal(t, "archive", string(gotBody))
+
+	// deleting a blob which does not exist succeeds
+	require.NoError(t, s.Delete(ctx, "exports/1.tar.gz"))
+	assert.Equal(t, http.MethodDelete, gotReq.Method)
+}
+
+func TestAzureStorePutStream(t *testing.T) {
+	var gotReqs []*http.Request
+	var gotBodies []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		gotReqs = append(gotReqs, r)
+		gotBodies = append(gotBodies, string(body))
+
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	s, err := newAzureStore(&AzureConfig{
+		AccountName: "account",
+		AccountKey:  base64.StdEncoding.EncodeToString([]byte("key")),
+		Container:   "hatchet",
+		Endpoint:    srv.URL + "/account",
+	}, srv.Client())
+
+	require.NoError(t, err)
+
+	s.blockSize = 4
+
+	ctx := context.Background()
+
+	require.NoError(t, s.PutStream(ctx, "exports/1.tar.gz", strings.NewReader("abcdefghij")))
+
+	require.Len(t, gotReqs, 4)
+
+	blockIds := make([]string, 0, 3)
+
+	for i, req := range gotReqs[:3] {
+		assert.Equal(t, "block", req.URL.Query().Get("comp"))
+		assert.Empty(t, req.Header.Get("X-Ms-Blob-Type"))
+
+		blockIds = append(blockIds, req.URL.Query().Get("blockid"))
+
+		assert.Equal(t, []string{"abcd", "efgh", "ij"}[i], gotBodies[i])
+	}
+
+	assert.Equal(t, "blocklist", gotReqs[3].URL.Query().Get("comp"))
+	assert.Equal(t, "<BlockList><Latest>"+strings.Join(blockIds, "</Latest><Latest>")+"</Latest></BlockList>", gotBodies[3])
+}
+
+func TestCanonicalizedQuery(t *testing.T) {
+	assert.Equal(t, "", canonicalizedQuery(nil))
+	assert.Equal(t, "\nblockid:MDA=\ncomp:block", canonicalizedQuery(url.Values{"comp": {"block"}, "blockid": {"MDA="}}))
+}
+
+func TestEscapeQuery(t *testing.T) {
+	assert.Equal(t, "", escapeQuery(nil))
+	assert.Equal(t, "partNumber=1&uploadId=a%2Fb%3D", escapeQuery(url.Values{"uploadId": {"a/b="}, "partNumber": {"1"}}))
+	assert.Equal(t, "uploads=", escapeQuery(url.Values{"uploads": {""}}))
+}
@@ -0,0 +1,120 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+type LocalConfig struct {
+	// Dir is the directory which objects are written to. It is created if it doesn't exist.
+	Dir string
+}
+
+// localStore writes each object to a file below a directory. Objects are written to a temporary file which is
+// renamed once it is complete, so that readers never see a partial object.
+type localStore struct {
+	dir string
+}
+
+func newLocalStore(dir string) *localStore {
+	return &localStore{
+		dir: dir,
+	}
+}
+
+func (s *localStore) path(key string) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
+
+func (s *localStore) Put(ctx context.Context, key string, body io.Reader, size int64) error {
+	return s.put(key, body, size)
+}
+
+func (s *localStore) PutStream(ctx context.Context, key string, body io.Reader) error {
+	return s.put(key, body, -1)
+}
+
+// put writes the body to a temporary file which replaces the object once it is complete. If size is negative, the
+// size of the body is not checked.
+func (s *localStore) put(key string, body io.Reader, size int64) error {
+	p, err := s.path(key)
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+		return fmt.Errorf("could not create directory: %w", err)
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(p), ".tmp-*")
+
+	if err != nil {
+		return fmt.Errorf("could not create file: %w", err)
+	}
+
+	defer os.Remove(f.Name()) // nolint: errcheck
+
+	n, err := io.Copy(f, body)
+
+	if err == nil && size >= 0 && n != size {
+		err = fmt.Errorf("wrote %d bytes, expected %d", n, size)
+	}
+
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return fmt.Errorf("could not write object: %w", err)
+	}
+
+	if err := os.Rename(f.Name(), p); err != nil {
+		return fmt.Errorf("could not write object: %w", err)
+	}
+
+	return nil
+}
+
+func (s *localStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	p, err := s.path(key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(p)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not open object: %w", err)
+	}
+
+	return f, nil
+}
+
+func (s *localStore) Delete(ctx context.Context, key string) error {
+	p, err := s.path(key)
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("could not delete object: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,390 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type S3Config struct {
+	// Bucket is the bucket which objects are written to
+	Bucket string
+
+	// Region is the region of the bucket
+	Region string
+
+	// (optional) Endpoint is the endpoint of an S3-compatible service, which defaults to the AWS endpoint of the
+	// region
+	Endpoint string
+
+	AccessKeyId     string
+	SecretAccessKey string
+}
+
+func (c *S3Config) validate() error {
+	if c.Bucket == "" {
+		return fmt.Errorf("bucket is required")
+	}
+
+	if c.Region == "" {
+		return fmt.Errorf("region is required")
+	}
+
+	if c.AccessKeyId == "" || c.SecretAccessKey == "" {
+		return fmt.Errorf("access key id and secret access key are required")
+	}
+
+	return validateEndpoint(c.Endpoint)
+}
+
+// unsignedPayload is signed instead of the hash of the body when objects are written, so that the body can be
+// streamed instead of being hashed up front.
+const unsignedPayload = "UNSIGNED-PAYLOAD"
+
+// s3Store uses path-style requests signed with AWS signature version 4, so that S3-compatible services like
+// MinIO and the interoperability API of GCS are supported as well.
+type s3Store struct {
+	endpoint        string
+	bucket          string
+	region          string
+	accessKeyId     string
+	secretAccessKey string
+	client          *http.Client
+
+	// partSize is the size of the parts of multipart uploads
+	partSize int
+
+	now func() time.Time
+}
+
+func newS3Store(c *S3Config, client *http.Client) *s3Store {
+	endpoint := c.Endpoint
+
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", c.Region)
+	}
+
+	return &s3Store{
+		endpoint:        strings.TrimSuffix(endpoint, "/"),
+		bucket:          c.Bucket,
+		region:          c.Region,
+		accessKeyId:     c.AccessKeyId,
+		secretAccessKey: c.SecretAccessKey,
+		client:          client,
+		partSize:        defaultPartSize,
+		now:             time.Now,
+	}
+}
+
+func (s *s3Store) Put(ctx context.Context, key string, body io.Reader, size int64) error {
+	res, err := s.do(ctx, http.MethodPut, key, nil, body, size)
+
+	if err != nil {
+		return fmt.Errorf("could not put object: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	return checkResponse(res)
+}
+
+// PutStream writes a body which fits in one part with a single request, and larger bodies with a multipart upload,
+// which is aborted if the body can't be uploaded.
+func (s *s3Store) PutStream(ctx context.Context, key string, body io.Reader) error {
+	buf := make([]byte, s.partSize)
+
+	n, done, err := readPart(body, buf)
+
+	if err != nil {
+		return err
+	}
+
+	if done {
+		return s.Put(ctx, key, bytes.NewReader(buf[:n]), int64(n))
+	}
+
+	uploadId, err := s.createMultipartUpload(ctx, key)
+
+	if err != nil {
+		return err
+	}
+
+	if err := s.uploadParts(ctx, key, uploadId, body, buf, n); err != nil {
+		// the parts which were uploaded are deleted even if the upload was canceled
+		if abortErr := s.abortMultipartUpload(context.WithoutCancel(ctx), key, uploadId); abortErr != nil {
+			return fmt.Errorf("%w (could not abort multipart upload: %s)", err, abortErr.Error())
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+type s3InitiateMultipartUploadResult struct {
+	UploadId string `xml:"UploadId"`
+}
+
+type s3CompleteMultipartUpload struct {
+	XMLName xml.Name          `xml:"CompleteMultipartUpload"`
+	Parts   []s3CompletedPart `xml:"Part"`
+}
+
+type s3CompletedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+type s3Error struct {
+	XMLName xml.Name
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+func (s *s3Store) createMultipartUpload(ctx context.Context, key string) (string, error) {
+	res, err := s.do(ctx, http.MethodPost, key, url.Values{"uploads": {""}}, nil, 0)
+
+	if err != nil {
+		return "", fmt.Errorf("could not create multipart upload: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	if err := checkResponse(res); err != nil {
+		return "", fmt.Errorf("could not create multipart upload: %w", err)
+	}
+
+	result := &s3InitiateMultipartUploadResult{}
+
+	if err := xml.NewDecoder(res.Body).Decode(result); err != nil {
+		return "", fmt.Errorf("could not decode multipart upload: %w", err)
+	}
+
+	if result.UploadId == "" {
+		return "", fmt.Errorf("multipart upload has no upload id")
+	}
+
+	return result.UploadId, nil
+}
+
+// uploadParts uploads the first part which is in buf, and the rest of the body, and completes the upload.
+func (s *s3Store) uploadParts(ctx context.Context, key, uploadId string, body io.Reader, buf []byte, n int) error {
+	complete := &s3CompleteMultipartUpload{}
+	done := false
+
+	for {
+		partNumber := len(complete.Parts) + 1
+
+		res, err := s.do(ctx, http.MethodPut, key, url.Values{
+			"partNumber": {strconv.Itoa(partNumber)},
+			"uploadId":   {uploadId},
+		}, bytes.NewReader(buf[:n]), int64(n))
+
+		if err != nil {
+			return fmt.Errorf("could not upload part %d: %w", partNumber, err)
+		}
+
+		_, _ = io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+
+		if err := checkResponse(res); err != nil {
+			return fmt.Errorf("could not upload part %d: %w", partNumber, err)
+		}
+
+		complete.Parts = append(complete.Parts, s3CompletedPart{
+			PartNumber: partNumber,
+			ETag:       res.Header.Get("ETag"),
+		})
+
+		if done {
+			break
+		}
+
+		n, done, err = readPart(body, buf)
+
+		if err != nil {
+			return err
+		}
+
+		// the body ended at the end of the previous part
+		if n == 0 {
+			break
+		}
+	}
+
+	data, err := xml.Marshal(complete)
+
+	if err != nil {
+		return fmt.Errorf("could not encode parts: %w", err)
+	}
+
+	res, err := s.do(ctx, http.MethodPost, key, url.Values{"uploadId": {uploadId}}, bytes.NewReader(data), int64(len(data)))
+
+	if err != nil {
+		return fmt.Errorf("could not complete multipart upload: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	if err := checkResponse(res); err != nil {
+		return fmt.Errorf("could not complete multipart upload: %w", err)
+	}
+
+	// an upload which fails after it started to complete is reported with an error in the body of a 200 response
+	resBody := &s3Error{}
+
+	if err := xml.NewDecoder(res.Body).Decode(resBody); err == nil && resBody.XMLName.Local == "Error" {
+		return fmt.Errorf("could not complete multipart upload: %s: %s", resBody.Code, resBody.Message)
+	}
+
+	return nil
+}
+
+func (s *s3Store) abortMultipartUpload(ctx context.Context, key, uploadId string) error {
+	res, err := s.do(ctx, http.MethodDelete, key, url.Values{"uploadId": {uploadId}}, nil, 0)
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if err := checkResponse(res); err != nil && err != ErrNotFound {
+		return err
+	}
+
+	return nil
+}
+
+func (s *s3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	res, err := s.do(ctx, http.MethodGet, key, nil, nil, 0)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get object: %w", err)
+	}
+
+	if err := checkResponse(res); err != nil {
+		res.Body.Close()
+		return nil, err
+	}
+
+	return res.Body, nil
+}
+
+func (s *s3Store) Delete(ctx context.Context, key string) error {
+	res, err := s.do(ctx, http.MethodDelete, key, nil, nil, 0)
+
+	if err != nil {
+		return fmt.Errorf("could not delete object: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if err := checkResponse(res); err != nil && err != ErrNotFound {
+		return err
+	}
+
+	return nil
+}
+
+func (s *s3Store) do(ctx context.Context, method, key string, query url.Values, body io.Reader, size int64) (*http.Response, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, s.endpoint+escapePath("/"+s.bucket+"/"+key), body)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create request: %w", err)
+	}
+
+	// the query is sent in the same encoding which it is signed with
+	req.URL.RawQuery = escapeQuery(query)
+
+	payloadHash := sha256Hex(nil)
+
+	if body != nil {
+		req.ContentLength = size
+		req.Header.Set("Content-Type", "application/octet-stream")
+		payloadHash = unsignedPayload
+	}
+
+	s.signRequest(req, payloadHash, s.now().UTC())
+
+	return s.client.Do(req)
+}
+
+func (s *s3Store) signRequest(req *http.Request, payloadHash string, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		"host:" + req.URL.Host,
+		"x-amz-content-sha256:" + payloadHash,
+		"x-amz-date:" + amzDate,
+		"",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", date, s.region)
+
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretAccessKey), date)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.accessKeyId,
+		scope,
+		signedHeaders,
+		signature,
+	))
+}
+
+func sha256Hex(b []byte) string {
+	h := sha256.Sum256(b)
+
+	return hex.EncodeToString(h[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+
+	return h.Sum(nil)
+}
@@ -1,6 +1,9 @@
 package database
 
 import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/spf13/viper"
 
 	"github.com/hatchet-dev/hatchet/internal/config/shared"
@@ -15,11 +18,42 @@ type ConfigFile struct {
 	PostgresDbName   string `mapstructure:"dbName" json:"dbName,omitempty" default:"hatchet"`
 	PostgresSSLMode  string `mapstructure:"sslMode" json:"sslMode,omitempty" default:"disable"`
 
+	// VaultCredentialsPath is the path of a Vault secret with a username and password which override the Postgres
+	// username and password, for example database/creds/hatchet for dynamic credentials from the database secrets
+	// engine. Dynamic credentials are renewed, and replaced once they can't be renewed any longer.
+	VaultCredentialsPath string `mapstructure:"vaultCredentialsPath" json:"vaultCredentialsPath,omitempty"`
+
+	Vault shared.VaultConfigFile `mapstructure:"vault" json:"vault,omitempty"`
+
 	Seed SeedConfigFile `mapstructure:"seed" json:"seed,omitempty"`
 
 	Logger shared.LoggerConfigFile `mapstructure:"logger" json:"logger,omitempty"`
 
 	LogQueries bool `mapstructure:"logQueries" json:"logQueries,omitempty" default:"false"`
+
+	// MaxConns is the maximum number of connections in the pool of the engine
+	MaxConns int32 `mapstructure:"maxConns" json:"maxConns,omitempty" default:"20"`
+
+	// MinConns is the number of connections which the pools keep open when they are idle
+	MinConns int32 `mapstructure:"minConns" json:"minConns,omitempty" default:"0"`
+
+	// MaxConnLifetime is how long a connection is used before it is closed and replaced
+	MaxConnLifetime time.Duration `mapstructure:"maxConnLifetime" json:"maxConnLifetime,omitempty" default:"1h"`
+
+	// MaxConnIdleTime is how long an idle connection is kept open
+	MaxConnIdleTime time.Duration `mapstructure:"maxConnIdleTime" json:"maxConnIdleTime,omitempty" default:"30m"`
+
+	// StatementTimeout is the statement timeout of the connections of the engine. If 0, statements don't time out.
+	StatementTimeout time.Duration `mapstructure:"statementTimeout" json:"statementTimeout,omitempty" default:"0s"`
+
+	// APIMaxConns is the maximum number of connections in the pool of the API server, which is separate from the
+	// pool of the engine so that expensive reads from the dashboard can't starve the engine of connections. If 0,
+	// the API server shares the pool of the engine.
+	APIMaxConns int32 `mapstructure:"apiMaxConns" json:"apiMaxConns,omitempty" default:"20"`
+
+	// APIStatementTimeout is the statement timeout of the connections of the API server. If 0, statements don't
+	// time out.
+	APIStatementTimeout time.Duration `mapstructure:"apiStatementTimeout" json:"apiStatementTimeout,omitempty" default:"0s"`
 }
 
 type SeedConfigFile struct {
@@ -39,6 +73,13 @@ type Config struct {
 
 	Repository repository.Repository
 
+	// APIRepository is the repository of the API server. It uses a separate connection pool from Repository, unless
+	// the API pool is disabled.
+	APIRepository repository.Repository
+
+	// Pools are the connection pools by name, which are used for pool metrics
+	Pools map[string]*pgxpool.Pool
+
 	Seed SeedConfigFile
 }
 
@@ -50,6 +91,15 @@ func BindAllEnv(v *viper.Viper) {
 	_ = v.BindEnv("dbName", "DATABASE_POSTGRES_DB_NAME")
 	_ = v.BindEnv("sslMode", "DATABASE_POSTGRES_SSL_MODE")
 	_ = v.BindEnv("logQueries", "DATABASE_LOG_QUERIES")
+	_ = v.BindEnv("vaultCredentialsPath", "DATABASE_VAULT_CREDENTIALS_PATH")
+
+	_ = v.BindEnv("maxConns", "DATABASE_MAX_CONNS")
+	_ = v.BindEnv("minConns", "DATABASE_MIN_CONNS")
+	_ = v.BindEnv("maxConnLifetime", "DATABASE_MAX_CONN_LIFETIME")
+	_ = v.BindEnv("maxConnIdleTime", "DATABASE_MAX_CONN_IDLE_TIME")
+	_ = v.BindEnv("statementTimeout", "DATABASE_STATEMENT_TIMEOUT")
+	_ = v.BindEnv("apiMaxConns", "DATABASE_API_MAX_CONNS")
+	_ = v.BindEnv("apiStatementTimeout", "DATABASE_API_STATEMENT_TIMEOUT")
 
 	_ = v.BindEnv("seed.adminEmail", "ADMIN_EMAIL")
 	_ = v.BindEnv("seed.adminPassword", "ADMIN_PASSWORD")
@@ -60,4 +110,6 @@ func BindAllEnv(v *viper.Viper) {
 
 	_ = v.BindEnv("logger.level", "DATABASE_LOGGER_LEVEL")
 	_ = v.BindEnv("logger.format", "DATABASE_LOGGER_FORMAT")
+
+	shared.BindVaultEnv(v, "vault")
 }
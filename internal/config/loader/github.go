@@ -0,0 +1,66 @@
+package loader
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hatchet-dev/hatchet/internal/auth/oauth"
+	"github.com/hatchet-dev/hatchet/internal/config/server"
+	"github.com/hatchet-dev/hatchet/internal/encryption"
+	"github.com/hatchet-dev/hatchet/internal/integrations/vcs/github"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+// loadStoredGithubAppConf loads the Github app which was most recently created with the app manifest flow. It returns
+// nil if no Github app was created.
+func loadStoredGithubAppConf(cf *server.ServerConfigFile, repo repository.Repository, enc encryption.EncryptionService) (*github.GithubAppConf, error) {
+	creds, err := repo.Github().ReadLatestGithubAppCredentials()
+
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("could not read github app credentials: %w", err)
+	}
+
+	clientSecret, err := enc.Decrypt(creds.ClientSecret, "github_app_client_secret")
+
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt github app client secret: %w", err)
+	}
+
+	webhookSecret, err := enc.Decrypt(creds.WebhookSecret, "github_app_webhook_secret")
+
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt github app webhook secret: %w", err)
+	}
+
+	privateKey, err := enc.Decrypt(creds.PrivateKey, "github_app_private_key")
+
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt github app private key: %w", err)
+	}
+
+	// the webhook url is the base url of the repository webhooks which Hatchet creates
+	webhookURL := cf.VCS.Github.GithubAppWebhookURL
+
+	if webhookURL == "" {
+		webhookURL = cf.Runtime.ServerURL
+	}
+
+	return github.NewGithubAppConfFromSecret(
+		&oauth.Config{
+			ClientID:     creds.ClientID,
+			ClientSecret: string(clientSecret),
+			Scopes:       []string{"read:user"},
+			BaseURL:      cf.Runtime.ServerURL,
+		},
+		creds.AppSlug,
+		privateKey,
+		string(webhookSecret),
+		webhookURL,
+		int64(creds.AppID),
+	), nil
+}
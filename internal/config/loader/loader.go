@@ -8,28 +8,43 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jackc/pgx/v5/tracelog"
 
 	pgxzero "github.com/jackc/pgx-zerolog"
+	"github.com/rs/zerolog"
 
 	"github.com/hatchet-dev/hatchet/internal/auth/cookie"
 	"github.com/hatchet-dev/hatchet/internal/auth/oauth"
+	"github.com/hatchet-dev/hatchet/internal/auth/spiffe"
 	"github.com/hatchet-dev/hatchet/internal/auth/token"
+	"github.com/hatchet-dev/hatchet/internal/blobstore"
 	clientconfig "github.com/hatchet-dev/hatchet/internal/config/client"
 	"github.com/hatchet-dev/hatchet/internal/config/database"
 	"github.com/hatchet-dev/hatchet/internal/config/loader/loaderutils"
 	"github.com/hatchet-dev/hatchet/internal/config/server"
+	"github.com/hatchet-dev/hatchet/internal/config/shared"
 	"github.com/hatchet-dev/hatchet/internal/encryption"
+	"github.com/hatchet-dev/hatchet/internal/integrations/vault"
 	"github.com/hatchet-dev/hatchet/internal/integrations/vcs"
 	"github.com/hatchet-dev/hatchet/internal/integrations/vcs/github"
 	"github.com/hatchet-dev/hatchet/internal/logger"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue/inmemory"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue/rabbitmq"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/backpressure"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/features"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/limits"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/settings"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/webhooks"
 	"github.com/hatchet-dev/hatchet/internal/validator"
 	"github.com/hatchet-dev/hatchet/pkg/client"
 	"github.com/hatchet-dev/hatchet/pkg/errors"
@@ -82,13 +97,12 @@ func (c *ConfigLoader) LoadDatabaseConfig() (res *database.Config, err error) {
 	return GetDatabaseConfigFromConfigFile(cf)
 }
 
-// LoadServerConfig loads the server configuration
-func (c *ConfigLoader) LoadServerConfig() (cleanup func() error, res *server.ServerConfig, err error) {
+// LoadServerConfig loads the server configuration. The overrides are applied to the server config file
+// before the server is configured.
+func (c *ConfigLoader) LoadServerConfig(overrides ...func(cf *server.ServerConfigFile)) (cleanup func() error, res *server.ServerConfig, err error) {
 	log.Printf("Loading server config from %s", c.directory)
-	sharedFilePath := filepath.Join(c.directory, "server.yaml")
-	log.Printf("Shared file path: %s", sharedFilePath)
 
-	configFileBytes, err := loaderutils.GetConfigBytes(sharedFilePath)
+	cf, err := c.loadServerConfigFile(overrides...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -98,21 +112,63 @@ func (c *ConfigLoader) LoadServerConfig() (cleanup func() error, res *server.Ser
 		return nil, nil, err
 	}
 
+	return getServerConfigFromConfigfile(dc, cf, func() (*server.ServerConfigFile, error) {
+		return c.loadServerConfigFile(overrides...)
+	})
+}
+
+func (c *ConfigLoader) loadServerConfigFile(overrides ...func(cf *server.ServerConfigFile)) (*server.ServerConfigFile, error) {
+	sharedFilePath := filepath.Join(c.directory, "server.yaml")
+	log.Printf("Shared file path: %s", sharedFilePath)
+
+	configFileBytes, err := loaderutils.GetConfigBytes(sharedFilePath)
+	if err != nil {
+		return nil, err
+	}
+
 	cf, err := LoadServerConfigFile(configFileBytes...)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	return GetServerConfigFromConfigfile(dc, cf)
+	for _, override := range overrides {
+		override(cf)
+	}
+
+	return cf, nil
 }
 
 func GetDatabaseConfigFromConfigFile(cf *database.ConfigFile) (res *database.Config, err error) {
 	l := logger.NewStdErr(&cf.Logger, "database")
 
+	username, password := cf.PostgresUsername, cf.PostgresPassword
+
+	var vaultClient *vault.Client
+	var creds *vault.Credentials
+
+	if cf.VaultCredentialsPath != "" {
+		vaultClient, err = vault.New(&cf.Vault, &l)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not create vault client: %w", err)
+		}
+
+		creds, err = vaultClient.Credentials(context.Background(), cf.VaultCredentialsPath)
+
+		if err != nil {
+			vaultClient.Close()
+			return nil, fmt.Errorf("could not read database credentials from vault: %w", err)
+		}
+
+		username, password = creds.Get()
+	}
+
+	// prisma connects with the credentials which were read at boot, so dynamic credentials from Vault should have
+	// a max TTL which is longer than the engine runs for, or the engine should be restarted before it is reached
 	databaseUrl := fmt.Sprintf(
 		"postgresql://%s:%s@%s:%d/%s?sslmode=%s",
-		cf.PostgresUsername,
-		cf.PostgresPassword,
+		username,
+		password,
 		cf.PostgresHost,
 		cf.PostgresPort,
 		cf.PostgresDbName,
@@ -129,34 +185,110 @@ func GetDatabaseConfigFromConfigFile(cf *database.ConfigFile) (res *database.Con
 		return nil, err
 	}
 
+	pool, err := newPool(databaseUrl, cf, &l, creds, cf.MaxConns, cf.StatementTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to database: %w", err)
+	}
+
+	pools := map[string]*pgxpool.Pool{
+		"engine": pool,
+	}
+
+	repo := prisma.NewPrismaRepository(c, pool, prisma.WithLogger(&l))
+	apiRepo := repo
+
+	if cf.APIMaxConns > 0 {
+		apiPool, err := newPool(databaseUrl, cf, &l, creds, cf.APIMaxConns, cf.APIStatementTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("could not connect to database: %w", err)
+		}
+
+		pools["api"] = apiPool
+		apiRepo = prisma.NewPrismaRepository(c, apiPool, prisma.WithLogger(&l))
+	}
+
+	return &database.Config{
+		Disconnect: func() error {
+			for _, pool := range pools {
+				pool.Close()
+			}
+
+			if vaultClient != nil {
+				vaultClient.Close()
+			}
+
+			return c.Prisma.Disconnect()
+		},
+		Repository:    repo,
+		APIRepository: apiRepo,
+		Pools:         pools,
+		Seed:          cf.Seed,
+	}, nil
+}
+
+func newPool(databaseUrl string, cf *database.ConfigFile, l *zerolog.Logger, creds *vault.Credentials, maxConns int32, statementTimeout time.Duration) (*pgxpool.Pool, error) {
 	config, err := pgxpool.ParseConfig(databaseUrl)
 	if err != nil {
 		return nil, err
 	}
 
+	if creds != nil {
+		// new connections use the current credentials from Vault, which are replaced before their lease ends
+		config.BeforeConnect = func(ctx context.Context, cc *pgx.ConnConfig) error {
+			cc.User, cc.Password = creds.Get()
+			return nil
+		}
+	}
+
 	if cf.LogQueries {
 		config.ConnConfig.Tracer = &tracelog.TraceLog{
-			Logger:   pgxzero.NewLogger(l),
+			Logger:   pgxzero.NewLogger(*l),
 			LogLevel: tracelog.LogLevelDebug,
 		}
 	}
 
-	config.MaxConns = 20
-
-	pool, err := pgxpool.NewWithConfig(context.Background(), config)
-	if err != nil {
-		return nil, fmt.Errorf("could not connect to database: %w", err)
+	if statementTimeout > 0 {
+		config.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(statementTimeout.Milliseconds(), 10)
 	}
 
-	return &database.Config{
-		Disconnect: c.Prisma.Disconnect,
-		Repository: prisma.NewPrismaRepository(c, pool, prisma.WithLogger(&l)),
-		Seed:       cf.Seed,
-	}, nil
+	config.MaxConns = maxConns
+	config.MinConns = cf.MinConns
+	config.MaxConnLifetime = cf.MaxConnLifetime
+	config.MaxConnIdleTime = cf.MaxConnIdleTime
+
+	return pgxpool.NewWithConfig(context.Background(), config)
 }
 
 func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigFile) (cleanup func() error, res *server.ServerConfig, err error) {
-	l := logger.NewStdErr(&cf.Logger, "server")
+	return getServerConfigFromConfigfile(dc, cf, func() (*server.ServerConfigFile, error) {
+		return nil, fmt.Errorf("the server config was not loaded from a config directory")
+	})
+}
+
+// getServerConfigFromConfigfile configures the server from a config file. The load function loads the config
+// file again when the server config is reloaded.
+func getServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigFile, load func() (*server.ServerConfigFile, error)) (cleanup func() error, res *server.ServerConfig, err error) {
+	if err := server.ValidateReloadable(cf); err != nil {
+		return nil, nil, fmt.Errorf("invalid server config: %w", err)
+	}
+
+	logLevel, err := logger.NewLevel(cf.Logger.Level)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not parse log level: %w", err)
+	}
+
+	l := logger.NewStdErrWithLevel(&cf.Logger, "server", logLevel)
+
+	var vaultClient *vault.Client
+
+	if cf.Encryption.VaultPath != "" || cf.MessageQueue.RabbitMQ.VaultCredentialsPath != "" {
+		vaultClient, err = vault.New(&cf.Vault, &l)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not create vault client: %w", err)
+		}
+	}
 
 	tls, err := loaderutils.LoadServerTLSConfig(&cf.TLS)
 
@@ -176,35 +308,130 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 		return nil, nil, fmt.Errorf("could not create session store: %w", err)
 	}
 
-	cleanup1, mq := rabbitmq.New(
-		rabbitmq.WithURL(cf.MessageQueue.RabbitMQ.URL),
-		rabbitmq.WithLogger(&l),
-	)
+	var cleanup1 func() error
+	var mq msgqueue.MessageQueue
+
+	switch cf.MessageQueue.Kind {
+	case "inmemory":
+		cleanup1, mq = inmemory.New(
+			inmemory.WithLogger(&l),
+		)
+	default:
+		codec, err := rabbitmq.ParseCodec(cf.MessageQueue.RabbitMQ.Codec)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		compression, err := rabbitmq.ParseCompression(cf.MessageQueue.RabbitMQ.Compression)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		urlOpt := rabbitmq.WithURL(cf.MessageQueue.RabbitMQ.URL)
+
+		if cf.MessageQueue.RabbitMQ.VaultCredentialsPath != "" {
+			urlOpt, err = getRabbitMQVaultURLOpt(vaultClient, &cf.MessageQueue.RabbitMQ)
+
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		cleanup1, mq = rabbitmq.New(
+			urlOpt,
+			rabbitmq.WithLogger(&l),
+			rabbitmq.WithCodec(codec),
+			rabbitmq.WithCompression(compression, cf.MessageQueue.RabbitMQ.CompressionThreshold),
+		)
+	}
+
+	// the payload limits are swapped out when the server config is reloaded
+	payloadLimits := limits.NewPayloadLimits(getPayloadLimitsOpts(&cf.Limits))
 
 	ingestor, err := ingestor.NewIngestor(
 		ingestor.WithEventRepository(dc.Repository.Event()),
 		ingestor.WithLogRepository(dc.Repository.Log()),
+		ingestor.WithStreamEventRepository(dc.Repository.StreamEvent()),
+		ingestor.WithTenantRepository(dc.Repository.Tenant()),
 		ingestor.WithMessageQueue(mq),
+		ingestor.WithPayloadLimits(payloadLimits),
 	)
 
 	if err != nil {
 		return nil, nil, fmt.Errorf("could not create ingestor: %w", err)
 	}
 
-	var alerter errors.Alerter
+	objectStore, err := getObjectStore(&cf.ObjectStore)
 
-	if cf.Alerting.Sentry.Enabled {
-		alerter, err = sentry.NewSentryAlerter(&sentry.SentryAlerterOpts{
-			DSN:         cf.Alerting.Sentry.DSN,
-			Environment: cf.Alerting.Sentry.Environment,
-		})
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create object store: %w", err)
+	}
+
+	baseAlerter, baseSLABreachAlerter, baseNoWorkerAlerter, baseAnomalyAlerter, err := getAlerters(&cf.Alerting)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the alerters, backpressure thresholds, feature flags and webhook options are swapped out when the server config
+	// is reloaded
+	alerter := errors.NewReloadableAlerter(baseAlerter)
+	slaBreachAlerter := errors.NewReloadableAlerter(baseSLABreachAlerter)
+	noWorkerAlerter := errors.NewReloadableAlerter(baseNoWorkerAlerter)
+	anomalyAlerter := errors.NewReloadableAlerter(baseAnomalyAlerter)
+
+	backpressureChecker := backpressure.NewChecker(dc.Repository.Tenant(), getBackpressureOpts(&cf.Backpressure))
+
+	featureFlagsOpts, err := getFeatureFlagsOpts(&cf.FeatureFlags)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	featureFlags := features.NewFlags(dc.Repository.FeatureFlag(), &l, featureFlagsOpts)
+
+	engineSettings := settings.NewService(dc.Repository.EngineSettings(), &l)
+
+	webhookReceiver := webhooks.NewReceiver(dc.Repository.WebhookDelivery(), getWebhookReceiverOpts(&cf.Webhooks))
+
+	reloader := server.NewReloader(&l, cf, load)
+
+	alerting := cf.Alerting
+
+	reloader.OnReload(func(next *server.ServerConfigFile) error {
+		if next.Alerting != alerting {
+			nextAlerter, nextSLABreachAlerter, nextNoWorkerAlerter, nextAnomalyAlerter, err := getAlerters(&next.Alerting)
+
+			if err != nil {
+				return err
+			}
+
+			alerter.Set(nextAlerter)
+			slaBreachAlerter.Set(nextSLABreachAlerter)
+			noWorkerAlerter.Set(nextNoWorkerAlerter)
+			anomalyAlerter.Set(nextAnomalyAlerter)
+			alerting = next.Alerting
+		}
+
+		if err := logLevel.Set(next.Logger.Level); err != nil {
+			return fmt.Errorf("could not set log level: %w", err)
+		}
+
+		nextFeatureFlagsOpts, err := getFeatureFlagsOpts(&next.FeatureFlags)
 
 		if err != nil {
-			return nil, nil, fmt.Errorf("could not create sentry alerter: %w", err)
+			return err
 		}
-	} else {
-		alerter = errors.NoOpAlerter{}
-	}
+
+		backpressureChecker.SetOpts(getBackpressureOpts(&next.Backpressure))
+		featureFlags.SetOpts(nextFeatureFlagsOpts)
+		payloadLimits.SetOpts(getPayloadLimitsOpts(&next.Limits))
+		webhookReceiver.SetOpts(getWebhookReceiverOpts(&next.Webhooks))
+
+		return nil
+	})
 
 	auth := server.AuthConfig{
 		ConfigFile: cf.Auth,
@@ -229,7 +456,30 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 		auth.GoogleOAuthConfig = gClient
 	}
 
-	encryptionSvc, err := loadEncryptionSvc(cf)
+	if cf.Auth.SPIFFE.Enabled {
+		// SVIDs are verified against the root CA, so without one any certificate would be trusted
+		if cf.TLS.TLSRootCA == "" && cf.TLS.TLSRootCAFile == "" {
+			return nil, nil, fmt.Errorf("TLS root CA with the SPIFFE trust bundle is required for SPIFFE authentication")
+		}
+
+		auth.SPIFFE, err = spiffe.NewAuthenticator(cf.Auth.SPIFFE.Mappings)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not load SPIFFE ID mappings: %w", err)
+		}
+	}
+
+	// the keysets from Vault are only set on a copy of the encryption config, so that they aren't compared when
+	// the server config is reloaded
+	encryptionCf := cf.Encryption
+
+	if cf.Encryption.VaultPath != "" {
+		if err := loadEncryptionKeysetsFromVault(vaultClient, &encryptionCf); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	encryptionSvc, err := loadEncryptionSvc(&encryptionCf)
 
 	if err != nil {
 		return nil, nil, fmt.Errorf("could not load encryption service: %w", err)
@@ -250,29 +500,38 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 	vcsProviders := make(map[vcs.VCSRepositoryKind]vcs.VCSProvider)
 
 	if cf.VCS.Github.Enabled {
-		var err error
-
-		githubAppConf, err := github.NewGithubAppConf(
-			&oauth.Config{
-				ClientID:     cf.VCS.Github.GithubAppClientID,
-				ClientSecret: cf.VCS.Github.GithubAppClientSecret,
-				Scopes:       []string{"read:user"},
-				BaseURL:      cf.Runtime.ServerURL,
-			},
-			cf.VCS.Github.GithubAppName,
-			cf.VCS.Github.GithubAppSecretPath,
-			cf.VCS.Github.GithubAppWebhookSecret,
-			cf.VCS.Github.GithubAppWebhookURL,
-			cf.VCS.Github.GithubAppID,
-		)
+		var githubAppConf *github.GithubAppConf
+
+		if cf.VCS.Github.GithubAppID != "" {
+			githubAppConf, err = github.NewGithubAppConf(
+				&oauth.Config{
+					ClientID:     cf.VCS.Github.GithubAppClientID,
+					ClientSecret: cf.VCS.Github.GithubAppClientSecret,
+					Scopes:       []string{"read:user"},
+					BaseURL:      cf.Runtime.ServerURL,
+				},
+				cf.VCS.Github.GithubAppName,
+				cf.VCS.Github.GithubAppSecretPath,
+				cf.VCS.Github.GithubAppWebhookSecret,
+				cf.VCS.Github.GithubAppWebhookURL,
+				cf.VCS.Github.GithubAppID,
+			)
+		} else {
+			// fall back to the Github app which was created with the app manifest flow, if there is one
+			githubAppConf, err = loadStoredGithubAppConf(cf, dc.Repository, encryptionSvc)
+		}
 
 		if err != nil {
 			return nil, nil, err
 		}
 
-		githubProvider := github.NewGithubVCSProvider(githubAppConf, dc.Repository, cf.Runtime.ServerURL, encryptionSvc)
+		if githubAppConf != nil {
+			githubProvider := github.NewGithubVCSProvider(githubAppConf, dc.Repository, cf.Runtime.ServerURL, encryptionSvc)
 
-		vcsProviders[vcs.VCSRepositoryKindGithub] = githubProvider
+			vcsProviders[vcs.VCSRepositoryKindGithub] = githubProvider
+		} else {
+			l.Warn().Msg("github is enabled, but no github app is configured. Create one with the github app manifest flow and restart the server.")
+		}
 	}
 
 	var internalClient client.Client
@@ -302,6 +561,7 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 			&clientconfig.ClientConfigFile{
 				Token:    token,
 				HostPort: cf.Runtime.GRPCBroadcastAddress,
+				TLS:      getInternalClientTLSConfig(cf),
 			},
 		)
 
@@ -310,42 +570,248 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 		}
 	}
 
+	replication := server.ReplicationConfig{
+		ConfigFile: cf.Replication,
+	}
+
+	var primaryPool *pgxpool.Pool
+
+	if cf.Replication.PrimaryDatabaseURL != "" {
+		primaryPool, err = pgxpool.New(context.Background(), cf.Replication.PrimaryDatabaseURL)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not connect to primary database: %w", err)
+		}
+
+		replication.PrimaryRepository = prisma.NewReplicationRepository(primaryPool, validator.NewDefaultValidator(), &l)
+	}
+
 	cleanup = func() error {
 		log.Printf("cleaning up server config")
 		if err := cleanup1(); err != nil {
-			return fmt.Errorf("error cleaning up rabbitmq: %w", err)
+			return fmt.Errorf("error cleaning up message queue: %w", err)
+		}
+		if primaryPool != nil {
+			primaryPool.Close()
+		}
+		if vaultClient != nil {
+			vaultClient.Close()
 		}
 		return nil
 	}
 
 	return cleanup, &server.ServerConfig{
-		Alerter:        alerter,
-		Runtime:        cf.Runtime,
-		Auth:           auth,
-		Encryption:     encryptionSvc,
-		Config:         dc,
-		MessageQueue:   mq,
-		Services:       cf.Services,
-		Logger:         &l,
-		TLSConfig:      tls,
-		SessionStore:   ss,
-		Validator:      validator.NewDefaultValidator(),
-		Ingestor:       ingestor,
-		OpenTelemetry:  cf.OpenTelemetry,
-		VCSProviders:   vcsProviders,
-		InternalClient: internalClient,
+		Alerter:           alerter,
+		SLABreachAlerter:  slaBreachAlerter,
+		NoWorkerAlerter:   noWorkerAlerter,
+		NoWorkerThreshold: cf.Alerting.NoWorkerThreshold,
+		AnomalyAlerter:    anomalyAlerter,
+		AnomalyWindow:     cf.Alerting.AnomalyWindow,
+		AnomalyThreshold:  cf.Alerting.AnomalyThreshold,
+		Backpressure:      backpressureChecker,
+		FeatureFlags:      featureFlags,
+		EngineSettings:    engineSettings,
+		PayloadLimits:     payloadLimits,
+		ObjectStore:       objectStore,
+		WebhookReceiver:   webhookReceiver,
+		Runtime:           cf.Runtime,
+		Requeue:           cf.Requeue,
+		Concurrency:       cf.Concurrency,
+		Batching:          cf.Batching,
+		Dispatcher:        cf.Dispatcher,
+		Replication:       replication,
+		Reloader:          reloader,
+		Auth:              auth,
+		Encryption:        encryptionSvc,
+		Config:            dc,
+		MessageQueue:      mq,
+		Services:          cf.Services,
+		Logger:            &l,
+		TLSConfig:         tls,
+		SessionStore:      ss,
+		Validator:         validator.NewDefaultValidator(),
+		Ingestor:          ingestor,
+		OpenTelemetry:     cf.OpenTelemetry,
+		VCSProviders:      vcsProviders,
+		InternalClient:    internalClient,
+	}, nil
+}
+
+// getAlerters returns the alerter for errors, the alerter for SLA breaches, the alerter for step runs which wait
+// for a worker and the alerter for anomalies of workflows.
+func getAlerters(cf *server.AlertingConfigFile) (errors.Alerter, errors.Alerter, errors.Alerter, errors.Alerter, error) {
+	var alerter errors.Alerter = errors.NoOpAlerter{}
+
+	if cf.Sentry.Enabled {
+		var err error
+
+		alerter, err = sentry.NewSentryAlerter(&sentry.SentryAlerterOpts{
+			DSN:         cf.Sentry.DSN,
+			Environment: cf.Sentry.Environment,
+		})
+
+		if err != nil {
+			return nil, nil, nil, nil, fmt.Errorf("could not create sentry alerter: %w", err)
+		}
+	}
+
+	var slaBreachAlerter errors.Alerter = errors.NoOpAlerter{}
+
+	if cf.SLABreaches {
+		slaBreachAlerter = alerter
+	}
+
+	var noWorkerAlerter errors.Alerter = errors.NoOpAlerter{}
+
+	if cf.NoWorkerAvailable {
+		noWorkerAlerter = alerter
+	}
+
+	var anomalyAlerter errors.Alerter = errors.NoOpAlerter{}
+
+	if cf.AnomalyDetected {
+		anomalyAlerter = alerter
+	}
+
+	return alerter, slaBreachAlerter, noWorkerAlerter, anomalyAlerter, nil
+}
+
+func getBackpressureOpts(cf *server.BackpressureConfigFile) *backpressure.CheckerOpts {
+	return &backpressure.CheckerOpts{
+		WarnQueueDepth: cf.WarnQueueDepth,
+		ShedQueueDepth: cf.ShedQueueDepth,
+		RetryAfter:     cf.RetryAfter,
+	}
+}
+
+func getFeatureFlagsOpts(cf *server.FeatureFlagsConfigFile) (*features.FlagsOpts, error) {
+	enabled, err := features.ParseFlags(cf.Enabled)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid enabled feature flags: %w", err)
+	}
+
+	disabled, err := features.ParseFlags(cf.Disabled)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid disabled feature flags: %w", err)
+	}
+
+	return &features.FlagsOpts{
+		Enabled:  enabled,
+		Disabled: disabled,
 	}, nil
 }
 
+func getPayloadLimitsOpts(cf *server.LimitsConfigFile) *limits.PayloadLimitsOpts {
+	return &limits.PayloadLimitsOpts{
+		MaxEventPayloadBytes:  cf.MaxEventPayloadBytes,
+		MaxWorkflowInputBytes: cf.MaxWorkflowInputBytes,
+		MaxStepOutputBytes:    cf.MaxStepOutputBytes,
+	}
+}
+
+// getObjectStore returns the object store of the config, or nil if objects are stored in the database.
+func getObjectStore(cf *server.ObjectStoreConfigFile) (blobstore.Store, error) {
+	if cf.Kind == "" {
+		return nil, nil
+	}
+
+	return blobstore.New(&blobstore.Config{
+		Kind:   blobstore.Kind(strings.ToLower(cf.Kind)),
+		Prefix: cf.Prefix,
+		Local: blobstore.LocalConfig{
+			Dir: cf.Local.Dir,
+		},
+		S3: blobstore.S3Config{
+			Bucket:          cf.S3.Bucket,
+			Region:          cf.S3.Region,
+			Endpoint:        cf.S3.Endpoint,
+			AccessKeyId:     cf.S3.AccessKeyId,
+			SecretAccessKey: cf.S3.SecretAccessKey,
+		},
+		Azure: blobstore.AzureConfig{
+			AccountName: cf.Azure.AccountName,
+			AccountKey:  cf.Azure.AccountKey,
+			Container:   cf.Azure.Container,
+			Endpoint:    cf.Azure.Endpoint,
+		},
+	})
+}
+
+func getWebhookReceiverOpts(cf *server.WebhooksConfigFile) *webhooks.ReceiverOpts {
+	return &webhooks.ReceiverOpts{
+		ReplayWindow:      cf.ReplayWindow,
+		DeliveryRetention: cf.DeliveryRetention,
+	}
+}
+
 func getStrArr(v string) []string {
 	return strings.Split(v, " ")
 }
 
-func loadEncryptionSvc(cf *server.ServerConfigFile) (encryption.EncryptionService, error) {
+// getRabbitMQVaultURLOpt reads the credentials of RabbitMQ from Vault, and returns an option which sets them on
+// the URL whenever the message queue connects, so that reconnects use rotated credentials.
+func getRabbitMQVaultURLOpt(vaultClient *vault.Client, cf *server.RabbitMQConfigFile) (rabbitmq.MessageQueueImplOpt, error) {
+	creds, err := vaultClient.Credentials(context.Background(), cf.VaultCredentialsPath)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read rabbitmq credentials from vault: %w", err)
+	}
+
+	rabbitURL := cf.URL
+
+	if _, err := creds.URL(rabbitURL); err != nil {
+		return nil, fmt.Errorf("invalid rabbitmq url: %w", err)
+	}
+
+	return rabbitmq.WithURLFunc(func() string {
+		u, _ := creds.URL(rabbitURL) // nolint: errcheck - the url was parsed above
+		return u
+	}), nil
+}
+
+// loadEncryptionKeysetsFromVault sets the keysets which are stored in the Vault secret at the vault path of the
+// config, overriding the keysets and keyset files of the config.
+func loadEncryptionKeysetsFromVault(vaultClient *vault.Client, cf *server.EncryptionConfigFile) error {
+	secret, err := vaultClient.Read(context.Background(), cf.VaultPath)
+
+	if err != nil {
+		return fmt.Errorf("could not read encryption keysets from vault: %w", err)
+	}
+
+	found := false
+
+	if v := secret.String("masterKeyset"); v != "" {
+		cf.MasterKeyset = v
+		cf.MasterKeysetFile = ""
+		found = true
+	}
+
+	if v := secret.String("privateJWTKeyset"); v != "" {
+		cf.JWT.PrivateJWTKeyset = v
+		cf.JWT.PrivateJWTKeysetFile = ""
+		found = true
+	}
+
+	if v := secret.String("publicJWTKeyset"); v != "" {
+		cf.JWT.PublicJWTKeyset = v
+		cf.JWT.PublicJWTKeysetFile = ""
+		found = true
+	}
+
+	if !found {
+		return fmt.Errorf("vault secret %s has no masterKeyset, privateJWTKeyset or publicJWTKeyset", cf.VaultPath)
+	}
+
+	return nil
+}
+
+func loadEncryptionSvc(cf *server.EncryptionConfigFile) (encryption.EncryptionService, error) {
 	var err error
 
-	hasLocalMasterKeyset := cf.Encryption.MasterKeyset != "" || cf.Encryption.MasterKeysetFile != ""
-	isCloudKMSEnabled := cf.Encryption.CloudKMS.Enabled
+	hasLocalMasterKeyset := cf.MasterKeyset != "" || cf.MasterKeysetFile != ""
+	isCloudKMSEnabled := cf.CloudKMS.Enabled
 
 	if !hasLocalMasterKeyset && !isCloudKMSEnabled {
 		return nil, fmt.Errorf("encryption is required")
@@ -355,17 +821,17 @@ func loadEncryptionSvc(cf *server.ServerConfigFile) (encryption.EncryptionServic
 		return nil, fmt.Errorf("cannot use both encryption and cloud kms")
 	}
 
-	hasJWTKeys := (cf.Encryption.JWT.PublicJWTKeyset != "" || cf.Encryption.JWT.PublicJWTKeysetFile != "") &&
-		(cf.Encryption.JWT.PrivateJWTKeyset != "" || cf.Encryption.JWT.PrivateJWTKeysetFile != "")
+	hasJWTKeys := (cf.JWT.PublicJWTKeyset != "" || cf.JWT.PublicJWTKeysetFile != "") &&
+		(cf.JWT.PrivateJWTKeyset != "" || cf.JWT.PrivateJWTKeysetFile != "")
 
 	if !hasJWTKeys {
 		return nil, fmt.Errorf("jwt encryption is required")
 	}
 
-	privateJWT := cf.Encryption.JWT.PrivateJWTKeyset
+	privateJWT := cf.JWT.PrivateJWTKeyset
 
-	if cf.Encryption.JWT.PrivateJWTKeysetFile != "" {
-		privateJWTBytes, err := loaderutils.GetFileBytes(cf.Encryption.JWT.PrivateJWTKeysetFile)
+	if cf.JWT.PrivateJWTKeysetFile != "" {
+		privateJWTBytes, err := loaderutils.GetFileBytes(cf.JWT.PrivateJWTKeysetFile)
 
 		if err != nil {
 			return nil, fmt.Errorf("could not load private jwt keyset file: %w", err)
@@ -374,10 +840,10 @@ func loadEncryptionSvc(cf *server.ServerConfigFile) (encryption.EncryptionServic
 		privateJWT = string(privateJWTBytes)
 	}
 
-	publicJWT := cf.Encryption.JWT.PublicJWTKeyset
+	publicJWT := cf.JWT.PublicJWTKeyset
 
-	if cf.Encryption.JWT.PublicJWTKeysetFile != "" {
-		publicJWTBytes, err := loaderutils.GetFileBytes(cf.Encryption.JWT.PublicJWTKeysetFile)
+	if cf.JWT.PublicJWTKeysetFile != "" {
+		publicJWTBytes, err := loaderutils.GetFileBytes(cf.JWT.PublicJWTKeysetFile)
 
 		if err != nil {
 			return nil, fmt.Errorf("could not load public jwt keyset file: %w", err)
@@ -389,10 +855,10 @@ func loadEncryptionSvc(cf *server.ServerConfigFile) (encryption.EncryptionServic
 	var encryptionSvc encryption.EncryptionService
 
 	if hasLocalMasterKeyset {
-		masterKeyset := cf.Encryption.MasterKeyset
+		masterKeyset := cf.MasterKeyset
 
-		if cf.Encryption.MasterKeysetFile != "" {
-			masterKeysetBytes, err := loaderutils.GetFileBytes(cf.Encryption.MasterKeysetFile)
+		if cf.MasterKeysetFile != "" {
+			masterKeysetBytes, err := loaderutils.GetFileBytes(cf.MasterKeysetFile)
 
 			if err != nil {
 				return nil, fmt.Errorf("could not load master keyset file: %w", err)
@@ -414,8 +880,8 @@ func loadEncryptionSvc(cf *server.ServerConfigFile) (encryption.EncryptionServic
 
 	if isCloudKMSEnabled {
 		encryptionSvc, err = encryption.NewCloudKMSEncryption(
-			cf.Encryption.CloudKMS.KeyURI,
-			[]byte(cf.Encryption.CloudKMS.CredentialsJSON),
+			cf.CloudKMS.KeyURI,
+			[]byte(cf.CloudKMS.CredentialsJSON),
 			[]byte(privateJWT),
 			[]byte(publicJWT),
 		)
@@ -427,3 +893,40 @@ func loadEncryptionSvc(cf *server.ServerConfigFile) (encryption.EncryptionServic
 
 	return encryptionSvc, nil
 }
+
+// getInternalClientTLSConfig returns the TLS config which the internal client connects to the dispatcher with. When
+// the dispatcher uses mTLS, the internal client presents the internal client certificate, or else the certificate of
+// the server, which must then be valid for client authentication.
+func getInternalClientTLSConfig(cf *server.ServerConfigFile) clientconfig.ClientTLSConfigFile {
+	if cf.TLS.TLSStrategy != "mtls" {
+		return clientconfig.ClientTLSConfigFile{}
+	}
+
+	res := clientconfig.ClientTLSConfigFile{
+		Base: shared.TLSConfigFile{
+			TLSStrategy:   "mtls",
+			TLSCert:       cf.TLS.TLSCert,
+			TLSCertFile:   cf.TLS.TLSCertFile,
+			TLSKey:        cf.TLS.TLSKey,
+			TLSKeyFile:    cf.TLS.TLSKeyFile,
+			TLSRootCA:     cf.TLS.TLSRootCA,
+			TLSRootCAFile: cf.TLS.TLSRootCAFile,
+		},
+	}
+
+	internal := cf.InternalClientTLS
+
+	if internal.TLSCert != "" || internal.TLSCertFile != "" {
+		res.Base.TLSCert = internal.TLSCert
+		res.Base.TLSCertFile = internal.TLSCertFile
+		res.Base.TLSKey = internal.TLSKey
+		res.Base.TLSKeyFile = internal.TLSKeyFile
+	}
+
+	if internal.TLSRootCA != "" || internal.TLSRootCAFile != "" {
+		res.Base.TLSRootCA = internal.TLSRootCA
+		res.Base.TLSRootCAFile = internal.TLSRootCAFile
+	}
+
+	return res
+}
@@ -31,6 +31,17 @@ func LoadClientTLSConfig(tlsConfig *client.ClientTLSConfigFile, serverName strin
 		return nil, fmt.Errorf("invalid TLS strategy: %s", tlsConfig.Base.TLSStrategy)
 	}
 
+	// a client certificate which is read from files is read again when the files change, so that it can be
+	// rotated without restarting the client
+	if tlsConfig.Base.TLSCertFile != "" && tlsConfig.Base.TLSKeyFile != "" {
+		r := newFileReloader(&tlsConfig.Base, res)
+
+		res.Certificates = nil
+		res.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return r.certificate(), nil
+		}
+	}
+
 	return res, nil
 }
 
@@ -59,6 +70,32 @@ func LoadServerTLSConfig(tlsConfig *shared.TLSConfigFile) (*tls.Config, error) {
 		return nil, fmt.Errorf("invalid TLS strategy: %s", tlsConfig.TLSStrategy)
 	}
 
+	// certificates and CAs which are read from files are read again when the files change, so that they can be
+	// rotated without restarting the server
+	if tlsConfig.TLSCertFile != "" && tlsConfig.TLSKeyFile != "" || tlsConfig.TLSRootCAFile != "" {
+		r := newFileReloader(tlsConfig, res)
+
+		if tlsConfig.TLSCertFile != "" && tlsConfig.TLSKeyFile != "" {
+			res.Certificates = nil
+			res.GetCertificate = func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+				return r.certificate(), nil
+			}
+		}
+
+		if tlsConfig.TLSRootCAFile != "" {
+			// client certificates are verified against the current CA after the handshake reads them, rather than
+			// against the static pool of ClientCAs
+			if res.ClientAuth == tls.RequireAndVerifyClientCert {
+				res.ClientAuth = tls.RequireAnyClientCert
+			} else {
+				res.ClientAuth = tls.RequestClientCert
+			}
+
+			res.ClientCAs = nil
+			res.VerifyPeerCertificate = r.verifyClientCertificate
+		}
+	}
+
 	return res, nil
 }
 
@@ -73,6 +110,10 @@ func LoadBaseTLSConfig(tlsConfig *shared.TLSConfigFile) (*tls.Config, *x509.Cert
 		x509Cert, err = tls.LoadX509KeyPair(tlsConfig.TLSCertFile, tlsConfig.TLSKeyFile)
 	}
 
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not load TLS certificate: %w", err)
+	}
+
 	var caBytes []byte
 
 	switch {
@@ -82,6 +123,10 @@ func LoadBaseTLSConfig(tlsConfig *shared.TLSConfigFile) (*tls.Config, *x509.Cert
 		caBytes, err = os.ReadFile(tlsConfig.TLSRootCAFile)
 	}
 
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not read root CA: %w", err)
+	}
+
 	var ca *x509.CertPool
 
 	if len(caBytes) != 0 {
@@ -0,0 +1,165 @@
+package loaderutils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/config/shared"
+)
+
+// reloadInterval is how often the files of certificates and CAs are checked for changes.
+const reloadInterval = 10 * time.Second
+
+// fileReloader serves the certificate and CA of a TLS config, and reads them again when their files change. If
+// the files can't be read, for example while they are being replaced, the previous certificate and CA are served
+// until the next check.
+type fileReloader struct {
+	cf *shared.TLSConfigFile
+
+	mu        sync.Mutex
+	checkedAt time.Time
+	modTimes  map[string]time.Time
+	cert      *tls.Certificate
+	ca        *x509.CertPool
+}
+
+func newFileReloader(cf *shared.TLSConfigFile, initial *tls.Config) *fileReloader {
+	r := &fileReloader{
+		cf:        cf,
+		checkedAt: time.Now(),
+		modTimes:  readModTimes(cf),
+	}
+
+	if len(initial.Certificates) != 0 {
+		r.cert = &initial.Certificates[0]
+	}
+
+	switch {
+	case initial.ClientCAs != nil:
+		r.ca = initial.ClientCAs
+	case initial.RootCAs != nil:
+		r.ca = initial.RootCAs
+	}
+
+	return r
+}
+
+func readModTimes(cf *shared.TLSConfigFile) map[string]time.Time {
+	res := make(map[string]time.Time)
+
+	for _, file := range []string{cf.TLSCertFile, cf.TLSKeyFile, cf.TLSRootCAFile} {
+		if file == "" {
+			continue
+		}
+
+		if info, err := os.Stat(file); err == nil {
+			res[file] = info.ModTime()
+		}
+	}
+
+	return res
+}
+
+func (r *fileReloader) refresh() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Since(r.checkedAt) < reloadInterval {
+		return
+	}
+
+	r.checkedAt = time.Now()
+
+	modTimes := readModTimes(r.cf)
+
+	changed := len(modTimes) != len(r.modTimes)
+
+	for file, modTime := range modTimes {
+		if !r.modTimes[file].Equal(modTime) {
+			changed = true
+		}
+	}
+
+	if !changed {
+		return
+	}
+
+	config, ca, err := LoadBaseTLSConfig(r.cf)
+
+	if err != nil {
+		// the mod times aren't updated, so the files are read again on the next check
+		return
+	}
+
+	if len(config.Certificates) != 0 {
+		r.cert = &config.Certificates[0]
+	}
+
+	if ca != nil {
+		r.ca = ca
+	}
+
+	r.modTimes = modTimes
+}
+
+// certificate returns the current certificate.
+func (r *fileReloader) certificate() *tls.Certificate {
+	r.refresh()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cert == nil {
+		return &tls.Certificate{}
+	}
+
+	return r.cert
+}
+
+// verifyClientCertificate verifies the certificate of a client against the current CA.
+func (r *fileReloader) verifyClientCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	// whether a certificate is required is enforced by the client auth of the config
+	if len(rawCerts) == 0 {
+		return nil
+	}
+
+	certs := make([]*x509.Certificate, len(rawCerts))
+
+	for i, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+
+		if err != nil {
+			return fmt.Errorf("could not parse client certificate: %w", err)
+		}
+
+		certs[i] = cert
+	}
+
+	r.refresh()
+
+	r.mu.Lock()
+	ca := r.ca
+	r.mu.Unlock()
+
+	intermediates := x509.NewCertPool()
+
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         ca,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not verify client certificate: %w", err)
+	}
+
+	return nil
+}
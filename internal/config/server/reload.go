@@ -0,0 +1,183 @@
+package server
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/services/shared/leader"
+)
+
+// ConfigChange is a change to a config option which was applied by a reload.
+type ConfigChange struct {
+	Option string `json:"option"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+}
+
+// Reloader reloads the options of the server config which can be changed while the server is running: the log
+// level, the alerting destinations, the backpressure thresholds, the payload limits, the webhook replay protection and the requeue
+// intervals.
+// Changes to other options are ignored until the server is restarted.
+type Reloader struct {
+	l    *zerolog.Logger
+	load func() (*ServerConfigFile, error)
+
+	mu          sync.Mutex
+	current     *ServerConfigFile
+	subscribers []func(cf *ServerConfigFile) error
+}
+
+// NewReloader returns a reloader for a server which was configured with the current config file. The load function
+// loads the config file again.
+func NewReloader(l *zerolog.Logger, current *ServerConfigFile, load func() (*ServerConfigFile, error)) *Reloader {
+	return &Reloader{
+		l:       l,
+		load:    load,
+		current: current,
+	}
+}
+
+// OnReload registers a function which applies a reloaded config file. It is called on every reload which changes
+// an option, so it should skip the options which have not changed.
+func (r *Reloader) OnReload(f func(cf *ServerConfigFile) error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.subscribers = append(r.subscribers, f)
+}
+
+// Reload loads and validates the config file, and applies the changed options. It returns the changes which were
+// applied.
+func (r *Reloader) Reload() ([]ConfigChange, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	next, err := r.load()
+
+	if err != nil {
+		return nil, fmt.Errorf("could not load server config: %w", err)
+	}
+
+	if err := ValidateReloadable(next); err != nil {
+		return nil, fmt.Errorf("invalid server config: %w", err)
+	}
+
+	changes := diffReloadable(r.current, next)
+
+	if len(changes) == 0 {
+		r.l.Info().Msg("reloaded server config, no options changed")
+		return changes, nil
+	}
+
+	for _, f := range r.subscribers {
+		if err := f(next); err != nil {
+			return nil, fmt.Errorf("could not apply server config: %w", err)
+		}
+	}
+
+	r.current = next
+
+	for _, change := range changes {
+		r.l.Info().Str("option", change.Option).Str("from", change.From).Str("to", change.To).Msg("server config option changed")
+	}
+
+	return changes, nil
+}
+
+// ValidateReloadable validates the options of a config file which can be reloaded.
+func ValidateReloadable(cf *ServerConfigFile) error {
+	if cf.Logger.Level != "" {
+		if _, err := zerolog.ParseLevel(cf.Logger.Level); err != nil {
+			return fmt.Errorf("invalid log level %q", cf.Logger.Level)
+		}
+	}
+
+	if cf.Backpressure.WarnQueueDepth < 0 || cf.Backpressure.ShedQueueDepth < 0 {
+		return fmt.Errorf("backpressure queue depths cannot be negative")
+	}
+
+	if cf.Backpressure.RetryAfter < 0 {
+		return fmt.Errorf("backpressure retry after cannot be negative")
+	}
+
+	if cf.Limits.MaxEventPayloadBytes < 0 || cf.Limits.MaxWorkflowInputBytes < 0 || cf.Limits.MaxStepOutputBytes < 0 {
+		return fmt.Errorf("payload limits cannot be negative")
+	}
+
+	if cf.Webhooks.ReplayWindow < 0 || cf.Webhooks.DeliveryRetention < 0 {
+		return fmt.Errorf("webhook replay window and delivery retention cannot be negative")
+	}
+
+	// the requeue loops renew the leader lease, so they must run more often than the lease expires
+	if err := validateRequeueInterval("requeue.stepRunInterval", cf.Requeue.StepRunInterval); err != nil {
+		return err
+	}
+
+	if err := validateRequeueInterval("requeue.getGroupKeyRunInterval", cf.Requeue.GetGroupKeyRunInterval); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateRequeueInterval(option string, interval time.Duration) error {
+	if err := leader.ValidateInterval(interval); err != nil {
+		return fmt.Errorf("invalid %s: %w", option, err)
+	}
+
+	return nil
+}
+
+type reloadableOption struct {
+	name      string
+	value     func(cf *ServerConfigFile) string
+	sensitive bool
+}
+
+var reloadableOptions = []reloadableOption{
+	{name: "logger.level", value: func(cf *ServerConfigFile) string { return cf.Logger.Level }},
+	{name: "alerting.sentry.enabled", value: func(cf *ServerConfigFile) string { return fmt.Sprint(cf.Alerting.Sentry.Enabled) }},
+	{name: "alerting.sentry.dsn", value: func(cf *ServerConfigFile) string { return cf.Alerting.Sentry.DSN }, sensitive: true},
+	{name: "alerting.sentry.environment", value: func(cf *ServerConfigFile) string { return cf.Alerting.Sentry.Environment }},
+	{name: "alerting.slaBreaches", value: func(cf *ServerConfigFile) string { return fmt.Sprint(cf.Alerting.SLABreaches) }},
+	{name: "alerting.noWorkerAvailable", value: func(cf *ServerConfigFile) string { return fmt.Sprint(cf.Alerting.NoWorkerAvailable) }},
+	{name: "alerting.anomalyDetected", value: func(cf *ServerConfigFile) string { return fmt.Sprint(cf.Alerting.AnomalyDetected) }},
+	{name: "backpressure.warnQueueDepth", value: func(cf *ServerConfigFile) string { return fmt.Sprint(cf.Backpressure.WarnQueueDepth) }},
+	{name: "backpressure.shedQueueDepth", value: func(cf *ServerConfigFile) string { return fmt.Sprint(cf.Backpressure.ShedQueueDepth) }},
+	{name: "backpressure.retryAfter", value: func(cf *ServerConfigFile) string { return cf.Backpressure.RetryAfter.String() }},
+	{name: "limits.maxEventPayloadBytes", value: func(cf *ServerConfigFile) string { return fmt.Sprint(cf.Limits.MaxEventPayloadBytes) }},
+	{name: "limits.maxWorkflowInputBytes", value: func(cf *ServerConfigFile) string { return fmt.Sprint(cf.Limits.MaxWorkflowInputBytes) }},
+	{name: "limits.maxStepOutputBytes", value: func(cf *ServerConfigFile) string { return fmt.Sprint(cf.Limits.MaxStepOutputBytes) }},
+	{name: "webhooks.replayWindow", value: func(cf *ServerConfigFile) string { return cf.Webhooks.ReplayWindow.String() }},
+	{name: "webhooks.deliveryRetention", value: func(cf *ServerConfigFile) string { return cf.Webhooks.DeliveryRetention.String() }},
+	{name: "requeue.stepRunInterval", value: func(cf *ServerConfigFile) string { return cf.Requeue.StepRunInterval.String() }},
+	{name: "requeue.getGroupKeyRunInterval", value: func(cf *ServerConfigFile) string { return cf.Requeue.GetGroupKeyRunInterval.String() }},
+}
+
+func diffReloadable(prev, next *ServerConfigFile) []ConfigChange {
+	changes := []ConfigChange{}
+
+	for _, option := range reloadableOptions {
+		from, to := option.value(prev), option.value(next)
+
+		if from == to {
+			continue
+		}
+
+		// sensitive values are not written to the audit log
+		if option.sensitive {
+			from, to = "<redacted>", "<redacted>"
+		}
+
+		changes = append(changes, ConfigChange{
+			Option: option.name,
+			From:   from,
+			To:     to,
+		})
+	}
+
+	return changes
+}
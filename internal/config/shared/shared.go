@@ -1,5 +1,7 @@
 package shared
 
+import "github.com/spf13/viper"
+
 type TLSConfigFile struct {
 	// TLSStrategy can be "tls" or "mtls"
 	TLSStrategy string `mapstructure:"tlsStrategy" json:"tlsStrategy,omitempty" default:"tls"`
@@ -23,3 +25,61 @@ type OpenTelemetryConfigFile struct {
 	CollectorURL string `mapstructure:"collectorURL" json:"collectorURL,omitempty"`
 	ServiceName  string `mapstructure:"serviceName" json:"serviceName,omitempty" default:"server"`
 }
+
+// VaultConfigFile configures the HashiCorp Vault server which secrets are read from at boot. Vault is only used if
+// an address is set.
+type VaultConfigFile struct {
+	Address   string `mapstructure:"address" json:"address,omitempty"`
+	Namespace string `mapstructure:"namespace" json:"namespace,omitempty"`
+
+	// CACertFile is the path to the CA certificate which the certificate of the Vault server is verified with. If
+	// empty, the system CAs are used.
+	CACertFile string `mapstructure:"caCertFile" json:"caCertFile,omitempty"`
+
+	// AuthMethod can be "token", "approle" or "kubernetes"
+	AuthMethod string `mapstructure:"authMethod" json:"authMethod,omitempty" default:"token"`
+
+	Token     string `mapstructure:"token" json:"token,omitempty"`
+	TokenFile string `mapstructure:"tokenFile" json:"tokenFile,omitempty"`
+
+	AppRole VaultAppRoleConfigFile `mapstructure:"appRole" json:"appRole,omitempty"`
+
+	Kubernetes VaultKubernetesConfigFile `mapstructure:"kubernetes" json:"kubernetes,omitempty"`
+}
+
+func (c *VaultConfigFile) Enabled() bool {
+	return c.Address != ""
+}
+
+type VaultAppRoleConfigFile struct {
+	RoleID       string `mapstructure:"roleId" json:"roleId,omitempty"`
+	SecretID     string `mapstructure:"secretId" json:"secretId,omitempty"`
+	SecretIDFile string `mapstructure:"secretIdFile" json:"secretIdFile,omitempty"`
+	MountPath    string `mapstructure:"mountPath" json:"mountPath,omitempty" default:"approle"`
+}
+
+type VaultKubernetesConfigFile struct {
+	Role string `mapstructure:"role" json:"role,omitempty"`
+
+	// TokenFile is the path to the service account token which Hatchet logs in with
+	TokenFile string `mapstructure:"tokenFile" json:"tokenFile,omitempty" default:"/var/run/secrets/kubernetes.io/serviceaccount/token"`
+	MountPath string `mapstructure:"mountPath" json:"mountPath,omitempty" default:"kubernetes"`
+}
+
+// BindVaultEnv binds the Vault config at the given key to the environment variables which the Vault CLI reads, so
+// that the database and server configs share them.
+func BindVaultEnv(v *viper.Viper, key string) {
+	_ = v.BindEnv(key+".address", "VAULT_ADDR")
+	_ = v.BindEnv(key+".namespace", "VAULT_NAMESPACE")
+	_ = v.BindEnv(key+".caCertFile", "VAULT_CACERT")
+	_ = v.BindEnv(key+".authMethod", "VAULT_AUTH_METHOD")
+	_ = v.BindEnv(key+".token", "VAULT_TOKEN")
+	_ = v.BindEnv(key+".tokenFile", "VAULT_TOKEN_FILE")
+	_ = v.BindEnv(key+".appRole.roleId", "VAULT_APPROLE_ROLE_ID")
+	_ = v.BindEnv(key+".appRole.secretId", "VAULT_APPROLE_SECRET_ID")
+	_ = v.BindEnv(key+".appRole.secretIdFile", "VAULT_APPROLE_SECRET_ID_FILE")
+	_ = v.BindEnv(key+".appRole.mountPath", "VAULT_APPROLE_MOUNT_PATH")
+	_ = v.BindEnv(key+".kubernetes.role", "VAULT_KUBERNETES_ROLE")
+	_ = v.BindEnv(key+".kubernetes.tokenFile", "VAULT_KUBERNETES_TOKEN_FILE")
+	_ = v.BindEnv(key+".kubernetes.mountPath", "VAULT_KUBERNETES_MOUNT_PATH")
+}
@@ -0,0 +1,395 @@
+// Package conformance is a test harness for worker SDKs. The Driver implements the Dispatcher gRPC service without
+// an engine behind it, so that the golden scenarios can assign actions to a worker under test and check the events
+// which the worker sends back: heartbeats, action acks, retries, cancellation and reconnecting the action stream.
+//
+// The worker under test must register the actions in RequiredActions. Run the scenarios with WaitForWorker and Run,
+// and see Scenarios for what each scenario expects.
+package conformance
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/internal/logger"
+	"github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
+)
+
+type RecordKind string
+
+const (
+	RecordRegister        RecordKind = "REGISTER"
+	RecordListen          RecordKind = "LISTEN"
+	RecordListenClosed    RecordKind = "LISTEN_CLOSED"
+	RecordUnsubscribe     RecordKind = "UNSUBSCRIBE"
+	RecordStepEvent       RecordKind = "STEP_EVENT"
+	RecordGroupKeyEvent   RecordKind = "GROUP_KEY_EVENT"
+	RecordUnauthenticated RecordKind = "UNAUTHENTICATED"
+)
+
+// Record is a call of the worker under test to the driver.
+type Record struct {
+	At   time.Time
+	Kind RecordKind
+
+	WorkerId string
+
+	// Register is set for REGISTER records
+	Register *contracts.WorkerRegisterRequest
+
+	// StepEvent is set for STEP_EVENT records
+	StepEvent *contracts.StepActionEvent
+
+	// GroupKeyEvent is set for GROUP_KEY_EVENT records
+	GroupKeyEvent *contracts.GroupKeyActionEvent
+
+	// Method is set for UNAUTHENTICATED records
+	Method string
+}
+
+type listener struct {
+	actions chan *contracts.AssignedAction
+
+	// closed is closed by the driver to end the stream
+	closed chan struct{}
+}
+
+// Driver implements the Dispatcher service for a worker under test, and records every call of the worker.
+type Driver struct {
+	contracts.UnimplementedDispatcherServer
+
+	l *zerolog.Logger
+
+	tenantId string
+	token    string
+
+	mu sync.Mutex
+
+	records []Record
+
+	// changed is closed and replaced whenever a record is added, to wake up waiting scenarios
+	changed chan struct{}
+
+	listeners map[string]*listener
+}
+
+type DriverOpt func(*DriverOpts)
+
+type DriverOpts struct {
+	l        *zerolog.Logger
+	tenantId string
+	token    string
+}
+
+func defaultDriverOpts() *DriverOpts {
+	l := logger.NewDefaultLogger("conformance")
+
+	return &DriverOpts{
+		l:        &l,
+		tenantId: uuid.New().String(),
+	}
+}
+
+func WithLogger(l *zerolog.Logger) DriverOpt {
+	return func(opts *DriverOpts) {
+		opts.l = l
+	}
+}
+
+// WithTenantId sets the tenant id which the driver returns to the worker.
+func WithTenantId(tenantId string) DriverOpt {
+	return func(opts *DriverOpts) {
+		opts.tenantId = tenantId
+	}
+}
+
+// WithToken sets the token which the worker must send as a bearer token on every call.
+func WithToken(token string) DriverOpt {
+	return func(opts *DriverOpts) {
+		opts.token = token
+	}
+}
+
+func NewDriver(fs ...DriverOpt) (*Driver, error) {
+	opts := defaultDriverOpts()
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	if opts.token == "" {
+		return nil, fmt.Errorf("token is required. use WithToken")
+	}
+
+	newLogger := opts.l.With().Str("service", "conformance-driver").Logger()
+
+	return &Driver{
+		l:         &newLogger,
+		tenantId:  opts.tenantId,
+		token:     opts.token,
+		changed:   make(chan struct{}),
+		listeners: map[string]*listener{},
+	}, nil
+}
+
+// Serve serves the Dispatcher service on the listener until the context is cancelled.
+func (d *Driver) Serve(ctx context.Context, lis net.Listener, tlsConfig *tls.Config) error {
+	s := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.UnaryInterceptor(d.unaryAuth),
+		grpc.StreamInterceptor(d.streamAuth),
+	)
+
+	contracts.RegisterDispatcherServer(s, d)
+
+	go func() {
+		<-ctx.Done()
+		s.Stop()
+	}()
+
+	return s.Serve(lis)
+}
+
+// Records returns every call which the driver has recorded.
+func (d *Driver) Records() []Record {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return append([]Record{}, d.records...)
+}
+
+func (d *Driver) Register(ctx context.Context, request *contracts.WorkerRegisterRequest) (*contracts.WorkerRegisterResponse, error) {
+	workerId := uuid.New().String()
+
+	d.record(Record{
+		Kind:     RecordRegister,
+		WorkerId: workerId,
+		Register: request,
+	})
+
+	return &contracts.WorkerRegisterResponse{
+		TenantId:   d.tenantId,
+		WorkerId:   workerId,
+		WorkerName: request.WorkerName,
+	}, nil
+}
+
+func (d *Driver) Listen(request *contracts.WorkerListenRequest, stream contracts.Dispatcher_ListenServer) error {
+	lis := &listener{
+		actions: make(chan *contracts.AssignedAction),
+		closed:  make(chan struct{}),
+	}
+
+	d.mu.Lock()
+
+	// a new stream replaces the previous stream of the worker, like a reconnect to another dispatcher
+	if prev, ok := d.listeners[request.WorkerId]; ok {
+		close(prev.closed)
+	}
+
+	d.listeners[request.WorkerId] = lis
+
+	d.mu.Unlock()
+
+	d.record(Record{
+		Kind:     RecordListen,
+		WorkerId: request.WorkerId,
+	})
+
+	defer func() {
+		d.mu.Lock()
+
+		if d.listeners[request.WorkerId] == lis {
+			delete(d.listeners, request.WorkerId)
+		}
+
+		d.mu.Unlock()
+
+		d.record(Record{
+			Kind:     RecordListenClosed,
+			WorkerId: request.WorkerId,
+		})
+	}()
+
+	for {
+		select {
+		case action := <-lis.actions:
+			if err := stream.Send(action); err != nil {
+				return err
+			}
+		case <-lis.closed:
+			return nil
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (d *Driver) SendStepActionEvent(ctx context.Context, request *contracts.StepActionEvent) (*contracts.ActionEventResponse, error) {
+	d.record(Record{
+		Kind:      RecordStepEvent,
+		WorkerId:  request.WorkerId,
+		StepEvent: request,
+	})
+
+	return &contracts.ActionEventResponse{
+		TenantId: d.tenantId,
+		WorkerId: request.WorkerId,
+	}, nil
+}
+
+func (d *Driver) SendGroupKeyActionEvent(ctx context.Context, request *contracts.GroupKeyActionEvent) (*contracts.ActionEventResponse, error) {
+	d.record(Record{
+		Kind:          RecordGroupKeyEvent,
+		WorkerId:      request.WorkerId,
+		GroupKeyEvent: request,
+	})
+
+	return &contracts.ActionEventResponse{
+		TenantId: d.tenantId,
+		WorkerId: request.WorkerId,
+	}, nil
+}
+
+func (d *Driver) PutOverridesData(ctx context.Context, request *contracts.OverridesData) (*contracts.OverridesDataResponse, error) {
+	return &contracts.OverridesDataResponse{}, nil
+}
+
+func (d *Driver) Unsubscribe(ctx context.Context, request *contracts.WorkerUnsubscribeRequest) (*contracts.WorkerUnsubscribeResponse, error) {
+	d.record(Record{
+		Kind:     RecordUnsubscribe,
+		WorkerId: request.WorkerId,
+	})
+
+	return &contracts.WorkerUnsubscribeResponse{
+		TenantId: d.tenantId,
+		WorkerId: request.WorkerId,
+	}, nil
+}
+
+// send assigns an action to the worker on its current stream.
+func (d *Driver) send(ctx context.Context, workerId string, action *contracts.AssignedAction) error {
+	d.mu.Lock()
+	lis, ok := d.listeners[workerId]
+	d.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("worker %s is not listening", workerId)
+	}
+
+	action.TenantId = d.tenantId
+
+	select {
+	case lis.actions <- action:
+		return nil
+	case <-lis.closed:
+		return fmt.Errorf("stream of worker %s was closed", workerId)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// disconnect ends the current stream of the worker, like a dispatcher which shuts down.
+func (d *Driver) disconnect(workerId string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if lis, ok := d.listeners[workerId]; ok {
+		close(lis.closed)
+		delete(d.listeners, workerId)
+	}
+}
+
+func (d *Driver) record(r Record) {
+	r.At = time.Now()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.l.Debug().Msgf("%s from worker %s", r.Kind, r.WorkerId)
+
+	d.records = append(d.records, r)
+
+	close(d.changed)
+	d.changed = make(chan struct{})
+}
+
+// waitFor waits until a record after the offset matches, and returns the record and the offset after it.
+func (d *Driver) waitFor(ctx context.Context, offset int, match func(r Record) bool) (*Record, int, error) {
+	for {
+		d.mu.Lock()
+
+		for i := offset; i < len(d.records); i++ {
+			if match(d.records[i]) {
+				r := d.records[i]
+				d.mu.Unlock()
+
+				return &r, i + 1, nil
+			}
+		}
+
+		offset = len(d.records)
+		changed := d.changed
+
+		d.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return nil, offset, ctx.Err()
+		}
+	}
+}
+
+// offset returns the number of records, to wait for records which are added after this point.
+func (d *Driver) offset() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return len(d.records)
+}
+
+func (d *Driver) authenticate(ctx context.Context, method string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	for _, v := range md.Get("authorization") {
+		if strings.TrimPrefix(v, "Bearer ") == d.token {
+			return nil
+		}
+	}
+
+	d.record(Record{
+		Kind:   RecordUnauthenticated,
+		Method: method,
+	})
+
+	return status.Error(codes.Unauthenticated, "invalid or missing bearer token")
+}
+
+func (d *Driver) unaryAuth(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := d.authenticate(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (d *Driver) streamAuth(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := d.authenticate(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+
+	return handler(srv, ss)
+}
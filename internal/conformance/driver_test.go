@@ -0,0 +1,171 @@
+package conformance_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	clientconfig "github.com/hatchet-dev/hatchet/internal/config/client"
+	"github.com/hatchet-dev/hatchet/internal/config/shared"
+	"github.com/hatchet-dev/hatchet/internal/conformance"
+	"github.com/hatchet-dev/hatchet/pkg/client"
+	"github.com/hatchet-dev/hatchet/pkg/worker"
+)
+
+type echoInput struct {
+	Message string `json:"message"`
+}
+
+type echoOutput struct {
+	Message string `json:"message"`
+}
+
+// TestGoSDK runs the scenarios against the Go SDK, which is the reference implementation of a worker.
+func TestGoSDK(t *testing.T) {
+	if testing.Short() {
+		t.Skip("the scenarios take about half a minute")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+
+	serverTLS, caPEM := newTestTLS(t)
+
+	l := zerolog.Nop()
+
+	d, err := conformance.NewDriver(
+		conformance.WithLogger(&l),
+		conformance.WithToken("conformance-token"),
+	)
+	require.NoError(t, err)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go func() {
+		_ = d.Serve(ctx, lis, serverTLS)
+	}()
+
+	c, err := client.NewFromConfigFile(&clientconfig.ClientConfigFile{
+		TenantId: "conformance",
+		Token:    "conformance-token",
+		HostPort: lis.Addr().String(),
+		TLS: clientconfig.ClientTLSConfigFile{
+			Base: shared.TLSConfigFile{
+				TLSStrategy: "tls",
+				TLSRootCA:   caPEM,
+			},
+			TLSServerName: "localhost",
+		},
+	})
+	require.NoError(t, err)
+
+	w, err := worker.NewWorker(
+		worker.WithClient(c),
+		worker.WithName("conformance-worker"),
+	)
+	require.NoError(t, err)
+
+	// step runs are run with the middleware of their service
+	w.NewService("conformance")
+
+	require.NoError(t, w.RegisterAction(conformance.ActionEcho, func(ctx worker.HatchetContext) (*echoOutput, error) {
+		input := &echoInput{}
+
+		if err := ctx.WorkflowInput(input); err != nil {
+			return nil, err
+		}
+
+		return &echoOutput{Message: input.Message}, nil
+	}))
+
+	require.NoError(t, w.RegisterAction(conformance.ActionFail, func(ctx context.Context) (*echoOutput, error) {
+		return nil, fmt.Errorf("conformance failure")
+	}))
+
+	require.NoError(t, w.RegisterAction(conformance.ActionWait, func(ctx context.Context) (*echoOutput, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}))
+
+	require.NoError(t, w.RegisterAction(conformance.ActionGroupKey, worker.GetWorkflowConcurrencyGroupFn(func(ctx worker.HatchetContext) (string, error) {
+		input := map[string]string{}
+
+		if err := ctx.WorkflowInput(&input); err != nil {
+			return "", err
+		}
+
+		return input["group"], nil
+	})))
+
+	cleanup, err := w.Start()
+	require.NoError(t, err)
+
+	defer cleanup() // nolint: errcheck
+
+	workerId, err := d.WaitForWorker(ctx)
+	require.NoError(t, err)
+
+	results := conformance.Run(ctx, d, workerId, conformance.Scenarios())
+
+	for _, res := range results {
+		assert.NoError(t, res.Err, "scenario %s", res.Scenario)
+	}
+}
+
+func TestNewDriverRequiresToken(t *testing.T) {
+	_, err := conformance.NewDriver()
+	assert.Error(t, err)
+}
+
+// newTestTLS returns a server TLS config with a self-signed certificate for localhost, and the certificate as the root
+// CA for clients.
+func newTestTLS(t *testing.T) (*tls.Config, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS13,
+	}, string(certPEM)
+}
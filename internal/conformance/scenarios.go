@@ -0,0 +1,617 @@
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
+)
+
+const (
+	// ActionEcho must return the workflow input as its output. The input is an object with a "message" string.
+	ActionEcho = "conformance:echo"
+
+	// ActionFail must fail with an error.
+	ActionFail = "conformance:fail"
+
+	// ActionWait must block until its step run is cancelled.
+	ActionWait = "conformance:wait"
+
+	// ActionGroupKey is a concurrency action which must return the "group" string of the workflow input.
+	ActionGroupKey = "concurrency:conformance"
+)
+
+// RequiredActions are the actions which the worker under test must register.
+var RequiredActions = []string{ActionEcho, ActionFail, ActionWait, ActionGroupKey}
+
+// Scenario is a golden scenario which the worker under test must pass.
+type Scenario struct {
+	Name        string
+	Description string
+
+	// Timeout is the time after which the scenario fails
+	Timeout time.Duration
+
+	run func(ctx context.Context, h *harness) error
+}
+
+// Result is the result of a scenario. Err is nil if the worker passed the scenario.
+type Result struct {
+	Scenario string
+	Err      error
+	Duration time.Duration
+}
+
+// Scenarios returns the golden scenarios, in the order in which they are run.
+func Scenarios() []Scenario {
+	return []Scenario{
+		{
+			Name:        "register",
+			Description: "the worker registers a name and every required action, and authenticates every call with its bearer token",
+			Timeout:     5 * time.Second,
+			run:         runRegister,
+		},
+		{
+			Name:        "heartbeat",
+			Description: "the worker keeps its action stream open, which is how the dispatcher tracks its heartbeat",
+			Timeout:     15 * time.Second,
+			run:         runHeartbeat,
+		},
+		{
+			Name:        "ack",
+			Description: "the worker acks a step run with a STARTED event before it sends a COMPLETED event with the output",
+			Timeout:     10 * time.Second,
+			run:         runAck,
+		},
+		{
+			Name:        "failure",
+			Description: "the worker sends a FAILED event with the error message when a step run fails",
+			Timeout:     10 * time.Second,
+			run:         runFailure,
+		},
+		{
+			Name:        "retry",
+			Description: "the worker runs a step run again when it is assigned again after a failure, as retries keep the step run id",
+			Timeout:     10 * time.Second,
+			run:         runRetry,
+		},
+		{
+			Name:        "concurrent",
+			Description: "the worker runs step runs which are assigned at the same time concurrently",
+			Timeout:     10 * time.Second,
+			run:         runConcurrent,
+		},
+		{
+			Name:        "cancellation",
+			Description: "the worker stops a step run when it is cancelled, and does not send a COMPLETED or FAILED event for it",
+			Timeout:     15 * time.Second,
+			run:         runCancellation,
+		},
+		{
+			Name:        "group-key",
+			Description: "the worker runs a concurrency action and sends the group key in its COMPLETED event",
+			Timeout:     10 * time.Second,
+			run:         runGroupKey,
+		},
+		{
+			Name:        "reconnect",
+			Description: "the worker opens a new action stream with the same worker id when its stream is closed by the server",
+			Timeout:     60 * time.Second,
+			run:         runReconnect,
+		},
+	}
+}
+
+// WaitForWorker waits for a worker which registers the required actions and listens for actions, and returns its
+// worker id.
+func (d *Driver) WaitForWorker(ctx context.Context) (string, error) {
+	register, _, err := d.waitFor(ctx, 0, func(r Record) bool {
+		return r.Kind == RecordRegister && hasActions(r.Register.Actions, RequiredActions)
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("no worker registered the actions %v: %w", RequiredActions, err)
+	}
+
+	_, _, err = d.waitFor(ctx, 0, func(r Record) bool {
+		return r.Kind == RecordListen && r.WorkerId == register.WorkerId
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("worker %s did not listen for actions: %w", register.WorkerId, err)
+	}
+
+	return register.WorkerId, nil
+}
+
+// Run runs the scenarios against the worker, see WaitForWorker.
+func Run(ctx context.Context, d *Driver, workerId string, scenarios []Scenario) []Result {
+	h := &harness{
+		d:        d,
+		workerId: workerId,
+	}
+
+	for _, r := range d.Records() {
+		if r.Kind == RecordRegister && r.WorkerId == workerId {
+			h.register = r.Register
+		}
+	}
+
+	results := make([]Result, 0, len(scenarios))
+
+	for _, s := range scenarios {
+		start := time.Now()
+
+		scenarioCtx, cancel := context.WithTimeout(ctx, s.Timeout)
+		err := s.run(scenarioCtx, h)
+		cancel()
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			err = fmt.Errorf("timed out after %s", s.Timeout)
+		}
+
+		results = append(results, Result{
+			Scenario: s.Name,
+			Err:      err,
+			Duration: time.Since(start),
+		})
+	}
+
+	return results
+}
+
+type harness struct {
+	d        *Driver
+	workerId string
+	register *contracts.WorkerRegisterRequest
+}
+
+type stepInput struct {
+	Message string `json:"message,omitempty"`
+	Group   string `json:"group,omitempty"`
+}
+
+// startStepRun assigns a new step run of the action to the worker.
+func (h *harness) startStepRun(ctx context.Context, actionId string, input stepInput) (*contracts.AssignedAction, error) {
+	action := &contracts.AssignedAction{
+		WorkflowRunId: uuid.New().String(),
+		JobId:         uuid.New().String(),
+		JobName:       "conformance",
+		JobRunId:      uuid.New().String(),
+		StepId:        uuid.New().String(),
+		StepRunId:     uuid.New().String(),
+		StepName:      "conformance",
+		ActionId:      actionId,
+		ActionType:    contracts.ActionType_START_STEP_RUN,
+	}
+
+	return action, h.assign(ctx, action, input)
+}
+
+// assign sends the action with the input in the same payload format as the dispatcher.
+func (h *harness) assign(ctx context.Context, action *contracts.AssignedAction, input stepInput) error {
+	payload, err := json.Marshal(map[string]any{
+		"input":        input,
+		"triggered_by": "manual",
+		"parents":      map[string]any{},
+		"user_data":    map[string]any{},
+		"overrides":    map[string]any{},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	action.ActionPayload = string(payload)
+
+	return h.d.send(ctx, h.workerId, action)
+}
+
+// cancelStepRun cancels a step run which was assigned to the worker.
+func (h *harness) cancelStepRun(ctx context.Context, action *contracts.AssignedAction) error {
+	cancelAction := &contracts.AssignedAction{
+		WorkflowRunId: action.WorkflowRunId,
+		JobId:         action.JobId,
+		JobName:       action.JobName,
+		JobRunId:      action.JobRunId,
+		StepId:        action.StepId,
+		StepRunId:     action.StepRunId,
+		StepName:      action.StepName,
+		ActionId:      action.ActionId,
+		ActionType:    contracts.ActionType_CANCEL_STEP_RUN,
+	}
+
+	return h.d.send(ctx, h.workerId, cancelAction)
+}
+
+// waitForStepEvent waits for the next event of the step run after the offset, and checks that it has the expected
+// type and refers to the action.
+func (h *harness) waitForStepEvent(
+	ctx context.Context,
+	offset int,
+	action *contracts.AssignedAction,
+	eventType contracts.StepActionEventType,
+) (*contracts.StepActionEvent, int, error) {
+	r, offset, err := h.d.waitFor(ctx, offset, func(r Record) bool {
+		return r.Kind == RecordStepEvent && r.StepEvent.StepRunId == action.StepRunId
+	})
+
+	if err != nil {
+		return nil, offset, fmt.Errorf("waiting for %s event of step run %s: %w", eventType, action.StepRunId, err)
+	}
+
+	event := r.StepEvent
+
+	if event.EventType != eventType {
+		return nil, offset, fmt.Errorf("expected %s event of step run %s, got %s", eventType, action.StepRunId, event.EventType)
+	}
+
+	if err := checkStepEvent(event, action, h.workerId); err != nil {
+		return nil, offset, err
+	}
+
+	return event, offset, nil
+}
+
+// expectNoStepEvent checks that the worker sends no event for the step run after the offset for the duration.
+func (h *harness) expectNoStepEvent(ctx context.Context, offset int, action *contracts.AssignedAction, d time.Duration) error {
+	waitCtx, cancel := context.WithTimeout(ctx, d)
+	defer cancel()
+
+	r, _, err := h.d.waitFor(waitCtx, offset, func(r Record) bool {
+		return r.Kind == RecordStepEvent && r.StepEvent.StepRunId == action.StepRunId
+	})
+
+	if err == nil {
+		return fmt.Errorf("unexpected %s event of step run %s", r.StepEvent.EventType, action.StepRunId)
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	return nil
+}
+
+func runRegister(ctx context.Context, h *harness) error {
+	if h.register.WorkerName == "" {
+		return fmt.Errorf("worker registered without a name")
+	}
+
+	for _, r := range h.d.Records() {
+		if r.Kind == RecordUnauthenticated {
+			return fmt.Errorf("call to %s was not authenticated with the bearer token", r.Method)
+		}
+	}
+
+	return nil
+}
+
+func runHeartbeat(ctx context.Context, h *harness) error {
+	offset := h.d.offset()
+
+	// workers are inactive after 5 seconds without a heartbeat, so the stream must stay open for longer than that
+	waitCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	r, _, err := h.d.waitFor(waitCtx, offset, func(r Record) bool {
+		return r.WorkerId == h.workerId && (r.Kind == RecordListenClosed || r.Kind == RecordUnsubscribe)
+	})
+
+	if err == nil {
+		return fmt.Errorf("worker sent %s while it should be listening", r.Kind)
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	return nil
+}
+
+func runAck(ctx context.Context, h *harness) error {
+	offset := h.d.offset()
+
+	action, err := h.startStepRun(ctx, ActionEcho, stepInput{Message: "hello"})
+
+	if err != nil {
+		return err
+	}
+
+	_, offset, err = h.waitForStepEvent(ctx, offset, action, contracts.StepActionEventType_STEP_EVENT_TYPE_STARTED)
+
+	if err != nil {
+		return err
+	}
+
+	completed, _, err := h.waitForStepEvent(ctx, offset, action, contracts.StepActionEventType_STEP_EVENT_TYPE_COMPLETED)
+
+	if err != nil {
+		return err
+	}
+
+	output := stepInput{}
+
+	if err := json.Unmarshal([]byte(decodePayload(completed.EventPayload)), &output); err != nil {
+		return fmt.Errorf("output of step run %s is not a JSON object: %w", action.StepRunId, err)
+	}
+
+	if output.Message != "hello" {
+		return fmt.Errorf("expected the output to echo the input message %q, got %q", "hello", output.Message)
+	}
+
+	return nil
+}
+
+func runFailure(ctx context.Context, h *harness) error {
+	offset := h.d.offset()
+
+	action, err := h.startStepRun(ctx, ActionFail, stepInput{})
+
+	if err != nil {
+		return err
+	}
+
+	_, offset, err = h.waitForStepEvent(ctx, offset, action, contracts.StepActionEventType_STEP_EVENT_TYPE_STARTED)
+
+	if err != nil {
+		return err
+	}
+
+	failed, _, err := h.waitForStepEvent(ctx, offset, action, contracts.StepActionEventType_STEP_EVENT_TYPE_FAILED)
+
+	if err != nil {
+		return err
+	}
+
+	if decodePayload(failed.EventPayload) == "" {
+		return fmt.Errorf("FAILED event of step run %s has no error message", action.StepRunId)
+	}
+
+	return nil
+}
+
+func runRetry(ctx context.Context, h *harness) error {
+	offset := h.d.offset()
+
+	action, err := h.startStepRun(ctx, ActionFail, stepInput{})
+
+	if err != nil {
+		return err
+	}
+
+	for attempt := 1; attempt <= 2; attempt++ {
+		if attempt > 1 {
+			if err := h.assign(ctx, action, stepInput{}); err != nil {
+				return err
+			}
+		}
+
+		_, offset, err = h.waitForStepEvent(ctx, offset, action, contracts.StepActionEventType_STEP_EVENT_TYPE_STARTED)
+
+		if err != nil {
+			return fmt.Errorf("attempt %d: %w", attempt, err)
+		}
+
+		_, offset, err = h.waitForStepEvent(ctx, offset, action, contracts.StepActionEventType_STEP_EVENT_TYPE_FAILED)
+
+		if err != nil {
+			return fmt.Errorf("attempt %d: %w", attempt, err)
+		}
+	}
+
+	return nil
+}
+
+func runConcurrent(ctx context.Context, h *harness) error {
+	offset := h.d.offset()
+
+	waiting, err := h.startStepRun(ctx, ActionWait, stepInput{})
+
+	if err != nil {
+		return err
+	}
+
+	if _, _, err := h.waitForStepEvent(ctx, offset, waiting, contracts.StepActionEventType_STEP_EVENT_TYPE_STARTED); err != nil {
+		return err
+	}
+
+	// the echo step run must complete while the first step run is still running
+	action, err := h.startStepRun(ctx, ActionEcho, stepInput{Message: "concurrent"})
+
+	if err != nil {
+		return err
+	}
+
+	_, offset, err = h.waitForStepEvent(ctx, offset, action, contracts.StepActionEventType_STEP_EVENT_TYPE_STARTED)
+
+	if err == nil {
+		_, _, err = h.waitForStepEvent(ctx, offset, action, contracts.StepActionEventType_STEP_EVENT_TYPE_COMPLETED)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("while another step run was running: %w", err)
+	}
+
+	// cancel the waiting step run, so that it does not run into the next scenarios
+	if cancelErr := h.cancelStepRun(ctx, waiting); cancelErr != nil && err == nil {
+		err = cancelErr
+	}
+
+	return err
+}
+
+func runCancellation(ctx context.Context, h *harness) error {
+	offset := h.d.offset()
+
+	action, err := h.startStepRun(ctx, ActionWait, stepInput{})
+
+	if err != nil {
+		return err
+	}
+
+	_, offset, err = h.waitForStepEvent(ctx, offset, action, contracts.StepActionEventType_STEP_EVENT_TYPE_STARTED)
+
+	if err != nil {
+		return err
+	}
+
+	if err := h.cancelStepRun(ctx, action); err != nil {
+		return err
+	}
+
+	if err := h.expectNoStepEvent(ctx, offset, action, 5*time.Second); err != nil {
+		return fmt.Errorf("cancelled step run: %w", err)
+	}
+
+	return nil
+}
+
+func runGroupKey(ctx context.Context, h *harness) error {
+	offset := h.d.offset()
+
+	action := &contracts.AssignedAction{
+		WorkflowRunId:    uuid.New().String(),
+		GetGroupKeyRunId: uuid.New().String(),
+		ActionId:         ActionGroupKey,
+		ActionType:       contracts.ActionType_START_GET_GROUP_KEY,
+	}
+
+	// the payload of a get group key run is the workflow input itself
+	payload, err := json.Marshal(stepInput{Group: "conformance-group"})
+
+	if err != nil {
+		return err
+	}
+
+	action.ActionPayload = string(payload)
+
+	if err := h.d.send(ctx, h.workerId, action); err != nil {
+		return err
+	}
+
+	events := []contracts.GroupKeyActionEventType{
+		contracts.GroupKeyActionEventType_GROUP_KEY_EVENT_TYPE_STARTED,
+		contracts.GroupKeyActionEventType_GROUP_KEY_EVENT_TYPE_COMPLETED,
+	}
+
+	var r *Record
+
+	for _, eventType := range events {
+		r, offset, err = h.d.waitFor(ctx, offset, func(r Record) bool {
+			return r.Kind == RecordGroupKeyEvent && r.GroupKeyEvent.GetGroupKeyRunId == action.GetGroupKeyRunId
+		})
+
+		if err != nil {
+			return fmt.Errorf("waiting for %s event of get group key run %s: %w", eventType, action.GetGroupKeyRunId, err)
+		}
+
+		if r.GroupKeyEvent.EventType != eventType {
+			return fmt.Errorf("expected %s event of get group key run %s, got %s", eventType, action.GetGroupKeyRunId, r.GroupKeyEvent.EventType)
+		}
+
+		if r.GroupKeyEvent.WorkflowRunId != action.WorkflowRunId || r.GroupKeyEvent.ActionId != action.ActionId || r.GroupKeyEvent.WorkerId != h.workerId {
+			return fmt.Errorf("%s event of get group key run %s does not match the assigned action", eventType, action.GetGroupKeyRunId)
+		}
+	}
+
+	if key := decodePayload(r.GroupKeyEvent.EventPayload); key != "conformance-group" {
+		return fmt.Errorf("expected the group key %q, got %q", "conformance-group", key)
+	}
+
+	return nil
+}
+
+func runReconnect(ctx context.Context, h *harness) error {
+	offset := h.d.offset()
+
+	h.d.disconnect(h.workerId)
+
+	_, offset, err := h.d.waitFor(ctx, offset, func(r Record) bool {
+		return r.Kind == RecordListen && r.WorkerId == h.workerId
+	})
+
+	if err != nil {
+		return fmt.Errorf("worker did not listen again with worker id %s: %w", h.workerId, err)
+	}
+
+	// the new stream must receive actions
+	action, err := h.startStepRun(ctx, ActionEcho, stepInput{Message: "reconnected"})
+
+	if err != nil {
+		return err
+	}
+
+	_, offset, err = h.waitForStepEvent(ctx, offset, action, contracts.StepActionEventType_STEP_EVENT_TYPE_STARTED)
+
+	if err != nil {
+		return err
+	}
+
+	_, _, err = h.waitForStepEvent(ctx, offset, action, contracts.StepActionEventType_STEP_EVENT_TYPE_COMPLETED)
+
+	return err
+}
+
+func checkStepEvent(event *contracts.StepActionEvent, action *contracts.AssignedAction, workerId string) error {
+	mismatch := func(field, expected, got string) error {
+		return fmt.Errorf("%s event of step run %s has %s %q, expected %q", event.EventType, action.StepRunId, field, got, expected)
+	}
+
+	switch {
+	case event.WorkerId != workerId:
+		return mismatch("worker id", workerId, event.WorkerId)
+	case event.JobId != action.JobId:
+		return mismatch("job id", action.JobId, event.JobId)
+	case event.JobRunId != action.JobRunId:
+		return mismatch("job run id", action.JobRunId, event.JobRunId)
+	case event.StepId != action.StepId:
+		return mismatch("step id", action.StepId, event.StepId)
+	case event.ActionId != action.ActionId:
+		return mismatch("action id", action.ActionId, event.ActionId)
+	}
+
+	if !validTimestamp(event.EventTimestamp) {
+		return fmt.Errorf("%s event of step run %s has no valid timestamp", event.EventType, action.StepRunId)
+	}
+
+	return nil
+}
+
+func validTimestamp(ts *timestamppb.Timestamp) bool {
+	return ts != nil && ts.IsValid() && !ts.AsTime().IsZero()
+}
+
+// decodePayload returns the payload of an event. SDKs may send the payload as a JSON encoded string, which the
+// dispatcher accepts as well.
+func decodePayload(payload string) string {
+	var s string
+
+	if err := json.Unmarshal([]byte(payload), &s); err == nil {
+		return s
+	}
+
+	return payload
+}
+
+func hasActions(registered, required []string) bool {
+	set := map[string]bool{}
+
+	for _, a := range registered {
+		set[a] = true
+	}
+
+	for _, a := range required {
+		if !set[a] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -25,6 +25,9 @@ type StepRunData struct {
 
 	// overrides set from the playground
 	Overrides map[string]interface{} `json:"overrides"`
+
+	// metadata set when the workflow run was triggered
+	AdditionalMetadata map[string]interface{} `json:"additional_metadata,omitempty"`
 }
 
 type StepData map[string]interface{}
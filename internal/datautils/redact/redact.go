@@ -0,0 +1,320 @@
+// Package redact masks the values in JSON documents which are matched by JSONPath expressions.
+//
+// A subset of JSONPath is supported: every expression starts with $, and is followed by child segments
+// (.name or ['name']), array indexes ([0]), wildcards (.* or [*]) and recursive descent (..name or ..*).
+// Filters, slices and unions are not supported.
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Redacted replaces every value which is matched by a rule.
+const Redacted = "[REDACTED]"
+
+// Purged replaces every value which is matched by a rule when it is purged, rather than redacted.
+const Purged = "[PURGED]"
+
+type segment struct {
+	// recursive is whether the segment matches at any depth, like ..name
+	recursive bool
+
+	// wildcard is whether the segment matches every child
+	wildcard bool
+
+	// name is the key of the child in an object, if index is -1
+	name string
+
+	// index is the index of the child in an array, or -1
+	index int
+}
+
+type path []segment
+
+// Rules is a compiled set of redaction rules. A nil *Rules redacts nothing.
+type Rules struct {
+	paths []path
+}
+
+// Compile parses a list of JSONPath expressions. If an expression is invalid, an error is returned along with
+// the rules for the other expressions, so that the valid rules can still be applied.
+func Compile(exprs []string) (*Rules, error) {
+	res := &Rules{}
+
+	var err error
+
+	for _, expr := range exprs {
+		p, parseErr := parse(expr)
+
+		if parseErr != nil {
+			if err == nil {
+				err = fmt.Errorf("invalid redaction rule %q: %w", expr, parseErr)
+			}
+
+			continue
+		}
+
+		res.paths = append(res.paths, p)
+	}
+
+	return res, err
+}
+
+// Empty returns whether there are no rules.
+func (r *Rules) Empty() bool {
+	return r == nil || len(r.paths) == 0
+}
+
+// RedactJSON returns the JSON document with every value which is matched by a rule replaced by Redacted. The
+// document is returned unchanged if there are no rules, or if it is not valid JSON.
+func (r *Rules) RedactJSON(data []byte) []byte {
+	if r.Empty() || len(data) == 0 {
+		return data
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v interface{}
+
+	if err := dec.Decode(&v); err != nil {
+		return data
+	}
+
+	res, err := json.Marshal(r.Redact(v))
+
+	if err != nil {
+		return data
+	}
+
+	return res
+}
+
+// RedactString is RedactJSON for a JSON document which is stored as a string.
+func (r *Rules) RedactString(data string) string {
+	return string(r.RedactJSON([]byte(data)))
+}
+
+// PurgeJSON returns the JSON document with every value which is matched by a rule replaced by Purged, and whether
+// any value was replaced. Values which were already purged are not counted, so purging a document twice only
+// changes it once. Like RedactJSON, the document is returned unchanged if it is not valid JSON.
+func (r *Rules) PurgeJSON(data []byte) ([]byte, bool) {
+	if r.Empty() || len(data) == 0 {
+		return data, false
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v interface{}
+
+	if err := dec.Decode(&v); err != nil {
+		return data, false
+	}
+
+	v, replaced := r.Purge(v)
+
+	if !replaced {
+		return data, false
+	}
+
+	res, err := json.Marshal(v)
+
+	if err != nil {
+		return data, false
+	}
+
+	return res, true
+}
+
+// Redact replaces every value in a decoded JSON document which is matched by a rule. Objects and arrays in the
+// document are modified in place.
+func (r *Rules) Redact(v interface{}) interface{} {
+	if r.Empty() {
+		return v
+	}
+
+	replaced := false
+
+	for _, p := range r.paths {
+		v = p.apply(v, 0, Redacted, &replaced)
+	}
+
+	return v
+}
+
+// Purge replaces every value in a decoded JSON document which is matched by a rule with Purged, and returns whether
+// any value was replaced. Like Redact, objects and arrays in the document are modified in place.
+func (r *Rules) Purge(v interface{}) (interface{}, bool) {
+	if r.Empty() {
+		return v, false
+	}
+
+	replaced := false
+
+	for _, p := range r.paths {
+		v = p.apply(v, 0, Purged, &replaced)
+	}
+
+	return v, replaced
+}
+
+// apply replaces the values which are matched by the path with the replacement, and sets replaced if any of them
+// was not already the replacement.
+func (p path) apply(v interface{}, i int, replacement string, replaced *bool) interface{} {
+	if i == len(p) {
+		if s, ok := v.(string); !ok || s != replacement {
+			*replaced = true
+		}
+
+		return replacement
+	}
+
+	seg := p[i]
+
+	v = seg.applyChildren(v, func(child interface{}) interface{} {
+		return p.apply(child, i+1, replacement, replaced)
+	})
+
+	if seg.recursive {
+		// descend into every child, so that the segment matches at any depth
+		v = eachChild(v, func(child interface{}) interface{} {
+			return p.apply(child, i, replacement, replaced)
+		})
+	}
+
+	return v
+}
+
+// applyChildren calls f on the children of v which are matched by the segment, and replaces them with the result.
+func (s segment) applyChildren(v interface{}, f func(interface{}) interface{}) interface{} {
+	if s.wildcard {
+		return eachChild(v, f)
+	}
+
+	switch val := v.(type) {
+	case map[string]interface{}:
+		if s.index == -1 {
+			if child, ok := val[s.name]; ok {
+				val[s.name] = f(child)
+			}
+		}
+	case []interface{}:
+		if s.index >= 0 && s.index < len(val) {
+			val[s.index] = f(val[s.index])
+		}
+	}
+
+	return v
+}
+
+func eachChild(v interface{}, f func(interface{}) interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, child := range val {
+			val[k] = f(child)
+		}
+	case []interface{}:
+		for i, child := range val {
+			val[i] = f(child)
+		}
+	}
+
+	return v
+}
+
+func parse(expr string) (path, error) {
+	if !strings.HasPrefix(expr, "$") {
+		return nil, fmt.Errorf("must start with $")
+	}
+
+	rest := expr[1:]
+	res := path{}
+
+	for rest != "" {
+		var (
+			seg segment
+			err error
+		)
+
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			seg, rest, err = parseDotSegment(rest[2:])
+			seg.recursive = true
+		case strings.HasPrefix(rest, "."):
+			seg, rest, err = parseDotSegment(rest[1:])
+		case strings.HasPrefix(rest, "["):
+			seg, rest, err = parseBracketSegment(rest[1:])
+		default:
+			err = fmt.Errorf("unexpected %q", rest[:1])
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, seg)
+	}
+
+	if len(res) == 0 {
+		return nil, fmt.Errorf("must match a value inside the document")
+	}
+
+	return res, nil
+}
+
+func parseDotSegment(rest string) (segment, string, error) {
+	if strings.HasPrefix(rest, "[") {
+		// ..['name'] and ..[0]
+		return parseBracketSegment(rest[1:])
+	}
+
+	end := strings.IndexAny(rest, ".[")
+
+	if end == -1 {
+		end = len(rest)
+	}
+
+	name := rest[:end]
+
+	if name == "" {
+		return segment{}, "", fmt.Errorf("empty name")
+	}
+
+	if name == "*" {
+		return segment{wildcard: true, index: -1}, rest[end:], nil
+	}
+
+	return segment{name: name, index: -1}, rest[end:], nil
+}
+
+func parseBracketSegment(rest string) (segment, string, error) {
+	end := strings.Index(rest, "]")
+
+	if end == -1 {
+		return segment{}, "", fmt.Errorf("missing ]")
+	}
+
+	inner := rest[:end]
+	rest = rest[end+1:]
+
+	if inner == "*" {
+		return segment{wildcard: true, index: -1}, rest, nil
+	}
+
+	if len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0] {
+		return segment{name: inner[1 : len(inner)-1], index: -1}, rest, nil
+	}
+
+	index, err := strconv.Atoi(inner)
+
+	if err != nil || index < 0 {
+		return segment{}, "", fmt.Errorf("unsupported selector [%s]", inner)
+	}
+
+	return segment{index: index}, rest, nil
+}
@@ -0,0 +1,143 @@
+package redact
+
+import (
+	"testing"
+)
+
+func TestRedactJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		rules    []string
+		input    string
+		expected string
+	}{
+		{
+			name:     "child",
+			rules:    []string{"$.token"},
+			input:    `{"token":"secret","name":"test"}`,
+			expected: `{"name":"test","token":"[REDACTED]"}`,
+		},
+		{
+			name:     "nested child",
+			rules:    []string{"$.user['email']"},
+			input:    `{"user":{"email":"a@b.com","id":1}}`,
+			expected: `{"user":{"email":"[REDACTED]","id":1}}`,
+		},
+		{
+			name:     "array wildcard",
+			rules:    []string{"$.users[*].email"},
+			input:    `{"users":[{"email":"a@b.com"},{"email":"c@d.com","id":2}]}`,
+			expected: `{"users":[{"email":"[REDACTED]"},{"email":"[REDACTED]","id":2}]}`,
+		},
+		{
+			name:     "array index",
+			rules:    []string{"$.keys[1]"},
+			input:    `{"keys":["a","b","c"]}`,
+			expected: `{"keys":["a","[REDACTED]","c"]}`,
+		},
+		{
+			name:     "recursive descent",
+			rules:    []string{"$..password"},
+			input:    `{"password":"a","nested":{"password":"b","list":[{"password":"c"}]}}`,
+			expected: `{"nested":{"list":[{"password":"[REDACTED]"}],"password":"[REDACTED]"},"password":"[REDACTED]"}`,
+		},
+		{
+			name:     "object values are redacted",
+			rules:    []string{"$.credentials"},
+			input:    `{"credentials":{"user":"a","password":"b"}}`,
+			expected: `{"credentials":"[REDACTED]"}`,
+		},
+		{
+			name:     "missing paths are ignored",
+			rules:    []string{"$.missing.token", "$.list[5]"},
+			input:    `{"list":[1],"token":"a"}`,
+			expected: `{"list":[1],"token":"a"}`,
+		},
+		{
+			name:     "numbers are preserved",
+			rules:    []string{"$.token"},
+			input:    `{"id":12345678901234567890,"token":1}`,
+			expected: `{"id":12345678901234567890,"token":"[REDACTED]"}`,
+		},
+		{
+			name:     "invalid json is unchanged",
+			rules:    []string{"$.token"},
+			input:    `not json`,
+			expected: `not json`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := Compile(tt.rules)
+
+			if err != nil {
+				t.Fatalf("Compile() error = %v", err)
+			}
+
+			if res := rules.RedactJSON([]byte(tt.input)); string(res) != tt.expected {
+				t.Errorf("RedactJSON() = %s, want %s", res, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCompileInvalid(t *testing.T) {
+	for _, expr := range []string{"", "$", "token", "$.", "$[", "$[-1]", "$[?(@.a)]", "$.a[1:2]"} {
+		if _, err := Compile([]string{expr}); err == nil {
+			t.Errorf("Compile(%q) should return an error", expr)
+		}
+	}
+}
+
+func TestCompilePartial(t *testing.T) {
+	rules, err := Compile([]string{"$[", "$.token"})
+
+	if err == nil {
+		t.Fatalf("Compile() should return an error")
+	}
+
+	if res := rules.RedactJSON([]byte(`{"token":"a"}`)); string(res) != `{"token":"[REDACTED]"}` {
+		t.Errorf("valid rules should still be applied, got %s", res)
+	}
+}
+
+func TestNilRules(t *testing.T) {
+	var rules *Rules
+
+	if res := rules.RedactJSON([]byte(`{"token":"a"}`)); string(res) != `{"token":"a"}` {
+		t.Errorf("nil rules should not redact, got %s", res)
+	}
+}
+
+func TestPurgeJSON(t *testing.T) {
+	rules, err := Compile([]string{"$.user.email", "$..phone"})
+
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+
+	res, purged := rules.PurgeJSON([]byte(`{"user":{"email":"a@b.com","id":1},"contacts":[{"phone":"123"}]}`))
+
+	if !purged {
+		t.Fatalf("PurgeJSON() should purge the matched values")
+	}
+
+	expected := `{"contacts":[{"phone":"[PURGED]"}],"user":{"email":"[PURGED]","id":1}}`
+
+	if string(res) != expected {
+		t.Errorf("PurgeJSON() = %s, want %s", res, expected)
+	}
+
+	// purging a purged document doesn't change it
+	if again, purged := rules.PurgeJSON(res); purged || string(again) != expected {
+		t.Errorf("PurgeJSON() of a purged document = %s, %v, want %s, false", again, purged, expected)
+	}
+
+	// documents without matched values aren't changed
+	input := `{"user": {"id": 1}}`
+
+	if res, purged := rules.PurgeJSON([]byte(input)); purged || string(res) != input {
+		t.Errorf("PurgeJSON() = %s, %v, want %s, false", res, purged, input)
+	}
+}
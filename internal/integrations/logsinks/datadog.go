@@ -0,0 +1,116 @@
+package logsinks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const defaultDatadogSite = "datadoghq.com"
+
+type DatadogConfig struct {
+	// the api key which is used to send logs
+	APIKey string `json:"apiKey"`
+
+	// (optional) the datadog site, defaults to datadoghq.com
+	Site string `json:"site,omitempty"`
+
+	// (optional) the service of the logs, defaults to hatchet
+	Service string `json:"service,omitempty"`
+}
+
+func (c *DatadogConfig) validate() error {
+	if c.APIKey == "" {
+		return fmt.Errorf("api key is required")
+	}
+
+	return nil
+}
+
+func (c *DatadogConfig) intakeURL() string {
+	site := c.Site
+
+	if site == "" {
+		site = defaultDatadogSite
+	}
+
+	return fmt.Sprintf("https://http-intake.logs.%s/api/v2/logs", site)
+}
+
+type datadogSink struct {
+	url     string
+	apiKey  string
+	service string
+	client  *http.Client
+}
+
+func newDatadogSink(c *DatadogConfig, client *http.Client) *datadogSink {
+	service := c.Service
+
+	if service == "" {
+		service = "hatchet"
+	}
+
+	return &datadogSink{
+		url:     c.intakeURL(),
+		apiKey:  c.APIKey,
+		service: service,
+		client:  client,
+	}
+}
+
+type datadogLog struct {
+	DDSource string `json:"ddsource"`
+	DDTags   string `json:"ddtags"`
+	Service  string `json:"service"`
+	Message  string `json:"message"`
+}
+
+func (s *datadogSink) Deliver(ctx context.Context, batch *Batch) error {
+	logs := make([]datadogLog, 0, len(batch.Records))
+
+	for _, record := range batch.Records {
+		message, err := json.Marshal(record)
+
+		if err != nil {
+			return fmt.Errorf("could not marshal record: %w", err)
+		}
+
+		logs = append(logs, datadogLog{
+			DDSource: "hatchet",
+			DDTags:   fmt.Sprintf("tenant:%s,event:%s", record.TenantId, record.Event),
+			Service:  s.service,
+			Message:  string(message),
+		})
+	}
+
+	body, err := json.Marshal(logs)
+
+	if err != nil {
+		return fmt.Errorf("could not marshal logs: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("DD-API-KEY", s.apiKey)
+
+	res, err := s.client.Do(req)
+
+	if err != nil {
+		return fmt.Errorf("could not send logs: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	return checkResponse(res)
+}
@@ -0,0 +1,95 @@
+package logsinks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+type HTTPConfig struct {
+	// the url which batches are posted to
+	URL string `json:"url"`
+
+	// (optional) headers which are sent with every request, for example for authorization
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// (optional) the secret which is used to sign the request body in the X-Hatchet-Signature header
+	Secret string `json:"secret,omitempty"`
+}
+
+func (c *HTTPConfig) validate() error {
+	u, err := url.Parse(c.URL)
+
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("url must be an http or https url")
+	}
+
+	return nil
+}
+
+type httpSink struct {
+	url     string
+	headers map[string]string
+	secret  string
+	client  *http.Client
+}
+
+func newHTTPSink(c *HTTPConfig, client *http.Client) *httpSink {
+	return &httpSink{
+		url:     c.URL,
+		headers: c.Headers,
+		secret:  c.Secret,
+		client:  client,
+	}
+}
+
+func (s *httpSink) Deliver(ctx context.Context, batch *Batch) error {
+	body, err := json.Marshal(batch)
+
+	if err != nil {
+		return fmt.Errorf("could not marshal batch: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Hatchet-Batch-Id", batch.Id)
+
+	if s.secret != "" {
+		req.Header.Set("X-Hatchet-Signature", "sha256="+sign(s.secret, body))
+	}
+
+	res, err := s.client.Do(req)
+
+	if err != nil {
+		return fmt.Errorf("could not send batch: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	return checkResponse(res)
+}
+
+func sign(secret string, body []byte) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
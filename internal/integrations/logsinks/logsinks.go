@@ -0,0 +1,130 @@
+// Package logsinks delivers batches of engine records, like step run lifecycle events and step run logs, to
+// external destinations configured per tenant.
+package logsinks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ConfigDataId is the data id which is used to encrypt the config of sinks.
+const ConfigDataId = "log_sink_config"
+
+type Kind string
+
+const (
+	KindHTTP    Kind = "HTTP"
+	KindS3      Kind = "S3"
+	KindDatadog Kind = "DATADOG"
+)
+
+// Record is a single record which is forwarded to a sink.
+type Record struct {
+	Id        int64           `json:"id"`
+	TenantId  string          `json:"tenantId"`
+	Event     string          `json:"event"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// Batch is a group of records which is delivered to a sink at once. Batches are retried until they are delivered,
+// so sinks should use the batch id to deduplicate deliveries.
+type Batch struct {
+	Id      string   `json:"batchId"`
+	Records []Record `json:"records"`
+}
+
+// NewBatch returns a batch of the given records, which must not be empty and must be sorted by id.
+func NewBatch(sinkId string, records []Record) *Batch {
+	return &Batch{
+		Id:      fmt.Sprintf("%s-%d-%d", sinkId, records[0].Id, records[len(records)-1].Id),
+		Records: records,
+	}
+}
+
+// Sink delivers batches of records to a destination.
+type Sink interface {
+	Deliver(ctx context.Context, batch *Batch) error
+}
+
+// Config is the config of a sink, which is stored encrypted because it contains secrets. Exactly one of the fields
+// is set, matching the kind of the sink.
+type Config struct {
+	HTTP    *HTTPConfig    `json:"http,omitempty"`
+	S3      *S3Config      `json:"s3,omitempty"`
+	Datadog *DatadogConfig `json:"datadog,omitempty"`
+}
+
+// Validate checks that the config is complete for the given kind of sink.
+func (c *Config) Validate(kind Kind) error {
+	switch kind {
+	case KindHTTP:
+		if c.HTTP == nil {
+			return fmt.Errorf("http config is required for HTTP sinks")
+		}
+
+		return c.HTTP.validate()
+	case KindS3:
+		if c.S3 == nil {
+			return fmt.Errorf("s3 config is required for S3 sinks")
+		}
+
+		return c.S3.validate()
+	case KindDatadog:
+		if c.Datadog == nil {
+			return fmt.Errorf("datadog config is required for DATADOG sinks")
+		}
+
+		return c.Datadog.validate()
+	}
+
+	return fmt.Errorf("unknown sink kind %s", kind)
+}
+
+// Destination returns a description of where records are sent, which does not contain any secrets.
+func (c *Config) Destination(kind Kind) string {
+	switch kind {
+	case KindHTTP:
+		return c.HTTP.URL
+	case KindS3:
+		return fmt.Sprintf("s3://%s/%s", c.S3.Bucket, c.S3.Prefix)
+	case KindDatadog:
+		return c.Datadog.intakeURL()
+	}
+
+	return ""
+}
+
+// New returns the sink of the given kind.
+func New(kind Kind, c *Config) (Sink, error) {
+	if err := c.Validate(kind); err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{
+		Timeout: 30 * time.Second,
+	}
+
+	switch kind {
+	case KindHTTP:
+		return newHTTPSink(c.HTTP, client), nil
+	case KindS3:
+		return newS3Sink(c.S3, client), nil
+	case KindDatadog:
+		return newDatadogSink(c.Datadog, client), nil
+	}
+
+	return nil, fmt.Errorf("unknown sink kind %s", kind)
+}
+
+// checkResponse returns an error if the response does not have a 2xx status.
+func checkResponse(res *http.Response) error {
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("destination responded with status %d", res.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package logsinks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBatch() *Batch {
+	createdAt := time.Date(2024, 3, 19, 10, 15, 44, 0, time.UTC)
+
+	return NewBatch("sink", []Record{
+		{
+			Id:        1,
+			TenantId:  "tenant",
+			Event:     "step-run-started",
+			CreatedAt: createdAt,
+			Data:      json.RawMessage(`{"stepRunId":"a"}`),
+		},
+		{
+			Id:        3,
+			TenantId:  "tenant",
+			Event:     "step-run-succeeded",
+			CreatedAt: createdAt,
+			Data:      json.RawMessage(`{"stepRunId":"a"}`),
+		},
+	})
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    Kind
+		config  Config
+		wantErr bool
+	}{
+		{
+			name:   "http",
+			kind:   KindHTTP,
+			config: Config{HTTP: &HTTPConfig{URL: "https://example.com/logs"}},
+		},
+		{
+			name:    "http without config",
+			kind:    KindHTTP,
+			config:  Config{S3: &S3Config{}},
+			wantErr: true,
+		},
+		{
+			name:    "http with invalid url",
+			kind:    KindHTTP,
+			config:  Config{HTTP: &HTTPConfig{URL: "ftp://example.com"}},
+			wantErr: true,
+		},
+		{
+			name:   "s3",
+			kind:   KindS3,
+			config: Config{S3: &S3Config{Bucket: "logs", Region: "us-east-1", AccessKeyId: "id", SecretAccessKey: "secret"}},
+		},
+		{
+			name:    "s3 without credentials",
+			kind:    KindS3,
+			config:  Config{S3: &S3Config{Bucket: "logs", Region: "us-east-1"}},
+			wantErr: true,
+		},
+		{
+			name:    "datadog without api key",
+			kind:    KindDatadog,
+			config:  Config{Datadog: &DatadogConfig{}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown kind",
+			kind:    Kind("SYSLOG"),
+			config:  Config{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate(tt.kind)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHTTPSink(t *testing.T) {
+	var gotReq *http.Request
+	var gotBody []byte
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotReq = r
+		gotBody, _ = io.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+
+	sink := newHTTPSink(&HTTPConfig{
+		URL:     srv.URL,
+		Headers: map[string]string{"Authorization": "Bearer token"},
+		Secret:  "secret",
+	}, srv.Client())
+
+	batch := testBatch()
+
+	require.NoError(t, sink.Deliver(context.Background(), batch))
+
+	assert.Equal(t, http.MethodPost, gotReq.Method)
+	assert.Equal(t, "sink-1-3", gotReq.Header.Get("X-Hatchet-Batch-Id"))
+	assert.Equal(t, "Bearer token", gotReq.Header.Get("Authorization"))
+	assert.Equal(t, "sha256="+sign("secret", gotBody), gotReq.Header.Get("X-Hatchet-Signature"))
+
+	var got Batch
+
+	require.NoError(t, json.Unmarshal(gotBody, &got))
+	assert.Equal(t, "sink-1-3", got.Id)
+	assert.Len(t, got.Records, 2)
+}
+
+func TestHTTPSinkErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	sink := newHTTPSink(&HTTPConfig{URL: srv.URL}, srv.Client())
+
+	assert.ErrorContains(t, sink.Deliver(context.Background(), testBatch()), "503")
+}
+
+func TestDatadogSink(t *testing.T) {
+	var gotReq *http.Request
+	var gotBody []byte
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotReq = r
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	sink := newDatadogSink(&DatadogConfig{APIKey: "key"}, srv.Client())
+	sink.url = srv.URL
+
+	require.NoError(t, sink.Deliver(context.Background(), testBatch()))
+
+	assert.Equal(t, "key", gotReq.Header.Get("DD-API-KEY"))
+
+	var logs []datadogLog
+
+	require.NoError(t, json.Unmarshal(gotBody, &logs))
+	require.Len(t, logs, 2)
+	assert.Equal(t, "hatchet", logs[0].Service)
+	assert.Equal(t, "tenant:tenant,event:step-run-started", logs[0].DDTags)
+}
+
+func TestS3Sink(t *testing.T) {
+	var gotReq *http.Request
+	var gotBody []byte
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotReq = r
+		gotBody, _ = io.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+
+	sink := newS3Sink(&S3Config{
+		Bucket:          "logs",
+		Region:          "us-east-1",
+		Prefix:          "/hatchet/",
+		AccessKeyId:     "id",
+		SecretAccessKey: "secret",
+		Endpoint:        srv.URL,
+	}, srv.Client())
+
+	sink.now = func() time.Time {
+		return time.Date(2024, 3, 19, 10, 15, 44, 0, time.UTC)
+	}
+
+	require.NoError(t, sink.Deliver(context.Background(), testBatch()))
+
+	assert.Equal(t, http.MethodPut, gotReq.Method)
+	assert.Equal(t, "/logs/hatchet/2024/03/19/sink-1-3.ndjson", gotReq.URL.Path)
+	assert.Equal(t, "20240319T101544Z", gotReq.Header.Get("X-Amz-Date"))
+	assert.Equal(t, sha256Hex(gotBody), gotReq.Header.Get("X-Amz-Content-Sha256"))
+	assert.True(t, strings.HasPrefix(
+		gotReq.Header.Get("Authorization"),
+		"AWS4-HMAC-SHA256 Credential=id/20240319/us-east-1/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=",
+	))
+	assert.Len(t, strings.Split(strings.TrimSpace(string(gotBody)), "\n"), 2)
+}
+
+func TestEscapePath(t *testing.T) {
+	assert.Equal(t, "/bucket/a%20b/c%2Bd~e.ndjson", escapePath("/bucket/a b/c+d~e.ndjson"))
+}
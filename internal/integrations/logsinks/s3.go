@@ -0,0 +1,217 @@
+package logsinks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type S3Config struct {
+	// the bucket which batches are written to
+	Bucket string `json:"bucket"`
+
+	// the region of the bucket
+	Region string `json:"region"`
+
+	// (optional) the prefix of the object keys
+	Prefix string `json:"prefix,omitempty"`
+
+	// the credentials which are used to write to the bucket
+	AccessKeyId     string `json:"accessKeyId"`
+	SecretAccessKey string `json:"secretAccessKey"`
+
+	// (optional) the endpoint of an S3-compatible service, defaults to the AWS endpoint of the region
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+func (c *S3Config) validate() error {
+	if c.Bucket == "" {
+		return fmt.Errorf("bucket is required")
+	}
+
+	if c.Region == "" {
+		return fmt.Errorf("region is required")
+	}
+
+	if c.AccessKeyId == "" || c.SecretAccessKey == "" {
+		return fmt.Errorf("access key id and secret access key are required")
+	}
+
+	if c.Endpoint != "" {
+		u, err := url.Parse(c.Endpoint)
+
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("endpoint must be an http or https url")
+		}
+	}
+
+	return nil
+}
+
+// s3Sink writes each batch as a newline-delimited JSON object, using path-style requests signed with AWS
+// signature version 4 so that S3-compatible services are supported as well.
+type s3Sink struct {
+	endpoint        string
+	bucket          string
+	region          string
+	prefix          string
+	accessKeyId     string
+	secretAccessKey string
+	client          *http.Client
+
+	now func() time.Time
+}
+
+func newS3Sink(c *S3Config, client *http.Client) *s3Sink {
+	endpoint := c.Endpoint
+
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", c.Region)
+	}
+
+	return &s3Sink{
+		endpoint:        strings.TrimSuffix(endpoint, "/"),
+		bucket:          c.Bucket,
+		region:          c.Region,
+		prefix:          strings.Trim(c.Prefix, "/"),
+		accessKeyId:     c.AccessKeyId,
+		secretAccessKey: c.SecretAccessKey,
+		client:          client,
+		now:             time.Now,
+	}
+}
+
+func (s *s3Sink) Deliver(ctx context.Context, batch *Batch) error {
+	var body bytes.Buffer
+
+	enc := json.NewEncoder(&body)
+
+	for _, record := range batch.Records {
+		if err := enc.Encode(record); err != nil {
+			return fmt.Errorf("could not marshal record: %w", err)
+		}
+	}
+
+	now := s.now().UTC()
+
+	key := fmt.Sprintf("%s/%s.ndjson", now.Format("2006/01/02"), batch.Id)
+
+	if s.prefix != "" {
+		key = s.prefix + "/" + key
+	}
+
+	path := "/" + s.bucket + "/" + key
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.endpoint+escapePath(path), bytes.NewReader(body.Bytes()))
+
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-ndjson")
+
+	s.signRequest(req, body.Bytes(), now)
+
+	res, err := s.client.Do(req)
+
+	if err != nil {
+		return fmt.Errorf("could not put object: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	return checkResponse(res)
+}
+
+func (s *s3Sink) signRequest(req *http.Request, body []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		"",
+		"host:" + req.URL.Host,
+		"x-amz-content-sha256:" + payloadHash,
+		"x-amz-date:" + amzDate,
+		"",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", date, s.region)
+
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretAccessKey), date)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.accessKeyId,
+		scope,
+		signedHeaders,
+		signature,
+	))
+}
+
+// escapePath escapes each segment of the path the way AWS expects, which only leaves unreserved characters as-is.
+func escapePath(path string) string {
+	segments := strings.Split(path, "/")
+
+	for i, segment := range segments {
+		var b strings.Builder
+
+		for _, c := range []byte(segment) {
+			if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '-' || c == '.' || c == '_' || c == '~' {
+				b.WriteByte(c)
+			} else {
+				fmt.Fprintf(&b, "%%%02X", c)
+			}
+		}
+
+		segments[i] = b.String()
+	}
+
+	return strings.Join(segments, "/")
+}
+
+func sha256Hex(b []byte) string {
+	h := sha256.Sum256(b)
+
+	return hex.EncodeToString(h[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+
+	return h.Sum(nil)
+}
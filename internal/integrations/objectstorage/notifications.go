@@ -0,0 +1,148 @@
+package objectstorage
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type s3Notification struct {
+	Records []struct {
+		EventName string    `json:"eventName"`
+		EventTime time.Time `json:"eventTime"`
+		S3        struct {
+			Bucket struct {
+				Name string `json:"name"`
+			} `json:"bucket"`
+			Object struct {
+				Key  string `json:"key"`
+				Size int64  `json:"size"`
+				ETag string `json:"eTag"`
+			} `json:"object"`
+		} `json:"s3"`
+	} `json:"Records"`
+}
+
+// snsNotification is an SNS notification, which wraps S3 event notifications that are sent to an SNS topic which
+// the queue is subscribed to, unless raw message delivery is enabled.
+type snsNotification struct {
+	Type    string `json:"Type"`
+	Message string `json:"Message"`
+}
+
+// ParseS3Notification returns the created objects of an S3 event notification, which may be wrapped in an SNS
+// notification. Records of other events, like removed objects, are skipped, and test events contain no objects.
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/notification-content-structure.html.
+func ParseS3Notification(body []byte) ([]Object, error) {
+	sns := snsNotification{}
+
+	if err := json.Unmarshal(body, &sns); err != nil {
+		return nil, fmt.Errorf("could not unmarshal notification: %w", err)
+	}
+
+	if sns.Type == "Notification" {
+		body = []byte(sns.Message)
+	}
+
+	notification := s3Notification{}
+
+	if err := json.Unmarshal(body, &notification); err != nil {
+		return nil, fmt.Errorf("could not unmarshal notification: %w", err)
+	}
+
+	var res []Object
+
+	for _, record := range notification.Records {
+		if !strings.HasPrefix(record.EventName, "ObjectCreated:") {
+			continue
+		}
+
+		// keys are url-encoded in notifications
+		key, err := url.QueryUnescape(record.S3.Object.Key)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not decode object key: %w", err)
+		}
+
+		res = append(res, Object{
+			Provider:  "s3",
+			Bucket:    record.S3.Bucket.Name,
+			Key:       key,
+			Size:      record.S3.Object.Size,
+			ETag:      record.S3.Object.ETag,
+			EventName: record.EventName,
+			EventTime: record.EventTime,
+		})
+	}
+
+	return res, nil
+}
+
+type gcsObject struct {
+	Bucket      string            `json:"bucket"`
+	Name        string            `json:"name"`
+	Size        string            `json:"size"`
+	ETag        string            `json:"etag"`
+	ContentType string            `json:"contentType"`
+	Metadata    map[string]string `json:"metadata"`
+	TimeCreated time.Time         `json:"timeCreated"`
+}
+
+// ParseGCSNotification returns the created object of a GCS Pub/Sub notification, or no objects for notifications of
+// other events, like deleted objects. The object is read from the data of the message if the notification has the
+// JSON_API_V1 payload format, or from the attributes of the message otherwise.
+// See https://cloud.google.com/storage/docs/pubsub-notifications.
+func ParseGCSNotification(attributes map[string]string, data []byte) ([]Object, error) {
+	if attributes["eventType"] != "OBJECT_FINALIZE" {
+		return nil, nil
+	}
+
+	res := Object{
+		Provider:  "gcs",
+		Bucket:    attributes["bucketId"],
+		Key:       attributes["objectId"],
+		EventName: attributes["eventType"],
+	}
+
+	if eventTime, err := time.Parse(time.RFC3339Nano, attributes["eventTime"]); err == nil {
+		res.EventTime = eventTime
+	}
+
+	if attributes["payloadFormat"] == "JSON_API_V1" {
+		object := gcsObject{}
+
+		if err := json.Unmarshal(data, &object); err != nil {
+			return nil, fmt.Errorf("could not unmarshal object: %w", err)
+		}
+
+		res.Bucket = object.Bucket
+		res.Key = object.Name
+		res.ETag = object.ETag
+		res.ContentType = object.ContentType
+		res.Metadata = object.Metadata
+
+		// sizes are encoded as strings, as they are 64-bit integers
+		if object.Size != "" {
+			size, err := strconv.ParseInt(object.Size, 10, 64)
+
+			if err != nil {
+				return nil, fmt.Errorf("could not parse object size: %w", err)
+			}
+
+			res.Size = size
+		}
+
+		if res.EventTime.IsZero() {
+			res.EventTime = object.TimeCreated
+		}
+	}
+
+	if res.Bucket == "" || res.Key == "" {
+		return nil, fmt.Errorf("notification does not contain a bucket and object")
+	}
+
+	return []Object{res}, nil
+}
@@ -0,0 +1,121 @@
+// Package objectstorage receives object storage notifications, like S3 event notifications from an SQS queue or GCS
+// notifications from a Pub/Sub subscription, so that created objects can trigger workflows.
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ConfigDataId is the data id which is used to encrypt the config of feeds.
+const ConfigDataId = "object_storage_feed_config"
+
+type Kind string
+
+const (
+	KindSQS    Kind = "SQS"
+	KindPubSub Kind = "PUBSUB"
+)
+
+// Object is an object which was created in a bucket.
+type Object struct {
+	// the provider of the bucket, either s3 or gcs
+	Provider string `json:"provider"`
+
+	Bucket string `json:"bucket"`
+	Key    string `json:"key"`
+	Size   int64  `json:"size"`
+
+	ETag        string `json:"etag,omitempty"`
+	ContentType string `json:"contentType,omitempty"`
+
+	// the custom metadata of the object, which is only included in GCS notifications
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// the name of the event which created the object, like ObjectCreated:Put or OBJECT_FINALIZE
+	EventName string    `json:"eventName"`
+	EventTime time.Time `json:"eventTime"`
+}
+
+// Message is a message which was received from a feed. A message can contain several objects, and contains no
+// objects if it does not describe created objects, like the test events which S3 sends. Messages must be
+// acknowledged once their objects were handled, or they are received again.
+type Message struct {
+	Objects []Object
+
+	// Err is set if the message is not a notification which could be parsed. Such messages should be acknowledged
+	// as well, as they can never be handled.
+	Err error
+
+	ackId string
+}
+
+// Feed receives messages from a queue of notifications.
+type Feed interface {
+	// Receive returns up to max messages which are available, or no messages if the queue is empty.
+	Receive(ctx context.Context, max int) ([]*Message, error)
+
+	// Ack acknowledges messages, so that they are not received again.
+	Ack(ctx context.Context, messages []*Message) error
+}
+
+// Config is the config of a feed, which is stored encrypted because it contains secrets. Exactly one of the fields
+// is set, matching the kind of the feed.
+type Config struct {
+	SQS    *SQSConfig    `json:"sqs,omitempty"`
+	PubSub *PubSubConfig `json:"pubsub,omitempty"`
+}
+
+// Validate checks that the config is complete for the given kind of feed.
+func (c *Config) Validate(kind Kind) error {
+	switch kind {
+	case KindSQS:
+		if c.SQS == nil {
+			return fmt.Errorf("sqs config is required for SQS feeds")
+		}
+
+		return c.SQS.validate()
+	case KindPubSub:
+		if c.PubSub == nil {
+			return fmt.Errorf("pubsub config is required for PUBSUB feeds")
+		}
+
+		return c.PubSub.validate()
+	}
+
+	return fmt.Errorf("unknown feed kind %s", kind)
+}
+
+// Source returns a description of where notifications are received from, which does not contain any secrets.
+func (c *Config) Source(kind Kind) string {
+	switch kind {
+	case KindSQS:
+		return c.SQS.QueueURL
+	case KindPubSub:
+		return c.PubSub.Subscription
+	}
+
+	return ""
+}
+
+// New returns the feed of the given kind.
+func New(ctx context.Context, kind Kind, c *Config) (Feed, error) {
+	if err := c.Validate(kind); err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{
+		Timeout: 30 * time.Second,
+	}
+
+	switch kind {
+	case KindSQS:
+		return newSQSFeed(c.SQS, client), nil
+	case KindPubSub:
+		return newPubSubFeed(ctx, c.PubSub, client)
+	}
+
+	return nil, fmt.Errorf("unknown feed kind %s", kind)
+}
@@ -0,0 +1,351 @@
+package objectstorage
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testS3Notification = `{
+  "Records": [
+    {
+      "eventVersion": "2.1",
+      "eventSource": "aws:s3",
+      "awsRegion": "us-east-1",
+      "eventTime": "2024-04-17T10:15:44.000Z",
+      "eventName": "ObjectCreated:Put",
+      "s3": {
+        "bucket": {"name": "uploads"},
+        "object": {"key": "incoming/a+b%2Bc.csv", "size": 1024, "eTag": "d41d8cd98f00b204e9800998ecf8427e"}
+      }
+    },
+    {
+      "eventVersion": "2.1",
+      "eventSource": "aws:s3",
+      "eventTime": "2024-04-17T10:15:45.000Z",
+      "eventName": "ObjectRemoved:Delete",
+      "s3": {
+        "bucket": {"name": "uploads"},
+        "object": {"key": "incoming/old.csv"}
+      }
+    }
+  ]
+}`
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    Kind
+		config  Config
+		wantErr bool
+	}{
+		{
+			name:   "sqs",
+			kind:   KindSQS,
+			config: Config{SQS: &SQSConfig{QueueURL: "https://sqs.us-east-1.amazonaws.com/123456789012/uploads", Region: "us-east-1", AccessKeyId: "id", SecretAccessKey: "secret"}},
+		},
+		{
+			name:    "sqs without credentials",
+			kind:    KindSQS,
+			config:  Config{SQS: &SQSConfig{QueueURL: "https://sqs.us-east-1.amazonaws.com/123456789012/uploads", Region: "us-east-1"}},
+			wantErr: true,
+		},
+		{
+			name:    "sqs with invalid queue url",
+			kind:    KindSQS,
+			config:  Config{SQS: &SQSConfig{QueueURL: "uploads", Region: "us-east-1", AccessKeyId: "id", SecretAccessKey: "secret"}},
+			wantErr: true,
+		},
+		{
+			name:    "sqs without config",
+			kind:    KindSQS,
+			config:  Config{PubSub: &PubSubConfig{}},
+			wantErr: true,
+		},
+		{
+			name:   "pubsub",
+			kind:   KindPubSub,
+			config: Config{PubSub: &PubSubConfig{Subscription: "projects/p/subscriptions/uploads", CredentialsJSON: `{"type":"service_account"}`}},
+		},
+		{
+			name:   "pubsub emulator without credentials",
+			kind:   KindPubSub,
+			config: Config{PubSub: &PubSubConfig{Subscription: "projects/p/subscriptions/uploads", Endpoint: "http://localhost:8085"}},
+		},
+		{
+			name:    "pubsub without credentials",
+			kind:    KindPubSub,
+			config:  Config{PubSub: &PubSubConfig{Subscription: "projects/p/subscriptions/uploads"}},
+			wantErr: true,
+		},
+		{
+			name:    "pubsub with invalid subscription",
+			kind:    KindPubSub,
+			config:  Config{PubSub: &PubSubConfig{Subscription: "uploads", CredentialsJSON: `{}`}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown kind",
+			kind:    Kind("KAFKA"),
+			config:  Config{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate(tt.kind)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParseS3Notification(t *testing.T) {
+	objects, err := ParseS3Notification([]byte(testS3Notification))
+
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+	assert.Equal(t, Object{
+		Provider:  "s3",
+		Bucket:    "uploads",
+		Key:       "incoming/a b+c.csv",
+		Size:      1024,
+		ETag:      "d41d8cd98f00b204e9800998ecf8427e",
+		EventName: "ObjectCreated:Put",
+		EventTime: time.Date(2024, 4, 17, 10, 15, 44, 0, time.UTC),
+	}, objects[0])
+}
+
+func TestParseS3NotificationFromSNS(t *testing.T) {
+	body, err := json.Marshal(map[string]string{
+		"Type":    "Notification",
+		"Message": testS3Notification,
+	})
+
+	require.NoError(t, err)
+
+	objects, err := ParseS3Notification(body)
+
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+	assert.Equal(t, "incoming/a b+c.csv", objects[0].Key)
+}
+
+func TestParseS3TestEvent(t *testing.T) {
+	objects, err := ParseS3Notification([]byte(`{"Service":"Amazon S3","Event":"s3:TestEvent","Bucket":"uploads"}`))
+
+	require.NoError(t, err)
+	assert.Empty(t, objects)
+
+	_, err = ParseS3Notification([]byte(`not json`))
+
+	assert.Error(t, err)
+}
+
+func TestParseGCSNotification(t *testing.T) {
+	attributes := map[string]string{
+		"eventType":     "OBJECT_FINALIZE",
+		"eventTime":     "2024-04-17T10:15:44.123Z",
+		"bucketId":      "uploads",
+		"objectId":      "incoming/a.csv",
+		"payloadFormat": "JSON_API_V1",
+	}
+
+	data := []byte(`{
+		"bucket": "uploads",
+		"name": "incoming/a.csv",
+		"size": "2048",
+		"etag": "CKih16GjycICEAE=",
+		"contentType": "text/csv",
+		"metadata": {"source": "billing"}
+	}`)
+
+	objects, err := ParseGCSNotification(attributes, data)
+
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+	assert.Equal(t, Object{
+		Provider:    "gcs",
+		Bucket:      "uploads",
+		Key:         "incoming/a.csv",
+		Size:        2048,
+		ETag:        "CKih16GjycICEAE=",
+		ContentType: "text/csv",
+		Metadata:    map[string]string{"source": "billing"},
+		EventName:   "OBJECT_FINALIZE",
+		EventTime:   time.Date(2024, 4, 17, 10, 15, 44, 123000000, time.UTC),
+	}, objects[0])
+
+	// notifications without a payload are read from the attributes
+	attributes["payloadFormat"] = "NONE"
+
+	objects, err = ParseGCSNotification(attributes, nil)
+
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+	assert.Equal(t, "incoming/a.csv", objects[0].Key)
+
+	// notifications of other events contain no objects
+	attributes["eventType"] = "OBJECT_DELETE"
+
+	objects, err = ParseGCSNotification(attributes, nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, objects)
+}
+
+func TestSQSFeed(t *testing.T) {
+	var gotReqs []*http.Request
+	var gotBodies []map[string]interface{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]interface{}{}
+		b, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(b, &body)
+
+		gotReqs = append(gotReqs, r)
+		gotBodies = append(gotBodies, body)
+
+		switch r.Header.Get("X-Amz-Target") {
+		case "AmazonSQS.ReceiveMessage":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"Messages": []map[string]string{
+					{"MessageId": "1", "ReceiptHandle": "handle-1", "Body": testS3Notification},
+					{"MessageId": "2", "ReceiptHandle": "handle-2", "Body": "not json"},
+				},
+			})
+		case "AmazonSQS.DeleteMessageBatch":
+			_, _ = w.Write([]byte(`{"Successful":[{"Id":"0"},{"Id":"1"}]}`))
+		}
+	}))
+	defer srv.Close()
+
+	feed := newSQSFeed(&SQSConfig{
+		QueueURL:        "https://sqs.us-east-1.amazonaws.com/123456789012/uploads",
+		Region:          "us-east-1",
+		AccessKeyId:     "id",
+		SecretAccessKey: "secret",
+		Endpoint:        srv.URL,
+	}, srv.Client())
+
+	feed.now = func() time.Time {
+		return time.Date(2024, 4, 17, 10, 15, 44, 0, time.UTC)
+	}
+
+	messages, err := feed.Receive(context.Background(), 100)
+
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	assert.Len(t, messages[0].Objects, 1)
+	assert.NoError(t, messages[0].Err)
+	assert.Error(t, messages[1].Err)
+
+	assert.Equal(t, "application/x-amz-json-1.0", gotReqs[0].Header.Get("Content-Type"))
+	assert.Equal(t, "20240417T101544Z", gotReqs[0].Header.Get("X-Amz-Date"))
+	assert.True(t, strings.HasPrefix(
+		gotReqs[0].Header.Get("Authorization"),
+		"AWS4-HMAC-SHA256 Credential=id/20240417/us-east-1/sqs/aws4_request, SignedHeaders=content-type;host;x-amz-date;x-amz-target, Signature=",
+	))
+	assert.Equal(t, "https://sqs.us-east-1.amazonaws.com/123456789012/uploads", gotBodies[0]["QueueUrl"])
+	assert.EqualValues(t, 10, gotBodies[0]["MaxNumberOfMessages"])
+
+	require.NoError(t, feed.Ack(context.Background(), messages))
+
+	entries := gotBodies[1]["Entries"].([]interface{})
+
+	require.Len(t, entries, 2)
+	assert.Equal(t, "handle-2", entries[1].(map[string]interface{})["ReceiptHandle"])
+}
+
+func TestSQSFeedError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"__type":"com.amazonaws.sqs#QueueDoesNotExist","message":"The specified queue does not exist."}`))
+	}))
+	defer srv.Close()
+
+	feed := newSQSFeed(&SQSConfig{
+		QueueURL:        srv.URL + "/123456789012/uploads",
+		Region:          "us-east-1",
+		AccessKeyId:     "id",
+		SecretAccessKey: "secret",
+	}, srv.Client())
+
+	_, err := feed.Receive(context.Background(), 10)
+
+	assert.ErrorContains(t, err, "The specified queue does not exist.")
+}
+
+func TestPubSubFeed(t *testing.T) {
+	var gotPaths []string
+	var gotBodies []map[string]interface{}
+
+	data := base64.StdEncoding.EncodeToString([]byte(`{"bucket":"uploads","name":"incoming/a.csv","size":"10"}`))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]interface{}{}
+		b, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(b, &body)
+
+		gotPaths = append(gotPaths, r.URL.Path)
+		gotBodies = append(gotBodies, body)
+
+		if strings.HasSuffix(r.URL.Path, ":pull") {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"receivedMessages": []map[string]interface{}{
+					{
+						"ackId": "ack-1",
+						"message": map[string]interface{}{
+							"messageId": "1",
+							"data":      data,
+							"attributes": map[string]string{
+								"eventType":     "OBJECT_FINALIZE",
+								"payloadFormat": "JSON_API_V1",
+							},
+						},
+					},
+				},
+			})
+			return
+		}
+
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	feed, err := newPubSubFeed(context.Background(), &PubSubConfig{
+		Subscription: "projects/p/subscriptions/uploads",
+		Endpoint:     srv.URL,
+	}, srv.Client())
+
+	require.NoError(t, err)
+
+	messages, err := feed.Receive(context.Background(), 10)
+
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	require.Len(t, messages[0].Objects, 1)
+	assert.Equal(t, int64(10), messages[0].Objects[0].Size)
+
+	require.NoError(t, feed.Ack(context.Background(), messages))
+
+	assert.Equal(t, []string{
+		"/v1/projects/p/subscriptions/uploads:pull",
+		"/v1/projects/p/subscriptions/uploads:acknowledge",
+	}, gotPaths)
+	assert.Equal(t, []interface{}{"ack-1"}, gotBodies[1]["ackIds"])
+}
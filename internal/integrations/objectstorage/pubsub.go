@@ -0,0 +1,209 @@
+package objectstorage
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+const pubSubScope = "https://www.googleapis.com/auth/pubsub"
+
+var subscriptionPattern = regexp.MustCompile(`^projects/[^/]+/subscriptions/[^/]+$`)
+
+type PubSubConfig struct {
+	// the subscription which GCS notifications are pulled from, like projects/my-project/subscriptions/my-subscription
+	Subscription string `json:"subscription"`
+
+	// the JSON key of the service account which is used to pull and acknowledge messages
+	CredentialsJSON string `json:"credentialsJson,omitempty"`
+
+	// (optional) the endpoint of a Pub/Sub-compatible service like the Pub/Sub emulator, defaults to the Google
+	// endpoint. Credentials are optional if an endpoint is set.
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+func (c *PubSubConfig) validate() error {
+	if !subscriptionPattern.MatchString(c.Subscription) {
+		return fmt.Errorf("subscription must be of the form projects/{project}/subscriptions/{subscription}")
+	}
+
+	if c.Endpoint != "" {
+		if err := validateHTTPURL(c.Endpoint); err != nil {
+			return fmt.Errorf("endpoint must be an http or https url")
+		}
+	} else if c.CredentialsJSON == "" {
+		return fmt.Errorf("credentials json is required")
+	}
+
+	if c.CredentialsJSON != "" && !json.Valid([]byte(c.CredentialsJSON)) {
+		return fmt.Errorf("credentials json must be valid json")
+	}
+
+	return nil
+}
+
+// pubSubFeed pulls GCS notifications from a Pub/Sub subscription with the Pub/Sub REST API.
+type pubSubFeed struct {
+	endpoint     string
+	subscription string
+	client       *http.Client
+}
+
+func newPubSubFeed(ctx context.Context, c *PubSubConfig, client *http.Client) (*pubSubFeed, error) {
+	endpoint := c.Endpoint
+
+	if endpoint == "" {
+		endpoint = "https://pubsub.googleapis.com"
+	}
+
+	if c.CredentialsJSON != "" {
+		// tokens are fetched with the client of the context
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, client)
+
+		creds, err := google.CredentialsFromJSON(ctx, []byte(c.CredentialsJSON), pubSubScope)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not parse credentials: %w", err)
+		}
+
+		timeout := client.Timeout
+
+		client = oauth2.NewClient(ctx, creds.TokenSource)
+		client.Timeout = timeout
+	}
+
+	return &pubSubFeed{
+		endpoint:     strings.TrimSuffix(endpoint, "/"),
+		subscription: c.Subscription,
+		client:       client,
+	}, nil
+}
+
+func (f *pubSubFeed) Receive(ctx context.Context, max int) ([]*Message, error) {
+	res := struct {
+		ReceivedMessages []struct {
+			AckId   string `json:"ackId"`
+			Message struct {
+				MessageId  string            `json:"messageId"`
+				Data       string            `json:"data"`
+				Attributes map[string]string `json:"attributes"`
+			} `json:"message"`
+		} `json:"receivedMessages"`
+	}{}
+
+	err := f.do(ctx, "pull", map[string]interface{}{
+		"maxMessages": max,
+	}, &res)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not pull messages: %w", err)
+	}
+
+	messages := make([]*Message, 0, len(res.ReceivedMessages))
+
+	for _, m := range res.ReceivedMessages {
+		var objects []Object
+
+		data, err := base64.StdEncoding.DecodeString(m.Message.Data)
+
+		if err == nil {
+			objects, err = ParseGCSNotification(m.Message.Attributes, data)
+		}
+
+		if err != nil {
+			err = fmt.Errorf("could not parse message %s: %w", m.Message.MessageId, err)
+		}
+
+		messages = append(messages, &Message{
+			Objects: objects,
+			Err:     err,
+			ackId:   m.AckId,
+		})
+	}
+
+	return messages, nil
+}
+
+func (f *pubSubFeed) Ack(ctx context.Context, messages []*Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	ackIds := make([]string, 0, len(messages))
+
+	for _, m := range messages {
+		ackIds = append(ackIds, m.ackId)
+	}
+
+	err := f.do(ctx, "acknowledge", map[string]interface{}{
+		"ackIds": ackIds,
+	}, &struct{}{})
+
+	if err != nil {
+		return fmt.Errorf("could not acknowledge messages: %w", err)
+	}
+
+	return nil
+}
+
+// do calls a method of the subscription and decodes the response into res.
+func (f *pubSubFeed) do(ctx context.Context, method string, body map[string]interface{}, res interface{}) error {
+	bodyBytes, err := json.Marshal(body)
+
+	if err != nil {
+		return fmt.Errorf("could not marshal request: %w", err)
+	}
+
+	u := fmt.Sprintf("%s/v1/%s:%s", f.endpoint, f.subscription, method)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(bodyBytes))
+
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := f.client.Do(req)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	respBytes, err := io.ReadAll(io.LimitReader(resp.Body, 10<<20))
+
+	if err != nil {
+		return fmt.Errorf("could not read response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		errRes := struct {
+			Error struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}{}
+
+		if json.Unmarshal(respBytes, &errRes) == nil && errRes.Error.Message != "" {
+			return fmt.Errorf("pubsub responded with status %d: %s", resp.StatusCode, errRes.Error.Message)
+		}
+
+		return fmt.Errorf("pubsub responded with status %d", resp.StatusCode)
+	}
+
+	if err := json.Unmarshal(respBytes, res); err != nil {
+		return fmt.Errorf("could not unmarshal response: %w", err)
+	}
+
+	return nil
+}
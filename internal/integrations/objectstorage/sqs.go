@@ -0,0 +1,299 @@
+package objectstorage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type SQSConfig struct {
+	// the url of the queue which S3 event notifications are sent to
+	QueueURL string `json:"queueUrl"`
+
+	// the region of the queue
+	Region string `json:"region"`
+
+	// the credentials which are used to receive and delete messages
+	AccessKeyId     string `json:"accessKeyId"`
+	SecretAccessKey string `json:"secretAccessKey"`
+
+	// (optional) the endpoint of an SQS-compatible service, defaults to the host of the queue url
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+func (c *SQSConfig) validate() error {
+	if err := validateHTTPURL(c.QueueURL); err != nil {
+		return fmt.Errorf("queue url must be an http or https url")
+	}
+
+	if c.Region == "" {
+		return fmt.Errorf("region is required")
+	}
+
+	if c.AccessKeyId == "" || c.SecretAccessKey == "" {
+		return fmt.Errorf("access key id and secret access key are required")
+	}
+
+	if c.Endpoint != "" {
+		if err := validateHTTPURL(c.Endpoint); err != nil {
+			return fmt.Errorf("endpoint must be an http or https url")
+		}
+	}
+
+	return nil
+}
+
+// sqsFeed receives S3 event notifications from an SQS queue, with the JSON protocol of SQS and requests signed with
+// AWS signature version 4, so that SQS-compatible services are supported as well.
+type sqsFeed struct {
+	endpoint        string
+	queueURL        string
+	region          string
+	accessKeyId     string
+	secretAccessKey string
+	client          *http.Client
+
+	now func() time.Time
+}
+
+func newSQSFeed(c *SQSConfig, client *http.Client) *sqsFeed {
+	endpoint := c.Endpoint
+
+	if endpoint == "" {
+		// the queue url is validated, so it can be parsed
+		u, _ := url.Parse(c.QueueURL)
+		endpoint = u.Scheme + "://" + u.Host
+	}
+
+	return &sqsFeed{
+		endpoint:        strings.TrimSuffix(endpoint, "/") + "/",
+		queueURL:        c.QueueURL,
+		region:          c.Region,
+		accessKeyId:     c.AccessKeyId,
+		secretAccessKey: c.SecretAccessKey,
+		client:          client,
+		now:             time.Now,
+	}
+}
+
+type sqsMessage struct {
+	MessageId     string `json:"MessageId"`
+	ReceiptHandle string `json:"ReceiptHandle"`
+	Body          string `json:"Body"`
+}
+
+func (f *sqsFeed) Receive(ctx context.Context, max int) ([]*Message, error) {
+	// SQS returns at most 10 messages at once
+	if max > 10 {
+		max = 10
+	}
+
+	res := struct {
+		Messages []sqsMessage `json:"Messages"`
+	}{}
+
+	err := f.do(ctx, "ReceiveMessage", map[string]interface{}{
+		"QueueUrl":            f.queueURL,
+		"MaxNumberOfMessages": max,
+		"WaitTimeSeconds":     0,
+	}, &res)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not receive messages: %w", err)
+	}
+
+	messages := make([]*Message, 0, len(res.Messages))
+
+	for _, m := range res.Messages {
+		objects, err := ParseS3Notification([]byte(m.Body))
+
+		if err != nil {
+			err = fmt.Errorf("could not parse message %s: %w", m.MessageId, err)
+		}
+
+		messages = append(messages, &Message{
+			Objects: objects,
+			Err:     err,
+			ackId:   m.ReceiptHandle,
+		})
+	}
+
+	return messages, nil
+}
+
+func (f *sqsFeed) Ack(ctx context.Context, messages []*Message) error {
+	// SQS deletes at most 10 messages at once
+	for start := 0; start < len(messages); start += 10 {
+		end := start + 10
+
+		if end > len(messages) {
+			end = len(messages)
+		}
+
+		entries := make([]map[string]string, 0, end-start)
+
+		for i, m := range messages[start:end] {
+			entries = append(entries, map[string]string{
+				"Id":            strconv.Itoa(i),
+				"ReceiptHandle": m.ackId,
+			})
+		}
+
+		res := struct {
+			Failed []struct {
+				Id      string `json:"Id"`
+				Code    string `json:"Code"`
+				Message string `json:"Message"`
+			} `json:"Failed"`
+		}{}
+
+		err := f.do(ctx, "DeleteMessageBatch", map[string]interface{}{
+			"QueueUrl": f.queueURL,
+			"Entries":  entries,
+		}, &res)
+
+		if err != nil {
+			return fmt.Errorf("could not delete messages: %w", err)
+		}
+
+		if len(res.Failed) > 0 {
+			return fmt.Errorf("could not delete %d messages: %s: %s", len(res.Failed), res.Failed[0].Code, res.Failed[0].Message)
+		}
+	}
+
+	return nil
+}
+
+// do calls an action of the SQS JSON protocol and decodes the response into res.
+func (f *sqsFeed) do(ctx context.Context, action string, body map[string]interface{}, res interface{}) error {
+	bodyBytes, err := json.Marshal(body)
+
+	if err != nil {
+		return fmt.Errorf("could not marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.endpoint, bytes.NewReader(bodyBytes))
+
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-amz-json-1.0")
+	req.Header.Set("X-Amz-Target", "AmazonSQS."+action)
+
+	f.signRequest(req, bodyBytes, f.now().UTC())
+
+	resp, err := f.client.Do(req)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	respBytes, err := io.ReadAll(io.LimitReader(resp.Body, 10<<20))
+
+	if err != nil {
+		return fmt.Errorf("could not read response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		errRes := struct {
+			Type    string `json:"__type"`
+			Message string `json:"message"`
+		}{}
+
+		if json.Unmarshal(respBytes, &errRes) == nil && errRes.Message != "" {
+			return fmt.Errorf("sqs responded with status %d: %s", resp.StatusCode, errRes.Message)
+		}
+
+		return fmt.Errorf("sqs responded with status %d", resp.StatusCode)
+	}
+
+	if err := json.Unmarshal(respBytes, res); err != nil {
+		return fmt.Errorf("could not unmarshal response: %w", err)
+	}
+
+	return nil
+}
+
+func (f *sqsFeed) signRequest(req *http.Request, body []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	signedHeaders := "content-type;host;x-amz-date;x-amz-target"
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		"",
+		"content-type:" + req.Header.Get("Content-Type"),
+		"host:" + req.URL.Host,
+		"x-amz-date:" + amzDate,
+		"x-amz-target:" + req.Header.Get("X-Amz-Target"),
+		"",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/sqs/aws4_request", date, f.region)
+
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+f.secretAccessKey), date)
+	key = hmacSHA256(key, f.region)
+	key = hmacSHA256(key, "sqs")
+	key = hmacSHA256(key, "aws4_request")
+
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		f.accessKeyId,
+		scope,
+		signedHeaders,
+		signature,
+	))
+}
+
+func validateHTTPURL(s string) error {
+	u, err := url.Parse(s)
+
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid url")
+	}
+
+	return nil
+}
+
+func sha256Hex(b []byte) string {
+	h := sha256.Sum256(b)
+
+	return hex.EncodeToString(h[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+
+	return h.Sum(nil)
+}
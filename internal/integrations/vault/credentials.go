@@ -0,0 +1,133 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Credentials are a username and password which were read from a Vault secret. Dynamic credentials are renewed
+// while the client is open, and replaced with new credentials once their lease can't be renewed any longer, so
+// Get should be called whenever a new connection is opened.
+type Credentials struct {
+	mu       sync.RWMutex
+	username string
+	password string
+}
+
+// Get returns the current username and password.
+func (cr *Credentials) Get() (username, password string) {
+	cr.mu.RLock()
+	defer cr.mu.RUnlock()
+
+	return cr.username, cr.password
+}
+
+func (cr *Credentials) set(username, password string) {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+
+	cr.username = username
+	cr.password = password
+}
+
+// URL returns the url with the current username and password as its user info.
+func (cr *Credentials) URL(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+
+	if err != nil {
+		return "", fmt.Errorf("could not parse url: %w", err)
+	}
+
+	u.User = url.UserPassword(cr.Get())
+
+	return u.String(), nil
+}
+
+// Credentials reads the username and password keys of the secret at a path. If the secret has a renewable lease,
+// the lease is renewed in the background until the client is closed.
+func (c *Client) Credentials(ctx context.Context, path string) (*Credentials, error) {
+	secret, err := c.readCredentials(ctx, path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cr := &Credentials{}
+	cr.set(secret.String("username"), secret.String("password"))
+
+	if secret.LeaseID != "" && secret.LeaseDuration > 0 {
+		go c.renewCredentials(path, cr, secret)
+	}
+
+	return cr, nil
+}
+
+func (c *Client) readCredentials(ctx context.Context, path string) (*Secret, error) {
+	secret, err := c.Read(ctx, path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if secret.String("username") == "" || secret.String("password") == "" {
+		return nil, fmt.Errorf("secret %s must have a username and password", path)
+	}
+
+	return secret, nil
+}
+
+// renewCredentials renews the lease of credentials when two thirds of it have passed. Once the lease can't be
+// renewed for its full duration, because renewing failed or the lease is reaching its max TTL, new credentials
+// are read. The old credentials stay valid until their lease ends, so open connections aren't interrupted.
+func (c *Client) renewCredentials(path string, cr *Credentials, secret *Secret) {
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-time.After(renewAfter(secret.LeaseDuration)):
+		}
+
+		if secret.Renewable {
+			renewed, err := c.renewLease(c.ctx, secret.LeaseID, secret.LeaseDuration)
+
+			if err == nil && renewed.LeaseDuration >= secret.LeaseDuration {
+				continue
+			}
+
+			if err != nil && c.ctx.Err() == nil {
+				c.l.Warn().Err(err).Msgf("could not renew lease of vault secret %s, reading new credentials", path)
+			}
+		}
+
+		next, err := c.readCredentials(c.ctx, path)
+
+		for err != nil {
+			if c.ctx.Err() != nil {
+				return
+			}
+
+			c.l.Err(err).Msgf("could not read new credentials from vault secret %s", path)
+
+			select {
+			case <-c.ctx.Done():
+				return
+			case <-time.After(retryInterval):
+			}
+
+			next, err = c.readCredentials(c.ctx, path)
+		}
+
+		cr.set(next.String("username"), next.String("password"))
+
+		c.l.Info().Msgf("read new credentials from vault secret %s", path)
+
+		if next.LeaseID == "" || next.LeaseDuration <= 0 {
+			return
+		}
+
+		secret = next
+	}
+}
@@ -0,0 +1,465 @@
+// Package vault reads the secrets of the engine from HashiCorp Vault at boot, and keeps the token of the engine and
+// the leases of dynamic credentials renewed while it runs.
+package vault
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/config/shared"
+)
+
+const (
+	AuthMethodToken      = "token"
+	AuthMethodAppRole    = "approle"
+	AuthMethodKubernetes = "kubernetes"
+)
+
+const (
+	// requestTimeout is the maximum time which a request to Vault can take.
+	requestTimeout = 10 * time.Second
+
+	// retryInterval is how long the renewers wait before retrying after Vault couldn't be reached.
+	retryInterval = 10 * time.Second
+)
+
+// Secret is a secret which was read from Vault. Secrets from the KV v2 secrets engine are unwrapped, so Data is
+// always the data of the secret.
+type Secret struct {
+	LeaseID       string
+	LeaseDuration time.Duration
+	Renewable     bool
+	Data          map[string]interface{}
+}
+
+// String returns the value of a key of the secret, or an empty string if the key isn't set or isn't a string.
+func (s *Secret) String(key string) string {
+	v, _ := s.Data[key].(string)
+	return v
+}
+
+type Client struct {
+	address    string
+	namespace  string
+	authMethod string
+	cf         *shared.VaultConfigFile
+	client     *http.Client
+	l          *zerolog.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	tokenMu sync.RWMutex
+	token   string
+}
+
+// New logs in to Vault with the auth method of the config, and keeps the token renewed until the client is closed.
+func New(cf *shared.VaultConfigFile, l *zerolog.Logger) (*Client, error) {
+	if !cf.Enabled() {
+		return nil, fmt.Errorf("vault address is required")
+	}
+
+	httpClient := &http.Client{
+		Timeout: requestTimeout,
+	}
+
+	if cf.CACertFile != "" {
+		caCert, err := os.ReadFile(cf.CACertFile)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not read vault CA certificate: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("could not parse vault CA certificate")
+		}
+
+		httpClient.Transport = &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs:    pool,
+				MinVersion: tls.VersionTLS12,
+			},
+		}
+	}
+
+	authMethod := cf.AuthMethod
+
+	if authMethod == "" {
+		authMethod = AuthMethodToken
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c := &Client{
+		address:    strings.TrimSuffix(cf.Address, "/"),
+		namespace:  cf.Namespace,
+		authMethod: authMethod,
+		cf:         cf,
+		client:     httpClient,
+		l:          l,
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+
+	auth, err := c.login(ctx)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not log in to vault: %w", err)
+	}
+
+	if auth.renewable && auth.ttl > 0 {
+		go c.renewToken(auth)
+	}
+
+	return c, nil
+}
+
+// Close stops renewing the token and the leases of credentials. The leases aren't revoked, so that credentials
+// which are still in use, for example by other replicas of the engine, expire at the end of their lease.
+func (c *Client) Close() {
+	c.cancel()
+}
+
+// Read reads the secret at a path, like secret/data/hatchet for the KV v2 secrets engine or database/creds/hatchet
+// for dynamic credentials.
+func (c *Client) Read(ctx context.Context, path string) (*Secret, error) {
+	res := &secretResponse{}
+
+	if err := c.do(ctx, http.MethodGet, "/v1/"+strings.TrimPrefix(path, "/"), nil, res); err != nil {
+		return nil, fmt.Errorf("could not read secret %s: %w", path, err)
+	}
+
+	if res.Data == nil {
+		return nil, fmt.Errorf("secret %s has no data", path)
+	}
+
+	return res.secret(), nil
+}
+
+// renewLease renews the lease of a secret for the given duration. Vault can renew the lease for a shorter duration,
+// once the lease nears its max TTL.
+func (c *Client) renewLease(ctx context.Context, leaseId string, increment time.Duration) (*Secret, error) {
+	res := &secretResponse{}
+
+	err := c.do(ctx, http.MethodPut, "/v1/sys/leases/renew", map[string]interface{}{
+		"lease_id":  leaseId,
+		"increment": int(increment.Seconds()),
+	}, res)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res.secret(), nil
+}
+
+type authInfo struct {
+	token     string
+	ttl       time.Duration
+	renewable bool
+}
+
+func (c *Client) login(ctx context.Context) (*authInfo, error) {
+	var path string
+	var body map[string]interface{}
+
+	switch c.authMethod {
+	case AuthMethodToken:
+		token, err := readValueOrFile(c.cf.Token, c.cf.TokenFile)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not read token: %w", err)
+		}
+
+		if token == "" {
+			return nil, fmt.Errorf("vault token is required")
+		}
+
+		c.setToken(token)
+
+		// look the token up to check that it is valid, and whether it expires
+		res := &struct {
+			Data struct {
+				TTL       int  `json:"ttl"`
+				Renewable bool `json:"renewable"`
+			} `json:"data"`
+		}{}
+
+		if err := c.do(ctx, http.MethodGet, "/v1/auth/token/lookup-self", nil, res); err != nil {
+			return nil, err
+		}
+
+		return &authInfo{
+			token:     token,
+			ttl:       time.Duration(res.Data.TTL) * time.Second,
+			renewable: res.Data.Renewable,
+		}, nil
+	case AuthMethodAppRole:
+		secretId, err := readValueOrFile(c.cf.AppRole.SecretID, c.cf.AppRole.SecretIDFile)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not read approle secret id: %w", err)
+		}
+
+		if c.cf.AppRole.RoleID == "" {
+			return nil, fmt.Errorf("approle role id is required")
+		}
+
+		path = fmt.Sprintf("/v1/auth/%s/login", mountPath(c.cf.AppRole.MountPath, AuthMethodAppRole))
+		body = map[string]interface{}{
+			"role_id":   c.cf.AppRole.RoleID,
+			"secret_id": secretId,
+		}
+	case AuthMethodKubernetes:
+		jwt, err := readValueOrFile("", c.cf.Kubernetes.TokenFile)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not read kubernetes service account token: %w", err)
+		}
+
+		if c.cf.Kubernetes.Role == "" {
+			return nil, fmt.Errorf("kubernetes role is required")
+		}
+
+		path = fmt.Sprintf("/v1/auth/%s/login", mountPath(c.cf.Kubernetes.MountPath, AuthMethodKubernetes))
+		body = map[string]interface{}{
+			"role": c.cf.Kubernetes.Role,
+			"jwt":  jwt,
+		}
+	default:
+		return nil, fmt.Errorf("unknown vault auth method %q", c.authMethod)
+	}
+
+	res := &authResponse{}
+
+	if err := c.do(ctx, http.MethodPost, path, body, res); err != nil {
+		return nil, err
+	}
+
+	if res.Auth == nil || res.Auth.ClientToken == "" {
+		return nil, fmt.Errorf("vault did not return a token")
+	}
+
+	auth := res.info()
+
+	c.setToken(auth.token)
+
+	return auth, nil
+}
+
+// renewToken renews the token of the client when two thirds of its TTL have passed. If the token can't be renewed
+// for its full TTL any longer, the client logs in again, which isn't possible with the token auth method.
+func (c *Client) renewToken(auth *authInfo) {
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-time.After(renewAfter(auth.ttl)):
+		}
+
+		res := &authResponse{}
+
+		err := c.do(c.ctx, http.MethodPost, "/v1/auth/token/renew-self", map[string]interface{}{
+			"increment": int(auth.ttl.Seconds()),
+		}, res)
+
+		if err == nil && res.Auth != nil {
+			renewed := res.info()
+
+			if renewed.ttl >= auth.ttl {
+				c.setToken(renewed.token)
+				continue
+			}
+		}
+
+		if c.ctx.Err() != nil {
+			return
+		}
+
+		if c.authMethod == AuthMethodToken {
+			if err != nil {
+				c.l.Err(err).Msg("could not renew vault token")
+			} else {
+				c.l.Warn().Msg("vault token is reaching its max TTL and can't be renewed any longer")
+			}
+
+			return
+		}
+
+		next, err := c.login(c.ctx)
+
+		for err != nil {
+			c.l.Err(err).Msg("could not log in to vault again")
+
+			select {
+			case <-c.ctx.Done():
+				return
+			case <-time.After(retryInterval):
+			}
+
+			next, err = c.login(c.ctx)
+		}
+
+		if !next.renewable || next.ttl <= 0 {
+			return
+		}
+
+		auth = next
+	}
+}
+
+func (c *Client) getToken() string {
+	c.tokenMu.RLock()
+	defer c.tokenMu.RUnlock()
+
+	return c.token
+}
+
+func (c *Client) setToken(token string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	c.token = token
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body interface{}, res interface{}) error {
+	var reqBody io.Reader
+
+	if body != nil {
+		b, err := json.Marshal(body)
+
+		if err != nil {
+			return fmt.Errorf("could not marshal request: %w", err)
+		}
+
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.address+path, reqBody)
+
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if token := c.getToken(); token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+
+	if c.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.namespace)
+	}
+
+	resp, err := c.client.Do(req)
+
+	if err != nil {
+		return fmt.Errorf("could not reach vault: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		errRes := &struct {
+			Errors []string `json:"errors"`
+		}{}
+
+		if err := json.NewDecoder(resp.Body).Decode(errRes); err == nil && len(errRes.Errors) > 0 {
+			return fmt.Errorf("vault returned status %d: %s", resp.StatusCode, strings.Join(errRes.Errors, ", "))
+		}
+
+		return fmt.Errorf("vault returned status %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(res); err != nil {
+		return fmt.Errorf("could not decode response: %w", err)
+	}
+
+	return nil
+}
+
+type secretResponse struct {
+	LeaseID       string                 `json:"lease_id"`
+	LeaseDuration int                    `json:"lease_duration"`
+	Renewable     bool                   `json:"renewable"`
+	Data          map[string]interface{} `json:"data"`
+}
+
+func (r *secretResponse) secret() *Secret {
+	data := r.Data
+
+	// the data of KV v2 secrets is nested under data, next to the metadata of the version
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		if _, ok := data["metadata"].(map[string]interface{}); ok {
+			data = nested
+		}
+	}
+
+	return &Secret{
+		LeaseID:       r.LeaseID,
+		LeaseDuration: time.Duration(r.LeaseDuration) * time.Second,
+		Renewable:     r.Renewable,
+		Data:          data,
+	}
+}
+
+type authResponse struct {
+	Auth *struct {
+		ClientToken   string `json:"client_token"`
+		LeaseDuration int    `json:"lease_duration"`
+		Renewable     bool   `json:"renewable"`
+	} `json:"auth"`
+}
+
+func (r *authResponse) info() *authInfo {
+	return &authInfo{
+		token:     r.Auth.ClientToken,
+		ttl:       time.Duration(r.Auth.LeaseDuration) * time.Second,
+		renewable: r.Auth.Renewable,
+	}
+}
+
+// renewAfter returns how long to wait before renewing a lease or token with the given TTL, which leaves a third of
+// the TTL to retry if Vault can't be reached.
+func renewAfter(ttl time.Duration) time.Duration {
+	return ttl * 2 / 3
+}
+
+func mountPath(path, defaultPath string) string {
+	if path == "" {
+		return defaultPath
+	}
+
+	return strings.Trim(path, "/")
+}
+
+func readValueOrFile(value, file string) (string, error) {
+	if file == "" {
+		return value, nil
+	}
+
+	b, err := os.ReadFile(file)
+
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(b)), nil
+}
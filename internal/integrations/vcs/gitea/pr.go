@@ -0,0 +1,104 @@
+package gitea
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/vcs"
+)
+
+// PullRequestEvent is the payload of a Gitea pull_request webhook delivery.
+type PullRequestEvent struct {
+	Action      string      `json:"action"`
+	Number      int64       `json:"number"`
+	PullRequest PullRequest `json:"pull_request"`
+	Repository  Repository  `json:"repository"`
+}
+
+type PullRequest struct {
+	ID        int64      `json:"id"`
+	Number    int64      `json:"number"`
+	Title     string     `json:"title"`
+	State     string     `json:"state"`
+	HTMLURL   string     `json:"html_url"`
+	Merged    bool       `json:"merged"`
+	Head      Branch     `json:"head"`
+	Base      Branch     `json:"base"`
+	User      User       `json:"user"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+type Branch struct {
+	Ref string `json:"ref"`
+	Sha string `json:"sha"`
+}
+
+type User struct {
+	Login string `json:"login"`
+}
+
+type Repository struct {
+	Name  string `json:"name"`
+	Owner User   `json:"owner"`
+}
+
+// ParsePullRequestEvent parses the payload of a pull_request webhook delivery.
+func ParsePullRequestEvent(payload []byte) (*PullRequestEvent, error) {
+	event := &PullRequestEvent{}
+
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, fmt.Errorf("could not parse pull request event: %w", err)
+	}
+
+	return event, nil
+}
+
+type giteaPullRequest struct {
+	repoOwner, repoName string
+	pr                  *PullRequest
+}
+
+func ToVCSRepositoryPullRequest(repoOwner, repoName string, pr *PullRequest) vcs.VCSRepositoryPullRequest {
+	return &giteaPullRequest{repoOwner, repoName, pr}
+}
+
+func (g *giteaPullRequest) GetRepoOwner() string {
+	return g.repoOwner
+}
+
+func (g *giteaPullRequest) GetRepoName() string {
+	return g.repoName
+}
+
+func (g *giteaPullRequest) GetVCSID() vcs.VCSObjectID {
+	return vcs.NewVCSObjectInt(g.pr.ID)
+}
+
+func (g *giteaPullRequest) GetPRNumber() int64 {
+	return g.pr.Number
+}
+
+func (g *giteaPullRequest) GetBaseSHA() string {
+	return g.pr.Base.Sha
+}
+
+func (g *giteaPullRequest) GetHeadSHA() string {
+	return g.pr.Head.Sha
+}
+
+func (g *giteaPullRequest) GetBaseBranch() string {
+	return g.pr.Base.Ref
+}
+
+func (g *giteaPullRequest) GetHeadBranch() string {
+	return g.pr.Head.Ref
+}
+
+func (g *giteaPullRequest) GetTitle() string {
+	return g.pr.Title
+}
+
+func (g *giteaPullRequest) GetState() string {
+	return g.pr.State
+}
@@ -0,0 +1,76 @@
+// Package gitea parses the webhooks of Gitea and of Gitea-compatible forges such as Forgejo, which are commonly
+// self-hosted.
+package gitea
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const (
+	// EventTypeHeader is the header which contains the event type of a webhook delivery
+	EventTypeHeader = "X-Gitea-Event"
+
+	// DeliveryHeader is the header which contains the id of a webhook delivery
+	DeliveryHeader = "X-Gitea-Delivery"
+
+	// SignatureHeader is the header which contains the hex-encoded HMAC-SHA256 signature of the payload of a webhook
+	// delivery
+	SignatureHeader = "X-Gitea-Signature"
+
+	EventTypePullRequest = "pull_request"
+)
+
+// WebHookType returns the event type of a webhook delivery.
+func WebHookType(r *http.Request) string {
+	return r.Header.Get(EventTypeHeader)
+}
+
+// DeliveryID returns the id of a webhook delivery.
+func DeliveryID(r *http.Request) string {
+	return r.Header.Get(DeliveryHeader)
+}
+
+// ValidatePayload reads the payload of a webhook delivery, and validates it against the signature of the delivery
+// with the given secret.
+func ValidatePayload(r *http.Request, secret []byte) ([]byte, error) {
+	signature := r.Header.Get(SignatureHeader)
+
+	if signature == "" {
+		return nil, fmt.Errorf("missing %s header", SignatureHeader)
+	}
+
+	payload, err := io.ReadAll(r.Body)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read payload: %w", err)
+	}
+
+	if err := ValidateSignature(signature, payload, secret); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// ValidateSignature validates the hex-encoded HMAC-SHA256 signature of a payload with the given secret.
+func ValidateSignature(signature string, payload, secret []byte) error {
+	decoded, err := hex.DecodeString(signature)
+
+	if err != nil {
+		return fmt.Errorf("invalid signature encoding: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload) // nolint: errcheck
+
+	if !hmac.Equal(decoded, mac.Sum(nil)) {
+		return fmt.Errorf("payload signature does not match")
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"net/url"
 
 	githubsdk "github.com/google/go-github/v57/github"
@@ -45,6 +46,10 @@ func (g GithubVCSProvider) GetGithubAppConfig() *GithubAppConf {
 	return g.appConf
 }
 
+func (g GithubVCSProvider) GetEncryption() encryption.EncryptionService {
+	return g.enc
+}
+
 func (g GithubVCSProvider) GetVCSRepositoryFromWorkflow(workflow *db.WorkflowModel) (vcs.VCSRepository, error) {
 	var installationId string
 	var deploymentConf *db.WorkflowDeploymentConfigModel
@@ -123,18 +128,8 @@ func (g *GithubVCSRepository) SetupRepository(tenantId string) error {
 			return err
 		}
 
-		webhookURL := fmt.Sprintf("%s/api/v1/github/webhook/%s", g.webhookURL, gw.ID)
-
 		_, _, err = g.client.Repositories.CreateHook(
-			context.Background(), repoOwner, repoName, &githubsdk.Hook{
-				Config: map[string]interface{}{
-					"url":          webhookURL,
-					"content_type": "json",
-					"secret":       signingSecret,
-				},
-				Events: []string{"pull_request", "push"},
-				Active: githubsdk.Bool(true),
-			},
+			context.Background(), repoOwner, repoName, newRepositoryHook(webhookURL(g.webhookURL, gw.ID), signingSecret),
 		)
 
 		return err
@@ -362,3 +357,32 @@ func (g *GithubVCSRepository) CompareCommits(base, head string) (vcs.VCSCommitsC
 
 	return &GithubCommitsComparison{commitsRes}, nil
 }
+
+// CreateOrUpdatePullRequestComment comments on a pull request, or updates the comment with the given id if it's set
+// and still exists
+func (g *GithubVCSRepository) CreateOrUpdatePullRequestComment(prNumber int, commentId *int64, body string) (int64, error) {
+	comment := &githubsdk.IssueComment{
+		Body: githubsdk.String(body),
+	}
+
+	if commentId != nil {
+		updated, resp, err := g.client.Issues.EditComment(context.Background(), g.GetRepoOwner(), g.GetRepoName(), *commentId, comment)
+
+		if err == nil {
+			return updated.GetID(), nil
+		}
+
+		// a comment which was deleted is created again
+		if resp == nil || resp.StatusCode != http.StatusNotFound {
+			return 0, fmt.Errorf("could not update comment: %w", err)
+		}
+	}
+
+	created, _, err := g.client.Issues.CreateComment(context.Background(), g.GetRepoOwner(), g.GetRepoName(), prNumber, comment)
+
+	if err != nil {
+		return 0, fmt.Errorf("could not create comment: %w", err)
+	}
+
+	return created.GetID(), nil
+}
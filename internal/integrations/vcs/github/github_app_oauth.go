@@ -44,12 +44,20 @@ func NewGithubAppConf(
 		return nil, fmt.Errorf("could not read github app secret: %s", err)
 	}
 
+	return NewGithubAppConfFromSecret(cfg, appName, appSecret, appWebhookSecret, appWebhookURL, intAppID), nil
+}
+
+// NewGithubAppConfFromSecret creates a Github app config from the app's private key, rather than from a path to the
+// private key.
+func NewGithubAppConfFromSecret(
+	cfg *oauth.Config,
+	appName string, appSecret []byte, appWebhookSecret, appWebhookURL string, appID int64) *GithubAppConf {
 	return &GithubAppConf{
 		appName:       appName,
 		webhookSecret: appWebhookSecret,
 		webhookURL:    appWebhookURL,
 		secret:        appSecret,
-		appID:         intAppID,
+		appID:         appID,
 		Config: oauth2.Config{
 			ClientID:     cfg.ClientID,
 			ClientSecret: cfg.ClientSecret,
@@ -60,7 +68,7 @@ func NewGithubAppConf(
 			RedirectURL: cfg.BaseURL + "/api/v1/users/github/callback",
 			Scopes:      cfg.Scopes,
 		},
-	}, nil
+	}
 }
 
 func (g *GithubAppConf) GetGithubClient(installationID int64) (*githubsdk.Client, error) {
@@ -0,0 +1,58 @@
+package github
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// AppManifest is a Github app manifest, which creates a preconfigured Github app with the app manifest flow. See
+// https://docs.github.com/en/apps/sharing-github-apps/registering-a-github-app-from-a-manifest.
+type AppManifest struct {
+	Name                  string                    `json:"name"`
+	URL                   string                    `json:"url"`
+	HookAttributes        AppManifestHookAttributes `json:"hook_attributes"`
+	RedirectURL           string                    `json:"redirect_url"`
+	CallbackURLs          []string                  `json:"callback_urls"`
+	RequestOAuthOnInstall bool                      `json:"request_oauth_on_install"`
+	Public                bool                      `json:"public"`
+	DefaultPermissions    map[string]string         `json:"default_permissions"`
+}
+
+type AppManifestHookAttributes struct {
+	URL    string `json:"url"`
+	Active bool   `json:"active"`
+}
+
+// NewAppManifest returns the manifest of a Github app which is configured for the Hatchet instance at the given
+// server url, with the permissions which Hatchet needs.
+func NewAppManifest(name, serverURL string) *AppManifest {
+	return &AppManifest{
+		Name: name,
+		URL:  serverURL,
+		HookAttributes: AppManifestHookAttributes{
+			URL:    serverURL + "/api/v1/github/webhook",
+			Active: true,
+		},
+		RedirectURL:           serverURL + "/api/v1/admin/github-app/callback",
+		CallbackURLs:          []string{serverURL + "/api/v1/users/github/callback"},
+		RequestOAuthOnInstall: true,
+		DefaultPermissions: map[string]string{
+			"checks":           "write",
+			"contents":         "read",
+			"metadata":         "read",
+			"pull_requests":    "write",
+			"repository_hooks": "write",
+			"emails":           "read",
+		},
+	}
+}
+
+// AppManifestURL returns the url which a Github app manifest is submitted to, to create the app in the given
+// organization, or in the personal account of the user if the organization is empty.
+func AppManifestURL(organization, state string) string {
+	if organization != "" {
+		return fmt.Sprintf("https://github.com/organizations/%s/settings/apps/new?state=%s", url.PathEscape(organization), url.QueryEscape(state))
+	}
+
+	return fmt.Sprintf("https://github.com/settings/apps/new?state=%s", url.QueryEscape(state))
+}
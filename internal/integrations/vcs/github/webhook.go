@@ -0,0 +1,176 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	githubsdk "github.com/google/go-github/v57/github"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+// webhookEvents are the events which the repository webhooks are subscribed to.
+var webhookEvents = []string{"pull_request", "push"}
+
+// maxFailedDeliveries is the maximum number of recent failed deliveries which are reported for a webhook.
+const maxFailedDeliveries = 10
+
+func webhookURL(baseURL, webhookId string) string {
+	return fmt.Sprintf("%s/api/v1/github/webhook/%s", baseURL, webhookId)
+}
+
+func newRepositoryHook(url, signingSecret string) *githubsdk.Hook {
+	return &githubsdk.Hook{
+		Config: map[string]interface{}{
+			"url":          url,
+			"content_type": "json",
+			"secret":       signingSecret,
+		},
+		Events: webhookEvents,
+		Active: githubsdk.Bool(true),
+	}
+}
+
+// WebhookDelivery is a failed delivery of a repository webhook, as reported by Github.
+type WebhookDelivery struct {
+	ID          int64     `json:"id"`
+	Event       string    `json:"event"`
+	Action      string    `json:"action,omitempty"`
+	StatusCode  int       `json:"statusCode"`
+	Status      string    `json:"status"`
+	DeliveredAt time.Time `json:"deliveredAt"`
+}
+
+// WebhookStatus is the result of reconciling a repository webhook.
+type WebhookStatus struct {
+	HookID int64
+
+	// Repaired is whether the webhook was missing or misconfigured, and was created or repaired
+	Repaired bool
+
+	// FailedDeliveries are the recent failed deliveries of the webhook, most recent first
+	FailedDeliveries []WebhookDelivery
+}
+
+// ReconcileWebhook makes sure that the repository webhook of the given Hatchet webhook exists on Github, and is
+// configured as expected. A missing webhook is created, and a misconfigured webhook is repaired.
+func (g GithubVCSProvider) ReconcileWebhook(ctx context.Context, installationId int64, webhook *db.GithubWebhookModel) (*WebhookStatus, error) {
+	client, err := g.appConf.GetGithubClient(installationId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get github client: %w", err)
+	}
+
+	signingSecret, err := g.enc.Decrypt(webhook.SigningSecret, "github_signing_secret")
+
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt signing secret: %w", err)
+	}
+
+	owner := webhook.RepositoryOwner
+	name := webhook.RepositoryName
+	expected := newRepositoryHook(webhookURL(g.appConf.GetWebhookURL(), webhook.ID), string(signingSecret))
+
+	hook, err := findRepositoryHook(ctx, client, owner, name, expected.Config["url"].(string))
+
+	if err != nil {
+		return nil, err
+	}
+
+	status := &WebhookStatus{}
+
+	switch {
+	case hook == nil:
+		hook, _, err = client.Repositories.CreateHook(ctx, owner, name, expected)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not create webhook: %w", err)
+		}
+
+		status.Repaired = true
+	case !isHookConfigured(hook):
+		// the secret of a webhook can't be read, so it's always set when the webhook is repaired
+		hook, _, err = client.Repositories.EditHook(ctx, owner, name, hook.GetID(), expected)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not repair webhook: %w", err)
+		}
+
+		status.Repaired = true
+	}
+
+	status.HookID = hook.GetID()
+
+	deliveries, _, err := client.Repositories.ListHookDeliveries(ctx, owner, name, status.HookID, &githubsdk.ListCursorOptions{
+		PerPage: 100,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list webhook deliveries: %w", err)
+	}
+
+	status.FailedDeliveries = make([]WebhookDelivery, 0)
+
+	for _, delivery := range deliveries {
+		if code := delivery.GetStatusCode(); code >= 200 && code < 300 {
+			continue
+		}
+
+		status.FailedDeliveries = append(status.FailedDeliveries, WebhookDelivery{
+			ID:          delivery.GetID(),
+			Event:       delivery.GetEvent(),
+			Action:      delivery.GetAction(),
+			StatusCode:  delivery.GetStatusCode(),
+			Status:      delivery.GetStatus(),
+			DeliveredAt: delivery.GetDeliveredAt().Time,
+		})
+
+		if len(status.FailedDeliveries) == maxFailedDeliveries {
+			break
+		}
+	}
+
+	return status, nil
+}
+
+func findRepositoryHook(ctx context.Context, client *githubsdk.Client, owner, name, url string) (*githubsdk.Hook, error) {
+	opts := &githubsdk.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		hooks, resp, err := client.Repositories.ListHooks(ctx, owner, name, opts)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not list webhooks: %w", err)
+		}
+
+		for _, hook := range hooks {
+			if hookURL, ok := hook.Config["url"].(string); ok && hookURL == url {
+				return hook, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return nil, nil
+		}
+
+		opts.Page = resp.NextPage
+	}
+}
+
+func isHookConfigured(hook *githubsdk.Hook) bool {
+	if !hook.GetActive() || hook.Config["content_type"] != "json" {
+		return false
+	}
+
+	for _, event := range webhookEvents {
+		if !slices.Contains(hook.Events, event) {
+			return false
+		}
+	}
+
+	return true
+}
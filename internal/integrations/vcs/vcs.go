@@ -14,6 +14,7 @@ const (
 	// enumeration of in-tree repository kinds
 	VCSRepositoryKindGithub VCSRepositoryKind = "github"
 	VCSRepositoryKindGitlab VCSRepositoryKind = "gitlab"
+	VCSRepositoryKindGitea  VCSRepositoryKind = "gitea"
 )
 
 type VCSCheckRunStatus string
@@ -89,6 +90,10 @@ type VCSRepository interface {
 
 	// CompareCommits compares a base commit with a head commit
 	CompareCommits(base, head string) (VCSCommitsComparison, error)
+
+	// CreateOrUpdatePullRequestComment comments on a pull request, or updates the comment with the given id if
+	// it's set and still exists. It returns the id of the comment.
+	CreateOrUpdatePullRequestComment(prNumber int, commentId *int64, body string) (int64, error)
 }
 
 // VCSObjectID is a generic method for retrieving IDs from the underlying VCS repository.
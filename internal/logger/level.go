@@ -0,0 +1,50 @@
+package logger
+
+import (
+	"sync/atomic"
+
+	"github.com/rs/zerolog"
+)
+
+// Level is a log level which can be changed while the loggers which use it are running.
+type Level struct {
+	lvl atomic.Int32
+}
+
+// NewLevel parses a log level. An empty level defaults to debug.
+func NewLevel(level string) (*Level, error) {
+	l := &Level{}
+
+	if err := l.Set(level); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+func (l *Level) Set(level string) error {
+	lvl := zerolog.DebugLevel
+
+	if level != "" {
+		var err error
+
+		lvl, err = zerolog.ParseLevel(level)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	l.lvl.Store(int32(lvl))
+
+	return nil
+}
+
+func (l *Level) Level() zerolog.Level {
+	return zerolog.Level(l.lvl.Load())
+}
+
+// Sample implements zerolog.Sampler, and only keeps the events at or above the level.
+func (l *Level) Sample(lvl zerolog.Level) bool {
+	return lvl >= l.Level()
+}
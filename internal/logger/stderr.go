@@ -31,6 +31,15 @@ func NewStdErr(cf *shared.LoggerConfigFile, service string) zerolog.Logger {
 		}
 	}
 
+	return newStdErr(cf, service).Level(lvl)
+}
+
+// NewStdErrWithLevel returns a logger which logs at a level that can be changed while it is running.
+func NewStdErrWithLevel(cf *shared.LoggerConfigFile, service string, level *Level) zerolog.Logger {
+	return newStdErr(cf, service).Sample(level)
+}
+
+func newStdErr(cf *shared.LoggerConfigFile, service string) zerolog.Logger {
 	var out io.Writer = os.Stderr
 
 	if cf.Format == "console" {
@@ -40,7 +49,7 @@ func NewStdErr(cf *shared.LoggerConfigFile, service string) zerolog.Logger {
 		}
 	}
 
-	l := zerolog.New(out).Level(lvl)
+	l := zerolog.New(out)
 	l = l.With().Timestamp().Logger()
 	if service != "" {
 		l = l.With().Str("service", service).Logger()
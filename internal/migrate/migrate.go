@@ -0,0 +1,380 @@
+// Package migrate applies the migrations of the database schema and detects drift between the migrations which
+// were applied to the database and the migrations which a binary was built with.
+//
+// Applied migrations are recorded in the _prisma_migrations table with the same checksums as prisma, and the same
+// advisory lock is held while migrating, so migrations can be applied by either tool.
+package migrate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/hatchet-dev/hatchet/prisma"
+)
+
+// advisoryLockKey is the key of the advisory lock which prisma holds while migrating.
+const advisoryLockKey = 72707369
+
+// ErrDrift is returned by Verify when the database does not match the migrations of the binary.
+var ErrDrift = errors.New("database schema has drifted")
+
+type Migration struct {
+	Name     string
+	SQL      string
+	Checksum string
+}
+
+// Load loads the migrations from the migration.sql file of each directory in the migrations directory of fsys,
+// ordered by name.
+func Load(fsys fs.FS) ([]*Migration, error) {
+	files, err := fs.Glob(fsys, "migrations/*/migration.sql")
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(files)
+
+	migrations := make([]*Migration, 0, len(files))
+
+	for _, file := range files {
+		b, err := fs.ReadFile(fsys, file)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not read migration %s: %w", file, err)
+		}
+
+		sum := sha256.Sum256(b)
+
+		migrations = append(migrations, &Migration{
+			Name:     path.Base(path.Dir(file)),
+			SQL:      string(b),
+			Checksum: hex.EncodeToString(sum[:]),
+		})
+	}
+
+	return migrations, nil
+}
+
+// LoadEmbedded loads the migrations which the binary was built with.
+func LoadEmbedded() ([]*Migration, error) {
+	return Load(prisma.Migrations)
+}
+
+// Status compares the migrations of the binary with the migrations which were applied to the database.
+type Status struct {
+	// Applied are the migrations which were applied to the database
+	Applied []string
+
+	// Pending are the migrations which have not been applied yet
+	Pending []string
+
+	// Failed are the migrations which were started but did not finish
+	Failed []string
+
+	// Modified are the applied migrations whose checksum doesn't match the migration of the binary
+	Modified []string
+
+	// Unknown are the applied migrations which the binary doesn't have, which means that the binary is older
+	// than the database
+	Unknown []string
+}
+
+func (s *Status) HasDrift() bool {
+	return len(s.Pending) > 0 || len(s.Failed) > 0 || len(s.Modified) > 0 || len(s.Unknown) > 0
+}
+
+// Untracked is whether no migrations were recorded in the database, which is the case for an empty database and for
+// a database whose schema was created with `prisma db push`.
+func (s *Status) Untracked() bool {
+	return len(s.Applied) == 0 && len(s.Failed) == 0 && len(s.Modified) == 0 && len(s.Unknown) == 0
+}
+
+func (s *Status) String() string {
+	var problems []string
+
+	for _, p := range []struct {
+		desc  string
+		names []string
+	}{
+		{"pending", s.Pending},
+		{"failed", s.Failed},
+		{"modified since they were applied", s.Modified},
+		{"unknown to this version", s.Unknown},
+	} {
+		if len(p.names) > 0 {
+			problems = append(problems, fmt.Sprintf("%s: %s", p.desc, strings.Join(p.names, ", ")))
+		}
+	}
+
+	if len(problems) == 0 {
+		return fmt.Sprintf("%d migrations applied, up to date", len(s.Applied))
+	}
+
+	return fmt.Sprintf("%d migrations applied; %s", len(s.Applied), strings.Join(problems, "; "))
+}
+
+type appliedMigration struct {
+	name     string
+	checksum string
+	finished bool
+}
+
+func compare(migrations []*Migration, applied []appliedMigration) *Status {
+	status := &Status{}
+	known := make(map[string]*Migration, len(migrations))
+
+	for _, m := range migrations {
+		known[m.Name] = m
+	}
+
+	seen := make(map[string]bool, len(applied))
+
+	for _, a := range applied {
+		seen[a.name] = true
+
+		m, ok := known[a.name]
+
+		switch {
+		case !a.finished:
+			status.Failed = append(status.Failed, a.name)
+		case !ok:
+			status.Unknown = append(status.Unknown, a.name)
+		case m.Checksum != a.checksum:
+			status.Modified = append(status.Modified, a.name)
+		default:
+			status.Applied = append(status.Applied, a.name)
+		}
+	}
+
+	for _, m := range migrations {
+		if !seen[m.Name] {
+			status.Pending = append(status.Pending, m.Name)
+		}
+	}
+
+	return status
+}
+
+type Migrator struct {
+	pool       *pgxpool.Pool
+	migrations []*Migration
+}
+
+func NewMigrator(pool *pgxpool.Pool, migrations []*Migration) *Migrator {
+	return &Migrator{
+		pool:       pool,
+		migrations: migrations,
+	}
+}
+
+// Status returns the status of the migrations of the database.
+func (m *Migrator) Status(ctx context.Context) (*Status, error) {
+	applied, err := getApplied(ctx, m.pool)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return compare(m.migrations, applied), nil
+}
+
+type VerifyOpt func(*verifyOpts)
+
+type verifyOpts struct {
+	allowUntracked bool
+}
+
+// WithAllowUntracked makes Verify accept a database in which no migrations were recorded, for environments whose
+// schema is created with `prisma db push`. Databases in which migrations were recorded are still verified.
+func WithAllowUntracked() VerifyOpt {
+	return func(opts *verifyOpts) {
+		opts.allowUntracked = true
+	}
+}
+
+// Verify returns an error which wraps ErrDrift if the database does not match the migrations.
+func (m *Migrator) Verify(ctx context.Context, opts ...VerifyOpt) error {
+	status, err := m.Status(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	return verify(status, opts...)
+}
+
+func verify(status *Status, opts ...VerifyOpt) error {
+	o := &verifyOpts{}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.allowUntracked && status.Untracked() {
+		return nil
+	}
+
+	if status.HasDrift() {
+		return fmt.Errorf("%w: %s", ErrDrift, status)
+	}
+
+	return nil
+}
+
+// Up applies the pending migrations in order, while holding the migration advisory lock. Each migration is applied
+// in a transaction. It returns the names of the applied migrations.
+func (m *Migrator) Up(ctx context.Context) ([]string, error) {
+	conn, err := m.pool.Acquire(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not acquire connection: %w", err)
+	}
+
+	defer conn.Release()
+
+	// the lock is held by the session, so it is acquired and released on the same connection
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_lock($1)", advisoryLockKey); err != nil {
+		return nil, fmt.Errorf("could not acquire migration lock: %w", err)
+	}
+
+	defer conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", advisoryLockKey) // nolint: errcheck
+
+	if _, err := conn.Exec(ctx, createMigrationsTable); err != nil {
+		return nil, fmt.Errorf("could not create migrations table: %w", err)
+	}
+
+	// the status is read after acquiring the lock, so migrations which were applied concurrently are skipped
+	applied, err := getApplied(ctx, conn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	status := compare(m.migrations, applied)
+
+	if len(status.Failed) > 0 || len(status.Modified) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrDrift, status)
+	}
+
+	pending := make(map[string]bool, len(status.Pending))
+
+	for _, name := range status.Pending {
+		pending[name] = true
+	}
+
+	res := []string{}
+
+	for _, migration := range m.migrations {
+		if !pending[migration.Name] {
+			continue
+		}
+
+		if err := apply(ctx, conn, migration); err != nil {
+			return res, fmt.Errorf("could not apply migration %s: %w", migration.Name, err)
+		}
+
+		res = append(res, migration.Name)
+	}
+
+	return res, nil
+}
+
+func apply(ctx context.Context, conn *pgxpool.Conn, migration *Migration) error {
+	tx, err := conn.Begin(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback(context.Background()) // nolint: errcheck
+
+	if _, err := tx.Exec(ctx, migration.SQL); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`INSERT INTO "_prisma_migrations" ("id", "checksum", "migration_name", "started_at", "finished_at", "applied_steps_count")
+		VALUES ($1, $2, $3, now(), now(), 1)`,
+		uuid.New().String(),
+		migration.Checksum,
+		migration.Name,
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not record migration: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+func getApplied(ctx context.Context, q querier) ([]appliedMigration, error) {
+	rows, err := q.Query(ctx, `SELECT to_regclass('"_prisma_migrations"') IS NOT NULL`)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not check for migrations table: %w", err)
+	}
+
+	exists, err := pgx.CollectOneRow(rows, pgx.RowTo[bool])
+
+	if err != nil {
+		return nil, fmt.Errorf("could not check for migrations table: %w", err)
+	}
+
+	// the table is created by the first migration run, so no migrations were applied yet
+	if !exists {
+		return nil, nil
+	}
+
+	rows, err = q.Query(
+		ctx,
+		`SELECT "migration_name", "checksum", "finished_at" IS NOT NULL
+		FROM "_prisma_migrations"
+		WHERE "rolled_back_at" IS NULL
+		ORDER BY "started_at" ASC, "migration_name" ASC`,
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list applied migrations: %w", err)
+	}
+
+	applied, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (appliedMigration, error) {
+		var a appliedMigration
+		err := row.Scan(&a.name, &a.checksum, &a.finished)
+		return a, err
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list applied migrations: %w", err)
+	}
+
+	return applied, nil
+}
+
+// createMigrationsTable creates the migrations table in the same way as prisma.
+const createMigrationsTable = `CREATE TABLE IF NOT EXISTS "_prisma_migrations" (
+    "id"                    VARCHAR(36) PRIMARY KEY NOT NULL,
+    "checksum"              VARCHAR(64) NOT NULL,
+    "finished_at"           TIMESTAMPTZ,
+    "migration_name"        VARCHAR(255) NOT NULL,
+    "logs"                  TEXT,
+    "rolled_back_at"        TIMESTAMPTZ,
+    "started_at"            TIMESTAMPTZ NOT NULL DEFAULT now(),
+    "applied_steps_count"   INTEGER NOT NULL DEFAULT 0
+)`
@@ -0,0 +1,142 @@
+package migrate
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoad(t *testing.T) {
+	fsys := fstest.MapFS{
+		"migrations/20240102000000_b/migration.sql": {Data: []byte("SELECT 2;")},
+		"migrations/20240101000000_a/migration.sql": {Data: []byte("SELECT 1;")},
+		"migrations/migration_lock.toml":            {Data: []byte("provider = \"postgresql\"")},
+	}
+
+	migrations, err := Load(fsys)
+
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if len(migrations) != 2 || migrations[0].Name != "20240101000000_a" || migrations[1].Name != "20240102000000_b" {
+		t.Fatalf("Load() = %+v, want migrations a and b in order", migrations)
+	}
+
+	// the checksum is the hex-encoded sha256 of the file, which is what prisma records
+	if migrations[0].Checksum != "17db4fd369edb9244b9f91d9aeed145c3d04ad8ba6e95d06247f07a63527d11a" {
+		t.Fatalf("Load() checksum = %s, want a sha256 hex digest", migrations[0].Checksum)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	migrations := []*Migration{
+		{Name: "a", Checksum: "1"},
+		{Name: "b", Checksum: "2"},
+		{Name: "c", Checksum: "3"},
+	}
+
+	tests := []struct {
+		name     string
+		applied  []appliedMigration
+		expected *Status
+		drift    bool
+	}{
+		{
+			name:     "empty database",
+			expected: &Status{Pending: []string{"a", "b", "c"}},
+			drift:    true,
+		},
+		{
+			name: "up to date",
+			applied: []appliedMigration{
+				{name: "a", checksum: "1", finished: true},
+				{name: "b", checksum: "2", finished: true},
+				{name: "c", checksum: "3", finished: true},
+			},
+			expected: &Status{Applied: []string{"a", "b", "c"}},
+		},
+		{
+			name: "drifted",
+			applied: []appliedMigration{
+				{name: "a", checksum: "1", finished: true},
+				{name: "b", checksum: "changed", finished: true},
+				{name: "c", checksum: "3", finished: false},
+				{name: "d", checksum: "4", finished: true},
+			},
+			expected: &Status{
+				Applied:  []string{"a"},
+				Modified: []string{"b"},
+				Failed:   []string{"c"},
+				Unknown:  []string{"d"},
+			},
+			drift: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := compare(migrations, tt.applied)
+
+			if !reflect.DeepEqual(status, tt.expected) {
+				t.Fatalf("compare() = %+v, want %+v", status, tt.expected)
+			}
+
+			if status.HasDrift() != tt.drift {
+				t.Fatalf("HasDrift() = %v, want %v", status.HasDrift(), tt.drift)
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	tests := []struct {
+		name   string
+		status *Status
+		opts   []VerifyOpt
+		drift  bool
+	}{
+		{
+			name:   "up to date",
+			status: &Status{Applied: []string{"a", "b"}},
+		},
+		{
+			name:   "pending",
+			status: &Status{Applied: []string{"a"}, Pending: []string{"b"}},
+			drift:  true,
+		},
+		{
+			name:   "untracked",
+			status: &Status{Pending: []string{"a", "b"}},
+			drift:  true,
+		},
+		{
+			name:   "untracked allowed",
+			status: &Status{Pending: []string{"a", "b"}},
+			opts:   []VerifyOpt{WithAllowUntracked()},
+		},
+		{
+			name:   "pending with untracked allowed",
+			status: &Status{Applied: []string{"a"}, Pending: []string{"b"}},
+			opts:   []VerifyOpt{WithAllowUntracked()},
+			drift:  true,
+		},
+		{
+			name:   "failed with untracked allowed",
+			status: &Status{Failed: []string{"a"}, Pending: []string{"b"}},
+			opts:   []VerifyOpt{WithAllowUntracked()},
+			drift:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verify(tt.status, tt.opts...)
+
+			if errors.Is(err, ErrDrift) != tt.drift {
+				t.Fatalf("verify() = %v, want drift %v", err, tt.drift)
+			}
+		})
+	}
+}
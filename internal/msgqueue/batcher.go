@@ -0,0 +1,220 @@
+package msgqueue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// BatchMessageID is the id of a message which contains a batch of messages of one tenant. Consumers handle a
+// batch by handling each of its messages in order, see UnbatchMessage.
+const BatchMessageID = "message-batch"
+
+type batchPayload struct {
+	Messages []*Message `json:"messages"`
+}
+
+// Batcher adds messages to a queue in batches, so that a burst of small messages is sent as a few large ones.
+// Messages are batched per queue and tenant, and a batch is sent when it reaches the maximum size or when its
+// oldest message has waited for the flush interval.
+type Batcher struct {
+	mq            MessageQueue
+	maxSize       int
+	flushInterval time.Duration
+
+	mu      sync.Mutex
+	pending map[batchKey]*pendingBatch
+}
+
+type batchKey struct {
+	queue    string
+	tenantId string
+}
+
+type pendingBatch struct {
+	queue    Queue
+	tenantId string
+	messages []*Message
+	timer    *time.Timer
+
+	// done is closed once the batch has been added to the queue, after which err is set
+	done chan struct{}
+	err  error
+}
+
+// NewBatcher returns a batcher which adds messages to the message queue in batches of up to maxSize messages.
+// If maxSize is less than 2, messages are added to the queue one by one.
+func NewBatcher(mq MessageQueue, maxSize int, flushInterval time.Duration) *Batcher {
+	return &Batcher{
+		mq:            mq,
+		maxSize:       maxSize,
+		flushInterval: flushInterval,
+		pending:       map[batchKey]*pendingBatch{},
+	}
+}
+
+// AddMessage adds the message to the pending batch of its queue and tenant. It returns once the batch has been
+// added to the queue, so a message is never acknowledged before it has been sent.
+func (b *Batcher) AddMessage(ctx context.Context, queue Queue, task *Message) error {
+	if b.maxSize < 2 {
+		return b.mq.AddMessage(ctx, queue, task)
+	}
+
+	// the batch is added without the context of the caller, so the correlation id is set on the message itself
+	task.SetCorrelationIDFromContext(ctx)
+
+	key := batchKey{
+		queue:    queue.Name(),
+		tenantId: task.TenantID(),
+	}
+
+	b.mu.Lock()
+
+	batch, ok := b.pending[key]
+
+	if !ok {
+		batch = &pendingBatch{
+			queue:    queue,
+			tenantId: key.tenantId,
+			done:     make(chan struct{}),
+		}
+
+		batch.timer = time.AfterFunc(b.flushInterval, func() {
+			b.flushBatch(key, batch)
+		})
+
+		b.pending[key] = batch
+	}
+
+	batch.messages = append(batch.messages, task)
+	full := len(batch.messages) >= b.maxSize
+
+	b.mu.Unlock()
+
+	if full {
+		b.flushBatch(key, batch)
+	}
+
+	select {
+	case <-batch.done:
+		return batch.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Flush adds all pending batches to the queue. It should be called before the batcher is discarded.
+func (b *Batcher) Flush() {
+	b.mu.Lock()
+
+	batches := make(map[batchKey]*pendingBatch, len(b.pending))
+
+	for key, batch := range b.pending {
+		batches[key] = batch
+	}
+
+	b.mu.Unlock()
+
+	for key, batch := range batches {
+		b.flushBatch(key, batch)
+	}
+}
+
+func (b *Batcher) flushBatch(key batchKey, batch *pendingBatch) {
+	b.mu.Lock()
+
+	// the batch was already flushed, by its timer or because it is full
+	if b.pending[key] != batch {
+		b.mu.Unlock()
+		return
+	}
+
+	delete(b.pending, key)
+	batch.timer.Stop()
+
+	b.mu.Unlock()
+
+	batchMsg, err := toBatchMessage(batch.tenantId, batch.messages)
+
+	// the batch isn't sent with the context of any one caller, so that a cancelled caller doesn't fail the batch
+	// of the other callers
+	if err == nil {
+		err = b.mq.AddMessage(context.Background(), batch.queue, batchMsg)
+	}
+
+	batch.err = err
+
+	close(batch.done)
+}
+
+func toBatchMessage(tenantId string, messages []*Message) (*Message, error) {
+	if len(messages) == 1 {
+		return messages[0], nil
+	}
+
+	// the messages are converted to plain values, so that every codec can encode the payload
+	messagesBytes, err := json.Marshal(messages)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal batch messages: %w", err)
+	}
+
+	var payloadMessages []interface{}
+
+	if err := json.Unmarshal(messagesBytes, &payloadMessages); err != nil {
+		return nil, fmt.Errorf("could not unmarshal batch messages: %w", err)
+	}
+
+	retries := 0
+
+	for _, msg := range messages {
+		if msg.Retries > retries {
+			retries = msg.Retries
+		}
+	}
+
+	return &Message{
+		ID: BatchMessageID,
+		Payload: map[string]interface{}{
+			"messages": payloadMessages,
+		},
+		Metadata: map[string]interface{}{
+			"tenant_id": tenantId,
+		},
+		Retries: retries,
+	}, nil
+}
+
+// UnbatchTenantMessage returns the messages of a message read from a tenant's queue. Batches fan out to the queues
+// of the tenant as one message, so subscribers to a tenant's queue read the messages of a batch in order, and
+// other messages as they are.
+func UnbatchTenantMessage(task *Message) ([]*Message, error) {
+	if task.ID != BatchMessageID {
+		return []*Message{task}, nil
+	}
+
+	return UnbatchMessage(task)
+}
+
+// UnbatchMessage returns the messages of a batch message, in the order they were added to the batch.
+func UnbatchMessage(task *Message) ([]*Message, error) {
+	if task.ID != BatchMessageID {
+		return nil, fmt.Errorf("message %s is not a batch", task.ID)
+	}
+
+	payloadBytes, err := json.Marshal(task.Payload)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal batch payload: %w", err)
+	}
+
+	payload := batchPayload{}
+
+	if err := json.Unmarshal(payloadBytes, &payload); err != nil {
+		return nil, fmt.Errorf("could not unmarshal batch payload: %w", err)
+	}
+
+	return payload.Messages, nil
+}
@@ -0,0 +1,140 @@
+package msgqueue_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+)
+
+type recordingQueue struct {
+	msgqueue.MessageQueue
+
+	mu       sync.Mutex
+	messages []*msgqueue.Message
+}
+
+func (q *recordingQueue) AddMessage(ctx context.Context, queue msgqueue.Queue, task *msgqueue.Message) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.messages = append(q.messages, task)
+
+	return nil
+}
+
+func (q *recordingQueue) added() []*msgqueue.Message {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return append([]*msgqueue.Message{}, q.messages...)
+}
+
+func newTask(tenantId string, i int) *msgqueue.Message {
+	return &msgqueue.Message{
+		ID:       "step-run-finished",
+		Payload:  map[string]interface{}{"step_run_id": fmt.Sprintf("step-run-%d", i)},
+		Metadata: map[string]interface{}{"tenant_id": tenantId},
+		Retries:  3,
+	}
+}
+
+func TestBatcherFlushesFullBatches(t *testing.T) {
+	mq := &recordingQueue{}
+	b := msgqueue.NewBatcher(mq, 3, time.Hour)
+
+	wg := sync.WaitGroup{}
+
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			assert.NoError(t, b.AddMessage(context.Background(), msgqueue.JOB_PROCESSING_QUEUE, newTask("tenant-a", i)))
+		}(i)
+	}
+
+	wg.Wait()
+
+	added := mq.added()
+	require.Len(t, added, 1)
+	assert.Equal(t, msgqueue.BatchMessageID, added[0].ID)
+	assert.Equal(t, "tenant-a", added[0].TenantID())
+	assert.Equal(t, 3, added[0].Retries)
+
+	messages, err := msgqueue.UnbatchMessage(added[0])
+	require.NoError(t, err)
+	require.Len(t, messages, 3)
+
+	for _, msg := range messages {
+		assert.Equal(t, "step-run-finished", msg.ID)
+		assert.Equal(t, "tenant-a", msg.TenantID())
+	}
+}
+
+func TestBatcherFlushesOnInterval(t *testing.T) {
+	mq := &recordingQueue{}
+	b := msgqueue.NewBatcher(mq, 100, 10*time.Millisecond)
+
+	wg := sync.WaitGroup{}
+
+	// messages of different tenants are never batched together
+	for i, tenantId := range []string{"tenant-a", "tenant-a", "tenant-b"} {
+		wg.Add(1)
+
+		go func(i int, tenantId string) {
+			defer wg.Done()
+
+			assert.NoError(t, b.AddMessage(context.Background(), msgqueue.JOB_PROCESSING_QUEUE, newTask(tenantId, i)))
+		}(i, tenantId)
+	}
+
+	wg.Wait()
+
+	batches := map[string]int{}
+
+	for _, msg := range mq.added() {
+		if msg.ID == msgqueue.BatchMessageID {
+			messages, err := msgqueue.UnbatchMessage(msg)
+			require.NoError(t, err)
+
+			batches[msg.TenantID()] += len(messages)
+		} else {
+			batches[msg.TenantID()]++
+		}
+	}
+
+	assert.Equal(t, map[string]int{"tenant-a": 2, "tenant-b": 1}, batches)
+}
+
+func TestBatcherSingleMessage(t *testing.T) {
+	mq := &recordingQueue{}
+	b := msgqueue.NewBatcher(mq, 100, time.Millisecond)
+
+	task := newTask("tenant-a", 0)
+
+	require.NoError(t, b.AddMessage(context.Background(), msgqueue.JOB_PROCESSING_QUEUE, task))
+
+	// a batch of one message is sent as the message itself
+	added := mq.added()
+	require.Len(t, added, 1)
+	assert.Equal(t, task, added[0])
+}
+
+func TestBatcherDisabled(t *testing.T) {
+	mq := &recordingQueue{}
+	b := msgqueue.NewBatcher(mq, 0, time.Hour)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, b.AddMessage(context.Background(), msgqueue.JOB_PROCESSING_QUEUE, newTask("tenant-a", i)))
+	}
+
+	assert.Len(t, mq.added(), 3)
+}
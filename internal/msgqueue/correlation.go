@@ -0,0 +1,82 @@
+package msgqueue
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+)
+
+// CorrelationIDKey is the metadata key which stores the correlation id of a message. The correlation id
+// of the API request which caused a message is passed on to every message created while handling it.
+const CorrelationIDKey = "correlation_id"
+
+type correlationIDCtxKey struct{}
+
+// WithCorrelationID returns a context which carries the correlation id. Messages added with this context
+// are tagged with the correlation id.
+func WithCorrelationID(ctx context.Context, correlationId string) context.Context {
+	if correlationId == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, correlationIDCtxKey{}, correlationId)
+}
+
+// CorrelationIDFromContext returns the correlation id of the context, or an empty string if it does not
+// have one.
+func CorrelationIDFromContext(ctx context.Context) string {
+	correlationId, _ := ctx.Value(correlationIDCtxKey{}).(string)
+
+	return correlationId
+}
+
+func (t *Message) CorrelationID() string {
+	correlationId, exists := t.Metadata[CorrelationIDKey]
+
+	if !exists {
+		return ""
+	}
+
+	correlationIdStr, ok := correlationId.(string)
+
+	if !ok {
+		return ""
+	}
+
+	return correlationIdStr
+}
+
+// SetCorrelationIDFromContext tags the message with the correlation id of the context, if the message
+// does not have one already.
+func (t *Message) SetCorrelationIDFromContext(ctx context.Context) {
+	correlationId := CorrelationIDFromContext(ctx)
+
+	if correlationId == "" || t.CorrelationID() != "" {
+		return
+	}
+
+	if t.Metadata == nil {
+		t.Metadata = map[string]interface{}{}
+	}
+
+	t.Metadata[CorrelationIDKey] = correlationId
+}
+
+// ContextForMessage returns a context which carries the correlation id of the message, so that messages
+// added while handling it are tagged with the same correlation id.
+func ContextForMessage(ctx context.Context, task *Message) context.Context {
+	return WithCorrelationID(ctx, task.CorrelationID())
+}
+
+// Logger returns a logger which adds the correlation id of the context to every log line.
+func Logger(ctx context.Context, l *zerolog.Logger) *zerolog.Logger {
+	correlationId := CorrelationIDFromContext(ctx)
+
+	if correlationId == "" {
+		return l
+	}
+
+	newLogger := l.With().Str(CorrelationIDKey, correlationId).Logger()
+
+	return &newLogger
+}
@@ -0,0 +1,319 @@
+package inmemory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/logger"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+)
+
+const (
+	// queueSize is the number of messages which can be buffered in a queue before AddMessage blocks
+	queueSize = 10000
+
+	// maxInFlight is the number of messages which each subscriber handles concurrently, matching the
+	// prefetch count of the RabbitMQ message queue
+	maxInFlight = 100
+
+	// retryDelay matches the TTL of the RabbitMQ dead letter queues
+	retryDelay = 5 * time.Second
+)
+
+type msgWithRetries struct {
+	*msgqueue.Message
+
+	// attempts is the number of times the message has been rejected by a subscriber
+	attempts int
+}
+
+type queue struct {
+	msgs chan *msgWithRetries
+
+	subscribers int
+}
+
+// MessageQueueImpl implements the MessageQueue interface in memory, for running every Hatchet service in
+// a single process. Messages are not persisted, so they are lost when the process exits.
+type MessageQueueImpl struct {
+	ctx context.Context
+
+	l *zerolog.Logger
+
+	mu sync.Mutex
+
+	// queues are the queues which are consumed by competing subscribers
+	queues map[string]*queue
+
+	// fanouts are the queues of each subscriber to a fanout exchange, keyed by the exchange
+	fanouts map[string]map[*queue]struct{}
+}
+
+type MessageQueueImplOpt func(*MessageQueueImplOpts)
+
+type MessageQueueImplOpts struct {
+	l *zerolog.Logger
+}
+
+func defaultMessageQueueImplOpts() *MessageQueueImplOpts {
+	l := logger.NewDefaultLogger("inmemory")
+
+	return &MessageQueueImplOpts{
+		l: &l,
+	}
+}
+
+func WithLogger(l *zerolog.Logger) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.l = l
+	}
+}
+
+// New creates a new MessageQueueImpl.
+func New(fs ...MessageQueueImplOpt) (func() error, *MessageQueueImpl) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	opts := defaultMessageQueueImplOpts()
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	newLogger := opts.l.With().Str("service", "inmemory-msgqueue").Logger()
+
+	t := &MessageQueueImpl{
+		ctx:     ctx,
+		l:       &newLogger,
+		queues:  make(map[string]*queue),
+		fanouts: make(map[string]map[*queue]struct{}),
+	}
+
+	cleanup := func() error {
+		cancel()
+		return nil
+	}
+
+	return cleanup, t
+}
+
+func (t *MessageQueueImpl) IsReady() bool {
+	return t.ctx.Err() == nil
+}
+
+// AddMessage adds a msg to the queue, and to the queue of every subscriber to the msg's tenant.
+func (t *MessageQueueImpl) AddMessage(ctx context.Context, q msgqueue.Queue, msg *msgqueue.Message) error {
+	msg.SetCorrelationIDFromContext(ctx)
+
+	t.mu.Lock()
+
+	queues := make([]*queue, 0)
+
+	if mq := t.getQueue(q, q.Durable()); mq != nil {
+		queues = append(queues, mq)
+	}
+
+	if tenantId := msg.TenantID(); tenantId != "" {
+		for mq := range t.fanouts[tenantId] {
+			queues = append(queues, mq)
+		}
+	}
+
+	t.mu.Unlock()
+
+	for _, mq := range queues {
+		// each subscriber gets its own copy of the message, in the same way as messages read from RabbitMQ
+		msgCp, err := copyMessage(msg)
+
+		if err != nil {
+			return fmt.Errorf("could not copy message: %w", err)
+		}
+
+		if err := t.push(ctx, mq, &msgWithRetries{Message: msgCp}); err != nil {
+			return err
+		}
+	}
+
+	t.l.Debug().Msgf("published msg %s to queue %s", msg.ID, q.Name())
+
+	return nil
+}
+
+// Subscribe subscribes to the msg queue.
+func (t *MessageQueueImpl) Subscribe(
+	q msgqueue.Queue,
+	preAck msgqueue.AckHook,
+	postAck msgqueue.AckHook,
+) (func() error, error) {
+	t.l.Debug().Msgf("subscribing to queue: %s", q.Name())
+
+	t.mu.Lock()
+
+	var mq *queue
+
+	if key := q.FanoutExchangeKey(); key != "" {
+		// every subscriber to a fanout exchange gets its own queue
+		mq = &queue{
+			msgs: make(chan *msgWithRetries, queueSize),
+		}
+
+		if _, ok := t.fanouts[key]; !ok {
+			t.fanouts[key] = make(map[*queue]struct{})
+		}
+
+		t.fanouts[key][mq] = struct{}{}
+	} else {
+		mq = t.getQueue(q, true)
+	}
+
+	mq.subscribers++
+
+	t.mu.Unlock()
+
+	ctx, cancel := context.WithCancel(t.ctx)
+
+	wg := sync.WaitGroup{}
+	sem := make(chan struct{}, maxInFlight)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg := <-mq.msgs:
+				select {
+				case <-ctx.Done():
+					// put the message back for the next subscriber
+					t.requeue(mq, msg)
+					return
+				case sem <- struct{}{}:
+				}
+
+				wg.Add(1)
+
+				go func(msg *msgWithRetries) {
+					defer wg.Done()
+					defer func() { <-sem }()
+
+					t.handleMessage(mq, msg, preAck, postAck)
+				}(msg)
+			}
+		}
+	}()
+
+	cleanup := func() error {
+		cancel()
+
+		t.l.Debug().Msgf("shutting down subscriber: %s", q.Name())
+		wg.Wait()
+
+		t.mu.Lock()
+		defer t.mu.Unlock()
+
+		mq.subscribers--
+
+		if key := q.FanoutExchangeKey(); key != "" {
+			delete(t.fanouts[key], mq)
+
+			if len(t.fanouts[key]) == 0 {
+				delete(t.fanouts, key)
+			}
+		} else if q.AutoDeleted() && mq.subscribers == 0 {
+			delete(t.queues, q.Name())
+		}
+
+		t.l.Debug().Msgf("successfully shut down subscriber: %s", q.Name())
+		return nil
+	}
+
+	return cleanup, nil
+}
+
+// RegisterTenant is a no-op, as tenant queues are created when they are subscribed to.
+func (t *MessageQueueImpl) RegisterTenant(ctx context.Context, tenantId string) error {
+	return nil
+}
+
+func (t *MessageQueueImpl) handleMessage(mq *queue, msg *msgWithRetries, preAck, postAck msgqueue.AckHook) {
+	t.l.Debug().Msgf("got msg: %v", msg.ID)
+
+	if err := preAck(msg.Message); err != nil {
+		t.l.Error().Msgf("error in pre-ack: %v", err)
+
+		msg.attempts++
+
+		if msg.attempts > msg.Retries {
+			t.l.Debug().Msgf("message %s has been rejected %d times, not requeuing", msg.ID, msg.attempts)
+			return
+		}
+
+		t.l.Debug().Msgf("message %s has been rejected %d times", msg.ID, msg.attempts)
+
+		time.AfterFunc(retryDelay, func() {
+			t.requeue(mq, msg)
+		})
+
+		return
+	}
+
+	if err := postAck(msg.Message); err != nil {
+		t.l.Error().Msgf("error in post-ack: %v", err)
+	}
+}
+
+// getQueue returns the queue with the given name. If it does not exist, it is created when create is true
+// and nil is returned otherwise. The caller must hold the lock.
+func (t *MessageQueueImpl) getQueue(q msgqueue.Queue, create bool) *queue {
+	if mq, ok := t.queues[q.Name()]; ok {
+		return mq
+	}
+
+	if !create {
+		return nil
+	}
+
+	mq := &queue{
+		msgs: make(chan *msgWithRetries, queueSize),
+	}
+
+	t.queues[q.Name()] = mq
+
+	return mq
+}
+
+func (t *MessageQueueImpl) push(ctx context.Context, mq *queue, msg *msgWithRetries) error {
+	select {
+	case mq.msgs <- msg:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.ctx.Done():
+		return fmt.Errorf("message queue is shut down")
+	}
+}
+
+func (t *MessageQueueImpl) requeue(mq *queue, msg *msgWithRetries) {
+	if err := t.push(context.Background(), mq, msg); err != nil {
+		t.l.Error().Msgf("could not requeue msg %s: %v", msg.ID, err)
+	}
+}
+
+func copyMessage(msg *msgqueue.Message) (*msgqueue.Message, error) {
+	body, err := json.Marshal(msg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &msgqueue.Message{}
+
+	if err := json.Unmarshal(body, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,163 @@
+package inmemory_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue/inmemory"
+)
+
+func TestMessageQueue(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	cleanup, mq := inmemory.New()
+	defer cleanup() // nolint: errcheck
+
+	wg := &sync.WaitGroup{}
+	wg.Add(3) // one message on the static queue, and one for each tenant subscriber
+
+	task := &msgqueue.Message{
+		ID:       "test-task",
+		Payload:  map[string]interface{}{"key": "value"},
+		Metadata: map[string]interface{}{"tenant_id": "test-tenant"},
+	}
+
+	ack := func(receivedMessage *msgqueue.Message) error {
+		defer wg.Done()
+		assert.Equal(t, task.ID, receivedMessage.ID, "received task ID should match sent task ID")
+		assert.Equal(t, "value", receivedMessage.Payload["key"], "received payload should match sent payload")
+		return nil
+	}
+
+	tenantQueue, err := msgqueue.TenantEventConsumerQueue("test-tenant")
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		cleanupTenantQueue, err := mq.Subscribe(tenantQueue, ack, msgqueue.NoOpHook)
+		require.NoError(t, err, "subscribing to tenant queue should not error")
+		defer cleanupTenantQueue() // nolint: errcheck
+	}
+
+	// messages added before the first subscriber are buffered
+	err = mq.AddMessage(ctx, msgqueue.EVENT_PROCESSING_QUEUE, task)
+	require.NoError(t, err, "adding task to static queue should not error")
+
+	cleanupQueue, err := mq.Subscribe(msgqueue.EVENT_PROCESSING_QUEUE, ack, msgqueue.NoOpHook)
+	require.NoError(t, err, "subscribing to static queue should not error")
+	defer cleanupQueue() // nolint: errcheck
+
+	wg.Wait()
+}
+
+func TestMessageQueueCorrelationID(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	cleanup, mq := inmemory.New()
+	defer cleanup() // nolint: errcheck
+
+	received := make(chan *msgqueue.Message, 1)
+
+	cleanupQueue, err := mq.Subscribe(msgqueue.JOB_PROCESSING_QUEUE, func(msg *msgqueue.Message) error {
+		received <- msg
+		return nil
+	}, msgqueue.NoOpHook)
+	require.NoError(t, err)
+	defer cleanupQueue() // nolint: errcheck
+
+	err = mq.AddMessage(msgqueue.WithCorrelationID(ctx, "test-correlation-id"), msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{
+		ID:       "test-task",
+		Metadata: map[string]interface{}{},
+	})
+	require.NoError(t, err)
+
+	select {
+	case msg := <-received:
+		assert.Equal(t, "test-correlation-id", msg.CorrelationID())
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for message")
+	}
+}
+
+func TestTenantSubscriberReceivesBatchedMessages(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	cleanup, mq := inmemory.New()
+	defer cleanup() // nolint: errcheck
+
+	received := make(chan string, 4)
+
+	tenantQueue, err := msgqueue.TenantEventConsumerQueue("test-tenant")
+	require.NoError(t, err)
+
+	// subscribe in the same way as the dispatcher streams the events of a workflow run
+	cleanupTenantQueue, err := mq.Subscribe(tenantQueue, msgqueue.NoOpHook, func(task *msgqueue.Message) error {
+		tasks, err := msgqueue.UnbatchTenantMessage(task)
+
+		if err != nil {
+			return err
+		}
+
+		for _, task := range tasks {
+			received <- task.ID
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+	defer cleanupTenantQueue() // nolint: errcheck
+
+	batcher := msgqueue.NewBatcher(mq, 3, time.Hour)
+
+	ids := []string{"step-run-started", "step-run-finished", "step-run-failed"}
+
+	wg := sync.WaitGroup{}
+
+	// each message is added once the previous one is pending, so that the batch keeps their order
+	for _, id := range ids {
+		wg.Add(1)
+
+		go func(id string) {
+			defer wg.Done()
+
+			err := batcher.AddMessage(ctx, msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{
+				ID:       id,
+				Payload:  map[string]interface{}{"step_run_id": "test-step-run"},
+				Metadata: map[string]interface{}{"tenant_id": "test-tenant"},
+			})
+			assert.NoError(t, err)
+		}(id)
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	wg.Wait()
+
+	// a message which isn't batched is received as it is
+	err = mq.AddMessage(ctx, msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{
+		ID:       "step-run-cancelled",
+		Metadata: map[string]interface{}{"tenant_id": "test-tenant"},
+	})
+	require.NoError(t, err)
+
+	var got []string
+
+	for len(got) < 4 {
+		select {
+		case id := <-received:
+			got = append(got, id)
+		case <-ctx.Done():
+			t.Fatalf("received %v before timing out", got)
+		}
+	}
+
+	assert.Equal(t, append(ids, "step-run-cancelled"), got)
+}
@@ -0,0 +1,198 @@
+package rabbitmq
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+)
+
+// Codec is the encoding which messages are published with. The codec of a message is set as its content type,
+// so that subscribers can decode messages from engines which are configured with a different codec.
+type Codec string
+
+const (
+	CodecJSON     Codec = "json"
+	CodecProtobuf Codec = "protobuf"
+)
+
+const (
+	contentTypeJSON     = "application/json"
+	contentTypeProtobuf = "application/x-protobuf"
+)
+
+// ParseCodec returns the codec for a config value. An empty value is the JSON codec.
+func ParseCodec(s string) (Codec, error) {
+	switch s {
+	case "", string(CodecJSON):
+		return CodecJSON, nil
+	case string(CodecProtobuf):
+		return CodecProtobuf, nil
+	default:
+		return CodecJSON, fmt.Errorf("unsupported message codec %q", s)
+	}
+}
+
+// The protobuf codec encodes a message with the following schema:
+//
+//	message Message {
+//	  string id = 1;
+//	  google.protobuf.Struct payload = 2;
+//	  google.protobuf.Struct metadata = 3;
+//	  int64 retries = 4;
+//	  int64 retry_delay = 5;
+//	}
+const (
+	fieldID         protowire.Number = 1
+	fieldPayload    protowire.Number = 2
+	fieldMetadata   protowire.Number = 3
+	fieldRetries    protowire.Number = 4
+	fieldRetryDelay protowire.Number = 5
+)
+
+// encode encodes a message with the codec. It returns the body and the content type of the body.
+func encode(c Codec, msg *msgqueue.Message) ([]byte, string, error) {
+	if c == CodecProtobuf {
+		body, err := encodeProtobuf(msg)
+
+		// payloads which can't be represented as a protobuf struct fall back to JSON, which every subscriber
+		// can decode
+		if err == nil {
+			return body, contentTypeProtobuf, nil
+		}
+	}
+
+	body, err := json.Marshal(msg)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body, contentTypeJSON, nil
+}
+
+// decode decodes a message with its content type. Messages without a content type were published before
+// codecs were configurable, and are always JSON.
+func decode(contentType string, body []byte) (*msgqueue.Message, error) {
+	switch contentType {
+	case "", contentTypeJSON:
+		msg := &msgqueue.Message{}
+
+		if err := json.Unmarshal(body, msg); err != nil {
+			return nil, err
+		}
+
+		return msg, nil
+	case contentTypeProtobuf:
+		return decodeProtobuf(body)
+	default:
+		return nil, fmt.Errorf("unsupported message content type %q", contentType)
+	}
+}
+
+func encodeProtobuf(msg *msgqueue.Message) ([]byte, error) {
+	var body []byte
+
+	body = protowire.AppendTag(body, fieldID, protowire.BytesType)
+	body = protowire.AppendString(body, msg.ID)
+
+	for _, field := range []struct {
+		num protowire.Number
+		m   map[string]interface{}
+	}{
+		{fieldPayload, msg.Payload},
+		{fieldMetadata, msg.Metadata},
+	} {
+		// nil maps are left out, so that they are decoded as nil maps like they are with JSON
+		if field.m == nil {
+			continue
+		}
+
+		s, err := structpb.NewStruct(field.m)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not convert message %s to a protobuf struct: %w", msg.ID, err)
+		}
+
+		structBytes, err := proto.Marshal(s)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal message %s: %w", msg.ID, err)
+		}
+
+		body = protowire.AppendTag(body, field.num, protowire.BytesType)
+		body = protowire.AppendBytes(body, structBytes)
+	}
+
+	body = protowire.AppendTag(body, fieldRetries, protowire.VarintType)
+	body = protowire.AppendVarint(body, uint64(msg.Retries))
+
+	body = protowire.AppendTag(body, fieldRetryDelay, protowire.VarintType)
+	body = protowire.AppendVarint(body, uint64(msg.RetryDelay))
+
+	return body, nil
+}
+
+func decodeProtobuf(body []byte) (*msgqueue.Message, error) {
+	msg := &msgqueue.Message{}
+
+	for len(body) > 0 {
+		num, typ, n := protowire.ConsumeTag(body)
+
+		if n < 0 {
+			return nil, fmt.Errorf("could not decode message: %w", protowire.ParseError(n))
+		}
+
+		body = body[n:]
+
+		switch {
+		case num == fieldID && typ == protowire.BytesType:
+			msg.ID, n = protowire.ConsumeString(body)
+		case (num == fieldPayload || num == fieldMetadata) && typ == protowire.BytesType:
+			var structBytes []byte
+
+			structBytes, n = protowire.ConsumeBytes(body)
+
+			if n < 0 {
+				break
+			}
+
+			s := &structpb.Struct{}
+
+			if err := proto.Unmarshal(structBytes, s); err != nil {
+				return nil, fmt.Errorf("could not decode message: %w", err)
+			}
+
+			if num == fieldPayload {
+				msg.Payload = s.AsMap()
+			} else {
+				msg.Metadata = s.AsMap()
+			}
+		case (num == fieldRetries || num == fieldRetryDelay) && typ == protowire.VarintType:
+			var v uint64
+
+			v, n = protowire.ConsumeVarint(body)
+
+			if num == fieldRetries {
+				msg.Retries = int(v)
+			} else {
+				msg.RetryDelay = int(v)
+			}
+		default:
+			// skip unknown fields, so that fields can be added to the schema
+			n = protowire.ConsumeFieldValue(num, typ, body)
+		}
+
+		if n < 0 {
+			return nil, fmt.Errorf("could not decode message: %w", protowire.ParseError(n))
+		}
+
+		body = body[n:]
+	}
+
+	return msg, nil
+}
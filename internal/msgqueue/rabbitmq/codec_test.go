@@ -0,0 +1,125 @@
+package rabbitmq
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+)
+
+func testMessage() *msgqueue.Message {
+	return &msgqueue.Message{
+		ID: "step-run-finished",
+		Payload: map[string]interface{}{
+			"step_run_id":      "a6b7ae0e-6d7c-4c34-9d8d-8b2f6a5c0d11",
+			"step_output_data": `{"result":1}`,
+			"nested": map[string]interface{}{
+				"count": float64(3),
+				"items": []interface{}{"a", true, nil},
+			},
+		},
+		Metadata: map[string]interface{}{
+			"tenant_id": "707d0855-80ab-4e1f-a156-f1c4546cbf52",
+		},
+		Retries:    5,
+		RetryDelay: 10,
+	}
+}
+
+func TestCodecRoundTrip(t *testing.T) {
+	for _, c := range []Codec{CodecJSON, CodecProtobuf} {
+		msg := testMessage()
+
+		body, contentType, err := encode(c, msg)
+
+		if err != nil {
+			t.Fatalf("could not encode with %s: %v", c, err)
+		}
+
+		res, err := decode(contentType, body)
+
+		if err != nil {
+			t.Fatalf("could not decode with %s: %v", c, err)
+		}
+
+		if !reflect.DeepEqual(res, msg) {
+			t.Fatalf("expected %s round trip to return %+v, got %+v", c, msg, res)
+		}
+	}
+}
+
+func TestCodecNilMaps(t *testing.T) {
+	msg := &msgqueue.Message{ID: "no-payload"}
+
+	body, contentType, err := encode(CodecProtobuf, msg)
+
+	if err != nil {
+		t.Fatalf("could not encode: %v", err)
+	}
+
+	res, err := decode(contentType, body)
+
+	if err != nil {
+		t.Fatalf("could not decode: %v", err)
+	}
+
+	if res.Payload != nil || res.Metadata != nil {
+		t.Fatalf("expected nil maps, got %+v", res)
+	}
+}
+
+func TestDecodeWithoutContentType(t *testing.T) {
+	msg := testMessage()
+
+	body, err := json.Marshal(msg)
+
+	if err != nil {
+		t.Fatalf("could not marshal: %v", err)
+	}
+
+	res, err := decode("", body)
+
+	if err != nil {
+		t.Fatalf("could not decode: %v", err)
+	}
+
+	if !reflect.DeepEqual(res, msg) {
+		t.Fatalf("expected %+v, got %+v", msg, res)
+	}
+}
+
+func TestEncodeFallsBackToJSON(t *testing.T) {
+	msg := &msgqueue.Message{
+		ID: "unsupported",
+		Payload: map[string]interface{}{
+			"data": struct {
+				Count int `json:"count"`
+			}{Count: 1},
+		},
+	}
+
+	_, contentType, err := encode(CodecProtobuf, msg)
+
+	if err != nil {
+		t.Fatalf("could not encode: %v", err)
+	}
+
+	if contentType != contentTypeJSON {
+		t.Fatalf("expected a payload which is not a protobuf struct to be encoded as json, got %q", contentType)
+	}
+}
+
+func TestParseCodec(t *testing.T) {
+	if c, err := ParseCodec(""); err != nil || c != CodecJSON {
+		t.Fatalf("expected an empty codec to be json, got %q, %v", c, err)
+	}
+
+	if c, err := ParseCodec("protobuf"); err != nil || c != CodecProtobuf {
+		t.Fatalf("expected protobuf codec, got %q, %v", c, err)
+	}
+
+	if _, err := ParseCodec("msgpack"); err == nil {
+		t.Fatal("expected an error for an unsupported codec")
+	}
+}
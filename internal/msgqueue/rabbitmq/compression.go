@@ -0,0 +1,83 @@
+package rabbitmq
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+)
+
+// Compression is the algorithm which message bodies above the compression threshold are compressed with. It is
+// set as the content encoding of a compressed message, so that subscribers know how to decompress it.
+type Compression string
+
+const (
+	CompressionNone Compression = ""
+	CompressionGzip Compression = "gzip"
+)
+
+// ParseCompression returns the compression for a config value. An empty value or "none" disables compression.
+func ParseCompression(s string) (Compression, error) {
+	switch s {
+	case "", "none":
+		return CompressionNone, nil
+	case string(CompressionGzip):
+		return CompressionGzip, nil
+	default:
+		return CompressionNone, fmt.Errorf("unsupported message compression %q", s)
+	}
+}
+
+// compress compresses a message body if it is at least threshold bytes. It returns the body and the content
+// encoding of the body, which is empty if the body was not compressed.
+func compress(c Compression, threshold int, body []byte) ([]byte, string, error) {
+	if c == CompressionNone || len(body) < threshold {
+		return body, "", nil
+	}
+
+	switch c {
+	case CompressionGzip:
+		var buf bytes.Buffer
+
+		w := gzip.NewWriter(&buf)
+
+		if _, err := w.Write(body); err != nil {
+			return nil, "", fmt.Errorf("could not gzip message body: %w", err)
+		}
+
+		if err := w.Close(); err != nil {
+			return nil, "", fmt.Errorf("could not gzip message body: %w", err)
+		}
+
+		return buf.Bytes(), string(CompressionGzip), nil
+	default:
+		return nil, "", fmt.Errorf("unsupported message compression %q", c)
+	}
+}
+
+// decompress decompresses a message body with its content encoding. Bodies without a content encoding were not
+// compressed, which includes every message published before compression was enabled.
+func decompress(contentEncoding string, body []byte) ([]byte, error) {
+	switch Compression(contentEncoding) {
+	case CompressionNone:
+		return body, nil
+	case CompressionGzip:
+		r, err := gzip.NewReader(bytes.NewReader(body))
+
+		if err != nil {
+			return nil, fmt.Errorf("could not gunzip message body: %w", err)
+		}
+
+		defer r.Close()
+
+		res, err := io.ReadAll(r)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not gunzip message body: %w", err)
+		}
+
+		return res, nil
+	default:
+		return nil, fmt.Errorf("unsupported message content encoding %q", contentEncoding)
+	}
+}
@@ -0,0 +1,84 @@
+package rabbitmq
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCompressBelowThreshold(t *testing.T) {
+	body := []byte(`{"id":"step-run-finished"}`)
+
+	res, contentEncoding, err := compress(CompressionGzip, 1024, body)
+
+	if err != nil {
+		t.Fatalf("could not compress: %v", err)
+	}
+
+	if contentEncoding != "" || !bytes.Equal(res, body) {
+		t.Fatalf("expected body below the threshold to be left as is, got encoding %q", contentEncoding)
+	}
+}
+
+func TestCompressRoundTrip(t *testing.T) {
+	body := bytes.Repeat([]byte(`{"input":"aaaaaaaaaaaaaaaa"}`), 100)
+
+	compressed, contentEncoding, err := compress(CompressionGzip, 1024, body)
+
+	if err != nil {
+		t.Fatalf("could not compress: %v", err)
+	}
+
+	if contentEncoding != "gzip" {
+		t.Fatalf("expected gzip content encoding, got %q", contentEncoding)
+	}
+
+	if len(compressed) >= len(body) {
+		t.Fatalf("expected compressed body to be smaller than %d bytes, got %d", len(body), len(compressed))
+	}
+
+	res, err := decompress(contentEncoding, compressed)
+
+	if err != nil {
+		t.Fatalf("could not decompress: %v", err)
+	}
+
+	if !bytes.Equal(res, body) {
+		t.Fatal("decompressed body does not match the original body")
+	}
+}
+
+func TestCompressionDisabled(t *testing.T) {
+	body := bytes.Repeat([]byte("a"), 4096)
+
+	res, contentEncoding, err := compress(CompressionNone, 0, body)
+
+	if err != nil {
+		t.Fatalf("could not compress: %v", err)
+	}
+
+	if contentEncoding != "" || !bytes.Equal(res, body) {
+		t.Fatalf("expected body to be left as is when compression is disabled, got encoding %q", contentEncoding)
+	}
+}
+
+func TestDecompressUnknownEncoding(t *testing.T) {
+	if _, err := decompress("br", []byte("body")); err == nil {
+		t.Fatal("expected an error for an unknown content encoding")
+	}
+}
+
+func TestParseCompression(t *testing.T) {
+	for _, s := range []string{"", "none"} {
+		if c, err := ParseCompression(s); err != nil || c != CompressionNone {
+			t.Fatalf("expected %q to disable compression, got %q, %v", s, c, err)
+		}
+	}
+
+	if c, err := ParseCompression("gzip"); err != nil || c != CompressionGzip {
+		t.Fatalf("expected gzip compression, got %q, %v", c, err)
+	}
+
+	if _, err := ParseCompression("lz4"); err == nil {
+		t.Fatal("expected an error for an unsupported compression")
+	}
+}
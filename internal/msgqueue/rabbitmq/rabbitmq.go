@@ -3,7 +3,6 @@ package rabbitmq
 import (
 	"context"
 	"crypto/sha256"
-	"encoding/json"
 	"fmt"
 	"os"
 	"sync"
@@ -39,6 +38,10 @@ type MessageQueueImpl struct {
 
 	l *zerolog.Logger
 
+	codec                Codec
+	compression          Compression
+	compressionThreshold int
+
 	ready bool
 
 	// lru cache for tenant ids
@@ -52,15 +55,21 @@ func (t *MessageQueueImpl) IsReady() bool {
 type MessageQueueImplOpt func(*MessageQueueImplOpts)
 
 type MessageQueueImplOpts struct {
-	l   *zerolog.Logger
-	url string
+	l       *zerolog.Logger
+	url     string
+	urlFunc func() string
+
+	codec                Codec
+	compression          Compression
+	compressionThreshold int
 }
 
 func defaultMessageQueueImplOpts() *MessageQueueImplOpts {
 	l := logger.NewDefaultLogger("rabbitmq")
 
 	return &MessageQueueImplOpts{
-		l: &l,
+		l:     &l,
+		codec: CodecJSON,
 	}
 }
 
@@ -76,6 +85,33 @@ func WithURL(url string) MessageQueueImplOpt {
 	}
 }
 
+// WithURLFunc sets a function which returns the URL whenever the message queue connects, for credentials which
+// are rotated while the engine runs. It takes precedence over WithURL.
+func WithURLFunc(f func() string) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.urlFunc = f
+	}
+}
+
+// WithCodec sets the codec which messages are published with. Subscribers decode messages with the codec they
+// were published with, but older engines can only decode JSON messages, so the protobuf codec should only be
+// enabled once every engine supports it.
+func WithCodec(c Codec) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.codec = c
+	}
+}
+
+// WithCompression compresses the bodies of messages which are at least threshold bytes. Subscribers decompress
+// messages whichever compression they were configured with, but older engines can't read compressed messages,
+// so compression should only be enabled once every engine supports it.
+func WithCompression(c Compression, threshold int) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.compression = c
+		opts.compressionThreshold = threshold
+	}
+}
+
 // New creates a new MessageQueueImpl.
 func New(fs ...MessageQueueImplOpt) (func() error, *MessageQueueImpl) {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -90,12 +126,23 @@ func New(fs ...MessageQueueImplOpt) (func() error, *MessageQueueImpl) {
 	opts.l = &newLogger
 
 	t := &MessageQueueImpl{
-		ctx:      ctx,
-		identity: identity(),
-		l:        opts.l,
+		ctx:                  ctx,
+		identity:             identity(),
+		l:                    opts.l,
+		codec:                opts.codec,
+		compression:          opts.compression,
+		compressionThreshold: opts.compressionThreshold,
+	}
+
+	urlFunc := opts.urlFunc
+
+	if urlFunc == nil {
+		urlFunc = func() string {
+			return opts.url
+		}
 	}
 
-	t.sessions = t.redial(ctx, opts.l, opts.url)
+	t.sessions = t.redial(ctx, opts.l, urlFunc)
 	t.msgs = make(chan *msgWithQueue)
 
 	// create a new lru cache for tenant ids
@@ -143,6 +190,8 @@ func New(fs ...MessageQueueImplOpt) (func() error, *MessageQueueImpl) {
 
 // AddMessage adds a msg to the queue.
 func (t *MessageQueueImpl) AddMessage(ctx context.Context, q msgqueue.Queue, msg *msgqueue.Message) error {
+	msg.SetCorrelationIDFromContext(ctx)
+
 	t.msgs <- &msgWithQueue{
 		Message: msg,
 		q:       q,
@@ -260,20 +309,29 @@ func (t *MessageQueueImpl) startPublishing() func() error {
 					return
 				case msg := <-t.msgs:
 					go func(msg *msgWithQueue) {
-						body, err := json.Marshal(msg)
+						body, contentType, err := encode(t.codec, msg.Message)
 
 						if err != nil {
 							t.l.Error().Msgf("error marshaling msg queue: %v", err)
 							return
 						}
 
+						body, contentEncoding, err := compress(t.compression, t.compressionThreshold, body)
+
+						if err != nil {
+							t.l.Error().Msgf("error compressing msg: %v", err)
+							return
+						}
+
 						ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 						defer cancel()
 
 						t.l.Debug().Msgf("publishing msg %s to queue %s", msg.ID, msg.q.Name())
 
 						err = pub.PublishWithContext(ctx, "", msg.q.Name(), false, false, amqp.Publishing{
-							Body: body,
+							ContentType:     contentType,
+							ContentEncoding: contentEncoding,
+							Body:            body,
 						})
 
 						// TODO: retry failed delivery on the next session
@@ -298,7 +356,9 @@ func (t *MessageQueueImpl) startPublishing() func() error {
 							t.l.Debug().Msgf("publishing tenant msg %s to exchange %s", msg.ID, msg.TenantID())
 
 							err = pub.PublishWithContext(ctx, msg.TenantID(), "", false, false, amqp.Publishing{
-								Body: body,
+								ContentType:     contentType,
+								ContentEncoding: contentEncoding,
+								Body:            body,
 							})
 
 							if err != nil {
@@ -367,13 +427,24 @@ func (t *MessageQueueImpl) subscribe(
 
 					go func(rabbitMsg amqp.Delivery) {
 						defer wg.Done()
-						msg := &msgWithQueue{}
+						body, err := decompress(rabbitMsg.ContentEncoding, rabbitMsg.Body)
 
-						if err := json.Unmarshal(rabbitMsg.Body, msg); err != nil {
+						if err != nil {
+							t.l.Error().Msgf("error decompressing message: %v", err)
+							return
+						}
+
+						decoded, err := decode(rabbitMsg.ContentType, body)
+
+						if err != nil {
 							t.l.Error().Msgf("error unmarshaling message: %v", err)
 							return
 						}
 
+						msg := &msgWithQueue{
+							Message: decoded,
+						}
+
 						// determine if we've hit the max number of retries
 						xDeath, exists := rabbitMsg.Headers["x-death"].([]interface{})
 
@@ -436,7 +507,7 @@ func (t *MessageQueueImpl) subscribe(
 }
 
 // redial continually connects to the URL, exiting the program when no longer possible
-func (t *MessageQueueImpl) redial(ctx context.Context, l *zerolog.Logger, url string) chan chan session {
+func (t *MessageQueueImpl) redial(ctx context.Context, l *zerolog.Logger, url func() string) chan chan session {
 	sessions := make(chan chan session)
 
 	go func() {
@@ -451,7 +522,7 @@ func (t *MessageQueueImpl) redial(ctx context.Context, l *zerolog.Logger, url st
 				return
 			}
 
-			newSession, err := getSession(ctx, l, url)
+			newSession, err := getSession(ctx, l, url())
 			if err != nil {
 				l.Error().Msgf("error getting session: %v", err)
 				return
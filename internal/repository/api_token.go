@@ -18,11 +18,28 @@ type CreateAPITokenOpts struct {
 
 	// (optional) A name for this API token
 	Name *string `validate:"omitempty,max=255"`
+
+	// (optional) The scopes of this API token. A token without scopes can be used for everything except
+	// managing other tokens.
+	Scopes []string `validate:"omitempty,dive,oneof=read write worker tokens"`
+
+	// (optional) The environment of this API token. Workers which register with the token join the
+	// environment, and the runs and events which the token creates belong to it.
+	Environment *string `validate:"omitnil,hatchetName"`
+
+	// (optional) The namespace of this API token. Workflow names and event keys without a namespace are
+	// resolved to the namespace, and the token can only access the workflows and events of the namespace.
+	Namespace *string `validate:"omitnil,hatchetName"`
+}
+
+type ListAPITokensOpts struct {
+	// (optional) the environment to filter by
+	Environment *string
 }
 
 type APITokenRepository interface {
 	GetAPITokenById(id string) (*db.APITokenModel, error)
 	CreateAPIToken(opts *CreateAPITokenOpts) (*db.APITokenModel, error)
 	RevokeAPIToken(id string) error
-	ListAPITokensByTenant(tenantId string) ([]db.APITokenModel, error)
+	ListAPITokensByTenant(tenantId string, opts *ListAPITokensOpts) ([]db.APITokenModel, error)
 }
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+type CreateClientCertificateOpts struct {
+	// (required) the name of the certificate, which is unique within the tenant
+	Name string `validate:"required,hatchetName"`
+
+	// (required) the SHA-256 fingerprint of the certificate, as lowercase hex
+	Fingerprint string `validate:"required,hexadecimal,len=64"`
+
+	// (optional) the subject of the certificate
+	Subject *string
+
+	// (optional) when the certificate expires
+	ExpiresAt *time.Time
+}
+
+type ClientCertificateRepository interface {
+	// CreateClientCertificate pins a client certificate for the workers of a tenant.
+	CreateClientCertificate(tenantId string, opts *CreateClientCertificateOpts) (*db.ClientCertificateModel, error)
+
+	// GetClientCertificateById returns a pinned client certificate by its id.
+	GetClientCertificateById(id string) (*db.ClientCertificateModel, error)
+
+	// ListClientCertificates returns the client certificates which are pinned for a tenant.
+	ListClientCertificates(tenantId string) ([]db.ClientCertificateModel, error)
+
+	// ListClientCertificateFingerprints returns the fingerprints of the client certificates which are pinned for
+	// a tenant.
+	ListClientCertificateFingerprints(tenantId string) ([]string, error)
+
+	// DeleteClientCertificate unpins a client certificate of a tenant.
+	DeleteClientCertificate(tenantId, id string) error
+}
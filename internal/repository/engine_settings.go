@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/settings"
+)
+
+type UpdateTenantEngineSettingsOpts struct {
+	// (optional) the timeout of step runs whose step doesn't set one
+	DefaultStepTimeout *string `validate:"omitnil,duration"`
+
+	// (optional) how long a step run waits to be assigned to a worker if its step doesn't set a schedule timeout
+	ScheduleTimeout *string `validate:"omitnil,duration"`
+
+	// (optional) the number of times step runs are retried if their step doesn't set retries
+	DefaultStepRetries *int `validate:"omitnil,min=0,max=100"`
+
+	// (optional) the maximum number of workflow runs which a single event triggers, or 0 for no limit
+	MaxFanOut *int `validate:"omitnil,min=0"`
+}
+
+type EngineSettingsRepository interface {
+	// GetTenantEngineSettings returns the settings which a tenant overrides, or db.ErrNotFound if the tenant has
+	// never overridden a setting.
+	GetTenantEngineSettings(tenantId string) (*db.TenantEngineSettingsModel, error)
+
+	// GetTenantEngineSettingsOverrides returns the settings which a tenant overrides, parsed.
+	GetTenantEngineSettingsOverrides(tenantId string) (*settings.Overrides, error)
+
+	// UpdateTenantEngineSettings replaces the settings which a tenant overrides. Settings which aren't set in the
+	// opts use the defaults again.
+	UpdateTenantEngineSettings(tenantId string, opts *UpdateTenantEngineSettingsOpts) (*db.TenantEngineSettingsModel, error)
+}
@@ -19,6 +19,9 @@ type CreateEventOpts struct {
 
 	// (optional) the event that this event is replaying
 	ReplayedEvent *string `validate:"omitempty,uuid"`
+
+	// (optional) the environment of the event. Workflow runs which are triggered by the event belong to it.
+	Environment *string `validate:"omitnil,hatchetName"`
 }
 
 type ListEventOpts struct {
@@ -40,6 +43,12 @@ type ListEventOpts struct {
 	// (optional) a search query
 	Search *string
 
+	// (optional) the environment to filter by
+	Environment *string
+
+	// (optional) the namespace to filter by, which only returns the events whose keys have the namespace
+	Namespace *string
+
 	// (optional) the event that this event is replaying
 	ReplayedEvent *string `validate:"omitempty,uuid"`
 
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+// The topics which event bus subscriptions can subscribe to.
+const (
+	EventBusTopicWorkflowRunFinished = "workflow-run-finished"
+	EventBusTopicStepRunFailed       = "step-run-failed"
+	EventBusTopicWorkerRegistered    = "worker-registered"
+)
+
+type CreateEventBusSubscriptionOpts struct {
+	// (required) the name of the subscription, which is unique within the tenant
+	Name string `validate:"required,hatchetName"`
+
+	// (required) the topics of the subscription
+	Topics []string `validate:"required,min=1,dive,oneof=workflow-run-finished step-run-failed worker-registered"`
+}
+
+type EventBusRepository interface {
+	// CreateEventBusSubscription creates a subscription for a tenant, which receives the records created after it.
+	CreateEventBusSubscription(tenantId string, opts *CreateEventBusSubscriptionOpts) (*db.EventBusSubscriptionModel, error)
+
+	// GetEventBusSubscriptionById returns a subscription by its id.
+	GetEventBusSubscriptionById(id string) (*db.EventBusSubscriptionModel, error)
+
+	// ListEventBusSubscriptions returns the subscriptions of a tenant.
+	ListEventBusSubscriptions(tenantId string) ([]db.EventBusSubscriptionModel, error)
+
+	// DeleteEventBusSubscription deletes a subscription of a tenant.
+	DeleteEventBusSubscription(tenantId, id string) error
+
+	// CreateEventBusRecord creates a record for a topic of a tenant, if any subscription of the tenant is subscribed
+	// to the topic.
+	CreateEventBusRecord(ctx context.Context, tenantId, topic string, data any) error
+
+	// ListEventBusRecords returns the records which have not been acknowledged by a subscription yet, oldest first,
+	// and records when the subscription was last polled.
+	ListEventBusRecords(ctx context.Context, subscriptionId string, limit int) ([]*dbsqlc.EventBusRecord, error)
+
+	// AckEventBusSubscription acknowledges the records up to and including the cursor for a subscription.
+	AckEventBusSubscription(ctx context.Context, subscriptionId string, cursor int64) error
+
+	// DeleteExpiredEventBusRecords deletes up to limit records which were created before the given time, and returns
+	// the number of deleted records.
+	DeleteExpiredEventBusRecords(ctx context.Context, before time.Time, limit int) (int64, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+type FeatureFlagRepository interface {
+	// ListTenantFeatureFlags returns the flags which are overridden for a tenant.
+	ListTenantFeatureFlags(tenantId string) ([]db.TenantFeatureFlagModel, error)
+
+	// GetTenantFeatureFlagOverrides returns whether each overridden flag is enabled for a tenant, by name.
+	GetTenantFeatureFlagOverrides(tenantId string) (map[string]bool, error)
+
+	// UpsertTenantFeatureFlag overrides a flag for a tenant.
+	UpsertTenantFeatureFlag(tenantId, flag string, enabled bool) (*db.TenantFeatureFlagModel, error)
+
+	// DeleteTenantFeatureFlag removes the override of a flag for a tenant, so that the instance-wide setting
+	// applies again.
+	DeleteTenantFeatureFlag(tenantId, flag string) error
+}
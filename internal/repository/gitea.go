@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/hatchet-dev/hatchet/internal/encryption"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+type CreateGiteaWebhookOpts struct {
+	// (required) the repo owner
+	RepoOwner string `validate:"required,min=1,max=255"`
+
+	// (required) the repo name
+	RepoName string `validate:"required,min=1,max=255"`
+
+	// (required) the encrypted signing secret
+	SigningSecret []byte `validate:"required,min=1"`
+}
+
+// NewGiteaWebhookCreateOpts generates the signing secret of a new Gitea webhook, and returns the options to create
+// the webhook with the encrypted secret, along with the secret which is configured on Gitea.
+func NewGiteaWebhookCreateOpts(
+	enc encryption.EncryptionService,
+	repoOwner string,
+	repoName string,
+) (opts *CreateGiteaWebhookOpts, signingSecret string, err error) {
+	signingSecret, err = encryption.GenerateRandomBytes(16)
+
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate signing secret: %s", err.Error())
+	}
+
+	signingSecretEncrypted, err := enc.Encrypt([]byte(signingSecret), "gitea_signing_secret")
+
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to encrypt signing secret: %s", err.Error())
+	}
+
+	opts = &CreateGiteaWebhookOpts{
+		RepoOwner:     repoOwner,
+		RepoName:      repoName,
+		SigningSecret: signingSecretEncrypted,
+	}
+
+	return opts, signingSecret, nil
+}
+
+type GiteaRepository interface {
+	// CreateGiteaWebhook creates a webhook which receives the events of a Gitea repository.
+	CreateGiteaWebhook(tenantId string, opts *CreateGiteaWebhookOpts) (*db.GiteaWebhookModel, error)
+
+	GetGiteaWebhookById(id string) (*db.GiteaWebhookModel, error)
+
+	ListGiteaWebhooks(tenantId string) ([]db.GiteaWebhookModel, error)
+
+	DeleteGiteaWebhook(tenantId, id string) error
+}
@@ -85,6 +85,54 @@ type CreateGithubAppOAuthOpts struct {
 	Config *types.JSON
 }
 
+type CreateGithubAppCredentialsOpts struct {
+	// (required) the Github app id
+	AppID int `validate:"required"`
+
+	// (required) the Github app slug
+	AppSlug string `validate:"required"`
+
+	// (required) the url of the Github app's settings page
+	SettingsURL string `validate:"required,url"`
+
+	// (required) the Github app's client id
+	ClientID string `validate:"required"`
+
+	// (required) the encrypted client secret
+	ClientSecret []byte `validate:"required,min=1"`
+
+	// (required) the encrypted webhook secret
+	WebhookSecret []byte `validate:"required,min=1"`
+
+	// (required) the encrypted private key
+	PrivateKey []byte `validate:"required,min=1"`
+}
+
+type UpdateGithubWebhookStatusOpts struct {
+	// (required) when the webhook was reconciled
+	ReconciledAt time.Time `validate:"required"`
+
+	// (optional) the id of the webhook on Github
+	HookID *int64
+
+	// (optional) the error of the reconciliation. The error of the previous reconciliation is cleared if this is
+	// not set.
+	ReconcileError *string
+
+	// (optional) the recent failed deliveries of the webhook
+	FailedDeliveries *types.JSON
+}
+
+// LinkedGithubRepository is a Github repository which is linked to a workflow of a tenant.
+type LinkedGithubRepository struct {
+	TenantId  string
+	RepoOwner string
+	RepoName  string
+
+	// InstallationID is the id of the Github app installation with access to the repository
+	InstallationID int
+}
+
 type UpdateInstallationOpts struct {
 }
 
@@ -98,6 +146,40 @@ type UpdatePullRequestOpts struct {
 	BaseBranch *string
 }
 
+type UpsertPullRequestOpts struct {
+	// (required) the repository owner
+	RepoOwner string `validate:"required"`
+
+	// (required) the repository name
+	RepoName string `validate:"required"`
+
+	// (required) the id of the pull request on Github
+	PullRequestID int `validate:"required"`
+
+	// (required) the pull request number
+	PullRequestNumber int `validate:"required"`
+
+	Title string
+
+	State string `validate:"required"`
+
+	HeadBranch string `validate:"required"`
+
+	BaseBranch string `validate:"required"`
+}
+
+type UpsertPreviewEnvironmentOpts struct {
+	// (optional) the url of the environment
+	URL *string
+
+	// (optional) the id of the pull request comment which reports the environment
+	CommentID *int64
+
+	// (optional) when the environment was torn down. This is cleared if it's not set, so that an environment
+	// which is provisioned again is no longer torn down.
+	TornDownAt *time.Time
+}
+
 type GithubRepository interface {
 	CreateInstallation(githubUserId int, opts *CreateInstallationOpts) (*db.GithubAppInstallationModel, error)
 
@@ -126,4 +208,32 @@ type GithubRepository interface {
 	UpdatePullRequest(tenantId, prId string, opts *UpdatePullRequestOpts) (*db.GithubPullRequestModel, error)
 
 	GetPullRequest(tenantId, repoOwner, repoName string, prNumber int) (*db.GithubPullRequestModel, error)
+
+	// UpsertPullRequest stores a pull request which wasn't opened by Hatchet, or updates it if it's already stored.
+	UpsertPullRequest(tenantId string, opts *UpsertPullRequestOpts) (*db.GithubPullRequestModel, error)
+
+	// AddPullRequestWorkflowRun links a workflow run to the pull request which it was triggered for.
+	AddPullRequestWorkflowRun(tenantId, prId, workflowRunId string) error
+
+	// GetPreviewEnvironment returns the preview environment which a workflow provisioned for a pull request. It
+	// returns db.ErrNotFound if the workflow didn't provision an environment for the pull request.
+	GetPreviewEnvironment(tenantId, prId, workflowId string) (*db.GithubPreviewEnvironmentModel, error)
+
+	// ListPreviewEnvironments lists the preview environments of a pull request.
+	ListPreviewEnvironments(tenantId, prId string) ([]db.GithubPreviewEnvironmentModel, error)
+
+	UpsertPreviewEnvironment(tenantId, prId, workflowId string, opts *UpsertPreviewEnvironmentOpts) (*db.GithubPreviewEnvironmentModel, error)
+
+	// CreateGithubAppCredentials stores the credentials of a Github app which was created with the app manifest flow.
+	CreateGithubAppCredentials(opts *CreateGithubAppCredentialsOpts) (*db.GithubAppCredentialsModel, error)
+
+	// ReadLatestGithubAppCredentials returns the credentials of the most recently created Github app.
+	ReadLatestGithubAppCredentials() (*db.GithubAppCredentialsModel, error)
+
+	// ListLinkedGithubRepositories lists the Github repositories which are linked to workflows, across tenants.
+	ListLinkedGithubRepositories() ([]*LinkedGithubRepository, error)
+
+	ListGithubWebhooks(tenantId string) ([]db.GithubWebhookModel, error)
+
+	UpdateGithubWebhookStatus(id string, opts *UpdateGithubWebhookStatusOpts) (*db.GithubWebhookModel, error)
 }
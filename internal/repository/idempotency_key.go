@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+type CreateIdempotencyKeyOpts struct {
+	// (required) the key sent by the client
+	Key string `validate:"required,max=255"`
+
+	// (required) a hash of the request
+	Fingerprint string `validate:"required"`
+
+	// (required) when the key can be reused
+	ExpiresAt time.Time `validate:"required"`
+}
+
+type UpdateIdempotencyKeyResponseOpts struct {
+	// (required) the response status code
+	Status int `validate:"required"`
+
+	// (optional) the response content type
+	ContentType *string
+
+	// (required) the response body, which should be encrypted
+	Body []byte
+}
+
+type IdempotencyKeyRepository interface {
+	// CreateIdempotencyKey reserves an idempotency key for a tenant. An expired key with the same value is
+	// replaced.
+	CreateIdempotencyKey(tenantId string, opts *CreateIdempotencyKeyOpts) (*db.IdempotencyKeyModel, error)
+
+	// GetIdempotencyKey returns an unexpired idempotency key for a tenant.
+	GetIdempotencyKey(tenantId, key string) (*db.IdempotencyKeyModel, error)
+
+	// UpdateIdempotencyKeyResponse stores the response to the request which reserved the key.
+	UpdateIdempotencyKeyResponse(tenantId, key string, opts *UpdateIdempotencyKeyResponseOpts) error
+
+	// DeleteIdempotencyKey releases a key, so that the request can be retried.
+	DeleteIdempotencyKey(tenantId, key string) error
+
+	// DeleteExpiredIdempotencyKeys deletes up to limit keys which expired, and returns the number of deleted keys.
+	DeleteExpiredIdempotencyKeys(ctx context.Context, limit int) (int64, error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+type LeaderLeaseRepository interface {
+	// AcquireLeaderLease acquires the lease of the role for the given duration, or renews it if it is already
+	// held by the leader. It returns false if the lease is held by another instance.
+	AcquireLeaderLease(ctx context.Context, role, leaderId string, duration time.Duration) (bool, error)
+
+	// ReleaseLeaderLease releases the lease of the role if it is held by the leader, so that another instance
+	// can take it over without waiting for it to expire.
+	ReleaseLeaderLease(ctx context.Context, role, leaderId string) error
+}
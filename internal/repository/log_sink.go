@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+// The events of the records which are forwarded to log sinks.
+const (
+	LogSinkEventStepRunStarted      = "step-run-started"
+	LogSinkEventStepRunSucceeded    = "step-run-succeeded"
+	LogSinkEventStepRunFailed       = "step-run-failed"
+	LogSinkEventStepRunCancelled    = "step-run-cancelled"
+	LogSinkEventStepRunLog          = "step-run-log"
+	LogSinkEventWorkflowRunFinished = "workflow-run-finished"
+)
+
+type CreateLogSinkOpts struct {
+	// (required) the name of the sink, which is unique within the tenant
+	Name string `validate:"required,hatchetName"`
+
+	// (required) the kind of the sink
+	Kind string `validate:"required,oneof=HTTP S3 DATADOG"`
+
+	// (required) where records are sent, without any secrets
+	Destination string `validate:"required"`
+
+	// (required) the config of the sink, which should be encrypted
+	Config []byte `validate:"required"`
+
+	// (optional) the maximum number of records which are sent in one delivery
+	BatchSize *int `validate:"omitnil,min=1,max=1000"`
+}
+
+type LogSinkRepository interface {
+	// CreateLogSink creates a log sink for a tenant, which receives the records created after it.
+	CreateLogSink(tenantId string, opts *CreateLogSinkOpts) (*db.LogSinkModel, error)
+
+	// GetLogSinkById returns a log sink by its id.
+	GetLogSinkById(id string) (*db.LogSinkModel, error)
+
+	// ListLogSinks returns the log sinks of a tenant.
+	ListLogSinks(tenantId string) ([]db.LogSinkModel, error)
+
+	// DeleteLogSink deletes a log sink of a tenant.
+	DeleteLogSink(tenantId, id string) error
+
+	// CreateLogSinkRecord creates a record for the log sinks of a tenant, if the tenant has any enabled log sinks.
+	CreateLogSinkRecord(ctx context.Context, tenantId, event string, data any) error
+
+	// ListLogSinksToDeliver returns the enabled log sinks which are not waiting to retry a failed delivery.
+	ListLogSinksToDeliver(ctx context.Context, limit int) ([]*dbsqlc.LogSink, error)
+
+	// ListLogSinkRecords returns the committed records which have not been delivered to a log sink yet, ordered by
+	// the transaction which created them.
+	ListLogSinkRecords(ctx context.Context, logSinkId string, limit int) ([]*dbsqlc.LogSinkRecord, error)
+
+	// UpdateLogSinkDelivered marks the records up to and including lastRecord as delivered to a log sink.
+	UpdateLogSinkDelivered(ctx context.Context, logSinkId string, lastRecord *dbsqlc.LogSinkRecord) error
+
+	// UpdateLogSinkFailed records a failed delivery to a log sink, which is retried at nextAttemptAt.
+	UpdateLogSinkFailed(ctx context.Context, logSinkId string, deliveryErr error, nextAttemptAt time.Time) error
+
+	// DeleteExpiredLogSinkRecords deletes up to limit records which were created before the given time, and returns
+	// the number of deleted records.
+	DeleteExpiredLogSinkRecords(ctx context.Context, before time.Time, limit int) (int64, error)
+}
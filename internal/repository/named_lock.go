@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+type NamedLockRepository interface {
+	// AcquireNamedLock acquires the named lock of a tenant for a step run, or for its workflow run if
+	// holdsWorkflowRun is set, until the timeout passes. It renews the lock if it's already held by the same
+	// holder, and returns nil if it's held by another step run or workflow run.
+	AcquireNamedLock(ctx context.Context, tenantId, name, stepRunId string, holdsWorkflowRun bool, timeout time.Duration) (*dbsqlc.NamedLock, error)
+
+	// ReleaseNamedLock releases the named lock of a tenant if it's held by the step run, or by its workflow run. It
+	// returns false if the lock isn't held by either.
+	ReleaseNamedLock(ctx context.Context, tenantId, name, stepRunId string) (bool, error)
+
+	// DeleteReleasedNamedLocks deletes the named locks which expired or whose holder finished, and returns how many
+	// were deleted. This is an instance-wide query.
+	DeleteReleasedNamedLocks(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+)
+
+// NamespaceSeparator separates the namespace of a workflow name or an event key from the rest of the name, as
+// in payments/process-order. Names without a separator belong to the default namespace.
+const NamespaceSeparator = "/"
+
+// ErrOutsideNamespace is returned when a token with a namespace uses a name of a different namespace.
+var ErrOutsideNamespace = errors.New("name is outside the namespace of the token")
+
+// SplitNamespace returns the namespace of a workflow name or an event key and the name without it. The namespace
+// is empty for names in the default namespace.
+func SplitNamespace(name string) (namespace, shortName string) {
+	if namespace, shortName, ok := strings.Cut(name, NamespaceSeparator); ok {
+		return namespace, shortName
+	}
+
+	return "", name
+}
+
+// InNamespace returns whether a workflow name or an event key belongs to the namespace.
+func InNamespace(namespace, name string) bool {
+	ns, _ := SplitNamespace(name)
+
+	return ns == namespace
+}
+
+// ResolveNamespace prefixes a workflow name or an event key with the namespace of a token, unless the name already
+// has a namespace. Tokens with a namespace can't use the names of other namespaces, while tokens without a
+// namespace (an empty namespace) can use every name as it is.
+func ResolveNamespace(namespace, name string) (string, error) {
+	if namespace == "" {
+		return name, nil
+	}
+
+	if !strings.Contains(name, NamespaceSeparator) {
+		return namespace + NamespaceSeparator + name, nil
+	}
+
+	if !InNamespace(namespace, name) {
+		return "", ErrOutsideNamespace
+	}
+
+	return name, nil
+}
+
+// ResolveWorkflowNamespace resolves the name and the event triggers of a workflow to the namespace of a token, so
+// that a workflow which is registered with the token belongs to its namespace.
+func ResolveWorkflowNamespace(namespace string, opts *CreateWorkflowVersionOpts) error {
+	name, err := ResolveNamespace(namespace, opts.Name)
+
+	if err != nil {
+		return err
+	}
+
+	opts.Name = name
+
+	for i, eventKey := range opts.EventTriggers {
+		resolved, err := ResolveNamespace(namespace, eventKey)
+
+		if err != nil {
+			return err
+		}
+
+		opts.EventTriggers[i] = resolved
+	}
+
+	return nil
+}
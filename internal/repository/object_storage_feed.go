@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+type CreateObjectStorageFeedOpts struct {
+	// (required) the name of the feed, which is unique within the tenant
+	Name string `validate:"required,hatchetName"`
+
+	// (required) the kind of the feed
+	Kind string `validate:"required,oneof=SQS PUBSUB"`
+
+	// (required) where notifications are received from, without any secrets
+	Source string `validate:"required"`
+
+	// (required) the config of the feed, which should be encrypted
+	Config []byte `validate:"required"`
+
+	// (required) the key of the events which are created for created objects
+	EventKey string `validate:"required"`
+
+	// (optional) only objects whose key starts with the prefix create events
+	KeyPrefix *string `validate:"omitnil,min=1"`
+}
+
+type ObjectStorageFeedRepository interface {
+	// CreateObjectStorageFeed creates an object storage feed for a tenant.
+	CreateObjectStorageFeed(tenantId string, opts *CreateObjectStorageFeedOpts) (*db.ObjectStorageFeedModel, error)
+
+	// GetObjectStorageFeedById returns an object storage feed by its id.
+	GetObjectStorageFeedById(id string) (*db.ObjectStorageFeedModel, error)
+
+	// ListObjectStorageFeeds returns the object storage feeds of a tenant.
+	ListObjectStorageFeeds(tenantId string) ([]db.ObjectStorageFeedModel, error)
+
+	// DeleteObjectStorageFeed deletes an object storage feed of a tenant.
+	DeleteObjectStorageFeed(tenantId, id string) error
+
+	// ListObjectStorageFeedsToPoll returns the enabled feeds which are not waiting to retry a failed poll.
+	ListObjectStorageFeedsToPoll(ctx context.Context, limit int) ([]*dbsqlc.ObjectStorageFeed, error)
+
+	// UpdateObjectStorageFeedPolled records a successful poll of a feed, which received notifications if received is
+	// true.
+	UpdateObjectStorageFeedPolled(ctx context.Context, feedId string, received bool) error
+
+	// UpdateObjectStorageFeedFailed records a failed poll of a feed, which is retried at nextAttemptAt.
+	UpdateObjectStorageFeedFailed(ctx context.Context, feedId string, pollErr error, nextAttemptAt time.Time) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"errors"
+)
+
+// ErrWorkflowPaused is returned when a workflow run is triggered while its workflow or tenant is paused with the
+// REJECT trigger behavior.
+var ErrWorkflowPaused = errors.New("workflow is paused")
+
+type PauseOpts struct {
+	// (optional) what happens to new triggers while paused, either REJECT or BUFFER. Triggers are rejected when
+	// not set.
+	TriggerBehavior *string `validate:"omitempty,oneof=REJECT BUFFER"`
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+type CreatePIIRuleOpts struct {
+	// (required) the JSONPath expression which matches the personal data, which is unique within the tenant
+	Path string `validate:"required,max=256"`
+
+	// (required) how long the matched values are kept after a step run settled, in seconds
+	RetentionSeconds int `validate:"required,min=60"`
+}
+
+// PurgeFunc replaces the personal data in a JSON document, and returns whether the document changed.
+type PurgeFunc func(data []byte) ([]byte, bool)
+
+type PurgeStepRunsOpts struct {
+	// (required) only the step runs which settled before this time are purged
+	Before time.Time
+
+	// (required) the maximum number of step runs which are purged
+	Limit int
+
+	// (required) purges the inputs and outputs of the step runs and their archived results
+	Purge PurgeFunc
+
+	// (required) purges the lookup data of the job runs of the step runs
+	PurgeLookupData PurgeFunc
+}
+
+type PurgeStepRunsResult struct {
+	// the number of step runs which were checked for personal data
+	StepRuns int
+
+	// the number of step runs, archived results and job run lookup data which changed
+	Purged int
+}
+
+type PIIRuleRepository interface {
+	// CreatePIIRule creates a PII rule for a tenant.
+	CreatePIIRule(tenantId string, opts *CreatePIIRuleOpts) (*db.PIIRuleModel, error)
+
+	// GetPIIRuleById returns a PII rule by its id.
+	GetPIIRuleById(id string) (*db.PIIRuleModel, error)
+
+	// ListPIIRules returns the PII rules of a tenant.
+	ListPIIRules(tenantId string) ([]db.PIIRuleModel, error)
+
+	// DeletePIIRule deletes a PII rule of a tenant. Values which were already purged are not restored.
+	DeletePIIRule(tenantId, id string) error
+
+	// ClaimPIIRulesToPurge returns the rules which are due, and claims them so that they are not returned to other
+	// tickers until their purge is recorded or the claim expires.
+	ClaimPIIRulesToPurge(ctx context.Context, limit int) ([]*dbsqlc.PIIRule, error)
+
+	// PurgeStepRuns purges the next step runs of a rule, starting after the step run which was purged last, and
+	// moves the rule past the purged step runs.
+	PurgeStepRuns(ctx context.Context, rule *dbsqlc.PIIRule, opts *PurgeStepRunsOpts) (*PurgeStepRunsResult, error)
+
+	// UpdatePIIRuleNextPurge schedules the next purge of a rule.
+	UpdatePIIRuleNextPurge(ctx context.Context, ruleId string, nextPurgeAt time.Time) error
+}
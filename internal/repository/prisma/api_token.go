@@ -47,6 +47,18 @@ func (a *apiTokenRepository) CreateAPIToken(opts *repository.CreateAPITokenOpts)
 		optionals = append(optionals, db.APIToken.Name.Set(*opts.Name))
 	}
 
+	if len(opts.Scopes) > 0 {
+		optionals = append(optionals, db.APIToken.Scopes.Set(opts.Scopes))
+	}
+
+	if opts.Environment != nil {
+		optionals = append(optionals, db.APIToken.Environment.Set(*opts.Environment))
+	}
+
+	if opts.Namespace != nil {
+		optionals = append(optionals, db.APIToken.Namespace.Set(*opts.Namespace))
+	}
+
 	return a.client.APIToken.CreateOne(
 		optionals...,
 	).Exec(context.Background())
@@ -63,9 +75,17 @@ func (a *apiTokenRepository) RevokeAPIToken(id string) error {
 	return err
 }
 
-func (a *apiTokenRepository) ListAPITokensByTenant(tenantId string) ([]db.APITokenModel, error) {
-	return a.client.APIToken.FindMany(
+func (a *apiTokenRepository) ListAPITokensByTenant(tenantId string, opts *repository.ListAPITokensOpts) ([]db.APITokenModel, error) {
+	params := []db.APITokenWhereParam{
 		db.APIToken.TenantID.Equals(tenantId),
 		db.APIToken.Revoked.Equals(false),
+	}
+
+	if opts != nil && opts.Environment != nil {
+		params = append(params, db.APIToken.Environment.Equals(*opts.Environment))
+	}
+
+	return a.client.APIToken.FindMany(
+		params...,
 	).Exec(context.Background())
 }
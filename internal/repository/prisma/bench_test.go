@@ -0,0 +1,338 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/internal/config/database"
+	"github.com/hatchet-dev/hatchet/internal/encryption"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/assignment"
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+)
+
+// The benchmarks in this file run against the database of the integration tests. Their output is in the standard
+// Go benchmark format, so runs can be compared with benchstat, see `task bench`.
+
+const benchAction = "bench:step"
+
+type benchFixture struct {
+	repo            repository.Repository
+	tenantId        string
+	workflowVersion *db.WorkflowVersionModel
+}
+
+// newBenchFixture creates a new tenant with a workflow of a single step. If concurrency is set, the workflow has a
+// GROUP_ROUND_ROBIN concurrency limit.
+func newBenchFixture(b *testing.B, conf *database.Config, concurrency bool) *benchFixture {
+	b.Helper()
+
+	tenantId := uuid.New().String()
+
+	slugSuffix, err := encryption.GenerateRandomBytes(8)
+
+	if err != nil {
+		b.Fatal(err.Error())
+	}
+
+	_, err = conf.Repository.Tenant().CreateTenant(&repository.CreateTenantOpts{
+		ID:   &tenantId,
+		Name: "bench-tenant",
+		Slug: fmt.Sprintf("bench-tenant-%s", slugSuffix),
+	})
+
+	if err != nil {
+		b.Fatalf("could not create tenant: %v", err)
+	}
+
+	opts := &repository.CreateWorkflowVersionOpts{
+		Name: "bench-workflow",
+		Jobs: []repository.CreateWorkflowJobOpts{
+			{
+				Name: "job",
+				Steps: []repository.CreateWorkflowStepOpts{
+					{
+						ReadableId: "step",
+						Action:     benchAction,
+					},
+				},
+			},
+		},
+	}
+
+	if concurrency {
+		maxRuns := int32(math.MaxInt32)
+
+		opts.Concurrency = &repository.CreateWorkflowConcurrencyOpts{
+			Action:        "bench:concurrency",
+			MaxRuns:       &maxRuns,
+			LimitStrategy: repository.StringPtr(string(db.ConcurrencyLimitStrategyGroupRoundRobin)),
+		}
+	}
+
+	workflowVersion, err := conf.Repository.Workflow().CreateNewWorkflow(tenantId, opts)
+
+	if err != nil {
+		b.Fatalf("could not create workflow: %v", err)
+	}
+
+	// fetch the workflow version with its relations
+	workflowVersion, err = conf.Repository.Workflow().GetWorkflowVersionById(tenantId, workflowVersion.ID)
+
+	if err != nil {
+		b.Fatalf("could not get workflow version: %v", err)
+	}
+
+	return &benchFixture{
+		repo:            conf.Repository,
+		tenantId:        tenantId,
+		workflowVersion: workflowVersion,
+	}
+}
+
+func (f *benchFixture) createWorkflowRun(b *testing.B) *db.WorkflowRunModel {
+	b.Helper()
+
+	opts, err := repository.GetCreateWorkflowRunOptsFromManual(f.workflowVersion, []byte(`{}`))
+
+	if err != nil {
+		b.Fatalf("could not get workflow run opts: %v", err)
+	}
+
+	workflowRun, err := f.repo.WorkflowRun().CreateNewWorkflowRun(context.Background(), f.tenantId, opts)
+
+	if err != nil {
+		b.Fatalf("could not create workflow run: %v", err)
+	}
+
+	return workflowRun
+}
+
+// createQueuedWorkflowRun creates a workflow run and finishes its get group key run, which puts the run in the
+// QUEUED status for the group.
+func (f *benchFixture) createQueuedWorkflowRun(b *testing.B, groupKey string) {
+	b.Helper()
+
+	workflowRun := f.createWorkflowRun(b)
+
+	groupKeyRun, ok := workflowRun.GetGroupKeyRun()
+
+	if !ok {
+		b.Fatalf("workflow run %s has no get group key run", workflowRun.ID)
+	}
+
+	now := time.Now().UTC()
+
+	_, err := f.repo.GetGroupKeyRun().UpdateGetGroupKeyRun(f.tenantId, groupKeyRun.ID, &repository.UpdateGetGroupKeyRunOpts{
+		FinishedAt: &now,
+		Status:     repository.StepRunStatusPtr(db.StepRunStatusSucceeded),
+		Output:     &groupKey,
+	})
+
+	if err != nil {
+		b.Fatalf("could not finish get group key run: %v", err)
+	}
+}
+
+// createPendingStepRun creates a workflow run and returns the id of its step run, which is pending assignment.
+func (f *benchFixture) createPendingStepRun(b *testing.B) string {
+	b.Helper()
+
+	workflowRun := f.createWorkflowRun(b)
+
+	stepRuns, err := f.repo.StepRun().ListStepRuns(f.tenantId, &repository.ListStepRunsOpts{
+		WorkflowRunId: &workflowRun.ID,
+	})
+
+	if err != nil || len(stepRuns) != 1 {
+		b.Fatalf("could not list step runs: %v", err)
+	}
+
+	_, err = f.repo.StepRun().QueueStepRun(context.Background(), f.tenantId, stepRuns[0].ID, &repository.UpdateStepRunOpts{
+		Status: repository.StepRunStatusPtr(db.StepRunStatusPendingAssignment),
+	})
+
+	if err != nil {
+		b.Fatalf("could not queue step run: %v", err)
+	}
+
+	return stepRuns[0].ID
+}
+
+// createWorker creates a worker for the step action, which can be assigned any number of step runs.
+func (f *benchFixture) createWorker(b *testing.B) string {
+	b.Helper()
+
+	dispatcher, err := f.repo.Dispatcher().CreateNewDispatcher(&repository.CreateDispatcherOpts{
+		ID: uuid.New().String(),
+	})
+
+	if err != nil {
+		b.Fatalf("could not create dispatcher: %v", err)
+	}
+
+	worker, err := f.repo.Worker().CreateNewWorker(f.tenantId, &repository.CreateWorkerOpts{
+		DispatcherId: dispatcher.ID,
+		Name:         "bench-worker",
+		Actions:      []string{benchAction},
+	})
+
+	if err != nil {
+		b.Fatalf("could not create worker: %v", err)
+	}
+
+	f.heartbeat(b, worker.ID)
+
+	return worker.ID
+}
+
+// heartbeat keeps the worker eligible for assignment, as workers without a heartbeat in the last 5 seconds are
+// skipped.
+func (f *benchFixture) heartbeat(b *testing.B, workerId string) {
+	b.Helper()
+
+	now := time.Now().UTC()
+
+	_, err := f.repo.Worker().UpdateWorker(f.tenantId, workerId, &repository.UpdateWorkerOpts{
+		LastHeartbeatAt: &now,
+	})
+
+	if err != nil {
+		b.Fatalf("could not update worker heartbeat: %v", err)
+	}
+}
+
+func BenchmarkGetWorkflowRunById(b *testing.B) {
+	testutils.RunTestWithDatabase(b, func(conf *database.Config) error {
+		f := newBenchFixture(b, conf, false)
+
+		workflowRun := f.createWorkflowRun(b)
+
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			if _, err := f.repo.WorkflowRun().GetWorkflowRunById(f.tenantId, workflowRun.ID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func BenchmarkAssignStepRunToWorker(b *testing.B) {
+	testutils.RunTestWithDatabase(b, func(conf *database.Config) error {
+		f := newBenchFixture(b, conf, false)
+
+		workerIds := make([]string, 0, 10)
+
+		for i := 0; i < 10; i++ {
+			workerIds = append(workerIds, f.createWorker(b))
+		}
+
+		selector := assignment.NewSelector()
+
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+
+			stepRunId := f.createPendingStepRun(b)
+
+			for _, workerId := range workerIds {
+				f.heartbeat(b, workerId)
+			}
+
+			b.StartTimer()
+
+			if _, _, err := f.repo.StepRun().AssignStepRunToWorker(f.tenantId, stepRunId, selector); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func BenchmarkPopWorkflowRunsRoundRobin(b *testing.B) {
+	// the number of queued runs and groups which are popped in each iteration
+	const runs, groups = 10, 3
+
+	testutils.RunTestWithDatabase(b, func(conf *database.Config) error {
+		f := newBenchFixture(b, conf, true)
+
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+
+			for j := 0; j < runs; j++ {
+				f.createQueuedWorkflowRun(b, fmt.Sprintf("group-%d", j%groups))
+			}
+
+			b.StartTimer()
+
+			popped, err := f.repo.WorkflowRun().PopWorkflowRunsRoundRobin(f.tenantId, f.workflowVersion.ID, math.MaxInt32)
+
+			if err != nil {
+				return err
+			}
+
+			if len(popped) != runs {
+				return fmt.Errorf("expected to pop %d workflow runs, popped %d", runs, len(popped))
+			}
+		}
+
+		return nil
+	})
+}
+
+func BenchmarkCreateEvent(b *testing.B) {
+	testutils.RunTestWithDatabase(b, func(conf *database.Config) error {
+		f := newBenchFixture(b, conf, false)
+
+		data := db.JSON(`{"key":"value"}`)
+
+		b.Run("serial", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, err := f.repo.Event().CreateEvent(context.Background(), &repository.CreateEventOpts{
+					TenantId: f.tenantId,
+					Key:      "bench:event",
+					Data:     &data,
+				})
+
+				if err != nil {
+					b.Fatalf("could not create event: %v", err)
+				}
+			}
+		})
+
+		// events are pushed concurrently by many clients, so this is the path which bulk ingestion exercises
+		b.Run("parallel", func(b *testing.B) {
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					_, err := f.repo.Event().CreateEvent(context.Background(), &repository.CreateEventOpts{
+						TenantId: f.tenantId,
+						Key:      "bench:event",
+						Data:     &data,
+					})
+
+					if err != nil {
+						b.Errorf("could not create event: %v", err)
+						return
+					}
+				}
+			})
+		})
+
+		return nil
+	})
+}
@@ -0,0 +1,76 @@
+package prisma
+
+import (
+	"context"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/validator"
+)
+
+type clientCertificateRepository struct {
+	client *db.PrismaClient
+	v      validator.Validator
+}
+
+func NewClientCertificateRepository(client *db.PrismaClient, v validator.Validator) repository.ClientCertificateRepository {
+	return &clientCertificateRepository{
+		client: client,
+		v:      v,
+	}
+}
+
+func (r *clientCertificateRepository) CreateClientCertificate(tenantId string, opts *repository.CreateClientCertificateOpts) (*db.ClientCertificateModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	return r.client.ClientCertificate.CreateOne(
+		db.ClientCertificate.Tenant.Link(
+			db.Tenant.ID.Equals(tenantId),
+		),
+		db.ClientCertificate.Name.Set(opts.Name),
+		db.ClientCertificate.Fingerprint.Set(opts.Fingerprint),
+		db.ClientCertificate.Subject.SetIfPresent(opts.Subject),
+		db.ClientCertificate.ExpiresAt.SetIfPresent(opts.ExpiresAt),
+	).Exec(context.Background())
+}
+
+func (r *clientCertificateRepository) GetClientCertificateById(id string) (*db.ClientCertificateModel, error) {
+	return r.client.ClientCertificate.FindUnique(
+		db.ClientCertificate.ID.Equals(id),
+	).Exec(context.Background())
+}
+
+func (r *clientCertificateRepository) ListClientCertificates(tenantId string) ([]db.ClientCertificateModel, error) {
+	return r.client.ClientCertificate.FindMany(
+		db.ClientCertificate.TenantID.Equals(tenantId),
+	).OrderBy(
+		db.ClientCertificate.CreatedAt.Order(db.ASC),
+	).Exec(context.Background())
+}
+
+func (r *clientCertificateRepository) ListClientCertificateFingerprints(tenantId string) ([]string, error) {
+	certs, err := r.ListClientCertificates(tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, len(certs))
+
+	for i := range certs {
+		res[i] = certs[i].Fingerprint
+	}
+
+	return res, nil
+}
+
+func (r *clientCertificateRepository) DeleteClientCertificate(tenantId, id string) error {
+	_, err := r.client.ClientCertificate.FindMany(
+		db.ClientCertificate.ID.Equals(id),
+		db.ClientCertificate.TenantID.Equals(tenantId),
+	).Delete().Exec(context.Background())
+
+	return err
+}
@@ -0,0 +1,83 @@
+-- name: CreateStepRunDiagnostic :one
+-- Captures the diagnostics of the latest attempt of a step run, if its workflow run was sampled.
+INSERT INTO "StepRunDiagnostic" (
+    "id",
+    "createdAt",
+    "tenantId",
+    "stepRunId",
+    "retryCount",
+    "workerId",
+    "input",
+    "output",
+    "error",
+    "queuedAt",
+    "slotWaitStartedAt",
+    "assignedAt",
+    "startedAt",
+    "finishedAt",
+    "resultPersistedAt",
+    "traceParent",
+    "runTraceParent"
+)
+SELECT
+    gen_random_uuid(),
+    CURRENT_TIMESTAMP,
+    sr."tenantId",
+    sr."id",
+    sr."retryCount",
+    sr."workerId",
+    sr."input",
+    sr."output",
+    sr."error",
+    sr."queuedAt",
+    sr."slotWaitStartedAt",
+    sr."assignedAt",
+    sr."startedAt",
+    sr."finishedAt",
+    sr."resultPersistedAt",
+    sqlc.narg('traceParent')::text,
+    diagnostics."traceParent"
+FROM
+    "StepRun" sr
+JOIN
+    "JobRun" jr ON sr."jobRunId" = jr."id"
+JOIN
+    "WorkflowRunDiagnostics" diagnostics ON diagnostics."workflowRunId" = jr."workflowRunId"
+WHERE
+    sr."id" = @stepRunId::uuid
+    AND sr."tenantId" = @tenantId::uuid
+RETURNING *;
+
+-- name: CreateWorkflowRunDiagnostics :execrows
+-- Samples a workflow run to capture extended diagnostics, with the probability of the diagnostics sample rate
+-- of its workflow.
+INSERT INTO "WorkflowRunDiagnostics" (
+    "workflowRunId",
+    "createdAt",
+    "tenantId",
+    "traceParent"
+)
+SELECT
+    @workflowRunId::uuid,
+    CURRENT_TIMESTAMP,
+    @tenantId::uuid,
+    sqlc.narg('traceParent')::text
+FROM
+    "WorkflowVersion" wv
+JOIN
+    "Workflow" w ON wv."workflowId" = w."id"
+WHERE
+    wv."id" = @workflowVersionId::uuid
+    AND w."diagnosticsSampleRate" > 0
+    AND random() * 100 < w."diagnosticsSampleRate";
+
+-- name: ListStepRunDiagnostics :many
+SELECT
+    *
+FROM
+    "StepRunDiagnostic"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "stepRunId" = @stepRunId::uuid
+ORDER BY
+    "createdAt" DESC;
@@ -0,0 +1,89 @@
+-- name: CreateEventBusRecord :exec
+-- Creates a record for a topic of the tenant. Nothing is created if no subscription of the tenant is subscribed to
+-- the topic.
+INSERT INTO "EventBusRecord" (
+    "createdAt",
+    "tenantId",
+    "topic",
+    "data"
+)
+SELECT
+    CURRENT_TIMESTAMP,
+    @tenantId::uuid,
+    @topic::text,
+    @data::jsonb
+WHERE EXISTS (
+    SELECT 1
+    FROM "EventBusSubscription"
+    WHERE
+        "tenantId" = @tenantId::uuid
+        AND @topic::text = ANY("topics")
+);
+
+-- name: ListEventBusRecords :many
+-- Lists the records of the topics of the subscription after the last record which was acknowledged. Records which
+-- were created before the subscription are skipped. Records from the last seconds are skipped as well, as ids are
+-- assigned before the records are committed, so a record with a lower id may still be committed after a record with
+-- a higher id.
+WITH subscription AS (
+    SELECT
+        "tenantId",
+        "createdAt",
+        "topics",
+        "ackedRecordId"
+    FROM
+        "EventBusSubscription"
+    WHERE
+        "id" = @subscriptionId::uuid
+)
+SELECT
+    r.*
+FROM
+    "EventBusRecord" r,
+    subscription
+WHERE
+    r."tenantId" = subscription."tenantId"
+    AND r."id" > subscription."ackedRecordId"
+    AND r."topic" = ANY(subscription."topics")
+    AND r."createdAt" >= subscription."createdAt"
+    AND r."createdAt" < CURRENT_TIMESTAMP - INTERVAL '2 seconds'
+ORDER BY
+    r."id" ASC
+LIMIT
+    @limit::int;
+
+-- name: UpdateEventBusSubscriptionPolled :exec
+UPDATE
+    "EventBusSubscription"
+SET
+    "lastPolledAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @subscriptionId::uuid;
+
+-- name: AckEventBusSubscription :exec
+-- Acknowledges the records of the subscription up to and including the cursor. The cursor never moves backwards, so
+-- acknowledgements which arrive out of order are ignored.
+UPDATE
+    "EventBusSubscription"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "ackedRecordId" = GREATEST("ackedRecordId", @cursor::bigint)
+WHERE
+    "id" = @subscriptionId::uuid;
+
+-- name: DeleteExpiredEventBusRecords :execrows
+DELETE FROM
+    "EventBusRecord"
+WHERE
+    "id" IN (
+        SELECT
+            "id"
+        FROM
+            "EventBusRecord"
+        WHERE
+            "createdAt" < @before::timestamp
+        ORDER BY
+            "id" ASC
+        LIMIT
+            @limit::int
+    );
@@ -3,7 +3,8 @@ SELECT
     "id",
     "key",
     "data",
-    "tenantId"
+    "tenantId",
+    "environment"
 FROM
     "Event"
 WHERE
@@ -39,6 +40,14 @@ WHERE
     (
         sqlc.narg('statuses')::text[] IS NULL OR
         "status" = ANY(cast(sqlc.narg('statuses')::text[] as "WorkflowRunStatus"[]))
+    ) AND
+    (
+        sqlc.narg('environment')::text IS NULL OR
+        events."environment" = sqlc.narg('environment')::text
+    ) AND
+    (
+        sqlc.narg('namespace')::text IS NULL OR
+        starts_with(events."key", sqlc.narg('namespace')::text || '/')
     );
 
 -- name: CreateEvent :one
@@ -50,7 +59,8 @@ INSERT INTO "Event" (
     "key",
     "tenantId",
     "replayedFromId",
-    "data"
+    "data",
+    "environment"
 ) VALUES (
     @id::uuid,
     coalesce(sqlc.narg('createdAt')::timestamp, CURRENT_TIMESTAMP),
@@ -59,7 +69,8 @@ INSERT INTO "Event" (
     @key::text,
     @tenantId::uuid,
     sqlc.narg('replayedFromId')::uuid,
-    @data::jsonb
+    @data::jsonb,
+    sqlc.narg('environment')::text
 ) RETURNING *;
 
 -- name: ListEvents :many
@@ -97,6 +108,14 @@ WHERE
     (
         sqlc.narg('statuses')::text[] IS NULL OR
         "status" = ANY(cast(sqlc.narg('statuses')::text[] as "WorkflowRunStatus"[]))
+    ) AND
+    (
+        sqlc.narg('environment')::text IS NULL OR
+        events."environment" = sqlc.narg('environment')::text
+    ) AND
+    (
+        sqlc.narg('namespace')::text IS NULL OR
+        starts_with(events."key", sqlc.narg('namespace')::text || '/')
     )
 GROUP BY
     events."id"
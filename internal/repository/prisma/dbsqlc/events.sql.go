@@ -41,15 +41,25 @@ WHERE
     (
         $5::text[] IS NULL OR
         "status" = ANY(cast($5::text[] as "WorkflowRunStatus"[]))
+    ) AND
+    (
+        $6::text IS NULL OR
+        events."environment" = $6::text
+    ) AND
+    (
+        $7::text IS NULL OR
+        starts_with(events."key", $7::text || '/')
     )
 `
 
 type CountEventsParams struct {
-	TenantId  pgtype.UUID `json:"tenantId"`
-	Keys      []string    `json:"keys"`
-	Workflows []string    `json:"workflows"`
-	Search    pgtype.Text `json:"search"`
-	Statuses  []string    `json:"statuses"`
+	TenantId    pgtype.UUID `json:"tenantId"`
+	Keys        []string    `json:"keys"`
+	Workflows   []string    `json:"workflows"`
+	Search      pgtype.Text `json:"search"`
+	Statuses    []string    `json:"statuses"`
+	Environment pgtype.Text `json:"environment"`
+	Namespace   pgtype.Text `json:"namespace"`
 }
 
 func (q *Queries) CountEvents(ctx context.Context, db DBTX, arg CountEventsParams) (int64, error) {
@@ -59,6 +69,8 @@ func (q *Queries) CountEvents(ctx context.Context, db DBTX, arg CountEventsParam
 		arg.Workflows,
 		arg.Search,
 		arg.Statuses,
+		arg.Environment,
+		arg.Namespace,
 	)
 	var total int64
 	err := row.Scan(&total)
@@ -74,7 +86,8 @@ INSERT INTO "Event" (
     "key",
     "tenantId",
     "replayedFromId",
-    "data"
+    "data",
+    "environment"
 ) VALUES (
     $1::uuid,
     coalesce($2::timestamp, CURRENT_TIMESTAMP),
@@ -83,8 +96,9 @@ INSERT INTO "Event" (
     $5::text,
     $6::uuid,
     $7::uuid,
-    $8::jsonb
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", key, "tenantId", "replayedFromId", data
+    $8::jsonb,
+    $9::text
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", key, "tenantId", "replayedFromId", data, environment
 `
 
 type CreateEventParams struct {
@@ -96,6 +110,7 @@ type CreateEventParams struct {
 	Tenantid       pgtype.UUID      `json:"tenantid"`
 	ReplayedFromId pgtype.UUID      `json:"replayedFromId"`
 	Data           []byte           `json:"data"`
+	Environment    pgtype.Text      `json:"environment"`
 }
 
 func (q *Queries) CreateEvent(ctx context.Context, db DBTX, arg CreateEventParams) (*Event, error) {
@@ -108,6 +123,7 @@ func (q *Queries) CreateEvent(ctx context.Context, db DBTX, arg CreateEventParam
 		arg.Tenantid,
 		arg.ReplayedFromId,
 		arg.Data,
+		arg.Environment,
 	)
 	var i Event
 	err := row.Scan(
@@ -119,6 +135,7 @@ func (q *Queries) CreateEvent(ctx context.Context, db DBTX, arg CreateEventParam
 		&i.TenantId,
 		&i.ReplayedFromId,
 		&i.Data,
+		&i.Environment,
 	)
 	return &i, err
 }
@@ -128,7 +145,8 @@ SELECT
     "id",
     "key",
     "data",
-    "tenantId"
+    "tenantId",
+    "environment"
 FROM
     "Event"
 WHERE
@@ -136,10 +154,11 @@ WHERE
 `
 
 type GetEventForEngineRow struct {
-	ID       pgtype.UUID `json:"id"`
-	Key      string      `json:"key"`
-	Data     []byte      `json:"data"`
-	TenantId pgtype.UUID `json:"tenantId"`
+	ID          pgtype.UUID `json:"id"`
+	Key         string      `json:"key"`
+	Data        []byte      `json:"data"`
+	TenantId    pgtype.UUID `json:"tenantId"`
+	Environment pgtype.Text `json:"environment"`
 }
 
 func (q *Queries) GetEventForEngine(ctx context.Context, db DBTX, id pgtype.UUID) (*GetEventForEngineRow, error) {
@@ -150,6 +169,7 @@ func (q *Queries) GetEventForEngine(ctx context.Context, db DBTX, id pgtype.UUID
 		&i.Key,
 		&i.Data,
 		&i.TenantId,
+		&i.Environment,
 	)
 	return &i, err
 }
@@ -195,7 +215,7 @@ func (q *Queries) GetEventsForRange(ctx context.Context, db DBTX) ([]*GetEventsF
 
 const listEvents = `-- name: ListEvents :many
 SELECT
-    events.id, events."createdAt", events."updatedAt", events."deletedAt", events.key, events."tenantId", events."replayedFromId", events.data,
+    events.id, events."createdAt", events."updatedAt", events."deletedAt", events.key, events."tenantId", events."replayedFromId", events.data, events.environment,
     sum(case when runs."status" = 'PENDING' OR runs."status" = 'QUEUED' then 1 else 0 end) AS pendingRuns,
     sum(case when runs."status" = 'RUNNING' then 1 else 0 end) AS runningRuns,
     sum(case when runs."status" = 'SUCCEEDED' then 1 else 0 end) AS succeededRuns,
@@ -228,27 +248,37 @@ WHERE
     (
         $5::text[] IS NULL OR
         "status" = ANY(cast($5::text[] as "WorkflowRunStatus"[]))
+    ) AND
+    (
+        $6::text IS NULL OR
+        events."environment" = $6::text
+    ) AND
+    (
+        $7::text IS NULL OR
+        starts_with(events."key", $7::text || '/')
     )
 GROUP BY
     events."id"
 ORDER BY
-    case when $6 = 'createdAt ASC' THEN events."createdAt" END ASC ,
-    case when $6 = 'createdAt DESC' then events."createdAt" END DESC
+    case when $8 = 'createdAt ASC' THEN events."createdAt" END ASC ,
+    case when $8 = 'createdAt DESC' then events."createdAt" END DESC
 OFFSET
-    COALESCE($7, 0)
+    COALESCE($9, 0)
 LIMIT
-    COALESCE($8, 50)
+    COALESCE($10, 50)
 `
 
 type ListEventsParams struct {
-	TenantId  pgtype.UUID `json:"tenantId"`
-	Keys      []string    `json:"keys"`
-	Workflows []string    `json:"workflows"`
-	Search    pgtype.Text `json:"search"`
-	Statuses  []string    `json:"statuses"`
-	Orderby   interface{} `json:"orderby"`
-	Offset    interface{} `json:"offset"`
-	Limit     interface{} `json:"limit"`
+	TenantId    pgtype.UUID `json:"tenantId"`
+	Keys        []string    `json:"keys"`
+	Workflows   []string    `json:"workflows"`
+	Search      pgtype.Text `json:"search"`
+	Statuses    []string    `json:"statuses"`
+	Environment pgtype.Text `json:"environment"`
+	Namespace   pgtype.Text `json:"namespace"`
+	Orderby     interface{} `json:"orderby"`
+	Offset      interface{} `json:"offset"`
+	Limit       interface{} `json:"limit"`
 }
 
 type ListEventsRow struct {
@@ -266,6 +296,8 @@ func (q *Queries) ListEvents(ctx context.Context, db DBTX, arg ListEventsParams)
 		arg.Workflows,
 		arg.Search,
 		arg.Statuses,
+		arg.Environment,
+		arg.Namespace,
 		arg.Orderby,
 		arg.Offset,
 		arg.Limit,
@@ -286,6 +318,7 @@ func (q *Queries) ListEvents(ctx context.Context, db DBTX, arg ListEventsParams)
 			&i.Event.TenantId,
 			&i.Event.ReplayedFromId,
 			&i.Event.Data,
+			&i.Event.Environment,
 			&i.Pendingruns,
 			&i.Runningruns,
 			&i.Succeededruns,
@@ -28,6 +28,7 @@ SELECT
     wr."id" AS "workflowRunId",
     wv."id" AS "workflowVersionId",
     wv."workflowId" AS "workflowId",
+    wv."scheduleTimeout" AS "scheduleTimeout",
     a."actionId" AS "actionId"
 FROM
     "GetGroupKeyRun" ggr
@@ -37,7 +38,8 @@ JOIN
     "WorkflowVersion" wv ON wr."workflowVersionId" = wv."id"
 JOIN
     "WorkflowConcurrency" wc ON wv."id" = wc."workflowVersionId"
-JOIN
+-- concurrency groups which are computed from key expressions don't have an action
+LEFT JOIN
     "Action" a ON wc."getConcurrencyGroupId" = a."id"
 WHERE
     ggr."id" = ANY(@ids::uuid[]) AND
@@ -82,7 +84,8 @@ WITH get_group_key_run AS (
     SELECT
         ggr."id",
         ggr."status",
-        a."id" AS "actionId"
+        a."id" AS "actionId",
+        wr."environment"
     FROM
         "GetGroupKeyRun" ggr
     JOIN
@@ -111,6 +114,8 @@ WITH get_group_key_run AS (
             INNER JOIN "Action" ON "Action"."id" = "_ActionToWorker"."A"
             WHERE "Action"."tenantId" = @tenantId AND "Action"."id" = get_group_key_run."actionId"
         )
+        -- runs are only assigned to workers of their environment
+        AND w."environment" IS NOT DISTINCT FROM get_group_key_run."environment"
     ORDER BY random()
     FOR UPDATE SKIP LOCKED
 ), selected_worker AS (
@@ -58,7 +58,8 @@ WITH get_group_key_run AS (
     SELECT
         ggr."id",
         ggr."status",
-        a."id" AS "actionId"
+        a."id" AS "actionId",
+        wr."environment"
     FROM
         "GetGroupKeyRun" ggr
     JOIN
@@ -87,6 +88,8 @@ WITH get_group_key_run AS (
             INNER JOIN "Action" ON "Action"."id" = "_ActionToWorker"."A"
             WHERE "Action"."tenantId" = $2 AND "Action"."id" = get_group_key_run."actionId"
         )
+        -- runs are only assigned to workers of their environment
+        AND w."environment" IS NOT DISTINCT FROM get_group_key_run."environment"
     ORDER BY random()
     FOR UPDATE SKIP LOCKED
 ), selected_worker AS (
@@ -136,6 +139,7 @@ SELECT
     wr."id" AS "workflowRunId",
     wv."id" AS "workflowVersionId",
     wv."workflowId" AS "workflowId",
+    wv."scheduleTimeout" AS "scheduleTimeout",
     a."actionId" AS "actionId"
 FROM
     "GetGroupKeyRun" ggr
@@ -145,7 +149,8 @@ JOIN
     "WorkflowVersion" wv ON wr."workflowVersionId" = wv."id"
 JOIN
     "WorkflowConcurrency" wc ON wv."id" = wc."workflowVersionId"
-JOIN
+-- concurrency groups which are computed from key expressions don't have an action
+LEFT JOIN
     "Action" a ON wc."getConcurrencyGroupId" = a."id"
 WHERE
     ggr."id" = ANY($1::uuid[]) AND
@@ -162,7 +167,8 @@ type GetGroupKeyRunForEngineRow struct {
 	WorkflowRunId     pgtype.UUID    `json:"workflowRunId"`
 	WorkflowVersionId pgtype.UUID    `json:"workflowVersionId"`
 	WorkflowId        pgtype.UUID    `json:"workflowId"`
-	ActionId          string         `json:"actionId"`
+	ScheduleTimeout   string         `json:"scheduleTimeout"`
+	ActionId          pgtype.Text    `json:"actionId"`
 }
 
 func (q *Queries) GetGroupKeyRunForEngine(ctx context.Context, db DBTX, arg GetGroupKeyRunForEngineParams) ([]*GetGroupKeyRunForEngineRow, error) {
@@ -198,6 +204,7 @@ func (q *Queries) GetGroupKeyRunForEngine(ctx context.Context, db DBTX, arg GetG
 			&i.WorkflowRunId,
 			&i.WorkflowVersionId,
 			&i.WorkflowId,
+			&i.ScheduleTimeout,
 			&i.ActionId,
 		); err != nil {
 			return nil, err
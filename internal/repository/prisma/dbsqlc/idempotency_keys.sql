@@ -0,0 +1,17 @@
+-- name: DeleteExpiredIdempotencyKeys :execrows
+-- Deletes up to limit idempotency keys which expired, oldest first.
+DELETE FROM
+    "IdempotencyKey"
+WHERE
+    "id" IN (
+        SELECT
+            "id"
+        FROM
+            "IdempotencyKey"
+        WHERE
+            "expiresAt" <= CURRENT_TIMESTAMP
+        ORDER BY
+            "expiresAt" ASC
+        LIMIT
+            @limit::int
+    );
@@ -13,11 +13,12 @@ import (
 
 const resolveJobRunStatus = `-- name: ResolveJobRunStatus :one
 WITH stepRuns AS (
-    SELECT sum(case when runs."status" IN ('PENDING', 'PENDING_ASSIGNMENT') then 1 else 0 end) AS pendingRuns,
+    SELECT sum(case when runs."status" IN ('PENDING', 'PENDING_ASSIGNMENT', 'WAITING_ON_DEPENDENCY') then 1 else 0 end) AS pendingRuns,
         sum(case when runs."status" IN ('RUNNING', 'ASSIGNED') then 1 else 0 end) AS runningRuns,
         sum(case when runs."status" = 'SUCCEEDED' then 1 else 0 end) AS succeededRuns,
-        sum(case when runs."status" = 'FAILED' then 1 else 0 end) AS failedRuns,
-        sum(case when runs."status" = 'CANCELLED' then 1 else 0 end) AS cancelledRuns
+        -- failed and cancelled runs on a branch of a join step which can still start don't fail the job
+        sum(case when runs."status" = 'FAILED' AND NOT runs."toleratedByJoin" then 1 else 0 end) AS failedRuns,
+        sum(case when runs."status" = 'CANCELLED' AND NOT runs."toleratedByJoin" then 1 else 0 end) AS cancelledRuns
     FROM "StepRun" as runs
     WHERE
         "jobRunId" = (
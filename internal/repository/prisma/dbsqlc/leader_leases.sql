@@ -0,0 +1,32 @@
+-- name: AcquireLeaderLease :one
+-- Acquires the lease of the role if it has expired, or renews it if it is already held by the leader. No
+-- rows are returned if the lease is held by another instance.
+INSERT INTO "LeaderLease" (
+    "role",
+    "createdAt",
+    "updatedAt",
+    "leaderId",
+    "expiresAt"
+) VALUES (
+    @role::text,
+    CURRENT_TIMESTAMP,
+    CURRENT_TIMESTAMP,
+    @leaderId::text,
+    CURRENT_TIMESTAMP + make_interval(secs => @leaseSeconds::float)
+)
+ON CONFLICT ("role") DO UPDATE
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "leaderId" = EXCLUDED."leaderId",
+    "expiresAt" = EXCLUDED."expiresAt"
+WHERE
+    "LeaderLease"."leaderId" = EXCLUDED."leaderId"
+    OR "LeaderLease"."expiresAt" < CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: ReleaseLeaderLease :exec
+DELETE FROM
+    "LeaderLease"
+WHERE
+    "role" = @role::text
+    AND "leaderId" = @leaderId::text;
@@ -0,0 +1,113 @@
+-- name: CreateLogSinkRecord :exec
+-- Creates a record for the log sinks of the tenant. Nothing is created if the tenant has no enabled log sinks.
+INSERT INTO "LogSinkRecord" (
+    "createdAt",
+    "tenantId",
+    "event",
+    "data"
+)
+SELECT
+    CURRENT_TIMESTAMP,
+    @tenantId::uuid,
+    @event::text,
+    @data::jsonb
+WHERE EXISTS (
+    SELECT 1
+    FROM "LogSink"
+    WHERE
+        "tenantId" = @tenantId::uuid
+        AND "enabled" = true
+);
+
+-- name: ListLogSinksToDeliver :many
+SELECT
+    *
+FROM
+    "LogSink"
+WHERE
+    "enabled" = true
+    AND "nextAttemptAt" <= CURRENT_TIMESTAMP
+ORDER BY
+    "nextAttemptAt" ASC
+LIMIT
+    @limit::int;
+
+-- name: ListLogSinkRecords :many
+-- Lists the records after the last record which was delivered to the sink, ordered by the transaction which created
+-- them. Records which were created before the sink are skipped. Records are only listed once every transaction with
+-- a lower id has finished, as ids are assigned before the records are committed, so a record with a lower id may
+-- still be committed after a record with a higher id.
+WITH sink AS (
+    SELECT
+        "tenantId",
+        "createdAt",
+        "lastRecordXid",
+        "lastRecordId"
+    FROM
+        "LogSink"
+    WHERE
+        "id" = @logSinkId::uuid
+)
+SELECT
+    r.*
+FROM
+    "LogSinkRecord" r,
+    sink
+WHERE
+    r."tenantId" = sink."tenantId"
+    AND (r."xid", r."id") > (sink."lastRecordXid", sink."lastRecordId")
+    AND r."createdAt" >= sink."createdAt"
+    AND r."xid" < pg_snapshot_xmin(pg_current_snapshot())::text::bigint
+ORDER BY
+    r."xid" ASC,
+    r."id" ASC
+LIMIT
+    @limit::int;
+
+-- name: UpdateLogSinkDelivered :exec
+UPDATE
+    "LogSink"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "lastRecordXid" = CASE
+        WHEN (@lastRecordXid::bigint, @lastRecordId::bigint) > ("lastRecordXid", "lastRecordId") THEN @lastRecordXid::bigint
+        ELSE "lastRecordXid"
+    END,
+    "lastRecordId" = CASE
+        WHEN (@lastRecordXid::bigint, @lastRecordId::bigint) > ("lastRecordXid", "lastRecordId") THEN @lastRecordId::bigint
+        ELSE "lastRecordId"
+    END,
+    "lastDeliveredAt" = CURRENT_TIMESTAMP,
+    "lastError" = NULL,
+    "failures" = 0,
+    "nextAttemptAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @logSinkId::uuid;
+
+-- name: UpdateLogSinkFailed :exec
+UPDATE
+    "LogSink"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "lastError" = @lastError::text,
+    "failures" = "failures" + 1,
+    "nextAttemptAt" = @nextAttemptAt::timestamp
+WHERE
+    "id" = @logSinkId::uuid;
+
+-- name: DeleteExpiredLogSinkRecords :execrows
+DELETE FROM
+    "LogSinkRecord"
+WHERE
+    "id" IN (
+        SELECT
+            "id"
+        FROM
+            "LogSinkRecord"
+        WHERE
+            "createdAt" < @before::timestamp
+        ORDER BY
+            "id" ASC
+        LIMIT
+            @limit::int
+    );
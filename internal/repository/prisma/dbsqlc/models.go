@@ -11,6 +11,52 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type CancellationSource string
+
+const (
+	CancellationSourceCONCURRENCY     CancellationSource = "CONCURRENCY"
+	CancellationSourceUSER            CancellationSource = "USER"
+	CancellationSourceTIMEOUT         CancellationSource = "TIMEOUT"
+	CancellationSourcePARENTCANCELLED CancellationSource = "PARENT_CANCELLED"
+	CancellationSourceJOINSATISFIED   CancellationSource = "JOIN_SATISFIED"
+	CancellationSourceBUDGETEXCEEDED  CancellationSource = "BUDGET_EXCEEDED"
+)
+
+func (e *CancellationSource) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CancellationSource(s)
+	case string:
+		*e = CancellationSource(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CancellationSource: %T", src)
+	}
+	return nil
+}
+
+type NullCancellationSource struct {
+	CancellationSource CancellationSource `json:"CancellationSource"`
+	Valid              bool               `json:"valid"` // Valid is true if CancellationSource is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCancellationSource) Scan(value interface{}) error {
+	if value == nil {
+		ns.CancellationSource, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CancellationSource.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCancellationSource) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CancellationSource), nil
+}
+
 type ConcurrencyLimitStrategy string
 
 const (
@@ -136,228 +182,956 @@ func (ns *NullJobRunStatus) Scan(value interface{}) error {
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullJobRunStatus) Value() (driver.Value, error) {
+func (ns NullJobRunStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.JobRunStatus), nil
+}
+
+type LogLineLevel string
+
+const (
+	LogLineLevelDEBUG LogLineLevel = "DEBUG"
+	LogLineLevelINFO  LogLineLevel = "INFO"
+	LogLineLevelWARN  LogLineLevel = "WARN"
+	LogLineLevelERROR LogLineLevel = "ERROR"
+)
+
+func (e *LogLineLevel) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = LogLineLevel(s)
+	case string:
+		*e = LogLineLevel(s)
+	default:
+		return fmt.Errorf("unsupported scan type for LogLineLevel: %T", src)
+	}
+	return nil
+}
+
+type NullLogLineLevel struct {
+	LogLineLevel LogLineLevel `json:"LogLineLevel"`
+	Valid        bool         `json:"valid"` // Valid is true if LogLineLevel is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullLogLineLevel) Scan(value interface{}) error {
+	if value == nil {
+		ns.LogLineLevel, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.LogLineLevel.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullLogLineLevel) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.LogLineLevel), nil
+}
+
+type LogSinkKind string
+
+const (
+	LogSinkKindHTTP    LogSinkKind = "HTTP"
+	LogSinkKindS3      LogSinkKind = "S3"
+	LogSinkKindDATADOG LogSinkKind = "DATADOG"
+)
+
+func (e *LogSinkKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = LogSinkKind(s)
+	case string:
+		*e = LogSinkKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for LogSinkKind: %T", src)
+	}
+	return nil
+}
+
+type NullLogSinkKind struct {
+	LogSinkKind LogSinkKind `json:"LogSinkKind"`
+	Valid       bool        `json:"valid"` // Valid is true if LogSinkKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullLogSinkKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.LogSinkKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.LogSinkKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullLogSinkKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.LogSinkKind), nil
+}
+
+type ObjectStorageFeedKind string
+
+const (
+	ObjectStorageFeedKindSQS    ObjectStorageFeedKind = "SQS"
+	ObjectStorageFeedKindPUBSUB ObjectStorageFeedKind = "PUBSUB"
+)
+
+func (e *ObjectStorageFeedKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ObjectStorageFeedKind(s)
+	case string:
+		*e = ObjectStorageFeedKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ObjectStorageFeedKind: %T", src)
+	}
+	return nil
+}
+
+type NullObjectStorageFeedKind struct {
+	ObjectStorageFeedKind ObjectStorageFeedKind `json:"ObjectStorageFeedKind"`
+	Valid                 bool                  `json:"valid"` // Valid is true if ObjectStorageFeedKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullObjectStorageFeedKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.ObjectStorageFeedKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ObjectStorageFeedKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullObjectStorageFeedKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ObjectStorageFeedKind), nil
+}
+
+type PausedTriggerBehavior string
+
+const (
+	PausedTriggerBehaviorREJECT PausedTriggerBehavior = "REJECT"
+	PausedTriggerBehaviorBUFFER PausedTriggerBehavior = "BUFFER"
+)
+
+func (e *PausedTriggerBehavior) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PausedTriggerBehavior(s)
+	case string:
+		*e = PausedTriggerBehavior(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PausedTriggerBehavior: %T", src)
+	}
+	return nil
+}
+
+type NullPausedTriggerBehavior struct {
+	PausedTriggerBehavior PausedTriggerBehavior `json:"PausedTriggerBehavior"`
+	Valid                 bool                  `json:"valid"` // Valid is true if PausedTriggerBehavior is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPausedTriggerBehavior) Scan(value interface{}) error {
+	if value == nil {
+		ns.PausedTriggerBehavior, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PausedTriggerBehavior.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPausedTriggerBehavior) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PausedTriggerBehavior), nil
+}
+
+type PreviewEnvironmentHook string
+
+const (
+	PreviewEnvironmentHookPROVISION PreviewEnvironmentHook = "PROVISION"
+	PreviewEnvironmentHookTEARDOWN  PreviewEnvironmentHook = "TEARDOWN"
+)
+
+func (e *PreviewEnvironmentHook) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PreviewEnvironmentHook(s)
+	case string:
+		*e = PreviewEnvironmentHook(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PreviewEnvironmentHook: %T", src)
+	}
+	return nil
+}
+
+type NullPreviewEnvironmentHook struct {
+	PreviewEnvironmentHook PreviewEnvironmentHook `json:"PreviewEnvironmentHook"`
+	Valid                  bool                   `json:"valid"` // Valid is true if PreviewEnvironmentHook is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPreviewEnvironmentHook) Scan(value interface{}) error {
+	if value == nil {
+		ns.PreviewEnvironmentHook, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PreviewEnvironmentHook.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPreviewEnvironmentHook) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PreviewEnvironmentHook), nil
+}
+
+type QueryTriggerMode string
+
+const (
+	QueryTriggerModePERROW   QueryTriggerMode = "PER_ROW"
+	QueryTriggerModeNONEMPTY QueryTriggerMode = "NON_EMPTY"
+)
+
+func (e *QueryTriggerMode) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = QueryTriggerMode(s)
+	case string:
+		*e = QueryTriggerMode(s)
+	default:
+		return fmt.Errorf("unsupported scan type for QueryTriggerMode: %T", src)
+	}
+	return nil
+}
+
+type NullQueryTriggerMode struct {
+	QueryTriggerMode QueryTriggerMode `json:"QueryTriggerMode"`
+	Valid            bool             `json:"valid"` // Valid is true if QueryTriggerMode is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullQueryTriggerMode) Scan(value interface{}) error {
+	if value == nil {
+		ns.QueryTriggerMode, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.QueryTriggerMode.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullQueryTriggerMode) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.QueryTriggerMode), nil
+}
+
+type RecurringTaskKind string
+
+const (
+	RecurringTaskKindPURGEWORKFLOWRUNS RecurringTaskKind = "PURGE_WORKFLOW_RUNS"
+	RecurringTaskKindEMITEVENT         RecurringTaskKind = "EMIT_EVENT"
+)
+
+func (e *RecurringTaskKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RecurringTaskKind(s)
+	case string:
+		*e = RecurringTaskKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RecurringTaskKind: %T", src)
+	}
+	return nil
+}
+
+type NullRecurringTaskKind struct {
+	RecurringTaskKind RecurringTaskKind `json:"RecurringTaskKind"`
+	Valid             bool              `json:"valid"` // Valid is true if RecurringTaskKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRecurringTaskKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.RecurringTaskKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RecurringTaskKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRecurringTaskKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RecurringTaskKind), nil
+}
+
+type ReplicationOperation string
+
+const (
+	ReplicationOperationINSERT ReplicationOperation = "INSERT"
+	ReplicationOperationUPDATE ReplicationOperation = "UPDATE"
+	ReplicationOperationDELETE ReplicationOperation = "DELETE"
+)
+
+func (e *ReplicationOperation) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReplicationOperation(s)
+	case string:
+		*e = ReplicationOperation(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReplicationOperation: %T", src)
+	}
+	return nil
+}
+
+type NullReplicationOperation struct {
+	ReplicationOperation ReplicationOperation `json:"ReplicationOperation"`
+	Valid                bool                 `json:"valid"` // Valid is true if ReplicationOperation is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullReplicationOperation) Scan(value interface{}) error {
+	if value == nil {
+		ns.ReplicationOperation, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ReplicationOperation.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullReplicationOperation) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ReplicationOperation), nil
+}
+
+type ReplicationRole string
+
+const (
+	ReplicationRolePRIMARY ReplicationRole = "PRIMARY"
+	ReplicationRoleSTANDBY ReplicationRole = "STANDBY"
+)
+
+func (e *ReplicationRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReplicationRole(s)
+	case string:
+		*e = ReplicationRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReplicationRole: %T", src)
+	}
+	return nil
+}
+
+type NullReplicationRole struct {
+	ReplicationRole ReplicationRole `json:"ReplicationRole"`
+	Valid           bool            `json:"valid"` // Valid is true if ReplicationRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullReplicationRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.ReplicationRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ReplicationRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullReplicationRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ReplicationRole), nil
+}
+
+type StepJoinStrategy string
+
+const (
+	StepJoinStrategyALL    StepJoinStrategy = "ALL"
+	StepJoinStrategyANY    StepJoinStrategy = "ANY"
+	StepJoinStrategyQUORUM StepJoinStrategy = "QUORUM"
+)
+
+func (e *StepJoinStrategy) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = StepJoinStrategy(s)
+	case string:
+		*e = StepJoinStrategy(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StepJoinStrategy: %T", src)
+	}
+	return nil
+}
+
+type NullStepJoinStrategy struct {
+	StepJoinStrategy StepJoinStrategy `json:"StepJoinStrategy"`
+	Valid            bool             `json:"valid"` // Valid is true if StepJoinStrategy is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullStepJoinStrategy) Scan(value interface{}) error {
+	if value == nil {
+		ns.StepJoinStrategy, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.StepJoinStrategy.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullStepJoinStrategy) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.StepJoinStrategy), nil
+}
+
+type StepPreflightCheckKind string
+
+const (
+	StepPreflightCheckKindHTTP       StepPreflightCheckKind = "HTTP"
+	StepPreflightCheckKindTCP        StepPreflightCheckKind = "TCP"
+	StepPreflightCheckKindEXPRESSION StepPreflightCheckKind = "EXPRESSION"
+)
+
+func (e *StepPreflightCheckKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = StepPreflightCheckKind(s)
+	case string:
+		*e = StepPreflightCheckKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StepPreflightCheckKind: %T", src)
+	}
+	return nil
+}
+
+type NullStepPreflightCheckKind struct {
+	StepPreflightCheckKind StepPreflightCheckKind `json:"StepPreflightCheckKind"`
+	Valid                  bool                   `json:"valid"` // Valid is true if StepPreflightCheckKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullStepPreflightCheckKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.StepPreflightCheckKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.StepPreflightCheckKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullStepPreflightCheckKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.StepPreflightCheckKind), nil
+}
+
+type StepRunStatus string
+
+const (
+	StepRunStatusPENDING             StepRunStatus = "PENDING"
+	StepRunStatusPENDINGASSIGNMENT   StepRunStatus = "PENDING_ASSIGNMENT"
+	StepRunStatusASSIGNED            StepRunStatus = "ASSIGNED"
+	StepRunStatusRUNNING             StepRunStatus = "RUNNING"
+	StepRunStatusSUCCEEDED           StepRunStatus = "SUCCEEDED"
+	StepRunStatusFAILED              StepRunStatus = "FAILED"
+	StepRunStatusCANCELLED           StepRunStatus = "CANCELLED"
+	StepRunStatusWAITINGONDEPENDENCY StepRunStatus = "WAITING_ON_DEPENDENCY"
+)
+
+func (e *StepRunStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = StepRunStatus(s)
+	case string:
+		*e = StepRunStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StepRunStatus: %T", src)
+	}
+	return nil
+}
+
+type NullStepRunStatus struct {
+	StepRunStatus StepRunStatus `json:"StepRunStatus"`
+	Valid         bool          `json:"valid"` // Valid is true if StepRunStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullStepRunStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.StepRunStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.StepRunStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullStepRunStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.StepRunStatus), nil
+}
+
+type StepRunSubStatus string
+
+const (
+	StepRunSubStatusWAITINGFORWORKER StepRunSubStatus = "WAITING_FOR_WORKER"
+)
+
+func (e *StepRunSubStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = StepRunSubStatus(s)
+	case string:
+		*e = StepRunSubStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StepRunSubStatus: %T", src)
+	}
+	return nil
+}
+
+type NullStepRunSubStatus struct {
+	StepRunSubStatus StepRunSubStatus `json:"StepRunSubStatus"`
+	Valid            bool             `json:"valid"` // Valid is true if StepRunSubStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullStepRunSubStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.StepRunSubStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.StepRunSubStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullStepRunSubStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.StepRunSubStatus), nil
+}
+
+type TenantExportStatus string
+
+const (
+	TenantExportStatusPENDING   TenantExportStatus = "PENDING"
+	TenantExportStatusRUNNING   TenantExportStatus = "RUNNING"
+	TenantExportStatusSUCCEEDED TenantExportStatus = "SUCCEEDED"
+	TenantExportStatusFAILED    TenantExportStatus = "FAILED"
+)
+
+func (e *TenantExportStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TenantExportStatus(s)
+	case string:
+		*e = TenantExportStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TenantExportStatus: %T", src)
+	}
+	return nil
+}
+
+type NullTenantExportStatus struct {
+	TenantExportStatus TenantExportStatus `json:"TenantExportStatus"`
+	Valid              bool               `json:"valid"` // Valid is true if TenantExportStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTenantExportStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.TenantExportStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TenantExportStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTenantExportStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TenantExportStatus), nil
+}
+
+type TenantMemberRole string
+
+const (
+	TenantMemberRoleOWNER  TenantMemberRole = "OWNER"
+	TenantMemberRoleADMIN  TenantMemberRole = "ADMIN"
+	TenantMemberRoleMEMBER TenantMemberRole = "MEMBER"
+)
+
+func (e *TenantMemberRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TenantMemberRole(s)
+	case string:
+		*e = TenantMemberRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TenantMemberRole: %T", src)
+	}
+	return nil
+}
+
+type NullTenantMemberRole struct {
+	TenantMemberRole TenantMemberRole `json:"TenantMemberRole"`
+	Valid            bool             `json:"valid"` // Valid is true if TenantMemberRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTenantMemberRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.TenantMemberRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TenantMemberRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTenantMemberRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TenantMemberRole), nil
+}
+
+type VcsProvider string
+
+const (
+	VcsProviderGITHUB VcsProvider = "GITHUB"
+)
+
+func (e *VcsProvider) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = VcsProvider(s)
+	case string:
+		*e = VcsProvider(s)
+	default:
+		return fmt.Errorf("unsupported scan type for VcsProvider: %T", src)
+	}
+	return nil
+}
+
+type NullVcsProvider struct {
+	VcsProvider VcsProvider `json:"VcsProvider"`
+	Valid       bool        `json:"valid"` // Valid is true if VcsProvider is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullVcsProvider) Scan(value interface{}) error {
+	if value == nil {
+		ns.VcsProvider, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.VcsProvider.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullVcsProvider) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.VcsProvider), nil
+}
+
+type WebhookDeliverySource string
+
+const (
+	WebhookDeliverySourceGITHUB WebhookDeliverySource = "GITHUB"
+	WebhookDeliverySourceGITEA  WebhookDeliverySource = "GITEA"
+)
+
+func (e *WebhookDeliverySource) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WebhookDeliverySource(s)
+	case string:
+		*e = WebhookDeliverySource(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WebhookDeliverySource: %T", src)
+	}
+	return nil
+}
+
+type NullWebhookDeliverySource struct {
+	WebhookDeliverySource WebhookDeliverySource `json:"WebhookDeliverySource"`
+	Valid                 bool                  `json:"valid"` // Valid is true if WebhookDeliverySource is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWebhookDeliverySource) Scan(value interface{}) error {
+	if value == nil {
+		ns.WebhookDeliverySource, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WebhookDeliverySource.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWebhookDeliverySource) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.JobRunStatus), nil
+	return string(ns.WebhookDeliverySource), nil
 }
 
-type LogLineLevel string
+type WebhookDeliveryStatus string
 
 const (
-	LogLineLevelDEBUG LogLineLevel = "DEBUG"
-	LogLineLevelINFO  LogLineLevel = "INFO"
-	LogLineLevelWARN  LogLineLevel = "WARN"
-	LogLineLevelERROR LogLineLevel = "ERROR"
+	WebhookDeliveryStatusRECEIVED  WebhookDeliveryStatus = "RECEIVED"
+	WebhookDeliveryStatusPROCESSED WebhookDeliveryStatus = "PROCESSED"
+	WebhookDeliveryStatusFAILED    WebhookDeliveryStatus = "FAILED"
+	WebhookDeliveryStatusREJECTED  WebhookDeliveryStatus = "REJECTED"
 )
 
-func (e *LogLineLevel) Scan(src interface{}) error {
+func (e *WebhookDeliveryStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = LogLineLevel(s)
+		*e = WebhookDeliveryStatus(s)
 	case string:
-		*e = LogLineLevel(s)
+		*e = WebhookDeliveryStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for LogLineLevel: %T", src)
+		return fmt.Errorf("unsupported scan type for WebhookDeliveryStatus: %T", src)
 	}
 	return nil
 }
 
-type NullLogLineLevel struct {
-	LogLineLevel LogLineLevel `json:"LogLineLevel"`
-	Valid        bool         `json:"valid"` // Valid is true if LogLineLevel is not NULL
+type NullWebhookDeliveryStatus struct {
+	WebhookDeliveryStatus WebhookDeliveryStatus `json:"WebhookDeliveryStatus"`
+	Valid                 bool                  `json:"valid"` // Valid is true if WebhookDeliveryStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullLogLineLevel) Scan(value interface{}) error {
+func (ns *NullWebhookDeliveryStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.LogLineLevel, ns.Valid = "", false
+		ns.WebhookDeliveryStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.LogLineLevel.Scan(value)
+	return ns.WebhookDeliveryStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullLogLineLevel) Value() (driver.Value, error) {
+func (ns NullWebhookDeliveryStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.LogLineLevel), nil
+	return string(ns.WebhookDeliveryStatus), nil
 }
 
-type StepRunStatus string
+type WorkerAssignmentStrategy string
 
 const (
-	StepRunStatusPENDING           StepRunStatus = "PENDING"
-	StepRunStatusPENDINGASSIGNMENT StepRunStatus = "PENDING_ASSIGNMENT"
-	StepRunStatusASSIGNED          StepRunStatus = "ASSIGNED"
-	StepRunStatusRUNNING           StepRunStatus = "RUNNING"
-	StepRunStatusSUCCEEDED         StepRunStatus = "SUCCEEDED"
-	StepRunStatusFAILED            StepRunStatus = "FAILED"
-	StepRunStatusCANCELLED         StepRunStatus = "CANCELLED"
+	WorkerAssignmentStrategyRANDOM      WorkerAssignmentStrategy = "RANDOM"
+	WorkerAssignmentStrategyLEASTLOADED WorkerAssignmentStrategy = "LEAST_LOADED"
+	WorkerAssignmentStrategyROUNDROBIN  WorkerAssignmentStrategy = "ROUND_ROBIN"
+	WorkerAssignmentStrategyLOCALITY    WorkerAssignmentStrategy = "LOCALITY"
 )
 
-func (e *StepRunStatus) Scan(src interface{}) error {
+func (e *WorkerAssignmentStrategy) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = StepRunStatus(s)
+		*e = WorkerAssignmentStrategy(s)
 	case string:
-		*e = StepRunStatus(s)
+		*e = WorkerAssignmentStrategy(s)
 	default:
-		return fmt.Errorf("unsupported scan type for StepRunStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for WorkerAssignmentStrategy: %T", src)
 	}
 	return nil
 }
 
-type NullStepRunStatus struct {
-	StepRunStatus StepRunStatus `json:"StepRunStatus"`
-	Valid         bool          `json:"valid"` // Valid is true if StepRunStatus is not NULL
+type NullWorkerAssignmentStrategy struct {
+	WorkerAssignmentStrategy WorkerAssignmentStrategy `json:"WorkerAssignmentStrategy"`
+	Valid                    bool                     `json:"valid"` // Valid is true if WorkerAssignmentStrategy is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullStepRunStatus) Scan(value interface{}) error {
+func (ns *NullWorkerAssignmentStrategy) Scan(value interface{}) error {
 	if value == nil {
-		ns.StepRunStatus, ns.Valid = "", false
+		ns.WorkerAssignmentStrategy, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.StepRunStatus.Scan(value)
+	return ns.WorkerAssignmentStrategy.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullStepRunStatus) Value() (driver.Value, error) {
+func (ns NullWorkerAssignmentStrategy) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.StepRunStatus), nil
+	return string(ns.WorkerAssignmentStrategy), nil
 }
 
-type TenantMemberRole string
+type WorkerStatus string
 
 const (
-	TenantMemberRoleOWNER  TenantMemberRole = "OWNER"
-	TenantMemberRoleADMIN  TenantMemberRole = "ADMIN"
-	TenantMemberRoleMEMBER TenantMemberRole = "MEMBER"
+	WorkerStatusACTIVE   WorkerStatus = "ACTIVE"
+	WorkerStatusINACTIVE WorkerStatus = "INACTIVE"
 )
 
-func (e *TenantMemberRole) Scan(src interface{}) error {
+func (e *WorkerStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = TenantMemberRole(s)
+		*e = WorkerStatus(s)
 	case string:
-		*e = TenantMemberRole(s)
+		*e = WorkerStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for TenantMemberRole: %T", src)
+		return fmt.Errorf("unsupported scan type for WorkerStatus: %T", src)
 	}
 	return nil
 }
 
-type NullTenantMemberRole struct {
-	TenantMemberRole TenantMemberRole `json:"TenantMemberRole"`
-	Valid            bool             `json:"valid"` // Valid is true if TenantMemberRole is not NULL
+type NullWorkerStatus struct {
+	WorkerStatus WorkerStatus `json:"WorkerStatus"`
+	Valid        bool         `json:"valid"` // Valid is true if WorkerStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullTenantMemberRole) Scan(value interface{}) error {
+func (ns *NullWorkerStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.TenantMemberRole, ns.Valid = "", false
+		ns.WorkerStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.TenantMemberRole.Scan(value)
+	return ns.WorkerStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullTenantMemberRole) Value() (driver.Value, error) {
+func (ns NullWorkerStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.TenantMemberRole), nil
+	return string(ns.WorkerStatus), nil
 }
 
-type VcsProvider string
+type WorkflowRolloutStatus string
 
 const (
-	VcsProviderGITHUB VcsProvider = "GITHUB"
+	WorkflowRolloutStatusPENDING    WorkflowRolloutStatus = "PENDING"
+	WorkflowRolloutStatusACTIVE     WorkflowRolloutStatus = "ACTIVE"
+	WorkflowRolloutStatusCOMPLETED  WorkflowRolloutStatus = "COMPLETED"
+	WorkflowRolloutStatusROLLEDBACK WorkflowRolloutStatus = "ROLLED_BACK"
 )
 
-func (e *VcsProvider) Scan(src interface{}) error {
+func (e *WorkflowRolloutStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = VcsProvider(s)
+		*e = WorkflowRolloutStatus(s)
 	case string:
-		*e = VcsProvider(s)
+		*e = WorkflowRolloutStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for VcsProvider: %T", src)
+		return fmt.Errorf("unsupported scan type for WorkflowRolloutStatus: %T", src)
 	}
 	return nil
 }
 
-type NullVcsProvider struct {
-	VcsProvider VcsProvider `json:"VcsProvider"`
-	Valid       bool        `json:"valid"` // Valid is true if VcsProvider is not NULL
+type NullWorkflowRolloutStatus struct {
+	WorkflowRolloutStatus WorkflowRolloutStatus `json:"WorkflowRolloutStatus"`
+	Valid                 bool                  `json:"valid"` // Valid is true if WorkflowRolloutStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullVcsProvider) Scan(value interface{}) error {
+func (ns *NullWorkflowRolloutStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.VcsProvider, ns.Valid = "", false
+		ns.WorkflowRolloutStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.VcsProvider.Scan(value)
+	return ns.WorkflowRolloutStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullVcsProvider) Value() (driver.Value, error) {
+func (ns NullWorkflowRolloutStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.VcsProvider), nil
+	return string(ns.WorkflowRolloutStatus), nil
 }
 
-type WorkerStatus string
+type WorkflowRunBulkRetryStatus string
 
 const (
-	WorkerStatusACTIVE   WorkerStatus = "ACTIVE"
-	WorkerStatusINACTIVE WorkerStatus = "INACTIVE"
+	WorkflowRunBulkRetryStatusPENDING   WorkflowRunBulkRetryStatus = "PENDING"
+	WorkflowRunBulkRetryStatusRUNNING   WorkflowRunBulkRetryStatus = "RUNNING"
+	WorkflowRunBulkRetryStatusSUCCEEDED WorkflowRunBulkRetryStatus = "SUCCEEDED"
+	WorkflowRunBulkRetryStatusFAILED    WorkflowRunBulkRetryStatus = "FAILED"
 )
 
-func (e *WorkerStatus) Scan(src interface{}) error {
+func (e *WorkflowRunBulkRetryStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = WorkerStatus(s)
+		*e = WorkflowRunBulkRetryStatus(s)
 	case string:
-		*e = WorkerStatus(s)
+		*e = WorkflowRunBulkRetryStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for WorkerStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for WorkflowRunBulkRetryStatus: %T", src)
 	}
 	return nil
 }
 
-type NullWorkerStatus struct {
-	WorkerStatus WorkerStatus `json:"WorkerStatus"`
-	Valid        bool         `json:"valid"` // Valid is true if WorkerStatus is not NULL
+type NullWorkflowRunBulkRetryStatus struct {
+	WorkflowRunBulkRetryStatus WorkflowRunBulkRetryStatus `json:"WorkflowRunBulkRetryStatus"`
+	Valid                      bool                       `json:"valid"` // Valid is true if WorkflowRunBulkRetryStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullWorkerStatus) Scan(value interface{}) error {
+func (ns *NullWorkflowRunBulkRetryStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.WorkerStatus, ns.Valid = "", false
+		ns.WorkflowRunBulkRetryStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.WorkerStatus.Scan(value)
+	return ns.WorkflowRunBulkRetryStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullWorkerStatus) Value() (driver.Value, error) {
+func (ns NullWorkflowRunBulkRetryStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.WorkerStatus), nil
+	return string(ns.WorkflowRunBulkRetryStatus), nil
 }
 
 type WorkflowRunStatus string
@@ -406,13 +1180,16 @@ func (ns NullWorkflowRunStatus) Value() (driver.Value, error) {
 }
 
 type APIToken struct {
-	ID        pgtype.UUID      `json:"id"`
-	CreatedAt pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt pgtype.Timestamp `json:"updatedAt"`
-	ExpiresAt pgtype.Timestamp `json:"expiresAt"`
-	Revoked   bool             `json:"revoked"`
-	Name      pgtype.Text      `json:"name"`
-	TenantId  pgtype.UUID      `json:"tenantId"`
+	ID          pgtype.UUID      `json:"id"`
+	CreatedAt   pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt   pgtype.Timestamp `json:"updatedAt"`
+	ExpiresAt   pgtype.Timestamp `json:"expiresAt"`
+	Revoked     bool             `json:"revoked"`
+	Name        pgtype.Text      `json:"name"`
+	TenantId    pgtype.UUID      `json:"tenantId"`
+	Scopes      []string         `json:"scopes"`
+	Environment pgtype.Text      `json:"environment"`
+	Namespace   pgtype.Text      `json:"namespace"`
 }
 
 type Action struct {
@@ -427,6 +1204,17 @@ type ActionToWorker struct {
 	A pgtype.UUID `json:"A"`
 }
 
+type ClientCertificate struct {
+	ID          pgtype.UUID      `json:"id"`
+	CreatedAt   pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt   pgtype.Timestamp `json:"updatedAt"`
+	TenantId    pgtype.UUID      `json:"tenantId"`
+	Name        string           `json:"name"`
+	Fingerprint string           `json:"fingerprint"`
+	Subject     pgtype.Text      `json:"subject"`
+	ExpiresAt   pgtype.Timestamp `json:"expiresAt"`
+}
+
 type Dispatcher struct {
 	ID              pgtype.UUID      `json:"id"`
 	CreatedAt       pgtype.Timestamp `json:"createdAt"`
@@ -445,6 +1233,26 @@ type Event struct {
 	TenantId       pgtype.UUID      `json:"tenantId"`
 	ReplayedFromId pgtype.UUID      `json:"replayedFromId"`
 	Data           []byte           `json:"data"`
+	Environment    pgtype.Text      `json:"environment"`
+}
+
+type EventBusRecord struct {
+	ID        int64            `json:"id"`
+	CreatedAt pgtype.Timestamp `json:"createdAt"`
+	TenantId  pgtype.UUID      `json:"tenantId"`
+	Topic     string           `json:"topic"`
+	Data      []byte           `json:"data"`
+}
+
+type EventBusSubscription struct {
+	ID            pgtype.UUID      `json:"id"`
+	CreatedAt     pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt     pgtype.Timestamp `json:"updatedAt"`
+	TenantId      pgtype.UUID      `json:"tenantId"`
+	Name          string           `json:"name"`
+	Topics        []string         `json:"topics"`
+	AckedRecordId int64            `json:"ackedRecordId"`
+	LastPolledAt  pgtype.Timestamp `json:"lastPolledAt"`
 }
 
 type GetGroupKeyRun struct {
@@ -470,6 +1278,29 @@ type GetGroupKeyRun struct {
 	ScheduleTimeoutAt pgtype.Timestamp `json:"scheduleTimeoutAt"`
 }
 
+type GiteaWebhook struct {
+	ID              pgtype.UUID      `json:"id"`
+	CreatedAt       pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt       pgtype.Timestamp `json:"updatedAt"`
+	TenantId        pgtype.UUID      `json:"tenantId"`
+	RepositoryOwner string           `json:"repositoryOwner"`
+	RepositoryName  string           `json:"repositoryName"`
+	SigningSecret   []byte           `json:"signingSecret"`
+}
+
+type GithubAppCredentials struct {
+	ID            pgtype.UUID      `json:"id"`
+	CreatedAt     pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt     pgtype.Timestamp `json:"updatedAt"`
+	AppId         int32            `json:"appId"`
+	AppSlug       string           `json:"appSlug"`
+	SettingsURL   string           `json:"settingsURL"`
+	ClientId      string           `json:"clientId"`
+	ClientSecret  []byte           `json:"clientSecret"`
+	WebhookSecret []byte           `json:"webhookSecret"`
+	PrivateKey    []byte           `json:"privateKey"`
+}
+
 type GithubAppInstallation struct {
 	ID                      pgtype.UUID      `json:"id"`
 	CreatedAt               pgtype.Timestamp `json:"createdAt"`
@@ -507,6 +1338,18 @@ type GithubAppOAuthToUser struct {
 	B pgtype.UUID `json:"B"`
 }
 
+type GithubPreviewEnvironment struct {
+	ID            pgtype.UUID      `json:"id"`
+	CreatedAt     pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt     pgtype.Timestamp `json:"updatedAt"`
+	TenantId      pgtype.UUID      `json:"tenantId"`
+	PullRequestId pgtype.UUID      `json:"pullRequestId"`
+	WorkflowId    pgtype.UUID      `json:"workflowId"`
+	Url           pgtype.Text      `json:"url"`
+	CommentId     pgtype.Int8      `json:"commentId"`
+	TornDownAt    pgtype.Timestamp `json:"tornDownAt"`
+}
+
 type GithubPullRequest struct {
 	ID                    pgtype.UUID      `json:"id"`
 	CreatedAt             pgtype.Timestamp `json:"createdAt"`
@@ -540,14 +1383,31 @@ type GithubPullRequestToWorkflowRun struct {
 }
 
 type GithubWebhook struct {
-	ID              pgtype.UUID      `json:"id"`
-	CreatedAt       pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt       pgtype.Timestamp `json:"updatedAt"`
-	DeletedAt       pgtype.Timestamp `json:"deletedAt"`
-	TenantId        pgtype.UUID      `json:"tenantId"`
-	RepositoryOwner string           `json:"repositoryOwner"`
-	RepositoryName  string           `json:"repositoryName"`
-	SigningSecret   []byte           `json:"signingSecret"`
+	ID               pgtype.UUID      `json:"id"`
+	CreatedAt        pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt        pgtype.Timestamp `json:"updatedAt"`
+	DeletedAt        pgtype.Timestamp `json:"deletedAt"`
+	TenantId         pgtype.UUID      `json:"tenantId"`
+	RepositoryOwner  string           `json:"repositoryOwner"`
+	RepositoryName   string           `json:"repositoryName"`
+	SigningSecret    []byte           `json:"signingSecret"`
+	HookId           pgtype.Int8      `json:"hookId"`
+	ReconciledAt     pgtype.Timestamp `json:"reconciledAt"`
+	ReconcileError   pgtype.Text      `json:"reconcileError"`
+	FailedDeliveries []byte           `json:"failedDeliveries"`
+}
+
+type IdempotencyKey struct {
+	ID                  pgtype.UUID      `json:"id"`
+	CreatedAt           pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt           pgtype.Timestamp `json:"updatedAt"`
+	ExpiresAt           pgtype.Timestamp `json:"expiresAt"`
+	TenantId            pgtype.UUID      `json:"tenantId"`
+	Key                 string           `json:"key"`
+	Fingerprint         string           `json:"fingerprint"`
+	ResponseStatus      pgtype.Int4      `json:"responseStatus"`
+	ResponseContentType pgtype.Text      `json:"responseContentType"`
+	ResponseBody        []byte           `json:"responseBody"`
 }
 
 type Job struct {
@@ -591,6 +1451,14 @@ type JobRunLookupData struct {
 	Data      []byte           `json:"data"`
 }
 
+type LeaderLease struct {
+	Role      string           `json:"role"`
+	CreatedAt pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt pgtype.Timestamp `json:"updatedAt"`
+	LeaderId  string           `json:"leaderId"`
+	ExpiresAt pgtype.Timestamp `json:"expiresAt"`
+}
+
 type LogLine struct {
 	ID        int64            `json:"id"`
 	CreatedAt pgtype.Timestamp `json:"createdAt"`
@@ -601,6 +1469,130 @@ type LogLine struct {
 	Metadata  []byte           `json:"metadata"`
 }
 
+type LogSink struct {
+	ID              pgtype.UUID      `json:"id"`
+	CreatedAt       pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt       pgtype.Timestamp `json:"updatedAt"`
+	TenantId        pgtype.UUID      `json:"tenantId"`
+	Name            string           `json:"name"`
+	Kind            LogSinkKind      `json:"kind"`
+	Destination     string           `json:"destination"`
+	Config          []byte           `json:"config"`
+	Enabled         bool             `json:"enabled"`
+	BatchSize       int32            `json:"batchSize"`
+	LastRecordXid   int64            `json:"lastRecordXid"`
+	LastRecordId    int64            `json:"lastRecordId"`
+	LastDeliveredAt pgtype.Timestamp `json:"lastDeliveredAt"`
+	LastError       pgtype.Text      `json:"lastError"`
+	Failures        int32            `json:"failures"`
+	NextAttemptAt   pgtype.Timestamp `json:"nextAttemptAt"`
+}
+
+type LogSinkRecord struct {
+	ID        int64            `json:"id"`
+	CreatedAt pgtype.Timestamp `json:"createdAt"`
+	TenantId  pgtype.UUID      `json:"tenantId"`
+	Event     string           `json:"event"`
+	Data      []byte           `json:"data"`
+	Xid       int64            `json:"xid"`
+}
+
+type NamedLock struct {
+	ID               pgtype.UUID      `json:"id"`
+	CreatedAt        pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt        pgtype.Timestamp `json:"updatedAt"`
+	TenantId         pgtype.UUID      `json:"tenantId"`
+	Name             string           `json:"name"`
+	StepRunId        pgtype.UUID      `json:"stepRunId"`
+	WorkflowRunId    pgtype.UUID      `json:"workflowRunId"`
+	HoldsWorkflowRun bool             `json:"holdsWorkflowRun"`
+	ExpiresAt        pgtype.Timestamp `json:"expiresAt"`
+}
+
+type ObjectStorageFeed struct {
+	ID             pgtype.UUID           `json:"id"`
+	CreatedAt      pgtype.Timestamp      `json:"createdAt"`
+	UpdatedAt      pgtype.Timestamp      `json:"updatedAt"`
+	TenantId       pgtype.UUID           `json:"tenantId"`
+	Name           string                `json:"name"`
+	Kind           ObjectStorageFeedKind `json:"kind"`
+	Source         string                `json:"source"`
+	Config         []byte                `json:"config"`
+	EventKey       string                `json:"eventKey"`
+	KeyPrefix      pgtype.Text           `json:"keyPrefix"`
+	Enabled        bool                  `json:"enabled"`
+	LastReceivedAt pgtype.Timestamp      `json:"lastReceivedAt"`
+	LastError      pgtype.Text           `json:"lastError"`
+	Failures       int32                 `json:"failures"`
+	NextAttemptAt  pgtype.Timestamp      `json:"nextAttemptAt"`
+}
+
+type PIIRule struct {
+	ID                   pgtype.UUID      `json:"id"`
+	CreatedAt            pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt            pgtype.Timestamp `json:"updatedAt"`
+	TenantId             pgtype.UUID      `json:"tenantId"`
+	Path                 string           `json:"path"`
+	RetentionSeconds     int32            `json:"retentionSeconds"`
+	PurgedUntil          pgtype.Timestamp `json:"purgedUntil"`
+	PurgedUntilStepRunId pgtype.UUID      `json:"purgedUntilStepRunId"`
+	NextPurgeAt          pgtype.Timestamp `json:"nextPurgeAt"`
+}
+
+type QueryTrigger struct {
+	ID        pgtype.UUID      `json:"id"`
+	CreatedAt pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt pgtype.Timestamp `json:"updatedAt"`
+	TenantId  pgtype.UUID      `json:"tenantId"`
+	Name      string           `json:"name"`
+	Config    []byte           `json:"config"`
+	Query     string           `json:"query"`
+	Cron      string           `json:"cron"`
+	Mode      QueryTriggerMode `json:"mode"`
+	EventKey  string           `json:"eventKey"`
+	Enabled   bool             `json:"enabled"`
+	LastRunAt pgtype.Timestamp `json:"lastRunAt"`
+	LastError pgtype.Text      `json:"lastError"`
+	NextRunAt pgtype.Timestamp `json:"nextRunAt"`
+}
+
+type RecurringTask struct {
+	ID               pgtype.UUID       `json:"id"`
+	CreatedAt        pgtype.Timestamp  `json:"createdAt"`
+	UpdatedAt        pgtype.Timestamp  `json:"updatedAt"`
+	TenantId         pgtype.UUID       `json:"tenantId"`
+	Name             string            `json:"name"`
+	Kind             RecurringTaskKind `json:"kind"`
+	Cron             string            `json:"cron"`
+	WorkflowId       pgtype.UUID       `json:"workflowId"`
+	RetentionSeconds pgtype.Int4       `json:"retentionSeconds"`
+	EventKey         pgtype.Text       `json:"eventKey"`
+	EventData        []byte            `json:"eventData"`
+	Enabled          bool              `json:"enabled"`
+	LastRunAt        pgtype.Timestamp  `json:"lastRunAt"`
+	LastError        pgtype.Text       `json:"lastError"`
+	NextRunAt        pgtype.Timestamp  `json:"nextRunAt"`
+}
+
+type ReplicationLogEntry struct {
+	ID        int64                `json:"id"`
+	CreatedAt pgtype.Timestamp     `json:"createdAt"`
+	Table     string               `json:"table"`
+	Operation ReplicationOperation `json:"operation"`
+	Data      []byte               `json:"data"`
+}
+
+type ReplicationState struct {
+	ID            int32            `json:"id"`
+	CreatedAt     pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt     pgtype.Timestamp `json:"updatedAt"`
+	Role          ReplicationRole  `json:"role"`
+	LastEntryId   int64            `json:"lastEntryId"`
+	LastAppliedAt pgtype.Timestamp `json:"lastAppliedAt"`
+	PromotedAt    pgtype.Timestamp `json:"promotedAt"`
+	RecoveredAt   pgtype.Timestamp `json:"recoveredAt"`
+}
+
 type SNSIntegration struct {
 	ID        pgtype.UUID      `json:"id"`
 	CreatedAt pgtype.Timestamp `json:"createdAt"`
@@ -625,18 +1617,27 @@ type ServiceToWorker struct {
 }
 
 type Step struct {
-	ID              pgtype.UUID      `json:"id"`
-	CreatedAt       pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt       pgtype.Timestamp `json:"updatedAt"`
-	DeletedAt       pgtype.Timestamp `json:"deletedAt"`
-	ReadableId      pgtype.Text      `json:"readableId"`
-	TenantId        pgtype.UUID      `json:"tenantId"`
-	JobId           pgtype.UUID      `json:"jobId"`
-	ActionId        string           `json:"actionId"`
-	Timeout         pgtype.Text      `json:"timeout"`
-	CustomUserData  []byte           `json:"customUserData"`
-	Retries         int32            `json:"retries"`
-	ScheduleTimeout string           `json:"scheduleTimeout"`
+	ID                pgtype.UUID      `json:"id"`
+	CreatedAt         pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt         pgtype.Timestamp `json:"updatedAt"`
+	DeletedAt         pgtype.Timestamp `json:"deletedAt"`
+	ReadableId        pgtype.Text      `json:"readableId"`
+	TenantId          pgtype.UUID      `json:"tenantId"`
+	JobId             pgtype.UUID      `json:"jobId"`
+	ActionId          string           `json:"actionId"`
+	Timeout           pgtype.Text      `json:"timeout"`
+	CustomUserData    []byte           `json:"customUserData"`
+	Retries           int32            `json:"retries"`
+	ScheduleTimeout   string           `json:"scheduleTimeout"`
+	Cpu               pgtype.Float8    `json:"cpu"`
+	MemoryMb          pgtype.Int4      `json:"memoryMb"`
+	Gpu               pgtype.Int4      `json:"gpu"`
+	CancelGracePeriod pgtype.Text      `json:"cancelGracePeriod"`
+	MaxTimeout        pgtype.Text      `json:"maxTimeout"`
+	JoinQuorum        pgtype.Int4      `json:"joinQuorum"`
+	JoinStrategy      StepJoinStrategy `json:"joinStrategy"`
+	OnTimeoutActionId pgtype.Text      `json:"onTimeoutActionId"`
+	CostCenter        pgtype.Text      `json:"costCenter"`
 }
 
 type StepOrder struct {
@@ -644,33 +1645,70 @@ type StepOrder struct {
 	B pgtype.UUID `json:"B"`
 }
 
+type StepPreflightCheck struct {
+	ID        pgtype.UUID            `json:"id"`
+	CreatedAt pgtype.Timestamp       `json:"createdAt"`
+	UpdatedAt pgtype.Timestamp       `json:"updatedAt"`
+	StepId    pgtype.UUID            `json:"stepId"`
+	Kind      StepPreflightCheckKind `json:"kind"`
+	Target    string                 `json:"target"`
+	Timeout   pgtype.Text            `json:"timeout"`
+}
+
 type StepRun struct {
+	ID                pgtype.UUID            `json:"id"`
+	CreatedAt         pgtype.Timestamp       `json:"createdAt"`
+	UpdatedAt         pgtype.Timestamp       `json:"updatedAt"`
+	DeletedAt         pgtype.Timestamp       `json:"deletedAt"`
+	TenantId          pgtype.UUID            `json:"tenantId"`
+	JobRunId          pgtype.UUID            `json:"jobRunId"`
+	StepId            pgtype.UUID            `json:"stepId"`
+	Order             int64                  `json:"order"`
+	WorkerId          pgtype.UUID            `json:"workerId"`
+	TickerId          pgtype.UUID            `json:"tickerId"`
+	Status            StepRunStatus          `json:"status"`
+	Input             []byte                 `json:"input"`
+	Output            []byte                 `json:"output"`
+	RequeueAfter      pgtype.Timestamp       `json:"requeueAfter"`
+	ScheduleTimeoutAt pgtype.Timestamp       `json:"scheduleTimeoutAt"`
+	Error             pgtype.Text            `json:"error"`
+	StartedAt         pgtype.Timestamp       `json:"startedAt"`
+	FinishedAt        pgtype.Timestamp       `json:"finishedAt"`
+	TimeoutAt         pgtype.Timestamp       `json:"timeoutAt"`
+	CancelledAt       pgtype.Timestamp       `json:"cancelledAt"`
+	CancelledReason   pgtype.Text            `json:"cancelledReason"`
+	CancelledError    pgtype.Text            `json:"cancelledError"`
+	InputSchema       []byte                 `json:"inputSchema"`
+	CallerFiles       []byte                 `json:"callerFiles"`
+	GitRepoBranch     pgtype.Text            `json:"gitRepoBranch"`
+	RetryCount        int32                  `json:"retryCount"`
+	QueuedAt          pgtype.Timestamp       `json:"queuedAt"`
+	SlotWaitStartedAt pgtype.Timestamp       `json:"slotWaitStartedAt"`
+	AssignedAt        pgtype.Timestamp       `json:"assignedAt"`
+	ResultPersistedAt pgtype.Timestamp       `json:"resultPersistedAt"`
+	CancelledSource   NullCancellationSource `json:"cancelledSource"`
+	ToleratedByJoin   bool                   `json:"toleratedByJoin"`
+	SubStatus         NullStepRunSubStatus   `json:"subStatus"`
+}
+
+type StepRunDiagnostic struct {
 	ID                pgtype.UUID      `json:"id"`
 	CreatedAt         pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt         pgtype.Timestamp `json:"updatedAt"`
-	DeletedAt         pgtype.Timestamp `json:"deletedAt"`
 	TenantId          pgtype.UUID      `json:"tenantId"`
-	JobRunId          pgtype.UUID      `json:"jobRunId"`
-	StepId            pgtype.UUID      `json:"stepId"`
-	Order             int64            `json:"order"`
+	StepRunId         pgtype.UUID      `json:"stepRunId"`
+	RetryCount        int32            `json:"retryCount"`
 	WorkerId          pgtype.UUID      `json:"workerId"`
-	TickerId          pgtype.UUID      `json:"tickerId"`
-	Status            StepRunStatus    `json:"status"`
 	Input             []byte           `json:"input"`
 	Output            []byte           `json:"output"`
-	RequeueAfter      pgtype.Timestamp `json:"requeueAfter"`
-	ScheduleTimeoutAt pgtype.Timestamp `json:"scheduleTimeoutAt"`
 	Error             pgtype.Text      `json:"error"`
+	QueuedAt          pgtype.Timestamp `json:"queuedAt"`
+	SlotWaitStartedAt pgtype.Timestamp `json:"slotWaitStartedAt"`
+	AssignedAt        pgtype.Timestamp `json:"assignedAt"`
 	StartedAt         pgtype.Timestamp `json:"startedAt"`
 	FinishedAt        pgtype.Timestamp `json:"finishedAt"`
-	TimeoutAt         pgtype.Timestamp `json:"timeoutAt"`
-	CancelledAt       pgtype.Timestamp `json:"cancelledAt"`
-	CancelledReason   pgtype.Text      `json:"cancelledReason"`
-	CancelledError    pgtype.Text      `json:"cancelledError"`
-	InputSchema       []byte           `json:"inputSchema"`
-	CallerFiles       []byte           `json:"callerFiles"`
-	GitRepoBranch     pgtype.Text      `json:"gitRepoBranch"`
-	RetryCount        int32            `json:"retryCount"`
+	ResultPersistedAt pgtype.Timestamp `json:"resultPersistedAt"`
+	TraceParent       pgtype.Text      `json:"traceParent"`
+	RunTraceParent    pgtype.Text      `json:"runTraceParent"`
 }
 
 type StepRunOrder struct {
@@ -694,15 +1732,78 @@ type StepRunResultArchive struct {
 	CancelledAt     pgtype.Timestamp `json:"cancelledAt"`
 	CancelledReason pgtype.Text      `json:"cancelledReason"`
 	CancelledError  pgtype.Text      `json:"cancelledError"`
+	RetryCount      int32            `json:"retryCount"`
+	WorkerId        pgtype.UUID      `json:"workerId"`
+}
+
+type StreamEvent struct {
+	ID         int64            `json:"id"`
+	CreatedAt  pgtype.Timestamp `json:"createdAt"`
+	TenantId   pgtype.UUID      `json:"tenantId"`
+	StepRunId  pgtype.UUID      `json:"stepRunId"`
+	Index      int32            `json:"index"`
+	RetryCount int32            `json:"retryCount"`
+	Message    string           `json:"message"`
 }
 
 type Tenant struct {
+	ID                    pgtype.UUID              `json:"id"`
+	CreatedAt             pgtype.Timestamp         `json:"createdAt"`
+	UpdatedAt             pgtype.Timestamp         `json:"updatedAt"`
+	DeletedAt             pgtype.Timestamp         `json:"deletedAt"`
+	Name                  string                   `json:"name"`
+	Slug                  string                   `json:"slug"`
+	IngestionPaused       bool                     `json:"ingestionPaused"`
+	RedactionRules        []string                 `json:"redactionRules"`
+	AssignmentStrategy    WorkerAssignmentStrategy `json:"assignmentStrategy"`
+	Paused                bool                     `json:"paused"`
+	PausedTriggerBehavior PausedTriggerBehavior    `json:"pausedTriggerBehavior"`
+	DependencyHealth      []byte                   `json:"dependencyHealth"`
+}
+
+type TenantEngineSettings struct {
+	ID                 pgtype.UUID      `json:"id"`
+	CreatedAt          pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt          pgtype.Timestamp `json:"updatedAt"`
+	TenantId           pgtype.UUID      `json:"tenantId"`
+	DefaultStepTimeout pgtype.Text      `json:"defaultStepTimeout"`
+	ScheduleTimeout    pgtype.Text      `json:"scheduleTimeout"`
+	DefaultStepRetries pgtype.Int4      `json:"defaultStepRetries"`
+	MaxFanOut          pgtype.Int4      `json:"maxFanOut"`
+}
+
+type TenantExport struct {
+	ID            pgtype.UUID        `json:"id"`
+	CreatedAt     pgtype.Timestamp   `json:"createdAt"`
+	UpdatedAt     pgtype.Timestamp   `json:"updatedAt"`
+	TenantId      pgtype.UUID        `json:"tenantId"`
+	Status        TenantExportStatus `json:"status"`
+	SectionsDone  int32              `json:"sectionsDone"`
+	SectionsTotal int32              `json:"sectionsTotal"`
+	RecordCount   int32              `json:"recordCount"`
+	Error         pgtype.Text        `json:"error"`
+	StartedAt     pgtype.Timestamp   `json:"startedAt"`
+	FinishedAt    pgtype.Timestamp   `json:"finishedAt"`
+	ArchiveSize   pgtype.Int4        `json:"archiveSize"`
+	ArchiveSha256 pgtype.Text        `json:"archiveSha256"`
+	ExpiresAt     pgtype.Timestamp   `json:"expiresAt"`
+	ArchiveKey    pgtype.Text        `json:"archiveKey"`
+}
+
+type TenantExportArchive struct {
+	ID        pgtype.UUID      `json:"id"`
+	CreatedAt pgtype.Timestamp `json:"createdAt"`
+	ExportId  pgtype.UUID      `json:"exportId"`
+	Data      []byte           `json:"data"`
+}
+
+type TenantFeatureFlag struct {
 	ID        pgtype.UUID      `json:"id"`
 	CreatedAt pgtype.Timestamp `json:"createdAt"`
 	UpdatedAt pgtype.Timestamp `json:"updatedAt"`
-	DeletedAt pgtype.Timestamp `json:"deletedAt"`
-	Name      string           `json:"name"`
-	Slug      string           `json:"slug"`
+	TenantId  pgtype.UUID      `json:"tenantId"`
+	Flag      string           `json:"flag"`
+	Enabled   bool             `json:"enabled"`
 }
 
 type TenantInviteLink struct {
@@ -780,6 +1881,21 @@ type UserSession struct {
 	ExpiresAt pgtype.Timestamp `json:"expiresAt"`
 }
 
+type WebhookDelivery struct {
+	ID         pgtype.UUID           `json:"id"`
+	CreatedAt  pgtype.Timestamp      `json:"createdAt"`
+	UpdatedAt  pgtype.Timestamp      `json:"updatedAt"`
+	TenantId   pgtype.UUID           `json:"tenantId"`
+	Source     WebhookDeliverySource `json:"source"`
+	WebhookId  pgtype.UUID           `json:"webhookId"`
+	DeliveryId pgtype.Text           `json:"deliveryId"`
+	Event      string                `json:"event"`
+	Nonce      string                `json:"nonce"`
+	SentAt     pgtype.Timestamp      `json:"sentAt"`
+	Status     WebhookDeliveryStatus `json:"status"`
+	Error      pgtype.Text           `json:"error"`
+}
+
 type Worker struct {
 	ID              pgtype.UUID      `json:"id"`
 	CreatedAt       pgtype.Timestamp `json:"createdAt"`
@@ -791,16 +1907,42 @@ type Worker struct {
 	Status          WorkerStatus     `json:"status"`
 	DispatcherId    pgtype.UUID      `json:"dispatcherId"`
 	MaxRuns         pgtype.Int4      `json:"maxRuns"`
+	Cpu             pgtype.Float8    `json:"cpu"`
+	MemoryMb        pgtype.Int4      `json:"memoryMb"`
+	Gpu             pgtype.Int4      `json:"gpu"`
+	Environment     pgtype.Text      `json:"environment"`
+	BuildId         pgtype.Text      `json:"buildId"`
+	Labels          []byte           `json:"labels"`
+	LastAssignedAt  pgtype.Timestamp `json:"lastAssignedAt"`
+	SessionToken    pgtype.UUID      `json:"sessionToken"`
+	ProtocolVersion int32            `json:"protocolVersion"`
 }
 
 type Workflow struct {
-	ID          pgtype.UUID      `json:"id"`
-	CreatedAt   pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt   pgtype.Timestamp `json:"updatedAt"`
-	DeletedAt   pgtype.Timestamp `json:"deletedAt"`
-	TenantId    pgtype.UUID      `json:"tenantId"`
-	Name        string           `json:"name"`
-	Description pgtype.Text      `json:"description"`
+	ID                    pgtype.UUID           `json:"id"`
+	CreatedAt             pgtype.Timestamp      `json:"createdAt"`
+	UpdatedAt             pgtype.Timestamp      `json:"updatedAt"`
+	DeletedAt             pgtype.Timestamp      `json:"deletedAt"`
+	TenantId              pgtype.UUID           `json:"tenantId"`
+	Name                  string                `json:"name"`
+	Description           pgtype.Text           `json:"description"`
+	Paused                bool                  `json:"paused"`
+	PausedTriggerBehavior PausedTriggerBehavior `json:"pausedTriggerBehavior"`
+	MaintenanceUntil      pgtype.Timestamp      `json:"maintenanceUntil"`
+	DiagnosticsSampleRate int32                 `json:"diagnosticsSampleRate"`
+}
+
+type WorkflowAnomalyBaseline struct {
+	CreatedAt           pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt           pgtype.Timestamp `json:"updatedAt"`
+	TenantId            pgtype.UUID      `json:"tenantId"`
+	WorkflowId          pgtype.UUID      `json:"workflowId"`
+	WindowEnd           pgtype.Timestamp `json:"windowEnd"`
+	Windows             int32            `json:"windows"`
+	FailureRateMean     float64          `json:"failureRateMean"`
+	FailureRateVariance float64          `json:"failureRateVariance"`
+	DurationMean        float64          `json:"durationMean"`
+	DurationVariance    float64          `json:"durationVariance"`
 }
 
 type WorkflowConcurrency struct {
@@ -811,34 +1953,123 @@ type WorkflowConcurrency struct {
 	GetConcurrencyGroupId pgtype.UUID              `json:"getConcurrencyGroupId"`
 	MaxRuns               int32                    `json:"maxRuns"`
 	LimitStrategy         ConcurrencyLimitStrategy `json:"limitStrategy"`
+	KeyExpressions        []string                 `json:"keyExpressions"`
 }
 
 type WorkflowDeploymentConfig struct {
-	ID                      pgtype.UUID      `json:"id"`
-	CreatedAt               pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt               pgtype.Timestamp `json:"updatedAt"`
-	DeletedAt               pgtype.Timestamp `json:"deletedAt"`
-	WorkflowId              pgtype.UUID      `json:"workflowId"`
-	GitRepoName             string           `json:"gitRepoName"`
-	GitRepoOwner            string           `json:"gitRepoOwner"`
-	GitRepoBranch           string           `json:"gitRepoBranch"`
-	GithubAppInstallationId pgtype.UUID      `json:"githubAppInstallationId"`
+	ID                      pgtype.UUID                `json:"id"`
+	CreatedAt               pgtype.Timestamp           `json:"createdAt"`
+	UpdatedAt               pgtype.Timestamp           `json:"updatedAt"`
+	DeletedAt               pgtype.Timestamp           `json:"deletedAt"`
+	WorkflowId              pgtype.UUID                `json:"workflowId"`
+	GitRepoName             string                     `json:"gitRepoName"`
+	GitRepoOwner            string                     `json:"gitRepoOwner"`
+	GitRepoBranch           string                     `json:"gitRepoBranch"`
+	GithubAppInstallationId pgtype.UUID                `json:"githubAppInstallationId"`
+	PreviewEnvironmentHook  NullPreviewEnvironmentHook `json:"previewEnvironmentHook"`
+}
+
+type WorkflowMaintenanceWindow struct {
+	ID              pgtype.UUID      `json:"id"`
+	CreatedAt       pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt       pgtype.Timestamp `json:"updatedAt"`
+	TenantId        pgtype.UUID      `json:"tenantId"`
+	WorkflowId      pgtype.UUID      `json:"workflowId"`
+	Name            string           `json:"name"`
+	Cron            string           `json:"cron"`
+	DurationSeconds int32            `json:"durationSeconds"`
+}
+
+type WorkflowRollout struct {
+	ID                   pgtype.UUID           `json:"id"`
+	CreatedAt            pgtype.Timestamp      `json:"createdAt"`
+	UpdatedAt            pgtype.Timestamp      `json:"updatedAt"`
+	TenantId             pgtype.UUID           `json:"tenantId"`
+	WorkflowId           pgtype.UUID           `json:"workflowId"`
+	Percentage           int32                 `json:"percentage"`
+	FailureRateThreshold float64               `json:"failureRateThreshold"`
+	WindowSeconds        int32                 `json:"windowSeconds"`
+	MinRuns              int32                 `json:"minRuns"`
+	Status               WorkflowRolloutStatus `json:"status"`
+	FromVersionId        pgtype.UUID           `json:"fromVersionId"`
+	ToVersionId          pgtype.UUID           `json:"toVersionId"`
+	StartedAt            pgtype.Timestamp      `json:"startedAt"`
+	FinishedAt           pgtype.Timestamp      `json:"finishedAt"`
+	FailureRate          pgtype.Float8         `json:"failureRate"`
 }
 
 type WorkflowRun struct {
-	CreatedAt          pgtype.Timestamp  `json:"createdAt"`
-	UpdatedAt          pgtype.Timestamp  `json:"updatedAt"`
-	DeletedAt          pgtype.Timestamp  `json:"deletedAt"`
-	TenantId           pgtype.UUID       `json:"tenantId"`
-	WorkflowVersionId  pgtype.UUID       `json:"workflowVersionId"`
-	Status             WorkflowRunStatus `json:"status"`
-	Error              pgtype.Text       `json:"error"`
-	StartedAt          pgtype.Timestamp  `json:"startedAt"`
-	FinishedAt         pgtype.Timestamp  `json:"finishedAt"`
-	ConcurrencyGroupId pgtype.Text       `json:"concurrencyGroupId"`
-	DisplayName        pgtype.Text       `json:"displayName"`
-	ID                 pgtype.UUID       `json:"id"`
-	GitRepoBranch      pgtype.Text       `json:"gitRepoBranch"`
+	CreatedAt                  pgtype.Timestamp       `json:"createdAt"`
+	UpdatedAt                  pgtype.Timestamp       `json:"updatedAt"`
+	DeletedAt                  pgtype.Timestamp       `json:"deletedAt"`
+	TenantId                   pgtype.UUID            `json:"tenantId"`
+	WorkflowVersionId          pgtype.UUID            `json:"workflowVersionId"`
+	Status                     WorkflowRunStatus      `json:"status"`
+	Error                      pgtype.Text            `json:"error"`
+	StartedAt                  pgtype.Timestamp       `json:"startedAt"`
+	FinishedAt                 pgtype.Timestamp       `json:"finishedAt"`
+	ConcurrencyGroupId         pgtype.Text            `json:"concurrencyGroupId"`
+	DisplayName                pgtype.Text            `json:"displayName"`
+	ID                         pgtype.UUID            `json:"id"`
+	GitRepoBranch              pgtype.Text            `json:"gitRepoBranch"`
+	Debug                      bool                   `json:"debug"`
+	ReplayOfId                 pgtype.UUID            `json:"replayOfId"`
+	AdditionalMetadata         []byte                 `json:"additionalMetadata"`
+	StepRunsTotal              int32                  `json:"stepRunsTotal"`
+	StepRunsRunning            int32                  `json:"stepRunsRunning"`
+	StepRunsSucceeded          int32                  `json:"stepRunsSucceeded"`
+	StepRunsFailed             int32                  `json:"stepRunsFailed"`
+	StepRunsCancelled          int32                  `json:"stepRunsCancelled"`
+	CancelledSource            NullCancellationSource `json:"cancelledSource"`
+	Environment                pgtype.Text            `json:"environment"`
+	BuildId                    pgtype.Text            `json:"buildId"`
+	BufferedAt                 pgtype.Timestamp       `json:"bufferedAt"`
+	StepExecutions             int32                  `json:"stepExecutions"`
+	StepRetries                int32                  `json:"stepRetries"`
+	ConcurrencyGroupComponents []string               `json:"concurrencyGroupComponents"`
+	CorrelationId              pgtype.Text            `json:"correlationId"`
+}
+
+type WorkflowRunBulkRetry struct {
+	ID           pgtype.UUID                `json:"id"`
+	CreatedAt    pgtype.Timestamp           `json:"createdAt"`
+	UpdatedAt    pgtype.Timestamp           `json:"updatedAt"`
+	TenantId     pgtype.UUID                `json:"tenantId"`
+	Status       WorkflowRunBulkRetryStatus `json:"status"`
+	Filter       []byte                     `json:"filter"`
+	MatchedCount int32                      `json:"matchedCount"`
+	RetriedCount int32                      `json:"retriedCount"`
+	FailedCount  int32                      `json:"failedCount"`
+	Error        pgtype.Text                `json:"error"`
+	StartedAt    pgtype.Timestamp           `json:"startedAt"`
+	FinishedAt   pgtype.Timestamp           `json:"finishedAt"`
+}
+
+type WorkflowRunDiagnostics struct {
+	WorkflowRunId pgtype.UUID      `json:"workflowRunId"`
+	CreatedAt     pgtype.Timestamp `json:"createdAt"`
+	TenantId      pgtype.UUID      `json:"tenantId"`
+	TraceParent   pgtype.Text      `json:"traceParent"`
+}
+
+type WorkflowRunRollup struct {
+	CreatedAt       pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt       pgtype.Timestamp `json:"updatedAt"`
+	TenantId        pgtype.UUID      `json:"tenantId"`
+	WorkflowId      pgtype.UUID      `json:"workflowId"`
+	Hour            pgtype.Timestamp `json:"hour"`
+	Succeeded       int32            `json:"succeeded"`
+	Failed          int32            `json:"failed"`
+	DurationSeconds float64          `json:"durationSeconds"`
+}
+
+type WorkflowRunSLABreach struct {
+	ID            pgtype.UUID      `json:"id"`
+	CreatedAt     pgtype.Timestamp `json:"createdAt"`
+	TenantId      pgtype.UUID      `json:"tenantId"`
+	WorkflowId    pgtype.UUID      `json:"workflowId"`
+	WorkflowRunId pgtype.UUID      `json:"workflowRunId"`
+	Sla           string           `json:"sla"`
 }
 
 type WorkflowRunTriggeredBy struct {
@@ -881,6 +2112,18 @@ type WorkflowTriggerEventRef struct {
 	EventKey string      `json:"eventKey"`
 }
 
+type WorkflowTriggerLink struct {
+	ID              pgtype.UUID      `json:"id"`
+	CreatedAt       pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt       pgtype.Timestamp `json:"updatedAt"`
+	TenantId        pgtype.UUID      `json:"tenantId"`
+	WorkflowId      pgtype.UUID      `json:"workflowId"`
+	Name            string           `json:"name"`
+	TokenHash       string           `json:"tokenHash"`
+	DefaultInput    []byte           `json:"defaultInput"`
+	LastTriggeredAt pgtype.Timestamp `json:"lastTriggeredAt"`
+}
+
 type WorkflowTriggerScheduledRef struct {
 	ID        pgtype.UUID      `json:"id"`
 	ParentId  pgtype.UUID      `json:"parentId"`
@@ -899,13 +2142,21 @@ type WorkflowTriggers struct {
 }
 
 type WorkflowVersion struct {
-	ID              pgtype.UUID      `json:"id"`
-	CreatedAt       pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt       pgtype.Timestamp `json:"updatedAt"`
-	DeletedAt       pgtype.Timestamp `json:"deletedAt"`
-	Version         pgtype.Text      `json:"version"`
-	Order           int64            `json:"order"`
-	WorkflowId      pgtype.UUID      `json:"workflowId"`
-	Checksum        string           `json:"checksum"`
-	ScheduleTimeout string           `json:"scheduleTimeout"`
+	ID                 pgtype.UUID                  `json:"id"`
+	CreatedAt          pgtype.Timestamp             `json:"createdAt"`
+	UpdatedAt          pgtype.Timestamp             `json:"updatedAt"`
+	DeletedAt          pgtype.Timestamp             `json:"deletedAt"`
+	Version            pgtype.Text                  `json:"version"`
+	Order              int64                        `json:"order"`
+	WorkflowId         pgtype.UUID                  `json:"workflowId"`
+	Checksum           string                       `json:"checksum"`
+	ScheduleTimeout    string                       `json:"scheduleTimeout"`
+	Sla                pgtype.Text                  `json:"sla"`
+	DefaultInput       []byte                       `json:"defaultInput"`
+	InputSchema        []byte                       `json:"inputSchema"`
+	PinToBuild         bool                         `json:"pinToBuild"`
+	AssignmentStrategy NullWorkerAssignmentStrategy `json:"assignmentStrategy"`
+	MaxStepExecutions  pgtype.Int4                  `json:"maxStepExecutions"`
+	MaxStepRetries     pgtype.Int4                  `json:"maxStepRetries"`
+	CostCenter         pgtype.Text                  `json:"costCenter"`
 }
@@ -0,0 +1,95 @@
+-- name: AcquireNamedLock :one
+-- Acquires a named lock for a step run, or for its workflow run if holdsWorkflowRun is set. The lock is taken over
+-- if it expired or its holder finished, and renewed if it's already held by the same holder. No rows are returned
+-- if the lock is held by another step run or workflow run.
+INSERT INTO "NamedLock" (
+    "id",
+    "createdAt",
+    "updatedAt",
+    "tenantId",
+    "name",
+    "stepRunId",
+    "workflowRunId",
+    "holdsWorkflowRun",
+    "expiresAt"
+)
+SELECT
+    gen_random_uuid(),
+    CURRENT_TIMESTAMP,
+    CURRENT_TIMESTAMP,
+    @tenantId::uuid,
+    @name::text,
+    sr."id",
+    jr."workflowRunId",
+    @holdsWorkflowRun::boolean,
+    CURRENT_TIMESTAMP + make_interval(secs => @timeoutSeconds::float)
+FROM
+    "StepRun" sr
+JOIN
+    "JobRun" jr ON sr."jobRunId" = jr."id"
+WHERE
+    sr."id" = @stepRunId::uuid AND
+    sr."tenantId" = @tenantId::uuid
+ON CONFLICT ("tenantId", "name") DO UPDATE
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "stepRunId" = EXCLUDED."stepRunId",
+    "workflowRunId" = EXCLUDED."workflowRunId",
+    -- a step run can't shorten the hold of its workflow run on the lock
+    "holdsWorkflowRun" = EXCLUDED."holdsWorkflowRun" OR (
+        "NamedLock"."holdsWorkflowRun" AND
+        "NamedLock"."workflowRunId" = EXCLUDED."workflowRunId" AND
+        "NamedLock"."expiresAt" > CURRENT_TIMESTAMP
+    ),
+    "expiresAt" = EXCLUDED."expiresAt"
+WHERE
+    "NamedLock"."expiresAt" <= CURRENT_TIMESTAMP
+    -- the lock is held by the same holder
+    OR (NOT "NamedLock"."holdsWorkflowRun" AND "NamedLock"."stepRunId" = EXCLUDED."stepRunId")
+    OR ("NamedLock"."holdsWorkflowRun" AND "NamedLock"."workflowRunId" = EXCLUDED."workflowRunId")
+    -- the holder finished, or was deleted
+    OR (NOT "NamedLock"."holdsWorkflowRun" AND NOT EXISTS (
+        SELECT 1
+        FROM "StepRun" holder
+        WHERE holder."id" = "NamedLock"."stepRunId" AND holder."status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED')
+    ))
+    OR ("NamedLock"."holdsWorkflowRun" AND NOT EXISTS (
+        SELECT 1
+        FROM "WorkflowRun" holder
+        WHERE holder."id" = "NamedLock"."workflowRunId" AND holder."status" NOT IN ('SUCCEEDED', 'FAILED')
+    ))
+RETURNING *;
+
+-- name: ReleaseNamedLock :execrows
+-- Releases a named lock if it's held by the step run, or by the workflow run of the step run.
+DELETE FROM
+    "NamedLock" l
+USING
+    "StepRun" sr
+JOIN
+    "JobRun" jr ON sr."jobRunId" = jr."id"
+WHERE
+    l."tenantId" = @tenantId::uuid AND
+    l."name" = @name::text AND
+    sr."id" = @stepRunId::uuid AND
+    (
+        (NOT l."holdsWorkflowRun" AND l."stepRunId" = sr."id") OR
+        (l."holdsWorkflowRun" AND l."workflowRunId" = jr."workflowRunId")
+    );
+
+-- name: DeleteReleasedNamedLocks :execrows
+-- Deletes the named locks which expired or whose holder finished, as they can be acquired again.
+DELETE FROM
+    "NamedLock" l
+WHERE
+    l."expiresAt" <= CURRENT_TIMESTAMP
+    OR (NOT l."holdsWorkflowRun" AND NOT EXISTS (
+        SELECT 1
+        FROM "StepRun" holder
+        WHERE holder."id" = l."stepRunId" AND holder."status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED')
+    ))
+    OR (l."holdsWorkflowRun" AND NOT EXISTS (
+        SELECT 1
+        FROM "WorkflowRun" holder
+        WHERE holder."id" = l."workflowRunId" AND holder."status" NOT IN ('SUCCEEDED', 'FAILED')
+    ));
@@ -0,0 +1,38 @@
+-- name: ListObjectStorageFeedsToPoll :many
+SELECT
+    *
+FROM
+    "ObjectStorageFeed"
+WHERE
+    "enabled" = true
+    AND "nextAttemptAt" <= CURRENT_TIMESTAMP
+ORDER BY
+    "nextAttemptAt" ASC
+LIMIT
+    @limit::int;
+
+-- name: UpdateObjectStorageFeedPolled :exec
+-- Clears the failures of the feed after a successful poll. The feed is only updated if notifications were received
+-- or the previous poll failed, so that idle feeds are not written to on every poll.
+UPDATE
+    "ObjectStorageFeed"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "lastReceivedAt" = CASE WHEN @received::boolean THEN CURRENT_TIMESTAMP ELSE "lastReceivedAt" END,
+    "lastError" = NULL,
+    "failures" = 0,
+    "nextAttemptAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @feedId::uuid
+    AND (@received::boolean OR "failures" > 0);
+
+-- name: UpdateObjectStorageFeedFailed :exec
+UPDATE
+    "ObjectStorageFeed"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "lastError" = @lastError::text,
+    "failures" = "failures" + 1,
+    "nextAttemptAt" = @nextAttemptAt::timestamp
+WHERE
+    "id" = @feedId::uuid;
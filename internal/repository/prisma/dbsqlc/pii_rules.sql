@@ -0,0 +1,115 @@
+-- name: ClaimPIIRulesToPurge :many
+-- Claims the rules which are due by moving their next purge into the future, so that each rule is only purged by
+-- one ticker at a time. If the ticker stops before the purge is recorded, the rule is purged again once the claim
+-- expires.
+WITH due_rules AS (
+    SELECT
+        "id"
+    FROM
+        "PIIRule"
+    WHERE
+        "nextPurgeAt" <= CURRENT_TIMESTAMP
+    ORDER BY
+        "nextPurgeAt" ASC
+    LIMIT
+        @limit::int
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE
+    "PIIRule" pr
+SET
+    "nextPurgeAt" = CURRENT_TIMESTAMP + INTERVAL '5 minutes'
+FROM
+    due_rules
+WHERE
+    pr."id" = due_rules."id"
+RETURNING
+    pr.*;
+
+-- name: ListStepRunsToPurge :many
+-- Lists the step runs of a tenant which settled before the given time, and after the step run which was purged
+-- last. Step runs are ordered by the time they settled at, and then by their id.
+SELECT
+    sr."id",
+    sr."jobRunId",
+    sr."input",
+    sr."output",
+    COALESCE(sr."finishedAt", sr."cancelledAt")::timestamp AS "settledAt"
+FROM
+    "StepRun" sr
+WHERE
+    sr."tenantId" = @tenantId::uuid
+    AND sr."status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED')
+    AND COALESCE(sr."finishedAt", sr."cancelledAt") <= @before::timestamp
+    AND (
+        sqlc.narg('after')::timestamp IS NULL
+        OR (COALESCE(sr."finishedAt", sr."cancelledAt"), sr."id") > (sqlc.narg('after')::timestamp, sqlc.narg('afterStepRunId')::uuid)
+    )
+ORDER BY
+    COALESCE(sr."finishedAt", sr."cancelledAt") ASC,
+    sr."id" ASC
+LIMIT
+    @limit::int;
+
+-- name: UpdateStepRunPurgedData :exec
+UPDATE
+    "StepRun"
+SET
+    "input" = COALESCE(sqlc.narg('input')::jsonb, "input"),
+    "output" = COALESCE(sqlc.narg('output')::jsonb, "output")
+WHERE
+    "id" = @stepRunId::uuid;
+
+-- name: ListStepRunResultArchivesToPurge :many
+SELECT
+    "id",
+    "input",
+    "output"
+FROM
+    "StepRunResultArchive"
+WHERE
+    "stepRunId" = ANY(@stepRunIds::uuid[]);
+
+-- name: UpdateStepRunResultArchivePurgedData :exec
+UPDATE
+    "StepRunResultArchive"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "input" = COALESCE(sqlc.narg('input')::jsonb, "input"),
+    "output" = COALESCE(sqlc.narg('output')::jsonb, "output")
+WHERE
+    "id" = @id::uuid;
+
+-- name: ListJobRunLookupDataToPurge :many
+-- Lists the lookup data of the job runs which have finished. The lookup data of a running job run is still read when
+-- its next step runs are started, so it is purged with the last step run of the job run.
+SELECT
+    ld."id",
+    ld."data"
+FROM
+    "JobRunLookupData" ld
+JOIN
+    "JobRun" jr ON jr."id" = ld."jobRunId"
+WHERE
+    ld."jobRunId" = ANY(@jobRunIds::uuid[])
+    AND jr."status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED');
+
+-- name: UpdateJobRunLookupDataPurgedData :exec
+UPDATE
+    "JobRunLookupData"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "data" = @data::jsonb
+WHERE
+    "id" = @id::uuid;
+
+-- name: UpdatePIIRulePurge :exec
+UPDATE
+    "PIIRule"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "purgedUntil" = COALESCE(sqlc.narg('purgedUntil')::timestamp, "purgedUntil"),
+    "purgedUntilStepRunId" = COALESCE(sqlc.narg('purgedUntilStepRunId')::uuid, "purgedUntilStepRunId"),
+    "nextPurgeAt" = @nextPurgeAt::timestamp
+WHERE
+    "id" = @ruleId::uuid;
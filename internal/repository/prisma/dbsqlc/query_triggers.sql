@@ -0,0 +1,39 @@
+-- name: ClaimQueryTriggersToRun :many
+-- Claims the enabled triggers which are due by moving their next run into the future, so that each run of a query
+-- only happens on one ticker. If the ticker stops before the run is recorded, the query runs again once the claim
+-- expires.
+WITH due_triggers AS (
+    SELECT
+        "id"
+    FROM
+        "QueryTrigger"
+    WHERE
+        "enabled" = true
+        AND "nextRunAt" <= CURRENT_TIMESTAMP
+    ORDER BY
+        "nextRunAt" ASC
+    LIMIT
+        @limit::int
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE
+    "QueryTrigger" qt
+SET
+    "nextRunAt" = CURRENT_TIMESTAMP + INTERVAL '5 minutes'
+FROM
+    due_triggers
+WHERE
+    qt."id" = due_triggers."id"
+RETURNING
+    qt.*;
+
+-- name: UpdateQueryTriggerRun :exec
+UPDATE
+    "QueryTrigger"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "lastRunAt" = CURRENT_TIMESTAMP,
+    "lastError" = sqlc.narg('lastError')::text,
+    "nextRunAt" = @nextRunAt::timestamp
+WHERE
+    "id" = @triggerId::uuid;
@@ -0,0 +1,167 @@
+-- name: ListJobRunsToRepair :many
+-- Lists job runs which are running although all of their step runs finished longer than the grace period ago, so
+-- that job runs whose status is being resolved are not repaired.
+SELECT
+    jr."id",
+    jr."tenantId",
+    jr."workflowRunId",
+    (
+        SELECT sr."id"
+        FROM "StepRun" sr
+        WHERE sr."jobRunId" = jr."id"
+        LIMIT 1
+    )::uuid AS "stepRunId"
+FROM
+    "JobRun" jr
+WHERE
+    jr."status" = 'RUNNING' AND
+    jr."deletedAt" IS NULL AND
+    EXISTS (
+        SELECT 1
+        FROM "StepRun" sr
+        WHERE sr."jobRunId" = jr."id"
+    ) AND
+    NOT EXISTS (
+        SELECT 1
+        FROM "StepRun" sr
+        WHERE
+            sr."jobRunId" = jr."id" AND
+            (
+                sr."status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED') OR
+                COALESCE(sr."finishedAt", sr."cancelledAt", sr."updatedAt") > NOW() - make_interval(secs => @graceSeconds::float8)
+            )
+    )
+ORDER BY
+    jr."createdAt" ASC
+LIMIT
+    @limit::int
+FOR UPDATE OF jr SKIP LOCKED;
+
+-- name: ListWorkflowRunsToRepair :many
+-- Lists workflow runs which are running although all of their job runs finished longer than the grace period ago.
+SELECT
+    wr."id",
+    wr."tenantId",
+    (
+        SELECT jr."id"
+        FROM "JobRun" jr
+        WHERE jr."workflowRunId" = wr."id"
+        LIMIT 1
+    )::uuid AS "jobRunId"
+FROM
+    "WorkflowRun" wr
+WHERE
+    wr."status" = 'RUNNING' AND
+    wr."deletedAt" IS NULL AND
+    EXISTS (
+        SELECT 1
+        FROM "JobRun" jr
+        WHERE jr."workflowRunId" = wr."id"
+    ) AND
+    NOT EXISTS (
+        SELECT 1
+        FROM "JobRun" jr
+        WHERE
+            jr."workflowRunId" = wr."id" AND
+            (
+                jr."status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED') OR
+                COALESCE(jr."finishedAt", jr."updatedAt") > NOW() - make_interval(secs => @graceSeconds::float8)
+            )
+    )
+ORDER BY
+    wr."createdAt" ASC
+LIMIT
+    @limit::int
+FOR UPDATE OF wr SKIP LOCKED;
+
+-- name: RepairOrphanedStepRuns :many
+-- Moves step runs which are assigned to a worker which was deleted, or which has not sent a heartbeat for longer
+-- than the timeout, back to pending assignment, so that they are requeued. The schedule timeout starts over.
+WITH orphaned_runs AS (
+    SELECT
+        sr."id",
+        sr."workerId"
+    FROM
+        "StepRun" sr
+    LEFT JOIN
+        "Worker" w ON sr."workerId" = w."id"
+    WHERE
+        sr."status" = 'ASSIGNED' AND
+        sr."deletedAt" IS NULL AND
+        COALESCE(sr."assignedAt", sr."updatedAt") < NOW() - make_interval(secs => @timeoutSeconds::float8) AND
+        (
+            w."id" IS NULL OR
+            w."lastHeartbeatAt" IS NULL OR
+            w."lastHeartbeatAt" < NOW() - make_interval(secs => @timeoutSeconds::float8)
+        )
+    ORDER BY
+        sr."createdAt" ASC
+    LIMIT
+        @limit::int
+    FOR UPDATE OF sr SKIP LOCKED
+)
+UPDATE
+    "StepRun" sr
+SET
+    "status" = 'PENDING_ASSIGNMENT',
+    "workerId" = NULL,
+    "requeueAfter" = CURRENT_TIMESTAMP,
+    "scheduleTimeoutAt" = CURRENT_TIMESTAMP + s."scheduleTimeout"::interval,
+    "queuedAt" = CURRENT_TIMESTAMP,
+    "slotWaitStartedAt" = NULL,
+    "assignedAt" = NULL,
+    "subStatus" = NULL,
+    "updatedAt" = CURRENT_TIMESTAMP
+FROM
+    orphaned_runs,
+    "Step" s,
+    "JobRun" jr
+WHERE
+    sr."id" = orphaned_runs."id" AND
+    s."id" = sr."stepId" AND
+    jr."id" = sr."jobRunId"
+RETURNING
+    sr."id",
+    sr."tenantId",
+    orphaned_runs."workerId" AS "previousWorkerId",
+    s."readableId" AS "stepReadableId",
+    jr."workflowRunId";
+
+-- name: RepairTimedOutGetGroupKeyRuns :many
+-- Cancels get group key runs which are still pending assignment for longer than the grace period after their
+-- schedule timeout. Get group key runs which were created without a schedule timeout time out after the schedule
+-- timeout of their workflow version.
+WITH timed_out_runs AS (
+    SELECT
+        ggr."id"
+    FROM
+        "GetGroupKeyRun" ggr
+    JOIN
+        "WorkflowRun" wr ON ggr."workflowRunId" = wr."id"
+    JOIN
+        "WorkflowVersion" wv ON wr."workflowVersionId" = wv."id"
+    WHERE
+        ggr."status" IN ('PENDING', 'PENDING_ASSIGNMENT') AND
+        ggr."deletedAt" IS NULL AND
+        COALESCE(ggr."scheduleTimeoutAt", ggr."createdAt" + wv."scheduleTimeout"::interval) < NOW() - make_interval(secs => @graceSeconds::float8)
+    ORDER BY
+        ggr."createdAt" ASC
+    LIMIT
+        @limit::int
+    FOR UPDATE OF ggr SKIP LOCKED
+)
+UPDATE
+    "GetGroupKeyRun" ggr
+SET
+    "status" = 'CANCELLED',
+    "cancelledAt" = CURRENT_TIMESTAMP,
+    "cancelledReason" = 'SCHEDULING_TIMED_OUT',
+    "updatedAt" = CURRENT_TIMESTAMP
+FROM
+    timed_out_runs
+WHERE
+    ggr."id" = timed_out_runs."id"
+RETURNING
+    ggr."id",
+    ggr."tenantId",
+    ggr."workflowRunId";
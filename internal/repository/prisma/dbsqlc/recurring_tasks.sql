@@ -0,0 +1,112 @@
+-- name: ClaimRecurringTasksToRun :many
+-- Claims the enabled tasks which are due by moving their next run into the future, so that each run of a task only
+-- happens on one ticker. If the ticker stops before the run is recorded, the task runs again once the claim expires.
+WITH due_tasks AS (
+    SELECT
+        "id"
+    FROM
+        "RecurringTask"
+    WHERE
+        "enabled" = true
+        AND "nextRunAt" <= CURRENT_TIMESTAMP
+    ORDER BY
+        "nextRunAt" ASC
+    LIMIT
+        @limit::int
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE
+    "RecurringTask" rt
+SET
+    "nextRunAt" = CURRENT_TIMESTAMP + INTERVAL '5 minutes'
+FROM
+    due_tasks
+WHERE
+    rt."id" = due_tasks."id"
+RETURNING
+    rt.*;
+
+-- name: PurgeWorkflowRuns :one
+-- Soft-deletes a batch of the runs of a workflow which finished before the retention, and adds the runs to the
+-- hourly rollups of the workflow in the same statement, so that the metrics of the workflow remain accurate. Debug
+-- runs aren't rolled up. Returns the number of purged runs.
+WITH runs_to_purge AS (
+    SELECT
+        runs."id"
+    FROM
+        "WorkflowRun" runs
+    JOIN
+        "WorkflowVersion" wv ON runs."workflowVersionId" = wv."id"
+    WHERE
+        runs."tenantId" = @tenantId::uuid
+        AND wv."workflowId" = @workflowId::uuid
+        AND runs."deletedAt" IS NULL
+        AND runs."status" IN ('SUCCEEDED', 'FAILED')
+        AND runs."finishedAt" < CURRENT_TIMESTAMP - make_interval(secs => @retentionSeconds::int)
+    LIMIT
+        @limit::int
+    FOR UPDATE SKIP LOCKED
+), purged AS (
+    UPDATE
+        "WorkflowRun" runs
+    SET
+        "deletedAt" = CURRENT_TIMESTAMP
+    FROM
+        runs_to_purge
+    WHERE
+        runs."id" = runs_to_purge."id"
+    RETURNING
+        runs."status",
+        runs."debug",
+        runs."createdAt",
+        runs."startedAt",
+        runs."finishedAt"
+), rollups AS (
+    INSERT INTO "WorkflowRunRollup" (
+        "createdAt",
+        "updatedAt",
+        "tenantId",
+        "workflowId",
+        "hour",
+        "succeeded",
+        "failed",
+        "durationSeconds"
+    )
+    SELECT
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        @tenantId::uuid,
+        @workflowId::uuid,
+        date_trunc('hour', purged."finishedAt"),
+        (COUNT(*) FILTER (WHERE purged."status" = 'SUCCEEDED'))::int,
+        (COUNT(*) FILTER (WHERE purged."status" = 'FAILED'))::int,
+        -- runs which failed before they started are measured from when they were created
+        SUM(GREATEST(EXTRACT(EPOCH FROM (purged."finishedAt" - COALESCE(purged."startedAt", purged."createdAt"))), 0))::float8
+    FROM
+        purged
+    WHERE
+        purged."debug" = false
+    GROUP BY
+        date_trunc('hour', purged."finishedAt")
+    ON CONFLICT ("workflowId", "hour") DO UPDATE
+    SET
+        "updatedAt" = CURRENT_TIMESTAMP,
+        "succeeded" = "WorkflowRunRollup"."succeeded" + EXCLUDED."succeeded",
+        "failed" = "WorkflowRunRollup"."failed" + EXCLUDED."failed",
+        "durationSeconds" = "WorkflowRunRollup"."durationSeconds" + EXCLUDED."durationSeconds"
+)
+SELECT
+    COUNT(*)
+FROM
+    purged;
+
+-- name: UpdateRecurringTaskRun :exec
+UPDATE
+    "RecurringTask"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "lastRunAt" = CURRENT_TIMESTAMP,
+    "lastError" = sqlc.narg('lastError')::text,
+    "nextRunAt" = @nextRunAt::timestamp
+WHERE
+    "id" = @taskId::uuid;
@@ -0,0 +1,125 @@
+-- name: ListReplicationLogEntries :many
+SELECT
+    *
+FROM
+    "ReplicationLogEntry"
+WHERE
+    "id" > @afterId::bigint
+ORDER BY
+    "id" ASC
+LIMIT
+    @batchSize::int;
+
+-- name: DeleteReplicationLogEntries :execrows
+DELETE FROM
+    "ReplicationLogEntry"
+WHERE
+    "id" <= @lastEntryId::bigint;
+
+-- name: GetReplicationLogStats :one
+SELECT
+    COUNT(*) AS "count",
+    COALESCE(MAX("id"), 0)::bigint AS "lastEntryId",
+    MIN("createdAt")::timestamp AS "oldestCreatedAt"
+FROM
+    "ReplicationLogEntry";
+
+-- name: ListReplicationLogTriggers :many
+-- Lists the tables which have the replication log trigger attached.
+SELECT
+    c."relname"::text AS "table"
+FROM
+    pg_trigger t
+JOIN
+    pg_class c ON c."oid" = t."tgrelid"
+WHERE
+    t."tgname" = 'replication_log'
+ORDER BY
+    c."relname" ASC;
+
+-- name: InitReplicationState :exec
+INSERT INTO "ReplicationState" (
+    "id"
+) VALUES (
+    1
+)
+ON CONFLICT ("id") DO NOTHING;
+
+-- name: GetReplicationState :one
+SELECT
+    *
+FROM
+    "ReplicationState"
+WHERE
+    "id" = 1;
+
+-- name: GetReplicationStateForUpdate :one
+SELECT
+    *
+FROM
+    "ReplicationState"
+WHERE
+    "id" = 1
+FOR UPDATE;
+
+-- name: UpdateReplicationStateApplied :one
+UPDATE
+    "ReplicationState"
+SET
+    "lastEntryId" = @lastEntryId::bigint,
+    "lastAppliedAt" = CURRENT_TIMESTAMP,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = 1
+RETURNING *;
+
+-- name: PromoteReplicationState :one
+UPDATE
+    "ReplicationState"
+SET
+    "role" = 'PRIMARY',
+    "promotedAt" = CURRENT_TIMESTAMP,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = 1
+RETURNING *;
+
+-- name: UpdateReplicationStateRecovered :one
+UPDATE
+    "ReplicationState"
+SET
+    "recoveredAt" = CURRENT_TIMESTAMP,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = 1
+RETURNING *;
+
+-- name: ListWorkflowRunsToRecover :many
+-- Lists the workflow runs which were queued but not started on the old primary.
+SELECT
+    "id",
+    "tenantId"
+FROM
+    "WorkflowRun"
+WHERE
+    "status" = 'PENDING'
+    AND "deletedAt" IS NULL
+ORDER BY
+    "createdAt" ASC;
+
+-- name: ListJobRunsToRecover :many
+-- Lists the job runs of started workflow runs which have not finished, so that the step runs which were
+-- queued on the old primary can be queued again.
+SELECT
+    jr."id",
+    jr."tenantId"
+FROM
+    "JobRun" jr
+JOIN
+    "WorkflowRun" wr ON wr."id" = jr."workflowRunId"
+WHERE
+    jr."status" IN ('PENDING', 'RUNNING')
+    AND jr."deletedAt" IS NULL
+    AND wr."status" = 'RUNNING'
+ORDER BY
+    jr."createdAt" ASC;
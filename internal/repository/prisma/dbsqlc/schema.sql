@@ -1,3 +1,6 @@
+-- CreateEnum
+CREATE TYPE "CancellationSource" AS ENUM ('CONCURRENCY', 'USER', 'TIMEOUT', 'PARENT_CANCELLED', 'JOIN_SATISFIED', 'BUDGET_EXCEEDED');
+
 -- CreateEnum
 CREATE TYPE "ConcurrencyLimitStrategy" AS ENUM ('CANCEL_IN_PROGRESS', 'DROP_NEWEST', 'QUEUE_NEWEST', 'GROUP_ROUND_ROBIN');
 
@@ -11,7 +14,43 @@ CREATE TYPE "JobRunStatus" AS ENUM ('PENDING', 'RUNNING', 'SUCCEEDED', 'FAILED',
 CREATE TYPE "LogLineLevel" AS ENUM ('DEBUG', 'INFO', 'WARN', 'ERROR');
 
 -- CreateEnum
-CREATE TYPE "StepRunStatus" AS ENUM ('PENDING', 'PENDING_ASSIGNMENT', 'ASSIGNED', 'RUNNING', 'SUCCEEDED', 'FAILED', 'CANCELLED');
+CREATE TYPE "LogSinkKind" AS ENUM ('HTTP', 'S3', 'DATADOG');
+
+-- CreateEnum
+CREATE TYPE "ObjectStorageFeedKind" AS ENUM ('SQS', 'PUBSUB');
+
+-- CreateEnum
+CREATE TYPE "PausedTriggerBehavior" AS ENUM ('REJECT', 'BUFFER');
+
+-- CreateEnum
+CREATE TYPE "PreviewEnvironmentHook" AS ENUM ('PROVISION', 'TEARDOWN');
+
+-- CreateEnum
+CREATE TYPE "QueryTriggerMode" AS ENUM ('PER_ROW', 'NON_EMPTY');
+
+-- CreateEnum
+CREATE TYPE "RecurringTaskKind" AS ENUM ('PURGE_WORKFLOW_RUNS', 'EMIT_EVENT');
+
+-- CreateEnum
+CREATE TYPE "ReplicationOperation" AS ENUM ('INSERT', 'UPDATE', 'DELETE');
+
+-- CreateEnum
+CREATE TYPE "ReplicationRole" AS ENUM ('PRIMARY', 'STANDBY');
+
+-- CreateEnum
+CREATE TYPE "StepJoinStrategy" AS ENUM ('ALL', 'ANY', 'QUORUM');
+
+-- CreateEnum
+CREATE TYPE "StepPreflightCheckKind" AS ENUM ('HTTP', 'TCP', 'EXPRESSION');
+
+-- CreateEnum
+CREATE TYPE "StepRunStatus" AS ENUM ('PENDING', 'PENDING_ASSIGNMENT', 'ASSIGNED', 'RUNNING', 'SUCCEEDED', 'FAILED', 'CANCELLED', 'WAITING_ON_DEPENDENCY');
+
+-- CreateEnum
+CREATE TYPE "StepRunSubStatus" AS ENUM ('WAITING_FOR_WORKER');
+
+-- CreateEnum
+CREATE TYPE "TenantExportStatus" AS ENUM ('PENDING', 'RUNNING', 'SUCCEEDED', 'FAILED');
 
 -- CreateEnum
 CREATE TYPE "TenantMemberRole" AS ENUM ('OWNER', 'ADMIN', 'MEMBER');
@@ -19,9 +58,24 @@ CREATE TYPE "TenantMemberRole" AS ENUM ('OWNER', 'ADMIN', 'MEMBER');
 -- CreateEnum
 CREATE TYPE "VcsProvider" AS ENUM ('GITHUB');
 
+-- CreateEnum
+CREATE TYPE "WebhookDeliverySource" AS ENUM ('GITHUB', 'GITEA');
+
+-- CreateEnum
+CREATE TYPE "WebhookDeliveryStatus" AS ENUM ('RECEIVED', 'PROCESSED', 'FAILED', 'REJECTED');
+
+-- CreateEnum
+CREATE TYPE "WorkerAssignmentStrategy" AS ENUM ('RANDOM', 'LEAST_LOADED', 'ROUND_ROBIN', 'LOCALITY');
+
 -- CreateEnum
 CREATE TYPE "WorkerStatus" AS ENUM ('ACTIVE', 'INACTIVE');
 
+-- CreateEnum
+CREATE TYPE "WorkflowRunBulkRetryStatus" AS ENUM ('PENDING', 'RUNNING', 'SUCCEEDED', 'FAILED');
+
+-- CreateEnum
+CREATE TYPE "WorkflowRolloutStatus" AS ENUM ('PENDING', 'ACTIVE', 'COMPLETED', 'ROLLED_BACK');
+
 -- CreateEnum
 CREATE TYPE "WorkflowRunStatus" AS ENUM ('PENDING', 'RUNNING', 'SUCCEEDED', 'FAILED', 'QUEUED');
 
@@ -34,6 +88,9 @@ CREATE TABLE "APIToken" (
     "revoked" BOOLEAN NOT NULL DEFAULT false,
     "name" TEXT,
     "tenantId" UUID,
+    "scopes" TEXT[],
+    "environment" TEXT,
+    "namespace" TEXT,
 
     CONSTRAINT "APIToken_pkey" PRIMARY KEY ("id")
 );
@@ -48,6 +105,20 @@ CREATE TABLE "Action" (
     CONSTRAINT "Action_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "ClientCertificate" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "fingerprint" TEXT NOT NULL,
+    "subject" TEXT,
+    "expiresAt" TIMESTAMP(3),
+
+    CONSTRAINT "ClientCertificate_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "Dispatcher" (
     "id" UUID NOT NULL,
@@ -70,10 +141,36 @@ CREATE TABLE "Event" (
     "tenantId" UUID NOT NULL,
     "replayedFromId" UUID,
     "data" JSONB,
+    "environment" TEXT,
 
     CONSTRAINT "Event_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "EventBusRecord" (
+    "id" BIGSERIAL NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "topic" TEXT NOT NULL,
+    "data" JSONB NOT NULL,
+
+    CONSTRAINT "EventBusRecord_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "EventBusSubscription" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "topics" TEXT[],
+    "ackedRecordId" BIGINT NOT NULL DEFAULT 0,
+    "lastPolledAt" TIMESTAMP(3),
+
+    CONSTRAINT "EventBusSubscription_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "GetGroupKeyRun" (
     "id" UUID NOT NULL,
@@ -100,6 +197,35 @@ CREATE TABLE "GetGroupKeyRun" (
     CONSTRAINT "GetGroupKeyRun_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "GiteaWebhook" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "repositoryOwner" TEXT NOT NULL,
+    "repositoryName" TEXT NOT NULL,
+    "signingSecret" BYTEA NOT NULL,
+
+    CONSTRAINT "GiteaWebhook_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "GithubAppCredentials" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "appId" INTEGER NOT NULL,
+    "appSlug" TEXT NOT NULL,
+    "settingsURL" TEXT NOT NULL,
+    "clientId" TEXT NOT NULL,
+    "clientSecret" BYTEA NOT NULL,
+    "webhookSecret" BYTEA NOT NULL,
+    "privateKey" BYTEA NOT NULL,
+
+    CONSTRAINT "GithubAppCredentials_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "GithubAppInstallation" (
     "id" UUID NOT NULL,
@@ -133,6 +259,21 @@ CREATE TABLE "GithubAppOAuth" (
     CONSTRAINT "GithubAppOAuth_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "GithubPreviewEnvironment" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "pullRequestId" UUID NOT NULL,
+    "workflowId" UUID NOT NULL,
+    "url" TEXT,
+    "commentId" BIGINT,
+    "tornDownAt" TIMESTAMP(3),
+
+    CONSTRAINT "GithubPreviewEnvironment_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "GithubPullRequest" (
     "id" UUID NOT NULL,
@@ -176,10 +317,30 @@ CREATE TABLE "GithubWebhook" (
     "repositoryOwner" TEXT NOT NULL,
     "repositoryName" TEXT NOT NULL,
     "signingSecret" BYTEA NOT NULL,
+    "hookId" BIGINT,
+    "reconciledAt" TIMESTAMP(3),
+    "reconcileError" TEXT,
+    "failedDeliveries" JSONB,
 
     CONSTRAINT "GithubWebhook_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "IdempotencyKey" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "expiresAt" TIMESTAMP(3) NOT NULL,
+    "tenantId" UUID NOT NULL,
+    "key" TEXT NOT NULL,
+    "fingerprint" TEXT NOT NULL,
+    "responseStatus" INTEGER,
+    "responseContentType" TEXT,
+    "responseBody" BYTEA,
+
+    CONSTRAINT "IdempotencyKey_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "Job" (
     "id" UUID NOT NULL,
@@ -230,6 +391,17 @@ CREATE TABLE "JobRunLookupData" (
     CONSTRAINT "JobRunLookupData_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "LeaderLease" (
+    "role" TEXT NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "leaderId" TEXT NOT NULL,
+    "expiresAt" TIMESTAMP(3) NOT NULL,
+
+    CONSTRAINT "LeaderLease_pkey" PRIMARY KEY ("role")
+);
+
 -- CreateTable
 CREATE TABLE "LogLine" (
     "id" BIGSERIAL NOT NULL,
@@ -243,6 +415,157 @@ CREATE TABLE "LogLine" (
     CONSTRAINT "LogLine_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "LogSink" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "kind" "LogSinkKind" NOT NULL,
+    "destination" TEXT NOT NULL,
+    "config" BYTEA NOT NULL,
+    "enabled" BOOLEAN NOT NULL DEFAULT true,
+    "batchSize" INTEGER NOT NULL DEFAULT 100,
+    "lastRecordXid" BIGINT NOT NULL DEFAULT 0,
+    "lastRecordId" BIGINT NOT NULL DEFAULT 0,
+    "lastDeliveredAt" TIMESTAMP(3),
+    "lastError" TEXT,
+    "failures" INTEGER NOT NULL DEFAULT 0,
+    "nextAttemptAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "LogSink_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "LogSinkRecord" (
+    "id" BIGSERIAL NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "event" TEXT NOT NULL,
+    "data" JSONB NOT NULL,
+    "xid" BIGINT NOT NULL DEFAULT (pg_current_xact_id())::text::bigint,
+
+    CONSTRAINT "LogSinkRecord_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "NamedLock" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "stepRunId" UUID NOT NULL,
+    "workflowRunId" UUID NOT NULL,
+    "holdsWorkflowRun" BOOLEAN NOT NULL DEFAULT false,
+    "expiresAt" TIMESTAMP(3) NOT NULL,
+
+    CONSTRAINT "NamedLock_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "ObjectStorageFeed" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "kind" "ObjectStorageFeedKind" NOT NULL,
+    "source" TEXT NOT NULL,
+    "config" BYTEA NOT NULL,
+    "eventKey" TEXT NOT NULL,
+    "keyPrefix" TEXT,
+    "enabled" BOOLEAN NOT NULL DEFAULT true,
+    "lastReceivedAt" TIMESTAMP(3),
+    "lastError" TEXT,
+    "failures" INTEGER NOT NULL DEFAULT 0,
+    "nextAttemptAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "ObjectStorageFeed_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "PIIRule" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "path" TEXT NOT NULL,
+    "retentionSeconds" INTEGER NOT NULL,
+    "purgedUntil" TIMESTAMP(3),
+    "purgedUntilStepRunId" UUID,
+    "nextPurgeAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "PIIRule_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "QueryTrigger" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "config" BYTEA NOT NULL,
+    "query" TEXT NOT NULL,
+    "cron" TEXT NOT NULL,
+    "mode" "QueryTriggerMode" NOT NULL DEFAULT 'PER_ROW',
+    "eventKey" TEXT NOT NULL,
+    "enabled" BOOLEAN NOT NULL DEFAULT true,
+    "lastRunAt" TIMESTAMP(3),
+    "lastError" TEXT,
+    "nextRunAt" TIMESTAMP(3) NOT NULL,
+
+    CONSTRAINT "QueryTrigger_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "RecurringTask" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "kind" "RecurringTaskKind" NOT NULL,
+    "cron" TEXT NOT NULL,
+    "workflowId" UUID,
+    "retentionSeconds" INTEGER,
+    "eventKey" TEXT,
+    "eventData" JSONB,
+    "enabled" BOOLEAN NOT NULL DEFAULT true,
+    "lastRunAt" TIMESTAMP(3),
+    "lastError" TEXT,
+    "nextRunAt" TIMESTAMP(3) NOT NULL,
+
+    CONSTRAINT "RecurringTask_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "ReplicationLogEntry" (
+    "id" BIGSERIAL NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "table" TEXT NOT NULL,
+    "operation" "ReplicationOperation" NOT NULL,
+    "data" JSONB NOT NULL,
+
+    CONSTRAINT "ReplicationLogEntry_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "ReplicationState" (
+    "id" INTEGER NOT NULL DEFAULT 1,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "role" "ReplicationRole" NOT NULL DEFAULT 'STANDBY',
+    "lastEntryId" BIGINT NOT NULL DEFAULT 0,
+    "lastAppliedAt" TIMESTAMP(3),
+    "promotedAt" TIMESTAMP(3),
+    "recoveredAt" TIMESTAMP(3),
+
+    CONSTRAINT "ReplicationState_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "SNSIntegration" (
     "id" UUID NOT NULL,
@@ -281,10 +604,32 @@ CREATE TABLE "Step" (
     "customUserData" JSONB,
     "retries" INTEGER NOT NULL DEFAULT 0,
     "scheduleTimeout" TEXT NOT NULL DEFAULT '5m',
+    "cpu" DOUBLE PRECISION,
+    "memoryMb" INTEGER,
+    "gpu" INTEGER,
+    "cancelGracePeriod" TEXT,
+    "maxTimeout" TEXT,
+    "joinQuorum" INTEGER,
+    "joinStrategy" "StepJoinStrategy" NOT NULL DEFAULT 'ALL',
+    "onTimeoutActionId" TEXT,
+    "costCenter" TEXT,
 
     CONSTRAINT "Step_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "StepPreflightCheck" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "stepId" UUID NOT NULL,
+    "kind" "StepPreflightCheckKind" NOT NULL,
+    "target" TEXT NOT NULL,
+    "timeout" TEXT,
+
+    CONSTRAINT "StepPreflightCheck_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "StepRun" (
     "id" UUID NOT NULL,
@@ -313,10 +658,40 @@ CREATE TABLE "StepRun" (
     "callerFiles" JSONB,
     "gitRepoBranch" TEXT,
     "retryCount" INTEGER NOT NULL DEFAULT 0,
+    "queuedAt" TIMESTAMP(3),
+    "slotWaitStartedAt" TIMESTAMP(3),
+    "assignedAt" TIMESTAMP(3),
+    "resultPersistedAt" TIMESTAMP(3),
+    "cancelledSource" "CancellationSource",
+    "toleratedByJoin" BOOLEAN NOT NULL DEFAULT false,
+    "subStatus" "StepRunSubStatus",
 
     CONSTRAINT "StepRun_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "StepRunDiagnostic" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "stepRunId" UUID NOT NULL,
+    "retryCount" INTEGER NOT NULL,
+    "workerId" UUID,
+    "input" JSONB,
+    "output" JSONB,
+    "error" TEXT,
+    "queuedAt" TIMESTAMP(3),
+    "slotWaitStartedAt" TIMESTAMP(3),
+    "assignedAt" TIMESTAMP(3),
+    "startedAt" TIMESTAMP(3),
+    "finishedAt" TIMESTAMP(3),
+    "resultPersistedAt" TIMESTAMP(3),
+    "traceParent" TEXT,
+    "runTraceParent" TEXT,
+
+    CONSTRAINT "StepRunDiagnostic_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "StepRunResultArchive" (
     "id" UUID NOT NULL,
@@ -334,10 +709,25 @@ CREATE TABLE "StepRunResultArchive" (
     "cancelledAt" TIMESTAMP(3),
     "cancelledReason" TEXT,
     "cancelledError" TEXT,
+    "retryCount" INTEGER NOT NULL DEFAULT 0,
+    "workerId" UUID,
 
     CONSTRAINT "StepRunResultArchive_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "StreamEvent" (
+    "id" BIGSERIAL NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "stepRunId" UUID NOT NULL,
+    "index" INTEGER NOT NULL,
+    "retryCount" INTEGER NOT NULL,
+    "message" TEXT NOT NULL,
+
+    CONSTRAINT "StreamEvent_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "Tenant" (
     "id" UUID NOT NULL,
@@ -346,10 +736,73 @@ CREATE TABLE "Tenant" (
     "deletedAt" TIMESTAMP(3),
     "name" TEXT NOT NULL,
     "slug" TEXT NOT NULL,
+    "ingestionPaused" BOOLEAN NOT NULL DEFAULT false,
+    "redactionRules" TEXT[],
+    "assignmentStrategy" "WorkerAssignmentStrategy" NOT NULL DEFAULT 'RANDOM',
+    "paused" BOOLEAN NOT NULL DEFAULT false,
+    "pausedTriggerBehavior" "PausedTriggerBehavior" NOT NULL DEFAULT 'REJECT',
+    "dependencyHealth" JSONB,
 
     CONSTRAINT "Tenant_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "TenantEngineSettings" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "defaultStepTimeout" TEXT,
+    "scheduleTimeout" TEXT,
+    "defaultStepRetries" INTEGER,
+    "maxFanOut" INTEGER,
+
+    CONSTRAINT "TenantEngineSettings_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "TenantExport" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "status" "TenantExportStatus" NOT NULL DEFAULT 'PENDING',
+    "sectionsDone" INTEGER NOT NULL DEFAULT 0,
+    "sectionsTotal" INTEGER NOT NULL DEFAULT 0,
+    "recordCount" INTEGER NOT NULL DEFAULT 0,
+    "error" TEXT,
+    "startedAt" TIMESTAMP(3),
+    "finishedAt" TIMESTAMP(3),
+    "archiveSize" INTEGER,
+    "archiveSha256" TEXT,
+    "expiresAt" TIMESTAMP(3),
+    "archiveKey" TEXT,
+
+    CONSTRAINT "TenantExport_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "TenantExportArchive" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "exportId" UUID NOT NULL,
+    "data" BYTEA NOT NULL,
+
+    CONSTRAINT "TenantExportArchive_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "TenantFeatureFlag" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "flag" TEXT NOT NULL,
+    "enabled" BOOLEAN NOT NULL,
+
+    CONSTRAINT "TenantFeatureFlag_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "TenantInviteLink" (
     "id" UUID NOT NULL,
@@ -447,6 +900,24 @@ CREATE TABLE "UserSession" (
     CONSTRAINT "UserSession_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "WebhookDelivery" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "source" "WebhookDeliverySource" NOT NULL,
+    "webhookId" UUID NOT NULL,
+    "deliveryId" TEXT,
+    "event" TEXT NOT NULL,
+    "nonce" TEXT NOT NULL,
+    "sentAt" TIMESTAMP(3),
+    "status" "WebhookDeliveryStatus" NOT NULL DEFAULT 'RECEIVED',
+    "error" TEXT,
+
+    CONSTRAINT "WebhookDelivery_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "Worker" (
     "id" UUID NOT NULL,
@@ -459,6 +930,15 @@ CREATE TABLE "Worker" (
     "status" "WorkerStatus" NOT NULL DEFAULT 'ACTIVE',
     "dispatcherId" UUID,
     "maxRuns" INTEGER,
+    "cpu" DOUBLE PRECISION,
+    "memoryMb" INTEGER,
+    "gpu" INTEGER,
+    "environment" TEXT,
+    "buildId" TEXT,
+    "labels" JSONB,
+    "lastAssignedAt" TIMESTAMP(3),
+    "sessionToken" UUID,
+    "protocolVersion" INTEGER NOT NULL DEFAULT 1,
 
     CONSTRAINT "Worker_pkey" PRIMARY KEY ("id")
 );
@@ -472,10 +952,30 @@ CREATE TABLE "Workflow" (
     "tenantId" UUID NOT NULL,
     "name" TEXT NOT NULL,
     "description" TEXT,
+    "paused" BOOLEAN NOT NULL DEFAULT false,
+    "pausedTriggerBehavior" "PausedTriggerBehavior" NOT NULL DEFAULT 'REJECT',
+    "maintenanceUntil" TIMESTAMP(3),
+    "diagnosticsSampleRate" INTEGER NOT NULL DEFAULT 0,
 
     CONSTRAINT "Workflow_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "WorkflowAnomalyBaseline" (
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "workflowId" UUID NOT NULL,
+    "windowEnd" TIMESTAMP(3) NOT NULL,
+    "windows" INTEGER NOT NULL,
+    "failureRateMean" DOUBLE PRECISION NOT NULL,
+    "failureRateVariance" DOUBLE PRECISION NOT NULL,
+    "durationMean" DOUBLE PRECISION NOT NULL,
+    "durationVariance" DOUBLE PRECISION NOT NULL,
+
+    CONSTRAINT "WorkflowAnomalyBaseline_pkey" PRIMARY KEY ("workflowId")
+);
+
 -- CreateTable
 CREATE TABLE "WorkflowConcurrency" (
     "id" UUID NOT NULL,
@@ -485,6 +985,7 @@ CREATE TABLE "WorkflowConcurrency" (
     "getConcurrencyGroupId" UUID,
     "maxRuns" INTEGER NOT NULL DEFAULT 1,
     "limitStrategy" "ConcurrencyLimitStrategy" NOT NULL DEFAULT 'CANCEL_IN_PROGRESS',
+    "keyExpressions" TEXT[],
 
     CONSTRAINT "WorkflowConcurrency_pkey" PRIMARY KEY ("id")
 );
@@ -500,27 +1001,133 @@ CREATE TABLE "WorkflowDeploymentConfig" (
     "gitRepoOwner" TEXT NOT NULL,
     "gitRepoBranch" TEXT NOT NULL,
     "githubAppInstallationId" UUID,
+    "previewEnvironmentHook" "PreviewEnvironmentHook",
+
+    CONSTRAINT "WorkflowDeploymentConfig_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "WorkflowMaintenanceWindow" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "workflowId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "cron" TEXT NOT NULL,
+    "durationSeconds" INTEGER NOT NULL,
+
+    CONSTRAINT "WorkflowMaintenanceWindow_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "WorkflowRollout" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "workflowId" UUID NOT NULL,
+    "percentage" INTEGER NOT NULL,
+    "failureRateThreshold" DOUBLE PRECISION NOT NULL,
+    "windowSeconds" INTEGER NOT NULL,
+    "minRuns" INTEGER NOT NULL,
+    "status" "WorkflowRolloutStatus" NOT NULL DEFAULT 'PENDING',
+    "fromVersionId" UUID,
+    "toVersionId" UUID,
+    "startedAt" TIMESTAMP(3),
+    "finishedAt" TIMESTAMP(3),
+    "failureRate" DOUBLE PRECISION,
+
+    CONSTRAINT "WorkflowRollout_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "WorkflowRun" (
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "deletedAt" TIMESTAMP(3),
+    "tenantId" UUID NOT NULL,
+    "workflowVersionId" UUID NOT NULL,
+    "status" "WorkflowRunStatus" NOT NULL DEFAULT 'PENDING',
+    "error" TEXT,
+    "startedAt" TIMESTAMP(3),
+    "finishedAt" TIMESTAMP(3),
+    "concurrencyGroupId" TEXT,
+    "displayName" TEXT,
+    "id" UUID NOT NULL,
+    "gitRepoBranch" TEXT,
+    "debug" BOOLEAN NOT NULL DEFAULT false,
+    "replayOfId" UUID,
+    "additionalMetadata" JSONB,
+    "stepRunsTotal" INTEGER NOT NULL DEFAULT 0,
+    "stepRunsRunning" INTEGER NOT NULL DEFAULT 0,
+    "stepRunsSucceeded" INTEGER NOT NULL DEFAULT 0,
+    "stepRunsFailed" INTEGER NOT NULL DEFAULT 0,
+    "stepRunsCancelled" INTEGER NOT NULL DEFAULT 0,
+    "cancelledSource" "CancellationSource",
+    "environment" TEXT,
+    "buildId" TEXT,
+    "bufferedAt" TIMESTAMP(3),
+    "stepExecutions" INTEGER NOT NULL DEFAULT 0,
+    "stepRetries" INTEGER NOT NULL DEFAULT 0,
+    "concurrencyGroupComponents" TEXT[],
+    "correlationId" TEXT,
+
+    CONSTRAINT "WorkflowRun_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "WorkflowRunBulkRetry" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "status" "WorkflowRunBulkRetryStatus" NOT NULL DEFAULT 'PENDING',
+    "filter" JSONB NOT NULL,
+    "matchedCount" INTEGER NOT NULL DEFAULT 0,
+    "retriedCount" INTEGER NOT NULL DEFAULT 0,
+    "failedCount" INTEGER NOT NULL DEFAULT 0,
+    "error" TEXT,
+    "startedAt" TIMESTAMP(3),
+    "finishedAt" TIMESTAMP(3),
+
+    CONSTRAINT "WorkflowRunBulkRetry_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "WorkflowRunDiagnostics" (
+    "workflowRunId" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "traceParent" TEXT,
 
-    CONSTRAINT "WorkflowDeploymentConfig_pkey" PRIMARY KEY ("id")
+    CONSTRAINT "WorkflowRunDiagnostics_pkey" PRIMARY KEY ("workflowRunId")
 );
 
 -- CreateTable
-CREATE TABLE "WorkflowRun" (
+CREATE TABLE "WorkflowRunRollup" (
     "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
     "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    "deletedAt" TIMESTAMP(3),
     "tenantId" UUID NOT NULL,
-    "workflowVersionId" UUID NOT NULL,
-    "status" "WorkflowRunStatus" NOT NULL DEFAULT 'PENDING',
-    "error" TEXT,
-    "startedAt" TIMESTAMP(3),
-    "finishedAt" TIMESTAMP(3),
-    "concurrencyGroupId" TEXT,
-    "displayName" TEXT,
+    "workflowId" UUID NOT NULL,
+    "hour" TIMESTAMP(3) NOT NULL,
+    "succeeded" INTEGER NOT NULL DEFAULT 0,
+    "failed" INTEGER NOT NULL DEFAULT 0,
+    "durationSeconds" DOUBLE PRECISION NOT NULL DEFAULT 0,
+
+    CONSTRAINT "WorkflowRunRollup_pkey" PRIMARY KEY ("workflowId","hour")
+);
+
+-- CreateTable
+CREATE TABLE "WorkflowRunSLABreach" (
     "id" UUID NOT NULL,
-    "gitRepoBranch" TEXT,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "workflowId" UUID NOT NULL,
+    "workflowRunId" UUID NOT NULL,
+    "sla" TEXT NOT NULL,
 
-    CONSTRAINT "WorkflowRun_pkey" PRIMARY KEY ("id")
+    CONSTRAINT "WorkflowRunSLABreach_pkey" PRIMARY KEY ("id")
 );
 
 -- CreateTable
@@ -566,6 +1173,21 @@ CREATE TABLE "WorkflowTriggerEventRef" (
     "eventKey" TEXT NOT NULL
 );
 
+-- CreateTable
+CREATE TABLE "WorkflowTriggerLink" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "workflowId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "tokenHash" TEXT NOT NULL,
+    "defaultInput" JSONB,
+    "lastTriggeredAt" TIMESTAMP(3),
+
+    CONSTRAINT "WorkflowTriggerLink_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "WorkflowTriggerScheduledRef" (
     "id" UUID NOT NULL,
@@ -600,6 +1222,14 @@ CREATE TABLE "WorkflowVersion" (
     "workflowId" UUID NOT NULL,
     "checksum" TEXT NOT NULL,
     "scheduleTimeout" TEXT NOT NULL DEFAULT '5m',
+    "sla" TEXT,
+    "defaultInput" JSONB,
+    "inputSchema" JSONB,
+    "pinToBuild" BOOLEAN NOT NULL DEFAULT false,
+    "assignmentStrategy" "WorkerAssignmentStrategy",
+    "maxStepExecutions" INTEGER,
+    "maxStepRetries" INTEGER,
+    "costCenter" TEXT,
 
     CONSTRAINT "WorkflowVersion_pkey" PRIMARY KEY ("id")
 );
@@ -661,18 +1291,51 @@ CREATE UNIQUE INDEX "Action_id_key" ON "Action"("id" ASC);
 -- CreateIndex
 CREATE UNIQUE INDEX "Action_tenantId_actionId_key" ON "Action"("tenantId" ASC, "actionId" ASC);
 
+-- CreateIndex
+CREATE UNIQUE INDEX "ClientCertificate_id_key" ON "ClientCertificate"("id" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "ClientCertificate_tenantId_fingerprint_key" ON "ClientCertificate"("tenantId" ASC, "fingerprint" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "ClientCertificate_tenantId_name_key" ON "ClientCertificate"("tenantId" ASC, "name" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "Dispatcher_id_key" ON "Dispatcher"("id" ASC);
 
 -- CreateIndex
 CREATE UNIQUE INDEX "Event_id_key" ON "Event"("id" ASC);
 
+-- CreateIndex
+CREATE INDEX "EventBusRecord_tenantId_id_idx" ON "EventBusRecord"("tenantId" ASC, "id" ASC);
+
+-- CreateIndex
+CREATE INDEX "EventBusRecord_createdAt_idx" ON "EventBusRecord"("createdAt" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "EventBusSubscription_id_key" ON "EventBusSubscription"("id" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "EventBusSubscription_tenantId_name_key" ON "EventBusSubscription"("tenantId" ASC, "name" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "GetGroupKeyRun_id_key" ON "GetGroupKeyRun"("id" ASC);
 
 -- CreateIndex
 CREATE UNIQUE INDEX "GetGroupKeyRun_workflowRunId_key" ON "GetGroupKeyRun"("workflowRunId" ASC);
 
+-- CreateIndex
+CREATE UNIQUE INDEX "GiteaWebhook_id_key" ON "GiteaWebhook"("id" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "GiteaWebhook_tenantId_repositoryOwner_repositoryName_key" ON "GiteaWebhook"("tenantId" ASC, "repositoryOwner" ASC, "repositoryName" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "GithubAppCredentials_appId_key" ON "GithubAppCredentials"("appId" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "GithubAppCredentials_id_key" ON "GithubAppCredentials"("id" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "GithubAppInstallation_id_key" ON "GithubAppInstallation"("id" ASC);
 
@@ -685,6 +1348,12 @@ CREATE UNIQUE INDEX "GithubAppOAuth_githubUserID_key" ON "GithubAppOAuth"("githu
 -- CreateIndex
 CREATE UNIQUE INDEX "GithubAppOAuth_id_key" ON "GithubAppOAuth"("id" ASC);
 
+-- CreateIndex
+CREATE UNIQUE INDEX "GithubPreviewEnvironment_id_key" ON "GithubPreviewEnvironment"("id" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "GithubPreviewEnvironment_pullRequestId_workflowId_key" ON "GithubPreviewEnvironment"("pullRequestId" ASC, "workflowId" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "GithubPullRequest_id_key" ON "GithubPullRequest"("id" ASC);
 
@@ -700,6 +1369,15 @@ CREATE UNIQUE INDEX "GithubWebhook_id_key" ON "GithubWebhook"("id" ASC);
 -- CreateIndex
 CREATE UNIQUE INDEX "GithubWebhook_tenantId_repositoryOwner_repositoryName_key" ON "GithubWebhook"("tenantId" ASC, "repositoryOwner" ASC, "repositoryName" ASC);
 
+-- CreateIndex
+CREATE UNIQUE INDEX "IdempotencyKey_id_key" ON "IdempotencyKey"("id" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "IdempotencyKey_tenantId_key_key" ON "IdempotencyKey"("tenantId" ASC, "key" ASC);
+
+-- CreateIndex
+CREATE INDEX "IdempotencyKey_expiresAt_idx" ON "IdempotencyKey"("expiresAt" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "Job_id_key" ON "Job"("id" ASC);
 
@@ -718,6 +1396,72 @@ CREATE UNIQUE INDEX "JobRunLookupData_jobRunId_key" ON "JobRunLookupData"("jobRu
 -- CreateIndex
 CREATE UNIQUE INDEX "JobRunLookupData_jobRunId_tenantId_key" ON "JobRunLookupData"("jobRunId" ASC, "tenantId" ASC);
 
+-- CreateIndex
+CREATE UNIQUE INDEX "LeaderLease_role_key" ON "LeaderLease"("role" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "LogSink_id_key" ON "LogSink"("id" ASC);
+
+-- CreateIndex
+CREATE INDEX "LogSink_enabled_nextAttemptAt_idx" ON "LogSink"("enabled" ASC, "nextAttemptAt" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "LogSink_tenantId_name_key" ON "LogSink"("tenantId" ASC, "name" ASC);
+
+-- CreateIndex
+CREATE INDEX "LogSinkRecord_tenantId_xid_id_idx" ON "LogSinkRecord"("tenantId" ASC, "xid" ASC, "id" ASC);
+
+-- CreateIndex
+CREATE INDEX "LogSinkRecord_createdAt_idx" ON "LogSinkRecord"("createdAt" ASC);
+
+-- CreateIndex
+CREATE INDEX "NamedLock_expiresAt_idx" ON "NamedLock"("expiresAt" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "NamedLock_id_key" ON "NamedLock"("id" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "NamedLock_tenantId_name_key" ON "NamedLock"("tenantId" ASC, "name" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "ObjectStorageFeed_id_key" ON "ObjectStorageFeed"("id" ASC);
+
+-- CreateIndex
+CREATE INDEX "ObjectStorageFeed_enabled_nextAttemptAt_idx" ON "ObjectStorageFeed"("enabled" ASC, "nextAttemptAt" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "ObjectStorageFeed_tenantId_name_key" ON "ObjectStorageFeed"("tenantId" ASC, "name" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "PIIRule_id_key" ON "PIIRule"("id" ASC);
+
+-- CreateIndex
+CREATE INDEX "PIIRule_nextPurgeAt_idx" ON "PIIRule"("nextPurgeAt" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "PIIRule_tenantId_path_key" ON "PIIRule"("tenantId" ASC, "path" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "QueryTrigger_id_key" ON "QueryTrigger"("id" ASC);
+
+-- CreateIndex
+CREATE INDEX "QueryTrigger_enabled_nextRunAt_idx" ON "QueryTrigger"("enabled" ASC, "nextRunAt" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "QueryTrigger_tenantId_name_key" ON "QueryTrigger"("tenantId" ASC, "name" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "RecurringTask_id_key" ON "RecurringTask"("id" ASC);
+
+-- CreateIndex
+CREATE INDEX "RecurringTask_enabled_nextRunAt_idx" ON "RecurringTask"("enabled" ASC, "nextRunAt" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "RecurringTask_tenantId_name_key" ON "RecurringTask"("tenantId" ASC, "name" ASC);
+
+-- CreateIndex
+CREATE INDEX "ReplicationLogEntry_createdAt_idx" ON "ReplicationLogEntry"("createdAt" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "SNSIntegration_id_key" ON "SNSIntegration"("id" ASC);
 
@@ -736,18 +1480,57 @@ CREATE UNIQUE INDEX "Step_id_key" ON "Step"("id" ASC);
 -- CreateIndex
 CREATE UNIQUE INDEX "Step_jobId_readableId_key" ON "Step"("jobId" ASC, "readableId" ASC);
 
+-- CreateIndex
+CREATE UNIQUE INDEX "StepPreflightCheck_id_key" ON "StepPreflightCheck"("id" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "StepRun_id_key" ON "StepRun"("id" ASC);
 
+-- CreateIndex
+CREATE INDEX "StepRun_tenantId_resultPersistedAt_idx" ON "StepRun"("tenantId" ASC, "resultPersistedAt" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "StepRunDiagnostic_id_key" ON "StepRunDiagnostic"("id" ASC);
+
+-- CreateIndex
+CREATE INDEX "StepRunDiagnostic_stepRunId_idx" ON "StepRunDiagnostic"("stepRunId" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "StepRunResultArchive_id_key" ON "StepRunResultArchive"("id" ASC);
 
+-- CreateIndex
+CREATE UNIQUE INDEX "StreamEvent_stepRunId_index_key" ON "StreamEvent"("stepRunId" ASC, "index" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "Tenant_id_key" ON "Tenant"("id" ASC);
 
 -- CreateIndex
 CREATE UNIQUE INDEX "Tenant_slug_key" ON "Tenant"("slug" ASC);
 
+-- CreateIndex
+CREATE UNIQUE INDEX "TenantEngineSettings_id_key" ON "TenantEngineSettings"("id" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "TenantEngineSettings_tenantId_key" ON "TenantEngineSettings"("tenantId" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "TenantExport_id_key" ON "TenantExport"("id" ASC);
+
+-- CreateIndex
+CREATE INDEX "TenantExport_expiresAt_idx" ON "TenantExport"("expiresAt" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "TenantExportArchive_exportId_key" ON "TenantExportArchive"("exportId" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "TenantExportArchive_id_key" ON "TenantExportArchive"("id" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "TenantFeatureFlag_id_key" ON "TenantFeatureFlag"("id" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "TenantFeatureFlag_tenantId_flag_key" ON "TenantFeatureFlag"("tenantId" ASC, "flag" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "TenantInviteLink_id_key" ON "TenantInviteLink"("id" ASC);
 
@@ -787,6 +1570,15 @@ CREATE UNIQUE INDEX "UserPassword_userId_key" ON "UserPassword"("userId" ASC);
 -- CreateIndex
 CREATE UNIQUE INDEX "UserSession_id_key" ON "UserSession"("id" ASC);
 
+-- CreateIndex
+CREATE UNIQUE INDEX "WebhookDelivery_id_key" ON "WebhookDelivery"("id" ASC);
+
+-- CreateIndex
+CREATE INDEX "WebhookDelivery_webhookId_createdAt_idx" ON "WebhookDelivery"("webhookId" ASC, "createdAt" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WebhookDelivery_webhookId_nonce_key" ON "WebhookDelivery"("webhookId" ASC, "nonce" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "Worker_id_key" ON "Worker"("id" ASC);
 
@@ -808,9 +1600,42 @@ CREATE UNIQUE INDEX "WorkflowDeploymentConfig_id_key" ON "WorkflowDeploymentConf
 -- CreateIndex
 CREATE UNIQUE INDEX "WorkflowDeploymentConfig_workflowId_key" ON "WorkflowDeploymentConfig"("workflowId" ASC);
 
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowMaintenanceWindow_id_key" ON "WorkflowMaintenanceWindow"("id" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowMaintenanceWindow_workflowId_name_key" ON "WorkflowMaintenanceWindow"("workflowId" ASC, "name" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowRollout_id_key" ON "WorkflowRollout"("id" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowRollout_workflowId_key" ON "WorkflowRollout"("workflowId" ASC);
+
+-- CreateIndex
+CREATE INDEX "WorkflowRollout_status_idx" ON "WorkflowRollout"("status" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "WorkflowRun_id_key" ON "WorkflowRun"("id" ASC);
 
+-- CreateIndex
+CREATE INDEX "WorkflowRun_tenantId_environment_idx" ON "WorkflowRun"("tenantId" ASC, "environment" ASC);
+
+-- CreateIndex
+CREATE INDEX "WorkflowRun_finishedAt_idx" ON "WorkflowRun"("finishedAt" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowRunBulkRetry_id_key" ON "WorkflowRunBulkRetry"("id" ASC);
+
+-- CreateIndex
+CREATE INDEX "WorkflowRunRollup_tenantId_hour_idx" ON "WorkflowRunRollup"("tenantId" ASC, "hour" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowRunSLABreach_id_key" ON "WorkflowRunSLABreach"("id" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowRunSLABreach_workflowRunId_key" ON "WorkflowRunSLABreach"("workflowRunId" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "WorkflowRunTriggeredBy_id_key" ON "WorkflowRunTriggeredBy"("id" ASC);
 
@@ -832,6 +1657,12 @@ CREATE UNIQUE INDEX "WorkflowTriggerCronRef_parentId_cron_key" ON "WorkflowTrigg
 -- CreateIndex
 CREATE UNIQUE INDEX "WorkflowTriggerEventRef_parentId_eventKey_key" ON "WorkflowTriggerEventRef"("parentId" ASC, "eventKey" ASC);
 
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowTriggerLink_id_key" ON "WorkflowTriggerLink"("id" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowTriggerLink_workflowId_name_key" ON "WorkflowTriggerLink"("workflowId" ASC, "name" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "WorkflowTriggerScheduledRef_id_key" ON "WorkflowTriggerScheduledRef"("id" ASC);
 
@@ -898,12 +1729,21 @@ ALTER TABLE "APIToken" ADD CONSTRAINT "APIToken_tenantId_fkey" FOREIGN KEY ("ten
 -- AddForeignKey
 ALTER TABLE "Action" ADD CONSTRAINT "Action_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "ClientCertificate" ADD CONSTRAINT "ClientCertificate_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "Event" ADD CONSTRAINT "Event_replayedFromId_fkey" FOREIGN KEY ("replayedFromId") REFERENCES "Event"("id") ON DELETE SET NULL ON UPDATE CASCADE;
 
 -- AddForeignKey
 ALTER TABLE "Event" ADD CONSTRAINT "Event_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "EventBusRecord" ADD CONSTRAINT "EventBusRecord_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "EventBusSubscription" ADD CONSTRAINT "EventBusSubscription_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "GetGroupKeyRun" ADD CONSTRAINT "GetGroupKeyRun_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
@@ -916,6 +1756,9 @@ ALTER TABLE "GetGroupKeyRun" ADD CONSTRAINT "GetGroupKeyRun_workerId_fkey" FOREI
 -- AddForeignKey
 ALTER TABLE "GetGroupKeyRun" ADD CONSTRAINT "GetGroupKeyRun_workflowRunId_fkey" FOREIGN KEY ("workflowRunId") REFERENCES "WorkflowRun"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "GiteaWebhook" ADD CONSTRAINT "GiteaWebhook_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "GithubAppInstallation" ADD CONSTRAINT "GithubAppInstallation_githubAppOAuthId_fkey" FOREIGN KEY ("githubAppOAuthId") REFERENCES "GithubAppOAuth"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
@@ -925,6 +1768,15 @@ ALTER TABLE "GithubAppInstallation" ADD CONSTRAINT "GithubAppInstallation_tenant
 -- AddForeignKey
 ALTER TABLE "GithubAppInstallation" ADD CONSTRAINT "GithubAppInstallation_tenantVcsProviderId_fkey" FOREIGN KEY ("tenantVcsProviderId") REFERENCES "TenantVcsProvider"("id") ON DELETE SET NULL ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "GithubPreviewEnvironment" ADD CONSTRAINT "GithubPreviewEnvironment_pullRequestId_fkey" FOREIGN KEY ("pullRequestId") REFERENCES "GithubPullRequest"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "GithubPreviewEnvironment" ADD CONSTRAINT "GithubPreviewEnvironment_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "GithubPreviewEnvironment" ADD CONSTRAINT "GithubPreviewEnvironment_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "GithubPullRequest" ADD CONSTRAINT "GithubPullRequest_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
@@ -937,6 +1789,9 @@ ALTER TABLE "GithubPullRequestComment" ADD CONSTRAINT "GithubPullRequestComment_
 -- AddForeignKey
 ALTER TABLE "GithubWebhook" ADD CONSTRAINT "GithubWebhook_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "IdempotencyKey" ADD CONSTRAINT "IdempotencyKey_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "Job" ADD CONSTRAINT "Job_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
@@ -967,6 +1822,30 @@ ALTER TABLE "LogLine" ADD CONSTRAINT "LogLine_stepRunId_fkey" FOREIGN KEY ("step
 -- AddForeignKey
 ALTER TABLE "LogLine" ADD CONSTRAINT "LogLine_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "LogSink" ADD CONSTRAINT "LogSink_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "LogSinkRecord" ADD CONSTRAINT "LogSinkRecord_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "NamedLock" ADD CONSTRAINT "NamedLock_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "ObjectStorageFeed" ADD CONSTRAINT "ObjectStorageFeed_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "PIIRule" ADD CONSTRAINT "PIIRule_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "QueryTrigger" ADD CONSTRAINT "QueryTrigger_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "RecurringTask" ADD CONSTRAINT "RecurringTask_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "RecurringTask" ADD CONSTRAINT "RecurringTask_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "SNSIntegration" ADD CONSTRAINT "SNSIntegration_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
@@ -982,6 +1861,9 @@ ALTER TABLE "Step" ADD CONSTRAINT "Step_jobId_fkey" FOREIGN KEY ("jobId") REFERE
 -- AddForeignKey
 ALTER TABLE "Step" ADD CONSTRAINT "Step_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "StepPreflightCheck" ADD CONSTRAINT "StepPreflightCheck_stepId_fkey" FOREIGN KEY ("stepId") REFERENCES "Step"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "StepRun" ADD CONSTRAINT "StepRun_jobRunId_fkey" FOREIGN KEY ("jobRunId") REFERENCES "JobRun"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
@@ -997,9 +1879,33 @@ ALTER TABLE "StepRun" ADD CONSTRAINT "StepRun_tickerId_fkey" FOREIGN KEY ("ticke
 -- AddForeignKey
 ALTER TABLE "StepRun" ADD CONSTRAINT "StepRun_workerId_fkey" FOREIGN KEY ("workerId") REFERENCES "Worker"("id") ON DELETE SET NULL ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "StepRunDiagnostic" ADD CONSTRAINT "StepRunDiagnostic_stepRunId_fkey" FOREIGN KEY ("stepRunId") REFERENCES "StepRun"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "StepRunDiagnostic" ADD CONSTRAINT "StepRunDiagnostic_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "StepRunResultArchive" ADD CONSTRAINT "StepRunResultArchive_stepRunId_fkey" FOREIGN KEY ("stepRunId") REFERENCES "StepRun"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "StreamEvent" ADD CONSTRAINT "StreamEvent_stepRunId_fkey" FOREIGN KEY ("stepRunId") REFERENCES "StepRun"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "StreamEvent" ADD CONSTRAINT "StreamEvent_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "TenantEngineSettings" ADD CONSTRAINT "TenantEngineSettings_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "TenantExport" ADD CONSTRAINT "TenantExport_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "TenantExportArchive" ADD CONSTRAINT "TenantExportArchive_exportId_fkey" FOREIGN KEY ("exportId") REFERENCES "TenantExport"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "TenantFeatureFlag" ADD CONSTRAINT "TenantFeatureFlag_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "TenantInviteLink" ADD CONSTRAINT "TenantInviteLink_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
@@ -1021,6 +1927,9 @@ ALTER TABLE "UserPassword" ADD CONSTRAINT "UserPassword_userId_fkey" FOREIGN KEY
 -- AddForeignKey
 ALTER TABLE "UserSession" ADD CONSTRAINT "UserSession_userId_fkey" FOREIGN KEY ("userId") REFERENCES "User"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "WebhookDelivery" ADD CONSTRAINT "WebhookDelivery_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "Worker" ADD CONSTRAINT "Worker_dispatcherId_fkey" FOREIGN KEY ("dispatcherId") REFERENCES "Dispatcher"("id") ON DELETE SET NULL ON UPDATE CASCADE;
 
@@ -1030,6 +1939,12 @@ ALTER TABLE "Worker" ADD CONSTRAINT "Worker_tenantId_fkey" FOREIGN KEY ("tenantI
 -- AddForeignKey
 ALTER TABLE "Workflow" ADD CONSTRAINT "Workflow_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "WorkflowAnomalyBaseline" ADD CONSTRAINT "WorkflowAnomalyBaseline_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowAnomalyBaseline" ADD CONSTRAINT "WorkflowAnomalyBaseline_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "WorkflowConcurrency" ADD CONSTRAINT "WorkflowConcurrency_getConcurrencyGroupId_fkey" FOREIGN KEY ("getConcurrencyGroupId") REFERENCES "Action"("id") ON DELETE SET NULL ON UPDATE CASCADE;
 
@@ -1042,12 +1957,57 @@ ALTER TABLE "WorkflowDeploymentConfig" ADD CONSTRAINT "WorkflowDeploymentConfig_
 -- AddForeignKey
 ALTER TABLE "WorkflowDeploymentConfig" ADD CONSTRAINT "WorkflowDeploymentConfig_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "WorkflowMaintenanceWindow" ADD CONSTRAINT "WorkflowMaintenanceWindow_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowMaintenanceWindow" ADD CONSTRAINT "WorkflowMaintenanceWindow_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRollout" ADD CONSTRAINT "WorkflowRollout_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRollout" ADD CONSTRAINT "WorkflowRollout_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRollout" ADD CONSTRAINT "WorkflowRollout_fromVersionId_fkey" FOREIGN KEY ("fromVersionId") REFERENCES "WorkflowVersion"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRollout" ADD CONSTRAINT "WorkflowRollout_toVersionId_fkey" FOREIGN KEY ("toVersionId") REFERENCES "WorkflowVersion"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "WorkflowRun" ADD CONSTRAINT "WorkflowRun_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "WorkflowRunBulkRetry" ADD CONSTRAINT "WorkflowRunBulkRetry_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRunDiagnostics" ADD CONSTRAINT "WorkflowRunDiagnostics_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRunDiagnostics" ADD CONSTRAINT "WorkflowRunDiagnostics_workflowRunId_fkey" FOREIGN KEY ("workflowRunId") REFERENCES "WorkflowRun"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRunRollup" ADD CONSTRAINT "WorkflowRunRollup_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRunRollup" ADD CONSTRAINT "WorkflowRunRollup_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRunSLABreach" ADD CONSTRAINT "WorkflowRunSLABreach_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRunSLABreach" ADD CONSTRAINT "WorkflowRunSLABreach_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRunSLABreach" ADD CONSTRAINT "WorkflowRunSLABreach_workflowRunId_fkey" FOREIGN KEY ("workflowRunId") REFERENCES "WorkflowRun"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "WorkflowRun" ADD CONSTRAINT "WorkflowRun_workflowVersionId_fkey" FOREIGN KEY ("workflowVersionId") REFERENCES "WorkflowVersion"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "WorkflowRun" ADD CONSTRAINT "WorkflowRun_replayOfId_fkey" FOREIGN KEY ("replayOfId") REFERENCES "WorkflowRun"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "WorkflowRunTriggeredBy" ADD CONSTRAINT "WorkflowRunTriggeredBy_cronParentId_cronSchedule_fkey" FOREIGN KEY ("cronParentId", "cronSchedule") REFERENCES "WorkflowTriggerCronRef"("parentId", "cron") ON DELETE SET NULL ON UPDATE CASCADE;
 
@@ -1075,6 +2035,12 @@ ALTER TABLE "WorkflowTriggerCronRef" ADD CONSTRAINT "WorkflowTriggerCronRef_tick
 -- AddForeignKey
 ALTER TABLE "WorkflowTriggerEventRef" ADD CONSTRAINT "WorkflowTriggerEventRef_parentId_fkey" FOREIGN KEY ("parentId") REFERENCES "WorkflowTriggers"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "WorkflowTriggerLink" ADD CONSTRAINT "WorkflowTriggerLink_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowTriggerLink" ADD CONSTRAINT "WorkflowTriggerLink_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "WorkflowTriggerScheduledRef" ADD CONSTRAINT "WorkflowTriggerScheduledRef_parentId_fkey" FOREIGN KEY ("parentId") REFERENCES "WorkflowVersion"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
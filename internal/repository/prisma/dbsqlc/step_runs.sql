@@ -17,6 +17,10 @@ SELECT
     s."id" AS "stepId",
     s."retries" AS "stepRetries",
     s."scheduleTimeout" AS "stepScheduleTimeout",
+    s."cancelGracePeriod" AS "stepCancelGracePeriod",
+    s."maxTimeout" AS "stepMaxTimeout",
+    s."onTimeoutActionId" AS "stepOnTimeoutActionId",
+    s."joinStrategy" AS "stepJoinStrategy",
     s."readableId" AS "stepReadableId",
     s."customUserData" AS "stepCustomUserData",
     j."name" AS "jobName",
@@ -24,7 +28,8 @@ SELECT
     wv."id" AS "workflowVersionId",
     w."name" AS "workflowName",
     w."id" AS "workflowId",
-    a."actionId" AS "actionId"
+    a."actionId" AS "actionId",
+    wr."additionalMetadata" AS "additionalMetadata"
 FROM
     "StepRun" sr
 JOIN
@@ -87,7 +92,38 @@ SET
         WHEN sqlc.narg('rerun')::boolean THEN NULL
         ELSE COALESCE(sqlc.narg('cancelledReason')::text, "cancelledReason")
     END,
-    "retryCount" = COALESCE(sqlc.narg('retryCount')::int, "retryCount")
+    "cancelledSource" = CASE
+        -- if this is a rerun, we clear the cancelledSource
+        WHEN sqlc.narg('rerun')::boolean THEN NULL
+        ELSE COALESCE(sqlc.narg('cancelledSource')::"CancellationSource", "cancelledSource")
+    END,
+    "retryCount" = COALESCE(sqlc.narg('retryCount')::int, "retryCount"),
+    -- queueing the step run for assignment starts a new attempt, so we reset the timeline of the attempt
+    "queuedAt" = CASE
+        WHEN sqlc.narg('status')::"StepRunStatus" = 'PENDING_ASSIGNMENT' AND "status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN CURRENT_TIMESTAMP
+        ELSE "queuedAt"
+    END,
+    "slotWaitStartedAt" = CASE
+        WHEN sqlc.narg('status')::"StepRunStatus" = 'PENDING_ASSIGNMENT' AND "status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN NULL
+        ELSE "slotWaitStartedAt"
+    END,
+    "assignedAt" = CASE
+        WHEN sqlc.narg('status')::"StepRunStatus" = 'PENDING_ASSIGNMENT' AND "status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN NULL
+        ELSE "assignedAt"
+    END,
+    -- a status update means the step run is no longer waiting for a worker
+    "subStatus" = CASE
+        WHEN sqlc.narg('status')::"StepRunStatus" IS NOT NULL THEN NULL
+        ELSE "subStatus"
+    END,
+    "resultPersistedAt" = CASE
+        -- if this is a rerun, we clear the resultPersistedAt
+        WHEN sqlc.narg('rerun')::boolean THEN NULL
+        WHEN "status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN "resultPersistedAt"
+        WHEN sqlc.narg('status')::"StepRunStatus" = 'PENDING_ASSIGNMENT' THEN NULL
+        WHEN sqlc.narg('finishedAt')::timestamp IS NOT NULL THEN CURRENT_TIMESTAMP
+        ELSE "resultPersistedAt"
+    END
 WHERE 
   "id" = @id::uuid AND
   "tenantId" = @tenantId::uuid
@@ -113,6 +149,10 @@ SET "status" = CASE
     WHEN (cs."status" = 'CANCELLED' AND cs."cancelledReason" = 'TIMED_OUT'::text) THEN 'PREVIOUS_STEP_TIMED_OUT'
     WHEN (cs."status" = 'CANCELLED') THEN 'PREVIOUS_STEP_CANCELLED'
     ELSE NULL
+    END,
+    "cancelledSource" = CASE
+    WHEN (cs."status" = 'CANCELLED') THEN 'PARENT_CANCELLED'::"CancellationSource"
+    ELSE NULL
     END
 FROM
     currStepRun cs
@@ -127,7 +167,17 @@ WHERE
         FROM "StepRun"
         WHERE "id" = @stepRunId::uuid
     ) AND
-    sr."tenantId" = @tenantId::uuid
+    sr."tenantId" = @tenantId::uuid AND
+    -- job runs with join steps are resolved along the edges between their step runs instead, so that a failed
+    -- branch of a join step doesn't cancel the other branches
+    NOT EXISTS (
+        SELECT 1
+        FROM "StepRun" AS join_run
+        JOIN "Step" AS join_step ON join_step."id" = join_run."stepId"
+        WHERE
+            join_run."jobRunId" = sr."jobRunId" AND
+            join_step."joinStrategy" != 'ALL'
+    )
 RETURNING sr.*;
 
 -- name: UpdateStepRunOverridesData :one
@@ -153,6 +203,49 @@ WHERE
     sr."id" = @stepRunId::uuid
 RETURNING "inputSchema";
 
+-- name: UpdateStepRunTimeoutAt :one
+UPDATE
+    "StepRun" sr
+SET
+    "timeoutAt" = @timeoutAt::timestamp,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    sr."tenantId" = @tenantId::uuid AND
+    sr."id" = @stepRunId::uuid AND
+    sr."status" = ANY(ARRAY['ASSIGNED', 'RUNNING']::"StepRunStatus"[])
+RETURNING "timeoutAt";
+
+-- name: ReleaseStepRun :one
+-- Moves a step run which its worker released back to pending assignment, which frees the slot of the worker. The
+-- step run is requeued once the requeue after time passed, and its schedule timeout starts at that time. This
+-- doesn't count as a retry of the step run.
+UPDATE
+    "StepRun" sr
+SET
+    "status" = 'PENDING_ASSIGNMENT',
+    "workerId" = NULL,
+    "requeueAfter" = @requeueAfter::timestamp,
+    "scheduleTimeoutAt" = @requeueAfter::timestamp + s."scheduleTimeout"::interval,
+    "queuedAt" = CURRENT_TIMESTAMP,
+    "slotWaitStartedAt" = NULL,
+    "assignedAt" = NULL,
+    "startedAt" = NULL,
+    "timeoutAt" = NULL,
+    "subStatus" = NULL,
+    "updatedAt" = CURRENT_TIMESTAMP
+FROM
+    "Step" s
+WHERE
+    sr."tenantId" = @tenantId::uuid AND
+    sr."id" = @stepRunId::uuid AND
+    sr."workerId" = @workerId::uuid AND
+    sr."status" = 'RUNNING' AND
+    sr."cancelledAt" IS NULL AND
+    s."id" = sr."stepId"
+RETURNING
+    sr."id",
+    sr."tickerId";
+
 -- name: ArchiveStepRunResultFromStepRun :one
 WITH step_run_data AS (
     SELECT
@@ -169,7 +262,9 @@ WITH step_run_data AS (
         "timeoutAt",
         "cancelledAt",
         "cancelledReason",
-        "cancelledError"
+        "cancelledError",
+        "retryCount",
+        "workerId"
     FROM "StepRun"
     WHERE "id" = @stepRunId::uuid AND "tenantId" = @tenantId::uuid
 )
@@ -187,7 +282,9 @@ INSERT INTO "StepRunResultArchive" (
     "timeoutAt",
     "cancelledAt",
     "cancelledReason",
-    "cancelledError"
+    "cancelledError",
+    "retryCount",
+    "workerId"
 )
 SELECT
     COALESCE(sqlc.arg('id')::uuid, gen_random_uuid()),
@@ -203,7 +300,9 @@ SELECT
     step_run_data."timeoutAt",
     step_run_data."cancelledAt",
     step_run_data."cancelledReason",
-    step_run_data."cancelledError"
+    step_run_data."cancelledError",
+    step_run_data."retryCount",
+    step_run_data."workerId"
 FROM step_run_data
 RETURNING *;
 
@@ -250,7 +349,7 @@ JOIN
 WHERE
     sr."tenantId" = @tenantId::uuid
     AND sr."requeueAfter" < NOW()
-    AND (sr."status" = 'PENDING' OR sr."status" = 'PENDING_ASSIGNMENT')
+    AND (sr."status" = 'PENDING' OR sr."status" = 'PENDING_ASSIGNMENT' OR sr."status" = 'WAITING_ON_DEPENDENCY')
     AND jr."status" = 'RUNNING'
     AND NOT EXISTS (
         SELECT 1
@@ -263,68 +362,239 @@ WHERE
 ORDER BY
     sr."createdAt" ASC;
 
+-- name: GetStepRunForAssignment :one
+SELECT
+    sr."id",
+    a."id" AS "actionId",
+    s."cpu",
+    s."memoryMb",
+    s."gpu",
+    wr."id" AS "workflowRunId",
+    wr."environment",
+    wr."buildId",
+    wv."pinToBuild",
+    COALESCE(wv."assignmentStrategy", t."assignmentStrategy")::"WorkerAssignmentStrategy" AS "assignmentStrategy",
+    (
+        -- the labels of the workers which ran the parents of the step run
+        SELECT COALESCE(jsonb_agg(pw."labels"), '[]'::jsonb)
+        FROM "_StepRunOrder" order_table
+        JOIN "StepRun" parent_sr ON parent_sr."id" = order_table."A"
+        JOIN "Worker" pw ON pw."id" = parent_sr."workerId"
+        WHERE order_table."B" = sr."id" AND pw."labels" IS NOT NULL
+    )::jsonb AS "parentWorkerLabels"
+FROM
+    "StepRun" sr
+JOIN
+    "Step" s ON sr."stepId" = s."id"
+JOIN
+    "JobRun" jr ON sr."jobRunId" = jr."id"
+JOIN
+    "WorkflowRun" wr ON jr."workflowRunId" = wr."id"
+JOIN
+    "WorkflowVersion" wv ON wr."workflowVersionId" = wv."id"
+JOIN
+    "Tenant" t ON sr."tenantId" = t."id"
+JOIN
+    "Action" a ON s."actionId" = a."actionId" AND a."tenantId" = @tenantId::uuid
+WHERE
+    sr."id" = @stepRunId::uuid AND
+    sr."tenantId" = @tenantId::uuid
+FOR UPDATE OF sr;
+
+-- name: ListWorkersForAssignment :many
+SELECT
+    w."id",
+    w."dispatcherId",
+    w."buildId",
+    w."labels",
+    w."lastAssignedAt",
+    (
+        SELECT COUNT(*)
+        FROM "StepRun" srs
+        WHERE srs."workerId" = w."id" AND srs."status" IN ('ASSIGNED', 'RUNNING')
+    ) AS "load"
+FROM
+    "Worker" w
+WHERE
+    w."tenantId" = @tenantId::uuid
+    AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
+    AND w."id" IN (
+        SELECT "_ActionToWorker"."B"
+        FROM "_ActionToWorker"
+        INNER JOIN "Action" ON "Action"."id" = "_ActionToWorker"."A"
+        WHERE "Action"."tenantId" = @tenantId AND "Action"."id" = @actionId::uuid
+    )
+    AND (
+        w."maxRuns" IS NULL OR
+        w."maxRuns" > (
+            SELECT COUNT(*)
+            FROM "StepRun" srs
+            WHERE srs."workerId" = w."id" AND srs."status" = 'RUNNING'
+        )
+    )
+    -- workers which do not advertise a resource are not filtered by it
+    AND (sqlc.narg('cpu')::float8 IS NULL OR w."cpu" IS NULL OR w."cpu" >= sqlc.narg('cpu')::float8)
+    AND (sqlc.narg('memoryMb')::int IS NULL OR w."memoryMb" IS NULL OR w."memoryMb" >= sqlc.narg('memoryMb')::int)
+    AND (sqlc.narg('gpu')::int IS NULL OR w."gpu" IS NULL OR w."gpu" >= sqlc.narg('gpu')::int)
+    -- runs are only assigned to workers of their environment
+    AND w."environment" IS NOT DISTINCT FROM sqlc.narg('environment')::text
+    -- pinned runs are only assigned to workers of the build which started them
+    AND (sqlc.narg('buildId')::text IS NULL OR w."buildId" = sqlc.narg('buildId')::text)
+FOR UPDATE SKIP LOCKED;
+
 -- name: AssignStepRunToWorker :one
+WITH selected_worker AS (
+    UPDATE
+        "Worker"
+    SET
+        "lastAssignedAt" = CURRENT_TIMESTAMP
+    WHERE
+        "id" = @workerId::uuid AND
+        "tenantId" = @tenantId::uuid
+    RETURNING "id", "dispatcherId"
+)
+UPDATE
+    "StepRun"
+SET
+    "status" = 'ASSIGNED',
+    "workerId" = (
+        SELECT "id"
+        FROM selected_worker
+        LIMIT 1
+    ),
+    "assignedAt" = CURRENT_TIMESTAMP,
+    "subStatus" = NULL,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @stepRunId::uuid AND
+    "tenantId" = @tenantId::uuid AND
+    EXISTS (SELECT 1 FROM selected_worker)
+RETURNING "StepRun"."id", "StepRun"."workerId", (SELECT "dispatcherId" FROM selected_worker) AS "dispatcherId";
+
+-- name: GetWorkerForStepRunTimeoutHook :one
+-- Returns the on-timeout action of the step of a step run, along with a random worker of the environment of the
+-- step run which registered the action. Timeout hooks don't take up a slot, so the worker may be at capacity. The
+-- worker id is null if no worker is available, and no row is returned if the step has no on-timeout action.
 WITH step_run AS (
     SELECT
-        sr."id",
-        sr."status",
-        a."id" AS "actionId"
+        s."onTimeoutActionId",
+        wr."environment"
     FROM
         "StepRun" sr
     JOIN
         "Step" s ON sr."stepId" = s."id"
     JOIN
-        "Action" a ON s."actionId" = a."actionId" AND a."tenantId" = @tenantId::uuid
+        "JobRun" jr ON sr."jobRunId" = jr."id"
+    JOIN
+        "WorkflowRun" wr ON jr."workflowRunId" = wr."id"
     WHERE
         sr."id" = @stepRunId::uuid AND
-        sr."tenantId" = @tenantId::uuid
-    FOR UPDATE
-),
-valid_workers AS (
+        sr."tenantId" = @tenantId::uuid AND
+        s."onTimeoutActionId" IS NOT NULL
+)
+SELECT
+    step_run."onTimeoutActionId"::text AS "actionId",
+    selected_worker."id" AS "workerId",
+    selected_worker."dispatcherId" AS "dispatcherId"
+FROM
+    step_run
+LEFT JOIN LATERAL (
     SELECT
-        w."id", w."dispatcherId"
+        w."id",
+        w."dispatcherId"
     FROM
-        "Worker" w, step_run
+        "Worker" w
     WHERE
         w."tenantId" = @tenantId::uuid
         AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
+        AND w."dispatcherId" IS NOT NULL
         AND w."id" IN (
             SELECT "_ActionToWorker"."B"
             FROM "_ActionToWorker"
             INNER JOIN "Action" ON "Action"."id" = "_ActionToWorker"."A"
-            WHERE "Action"."tenantId" = @tenantId AND "Action"."id" = step_run."actionId"
-        )
-        AND (
-            w."maxRuns" IS NULL OR
-            w."maxRuns" > (
-                SELECT COUNT(*)
-                FROM "StepRun" srs
-                WHERE srs."workerId" = w."id" AND srs."status" = 'RUNNING'
-            )
+            WHERE "Action"."tenantId" = @tenantId::uuid AND "Action"."actionId" = step_run."onTimeoutActionId"
         )
+        -- hooks only run on workers of the environment of their step run
+        AND w."environment" IS NOT DISTINCT FROM step_run."environment"
+        -- workers which speak protocol version 1 don't understand timeout hook actions
+        AND w."protocolVersion" >= 2
     ORDER BY random()
-    FOR UPDATE SKIP LOCKED
-),
-selected_worker AS (
-    SELECT "id", "dispatcherId"
-    FROM valid_workers
     LIMIT 1
-)
+) selected_worker ON true;
+
+-- name: GetStepRunCorrelationId :one
+-- Returns the correlation id of the workflow run of a step run, which is null if the run wasn't triggered by a
+-- request with a correlation id.
+SELECT
+    wr."correlationId"
+FROM
+    "StepRun" sr
+JOIN
+    "JobRun" jr ON sr."jobRunId" = jr."id"
+JOIN
+    "WorkflowRun" wr ON jr."workflowRunId" = wr."id"
+WHERE
+    sr."id" = @stepRunId::uuid AND
+    sr."tenantId" = @tenantId::uuid;
+
+-- name: PinWorkflowRunToBuild :exec
+UPDATE
+    "WorkflowRun"
+SET
+    "buildId" = @buildId::text
+WHERE
+    "id" = @workflowRunId::uuid AND
+    "tenantId" = @tenantId::uuid AND
+    "buildId" IS NULL;
+
+-- name: SetStepRunSlotWaitStarted :exec
 UPDATE
     "StepRun"
 SET
-    "status" = 'ASSIGNED',
-    "workerId" = (
-        SELECT "id"
-        FROM selected_worker
-        LIMIT 1
-    ),
-    "updatedAt" = CURRENT_TIMESTAMP
+    "slotWaitStartedAt" = COALESCE("slotWaitStartedAt", CURRENT_TIMESTAMP)
 WHERE
     "id" = @stepRunId::uuid AND
     "tenantId" = @tenantId::uuid AND
-    EXISTS (SELECT 1 FROM selected_worker)
-RETURNING "StepRun"."id", "StepRun"."workerId", (SELECT "dispatcherId" FROM selected_worker) AS "dispatcherId";
+    "status" = 'PENDING_ASSIGNMENT';
+
+-- name: MarkStepRunsWaitingForWorker :many
+-- Marks up to limit step runs, across all tenants, which have waited for a worker with a free slot for longer than
+-- the threshold. Step runs which are already marked are skipped, so each step run is returned once per attempt.
+WITH waiting_runs AS (
+    SELECT
+        sr."id"
+    FROM
+        "StepRun" sr
+    WHERE
+        sr."status" = 'PENDING_ASSIGNMENT' AND
+        sr."subStatus" IS NULL AND
+        sr."deletedAt" IS NULL AND
+        sr."slotWaitStartedAt" < NOW() - make_interval(secs => @thresholdSeconds::float8)
+    ORDER BY
+        sr."slotWaitStartedAt" ASC
+    LIMIT
+        @limit::int
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE
+    "StepRun" sr
+SET
+    "subStatus" = 'WAITING_FOR_WORKER'
+FROM
+    waiting_runs,
+    "Step" s,
+    "JobRun" jr
+WHERE
+    sr."id" = waiting_runs."id" AND
+    s."id" = sr."stepId" AND
+    jr."id" = sr."jobRunId"
+RETURNING
+    sr."id",
+    sr."tenantId",
+    sr."slotWaitStartedAt",
+    s."actionId",
+    s."readableId" AS "stepReadableId",
+    jr."workflowRunId";
 
 -- name: AssignStepRunToTicker :one
 WITH selected_ticker AS (
@@ -348,4 +618,198 @@ WHERE
     "id" = @stepRunId::uuid AND
     "tenantId" = @tenantId::uuid AND
     EXISTS (SELECT 1 FROM selected_ticker)
-RETURNING "StepRun"."id", "StepRun"."tickerId";
\ No newline at end of file
+RETURNING "StepRun"."id", "StepRun"."tickerId";
+-- name: ListStepRunQueues :many
+SELECT
+    sr."tenantId" AS "tenantId",
+    s."actionId" AS "actionId",
+    COUNT(sr."id") AS "pendingAssignment",
+    MIN(sr."createdAt")::timestamp AS "oldestCreatedAt"
+FROM
+    "StepRun" sr
+JOIN
+    "Step" s ON sr."stepId" = s."id"
+WHERE
+    sr."status" = 'PENDING_ASSIGNMENT'
+    AND sr."deletedAt" IS NULL
+GROUP BY
+    sr."tenantId", s."actionId"
+ORDER BY
+    "pendingAssignment" DESC;
+
+-- name: ListStepRunPhaseMetrics :many
+WITH phases AS (
+    SELECT
+        s."actionId",
+        -- worker clocks may be skewed, so phases which rely on worker timestamps are clamped to zero
+        GREATEST(EXTRACT(EPOCH FROM (COALESCE(sr."slotWaitStartedAt", sr."assignedAt") - sr."queuedAt")) * 1000, 0)::float8 AS "queueMs",
+        GREATEST(EXTRACT(EPOCH FROM (sr."assignedAt" - COALESCE(sr."slotWaitStartedAt", sr."assignedAt"))) * 1000, 0)::float8 AS "slotWaitMs",
+        GREATEST(EXTRACT(EPOCH FROM (sr."startedAt" - sr."assignedAt")) * 1000, 0)::float8 AS "dispatchMs",
+        GREATEST(EXTRACT(EPOCH FROM (sr."finishedAt" - sr."startedAt")) * 1000, 0)::float8 AS "executionMs",
+        GREATEST(EXTRACT(EPOCH FROM (sr."resultPersistedAt" - sr."finishedAt")) * 1000, 0)::float8 AS "persistenceMs"
+    FROM
+        "StepRun" sr
+    JOIN
+        "Step" s ON sr."stepId" = s."id"
+    WHERE
+        sr."tenantId" = @tenantId::uuid
+        AND sr."deletedAt" IS NULL
+        AND sr."resultPersistedAt" >= @since::timestamp
+        -- only step runs with a full timeline are included
+        AND sr."queuedAt" IS NOT NULL
+        AND sr."assignedAt" IS NOT NULL
+        AND sr."startedAt" IS NOT NULL
+        AND sr."finishedAt" IS NOT NULL
+)
+SELECT
+    "actionId",
+    COUNT(*) AS "count",
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY "queueMs")::float8 AS "queueP50",
+    percentile_cont(0.95) WITHIN GROUP (ORDER BY "queueMs")::float8 AS "queueP95",
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY "slotWaitMs")::float8 AS "slotWaitP50",
+    percentile_cont(0.95) WITHIN GROUP (ORDER BY "slotWaitMs")::float8 AS "slotWaitP95",
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY "dispatchMs")::float8 AS "dispatchP50",
+    percentile_cont(0.95) WITHIN GROUP (ORDER BY "dispatchMs")::float8 AS "dispatchP95",
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY "executionMs")::float8 AS "executionP50",
+    percentile_cont(0.95) WITHIN GROUP (ORDER BY "executionMs")::float8 AS "executionP95",
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY "persistenceMs")::float8 AS "persistenceP50",
+    percentile_cont(0.95) WITHIN GROUP (ORDER BY "persistenceMs")::float8 AS "persistenceP95"
+FROM
+    phases
+GROUP BY
+    "actionId"
+ORDER BY
+    "actionId" ASC;
+
+-- name: ListCostCenterUsage :many
+-- Returns the compute seconds and run counts of the step runs of a tenant which finished in a time range, grouped
+-- by cost center and time bucket. Step runs are attributed to the cost center of their step, or else the cost
+-- center of their workflow version.
+SELECT
+    COALESCE(s."costCenter", wv."costCenter", '')::text AS "costCenter",
+    date_trunc(@bucket::text, sr."resultPersistedAt")::timestamp AS "bucket",
+    -- worker clocks may be skewed, so durations are clamped to zero
+    SUM(GREATEST(EXTRACT(EPOCH FROM (sr."finishedAt" - sr."startedAt")), 0))::float8 AS "computeSeconds",
+    COUNT(*) AS "stepRunCount",
+    COUNT(DISTINCT jr."workflowRunId") AS "workflowRunCount"
+FROM
+    "StepRun" sr
+JOIN
+    "Step" s ON sr."stepId" = s."id"
+JOIN
+    "JobRun" jr ON sr."jobRunId" = jr."id"
+JOIN
+    "WorkflowRun" wr ON jr."workflowRunId" = wr."id"
+JOIN
+    "WorkflowVersion" wv ON wr."workflowVersionId" = wv."id"
+WHERE
+    sr."tenantId" = @tenantId::uuid
+    AND sr."deletedAt" IS NULL
+    AND sr."resultPersistedAt" >= @since::timestamp
+    AND sr."resultPersistedAt" < @until::timestamp
+    AND sr."startedAt" IS NOT NULL
+    AND sr."finishedAt" IS NOT NULL
+    AND (
+        sqlc.narg('costCenter')::text IS NULL OR
+        COALESCE(s."costCenter", wv."costCenter", '') = sqlc.narg('costCenter')::text
+    )
+GROUP BY
+    1, 2
+ORDER BY
+    "bucket" ASC,
+    "costCenter" ASC;
+
+-- name: ListStepRunsWaitingForWorker :many
+SELECT
+    s."actionId",
+    COUNT(*) AS "count",
+    MIN(sr."slotWaitStartedAt")::timestamp AS "waitingSince"
+FROM
+    "StepRun" sr
+JOIN
+    "Step" s ON sr."stepId" = s."id"
+WHERE
+    sr."tenantId" = @tenantId::uuid
+    AND sr."deletedAt" IS NULL
+    AND sr."status" = 'PENDING_ASSIGNMENT'
+    AND sr."subStatus" = 'WAITING_FOR_WORKER'
+GROUP BY
+    s."actionId"
+ORDER BY
+    "count" DESC,
+    s."actionId" ASC;
+
+-- name: ListCancellableStepRuns :many
+-- Returns the step runs which are cancelled to cancel the unfinished runs of a tenant, or of one of its workflows.
+-- Pending step runs are only returned if they have no parents, since the later step runs of a job run are
+-- cancelled along with the step run before them.
+SELECT
+    sr."id"
+FROM
+    "StepRun" sr
+JOIN
+    "JobRun" jr ON jr."id" = sr."jobRunId"
+JOIN
+    "WorkflowRun" wr ON wr."id" = jr."workflowRunId"
+JOIN
+    "WorkflowVersion" wv ON wv."id" = wr."workflowVersionId"
+WHERE
+    sr."tenantId" = @tenantId::uuid
+    AND sr."deletedAt" IS NULL
+    AND (
+        sqlc.narg('workflowId')::uuid IS NULL OR
+        wv."workflowId" = sqlc.narg('workflowId')::uuid
+    )
+    AND (
+        sr."status" IN ('PENDING_ASSIGNMENT', 'WAITING_ON_DEPENDENCY', 'ASSIGNED', 'RUNNING') OR
+        (
+            sr."status" = 'PENDING' AND
+            NOT EXISTS (
+                SELECT 1
+                FROM "_StepRunOrder" AS step_run_order
+                WHERE step_run_order."B" = sr."id"
+            )
+        )
+    );
+
+-- name: ListStepRunsForJoinResolution :many
+SELECT
+    sr."id",
+    sr."status",
+    sr."toleratedByJoin",
+    s."joinStrategy",
+    s."joinQuorum",
+    array_remove(array_agg(step_run_order."A"), NULL)::uuid[] AS "parentIds"
+FROM
+    "StepRun" sr
+JOIN
+    "Step" s ON sr."stepId" = s."id"
+LEFT JOIN
+    "_StepRunOrder" AS step_run_order ON step_run_order."B" = sr."id"
+WHERE
+    sr."jobRunId" = @jobRunId::uuid AND
+    sr."tenantId" = @tenantId::uuid AND
+    sr."deletedAt" IS NULL
+GROUP BY
+    sr."id", s."joinStrategy", s."joinQuorum";
+
+-- name: CancelPendingStepRuns :exec
+UPDATE
+    "StepRun"
+SET
+    "status" = 'CANCELLED',
+    "cancelledReason" = sqlc.narg('cancelledReason')::text,
+    "cancelledSource" = sqlc.narg('cancelledSource')::"CancellationSource"
+WHERE
+    "id" = ANY(@ids::uuid[]) AND
+    "tenantId" = @tenantId::uuid AND
+    "status" = 'PENDING';
+
+-- name: UpdateStepRunsToleratedByJoin :exec
+UPDATE
+    "StepRun"
+SET
+    "toleratedByJoin" = "id" = ANY(@toleratedIds::uuid[])
+WHERE
+    "id" = ANY(@ids::uuid[]) AND
+    "tenantId" = @tenantId::uuid;
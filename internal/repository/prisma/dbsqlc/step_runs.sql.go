@@ -27,7 +27,9 @@ WITH step_run_data AS (
         "timeoutAt",
         "cancelledAt",
         "cancelledReason",
-        "cancelledError"
+        "cancelledError",
+        "retryCount",
+        "workerId"
     FROM "StepRun"
     WHERE "id" = $2::uuid AND "tenantId" = $3::uuid
 )
@@ -45,7 +47,9 @@ INSERT INTO "StepRunResultArchive" (
     "timeoutAt",
     "cancelledAt",
     "cancelledReason",
-    "cancelledError"
+    "cancelledError",
+    "retryCount",
+    "workerId"
 )
 SELECT
     COALESCE($1::uuid, gen_random_uuid()),
@@ -61,9 +65,11 @@ SELECT
     step_run_data."timeoutAt",
     step_run_data."cancelledAt",
     step_run_data."cancelledReason",
-    step_run_data."cancelledError"
+    step_run_data."cancelledError",
+    step_run_data."retryCount",
+    step_run_data."workerId"
 FROM step_run_data
-RETURNING id, "createdAt", "updatedAt", "deletedAt", "stepRunId", "order", input, output, error, "startedAt", "finishedAt", "timeoutAt", "cancelledAt", "cancelledReason", "cancelledError"
+RETURNING id, "createdAt", "updatedAt", "deletedAt", "stepRunId", "order", input, output, error, "startedAt", "finishedAt", "timeoutAt", "cancelledAt", "cancelledReason", "cancelledError", "retryCount", "workerId"
 `
 
 type ArchiveStepRunResultFromStepRunParams struct {
@@ -91,6 +97,8 @@ func (q *Queries) ArchiveStepRunResultFromStepRun(ctx context.Context, db DBTX,
 		&i.CancelledAt,
 		&i.CancelledReason,
 		&i.CancelledError,
+		&i.RetryCount,
+		&i.WorkerId,
 	)
 	return &i, err
 }
@@ -138,51 +146,15 @@ func (q *Queries) AssignStepRunToTicker(ctx context.Context, db DBTX, arg Assign
 }
 
 const assignStepRunToWorker = `-- name: AssignStepRunToWorker :one
-WITH step_run AS (
-    SELECT
-        sr."id",
-        sr."status",
-        a."id" AS "actionId"
-    FROM
-        "StepRun" sr
-    JOIN
-        "Step" s ON sr."stepId" = s."id"
-    JOIN
-        "Action" a ON s."actionId" = a."actionId" AND a."tenantId" = $2::uuid
+WITH selected_worker AS (
+    UPDATE
+        "Worker"
+    SET
+        "lastAssignedAt" = CURRENT_TIMESTAMP
     WHERE
-        sr."id" = $1::uuid AND
-        sr."tenantId" = $2::uuid
-    FOR UPDATE
-),
-valid_workers AS (
-    SELECT
-        w."id", w."dispatcherId"
-    FROM
-        "Worker" w, step_run
-    WHERE
-        w."tenantId" = $2::uuid
-        AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
-        AND w."id" IN (
-            SELECT "_ActionToWorker"."B"
-            FROM "_ActionToWorker"
-            INNER JOIN "Action" ON "Action"."id" = "_ActionToWorker"."A"
-            WHERE "Action"."tenantId" = $2 AND "Action"."id" = step_run."actionId"
-        )
-        AND (
-            w."maxRuns" IS NULL OR
-            w."maxRuns" > (
-                SELECT COUNT(*)
-                FROM "StepRun" srs
-                WHERE srs."workerId" = w."id" AND srs."status" = 'RUNNING'
-            )
-        )
-    ORDER BY random()
-    FOR UPDATE SKIP LOCKED
-),
-selected_worker AS (
-    SELECT "id", "dispatcherId"
-    FROM valid_workers
-    LIMIT 1
+        "id" = $1::uuid AND
+        "tenantId" = $2::uuid
+    RETURNING "id", "dispatcherId"
 )
 UPDATE
     "StepRun"
@@ -193,17 +165,20 @@ SET
         FROM selected_worker
         LIMIT 1
     ),
+    "assignedAt" = CURRENT_TIMESTAMP,
+    "subStatus" = NULL,
     "updatedAt" = CURRENT_TIMESTAMP
 WHERE
-    "id" = $1::uuid AND
+    "id" = $3::uuid AND
     "tenantId" = $2::uuid AND
     EXISTS (SELECT 1 FROM selected_worker)
 RETURNING "StepRun"."id", "StepRun"."workerId", (SELECT "dispatcherId" FROM selected_worker) AS "dispatcherId"
 `
 
 type AssignStepRunToWorkerParams struct {
-	Steprunid pgtype.UUID `json:"steprunid"`
+	Workerid  pgtype.UUID `json:"workerid"`
 	Tenantid  pgtype.UUID `json:"tenantid"`
+	Steprunid pgtype.UUID `json:"steprunid"`
 }
 
 type AssignStepRunToWorkerRow struct {
@@ -213,15 +188,45 @@ type AssignStepRunToWorkerRow struct {
 }
 
 func (q *Queries) AssignStepRunToWorker(ctx context.Context, db DBTX, arg AssignStepRunToWorkerParams) (*AssignStepRunToWorkerRow, error) {
-	row := db.QueryRow(ctx, assignStepRunToWorker, arg.Steprunid, arg.Tenantid)
+	row := db.QueryRow(ctx, assignStepRunToWorker, arg.Workerid, arg.Tenantid, arg.Steprunid)
 	var i AssignStepRunToWorkerRow
 	err := row.Scan(&i.ID, &i.WorkerId, &i.DispatcherId)
 	return &i, err
 }
 
+const cancelPendingStepRuns = `-- name: CancelPendingStepRuns :exec
+UPDATE
+    "StepRun"
+SET
+    "status" = 'CANCELLED',
+    "cancelledReason" = $1::text,
+    "cancelledSource" = $2::"CancellationSource"
+WHERE
+    "id" = ANY($3::uuid[]) AND
+    "tenantId" = $4::uuid AND
+    "status" = 'PENDING'
+`
+
+type CancelPendingStepRunsParams struct {
+	CancelledReason pgtype.Text            `json:"cancelledReason"`
+	CancelledSource NullCancellationSource `json:"cancelledSource"`
+	Ids             []pgtype.UUID          `json:"ids"`
+	Tenantid        pgtype.UUID            `json:"tenantid"`
+}
+
+func (q *Queries) CancelPendingStepRuns(ctx context.Context, db DBTX, arg CancelPendingStepRunsParams) error {
+	_, err := db.Exec(ctx, cancelPendingStepRuns,
+		arg.CancelledReason,
+		arg.CancelledSource,
+		arg.Ids,
+		arg.Tenantid,
+	)
+	return err
+}
+
 const getStepRun = `-- name: GetStepRun :one
 SELECT
-    "StepRun".id, "StepRun"."createdAt", "StepRun"."updatedAt", "StepRun"."deletedAt", "StepRun"."tenantId", "StepRun"."jobRunId", "StepRun"."stepId", "StepRun"."order", "StepRun"."workerId", "StepRun"."tickerId", "StepRun".status, "StepRun".input, "StepRun".output, "StepRun"."requeueAfter", "StepRun"."scheduleTimeoutAt", "StepRun".error, "StepRun"."startedAt", "StepRun"."finishedAt", "StepRun"."timeoutAt", "StepRun"."cancelledAt", "StepRun"."cancelledReason", "StepRun"."cancelledError", "StepRun"."inputSchema", "StepRun"."callerFiles", "StepRun"."gitRepoBranch", "StepRun"."retryCount"
+    "StepRun".id, "StepRun"."createdAt", "StepRun"."updatedAt", "StepRun"."deletedAt", "StepRun"."tenantId", "StepRun"."jobRunId", "StepRun"."stepId", "StepRun"."order", "StepRun"."workerId", "StepRun"."tickerId", "StepRun".status, "StepRun".input, "StepRun".output, "StepRun"."requeueAfter", "StepRun"."scheduleTimeoutAt", "StepRun".error, "StepRun"."startedAt", "StepRun"."finishedAt", "StepRun"."timeoutAt", "StepRun"."cancelledAt", "StepRun"."cancelledReason", "StepRun"."cancelledError", "StepRun"."inputSchema", "StepRun"."callerFiles", "StepRun"."gitRepoBranch", "StepRun"."retryCount", "StepRun"."queuedAt", "StepRun"."slotWaitStartedAt", "StepRun"."assignedAt", "StepRun"."resultPersistedAt", "StepRun"."cancelledSource", "StepRun"."toleratedByJoin", "StepRun"."subStatus"
 FROM
     "StepRun"
 WHERE
@@ -264,13 +269,126 @@ func (q *Queries) GetStepRun(ctx context.Context, db DBTX, arg GetStepRunParams)
 		&i.CallerFiles,
 		&i.GitRepoBranch,
 		&i.RetryCount,
+		&i.QueuedAt,
+		&i.SlotWaitStartedAt,
+		&i.AssignedAt,
+		&i.ResultPersistedAt,
+		&i.CancelledSource,
+		&i.ToleratedByJoin,
+		&i.SubStatus,
+	)
+	return &i, err
+}
+
+const getStepRunCorrelationId = `-- name: GetStepRunCorrelationId :one
+SELECT
+    wr."correlationId"
+FROM
+    "StepRun" sr
+JOIN
+    "JobRun" jr ON sr."jobRunId" = jr."id"
+JOIN
+    "WorkflowRun" wr ON jr."workflowRunId" = wr."id"
+WHERE
+    sr."id" = $1::uuid AND
+    sr."tenantId" = $2::uuid
+`
+
+type GetStepRunCorrelationIdParams struct {
+	Steprunid pgtype.UUID `json:"steprunid"`
+	Tenantid  pgtype.UUID `json:"tenantid"`
+}
+
+// Returns the correlation id of the workflow run of a step run, which is null if the run wasn't triggered by a
+// request with a correlation id.
+func (q *Queries) GetStepRunCorrelationId(ctx context.Context, db DBTX, arg GetStepRunCorrelationIdParams) (pgtype.Text, error) {
+	row := db.QueryRow(ctx, getStepRunCorrelationId, arg.Steprunid, arg.Tenantid)
+	var correlationId pgtype.Text
+	err := row.Scan(&correlationId)
+	return correlationId, err
+}
+
+const getStepRunForAssignment = `-- name: GetStepRunForAssignment :one
+SELECT
+    sr."id",
+    a."id" AS "actionId",
+    s."cpu",
+    s."memoryMb",
+    s."gpu",
+    wr."id" AS "workflowRunId",
+    wr."environment",
+    wr."buildId",
+    wv."pinToBuild",
+    COALESCE(wv."assignmentStrategy", t."assignmentStrategy")::"WorkerAssignmentStrategy" AS "assignmentStrategy",
+    (
+        -- the labels of the workers which ran the parents of the step run
+        SELECT COALESCE(jsonb_agg(pw."labels"), '[]'::jsonb)
+        FROM "_StepRunOrder" order_table
+        JOIN "StepRun" parent_sr ON parent_sr."id" = order_table."A"
+        JOIN "Worker" pw ON pw."id" = parent_sr."workerId"
+        WHERE order_table."B" = sr."id" AND pw."labels" IS NOT NULL
+    )::jsonb AS "parentWorkerLabels"
+FROM
+    "StepRun" sr
+JOIN
+    "Step" s ON sr."stepId" = s."id"
+JOIN
+    "JobRun" jr ON sr."jobRunId" = jr."id"
+JOIN
+    "WorkflowRun" wr ON jr."workflowRunId" = wr."id"
+JOIN
+    "WorkflowVersion" wv ON wr."workflowVersionId" = wv."id"
+JOIN
+    "Tenant" t ON sr."tenantId" = t."id"
+JOIN
+    "Action" a ON s."actionId" = a."actionId" AND a."tenantId" = $1::uuid
+WHERE
+    sr."id" = $2::uuid AND
+    sr."tenantId" = $1::uuid
+FOR UPDATE OF sr
+`
+
+type GetStepRunForAssignmentParams struct {
+	Tenantid  pgtype.UUID `json:"tenantid"`
+	Steprunid pgtype.UUID `json:"steprunid"`
+}
+
+type GetStepRunForAssignmentRow struct {
+	ID                 pgtype.UUID              `json:"id"`
+	ActionId           pgtype.UUID              `json:"actionId"`
+	Cpu                pgtype.Float8            `json:"cpu"`
+	MemoryMb           pgtype.Int4              `json:"memoryMb"`
+	Gpu                pgtype.Int4              `json:"gpu"`
+	WorkflowRunId      pgtype.UUID              `json:"workflowRunId"`
+	Environment        pgtype.Text              `json:"environment"`
+	BuildId            pgtype.Text              `json:"buildId"`
+	PinToBuild         bool                     `json:"pinToBuild"`
+	AssignmentStrategy WorkerAssignmentStrategy `json:"assignmentStrategy"`
+	ParentWorkerLabels []byte                   `json:"parentWorkerLabels"`
+}
+
+func (q *Queries) GetStepRunForAssignment(ctx context.Context, db DBTX, arg GetStepRunForAssignmentParams) (*GetStepRunForAssignmentRow, error) {
+	row := db.QueryRow(ctx, getStepRunForAssignment, arg.Tenantid, arg.Steprunid)
+	var i GetStepRunForAssignmentRow
+	err := row.Scan(
+		&i.ID,
+		&i.ActionId,
+		&i.Cpu,
+		&i.MemoryMb,
+		&i.Gpu,
+		&i.WorkflowRunId,
+		&i.Environment,
+		&i.BuildId,
+		&i.PinToBuild,
+		&i.AssignmentStrategy,
+		&i.ParentWorkerLabels,
 	)
 	return &i, err
 }
 
 const getStepRunForEngine = `-- name: GetStepRunForEngine :many
 SELECT
-    sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount",
+    sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount", sr."queuedAt", sr."slotWaitStartedAt", sr."assignedAt", sr."resultPersistedAt", sr."cancelledSource", sr."toleratedByJoin", sr."subStatus",
     jrld."data" AS "jobRunLookupData",
     -- TODO: everything below this line is cacheable and should be moved to a separate query
     jr."id" AS "jobRunId",
@@ -278,6 +396,10 @@ SELECT
     s."id" AS "stepId",
     s."retries" AS "stepRetries",
     s."scheduleTimeout" AS "stepScheduleTimeout",
+    s."cancelGracePeriod" AS "stepCancelGracePeriod",
+    s."maxTimeout" AS "stepMaxTimeout",
+    s."onTimeoutActionId" AS "stepOnTimeoutActionId",
+    s."joinStrategy" AS "stepJoinStrategy",
     s."readableId" AS "stepReadableId",
     s."customUserData" AS "stepCustomUserData",
     j."name" AS "jobName",
@@ -285,7 +407,8 @@ SELECT
     wv."id" AS "workflowVersionId",
     w."name" AS "workflowName",
     w."id" AS "workflowId",
-    a."actionId" AS "actionId"
+    a."actionId" AS "actionId",
+    wr."additionalMetadata" AS "additionalMetadata"
 FROM
     "StepRun" sr
 JOIN
@@ -315,21 +438,26 @@ type GetStepRunForEngineParams struct {
 }
 
 type GetStepRunForEngineRow struct {
-	StepRun             StepRun     `json:"step_run"`
-	JobRunLookupData    []byte      `json:"jobRunLookupData"`
-	JobRunId            pgtype.UUID `json:"jobRunId"`
-	WorkflowRunId       pgtype.UUID `json:"workflowRunId"`
-	StepId              pgtype.UUID `json:"stepId"`
-	StepRetries         int32       `json:"stepRetries"`
-	StepScheduleTimeout string      `json:"stepScheduleTimeout"`
-	StepReadableId      pgtype.Text `json:"stepReadableId"`
-	StepCustomUserData  []byte      `json:"stepCustomUserData"`
-	JobName             string      `json:"jobName"`
-	JobId               pgtype.UUID `json:"jobId"`
-	WorkflowVersionId   pgtype.UUID `json:"workflowVersionId"`
-	WorkflowName        string      `json:"workflowName"`
-	WorkflowId          pgtype.UUID `json:"workflowId"`
-	ActionId            string      `json:"actionId"`
+	StepRun               StepRun          `json:"step_run"`
+	JobRunLookupData      []byte           `json:"jobRunLookupData"`
+	JobRunId              pgtype.UUID      `json:"jobRunId"`
+	WorkflowRunId         pgtype.UUID      `json:"workflowRunId"`
+	StepId                pgtype.UUID      `json:"stepId"`
+	StepRetries           int32            `json:"stepRetries"`
+	StepScheduleTimeout   string           `json:"stepScheduleTimeout"`
+	StepCancelGracePeriod pgtype.Text      `json:"stepCancelGracePeriod"`
+	StepMaxTimeout        pgtype.Text      `json:"stepMaxTimeout"`
+	StepOnTimeoutActionId pgtype.Text      `json:"stepOnTimeoutActionId"`
+	StepJoinStrategy      StepJoinStrategy `json:"stepJoinStrategy"`
+	StepReadableId        pgtype.Text      `json:"stepReadableId"`
+	StepCustomUserData    []byte           `json:"stepCustomUserData"`
+	JobName               string           `json:"jobName"`
+	JobId                 pgtype.UUID      `json:"jobId"`
+	WorkflowVersionId     pgtype.UUID      `json:"workflowVersionId"`
+	WorkflowName          string           `json:"workflowName"`
+	WorkflowId            pgtype.UUID      `json:"workflowId"`
+	ActionId              string           `json:"actionId"`
+	AdditionalMetadata    []byte           `json:"additionalMetadata"`
 }
 
 func (q *Queries) GetStepRunForEngine(ctx context.Context, db DBTX, arg GetStepRunForEngineParams) ([]*GetStepRunForEngineRow, error) {
@@ -368,12 +496,23 @@ func (q *Queries) GetStepRunForEngine(ctx context.Context, db DBTX, arg GetStepR
 			&i.StepRun.CallerFiles,
 			&i.StepRun.GitRepoBranch,
 			&i.StepRun.RetryCount,
+			&i.StepRun.QueuedAt,
+			&i.StepRun.SlotWaitStartedAt,
+			&i.StepRun.AssignedAt,
+			&i.StepRun.ResultPersistedAt,
+			&i.StepRun.CancelledSource,
+			&i.StepRun.ToleratedByJoin,
+			&i.StepRun.SubStatus,
 			&i.JobRunLookupData,
 			&i.JobRunId,
 			&i.WorkflowRunId,
 			&i.StepId,
 			&i.StepRetries,
 			&i.StepScheduleTimeout,
+			&i.StepCancelGracePeriod,
+			&i.StepMaxTimeout,
+			&i.StepOnTimeoutActionId,
+			&i.StepJoinStrategy,
 			&i.StepReadableId,
 			&i.StepCustomUserData,
 			&i.JobName,
@@ -382,6 +521,435 @@ func (q *Queries) GetStepRunForEngine(ctx context.Context, db DBTX, arg GetStepR
 			&i.WorkflowName,
 			&i.WorkflowId,
 			&i.ActionId,
+			&i.AdditionalMetadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWorkerForStepRunTimeoutHook = `-- name: GetWorkerForStepRunTimeoutHook :one
+WITH step_run AS (
+    SELECT
+        s."onTimeoutActionId",
+        wr."environment"
+    FROM
+        "StepRun" sr
+    JOIN
+        "Step" s ON sr."stepId" = s."id"
+    JOIN
+        "JobRun" jr ON sr."jobRunId" = jr."id"
+    JOIN
+        "WorkflowRun" wr ON jr."workflowRunId" = wr."id"
+    WHERE
+        sr."id" = $1::uuid AND
+        sr."tenantId" = $2::uuid AND
+        s."onTimeoutActionId" IS NOT NULL
+)
+SELECT
+    step_run."onTimeoutActionId"::text AS "actionId",
+    selected_worker."id" AS "workerId",
+    selected_worker."dispatcherId" AS "dispatcherId"
+FROM
+    step_run
+LEFT JOIN LATERAL (
+    SELECT
+        w."id",
+        w."dispatcherId"
+    FROM
+        "Worker" w
+    WHERE
+        w."tenantId" = $2::uuid
+        AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
+        AND w."dispatcherId" IS NOT NULL
+        AND w."id" IN (
+            SELECT "_ActionToWorker"."B"
+            FROM "_ActionToWorker"
+            INNER JOIN "Action" ON "Action"."id" = "_ActionToWorker"."A"
+            WHERE "Action"."tenantId" = $2::uuid AND "Action"."actionId" = step_run."onTimeoutActionId"
+        )
+        -- hooks only run on workers of the environment of their step run
+        AND w."environment" IS NOT DISTINCT FROM step_run."environment"
+        -- workers which speak protocol version 1 don't understand timeout hook actions
+        AND w."protocolVersion" >= 2
+    ORDER BY random()
+    LIMIT 1
+) selected_worker ON true
+`
+
+type GetWorkerForStepRunTimeoutHookParams struct {
+	Steprunid pgtype.UUID `json:"steprunid"`
+	Tenantid  pgtype.UUID `json:"tenantid"`
+}
+
+type GetWorkerForStepRunTimeoutHookRow struct {
+	ActionId     string      `json:"actionId"`
+	WorkerId     pgtype.UUID `json:"workerId"`
+	DispatcherId pgtype.UUID `json:"dispatcherId"`
+}
+
+// Returns the on-timeout action of the step of a step run, along with a random worker of the environment of the
+// step run which registered the action. Timeout hooks don't take up a slot, so the worker may be at capacity. The
+// worker id is null if no worker is available, and no row is returned if the step has no on-timeout action.
+func (q *Queries) GetWorkerForStepRunTimeoutHook(ctx context.Context, db DBTX, arg GetWorkerForStepRunTimeoutHookParams) (*GetWorkerForStepRunTimeoutHookRow, error) {
+	row := db.QueryRow(ctx, getWorkerForStepRunTimeoutHook, arg.Steprunid, arg.Tenantid)
+	var i GetWorkerForStepRunTimeoutHookRow
+	err := row.Scan(&i.ActionId, &i.WorkerId, &i.DispatcherId)
+	return &i, err
+}
+
+const listCancellableStepRuns = `-- name: ListCancellableStepRuns :many
+SELECT
+    sr."id"
+FROM
+    "StepRun" sr
+JOIN
+    "JobRun" jr ON jr."id" = sr."jobRunId"
+JOIN
+    "WorkflowRun" wr ON wr."id" = jr."workflowRunId"
+JOIN
+    "WorkflowVersion" wv ON wv."id" = wr."workflowVersionId"
+WHERE
+    sr."tenantId" = $1::uuid
+    AND sr."deletedAt" IS NULL
+    AND (
+        $2::uuid IS NULL OR
+        wv."workflowId" = $2::uuid
+    )
+    AND (
+        sr."status" IN ('PENDING_ASSIGNMENT', 'WAITING_ON_DEPENDENCY', 'ASSIGNED', 'RUNNING') OR
+        (
+            sr."status" = 'PENDING' AND
+            NOT EXISTS (
+                SELECT 1
+                FROM "_StepRunOrder" AS step_run_order
+                WHERE step_run_order."B" = sr."id"
+            )
+        )
+    )
+`
+
+type ListCancellableStepRunsParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	WorkflowId pgtype.UUID `json:"workflowId"`
+}
+
+// Returns the step runs which are cancelled to cancel the unfinished runs of a tenant, or of one of its workflows.
+// Pending step runs are only returned if they have no parents, since the later step runs of a job run are
+// cancelled along with the step run before them.
+func (q *Queries) ListCancellableStepRuns(ctx context.Context, db DBTX, arg ListCancellableStepRunsParams) ([]pgtype.UUID, error) {
+	rows, err := db.Query(ctx, listCancellableStepRuns, arg.Tenantid, arg.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCostCenterUsage = `-- name: ListCostCenterUsage :many
+SELECT
+    COALESCE(s."costCenter", wv."costCenter", '')::text AS "costCenter",
+    date_trunc($1::text, sr."resultPersistedAt")::timestamp AS "bucket",
+    -- worker clocks may be skewed, so durations are clamped to zero
+    SUM(GREATEST(EXTRACT(EPOCH FROM (sr."finishedAt" - sr."startedAt")), 0))::float8 AS "computeSeconds",
+    COUNT(*) AS "stepRunCount",
+    COUNT(DISTINCT jr."workflowRunId") AS "workflowRunCount"
+FROM
+    "StepRun" sr
+JOIN
+    "Step" s ON sr."stepId" = s."id"
+JOIN
+    "JobRun" jr ON sr."jobRunId" = jr."id"
+JOIN
+    "WorkflowRun" wr ON jr."workflowRunId" = wr."id"
+JOIN
+    "WorkflowVersion" wv ON wr."workflowVersionId" = wv."id"
+WHERE
+    sr."tenantId" = $2::uuid
+    AND sr."deletedAt" IS NULL
+    AND sr."resultPersistedAt" >= $3::timestamp
+    AND sr."resultPersistedAt" < $4::timestamp
+    AND sr."startedAt" IS NOT NULL
+    AND sr."finishedAt" IS NOT NULL
+    AND (
+        $5::text IS NULL OR
+        COALESCE(s."costCenter", wv."costCenter", '') = $5::text
+    )
+GROUP BY
+    1, 2
+ORDER BY
+    "bucket" ASC,
+    "costCenter" ASC
+`
+
+type ListCostCenterUsageParams struct {
+	Bucket     string           `json:"bucket"`
+	Tenantid   pgtype.UUID      `json:"tenantid"`
+	Since      pgtype.Timestamp `json:"since"`
+	Until      pgtype.Timestamp `json:"until"`
+	CostCenter pgtype.Text      `json:"costCenter"`
+}
+
+type ListCostCenterUsageRow struct {
+	CostCenter       string           `json:"costCenter"`
+	Bucket           pgtype.Timestamp `json:"bucket"`
+	ComputeSeconds   float64          `json:"computeSeconds"`
+	StepRunCount     int64            `json:"stepRunCount"`
+	WorkflowRunCount int64            `json:"workflowRunCount"`
+}
+
+// Returns the compute seconds and run counts of the step runs of a tenant which finished in a time range, grouped
+// by cost center and time bucket. Step runs are attributed to the cost center of their step, or else the cost
+// center of their workflow version.
+func (q *Queries) ListCostCenterUsage(ctx context.Context, db DBTX, arg ListCostCenterUsageParams) ([]*ListCostCenterUsageRow, error) {
+	rows, err := db.Query(ctx, listCostCenterUsage,
+		arg.Bucket,
+		arg.Tenantid,
+		arg.Since,
+		arg.Until,
+		arg.CostCenter,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListCostCenterUsageRow
+	for rows.Next() {
+		var i ListCostCenterUsageRow
+		if err := rows.Scan(
+			&i.CostCenter,
+			&i.Bucket,
+			&i.ComputeSeconds,
+			&i.StepRunCount,
+			&i.WorkflowRunCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStepRunPhaseMetrics = `-- name: ListStepRunPhaseMetrics :many
+WITH phases AS (
+    SELECT
+        s."actionId",
+        -- worker clocks may be skewed, so phases which rely on worker timestamps are clamped to zero
+        GREATEST(EXTRACT(EPOCH FROM (COALESCE(sr."slotWaitStartedAt", sr."assignedAt") - sr."queuedAt")) * 1000, 0)::float8 AS "queueMs",
+        GREATEST(EXTRACT(EPOCH FROM (sr."assignedAt" - COALESCE(sr."slotWaitStartedAt", sr."assignedAt"))) * 1000, 0)::float8 AS "slotWaitMs",
+        GREATEST(EXTRACT(EPOCH FROM (sr."startedAt" - sr."assignedAt")) * 1000, 0)::float8 AS "dispatchMs",
+        GREATEST(EXTRACT(EPOCH FROM (sr."finishedAt" - sr."startedAt")) * 1000, 0)::float8 AS "executionMs",
+        GREATEST(EXTRACT(EPOCH FROM (sr."resultPersistedAt" - sr."finishedAt")) * 1000, 0)::float8 AS "persistenceMs"
+    FROM
+        "StepRun" sr
+    JOIN
+        "Step" s ON sr."stepId" = s."id"
+    WHERE
+        sr."tenantId" = $1::uuid
+        AND sr."deletedAt" IS NULL
+        AND sr."resultPersistedAt" >= $2::timestamp
+        -- only step runs with a full timeline are included
+        AND sr."queuedAt" IS NOT NULL
+        AND sr."assignedAt" IS NOT NULL
+        AND sr."startedAt" IS NOT NULL
+        AND sr."finishedAt" IS NOT NULL
+)
+SELECT
+    "actionId",
+    COUNT(*) AS "count",
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY "queueMs")::float8 AS "queueP50",
+    percentile_cont(0.95) WITHIN GROUP (ORDER BY "queueMs")::float8 AS "queueP95",
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY "slotWaitMs")::float8 AS "slotWaitP50",
+    percentile_cont(0.95) WITHIN GROUP (ORDER BY "slotWaitMs")::float8 AS "slotWaitP95",
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY "dispatchMs")::float8 AS "dispatchP50",
+    percentile_cont(0.95) WITHIN GROUP (ORDER BY "dispatchMs")::float8 AS "dispatchP95",
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY "executionMs")::float8 AS "executionP50",
+    percentile_cont(0.95) WITHIN GROUP (ORDER BY "executionMs")::float8 AS "executionP95",
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY "persistenceMs")::float8 AS "persistenceP50",
+    percentile_cont(0.95) WITHIN GROUP (ORDER BY "persistenceMs")::float8 AS "persistenceP95"
+FROM
+    phases
+GROUP BY
+    "actionId"
+ORDER BY
+    "actionId" ASC
+`
+
+type ListStepRunPhaseMetricsParams struct {
+	Tenantid pgtype.UUID      `json:"tenantid"`
+	Since    pgtype.Timestamp `json:"since"`
+}
+
+type ListStepRunPhaseMetricsRow struct {
+	ActionId       string  `json:"actionId"`
+	Count          int64   `json:"count"`
+	QueueP50       float64 `json:"queueP50"`
+	QueueP95       float64 `json:"queueP95"`
+	SlotWaitP50    float64 `json:"slotWaitP50"`
+	SlotWaitP95    float64 `json:"slotWaitP95"`
+	DispatchP50    float64 `json:"dispatchP50"`
+	DispatchP95    float64 `json:"dispatchP95"`
+	ExecutionP50   float64 `json:"executionP50"`
+	ExecutionP95   float64 `json:"executionP95"`
+	PersistenceP50 float64 `json:"persistenceP50"`
+	PersistenceP95 float64 `json:"persistenceP95"`
+}
+
+func (q *Queries) ListStepRunPhaseMetrics(ctx context.Context, db DBTX, arg ListStepRunPhaseMetricsParams) ([]*ListStepRunPhaseMetricsRow, error) {
+	rows, err := db.Query(ctx, listStepRunPhaseMetrics, arg.Tenantid, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListStepRunPhaseMetricsRow
+	for rows.Next() {
+		var i ListStepRunPhaseMetricsRow
+		if err := rows.Scan(
+			&i.ActionId,
+			&i.Count,
+			&i.QueueP50,
+			&i.QueueP95,
+			&i.SlotWaitP50,
+			&i.SlotWaitP95,
+			&i.DispatchP50,
+			&i.DispatchP95,
+			&i.ExecutionP50,
+			&i.ExecutionP95,
+			&i.PersistenceP50,
+			&i.PersistenceP95,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStepRunQueues = `-- name: ListStepRunQueues :many
+SELECT
+    sr."tenantId" AS "tenantId",
+    s."actionId" AS "actionId",
+    COUNT(sr."id") AS "pendingAssignment",
+    MIN(sr."createdAt")::timestamp AS "oldestCreatedAt"
+FROM
+    "StepRun" sr
+JOIN
+    "Step" s ON sr."stepId" = s."id"
+WHERE
+    sr."status" = 'PENDING_ASSIGNMENT'
+    AND sr."deletedAt" IS NULL
+GROUP BY
+    sr."tenantId", s."actionId"
+ORDER BY
+    "pendingAssignment" DESC
+`
+
+type ListStepRunQueuesRow struct {
+	TenantId          pgtype.UUID      `json:"tenantId"`
+	ActionId          string           `json:"actionId"`
+	PendingAssignment int64            `json:"pendingAssignment"`
+	OldestCreatedAt   pgtype.Timestamp `json:"oldestCreatedAt"`
+}
+
+func (q *Queries) ListStepRunQueues(ctx context.Context, db DBTX) ([]*ListStepRunQueuesRow, error) {
+	rows, err := db.Query(ctx, listStepRunQueues)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListStepRunQueuesRow
+	for rows.Next() {
+		var i ListStepRunQueuesRow
+		if err := rows.Scan(
+			&i.TenantId,
+			&i.ActionId,
+			&i.PendingAssignment,
+			&i.OldestCreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStepRunsForJoinResolution = `-- name: ListStepRunsForJoinResolution :many
+SELECT
+    sr."id",
+    sr."status",
+    sr."toleratedByJoin",
+    s."joinStrategy",
+    s."joinQuorum",
+    array_remove(array_agg(step_run_order."A"), NULL)::uuid[] AS "parentIds"
+FROM
+    "StepRun" sr
+JOIN
+    "Step" s ON sr."stepId" = s."id"
+LEFT JOIN
+    "_StepRunOrder" AS step_run_order ON step_run_order."B" = sr."id"
+WHERE
+    sr."jobRunId" = $1::uuid AND
+    sr."tenantId" = $2::uuid AND
+    sr."deletedAt" IS NULL
+GROUP BY
+    sr."id", s."joinStrategy", s."joinQuorum"
+`
+
+type ListStepRunsForJoinResolutionParams struct {
+	Jobrunid pgtype.UUID `json:"jobrunid"`
+	Tenantid pgtype.UUID `json:"tenantid"`
+}
+
+type ListStepRunsForJoinResolutionRow struct {
+	ID              pgtype.UUID      `json:"id"`
+	Status          StepRunStatus    `json:"status"`
+	ToleratedByJoin bool             `json:"toleratedByJoin"`
+	JoinStrategy    StepJoinStrategy `json:"joinStrategy"`
+	JoinQuorum      pgtype.Int4      `json:"joinQuorum"`
+	ParentIds       []pgtype.UUID    `json:"parentIds"`
+}
+
+func (q *Queries) ListStepRunsForJoinResolution(ctx context.Context, db DBTX, arg ListStepRunsForJoinResolutionParams) ([]*ListStepRunsForJoinResolutionRow, error) {
+	rows, err := db.Query(ctx, listStepRunsForJoinResolution, arg.Jobrunid, arg.Tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListStepRunsForJoinResolutionRow
+	for rows.Next() {
+		var i ListStepRunsForJoinResolutionRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Status,
+			&i.ToleratedByJoin,
+			&i.JoinStrategy,
+			&i.JoinQuorum,
+			&i.ParentIds,
 		); err != nil {
 			return nil, err
 		}
@@ -395,7 +963,7 @@ func (q *Queries) GetStepRunForEngine(ctx context.Context, db DBTX, arg GetStepR
 
 const listStepRunsToReassign = `-- name: ListStepRunsToReassign :many
 SELECT
-    sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount"
+    sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount", sr."queuedAt", sr."slotWaitStartedAt", sr."assignedAt", sr."resultPersistedAt", sr."cancelledSource", sr."toleratedByJoin", sr."subStatus"
 FROM
     "StepRun" sr
 LEFT JOIN
@@ -461,6 +1029,13 @@ func (q *Queries) ListStepRunsToReassign(ctx context.Context, db DBTX, tenantid
 			&i.CallerFiles,
 			&i.GitRepoBranch,
 			&i.RetryCount,
+			&i.QueuedAt,
+			&i.SlotWaitStartedAt,
+			&i.AssignedAt,
+			&i.ResultPersistedAt,
+			&i.CancelledSource,
+			&i.ToleratedByJoin,
+			&i.SubStatus,
 		); err != nil {
 			return nil, err
 		}
@@ -474,7 +1049,7 @@ func (q *Queries) ListStepRunsToReassign(ctx context.Context, db DBTX, tenantid
 
 const listStepRunsToRequeue = `-- name: ListStepRunsToRequeue :many
 SELECT
-    sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount"
+    sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount", sr."queuedAt", sr."slotWaitStartedAt", sr."assignedAt", sr."resultPersistedAt", sr."cancelledSource", sr."toleratedByJoin", sr."subStatus"
 FROM
     "StepRun" sr
 LEFT JOIN
@@ -484,7 +1059,7 @@ JOIN
 WHERE
     sr."tenantId" = $1::uuid
     AND sr."requeueAfter" < NOW()
-    AND (sr."status" = 'PENDING' OR sr."status" = 'PENDING_ASSIGNMENT')
+    AND (sr."status" = 'PENDING' OR sr."status" = 'PENDING_ASSIGNMENT' OR sr."status" = 'WAITING_ON_DEPENDENCY')
     AND jr."status" = 'RUNNING'
     AND NOT EXISTS (
         SELECT 1
@@ -534,6 +1109,237 @@ func (q *Queries) ListStepRunsToRequeue(ctx context.Context, db DBTX, tenantid p
 			&i.CallerFiles,
 			&i.GitRepoBranch,
 			&i.RetryCount,
+			&i.QueuedAt,
+			&i.SlotWaitStartedAt,
+			&i.AssignedAt,
+			&i.ResultPersistedAt,
+			&i.CancelledSource,
+			&i.ToleratedByJoin,
+			&i.SubStatus,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStepRunsWaitingForWorker = `-- name: ListStepRunsWaitingForWorker :many
+SELECT
+    s."actionId",
+    COUNT(*) AS "count",
+    MIN(sr."slotWaitStartedAt")::timestamp AS "waitingSince"
+FROM
+    "StepRun" sr
+JOIN
+    "Step" s ON sr."stepId" = s."id"
+WHERE
+    sr."tenantId" = $1::uuid
+    AND sr."deletedAt" IS NULL
+    AND sr."status" = 'PENDING_ASSIGNMENT'
+    AND sr."subStatus" = 'WAITING_FOR_WORKER'
+GROUP BY
+    s."actionId"
+ORDER BY
+    "count" DESC,
+    s."actionId" ASC
+`
+
+type ListStepRunsWaitingForWorkerRow struct {
+	ActionId     string           `json:"actionId"`
+	Count        int64            `json:"count"`
+	WaitingSince pgtype.Timestamp `json:"waitingSince"`
+}
+
+func (q *Queries) ListStepRunsWaitingForWorker(ctx context.Context, db DBTX, tenantid pgtype.UUID) ([]*ListStepRunsWaitingForWorkerRow, error) {
+	rows, err := db.Query(ctx, listStepRunsWaitingForWorker, tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListStepRunsWaitingForWorkerRow
+	for rows.Next() {
+		var i ListStepRunsWaitingForWorkerRow
+		if err := rows.Scan(&i.ActionId, &i.Count, &i.WaitingSince); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkersForAssignment = `-- name: ListWorkersForAssignment :many
+SELECT
+    w."id",
+    w."dispatcherId",
+    w."buildId",
+    w."labels",
+    w."lastAssignedAt",
+    (
+        SELECT COUNT(*)
+        FROM "StepRun" srs
+        WHERE srs."workerId" = w."id" AND srs."status" IN ('ASSIGNED', 'RUNNING')
+    ) AS "load"
+FROM
+    "Worker" w
+WHERE
+    w."tenantId" = $1::uuid
+    AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
+    AND w."id" IN (
+        SELECT "_ActionToWorker"."B"
+        FROM "_ActionToWorker"
+        INNER JOIN "Action" ON "Action"."id" = "_ActionToWorker"."A"
+        WHERE "Action"."tenantId" = $1 AND "Action"."id" = $2::uuid
+    )
+    AND (
+        w."maxRuns" IS NULL OR
+        w."maxRuns" > (
+            SELECT COUNT(*)
+            FROM "StepRun" srs
+            WHERE srs."workerId" = w."id" AND srs."status" = 'RUNNING'
+        )
+    )
+    -- workers which do not advertise a resource are not filtered by it
+    AND ($3::float8 IS NULL OR w."cpu" IS NULL OR w."cpu" >= $3::float8)
+    AND ($4::int IS NULL OR w."memoryMb" IS NULL OR w."memoryMb" >= $4::int)
+    AND ($5::int IS NULL OR w."gpu" IS NULL OR w."gpu" >= $5::int)
+    -- runs are only assigned to workers of their environment
+    AND w."environment" IS NOT DISTINCT FROM $6::text
+    -- pinned runs are only assigned to workers of the build which started them
+    AND ($7::text IS NULL OR w."buildId" = $7::text)
+FOR UPDATE SKIP LOCKED
+`
+
+type ListWorkersForAssignmentParams struct {
+	Tenantid    pgtype.UUID   `json:"tenantid"`
+	Actionid    pgtype.UUID   `json:"actionid"`
+	Cpu         pgtype.Float8 `json:"cpu"`
+	MemoryMb    pgtype.Int4   `json:"memoryMb"`
+	Gpu         pgtype.Int4   `json:"gpu"`
+	Environment pgtype.Text   `json:"environment"`
+	BuildId     pgtype.Text   `json:"buildId"`
+}
+
+type ListWorkersForAssignmentRow struct {
+	ID             pgtype.UUID      `json:"id"`
+	DispatcherId   pgtype.UUID      `json:"dispatcherId"`
+	BuildId        pgtype.Text      `json:"buildId"`
+	Labels         []byte           `json:"labels"`
+	LastAssignedAt pgtype.Timestamp `json:"lastAssignedAt"`
+	Load           int64            `json:"load"`
+}
+
+func (q *Queries) ListWorkersForAssignment(ctx context.Context, db DBTX, arg ListWorkersForAssignmentParams) ([]*ListWorkersForAssignmentRow, error) {
+	rows, err := db.Query(ctx, listWorkersForAssignment,
+		arg.Tenantid,
+		arg.Actionid,
+		arg.Cpu,
+		arg.MemoryMb,
+		arg.Gpu,
+		arg.Environment,
+		arg.BuildId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkersForAssignmentRow
+	for rows.Next() {
+		var i ListWorkersForAssignmentRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.DispatcherId,
+			&i.BuildId,
+			&i.Labels,
+			&i.LastAssignedAt,
+			&i.Load,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markStepRunsWaitingForWorker = `-- name: MarkStepRunsWaitingForWorker :many
+WITH waiting_runs AS (
+    SELECT
+        sr."id"
+    FROM
+        "StepRun" sr
+    WHERE
+        sr."status" = 'PENDING_ASSIGNMENT' AND
+        sr."subStatus" IS NULL AND
+        sr."deletedAt" IS NULL AND
+        sr."slotWaitStartedAt" < NOW() - make_interval(secs => $1::float8)
+    ORDER BY
+        sr."slotWaitStartedAt" ASC
+    LIMIT
+        $2::int
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE
+    "StepRun" sr
+SET
+    "subStatus" = 'WAITING_FOR_WORKER'
+FROM
+    waiting_runs,
+    "Step" s,
+    "JobRun" jr
+WHERE
+    sr."id" = waiting_runs."id" AND
+    s."id" = sr."stepId" AND
+    jr."id" = sr."jobRunId"
+RETURNING
+    sr."id",
+    sr."tenantId",
+    sr."slotWaitStartedAt",
+    s."actionId",
+    s."readableId" AS "stepReadableId",
+    jr."workflowRunId"
+`
+
+type MarkStepRunsWaitingForWorkerParams struct {
+	Thresholdseconds float64 `json:"thresholdseconds"`
+	Limit            int32   `json:"limit"`
+}
+
+type MarkStepRunsWaitingForWorkerRow struct {
+	ID                pgtype.UUID      `json:"id"`
+	TenantId          pgtype.UUID      `json:"tenantId"`
+	SlotWaitStartedAt pgtype.Timestamp `json:"slotWaitStartedAt"`
+	ActionId          string           `json:"actionId"`
+	StepReadableId    pgtype.Text      `json:"stepReadableId"`
+	WorkflowRunId     pgtype.UUID      `json:"workflowRunId"`
+}
+
+// Marks up to limit step runs, across all tenants, which have waited for a worker with a free slot for longer than
+// the threshold. Step runs which are already marked are skipped, so each step run is returned once per attempt.
+func (q *Queries) MarkStepRunsWaitingForWorker(ctx context.Context, db DBTX, arg MarkStepRunsWaitingForWorkerParams) ([]*MarkStepRunsWaitingForWorkerRow, error) {
+	rows, err := db.Query(ctx, markStepRunsWaitingForWorker, arg.Thresholdseconds, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*MarkStepRunsWaitingForWorkerRow
+	for rows.Next() {
+		var i MarkStepRunsWaitingForWorkerRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantId,
+			&i.SlotWaitStartedAt,
+			&i.ActionId,
+			&i.StepReadableId,
+			&i.WorkflowRunId,
 		); err != nil {
 			return nil, err
 		}
@@ -545,9 +1351,87 @@ func (q *Queries) ListStepRunsToRequeue(ctx context.Context, db DBTX, tenantid p
 	return items, nil
 }
 
+const pinWorkflowRunToBuild = `-- name: PinWorkflowRunToBuild :exec
+UPDATE
+    "WorkflowRun"
+SET
+    "buildId" = $1::text
+WHERE
+    "id" = $2::uuid AND
+    "tenantId" = $3::uuid AND
+    "buildId" IS NULL
+`
+
+type PinWorkflowRunToBuildParams struct {
+	Buildid       string      `json:"buildid"`
+	Workflowrunid pgtype.UUID `json:"workflowrunid"`
+	Tenantid      pgtype.UUID `json:"tenantid"`
+}
+
+func (q *Queries) PinWorkflowRunToBuild(ctx context.Context, db DBTX, arg PinWorkflowRunToBuildParams) error {
+	_, err := db.Exec(ctx, pinWorkflowRunToBuild, arg.Buildid, arg.Workflowrunid, arg.Tenantid)
+	return err
+}
+
+const releaseStepRun = `-- name: ReleaseStepRun :one
+UPDATE
+    "StepRun" sr
+SET
+    "status" = 'PENDING_ASSIGNMENT',
+    "workerId" = NULL,
+    "requeueAfter" = $1::timestamp,
+    "scheduleTimeoutAt" = $1::timestamp + s."scheduleTimeout"::interval,
+    "queuedAt" = CURRENT_TIMESTAMP,
+    "slotWaitStartedAt" = NULL,
+    "assignedAt" = NULL,
+    "startedAt" = NULL,
+    "timeoutAt" = NULL,
+    "subStatus" = NULL,
+    "updatedAt" = CURRENT_TIMESTAMP
+FROM
+    "Step" s
+WHERE
+    sr."tenantId" = $2::uuid AND
+    sr."id" = $3::uuid AND
+    sr."workerId" = $4::uuid AND
+    sr."status" = 'RUNNING' AND
+    sr."cancelledAt" IS NULL AND
+    s."id" = sr."stepId"
+RETURNING
+    sr."id",
+    sr."tickerId"
+`
+
+type ReleaseStepRunParams struct {
+	Requeueafter pgtype.Timestamp `json:"requeueafter"`
+	Tenantid     pgtype.UUID      `json:"tenantid"`
+	Steprunid    pgtype.UUID      `json:"steprunid"`
+	Workerid     pgtype.UUID      `json:"workerid"`
+}
+
+type ReleaseStepRunRow struct {
+	ID       pgtype.UUID `json:"id"`
+	TickerId pgtype.UUID `json:"tickerId"`
+}
+
+// Moves a step run which its worker released back to pending assignment, which frees the slot of the worker. The
+// step run is requeued once the requeue after time passed, and its schedule timeout starts at that time. This
+// doesn't count as a retry of the step run.
+func (q *Queries) ReleaseStepRun(ctx context.Context, db DBTX, arg ReleaseStepRunParams) (*ReleaseStepRunRow, error) {
+	row := db.QueryRow(ctx, releaseStepRun,
+		arg.Requeueafter,
+		arg.Tenantid,
+		arg.Steprunid,
+		arg.Workerid,
+	)
+	var i ReleaseStepRunRow
+	err := row.Scan(&i.ID, &i.TickerId)
+	return &i, err
+}
+
 const resolveLaterStepRuns = `-- name: ResolveLaterStepRuns :many
 WITH currStepRun AS (
-  SELECT id, "createdAt", "updatedAt", "deletedAt", "tenantId", "jobRunId", "stepId", "order", "workerId", "tickerId", status, input, output, "requeueAfter", "scheduleTimeoutAt", error, "startedAt", "finishedAt", "timeoutAt", "cancelledAt", "cancelledReason", "cancelledError", "inputSchema", "callerFiles", "gitRepoBranch", "retryCount"
+  SELECT id, "createdAt", "updatedAt", "deletedAt", "tenantId", "jobRunId", "stepId", "order", "workerId", "tickerId", status, input, output, "requeueAfter", "scheduleTimeoutAt", error, "startedAt", "finishedAt", "timeoutAt", "cancelledAt", "cancelledReason", "cancelledError", "inputSchema", "callerFiles", "gitRepoBranch", "retryCount", "queuedAt", "slotWaitStartedAt", "assignedAt", "resultPersistedAt", "cancelledSource", "toleratedByJoin", "subStatus"
   FROM "StepRun"
   WHERE
     "id" = $1::uuid AND
@@ -565,6 +1449,10 @@ SET "status" = CASE
     WHEN (cs."status" = 'CANCELLED' AND cs."cancelledReason" = 'TIMED_OUT'::text) THEN 'PREVIOUS_STEP_TIMED_OUT'
     WHEN (cs."status" = 'CANCELLED') THEN 'PREVIOUS_STEP_CANCELLED'
     ELSE NULL
+    END,
+    "cancelledSource" = CASE
+    WHEN (cs."status" = 'CANCELLED') THEN 'PARENT_CANCELLED'::"CancellationSource"
+    ELSE NULL
     END
 FROM
     currStepRun cs
@@ -579,8 +1467,18 @@ WHERE
         FROM "StepRun"
         WHERE "id" = $1::uuid
     ) AND
-    sr."tenantId" = $2::uuid
-RETURNING sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount"
+    sr."tenantId" = $2::uuid AND
+    -- job runs with join steps are resolved along the edges between their step runs instead, so that a failed
+    -- branch of a join step doesn't cancel the other branches
+    NOT EXISTS (
+        SELECT 1
+        FROM "StepRun" AS join_run
+        JOIN "Step" AS join_step ON join_step."id" = join_run."stepId"
+        WHERE
+            join_run."jobRunId" = sr."jobRunId" AND
+            join_step."joinStrategy" != 'ALL'
+    )
+RETURNING sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount", sr."queuedAt", sr."slotWaitStartedAt", sr."assignedAt", sr."resultPersistedAt", sr."cancelledSource", sr."toleratedByJoin", sr."subStatus"
 `
 
 type ResolveLaterStepRunsParams struct {
@@ -624,6 +1522,13 @@ func (q *Queries) ResolveLaterStepRuns(ctx context.Context, db DBTX, arg Resolve
 			&i.CallerFiles,
 			&i.GitRepoBranch,
 			&i.RetryCount,
+			&i.QueuedAt,
+			&i.SlotWaitStartedAt,
+			&i.AssignedAt,
+			&i.ResultPersistedAt,
+			&i.CancelledSource,
+			&i.ToleratedByJoin,
+			&i.SubStatus,
 		); err != nil {
 			return nil, err
 		}
@@ -635,6 +1540,27 @@ func (q *Queries) ResolveLaterStepRuns(ctx context.Context, db DBTX, arg Resolve
 	return items, nil
 }
 
+const setStepRunSlotWaitStarted = `-- name: SetStepRunSlotWaitStarted :exec
+UPDATE
+    "StepRun"
+SET
+    "slotWaitStartedAt" = COALESCE("slotWaitStartedAt", CURRENT_TIMESTAMP)
+WHERE
+    "id" = $1::uuid AND
+    "tenantId" = $2::uuid AND
+    "status" = 'PENDING_ASSIGNMENT'
+`
+
+type SetStepRunSlotWaitStartedParams struct {
+	Steprunid pgtype.UUID `json:"steprunid"`
+	Tenantid  pgtype.UUID `json:"tenantid"`
+}
+
+func (q *Queries) SetStepRunSlotWaitStarted(ctx context.Context, db DBTX, arg SetStepRunSlotWaitStartedParams) error {
+	_, err := db.Exec(ctx, setStepRunSlotWaitStarted, arg.Steprunid, arg.Tenantid)
+	return err
+}
+
 const updateStepRun = `-- name: UpdateStepRun :one
 UPDATE
     "StepRun"
@@ -675,28 +1601,60 @@ SET
         WHEN $4::boolean THEN NULL
         ELSE COALESCE($11::text, "cancelledReason")
     END,
-    "retryCount" = COALESCE($12::int, "retryCount")
+    "cancelledSource" = CASE
+        -- if this is a rerun, we clear the cancelledSource
+        WHEN $4::boolean THEN NULL
+        ELSE COALESCE($12::"CancellationSource", "cancelledSource")
+    END,
+    "retryCount" = COALESCE($13::int, "retryCount"),
+    -- queueing the step run for assignment starts a new attempt, so we reset the timeline of the attempt
+    "queuedAt" = CASE
+        WHEN $6::"StepRunStatus" = 'PENDING_ASSIGNMENT' AND "status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN CURRENT_TIMESTAMP
+        ELSE "queuedAt"
+    END,
+    "slotWaitStartedAt" = CASE
+        WHEN $6::"StepRunStatus" = 'PENDING_ASSIGNMENT' AND "status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN NULL
+        ELSE "slotWaitStartedAt"
+    END,
+    "assignedAt" = CASE
+        WHEN $6::"StepRunStatus" = 'PENDING_ASSIGNMENT' AND "status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN NULL
+        ELSE "assignedAt"
+    END,
+    -- a status update means the step run is no longer waiting for a worker
+    "subStatus" = CASE
+        WHEN $6::"StepRunStatus" IS NOT NULL THEN NULL
+        ELSE "subStatus"
+    END,
+    "resultPersistedAt" = CASE
+        -- if this is a rerun, we clear the resultPersistedAt
+        WHEN $4::boolean THEN NULL
+        WHEN "status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN "resultPersistedAt"
+        WHEN $6::"StepRunStatus" = 'PENDING_ASSIGNMENT' THEN NULL
+        WHEN $5::timestamp IS NOT NULL THEN CURRENT_TIMESTAMP
+        ELSE "resultPersistedAt"
+    END
 WHERE 
-  "id" = $13::uuid AND
-  "tenantId" = $14::uuid
-RETURNING "StepRun".id, "StepRun"."createdAt", "StepRun"."updatedAt", "StepRun"."deletedAt", "StepRun"."tenantId", "StepRun"."jobRunId", "StepRun"."stepId", "StepRun"."order", "StepRun"."workerId", "StepRun"."tickerId", "StepRun".status, "StepRun".input, "StepRun".output, "StepRun"."requeueAfter", "StepRun"."scheduleTimeoutAt", "StepRun".error, "StepRun"."startedAt", "StepRun"."finishedAt", "StepRun"."timeoutAt", "StepRun"."cancelledAt", "StepRun"."cancelledReason", "StepRun"."cancelledError", "StepRun"."inputSchema", "StepRun"."callerFiles", "StepRun"."gitRepoBranch", "StepRun"."retryCount"
+  "id" = $14::uuid AND
+  "tenantId" = $15::uuid
+RETURNING "StepRun".id, "StepRun"."createdAt", "StepRun"."updatedAt", "StepRun"."deletedAt", "StepRun"."tenantId", "StepRun"."jobRunId", "StepRun"."stepId", "StepRun"."order", "StepRun"."workerId", "StepRun"."tickerId", "StepRun".status, "StepRun".input, "StepRun".output, "StepRun"."requeueAfter", "StepRun"."scheduleTimeoutAt", "StepRun".error, "StepRun"."startedAt", "StepRun"."finishedAt", "StepRun"."timeoutAt", "StepRun"."cancelledAt", "StepRun"."cancelledReason", "StepRun"."cancelledError", "StepRun"."inputSchema", "StepRun"."callerFiles", "StepRun"."gitRepoBranch", "StepRun"."retryCount", "StepRun"."queuedAt", "StepRun"."slotWaitStartedAt", "StepRun"."assignedAt", "StepRun"."resultPersistedAt", "StepRun"."cancelledSource", "StepRun"."toleratedByJoin", "StepRun"."subStatus"
 `
 
 type UpdateStepRunParams struct {
-	RequeueAfter      pgtype.Timestamp  `json:"requeueAfter"`
-	ScheduleTimeoutAt pgtype.Timestamp  `json:"scheduleTimeoutAt"`
-	StartedAt         pgtype.Timestamp  `json:"startedAt"`
-	Rerun             pgtype.Bool       `json:"rerun"`
-	FinishedAt        pgtype.Timestamp  `json:"finishedAt"`
-	Status            NullStepRunStatus `json:"status"`
-	Input             []byte            `json:"input"`
-	Output            []byte            `json:"output"`
-	Error             pgtype.Text       `json:"error"`
-	CancelledAt       pgtype.Timestamp  `json:"cancelledAt"`
-	CancelledReason   pgtype.Text       `json:"cancelledReason"`
-	RetryCount        pgtype.Int4       `json:"retryCount"`
-	ID                pgtype.UUID       `json:"id"`
-	Tenantid          pgtype.UUID       `json:"tenantid"`
+	RequeueAfter      pgtype.Timestamp       `json:"requeueAfter"`
+	ScheduleTimeoutAt pgtype.Timestamp       `json:"scheduleTimeoutAt"`
+	StartedAt         pgtype.Timestamp       `json:"startedAt"`
+	Rerun             pgtype.Bool            `json:"rerun"`
+	FinishedAt        pgtype.Timestamp       `json:"finishedAt"`
+	Status            NullStepRunStatus      `json:"status"`
+	Input             []byte                 `json:"input"`
+	Output            []byte                 `json:"output"`
+	Error             pgtype.Text            `json:"error"`
+	CancelledAt       pgtype.Timestamp       `json:"cancelledAt"`
+	CancelledReason   pgtype.Text            `json:"cancelledReason"`
+	CancelledSource   NullCancellationSource `json:"cancelledSource"`
+	RetryCount        pgtype.Int4            `json:"retryCount"`
+	ID                pgtype.UUID            `json:"id"`
+	Tenantid          pgtype.UUID            `json:"tenantid"`
 }
 
 func (q *Queries) UpdateStepRun(ctx context.Context, db DBTX, arg UpdateStepRunParams) (*StepRun, error) {
@@ -712,6 +1670,7 @@ func (q *Queries) UpdateStepRun(ctx context.Context, db DBTX, arg UpdateStepRunP
 		arg.Error,
 		arg.CancelledAt,
 		arg.CancelledReason,
+		arg.CancelledSource,
 		arg.RetryCount,
 		arg.ID,
 		arg.Tenantid,
@@ -744,6 +1703,13 @@ func (q *Queries) UpdateStepRun(ctx context.Context, db DBTX, arg UpdateStepRunP
 		&i.CallerFiles,
 		&i.GitRepoBranch,
 		&i.RetryCount,
+		&i.QueuedAt,
+		&i.SlotWaitStartedAt,
+		&i.AssignedAt,
+		&i.ResultPersistedAt,
+		&i.CancelledSource,
+		&i.ToleratedByJoin,
+		&i.SubStatus,
 	)
 	return &i, err
 }
@@ -808,3 +1774,50 @@ func (q *Queries) UpdateStepRunOverridesData(ctx context.Context, db DBTX, arg U
 	err := row.Scan(&input)
 	return input, err
 }
+
+const updateStepRunTimeoutAt = `-- name: UpdateStepRunTimeoutAt :one
+UPDATE
+    "StepRun" sr
+SET
+    "timeoutAt" = $1::timestamp,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    sr."tenantId" = $2::uuid AND
+    sr."id" = $3::uuid AND
+    sr."status" = ANY(ARRAY['ASSIGNED', 'RUNNING']::"StepRunStatus"[])
+RETURNING "timeoutAt"
+`
+
+type UpdateStepRunTimeoutAtParams struct {
+	Timeoutat pgtype.Timestamp `json:"timeoutat"`
+	Tenantid  pgtype.UUID      `json:"tenantid"`
+	Steprunid pgtype.UUID      `json:"steprunid"`
+}
+
+func (q *Queries) UpdateStepRunTimeoutAt(ctx context.Context, db DBTX, arg UpdateStepRunTimeoutAtParams) (pgtype.Timestamp, error) {
+	row := db.QueryRow(ctx, updateStepRunTimeoutAt, arg.Timeoutat, arg.Tenantid, arg.Steprunid)
+	var timeoutAt pgtype.Timestamp
+	err := row.Scan(&timeoutAt)
+	return timeoutAt, err
+}
+
+const updateStepRunsToleratedByJoin = `-- name: UpdateStepRunsToleratedByJoin :exec
+UPDATE
+    "StepRun"
+SET
+    "toleratedByJoin" = "id" = ANY($1::uuid[])
+WHERE
+    "id" = ANY($2::uuid[]) AND
+    "tenantId" = $3::uuid
+`
+
+type UpdateStepRunsToleratedByJoinParams struct {
+	Toleratedids []pgtype.UUID `json:"toleratedids"`
+	Ids          []pgtype.UUID `json:"ids"`
+	Tenantid     pgtype.UUID   `json:"tenantid"`
+}
+
+func (q *Queries) UpdateStepRunsToleratedByJoin(ctx context.Context, db DBTX, arg UpdateStepRunsToleratedByJoinParams) error {
+	_, err := db.Exec(ctx, updateStepRunsToleratedByJoin, arg.Toleratedids, arg.Ids, arg.Tenantid)
+	return err
+}
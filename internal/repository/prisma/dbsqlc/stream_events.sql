@@ -0,0 +1,61 @@
+-- name: CreateStreamEvent :one
+WITH step_run AS (
+    SELECT
+        sr."id",
+        sr."retryCount",
+        jr."workflowRunId"
+    FROM
+        "StepRun" sr
+    JOIN
+        "JobRun" jr ON jr."id" = sr."jobRunId"
+    WHERE
+        sr."id" = @stepRunId::uuid AND
+        sr."tenantId" = @tenantId::uuid AND
+        sr."status" = 'RUNNING'
+), stream_event AS (
+    INSERT INTO "StreamEvent" (
+        "createdAt",
+        "tenantId",
+        "stepRunId",
+        "index",
+        "retryCount",
+        "message"
+    )
+    SELECT
+        coalesce(sqlc.narg('createdAt')::timestamp, now()),
+        @tenantId::uuid,
+        step_run."id",
+        -- the index continues across retries, so that the stream events of a step run are ordered by index
+        coalesce((
+            SELECT MAX(se."index") + 1
+            FROM "StreamEvent" se
+            WHERE se."stepRunId" = step_run."id"
+        ), 0),
+        step_run."retryCount",
+        @message::text
+    FROM
+        step_run
+    RETURNING *
+)
+SELECT
+    stream_event.*,
+    step_run."workflowRunId"
+FROM
+    stream_event, step_run;
+
+-- name: ListStreamEvents :many
+SELECT
+    se.*
+FROM
+    "StreamEvent" se
+JOIN
+    "StepRun" sr ON sr."id" = se."stepRunId"
+WHERE
+    se."tenantId" = @tenantId::uuid AND
+    se."stepRunId" = @stepRunId::uuid AND
+    -- only the stream events of the latest attempt of the step run
+    se."retryCount" = sr."retryCount" AND
+    (sqlc.narg('after')::int IS NULL OR se."index" > sqlc.narg('after')::int)
+ORDER BY
+    se."index" ASC
+LIMIT COALESCE(sqlc.narg('limit')::int, 1000);
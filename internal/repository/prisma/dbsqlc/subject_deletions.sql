@@ -0,0 +1,126 @@
+-- name: ListSubjectWorkflowRuns :many
+-- Lists the workflow runs of a tenant with the given value of a run metadata key. Finished workflow runs are listed
+-- first, so that running workflow runs don't hold up the deletion of finished ones.
+SELECT
+    "id",
+    "status"
+FROM
+    "WorkflowRun"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "additionalMetadata" ->> @key::text = @value::text
+ORDER BY
+    "status" IN ('SUCCEEDED', 'FAILED') DESC,
+    "createdAt" ASC
+LIMIT
+    @limit::int;
+
+-- name: ScrubSubjectWorkflowRuns :execrows
+UPDATE
+    "WorkflowRun"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "additionalMetadata" = NULL
+WHERE
+    "id" = ANY(@workflowRunIds::uuid[]);
+
+-- name: ScrubSubjectWorkflowRunTriggers :execrows
+UPDATE
+    "WorkflowRunTriggeredBy"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "input" = NULL
+WHERE
+    "parentId" = ANY(@workflowRunIds::uuid[])
+    AND "input" IS NOT NULL;
+
+-- name: ScrubSubjectEvents :execrows
+UPDATE
+    "Event" e
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "data" = NULL
+FROM
+    "WorkflowRunTriggeredBy" tb
+WHERE
+    tb."eventId" = e."id"
+    AND tb."parentId" = ANY(@workflowRunIds::uuid[])
+    AND e."data" IS NOT NULL;
+
+-- name: ScrubSubjectJobRunLookupData :execrows
+UPDATE
+    "JobRunLookupData" ld
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "data" = NULL
+FROM
+    "JobRun" jr
+WHERE
+    ld."jobRunId" = jr."id"
+    AND jr."workflowRunId" = ANY(@workflowRunIds::uuid[])
+    AND ld."data" IS NOT NULL;
+
+-- name: ScrubSubjectGetGroupKeyRuns :execrows
+UPDATE
+    "GetGroupKeyRun"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "input" = NULL,
+    "output" = NULL
+WHERE
+    "workflowRunId" = ANY(@workflowRunIds::uuid[])
+    AND ("input" IS NOT NULL OR "output" IS NOT NULL);
+
+-- name: ScrubSubjectStepRuns :execrows
+UPDATE
+    "StepRun" sr
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "input" = NULL,
+    "output" = NULL,
+    "error" = NULL
+FROM
+    "JobRun" jr
+WHERE
+    sr."jobRunId" = jr."id"
+    AND jr."workflowRunId" = ANY(@workflowRunIds::uuid[])
+    AND (sr."input" IS NOT NULL OR sr."output" IS NOT NULL OR sr."error" IS NOT NULL);
+
+-- name: ScrubSubjectStepRunResultArchives :execrows
+UPDATE
+    "StepRunResultArchive" a
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "input" = NULL,
+    "output" = NULL,
+    "error" = NULL
+FROM
+    "StepRun" sr
+JOIN
+    "JobRun" jr ON jr."id" = sr."jobRunId"
+WHERE
+    a."stepRunId" = sr."id"
+    AND jr."workflowRunId" = ANY(@workflowRunIds::uuid[])
+    AND (a."input" IS NOT NULL OR a."output" IS NOT NULL OR a."error" IS NOT NULL);
+
+-- name: DeleteSubjectLogLines :execrows
+DELETE FROM
+    "LogLine" ll
+USING
+    "StepRun" sr,
+    "JobRun" jr
+WHERE
+    ll."stepRunId" = sr."id"
+    AND sr."jobRunId" = jr."id"
+    AND jr."workflowRunId" = ANY(@workflowRunIds::uuid[]);
+
+-- name: DeleteSubjectStreamEvents :execrows
+DELETE FROM
+    "StreamEvent" se
+USING
+    "StepRun" sr,
+    "JobRun" jr
+WHERE
+    se."stepRunId" = sr."id"
+    AND sr."jobRunId" = jr."id"
+    AND jr."workflowRunId" = ANY(@workflowRunIds::uuid[]);
@@ -0,0 +1,177 @@
+-- name: ListWorkflowsForTenantExport :many
+SELECT
+    "id",
+    "createdAt",
+    "name",
+    "description",
+    "paused"
+FROM
+    "Workflow"
+WHERE
+    "tenantId" = @tenantId::uuid AND
+    "deletedAt" IS NULL
+ORDER BY
+    "createdAt" ASC, "id" ASC;
+
+-- name: ListWorkflowVersionsForTenantExport :many
+SELECT
+    wv."id",
+    wv."createdAt",
+    wv."workflowId",
+    wv."version",
+    wv."order",
+    wv."checksum",
+    wv."scheduleTimeout",
+    wv."sla",
+    wv."defaultInput",
+    wv."inputSchema"
+FROM
+    "WorkflowVersion" wv
+JOIN
+    "Workflow" w ON w."id" = wv."workflowId"
+WHERE
+    w."tenantId" = @tenantId::uuid AND
+    w."deletedAt" IS NULL AND
+    wv."deletedAt" IS NULL
+ORDER BY
+    wv."workflowId" ASC, wv."order" ASC;
+
+-- name: ListStepsForTenantExport :many
+-- Lists the steps of the workflow versions of a tenant with the job which they belong to, and the readable ids
+-- of their parents.
+SELECT
+    s."id",
+    j."workflowVersionId",
+    j."name" AS "jobName",
+    s."readableId",
+    s."actionId",
+    s."timeout",
+    s."retries",
+    ARRAY(
+        SELECT
+            parent."readableId"
+        FROM
+            "_StepOrder" so
+        JOIN
+            "Step" parent ON parent."id" = so."A"
+        WHERE
+            so."B" = s."id"
+        ORDER BY
+            parent."readableId"
+    )::text[] AS "parents"
+FROM
+    "Step" s
+JOIN
+    "Job" j ON j."id" = s."jobId"
+WHERE
+    s."tenantId" = @tenantId::uuid AND
+    s."deletedAt" IS NULL
+ORDER BY
+    j."workflowVersionId" ASC, j."name" ASC, s."readableId" ASC;
+
+-- name: ListStepRunsForTenantExport :many
+SELECT
+    sr."id",
+    jr."workflowRunId",
+    sr."stepId",
+    s."readableId" AS "stepReadableId",
+    sr."status",
+    sr."input",
+    sr."output",
+    sr."error",
+    sr."retryCount",
+    sr."createdAt",
+    sr."startedAt",
+    sr."finishedAt",
+    sr."cancelledAt",
+    sr."cancelledReason"
+FROM
+    "StepRun" sr
+JOIN
+    "JobRun" jr ON jr."id" = sr."jobRunId"
+JOIN
+    "Step" s ON s."id" = sr."stepId"
+WHERE
+    sr."tenantId" = @tenantId::uuid AND
+    sr."deletedAt" IS NULL AND
+    jr."workflowRunId" = ANY(@workflowRunIds::uuid[])
+ORDER BY
+    jr."workflowRunId" ASC, sr."order" ASC;
+
+-- name: ListEventsForTenantExport :many
+SELECT
+    "id",
+    "createdAt",
+    "key",
+    "data",
+    "replayedFromId",
+    "environment"
+FROM
+    "Event"
+WHERE
+    "tenantId" = @tenantId::uuid AND
+    "deletedAt" IS NULL AND
+    (
+        -- keyset pagination on (createdAt, id) so exports are stable while new events are created
+        sqlc.narg('afterCreatedAt')::timestamp IS NULL OR
+        ("createdAt", "id") > (sqlc.narg('afterCreatedAt')::timestamp, sqlc.narg('afterId')::uuid)
+    )
+ORDER BY
+    "createdAt" ASC, "id" ASC
+LIMIT
+    @limit::int;
+
+-- name: ListAPITokensForTenantExport :many
+-- Lists the metadata of the API tokens of a tenant. The tokens themselves aren't stored.
+SELECT
+    "id",
+    "createdAt",
+    "expiresAt",
+    "revoked",
+    "name",
+    "scopes",
+    "environment",
+    "namespace"
+FROM
+    "APIToken"
+WHERE
+    "tenantId" = @tenantId::uuid
+ORDER BY
+    "createdAt" ASC, "id" ASC;
+
+-- name: CreateTenantExportArchive :exec
+INSERT INTO "TenantExportArchive" (
+    "id",
+    "createdAt",
+    "exportId",
+    "data"
+) VALUES (
+    gen_random_uuid(),
+    CURRENT_TIMESTAMP,
+    @exportId::uuid,
+    @data::bytea
+);
+
+-- name: CompleteTenantExport :exec
+UPDATE
+    "TenantExport"
+SET
+    "status" = 'SUCCEEDED',
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "finishedAt" = CURRENT_TIMESTAMP,
+    "archiveSize" = @archiveSize::int,
+    "archiveSha256" = @archiveSha256::text,
+    "archiveKey" = sqlc.narg('archiveKey')::text,
+    "expiresAt" = @expiresAt::timestamp
+WHERE
+    "id" = @id::uuid;
+
+-- name: DeleteExpiredTenantExports :many
+-- Deletes the exports which expired, together with the archives which are stored in the database, and returns the
+-- keys of the archives which are stored in the object store.
+DELETE FROM
+    "TenantExport"
+WHERE
+    "expiresAt" <= CURRENT_TIMESTAMP
+RETURNING
+    "archiveKey";
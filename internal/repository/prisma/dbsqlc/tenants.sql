@@ -0,0 +1,48 @@
+-- name: GetTenantQueueDepth :one
+SELECT
+    (
+        SELECT COUNT(*)
+        FROM "WorkflowRun" runs
+        WHERE
+            runs."tenantId" = @tenantId::uuid
+            AND runs."status" IN ('PENDING', 'QUEUED')
+            AND runs."deletedAt" IS NULL
+    ) AS "queuedRuns",
+    (
+        -- step runs which are waiting for a worker to be assigned
+        SELECT COUNT(*)
+        FROM "StepRun" stepRuns
+        WHERE
+            stepRuns."tenantId" = @tenantId::uuid
+            AND stepRuns."status" = 'PENDING_ASSIGNMENT'
+            AND stepRuns."deletedAt" IS NULL
+    ) AS "queuedStepRuns";
+
+-- name: ListTenantsWithRunCounts :many
+SELECT
+    sqlc.embed(tenants),
+    COUNT(runs."id") AS "totalRuns",
+    COUNT(runs."id") FILTER (WHERE runs."status" IN ('PENDING', 'QUEUED')) AS "pendingRuns",
+    COUNT(runs."id") FILTER (WHERE runs."status" = 'RUNNING') AS "runningRuns",
+    COUNT(runs."id") FILTER (WHERE runs."status" = 'SUCCEEDED') AS "succeededRuns",
+    COUNT(runs."id") FILTER (WHERE runs."status" = 'FAILED') AS "failedRuns"
+FROM
+    "Tenant" tenants
+LEFT JOIN
+    "WorkflowRun" AS runs ON runs."tenantId" = tenants."id"
+    AND runs."createdAt" > @createdAfter::timestamp
+    AND runs."deletedAt" IS NULL
+GROUP BY
+    tenants."id"
+ORDER BY
+    tenants."createdAt" ASC;
+
+-- name: UpdateTenantPause :one
+UPDATE "Tenant"
+SET
+    "paused" = @paused::boolean,
+    "pausedTriggerBehavior" = COALESCE(sqlc.narg('pausedTriggerBehavior')::"PausedTriggerBehavior", "pausedTriggerBehavior"),
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @id::uuid
+RETURNING *;
@@ -0,0 +1,78 @@
+-- name: CreateWebhookDelivery :one
+-- Records a delivery of a webhook. If the webhook already received a delivery with the same nonce, the delivery is
+-- only recorded again when replay protection is disabled or the previous delivery failed, and no rows are returned
+-- otherwise.
+INSERT INTO "WebhookDelivery" (
+    "id",
+    "createdAt",
+    "updatedAt",
+    "tenantId",
+    "source",
+    "webhookId",
+    "deliveryId",
+    "event",
+    "nonce",
+    "sentAt",
+    "status",
+    "error"
+) VALUES (
+    gen_random_uuid(),
+    CURRENT_TIMESTAMP,
+    CURRENT_TIMESTAMP,
+    @tenantId::uuid,
+    @source::"WebhookDeliverySource",
+    @webhookId::uuid,
+    sqlc.narg('deliveryId')::text,
+    @event::text,
+    @nonce::text,
+    sqlc.narg('sentAt')::timestamp,
+    @status::"WebhookDeliveryStatus",
+    sqlc.narg('error')::text
+)
+ON CONFLICT ("webhookId", "nonce") DO UPDATE
+SET
+    "createdAt" = CURRENT_TIMESTAMP,
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "deliveryId" = EXCLUDED."deliveryId",
+    "event" = EXCLUDED."event",
+    "sentAt" = EXCLUDED."sentAt",
+    "status" = EXCLUDED."status",
+    "error" = EXCLUDED."error"
+WHERE
+    NOT @replayProtection::boolean
+    -- failed deliveries can always be redelivered
+    OR "WebhookDelivery"."status" = 'FAILED'
+RETURNING *;
+
+-- name: UpdateWebhookDeliveryStatus :exec
+UPDATE
+    "WebhookDelivery"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "status" = @status::"WebhookDeliveryStatus",
+    "error" = sqlc.narg('error')::text
+WHERE
+    "id" = @id::uuid;
+
+-- name: ListWebhookDeliveries :many
+SELECT
+    *
+FROM
+    "WebhookDelivery"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND (
+        sqlc.narg('webhookId')::uuid IS NULL
+        OR "webhookId" = sqlc.narg('webhookId')::uuid
+    )
+ORDER BY
+    "createdAt" DESC
+LIMIT
+    @limit::int;
+
+-- name: DeleteExpiredWebhookDeliveries :execrows
+DELETE FROM
+    "WebhookDelivery"
+WHERE
+    "webhookId" = @webhookId::uuid
+    AND "createdAt" < @before::timestamp;
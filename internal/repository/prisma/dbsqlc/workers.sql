@@ -30,6 +30,10 @@ WHERE
             WHERE srs."workerId" = workers."id" AND srs."status" = 'RUNNING'
         ))
     )
+    AND (
+        sqlc.narg('environment')::text IS NULL OR
+        workers."environment" = sqlc.narg('environment')::text
+    )
 GROUP BY
     workers."id";
 
@@ -42,4 +46,28 @@ FROM
     "Worker" w
 WHERE
     w."tenantId" = @tenantId
-    AND w."id" = @id;
\ No newline at end of file
+    AND w."id" = @id;
+
+-- name: ListUnackedStepRunsForWorker :many
+SELECT
+    sr."id"
+FROM
+    "StepRun" sr
+WHERE
+    sr."tenantId" = @tenantId::uuid
+    AND sr."workerId" = @workerId::uuid
+    AND sr."status" = 'ASSIGNED'
+ORDER BY
+    sr."createdAt" ASC;
+
+-- name: ListUnackedGetGroupKeyRunsForWorker :many
+SELECT
+    ggr."workflowRunId"
+FROM
+    "GetGroupKeyRun" ggr
+WHERE
+    ggr."tenantId" = @tenantId::uuid
+    AND ggr."workerId" = @workerId::uuid
+    AND ggr."status" = 'ASSIGNED'
+ORDER BY
+    ggr."createdAt" ASC;
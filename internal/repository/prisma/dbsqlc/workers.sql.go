@@ -41,9 +41,85 @@ func (q *Queries) GetWorkerForEngine(ctx context.Context, db DBTX, arg GetWorker
 	return &i, err
 }
 
+const listUnackedGetGroupKeyRunsForWorker = `-- name: ListUnackedGetGroupKeyRunsForWorker :many
+SELECT
+    ggr."workflowRunId"
+FROM
+    "GetGroupKeyRun" ggr
+WHERE
+    ggr."tenantId" = $1::uuid
+    AND ggr."workerId" = $2::uuid
+    AND ggr."status" = 'ASSIGNED'
+ORDER BY
+    ggr."createdAt" ASC
+`
+
+type ListUnackedGetGroupKeyRunsForWorkerParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Workerid pgtype.UUID `json:"workerid"`
+}
+
+func (q *Queries) ListUnackedGetGroupKeyRunsForWorker(ctx context.Context, db DBTX, arg ListUnackedGetGroupKeyRunsForWorkerParams) ([]pgtype.UUID, error) {
+	rows, err := db.Query(ctx, listUnackedGetGroupKeyRunsForWorker, arg.Tenantid, arg.Workerid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var workflowRunId pgtype.UUID
+		if err := rows.Scan(&workflowRunId); err != nil {
+			return nil, err
+		}
+		items = append(items, workflowRunId)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnackedStepRunsForWorker = `-- name: ListUnackedStepRunsForWorker :many
+SELECT
+    sr."id"
+FROM
+    "StepRun" sr
+WHERE
+    sr."tenantId" = $1::uuid
+    AND sr."workerId" = $2::uuid
+    AND sr."status" = 'ASSIGNED'
+ORDER BY
+    sr."createdAt" ASC
+`
+
+type ListUnackedStepRunsForWorkerParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Workerid pgtype.UUID `json:"workerid"`
+}
+
+func (q *Queries) ListUnackedStepRunsForWorker(ctx context.Context, db DBTX, arg ListUnackedStepRunsForWorkerParams) ([]pgtype.UUID, error) {
+	rows, err := db.Query(ctx, listUnackedStepRunsForWorker, arg.Tenantid, arg.Workerid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listWorkersWithStepCount = `-- name: ListWorkersWithStepCount :many
 SELECT
-    workers.id, workers."createdAt", workers."updatedAt", workers."deletedAt", workers."tenantId", workers."lastHeartbeatAt", workers.name, workers.status, workers."dispatcherId", workers."maxRuns",
+    workers.id, workers."createdAt", workers."updatedAt", workers."deletedAt", workers."tenantId", workers."lastHeartbeatAt", workers.name, workers.status, workers."dispatcherId", workers."maxRuns", workers.cpu, workers."memoryMb", workers.gpu, workers.environment, workers."buildId", workers.labels, workers."lastAssignedAt", workers."sessionToken", workers."protocolVersion",
     COUNT(runs."id") FILTER (WHERE runs."status" = 'RUNNING') AS "runningStepRuns"
 FROM
     "Worker" workers
@@ -73,6 +149,10 @@ WHERE
             WHERE srs."workerId" = workers."id" AND srs."status" = 'RUNNING'
         ))
     )
+    AND (
+        $5::text IS NULL OR
+        workers."environment" = $5::text
+    )
 GROUP BY
     workers."id"
 `
@@ -82,6 +162,7 @@ type ListWorkersWithStepCountParams struct {
 	ActionId           pgtype.Text      `json:"actionId"`
 	LastHeartbeatAfter pgtype.Timestamp `json:"lastHeartbeatAfter"`
 	Assignable         pgtype.Bool      `json:"assignable"`
+	Environment        pgtype.Text      `json:"environment"`
 }
 
 type ListWorkersWithStepCountRow struct {
@@ -95,6 +176,7 @@ func (q *Queries) ListWorkersWithStepCount(ctx context.Context, db DBTX, arg Lis
 		arg.ActionId,
 		arg.LastHeartbeatAfter,
 		arg.Assignable,
+		arg.Environment,
 	)
 	if err != nil {
 		return nil, err
@@ -114,6 +196,15 @@ func (q *Queries) ListWorkersWithStepCount(ctx context.Context, db DBTX, arg Lis
 			&i.Worker.Status,
 			&i.Worker.DispatcherId,
 			&i.Worker.MaxRuns,
+			&i.Worker.Cpu,
+			&i.Worker.MemoryMb,
+			&i.Worker.Gpu,
+			&i.Worker.Environment,
+			&i.Worker.BuildId,
+			&i.Worker.Labels,
+			&i.Worker.LastAssignedAt,
+			&i.Worker.SessionToken,
+			&i.Worker.ProtocolVersion,
 			&i.RunningStepRuns,
 		); err != nil {
 			return nil, err
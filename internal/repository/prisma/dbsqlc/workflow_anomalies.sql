@@ -0,0 +1,76 @@
+-- name: ListWorkflowAnomalyWindows :many
+-- Lists the runs of each workflow which finished in a window, together with the baseline of the workflow, for
+-- the workflows whose baseline hasn't learned from the window yet. Debug runs are excluded.
+SELECT
+    workflows."tenantId",
+    workflows."id" AS "workflowId",
+    workflows."name" AS "workflowName",
+    COUNT(runs."id") AS "finished",
+    COUNT(runs."id") FILTER (WHERE runs."status" = 'FAILED') AS "failed",
+    COALESCE(AVG(EXTRACT(EPOCH FROM (runs."finishedAt" - runs."startedAt"))), 0)::float8 AS "duration",
+    baselines."windows",
+    baselines."failureRateMean",
+    baselines."failureRateVariance",
+    baselines."durationMean",
+    baselines."durationVariance"
+FROM
+    "WorkflowRun" AS runs
+JOIN
+    "WorkflowVersion" AS versions ON runs."workflowVersionId" = versions."id"
+JOIN
+    "Workflow" AS workflows ON versions."workflowId" = workflows."id"
+LEFT JOIN
+    "WorkflowAnomalyBaseline" AS baselines ON baselines."workflowId" = workflows."id"
+WHERE
+    runs."deletedAt" IS NULL
+    AND runs."debug" = false
+    AND runs."status" IN ('SUCCEEDED', 'FAILED')
+    AND runs."finishedAt" >= @windowStart::timestamp
+    AND runs."finishedAt" < @windowEnd::timestamp
+    AND workflows."deletedAt" IS NULL
+    AND (baselines."windowEnd" IS NULL OR baselines."windowEnd" < @windowEnd::timestamp)
+GROUP BY
+    workflows."id",
+    baselines."workflowId"
+LIMIT
+    @limit::int;
+
+-- name: UpsertWorkflowAnomalyBaseline :one
+-- Sets the baseline of a workflow once it has learned from a window. The baseline is only set if it hasn't
+-- learned from the window yet, so that if multiple tickers analyze the same window, only one of them emits
+-- the anomalies of the window.
+INSERT INTO "WorkflowAnomalyBaseline" (
+    "createdAt",
+    "updatedAt",
+    "tenantId",
+    "workflowId",
+    "windowEnd",
+    "windows",
+    "failureRateMean",
+    "failureRateVariance",
+    "durationMean",
+    "durationVariance"
+) VALUES (
+    CURRENT_TIMESTAMP,
+    CURRENT_TIMESTAMP,
+    @tenantId::uuid,
+    @workflowId::uuid,
+    @windowEnd::timestamp,
+    @windows::int,
+    @failureRateMean::float8,
+    @failureRateVariance::float8,
+    @durationMean::float8,
+    @durationVariance::float8
+)
+ON CONFLICT ("workflowId") DO UPDATE
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "windowEnd" = EXCLUDED."windowEnd",
+    "windows" = EXCLUDED."windows",
+    "failureRateMean" = EXCLUDED."failureRateMean",
+    "failureRateVariance" = EXCLUDED."failureRateVariance",
+    "durationMean" = EXCLUDED."durationMean",
+    "durationVariance" = EXCLUDED."durationVariance"
+WHERE
+    "WorkflowAnomalyBaseline"."windowEnd" < EXCLUDED."windowEnd"
+RETURNING *;
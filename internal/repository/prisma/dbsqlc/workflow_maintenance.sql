@@ -0,0 +1,31 @@
+-- name: ListAllWorkflowMaintenanceWindows :many
+-- Lists the maintenance windows of all workflows which aren't deleted, for the ticker which starts and ends the
+-- windows.
+SELECT
+    windows.*
+FROM
+    "WorkflowMaintenanceWindow" as windows
+JOIN
+    "Workflow" as w ON w."id" = windows."workflowId"
+WHERE
+    w."deletedAt" IS NULL;
+
+-- name: StartWorkflowMaintenance :execrows
+-- Buffers the runs of the workflow until the end of a maintenance window which is in progress. Overlapping windows
+-- extend the maintenance until the end of the last window.
+UPDATE "Workflow"
+SET
+    "maintenanceUntil" = @maintenanceUntil::timestamp
+WHERE
+    "id" = @workflowId::uuid AND
+    ("maintenanceUntil" IS NULL OR "maintenanceUntil" < @maintenanceUntil::timestamp);
+
+-- name: EndWorkflowMaintenance :many
+-- Ends the maintenance of the workflows whose maintenance window has ended, and returns the workflows. Each workflow
+-- is only returned to one caller, which releases its buffered runs.
+UPDATE "Workflow"
+SET
+    "maintenanceUntil" = NULL
+WHERE
+    "maintenanceUntil" <= CURRENT_TIMESTAMP
+RETURNING *;
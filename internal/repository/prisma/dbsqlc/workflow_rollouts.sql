@@ -0,0 +1,97 @@
+-- name: StartWorkflowRollout :exec
+-- Starts rolling out a new version of the workflow, if the workflow has a rollout policy. While a previous
+-- rollout is active or has been rolled back, the new version is rolled out from the version which the
+-- previous rollout was rolled out from, since that is the last version which is known to work.
+UPDATE
+    "WorkflowRollout" AS rollouts
+SET
+    "fromVersionId" = CASE
+        WHEN rollouts."status" IN ('ACTIVE', 'ROLLED_BACK') AND rollouts."fromVersionId" IS NOT NULL
+            THEN rollouts."fromVersionId"
+        ELSE (
+            SELECT
+                versions."id"
+            FROM
+                "WorkflowVersion" AS versions
+            WHERE
+                versions."workflowId" = rollouts."workflowId"
+                AND versions."id" != @workflowVersionId::uuid
+                AND versions."deletedAt" IS NULL
+            ORDER BY
+                versions."order" DESC
+            LIMIT 1
+        )
+    END,
+    "toVersionId" = @workflowVersionId::uuid,
+    "status" = 'ACTIVE',
+    "startedAt" = CURRENT_TIMESTAMP,
+    "finishedAt" = NULL,
+    "failureRate" = NULL,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    rollouts."workflowId" = @workflowId::uuid;
+
+-- name: ResolveWorkflowVersions :many
+-- Resolves the versions which are triggered in place of the given latest versions. A version which is being
+-- rolled out is only triggered for the percentage of the rollout, and once its rollout is rolled back, the
+-- version which it was rolled out from is always triggered instead.
+SELECT
+    COALESCE(rollouts."fromVersionId", versions."id")::uuid AS "id"
+FROM
+    unnest(@ids::uuid[]) WITH ORDINALITY AS versions("id", "index")
+LEFT JOIN
+    "WorkflowRollout" AS rollouts ON
+        rollouts."toVersionId" = versions."id"
+        AND rollouts."tenantId" = @tenantId::uuid
+        AND rollouts."fromVersionId" IS NOT NULL
+        AND (
+            rollouts."status" = 'ROLLED_BACK'
+            OR (rollouts."status" = 'ACTIVE' AND random() * 100 >= rollouts."percentage")
+        )
+ORDER BY
+    versions."index" ASC;
+
+-- name: RollBackFailingWorkflowRollouts :many
+-- Rolls back each active rollout whose new version failed more often than the threshold of the rollout, over
+-- the runs of the new version which finished within the window. The failure rate is only checked once the
+-- window contains the minimum number of finished runs. Debug runs are excluded.
+WITH rates AS (
+    SELECT
+        rollouts."id",
+        COUNT(runs."id") AS "finished",
+        COUNT(runs."id") FILTER (WHERE runs."status" = 'FAILED') AS "failed"
+    FROM
+        "WorkflowRollout" AS rollouts
+    JOIN
+        "WorkflowRun" AS runs ON runs."workflowVersionId" = rollouts."toVersionId"
+    WHERE
+        rollouts."status" = 'ACTIVE'
+        AND rollouts."fromVersionId" IS NOT NULL
+        AND runs."deletedAt" IS NULL
+        AND runs."debug" = false
+        AND runs."createdAt" >= rollouts."startedAt"
+        AND runs."status" IN ('SUCCEEDED', 'FAILED')
+        AND runs."finishedAt" > NOW() - rollouts."windowSeconds" * INTERVAL '1 second'
+    GROUP BY
+        rollouts."id"
+)
+UPDATE
+    "WorkflowRollout" AS rollouts
+SET
+    "status" = 'ROLLED_BACK',
+    "finishedAt" = CURRENT_TIMESTAMP,
+    "failureRate" = rates."failed"::float / rates."finished",
+    "updatedAt" = CURRENT_TIMESTAMP
+FROM
+    rates,
+    "Workflow" AS workflows
+WHERE
+    rollouts."id" = rates."id"
+    AND workflows."id" = rollouts."workflowId"
+    -- rechecked after the row is locked, so that each rollout is only rolled back by one ticker
+    AND rollouts."status" = 'ACTIVE'
+    AND rates."finished" >= rollouts."minRuns"
+    AND rates."failed"::float / rates."finished" > rollouts."failureRateThreshold"
+RETURNING
+    rollouts.*,
+    workflows."name" AS "workflowName";
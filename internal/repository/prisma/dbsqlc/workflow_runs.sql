@@ -32,6 +32,18 @@ WHERE
     (
     sqlc.narg('status')::"WorkflowRunStatus" IS NULL OR
     runs."status" = sqlc.narg('status')::"WorkflowRunStatus"
+    ) AND
+    (
+    sqlc.narg('createdAfter')::timestamp IS NULL OR
+    runs."createdAt" >= sqlc.narg('createdAfter')::timestamp
+    ) AND
+    (
+    sqlc.narg('cancelledSource')::"CancellationSource" IS NULL OR
+    runs."cancelledSource" = sqlc.narg('cancelledSource')::"CancellationSource"
+    ) AND
+    (
+    sqlc.narg('environment')::text IS NULL OR
+    runs."environment" = sqlc.narg('environment')::text
     );
 
 -- name: ListWorkflowRuns :many
@@ -73,6 +85,18 @@ WHERE
     (
     sqlc.narg('status')::"WorkflowRunStatus" IS NULL OR
     runs."status" = sqlc.narg('status')::"WorkflowRunStatus"
+    ) AND
+    (
+    sqlc.narg('createdAfter')::timestamp IS NULL OR
+    runs."createdAt" >= sqlc.narg('createdAfter')::timestamp
+    ) AND
+    (
+    sqlc.narg('cancelledSource')::"CancellationSource" IS NULL OR
+    runs."cancelledSource" = sqlc.narg('cancelledSource')::"CancellationSource"
+    ) AND
+    (
+    sqlc.narg('environment')::text IS NULL OR
+    runs."environment" = sqlc.narg('environment')::text
     )
 ORDER BY
     case when @orderBy = 'createdAt ASC' THEN runs."createdAt" END ASC ,
@@ -82,6 +106,139 @@ OFFSET
 LIMIT
     COALESCE(sqlc.narg('limit'), 50);
 
+-- name: ListWorkflowRunsForExport :many
+SELECT
+    sqlc.embed(runs),
+    workflow."id" AS "workflowId",
+    workflow."name" AS "workflowName"
+FROM
+    "WorkflowRun" as runs
+JOIN
+    "WorkflowVersion" as workflowVersion ON runs."workflowVersionId" = workflowVersion."id"
+JOIN
+    "Workflow" as workflow ON workflowVersion."workflowId" = workflow."id"
+WHERE
+    runs."tenantId" = @tenantId::uuid AND
+    runs."deletedAt" IS NULL AND
+    (
+        sqlc.narg('workflowId')::uuid IS NULL OR
+        workflow."id" = sqlc.narg('workflowId')::uuid
+    ) AND
+    (
+        sqlc.narg('status')::"WorkflowRunStatus" IS NULL OR
+        runs."status" = sqlc.narg('status')::"WorkflowRunStatus"
+    ) AND
+    (
+        sqlc.narg('createdAfter')::timestamp IS NULL OR
+        runs."createdAt" >= sqlc.narg('createdAfter')::timestamp
+    ) AND
+    (
+        sqlc.narg('createdBefore')::timestamp IS NULL OR
+        runs."createdAt" < sqlc.narg('createdBefore')::timestamp
+    ) AND
+    (
+        -- keyset pagination on (createdAt, id) so exports are stable while new runs are created
+        sqlc.narg('afterCreatedAt')::timestamp IS NULL OR
+        (runs."createdAt", runs."id") > (sqlc.narg('afterCreatedAt')::timestamp, sqlc.narg('afterId')::uuid)
+    )
+ORDER BY
+    runs."createdAt" ASC, runs."id" ASC
+LIMIT
+    @limit::int;
+
+-- name: ListWorkflowRunsForConcurrencySimulation :many
+-- Lists the runs of a workflow which were created after the given time, across all of its versions, along with
+-- their input, in creation order.
+SELECT
+    runs."id",
+    runs."createdAt",
+    runs."startedAt",
+    runs."finishedAt",
+    runs."status",
+    runs."concurrencyGroupId",
+    runs."additionalMetadata",
+    triggeredBy."input"
+FROM
+    "WorkflowRun" as runs
+JOIN
+    "WorkflowVersion" as workflowVersion ON runs."workflowVersionId" = workflowVersion."id"
+LEFT JOIN
+    "WorkflowRunTriggeredBy" as triggeredBy ON triggeredBy."parentId" = runs."id"
+WHERE
+    runs."tenantId" = @tenantId::uuid AND
+    runs."deletedAt" IS NULL AND
+    workflowVersion."workflowId" = @workflowId::uuid AND
+    runs."createdAt" >= @createdAfter::timestamp
+ORDER BY
+    runs."createdAt" ASC, runs."id" ASC
+LIMIT
+    @limit::int;
+
+-- name: ListWorkflowConcurrencyQueueDepth :many
+-- Counts the queued and running runs of a workflow by their concurrency group key, and by each component of the
+-- key, so that the queue depth can be aggregated along each key expression. For each position, only the values
+-- with the most queued runs are returned, up to the limit.
+WITH runs AS (
+    SELECT
+        runs."status",
+        runs."concurrencyGroupId",
+        runs."concurrencyGroupComponents"
+    FROM
+        "WorkflowRun" as runs
+    JOIN
+        "WorkflowVersion" as workflowVersion ON runs."workflowVersionId" = workflowVersion."id"
+    WHERE
+        runs."tenantId" = @tenantId::uuid AND
+        runs."deletedAt" IS NULL AND
+        workflowVersion."workflowId" = @workflowId::uuid AND
+        runs."status" IN ('QUEUED', 'RUNNING') AND
+        runs."concurrencyGroupId" IS NOT NULL
+), keys AS (
+    -- position 0 is the group key itself, which combines the components
+    SELECT
+        0 AS "position",
+        runs."concurrencyGroupId" AS "value",
+        runs."status"
+    FROM
+        runs
+    UNION ALL
+    SELECT
+        components."position",
+        components."value",
+        runs."status"
+    FROM
+        runs
+    CROSS JOIN LATERAL
+        unnest(runs."concurrencyGroupComponents") WITH ORDINALITY AS components("value", "position")
+), counts AS (
+    SELECT
+        keys."position",
+        keys."value",
+        COUNT(*) FILTER (WHERE keys."status" = 'QUEUED') AS "queued",
+        COUNT(*) FILTER (WHERE keys."status" = 'RUNNING') AS "running"
+    FROM
+        keys
+    GROUP BY
+        keys."position", keys."value"
+), ranked AS (
+    SELECT
+        counts.*,
+        row_number() OVER (PARTITION BY counts."position" ORDER BY counts."queued" DESC, counts."running" DESC, counts."value") AS "rank"
+    FROM
+        counts
+)
+SELECT
+    ranked."position"::int AS "position",
+    ranked."value"::text AS "value",
+    ranked."queued"::bigint AS "queued",
+    ranked."running"::bigint AS "running"
+FROM
+    ranked
+WHERE
+    ranked."rank" <= @limit::int
+ORDER BY
+    ranked."position" ASC, ranked."rank" ASC;
+
 -- name: PopWorkflowRunsRoundRobin :many
 WITH running_count AS (
     SELECT
@@ -159,6 +316,15 @@ workflowRun."id" = groupKeyRun."workflowRunId" AND
 workflowRun."tenantId" = @tenantId::uuid
 RETURNING workflowRun.*;
 
+-- name: UpdateWorkflowRunConcurrencyGroupComponents :exec
+UPDATE
+    "WorkflowRun"
+SET
+    "concurrencyGroupComponents" = @components::text[]
+WHERE
+    "id" = @workflowRunId::uuid
+    AND "tenantId" = @tenantId::uuid;
+
 -- name: ResolveWorkflowRunStatus :one
 WITH jobRuns AS (
     SELECT sum(case when runs."status" = 'PENDING' then 1 else 0 end) AS pendingRuns,
@@ -200,6 +366,24 @@ END, "startedAt" = CASE
     -- If a job is running or in a final state, then the workflow has started
     WHEN j.runningRuns > 0 OR j.succeededRuns > 0 OR j.failedRuns > 0 OR j.cancelledRuns > 0 THEN NOW()
     ELSE "startedAt"
+END, "cancelledSource" = CASE
+    -- Final states are final, cannot be updated
+    WHEN "status" IN ('SUCCEEDED', 'FAILED') THEN "cancelledSource"
+    -- When the workflow fails only because job runs were cancelled, the source is the source of the step run which
+    -- was cancelled first, preferring step runs which were not cancelled because of a previous step run
+    WHEN j.runningRuns = 0 AND j.failedRuns = 0 AND j.cancelledRuns > 0 THEN (
+        SELECT sr."cancelledSource"
+        FROM "StepRun" sr
+        JOIN "JobRun" jr ON sr."jobRunId" = jr."id"
+        WHERE
+            jr."workflowRunId" = "WorkflowRun"."id" AND
+            sr."tenantId" = @tenantId::uuid AND
+            sr."status" = 'CANCELLED' AND
+            sr."cancelledSource" IS NOT NULL
+        ORDER BY sr."cancelledSource" = 'PARENT_CANCELLED', sr."cancelledAt" ASC NULLS LAST
+        LIMIT 1
+    )
+    ELSE "cancelledSource"
 END
 FROM
     jobRuns j
@@ -248,7 +432,12 @@ INSERT INTO "WorkflowRun" (
     "status",
     "error",
     "startedAt",
-    "finishedAt"
+    "finishedAt",
+    "debug",
+    "replayOfId",
+    "additionalMetadata",
+    "environment",
+    "correlationId"
 ) VALUES (
     COALESCE(sqlc.narg('id')::uuid, gen_random_uuid()),
     CURRENT_TIMESTAMP,
@@ -260,7 +449,12 @@ INSERT INTO "WorkflowRun" (
     'PENDING', -- default status
     NULL, -- assuming error is not set on creation
     NULL, -- assuming startedAt is not set on creation
-    NULL  -- assuming finishedAt is not set on creation
+    NULL, -- assuming finishedAt is not set on creation
+    COALESCE(sqlc.narg('debug')::boolean, false),
+    sqlc.narg('replayOfId')::uuid,
+    sqlc.narg('additionalMetadata')::jsonb,
+    sqlc.narg('environment')::text,
+    sqlc.narg('correlationId')::text
 ) RETURNING *;
 
 -- name: CreateWorkflowRunTriggeredBy :one
@@ -412,6 +606,32 @@ FROM
 WHERE
     "Step"."jobId" = job_id."jobId";
 
+-- name: GetWorkflowRunLastUpdatedAt :one
+SELECT
+    -- GREATEST ignores nulls, so runs without job runs, step runs or logs still have a value
+    GREATEST(
+        runs."updatedAt",
+        (SELECT MAX(jr."updatedAt") FROM "JobRun" jr WHERE jr."workflowRunId" = runs."id"),
+        (
+            SELECT MAX(sr."updatedAt")
+            FROM "StepRun" sr
+            JOIN "JobRun" jr ON sr."jobRunId" = jr."id"
+            WHERE jr."workflowRunId" = runs."id"
+        ),
+        (
+            SELECT MAX(ll."createdAt")
+            FROM "LogLine" ll
+            JOIN "StepRun" sr ON ll."stepRunId" = sr."id"
+            JOIN "JobRun" jr ON sr."jobRunId" = jr."id"
+            WHERE jr."workflowRunId" = runs."id"
+        )
+    )::timestamp AS "lastUpdatedAt"
+FROM
+    "WorkflowRun" as runs
+WHERE
+    runs."id" = @workflowRunId::uuid AND
+    runs."tenantId" = @tenantId::uuid;
+
 -- name: LinkStepRunParents :exec
 INSERT INTO "_StepRunOrder" ("A", "B")
 SELECT 
@@ -424,16 +644,63 @@ JOIN
 JOIN 
     "StepRun" AS child_run ON child_run."stepId" = step_order."B" AND child_run."jobRunId" = @jobRunId::uuid;
 
+-- name: ListFailedWorkflowRunsForRetry :many
+SELECT
+    runs."id",
+    runs."createdAt"
+FROM
+    "WorkflowRun" as runs
+JOIN
+    "WorkflowVersion" as workflowVersion ON runs."workflowVersionId" = workflowVersion."id"
+WHERE
+    runs."tenantId" = @tenantId::uuid AND
+    runs."deletedAt" IS NULL AND
+    runs."status" = 'FAILED' AND
+    -- only consider runs which existed when the bulk retry was created, so a retry which fails
+    -- again is not picked up a second time
+    runs."createdAt" < @createdBefore::timestamp AND
+    (
+        sqlc.narg('workflowId')::uuid IS NULL OR
+        workflowVersion."workflowId" = sqlc.narg('workflowId')::uuid
+    ) AND
+    (
+        sqlc.narg('createdAfter')::timestamp IS NULL OR
+        runs."createdAt" >= sqlc.narg('createdAfter')::timestamp
+    ) AND
+    (
+        sqlc.narg('errorContains')::text IS NULL OR
+        strpos(runs."error", sqlc.narg('errorContains')::text) > 0 OR
+        EXISTS (
+            SELECT 1
+            FROM "JobRun" jr
+            JOIN "StepRun" sr ON sr."jobRunId" = jr."id"
+            WHERE
+                jr."workflowRunId" = runs."id" AND
+                sr."status" = 'FAILED' AND
+                strpos(sr."error", sqlc.narg('errorContains')::text) > 0
+        )
+    ) AND
+    (
+        sqlc.narg('afterCreatedAt')::timestamp IS NULL OR
+        (runs."createdAt", runs."id") > (sqlc.narg('afterCreatedAt')::timestamp, sqlc.narg('afterId')::uuid)
+    )
+ORDER BY
+    runs."createdAt" ASC, runs."id" ASC
+LIMIT
+    @limit::int;
+
 -- name: ListStartableStepRuns :many
 WITH job_run AS (
     SELECT "status"
     FROM "JobRun"
     WHERE "id" = @jobRunId::uuid
 )
-SELECT 
+SELECT DISTINCT
     child_run."id" AS "id"
 FROM 
     "StepRun" AS child_run
+JOIN
+    "Step" AS child_step ON child_step."id" = child_run."stepId"
 LEFT JOIN 
     "_StepRunOrder" AS step_run_order ON step_run_order."B" = child_run."id"
 JOIN
@@ -442,18 +709,441 @@ WHERE
     child_run."jobRunId" = @jobRunId::uuid
     AND child_run."status" = 'PENDING'
     AND job_run."status" = 'RUNNING'
-    -- case on whether parentStepRunId is null
+    -- case on whether parentStepRunId is null. when it is null, the job run is starting, so every step
+    -- run whose parents have succeeded is startable: these are the step runs without parents, and the
+    -- step runs after the step runs which were copied from a replayed run.
+    AND (
+        sqlc.narg('parentStepRunId')::uuid IS NULL OR
+        step_run_order."A" = sqlc.narg('parentStepRunId')::uuid
+    )
+    -- the join strategy of the step sets how many parents must have succeeded: every parent by default, or
+    -- a single parent or a quorum of parents for join steps
     AND (
-        (sqlc.narg('parentStepRunId')::uuid IS NULL AND step_run_order."A" IS NULL) OR 
+        SELECT
+            COUNT(*) FILTER (WHERE parent_run."status" = 'SUCCEEDED') >= CASE child_step."joinStrategy"
+                WHEN 'ANY' THEN 1
+                WHEN 'QUORUM' THEN child_step."joinQuorum"
+                ELSE COUNT(*)
+            END
+        FROM "_StepRunOrder" AS parent_order
+        JOIN "StepRun" AS parent_run ON parent_order."A" = parent_run."id"
+        WHERE 
+            parent_order."B" = child_run."id"
+    );
+
+-- name: CopyReplayedStepRuns :exec
+-- Copies the step runs which succeeded in the replayed workflow run, except for the steps which are
+-- replayed: the start step and every step after it.
+WITH RECURSIVE replayed_steps AS (
+    SELECT "id"
+    FROM "Step"
+    WHERE "id" = @startFromStepId::uuid
+    UNION
+    SELECT step_order."B"
+    FROM "_StepOrder" AS step_order
+    JOIN replayed_steps ON step_order."A" = replayed_steps."id"
+), copied_runs AS (
+    SELECT
+        replayed_run."stepId",
+        replayed_run."input",
+        replayed_run."output",
+        replayed_run."startedAt",
+        replayed_run."finishedAt"
+    FROM
+        "StepRun" AS replayed_run
+    JOIN
+        "JobRun" AS replayed_job_run ON replayed_run."jobRunId" = replayed_job_run."id"
+    WHERE
+        replayed_job_run."workflowRunId" = @replayOfId::uuid
+        AND replayed_run."tenantId" = @tenantId::uuid
+        AND replayed_run."status" = 'SUCCEEDED'
+        AND replayed_run."stepId" NOT IN (SELECT "id" FROM replayed_steps)
+)
+UPDATE
+    "StepRun" AS step_run
+SET
+    "status" = 'SUCCEEDED',
+    "input" = copied_runs."input",
+    "output" = copied_runs."output",
+    "startedAt" = copied_runs."startedAt",
+    "finishedAt" = copied_runs."finishedAt"
+FROM
+    "JobRun" AS job_run,
+    copied_runs
+WHERE
+    step_run."jobRunId" = job_run."id"
+    AND job_run."workflowRunId" = @workflowRunId::uuid
+    AND step_run."tenantId" = @tenantId::uuid
+    AND step_run."stepId" = copied_runs."stepId";
+
+-- name: UpdateJobRunLookupDataWithCopiedStepRuns :exec
+UPDATE
+    "JobRunLookupData" AS lookup_data
+SET
+    "data" = jsonb_set(lookup_data."data", '{steps}', copied_steps."steps", true),
+    "updatedAt" = CURRENT_TIMESTAMP
+FROM (
+    SELECT
+        step_run."jobRunId",
+        jsonb_object_agg(step."readableId", COALESCE(step_run."output", 'null'::jsonb)) AS "steps"
+    FROM
+        "StepRun" AS step_run
+    JOIN
+        "Step" AS step ON step_run."stepId" = step."id"
+    JOIN
+        "JobRun" AS job_run ON step_run."jobRunId" = job_run."id"
+    WHERE
+        job_run."workflowRunId" = @workflowRunId::uuid
+        AND step_run."tenantId" = @tenantId::uuid
+        AND step_run."status" = 'SUCCEEDED'
+        AND step."readableId" IS NOT NULL
+    GROUP BY
+        step_run."jobRunId"
+) AS copied_steps
+WHERE
+    lookup_data."jobRunId" = copied_steps."jobRunId";
+
+-- name: SucceedCopiedJobRuns :exec
+-- Job runs whose step runs were all copied from the replayed workflow run have already succeeded, so
+-- they are not queued.
+UPDATE
+    "JobRun" AS job_run
+SET
+    "status" = 'SUCCEEDED',
+    "startedAt" = step_runs."startedAt",
+    "finishedAt" = step_runs."finishedAt"
+FROM (
+    SELECT
+        step_run."jobRunId",
+        MIN(step_run."startedAt") AS "startedAt",
+        MAX(step_run."finishedAt") AS "finishedAt"
+    FROM
+        "StepRun" AS step_run
+    JOIN
+        "JobRun" AS job_run ON step_run."jobRunId" = job_run."id"
+    WHERE
+        job_run."workflowRunId" = @workflowRunId::uuid
+        AND job_run."tenantId" = @tenantId::uuid
+    GROUP BY
+        step_run."jobRunId"
+    HAVING
+        bool_and(step_run."status" = 'SUCCEEDED')
+) AS step_runs
+WHERE
+    job_run."id" = step_runs."jobRunId";
+
+-- name: RestoreStepRun :exec
+-- Sets the state of a step run of a restored workflow run from an exported bundle. Step runs are matched
+-- by job name and step readable id, since the ids of the exported run do not exist in this instance.
+UPDATE
+    "StepRun" AS step_run
+SET
+    "status" = @status::"StepRunStatus",
+    "input" = sqlc.narg('input')::jsonb,
+    "output" = sqlc.narg('output')::jsonb,
+    "error" = sqlc.narg('error')::text,
+    "startedAt" = sqlc.narg('startedAt')::timestamp,
+    "finishedAt" = sqlc.narg('finishedAt')::timestamp,
+    "cancelledAt" = sqlc.narg('cancelledAt')::timestamp,
+    "cancelledReason" = sqlc.narg('cancelledReason')::text,
+    "retryCount" = @retryCount::int,
+    "requeueAfter" = NULL
+FROM
+    "JobRun" AS job_run,
+    "Job" AS job,
+    "Step" AS step
+WHERE
+    step_run."jobRunId" = job_run."id"
+    AND job_run."jobId" = job."id"
+    AND step_run."stepId" = step."id"
+    AND job_run."workflowRunId" = @workflowRunId::uuid
+    AND step_run."tenantId" = @tenantId::uuid
+    AND job."name" = @jobName::text
+    AND step."readableId" = @stepReadableId::text;
+
+-- name: RestoreJobRuns :exec
+-- Resolves the job runs of a restored workflow run from its restored step runs. Restored runs are never
+-- queued, so job runs whose step runs did not all finish are cancelled.
+UPDATE
+    "JobRun" AS job_run
+SET
+    "status" = CASE
+        WHEN step_runs."failedRuns" > 0 THEN 'FAILED'
+        WHEN step_runs."succeededRuns" = step_runs."totalRuns" THEN 'SUCCEEDED'
+        ELSE 'CANCELLED'
+    END::"JobRunStatus",
+    "startedAt" = step_runs."startedAt",
+    "finishedAt" = step_runs."finishedAt"
+FROM (
+    SELECT
+        step_run."jobRunId",
+        COUNT(*) AS "totalRuns",
+        COUNT(*) FILTER (WHERE step_run."status" = 'SUCCEEDED') AS "succeededRuns",
+        COUNT(*) FILTER (WHERE step_run."status" = 'FAILED') AS "failedRuns",
+        MIN(step_run."startedAt") AS "startedAt",
+        MAX(COALESCE(step_run."finishedAt", step_run."cancelledAt")) AS "finishedAt"
+    FROM
+        "StepRun" AS step_run
+    JOIN
+        "JobRun" AS job_run ON step_run."jobRunId" = job_run."id"
+    WHERE
+        job_run."workflowRunId" = @workflowRunId::uuid
+        AND job_run."tenantId" = @tenantId::uuid
+    GROUP BY
+        step_run."jobRunId"
+) AS step_runs
+WHERE
+    job_run."id" = step_runs."jobRunId";
+
+-- name: RestoreWorkflowRun :exec
+UPDATE
+    "WorkflowRun"
+SET
+    "status" = @status::"WorkflowRunStatus",
+    "error" = sqlc.narg('error')::text,
+    "startedAt" = sqlc.narg('startedAt')::timestamp,
+    "finishedAt" = sqlc.narg('finishedAt')::timestamp
+WHERE
+    "id" = @workflowRunId::uuid
+    AND "tenantId" = @tenantId::uuid;
+
+-- name: CreateWorkflowRunSLABreaches :many
+-- Creates a breach for each workflow run which has exceeded the SLA of its workflow version. Runs which
+-- finished recently are checked along with runs which are in progress, so that a breach is recorded when a
+-- run finishes between two checks. Debug runs are excluded.
+WITH breached_runs AS (
+    SELECT
+        runs."id",
+        runs."tenantId",
+        workflowVersion."workflowId",
+        workflowVersion."sla"
+    FROM
+        "WorkflowRun" as runs
+    JOIN
+        "WorkflowVersion" as workflowVersion ON runs."workflowVersionId" = workflowVersion."id"
+    WHERE
+        workflowVersion."sla" IS NOT NULL AND
+        runs."deletedAt" IS NULL AND
+        runs."debug" = false AND
+        runs."createdAt" + workflowVersion."sla"::interval < NOW() AND
         (
-            step_run_order."A" = sqlc.narg('parentStepRunId')::uuid
-            AND NOT EXISTS (
-                SELECT 1
-                FROM "_StepRunOrder" AS parent_order
-                JOIN "StepRun" AS parent_run ON parent_order."A" = parent_run."id"
-                WHERE 
-                    parent_order."B" = child_run."id"
-                    AND parent_run."status" != 'SUCCEEDED'
+            runs."status" IN ('PENDING', 'QUEUED', 'RUNNING') OR
+            (
+                runs."finishedAt" > NOW() - INTERVAL '1 minute' AND
+                runs."finishedAt" > runs."createdAt" + workflowVersion."sla"::interval
             )
+        ) AND
+        NOT EXISTS (
+            SELECT 1
+            FROM "WorkflowRunSLABreach" as existing
+            WHERE existing."workflowRunId" = runs."id"
         )
-    );
+    LIMIT
+        @limit::int
+), breaches AS (
+    INSERT INTO "WorkflowRunSLABreach" (
+        "id",
+        "createdAt",
+        "tenantId",
+        "workflowId",
+        "workflowRunId",
+        "sla"
+    )
+    SELECT
+        gen_random_uuid(),
+        NOW(),
+        breached_runs."tenantId",
+        breached_runs."workflowId",
+        breached_runs."id",
+        breached_runs."sla"
+    FROM
+        breached_runs
+    -- a run can be checked by more than one ticker at the same time
+    ON CONFLICT ("workflowRunId") DO NOTHING
+    RETURNING *
+)
+SELECT
+    breaches.*,
+    workflow."name" AS "workflowName"
+FROM
+    breaches
+JOIN
+    "Workflow" as workflow ON breaches."workflowId" = workflow."id";
+
+-- name: ListWorkflowRunSLABreaches :many
+SELECT
+    sqlc.embed(breaches),
+    runs."workflowVersionId",
+    runs."status" AS "workflowRunStatus",
+    runs."createdAt" AS "workflowRunCreatedAt",
+    runs."finishedAt" AS "workflowRunFinishedAt"
+FROM
+    "WorkflowRunSLABreach" as breaches
+JOIN
+    "WorkflowRun" as runs ON breaches."workflowRunId" = runs."id"
+WHERE
+    breaches."tenantId" = @tenantId::uuid AND
+    breaches."workflowId" = @workflowId::uuid
+ORDER BY
+    breaches."createdAt" DESC, breaches."id" DESC
+LIMIT
+    COALESCE(sqlc.narg('limit'), 50)
+OFFSET
+    COALESCE(sqlc.narg('offset'), 0);
+
+-- name: CountWorkflowRunSLABreaches :one
+SELECT
+    COUNT(*) AS total
+FROM
+    "WorkflowRunSLABreach" as breaches
+WHERE
+    breaches."tenantId" = @tenantId::uuid AND
+    breaches."workflowId" = @workflowId::uuid;
+
+-- name: GetWorkflowVersionPauseState :one
+SELECT
+    t."paused" AS "tenantPaused",
+    t."pausedTriggerBehavior" AS "tenantPausedTriggerBehavior",
+    w."paused" AS "workflowPaused",
+    w."pausedTriggerBehavior" AS "workflowPausedTriggerBehavior"
+FROM
+    "WorkflowVersion" as workflowVersion
+JOIN
+    "Workflow" as w ON w."id" = workflowVersion."workflowId"
+JOIN
+    "Tenant" as t ON t."id" = w."tenantId"
+WHERE
+    workflowVersion."id" = @workflowVersionId::uuid AND
+    w."tenantId" = @tenantId::uuid;
+
+-- name: BufferWorkflowRunIfPaused :execrows
+WITH paused_run AS (
+    SELECT
+        runs."id"
+    FROM
+        "WorkflowRun" as runs
+    JOIN
+        "WorkflowVersion" as workflowVersion ON workflowVersion."id" = runs."workflowVersionId"
+    JOIN
+        "Workflow" as w ON w."id" = workflowVersion."workflowId"
+    JOIN
+        "Tenant" as t ON t."id" = runs."tenantId"
+    WHERE
+        runs."id" = @workflowRunId::uuid AND
+        runs."tenantId" = @tenantId::uuid AND
+        (w."paused" OR t."paused" OR w."maintenanceUntil" > CURRENT_TIMESTAMP)
+    -- the workflow and the tenant are locked, so that a pause or maintenance window which ends concurrently
+    -- releases the run after it has been buffered
+    FOR SHARE OF w, t
+)
+UPDATE "WorkflowRun"
+SET
+    "bufferedAt" = CURRENT_TIMESTAMP
+FROM
+    paused_run
+WHERE
+    "WorkflowRun"."id" = paused_run."id";
+
+-- name: ReleaseBufferedWorkflowRuns :many
+UPDATE "WorkflowRun" as runs
+SET
+    "bufferedAt" = NULL
+FROM
+    "WorkflowVersion" as workflowVersion,
+    "Workflow" as w,
+    "Tenant" as t
+WHERE
+    runs."tenantId" = @tenantId::uuid AND
+    runs."bufferedAt" IS NOT NULL AND
+    runs."status" = 'PENDING' AND
+    runs."deletedAt" IS NULL AND
+    workflowVersion."id" = runs."workflowVersionId" AND
+    w."id" = workflowVersion."workflowId" AND
+    t."id" = runs."tenantId" AND
+    NOT w."paused" AND
+    NOT t."paused" AND
+    (w."maintenanceUntil" IS NULL OR w."maintenanceUntil" <= CURRENT_TIMESTAMP)
+RETURNING
+    runs.*;
+
+-- name: AddWorkflowRunBudgetUsage :one
+UPDATE "WorkflowRun" as runs
+SET
+    "stepExecutions" = runs."stepExecutions" + @stepExecutions::int,
+    "stepRetries" = runs."stepRetries" + @stepRetries::int
+FROM
+    "WorkflowVersion" as workflowVersion
+WHERE
+    runs."id" = @workflowRunId::uuid AND
+    runs."tenantId" = @tenantId::uuid AND
+    workflowVersion."id" = runs."workflowVersionId" AND
+    -- usage is only tracked for runs of workflow versions with a budget
+    (workflowVersion."maxStepExecutions" IS NOT NULL OR workflowVersion."maxStepRetries" IS NOT NULL)
+RETURNING
+    runs."stepExecutions",
+    runs."stepRetries",
+    workflowVersion."maxStepExecutions",
+    workflowVersion."maxStepRetries";
+
+-- name: ListWorkflowRunMetrics :many
+-- Returns the number of runs of the workflows of a tenant which succeeded and failed in a time range, and their
+-- total duration, grouped by workflow and time bucket. Runs which were purged are read from the hourly rollups of
+-- their workflow, so that the metrics remain accurate beyond the retention of the runs. Debug runs are excluded.
+WITH metrics AS (
+    SELECT
+        wv."workflowId",
+        date_trunc(@bucket::text, runs."finishedAt") AS "bucket",
+        COUNT(*) FILTER (WHERE runs."status" = 'SUCCEEDED') AS "succeeded",
+        COUNT(*) FILTER (WHERE runs."status" = 'FAILED') AS "failed",
+        SUM(GREATEST(EXTRACT(EPOCH FROM (runs."finishedAt" - COALESCE(runs."startedAt", runs."createdAt"))), 0)) AS "durationSeconds"
+    FROM
+        "WorkflowRun" runs
+    JOIN
+        "WorkflowVersion" wv ON runs."workflowVersionId" = wv."id"
+    WHERE
+        runs."tenantId" = @tenantId::uuid
+        AND runs."deletedAt" IS NULL
+        AND runs."debug" = false
+        AND runs."status" IN ('SUCCEEDED', 'FAILED')
+        AND runs."finishedAt" >= @since::timestamp
+        AND runs."finishedAt" < @until::timestamp
+        AND (sqlc.narg('workflowId')::uuid IS NULL OR wv."workflowId" = sqlc.narg('workflowId')::uuid)
+    GROUP BY
+        1, 2
+    UNION ALL
+    SELECT
+        rollups."workflowId",
+        date_trunc(@bucket::text, rollups."hour"),
+        SUM(rollups."succeeded"),
+        SUM(rollups."failed"),
+        SUM(rollups."durationSeconds")
+    FROM
+        "WorkflowRunRollup" rollups
+    WHERE
+        rollups."tenantId" = @tenantId::uuid
+        AND rollups."hour" >= @since::timestamp
+        AND rollups."hour" < @until::timestamp
+        AND (sqlc.narg('workflowId')::uuid IS NULL OR rollups."workflowId" = sqlc.narg('workflowId')::uuid)
+    GROUP BY
+        1, 2
+)
+SELECT
+    metrics."workflowId",
+    w."name" AS "workflowName",
+    metrics."bucket"::timestamp AS "bucket",
+    SUM(metrics."succeeded")::bigint AS "succeeded",
+    SUM(metrics."failed")::bigint AS "failed",
+    SUM(metrics."durationSeconds")::float8 AS "durationSeconds"
+FROM
+    metrics
+JOIN
+    "Workflow" w ON metrics."workflowId" = w."id"
+WHERE
+    w."deletedAt" IS NULL
+GROUP BY
+    metrics."workflowId",
+    w."name",
+    metrics."bucket"
+ORDER BY
+    "bucket" ASC,
+    "workflowName" ASC;
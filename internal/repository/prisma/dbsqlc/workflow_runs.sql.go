@@ -11,6 +11,179 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const addWorkflowRunBudgetUsage = `-- name: AddWorkflowRunBudgetUsage :one
+UPDATE "WorkflowRun" as runs
+SET
+    "stepExecutions" = runs."stepExecutions" + $1::int,
+    "stepRetries" = runs."stepRetries" + $2::int
+FROM
+    "WorkflowVersion" as workflowVersion
+WHERE
+    runs."id" = $3::uuid AND
+    runs."tenantId" = $4::uuid AND
+    workflowVersion."id" = runs."workflowVersionId" AND
+    -- usage is only tracked for runs of workflow versions with a budget
+    (workflowVersion."maxStepExecutions" IS NOT NULL OR workflowVersion."maxStepRetries" IS NOT NULL)
+RETURNING
+    runs."stepExecutions",
+    runs."stepRetries",
+    workflowVersion."maxStepExecutions",
+    workflowVersion."maxStepRetries"
+`
+
+type AddWorkflowRunBudgetUsageParams struct {
+	Stepexecutions int32       `json:"stepexecutions"`
+	Stepretries    int32       `json:"stepretries"`
+	Workflowrunid  pgtype.UUID `json:"workflowrunid"`
+	Tenantid       pgtype.UUID `json:"tenantid"`
+}
+
+type AddWorkflowRunBudgetUsageRow struct {
+	StepExecutions    int32       `json:"stepExecutions"`
+	StepRetries       int32       `json:"stepRetries"`
+	MaxStepExecutions pgtype.Int4 `json:"maxStepExecutions"`
+	MaxStepRetries    pgtype.Int4 `json:"maxStepRetries"`
+}
+
+func (q *Queries) AddWorkflowRunBudgetUsage(ctx context.Context, db DBTX, arg AddWorkflowRunBudgetUsageParams) (*AddWorkflowRunBudgetUsageRow, error) {
+	row := db.QueryRow(ctx, addWorkflowRunBudgetUsage,
+		arg.Stepexecutions,
+		arg.Stepretries,
+		arg.Workflowrunid,
+		arg.Tenantid,
+	)
+	var i AddWorkflowRunBudgetUsageRow
+	err := row.Scan(
+		&i.StepExecutions,
+		&i.StepRetries,
+		&i.MaxStepExecutions,
+		&i.MaxStepRetries,
+	)
+	return &i, err
+}
+
+const bufferWorkflowRunIfPaused = `-- name: BufferWorkflowRunIfPaused :execrows
+WITH paused_run AS (
+    SELECT
+        runs."id"
+    FROM
+        "WorkflowRun" as runs
+    JOIN
+        "WorkflowVersion" as workflowVersion ON workflowVersion."id" = runs."workflowVersionId"
+    JOIN
+        "Workflow" as w ON w."id" = workflowVersion."workflowId"
+    JOIN
+        "Tenant" as t ON t."id" = runs."tenantId"
+    WHERE
+        runs."id" = $1::uuid AND
+        runs."tenantId" = $2::uuid AND
+        (w."paused" OR t."paused" OR w."maintenanceUntil" > CURRENT_TIMESTAMP)
+    -- the workflow and the tenant are locked, so that a pause or maintenance window which ends concurrently
+    -- releases the run after it has been buffered
+    FOR SHARE OF w, t
+)
+UPDATE "WorkflowRun"
+SET
+    "bufferedAt" = CURRENT_TIMESTAMP
+FROM
+    paused_run
+WHERE
+    "WorkflowRun"."id" = paused_run."id"
+`
+
+type BufferWorkflowRunIfPausedParams struct {
+	Workflowrunid pgtype.UUID `json:"workflowrunid"`
+	Tenantid      pgtype.UUID `json:"tenantid"`
+}
+
+func (q *Queries) BufferWorkflowRunIfPaused(ctx context.Context, db DBTX, arg BufferWorkflowRunIfPausedParams) (int64, error) {
+	result, err := db.Exec(ctx, bufferWorkflowRunIfPaused, arg.Workflowrunid, arg.Tenantid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const copyReplayedStepRuns = `-- name: CopyReplayedStepRuns :exec
+WITH RECURSIVE replayed_steps AS (
+    SELECT "id"
+    FROM "Step"
+    WHERE "id" = $1::uuid
+    UNION
+    SELECT step_order."B"
+    FROM "_StepOrder" AS step_order
+    JOIN replayed_steps ON step_order."A" = replayed_steps."id"
+), copied_runs AS (
+    SELECT
+        replayed_run."stepId",
+        replayed_run."input",
+        replayed_run."output",
+        replayed_run."startedAt",
+        replayed_run."finishedAt"
+    FROM
+        "StepRun" AS replayed_run
+    JOIN
+        "JobRun" AS replayed_job_run ON replayed_run."jobRunId" = replayed_job_run."id"
+    WHERE
+        replayed_job_run."workflowRunId" = $2::uuid
+        AND replayed_run."tenantId" = $3::uuid
+        AND replayed_run."status" = 'SUCCEEDED'
+        AND replayed_run."stepId" NOT IN (SELECT "id" FROM replayed_steps)
+)
+UPDATE
+    "StepRun" AS step_run
+SET
+    "status" = 'SUCCEEDED',
+    "input" = copied_runs."input",
+    "output" = copied_runs."output",
+    "startedAt" = copied_runs."startedAt",
+    "finishedAt" = copied_runs."finishedAt"
+FROM
+    "JobRun" AS job_run,
+    copied_runs
+WHERE
+    step_run."jobRunId" = job_run."id"
+    AND job_run."workflowRunId" = $4::uuid
+    AND step_run."tenantId" = $3::uuid
+    AND step_run."stepId" = copied_runs."stepId"
+`
+
+type CopyReplayedStepRunsParams struct {
+	Startfromstepid pgtype.UUID `json:"startfromstepid"`
+	Replayofid      pgtype.UUID `json:"replayofid"`
+	Tenantid        pgtype.UUID `json:"tenantid"`
+	Workflowrunid   pgtype.UUID `json:"workflowrunid"`
+}
+
+// Copies the step runs which succeeded in the replayed workflow run, except for the steps which are
+// replayed: the start step and every step after it.
+func (q *Queries) CopyReplayedStepRuns(ctx context.Context, db DBTX, arg CopyReplayedStepRunsParams) error {
+	_, err := db.Exec(ctx, copyReplayedStepRuns, arg.Startfromstepid, arg.Replayofid, arg.Tenantid, arg.Workflowrunid)
+	return err
+}
+
+const countWorkflowRunSLABreaches = `-- name: CountWorkflowRunSLABreaches :one
+SELECT
+    COUNT(*) AS total
+FROM
+    "WorkflowRunSLABreach" as breaches
+WHERE
+    breaches."tenantId" = $1::uuid AND
+    breaches."workflowId" = $2::uuid
+`
+
+type CountWorkflowRunSLABreachesParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Workflowid pgtype.UUID `json:"workflowid"`
+}
+
+func (q *Queries) CountWorkflowRunSLABreaches(ctx context.Context, db DBTX, arg CountWorkflowRunSLABreachesParams) (int64, error) {
+	row := db.QueryRow(ctx, countWorkflowRunSLABreaches, arg.Tenantid, arg.Workflowid)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
 const countWorkflowRuns = `-- name: CountWorkflowRuns :one
 SELECT
     count(runs) OVER() AS total
@@ -45,16 +218,31 @@ WHERE
     (
     $6::"WorkflowRunStatus" IS NULL OR
     runs."status" = $6::"WorkflowRunStatus"
+    ) AND
+    (
+    $7::timestamp IS NULL OR
+    runs."createdAt" >= $7::timestamp
+    ) AND
+    (
+    $8::"CancellationSource" IS NULL OR
+    runs."cancelledSource" = $8::"CancellationSource"
+    ) AND
+    (
+    $9::text IS NULL OR
+    runs."environment" = $9::text
     )
 `
 
 type CountWorkflowRunsParams struct {
-	TenantId          pgtype.UUID           `json:"tenantId"`
-	WorkflowVersionId pgtype.UUID           `json:"workflowVersionId"`
-	WorkflowId        pgtype.UUID           `json:"workflowId"`
-	EventId           pgtype.UUID           `json:"eventId"`
-	GroupKey          pgtype.Text           `json:"groupKey"`
-	Status            NullWorkflowRunStatus `json:"status"`
+	TenantId          pgtype.UUID            `json:"tenantId"`
+	WorkflowVersionId pgtype.UUID            `json:"workflowVersionId"`
+	WorkflowId        pgtype.UUID            `json:"workflowId"`
+	EventId           pgtype.UUID            `json:"eventId"`
+	GroupKey          pgtype.Text            `json:"groupKey"`
+	Status            NullWorkflowRunStatus  `json:"status"`
+	CreatedAfter      pgtype.Timestamp       `json:"createdAfter"`
+	CancelledSource   NullCancellationSource `json:"cancelledSource"`
+	Environment       pgtype.Text            `json:"environment"`
 }
 
 func (q *Queries) CountWorkflowRuns(ctx context.Context, db DBTX, arg CountWorkflowRunsParams) (int64, error) {
@@ -65,6 +253,9 @@ func (q *Queries) CountWorkflowRuns(ctx context.Context, db DBTX, arg CountWorkf
 		arg.EventId,
 		arg.GroupKey,
 		arg.Status,
+		arg.CreatedAfter,
+		arg.CancelledSource,
+		arg.Environment,
 	)
 	var total int64
 	err := row.Scan(&total)
@@ -320,7 +511,12 @@ INSERT INTO "WorkflowRun" (
     "status",
     "error",
     "startedAt",
-    "finishedAt"
+    "finishedAt",
+    "debug",
+    "replayOfId",
+    "additionalMetadata",
+    "environment",
+    "correlationId"
 ) VALUES (
     COALESCE($1::uuid, gen_random_uuid()),
     CURRENT_TIMESTAMP,
@@ -332,15 +528,25 @@ INSERT INTO "WorkflowRun" (
     'PENDING', -- default status
     NULL, -- assuming error is not set on creation
     NULL, -- assuming startedAt is not set on creation
-    NULL  -- assuming finishedAt is not set on creation
-) RETURNING "createdAt", "updatedAt", "deletedAt", "tenantId", "workflowVersionId", status, error, "startedAt", "finishedAt", "concurrencyGroupId", "displayName", id, "gitRepoBranch"
+    NULL, -- assuming finishedAt is not set on creation
+    COALESCE($5::boolean, false),
+    $6::uuid,
+    $7::jsonb,
+    $8::text,
+    $9::text
+) RETURNING "createdAt", "updatedAt", "deletedAt", "tenantId", "workflowVersionId", status, error, "startedAt", "finishedAt", "concurrencyGroupId", "displayName", id, "gitRepoBranch", debug, "replayOfId", "additionalMetadata", "stepRunsTotal", "stepRunsRunning", "stepRunsSucceeded", "stepRunsFailed", "stepRunsCancelled", "cancelledSource", environment, "buildId", "bufferedAt", "stepExecutions", "stepRetries", "concurrencyGroupComponents", "correlationId"
 `
 
 type CreateWorkflowRunParams struct {
-	ID                pgtype.UUID `json:"id"`
-	DisplayName       pgtype.Text `json:"displayName"`
-	Tenantid          pgtype.UUID `json:"tenantid"`
-	Workflowversionid pgtype.UUID `json:"workflowversionid"`
+	ID                 pgtype.UUID `json:"id"`
+	DisplayName        pgtype.Text `json:"displayName"`
+	Tenantid           pgtype.UUID `json:"tenantid"`
+	Workflowversionid  pgtype.UUID `json:"workflowversionid"`
+	Debug              pgtype.Bool `json:"debug"`
+	ReplayOfId         pgtype.UUID `json:"replayOfId"`
+	AdditionalMetadata []byte      `json:"additionalMetadata"`
+	Environment        pgtype.Text `json:"environment"`
+	CorrelationId      pgtype.Text `json:"correlationId"`
 }
 
 func (q *Queries) CreateWorkflowRun(ctx context.Context, db DBTX, arg CreateWorkflowRunParams) (*WorkflowRun, error) {
@@ -349,6 +555,11 @@ func (q *Queries) CreateWorkflowRun(ctx context.Context, db DBTX, arg CreateWork
 		arg.DisplayName,
 		arg.Tenantid,
 		arg.Workflowversionid,
+		arg.Debug,
+		arg.ReplayOfId,
+		arg.AdditionalMetadata,
+		arg.Environment,
+		arg.CorrelationId,
 	)
 	var i WorkflowRun
 	err := row.Scan(
@@ -365,10 +576,128 @@ func (q *Queries) CreateWorkflowRun(ctx context.Context, db DBTX, arg CreateWork
 		&i.DisplayName,
 		&i.ID,
 		&i.GitRepoBranch,
+		&i.Debug,
+		&i.ReplayOfId,
+		&i.AdditionalMetadata,
+		&i.StepRunsTotal,
+		&i.StepRunsRunning,
+		&i.StepRunsSucceeded,
+		&i.StepRunsFailed,
+		&i.StepRunsCancelled,
+		&i.CancelledSource,
+		&i.Environment,
+		&i.BuildId,
+		&i.BufferedAt,
+		&i.StepExecutions,
+		&i.StepRetries,
+		&i.ConcurrencyGroupComponents,
+		&i.CorrelationId,
 	)
 	return &i, err
 }
 
+const createWorkflowRunSLABreaches = `-- name: CreateWorkflowRunSLABreaches :many
+WITH breached_runs AS (
+    SELECT
+        runs."id",
+        runs."tenantId",
+        workflowVersion."workflowId",
+        workflowVersion."sla"
+    FROM
+        "WorkflowRun" as runs
+    JOIN
+        "WorkflowVersion" as workflowVersion ON runs."workflowVersionId" = workflowVersion."id"
+    WHERE
+        workflowVersion."sla" IS NOT NULL AND
+        runs."deletedAt" IS NULL AND
+        runs."debug" = false AND
+        runs."createdAt" + workflowVersion."sla"::interval < NOW() AND
+        (
+            runs."status" IN ('PENDING', 'QUEUED', 'RUNNING') OR
+            (
+                runs."finishedAt" > NOW() - INTERVAL '1 minute' AND
+                runs."finishedAt" > runs."createdAt" + workflowVersion."sla"::interval
+            )
+        ) AND
+        NOT EXISTS (
+            SELECT 1
+            FROM "WorkflowRunSLABreach" as existing
+            WHERE existing."workflowRunId" = runs."id"
+        )
+    LIMIT
+        $1::int
+), breaches AS (
+    INSERT INTO "WorkflowRunSLABreach" (
+        "id",
+        "createdAt",
+        "tenantId",
+        "workflowId",
+        "workflowRunId",
+        "sla"
+    )
+    SELECT
+        gen_random_uuid(),
+        NOW(),
+        breached_runs."tenantId",
+        breached_runs."workflowId",
+        breached_runs."id",
+        breached_runs."sla"
+    FROM
+        breached_runs
+    -- a run can be checked by more than one ticker at the same time
+    ON CONFLICT ("workflowRunId") DO NOTHING
+    RETURNING id, "createdAt", "tenantId", "workflowId", "workflowRunId", sla
+)
+SELECT
+    breaches.id, breaches."createdAt", breaches."tenantId", breaches."workflowId", breaches."workflowRunId", breaches.sla,
+    workflow."name" AS "workflowName"
+FROM
+    breaches
+JOIN
+    "Workflow" as workflow ON breaches."workflowId" = workflow."id"
+`
+
+type CreateWorkflowRunSLABreachesRow struct {
+	ID            pgtype.UUID      `json:"id"`
+	CreatedAt     pgtype.Timestamp `json:"createdAt"`
+	TenantId      pgtype.UUID      `json:"tenantId"`
+	WorkflowId    pgtype.UUID      `json:"workflowId"`
+	WorkflowRunId pgtype.UUID      `json:"workflowRunId"`
+	Sla           string           `json:"sla"`
+	WorkflowName  string           `json:"workflowName"`
+}
+
+// Creates a breach for each workflow run which has exceeded the SLA of its workflow version. Runs which
+// finished recently are checked along with runs which are in progress, so that a breach is recorded when a
+// run finishes between two checks. Debug runs are excluded.
+func (q *Queries) CreateWorkflowRunSLABreaches(ctx context.Context, db DBTX, limit int32) ([]*CreateWorkflowRunSLABreachesRow, error) {
+	rows, err := db.Query(ctx, createWorkflowRunSLABreaches, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*CreateWorkflowRunSLABreachesRow
+	for rows.Next() {
+		var i CreateWorkflowRunSLABreachesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.TenantId,
+			&i.WorkflowId,
+			&i.WorkflowRunId,
+			&i.Sla,
+			&i.WorkflowName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createWorkflowRunTriggeredBy = `-- name: CreateWorkflowRunTriggeredBy :one
 INSERT INTO "WorkflowRunTriggeredBy" (
     "id",
@@ -430,6 +759,86 @@ func (q *Queries) CreateWorkflowRunTriggeredBy(ctx context.Context, db DBTX, arg
 	return &i, err
 }
 
+const getWorkflowRunLastUpdatedAt = `-- name: GetWorkflowRunLastUpdatedAt :one
+SELECT
+    -- GREATEST ignores nulls, so runs without job runs, step runs or logs still have a value
+    GREATEST(
+        runs."updatedAt",
+        (SELECT MAX(jr."updatedAt") FROM "JobRun" jr WHERE jr."workflowRunId" = runs."id"),
+        (
+            SELECT MAX(sr."updatedAt")
+            FROM "StepRun" sr
+            JOIN "JobRun" jr ON sr."jobRunId" = jr."id"
+            WHERE jr."workflowRunId" = runs."id"
+        ),
+        (
+            SELECT MAX(ll."createdAt")
+            FROM "LogLine" ll
+            JOIN "StepRun" sr ON ll."stepRunId" = sr."id"
+            JOIN "JobRun" jr ON sr."jobRunId" = jr."id"
+            WHERE jr."workflowRunId" = runs."id"
+        )
+    )::timestamp AS "lastUpdatedAt"
+FROM
+    "WorkflowRun" as runs
+WHERE
+    runs."id" = $1::uuid AND
+    runs."tenantId" = $2::uuid
+`
+
+type GetWorkflowRunLastUpdatedAtParams struct {
+	Workflowrunid pgtype.UUID `json:"workflowrunid"`
+	Tenantid      pgtype.UUID `json:"tenantid"`
+}
+
+func (q *Queries) GetWorkflowRunLastUpdatedAt(ctx context.Context, db DBTX, arg GetWorkflowRunLastUpdatedAtParams) (pgtype.Timestamp, error) {
+	row := db.QueryRow(ctx, getWorkflowRunLastUpdatedAt, arg.Workflowrunid, arg.Tenantid)
+	var lastUpdatedAt pgtype.Timestamp
+	err := row.Scan(&lastUpdatedAt)
+	return lastUpdatedAt, err
+}
+
+const getWorkflowVersionPauseState = `-- name: GetWorkflowVersionPauseState :one
+SELECT
+    t."paused" AS "tenantPaused",
+    t."pausedTriggerBehavior" AS "tenantPausedTriggerBehavior",
+    w."paused" AS "workflowPaused",
+    w."pausedTriggerBehavior" AS "workflowPausedTriggerBehavior"
+FROM
+    "WorkflowVersion" as workflowVersion
+JOIN
+    "Workflow" as w ON w."id" = workflowVersion."workflowId"
+JOIN
+    "Tenant" as t ON t."id" = w."tenantId"
+WHERE
+    workflowVersion."id" = $1::uuid AND
+    w."tenantId" = $2::uuid
+`
+
+type GetWorkflowVersionPauseStateParams struct {
+	Workflowversionid pgtype.UUID `json:"workflowversionid"`
+	Tenantid          pgtype.UUID `json:"tenantid"`
+}
+
+type GetWorkflowVersionPauseStateRow struct {
+	TenantPaused                  bool                  `json:"tenantPaused"`
+	TenantPausedTriggerBehavior   PausedTriggerBehavior `json:"tenantPausedTriggerBehavior"`
+	WorkflowPaused                bool                  `json:"workflowPaused"`
+	WorkflowPausedTriggerBehavior PausedTriggerBehavior `json:"workflowPausedTriggerBehavior"`
+}
+
+func (q *Queries) GetWorkflowVersionPauseState(ctx context.Context, db DBTX, arg GetWorkflowVersionPauseStateParams) (*GetWorkflowVersionPauseStateRow, error) {
+	row := db.QueryRow(ctx, getWorkflowVersionPauseState, arg.Workflowversionid, arg.Tenantid)
+	var i GetWorkflowVersionPauseStateRow
+	err := row.Scan(
+		&i.TenantPaused,
+		&i.TenantPausedTriggerBehavior,
+		&i.WorkflowPaused,
+		&i.WorkflowPausedTriggerBehavior,
+	)
+	return &i, err
+}
+
 const linkStepRunParents = `-- name: LinkStepRunParents :exec
 INSERT INTO "_StepRunOrder" ("A", "B")
 SELECT 
@@ -448,16 +857,109 @@ func (q *Queries) LinkStepRunParents(ctx context.Context, db DBTX, jobrunid pgty
 	return err
 }
 
+const listFailedWorkflowRunsForRetry = `-- name: ListFailedWorkflowRunsForRetry :many
+SELECT
+    runs."id",
+    runs."createdAt"
+FROM
+    "WorkflowRun" as runs
+JOIN
+    "WorkflowVersion" as workflowVersion ON runs."workflowVersionId" = workflowVersion."id"
+WHERE
+    runs."tenantId" = $1::uuid AND
+    runs."deletedAt" IS NULL AND
+    runs."status" = 'FAILED' AND
+    -- only consider runs which existed when the bulk retry was created, so a retry which fails
+    -- again is not picked up a second time
+    runs."createdAt" < $2::timestamp AND
+    (
+        $3::uuid IS NULL OR
+        workflowVersion."workflowId" = $3::uuid
+    ) AND
+    (
+        $4::timestamp IS NULL OR
+        runs."createdAt" >= $4::timestamp
+    ) AND
+    (
+        $5::text IS NULL OR
+        strpos(runs."error", $5::text) > 0 OR
+        EXISTS (
+            SELECT 1
+            FROM "JobRun" jr
+            JOIN "StepRun" sr ON sr."jobRunId" = jr."id"
+            WHERE
+                jr."workflowRunId" = runs."id" AND
+                sr."status" = 'FAILED' AND
+                strpos(sr."error", $5::text) > 0
+        )
+    ) AND
+    (
+        $6::timestamp IS NULL OR
+        (runs."createdAt", runs."id") > ($6::timestamp, $7::uuid)
+    )
+ORDER BY
+    runs."createdAt" ASC, runs."id" ASC
+LIMIT
+    $8::int
+`
+
+type ListFailedWorkflowRunsForRetryParams struct {
+	Tenantid       pgtype.UUID      `json:"tenantid"`
+	Createdbefore  pgtype.Timestamp `json:"createdbefore"`
+	WorkflowId     pgtype.UUID      `json:"workflowId"`
+	CreatedAfter   pgtype.Timestamp `json:"createdAfter"`
+	ErrorContains  pgtype.Text      `json:"errorContains"`
+	AfterCreatedAt pgtype.Timestamp `json:"afterCreatedAt"`
+	AfterId        pgtype.UUID      `json:"afterId"`
+	Limit          int32            `json:"limit"`
+}
+
+type ListFailedWorkflowRunsForRetryRow struct {
+	ID        pgtype.UUID      `json:"id"`
+	CreatedAt pgtype.Timestamp `json:"createdAt"`
+}
+
+func (q *Queries) ListFailedWorkflowRunsForRetry(ctx context.Context, db DBTX, arg ListFailedWorkflowRunsForRetryParams) ([]*ListFailedWorkflowRunsForRetryRow, error) {
+	rows, err := db.Query(ctx, listFailedWorkflowRunsForRetry,
+		arg.Tenantid,
+		arg.Createdbefore,
+		arg.WorkflowId,
+		arg.CreatedAfter,
+		arg.ErrorContains,
+		arg.AfterCreatedAt,
+		arg.AfterId,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListFailedWorkflowRunsForRetryRow
+	for rows.Next() {
+		var i ListFailedWorkflowRunsForRetryRow
+		if err := rows.Scan(&i.ID, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listStartableStepRuns = `-- name: ListStartableStepRuns :many
 WITH job_run AS (
     SELECT "status"
     FROM "JobRun"
     WHERE "id" = $1::uuid
 )
-SELECT 
+SELECT DISTINCT
     child_run."id" AS "id"
 FROM 
     "StepRun" AS child_run
+JOIN
+    "Step" AS child_step ON child_step."id" = child_run."stepId"
 LEFT JOIN 
     "_StepRunOrder" AS step_run_order ON step_run_order."B" = child_run."id"
 JOIN
@@ -466,20 +968,26 @@ WHERE
     child_run."jobRunId" = $1::uuid
     AND child_run."status" = 'PENDING'
     AND job_run."status" = 'RUNNING'
-    -- case on whether parentStepRunId is null
+    -- case on whether parentStepRunId is null. when it is null, the job run is starting, so every step
+    -- run whose parents have succeeded is startable: these are the step runs without parents, and the
+    -- step runs after the step runs which were copied from a replayed run.
     AND (
-        ($2::uuid IS NULL AND step_run_order."A" IS NULL) OR 
-        (
-            step_run_order."A" = $2::uuid
-            AND NOT EXISTS (
-                SELECT 1
-                FROM "_StepRunOrder" AS parent_order
-                JOIN "StepRun" AS parent_run ON parent_order."A" = parent_run."id"
-                WHERE 
-                    parent_order."B" = child_run."id"
-                    AND parent_run."status" != 'SUCCEEDED'
-            )
-        )
+        $2::uuid IS NULL OR
+        step_run_order."A" = $2::uuid
+    )
+    -- the join strategy of the step sets how many parents must have succeeded: every parent by default, or
+    -- a single parent or a quorum of parents for join steps
+    AND (
+        SELECT
+            COUNT(*) FILTER (WHERE parent_run."status" = 'SUCCEEDED') >= CASE child_step."joinStrategy"
+                WHEN 'ANY' THEN 1
+                WHEN 'QUORUM' THEN child_step."joinQuorum"
+                ELSE COUNT(*)
+            END
+        FROM "_StepRunOrder" AS parent_order
+        JOIN "StepRun" AS parent_run ON parent_order."A" = parent_run."id"
+        WHERE 
+            parent_order."B" = child_run."id"
     )
 `
 
@@ -508,73 +1016,379 @@ func (q *Queries) ListStartableStepRuns(ctx context.Context, db DBTX, arg ListSt
 	return items, nil
 }
 
-const listWorkflowRuns = `-- name: ListWorkflowRuns :many
+const listWorkflowConcurrencyQueueDepth = `-- name: ListWorkflowConcurrencyQueueDepth :many
+WITH runs AS (
+    SELECT
+        runs."status",
+        runs."concurrencyGroupId",
+        runs."concurrencyGroupComponents"
+    FROM
+        "WorkflowRun" as runs
+    JOIN
+        "WorkflowVersion" as workflowVersion ON runs."workflowVersionId" = workflowVersion."id"
+    WHERE
+        runs."tenantId" = $1::uuid AND
+        runs."deletedAt" IS NULL AND
+        workflowVersion."workflowId" = $2::uuid AND
+        runs."status" IN ('QUEUED', 'RUNNING') AND
+        runs."concurrencyGroupId" IS NOT NULL
+), keys AS (
+    -- position 0 is the group key itself, which combines the components
+    SELECT
+        0 AS "position",
+        runs."concurrencyGroupId" AS "value",
+        runs."status"
+    FROM
+        runs
+    UNION ALL
+    SELECT
+        components."position",
+        components."value",
+        runs."status"
+    FROM
+        runs
+    CROSS JOIN LATERAL
+        unnest(runs."concurrencyGroupComponents") WITH ORDINALITY AS components("value", "position")
+), counts AS (
+    SELECT
+        keys."position",
+        keys."value",
+        COUNT(*) FILTER (WHERE keys."status" = 'QUEUED') AS "queued",
+        COUNT(*) FILTER (WHERE keys."status" = 'RUNNING') AS "running"
+    FROM
+        keys
+    GROUP BY
+        keys."position", keys."value"
+), ranked AS (
+    SELECT
+        counts.*,
+        row_number() OVER (PARTITION BY counts."position" ORDER BY counts."queued" DESC, counts."running" DESC, counts."value") AS "rank"
+    FROM
+        counts
+)
 SELECT
-    runs."createdAt", runs."updatedAt", runs."deletedAt", runs."tenantId", runs."workflowVersionId", runs.status, runs.error, runs."startedAt", runs."finishedAt", runs."concurrencyGroupId", runs."displayName", runs.id, runs."gitRepoBranch", 
-    workflow.id, workflow."createdAt", workflow."updatedAt", workflow."deletedAt", workflow."tenantId", workflow.name, workflow.description, 
-    runtriggers.id, runtriggers."createdAt", runtriggers."updatedAt", runtriggers."deletedAt", runtriggers."tenantId", runtriggers."eventId", runtriggers."cronParentId", runtriggers."cronSchedule", runtriggers."scheduledId", runtriggers.input, runtriggers."parentId", 
-    workflowversion.id, workflowversion."createdAt", workflowversion."updatedAt", workflowversion."deletedAt", workflowversion.version, workflowversion."order", workflowversion."workflowId", workflowversion.checksum, workflowversion."scheduleTimeout", 
-    -- waiting on https://github.com/sqlc-dev/sqlc/pull/2858 for nullable events field
-    events.id, events.key, events."createdAt", events."updatedAt"
+    ranked."position"::int AS "position",
+    ranked."value"::text AS "value",
+    ranked."queued"::bigint AS "queued",
+    ranked."running"::bigint AS "running"
 FROM
-    "WorkflowRun" as runs 
-LEFT JOIN
-    "WorkflowRunTriggeredBy" as runTriggers ON runTriggers."parentId" = runs."id"
-LEFT JOIN
-    "Event" as events ON runTriggers."eventId" = events."id"
-LEFT JOIN
-    "WorkflowVersion" as workflowVersion ON runs."workflowVersionId" = workflowVersion."id"
-LEFT JOIN
-    "Workflow" as workflow ON workflowVersion."workflowId" = workflow."id"
+    ranked
 WHERE
-    runs."tenantId" = $1 AND
-    (
-        $2::uuid IS NULL OR
-        workflowVersion."id" = $2::uuid
-    ) AND
-    (
-        $3::uuid IS NULL OR
-        workflow."id" = $3::uuid
-    ) AND
-    (
-        $4::uuid IS NULL OR
-        events."id" = $4::uuid
-    ) AND
-    (
-    $5::text IS NULL OR
-    runs."concurrencyGroupId" = $5::text
-    ) AND
-    (
-    $6::"WorkflowRunStatus" IS NULL OR
-    runs."status" = $6::"WorkflowRunStatus"
-    )
+    ranked."rank" <= $3::int
 ORDER BY
-    case when $7 = 'createdAt ASC' THEN runs."createdAt" END ASC ,
-    case when $7 = 'createdAt DESC' then runs."createdAt" END DESC
-OFFSET
-    COALESCE($8, 0)
-LIMIT
-    COALESCE($9, 50)
+    ranked."position" ASC, ranked."rank" ASC
 `
 
-type ListWorkflowRunsParams struct {
-	TenantId          pgtype.UUID           `json:"tenantId"`
-	WorkflowVersionId pgtype.UUID           `json:"workflowVersionId"`
-	WorkflowId        pgtype.UUID           `json:"workflowId"`
-	EventId           pgtype.UUID           `json:"eventId"`
-	GroupKey          pgtype.Text           `json:"groupKey"`
-	Status            NullWorkflowRunStatus `json:"status"`
-	Orderby           interface{}           `json:"orderby"`
-	Offset            interface{}           `json:"offset"`
-	Limit             interface{}           `json:"limit"`
+type ListWorkflowConcurrencyQueueDepthParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Workflowid pgtype.UUID `json:"workflowid"`
+	Limit      int32       `json:"limit"`
 }
 
-type ListWorkflowRunsRow struct {
-	WorkflowRun            WorkflowRun            `json:"workflow_run"`
-	Workflow               Workflow               `json:"workflow"`
-	WorkflowRunTriggeredBy WorkflowRunTriggeredBy `json:"workflow_run_triggered_by"`
-	WorkflowVersion        WorkflowVersion        `json:"workflow_version"`
-	ID                     pgtype.UUID            `json:"id"`
+type ListWorkflowConcurrencyQueueDepthRow struct {
+	Position int32  `json:"position"`
+	Value    string `json:"value"`
+	Queued   int64  `json:"queued"`
+	Running  int64  `json:"running"`
+}
+
+// Counts the queued and running runs of a workflow by their concurrency group key, and by each component of the
+// key, so that the queue depth can be aggregated along each key expression. For each position, only the values
+// with the most queued runs are returned, up to the limit.
+func (q *Queries) ListWorkflowConcurrencyQueueDepth(ctx context.Context, db DBTX, arg ListWorkflowConcurrencyQueueDepthParams) ([]*ListWorkflowConcurrencyQueueDepthRow, error) {
+	rows, err := db.Query(ctx, listWorkflowConcurrencyQueueDepth, arg.Tenantid, arg.Workflowid, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkflowConcurrencyQueueDepthRow
+	for rows.Next() {
+		var i ListWorkflowConcurrencyQueueDepthRow
+		if err := rows.Scan(
+			&i.Position,
+			&i.Value,
+			&i.Queued,
+			&i.Running,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkflowRunMetrics = `-- name: ListWorkflowRunMetrics :many
+WITH metrics AS (
+    SELECT
+        wv."workflowId",
+        date_trunc($1::text, runs."finishedAt") AS "bucket",
+        COUNT(*) FILTER (WHERE runs."status" = 'SUCCEEDED') AS "succeeded",
+        COUNT(*) FILTER (WHERE runs."status" = 'FAILED') AS "failed",
+        SUM(GREATEST(EXTRACT(EPOCH FROM (runs."finishedAt" - COALESCE(runs."startedAt", runs."createdAt"))), 0)) AS "durationSeconds"
+    FROM
+        "WorkflowRun" runs
+    JOIN
+        "WorkflowVersion" wv ON runs."workflowVersionId" = wv."id"
+    WHERE
+        runs."tenantId" = $2::uuid
+        AND runs."deletedAt" IS NULL
+        AND runs."debug" = false
+        AND runs."status" IN ('SUCCEEDED', 'FAILED')
+        AND runs."finishedAt" >= $3::timestamp
+        AND runs."finishedAt" < $4::timestamp
+        AND ($5::uuid IS NULL OR wv."workflowId" = $5::uuid)
+    GROUP BY
+        1, 2
+    UNION ALL
+    SELECT
+        rollups."workflowId",
+        date_trunc($1::text, rollups."hour"),
+        SUM(rollups."succeeded"),
+        SUM(rollups."failed"),
+        SUM(rollups."durationSeconds")
+    FROM
+        "WorkflowRunRollup" rollups
+    WHERE
+        rollups."tenantId" = $2::uuid
+        AND rollups."hour" >= $3::timestamp
+        AND rollups."hour" < $4::timestamp
+        AND ($5::uuid IS NULL OR rollups."workflowId" = $5::uuid)
+    GROUP BY
+        1, 2
+)
+SELECT
+    metrics."workflowId",
+    w."name" AS "workflowName",
+    metrics."bucket"::timestamp AS "bucket",
+    SUM(metrics."succeeded")::bigint AS "succeeded",
+    SUM(metrics."failed")::bigint AS "failed",
+    SUM(metrics."durationSeconds")::float8 AS "durationSeconds"
+FROM
+    metrics
+JOIN
+    "Workflow" w ON metrics."workflowId" = w."id"
+WHERE
+    w."deletedAt" IS NULL
+GROUP BY
+    metrics."workflowId",
+    w."name",
+    metrics."bucket"
+ORDER BY
+    "bucket" ASC,
+    "workflowName" ASC
+`
+
+type ListWorkflowRunMetricsParams struct {
+	Bucket     string           `json:"bucket"`
+	Tenantid   pgtype.UUID      `json:"tenantid"`
+	Since      pgtype.Timestamp `json:"since"`
+	Until      pgtype.Timestamp `json:"until"`
+	WorkflowId pgtype.UUID      `json:"workflowId"`
+}
+
+type ListWorkflowRunMetricsRow struct {
+	WorkflowId      pgtype.UUID      `json:"workflowId"`
+	WorkflowName    string           `json:"workflowName"`
+	Bucket          pgtype.Timestamp `json:"bucket"`
+	Succeeded       int64            `json:"succeeded"`
+	Failed          int64            `json:"failed"`
+	DurationSeconds float64          `json:"durationSeconds"`
+}
+
+// Returns the number of runs of the workflows of a tenant which succeeded and failed in a time range, and their
+// total duration, grouped by workflow and time bucket. Runs which were purged are read from the hourly rollups of
+// their workflow, so that the metrics remain accurate beyond the retention of the runs. Debug runs are excluded.
+func (q *Queries) ListWorkflowRunMetrics(ctx context.Context, db DBTX, arg ListWorkflowRunMetricsParams) ([]*ListWorkflowRunMetricsRow, error) {
+	rows, err := db.Query(ctx, listWorkflowRunMetrics,
+		arg.Bucket,
+		arg.Tenantid,
+		arg.Since,
+		arg.Until,
+		arg.WorkflowId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkflowRunMetricsRow
+	for rows.Next() {
+		var i ListWorkflowRunMetricsRow
+		if err := rows.Scan(
+			&i.WorkflowId,
+			&i.WorkflowName,
+			&i.Bucket,
+			&i.Succeeded,
+			&i.Failed,
+			&i.DurationSeconds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkflowRunSLABreaches = `-- name: ListWorkflowRunSLABreaches :many
+SELECT
+    breaches.id, breaches."createdAt", breaches."tenantId", breaches."workflowId", breaches."workflowRunId", breaches.sla,
+    runs."workflowVersionId",
+    runs."status" AS "workflowRunStatus",
+    runs."createdAt" AS "workflowRunCreatedAt",
+    runs."finishedAt" AS "workflowRunFinishedAt"
+FROM
+    "WorkflowRunSLABreach" as breaches
+JOIN
+    "WorkflowRun" as runs ON breaches."workflowRunId" = runs."id"
+WHERE
+    breaches."tenantId" = $1::uuid AND
+    breaches."workflowId" = $2::uuid
+ORDER BY
+    breaches."createdAt" DESC, breaches."id" DESC
+LIMIT
+    COALESCE($3, 50)
+OFFSET
+    COALESCE($4, 0)
+`
+
+type ListWorkflowRunSLABreachesParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Workflowid pgtype.UUID `json:"workflowid"`
+	Limit      interface{} `json:"limit"`
+	Offset     interface{} `json:"offset"`
+}
+
+type ListWorkflowRunSLABreachesRow struct {
+	WorkflowRunSLABreach  WorkflowRunSLABreach `json:"workflow_run_sla_breach"`
+	WorkflowVersionId     pgtype.UUID          `json:"workflowVersionId"`
+	WorkflowRunStatus     WorkflowRunStatus    `json:"workflowRunStatus"`
+	WorkflowRunCreatedAt  pgtype.Timestamp     `json:"workflowRunCreatedAt"`
+	WorkflowRunFinishedAt pgtype.Timestamp     `json:"workflowRunFinishedAt"`
+}
+
+func (q *Queries) ListWorkflowRunSLABreaches(ctx context.Context, db DBTX, arg ListWorkflowRunSLABreachesParams) ([]*ListWorkflowRunSLABreachesRow, error) {
+	rows, err := db.Query(ctx, listWorkflowRunSLABreaches,
+		arg.Tenantid,
+		arg.Workflowid,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkflowRunSLABreachesRow
+	for rows.Next() {
+		var i ListWorkflowRunSLABreachesRow
+		if err := rows.Scan(
+			&i.WorkflowRunSLABreach.ID,
+			&i.WorkflowRunSLABreach.CreatedAt,
+			&i.WorkflowRunSLABreach.TenantId,
+			&i.WorkflowRunSLABreach.WorkflowId,
+			&i.WorkflowRunSLABreach.WorkflowRunId,
+			&i.WorkflowRunSLABreach.Sla,
+			&i.WorkflowVersionId,
+			&i.WorkflowRunStatus,
+			&i.WorkflowRunCreatedAt,
+			&i.WorkflowRunFinishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkflowRuns = `-- name: ListWorkflowRuns :many
+SELECT
+    runs."createdAt", runs."updatedAt", runs."deletedAt", runs."tenantId", runs."workflowVersionId", runs.status, runs.error, runs."startedAt", runs."finishedAt", runs."concurrencyGroupId", runs."displayName", runs.id, runs."gitRepoBranch", runs.debug, runs."replayOfId", runs."additionalMetadata", runs."stepRunsTotal", runs."stepRunsRunning", runs."stepRunsSucceeded", runs."stepRunsFailed", runs."stepRunsCancelled", runs."cancelledSource", runs.environment, runs."buildId", runs."bufferedAt", runs."stepExecutions", runs."stepRetries", runs."concurrencyGroupComponents", runs."correlationId", 
+    workflow.id, workflow."createdAt", workflow."updatedAt", workflow."deletedAt", workflow."tenantId", workflow.name, workflow.description, workflow.paused, workflow."pausedTriggerBehavior", workflow."maintenanceUntil", workflow."diagnosticsSampleRate", 
+    runtriggers.id, runtriggers."createdAt", runtriggers."updatedAt", runtriggers."deletedAt", runtriggers."tenantId", runtriggers."eventId", runtriggers."cronParentId", runtriggers."cronSchedule", runtriggers."scheduledId", runtriggers.input, runtriggers."parentId", 
+    workflowversion.id, workflowversion."createdAt", workflowversion."updatedAt", workflowversion."deletedAt", workflowversion.version, workflowversion."order", workflowversion."workflowId", workflowversion.checksum, workflowversion."scheduleTimeout", workflowversion.sla, workflowversion."defaultInput", workflowversion."inputSchema", workflowversion."pinToBuild", workflowversion."assignmentStrategy", workflowversion."maxStepExecutions", workflowversion."maxStepRetries", workflowversion."costCenter", 
+    -- waiting on https://github.com/sqlc-dev/sqlc/pull/2858 for nullable events field
+    events.id, events.key, events."createdAt", events."updatedAt"
+FROM
+    "WorkflowRun" as runs 
+LEFT JOIN
+    "WorkflowRunTriggeredBy" as runTriggers ON runTriggers."parentId" = runs."id"
+LEFT JOIN
+    "Event" as events ON runTriggers."eventId" = events."id"
+LEFT JOIN
+    "WorkflowVersion" as workflowVersion ON runs."workflowVersionId" = workflowVersion."id"
+LEFT JOIN
+    "Workflow" as workflow ON workflowVersion."workflowId" = workflow."id"
+WHERE
+    runs."tenantId" = $1 AND
+    (
+        $2::uuid IS NULL OR
+        workflowVersion."id" = $2::uuid
+    ) AND
+    (
+        $3::uuid IS NULL OR
+        workflow."id" = $3::uuid
+    ) AND
+    (
+        $4::uuid IS NULL OR
+        events."id" = $4::uuid
+    ) AND
+    (
+    $5::text IS NULL OR
+    runs."concurrencyGroupId" = $5::text
+    ) AND
+    (
+    $6::"WorkflowRunStatus" IS NULL OR
+    runs."status" = $6::"WorkflowRunStatus"
+    ) AND
+    (
+    $7::timestamp IS NULL OR
+    runs."createdAt" >= $7::timestamp
+    ) AND
+    (
+    $8::"CancellationSource" IS NULL OR
+    runs."cancelledSource" = $8::"CancellationSource"
+    ) AND
+    (
+    $9::text IS NULL OR
+    runs."environment" = $9::text
+    )
+ORDER BY
+    case when $10 = 'createdAt ASC' THEN runs."createdAt" END ASC ,
+    case when $10 = 'createdAt DESC' then runs."createdAt" END DESC
+OFFSET
+    COALESCE($11, 0)
+LIMIT
+    COALESCE($12, 50)
+`
+
+type ListWorkflowRunsParams struct {
+	TenantId          pgtype.UUID            `json:"tenantId"`
+	WorkflowVersionId pgtype.UUID            `json:"workflowVersionId"`
+	WorkflowId        pgtype.UUID            `json:"workflowId"`
+	EventId           pgtype.UUID            `json:"eventId"`
+	GroupKey          pgtype.Text            `json:"groupKey"`
+	Status            NullWorkflowRunStatus  `json:"status"`
+	CreatedAfter      pgtype.Timestamp       `json:"createdAfter"`
+	CancelledSource   NullCancellationSource `json:"cancelledSource"`
+	Environment       pgtype.Text            `json:"environment"`
+	Orderby           interface{}            `json:"orderby"`
+	Offset            interface{}            `json:"offset"`
+	Limit             interface{}            `json:"limit"`
+}
+
+type ListWorkflowRunsRow struct {
+	WorkflowRun            WorkflowRun            `json:"workflow_run"`
+	Workflow               Workflow               `json:"workflow"`
+	WorkflowRunTriggeredBy WorkflowRunTriggeredBy `json:"workflow_run_triggered_by"`
+	WorkflowVersion        WorkflowVersion        `json:"workflow_version"`
+	ID                     pgtype.UUID            `json:"id"`
 	Key                    pgtype.Text            `json:"key"`
 	CreatedAt              pgtype.Timestamp       `json:"createdAt"`
 	UpdatedAt              pgtype.Timestamp       `json:"updatedAt"`
@@ -588,6 +1402,9 @@ func (q *Queries) ListWorkflowRuns(ctx context.Context, db DBTX, arg ListWorkflo
 		arg.EventId,
 		arg.GroupKey,
 		arg.Status,
+		arg.CreatedAfter,
+		arg.CancelledSource,
+		arg.Environment,
 		arg.Orderby,
 		arg.Offset,
 		arg.Limit,
@@ -613,6 +1430,22 @@ func (q *Queries) ListWorkflowRuns(ctx context.Context, db DBTX, arg ListWorkflo
 			&i.WorkflowRun.DisplayName,
 			&i.WorkflowRun.ID,
 			&i.WorkflowRun.GitRepoBranch,
+			&i.WorkflowRun.Debug,
+			&i.WorkflowRun.ReplayOfId,
+			&i.WorkflowRun.AdditionalMetadata,
+			&i.WorkflowRun.StepRunsTotal,
+			&i.WorkflowRun.StepRunsRunning,
+			&i.WorkflowRun.StepRunsSucceeded,
+			&i.WorkflowRun.StepRunsFailed,
+			&i.WorkflowRun.StepRunsCancelled,
+			&i.WorkflowRun.CancelledSource,
+			&i.WorkflowRun.Environment,
+			&i.WorkflowRun.BuildId,
+			&i.WorkflowRun.BufferedAt,
+			&i.WorkflowRun.StepExecutions,
+			&i.WorkflowRun.StepRetries,
+			&i.WorkflowRun.ConcurrencyGroupComponents,
+			&i.WorkflowRun.CorrelationId,
 			&i.Workflow.ID,
 			&i.Workflow.CreatedAt,
 			&i.Workflow.UpdatedAt,
@@ -620,6 +1453,10 @@ func (q *Queries) ListWorkflowRuns(ctx context.Context, db DBTX, arg ListWorkflo
 			&i.Workflow.TenantId,
 			&i.Workflow.Name,
 			&i.Workflow.Description,
+			&i.Workflow.Paused,
+			&i.Workflow.PausedTriggerBehavior,
+			&i.Workflow.MaintenanceUntil,
+			&i.Workflow.DiagnosticsSampleRate,
 			&i.WorkflowRunTriggeredBy.ID,
 			&i.WorkflowRunTriggeredBy.CreatedAt,
 			&i.WorkflowRunTriggeredBy.UpdatedAt,
@@ -640,6 +1477,14 @@ func (q *Queries) ListWorkflowRuns(ctx context.Context, db DBTX, arg ListWorkflo
 			&i.WorkflowVersion.WorkflowId,
 			&i.WorkflowVersion.Checksum,
 			&i.WorkflowVersion.ScheduleTimeout,
+			&i.WorkflowVersion.Sla,
+			&i.WorkflowVersion.DefaultInput,
+			&i.WorkflowVersion.InputSchema,
+			&i.WorkflowVersion.PinToBuild,
+			&i.WorkflowVersion.AssignmentStrategy,
+			&i.WorkflowVersion.MaxStepExecutions,
+			&i.WorkflowVersion.MaxStepRetries,
+			&i.WorkflowVersion.CostCenter,
 			&i.ID,
 			&i.Key,
 			&i.CreatedAt,
@@ -655,6 +1500,206 @@ func (q *Queries) ListWorkflowRuns(ctx context.Context, db DBTX, arg ListWorkflo
 	return items, nil
 }
 
+const listWorkflowRunsForConcurrencySimulation = `-- name: ListWorkflowRunsForConcurrencySimulation :many
+SELECT
+    runs."id",
+    runs."createdAt",
+    runs."startedAt",
+    runs."finishedAt",
+    runs."status",
+    runs."concurrencyGroupId",
+    runs."additionalMetadata",
+    triggeredBy."input"
+FROM
+    "WorkflowRun" as runs
+JOIN
+    "WorkflowVersion" as workflowVersion ON runs."workflowVersionId" = workflowVersion."id"
+LEFT JOIN
+    "WorkflowRunTriggeredBy" as triggeredBy ON triggeredBy."parentId" = runs."id"
+WHERE
+    runs."tenantId" = $1::uuid AND
+    runs."deletedAt" IS NULL AND
+    workflowVersion."workflowId" = $2::uuid AND
+    runs."createdAt" >= $3::timestamp
+ORDER BY
+    runs."createdAt" ASC, runs."id" ASC
+LIMIT
+    $4::int
+`
+
+type ListWorkflowRunsForConcurrencySimulationParams struct {
+	Tenantid     pgtype.UUID      `json:"tenantid"`
+	Workflowid   pgtype.UUID      `json:"workflowid"`
+	Createdafter pgtype.Timestamp `json:"createdafter"`
+	Limit        int32            `json:"limit"`
+}
+
+type ListWorkflowRunsForConcurrencySimulationRow struct {
+	ID                 pgtype.UUID       `json:"id"`
+	CreatedAt          pgtype.Timestamp  `json:"createdAt"`
+	StartedAt          pgtype.Timestamp  `json:"startedAt"`
+	FinishedAt         pgtype.Timestamp  `json:"finishedAt"`
+	Status             WorkflowRunStatus `json:"status"`
+	ConcurrencyGroupId pgtype.Text       `json:"concurrencyGroupId"`
+	AdditionalMetadata []byte            `json:"additionalMetadata"`
+	Input              []byte            `json:"input"`
+}
+
+// Lists the runs of a workflow which were created after the given time, across all of its versions, along with
+// their input, in creation order.
+func (q *Queries) ListWorkflowRunsForConcurrencySimulation(ctx context.Context, db DBTX, arg ListWorkflowRunsForConcurrencySimulationParams) ([]*ListWorkflowRunsForConcurrencySimulationRow, error) {
+	rows, err := db.Query(ctx, listWorkflowRunsForConcurrencySimulation,
+		arg.Tenantid,
+		arg.Workflowid,
+		arg.Createdafter,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkflowRunsForConcurrencySimulationRow
+	for rows.Next() {
+		var i ListWorkflowRunsForConcurrencySimulationRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.StartedAt,
+			&i.FinishedAt,
+			&i.Status,
+			&i.ConcurrencyGroupId,
+			&i.AdditionalMetadata,
+			&i.Input,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkflowRunsForExport = `-- name: ListWorkflowRunsForExport :many
+SELECT
+    runs."createdAt", runs."updatedAt", runs."deletedAt", runs."tenantId", runs."workflowVersionId", runs.status, runs.error, runs."startedAt", runs."finishedAt", runs."concurrencyGroupId", runs."displayName", runs.id, runs."gitRepoBranch", runs.debug, runs."replayOfId", runs."additionalMetadata", runs."stepRunsTotal", runs."stepRunsRunning", runs."stepRunsSucceeded", runs."stepRunsFailed", runs."stepRunsCancelled", runs."cancelledSource", runs.environment, runs."buildId", runs."bufferedAt", runs."stepExecutions", runs."stepRetries", runs."concurrencyGroupComponents", runs."correlationId",
+    workflow."id" AS "workflowId",
+    workflow."name" AS "workflowName"
+FROM
+    "WorkflowRun" as runs
+JOIN
+    "WorkflowVersion" as workflowVersion ON runs."workflowVersionId" = workflowVersion."id"
+JOIN
+    "Workflow" as workflow ON workflowVersion."workflowId" = workflow."id"
+WHERE
+    runs."tenantId" = $1::uuid AND
+    runs."deletedAt" IS NULL AND
+    (
+        $2::uuid IS NULL OR
+        workflow."id" = $2::uuid
+    ) AND
+    (
+        $3::"WorkflowRunStatus" IS NULL OR
+        runs."status" = $3::"WorkflowRunStatus"
+    ) AND
+    (
+        $4::timestamp IS NULL OR
+        runs."createdAt" >= $4::timestamp
+    ) AND
+    (
+        $5::timestamp IS NULL OR
+        runs."createdAt" < $5::timestamp
+    ) AND
+    (
+        -- keyset pagination on (createdAt, id) so exports are stable while new runs are created
+        $6::timestamp IS NULL OR
+        (runs."createdAt", runs."id") > ($6::timestamp, $7::uuid)
+    )
+ORDER BY
+    runs."createdAt" ASC, runs."id" ASC
+LIMIT
+    $8::int
+`
+
+type ListWorkflowRunsForExportParams struct {
+	Tenantid       pgtype.UUID           `json:"tenantid"`
+	WorkflowId     pgtype.UUID           `json:"workflowId"`
+	Status         NullWorkflowRunStatus `json:"status"`
+	CreatedAfter   pgtype.Timestamp      `json:"createdAfter"`
+	CreatedBefore  pgtype.Timestamp      `json:"createdBefore"`
+	AfterCreatedAt pgtype.Timestamp      `json:"afterCreatedAt"`
+	AfterId        pgtype.UUID           `json:"afterId"`
+	Limit          int32                 `json:"limit"`
+}
+
+type ListWorkflowRunsForExportRow struct {
+	WorkflowRun  WorkflowRun `json:"workflow_run"`
+	WorkflowId   pgtype.UUID `json:"workflowId"`
+	WorkflowName string      `json:"workflowName"`
+}
+
+func (q *Queries) ListWorkflowRunsForExport(ctx context.Context, db DBTX, arg ListWorkflowRunsForExportParams) ([]*ListWorkflowRunsForExportRow, error) {
+	rows, err := db.Query(ctx, listWorkflowRunsForExport,
+		arg.Tenantid,
+		arg.WorkflowId,
+		arg.Status,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.AfterCreatedAt,
+		arg.AfterId,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkflowRunsForExportRow
+	for rows.Next() {
+		var i ListWorkflowRunsForExportRow
+		if err := rows.Scan(
+			&i.WorkflowRun.CreatedAt,
+			&i.WorkflowRun.UpdatedAt,
+			&i.WorkflowRun.DeletedAt,
+			&i.WorkflowRun.TenantId,
+			&i.WorkflowRun.WorkflowVersionId,
+			&i.WorkflowRun.Status,
+			&i.WorkflowRun.Error,
+			&i.WorkflowRun.StartedAt,
+			&i.WorkflowRun.FinishedAt,
+			&i.WorkflowRun.ConcurrencyGroupId,
+			&i.WorkflowRun.DisplayName,
+			&i.WorkflowRun.ID,
+			&i.WorkflowRun.GitRepoBranch,
+			&i.WorkflowRun.Debug,
+			&i.WorkflowRun.ReplayOfId,
+			&i.WorkflowRun.AdditionalMetadata,
+			&i.WorkflowRun.StepRunsTotal,
+			&i.WorkflowRun.StepRunsRunning,
+			&i.WorkflowRun.StepRunsSucceeded,
+			&i.WorkflowRun.StepRunsFailed,
+			&i.WorkflowRun.StepRunsCancelled,
+			&i.WorkflowRun.CancelledSource,
+			&i.WorkflowRun.Environment,
+			&i.WorkflowRun.BuildId,
+			&i.WorkflowRun.BufferedAt,
+			&i.WorkflowRun.StepExecutions,
+			&i.WorkflowRun.StepRetries,
+			&i.WorkflowRun.ConcurrencyGroupComponents,
+			&i.WorkflowRun.CorrelationId,
+			&i.WorkflowId,
+			&i.WorkflowName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const popWorkflowRunsRoundRobin = `-- name: PopWorkflowRunsRoundRobin :many
 WITH running_count AS (
     SELECT
@@ -699,7 +1744,7 @@ FROM
 WHERE
     "WorkflowRun".id = eligible_runs.id
 RETURNING
-    "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."gitRepoBranch"
+    "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."gitRepoBranch", "WorkflowRun".debug, "WorkflowRun"."replayOfId", "WorkflowRun"."additionalMetadata", "WorkflowRun"."stepRunsTotal", "WorkflowRun"."stepRunsRunning", "WorkflowRun"."stepRunsSucceeded", "WorkflowRun"."stepRunsFailed", "WorkflowRun"."stepRunsCancelled", "WorkflowRun"."cancelledSource", "WorkflowRun".environment, "WorkflowRun"."buildId", "WorkflowRun"."bufferedAt", "WorkflowRun"."stepExecutions", "WorkflowRun"."stepRetries", "WorkflowRun"."concurrencyGroupComponents", "WorkflowRun"."correlationId"
 `
 
 type PopWorkflowRunsRoundRobinParams struct {
@@ -731,6 +1776,95 @@ func (q *Queries) PopWorkflowRunsRoundRobin(ctx context.Context, db DBTX, arg Po
 			&i.DisplayName,
 			&i.ID,
 			&i.GitRepoBranch,
+			&i.Debug,
+			&i.ReplayOfId,
+			&i.AdditionalMetadata,
+			&i.StepRunsTotal,
+			&i.StepRunsRunning,
+			&i.StepRunsSucceeded,
+			&i.StepRunsFailed,
+			&i.StepRunsCancelled,
+			&i.CancelledSource,
+			&i.Environment,
+			&i.BuildId,
+			&i.BufferedAt,
+			&i.StepExecutions,
+			&i.StepRetries,
+			&i.ConcurrencyGroupComponents,
+			&i.CorrelationId,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const releaseBufferedWorkflowRuns = `-- name: ReleaseBufferedWorkflowRuns :many
+UPDATE "WorkflowRun" as runs
+SET
+    "bufferedAt" = NULL
+FROM
+    "WorkflowVersion" as workflowVersion,
+    "Workflow" as w,
+    "Tenant" as t
+WHERE
+    runs."tenantId" = $1::uuid AND
+    runs."bufferedAt" IS NOT NULL AND
+    runs."status" = 'PENDING' AND
+    runs."deletedAt" IS NULL AND
+    workflowVersion."id" = runs."workflowVersionId" AND
+    w."id" = workflowVersion."workflowId" AND
+    t."id" = runs."tenantId" AND
+    NOT w."paused" AND
+    NOT t."paused" AND
+    (w."maintenanceUntil" IS NULL OR w."maintenanceUntil" <= CURRENT_TIMESTAMP)
+RETURNING
+    runs."createdAt", runs."updatedAt", runs."deletedAt", runs."tenantId", runs."workflowVersionId", runs.status, runs.error, runs."startedAt", runs."finishedAt", runs."concurrencyGroupId", runs."displayName", runs.id, runs."gitRepoBranch", runs.debug, runs."replayOfId", runs."additionalMetadata", runs."stepRunsTotal", runs."stepRunsRunning", runs."stepRunsSucceeded", runs."stepRunsFailed", runs."stepRunsCancelled", runs."cancelledSource", runs.environment, runs."buildId", runs."bufferedAt", runs."stepExecutions", runs."stepRetries", runs."concurrencyGroupComponents", runs."correlationId"
+`
+
+func (q *Queries) ReleaseBufferedWorkflowRuns(ctx context.Context, db DBTX, tenantid pgtype.UUID) ([]*WorkflowRun, error) {
+	rows, err := db.Query(ctx, releaseBufferedWorkflowRuns, tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*WorkflowRun
+	for rows.Next() {
+		var i WorkflowRun
+		if err := rows.Scan(
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.TenantId,
+			&i.WorkflowVersionId,
+			&i.Status,
+			&i.Error,
+			&i.StartedAt,
+			&i.FinishedAt,
+			&i.ConcurrencyGroupId,
+			&i.DisplayName,
+			&i.ID,
+			&i.GitRepoBranch,
+			&i.Debug,
+			&i.ReplayOfId,
+			&i.AdditionalMetadata,
+			&i.StepRunsTotal,
+			&i.StepRunsRunning,
+			&i.StepRunsSucceeded,
+			&i.StepRunsFailed,
+			&i.StepRunsCancelled,
+			&i.CancelledSource,
+			&i.Environment,
+			&i.BuildId,
+			&i.BufferedAt,
+			&i.StepExecutions,
+			&i.StepRetries,
+			&i.ConcurrencyGroupComponents,
+			&i.CorrelationId,
 		); err != nil {
 			return nil, err
 		}
@@ -783,6 +1917,24 @@ END, "startedAt" = CASE
     -- If a job is running or in a final state, then the workflow has started
     WHEN j.runningRuns > 0 OR j.succeededRuns > 0 OR j.failedRuns > 0 OR j.cancelledRuns > 0 THEN NOW()
     ELSE "startedAt"
+END, "cancelledSource" = CASE
+    -- Final states are final, cannot be updated
+    WHEN "status" IN ('SUCCEEDED', 'FAILED') THEN "cancelledSource"
+    -- When the workflow fails only because job runs were cancelled, the source is the source of the step run which
+    -- was cancelled first, preferring step runs which were not cancelled because of a previous step run
+    WHEN j.runningRuns = 0 AND j.failedRuns = 0 AND j.cancelledRuns > 0 THEN (
+        SELECT sr."cancelledSource"
+        FROM "StepRun" sr
+        JOIN "JobRun" jr ON sr."jobRunId" = jr."id"
+        WHERE
+            jr."workflowRunId" = "WorkflowRun"."id" AND
+            sr."tenantId" = $2::uuid AND
+            sr."status" = 'CANCELLED' AND
+            sr."cancelledSource" IS NOT NULL
+        ORDER BY sr."cancelledSource" = 'PARENT_CANCELLED', sr."cancelledAt" ASC NULLS LAST
+        LIMIT 1
+    )
+    ELSE "cancelledSource"
 END
 FROM
     jobRuns j
@@ -791,7 +1943,7 @@ WHERE "id" = (
     FROM "JobRun"
     WHERE "id" = $1::uuid
 ) AND "tenantId" = $2::uuid
-RETURNING "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."gitRepoBranch"
+RETURNING "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."gitRepoBranch", "WorkflowRun".debug, "WorkflowRun"."replayOfId", "WorkflowRun"."additionalMetadata", "WorkflowRun"."stepRunsTotal", "WorkflowRun"."stepRunsRunning", "WorkflowRun"."stepRunsSucceeded", "WorkflowRun"."stepRunsFailed", "WorkflowRun"."stepRunsCancelled", "WorkflowRun"."cancelledSource", "WorkflowRun".environment, "WorkflowRun"."buildId", "WorkflowRun"."bufferedAt", "WorkflowRun"."stepExecutions", "WorkflowRun"."stepRetries", "WorkflowRun"."concurrencyGroupComponents", "WorkflowRun"."correlationId"
 `
 
 type ResolveWorkflowRunStatusParams struct {
@@ -816,10 +1968,248 @@ func (q *Queries) ResolveWorkflowRunStatus(ctx context.Context, db DBTX, arg Res
 		&i.DisplayName,
 		&i.ID,
 		&i.GitRepoBranch,
+		&i.Debug,
+		&i.ReplayOfId,
+		&i.AdditionalMetadata,
+		&i.StepRunsTotal,
+		&i.StepRunsRunning,
+		&i.StepRunsSucceeded,
+		&i.StepRunsFailed,
+		&i.StepRunsCancelled,
+		&i.CancelledSource,
+		&i.Environment,
+		&i.BuildId,
+		&i.BufferedAt,
+		&i.StepExecutions,
+		&i.StepRetries,
+		&i.ConcurrencyGroupComponents,
+		&i.CorrelationId,
 	)
 	return &i, err
 }
 
+const restoreJobRuns = `-- name: RestoreJobRuns :exec
+UPDATE
+    "JobRun" AS job_run
+SET
+    "status" = CASE
+        WHEN step_runs."failedRuns" > 0 THEN 'FAILED'
+        WHEN step_runs."succeededRuns" = step_runs."totalRuns" THEN 'SUCCEEDED'
+        ELSE 'CANCELLED'
+    END::"JobRunStatus",
+    "startedAt" = step_runs."startedAt",
+    "finishedAt" = step_runs."finishedAt"
+FROM (
+    SELECT
+        step_run."jobRunId",
+        COUNT(*) AS "totalRuns",
+        COUNT(*) FILTER (WHERE step_run."status" = 'SUCCEEDED') AS "succeededRuns",
+        COUNT(*) FILTER (WHERE step_run."status" = 'FAILED') AS "failedRuns",
+        MIN(step_run."startedAt") AS "startedAt",
+        MAX(COALESCE(step_run."finishedAt", step_run."cancelledAt")) AS "finishedAt"
+    FROM
+        "StepRun" AS step_run
+    JOIN
+        "JobRun" AS job_run ON step_run."jobRunId" = job_run."id"
+    WHERE
+        job_run."workflowRunId" = $1::uuid
+        AND job_run."tenantId" = $2::uuid
+    GROUP BY
+        step_run."jobRunId"
+) AS step_runs
+WHERE
+    job_run."id" = step_runs."jobRunId"
+`
+
+type RestoreJobRunsParams struct {
+	Workflowrunid pgtype.UUID `json:"workflowrunid"`
+	Tenantid      pgtype.UUID `json:"tenantid"`
+}
+
+// Resolves the job runs of a restored workflow run from its restored step runs. Restored runs are never
+// queued, so job runs whose step runs did not all finish are cancelled.
+func (q *Queries) RestoreJobRuns(ctx context.Context, db DBTX, arg RestoreJobRunsParams) error {
+	_, err := db.Exec(ctx, restoreJobRuns, arg.Workflowrunid, arg.Tenantid)
+	return err
+}
+
+const restoreStepRun = `-- name: RestoreStepRun :exec
+UPDATE
+    "StepRun" AS step_run
+SET
+    "status" = $1::"StepRunStatus",
+    "input" = $2::jsonb,
+    "output" = $3::jsonb,
+    "error" = $4::text,
+    "startedAt" = $5::timestamp,
+    "finishedAt" = $6::timestamp,
+    "cancelledAt" = $7::timestamp,
+    "cancelledReason" = $8::text,
+    "retryCount" = $9::int,
+    "requeueAfter" = NULL
+FROM
+    "JobRun" AS job_run,
+    "Job" AS job,
+    "Step" AS step
+WHERE
+    step_run."jobRunId" = job_run."id"
+    AND job_run."jobId" = job."id"
+    AND step_run."stepId" = step."id"
+    AND job_run."workflowRunId" = $10::uuid
+    AND step_run."tenantId" = $11::uuid
+    AND job."name" = $12::text
+    AND step."readableId" = $13::text
+`
+
+type RestoreStepRunParams struct {
+	Status          StepRunStatus    `json:"status"`
+	Input           []byte           `json:"input"`
+	Output          []byte           `json:"output"`
+	Error           pgtype.Text      `json:"error"`
+	StartedAt       pgtype.Timestamp `json:"startedAt"`
+	FinishedAt      pgtype.Timestamp `json:"finishedAt"`
+	CancelledAt     pgtype.Timestamp `json:"cancelledAt"`
+	CancelledReason pgtype.Text      `json:"cancelledReason"`
+	Retrycount      int32            `json:"retrycount"`
+	Workflowrunid   pgtype.UUID      `json:"workflowrunid"`
+	Tenantid        pgtype.UUID      `json:"tenantid"`
+	Jobname         string           `json:"jobname"`
+	Stepreadableid  string           `json:"stepreadableid"`
+}
+
+// Sets the state of a step run of a restored workflow run from an exported bundle. Step runs are matched
+// by job name and step readable id, since the ids of the exported run do not exist in this instance.
+func (q *Queries) RestoreStepRun(ctx context.Context, db DBTX, arg RestoreStepRunParams) error {
+	_, err := db.Exec(ctx, restoreStepRun,
+		arg.Status,
+		arg.Input,
+		arg.Output,
+		arg.Error,
+		arg.StartedAt,
+		arg.FinishedAt,
+		arg.CancelledAt,
+		arg.CancelledReason,
+		arg.Retrycount,
+		arg.Workflowrunid,
+		arg.Tenantid,
+		arg.Jobname,
+		arg.Stepreadableid,
+	)
+	return err
+}
+
+const restoreWorkflowRun = `-- name: RestoreWorkflowRun :exec
+UPDATE
+    "WorkflowRun"
+SET
+    "status" = $1::"WorkflowRunStatus",
+    "error" = $2::text,
+    "startedAt" = $3::timestamp,
+    "finishedAt" = $4::timestamp
+WHERE
+    "id" = $5::uuid
+    AND "tenantId" = $6::uuid
+`
+
+type RestoreWorkflowRunParams struct {
+	Status        WorkflowRunStatus `json:"status"`
+	Error         pgtype.Text       `json:"error"`
+	StartedAt     pgtype.Timestamp  `json:"startedAt"`
+	FinishedAt    pgtype.Timestamp  `json:"finishedAt"`
+	Workflowrunid pgtype.UUID       `json:"workflowrunid"`
+	Tenantid      pgtype.UUID       `json:"tenantid"`
+}
+
+func (q *Queries) RestoreWorkflowRun(ctx context.Context, db DBTX, arg RestoreWorkflowRunParams) error {
+	_, err := db.Exec(ctx, restoreWorkflowRun,
+		arg.Status,
+		arg.Error,
+		arg.StartedAt,
+		arg.FinishedAt,
+		arg.Workflowrunid,
+		arg.Tenantid,
+	)
+	return err
+}
+
+const succeedCopiedJobRuns = `-- name: SucceedCopiedJobRuns :exec
+UPDATE
+    "JobRun" AS job_run
+SET
+    "status" = 'SUCCEEDED',
+    "startedAt" = step_runs."startedAt",
+    "finishedAt" = step_runs."finishedAt"
+FROM (
+    SELECT
+        step_run."jobRunId",
+        MIN(step_run."startedAt") AS "startedAt",
+        MAX(step_run."finishedAt") AS "finishedAt"
+    FROM
+        "StepRun" AS step_run
+    JOIN
+        "JobRun" AS job_run ON step_run."jobRunId" = job_run."id"
+    WHERE
+        job_run."workflowRunId" = $1::uuid
+        AND job_run."tenantId" = $2::uuid
+    GROUP BY
+        step_run."jobRunId"
+    HAVING
+        bool_and(step_run."status" = 'SUCCEEDED')
+) AS step_runs
+WHERE
+    job_run."id" = step_runs."jobRunId"
+`
+
+type SucceedCopiedJobRunsParams struct {
+	Workflowrunid pgtype.UUID `json:"workflowrunid"`
+	Tenantid      pgtype.UUID `json:"tenantid"`
+}
+
+// Job runs whose step runs were all copied from the replayed workflow run have already succeeded, so
+// they are not queued.
+func (q *Queries) SucceedCopiedJobRuns(ctx context.Context, db DBTX, arg SucceedCopiedJobRunsParams) error {
+	_, err := db.Exec(ctx, succeedCopiedJobRuns, arg.Workflowrunid, arg.Tenantid)
+	return err
+}
+
+const updateJobRunLookupDataWithCopiedStepRuns = `-- name: UpdateJobRunLookupDataWithCopiedStepRuns :exec
+UPDATE
+    "JobRunLookupData" AS lookup_data
+SET
+    "data" = jsonb_set(lookup_data."data", '{steps}', copied_steps."steps", true),
+    "updatedAt" = CURRENT_TIMESTAMP
+FROM (
+    SELECT
+        step_run."jobRunId",
+        jsonb_object_agg(step."readableId", COALESCE(step_run."output", 'null'::jsonb)) AS "steps"
+    FROM
+        "StepRun" AS step_run
+    JOIN
+        "Step" AS step ON step_run."stepId" = step."id"
+    JOIN
+        "JobRun" AS job_run ON step_run."jobRunId" = job_run."id"
+    WHERE
+        job_run."workflowRunId" = $1::uuid
+        AND step_run."tenantId" = $2::uuid
+        AND step_run."status" = 'SUCCEEDED'
+        AND step."readableId" IS NOT NULL
+    GROUP BY
+        step_run."jobRunId"
+) AS copied_steps
+WHERE
+    lookup_data."jobRunId" = copied_steps."jobRunId"
+`
+
+type UpdateJobRunLookupDataWithCopiedStepRunsParams struct {
+	Workflowrunid pgtype.UUID `json:"workflowrunid"`
+	Tenantid      pgtype.UUID `json:"tenantid"`
+}
+
+func (q *Queries) UpdateJobRunLookupDataWithCopiedStepRuns(ctx context.Context, db DBTX, arg UpdateJobRunLookupDataWithCopiedStepRunsParams) error {
+	_, err := db.Exec(ctx, updateJobRunLookupDataWithCopiedStepRuns, arg.Workflowrunid, arg.Tenantid)
+	return err
+}
+
 const updateManyWorkflowRun = `-- name: UpdateManyWorkflowRun :many
 UPDATE
     "WorkflowRun"
@@ -831,7 +2221,7 @@ SET
 WHERE 
     "tenantId" = $5::uuid AND
     "id" = ANY($6::uuid[])
-RETURNING "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."gitRepoBranch"
+RETURNING "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."gitRepoBranch", "WorkflowRun".debug, "WorkflowRun"."replayOfId", "WorkflowRun"."additionalMetadata", "WorkflowRun"."stepRunsTotal", "WorkflowRun"."stepRunsRunning", "WorkflowRun"."stepRunsSucceeded", "WorkflowRun"."stepRunsFailed", "WorkflowRun"."stepRunsCancelled", "WorkflowRun"."cancelledSource", "WorkflowRun".environment, "WorkflowRun"."buildId", "WorkflowRun"."bufferedAt", "WorkflowRun"."stepExecutions", "WorkflowRun"."stepRetries", "WorkflowRun"."concurrencyGroupComponents", "WorkflowRun"."correlationId"
 `
 
 type UpdateManyWorkflowRunParams struct {
@@ -873,6 +2263,22 @@ func (q *Queries) UpdateManyWorkflowRun(ctx context.Context, db DBTX, arg Update
 			&i.DisplayName,
 			&i.ID,
 			&i.GitRepoBranch,
+			&i.Debug,
+			&i.ReplayOfId,
+			&i.AdditionalMetadata,
+			&i.StepRunsTotal,
+			&i.StepRunsRunning,
+			&i.StepRunsSucceeded,
+			&i.StepRunsFailed,
+			&i.StepRunsCancelled,
+			&i.CancelledSource,
+			&i.Environment,
+			&i.BuildId,
+			&i.BufferedAt,
+			&i.StepExecutions,
+			&i.StepRetries,
+			&i.ConcurrencyGroupComponents,
+			&i.CorrelationId,
 		); err != nil {
 			return nil, err
 		}
@@ -895,7 +2301,7 @@ SET
 WHERE 
     "id" = $5::uuid AND
     "tenantId" = $6::uuid
-RETURNING "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."gitRepoBranch"
+RETURNING "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."gitRepoBranch", "WorkflowRun".debug, "WorkflowRun"."replayOfId", "WorkflowRun"."additionalMetadata", "WorkflowRun"."stepRunsTotal", "WorkflowRun"."stepRunsRunning", "WorkflowRun"."stepRunsSucceeded", "WorkflowRun"."stepRunsFailed", "WorkflowRun"."stepRunsCancelled", "WorkflowRun"."cancelledSource", "WorkflowRun".environment, "WorkflowRun"."buildId", "WorkflowRun"."bufferedAt", "WorkflowRun"."stepExecutions", "WorkflowRun"."stepRetries", "WorkflowRun"."concurrencyGroupComponents", "WorkflowRun"."correlationId"
 `
 
 type UpdateWorkflowRunParams struct {
@@ -931,10 +2337,47 @@ func (q *Queries) UpdateWorkflowRun(ctx context.Context, db DBTX, arg UpdateWork
 		&i.DisplayName,
 		&i.ID,
 		&i.GitRepoBranch,
+		&i.Debug,
+		&i.ReplayOfId,
+		&i.AdditionalMetadata,
+		&i.StepRunsTotal,
+		&i.StepRunsRunning,
+		&i.StepRunsSucceeded,
+		&i.StepRunsFailed,
+		&i.StepRunsCancelled,
+		&i.CancelledSource,
+		&i.Environment,
+		&i.BuildId,
+		&i.BufferedAt,
+		&i.StepExecutions,
+		&i.StepRetries,
+		&i.ConcurrencyGroupComponents,
+		&i.CorrelationId,
 	)
 	return &i, err
 }
 
+const updateWorkflowRunConcurrencyGroupComponents = `-- name: UpdateWorkflowRunConcurrencyGroupComponents :exec
+UPDATE
+    "WorkflowRun"
+SET
+    "concurrencyGroupComponents" = $1::text[]
+WHERE
+    "id" = $2::uuid
+    AND "tenantId" = $3::uuid
+`
+
+type UpdateWorkflowRunConcurrencyGroupComponentsParams struct {
+	Components    []string    `json:"components"`
+	Workflowrunid pgtype.UUID `json:"workflowrunid"`
+	Tenantid      pgtype.UUID `json:"tenantid"`
+}
+
+func (q *Queries) UpdateWorkflowRunConcurrencyGroupComponents(ctx context.Context, db DBTX, arg UpdateWorkflowRunConcurrencyGroupComponentsParams) error {
+	_, err := db.Exec(ctx, updateWorkflowRunConcurrencyGroupComponents, arg.Components, arg.Workflowrunid, arg.Tenantid)
+	return err
+}
+
 const updateWorkflowRunGroupKey = `-- name: UpdateWorkflowRunGroupKey :one
 WITH groupKeyRun AS (
     SELECT "id", "status" as groupKeyRunStatus, "output", "workflowRunId"
@@ -964,7 +2407,7 @@ FROM
 WHERE 
 workflowRun."id" = groupKeyRun."workflowRunId" AND
 workflowRun."tenantId" = $1::uuid
-RETURNING workflowrun."createdAt", workflowrun."updatedAt", workflowrun."deletedAt", workflowrun."tenantId", workflowrun."workflowVersionId", workflowrun.status, workflowrun.error, workflowrun."startedAt", workflowrun."finishedAt", workflowrun."concurrencyGroupId", workflowrun."displayName", workflowrun.id, workflowrun."gitRepoBranch"
+RETURNING workflowrun."createdAt", workflowrun."updatedAt", workflowrun."deletedAt", workflowrun."tenantId", workflowrun."workflowVersionId", workflowrun.status, workflowrun.error, workflowrun."startedAt", workflowrun."finishedAt", workflowrun."concurrencyGroupId", workflowrun."displayName", workflowrun.id, workflowrun."gitRepoBranch", workflowrun.debug, workflowrun."replayOfId", workflowrun."additionalMetadata", workflowrun."stepRunsTotal", workflowrun."stepRunsRunning", workflowrun."stepRunsSucceeded", workflowrun."stepRunsFailed", workflowrun."stepRunsCancelled", workflowrun."cancelledSource", workflowrun.environment, workflowrun."buildId", workflowrun."bufferedAt", workflowrun."stepExecutions", workflowrun."stepRetries", workflowrun."concurrencyGroupComponents", workflowrun."correlationId"
 `
 
 type UpdateWorkflowRunGroupKeyParams struct {
@@ -989,6 +2432,22 @@ func (q *Queries) UpdateWorkflowRunGroupKey(ctx context.Context, db DBTX, arg Up
 		&i.DisplayName,
 		&i.ID,
 		&i.GitRepoBranch,
+		&i.Debug,
+		&i.ReplayOfId,
+		&i.AdditionalMetadata,
+		&i.StepRunsTotal,
+		&i.StepRunsRunning,
+		&i.StepRunsSucceeded,
+		&i.StepRunsFailed,
+		&i.StepRunsCancelled,
+		&i.CancelledSource,
+		&i.Environment,
+		&i.BuildId,
+		&i.BufferedAt,
+		&i.StepExecutions,
+		&i.StepRetries,
+		&i.ConcurrencyGroupComponents,
+		&i.CorrelationId,
 	)
 	return &i, err
 }
@@ -30,6 +30,26 @@ WHERE
             ORDER BY 
                 t1."workflowId" DESC, t1."order" DESC
         )
+    ) AND
+    (
+        sqlc.narg('environment')::text IS NULL OR
+        workflows."id" IN (
+            SELECT
+                workflowVersion."workflowId"
+            FROM
+                "WorkflowVersion" AS workflowVersion
+                JOIN "Job" AS job ON job."workflowVersionId" = workflowVersion."id"
+                JOIN "Step" AS step ON step."jobId" = job."id"
+                JOIN "Action" AS action ON action."actionId" = step."actionId" AND action."tenantId" = step."tenantId"
+                JOIN "_ActionToWorker" AS actionToWorker ON actionToWorker."A" = action."id"
+                JOIN "Worker" AS worker ON worker."id" = actionToWorker."B"
+            WHERE
+                worker."environment" = sqlc.narg('environment')::text
+        )
+    ) AND
+    (
+        sqlc.narg('namespace')::text IS NULL OR
+        starts_with(workflows."name", sqlc.narg('namespace')::text || '/')
     );
 
 -- name: ListWorkflowsLatestRuns :many
@@ -117,6 +137,28 @@ FROM (
                     t1."workflowId" DESC
             )
         )
+        AND
+        (
+            sqlc.narg('environment')::text IS NULL OR
+            workflows."id" IN (
+                SELECT
+                    workflowVersion."workflowId"
+                FROM
+                    "WorkflowVersion" AS workflowVersion
+                    JOIN "Job" AS job ON job."workflowVersionId" = workflowVersion."id"
+                    JOIN "Step" AS step ON step."jobId" = job."id"
+                    JOIN "Action" AS action ON action."actionId" = step."actionId" AND action."tenantId" = step."tenantId"
+                    JOIN "_ActionToWorker" AS actionToWorker ON actionToWorker."A" = action."id"
+                    JOIN "Worker" AS worker ON worker."id" = actionToWorker."B"
+                WHERE
+                    worker."environment" = sqlc.narg('environment')::text
+            )
+        )
+        AND
+        (
+            sqlc.narg('namespace')::text IS NULL OR
+            starts_with(workflows."name", sqlc.narg('namespace')::text || '/')
+        )
     ORDER BY workflows."id" DESC
 ) as workflows
 ORDER BY
@@ -155,7 +197,15 @@ INSERT INTO "WorkflowVersion" (
     "checksum",
     "version",
     "workflowId",
-    "scheduleTimeout"
+    "scheduleTimeout",
+    "sla",
+    "defaultInput",
+    "inputSchema",
+    "pinToBuild",
+    "assignmentStrategy",
+    "maxStepExecutions",
+    "maxStepRetries",
+    "costCenter"
 ) VALUES (
     @id::uuid,
     coalesce(sqlc.narg('createdAt')::timestamp, CURRENT_TIMESTAMP),
@@ -164,7 +214,15 @@ INSERT INTO "WorkflowVersion" (
     @checksum::text,
     sqlc.narg('version')::text,
     @workflowId::uuid,
-    coalesce(sqlc.narg('scheduleTimeout')::text, '5m')
+    coalesce(sqlc.narg('scheduleTimeout')::text, '5m'),
+    sqlc.narg('sla')::text,
+    sqlc.narg('defaultInput')::jsonb,
+    sqlc.narg('inputSchema')::jsonb,
+    coalesce(sqlc.narg('pinToBuild')::boolean, false),
+    sqlc.narg('assignmentStrategy')::"WorkerAssignmentStrategy",
+    sqlc.narg('maxStepExecutions')::int,
+    sqlc.narg('maxStepRetries')::int,
+    sqlc.narg('costCenter')::text
 ) RETURNING *;
 
 -- name: CreateWorkflowConcurrency :one
@@ -175,7 +233,8 @@ INSERT INTO "WorkflowConcurrency" (
     "workflowVersionId",
     "getConcurrencyGroupId",
     "maxRuns",
-    "limitStrategy"
+    "limitStrategy",
+    "keyExpressions"
 ) VALUES (
     @id::uuid,
     coalesce(sqlc.narg('createdAt')::timestamp, CURRENT_TIMESTAMP),
@@ -183,7 +242,8 @@ INSERT INTO "WorkflowConcurrency" (
     @workflowVersionId::uuid,
     @getConcurrencyGroupId::uuid,
     coalesce(sqlc.narg('maxRuns')::integer, 1),
-    coalesce(sqlc.narg('limitStrategy')::"ConcurrencyLimitStrategy", 'CANCEL_IN_PROGRESS')
+    coalesce(sqlc.narg('limitStrategy')::"ConcurrencyLimitStrategy", 'CANCEL_IN_PROGRESS'),
+    @keyExpressions::text[]
 ) RETURNING *;
 
 -- name: CreateJob :one
@@ -222,7 +282,16 @@ INSERT INTO "Step" (
     "timeout",
     "customUserData",
     "retries",
-    "scheduleTimeout"
+    "scheduleTimeout",
+    "cpu",
+    "memoryMb",
+    "gpu",
+    "cancelGracePeriod",
+    "maxTimeout",
+    "joinStrategy",
+    "joinQuorum",
+    "onTimeoutActionId",
+    "costCenter"
 ) VALUES (
     @id::uuid,
     coalesce(sqlc.narg('createdAt')::timestamp, CURRENT_TIMESTAMP),
@@ -235,7 +304,16 @@ INSERT INTO "Step" (
     @timeout::text,
     coalesce(sqlc.narg('customUserData')::jsonb, '{}'),
     coalesce(sqlc.narg('retries')::integer, 0),
-    coalesce(sqlc.narg('scheduleTimeout')::text, '5m')
+    coalesce(sqlc.narg('scheduleTimeout')::text, '5m'),
+    sqlc.narg('cpu')::double precision,
+    sqlc.narg('memoryMb')::integer,
+    sqlc.narg('gpu')::integer,
+    sqlc.narg('cancelGracePeriod')::text,
+    sqlc.narg('maxTimeout')::text,
+    coalesce(sqlc.narg('joinStrategy')::"StepJoinStrategy", 'ALL'),
+    sqlc.narg('joinQuorum')::integer,
+    sqlc.narg('onTimeoutActionId')::text,
+    sqlc.narg('costCenter')::text
 ) RETURNING *;
 
 -- name: AddStepParents :exec
@@ -248,6 +326,25 @@ FROM
 JOIN 
     "Step" AS step ON step."readableId" = parent_readable_id AND step."jobId" = @jobId::uuid;
 
+-- name: CreateStepPreflightCheck :exec
+INSERT INTO "StepPreflightCheck" (
+    "id",
+    "createdAt",
+    "updatedAt",
+    "stepId",
+    "kind",
+    "target",
+    "timeout"
+) VALUES (
+    gen_random_uuid(),
+    CURRENT_TIMESTAMP,
+    CURRENT_TIMESTAMP,
+    @stepId::uuid,
+    @kind::"StepPreflightCheckKind",
+    @target::text,
+    sqlc.narg('timeout')::text
+);
+
 -- name: UpsertAction :one
 INSERT INTO "Action" (
     "id",
@@ -371,4 +468,14 @@ JOIN
     "Workflow" as w ON w."id" = workflowVersions."workflowId"
 WHERE
     workflowVersions."id" = ANY(@ids::uuid[]) AND
-    w."tenantId" = @tenantId::uuid;
\ No newline at end of file
+    w."tenantId" = @tenantId::uuid;
+-- name: UpdateWorkflowPause :one
+UPDATE "Workflow"
+SET
+    "paused" = @paused::boolean,
+    "pausedTriggerBehavior" = COALESCE(sqlc.narg('pausedTriggerBehavior')::"PausedTriggerBehavior", "pausedTriggerBehavior"),
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @id::uuid AND
+    "tenantId" = @tenantId::uuid
+RETURNING *;
@@ -81,16 +81,43 @@ WHERE
             ORDER BY 
                 t1."workflowId" DESC, t1."order" DESC
         )
+    ) AND
+    (
+        $3::text IS NULL OR
+        workflows."id" IN (
+            SELECT
+                workflowVersion."workflowId"
+            FROM
+                "WorkflowVersion" AS workflowVersion
+                JOIN "Job" AS job ON job."workflowVersionId" = workflowVersion."id"
+                JOIN "Step" AS step ON step."jobId" = job."id"
+                JOIN "Action" AS action ON action."actionId" = step."actionId" AND action."tenantId" = step."tenantId"
+                JOIN "_ActionToWorker" AS actionToWorker ON actionToWorker."A" = action."id"
+                JOIN "Worker" AS worker ON worker."id" = actionToWorker."B"
+            WHERE
+                worker."environment" = $3::text
+        )
+    ) AND
+    (
+        $4::text IS NULL OR
+        starts_with(workflows."name", $4::text || '/')
     )
 `
 
 type CountWorkflowsParams struct {
-	TenantId pgtype.UUID `json:"tenantId"`
-	EventKey pgtype.Text `json:"eventKey"`
+	TenantId    pgtype.UUID `json:"tenantId"`
+	EventKey    pgtype.Text `json:"eventKey"`
+	Environment pgtype.Text `json:"environment"`
+	Namespace   pgtype.Text `json:"namespace"`
 }
 
 func (q *Queries) CountWorkflows(ctx context.Context, db DBTX, arg CountWorkflowsParams) (int64, error) {
-	row := db.QueryRow(ctx, countWorkflows, arg.TenantId, arg.EventKey)
+	row := db.QueryRow(ctx, countWorkflows,
+		arg.TenantId,
+		arg.EventKey,
+		arg.Environment,
+		arg.Namespace,
+	)
 	var total int64
 	err := row.Scan(&total)
 	return total, err
@@ -172,7 +199,16 @@ INSERT INTO "Step" (
     "timeout",
     "customUserData",
     "retries",
-    "scheduleTimeout"
+    "scheduleTimeout",
+    "cpu",
+    "memoryMb",
+    "gpu",
+    "cancelGracePeriod",
+    "maxTimeout",
+    "joinStrategy",
+    "joinQuorum",
+    "onTimeoutActionId",
+    "costCenter"
 ) VALUES (
     $1::uuid,
     coalesce($2::timestamp, CURRENT_TIMESTAMP),
@@ -185,23 +221,41 @@ INSERT INTO "Step" (
     $9::text,
     coalesce($10::jsonb, '{}'),
     coalesce($11::integer, 0),
-    coalesce($12::text, '5m')
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", "readableId", "tenantId", "jobId", "actionId", timeout, "customUserData", retries, "scheduleTimeout"
+    coalesce($12::text, '5m'),
+    $13::double precision,
+    $14::integer,
+    $15::integer,
+    $16::text,
+    $17::text,
+    coalesce($18::"StepJoinStrategy", 'ALL'),
+    $19::integer,
+    $20::text,
+    $21::text
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", "readableId", "tenantId", "jobId", "actionId", timeout, "customUserData", retries, "scheduleTimeout", cpu, "memoryMb", gpu, "cancelGracePeriod", "maxTimeout", "joinQuorum", "joinStrategy", "onTimeoutActionId", "costCenter"
 `
 
 type CreateStepParams struct {
-	ID              pgtype.UUID      `json:"id"`
-	CreatedAt       pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt       pgtype.Timestamp `json:"updatedAt"`
-	Deletedat       pgtype.Timestamp `json:"deletedat"`
-	Readableid      string           `json:"readableid"`
-	Tenantid        pgtype.UUID      `json:"tenantid"`
-	Jobid           pgtype.UUID      `json:"jobid"`
-	Actionid        string           `json:"actionid"`
-	Timeout         string           `json:"timeout"`
-	CustomUserData  []byte           `json:"customUserData"`
-	Retries         pgtype.Int4      `json:"retries"`
-	ScheduleTimeout pgtype.Text      `json:"scheduleTimeout"`
+	ID                pgtype.UUID          `json:"id"`
+	CreatedAt         pgtype.Timestamp     `json:"createdAt"`
+	UpdatedAt         pgtype.Timestamp     `json:"updatedAt"`
+	Deletedat         pgtype.Timestamp     `json:"deletedat"`
+	Readableid        string               `json:"readableid"`
+	Tenantid          pgtype.UUID          `json:"tenantid"`
+	Jobid             pgtype.UUID          `json:"jobid"`
+	Actionid          string               `json:"actionid"`
+	Timeout           string               `json:"timeout"`
+	CustomUserData    []byte               `json:"customUserData"`
+	Retries           pgtype.Int4          `json:"retries"`
+	ScheduleTimeout   pgtype.Text          `json:"scheduleTimeout"`
+	Cpu               pgtype.Float8        `json:"cpu"`
+	MemoryMb          pgtype.Int4          `json:"memoryMb"`
+	Gpu               pgtype.Int4          `json:"gpu"`
+	CancelGracePeriod pgtype.Text          `json:"cancelGracePeriod"`
+	MaxTimeout        pgtype.Text          `json:"maxTimeout"`
+	JoinStrategy      NullStepJoinStrategy `json:"joinStrategy"`
+	JoinQuorum        pgtype.Int4          `json:"joinQuorum"`
+	OnTimeoutActionId pgtype.Text          `json:"onTimeoutActionId"`
+	CostCenter        pgtype.Text          `json:"costCenter"`
 }
 
 func (q *Queries) CreateStep(ctx context.Context, db DBTX, arg CreateStepParams) (*Step, error) {
@@ -218,6 +272,15 @@ func (q *Queries) CreateStep(ctx context.Context, db DBTX, arg CreateStepParams)
 		arg.CustomUserData,
 		arg.Retries,
 		arg.ScheduleTimeout,
+		arg.Cpu,
+		arg.MemoryMb,
+		arg.Gpu,
+		arg.CancelGracePeriod,
+		arg.MaxTimeout,
+		arg.JoinStrategy,
+		arg.JoinQuorum,
+		arg.OnTimeoutActionId,
+		arg.CostCenter,
 	)
 	var i Step
 	err := row.Scan(
@@ -233,10 +296,56 @@ func (q *Queries) CreateStep(ctx context.Context, db DBTX, arg CreateStepParams)
 		&i.CustomUserData,
 		&i.Retries,
 		&i.ScheduleTimeout,
+		&i.Cpu,
+		&i.MemoryMb,
+		&i.Gpu,
+		&i.CancelGracePeriod,
+		&i.MaxTimeout,
+		&i.JoinQuorum,
+		&i.JoinStrategy,
+		&i.OnTimeoutActionId,
+		&i.CostCenter,
 	)
 	return &i, err
 }
 
+const createStepPreflightCheck = `-- name: CreateStepPreflightCheck :exec
+INSERT INTO "StepPreflightCheck" (
+    "id",
+    "createdAt",
+    "updatedAt",
+    "stepId",
+    "kind",
+    "target",
+    "timeout"
+) VALUES (
+    gen_random_uuid(),
+    CURRENT_TIMESTAMP,
+    CURRENT_TIMESTAMP,
+    $1::uuid,
+    $2::"StepPreflightCheckKind",
+    $3::text,
+    $4::text
+)
+`
+
+type CreateStepPreflightCheckParams struct {
+	Stepid  pgtype.UUID            `json:"stepid"`
+	Kind    StepPreflightCheckKind `json:"kind"`
+	Target  string                 `json:"target"`
+	Timeout pgtype.Text            `json:"timeout"`
+}
+
+func (q *Queries) CreateStepPreflightCheck(ctx context.Context, db DBTX, arg CreateStepPreflightCheckParams) error {
+	_, err := db.Exec(ctx, createStepPreflightCheck,
+		arg.Stepid,
+		arg.Kind,
+		arg.Target,
+		arg.Timeout,
+	)
+	return err
+}
+
 const createWorkflow = `-- name: CreateWorkflow :one
 INSERT INTO "Workflow" (
     "id",
@@ -254,7 +363,7 @@ INSERT INTO "Workflow" (
     $5::uuid,
     $6::text,
     $7::text
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", name, description
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", name, description, paused, "pausedTriggerBehavior", "maintenanceUntil", "diagnosticsSampleRate"
 `
 
 type CreateWorkflowParams struct {
@@ -286,6 +395,10 @@ func (q *Queries) CreateWorkflow(ctx context.Context, db DBTX, arg CreateWorkflo
 		&i.TenantId,
 		&i.Name,
 		&i.Description,
+		&i.Paused,
+		&i.PausedTriggerBehavior,
+		&i.MaintenanceUntil,
+		&i.DiagnosticsSampleRate,
 	)
 	return &i, err
 }
@@ -298,7 +411,8 @@ INSERT INTO "WorkflowConcurrency" (
     "workflowVersionId",
     "getConcurrencyGroupId",
     "maxRuns",
-    "limitStrategy"
+    "limitStrategy",
+    "keyExpressions"
 ) VALUES (
     $1::uuid,
     coalesce($2::timestamp, CURRENT_TIMESTAMP),
@@ -306,8 +420,9 @@ INSERT INTO "WorkflowConcurrency" (
     $4::uuid,
     $5::uuid,
     coalesce($6::integer, 1),
-    coalesce($7::"ConcurrencyLimitStrategy", 'CANCEL_IN_PROGRESS')
-) RETURNING id, "createdAt", "updatedAt", "workflowVersionId", "getConcurrencyGroupId", "maxRuns", "limitStrategy"
+    coalesce($7::"ConcurrencyLimitStrategy", 'CANCEL_IN_PROGRESS'),
+    $8::text[]
+) RETURNING id, "createdAt", "updatedAt", "workflowVersionId", "getConcurrencyGroupId", "maxRuns", "limitStrategy", "keyExpressions"
 `
 
 type CreateWorkflowConcurrencyParams struct {
@@ -318,6 +433,7 @@ type CreateWorkflowConcurrencyParams struct {
 	Getconcurrencygroupid pgtype.UUID                  `json:"getconcurrencygroupid"`
 	MaxRuns               pgtype.Int4                  `json:"maxRuns"`
 	LimitStrategy         NullConcurrencyLimitStrategy `json:"limitStrategy"`
+	Keyexpressions        []string                     `json:"keyexpressions"`
 }
 
 func (q *Queries) CreateWorkflowConcurrency(ctx context.Context, db DBTX, arg CreateWorkflowConcurrencyParams) (*WorkflowConcurrency, error) {
@@ -329,6 +445,7 @@ func (q *Queries) CreateWorkflowConcurrency(ctx context.Context, db DBTX, arg Cr
 		arg.Getconcurrencygroupid,
 		arg.MaxRuns,
 		arg.LimitStrategy,
+		arg.Keyexpressions,
 	)
 	var i WorkflowConcurrency
 	err := row.Scan(
@@ -339,6 +456,7 @@ func (q *Queries) CreateWorkflowConcurrency(ctx context.Context, db DBTX, arg Cr
 		&i.GetConcurrencyGroupId,
 		&i.MaxRuns,
 		&i.LimitStrategy,
+		&i.KeyExpressions,
 	)
 	return &i, err
 }
@@ -473,7 +591,15 @@ INSERT INTO "WorkflowVersion" (
     "checksum",
     "version",
     "workflowId",
-    "scheduleTimeout"
+    "scheduleTimeout",
+    "sla",
+    "defaultInput",
+    "inputSchema",
+    "pinToBuild",
+    "assignmentStrategy",
+    "maxStepExecutions",
+    "maxStepRetries",
+    "costCenter"
 ) VALUES (
     $1::uuid,
     coalesce($2::timestamp, CURRENT_TIMESTAMP),
@@ -482,19 +608,35 @@ INSERT INTO "WorkflowVersion" (
     $5::text,
     $6::text,
     $7::uuid,
-    coalesce($8::text, '5m')
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout"
+    coalesce($8::text, '5m'),
+    $9::text,
+    $10::jsonb,
+    $11::jsonb,
+    coalesce($12::boolean, false),
+    $13::"WorkerAssignmentStrategy",
+    $14::int,
+    $15::int,
+    $16::text
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", sla, "defaultInput", "inputSchema", "pinToBuild", "assignmentStrategy", "maxStepExecutions", "maxStepRetries", "costCenter"
 `
 
 type CreateWorkflowVersionParams struct {
-	ID              pgtype.UUID      `json:"id"`
-	CreatedAt       pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt       pgtype.Timestamp `json:"updatedAt"`
-	Deletedat       pgtype.Timestamp `json:"deletedat"`
-	Checksum        string           `json:"checksum"`
-	Version         pgtype.Text      `json:"version"`
-	Workflowid      pgtype.UUID      `json:"workflowid"`
-	ScheduleTimeout pgtype.Text      `json:"scheduleTimeout"`
+	ID                 pgtype.UUID                  `json:"id"`
+	CreatedAt          pgtype.Timestamp             `json:"createdAt"`
+	UpdatedAt          pgtype.Timestamp             `json:"updatedAt"`
+	Deletedat          pgtype.Timestamp             `json:"deletedat"`
+	Checksum           string                       `json:"checksum"`
+	Version            pgtype.Text                  `json:"version"`
+	Workflowid         pgtype.UUID                  `json:"workflowid"`
+	ScheduleTimeout    pgtype.Text                  `json:"scheduleTimeout"`
+	Sla                pgtype.Text                  `json:"sla"`
+	DefaultInput       []byte                       `json:"defaultInput"`
+	InputSchema        []byte                       `json:"inputSchema"`
+	PinToBuild         pgtype.Bool                  `json:"pinToBuild"`
+	AssignmentStrategy NullWorkerAssignmentStrategy `json:"assignmentStrategy"`
+	MaxStepExecutions  pgtype.Int4                  `json:"maxStepExecutions"`
+	MaxStepRetries     pgtype.Int4                  `json:"maxStepRetries"`
+	CostCenter         pgtype.Text                  `json:"costCenter"`
 }
 
 func (q *Queries) CreateWorkflowVersion(ctx context.Context, db DBTX, arg CreateWorkflowVersionParams) (*WorkflowVersion, error) {
@@ -507,6 +649,14 @@ func (q *Queries) CreateWorkflowVersion(ctx context.Context, db DBTX, arg Create
 		arg.Version,
 		arg.Workflowid,
 		arg.ScheduleTimeout,
+		arg.Sla,
+		arg.DefaultInput,
+		arg.InputSchema,
+		arg.PinToBuild,
+		arg.AssignmentStrategy,
+		arg.MaxStepExecutions,
+		arg.MaxStepRetries,
+		arg.CostCenter,
 	)
 	var i WorkflowVersion
 	err := row.Scan(
@@ -519,13 +669,21 @@ func (q *Queries) CreateWorkflowVersion(ctx context.Context, db DBTX, arg Create
 		&i.WorkflowId,
 		&i.Checksum,
 		&i.ScheduleTimeout,
+		&i.Sla,
+		&i.DefaultInput,
+		&i.InputSchema,
+		&i.PinToBuild,
+		&i.AssignmentStrategy,
+		&i.MaxStepExecutions,
+		&i.MaxStepRetries,
+		&i.CostCenter,
 	)
 	return &i, err
 }
 
 const getWorkflowVersionForEngine = `-- name: GetWorkflowVersionForEngine :many
 SELECT
-    workflowversions.id, workflowversions."createdAt", workflowversions."updatedAt", workflowversions."deletedAt", workflowversions.version, workflowversions."order", workflowversions."workflowId", workflowversions.checksum, workflowversions."scheduleTimeout",
+    workflowversions.id, workflowversions."createdAt", workflowversions."updatedAt", workflowversions."deletedAt", workflowversions.version, workflowversions."order", workflowversions."workflowId", workflowversions.checksum, workflowversions."scheduleTimeout", workflowversions.sla, workflowversions."defaultInput", workflowversions."inputSchema", workflowversions."pinToBuild", workflowversions."assignmentStrategy", workflowversions."maxStepExecutions", workflowversions."maxStepRetries", workflowversions."costCenter",
     w."name" as "workflowName",
     -- return "hasWorkflowConcurrency" if the workflow has concurrency
     EXISTS (
@@ -572,6 +730,14 @@ func (q *Queries) GetWorkflowVersionForEngine(ctx context.Context, db DBTX, arg
 			&i.WorkflowVersion.WorkflowId,
 			&i.WorkflowVersion.Checksum,
 			&i.WorkflowVersion.ScheduleTimeout,
+			&i.WorkflowVersion.Sla,
+			&i.WorkflowVersion.DefaultInput,
+			&i.WorkflowVersion.InputSchema,
+			&i.WorkflowVersion.PinToBuild,
+			&i.WorkflowVersion.AssignmentStrategy,
+			&i.WorkflowVersion.MaxStepExecutions,
+			&i.WorkflowVersion.MaxStepRetries,
+			&i.WorkflowVersion.CostCenter,
 			&i.WorkflowName,
 			&i.HasWorkflowConcurrency,
 		); err != nil {
@@ -587,15 +753,15 @@ func (q *Queries) GetWorkflowVersionForEngine(ctx context.Context, db DBTX, arg
 
 const listWorkflows = `-- name: ListWorkflows :many
 SELECT 
-    workflows.id, workflows."createdAt", workflows."updatedAt", workflows."deletedAt", workflows."tenantId", workflows.name, workflows.description
+    workflows.id, workflows."createdAt", workflows."updatedAt", workflows."deletedAt", workflows."tenantId", workflows.name, workflows.description, workflows.paused, workflows."pausedTriggerBehavior", workflows."maintenanceUntil", workflows."diagnosticsSampleRate"
 FROM (
     SELECT
-        DISTINCT ON(workflows."id") workflows.id, workflows."createdAt", workflows."updatedAt", workflows."deletedAt", workflows."tenantId", workflows.name, workflows.description
+        DISTINCT ON(workflows."id") workflows.id, workflows."createdAt", workflows."updatedAt", workflows."deletedAt", workflows."tenantId", workflows.name, workflows.description, workflows.paused, workflows."pausedTriggerBehavior", workflows."maintenanceUntil", workflows."diagnosticsSampleRate"
     FROM
         "Workflow" as workflows 
     LEFT JOIN
         (
-            SELECT id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout" FROM "WorkflowVersion" as workflowVersion ORDER BY workflowVersion."order" DESC LIMIT 1
+            SELECT id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", sla, "defaultInput", "inputSchema", "pinToBuild", "assignmentStrategy", "maxStepExecutions", "maxStepRetries", "costCenter" FROM "WorkflowVersion" as workflowVersion ORDER BY workflowVersion."order" DESC LIMIT 1
         ) as workflowVersion ON workflows."id" = workflowVersion."workflowId"
     LEFT JOIN
         "WorkflowTriggers" as workflowTrigger ON workflowVersion."id" = workflowTrigger."workflowVersionId"
@@ -630,23 +796,47 @@ FROM (
                     t1."workflowId" DESC
             )
         )
+        AND
+        (
+            $3::text IS NULL OR
+            workflows."id" IN (
+                SELECT
+                    workflowVersion."workflowId"
+                FROM
+                    "WorkflowVersion" AS workflowVersion
+                    JOIN "Job" AS job ON job."workflowVersionId" = workflowVersion."id"
+                    JOIN "Step" AS step ON step."jobId" = job."id"
+                    JOIN "Action" AS action ON action."actionId" = step."actionId" AND action."tenantId" = step."tenantId"
+                    JOIN "_ActionToWorker" AS actionToWorker ON actionToWorker."A" = action."id"
+                    JOIN "Worker" AS worker ON worker."id" = actionToWorker."B"
+                WHERE
+                    worker."environment" = $3::text
+            )
+        )
+        AND
+        (
+            $4::text IS NULL OR
+            starts_with(workflows."name", $4::text || '/')
+        )
     ORDER BY workflows."id" DESC
 ) as workflows
 ORDER BY
-    case when $3 = 'createdAt ASC' THEN workflows."createdAt" END ASC ,
-    case when $3 = 'createdAt DESC' then workflows."createdAt" END DESC
+    case when $5 = 'createdAt ASC' THEN workflows."createdAt" END ASC ,
+    case when $5 = 'createdAt DESC' then workflows."createdAt" END DESC
 OFFSET
-    COALESCE($4, 0)
+    COALESCE($6, 0)
 LIMIT
-    COALESCE($5, 50)
+    COALESCE($7, 50)
 `
 
 type ListWorkflowsParams struct {
-	TenantId pgtype.UUID `json:"tenantId"`
-	EventKey pgtype.Text `json:"eventKey"`
-	Orderby  interface{} `json:"orderby"`
-	Offset   interface{} `json:"offset"`
-	Limit    interface{} `json:"limit"`
+	TenantId    pgtype.UUID `json:"tenantId"`
+	EventKey    pgtype.Text `json:"eventKey"`
+	Environment pgtype.Text `json:"environment"`
+	Namespace   pgtype.Text `json:"namespace"`
+	Orderby     interface{} `json:"orderby"`
+	Offset      interface{} `json:"offset"`
+	Limit       interface{} `json:"limit"`
 }
 
 type ListWorkflowsRow struct {
@@ -657,6 +847,8 @@ func (q *Queries) ListWorkflows(ctx context.Context, db DBTX, arg ListWorkflowsP
 	rows, err := db.Query(ctx, listWorkflows,
 		arg.TenantId,
 		arg.EventKey,
+		arg.Environment,
+		arg.Namespace,
 		arg.Orderby,
 		arg.Offset,
 		arg.Limit,
@@ -676,6 +868,10 @@ func (q *Queries) ListWorkflows(ctx context.Context, db DBTX, arg ListWorkflowsP
 			&i.Workflow.TenantId,
 			&i.Workflow.Name,
 			&i.Workflow.Description,
+			&i.Workflow.Paused,
+			&i.Workflow.PausedTriggerBehavior,
+			&i.Workflow.MaintenanceUntil,
+			&i.Workflow.DiagnosticsSampleRate,
 		); err != nil {
 			return nil, err
 		}
@@ -730,7 +926,7 @@ func (q *Queries) ListWorkflowsForEvent(ctx context.Context, db DBTX, arg ListWo
 
 const listWorkflowsLatestRuns = `-- name: ListWorkflowsLatestRuns :many
 SELECT
-    DISTINCT ON (workflow."id") runs."createdAt", runs."updatedAt", runs."deletedAt", runs."tenantId", runs."workflowVersionId", runs.status, runs.error, runs."startedAt", runs."finishedAt", runs."concurrencyGroupId", runs."displayName", runs.id, runs."gitRepoBranch", workflow."id" as "workflowId"
+    DISTINCT ON (workflow."id") runs."createdAt", runs."updatedAt", runs."deletedAt", runs."tenantId", runs."workflowVersionId", runs.status, runs.error, runs."startedAt", runs."finishedAt", runs."concurrencyGroupId", runs."displayName", runs.id, runs."gitRepoBranch", runs.debug, runs."replayOfId", runs."additionalMetadata", runs."stepRunsTotal", runs."stepRunsRunning", runs."stepRunsSucceeded", runs."stepRunsFailed", runs."stepRunsCancelled", runs."cancelledSource", runs.environment, runs."buildId", runs."bufferedAt", runs."stepExecutions", runs."stepRetries", runs."concurrencyGroupComponents", runs."correlationId", workflow."id" as "workflowId"
 FROM
     "WorkflowRun" as runs
 LEFT JOIN
@@ -802,6 +998,22 @@ func (q *Queries) ListWorkflowsLatestRuns(ctx context.Context, db DBTX, arg List
 			&i.WorkflowRun.DisplayName,
 			&i.WorkflowRun.ID,
 			&i.WorkflowRun.GitRepoBranch,
+			&i.WorkflowRun.Debug,
+			&i.WorkflowRun.ReplayOfId,
+			&i.WorkflowRun.AdditionalMetadata,
+			&i.WorkflowRun.StepRunsTotal,
+			&i.WorkflowRun.StepRunsRunning,
+			&i.WorkflowRun.StepRunsSucceeded,
+			&i.WorkflowRun.StepRunsFailed,
+			&i.WorkflowRun.StepRunsCancelled,
+			&i.WorkflowRun.CancelledSource,
+			&i.WorkflowRun.Environment,
+			&i.WorkflowRun.BuildId,
+			&i.WorkflowRun.BufferedAt,
+			&i.WorkflowRun.StepExecutions,
+			&i.WorkflowRun.StepRetries,
+			&i.WorkflowRun.ConcurrencyGroupComponents,
+			&i.WorkflowRun.CorrelationId,
 			&i.WorkflowId,
 		); err != nil {
 			return nil, err
@@ -814,6 +1026,49 @@ func (q *Queries) ListWorkflowsLatestRuns(ctx context.Context, db DBTX, arg List
 	return items, nil
 }
 
+const updateWorkflowPause = `-- name: UpdateWorkflowPause :one
+UPDATE "Workflow"
+SET
+    "paused" = $1::boolean,
+    "pausedTriggerBehavior" = COALESCE($2::"PausedTriggerBehavior", "pausedTriggerBehavior"),
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = $3::uuid AND
+    "tenantId" = $4::uuid
+RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", name, description, paused, "pausedTriggerBehavior", "maintenanceUntil", "diagnosticsSampleRate"
+`
+
+type UpdateWorkflowPauseParams struct {
+	Paused                bool                      `json:"paused"`
+	PausedTriggerBehavior NullPausedTriggerBehavior `json:"pausedTriggerBehavior"`
+	ID                    pgtype.UUID               `json:"id"`
+	Tenantid              pgtype.UUID               `json:"tenantid"`
+}
+
+func (q *Queries) UpdateWorkflowPause(ctx context.Context, db DBTX, arg UpdateWorkflowPauseParams) (*Workflow, error) {
+	row := db.QueryRow(ctx, updateWorkflowPause,
+		arg.Paused,
+		arg.PausedTriggerBehavior,
+		arg.ID,
+		arg.Tenantid,
+	)
+	var i Workflow
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TenantId,
+		&i.Name,
+		&i.Description,
+		&i.Paused,
+		&i.PausedTriggerBehavior,
+		&i.MaintenanceUntil,
+		&i.DiagnosticsSampleRate,
+	)
+	return &i, err
+}
+
 const upsertAction = `-- name: UpsertAction :one
 INSERT INTO "Action" (
     "id",
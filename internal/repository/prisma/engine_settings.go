@@ -0,0 +1,68 @@
+package prisma
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/settings"
+	"github.com/hatchet-dev/hatchet/internal/validator"
+)
+
+type engineSettingsRepository struct {
+	client *db.PrismaClient
+	v      validator.Validator
+}
+
+func NewEngineSettingsRepository(client *db.PrismaClient, v validator.Validator) repository.EngineSettingsRepository {
+	return &engineSettingsRepository{
+		client: client,
+		v:      v,
+	}
+}
+
+func (r *engineSettingsRepository) GetTenantEngineSettings(tenantId string) (*db.TenantEngineSettingsModel, error) {
+	return r.client.TenantEngineSettings.FindUnique(
+		db.TenantEngineSettings.TenantID.Equals(tenantId),
+	).Exec(context.Background())
+}
+
+func (r *engineSettingsRepository) GetTenantEngineSettingsOverrides(tenantId string) (*settings.Overrides, error) {
+	engineSettings, err := r.GetTenantEngineSettings(tenantId)
+
+	if errors.Is(err, db.ErrNotFound) {
+		return &settings.Overrides{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	inner := engineSettings.InnerTenantEngineSettings
+
+	return settings.ParseOverrides(inner.DefaultStepTimeout, inner.ScheduleTimeout, inner.DefaultStepRetries, inner.MaxFanOut), nil
+}
+
+func (r *engineSettingsRepository) UpdateTenantEngineSettings(tenantId string, opts *repository.UpdateTenantEngineSettingsOpts) (*db.TenantEngineSettingsModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	return r.client.TenantEngineSettings.UpsertOne(
+		db.TenantEngineSettings.TenantID.Equals(tenantId),
+	).Create(
+		db.TenantEngineSettings.Tenant.Link(
+			db.Tenant.ID.Equals(tenantId),
+		),
+		db.TenantEngineSettings.DefaultStepTimeout.SetIfPresent(opts.DefaultStepTimeout),
+		db.TenantEngineSettings.ScheduleTimeout.SetIfPresent(opts.ScheduleTimeout),
+		db.TenantEngineSettings.DefaultStepRetries.SetIfPresent(opts.DefaultStepRetries),
+		db.TenantEngineSettings.MaxFanOut.SetIfPresent(opts.MaxFanOut),
+	).Update(
+		db.TenantEngineSettings.DefaultStepTimeout.SetOptional(opts.DefaultStepTimeout),
+		db.TenantEngineSettings.ScheduleTimeout.SetOptional(opts.ScheduleTimeout),
+		db.TenantEngineSettings.DefaultStepRetries.SetOptional(opts.DefaultStepRetries),
+		db.TenantEngineSettings.MaxFanOut.SetOptional(opts.MaxFanOut),
+	).Exec(context.Background())
+}
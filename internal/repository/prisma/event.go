@@ -67,6 +67,16 @@ func (r *eventRepository) ListEvents(tenantId string, opts *repository.ListEvent
 		countParams.Search = sqlchelpers.TextFromStr(*opts.Search)
 	}
 
+	if opts.Environment != nil {
+		queryParams.Environment = sqlchelpers.TextFromStr(*opts.Environment)
+		countParams.Environment = sqlchelpers.TextFromStr(*opts.Environment)
+	}
+
+	if opts.Namespace != nil {
+		queryParams.Namespace = sqlchelpers.TextFromStr(*opts.Namespace)
+		countParams.Namespace = sqlchelpers.TextFromStr(*opts.Namespace)
+	}
+
 	if opts.Offset != nil {
 		queryParams.Offset = *opts.Offset
 	}
@@ -213,6 +223,10 @@ func (r *eventRepository) CreateEvent(ctx context.Context, opts *repository.Crea
 		createParams.ReplayedFromId = sqlchelpers.UUIDFromStr(*opts.ReplayedEvent)
 	}
 
+	if opts.Environment != nil {
+		createParams.Environment = sqlchelpers.TextFromStr(*opts.Environment)
+	}
+
 	tx, err := r.pool.Begin(ctx)
 
 	if err != nil {
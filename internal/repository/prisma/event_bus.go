@@ -0,0 +1,136 @@
+package prisma
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/validator"
+)
+
+type eventBusRepository struct {
+	client  *db.PrismaClient
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewEventBusRepository(client *db.PrismaClient, pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.EventBusRepository {
+	queries := dbsqlc.New()
+
+	return &eventBusRepository{
+		client:  client,
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *eventBusRepository) CreateEventBusSubscription(tenantId string, opts *repository.CreateEventBusSubscriptionOpts) (*db.EventBusSubscriptionModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	return r.client.EventBusSubscription.CreateOne(
+		db.EventBusSubscription.Tenant.Link(
+			db.Tenant.ID.Equals(tenantId),
+		),
+		db.EventBusSubscription.Name.Set(opts.Name),
+		db.EventBusSubscription.Topics.Set(opts.Topics),
+	).Exec(context.Background())
+}
+
+func (r *eventBusRepository) GetEventBusSubscriptionById(id string) (*db.EventBusSubscriptionModel, error) {
+	return r.client.EventBusSubscription.FindUnique(
+		db.EventBusSubscription.ID.Equals(id),
+	).Exec(context.Background())
+}
+
+func (r *eventBusRepository) ListEventBusSubscriptions(tenantId string) ([]db.EventBusSubscriptionModel, error) {
+	return r.client.EventBusSubscription.FindMany(
+		db.EventBusSubscription.TenantID.Equals(tenantId),
+	).OrderBy(
+		db.EventBusSubscription.CreatedAt.Order(db.ASC),
+	).Exec(context.Background())
+}
+
+func (r *eventBusRepository) DeleteEventBusSubscription(tenantId, id string) error {
+	_, err := r.client.EventBusSubscription.FindMany(
+		db.EventBusSubscription.ID.Equals(id),
+		db.EventBusSubscription.TenantID.Equals(tenantId),
+	).Delete().Exec(context.Background())
+
+	return err
+}
+
+func (r *eventBusRepository) CreateEventBusRecord(ctx context.Context, tenantId, topic string, data any) error {
+	return createEventBusRecord(ctx, r.queries, r.pool, tenantId, topic, data)
+}
+
+func (r *eventBusRepository) ListEventBusRecords(ctx context.Context, subscriptionId string, limit int) ([]*dbsqlc.EventBusRecord, error) {
+	pgSubscriptionId := sqlchelpers.UUIDFromStr(subscriptionId)
+
+	records, err := r.queries.ListEventBusRecords(ctx, r.pool, dbsqlc.ListEventBusRecordsParams{
+		Subscriptionid: pgSubscriptionId,
+		Limit:          int32(limit),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list event bus records: %w", err)
+	}
+
+	if err := r.queries.UpdateEventBusSubscriptionPolled(ctx, r.pool, pgSubscriptionId); err != nil {
+		return nil, fmt.Errorf("could not update event bus subscription: %w", err)
+	}
+
+	return records, nil
+}
+
+func (r *eventBusRepository) AckEventBusSubscription(ctx context.Context, subscriptionId string, cursor int64) error {
+	return r.queries.AckEventBusSubscription(ctx, r.pool, dbsqlc.AckEventBusSubscriptionParams{
+		Subscriptionid: sqlchelpers.UUIDFromStr(subscriptionId),
+		Cursor:         cursor,
+	})
+}
+
+func (r *eventBusRepository) DeleteExpiredEventBusRecords(ctx context.Context, before time.Time, limit int) (int64, error) {
+	return r.queries.DeleteExpiredEventBusRecords(ctx, r.pool, dbsqlc.DeleteExpiredEventBusRecordsParams{
+		Before: sqlchelpers.TimestampFromTime(before.UTC()),
+		Limit:  int32(limit),
+	})
+}
+
+// createEventBusRecord creates an event bus record with the given db, so that records can be created in the same
+// transaction as the change which they describe.
+func createEventBusRecord(ctx context.Context, queries *dbsqlc.Queries, dbtx dbsqlc.DBTX, tenantId, topic string, data any) error {
+	dataBytes, err := json.Marshal(data)
+
+	if err != nil {
+		return fmt.Errorf("could not marshal event bus record: %w", err)
+	}
+
+	return queries.CreateEventBusRecord(ctx, dbtx, dbsqlc.CreateEventBusRecordParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Topic:    topic,
+		Data:     dataBytes,
+	})
+}
+
+// createStepRunEventBusRecord creates an event bus record for a step run which changed to the failed status.
+func createStepRunEventBusRecord(ctx context.Context, queries *dbsqlc.Queries, dbtx dbsqlc.DBTX, stepRun *dbsqlc.GetStepRunForEngineRow) error {
+	if stepRun.StepRun.Status != dbsqlc.StepRunStatusFAILED {
+		return nil
+	}
+
+	return createEventBusRecord(ctx, queries, dbtx, sqlchelpers.UUIDToStr(stepRun.StepRun.TenantId), repository.EventBusTopicStepRunFailed, stepRunRecordData(stepRun))
+}
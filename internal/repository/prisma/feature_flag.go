@@ -0,0 +1,71 @@
+package prisma
+
+import (
+	"context"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/validator"
+)
+
+type featureFlagRepository struct {
+	client *db.PrismaClient
+	v      validator.Validator
+}
+
+func NewFeatureFlagRepository(client *db.PrismaClient, v validator.Validator) repository.FeatureFlagRepository {
+	return &featureFlagRepository{
+		client: client,
+		v:      v,
+	}
+}
+
+func (r *featureFlagRepository) ListTenantFeatureFlags(tenantId string) ([]db.TenantFeatureFlagModel, error) {
+	return r.client.TenantFeatureFlag.FindMany(
+		db.TenantFeatureFlag.TenantID.Equals(tenantId),
+	).OrderBy(
+		db.TenantFeatureFlag.Flag.Order(db.ASC),
+	).Exec(context.Background())
+}
+
+func (r *featureFlagRepository) GetTenantFeatureFlagOverrides(tenantId string) (map[string]bool, error) {
+	flags, err := r.ListTenantFeatureFlags(tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]bool, len(flags))
+
+	for _, flag := range flags {
+		res[flag.Flag] = flag.Enabled
+	}
+
+	return res, nil
+}
+
+func (r *featureFlagRepository) UpsertTenantFeatureFlag(tenantId, flag string, enabled bool) (*db.TenantFeatureFlagModel, error) {
+	return r.client.TenantFeatureFlag.UpsertOne(
+		db.TenantFeatureFlag.TenantIDFlag(
+			db.TenantFeatureFlag.TenantID.Equals(tenantId),
+			db.TenantFeatureFlag.Flag.Equals(flag),
+		),
+	).Create(
+		db.TenantFeatureFlag.Tenant.Link(
+			db.Tenant.ID.Equals(tenantId),
+		),
+		db.TenantFeatureFlag.Flag.Set(flag),
+		db.TenantFeatureFlag.Enabled.Set(enabled),
+	).Update(
+		db.TenantFeatureFlag.Enabled.Set(enabled),
+	).Exec(context.Background())
+}
+
+func (r *featureFlagRepository) DeleteTenantFeatureFlag(tenantId, flag string) error {
+	_, err := r.client.TenantFeatureFlag.FindMany(
+		db.TenantFeatureFlag.TenantID.Equals(tenantId),
+		db.TenantFeatureFlag.Flag.Equals(flag),
+	).Delete().Exec(context.Background())
+
+	return err
+}
@@ -0,0 +1,64 @@
+package prisma
+
+import (
+	"context"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/validator"
+)
+
+type giteaRepository struct {
+	client *db.PrismaClient
+	v      validator.Validator
+}
+
+func NewGiteaRepository(client *db.PrismaClient, v validator.Validator) repository.GiteaRepository {
+	return &giteaRepository{
+		client: client,
+		v:      v,
+	}
+}
+
+func (r *giteaRepository) CreateGiteaWebhook(tenantId string, opts *repository.CreateGiteaWebhookOpts) (*db.GiteaWebhookModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	return r.client.GiteaWebhook.CreateOne(
+		db.GiteaWebhook.Tenant.Link(
+			db.Tenant.ID.Equals(tenantId),
+		),
+		db.GiteaWebhook.RepositoryOwner.Set(opts.RepoOwner),
+		db.GiteaWebhook.RepositoryName.Set(opts.RepoName),
+		db.GiteaWebhook.SigningSecret.Set(opts.SigningSecret),
+	).Exec(context.Background())
+}
+
+func (r *giteaRepository) GetGiteaWebhookById(id string) (*db.GiteaWebhookModel, error) {
+	return r.client.GiteaWebhook.FindUnique(
+		db.GiteaWebhook.ID.Equals(id),
+	).Exec(context.Background())
+}
+
+func (r *giteaRepository) ListGiteaWebhooks(tenantId string) ([]db.GiteaWebhookModel, error) {
+	return r.client.GiteaWebhook.FindMany(
+		db.GiteaWebhook.TenantID.Equals(tenantId),
+	).OrderBy(
+		db.GiteaWebhook.CreatedAt.Order(db.ASC),
+	).Exec(context.Background())
+}
+
+func (r *giteaRepository) DeleteGiteaWebhook(tenantId, id string) error {
+	// deliveries don't reference the webhook, so they are deleted along with it
+	deleteDeliveries := r.client.WebhookDelivery.FindMany(
+		db.WebhookDelivery.TenantID.Equals(tenantId),
+		db.WebhookDelivery.WebhookID.Equals(id),
+	).Delete().Tx()
+
+	deleteWebhook := r.client.GiteaWebhook.FindUnique(
+		db.GiteaWebhook.ID.Equals(id),
+	).Delete().Tx()
+
+	return r.client.Prisma.Transaction(deleteDeliveries, deleteWebhook).Exec(context.Background())
+}
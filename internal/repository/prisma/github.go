@@ -189,3 +189,200 @@ func (r *githubRepository) GetPullRequest(tenantId, repoOwner, repoName string,
 		),
 	).Exec(context.Background())
 }
+
+func (r *githubRepository) UpsertPullRequest(tenantId string, opts *repository.UpsertPullRequestOpts) (*db.GithubPullRequestModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	return r.client.GithubPullRequest.UpsertOne(
+		db.GithubPullRequest.TenantIDRepositoryOwnerRepositoryNamePullRequestNumber(
+			db.GithubPullRequest.TenantID.Equals(tenantId),
+			db.GithubPullRequest.RepositoryOwner.Equals(opts.RepoOwner),
+			db.GithubPullRequest.RepositoryName.Equals(opts.RepoName),
+			db.GithubPullRequest.PullRequestNumber.Equals(opts.PullRequestNumber),
+		),
+	).Create(
+		db.GithubPullRequest.Tenant.Link(
+			db.Tenant.ID.Equals(tenantId),
+		),
+		db.GithubPullRequest.RepositoryOwner.Set(opts.RepoOwner),
+		db.GithubPullRequest.RepositoryName.Set(opts.RepoName),
+		db.GithubPullRequest.PullRequestID.Set(opts.PullRequestID),
+		db.GithubPullRequest.PullRequestTitle.Set(opts.Title),
+		db.GithubPullRequest.PullRequestNumber.Set(opts.PullRequestNumber),
+		db.GithubPullRequest.PullRequestHeadBranch.Set(opts.HeadBranch),
+		db.GithubPullRequest.PullRequestBaseBranch.Set(opts.BaseBranch),
+		db.GithubPullRequest.PullRequestState.Set(opts.State),
+	).Update(
+		db.GithubPullRequest.PullRequestTitle.Set(opts.Title),
+		db.GithubPullRequest.PullRequestHeadBranch.Set(opts.HeadBranch),
+		db.GithubPullRequest.PullRequestBaseBranch.Set(opts.BaseBranch),
+		db.GithubPullRequest.PullRequestState.Set(opts.State),
+	).Exec(context.Background())
+}
+
+func (r *githubRepository) AddPullRequestWorkflowRun(tenantId, prId, workflowRunId string) error {
+	_, err := r.client.GithubPullRequest.FindUnique(
+		db.GithubPullRequest.ID.Equals(prId),
+	).Update(
+		db.GithubPullRequest.WorkflowRuns.Link(
+			db.WorkflowRun.ID.Equals(workflowRunId),
+		),
+	).Exec(context.Background())
+
+	return err
+}
+
+func (r *githubRepository) GetPreviewEnvironment(tenantId, prId, workflowId string) (*db.GithubPreviewEnvironmentModel, error) {
+	return r.client.GithubPreviewEnvironment.FindUnique(
+		db.GithubPreviewEnvironment.PullRequestIDWorkflowID(
+			db.GithubPreviewEnvironment.PullRequestID.Equals(prId),
+			db.GithubPreviewEnvironment.WorkflowID.Equals(workflowId),
+		),
+	).Exec(context.Background())
+}
+
+func (r *githubRepository) ListPreviewEnvironments(tenantId, prId string) ([]db.GithubPreviewEnvironmentModel, error) {
+	return r.client.GithubPreviewEnvironment.FindMany(
+		db.GithubPreviewEnvironment.TenantID.Equals(tenantId),
+		db.GithubPreviewEnvironment.PullRequestID.Equals(prId),
+	).With(
+		db.GithubPreviewEnvironment.Workflow.Fetch(),
+	).OrderBy(
+		db.GithubPreviewEnvironment.CreatedAt.Order(db.ASC),
+	).Exec(context.Background())
+}
+
+func (r *githubRepository) UpsertPreviewEnvironment(tenantId, prId, workflowId string, opts *repository.UpsertPreviewEnvironmentOpts) (*db.GithubPreviewEnvironmentModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	var commentId *db.BigInt
+
+	if opts.CommentID != nil {
+		commentId = (*db.BigInt)(opts.CommentID)
+	}
+
+	return r.client.GithubPreviewEnvironment.UpsertOne(
+		db.GithubPreviewEnvironment.PullRequestIDWorkflowID(
+			db.GithubPreviewEnvironment.PullRequestID.Equals(prId),
+			db.GithubPreviewEnvironment.WorkflowID.Equals(workflowId),
+		),
+	).Create(
+		db.GithubPreviewEnvironment.Tenant.Link(
+			db.Tenant.ID.Equals(tenantId),
+		),
+		db.GithubPreviewEnvironment.PullRequest.Link(
+			db.GithubPullRequest.ID.Equals(prId),
+		),
+		db.GithubPreviewEnvironment.Workflow.Link(
+			db.Workflow.ID.Equals(workflowId),
+		),
+		db.GithubPreviewEnvironment.URL.SetIfPresent(opts.URL),
+		db.GithubPreviewEnvironment.CommentID.SetIfPresent(commentId),
+		db.GithubPreviewEnvironment.TornDownAt.SetIfPresent(opts.TornDownAt),
+	).Update(
+		db.GithubPreviewEnvironment.URL.SetIfPresent(opts.URL),
+		db.GithubPreviewEnvironment.CommentID.SetIfPresent(commentId),
+		db.GithubPreviewEnvironment.TornDownAt.SetOptional(opts.TornDownAt),
+	).Exec(context.Background())
+}
+
+func (r *githubRepository) CreateGithubAppCredentials(opts *repository.CreateGithubAppCredentialsOpts) (*db.GithubAppCredentialsModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	return r.client.GithubAppCredentials.CreateOne(
+		db.GithubAppCredentials.AppID.Set(opts.AppID),
+		db.GithubAppCredentials.AppSlug.Set(opts.AppSlug),
+		db.GithubAppCredentials.SettingsURL.Set(opts.SettingsURL),
+		db.GithubAppCredentials.ClientID.Set(opts.ClientID),
+		db.GithubAppCredentials.ClientSecret.Set(opts.ClientSecret),
+		db.GithubAppCredentials.WebhookSecret.Set(opts.WebhookSecret),
+		db.GithubAppCredentials.PrivateKey.Set(opts.PrivateKey),
+	).Exec(context.Background())
+}
+
+func (r *githubRepository) ReadLatestGithubAppCredentials() (*db.GithubAppCredentialsModel, error) {
+	return r.client.GithubAppCredentials.FindFirst().OrderBy(
+		db.GithubAppCredentials.CreatedAt.Order(db.DESC),
+	).Exec(context.Background())
+}
+
+func (r *githubRepository) ListLinkedGithubRepositories() ([]*repository.LinkedGithubRepository, error) {
+	deploymentConfigs, err := r.client.WorkflowDeploymentConfig.FindMany(
+		db.WorkflowDeploymentConfig.DeletedAt.IsNull(),
+		db.WorkflowDeploymentConfig.Workflow.Where(
+			db.Workflow.DeletedAt.IsNull(),
+		),
+	).With(
+		db.WorkflowDeploymentConfig.Workflow.Fetch(),
+		db.WorkflowDeploymentConfig.GithubAppInstallation.Fetch(),
+	).Exec(context.Background())
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*repository.LinkedGithubRepository, 0)
+	seen := map[string]bool{}
+
+	for _, deploymentConfig := range deploymentConfigs {
+		installation, ok := deploymentConfig.GithubAppInstallation()
+
+		if !ok {
+			continue
+		}
+
+		linked := &repository.LinkedGithubRepository{
+			TenantId:       deploymentConfig.Workflow().TenantID,
+			RepoOwner:      deploymentConfig.GitRepoOwner,
+			RepoName:       deploymentConfig.GitRepoName,
+			InstallationID: installation.InstallationID,
+		}
+
+		// several workflows of a tenant can be linked to the same repository
+		key := linked.TenantId + "/" + linked.RepoOwner + "/" + linked.RepoName
+
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		res = append(res, linked)
+	}
+
+	return res, nil
+}
+
+func (r *githubRepository) ListGithubWebhooks(tenantId string) ([]db.GithubWebhookModel, error) {
+	return r.client.GithubWebhook.FindMany(
+		db.GithubWebhook.TenantID.Equals(tenantId),
+	).OrderBy(
+		db.GithubWebhook.CreatedAt.Order(db.ASC),
+	).Exec(context.Background())
+}
+
+func (r *githubRepository) UpdateGithubWebhookStatus(id string, opts *repository.UpdateGithubWebhookStatusOpts) (*db.GithubWebhookModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	var hookId *db.BigInt
+
+	if opts.HookID != nil {
+		hookId = (*db.BigInt)(opts.HookID)
+	}
+
+	return r.client.GithubWebhook.FindUnique(
+		db.GithubWebhook.ID.Equals(id),
+	).Update(
+		db.GithubWebhook.ReconciledAt.Set(opts.ReconciledAt),
+		db.GithubWebhook.HookID.SetIfPresent(hookId),
+		db.GithubWebhook.ReconcileError.SetOptional(opts.ReconcileError),
+		db.GithubWebhook.FailedDeliveries.SetIfPresent(opts.FailedDeliveries),
+	).Exec(context.Background())
+}
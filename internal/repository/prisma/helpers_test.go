@@ -0,0 +1,39 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/internal/config/database"
+	"github.com/hatchet-dev/hatchet/internal/encryption"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+)
+
+// createTestTenant creates a new tenant and returns its id.
+func createTestTenant(t *testing.T, conf *database.Config) string {
+	t.Helper()
+
+	tenantId := uuid.New().String()
+
+	slugSuffix, err := encryption.GenerateRandomBytes(8)
+
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	_, err = conf.Repository.Tenant().CreateTenant(&repository.CreateTenantOpts{
+		ID:   &tenantId,
+		Name: "test-tenant",
+		Slug: fmt.Sprintf("test-tenant-%s", slugSuffix),
+	})
+
+	if err != nil {
+		t.Fatalf("could not create tenant: %v", err)
+	}
+
+	return tenantId
+}
@@ -0,0 +1,99 @@
+package prisma
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/validator"
+)
+
+type idempotencyKeyRepository struct {
+	client  *db.PrismaClient
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+}
+
+func NewIdempotencyKeyRepository(client *db.PrismaClient, pool *pgxpool.Pool, v validator.Validator) repository.IdempotencyKeyRepository {
+	queries := dbsqlc.New()
+
+	return &idempotencyKeyRepository{
+		client:  client,
+		pool:    pool,
+		v:       v,
+		queries: queries,
+	}
+}
+
+func (r *idempotencyKeyRepository) CreateIdempotencyKey(tenantId string, opts *repository.CreateIdempotencyKeyOpts) (*db.IdempotencyKeyModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	// an expired key which was not deleted by the ticker yet is removed when the key is reused
+	_, err := r.client.IdempotencyKey.FindMany(
+		db.IdempotencyKey.TenantID.Equals(tenantId),
+		db.IdempotencyKey.Key.Equals(opts.Key),
+		db.IdempotencyKey.ExpiresAt.Lte(time.Now().UTC()),
+	).Delete().Exec(context.Background())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.client.IdempotencyKey.CreateOne(
+		db.IdempotencyKey.ExpiresAt.Set(opts.ExpiresAt),
+		db.IdempotencyKey.Tenant.Link(
+			db.Tenant.ID.Equals(tenantId),
+		),
+		db.IdempotencyKey.Key.Set(opts.Key),
+		db.IdempotencyKey.Fingerprint.Set(opts.Fingerprint),
+	).Exec(context.Background())
+}
+
+func (r *idempotencyKeyRepository) GetIdempotencyKey(tenantId, key string) (*db.IdempotencyKeyModel, error) {
+	return r.client.IdempotencyKey.FindFirst(
+		db.IdempotencyKey.TenantID.Equals(tenantId),
+		db.IdempotencyKey.Key.Equals(key),
+		db.IdempotencyKey.ExpiresAt.Gt(time.Now().UTC()),
+	).Exec(context.Background())
+}
+
+func (r *idempotencyKeyRepository) UpdateIdempotencyKeyResponse(tenantId, key string, opts *repository.UpdateIdempotencyKeyResponseOpts) error {
+	if err := r.v.Validate(opts); err != nil {
+		return err
+	}
+
+	_, err := r.client.IdempotencyKey.FindUnique(
+		db.IdempotencyKey.TenantIDKey(
+			db.IdempotencyKey.TenantID.Equals(tenantId),
+			db.IdempotencyKey.Key.Equals(key),
+		),
+	).Update(
+		db.IdempotencyKey.ResponseStatus.Set(opts.Status),
+		db.IdempotencyKey.ResponseContentType.SetIfPresent(opts.ContentType),
+		db.IdempotencyKey.ResponseBody.Set(opts.Body),
+	).Exec(context.Background())
+
+	return err
+}
+
+func (r *idempotencyKeyRepository) DeleteIdempotencyKey(tenantId, key string) error {
+	_, err := r.client.IdempotencyKey.FindUnique(
+		db.IdempotencyKey.TenantIDKey(
+			db.IdempotencyKey.TenantID.Equals(tenantId),
+			db.IdempotencyKey.Key.Equals(key),
+		),
+	).Delete().Exec(context.Background())
+
+	return err
+}
+
+func (r *idempotencyKeyRepository) DeleteExpiredIdempotencyKeys(ctx context.Context, limit int) (int64, error) {
+	return r.queries.DeleteExpiredIdempotencyKeys(ctx, r.pool, int32(limit))
+}
@@ -0,0 +1,88 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/internal/config/database"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+)
+
+func TestDeleteExpiredIdempotencyKeys(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		repo := conf.Repository.IdempotencyKey()
+
+		keys := map[string]time.Time{
+			"expired-1": time.Now().UTC().Add(-2 * time.Hour),
+			"expired-2": time.Now().UTC().Add(-time.Minute),
+			"active":    time.Now().UTC().Add(time.Hour),
+		}
+
+		for key, expiresAt := range keys {
+			_, err := repo.CreateIdempotencyKey(tenantId, &repository.CreateIdempotencyKeyOpts{
+				Key:         key,
+				Fingerprint: "fingerprint",
+				ExpiresAt:   expiresAt,
+			})
+
+			if err != nil {
+				t.Fatalf("could not create idempotency key %s: %v", key, err)
+			}
+		}
+
+		var total int64
+
+		// the keys are deleted in batches, so the batches are smaller than the number of expired keys
+		for {
+			deleted, err := repo.DeleteExpiredIdempotencyKeys(ctx, 1)
+
+			if err != nil {
+				t.Fatalf("could not delete expired idempotency keys: %v", err)
+			}
+
+			assert.LessOrEqual(t, deleted, int64(1))
+
+			total += deleted
+
+			if deleted == 0 {
+				break
+			}
+		}
+
+		assert.GreaterOrEqual(t, total, int64(2))
+
+		var remaining []string
+
+		rows, err := conf.Pools["engine"].Query(ctx, `SELECT "key" FROM "IdempotencyKey" WHERE "tenantId" = $1::uuid`, tenantId)
+
+		if err != nil {
+			t.Fatalf("could not list idempotency keys: %v", err)
+		}
+
+		for rows.Next() {
+			var key string
+
+			if err := rows.Scan(&key); err != nil {
+				t.Fatalf("could not scan idempotency key: %v", err)
+			}
+
+			remaining = append(remaining, key)
+		}
+
+		if err := rows.Err(); err != nil {
+			t.Fatalf("could not list idempotency keys: %v", err)
+		}
+
+		assert.Equal(t, []string{"active"}, remaining)
+
+		return nil
+	})
+}
@@ -0,0 +1,56 @@
+package prisma
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+type leaderLeaseRepository struct {
+	pool    *pgxpool.Pool
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewLeaderLeaseRepository(pool *pgxpool.Pool, l *zerolog.Logger) repository.LeaderLeaseRepository {
+	queries := dbsqlc.New()
+
+	return &leaderLeaseRepository{
+		pool:    pool,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *leaderLeaseRepository) AcquireLeaderLease(ctx context.Context, role, leaderId string, duration time.Duration) (bool, error) {
+	_, err := r.queries.AcquireLeaderLease(ctx, r.pool, dbsqlc.AcquireLeaderLeaseParams{
+		Role:         role,
+		Leaderid:     leaderId,
+		Leaseseconds: duration.Seconds(),
+	})
+
+	if err != nil {
+		// no rows are returned when the lease is held by another instance
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *leaderLeaseRepository) ReleaseLeaderLease(ctx context.Context, role, leaderId string) error {
+	return r.queries.ReleaseLeaderLease(ctx, r.pool, dbsqlc.ReleaseLeaderLeaseParams{
+		Role:     role,
+		Leaderid: leaderId,
+	})
+}
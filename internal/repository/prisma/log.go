@@ -2,6 +2,7 @@ package prisma
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -81,6 +82,23 @@ func (r *logRepository) PutLog(tenantId string, opts *repository.CreateLogLineOp
 		return nil, fmt.Errorf("could not create log line: %w", err)
 	}
 
+	logSinkData := map[string]interface{}{
+		"stepRunId": opts.StepRunId,
+		"message":   logLine.Message,
+		"level":     string(logLine.Level),
+		"createdAt": logLine.CreatedAt.Time,
+	}
+
+	if len(logLine.Metadata) > 0 {
+		logSinkData["metadata"] = json.RawMessage(logLine.Metadata)
+	}
+
+	err = createLogSinkRecord(context.Background(), r.queries, tx, tenantId, repository.LogSinkEventStepRunLog, logSinkData)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create log sink record: %w", err)
+	}
+
 	err = tx.Commit(context.Background())
 
 	if err != nil {
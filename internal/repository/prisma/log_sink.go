@@ -0,0 +1,188 @@
+package prisma
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/validator"
+)
+
+type logSinkRepository struct {
+	client  *db.PrismaClient
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewLogSinkRepository(client *db.PrismaClient, pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.LogSinkRepository {
+	queries := dbsqlc.New()
+
+	return &logSinkRepository{
+		client:  client,
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *logSinkRepository) CreateLogSink(tenantId string, opts *repository.CreateLogSinkOpts) (*db.LogSinkModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	optionals := []db.LogSinkSetParam{}
+
+	if opts.BatchSize != nil {
+		optionals = append(optionals, db.LogSink.BatchSize.Set(*opts.BatchSize))
+	}
+
+	return r.client.LogSink.CreateOne(
+		db.LogSink.Tenant.Link(
+			db.Tenant.ID.Equals(tenantId),
+		),
+		db.LogSink.Name.Set(opts.Name),
+		db.LogSink.Kind.Set(db.LogSinkKind(opts.Kind)),
+		db.LogSink.Destination.Set(opts.Destination),
+		db.LogSink.Config.Set(opts.Config),
+		optionals...,
+	).Exec(context.Background())
+}
+
+func (r *logSinkRepository) GetLogSinkById(id string) (*db.LogSinkModel, error) {
+	return r.client.LogSink.FindUnique(
+		db.LogSink.ID.Equals(id),
+	).Exec(context.Background())
+}
+
+func (r *logSinkRepository) ListLogSinks(tenantId string) ([]db.LogSinkModel, error) {
+	return r.client.LogSink.FindMany(
+		db.LogSink.TenantID.Equals(tenantId),
+	).OrderBy(
+		db.LogSink.CreatedAt.Order(db.ASC),
+	).Exec(context.Background())
+}
+
+func (r *logSinkRepository) DeleteLogSink(tenantId, id string) error {
+	_, err := r.client.LogSink.FindMany(
+		db.LogSink.ID.Equals(id),
+		db.LogSink.TenantID.Equals(tenantId),
+	).Delete().Exec(context.Background())
+
+	return err
+}
+
+func (r *logSinkRepository) CreateLogSinkRecord(ctx context.Context, tenantId, event string, data any) error {
+	return createLogSinkRecord(ctx, r.queries, r.pool, tenantId, event, data)
+}
+
+func (r *logSinkRepository) ListLogSinksToDeliver(ctx context.Context, limit int) ([]*dbsqlc.LogSink, error) {
+	return r.queries.ListLogSinksToDeliver(ctx, r.pool, int32(limit))
+}
+
+func (r *logSinkRepository) ListLogSinkRecords(ctx context.Context, logSinkId string, limit int) ([]*dbsqlc.LogSinkRecord, error) {
+	return r.queries.ListLogSinkRecords(ctx, r.pool, dbsqlc.ListLogSinkRecordsParams{
+		Logsinkid: sqlchelpers.UUIDFromStr(logSinkId),
+		Limit:     int32(limit),
+	})
+}
+
+func (r *logSinkRepository) UpdateLogSinkDelivered(ctx context.Context, logSinkId string, lastRecord *dbsqlc.LogSinkRecord) error {
+	return r.queries.UpdateLogSinkDelivered(ctx, r.pool, dbsqlc.UpdateLogSinkDeliveredParams{
+		Logsinkid:     sqlchelpers.UUIDFromStr(logSinkId),
+		Lastrecordxid: lastRecord.Xid,
+		Lastrecordid:  lastRecord.ID,
+	})
+}
+
+func (r *logSinkRepository) UpdateLogSinkFailed(ctx context.Context, logSinkId string, deliveryErr error, nextAttemptAt time.Time) error {
+	return r.queries.UpdateLogSinkFailed(ctx, r.pool, dbsqlc.UpdateLogSinkFailedParams{
+		Logsinkid:     sqlchelpers.UUIDFromStr(logSinkId),
+		Lasterror:     deliveryErr.Error(),
+		Nextattemptat: sqlchelpers.TimestampFromTime(nextAttemptAt.UTC()),
+	})
+}
+
+func (r *logSinkRepository) DeleteExpiredLogSinkRecords(ctx context.Context, before time.Time, limit int) (int64, error) {
+	return r.queries.DeleteExpiredLogSinkRecords(ctx, r.pool, dbsqlc.DeleteExpiredLogSinkRecordsParams{
+		Before: sqlchelpers.TimestampFromTime(before.UTC()),
+		Limit:  int32(limit),
+	})
+}
+
+// createLogSinkRecord creates a log sink record with the given db, so that records can be created in the same
+// transaction as the change which they describe.
+func createLogSinkRecord(ctx context.Context, queries *dbsqlc.Queries, dbtx dbsqlc.DBTX, tenantId, event string, data any) error {
+	dataBytes, err := json.Marshal(data)
+
+	if err != nil {
+		return fmt.Errorf("could not marshal log sink record: %w", err)
+	}
+
+	return queries.CreateLogSinkRecord(ctx, dbtx, dbsqlc.CreateLogSinkRecordParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Event:    event,
+		Data:     dataBytes,
+	})
+}
+
+// stepRunLogSinkEvents are the log sink events of the step run statuses which are forwarded.
+var stepRunLogSinkEvents = map[dbsqlc.StepRunStatus]string{
+	dbsqlc.StepRunStatusRUNNING:   repository.LogSinkEventStepRunStarted,
+	dbsqlc.StepRunStatusSUCCEEDED: repository.LogSinkEventStepRunSucceeded,
+	dbsqlc.StepRunStatusFAILED:    repository.LogSinkEventStepRunFailed,
+	dbsqlc.StepRunStatusCANCELLED: repository.LogSinkEventStepRunCancelled,
+}
+
+// createStepRunLogSinkRecord creates a log sink record for a step run which changed to a status which is forwarded.
+func createStepRunLogSinkRecord(ctx context.Context, queries *dbsqlc.Queries, dbtx dbsqlc.DBTX, stepRun *dbsqlc.GetStepRunForEngineRow) error {
+	event, ok := stepRunLogSinkEvents[stepRun.StepRun.Status]
+
+	if !ok {
+		return nil
+	}
+
+	return createLogSinkRecord(ctx, queries, dbtx, sqlchelpers.UUIDToStr(stepRun.StepRun.TenantId), event, stepRunRecordData(stepRun))
+}
+
+// stepRunRecordData returns the data of a log sink or event bus record for a step run.
+func stepRunRecordData(stepRun *dbsqlc.GetStepRunForEngineRow) map[string]interface{} {
+	data := map[string]interface{}{
+		"stepRunId":     sqlchelpers.UUIDToStr(stepRun.StepRun.ID),
+		"jobRunId":      sqlchelpers.UUIDToStr(stepRun.JobRunId),
+		"workflowRunId": sqlchelpers.UUIDToStr(stepRun.WorkflowRunId),
+		"workflowName":  stepRun.WorkflowName,
+		"jobName":       stepRun.JobName,
+		"actionId":      stepRun.ActionId,
+		"status":        string(stepRun.StepRun.Status),
+		"retryCount":    stepRun.StepRun.RetryCount,
+	}
+
+	if stepRun.StepReadableId.Valid {
+		data["stepReadableId"] = stepRun.StepReadableId.String
+	}
+
+	if stepRun.StepRun.Error.Valid {
+		data["error"] = stepRun.StepRun.Error.String
+	}
+
+	if stepRun.StepRun.CancelledReason.Valid {
+		data["cancelledReason"] = stepRun.StepRun.CancelledReason.String
+	}
+
+	if stepRun.StepRun.WorkerId.Valid {
+		data["workerId"] = sqlchelpers.UUIDToStr(stepRun.StepRun.WorkerId)
+	}
+
+	return data
+}
@@ -0,0 +1,124 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/internal/config/database"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+)
+
+// TestListLogSinkRecordsLateCommit creates a record in a transaction which commits after a record with a higher id,
+// and checks that it is still delivered to the sink.
+func TestListLogSinkRecordsLateCommit(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+
+		sink, err := conf.Repository.LogSink().CreateLogSink(tenantId, &repository.CreateLogSinkOpts{
+			Name:        "test-sink",
+			Kind:        "HTTP",
+			Destination: "http://localhost",
+			Config:      []byte("config"),
+		})
+
+		if err != nil {
+			t.Fatalf("could not create log sink: %v", err)
+		}
+
+		// the records of the transaction are created at the start of the transaction, which has to be after the
+		// sink was created
+		time.Sleep(10 * time.Millisecond)
+
+		tx, err := conf.Pools["engine"].Begin(ctx)
+
+		if err != nil {
+			t.Fatalf("could not begin transaction: %v", err)
+		}
+
+		defer tx.Rollback(ctx) // nolint: errcheck
+
+		err = dbsqlc.New().CreateLogSinkRecord(ctx, tx, dbsqlc.CreateLogSinkRecordParams{
+			Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+			Event:    "late",
+			Data:     []byte("{}"),
+		})
+
+		if err != nil {
+			t.Fatalf("could not create late record: %v", err)
+		}
+
+		// this record gets a higher id, but is committed first
+		err = conf.Repository.LogSink().CreateLogSinkRecord(ctx, tenantId, "early", map[string]string{})
+
+		if err != nil {
+			t.Fatalf("could not create early record: %v", err)
+		}
+
+		records, err := conf.Repository.LogSink().ListLogSinkRecords(ctx, sink.ID, 100)
+
+		if err != nil {
+			t.Fatalf("could not list records: %v", err)
+		}
+
+		assert.Empty(t, records, "records must not be listed while a transaction with a lower id is in progress")
+
+		if err := tx.Commit(ctx); err != nil {
+			t.Fatalf("could not commit transaction: %v", err)
+		}
+
+		// transactions of other tests may still hold back the snapshot
+		records = waitForLogSinkRecords(t, conf, sink.ID, 2)
+
+		if len(records) != 2 {
+			t.Fatalf("expected 2 records, got %d", len(records))
+		}
+
+		assert.Equal(t, "late", records[0].Event)
+		assert.Equal(t, "early", records[1].Event)
+
+		err = conf.Repository.LogSink().UpdateLogSinkDelivered(ctx, sink.ID, records[len(records)-1])
+
+		if err != nil {
+			t.Fatalf("could not update delivered records: %v", err)
+		}
+
+		records, err = conf.Repository.LogSink().ListLogSinkRecords(ctx, sink.ID, 100)
+
+		if err != nil {
+			t.Fatalf("could not list records: %v", err)
+		}
+
+		assert.Empty(t, records)
+
+		return nil
+	})
+}
+
+func waitForLogSinkRecords(t *testing.T, conf *database.Config, sinkId string, count int) []*dbsqlc.LogSinkRecord {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+
+	for {
+		records, err := conf.Repository.LogSink().ListLogSinkRecords(context.Background(), sinkId, 100)
+
+		if err != nil {
+			t.Fatalf("could not list records: %v", err)
+		}
+
+		if len(records) >= count || time.Now().After(deadline) {
+			return records
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+}
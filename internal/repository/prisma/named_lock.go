@@ -0,0 +1,70 @@
+package prisma
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+)
+
+type namedLockRepository struct {
+	pool    *pgxpool.Pool
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewNamedLockRepository(pool *pgxpool.Pool, l *zerolog.Logger) repository.NamedLockRepository {
+	queries := dbsqlc.New()
+
+	return &namedLockRepository{
+		pool:    pool,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *namedLockRepository) AcquireNamedLock(ctx context.Context, tenantId, name, stepRunId string, holdsWorkflowRun bool, timeout time.Duration) (*dbsqlc.NamedLock, error) {
+	lock, err := r.queries.AcquireNamedLock(ctx, r.pool, dbsqlc.AcquireNamedLockParams{
+		Tenantid:         sqlchelpers.UUIDFromStr(tenantId),
+		Name:             name,
+		Holdsworkflowrun: holdsWorkflowRun,
+		Timeoutseconds:   timeout.Seconds(),
+		Steprunid:        sqlchelpers.UUIDFromStr(stepRunId),
+	})
+
+	if err != nil {
+		// no rows are returned when the lock is held by another step run or workflow run
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return lock, nil
+}
+
+func (r *namedLockRepository) ReleaseNamedLock(ctx context.Context, tenantId, name, stepRunId string) (bool, error) {
+	released, err := r.queries.ReleaseNamedLock(ctx, r.pool, dbsqlc.ReleaseNamedLockParams{
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+		Name:      name,
+		Steprunid: sqlchelpers.UUIDFromStr(stepRunId),
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	return released > 0, nil
+}
+
+func (r *namedLockRepository) DeleteReleasedNamedLocks(ctx context.Context) (int64, error) {
+	return r.queries.DeleteReleasedNamedLocks(ctx, r.pool)
+}
@@ -0,0 +1,95 @@
+package prisma
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/validator"
+)
+
+type objectStorageFeedRepository struct {
+	client  *db.PrismaClient
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewObjectStorageFeedRepository(client *db.PrismaClient, pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.ObjectStorageFeedRepository {
+	queries := dbsqlc.New()
+
+	return &objectStorageFeedRepository{
+		client:  client,
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *objectStorageFeedRepository) CreateObjectStorageFeed(tenantId string, opts *repository.CreateObjectStorageFeedOpts) (*db.ObjectStorageFeedModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	return r.client.ObjectStorageFeed.CreateOne(
+		db.ObjectStorageFeed.Tenant.Link(
+			db.Tenant.ID.Equals(tenantId),
+		),
+		db.ObjectStorageFeed.Name.Set(opts.Name),
+		db.ObjectStorageFeed.Kind.Set(db.ObjectStorageFeedKind(opts.Kind)),
+		db.ObjectStorageFeed.Source.Set(opts.Source),
+		db.ObjectStorageFeed.Config.Set(opts.Config),
+		db.ObjectStorageFeed.EventKey.Set(opts.EventKey),
+		db.ObjectStorageFeed.KeyPrefix.SetIfPresent(opts.KeyPrefix),
+	).Exec(context.Background())
+}
+
+func (r *objectStorageFeedRepository) GetObjectStorageFeedById(id string) (*db.ObjectStorageFeedModel, error) {
+	return r.client.ObjectStorageFeed.FindUnique(
+		db.ObjectStorageFeed.ID.Equals(id),
+	).Exec(context.Background())
+}
+
+func (r *objectStorageFeedRepository) ListObjectStorageFeeds(tenantId string) ([]db.ObjectStorageFeedModel, error) {
+	return r.client.ObjectStorageFeed.FindMany(
+		db.ObjectStorageFeed.TenantID.Equals(tenantId),
+	).OrderBy(
+		db.ObjectStorageFeed.CreatedAt.Order(db.ASC),
+	).Exec(context.Background())
+}
+
+func (r *objectStorageFeedRepository) DeleteObjectStorageFeed(tenantId, id string) error {
+	_, err := r.client.ObjectStorageFeed.FindMany(
+		db.ObjectStorageFeed.ID.Equals(id),
+		db.ObjectStorageFeed.TenantID.Equals(tenantId),
+	).Delete().Exec(context.Background())
+
+	return err
+}
+
+func (r *objectStorageFeedRepository) ListObjectStorageFeedsToPoll(ctx context.Context, limit int) ([]*dbsqlc.ObjectStorageFeed, error) {
+	return r.queries.ListObjectStorageFeedsToPoll(ctx, r.pool, int32(limit))
+}
+
+func (r *objectStorageFeedRepository) UpdateObjectStorageFeedPolled(ctx context.Context, feedId string, received bool) error {
+	return r.queries.UpdateObjectStorageFeedPolled(ctx, r.pool, dbsqlc.UpdateObjectStorageFeedPolledParams{
+		Received: received,
+		Feedid:   sqlchelpers.UUIDFromStr(feedId),
+	})
+}
+
+func (r *objectStorageFeedRepository) UpdateObjectStorageFeedFailed(ctx context.Context, feedId string, pollErr error, nextAttemptAt time.Time) error {
+	return r.queries.UpdateObjectStorageFeedFailed(ctx, r.pool, dbsqlc.UpdateObjectStorageFeedFailedParams{
+		Lasterror:     pollErr.Error(),
+		Nextattemptat: sqlchelpers.TimestampFromTime(nextAttemptAt.UTC()),
+		Feedid:        sqlchelpers.UUIDFromStr(feedId),
+	})
+}
@@ -0,0 +1,233 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/validator"
+)
+
+type piiRuleRepository struct {
+	client  *db.PrismaClient
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewPIIRuleRepository(client *db.PrismaClient, pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.PIIRuleRepository {
+	queries := dbsqlc.New()
+
+	return &piiRuleRepository{
+		client:  client,
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *piiRuleRepository) CreatePIIRule(tenantId string, opts *repository.CreatePIIRuleOpts) (*db.PIIRuleModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	return r.client.PIIRule.CreateOne(
+		db.PIIRule.Tenant.Link(
+			db.Tenant.ID.Equals(tenantId),
+		),
+		db.PIIRule.Path.Set(opts.Path),
+		db.PIIRule.RetentionSeconds.Set(opts.RetentionSeconds),
+	).Exec(context.Background())
+}
+
+func (r *piiRuleRepository) GetPIIRuleById(id string) (*db.PIIRuleModel, error) {
+	return r.client.PIIRule.FindUnique(
+		db.PIIRule.ID.Equals(id),
+	).Exec(context.Background())
+}
+
+func (r *piiRuleRepository) ListPIIRules(tenantId string) ([]db.PIIRuleModel, error) {
+	return r.client.PIIRule.FindMany(
+		db.PIIRule.TenantID.Equals(tenantId),
+	).OrderBy(
+		db.PIIRule.CreatedAt.Order(db.ASC),
+	).Exec(context.Background())
+}
+
+func (r *piiRuleRepository) DeletePIIRule(tenantId, id string) error {
+	_, err := r.client.PIIRule.FindMany(
+		db.PIIRule.ID.Equals(id),
+		db.PIIRule.TenantID.Equals(tenantId),
+	).Delete().Exec(context.Background())
+
+	return err
+}
+
+func (r *piiRuleRepository) ClaimPIIRulesToPurge(ctx context.Context, limit int) ([]*dbsqlc.PIIRule, error) {
+	return r.queries.ClaimPIIRulesToPurge(ctx, r.pool, int32(limit))
+}
+
+func (r *piiRuleRepository) PurgeStepRuns(ctx context.Context, rule *dbsqlc.PIIRule, opts *repository.PurgeStepRunsOpts) (*repository.PurgeStepRunsResult, error) {
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer deferRollback(ctx, r.l, tx.Rollback)
+
+	stepRuns, err := r.queries.ListStepRunsToPurge(ctx, tx, dbsqlc.ListStepRunsToPurgeParams{
+		Tenantid:       rule.TenantId,
+		Before:         sqlchelpers.TimestampFromTime(opts.Before.UTC()),
+		After:          rule.PurgedUntil,
+		AfterStepRunId: rule.PurgedUntilStepRunId,
+		Limit:          int32(opts.Limit),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list step runs to purge: %w", err)
+	}
+
+	res := &repository.PurgeStepRunsResult{
+		StepRuns: len(stepRuns),
+	}
+
+	if len(stepRuns) == 0 {
+		return res, nil
+	}
+
+	stepRunIds := make([]pgtype.UUID, 0, len(stepRuns))
+	jobRunIds := make([]pgtype.UUID, 0)
+	seenJobRuns := make(map[string]bool)
+
+	for _, stepRun := range stepRuns {
+		stepRunIds = append(stepRunIds, stepRun.ID)
+
+		if jobRunId := sqlchelpers.UUIDToStr(stepRun.JobRunId); !seenJobRuns[jobRunId] {
+			seenJobRuns[jobRunId] = true
+			jobRunIds = append(jobRunIds, stepRun.JobRunId)
+		}
+
+		input, inputPurged := opts.Purge(stepRun.Input)
+		output, outputPurged := opts.Purge(stepRun.Output)
+
+		if !inputPurged && !outputPurged {
+			continue
+		}
+
+		params := dbsqlc.UpdateStepRunPurgedDataParams{
+			Steprunid: stepRun.ID,
+		}
+
+		// unchanged values are passed as null, which keeps them
+		if inputPurged {
+			params.Input = input
+		}
+
+		if outputPurged {
+			params.Output = output
+		}
+
+		if err := r.queries.UpdateStepRunPurgedData(ctx, tx, params); err != nil {
+			return nil, fmt.Errorf("could not update step run: %w", err)
+		}
+
+		res.Purged++
+	}
+
+	// the previous attempts of retried step runs are archived, and their inputs and outputs are purged as well
+	archives, err := r.queries.ListStepRunResultArchivesToPurge(ctx, tx, stepRunIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list archived step run results to purge: %w", err)
+	}
+
+	for _, archive := range archives {
+		input, inputPurged := opts.Purge(archive.Input)
+		output, outputPurged := opts.Purge(archive.Output)
+
+		if !inputPurged && !outputPurged {
+			continue
+		}
+
+		params := dbsqlc.UpdateStepRunResultArchivePurgedDataParams{
+			ID: archive.ID,
+		}
+
+		if inputPurged {
+			params.Input = input
+		}
+
+		if outputPurged {
+			params.Output = output
+		}
+
+		if err := r.queries.UpdateStepRunResultArchivePurgedData(ctx, tx, params); err != nil {
+			return nil, fmt.Errorf("could not update archived step run result: %w", err)
+		}
+
+		res.Purged++
+	}
+
+	lookupData, err := r.queries.ListJobRunLookupDataToPurge(ctx, tx, jobRunIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list job run lookup data to purge: %w", err)
+	}
+
+	for _, ld := range lookupData {
+		data, purged := opts.PurgeLookupData(ld.Data)
+
+		if !purged {
+			continue
+		}
+
+		err := r.queries.UpdateJobRunLookupDataPurgedData(ctx, tx, dbsqlc.UpdateJobRunLookupDataPurgedDataParams{
+			Data: data,
+			ID:   ld.ID,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not update job run lookup data: %w", err)
+		}
+
+		res.Purged++
+	}
+
+	// move the rule past the last purged step run, and keep the claim of the rule until its next purge is scheduled
+	last := stepRuns[len(stepRuns)-1]
+
+	err = r.queries.UpdatePIIRulePurge(ctx, tx, dbsqlc.UpdatePIIRulePurgeParams{
+		PurgedUntil:          last.SettledAt,
+		PurgedUntilStepRunId: last.ID,
+		Nextpurgeat:          rule.NextPurgeAt,
+		Ruleid:               rule.ID,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not update PII rule: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *piiRuleRepository) UpdatePIIRuleNextPurge(ctx context.Context, ruleId string, nextPurgeAt time.Time) error {
+	return r.queries.UpdatePIIRulePurge(ctx, r.pool, dbsqlc.UpdatePIIRulePurgeParams{
+		Nextpurgeat: sqlchelpers.TimestampFromTime(nextPurgeAt.UTC()),
+		Ruleid:      sqlchelpers.UUIDFromStr(ruleId),
+	})
+}
@@ -0,0 +1,96 @@
+package prisma
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/validator"
+)
+
+type queryTriggerRepository struct {
+	client  *db.PrismaClient
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewQueryTriggerRepository(client *db.PrismaClient, pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.QueryTriggerRepository {
+	queries := dbsqlc.New()
+
+	return &queryTriggerRepository{
+		client:  client,
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *queryTriggerRepository) CreateQueryTrigger(tenantId string, opts *repository.CreateQueryTriggerOpts) (*db.QueryTriggerModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	return r.client.QueryTrigger.CreateOne(
+		db.QueryTrigger.Tenant.Link(
+			db.Tenant.ID.Equals(tenantId),
+		),
+		db.QueryTrigger.Name.Set(opts.Name),
+		db.QueryTrigger.Config.Set(opts.Config),
+		db.QueryTrigger.Query.Set(opts.Query),
+		db.QueryTrigger.Cron.Set(opts.Cron),
+		db.QueryTrigger.EventKey.Set(opts.EventKey),
+		db.QueryTrigger.NextRunAt.Set(opts.NextRunAt.UTC()),
+		db.QueryTrigger.Mode.Set(db.QueryTriggerMode(opts.Mode)),
+	).Exec(context.Background())
+}
+
+func (r *queryTriggerRepository) GetQueryTriggerById(id string) (*db.QueryTriggerModel, error) {
+	return r.client.QueryTrigger.FindUnique(
+		db.QueryTrigger.ID.Equals(id),
+	).Exec(context.Background())
+}
+
+func (r *queryTriggerRepository) ListQueryTriggers(tenantId string) ([]db.QueryTriggerModel, error) {
+	return r.client.QueryTrigger.FindMany(
+		db.QueryTrigger.TenantID.Equals(tenantId),
+	).OrderBy(
+		db.QueryTrigger.CreatedAt.Order(db.ASC),
+	).Exec(context.Background())
+}
+
+func (r *queryTriggerRepository) DeleteQueryTrigger(tenantId, id string) error {
+	_, err := r.client.QueryTrigger.FindMany(
+		db.QueryTrigger.ID.Equals(id),
+		db.QueryTrigger.TenantID.Equals(tenantId),
+	).Delete().Exec(context.Background())
+
+	return err
+}
+
+func (r *queryTriggerRepository) ClaimQueryTriggersToRun(ctx context.Context, limit int) ([]*dbsqlc.QueryTrigger, error) {
+	return r.queries.ClaimQueryTriggersToRun(ctx, r.pool, int32(limit))
+}
+
+func (r *queryTriggerRepository) UpdateQueryTriggerRun(ctx context.Context, triggerId string, runErr error, nextRunAt time.Time) error {
+	lastError := pgtype.Text{}
+
+	if runErr != nil {
+		lastError = sqlchelpers.TextFromStr(runErr.Error())
+	}
+
+	return r.queries.UpdateQueryTriggerRun(ctx, r.pool, dbsqlc.UpdateQueryTriggerRunParams{
+		LastError: lastError,
+		Nextrunat: sqlchelpers.TimestampFromTime(nextRunAt.UTC()),
+		Triggerid: sqlchelpers.UUIDFromStr(triggerId),
+	})
+}
@@ -0,0 +1,198 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/validator"
+)
+
+type reconcilerRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewReconcilerRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.ReconcilerRepository {
+	queries := dbsqlc.New()
+
+	return &reconcilerRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *reconcilerRepository) RepairFinishedRuns(ctx context.Context, grace time.Duration, limit int) ([]*repository.RepairedRun, error) {
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer deferRollback(ctx, r.l, tx.Rollback)
+
+	jobRuns, err := r.queries.ListJobRunsToRepair(ctx, tx, dbsqlc.ListJobRunsToRepairParams{
+		Graceseconds: grace.Seconds(),
+		Limit:        int32(limit),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list job runs to repair: %w", err)
+	}
+
+	res := make([]*repository.RepairedRun, 0, len(jobRuns))
+
+	for _, jobRun := range jobRuns {
+		resolvedJobRun, err := r.queries.ResolveJobRunStatus(ctx, tx, dbsqlc.ResolveJobRunStatusParams{
+			Steprunid: jobRun.StepRunId,
+			Tenantid:  jobRun.TenantId,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not resolve job run status: %w", err)
+		}
+
+		workflowRun, err := r.queries.ResolveWorkflowRunStatus(ctx, tx, dbsqlc.ResolveWorkflowRunStatusParams{
+			Jobrunid: jobRun.ID,
+			Tenantid: jobRun.TenantId,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not resolve workflow run status: %w", err)
+		}
+
+		res = append(res, &repository.RepairedRun{
+			TenantId:              sqlchelpers.UUIDToStr(jobRun.TenantId),
+			WorkflowRunId:         sqlchelpers.UUIDToStr(jobRun.WorkflowRunId),
+			JobRunId:              sqlchelpers.UUIDToStr(jobRun.ID),
+			JobRunStatus:          string(resolvedJobRun.Status),
+			WorkflowRunStatus:     string(workflowRun.Status),
+			WorkflowRunFinalState: isFinalWorkflowRunStatus(workflowRun.Status),
+		})
+	}
+
+	// workflow runs are listed after the job runs were resolved, so workflow runs which were resolved above are
+	// not listed again
+	workflowRuns, err := r.queries.ListWorkflowRunsToRepair(ctx, tx, dbsqlc.ListWorkflowRunsToRepairParams{
+		Graceseconds: grace.Seconds(),
+		Limit:        int32(limit),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list workflow runs to repair: %w", err)
+	}
+
+	for _, workflowRun := range workflowRuns {
+		resolved, err := r.queries.ResolveWorkflowRunStatus(ctx, tx, dbsqlc.ResolveWorkflowRunStatusParams{
+			Jobrunid: workflowRun.JobRunId,
+			Tenantid: workflowRun.TenantId,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not resolve workflow run status: %w", err)
+		}
+
+		res = append(res, &repository.RepairedRun{
+			TenantId:              sqlchelpers.UUIDToStr(workflowRun.TenantId),
+			WorkflowRunId:         sqlchelpers.UUIDToStr(workflowRun.ID),
+			WorkflowRunStatus:     string(resolved.Status),
+			WorkflowRunFinalState: isFinalWorkflowRunStatus(resolved.Status),
+		})
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *reconcilerRepository) RepairOrphanedStepRuns(ctx context.Context, timeout time.Duration, limit int) ([]*repository.RepairedStepRun, error) {
+	stepRuns, err := r.queries.RepairOrphanedStepRuns(ctx, r.pool, dbsqlc.RepairOrphanedStepRunsParams{
+		Timeoutseconds: timeout.Seconds(),
+		Limit:          int32(limit),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not repair orphaned step runs: %w", err)
+	}
+
+	res := make([]*repository.RepairedStepRun, 0, len(stepRuns))
+
+	for _, stepRun := range stepRuns {
+		repaired := &repository.RepairedStepRun{
+			TenantId:       sqlchelpers.UUIDToStr(stepRun.TenantId),
+			WorkflowRunId:  sqlchelpers.UUIDToStr(stepRun.WorkflowRunId),
+			StepRunId:      sqlchelpers.UUIDToStr(stepRun.ID),
+			StepReadableId: stepRun.StepReadableId.String,
+		}
+
+		if stepRun.PreviousWorkerId.Valid {
+			repaired.PreviousWorkerId = sqlchelpers.UUIDToStr(stepRun.PreviousWorkerId)
+		}
+
+		res = append(res, repaired)
+	}
+
+	return res, nil
+}
+
+func (r *reconcilerRepository) RepairTimedOutGetGroupKeyRuns(ctx context.Context, grace time.Duration, limit int) ([]*repository.RepairedGetGroupKeyRun, error) {
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer deferRollback(ctx, r.l, tx.Rollback)
+
+	getGroupKeyRuns, err := r.queries.RepairTimedOutGetGroupKeyRuns(ctx, tx, dbsqlc.RepairTimedOutGetGroupKeyRunsParams{
+		Graceseconds: grace.Seconds(),
+		Limit:        int32(limit),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not cancel timed out get group key runs: %w", err)
+	}
+
+	res := make([]*repository.RepairedGetGroupKeyRun, 0, len(getGroupKeyRuns))
+
+	for _, getGroupKeyRun := range getGroupKeyRuns {
+		// the workflow run fails because its get group key run was cancelled
+		workflowRun, err := r.queries.UpdateWorkflowRunGroupKey(ctx, tx, dbsqlc.UpdateWorkflowRunGroupKeyParams{
+			Tenantid:      getGroupKeyRun.TenantId,
+			Groupkeyrunid: getGroupKeyRun.ID,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not resolve workflow run status from get group key run: %w", err)
+		}
+
+		res = append(res, &repository.RepairedGetGroupKeyRun{
+			TenantId:          sqlchelpers.UUIDToStr(getGroupKeyRun.TenantId),
+			WorkflowRunId:     sqlchelpers.UUIDToStr(getGroupKeyRun.WorkflowRunId),
+			GetGroupKeyRunId:  sqlchelpers.UUIDToStr(getGroupKeyRun.ID),
+			WorkflowRunStatus: string(workflowRun.Status),
+		})
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,118 @@
+package prisma
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/validator"
+)
+
+type recurringTaskRepository struct {
+	client  *db.PrismaClient
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewRecurringTaskRepository(client *db.PrismaClient, pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.RecurringTaskRepository {
+	queries := dbsqlc.New()
+
+	return &recurringTaskRepository{
+		client:  client,
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *recurringTaskRepository) CreateRecurringTask(tenantId string, opts *repository.CreateRecurringTaskOpts) (*db.RecurringTaskModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	optionals := []db.RecurringTaskSetParam{
+		db.RecurringTask.RetentionSeconds.SetIfPresent(opts.RetentionSeconds),
+		db.RecurringTask.EventKey.SetIfPresent(opts.EventKey),
+	}
+
+	if opts.WorkflowId != nil {
+		optionals = append(optionals, db.RecurringTask.Workflow.Link(
+			db.Workflow.ID.Equals(*opts.WorkflowId),
+		))
+	}
+
+	if opts.EventData != nil {
+		optionals = append(optionals, db.RecurringTask.EventData.Set(db.JSON(opts.EventData)))
+	}
+
+	return r.client.RecurringTask.CreateOne(
+		db.RecurringTask.Tenant.Link(
+			db.Tenant.ID.Equals(tenantId),
+		),
+		db.RecurringTask.Name.Set(opts.Name),
+		db.RecurringTask.Kind.Set(db.RecurringTaskKind(opts.Kind)),
+		db.RecurringTask.Cron.Set(opts.Cron),
+		db.RecurringTask.NextRunAt.Set(opts.NextRunAt.UTC()),
+		optionals...,
+	).Exec(context.Background())
+}
+
+func (r *recurringTaskRepository) GetRecurringTaskById(id string) (*db.RecurringTaskModel, error) {
+	return r.client.RecurringTask.FindUnique(
+		db.RecurringTask.ID.Equals(id),
+	).Exec(context.Background())
+}
+
+func (r *recurringTaskRepository) ListRecurringTasks(tenantId string) ([]db.RecurringTaskModel, error) {
+	return r.client.RecurringTask.FindMany(
+		db.RecurringTask.TenantID.Equals(tenantId),
+	).OrderBy(
+		db.RecurringTask.CreatedAt.Order(db.ASC),
+	).Exec(context.Background())
+}
+
+func (r *recurringTaskRepository) DeleteRecurringTask(tenantId, id string) error {
+	_, err := r.client.RecurringTask.FindMany(
+		db.RecurringTask.ID.Equals(id),
+		db.RecurringTask.TenantID.Equals(tenantId),
+	).Delete().Exec(context.Background())
+
+	return err
+}
+
+func (r *recurringTaskRepository) ClaimRecurringTasksToRun(ctx context.Context, limit int) ([]*dbsqlc.RecurringTask, error) {
+	return r.queries.ClaimRecurringTasksToRun(ctx, r.pool, int32(limit))
+}
+
+func (r *recurringTaskRepository) UpdateRecurringTaskRun(ctx context.Context, taskId string, runErr error, nextRunAt time.Time) error {
+	lastError := pgtype.Text{}
+
+	if runErr != nil {
+		lastError = sqlchelpers.TextFromStr(runErr.Error())
+	}
+
+	return r.queries.UpdateRecurringTaskRun(ctx, r.pool, dbsqlc.UpdateRecurringTaskRunParams{
+		LastError: lastError,
+		Nextrunat: sqlchelpers.TimestampFromTime(nextRunAt.UTC()),
+		Taskid:    sqlchelpers.UUIDFromStr(taskId),
+	})
+}
+
+func (r *recurringTaskRepository) PurgeWorkflowRuns(ctx context.Context, tenantId, workflowId string, retention time.Duration, limit int) (int64, error) {
+	return r.queries.PurgeWorkflowRuns(ctx, r.pool, dbsqlc.PurgeWorkflowRunsParams{
+		Tenantid:         sqlchelpers.UUIDFromStr(tenantId),
+		Workflowid:       sqlchelpers.UUIDFromStr(workflowId),
+		Retentionseconds: int32(retention.Seconds()),
+		Limit:            int32(limit),
+	})
+}
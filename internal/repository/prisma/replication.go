@@ -0,0 +1,370 @@
+package prisma
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/validator"
+)
+
+// the name of the triggers which record mutations in the replication log
+const replicationLogTrigger = "replication_log"
+
+type replicatedTable struct {
+	name string
+
+	// the columns which identify a row of the table
+	key []string
+
+	// region-local tables contain the engine instances and workers of a region. only their inserts and deletes
+	// are replicated, so that the rows which reference them can be applied without replicating every heartbeat.
+	// after a promotion they look stale, so the heartbeater and the requeue loops move their work elsewhere.
+	regionLocal bool
+}
+
+// replicatedTables are the tables which a standby needs to take over the queued and running workflow runs
+// of the primary, in the order of their foreign keys.
+var replicatedTables = []replicatedTable{
+	{name: "User", key: []string{"id"}},
+	{name: "UserOAuth", key: []string{"id"}},
+	{name: "UserPassword", key: []string{"userId"}},
+	{name: "Tenant", key: []string{"id"}},
+	{name: "TenantMember", key: []string{"id"}},
+	{name: "APIToken", key: []string{"id"}},
+	{name: "SNSIntegration", key: []string{"id"}},
+	{name: "Dispatcher", key: []string{"id"}, regionLocal: true},
+	{name: "Ticker", key: []string{"id"}, regionLocal: true},
+	{name: "Worker", key: []string{"id"}, regionLocal: true},
+	{name: "Event", key: []string{"id"}},
+	{name: "WorkflowTag", key: []string{"id"}},
+	{name: "Workflow", key: []string{"id"}},
+	{name: "_WorkflowToWorkflowTag", key: []string{"A", "B"}},
+	{name: "WorkflowVersion", key: []string{"id"}},
+	{name: "WorkflowConcurrency", key: []string{"id"}},
+	{name: "WorkflowTriggers", key: []string{"id"}},
+	{name: "WorkflowTriggerEventRef", key: []string{"parentId", "eventKey"}},
+	{name: "WorkflowTriggerCronRef", key: []string{"parentId", "cron"}},
+	{name: "WorkflowTriggerScheduledRef", key: []string{"id"}},
+	{name: "Action", key: []string{"id"}},
+	{name: "Job", key: []string{"id"}},
+	{name: "Step", key: []string{"id"}},
+	{name: "_StepOrder", key: []string{"A", "B"}},
+	{name: "WorkflowRun", key: []string{"id"}},
+	{name: "GetGroupKeyRun", key: []string{"id"}},
+	{name: "WorkflowRunTriggeredBy", key: []string{"id"}},
+	{name: "JobRun", key: []string{"id"}},
+	{name: "JobRunLookupData", key: []string{"id"}},
+	{name: "StepRun", key: []string{"id"}},
+	{name: "_StepRunOrder", key: []string{"A", "B"}},
+}
+
+type replicationRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+	tables  map[string]replicatedTable
+}
+
+func NewReplicationRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.ReplicationRepository {
+	queries := dbsqlc.New()
+
+	tables := make(map[string]replicatedTable, len(replicatedTables))
+
+	for _, table := range replicatedTables {
+		tables[table.name] = table
+	}
+
+	return &replicationRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+		tables:  tables,
+	}
+}
+
+func (r *replicationRepository) EnableReplicationLog(ctx context.Context) error {
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	defer deferRollback(ctx, r.l, tx.Rollback)
+
+	for _, table := range replicatedTables {
+		name := pgx.Identifier{table.name}.Sanitize()
+
+		events := "INSERT OR UPDATE OR DELETE"
+
+		if table.regionLocal {
+			events = "INSERT OR DELETE"
+		}
+
+		_, err := tx.Exec(ctx, fmt.Sprintf("DROP TRIGGER IF EXISTS %s ON %s", replicationLogTrigger, name))
+
+		if err != nil {
+			return fmt.Errorf("could not drop replication log trigger of %s: %w", table.name, err)
+		}
+
+		_, err = tx.Exec(ctx, fmt.Sprintf(
+			"CREATE TRIGGER %s AFTER %s ON %s FOR EACH ROW EXECUTE FUNCTION log_replicated_row()",
+			replicationLogTrigger,
+			events,
+			name,
+		))
+
+		if err != nil {
+			return fmt.Errorf("could not create replication log trigger of %s: %w", table.name, err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *replicationRepository) DisableReplicationLog(ctx context.Context) error {
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	defer deferRollback(ctx, r.l, tx.Rollback)
+
+	for _, table := range replicatedTables {
+		_, err := tx.Exec(ctx, fmt.Sprintf(
+			"DROP TRIGGER IF EXISTS %s ON %s",
+			replicationLogTrigger,
+			pgx.Identifier{table.name}.Sanitize(),
+		))
+
+		if err != nil {
+			return fmt.Errorf("could not drop replication log trigger of %s: %w", table.name, err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *replicationRepository) ListReplicationLogTables(ctx context.Context) ([]string, error) {
+	return r.queries.ListReplicationLogTriggers(ctx, r.pool)
+}
+
+func (r *replicationRepository) GetReplicationLogStats(ctx context.Context) (*dbsqlc.GetReplicationLogStatsRow, error) {
+	return r.queries.GetReplicationLogStats(ctx, r.pool)
+}
+
+func (r *replicationRepository) ListReplicationLogEntries(ctx context.Context, afterId int64, limit int) ([]*dbsqlc.ReplicationLogEntry, error) {
+	return r.queries.ListReplicationLogEntries(ctx, r.pool, dbsqlc.ListReplicationLogEntriesParams{
+		Afterid:   afterId,
+		Batchsize: int32(limit),
+	})
+}
+
+func (r *replicationRepository) DeleteReplicationLogEntries(ctx context.Context, lastEntryId int64) (int64, error) {
+	return r.queries.DeleteReplicationLogEntries(ctx, r.pool, lastEntryId)
+}
+
+func (r *replicationRepository) GetReplicationState(ctx context.Context) (*dbsqlc.ReplicationState, error) {
+	state, err := r.queries.GetReplicationState(ctx, r.pool)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return state, nil
+}
+
+func (r *replicationRepository) ApplyReplicationLogEntries(ctx context.Context, lastEntryId int64, entries []*dbsqlc.ReplicationLogEntry) (*dbsqlc.ReplicationState, error) {
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer deferRollback(ctx, r.l, tx.Rollback)
+
+	err = r.queries.InitReplicationState(ctx, tx)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not init replication state: %w", err)
+	}
+
+	// lock the state, so that the standby can't be promoted while entries are applied
+	state, err := r.queries.GetReplicationStateForUpdate(ctx, tx)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get replication state: %w", err)
+	}
+
+	if state.Role == dbsqlc.ReplicationRolePRIMARY {
+		return nil, repository.ErrReplicaPromoted
+	}
+
+	for _, entry := range entries {
+		if entry.ID <= state.LastEntryId {
+			continue
+		}
+
+		if err := r.applyEntry(ctx, tx, entry); err != nil {
+			return nil, fmt.Errorf("could not apply replication log entry %d: %w", entry.ID, err)
+		}
+	}
+
+	if lastEntryId > state.LastEntryId {
+		state, err = r.queries.UpdateReplicationStateApplied(ctx, tx, lastEntryId)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not update replication state: %w", err)
+		}
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+func (r *replicationRepository) applyEntry(ctx context.Context, tx pgx.Tx, entry *dbsqlc.ReplicationLogEntry) error {
+	table, ok := r.tables[entry.Table]
+
+	if !ok {
+		return fmt.Errorf("table %s is not replicated", entry.Table)
+	}
+
+	row := map[string]interface{}{}
+
+	if err := json.Unmarshal(entry.Data, &row); err != nil {
+		return fmt.Errorf("could not unmarshal row: %w", err)
+	}
+
+	// the internal tenant is created by the migrations of every instance, and can't be written to
+	if table.name == "Tenant" && row["slug"] == "internal" {
+		return nil
+	}
+
+	name := pgx.Identifier{table.name}.Sanitize()
+	key := sanitizeColumns(table.key)
+
+	var sql string
+
+	switch entry.Operation {
+	case dbsqlc.ReplicationOperationDELETE:
+		sql = fmt.Sprintf(
+			"DELETE FROM %s WHERE (%s) = (SELECT %s FROM jsonb_populate_record(NULL::%s, $1::jsonb))",
+			name, key, key, name,
+		)
+	default:
+		sql = fmt.Sprintf(
+			"INSERT INTO %s SELECT * FROM jsonb_populate_record(NULL::%s, $1::jsonb) ON CONFLICT (%s) %s",
+			name, name, key, conflictAction(table, row),
+		)
+	}
+
+	_, err := tx.Exec(ctx, sql, entry.Data)
+
+	return err
+}
+
+func (r *replicationRepository) PromoteReplica(ctx context.Context) (*dbsqlc.ReplicationState, error) {
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer deferRollback(ctx, r.l, tx.Rollback)
+
+	err = r.queries.InitReplicationState(ctx, tx)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not init replication state: %w", err)
+	}
+
+	state, err := r.queries.PromoteReplicationState(ctx, tx)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not promote replica: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+func (r *replicationRepository) ListWorkflowRunsToRecover(ctx context.Context) ([]*dbsqlc.ListWorkflowRunsToRecoverRow, error) {
+	return r.queries.ListWorkflowRunsToRecover(ctx, r.pool)
+}
+
+func (r *replicationRepository) ListJobRunsToRecover(ctx context.Context) ([]*dbsqlc.ListJobRunsToRecoverRow, error) {
+	return r.queries.ListJobRunsToRecover(ctx, r.pool)
+}
+
+func (r *replicationRepository) MarkReplicaRecovered(ctx context.Context) (*dbsqlc.ReplicationState, error) {
+	return r.queries.UpdateReplicationStateRecovered(ctx, r.pool)
+}
+
+// conflictAction returns the action for a row which already exists on the standby, which updates every
+// column which is not part of the key.
+func conflictAction(table replicatedTable, row map[string]interface{}) string {
+	isKey := make(map[string]bool, len(table.key))
+
+	for _, column := range table.key {
+		isKey[column] = true
+	}
+
+	columns := make([]string, 0, len(row))
+
+	for column := range row {
+		if !isKey[column] {
+			columns = append(columns, column)
+		}
+	}
+
+	if len(columns) == 0 {
+		return "DO NOTHING"
+	}
+
+	sort.Strings(columns)
+
+	set := make([]string, len(columns))
+
+	for i, column := range columns {
+		sanitized := pgx.Identifier{column}.Sanitize()
+		set[i] = fmt.Sprintf("%s = EXCLUDED.%s", sanitized, sanitized)
+	}
+
+	return "DO UPDATE SET " + strings.Join(set, ", ")
+}
+
+func sanitizeColumns(columns []string) string {
+	res := make([]string, len(columns))
+
+	for i, column := range columns {
+		res[i] = pgx.Identifier{column}.Sanitize()
+	}
+
+	return strings.Join(res, ", ")
+}
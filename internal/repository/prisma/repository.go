@@ -10,25 +10,45 @@ import (
 )
 
 type prismaRepository struct {
-	apiToken       repository.APITokenRepository
-	event          repository.EventRepository
-	log            repository.LogsRepository
-	tenant         repository.TenantRepository
-	tenantInvite   repository.TenantInviteRepository
-	workflow       repository.WorkflowRepository
-	workflowRun    repository.WorkflowRunRepository
-	jobRun         repository.JobRunRepository
-	stepRun        repository.StepRunRepository
-	getGroupKeyRun repository.GetGroupKeyRunRepository
-	github         repository.GithubRepository
-	step           repository.StepRepository
-	sns            repository.SNSRepository
-	dispatcher     repository.DispatcherRepository
-	worker         repository.WorkerRepository
-	ticker         repository.TickerRepository
-	userSession    repository.UserSessionRepository
-	user           repository.UserRepository
-	health         repository.HealthRepository
+	apiToken          repository.APITokenRepository
+	idempotencyKey    repository.IdempotencyKeyRepository
+	event             repository.EventRepository
+	log               repository.LogsRepository
+	streamEvent       repository.StreamEventRepository
+	tenant            repository.TenantRepository
+	tenantInvite      repository.TenantInviteRepository
+	workflow          repository.WorkflowRepository
+	workflowRun       repository.WorkflowRunRepository
+	jobRun            repository.JobRunRepository
+	stepRun           repository.StepRunRepository
+	getGroupKeyRun    repository.GetGroupKeyRunRepository
+	github            repository.GithubRepository
+	gitea             repository.GiteaRepository
+	step              repository.StepRepository
+	sns               repository.SNSRepository
+	logSink           repository.LogSinkRepository
+	eventBus          repository.EventBusRepository
+	webhookDelivery   repository.WebhookDeliveryRepository
+	objectStorageFeed repository.ObjectStorageFeedRepository
+	queryTrigger      repository.QueryTriggerRepository
+	recurringTask     repository.RecurringTaskRepository
+	clientCertificate repository.ClientCertificateRepository
+	piiRule           repository.PIIRuleRepository
+	subjectDeletion   repository.SubjectDeletionRepository
+	reconciler        repository.ReconcilerRepository
+	tenantExport      repository.TenantExportRepository
+	featureFlag       repository.FeatureFlagRepository
+	engineSettings    repository.EngineSettingsRepository
+	triggerLink       repository.TriggerLinkRepository
+	dispatcher        repository.DispatcherRepository
+	worker            repository.WorkerRepository
+	ticker            repository.TickerRepository
+	leaderLease       repository.LeaderLeaseRepository
+	namedLock         repository.NamedLockRepository
+	replication       repository.ReplicationRepository
+	userSession       repository.UserSessionRepository
+	user              repository.UserRepository
+	health            repository.HealthRepository
 }
 
 type PrismaRepositoryOpt func(*PrismaRepositoryOpts)
@@ -67,25 +87,45 @@ func NewPrismaRepository(client *db.PrismaClient, pool *pgxpool.Pool, fs ...Pris
 	opts.l = &newLogger
 
 	return &prismaRepository{
-		apiToken:       NewAPITokenRepository(client, opts.v),
-		event:          NewEventRepository(client, pool, opts.v, opts.l),
-		log:            NewLogRepository(client, pool, opts.v, opts.l),
-		tenant:         NewTenantRepository(client, opts.v),
-		tenantInvite:   NewTenantInviteRepository(client, opts.v),
-		workflow:       NewWorkflowRepository(client, pool, opts.v, opts.l),
-		workflowRun:    NewWorkflowRunRepository(client, pool, opts.v, opts.l),
-		jobRun:         NewJobRunRepository(client, pool, opts.v, opts.l),
-		stepRun:        NewStepRunRepository(client, pool, opts.v, opts.l),
-		getGroupKeyRun: NewGetGroupKeyRunRepository(client, pool, opts.v, opts.l),
-		github:         NewGithubRepository(client, opts.v),
-		step:           NewStepRepository(client, opts.v),
-		sns:            NewSNSRepository(client, opts.v),
-		dispatcher:     NewDispatcherRepository(client, pool, opts.v, opts.l),
-		worker:         NewWorkerRepository(client, pool, opts.v, opts.l),
-		ticker:         NewTickerRepository(client, pool, opts.v, opts.l),
-		userSession:    NewUserSessionRepository(client, opts.v),
-		user:           NewUserRepository(client, opts.v),
-		health:         NewHealthRepository(client, pool),
+		apiToken:          NewAPITokenRepository(client, opts.v),
+		idempotencyKey:    NewIdempotencyKeyRepository(client, pool, opts.v),
+		event:             NewEventRepository(client, pool, opts.v, opts.l),
+		log:               NewLogRepository(client, pool, opts.v, opts.l),
+		streamEvent:       NewStreamEventRepository(pool, opts.v, opts.l),
+		tenant:            NewTenantRepository(client, pool, opts.v, opts.l),
+		tenantInvite:      NewTenantInviteRepository(client, opts.v),
+		workflow:          NewWorkflowRepository(client, pool, opts.v, opts.l),
+		workflowRun:       NewWorkflowRunRepository(client, pool, opts.v, opts.l),
+		jobRun:            NewJobRunRepository(client, pool, opts.v, opts.l),
+		stepRun:           NewStepRunRepository(client, pool, opts.v, opts.l),
+		getGroupKeyRun:    NewGetGroupKeyRunRepository(client, pool, opts.v, opts.l),
+		github:            NewGithubRepository(client, opts.v),
+		gitea:             NewGiteaRepository(client, opts.v),
+		step:              NewStepRepository(client, opts.v),
+		sns:               NewSNSRepository(client, opts.v),
+		logSink:           NewLogSinkRepository(client, pool, opts.v, opts.l),
+		eventBus:          NewEventBusRepository(client, pool, opts.v, opts.l),
+		webhookDelivery:   NewWebhookDeliveryRepository(pool, opts.v, opts.l),
+		objectStorageFeed: NewObjectStorageFeedRepository(client, pool, opts.v, opts.l),
+		queryTrigger:      NewQueryTriggerRepository(client, pool, opts.v, opts.l),
+		recurringTask:     NewRecurringTaskRepository(client, pool, opts.v, opts.l),
+		clientCertificate: NewClientCertificateRepository(client, opts.v),
+		piiRule:           NewPIIRuleRepository(client, pool, opts.v, opts.l),
+		subjectDeletion:   NewSubjectDeletionRepository(pool, opts.v, opts.l),
+		reconciler:        NewReconcilerRepository(pool, opts.v, opts.l),
+		tenantExport:      NewTenantExportRepository(client, pool, opts.v, opts.l),
+		featureFlag:       NewFeatureFlagRepository(client, opts.v),
+		engineSettings:    NewEngineSettingsRepository(client, opts.v),
+		triggerLink:       NewTriggerLinkRepository(client, opts.v),
+		dispatcher:        NewDispatcherRepository(client, pool, opts.v, opts.l),
+		worker:            NewWorkerRepository(client, pool, opts.v, opts.l),
+		ticker:            NewTickerRepository(client, pool, opts.v, opts.l),
+		leaderLease:       NewLeaderLeaseRepository(pool, opts.l),
+		namedLock:         NewNamedLockRepository(pool, opts.l),
+		replication:       NewReplicationRepository(pool, opts.v, opts.l),
+		userSession:       NewUserSessionRepository(client, opts.v),
+		user:              NewUserRepository(client, opts.v),
+		health:            NewHealthRepository(client, pool),
 	}
 }
 
@@ -97,6 +137,10 @@ func (r *prismaRepository) APIToken() repository.APITokenRepository {
 	return r.apiToken
 }
 
+func (r *prismaRepository) IdempotencyKey() repository.IdempotencyKeyRepository {
+	return r.idempotencyKey
+}
+
 func (r *prismaRepository) Event() repository.EventRepository {
 	return r.event
 }
@@ -105,6 +149,10 @@ func (r *prismaRepository) Log() repository.LogsRepository {
 	return r.log
 }
 
+func (r *prismaRepository) StreamEvent() repository.StreamEventRepository {
+	return r.streamEvent
+}
+
 func (r *prismaRepository) Tenant() repository.TenantRepository {
 	return r.tenant
 }
@@ -133,6 +181,62 @@ func (r *prismaRepository) SNS() repository.SNSRepository {
 	return r.sns
 }
 
+func (r *prismaRepository) LogSink() repository.LogSinkRepository {
+	return r.logSink
+}
+
+func (r *prismaRepository) EventBus() repository.EventBusRepository {
+	return r.eventBus
+}
+
+func (r *prismaRepository) WebhookDelivery() repository.WebhookDeliveryRepository {
+	return r.webhookDelivery
+}
+
+func (r *prismaRepository) ObjectStorageFeed() repository.ObjectStorageFeedRepository {
+	return r.objectStorageFeed
+}
+
+func (r *prismaRepository) QueryTrigger() repository.QueryTriggerRepository {
+	return r.queryTrigger
+}
+
+func (r *prismaRepository) RecurringTask() repository.RecurringTaskRepository {
+	return r.recurringTask
+}
+
+func (r *prismaRepository) ClientCertificate() repository.ClientCertificateRepository {
+	return r.clientCertificate
+}
+
+func (r *prismaRepository) PIIRule() repository.PIIRuleRepository {
+	return r.piiRule
+}
+
+func (r *prismaRepository) SubjectDeletion() repository.SubjectDeletionRepository {
+	return r.subjectDeletion
+}
+
+func (r *prismaRepository) Reconciler() repository.ReconcilerRepository {
+	return r.reconciler
+}
+
+func (r *prismaRepository) TenantExport() repository.TenantExportRepository {
+	return r.tenantExport
+}
+
+func (r *prismaRepository) FeatureFlag() repository.FeatureFlagRepository {
+	return r.featureFlag
+}
+
+func (r *prismaRepository) EngineSettings() repository.EngineSettingsRepository {
+	return r.engineSettings
+}
+
+func (r *prismaRepository) TriggerLink() repository.TriggerLinkRepository {
+	return r.triggerLink
+}
+
 func (r *prismaRepository) GetGroupKeyRun() repository.GetGroupKeyRunRepository {
 	return r.getGroupKeyRun
 }
@@ -141,6 +245,10 @@ func (r *prismaRepository) Github() repository.GithubRepository {
 	return r.github
 }
 
+func (r *prismaRepository) Gitea() repository.GiteaRepository {
+	return r.gitea
+}
+
 func (r *prismaRepository) Step() repository.StepRepository {
 	return r.step
 }
@@ -157,6 +265,18 @@ func (r *prismaRepository) Ticker() repository.TickerRepository {
 	return r.ticker
 }
 
+func (r *prismaRepository) LeaderLease() repository.LeaderLeaseRepository {
+	return r.leaderLease
+}
+
+func (r *prismaRepository) NamedLock() repository.NamedLockRepository {
+	return r.namedLock
+}
+
+func (r *prismaRepository) Replication() repository.ReplicationRepository {
+	return r.replication
+}
+
 func (r *prismaRepository) UserSession() repository.UserSessionRepository {
 	return r.userSession
 }
@@ -2,6 +2,7 @@ package prisma
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -17,6 +18,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/joins"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
 	"github.com/hatchet-dev/hatchet/internal/validator"
 )
@@ -102,6 +104,71 @@ func (s *stepRunRepository) ListStepRunsToRequeue(tenantId string) ([]*dbsqlc.St
 	return stepRuns, nil
 }
 
+func (s *stepRunRepository) ListStepRunQueues() ([]*dbsqlc.ListStepRunQueuesRow, error) {
+	return s.queries.ListStepRunQueues(context.Background(), s.pool)
+}
+
+func (s *stepRunRepository) ListStepRunPhaseMetrics(tenantId string, since time.Time) ([]*dbsqlc.ListStepRunPhaseMetricsRow, error) {
+	return s.queries.ListStepRunPhaseMetrics(context.Background(), s.pool, dbsqlc.ListStepRunPhaseMetricsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Since:    sqlchelpers.TimestampFromTime(since),
+	})
+}
+
+func (s *stepRunRepository) ListStepRunsWaitingForWorker(tenantId string) ([]*dbsqlc.ListStepRunsWaitingForWorkerRow, error) {
+	return s.queries.ListStepRunsWaitingForWorker(context.Background(), s.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (s *stepRunRepository) MarkStepRunsWaitingForWorker(ctx context.Context, threshold time.Duration, limit int) ([]*dbsqlc.MarkStepRunsWaitingForWorkerRow, error) {
+	return s.queries.MarkStepRunsWaitingForWorker(ctx, s.pool, dbsqlc.MarkStepRunsWaitingForWorkerParams{
+		Thresholdseconds: threshold.Seconds(),
+		Limit:            int32(limit),
+	})
+}
+
+func (s *stepRunRepository) ListCancellableStepRuns(ctx context.Context, tenantId string, workflowId *string) ([]string, error) {
+	params := dbsqlc.ListCancellableStepRunsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	}
+
+	if workflowId != nil {
+		params.WorkflowId = sqlchelpers.UUIDFromStr(*workflowId)
+	}
+
+	stepRunIds, err := s.queries.ListCancellableStepRuns(ctx, s.pool, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list cancellable step runs: %w", err)
+	}
+
+	res := make([]string, 0, len(stepRunIds))
+
+	for _, id := range stepRunIds {
+		res = append(res, sqlchelpers.UUIDToStr(id))
+	}
+
+	return res, nil
+}
+
+func (s *stepRunRepository) ListCostCenterUsage(tenantId string, opts *repository.ListCostCenterUsageOpts) ([]*dbsqlc.ListCostCenterUsageRow, error) {
+	if err := s.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.ListCostCenterUsageParams{
+		Bucket:   opts.Bucket,
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Since:    sqlchelpers.TimestampFromTime(opts.Since),
+		Until:    sqlchelpers.TimestampFromTime(opts.Until),
+	}
+
+	if opts.CostCenter != nil {
+		params.CostCenter = sqlchelpers.TextFromStr(*opts.CostCenter)
+	}
+
+	return s.queries.ListCostCenterUsage(context.Background(), s.pool, params)
+}
+
 func (s *stepRunRepository) ListStepRunsToReassign(tenantId string) ([]*dbsqlc.StepRun, error) {
 	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
 
@@ -203,27 +270,40 @@ var retrier = func(l *zerolog.Logger, f func() error) error {
 	return nil
 }
 
-func (s *stepRunRepository) AssignStepRunToWorker(tenantId, stepRunId string) (string, string, error) {
+func (s *stepRunRepository) AssignStepRunToWorker(tenantId, stepRunId string, selector repository.WorkerSelector) (string, string, error) {
 	// var assigned
 	var assigned *dbsqlc.AssignStepRunToWorkerRow
 
-	err := retrier(s.l, func() (err error) {
-		assigned, err = s.queries.AssignStepRunToWorker(context.Background(), s.pool, dbsqlc.AssignStepRunToWorkerParams{
-			Steprunid: sqlchelpers.UUIDFromStr(stepRunId),
-			Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
-		})
+	err := retrier(s.l, func() error {
+		tx, err := s.pool.Begin(context.Background())
 
 		if err != nil {
-			if errors.Is(err, pgx.ErrNoRows) {
-				return repository.ErrNoWorkerAvailable
-			}
+			return err
+		}
+
+		defer deferRollback(context.Background(), s.l, tx.Rollback)
+
+		assigned, err = s.assignStepRunToWorker(context.Background(), tx, tenantId, stepRunId, selector)
 
+		if err != nil {
 			return err
 		}
 
-		return nil
+		return tx.Commit(context.Background())
 	})
 
+	if errors.Is(err, repository.ErrNoWorkerAvailable) {
+		// the step run waits for a worker slot from the first failed assignment, which is only set once per attempt
+		setErr := s.queries.SetStepRunSlotWaitStarted(context.Background(), s.pool, dbsqlc.SetStepRunSlotWaitStartedParams{
+			Steprunid: sqlchelpers.UUIDFromStr(stepRunId),
+			Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+		})
+
+		if setErr != nil {
+			s.l.Err(setErr).Msg("could not set slot wait started for step run")
+		}
+	}
+
 	if err != nil {
 		return "", "", err
 	}
@@ -231,6 +311,109 @@ func (s *stepRunRepository) AssignStepRunToWorker(tenantId, stepRunId string) (s
 	return sqlchelpers.UUIDToStr(assigned.WorkerId), sqlchelpers.UUIDToStr(assigned.DispatcherId), nil
 }
 
+func (s *stepRunRepository) assignStepRunToWorker(ctx context.Context, tx pgx.Tx, tenantId, stepRunId string, selector repository.WorkerSelector) (*dbsqlc.AssignStepRunToWorkerRow, error) {
+	stepRun, err := s.queries.GetStepRunForAssignment(ctx, tx, dbsqlc.GetStepRunForAssignmentParams{
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+		Steprunid: sqlchelpers.UUIDFromStr(stepRunId),
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrNoWorkerAvailable
+		}
+
+		return nil, err
+	}
+
+	workers, err := s.queries.ListWorkersForAssignment(ctx, tx, dbsqlc.ListWorkersForAssignmentParams{
+		Tenantid:    sqlchelpers.UUIDFromStr(tenantId),
+		Actionid:    stepRun.ActionId,
+		Cpu:         stepRun.Cpu,
+		MemoryMb:    stepRun.MemoryMb,
+		Gpu:         stepRun.Gpu,
+		Environment: stepRun.Environment,
+		BuildId:     stepRun.BuildId,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(workers) == 0 {
+		return nil, repository.ErrNoWorkerAvailable
+	}
+
+	candidates := make([]*repository.WorkerCandidate, 0, len(workers))
+	buildIds := make(map[string]pgtype.Text, len(workers))
+
+	for _, worker := range workers {
+		candidate := &repository.WorkerCandidate{
+			WorkerId:     sqlchelpers.UUIDToStr(worker.ID),
+			DispatcherId: sqlchelpers.UUIDToStr(worker.DispatcherId),
+			Load:         int(worker.Load),
+		}
+
+		if len(worker.Labels) > 0 {
+			if err := json.Unmarshal(worker.Labels, &candidate.Labels); err != nil {
+				s.l.Err(err).Msgf("could not unmarshal labels of worker %s", candidate.WorkerId)
+			}
+		}
+
+		if worker.LastAssignedAt.Valid {
+			candidate.LastAssignedAt = &worker.LastAssignedAt.Time
+		}
+
+		candidates = append(candidates, candidate)
+		buildIds[candidate.WorkerId] = worker.BuildId
+	}
+
+	assignment := &repository.StepRunAssignment{
+		StepRunId: stepRunId,
+		Strategy:  stepRun.AssignmentStrategy,
+	}
+
+	if len(stepRun.ParentWorkerLabels) > 0 {
+		if err := json.Unmarshal(stepRun.ParentWorkerLabels, &assignment.ParentWorkerLabels); err != nil {
+			s.l.Err(err).Msgf("could not unmarshal parent worker labels of step run %s", stepRunId)
+		}
+	}
+
+	selected := selector.SelectWorker(assignment, candidates)
+
+	if selected == nil {
+		return nil, repository.ErrNoWorkerAvailable
+	}
+
+	assigned, err := s.queries.AssignStepRunToWorker(ctx, tx, dbsqlc.AssignStepRunToWorkerParams{
+		Workerid:  sqlchelpers.UUIDFromStr(selected.WorkerId),
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+		Steprunid: sqlchelpers.UUIDFromStr(stepRunId),
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrNoWorkerAvailable
+		}
+
+		return nil, err
+	}
+
+	// the first step run which is assigned pins the run to the build of its worker
+	if buildId := buildIds[selected.WorkerId]; stepRun.PinToBuild && !stepRun.BuildId.Valid && buildId.Valid {
+		err = s.queries.PinWorkflowRunToBuild(ctx, tx, dbsqlc.PinWorkflowRunToBuildParams{
+			Buildid:       buildId.String,
+			Workflowrunid: stepRun.WorkflowRunId,
+			Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return assigned, nil
+}
+
 func (s *stepRunRepository) AssignStepRunToTicker(tenantId, stepRunId string) (tickerId string, err error) {
 	err = retrier(s.l, func() error {
 		assigned, err := s.queries.AssignStepRunToTicker(context.Background(), s.pool, dbsqlc.AssignStepRunToTickerParams{
@@ -301,7 +484,7 @@ func (s *stepRunRepository) UpdateStepRun(ctx context.Context, tenantId, stepRun
 
 		defer deferRollback(context.Background(), s.l, tx.Rollback)
 
-		updateInfo, err = s.updateStepRunExtra(ctx, tx, tenantId, resolveJobRunParams, resolveLaterStepRunsParams)
+		updateInfo, err = s.updateStepRunExtra(ctx, tx, tenantId, stepRun, resolveJobRunParams, resolveLaterStepRunsParams)
 
 		if err != nil {
 			return err
@@ -413,6 +596,39 @@ func (s *stepRunRepository) UpdateStepRunInputSchema(tenantId, stepRunId string,
 	return inputSchema, nil
 }
 
+func (s *stepRunRepository) UpdateStepRunTimeoutAt(tenantId, stepRunId string, timeoutAt time.Time) (*time.Time, error) {
+	newTimeoutAt, err := s.queries.UpdateStepRunTimeoutAt(
+		context.Background(),
+		s.pool,
+		dbsqlc.UpdateStepRunTimeoutAtParams{
+			Steprunid: sqlchelpers.UUIDFromStr(stepRunId),
+			Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+			Timeoutat: sqlchelpers.TimestampFromTime(timeoutAt),
+		},
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not update step run timeout: %w", err)
+	}
+
+	return &newTimeoutAt.Time, nil
+}
+
+func (s *stepRunRepository) ReleaseStepRun(ctx context.Context, tenantId, stepRunId, workerId string, requeueAfter time.Time) (*dbsqlc.ReleaseStepRunRow, error) {
+	stepRun, err := s.queries.ReleaseStepRun(ctx, s.pool, dbsqlc.ReleaseStepRunParams{
+		Requeueafter: sqlchelpers.TimestampFromTime(requeueAfter),
+		Tenantid:     sqlchelpers.UUIDFromStr(tenantId),
+		Steprunid:    sqlchelpers.UUIDFromStr(stepRunId),
+		Workerid:     sqlchelpers.UUIDFromStr(workerId),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not release step run: %w", err)
+	}
+
+	return stepRun, nil
+}
+
 func (s *stepRunRepository) QueueStepRun(ctx context.Context, tenantId, stepRunId string, opts *repository.UpdateStepRunOpts) (*dbsqlc.GetStepRunForEngineRow, error) {
 	ctx, span := telemetry.NewSpan(ctx, "queue-step-run-database")
 	defer span.End()
@@ -476,7 +692,7 @@ func (s *stepRunRepository) QueueStepRun(ctx context.Context, tenantId, stepRunI
 
 		defer deferRollback(context.Background(), s.l, tx.Rollback)
 
-		_, err = s.updateStepRunExtra(ctx, tx, tenantId, resolveJobRunParams, resolveLaterStepRunsParams)
+		_, err = s.updateStepRunExtra(ctx, tx, tenantId, stepRun, resolveJobRunParams, resolveLaterStepRunsParams)
 
 		if err != nil {
 			return err
@@ -583,6 +799,12 @@ func getUpdateParams(
 		updateParams.CancelledReason = sqlchelpers.TextFromStr(*opts.CancelledReason)
 	}
 
+	if opts.CancelledSource != nil {
+		if err := updateParams.CancelledSource.Scan(string(*opts.CancelledSource)); err != nil {
+			return updateParams, nil, resolveJobRunParams, resolveLaterStepRunsParams, err
+		}
+	}
+
 	if opts.RetryCount != nil {
 		updateParams.RetryCount = pgtype.Int4{
 			Valid: true,
@@ -631,6 +853,21 @@ func (s *stepRunRepository) updateStepRunCore(
 		return nil, fmt.Errorf("could not find step run for engine")
 	}
 
+	// only forward a step run to log sinks and event bus subscriptions when this update changed its status
+	if updateParams.Status.Valid {
+		err = createStepRunLogSinkRecord(ctx, s.queries, tx, stepRuns[0])
+
+		if err != nil {
+			return nil, fmt.Errorf("could not create log sink record: %w", err)
+		}
+
+		err = createStepRunEventBusRecord(ctx, s.queries, tx, stepRuns[0])
+
+		if err != nil {
+			return nil, fmt.Errorf("could not create event bus record: %w", err)
+		}
+	}
+
 	return stepRuns[0], nil
 }
 
@@ -638,6 +875,7 @@ func (s *stepRunRepository) updateStepRunExtra(
 	ctx context.Context,
 	tx pgx.Tx,
 	tenantId string,
+	stepRun *dbsqlc.GetStepRunForEngineRow,
 	resolveJobRunParams dbsqlc.ResolveJobRunStatusParams,
 	resolveLaterStepRunsParams dbsqlc.ResolveLaterStepRunsParams,
 ) (*repository.StepRunUpdateInfo, error) {
@@ -650,6 +888,12 @@ func (s *stepRunRepository) updateStepRunExtra(
 		return nil, fmt.Errorf("could not resolve later step runs: %w", err)
 	}
 
+	err = s.resolveJoinStepRuns(ctx, tx, tenantId, stepRun)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve join step runs: %w", err)
+	}
+
 	jobRun, err := s.queries.ResolveJobRunStatus(context.Background(), tx, resolveJobRunParams)
 
 	if err != nil {
@@ -675,6 +919,110 @@ func (s *stepRunRepository) updateStepRunExtra(
 	}, nil
 }
 
+// resolveJoinStepRuns resolves the step runs after a failed or cancelled step run of a job run with join steps, which
+// ResolveLaterStepRuns skips. Only the step runs which depend on the step run and can no longer start are cancelled,
+// and the failure or cancellation of the step runs on a branch of a join step run which can still start is tolerated.
+func (s *stepRunRepository) resolveJoinStepRuns(ctx context.Context, tx pgx.Tx, tenantId string, stepRun *dbsqlc.GetStepRunForEngineRow) error {
+	if stepRun.StepRun.Status != dbsqlc.StepRunStatusFAILED && stepRun.StepRun.Status != dbsqlc.StepRunStatusCANCELLED {
+		return nil
+	}
+
+	graph, err := s.getJoinGraph(ctx, tx, tenantId, stepRun.JobRunId)
+
+	if err != nil {
+		return err
+	}
+
+	if !graph.HasJoins() {
+		return nil
+	}
+
+	stepRunId := sqlchelpers.UUIDToStr(stepRun.StepRun.ID)
+	res := graph.Resolve(stepRunId)
+
+	if len(res.Cancelled) > 0 {
+		cancelParams := dbsqlc.CancelPendingStepRunsParams{
+			Ids:      uuidsFromStrs(res.Cancelled),
+			Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		}
+
+		// the same reasons as ResolveLaterStepRuns
+		if stepRun.StepRun.Status == dbsqlc.StepRunStatusCANCELLED {
+			reason := "PREVIOUS_STEP_CANCELLED"
+
+			if stepRun.StepRun.CancelledReason.String == "TIMED_OUT" {
+				reason = "PREVIOUS_STEP_TIMED_OUT"
+			}
+
+			cancelParams.CancelledReason = sqlchelpers.TextFromStr(reason)
+			cancelParams.CancelledSource = dbsqlc.NullCancellationSource{
+				CancellationSource: dbsqlc.CancellationSourcePARENTCANCELLED,
+				Valid:              true,
+			}
+		}
+
+		err = s.queries.CancelPendingStepRuns(ctx, tx, cancelParams)
+
+		if err != nil {
+			return fmt.Errorf("could not cancel pending step runs: %w", err)
+		}
+	}
+
+	err = s.queries.UpdateStepRunsToleratedByJoin(ctx, tx, dbsqlc.UpdateStepRunsToleratedByJoinParams{
+		Toleratedids: uuidsFromStrs(res.Tolerated),
+		Ids:          uuidsFromStrs(append([]string{stepRunId}, res.Cancelled...)),
+		Tenantid:     sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not update tolerated step runs: %w", err)
+	}
+
+	return nil
+}
+
+func (s *stepRunRepository) getJoinGraph(ctx context.Context, tx dbsqlc.DBTX, tenantId string, jobRunId pgtype.UUID) (*joins.Graph, error) {
+	rows, err := s.queries.ListStepRunsForJoinResolution(ctx, tx, dbsqlc.ListStepRunsForJoinResolutionParams{
+		Jobrunid: jobRunId,
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list step runs of job run: %w", err)
+	}
+
+	stepRuns := make([]*joins.StepRun, len(rows))
+
+	for i, row := range rows {
+		parentIds := make([]string, len(row.ParentIds))
+
+		for j, parentId := range row.ParentIds {
+			parentIds[j] = sqlchelpers.UUIDToStr(parentId)
+		}
+
+		stepRuns[i] = &joins.StepRun{
+			ID:              sqlchelpers.UUIDToStr(row.ID),
+			ParentIds:       parentIds,
+			Status:          row.Status,
+			Strategy:        row.JoinStrategy,
+			Quorum:          int(row.JoinQuorum.Int32),
+			ToleratedByJoin: row.ToleratedByJoin,
+		}
+	}
+
+	return joins.NewGraph(stepRuns), nil
+}
+
+func uuidsFromStrs(ids []string) []pgtype.UUID {
+	res := make([]pgtype.UUID, len(ids))
+
+	for i, id := range ids {
+		res[i] = sqlchelpers.UUIDFromStr(id)
+	}
+
+	return res
+}
+
 func isFinalJobRunStatus(status dbsqlc.JobRunStatus) bool {
 	return status != dbsqlc.JobRunStatusPENDING && status != dbsqlc.JobRunStatusRUNNING
 }
@@ -694,6 +1042,7 @@ func (s *stepRunRepository) GetStepRunById(tenantId, stepRunId string) (*db.Step
 		db.StepRun.Step.Fetch().With(
 			db.Step.Job.Fetch(),
 			db.Step.Action.Fetch(),
+			db.Step.PreflightChecks.Fetch(),
 		),
 		db.StepRun.JobRun.Fetch().With(
 			db.JobRun.LookupData.Fetch(),
@@ -721,6 +1070,26 @@ func (s *stepRunRepository) GetStepRunForEngine(tenantId, stepRunId string) (*db
 	return res[0], nil
 }
 
+func (s *stepRunRepository) GetWorkerForStepRunTimeoutHook(ctx context.Context, tenantId, stepRunId string) (*dbsqlc.GetWorkerForStepRunTimeoutHookRow, error) {
+	return s.queries.GetWorkerForStepRunTimeoutHook(ctx, s.pool, dbsqlc.GetWorkerForStepRunTimeoutHookParams{
+		Steprunid: sqlchelpers.UUIDFromStr(stepRunId),
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+	})
+}
+
+func (s *stepRunRepository) GetStepRunCorrelationId(ctx context.Context, tenantId, stepRunId string) (string, error) {
+	correlationId, err := s.queries.GetStepRunCorrelationId(ctx, s.pool, dbsqlc.GetStepRunCorrelationIdParams{
+		Steprunid: sqlchelpers.UUIDFromStr(stepRunId),
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return correlationId.String, nil
+}
+
 func (s *stepRunRepository) ListStartableStepRuns(tenantId, jobRunId string, parentStepRunId *string) ([]*dbsqlc.GetStepRunForEngineRow, error) {
 	tx, err := s.pool.Begin(context.Background())
 
@@ -758,6 +1127,22 @@ func (s *stepRunRepository) ListStartableStepRuns(tenantId, jobRunId string, par
 	return res, err
 }
 
+func (s *stepRunRepository) ListSupersededStepRuns(ctx context.Context, tenantId, joinStepRunId string) ([]string, error) {
+	stepRun, err := s.GetStepRunForEngine(tenantId, joinStepRunId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	graph, err := s.getJoinGraph(ctx, s.pool, tenantId, stepRun.JobRunId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return graph.Superseded(joinStepRunId), nil
+}
+
 func (s *stepRunRepository) ArchiveStepRunResult(tenantId, stepRunId string) error {
 	_, err := s.queries.ArchiveStepRunResultFromStepRun(context.Background(), s.pool, dbsqlc.ArchiveStepRunResultFromStepRunParams{
 		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
@@ -789,6 +1174,36 @@ func (s *stepRunRepository) GetFirstArchivedStepRunResult(tenantId, stepRunId st
 	).Exec(context.Background())
 }
 
+func (s *stepRunRepository) CreateStepRunDiagnostic(ctx context.Context, tenantId, stepRunId, traceParent string) (*dbsqlc.StepRunDiagnostic, error) {
+	params := dbsqlc.CreateStepRunDiagnosticParams{
+		Steprunid: sqlchelpers.UUIDFromStr(stepRunId),
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+	}
+
+	if traceParent != "" {
+		params.TraceParent = sqlchelpers.TextFromStr(traceParent)
+	}
+
+	diagnostic, err := s.queries.CreateStepRunDiagnostic(ctx, s.pool, params)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("could not create step run diagnostic: %w", err)
+	}
+
+	return diagnostic, nil
+}
+
+func (s *stepRunRepository) ListStepRunDiagnostics(tenantId, stepRunId string) ([]*dbsqlc.StepRunDiagnostic, error) {
+	return s.queries.ListStepRunDiagnostics(context.Background(), s.pool, dbsqlc.ListStepRunDiagnosticsParams{
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+		Steprunid: sqlchelpers.UUIDFromStr(stepRunId),
+	})
+}
+
 // sleepWithJitter sleeps for a random duration between min and max duration.
 // min and max are time.Duration values, specifying the minimum and maximum sleep times.
 func sleepWithJitter(min, max time.Duration) {
@@ -0,0 +1,90 @@
+package prisma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/validator"
+)
+
+type streamEventRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewStreamEventRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.StreamEventRepository {
+	queries := dbsqlc.New()
+
+	return &streamEventRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *streamEventRepository) PutStreamEvent(ctx context.Context, tenantId string, opts *repository.CreateStreamEventOpts) (*dbsqlc.CreateStreamEventRow, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	createParams := dbsqlc.CreateStreamEventParams{
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+		Steprunid: sqlchelpers.UUIDFromStr(opts.StepRunId),
+		Message:   opts.Message,
+	}
+
+	if opts.CreatedAt != nil {
+		createParams.CreatedAt = sqlchelpers.TimestampFromTime(opts.CreatedAt.UTC())
+	}
+
+	streamEvent, err := r.queries.CreateStreamEvent(ctx, r.pool, createParams)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrStepRunNotRunning
+		}
+
+		return nil, fmt.Errorf("could not create stream event: %w", err)
+	}
+
+	return streamEvent, nil
+}
+
+func (r *streamEventRepository) ListStreamEvents(ctx context.Context, tenantId, stepRunId string, opts *repository.ListStreamEventsOpts) ([]*dbsqlc.StreamEvent, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	queryParams := dbsqlc.ListStreamEventsParams{
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+		Steprunid: sqlchelpers.UUIDFromStr(stepRunId),
+	}
+
+	if opts.After != nil {
+		queryParams.After = pgtype.Int4{Int32: int32(*opts.After), Valid: true}
+	}
+
+	if opts.Limit != nil {
+		queryParams.Limit = pgtype.Int4{Int32: int32(*opts.Limit), Valid: true}
+	}
+
+	streamEvents, err := r.queries.ListStreamEvents(ctx, r.pool, queryParams)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list stream events: %w", err)
+	}
+
+	return streamEvents, nil
+}
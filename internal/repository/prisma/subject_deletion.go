@@ -0,0 +1,120 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/validator"
+)
+
+type subjectDeletionRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewSubjectDeletionRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.SubjectDeletionRepository {
+	queries := dbsqlc.New()
+
+	return &subjectDeletionRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *subjectDeletionRepository) DeleteSubject(ctx context.Context, tenantId string, opts *repository.DeleteSubjectOpts) (*repository.SubjectDeletionReport, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// a dry run deletes everything like a deletion, and then rolls back, so that the report is exact
+	defer deferRollback(ctx, r.l, tx.Rollback)
+
+	runs, err := r.queries.ListSubjectWorkflowRuns(ctx, tx, dbsqlc.ListSubjectWorkflowRunsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Key:      opts.Key,
+		Value:    opts.Value,
+		Limit:    int32(opts.Limit),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list workflow runs of subject: %w", err)
+	}
+
+	res := &repository.SubjectDeletionReport{
+		WorkflowRunIds:        make([]string, 0),
+		SkippedWorkflowRunIds: make([]string, 0),
+		HasMore:               len(runs) == opts.Limit,
+	}
+
+	workflowRunIds := make([]pgtype.UUID, 0, len(runs))
+
+	for _, run := range runs {
+		switch run.Status {
+		case dbsqlc.WorkflowRunStatusSUCCEEDED, dbsqlc.WorkflowRunStatusFAILED:
+			workflowRunIds = append(workflowRunIds, run.ID)
+			res.WorkflowRunIds = append(res.WorkflowRunIds, sqlchelpers.UUIDToStr(run.ID))
+		default:
+			// the data of running workflow runs is still read by their next step runs
+			res.SkippedWorkflowRunIds = append(res.SkippedWorkflowRunIds, sqlchelpers.UUIDToStr(run.ID))
+		}
+	}
+
+	if len(workflowRunIds) == 0 {
+		return res, nil
+	}
+
+	for _, q := range []struct {
+		name  string
+		count *int64
+		run   func(context.Context, dbsqlc.DBTX, []pgtype.UUID) (int64, error)
+	}{
+		{"workflow run triggers", &res.WorkflowRunTriggers, r.queries.ScrubSubjectWorkflowRunTriggers},
+		{"events", &res.Events, r.queries.ScrubSubjectEvents},
+		{"job run lookup data", &res.JobRunLookupData, r.queries.ScrubSubjectJobRunLookupData},
+		{"get group key runs", &res.GetGroupKeyRuns, r.queries.ScrubSubjectGetGroupKeyRuns},
+		{"step runs", &res.StepRuns, r.queries.ScrubSubjectStepRuns},
+		{"step run result archives", &res.StepRunResultArchives, r.queries.ScrubSubjectStepRunResultArchives},
+		{"log lines", &res.LogLines, r.queries.DeleteSubjectLogLines},
+		{"stream events", &res.StreamEvents, r.queries.DeleteSubjectStreamEvents},
+	} {
+		count, err := q.run(ctx, tx, workflowRunIds)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not delete %s of subject: %w", q.name, err)
+		}
+
+		*q.count = count
+	}
+
+	// the workflow runs can't be found by the metadata of the subject afterwards
+	if _, err := r.queries.ScrubSubjectWorkflowRuns(ctx, tx, workflowRunIds); err != nil {
+		return nil, fmt.Errorf("could not scrub workflow runs of subject: %w", err)
+	}
+
+	if opts.DryRun {
+		return res, nil
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,146 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/internal/config/database"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+)
+
+// TestDeleteSubjectScrubsStepRunErrors checks that the errors of the step runs of a subject, which may contain the
+// data of the subject, are deleted together with their inputs and outputs.
+func TestDeleteSubjectScrubsStepRunErrors(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		pool := conf.Pools["engine"]
+
+		workflowVersion, err := conf.Repository.Workflow().CreateNewWorkflow(tenantId, &repository.CreateWorkflowVersionOpts{
+			Name: "subject-workflow",
+			Jobs: []repository.CreateWorkflowJobOpts{
+				{
+					Name: "job",
+					Steps: []repository.CreateWorkflowStepOpts{
+						{
+							ReadableId: "step",
+							Action:     "subject:step",
+						},
+					},
+				},
+			},
+		})
+
+		if err != nil {
+			t.Fatalf("could not create workflow: %v", err)
+		}
+
+		workflowVersion, err = conf.Repository.Workflow().GetWorkflowVersionById(tenantId, workflowVersion.ID)
+
+		if err != nil {
+			t.Fatalf("could not get workflow version: %v", err)
+		}
+
+		subjectId := uuid.New().String()
+
+		opts, err := repository.GetCreateWorkflowRunOptsFromManual(workflowVersion, []byte(`{}`))
+
+		if err != nil {
+			t.Fatalf("could not get workflow run opts: %v", err)
+		}
+
+		opts.AdditionalMetadata = []byte(fmt.Sprintf(`{"customer_id": %q}`, subjectId))
+
+		workflowRun, err := conf.Repository.WorkflowRun().CreateNewWorkflowRun(ctx, tenantId, opts)
+
+		if err != nil {
+			t.Fatalf("could not create workflow run: %v", err)
+		}
+
+		// fail the step run and the workflow run with an error which contains the data of the subject, and archive
+		// a previous attempt of the step run
+		_, err = pool.Exec(ctx, `
+			UPDATE "StepRun" sr
+			SET "status" = 'FAILED', "error" = $2::text
+			FROM "JobRun" jr
+			WHERE sr."jobRunId" = jr."id" AND jr."workflowRunId" = $1::uuid`,
+			workflowRun.ID, "could not charge "+subjectId,
+		)
+
+		if err != nil {
+			t.Fatalf("could not fail step run: %v", err)
+		}
+
+		_, err = pool.Exec(ctx, `
+			INSERT INTO "StepRunResultArchive" ("id", "stepRunId", "error")
+			SELECT gen_random_uuid(), sr."id", $2::text
+			FROM "StepRun" sr
+			JOIN "JobRun" jr ON jr."id" = sr."jobRunId"
+			WHERE jr."workflowRunId" = $1::uuid`,
+			workflowRun.ID, "could not charge "+subjectId,
+		)
+
+		if err != nil {
+			t.Fatalf("could not archive step run: %v", err)
+		}
+
+		_, err = pool.Exec(ctx, `UPDATE "WorkflowRun" SET "status" = 'FAILED' WHERE "id" = $1::uuid`, workflowRun.ID)
+
+		if err != nil {
+			t.Fatalf("could not fail workflow run: %v", err)
+		}
+
+		report, err := conf.Repository.SubjectDeletion().DeleteSubject(ctx, tenantId, &repository.DeleteSubjectOpts{
+			Key:   "customer_id",
+			Value: subjectId,
+			Limit: 10,
+		})
+
+		if err != nil {
+			t.Fatalf("could not delete subject: %v", err)
+		}
+
+		assert.Equal(t, []string{workflowRun.ID}, report.WorkflowRunIds)
+		assert.Equal(t, int64(1), report.StepRuns)
+		assert.Equal(t, int64(1), report.StepRunResultArchives)
+
+		var stepRunErrors, archiveErrors int
+
+		err = pool.QueryRow(ctx, `
+			SELECT COUNT(*)
+			FROM "StepRun" sr
+			JOIN "JobRun" jr ON jr."id" = sr."jobRunId"
+			WHERE jr."workflowRunId" = $1::uuid AND sr."error" IS NOT NULL`,
+			workflowRun.ID,
+		).Scan(&stepRunErrors)
+
+		if err != nil {
+			t.Fatalf("could not count step run errors: %v", err)
+		}
+
+		err = pool.QueryRow(ctx, `
+			SELECT COUNT(*)
+			FROM "StepRunResultArchive" a
+			JOIN "StepRun" sr ON sr."id" = a."stepRunId"
+			JOIN "JobRun" jr ON jr."id" = sr."jobRunId"
+			WHERE jr."workflowRunId" = $1::uuid AND a."error" IS NOT NULL`,
+			workflowRun.ID,
+		).Scan(&archiveErrors)
+
+		if err != nil {
+			t.Fatalf("could not count step run result archive errors: %v", err)
+		}
+
+		assert.Zero(t, stepRunErrors, "the errors of the step runs must be deleted")
+		assert.Zero(t, archiveErrors, "the errors of the step run result archives must be deleted")
+
+		return nil
+	})
+}
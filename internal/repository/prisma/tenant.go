@@ -2,21 +2,36 @@ package prisma
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
 
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/internal/validator"
 )
 
 type tenantRepository struct {
-	client *db.PrismaClient
-	v      validator.Validator
+	client  *db.PrismaClient
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
 }
 
-func NewTenantRepository(client *db.PrismaClient, v validator.Validator) repository.TenantRepository {
+func NewTenantRepository(client *db.PrismaClient, pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.TenantRepository {
+	queries := dbsqlc.New()
+
 	return &tenantRepository{
-		client: client,
-		v:      v,
+		client:  client,
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
 	}
 }
 
@@ -36,6 +51,95 @@ func (r *tenantRepository) ListTenants() ([]db.TenantModel, error) {
 	return r.client.Tenant.FindMany().Exec(context.Background())
 }
 
+func (r *tenantRepository) ListTenantsWithRunCounts(createdAfter time.Time) ([]*dbsqlc.ListTenantsWithRunCountsRow, error) {
+	return r.queries.ListTenantsWithRunCounts(context.Background(), r.pool, sqlchelpers.TimestampFromTime(createdAfter))
+}
+
+func (r *tenantRepository) GetTenantQueueDepth(tenantId string) (*dbsqlc.GetTenantQueueDepthRow, error) {
+	return r.queries.GetTenantQueueDepth(context.Background(), r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *tenantRepository) UpdateTenant(tenantId string, opts *repository.UpdateTenantOpts) (*db.TenantModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := []db.TenantSetParam{
+		db.Tenant.Name.SetIfPresent(opts.Name),
+		db.Tenant.IngestionPaused.SetIfPresent(opts.IngestionPaused),
+	}
+
+	if opts.RedactionRules != nil {
+		params = append(params, db.Tenant.RedactionRules.Set(opts.RedactionRules))
+	}
+
+	if opts.AssignmentStrategy != nil {
+		params = append(params, db.Tenant.AssignmentStrategy.Set(db.WorkerAssignmentStrategy(*opts.AssignmentStrategy)))
+	}
+
+	if opts.DependencyHealth != nil {
+		params = append(params, db.Tenant.DependencyHealth.Set(opts.DependencyHealth))
+	}
+
+	return r.client.Tenant.FindUnique(
+		db.Tenant.ID.Equals(tenantId),
+	).Update(
+		params...,
+	).Exec(context.Background())
+}
+
+func (r *tenantRepository) PauseTenant(ctx context.Context, tenantId string, opts *repository.PauseOpts) error {
+	if err := r.v.Validate(opts); err != nil {
+		return err
+	}
+
+	_, err := r.queries.UpdateTenantPause(ctx, r.pool, dbsqlc.UpdateTenantPauseParams{
+		Paused:                true,
+		PausedTriggerBehavior: pausedTriggerBehavior(opts),
+		ID:                    sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not pause tenant: %w", err)
+	}
+
+	return nil
+}
+
+func (r *tenantRepository) ResumeTenant(ctx context.Context, tenantId string) ([]*dbsqlc.WorkflowRun, error) {
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer deferRollback(ctx, r.l, tx.Rollback)
+
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	_, err = r.queries.UpdateTenantPause(ctx, tx, dbsqlc.UpdateTenantPauseParams{
+		Paused: false,
+		ID:     pgTenantId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not resume tenant: %w", err)
+	}
+
+	// see ResumeWorkflow: the runs are released while the tenant is locked
+	released, err := r.queries.ReleaseBufferedWorkflowRuns(ctx, tx, pgTenantId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not release buffered workflow runs: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return released, nil
+}
+
 func (r *tenantRepository) GetTenantByID(id string) (*db.TenantModel, error) {
 	return r.client.Tenant.FindUnique(
 		db.Tenant.ID.Equals(id),
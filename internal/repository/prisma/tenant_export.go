@@ -0,0 +1,197 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/validator"
+)
+
+type tenantExportRepository struct {
+	client  *db.PrismaClient
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewTenantExportRepository(client *db.PrismaClient, pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.TenantExportRepository {
+	queries := dbsqlc.New()
+
+	return &tenantExportRepository{
+		client:  client,
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *tenantExportRepository) CreateTenantExport(tenantId string) (*db.TenantExportModel, error) {
+	return r.client.TenantExport.CreateOne(
+		db.TenantExport.Tenant.Link(
+			db.Tenant.ID.Equals(tenantId),
+		),
+	).Exec(context.Background())
+}
+
+func (r *tenantExportRepository) GetTenantExportById(exportId string) (*db.TenantExportModel, error) {
+	return r.client.TenantExport.FindUnique(
+		db.TenantExport.ID.Equals(exportId),
+	).Exec(context.Background())
+}
+
+func (r *tenantExportRepository) ListTenantExports(tenantId string) ([]db.TenantExportModel, error) {
+	return r.client.TenantExport.FindMany(
+		db.TenantExport.TenantID.Equals(tenantId),
+	).OrderBy(
+		db.TenantExport.CreatedAt.Order(db.DESC),
+	).Exec(context.Background())
+}
+
+func (r *tenantExportRepository) UpdateTenantExport(tenantId, exportId string, opts *repository.UpdateTenantExportOpts) (*db.TenantExportModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	return r.client.TenantExport.FindUnique(
+		db.TenantExport.ID.Equals(exportId),
+	).Update(
+		db.TenantExport.Status.SetIfPresent(opts.Status),
+		db.TenantExport.SectionsDone.SetIfPresent(opts.SectionsDone),
+		db.TenantExport.SectionsTotal.SetIfPresent(opts.SectionsTotal),
+		db.TenantExport.RecordCount.SetIfPresent(opts.RecordCount),
+		db.TenantExport.Error.SetIfPresent(opts.Error),
+		db.TenantExport.StartedAt.SetIfPresent(opts.StartedAt),
+		db.TenantExport.FinishedAt.SetIfPresent(opts.FinishedAt),
+	).Exec(context.Background())
+}
+
+func (r *tenantExportRepository) CompleteTenantExport(ctx context.Context, exportId string, opts *repository.CompleteTenantExportOpts) error {
+	if err := r.v.Validate(opts); err != nil {
+		return err
+	}
+
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	defer deferRollback(ctx, r.l, tx.Rollback)
+
+	pgExportId := sqlchelpers.UUIDFromStr(exportId)
+
+	params := dbsqlc.CompleteTenantExportParams{
+		Archivesize:   int32(opts.ArchiveSize),
+		Archivesha256: opts.ArchiveSHA256,
+		Expiresat:     sqlchelpers.TimestampFromTime(opts.ExpiresAt.UTC()),
+		ID:            pgExportId,
+	}
+
+	if opts.ArchiveKey != nil {
+		params.ArchiveKey = sqlchelpers.TextFromStr(*opts.ArchiveKey)
+	} else {
+		err = r.queries.CreateTenantExportArchive(ctx, tx, dbsqlc.CreateTenantExportArchiveParams{
+			Exportid: pgExportId,
+			Data:     opts.Archive,
+		})
+
+		if err != nil {
+			return fmt.Errorf("could not create export archive: %w", err)
+		}
+	}
+
+	err = r.queries.CompleteTenantExport(ctx, tx, params)
+
+	if err != nil {
+		return fmt.Errorf("could not complete export: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *tenantExportRepository) GetTenantExportArchive(exportId string) ([]byte, error) {
+	archive, err := r.client.TenantExportArchive.FindUnique(
+		db.TenantExportArchive.ExportID.Equals(exportId),
+	).Exec(context.Background())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return archive.Data, nil
+}
+
+func (r *tenantExportRepository) DeleteExpiredTenantExports(ctx context.Context) (int64, []string, error) {
+	archiveKeys, err := r.queries.DeleteExpiredTenantExports(ctx, r.pool)
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	keys := []string{}
+
+	for _, key := range archiveKeys {
+		if key.Valid {
+			keys = append(keys, key.String)
+		}
+	}
+
+	return int64(len(archiveKeys)), keys, nil
+}
+
+func (r *tenantExportRepository) ListWorkflowsForTenantExport(ctx context.Context, tenantId string) ([]*dbsqlc.ListWorkflowsForTenantExportRow, error) {
+	return r.queries.ListWorkflowsForTenantExport(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *tenantExportRepository) ListWorkflowVersionsForTenantExport(ctx context.Context, tenantId string) ([]*dbsqlc.ListWorkflowVersionsForTenantExportRow, error) {
+	return r.queries.ListWorkflowVersionsForTenantExport(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *tenantExportRepository) ListStepsForTenantExport(ctx context.Context, tenantId string) ([]*dbsqlc.ListStepsForTenantExportRow, error) {
+	return r.queries.ListStepsForTenantExport(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *tenantExportRepository) ListStepRunsForTenantExport(ctx context.Context, tenantId string, workflowRunIds []string) ([]*dbsqlc.ListStepRunsForTenantExportRow, error) {
+	ids := make([]pgtype.UUID, len(workflowRunIds))
+
+	for i, id := range workflowRunIds {
+		ids[i] = sqlchelpers.UUIDFromStr(id)
+	}
+
+	return r.queries.ListStepRunsForTenantExport(ctx, r.pool, dbsqlc.ListStepRunsForTenantExportParams{
+		Tenantid:       sqlchelpers.UUIDFromStr(tenantId),
+		Workflowrunids: ids,
+	})
+}
+
+func (r *tenantExportRepository) ListEventsForTenantExport(ctx context.Context, tenantId string, opts *repository.ListEventsForTenantExportOpts) ([]*dbsqlc.ListEventsForTenantExportRow, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	queryParams := dbsqlc.ListEventsForTenantExportParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Limit:    int32(opts.Limit),
+	}
+
+	if opts.After != nil {
+		queryParams.AfterCreatedAt = sqlchelpers.TimestampFromTime(opts.After.CreatedAt)
+		queryParams.AfterId = sqlchelpers.UUIDFromStr(opts.After.ID)
+	}
+
+	return r.queries.ListEventsForTenantExport(ctx, r.pool, queryParams)
+}
+
+func (r *tenantExportRepository) ListAPITokensForTenantExport(ctx context.Context, tenantId string) ([]*dbsqlc.ListAPITokensForTenantExportRow, error) {
+	return r.queries.ListAPITokensForTenantExport(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
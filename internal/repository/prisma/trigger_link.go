@@ -0,0 +1,79 @@
+package prisma
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/validator"
+)
+
+type triggerLinkRepository struct {
+	client *db.PrismaClient
+	v      validator.Validator
+}
+
+func NewTriggerLinkRepository(client *db.PrismaClient, v validator.Validator) repository.TriggerLinkRepository {
+	return &triggerLinkRepository{
+		client: client,
+		v:      v,
+	}
+}
+
+func (r *triggerLinkRepository) CreateTriggerLink(tenantId, workflowId string, opts *repository.CreateTriggerLinkOpts) (*db.WorkflowTriggerLinkModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	optionals := []db.WorkflowTriggerLinkSetParam{}
+
+	if opts.DefaultInput != nil {
+		optionals = append(optionals, db.WorkflowTriggerLink.DefaultInput.Set(opts.DefaultInput))
+	}
+
+	return r.client.WorkflowTriggerLink.CreateOne(
+		db.WorkflowTriggerLink.Tenant.Link(
+			db.Tenant.ID.Equals(tenantId),
+		),
+		db.WorkflowTriggerLink.Workflow.Link(
+			db.Workflow.ID.Equals(workflowId),
+		),
+		db.WorkflowTriggerLink.Name.Set(opts.Name),
+		db.WorkflowTriggerLink.TokenHash.Set(opts.TokenHash),
+		optionals...,
+	).Exec(context.Background())
+}
+
+func (r *triggerLinkRepository) GetTriggerLinkById(id string) (*db.WorkflowTriggerLinkModel, error) {
+	return r.client.WorkflowTriggerLink.FindUnique(
+		db.WorkflowTriggerLink.ID.Equals(id),
+	).Exec(context.Background())
+}
+
+func (r *triggerLinkRepository) ListTriggerLinks(tenantId, workflowId string) ([]db.WorkflowTriggerLinkModel, error) {
+	return r.client.WorkflowTriggerLink.FindMany(
+		db.WorkflowTriggerLink.TenantID.Equals(tenantId),
+		db.WorkflowTriggerLink.WorkflowID.Equals(workflowId),
+	).OrderBy(
+		db.WorkflowTriggerLink.CreatedAt.Order(db.ASC),
+	).Exec(context.Background())
+}
+
+func (r *triggerLinkRepository) UpdateTriggerLinkTriggered(id string, triggeredAt time.Time) error {
+	_, err := r.client.WorkflowTriggerLink.FindUnique(
+		db.WorkflowTriggerLink.ID.Equals(id),
+	).Update(
+		db.WorkflowTriggerLink.LastTriggeredAt.Set(triggeredAt),
+	).Exec(context.Background())
+
+	return err
+}
+
+func (r *triggerLinkRepository) DeleteTriggerLink(tenantId, id string) error {
+	_, err := r.client.WorkflowTriggerLink.FindUnique(
+		db.WorkflowTriggerLink.ID.Equals(id),
+	).Delete().Exec(context.Background())
+
+	return err
+}
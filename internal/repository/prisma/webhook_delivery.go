@@ -0,0 +1,116 @@
+package prisma
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/validator"
+)
+
+type webhookDeliveryRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewWebhookDeliveryRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.WebhookDeliveryRepository {
+	queries := dbsqlc.New()
+
+	return &webhookDeliveryRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *webhookDeliveryRepository) CreateWebhookDelivery(ctx context.Context, tenantId string, opts *repository.CreateWebhookDeliveryOpts) (*dbsqlc.WebhookDelivery, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.CreateWebhookDeliveryParams{
+		Tenantid:         sqlchelpers.UUIDFromStr(tenantId),
+		Source:           opts.Source,
+		Webhookid:        sqlchelpers.UUIDFromStr(opts.WebhookId),
+		Event:            opts.Event,
+		Nonce:            opts.Nonce,
+		Status:           opts.Status,
+		Replayprotection: opts.ReplayProtection,
+	}
+
+	if opts.DeliveryId != nil {
+		params.DeliveryId = sqlchelpers.TextFromStr(*opts.DeliveryId)
+	}
+
+	if opts.SentAt != nil {
+		params.SentAt = sqlchelpers.TimestampFromTime(opts.SentAt.UTC())
+	}
+
+	if opts.Error != nil {
+		params.Error = sqlchelpers.TextFromStr(*opts.Error)
+	}
+
+	delivery, err := r.queries.CreateWebhookDelivery(ctx, r.pool, params)
+
+	if err != nil {
+		// no rows are returned when the delivery is a replay of a previous delivery
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrDuplicateWebhookDelivery
+		}
+
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+func (r *webhookDeliveryRepository) UpdateWebhookDeliveryStatus(ctx context.Context, id string, status dbsqlc.WebhookDeliveryStatus, errMsg *string) error {
+	params := dbsqlc.UpdateWebhookDeliveryStatusParams{
+		ID:     sqlchelpers.UUIDFromStr(id),
+		Status: status,
+	}
+
+	if errMsg != nil {
+		params.Error = sqlchelpers.TextFromStr(*errMsg)
+	}
+
+	return r.queries.UpdateWebhookDeliveryStatus(ctx, r.pool, params)
+}
+
+func (r *webhookDeliveryRepository) ListWebhookDeliveries(ctx context.Context, tenantId string, opts *repository.ListWebhookDeliveriesOpts) ([]*dbsqlc.WebhookDelivery, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.ListWebhookDeliveriesParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Limit:    50,
+	}
+
+	if opts.WebhookId != nil {
+		params.WebhookId = sqlchelpers.UUIDFromStr(*opts.WebhookId)
+	}
+
+	if opts.Limit != nil {
+		params.Limit = int32(*opts.Limit)
+	}
+
+	return r.queries.ListWebhookDeliveries(ctx, r.pool, params)
+}
+
+func (r *webhookDeliveryRepository) DeleteExpiredWebhookDeliveries(ctx context.Context, webhookId string, before time.Time) (int64, error) {
+	return r.queries.DeleteExpiredWebhookDeliveries(ctx, r.pool, dbsqlc.DeleteExpiredWebhookDeliveriesParams{
+		Webhookid: sqlchelpers.UUIDFromStr(webhookId),
+		Before:    sqlchelpers.TimestampFromTime(before.UTC()),
+	})
+}
@@ -2,6 +2,7 @@ package prisma
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -54,6 +55,44 @@ func (w *workerRepository) GetWorkerForEngine(tenantId, workerId string) (*dbsql
 	})
 }
 
+func (w *workerRepository) ListUnackedAssignments(tenantId, workerId string) (*repository.UnackedAssignments, error) {
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+	pgWorkerId := sqlchelpers.UUIDFromStr(workerId)
+
+	stepRunIds, err := w.queries.ListUnackedStepRunsForWorker(context.Background(), w.pool, dbsqlc.ListUnackedStepRunsForWorkerParams{
+		Tenantid: pgTenantId,
+		Workerid: pgWorkerId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list unacked step runs: %w", err)
+	}
+
+	workflowRunIds, err := w.queries.ListUnackedGetGroupKeyRunsForWorker(context.Background(), w.pool, dbsqlc.ListUnackedGetGroupKeyRunsForWorkerParams{
+		Tenantid: pgTenantId,
+		Workerid: pgWorkerId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list unacked get group key runs: %w", err)
+	}
+
+	res := &repository.UnackedAssignments{
+		StepRunIds:     make([]string, 0, len(stepRunIds)),
+		WorkflowRunIds: make([]string, 0, len(workflowRunIds)),
+	}
+
+	for _, id := range stepRunIds {
+		res.StepRunIds = append(res.StepRunIds, sqlchelpers.UUIDToStr(id))
+	}
+
+	for _, id := range workflowRunIds {
+		res.WorkflowRunIds = append(res.WorkflowRunIds, sqlchelpers.UUIDToStr(id))
+	}
+
+	return res, nil
+}
+
 func (w *workerRepository) ListRecentWorkerStepRuns(tenantId, workerId string) ([]db.StepRunModel, error) {
 	return w.client.StepRun.FindMany(
 		db.StepRun.WorkerID.Equals(workerId),
@@ -101,6 +140,10 @@ func (r *workerRepository) ListWorkers(tenantId string, opts *repository.ListWor
 		}
 	}
 
+	if opts.Environment != nil {
+		queryParams.Environment = sqlchelpers.TextFromStr(*opts.Environment)
+	}
+
 	tx, err := r.pool.Begin(context.Background())
 
 	if err != nil {
@@ -137,6 +180,23 @@ func (w *workerRepository) CreateNewWorker(tenantId string, opts *repository.Cre
 
 	workerId := uuid.New().String()
 
+	// the session token is returned to the worker on registration, and lets the worker resume its session
+	// when it reconnects
+	sessionToken := uuid.New().String()
+
+	var labels *db.JSON
+
+	if len(opts.Labels) > 0 {
+		labelBytes, err := json.Marshal(opts.Labels)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal worker labels: %w", err)
+		}
+
+		labelsJSON := db.JSON(labelBytes)
+		labels = &labelsJSON
+	}
+
 	createTx := w.client.Worker.CreateOne(
 		db.Worker.Tenant.Link(
 			db.Tenant.ID.Equals(tenantId),
@@ -147,6 +207,14 @@ func (w *workerRepository) CreateNewWorker(tenantId string, opts *repository.Cre
 		),
 		db.Worker.ID.Set(workerId),
 		db.Worker.MaxRuns.SetIfPresent(opts.MaxRuns),
+		db.Worker.Cpu.SetIfPresent(opts.Cpu),
+		db.Worker.MemoryMb.SetIfPresent(opts.MemoryMb),
+		db.Worker.Gpu.SetIfPresent(opts.Gpu),
+		db.Worker.Environment.SetIfPresent(opts.Environment),
+		db.Worker.BuildID.SetIfPresent(opts.BuildId),
+		db.Worker.Labels.SetIfPresent(labels),
+		db.Worker.ProtocolVersion.SetIfPresent(opts.ProtocolVersion),
+		db.Worker.SessionToken.Set(sessionToken),
 	).Tx()
 
 	txs = append(txs, createTx)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -17,6 +18,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlctoprisma"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/anomaly"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
 	"github.com/hatchet-dev/hatchet/internal/validator"
 )
@@ -78,6 +80,16 @@ func (r *workflowRepository) ListWorkflows(tenantId string, opts *repository.Lis
 		latestRunParams.EventKey = *pgEventKey
 	}
 
+	if opts.Environment != nil {
+		queryParams.Environment = sqlchelpers.TextFromStr(*opts.Environment)
+		countParams.Environment = sqlchelpers.TextFromStr(*opts.Environment)
+	}
+
+	if opts.Namespace != nil {
+		queryParams.Namespace = sqlchelpers.TextFromStr(*opts.Namespace)
+		countParams.Namespace = sqlchelpers.TextFromStr(*opts.Namespace)
+	}
+
 	if opts.Offset != nil {
 		queryParams.Offset = *opts.Offset
 	}
@@ -396,6 +408,47 @@ func (r *workflowRepository) GetScheduledById(tenantId, scheduleTriggerId string
 	).Exec(context.Background())
 }
 
+func (r *workflowRepository) ListScheduledWorkflows(tenantId string, opts *repository.ListScheduledWorkflowsOpts) ([]db.WorkflowTriggerScheduledRefModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	workflowParams := []db.WorkflowWhereParam{
+		db.Workflow.TenantID.Equals(tenantId),
+		db.Workflow.DeletedAt.IsNull(),
+	}
+
+	if opts.WorkflowId != nil {
+		workflowParams = append(workflowParams, db.Workflow.ID.Equals(*opts.WorkflowId))
+	}
+
+	offset := 0
+	limit := 50
+
+	if opts.Offset != nil {
+		offset = *opts.Offset
+	}
+
+	if opts.Limit != nil {
+		limit = *opts.Limit
+	}
+
+	return r.client.WorkflowTriggerScheduledRef.FindMany(
+		db.WorkflowTriggerScheduledRef.Parent.Where(
+			db.WorkflowVersion.Workflow.Where(
+				workflowParams...,
+			),
+		),
+	).With(
+		db.WorkflowTriggerScheduledRef.Parent.Fetch().With(
+			db.WorkflowVersion.Workflow.Fetch(),
+		),
+		db.WorkflowTriggerScheduledRef.Triggered.Fetch(),
+	).OrderBy(
+		db.WorkflowTriggerScheduledRef.TriggerAt.Order(db.SortOrderAsc),
+	).Skip(offset).Take(limit).Exec(context.Background())
+}
+
 func (r *workflowRepository) ListWorkflowsForEvent(ctx context.Context, tenantId, eventKey string) ([]*dbsqlc.GetWorkflowVersionForEngineRow, error) {
 	ctx, span := telemetry.NewSpan(ctx, "db-list-workflows-for-event")
 	defer span.End()
@@ -421,6 +474,16 @@ func (r *workflowRepository) ListWorkflowsForEvent(ctx context.Context, tenantId
 		return nil, fmt.Errorf("failed to fetch workflows: %w", err)
 	}
 
+	// trigger the previous version in place of a version which is being rolled out
+	workflowVersionIds, err = r.queries.ResolveWorkflowVersions(ctx, tx, dbsqlc.ResolveWorkflowVersionsParams{
+		Ids:      workflowVersionIds,
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve workflow versions: %w", err)
+	}
+
 	workflows, err := r.queries.GetWorkflowVersionForEngine(context.Background(), tx, dbsqlc.GetWorkflowVersionForEngineParams{
 		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
 		Ids:      workflowVersionIds,
@@ -465,6 +528,44 @@ func (r *workflowRepository) createWorkflowVersionTxs(ctx context.Context, tx pg
 		createParams.ScheduleTimeout = sqlchelpers.TextFromStr(*opts.ScheduleTimeout)
 	}
 
+	if opts.SLA != nil {
+		createParams.Sla = sqlchelpers.TextFromStr(*opts.SLA)
+	}
+
+	if opts.DefaultInput != nil {
+		createParams.DefaultInput = []byte(*opts.DefaultInput)
+	}
+
+	if opts.InputSchema != nil {
+		createParams.InputSchema = []byte(*opts.InputSchema)
+	}
+
+	if opts.PinToBuild != nil {
+		createParams.PinToBuild = pgtype.Bool{
+			Bool:  *opts.PinToBuild,
+			Valid: true,
+		}
+	}
+
+	if opts.AssignmentStrategy != nil {
+		createParams.AssignmentStrategy = dbsqlc.NullWorkerAssignmentStrategy{
+			WorkerAssignmentStrategy: dbsqlc.WorkerAssignmentStrategy(*opts.AssignmentStrategy),
+			Valid:                    true,
+		}
+	}
+
+	if opts.MaxStepExecutions != nil {
+		createParams.MaxStepExecutions = sqlchelpers.ToInt(*opts.MaxStepExecutions)
+	}
+
+	if opts.MaxStepRetries != nil {
+		createParams.MaxStepRetries = sqlchelpers.ToInt(*opts.MaxStepRetries)
+	}
+
+	if opts.CostCenter != nil {
+		createParams.CostCenter = sqlchelpers.TextFromStr(*opts.CostCenter)
+	}
+
 	sqlcWorkflowVersion, err := r.queries.CreateWorkflowVersion(
 		context.Background(),
 		tx,
@@ -477,24 +578,29 @@ func (r *workflowRepository) createWorkflowVersionTxs(ctx context.Context, tx pg
 
 	// create concurrency group
 	if opts.Concurrency != nil {
-		// upsert the action
-		action, err := r.queries.UpsertAction(
-			context.Background(),
-			tx,
-			dbsqlc.UpsertActionParams{
-				Action:   opts.Concurrency.Action,
-				Tenantid: tenantId,
-			},
-		)
-
-		if err != nil {
-			return "", fmt.Errorf("could not upsert action: %w", err)
+		params := dbsqlc.CreateWorkflowConcurrencyParams{
+			ID:                sqlchelpers.UUIDFromStr(uuid.New().String()),
+			Workflowversionid: sqlcWorkflowVersion.ID,
+			Keyexpressions:    opts.Concurrency.KeyExpressions,
 		}
 
-		params := dbsqlc.CreateWorkflowConcurrencyParams{
-			ID:                    sqlchelpers.UUIDFromStr(uuid.New().String()),
-			Workflowversionid:     sqlcWorkflowVersion.ID,
-			Getconcurrencygroupid: action.ID,
+		// concurrency groups which are computed from key expressions don't run an action
+		if opts.Concurrency.Action != "" {
+			// upsert the action
+			action, err := r.queries.UpsertAction(
+				context.Background(),
+				tx,
+				dbsqlc.UpsertActionParams{
+					Action:   opts.Concurrency.Action,
+					Tenantid: tenantId,
+				},
+			)
+
+			if err != nil {
+				return "", fmt.Errorf("could not upsert action: %w", err)
+			}
+
+			params.Getconcurrencygroupid = action.ID
 		}
 
 		if opts.Concurrency.MaxRuns != nil {
@@ -607,10 +713,64 @@ func (r *workflowRepository) createWorkflowVersionTxs(ctx context.Context, tx pg
 				Retries:        retries,
 			}
 
-			if opts.ScheduleTimeout != nil {
+			// the schedule timeout of the step takes precedence over the schedule timeout of the workflow
+			if stepOpts.ScheduleTimeout != nil {
+				createStepParams.ScheduleTimeout = sqlchelpers.TextFromStr(*stepOpts.ScheduleTimeout)
+			} else if opts.ScheduleTimeout != nil {
 				createStepParams.ScheduleTimeout = sqlchelpers.TextFromStr(*opts.ScheduleTimeout)
 			}
 
+			if stepOpts.OnTimeout != nil {
+				createStepParams.OnTimeoutActionId = sqlchelpers.TextFromStr(*stepOpts.OnTimeout)
+			}
+
+			if stepOpts.CostCenter != nil {
+				createStepParams.CostCenter = sqlchelpers.TextFromStr(*stepOpts.CostCenter)
+			}
+
+			if stepOpts.Cpu != nil {
+				createStepParams.Cpu = pgtype.Float8{
+					Valid:   true,
+					Float64: *stepOpts.Cpu,
+				}
+			}
+
+			if stepOpts.MemoryMb != nil {
+				createStepParams.MemoryMb = pgtype.Int4{
+					Valid: true,
+					Int32: int32(*stepOpts.MemoryMb),
+				}
+			}
+
+			if stepOpts.Gpu != nil {
+				createStepParams.Gpu = pgtype.Int4{
+					Valid: true,
+					Int32: int32(*stepOpts.Gpu),
+				}
+			}
+
+			if stepOpts.CancelGracePeriod != nil {
+				createStepParams.CancelGracePeriod = sqlchelpers.TextFromStr(*stepOpts.CancelGracePeriod)
+			}
+
+			if stepOpts.MaxTimeout != nil {
+				createStepParams.MaxTimeout = sqlchelpers.TextFromStr(*stepOpts.MaxTimeout)
+			}
+
+			if stepOpts.JoinStrategy != nil {
+				createStepParams.JoinStrategy = dbsqlc.NullStepJoinStrategy{
+					StepJoinStrategy: dbsqlc.StepJoinStrategy(*stepOpts.JoinStrategy),
+					Valid:            true,
+				}
+			}
+
+			if stepOpts.JoinQuorum != nil {
+				createStepParams.JoinQuorum = pgtype.Int4{
+					Valid: true,
+					Int32: int32(*stepOpts.JoinQuorum),
+				}
+			}
+
 			_, err = r.queries.CreateStep(
 				context.Background(),
 				tx,
@@ -621,6 +781,28 @@ func (r *workflowRepository) createWorkflowVersionTxs(ctx context.Context, tx pg
 				return "", err
 			}
 
+			for _, checkOpts := range stepOpts.PreflightChecks {
+				createCheckParams := dbsqlc.CreateStepPreflightCheckParams{
+					Stepid: sqlchelpers.UUIDFromStr(stepId),
+					Kind:   dbsqlc.StepPreflightCheckKind(checkOpts.Kind),
+					Target: checkOpts.Target,
+				}
+
+				if checkOpts.Timeout != nil {
+					createCheckParams.Timeout = sqlchelpers.TextFromStr(*checkOpts.Timeout)
+				}
+
+				err := r.queries.CreateStepPreflightCheck(
+					context.Background(),
+					tx,
+					createCheckParams,
+				)
+
+				if err != nil {
+					return "", err
+				}
+			}
+
 			if len(stepOpts.Parents) > 0 {
 				err := r.queries.AddStepParents(
 					context.Background(),
@@ -701,6 +883,20 @@ func (r *workflowRepository) createWorkflowVersionTxs(ctx context.Context, tx pg
 		}
 	}
 
+	// if the workflow has a rollout policy, the new version only receives part of the triggers until it's proven
+	err = r.queries.StartWorkflowRollout(
+		context.Background(),
+		tx,
+		dbsqlc.StartWorkflowRolloutParams{
+			Workflowversionid: sqlcWorkflowVersion.ID,
+			Workflowid:        workflowId,
+		},
+	)
+
+	if err != nil {
+		return "", fmt.Errorf("could not start workflow rollout: %w", err)
+	}
+
 	return workflowVersionId, nil
 }
 
@@ -738,6 +934,7 @@ func (r *workflowRepository) UpsertWorkflowDeploymentConfig(workflowId string, o
 		db.WorkflowDeploymentConfig.GithubAppInstallation.Link(
 			db.GithubAppInstallation.ID.Equals(opts.GithubAppInstallationId),
 		),
+		db.WorkflowDeploymentConfig.PreviewEnvironmentHook.SetIfPresent(opts.PreviewEnvironmentHook),
 	).Update(
 		db.WorkflowDeploymentConfig.GitRepoName.Set(opts.GitRepoName),
 		db.WorkflowDeploymentConfig.GitRepoOwner.Set(opts.GitRepoOwner),
@@ -745,6 +942,7 @@ func (r *workflowRepository) UpsertWorkflowDeploymentConfig(workflowId string, o
 		db.WorkflowDeploymentConfig.GithubAppInstallation.Link(
 			db.GithubAppInstallation.ID.Equals(opts.GithubAppInstallationId),
 		),
+		db.WorkflowDeploymentConfig.PreviewEnvironmentHook.SetOptional(opts.PreviewEnvironmentHook),
 	).Exec(context.Background())
 
 	if err != nil {
@@ -754,6 +952,327 @@ func (r *workflowRepository) UpsertWorkflowDeploymentConfig(workflowId string, o
 	return deploymentConfig, nil
 }
 
+func (r *workflowRepository) ListPreviewEnvironmentWorkflows(tenantId, repoOwner, repoName string, hook db.PreviewEnvironmentHook) ([]db.WorkflowModel, error) {
+	return r.client.Workflow.FindMany(
+		db.Workflow.TenantID.Equals(tenantId),
+		db.Workflow.DeletedAt.IsNull(),
+		db.Workflow.DeploymentConfig.Where(
+			db.WorkflowDeploymentConfig.GitRepoOwner.Equals(repoOwner),
+			db.WorkflowDeploymentConfig.GitRepoName.Equals(repoName),
+			db.WorkflowDeploymentConfig.PreviewEnvironmentHook.Equals(hook),
+		),
+	).With(
+		defaultWorkflowPopulator()...,
+	).Exec(context.Background())
+}
+
+func (r *workflowRepository) GetWorkflowRollout(workflowId string) (*db.WorkflowRolloutModel, error) {
+	return r.client.WorkflowRollout.FindUnique(
+		db.WorkflowRollout.WorkflowID.Equals(workflowId),
+	).Exec(context.Background())
+}
+
+func (r *workflowRepository) UpsertWorkflowRollout(tenantId, workflowId string, opts *repository.UpsertWorkflowRolloutOpts) (*db.WorkflowRolloutModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := []db.WorkflowRolloutSetParam{
+		db.WorkflowRollout.Percentage.Set(opts.Percentage),
+		db.WorkflowRollout.FailureRateThreshold.Set(opts.FailureRateThreshold),
+		db.WorkflowRollout.WindowSeconds.Set(opts.WindowSeconds),
+		db.WorkflowRollout.MinRuns.Set(opts.MinRuns),
+	}
+
+	existing, err := r.GetWorkflowRollout(workflowId)
+
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		return nil, err
+	}
+
+	// an active rollout which receives all of the triggers is complete, so it can't be rolled back anymore
+	if existing != nil && existing.Status == db.WorkflowRolloutStatusActive && opts.Percentage == 100 {
+		params = append(
+			params,
+			db.WorkflowRollout.Status.Set(db.WorkflowRolloutStatusCompleted),
+			db.WorkflowRollout.FinishedAt.Set(time.Now().UTC()),
+		)
+	}
+
+	return r.client.WorkflowRollout.UpsertOne(
+		db.WorkflowRollout.WorkflowID.Equals(workflowId),
+	).Create(
+		db.WorkflowRollout.Tenant.Link(
+			db.Tenant.ID.Equals(tenantId),
+		),
+		db.WorkflowRollout.Workflow.Link(
+			db.Workflow.ID.Equals(workflowId),
+		),
+		db.WorkflowRollout.Percentage.Set(opts.Percentage),
+		db.WorkflowRollout.FailureRateThreshold.Set(opts.FailureRateThreshold),
+		db.WorkflowRollout.WindowSeconds.Set(opts.WindowSeconds),
+		db.WorkflowRollout.MinRuns.Set(opts.MinRuns),
+	).Update(
+		params...,
+	).Exec(context.Background())
+}
+
+func (r *workflowRepository) DeleteWorkflowRollout(workflowId string) (*db.WorkflowRolloutModel, error) {
+	return r.client.WorkflowRollout.FindUnique(
+		db.WorkflowRollout.WorkflowID.Equals(workflowId),
+	).Delete().Exec(context.Background())
+}
+
+func (r *workflowRepository) ResolveWorkflowVersions(ctx context.Context, tenantId string, workflowVersionIds []string) ([]string, error) {
+	ids := make([]pgtype.UUID, len(workflowVersionIds))
+
+	for i, id := range workflowVersionIds {
+		ids[i] = sqlchelpers.UUIDFromStr(id)
+	}
+
+	resolved, err := r.queries.ResolveWorkflowVersions(ctx, r.pool, dbsqlc.ResolveWorkflowVersionsParams{
+		Ids:      ids,
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve workflow versions: %w", err)
+	}
+
+	res := make([]string, len(resolved))
+
+	for i, id := range resolved {
+		res[i] = sqlchelpers.UUIDToStr(id)
+	}
+
+	return res, nil
+}
+
+func (r *workflowRepository) RollBackFailingWorkflowRollouts(ctx context.Context) ([]*dbsqlc.RollBackFailingWorkflowRolloutsRow, error) {
+	return r.queries.RollBackFailingWorkflowRollouts(ctx, r.pool)
+}
+
+func (r *workflowRepository) ListWorkflowAnomalyWindows(ctx context.Context, windowStart, windowEnd time.Time, limit int) ([]*dbsqlc.ListWorkflowAnomalyWindowsRow, error) {
+	return r.queries.ListWorkflowAnomalyWindows(ctx, r.pool, dbsqlc.ListWorkflowAnomalyWindowsParams{
+		Windowstart: sqlchelpers.TimestampFromTime(windowStart),
+		Windowend:   sqlchelpers.TimestampFromTime(windowEnd),
+		Limit:       int32(limit),
+	})
+}
+
+func (r *workflowRepository) UpsertWorkflowAnomalyBaseline(ctx context.Context, tenantId, workflowId string, windowEnd time.Time, baseline *anomaly.Baseline) (*dbsqlc.WorkflowAnomalyBaseline, error) {
+	res, err := r.queries.UpsertWorkflowAnomalyBaseline(ctx, r.pool, dbsqlc.UpsertWorkflowAnomalyBaselineParams{
+		Tenantid:            sqlchelpers.UUIDFromStr(tenantId),
+		Workflowid:          sqlchelpers.UUIDFromStr(workflowId),
+		Windowend:           sqlchelpers.TimestampFromTime(windowEnd),
+		Windows:             int32(baseline.Windows),
+		Failureratemean:     baseline.FailureRateMean,
+		Failureratevariance: baseline.FailureRateVariance,
+		Durationmean:        baseline.DurationMean,
+		Durationvariance:    baseline.DurationVariance,
+	})
+
+	if err != nil {
+		// no rows are returned when another ticker has already learned from the window
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *workflowRepository) PauseWorkflow(ctx context.Context, tenantId, workflowId string, opts *repository.PauseOpts) error {
+	if err := r.v.Validate(opts); err != nil {
+		return err
+	}
+
+	_, err := r.queries.UpdateWorkflowPause(ctx, r.pool, dbsqlc.UpdateWorkflowPauseParams{
+		Paused:                true,
+		PausedTriggerBehavior: pausedTriggerBehavior(opts),
+		ID:                    sqlchelpers.UUIDFromStr(workflowId),
+		Tenantid:              sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not pause workflow: %w", err)
+	}
+
+	return nil
+}
+
+func (r *workflowRepository) ResumeWorkflow(ctx context.Context, tenantId, workflowId string) ([]*dbsqlc.WorkflowRun, error) {
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer deferRollback(ctx, r.l, tx.Rollback)
+
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	_, err = r.queries.UpdateWorkflowPause(ctx, tx, dbsqlc.UpdateWorkflowPauseParams{
+		Paused:   false,
+		ID:       sqlchelpers.UUIDFromStr(workflowId),
+		Tenantid: pgTenantId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not resume workflow: %w", err)
+	}
+
+	// the runs are released in the same transaction, which holds the lock on the workflow, so that runs which
+	// are buffered concurrently are either released here or not buffered at all
+	released, err := r.queries.ReleaseBufferedWorkflowRuns(ctx, tx, pgTenantId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not release buffered workflow runs: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return released, nil
+}
+
+// pausedTriggerBehavior returns the trigger behavior of a pause, which is REJECT unless the options set one.
+func pausedTriggerBehavior(opts *repository.PauseOpts) dbsqlc.NullPausedTriggerBehavior {
+	behavior := dbsqlc.PausedTriggerBehaviorREJECT
+
+	if opts.TriggerBehavior != nil {
+		behavior = dbsqlc.PausedTriggerBehavior(*opts.TriggerBehavior)
+	}
+
+	return dbsqlc.NullPausedTriggerBehavior{
+		PausedTriggerBehavior: behavior,
+		Valid:                 true,
+	}
+}
+
+func (r *workflowRepository) CreateWorkflowMaintenanceWindow(tenantId, workflowId string, opts *repository.CreateWorkflowMaintenanceWindowOpts) (*db.WorkflowMaintenanceWindowModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	return r.client.WorkflowMaintenanceWindow.CreateOne(
+		db.WorkflowMaintenanceWindow.Tenant.Link(
+			db.Tenant.ID.Equals(tenantId),
+		),
+		db.WorkflowMaintenanceWindow.Workflow.Link(
+			db.Workflow.ID.Equals(workflowId),
+		),
+		db.WorkflowMaintenanceWindow.Name.Set(opts.Name),
+		db.WorkflowMaintenanceWindow.Cron.Set(opts.Cron),
+		db.WorkflowMaintenanceWindow.DurationSeconds.Set(opts.DurationSeconds),
+	).Exec(context.Background())
+}
+
+func (r *workflowRepository) GetWorkflowMaintenanceWindowById(id string) (*db.WorkflowMaintenanceWindowModel, error) {
+	return r.client.WorkflowMaintenanceWindow.FindUnique(
+		db.WorkflowMaintenanceWindow.ID.Equals(id),
+	).Exec(context.Background())
+}
+
+func (r *workflowRepository) ListWorkflowMaintenanceWindows(workflowId string) ([]db.WorkflowMaintenanceWindowModel, error) {
+	return r.client.WorkflowMaintenanceWindow.FindMany(
+		db.WorkflowMaintenanceWindow.WorkflowID.Equals(workflowId),
+	).OrderBy(
+		db.WorkflowMaintenanceWindow.CreatedAt.Order(db.ASC),
+	).Exec(context.Background())
+}
+
+func (r *workflowRepository) DeleteWorkflowMaintenanceWindow(id string) error {
+	_, err := r.client.WorkflowMaintenanceWindow.FindUnique(
+		db.WorkflowMaintenanceWindow.ID.Equals(id),
+	).Delete().Exec(context.Background())
+
+	return err
+}
+
+func (r *workflowRepository) ListAllWorkflowMaintenanceWindows(ctx context.Context) ([]*dbsqlc.WorkflowMaintenanceWindow, error) {
+	return r.queries.ListAllWorkflowMaintenanceWindows(ctx, r.pool)
+}
+
+func (r *workflowRepository) StartWorkflowMaintenance(ctx context.Context, workflowId string, until time.Time) error {
+	_, err := r.queries.StartWorkflowMaintenance(ctx, r.pool, dbsqlc.StartWorkflowMaintenanceParams{
+		Maintenanceuntil: sqlchelpers.TimestampFromTime(until.UTC()),
+		Workflowid:       sqlchelpers.UUIDFromStr(workflowId),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not start maintenance of workflow: %w", err)
+	}
+
+	return nil
+}
+
+func (r *workflowRepository) EndWorkflowMaintenance(ctx context.Context) ([]*dbsqlc.WorkflowRun, error) {
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer deferRollback(ctx, r.l, tx.Rollback)
+
+	workflows, err := r.queries.EndWorkflowMaintenance(ctx, tx)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not end maintenance of workflows: %w", err)
+	}
+
+	// like when a pause is lifted, the runs are released in the same transaction which ended the maintenance, so
+	// that runs which are buffered concurrently are either released here or not buffered at all
+	released := []*dbsqlc.WorkflowRun{}
+	releasedTenants := map[string]bool{}
+
+	for _, workflow := range workflows {
+		tenantId := sqlchelpers.UUIDToStr(workflow.TenantId)
+
+		if releasedTenants[tenantId] {
+			continue
+		}
+
+		releasedTenants[tenantId] = true
+
+		runs, err := r.queries.ReleaseBufferedWorkflowRuns(ctx, tx, workflow.TenantId)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not release buffered workflow runs: %w", err)
+		}
+
+		released = append(released, runs...)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return released, nil
+}
+
+func (r *workflowRepository) UpdateWorkflowDiagnostics(workflowId string, opts *repository.UpdateWorkflowDiagnosticsOpts) (*db.WorkflowModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	_, err := r.client.Workflow.FindUnique(
+		db.Workflow.ID.Equals(workflowId),
+	).Update(
+		db.Workflow.DiagnosticsSampleRate.Set(opts.SampleRate),
+	).Exec(context.Background())
+
+	if err != nil {
+		return nil, fmt.Errorf("could not update workflow diagnostics: %w", err)
+	}
+
+	return r.GetWorkflowById(workflowId)
+}
+
 func defaultWorkflowPopulator() []db.WorkflowRelationWith {
 	return []db.WorkflowRelationWith{
 		db.Workflow.Tags.Fetch(),
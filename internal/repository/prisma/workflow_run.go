@@ -2,7 +2,9 @@ package prisma
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,6 +12,7 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
+	"github.com/steebchen/prisma-client-go/runtime/types"
 
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
@@ -106,6 +109,27 @@ func (w *workflowRunRepository) ListWorkflowRuns(tenantId string, opts *reposito
 		countParams.Status = status
 	}
 
+	if opts.CreatedAfter != nil {
+		queryParams.CreatedAfter = sqlchelpers.TimestampFromTime(*opts.CreatedAfter)
+		countParams.CreatedAfter = sqlchelpers.TimestampFromTime(*opts.CreatedAfter)
+	}
+
+	if opts.CancelledSource != nil {
+		var cancelledSource dbsqlc.NullCancellationSource
+
+		if err := cancelledSource.Scan(string(*opts.CancelledSource)); err != nil {
+			return nil, err
+		}
+
+		queryParams.CancelledSource = cancelledSource
+		countParams.CancelledSource = cancelledSource
+	}
+
+	if opts.Environment != nil {
+		queryParams.Environment = sqlchelpers.TextFromStr(*opts.Environment)
+		countParams.Environment = sqlchelpers.TextFromStr(*opts.Environment)
+	}
+
 	orderByField := "createdAt"
 
 	if opts.OrderBy != nil {
@@ -152,6 +176,144 @@ func (w *workflowRunRepository) ListWorkflowRuns(tenantId string, opts *reposito
 	return res, nil
 }
 
+func (w *workflowRunRepository) ListWorkflowRunsForExport(ctx context.Context, tenantId string, opts *repository.ListWorkflowRunsForExportOpts) ([]*dbsqlc.ListWorkflowRunsForExportRow, error) {
+	if err := w.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	queryParams := dbsqlc.ListWorkflowRunsForExportParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Limit:    int32(opts.Limit),
+	}
+
+	if opts.WorkflowId != nil {
+		queryParams.WorkflowId = sqlchelpers.UUIDFromStr(*opts.WorkflowId)
+	}
+
+	if opts.Status != nil {
+		var status dbsqlc.NullWorkflowRunStatus
+
+		if err := status.Scan(string(*opts.Status)); err != nil {
+			return nil, err
+		}
+
+		queryParams.Status = status
+	}
+
+	if opts.CreatedAfter != nil {
+		queryParams.CreatedAfter = sqlchelpers.TimestampFromTime(*opts.CreatedAfter)
+	}
+
+	if opts.CreatedBefore != nil {
+		queryParams.CreatedBefore = sqlchelpers.TimestampFromTime(*opts.CreatedBefore)
+	}
+
+	if opts.After != nil {
+		queryParams.AfterCreatedAt = sqlchelpers.TimestampFromTime(opts.After.CreatedAt)
+		queryParams.AfterId = sqlchelpers.UUIDFromStr(opts.After.ID)
+	}
+
+	return w.queries.ListWorkflowRunsForExport(ctx, w.pool, queryParams)
+}
+
+func (w *workflowRunRepository) ListWorkflowRunsForConcurrencySimulation(ctx context.Context, tenantId, workflowId string, createdAfter time.Time, limit int) ([]*dbsqlc.ListWorkflowRunsForConcurrencySimulationRow, error) {
+	return w.queries.ListWorkflowRunsForConcurrencySimulation(ctx, w.pool, dbsqlc.ListWorkflowRunsForConcurrencySimulationParams{
+		Tenantid:     sqlchelpers.UUIDFromStr(tenantId),
+		Workflowid:   sqlchelpers.UUIDFromStr(workflowId),
+		Createdafter: sqlchelpers.TimestampFromTime(createdAfter),
+		Limit:        int32(limit),
+	})
+}
+
+func (w *workflowRunRepository) ListWorkflowConcurrencyQueueDepth(ctx context.Context, tenantId, workflowId string, limit int) ([]*dbsqlc.ListWorkflowConcurrencyQueueDepthRow, error) {
+	return w.queries.ListWorkflowConcurrencyQueueDepth(ctx, w.pool, dbsqlc.ListWorkflowConcurrencyQueueDepthParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Workflowid: sqlchelpers.UUIDFromStr(workflowId),
+		Limit:      int32(limit),
+	})
+}
+
+func (w *workflowRunRepository) ListFailedWorkflowRunsForRetry(ctx context.Context, tenantId string, opts *repository.ListFailedWorkflowRunsForRetryOpts) ([]*dbsqlc.ListFailedWorkflowRunsForRetryRow, error) {
+	if err := w.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	before := opts.Before
+
+	if opts.Filter.CreatedBefore != nil && opts.Filter.CreatedBefore.Before(before) {
+		before = *opts.Filter.CreatedBefore
+	}
+
+	queryParams := dbsqlc.ListFailedWorkflowRunsForRetryParams{
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+		Createdbefore: sqlchelpers.TimestampFromTime(before),
+		Limit:         int32(opts.Limit),
+	}
+
+	if opts.Filter.WorkflowId != nil {
+		queryParams.WorkflowId = sqlchelpers.UUIDFromStr(*opts.Filter.WorkflowId)
+	}
+
+	if opts.Filter.CreatedAfter != nil {
+		queryParams.CreatedAfter = sqlchelpers.TimestampFromTime(*opts.Filter.CreatedAfter)
+	}
+
+	if opts.Filter.ErrorContains != nil {
+		queryParams.ErrorContains = sqlchelpers.TextFromStr(*opts.Filter.ErrorContains)
+	}
+
+	if opts.After != nil {
+		queryParams.AfterCreatedAt = sqlchelpers.TimestampFromTime(opts.After.CreatedAt)
+		queryParams.AfterId = sqlchelpers.UUIDFromStr(opts.After.ID)
+	}
+
+	return w.queries.ListFailedWorkflowRunsForRetry(ctx, w.pool, queryParams)
+}
+
+func (w *workflowRunRepository) CreateWorkflowRunBulkRetry(tenantId string, opts *repository.CreateWorkflowRunBulkRetryOpts) (*db.WorkflowRunBulkRetryModel, error) {
+	if err := w.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	filterBytes, err := json.Marshal(opts.Filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return w.client.WorkflowRunBulkRetry.CreateOne(
+		db.WorkflowRunBulkRetry.Tenant.Link(
+			db.Tenant.ID.Equals(tenantId),
+		),
+		db.WorkflowRunBulkRetry.Filter.Set(types.JSON(filterBytes)),
+	).Exec(context.Background())
+}
+
+func (w *workflowRunRepository) GetWorkflowRunBulkRetryById(tenantId, bulkRetryId string) (*db.WorkflowRunBulkRetryModel, error) {
+	return w.client.WorkflowRunBulkRetry.FindFirst(
+		db.WorkflowRunBulkRetry.ID.Equals(bulkRetryId),
+		db.WorkflowRunBulkRetry.TenantID.Equals(tenantId),
+	).Exec(context.Background())
+}
+
+func (w *workflowRunRepository) UpdateWorkflowRunBulkRetry(tenantId, bulkRetryId string, opts *repository.UpdateWorkflowRunBulkRetryOpts) (*db.WorkflowRunBulkRetryModel, error) {
+	if err := w.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	return w.client.WorkflowRunBulkRetry.FindUnique(
+		db.WorkflowRunBulkRetry.ID.Equals(bulkRetryId),
+	).Update(
+		db.WorkflowRunBulkRetry.Status.SetIfPresent(opts.Status),
+		db.WorkflowRunBulkRetry.MatchedCount.SetIfPresent(opts.MatchedCount),
+		db.WorkflowRunBulkRetry.RetriedCount.SetIfPresent(opts.RetriedCount),
+		db.WorkflowRunBulkRetry.FailedCount.SetIfPresent(opts.FailedCount),
+		db.WorkflowRunBulkRetry.Error.SetIfPresent(opts.Error),
+		db.WorkflowRunBulkRetry.StartedAt.SetIfPresent(opts.StartedAt),
+		db.WorkflowRunBulkRetry.FinishedAt.SetIfPresent(opts.FinishedAt),
+	).Exec(context.Background())
+}
+
 func (w *workflowRunRepository) PopWorkflowRunsRoundRobin(tenantId, workflowVersionId string, maxRuns int) ([]*dbsqlc.WorkflowRun, error) {
 	pgTenantId := &pgtype.UUID{}
 
@@ -186,6 +348,84 @@ func (w *workflowRunRepository) PopWorkflowRunsRoundRobin(tenantId, workflowVers
 	return res, nil
 }
 
+func (w *workflowRunRepository) CreateWorkflowRunSLABreaches(ctx context.Context, limit int) ([]*dbsqlc.CreateWorkflowRunSLABreachesRow, error) {
+	return w.queries.CreateWorkflowRunSLABreaches(ctx, w.pool, int32(limit))
+}
+
+func (w *workflowRunRepository) ListWorkflowRunSLABreaches(tenantId, workflowId string, opts *repository.ListWorkflowRunSLABreachesOpts) (*repository.ListWorkflowRunSLABreachesResult, error) {
+	if err := w.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	queryParams := dbsqlc.ListWorkflowRunSLABreachesParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Workflowid: sqlchelpers.UUIDFromStr(workflowId),
+	}
+
+	countParams := dbsqlc.CountWorkflowRunSLABreachesParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Workflowid: sqlchelpers.UUIDFromStr(workflowId),
+	}
+
+	if opts.Offset != nil {
+		queryParams.Offset = *opts.Offset
+	}
+
+	if opts.Limit != nil {
+		queryParams.Limit = *opts.Limit
+	}
+
+	tx, err := w.pool.Begin(context.Background())
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer deferRollback(context.Background(), w.l, tx.Rollback)
+
+	breaches, err := w.queries.ListWorkflowRunSLABreaches(context.Background(), tx, queryParams)
+
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := w.queries.CountWorkflowRunSLABreaches(context.Background(), tx, countParams)
+
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	err = tx.Commit(context.Background())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository.ListWorkflowRunSLABreachesResult{
+		Rows:  breaches,
+		Count: int(count),
+	}, nil
+}
+
+func (w *workflowRunRepository) ListWorkflowRunMetrics(tenantId string, opts *repository.ListWorkflowRunMetricsOpts) ([]*dbsqlc.ListWorkflowRunMetricsRow, error) {
+	if err := w.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.ListWorkflowRunMetricsParams{
+		Bucket:   opts.Bucket,
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Since:    sqlchelpers.TimestampFromTime(opts.Since),
+		Until:    sqlchelpers.TimestampFromTime(opts.Until),
+	}
+
+	if opts.WorkflowId != nil {
+		params.WorkflowId = sqlchelpers.UUIDFromStr(*opts.WorkflowId)
+	}
+
+	return w.queries.ListWorkflowRunMetrics(context.Background(), w.pool, params)
+}
+
 func (w *workflowRunRepository) CreateNewWorkflowRun(ctx context.Context, tenantId string, opts *repository.CreateWorkflowRunOpts) (*db.WorkflowRunModel, error) {
 	ctx, span := telemetry.NewSpan(ctx, "db-create-new-workflow-run")
 	defer span.End()
@@ -211,6 +451,19 @@ func (w *workflowRunRepository) CreateNewWorkflowRun(ctx context.Context, tenant
 
 		pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
 
+		pauseState, err := w.queries.GetWorkflowVersionPauseState(tx1Ctx, tx, dbsqlc.GetWorkflowVersionPauseStateParams{
+			Workflowversionid: sqlchelpers.UUIDFromStr(opts.WorkflowVersionId),
+			Tenantid:          pgTenantId,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not get pause state: %w", err)
+		}
+
+		if rejectsTriggers(pauseState) {
+			return nil, repository.ErrWorkflowPaused
+		}
+
 		createParams := dbsqlc.CreateWorkflowRunParams{
 			ID:                sqlchelpers.UUIDFromStr(workflowRunId),
 			Tenantid:          pgTenantId,
@@ -221,6 +474,33 @@ func (w *workflowRunRepository) CreateNewWorkflowRun(ctx context.Context, tenant
 			createParams.DisplayName = sqlchelpers.TextFromStr(*opts.DisplayName)
 		}
 
+		if len(opts.AdditionalMetadata) > 0 {
+			createParams.AdditionalMetadata = opts.AdditionalMetadata
+		}
+
+		if opts.Environment != nil {
+			createParams.Environment = sqlchelpers.TextFromStr(*opts.Environment)
+		}
+
+		if opts.CorrelationId != nil {
+			createParams.CorrelationId = sqlchelpers.TextFromStr(*opts.CorrelationId)
+		}
+
+		if opts.Replay != nil {
+			createParams.Debug = pgtype.Bool{
+				Bool:  true,
+				Valid: true,
+			}
+			createParams.ReplayOfId = sqlchelpers.UUIDFromStr(opts.Replay.WorkflowRunId)
+		}
+
+		if opts.Restore != nil {
+			createParams.Debug = pgtype.Bool{
+				Bool:  true,
+				Valid: true,
+			}
+		}
+
 		// create a workflow
 		sqlcWorkflowRun, err := w.queries.CreateWorkflowRun(
 			tx1Ctx,
@@ -270,10 +550,35 @@ func (w *workflowRunRepository) CreateNewWorkflowRun(ctx context.Context, tenant
 			return nil, err
 		}
 
+		// the run is sampled when it's created, so that all of its step runs capture diagnostics
+		diagnosticsParams := dbsqlc.CreateWorkflowRunDiagnosticsParams{
+			Workflowrunid:     sqlcWorkflowRun.ID,
+			Tenantid:          pgTenantId,
+			Workflowversionid: sqlchelpers.UUIDFromStr(opts.WorkflowVersionId),
+		}
+
+		if traceParent := telemetry.TraceParent(ctx); traceParent != "" {
+			diagnosticsParams.TraceParent = sqlchelpers.TextFromStr(traceParent)
+		}
+
+		_, err = w.queries.CreateWorkflowRunDiagnostics(tx1Ctx, tx, diagnosticsParams)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not sample workflow run for diagnostics: %w", err)
+		}
+
 		requeueAfter := time.Now().UTC().Add(5 * time.Second)
 
 		if opts.GetGroupKeyRun != nil {
-			scheduleTimeoutAt := time.Now().UTC().Add(defaults.DefaultScheduleTimeout)
+			scheduleTimeout := defaults.DefaultScheduleTimeout
+
+			if opts.GetGroupKeyRun.ScheduleTimeout != nil {
+				if parsed, err := time.ParseDuration(*opts.GetGroupKeyRun.ScheduleTimeout); err == nil {
+					scheduleTimeout = parsed
+				}
+			}
+
+			scheduleTimeoutAt := time.Now().UTC().Add(scheduleTimeout)
 
 			params := dbsqlc.CreateGetGroupKeyRunParams{
 				Tenantid:          pgTenantId,
@@ -356,6 +661,22 @@ func (w *workflowRunRepository) CreateNewWorkflowRun(ctx context.Context, tenant
 			}
 		}
 
+		if opts.Replay != nil && opts.Replay.StartFromStepId != nil {
+			err = copyReplayedStepRuns(tx1Ctx, w.queries, tx, pgTenantId, sqlcWorkflowRun.ID, opts.Replay)
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if opts.Restore != nil {
+			err = restoreStepRuns(tx1Ctx, w.queries, tx, pgTenantId, sqlcWorkflowRun.ID, opts.Restore)
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		err = tx.Commit(tx1Ctx)
 
 		if err != nil {
@@ -385,6 +706,116 @@ func (w *workflowRunRepository) CreateNewWorkflowRun(ctx context.Context, tenant
 	return res, nil
 }
 
+// copyReplayedStepRuns copies the succeeded step runs of the replayed run which are before the start step,
+// along with their outputs, so that the replay starts from the start step.
+func copyReplayedStepRuns(ctx context.Context, queries *dbsqlc.Queries, tx pgx.Tx, tenantId, workflowRunId pgtype.UUID, opts *repository.CreateWorkflowRunReplayOpts) error {
+	err := queries.CopyReplayedStepRuns(ctx, tx, dbsqlc.CopyReplayedStepRunsParams{
+		Startfromstepid: sqlchelpers.UUIDFromStr(*opts.StartFromStepId),
+		Replayofid:      sqlchelpers.UUIDFromStr(opts.WorkflowRunId),
+		Tenantid:        tenantId,
+		Workflowrunid:   workflowRunId,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	err = queries.UpdateJobRunLookupDataWithCopiedStepRuns(ctx, tx, dbsqlc.UpdateJobRunLookupDataWithCopiedStepRunsParams{
+		Workflowrunid: workflowRunId,
+		Tenantid:      tenantId,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return queries.SucceedCopiedJobRuns(ctx, tx, dbsqlc.SucceedCopiedJobRunsParams{
+		Workflowrunid: workflowRunId,
+		Tenantid:      tenantId,
+	})
+}
+
+// restoreStepRuns sets the step runs, job runs and workflow run to the state of the restored run. The
+// lookup data is updated with the outputs of the succeeded step runs, like for a replay.
+func restoreStepRuns(ctx context.Context, queries *dbsqlc.Queries, tx pgx.Tx, tenantId, workflowRunId pgtype.UUID, opts *repository.CreateWorkflowRunRestoreOpts) error {
+	for _, stepRun := range opts.StepRuns {
+		params := dbsqlc.RestoreStepRunParams{
+			Status:         dbsqlc.StepRunStatus(stepRun.Status),
+			Input:          stepRun.Input,
+			Output:         stepRun.Output,
+			Retrycount:     int32(stepRun.RetryCount),
+			Workflowrunid:  workflowRunId,
+			Tenantid:       tenantId,
+			Jobname:        stepRun.JobName,
+			Stepreadableid: stepRun.StepReadableId,
+		}
+
+		if stepRun.Error != nil {
+			params.Error = sqlchelpers.TextFromStr(*stepRun.Error)
+		}
+
+		if stepRun.CancelledReason != nil {
+			params.CancelledReason = sqlchelpers.TextFromStr(*stepRun.CancelledReason)
+		}
+
+		if stepRun.StartedAt != nil {
+			params.StartedAt = sqlchelpers.TimestampFromTime(stepRun.StartedAt.UTC())
+		}
+
+		if stepRun.FinishedAt != nil {
+			params.FinishedAt = sqlchelpers.TimestampFromTime(stepRun.FinishedAt.UTC())
+		}
+
+		if stepRun.CancelledAt != nil {
+			params.CancelledAt = sqlchelpers.TimestampFromTime(stepRun.CancelledAt.UTC())
+		}
+
+		err := queries.RestoreStepRun(ctx, tx, params)
+
+		if err != nil {
+			return fmt.Errorf("could not restore step run %s of job %s: %w", stepRun.StepReadableId, stepRun.JobName, err)
+		}
+	}
+
+	err := queries.UpdateJobRunLookupDataWithCopiedStepRuns(ctx, tx, dbsqlc.UpdateJobRunLookupDataWithCopiedStepRunsParams{
+		Workflowrunid: workflowRunId,
+		Tenantid:      tenantId,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	err = queries.RestoreJobRuns(ctx, tx, dbsqlc.RestoreJobRunsParams{
+		Workflowrunid: workflowRunId,
+		Tenantid:      tenantId,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	params := dbsqlc.RestoreWorkflowRunParams{
+		Status:        dbsqlc.WorkflowRunStatus(opts.Status),
+		Workflowrunid: workflowRunId,
+		Tenantid:      tenantId,
+	}
+
+	if opts.Error != nil {
+		params.Error = sqlchelpers.TextFromStr(*opts.Error)
+	}
+
+	if opts.StartedAt != nil {
+		params.StartedAt = sqlchelpers.TimestampFromTime(opts.StartedAt.UTC())
+	}
+
+	if opts.FinishedAt != nil {
+		params.FinishedAt = sqlchelpers.TimestampFromTime(opts.FinishedAt.UTC())
+	}
+
+	return queries.RestoreWorkflowRun(ctx, tx, params)
+}
+
 func (w *workflowRunRepository) GetWorkflowRunById(tenantId, id string) (*db.WorkflowRunModel, error) {
 	return w.client.WorkflowRun.FindUnique(
 		db.WorkflowRun.ID.Equals(id),
@@ -393,6 +824,146 @@ func (w *workflowRunRepository) GetWorkflowRunById(tenantId, id string) (*db.Wor
 	).Exec(context.Background())
 }
 
+func (w *workflowRunRepository) BufferWorkflowRunIfPaused(ctx context.Context, tenantId, workflowRunId string) (bool, error) {
+	buffered, err := w.queries.BufferWorkflowRunIfPaused(ctx, w.pool, dbsqlc.BufferWorkflowRunIfPausedParams{
+		Workflowrunid: sqlchelpers.UUIDFromStr(workflowRunId),
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("could not buffer workflow run: %w", err)
+	}
+
+	return buffered > 0, nil
+}
+
+func (w *workflowRunRepository) AddWorkflowRunBudgetUsage(ctx context.Context, tenantId, workflowRunId string, opts *repository.AddWorkflowRunBudgetUsageOpts) (*dbsqlc.AddWorkflowRunBudgetUsageRow, error) {
+	if err := w.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	usage, err := w.queries.AddWorkflowRunBudgetUsage(ctx, w.pool, dbsqlc.AddWorkflowRunBudgetUsageParams{
+		Stepexecutions: opts.StepExecutions,
+		Stepretries:    opts.StepRetries,
+		Workflowrunid:  sqlchelpers.UUIDFromStr(workflowRunId),
+		Tenantid:       sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("could not add workflow run budget usage: %w", err)
+	}
+
+	return usage, nil
+}
+
+func (w *workflowRunRepository) UpdateWorkflowRunConcurrencyGroupComponents(ctx context.Context, tenantId, workflowRunId string, components []string) error {
+	err := w.queries.UpdateWorkflowRunConcurrencyGroupComponents(ctx, w.pool, dbsqlc.UpdateWorkflowRunConcurrencyGroupComponentsParams{
+		Components:    components,
+		Workflowrunid: sqlchelpers.UUIDFromStr(workflowRunId),
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not update workflow run concurrency group components: %w", err)
+	}
+
+	return nil
+}
+
+// rejectsTriggers returns whether new runs of a workflow version are rejected. The pause of the tenant takes
+// precedence over the pause of the workflow.
+func rejectsTriggers(state *dbsqlc.GetWorkflowVersionPauseStateRow) bool {
+	if state.TenantPaused {
+		return state.TenantPausedTriggerBehavior == dbsqlc.PausedTriggerBehaviorREJECT
+	}
+
+	return state.WorkflowPaused && state.WorkflowPausedTriggerBehavior == dbsqlc.PausedTriggerBehaviorREJECT
+}
+
+func (w *workflowRunRepository) GetWorkflowRun(tenantId, id string, opts *repository.GetWorkflowRunOpts) (*db.WorkflowRunModel, error) {
+	if err := w.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	triggeredBy := db.WorkflowRun.TriggeredBy.Fetch()
+
+	if opts.Events {
+		triggeredBy = triggeredBy.With(
+			db.WorkflowRunTriggeredBy.Event.Fetch(),
+			db.WorkflowRunTriggeredBy.Cron.Fetch(),
+		)
+	} else {
+		triggeredBy = triggeredBy.With(
+			db.WorkflowRunTriggeredBy.Cron.Fetch(),
+		)
+	}
+
+	populator := []db.WorkflowRunRelationWith{
+		db.WorkflowRun.WorkflowVersion.Fetch().With(
+			db.WorkflowVersion.Workflow.Fetch(),
+			db.WorkflowVersion.Concurrency.Fetch().With(
+				db.WorkflowConcurrency.GetConcurrencyGroup.Fetch(),
+			),
+		),
+		db.WorkflowRun.GetGroupKeyRun.Fetch(),
+		triggeredBy,
+	}
+
+	if opts.StepRuns || opts.Logs || opts.Workers {
+		stepRunsWith := []db.StepRunRelationWith{
+			db.StepRun.Step.Fetch().With(
+				db.Step.Action.Fetch(),
+				db.Step.Parents.Fetch(),
+			),
+		}
+
+		if opts.Logs {
+			stepRunsWith = append(stepRunsWith, db.StepRun.Logs.Fetch().OrderBy(
+				db.LogLine.CreatedAt.Order(db.SortOrderAsc),
+			).Take(maxLogLinesPerStepRun))
+		}
+
+		if opts.Workers {
+			stepRunsWith = append(stepRunsWith, db.StepRun.Worker.Fetch())
+		}
+
+		populator = append(populator, db.WorkflowRun.JobRuns.Fetch().With(
+			db.JobRun.Job.Fetch().With(
+				db.Job.Steps.Fetch().With(
+					db.Step.Action.Fetch(),
+					db.Step.Parents.Fetch(),
+				),
+			),
+			db.JobRun.StepRuns.Fetch().With(
+				stepRunsWith...,
+			),
+		))
+	}
+
+	return w.client.WorkflowRun.FindUnique(
+		db.WorkflowRun.ID.Equals(id),
+	).With(
+		populator...,
+	).Exec(context.Background())
+}
+
+func (w *workflowRunRepository) GetWorkflowRunLastUpdatedAt(ctx context.Context, tenantId, id string) (time.Time, error) {
+	lastUpdatedAt, err := w.queries.GetWorkflowRunLastUpdatedAt(ctx, w.pool, dbsqlc.GetWorkflowRunLastUpdatedAtParams{
+		Workflowrunid: sqlchelpers.UUIDFromStr(id),
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return lastUpdatedAt.Time, nil
+}
+
 func (s *workflowRunRepository) CreateWorkflowRunPullRequest(tenantId, workflowRunId string, opts *repository.CreateWorkflowRunPullRequestOpts) (*db.GithubPullRequestModel, error) {
 	return s.client.GithubPullRequest.CreateOne(
 		db.GithubPullRequest.Tenant.Link(
@@ -433,6 +1004,10 @@ func (s *workflowRunRepository) ListPullRequestsForWorkflowRun(tenantId, workflo
 	).Exec(context.Background())
 }
 
+// maxLogLinesPerStepRun is the maximum number of log lines fetched for each step run when logs are
+// included on a workflow run.
+const maxLogLinesPerStepRun = 1000
+
 func defaultWorkflowRunPopulator() []db.WorkflowRunRelationWith {
 	return []db.WorkflowRunRelationWith{
 		db.WorkflowRun.WorkflowVersion.Fetch().With(
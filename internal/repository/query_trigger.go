@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+type CreateQueryTriggerOpts struct {
+	// (required) the name of the trigger, which is unique within the tenant
+	Name string `validate:"required,hatchetName"`
+
+	// (required) the config of the trigger, which should be encrypted
+	Config []byte `validate:"required"`
+
+	// (required) the read-only query which is run
+	Query string `validate:"required"`
+
+	// (required) the cron expression of the schedule of the query
+	Cron string `validate:"required"`
+
+	// (required) whether an event is created for every row, or one event for a non-empty result
+	Mode string `validate:"required,oneof=PER_ROW NON_EMPTY"`
+
+	// (required) the key of the events which are created
+	EventKey string `validate:"required"`
+
+	// (required) the time when the query runs first
+	NextRunAt time.Time `validate:"required"`
+}
+
+type QueryTriggerRepository interface {
+	// CreateQueryTrigger creates a query trigger for a tenant.
+	CreateQueryTrigger(tenantId string, opts *CreateQueryTriggerOpts) (*db.QueryTriggerModel, error)
+
+	// GetQueryTriggerById returns a query trigger by its id.
+	GetQueryTriggerById(id string) (*db.QueryTriggerModel, error)
+
+	// ListQueryTriggers returns the query triggers of a tenant.
+	ListQueryTriggers(tenantId string) ([]db.QueryTriggerModel, error)
+
+	// DeleteQueryTrigger deletes a query trigger of a tenant.
+	DeleteQueryTrigger(tenantId, id string) error
+
+	// ClaimQueryTriggersToRun returns the enabled triggers which are due, and claims them so that they are not
+	// returned to other tickers until their run is recorded or the claim expires.
+	ClaimQueryTriggersToRun(ctx context.Context, limit int) ([]*dbsqlc.QueryTrigger, error)
+
+	// UpdateQueryTriggerRun records a run of a trigger, which failed if runErr is set, and schedules its next run.
+	UpdateQueryTriggerRun(ctx context.Context, triggerId string, runErr error, nextRunAt time.Time) error
+}
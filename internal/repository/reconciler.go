@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+type RepairedRun struct {
+	TenantId      string
+	WorkflowRunId string
+
+	// the job run whose status was resolved, which is empty if only the status of the workflow run was resolved
+	JobRunId     string
+	JobRunStatus string
+
+	WorkflowRunStatus     string
+	WorkflowRunFinalState bool
+}
+
+type RepairedStepRun struct {
+	TenantId       string
+	WorkflowRunId  string
+	StepRunId      string
+	StepReadableId string
+
+	// the worker which the step run was assigned to, which is empty if the worker was deleted
+	PreviousWorkerId string
+}
+
+type RepairedGetGroupKeyRun struct {
+	TenantId          string
+	WorkflowRunId     string
+	GetGroupKeyRunId  string
+	WorkflowRunStatus string
+}
+
+// ReconcilerRepository repairs runs whose status is inconsistent, for example because an engine instance crashed
+// while it was updating them. Each method repairs up to limit runs across all tenants, and skips runs which are
+// locked by another instance.
+type ReconcilerRepository interface {
+	// RepairFinishedRuns resolves the status of job runs and workflow runs which are running although all of their
+	// step runs or job runs finished longer than the grace period ago.
+	RepairFinishedRuns(ctx context.Context, grace time.Duration, limit int) ([]*RepairedRun, error)
+
+	// RepairOrphanedStepRuns moves step runs which are assigned to a worker which was deleted, or which has not
+	// sent a heartbeat for longer than the timeout, back to pending assignment so that they are requeued.
+	RepairOrphanedStepRuns(ctx context.Context, timeout time.Duration, limit int) ([]*RepairedStepRun, error)
+
+	// RepairTimedOutGetGroupKeyRuns cancels get group key runs which are still waiting to be assigned for longer
+	// than the grace period after their schedule timeout, and fails their workflow runs.
+	RepairTimedOutGetGroupKeyRuns(ctx context.Context, grace time.Duration, limit int) ([]*RepairedGetGroupKeyRun, error)
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+type CreateRecurringTaskOpts struct {
+	// (required) the name of the task, which is unique within the tenant
+	Name string `validate:"required,hatchetName"`
+
+	// (required) the action which the task runs
+	Kind string `validate:"required,oneof=PURGE_WORKFLOW_RUNS EMIT_EVENT"`
+
+	// (required) the cron expression of the schedule of the task
+	Cron string `validate:"required"`
+
+	// (optional) the workflow whose finished runs are purged, for PURGE_WORKFLOW_RUNS tasks
+	WorkflowId *string `validate:"omitnil,uuid"`
+
+	// (optional) how long finished runs are kept before they're purged, for PURGE_WORKFLOW_RUNS tasks
+	RetentionSeconds *int `validate:"omitnil,min=1"`
+
+	// (optional) the key of the event which is emitted, for EMIT_EVENT tasks
+	EventKey *string `validate:"omitnil,min=1"`
+
+	// (optional) the data of the event which is emitted, for EMIT_EVENT tasks
+	EventData []byte
+
+	// (required) the time when the task runs first
+	NextRunAt time.Time `validate:"required"`
+}
+
+type RecurringTaskRepository interface {
+	// CreateRecurringTask creates a recurring task for a tenant.
+	CreateRecurringTask(tenantId string, opts *CreateRecurringTaskOpts) (*db.RecurringTaskModel, error)
+
+	// GetRecurringTaskById returns a recurring task by its id.
+	GetRecurringTaskById(id string) (*db.RecurringTaskModel, error)
+
+	// ListRecurringTasks returns the recurring tasks of a tenant.
+	ListRecurringTasks(tenantId string) ([]db.RecurringTaskModel, error)
+
+	// DeleteRecurringTask deletes a recurring task of a tenant.
+	DeleteRecurringTask(tenantId, id string) error
+
+	// ClaimRecurringTasksToRun returns the enabled tasks which are due, and claims them so that they are not
+	// returned to other tickers until their run is recorded or the claim expires.
+	ClaimRecurringTasksToRun(ctx context.Context, limit int) ([]*dbsqlc.RecurringTask, error)
+
+	// UpdateRecurringTaskRun records a run of a task, which failed if runErr is set, and schedules its next run.
+	UpdateRecurringTaskRun(ctx context.Context, taskId string, runErr error, nextRunAt time.Time) error
+
+	// PurgeWorkflowRuns soft-deletes up to limit runs of a workflow which finished more than the retention ago, and
+	// returns the number of runs which were purged. The purged runs are added to the hourly rollups of the workflow,
+	// so that its metrics remain accurate.
+	PurgeWorkflowRuns(ctx context.Context, tenantId, workflowId string, retention time.Duration, limit int) (int64, error)
+}
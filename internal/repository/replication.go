@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+// ErrReplicaPromoted is returned when replication log entries are applied to an instance which has been
+// promoted to a primary.
+var ErrReplicaPromoted = errors.New("the instance has been promoted to a primary")
+
+type ReplicationRepository interface {
+	// EnableReplicationLog attaches the triggers which record the mutations of the replicated tables in the
+	// replication log.
+	EnableReplicationLog(ctx context.Context) error
+
+	// DisableReplicationLog detaches the replication log triggers. Entries which were already recorded are kept.
+	DisableReplicationLog(ctx context.Context) error
+
+	// ListReplicationLogTables lists the tables whose mutations are recorded in the replication log.
+	ListReplicationLogTables(ctx context.Context) ([]string, error)
+
+	// GetReplicationLogStats returns the number of entries in the replication log and the id of the last one.
+	GetReplicationLogStats(ctx context.Context) (*dbsqlc.GetReplicationLogStatsRow, error)
+
+	// ListReplicationLogEntries lists the entries of the replication log after the given id, in order.
+	ListReplicationLogEntries(ctx context.Context, afterId int64, limit int) ([]*dbsqlc.ReplicationLogEntry, error)
+
+	// DeleteReplicationLogEntries deletes the entries of the replication log up to and including the given id.
+	DeleteReplicationLogEntries(ctx context.Context, lastEntryId int64) (int64, error)
+
+	// GetReplicationState returns the replication state of the instance, or nil if it has never been a standby.
+	GetReplicationState(ctx context.Context) (*dbsqlc.ReplicationState, error)
+
+	// ApplyReplicationLogEntries applies the entries of the primary's replication log in one transaction, and
+	// records the id of the last entry. It returns ErrReplicaPromoted if the instance has been promoted.
+	ApplyReplicationLogEntries(ctx context.Context, lastEntryId int64, entries []*dbsqlc.ReplicationLogEntry) (*dbsqlc.ReplicationState, error)
+
+	// PromoteReplica marks the standby as a primary, which stops the replicator from applying entries.
+	PromoteReplica(ctx context.Context) (*dbsqlc.ReplicationState, error)
+
+	// ListWorkflowRunsToRecover lists the workflow runs which were queued on the old primary.
+	ListWorkflowRunsToRecover(ctx context.Context) ([]*dbsqlc.ListWorkflowRunsToRecoverRow, error)
+
+	// ListJobRunsToRecover lists the job runs whose step runs may have been queued on the old primary.
+	ListJobRunsToRecover(ctx context.Context) ([]*dbsqlc.ListJobRunsToRecoverRow, error)
+
+	// MarkReplicaRecovered records that the runs which were queued on the old primary have been queued again.
+	MarkReplicaRecovered(ctx context.Context) (*dbsqlc.ReplicationState, error)
+}
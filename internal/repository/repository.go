@@ -3,8 +3,10 @@ package repository
 type Repository interface {
 	Health() HealthRepository
 	APIToken() APITokenRepository
+	IdempotencyKey() IdempotencyKeyRepository
 	Event() EventRepository
 	Log() LogsRepository
+	StreamEvent() StreamEventRepository
 	Tenant() TenantRepository
 	TenantInvite() TenantInviteRepository
 	Workflow() WorkflowRepository
@@ -13,10 +15,28 @@ type Repository interface {
 	StepRun() StepRunRepository
 	GetGroupKeyRun() GetGroupKeyRunRepository
 	Github() GithubRepository
+	Gitea() GiteaRepository
 	SNS() SNSRepository
+	LogSink() LogSinkRepository
+	EventBus() EventBusRepository
+	WebhookDelivery() WebhookDeliveryRepository
+	ObjectStorageFeed() ObjectStorageFeedRepository
+	QueryTrigger() QueryTriggerRepository
+	RecurringTask() RecurringTaskRepository
+	ClientCertificate() ClientCertificateRepository
+	PIIRule() PIIRuleRepository
+	SubjectDeletion() SubjectDeletionRepository
+	Reconciler() ReconcilerRepository
+	TenantExport() TenantExportRepository
+	FeatureFlag() FeatureFlagRepository
+	EngineSettings() EngineSettingsRepository
+	TriggerLink() TriggerLinkRepository
 	Step() StepRepository
 	Dispatcher() DispatcherRepository
 	Ticker() TickerRepository
+	LeaderLease() LeaderLeaseRepository
+	NamedLock() NamedLockRepository
+	Replication() ReplicationRepository
 	Worker() WorkerRepository
 	UserSession() UserSessionRepository
 	User() UserRepository
@@ -25,6 +25,19 @@ type ListStepRunsOpts struct {
 	Status *db.StepRunStatus
 }
 
+type ListCostCenterUsageOpts struct {
+	// the time bucket which the usage is grouped by
+	Bucket string `validate:"required,oneof=hour day week"`
+
+	Since time.Time `validate:"required"`
+
+	Until time.Time `validate:"required,gtfield=Since"`
+
+	// (optional) only the usage of this cost center is returned. Step runs without a cost center are returned
+	// with an empty cost center.
+	CostCenter *string
+}
+
 type UpdateStepRunOpts struct {
 	IsRerun bool
 
@@ -44,6 +57,8 @@ type UpdateStepRunOpts struct {
 
 	CancelledReason *string
 
+	CancelledSource *db.CancellationSource
+
 	Error *string
 
 	Input []byte
@@ -63,9 +78,65 @@ func StepRunStatusPtr(status db.StepRunStatus) *db.StepRunStatus {
 	return &status
 }
 
+func CancellationSourcePtr(source db.CancellationSource) *db.CancellationSource {
+	return &source
+}
+
+// CancellationSourceFromReason returns the source of a cancellation for the reason which a step run is cancelled
+// with, or nil if the source of the reason is unknown.
+func CancellationSourceFromReason(reason string) *db.CancellationSource {
+	switch reason {
+	case "CANCELLED_BY_CONCURRENCY_LIMIT":
+		return CancellationSourcePtr(db.CancellationSourceConcurrency)
+	case "CANCELLED_BY_USER", "CANCELLED_BY_PAUSE":
+		return CancellationSourcePtr(db.CancellationSourceUser)
+	case "TIMED_OUT", "SCHEDULING_TIMED_OUT":
+		return CancellationSourcePtr(db.CancellationSourceTimeout)
+	case "PREVIOUS_STEP_TIMED_OUT", "PREVIOUS_STEP_CANCELLED":
+		return CancellationSourcePtr(db.CancellationSourceParentCancelled)
+	case "JOIN_SATISFIED":
+		return CancellationSourcePtr(db.CancellationSourceJoinSatisfied)
+	case "BUDGET_EXCEEDED":
+		return CancellationSourcePtr(db.CancellationSourceBudgetExceeded)
+	default:
+		return nil
+	}
+}
+
 var ErrStepRunIsNotPending = fmt.Errorf("step run is not pending")
 var ErrNoWorkerAvailable = fmt.Errorf("no worker available")
 
+// StepRunAssignment is a step run which is being assigned to a worker.
+type StepRunAssignment struct {
+	StepRunId string
+
+	// Strategy is the assignment strategy of the workflow version of the step run, or of the tenant if the
+	// workflow version doesn't set one.
+	Strategy dbsqlc.WorkerAssignmentStrategy
+
+	// ParentWorkerLabels are the labels of the workers which ran the parents of the step run.
+	ParentWorkerLabels []map[string]string
+}
+
+// WorkerCandidate is a worker which a step run can be assigned to.
+type WorkerCandidate struct {
+	WorkerId     string
+	DispatcherId string
+	Labels       map[string]string
+
+	// Load is the number of step runs which are assigned to or running on the worker.
+	Load int
+
+	// LastAssignedAt is the time at which a step run was last assigned to the worker, or nil if none was.
+	LastAssignedAt *time.Time
+}
+
+// WorkerSelector selects the worker which a step run is assigned to from the workers which can run it. It returns
+// nil if none of the candidates should be selected.
+type WorkerSelector interface {
+	SelectWorker(stepRun *StepRunAssignment, candidates []*WorkerCandidate) *WorkerCandidate
+}
+
 type StepRunUpdateInfo struct {
 	JobRunFinalState      bool
 	WorkflowRunFinalState bool
@@ -86,6 +157,30 @@ type StepRunRepository interface {
 	// ListStepRunsToReassign returns a list of step runs which are in a reassignable state.
 	ListStepRunsToReassign(tenantId string) ([]*dbsqlc.StepRun, error)
 
+	// ListStepRunQueues returns the number of step runs pending assignment, grouped by tenant and action. This
+	// is an instance-wide query.
+	ListStepRunQueues() ([]*dbsqlc.ListStepRunQueuesRow, error)
+
+	// ListStepRunPhaseMetrics returns the p50 and p95 latency in milliseconds of each phase of the step runs for a
+	// tenant which finished since the given time, grouped by action.
+	ListStepRunPhaseMetrics(tenantId string, since time.Time) ([]*dbsqlc.ListStepRunPhaseMetricsRow, error)
+
+	// ListCostCenterUsage returns the compute seconds and the number of step runs and workflow runs of a tenant
+	// which finished in the time range, grouped by cost center and time bucket.
+	ListCostCenterUsage(tenantId string, opts *ListCostCenterUsageOpts) ([]*dbsqlc.ListCostCenterUsageRow, error)
+
+	// ListStepRunsWaitingForWorker returns the number of step runs of a tenant which are waiting for a worker, and
+	// when the earliest of them started waiting, grouped by action.
+	ListStepRunsWaitingForWorker(tenantId string) ([]*dbsqlc.ListStepRunsWaitingForWorkerRow, error)
+
+	// MarkStepRunsWaitingForWorker sets the sub-status of up to limit step runs, across all tenants, which haven't
+	// found a worker with a free slot for longer than the threshold to waiting for a worker, and returns them.
+	MarkStepRunsWaitingForWorker(ctx context.Context, threshold time.Duration, limit int) ([]*dbsqlc.MarkStepRunsWaitingForWorkerRow, error)
+
+	// ListCancellableStepRuns returns the ids of the step runs which should be cancelled to cancel the unfinished
+	// runs of a tenant, or of one of its workflows if workflowId is set.
+	ListCancellableStepRuns(ctx context.Context, tenantId string, workflowId *string) ([]string, error)
+
 	UpdateStepRun(ctx context.Context, tenantId, stepRunId string, opts *UpdateStepRunOpts) (*dbsqlc.GetStepRunForEngineRow, *StepRunUpdateInfo, error)
 
 	// UpdateStepRunOverridesData updates the overrides data field in the input for a step run. This returns the input
@@ -94,21 +189,51 @@ type StepRunRepository interface {
 
 	UpdateStepRunInputSchema(tenantId, stepRunId string, schema []byte) ([]byte, error)
 
-	AssignStepRunToWorker(tenantId, stepRunId string) (workerId string, dispatcherId string, err error)
+	// UpdateStepRunTimeoutAt sets the time at which an assigned or running step run times out. This returns
+	// pgx.ErrNoRows if the step run is no longer assigned or running.
+	UpdateStepRunTimeoutAt(tenantId, stepRunId string, timeoutAt time.Time) (*time.Time, error)
+
+	// ReleaseStepRun moves a running step run which its worker released back to pending assignment, so that it is
+	// requeued after the given time. This returns pgx.ErrNoRows if the worker is no longer running the step run.
+	ReleaseStepRun(ctx context.Context, tenantId, stepRunId, workerId string, requeueAfter time.Time) (*dbsqlc.ReleaseStepRunRow, error)
+
+	// AssignStepRunToWorker assigns a step run to the worker which the selector selects from the workers which can
+	// run it. This returns ErrNoWorkerAvailable if there is no such worker.
+	AssignStepRunToWorker(tenantId, stepRunId string, selector WorkerSelector) (workerId string, dispatcherId string, err error)
 	AssignStepRunToTicker(tenantId, stepRunId string) (tickerId string, err error)
 
 	GetStepRunById(tenantId, stepRunId string) (*db.StepRunModel, error)
 	GetStepRunForEngine(tenantId, stepRunId string) (*dbsqlc.GetStepRunForEngineRow, error)
 
+	// GetWorkerForStepRunTimeoutHook returns the on-timeout action of the step of a step run, and a random active
+	// worker which registered it. This returns pgx.ErrNoRows if the step has no on-timeout action, and an invalid
+	// worker id if no active worker registered it.
+	GetWorkerForStepRunTimeoutHook(ctx context.Context, tenantId, stepRunId string) (*dbsqlc.GetWorkerForStepRunTimeoutHookRow, error)
+
+	// GetStepRunCorrelationId returns the correlation id of the request which triggered the workflow run of a step
+	// run, or an empty string if it has none.
+	GetStepRunCorrelationId(ctx context.Context, tenantId, stepRunId string) (string, error)
+
 	// QueueStepRun is like UpdateStepRun, except that it will only update the step run if it is in
 	// a pending state.
 	QueueStepRun(ctx context.Context, tenantId, stepRunId string, opts *UpdateStepRunOpts) (*dbsqlc.GetStepRunForEngineRow, error)
 
 	ListStartableStepRuns(tenantId, jobRunId string, parentStepRunId *string) ([]*dbsqlc.GetStepRunForEngineRow, error)
 
+	// ListSupersededStepRuns returns the ids of the unfinished step runs which are no longer needed because the join
+	// step run with the given id started.
+	ListSupersededStepRuns(ctx context.Context, tenantId, joinStepRunId string) ([]string, error)
+
 	ArchiveStepRunResult(tenantId, stepRunId string) error
 
 	ListArchivedStepRunResults(tenantId, stepRunId string) ([]db.StepRunResultArchiveModel, error)
 
 	GetFirstArchivedStepRunResult(tenantId, stepRunId string) (*db.StepRunResultArchiveModel, error)
+
+	// CreateStepRunDiagnostic captures the diagnostics of the latest attempt of a step run, with the traceparent of
+	// the span which captured it. It returns nil if the workflow run of the step run wasn't sampled.
+	CreateStepRunDiagnostic(ctx context.Context, tenantId, stepRunId, traceParent string) (*dbsqlc.StepRunDiagnostic, error)
+
+	// ListStepRunDiagnostics returns the captured diagnostics of the attempts of a step run, newest first.
+	ListStepRunDiagnostics(tenantId, stepRunId string) ([]*dbsqlc.StepRunDiagnostic, error)
 }
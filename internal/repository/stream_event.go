@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+// ErrStepRunNotRunning is returned when a stream event is put for a step run which isn't running.
+var ErrStepRunNotRunning = errors.New("step run is not running")
+
+type CreateStreamEventOpts struct {
+	// The step run id
+	StepRunId string `validate:"required,uuid"`
+
+	// (optional) The time when the stream event was created.
+	CreatedAt *time.Time
+
+	// (required) The message of the stream event.
+	Message string `validate:"required,min=1,max=65536"`
+}
+
+type ListStreamEventsOpts struct {
+	// (optional) only return the stream events after the given index
+	After *int `validate:"omitnil,min=0"`
+
+	// (optional) number of stream events to return
+	Limit *int `validate:"omitnil,min=1,max=1000"`
+}
+
+type StreamEventRepository interface {
+	// PutStreamEvent creates a new stream event for a running step run, at the end of its output stream.
+	PutStreamEvent(ctx context.Context, tenantId string, opts *CreateStreamEventOpts) (*dbsqlc.CreateStreamEventRow, error)
+
+	// ListStreamEvents returns the stream events of the latest attempt of a step run, ordered by index.
+	ListStreamEvents(ctx context.Context, tenantId, stepRunId string, opts *ListStreamEventsOpts) ([]*dbsqlc.StreamEvent, error)
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+)
+
+type DeleteSubjectOpts struct {
+	// (required) the run metadata key which identifies the subject, like customer_id
+	Key string `validate:"required,max=256"`
+
+	// (required) the value of the key for the subject
+	Value string `validate:"required,max=1024"`
+
+	// (required) the maximum number of workflow runs which are deleted at once
+	Limit int `validate:"required,min=1"`
+
+	// (optional) whether the deletion is only reported, and nothing is deleted
+	DryRun bool
+}
+
+type SubjectDeletionReport struct {
+	// the workflow runs of the subject which were scrubbed
+	WorkflowRunIds []string
+
+	// the workflow runs of the subject which are still running, and were skipped
+	SkippedWorkflowRunIds []string
+
+	// whether the subject has more workflow runs than the limit
+	HasMore bool
+
+	// the number of records which were scrubbed or deleted
+	WorkflowRunTriggers   int64
+	Events                int64
+	JobRunLookupData      int64
+	GetGroupKeyRuns       int64
+	StepRuns              int64
+	StepRunResultArchives int64
+	LogLines              int64
+	StreamEvents          int64
+}
+
+type SubjectDeletionRepository interface {
+	// DeleteSubject scrubs the inputs, outputs, errors and metadata of the finished workflow runs of a tenant whose
+	// run metadata has the value of the key, along with the events which triggered them, and deletes their logs and
+	// streamed events. The workflow runs and their step runs are kept.
+	DeleteSubject(ctx context.Context, tenantId string, opts *DeleteSubjectOpts) (*SubjectDeletionReport, error)
+}
@@ -1,6 +1,12 @@
 package repository
 
-import "github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
 
 type CreateTenantOpts struct {
 	// (required) the tenant name
@@ -13,6 +19,23 @@ type CreateTenantOpts struct {
 	ID *string `validate:"omitempty,uuid"`
 }
 
+type UpdateTenantOpts struct {
+	// (optional) the tenant name
+	Name *string `validate:"omitempty,min=1"`
+
+	// (optional) whether ingestion of new events is paused for the tenant
+	IngestionPaused *bool
+
+	// (optional) the redaction rules of the tenant, which replace the existing rules. Rules are unchanged when nil.
+	RedactionRules []string `validate:"omitempty,max=100,dive,required,max=256"`
+
+	// (optional) the strategy for assigning step runs to workers, which is used for workflows which don't set one
+	AssignmentStrategy *string `validate:"omitempty,oneof=RANDOM LEAST_LOADED ROUND_ROBIN LOCALITY"`
+
+	// (optional) the health of the dependencies of the tenant as a JSON object, which replaces the existing health
+	DependencyHealth []byte
+}
+
 type CreateTenantMemberOpts struct {
 	Role   string `validate:"required,oneof=OWNER ADMIN MEMBER"`
 	UserId string `validate:"required,uuid"`
@@ -29,6 +52,25 @@ type TenantRepository interface {
 	// ListTenants lists all tenants in the instance
 	ListTenants() ([]db.TenantModel, error)
 
+	// ListTenantsWithRunCounts lists all tenants in the instance along with the number of workflow runs
+	// per status which were created after the given time.
+	ListTenantsWithRunCounts(createdAfter time.Time) ([]*dbsqlc.ListTenantsWithRunCountsRow, error)
+
+	// GetTenantQueueDepth returns the number of workflow runs and step runs of the tenant which are waiting
+	// to be processed.
+	GetTenantQueueDepth(tenantId string) (*dbsqlc.GetTenantQueueDepthRow, error)
+
+	// UpdateTenant updates the tenant with the given id
+	UpdateTenant(tenantId string, opts *UpdateTenantOpts) (*db.TenantModel, error)
+
+	// PauseTenant pauses all workflows of the tenant. New triggers are rejected or buffered based on the trigger
+	// behavior, and runs which are queued while the tenant is paused are buffered.
+	PauseTenant(ctx context.Context, tenantId string, opts *PauseOpts) error
+
+	// ResumeTenant lifts the pause of the tenant, and returns the buffered workflow runs which were released and
+	// should be queued. Runs of workflows which are still paused stay buffered.
+	ResumeTenant(ctx context.Context, tenantId string) ([]*dbsqlc.WorkflowRun, error)
+
 	// GetTenantByID returns the tenant with the given id
 	GetTenantByID(tenantId string) (*db.TenantModel, error)
 
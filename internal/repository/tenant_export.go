@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+type UpdateTenantExportOpts struct {
+	Status *db.TenantExportStatus
+
+	SectionsDone *int
+
+	SectionsTotal *int
+
+	RecordCount *int
+
+	Error *string
+
+	StartedAt *time.Time
+
+	FinishedAt *time.Time
+}
+
+func TenantExportStatusPtr(status db.TenantExportStatus) *db.TenantExportStatus {
+	return &status
+}
+
+type CompleteTenantExportOpts struct {
+	// (optional) the gzipped tar archive of the export, which is stored in the database. Required unless the
+	// archive was written to the object store.
+	Archive []byte `validate:"required_without=ArchiveKey"`
+
+	// (optional) the key of the archive in the object store. If set, the archive was written to the object store
+	// and is not stored in the database.
+	ArchiveKey *string
+
+	// (required) the size of the archive in bytes
+	ArchiveSize int64 `validate:"required,min=1"`
+
+	// (required) the hex-encoded SHA-256 of the archive
+	ArchiveSHA256 string `validate:"required,len=64"`
+
+	// (required) the time after which the export and its archive are deleted
+	ExpiresAt time.Time `validate:"required"`
+}
+
+type ListEventsForTenantExportOpts struct {
+	// (optional) only return events which come after this cursor
+	After *TenantExportCursor
+
+	// (required) the number of events to return
+	Limit int `validate:"required,min=1,max=1000"`
+}
+
+// TenantExportCursor identifies a position in a list of records which are exported in (createdAt, id) order.
+type TenantExportCursor struct {
+	CreatedAt time.Time
+
+	ID string `validate:"required,uuid"`
+}
+
+type TenantExportRepository interface {
+	// CreateTenantExport creates a new pending export of a tenant.
+	CreateTenantExport(tenantId string) (*db.TenantExportModel, error)
+
+	// GetTenantExportById returns an export by its id.
+	GetTenantExportById(exportId string) (*db.TenantExportModel, error)
+
+	// ListTenantExports returns the exports of a tenant, newest first.
+	ListTenantExports(tenantId string) ([]db.TenantExportModel, error)
+
+	// UpdateTenantExport updates the status and progress of an export.
+	UpdateTenantExport(tenantId, exportId string, opts *UpdateTenantExportOpts) (*db.TenantExportModel, error)
+
+	// CompleteTenantExport stores the archive of an export, unless it was written to the object store, and marks the
+	// export as succeeded.
+	CompleteTenantExport(ctx context.Context, exportId string, opts *CompleteTenantExportOpts) error
+
+	// GetTenantExportArchive returns the archive of an export which succeeded, if the archive is stored in the
+	// database.
+	GetTenantExportArchive(exportId string) ([]byte, error)
+
+	// DeleteExpiredTenantExports deletes the exports which expired together with the archives which are stored in
+	// the database. It returns how many exports were deleted, and the keys of their archives in the object store,
+	// which the caller deletes.
+	DeleteExpiredTenantExports(ctx context.Context) (int64, []string, error)
+
+	ListWorkflowsForTenantExport(ctx context.Context, tenantId string) ([]*dbsqlc.ListWorkflowsForTenantExportRow, error)
+
+	ListWorkflowVersionsForTenantExport(ctx context.Context, tenantId string) ([]*dbsqlc.ListWorkflowVersionsForTenantExportRow, error)
+
+	ListStepsForTenantExport(ctx context.Context, tenantId string) ([]*dbsqlc.ListStepsForTenantExportRow, error)
+
+	// ListStepRunsForTenantExport returns the step runs of a page of workflow runs.
+	ListStepRunsForTenantExport(ctx context.Context, tenantId string, workflowRunIds []string) ([]*dbsqlc.ListStepRunsForTenantExportRow, error)
+
+	// ListEventsForTenantExport returns a page of events in (createdAt, id) order, starting after the given cursor.
+	ListEventsForTenantExport(ctx context.Context, tenantId string, opts *ListEventsForTenantExportOpts) ([]*dbsqlc.ListEventsForTenantExportRow, error)
+
+	// ListAPITokensForTenantExport returns the metadata of the API tokens of a tenant.
+	ListAPITokensForTenantExport(ctx context.Context, tenantId string) ([]*dbsqlc.ListAPITokensForTenantExportRow, error)
+}
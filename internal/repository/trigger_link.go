@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/encryption"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+type CreateTriggerLinkOpts struct {
+	// (required) the name of the link, which is unique within the workflow
+	Name string `validate:"required,hatchetName"`
+
+	// (required) the sha256 hash of the token of the link
+	TokenHash string `validate:"required,len=64"`
+
+	// (optional) the input which the body of every trigger request is merged onto
+	DefaultInput []byte
+}
+
+// NewTriggerLinkCreateOpts generates the token of a new trigger link. The token is returned so that it can be
+// shown once, and only its hash is stored.
+func NewTriggerLinkCreateOpts(name string, defaultInput []byte) (opts *CreateTriggerLinkOpts, token string, err error) {
+	token, err = encryption.GenerateRandomBytes(32)
+
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate trigger link token: %w", err)
+	}
+
+	opts = &CreateTriggerLinkOpts{
+		Name:         name,
+		TokenHash:    HashTriggerLinkToken(token),
+		DefaultInput: defaultInput,
+	}
+
+	return opts, token, nil
+}
+
+// HashTriggerLinkToken returns the hash of a trigger link token which is stored in the database.
+func HashTriggerLinkToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(hash[:])
+}
+
+type TriggerLinkRepository interface {
+	// CreateTriggerLink creates a trigger link for a workflow.
+	CreateTriggerLink(tenantId, workflowId string, opts *CreateTriggerLinkOpts) (*db.WorkflowTriggerLinkModel, error)
+
+	// GetTriggerLinkById returns a trigger link by its id.
+	GetTriggerLinkById(id string) (*db.WorkflowTriggerLinkModel, error)
+
+	// ListTriggerLinks returns the trigger links of a workflow.
+	ListTriggerLinks(tenantId, workflowId string) ([]db.WorkflowTriggerLinkModel, error)
+
+	// UpdateTriggerLinkTriggered sets the time at which a trigger link last triggered a run.
+	UpdateTriggerLinkTriggered(id string, triggeredAt time.Time) error
+
+	// DeleteTriggerLink deletes a trigger link of a tenant, after which its token can no longer trigger runs.
+	DeleteTriggerLink(tenantId, id string) error
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+// ErrDuplicateWebhookDelivery is returned when a webhook already received a delivery with the same nonce.
+var ErrDuplicateWebhookDelivery = errors.New("webhook delivery was already received")
+
+type CreateWebhookDeliveryOpts struct {
+	// (required) the source of the webhook
+	Source dbsqlc.WebhookDeliverySource `validate:"required,oneof=GITHUB GITEA"`
+
+	// (required) the id of the webhook which received the delivery
+	WebhookId string `validate:"required,uuid"`
+
+	// (optional) the id of the delivery which was assigned by the source
+	DeliveryId *string
+
+	// (required) the event type of the delivery
+	Event string `validate:"required"`
+
+	// (required) the nonce of the delivery, which is unique for each delivery of the webhook
+	Nonce string `validate:"required"`
+
+	// (optional) the time the delivery was sent at
+	SentAt *time.Time
+
+	// (required) the status of the delivery
+	Status dbsqlc.WebhookDeliveryStatus `validate:"required,oneof=RECEIVED PROCESSED FAILED REJECTED"`
+
+	// (optional) the reason the delivery failed or was rejected
+	Error *string
+
+	// whether deliveries with a nonce which the webhook already received are rejected
+	ReplayProtection bool
+}
+
+type ListWebhookDeliveriesOpts struct {
+	// (optional) the id of the webhook to list deliveries for
+	WebhookId *string `validate:"omitempty,uuid"`
+
+	// (optional) the number of deliveries to return
+	Limit *int `validate:"omitnil,min=1,max=1000"`
+}
+
+type WebhookDeliveryRepository interface {
+	// CreateWebhookDelivery records a delivery of a webhook. With replay protection, it returns
+	// ErrDuplicateWebhookDelivery if the webhook already received a delivery with the same nonce which did not fail.
+	CreateWebhookDelivery(ctx context.Context, tenantId string, opts *CreateWebhookDeliveryOpts) (*dbsqlc.WebhookDelivery, error)
+
+	// UpdateWebhookDeliveryStatus records the result of processing a delivery.
+	UpdateWebhookDeliveryStatus(ctx context.Context, id string, status dbsqlc.WebhookDeliveryStatus, errMsg *string) error
+
+	// ListWebhookDeliveries returns the most recent deliveries of the webhooks of a tenant, newest first.
+	ListWebhookDeliveries(ctx context.Context, tenantId string, opts *ListWebhookDeliveriesOpts) ([]*dbsqlc.WebhookDelivery, error)
+
+	// DeleteExpiredWebhookDeliveries deletes the deliveries of a webhook which were received before the given time,
+	// and returns the number of deleted deliveries.
+	DeleteExpiredWebhookDeliveries(ctx context.Context, webhookId string, before time.Time) (int64, error)
+}
@@ -14,6 +14,28 @@ type CreateWorkerOpts struct {
 	// The maximum number of runs this worker can run at a time
 	MaxRuns *int `validate:"omitempty,gte=1"`
 
+	// The number of cpu cores this worker advertises
+	Cpu *float64 `validate:"omitempty,gt=0"`
+
+	// The memory in megabytes this worker advertises
+	MemoryMb *int `validate:"omitempty,gte=1"`
+
+	// The number of gpus this worker advertises
+	Gpu *int `validate:"omitempty,gte=1"`
+
+	// (optional) The environment of the worker, which is set by the token the worker registers with
+	Environment *string `validate:"omitnil,hatchetName"`
+
+	// (optional) The build id of the worker's code, which runs of workflows with build pinning are pinned to
+	BuildId *string `validate:"omitnil,max=255"`
+
+	// (optional) The labels of the worker, which the locality assignment strategy matches against the labels of
+	// the workers which ran the parents of a step run
+	Labels map[string]string `validate:"max=50,dive,keys,max=255,endkeys,max=255"`
+
+	// (optional) The version of the dispatcher protocol which the worker agreed on, which defaults to 1
+	ProtocolVersion *int `validate:"omitnil,gte=1"`
+
 	// The name of the worker
 	Name string `validate:"required,hatchetName"`
 
@@ -43,12 +65,23 @@ type WorkerWithStepCount struct {
 	StepRunCount int
 }
 
+// UnackedAssignments are the actions which were assigned to a worker but which the worker hasn't started yet.
+type UnackedAssignments struct {
+	// The ids of the step runs which are assigned to the worker
+	StepRunIds []string
+
+	// The ids of the workflow runs whose get group key runs are assigned to the worker
+	WorkflowRunIds []string
+}
+
 type ListWorkersOpts struct {
 	Action *string `validate:"omitempty,actionId"`
 
 	LastHeartbeatAfter *time.Time
 
 	Assignable *bool
+
+	Environment *string
 }
 
 type WorkerRepository interface {
@@ -70,6 +103,10 @@ type WorkerRepository interface {
 	// GetWorkerById returns a worker by its id.
 	GetWorkerById(workerId string) (*db.WorkerModel, error)
 
+	// ListUnackedAssignments lists the step runs and get group key runs which are assigned to the worker but
+	// which the worker hasn't started yet, oldest first.
+	ListUnackedAssignments(tenantId, workerId string) (*UnackedAssignments, error)
+
 	GetWorkerForEngine(tenantId, workerId string) (*dbsqlc.GetWorkerForEngineRow, error)
 
 	// AddStepRun assigns a step run to a worker.
@@ -9,11 +9,12 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/digest"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/anomaly"
 )
 
 type CreateWorkflowVersionOpts struct {
-	// (required) the workflow name
-	Name string `validate:"required,hatchetName"`
+	// (required) the workflow name, which may be prefixed with a namespace
+	Name string `validate:"required,hatchetQualifiedName"`
 
 	Tags []CreateWorkflowTagOpts `validate:"dive"`
 
@@ -40,11 +41,43 @@ type CreateWorkflowVersionOpts struct {
 
 	// (optional) the amount of time for step runs to wait to be scheduled before timing out
 	ScheduleTimeout *string `validate:"omitempty,duration"`
+
+	// (optional) the expected maximum duration of a workflow run, after which the run breaches its SLA. This
+	// is omitted from the checksum when it isn't set, so that existing workflow versions keep their checksum.
+	SLA *string `json:"SLA,omitempty" validate:"omitempty,duration"`
+
+	// (optional) the input values which are deep-merged with the input of every run, serialized as a JSON object
+	DefaultInput *string `json:"defaultInput,omitempty" validate:"omitnil,json"`
+
+	// (optional) a JSON schema which the merged input of every run is validated against
+	InputSchema *string `json:"inputSchema,omitempty" validate:"omitnil,json"`
+
+	// (optional) whether runs are pinned to the build id of the worker which starts them, so that the
+	// remaining step runs of a run are only assigned to workers of the same build
+	PinToBuild *bool `json:"pinToBuild,omitempty"`
+
+	// (optional) the strategy for assigning the step runs of the workflow to workers, which overrides the strategy
+	// of the tenant
+	AssignmentStrategy *string `json:"assignmentStrategy,omitempty" validate:"omitnil,oneof=RANDOM LEAST_LOADED ROUND_ROBIN LOCALITY"`
+
+	// (optional) the maximum number of step runs a run may queue, including retries, after which the run fails
+	MaxStepExecutions *int32 `json:"maxStepExecutions,omitempty" validate:"omitnil,min=1"`
+
+	// (optional) the maximum number of retries of the step runs of a run, after which the run fails
+	MaxStepRetries *int32 `json:"maxStepRetries,omitempty" validate:"omitnil,min=1"`
+
+	// (optional) the cost center which the compute of the runs of the workflow is attributed to
+	CostCenter *string `json:"costCenter,omitempty" validate:"omitnil,hatchetName,max=64"`
 }
 
 type CreateWorkflowConcurrencyOpts struct {
-	// (required) the action id for getting the concurrency group
-	Action string `validate:"required,actionId"`
+	// (optional) the action id for getting the concurrency group, required if there are no key expressions
+	Action string `validate:"required_without=KeyExpressions,omitempty,actionId"`
+
+	// (optional) the expressions which the engine evaluates to compute the components of the concurrency group,
+	// instead of running the action. This is omitted from the checksum when it isn't set, so that existing workflow
+	// versions keep their checksum.
+	KeyExpressions []string `json:"keyExpressions,omitempty" validate:"omitempty,excluded_with=Action,max=4,dive,required"`
 
 	// (optional) the maximum number of concurrent workflow runs, default 1
 	MaxRuns *int32
@@ -116,6 +149,54 @@ type CreateWorkflowStepOpts struct {
 
 	// (optional) the step retry max
 	Retries *int `validate:"omitempty,min=0"`
+
+	// (optional) the number of cpu cores which a worker must advertise to run the step
+	Cpu *float64 `validate:"omitempty,gt=0"`
+
+	// (optional) the memory in megabytes which a worker must advertise to run the step
+	MemoryMb *int `validate:"omitempty,gte=1"`
+
+	// (optional) the number of gpus which a worker must advertise to run the step
+	Gpu *int `validate:"omitempty,gte=1"`
+
+	// (optional) the amount of time a worker may take to finish a step run after it was cancelled, for example 30s
+	CancelGracePeriod *string `validate:"omitnil,duration"`
+
+	// (optional) the maximum duration of a step run when workers extend its timeout, for example 2h
+	MaxTimeout *string `validate:"omitnil,duration"`
+
+	// (optional) the checks of the dependencies of the step, which must pass before a step run is assigned
+	PreflightChecks []CreateStepPreflightCheckOpts `validate:"dive"`
+
+	// (optional) how many parents must succeed before a step run starts, one of ALL, ANY or QUORUM
+	JoinStrategy *string `validate:"omitnil,oneof=ALL ANY QUORUM"`
+
+	// (optional) the number of parents which must succeed before a step run starts, for the QUORUM join strategy
+	JoinQuorum *int `validate:"omitnil,gte=1"`
+
+	// (optional) the amount of time for step runs to wait to be scheduled before timing out, which overrides the
+	// schedule timeout of the workflow. This is omitted from the checksum when it isn't set, so that existing
+	// workflow versions keep their checksum.
+	ScheduleTimeout *string `json:"scheduleTimeout,omitempty" validate:"omitnil,duration"`
+
+	// (optional) the action id of a handler which runs on any worker which registered it when a step run times out,
+	// with the input of the step run. This is omitted from the checksum when it isn't set.
+	OnTimeout *string `json:"onTimeout,omitempty" validate:"omitnil,actionId"`
+
+	// (optional) the cost center which the compute of the step runs is attributed to, which overrides the cost
+	// center of the workflow. This is omitted from the checksum when it isn't set.
+	CostCenter *string `json:"costCenter,omitempty" validate:"omitnil,hatchetName,max=64"`
+}
+
+type CreateStepPreflightCheckOpts struct {
+	// (required) the kind of the check, one of HTTP, TCP or EXPRESSION
+	Kind string `validate:"required,oneof=HTTP TCP EXPRESSION"`
+
+	// (required) the URL of HTTP checks, the host:port address of TCP checks, or the expression of EXPRESSION checks
+	Target string `validate:"required"`
+
+	// (optional) the timeout of HTTP and TCP checks, for example 5s
+	Timeout *string `validate:"omitnil,duration"`
 }
 
 type ListWorkflowsOpts struct {
@@ -127,6 +208,24 @@ type ListWorkflowsOpts struct {
 
 	// (optional) the event key to filter by
 	EventKey *string
+
+	// (optional) the environment to filter by. A workflow belongs to every environment which has a worker
+	// that registered one of its actions.
+	Environment *string
+
+	// (optional) the namespace to filter by, which only returns the workflows whose names have the namespace
+	Namespace *string
+}
+
+type ListScheduledWorkflowsOpts struct {
+	// (optional) number of scheduled workflows to skip
+	Offset *int
+
+	// (optional) number of scheduled workflows to return
+	Limit *int
+
+	// (optional) the workflow id to filter by
+	WorkflowId *string `validate:"omitempty,uuid"`
 }
 
 type ListWorkflowsRow struct {
@@ -160,6 +259,41 @@ type UpsertWorkflowDeploymentConfigOpts struct {
 
 	// (required) the github repository branch
 	GitRepoBranch string `validate:"required"`
+
+	// (optional) whether the workflow provisions or tears down the preview environments of pull requests on the
+	// repository
+	PreviewEnvironmentHook *db.PreviewEnvironmentHook
+}
+
+type UpsertWorkflowRolloutOpts struct {
+	// (required) the percentage of triggers which run the new version while it is rolled out
+	Percentage int `validate:"min=0,max=100"`
+
+	// (required) the failure rate of the new version, between 0 and 1, above which the rollout is rolled back
+	FailureRateThreshold float64 `validate:"min=0,max=1"`
+
+	// (required) the number of seconds over which the failure rate of the new version is computed
+	WindowSeconds int `validate:"min=1"`
+
+	// (required) the minimum number of finished runs of the new version in the window before the failure
+	// rate is checked
+	MinRuns int `validate:"min=1"`
+}
+
+type UpdateWorkflowDiagnosticsOpts struct {
+	// (required) the percentage of the runs of the workflow which capture extended diagnostics
+	SampleRate int `validate:"min=0,max=100"`
+}
+
+type CreateWorkflowMaintenanceWindowOpts struct {
+	// (required) the name of the window, which is unique within the workflow
+	Name string `validate:"required,hatchetName"`
+
+	// (required) the cron expression, in UTC, of the start of each window
+	Cron string `validate:"required,cron"`
+
+	// (required) the duration of each window in seconds
+	DurationSeconds int `validate:"min=1"`
 }
 
 type WorkflowRepository interface {
@@ -180,6 +314,10 @@ type WorkflowRepository interface {
 	// GetScheduledById returns a scheduled workflow by its id.
 	GetScheduledById(tenantId, scheduleTriggerId string) (*db.WorkflowTriggerScheduledRefModel, error)
 
+	// ListScheduledWorkflows returns the scheduled workflow triggers for a given tenant, ordered by
+	// the time they will be triggered.
+	ListScheduledWorkflows(tenantId string, opts *ListScheduledWorkflowsOpts) ([]db.WorkflowTriggerScheduledRefModel, error)
+
 	// GetWorkflowById returns a workflow by its name. It will return db.ErrNotFound if the workflow does not exist.
 	GetWorkflowById(workflowId string) (*db.WorkflowModel, error)
 
@@ -198,4 +336,74 @@ type WorkflowRepository interface {
 	DeleteWorkflow(tenantId, workflowId string) (*db.WorkflowModel, error)
 
 	UpsertWorkflowDeploymentConfig(workflowId string, opts *UpsertWorkflowDeploymentConfigOpts) (*db.WorkflowDeploymentConfigModel, error)
+
+	// ListPreviewEnvironmentWorkflows returns the workflows of a tenant which are linked to the given Github
+	// repository with the given preview environment hook.
+	ListPreviewEnvironmentWorkflows(tenantId, repoOwner, repoName string, hook db.PreviewEnvironmentHook) ([]db.WorkflowModel, error)
+
+	// GetWorkflowRollout returns the rollout policy of a workflow. It will return db.ErrNotFound if the workflow
+	// does not have a rollout policy.
+	GetWorkflowRollout(workflowId string) (*db.WorkflowRolloutModel, error)
+
+	// UpsertWorkflowRollout sets the rollout policy of a workflow. The policy applies to the next version of the
+	// workflow, or to the version which is being rolled out. Setting the percentage of an active rollout to 100
+	// completes the rollout.
+	UpsertWorkflowRollout(tenantId, workflowId string, opts *UpsertWorkflowRolloutOpts) (*db.WorkflowRolloutModel, error)
+
+	// DeleteWorkflowRollout deletes the rollout policy of a workflow, after which the latest version receives
+	// all of the triggers.
+	DeleteWorkflowRollout(workflowId string) (*db.WorkflowRolloutModel, error)
+
+	// ResolveWorkflowVersions returns the versions which should be triggered in place of the given latest
+	// versions, in the same order, based on the rollout policies of their workflows.
+	ResolveWorkflowVersions(ctx context.Context, tenantId string, workflowVersionIds []string) ([]string, error)
+
+	// RollBackFailingWorkflowRollouts rolls back the active rollouts whose new version exceeded the failure
+	// rate threshold, and returns the rollouts which were rolled back.
+	RollBackFailingWorkflowRollouts(ctx context.Context) ([]*dbsqlc.RollBackFailingWorkflowRolloutsRow, error)
+
+	// ListWorkflowAnomalyWindows returns the runs of each workflow which finished in a window, together with the
+	// baseline of the workflow, for at most limit workflows whose baseline hasn't learned from the window yet.
+	ListWorkflowAnomalyWindows(ctx context.Context, windowStart, windowEnd time.Time, limit int) ([]*dbsqlc.ListWorkflowAnomalyWindowsRow, error)
+
+	// UpsertWorkflowAnomalyBaseline sets the baseline of a workflow once it has learned from the window which ends
+	// at windowEnd. It returns nil if the baseline has already learned from the window.
+	UpsertWorkflowAnomalyBaseline(ctx context.Context, tenantId, workflowId string, windowEnd time.Time, baseline *anomaly.Baseline) (*dbsqlc.WorkflowAnomalyBaseline, error)
+
+	// PauseWorkflow pauses a workflow. New triggers are rejected or buffered based on the trigger behavior, and
+	// runs which are queued while the workflow is paused are buffered.
+	PauseWorkflow(ctx context.Context, tenantId, workflowId string, opts *PauseOpts) error
+
+	// ResumeWorkflow lifts the pause of a workflow, and returns the buffered workflow runs of the tenant which
+	// were released and should be queued.
+	ResumeWorkflow(ctx context.Context, tenantId, workflowId string) ([]*dbsqlc.WorkflowRun, error)
+
+	// CreateWorkflowMaintenanceWindow creates a recurring maintenance window for a workflow.
+	CreateWorkflowMaintenanceWindow(tenantId, workflowId string, opts *CreateWorkflowMaintenanceWindowOpts) (*db.WorkflowMaintenanceWindowModel, error)
+
+	// GetWorkflowMaintenanceWindowById returns a maintenance window by its id.
+	GetWorkflowMaintenanceWindowById(id string) (*db.WorkflowMaintenanceWindowModel, error)
+
+	// ListWorkflowMaintenanceWindows returns the maintenance windows of a workflow.
+	ListWorkflowMaintenanceWindows(workflowId string) ([]db.WorkflowMaintenanceWindowModel, error)
+
+	// DeleteWorkflowMaintenanceWindow deletes a maintenance window. A window which is in progress still ends at
+	// its scheduled end.
+	DeleteWorkflowMaintenanceWindow(id string) error
+
+	// ListAllWorkflowMaintenanceWindows returns the maintenance windows of all workflows. This is an
+	// instance-wide query.
+	ListAllWorkflowMaintenanceWindows(ctx context.Context) ([]*dbsqlc.WorkflowMaintenanceWindow, error)
+
+	// StartWorkflowMaintenance buffers the runs of a workflow until the given time, unless the workflow is
+	// already in maintenance until a later time.
+	StartWorkflowMaintenance(ctx context.Context, workflowId string, until time.Time) error
+
+	// EndWorkflowMaintenance ends the maintenance of the workflows whose maintenance window has ended, and
+	// returns the buffered workflow runs which were released and should be queued.
+	EndWorkflowMaintenance(ctx context.Context) ([]*dbsqlc.WorkflowRun, error)
+
+	// UpdateWorkflowDiagnostics sets the percentage of the new runs of a workflow which are sampled to capture
+	// extended diagnostics.
+	UpdateWorkflowDiagnostics(workflowId string, opts *UpdateWorkflowDiagnosticsOpts) (*db.WorkflowModel, error)
 }
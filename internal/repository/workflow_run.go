@@ -3,12 +3,17 @@ package repository
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/datautils/merge"
 	"github.com/hatchet-dev/hatchet/internal/encryption"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/schema"
 )
 
 type CreateWorkflowRunOpts struct {
@@ -30,19 +35,99 @@ type CreateWorkflowRunOpts struct {
 	// (optional) the scheduled trigger
 	ScheduledWorkflowId *string `validate:"omitnil,uuid,required_without=ManualTriggerInput,required_without=TriggeringEventId,required_without=Cron,excluded_with=ManualTriggerInput,excluded_with=TriggeringEventId,excluded_with=Cron"`
 
+	// (optional) the environment of the workflow run. The step runs of the workflow run are only assigned to
+	// workers of the same environment.
+	Environment *string `validate:"omitnil,hatchetName"`
+
 	InputData []byte
 
 	TriggeredBy string
 
+	// (optional) the metadata of the run, a JSON object which is passed to every step run and the get group key run
+	AdditionalMetadata []byte
+
+	// (optional) the correlation id of the request which triggered the run
+	CorrelationId *string
+
 	GetGroupKeyRun *CreateGroupKeyRunOpts `validate:"omitempty"`
+
+	// (optional) the run which is replayed. Replays are debug runs.
+	Replay *CreateWorkflowRunReplayOpts `validate:"omitempty"`
+
+	// (optional) the state of an exported run which is restored. Restored runs are debug runs, and they are
+	// created in their final state, so they are never queued.
+	Restore *CreateWorkflowRunRestoreOpts `validate:"omitempty"`
 }
 
 type CreateGroupKeyRunOpts struct {
 	// (optional) the input data
 	Input []byte
+
+	// (optional) the amount of time for the get group key run to wait to be scheduled before timing out, which is
+	// the schedule timeout of the workflow version
+	ScheduleTimeout *string
+}
+
+type CreateWorkflowRunReplayOpts struct {
+	// (required) the id of the replayed workflow run, which must have the same workflow version
+	WorkflowRunId string `validate:"required,uuid"`
+
+	// (optional) the step to start the replay from. The step runs which succeeded in the replayed run are
+	// copied, except for this step and the steps after it.
+	StartFromStepId *string `validate:"omitnil,uuid"`
+}
+
+type CreateWorkflowRunRestoreOpts struct {
+	// (required) the final status of the restored run
+	Status string `validate:"required,oneof=SUCCEEDED FAILED"`
+
+	// (optional) the error of the restored run
+	Error *string
+
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+
+	// (optional) the step runs of the restored run. Step runs which are not given stay pending.
+	StepRuns []CreateWorkflowRunRestoreStepRunOpts `validate:"dive"`
+}
+
+type CreateWorkflowRunRestoreStepRunOpts struct {
+	// (required) the name of the job of the step
+	JobName string `validate:"required"`
+
+	// (required) the readable id of the step
+	StepReadableId string `validate:"required"`
+
+	// (required) the status of the step run, which cannot be in progress
+	Status string `validate:"required,oneof=PENDING SUCCEEDED FAILED CANCELLED"`
+
+	Input  []byte
+	Output []byte
+
+	Error           *string
+	CancelledReason *string
+
+	StartedAt   *time.Time
+	FinishedAt  *time.Time
+	CancelledAt *time.Time
+
+	RetryCount int `validate:"min=0"`
 }
 
+// ErrInvalidWorkflowRunInput is returned when the input of a workflow run doesn't match the input schema of
+// its workflow version.
+var ErrInvalidWorkflowRunInput = errors.New("invalid workflow run input")
+
 func GetCreateWorkflowRunOptsFromManual(workflowVersion *db.WorkflowVersionModel, input []byte) (*CreateWorkflowRunOpts, error) {
+	defaultInput, _ := workflowVersion.DefaultInput()
+	inputSchema, _ := workflowVersion.InputSchema()
+
+	input, err := withInputDefaults(defaultInput, inputSchema, input)
+
+	if err != nil {
+		return nil, err
+	}
+
 	opts := &CreateWorkflowRunOpts{
 		DisplayName:        StringPtr(getWorkflowRunDisplayName(workflowVersion.Workflow().Name)),
 		WorkflowVersionId:  workflowVersion.ID,
@@ -54,7 +139,8 @@ func GetCreateWorkflowRunOptsFromManual(workflowVersion *db.WorkflowVersionModel
 	if input != nil {
 		if _, hasConcurrency := workflowVersion.Concurrency(); hasConcurrency {
 			opts.GetGroupKeyRun = &CreateGroupKeyRunOpts{
-				Input: input,
+				Input:           input,
+				ScheduleTimeout: &workflowVersion.ScheduleTimeout,
 			}
 		}
 	}
@@ -65,25 +151,35 @@ func GetCreateWorkflowRunOptsFromManual(workflowVersion *db.WorkflowVersionModel
 func GetCreateWorkflowRunOptsFromEvent(event *dbsqlc.GetEventForEngineRow, workflowVersion *dbsqlc.GetWorkflowVersionForEngineRow) (*CreateWorkflowRunOpts, error) {
 	eventId := sqlchelpers.UUIDToStr(event.ID)
 
+	input, err := withInputDefaults(workflowVersion.WorkflowVersion.DefaultInput, workflowVersion.WorkflowVersion.InputSchema, event.Data)
+
+	if err != nil {
+		return nil, err
+	}
+
 	opts := &CreateWorkflowRunOpts{
 		DisplayName:       StringPtr(getWorkflowRunDisplayName(workflowVersion.WorkflowName)),
 		WorkflowVersionId: sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersion.ID),
 		TriggeringEventId: &eventId,
 		TriggeredBy:       string(datautils.TriggeredByEvent),
-		InputData:         event.Data,
+		InputData:         input,
+	}
+
+	// runs which are triggered by an event belong to the environment of the event
+	if event.Environment.Valid {
+		opts.Environment = &event.Environment.String
 	}
 
-	if event.Data != nil {
+	if input != nil {
 		if workflowVersion.HasWorkflowConcurrency {
 			opts.GetGroupKeyRun = &CreateGroupKeyRunOpts{
-				Input: event.Data,
+				Input:           input,
+				ScheduleTimeout: &workflowVersion.WorkflowVersion.ScheduleTimeout,
 			}
 		}
 	}
 
-	var err error
-
-	return opts, err
+	return opts, nil
 }
 
 func GetCreateWorkflowRunOptsFromCron(cron, cronParentId string, workflowVersion *db.WorkflowVersionModel) (*CreateWorkflowRunOpts, error) {
@@ -95,9 +191,18 @@ func GetCreateWorkflowRunOptsFromCron(cron, cronParentId string, workflowVersion
 		TriggeredBy:       string(datautils.TriggeredByCron),
 	}
 
-	var err error
+	defaultInput, _ := workflowVersion.DefaultInput()
+	inputSchema, _ := workflowVersion.InputSchema()
+
+	input, err := withInputDefaults(defaultInput, inputSchema, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	opts.InputData = input
 
-	return opts, err
+	return opts, nil
 }
 
 func GetCreateWorkflowRunOptsFromSchedule(scheduledTrigger *db.WorkflowTriggerScheduledRefModel, workflowVersion *db.WorkflowVersionModel) (*CreateWorkflowRunOpts, error) {
@@ -111,21 +216,74 @@ func GetCreateWorkflowRunOptsFromSchedule(scheduledTrigger *db.WorkflowTriggerSc
 	data := scheduledTrigger.InnerWorkflowTriggerScheduledRef.Input
 	var jobRunData []byte
 
-	var err error
-
 	if data != nil {
 		jobRunData = []byte(json.RawMessage(*data))
+	}
+
+	defaultInput, _ := workflowVersion.DefaultInput()
+	inputSchema, _ := workflowVersion.InputSchema()
+
+	jobRunData, err := withInputDefaults(defaultInput, inputSchema, jobRunData)
+
+	if err != nil {
+		return nil, err
+	}
 
+	if jobRunData != nil {
 		if _, hasConcurrency := workflowVersion.Concurrency(); hasConcurrency {
 			opts.GetGroupKeyRun = &CreateGroupKeyRunOpts{
-				Input: jobRunData,
+				Input:           jobRunData,
+				ScheduleTimeout: &workflowVersion.ScheduleTimeout,
 			}
 		}
 	}
 
 	opts.InputData = jobRunData
 
-	return opts, err
+	return opts, nil
+}
+
+// withInputDefaults deep-merges the default input of a workflow version with the input of a run, where the
+// input of the run takes precedence, and validates the merged input against the input schema of the version.
+// A key which is set to null in the input of the run removes the default.
+func withInputDefaults(defaultInput, inputSchema, input []byte) ([]byte, error) {
+	if len(defaultInput) > 0 {
+		defaults := map[string]interface{}{}
+
+		if err := json.Unmarshal(defaultInput, &defaults); err != nil {
+			return nil, fmt.Errorf("could not unmarshal default input: %w", err)
+		}
+
+		inputMap := map[string]interface{}{}
+
+		if len(input) > 0 {
+			if err := json.Unmarshal(input, &inputMap); err != nil {
+				return nil, fmt.Errorf("%w: input must be a JSON object", ErrInvalidWorkflowRunInput)
+			}
+		}
+
+		merged, err := json.Marshal(merge.MergeMaps(defaults, inputMap))
+
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal merged input: %w", err)
+		}
+
+		input = merged
+	}
+
+	if len(inputSchema) > 0 {
+		data := input
+
+		if len(data) == 0 {
+			data = []byte("{}")
+		}
+
+		if err := schema.Validate(inputSchema, data); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidWorkflowRunInput, err.Error())
+		}
+	}
+
+	return input, nil
 }
 
 func getWorkflowRunDisplayName(workflowName string) string {
@@ -150,6 +308,15 @@ type ListWorkflowRunsOpts struct {
 	// (optional) the status of the workflow run
 	Status *db.WorkflowRunStatus
 
+	// (optional) only return workflow runs created at or after this time
+	CreatedAfter *time.Time
+
+	// (optional) the source of the cancellation which caused the workflow run to fail
+	CancelledSource *db.CancellationSource
+
+	// (optional) the environment of the workflow run
+	Environment *string
+
 	// (optional) number of events to skip
 	Offset *int
 
@@ -168,6 +335,137 @@ type ListWorkflowRunsResult struct {
 	Count int
 }
 
+type ListWorkflowRunsForExportOpts struct {
+	// (optional) the workflow id
+	WorkflowId *string `validate:"omitempty,uuid"`
+
+	// (optional) the status of the workflow run
+	Status *db.WorkflowRunStatus
+
+	// (optional) only return runs created at or after this time
+	CreatedAfter *time.Time
+
+	// (optional) only return runs created before this time
+	CreatedBefore *time.Time
+
+	// (optional) only return runs which come after this cursor
+	After *WorkflowRunExportCursor
+
+	// (required) the number of runs to return
+	Limit int `validate:"required,min=1,max=1000"`
+}
+
+// WorkflowRunExportCursor identifies a position in a workflow run export. Runs are exported in
+// (createdAt, id) order, so the pair is stable even when new runs are created during the export.
+type WorkflowRunExportCursor struct {
+	CreatedAt time.Time
+
+	ID string `validate:"required,uuid"`
+}
+
+// WorkflowRunBulkRetryFilter selects the failed workflow runs which a bulk retry re-queues. It is
+// stored on the bulk retry so the job can be resumed and inspected later.
+type WorkflowRunBulkRetryFilter struct {
+	// (optional) the workflow id
+	WorkflowId *string `json:"workflowId,omitempty" validate:"omitempty,uuid"`
+
+	// (optional) only retry runs created at or after this time
+	CreatedAfter *time.Time `json:"createdAfter,omitempty"`
+
+	// (optional) only retry runs created before this time
+	CreatedBefore *time.Time `json:"createdBefore,omitempty"`
+
+	// (optional) only retry runs with a workflow run or step run error containing this substring
+	ErrorContains *string `json:"errorContains,omitempty" validate:"omitempty,min=1,max=255"`
+}
+
+type CreateWorkflowRunBulkRetryOpts struct {
+	// (required) the filter for the runs to retry
+	Filter WorkflowRunBulkRetryFilter
+}
+
+type UpdateWorkflowRunBulkRetryOpts struct {
+	Status *db.WorkflowRunBulkRetryStatus
+
+	MatchedCount *int `validate:"omitempty,min=0"`
+
+	RetriedCount *int `validate:"omitempty,min=0"`
+
+	FailedCount *int `validate:"omitempty,min=0"`
+
+	Error *string
+
+	StartedAt *time.Time
+
+	FinishedAt *time.Time
+}
+
+func WorkflowRunBulkRetryStatusPtr(status db.WorkflowRunBulkRetryStatus) *db.WorkflowRunBulkRetryStatus {
+	return &status
+}
+
+type ListFailedWorkflowRunsForRetryOpts struct {
+	// (required) the filter for the runs to retry
+	Filter WorkflowRunBulkRetryFilter
+
+	// (required) only return runs created before this time, which is normally the time the bulk
+	// retry was created
+	Before time.Time `validate:"required"`
+
+	// (optional) only return runs which come after this cursor
+	After *WorkflowRunExportCursor
+
+	// (required) the number of runs to return
+	Limit int `validate:"required,min=1,max=1000"`
+}
+
+type ListWorkflowRunSLABreachesOpts struct {
+	// (optional) number of breaches to skip
+	Offset *int
+
+	// (optional) number of breaches to return
+	Limit *int
+}
+
+type ListWorkflowRunSLABreachesResult struct {
+	Rows  []*dbsqlc.ListWorkflowRunSLABreachesRow
+	Count int
+}
+
+type ListWorkflowRunMetricsOpts struct {
+	// the time bucket which the metrics are grouped by
+	Bucket string `validate:"required,oneof=hour day"`
+
+	Since time.Time `validate:"required"`
+
+	Until time.Time `validate:"required,gtfield=Since"`
+
+	// (optional) only the metrics of this workflow are returned
+	WorkflowId *string `validate:"omitempty,uuid"`
+}
+
+type GetWorkflowRunOpts struct {
+	// (optional) whether to fetch the job runs and step runs
+	StepRuns bool
+
+	// (optional) whether to fetch the event which triggered the workflow run
+	Events bool
+
+	// (optional) whether to fetch the logs of each step run, which implies StepRuns
+	Logs bool
+
+	// (optional) whether to fetch the worker of each step run, which implies StepRuns
+	Workers bool
+}
+
+type AddWorkflowRunBudgetUsageOpts struct {
+	// (optional) the number of step runs which were queued, including retries
+	StepExecutions int32 `validate:"min=0"`
+
+	// (optional) the number of step runs which are retried
+	StepRetries int32 `validate:"min=0"`
+}
+
 type CreateWorkflowRunPullRequestOpts struct {
 	RepositoryOwner       string
 	RepositoryName        string
@@ -204,14 +502,72 @@ type WorkflowRunRepository interface {
 	// ListWorkflowRuns returns workflow runs for a given workflow version id.
 	ListWorkflowRuns(tenantId string, opts *ListWorkflowRunsOpts) (*ListWorkflowRunsResult, error)
 
+	// ListWorkflowRunsForExport returns a page of workflow runs in (createdAt, id) order, starting after
+	// the given cursor.
+	ListWorkflowRunsForExport(ctx context.Context, tenantId string, opts *ListWorkflowRunsForExportOpts) ([]*dbsqlc.ListWorkflowRunsForExportRow, error)
+
+	// ListWorkflowRunsForConcurrencySimulation returns up to limit runs of a workflow which were created after the
+	// given time, along with their input, in creation order.
+	ListWorkflowRunsForConcurrencySimulation(ctx context.Context, tenantId, workflowId string, createdAfter time.Time, limit int) ([]*dbsqlc.ListWorkflowRunsForConcurrencySimulationRow, error)
+
+	// ListWorkflowConcurrencyQueueDepth returns the number of queued and running runs of a workflow by concurrency
+	// group key, at position 0, and by each component of the key, at the position of its key expression starting
+	// from 1. For each position, up to limit values with the most queued runs are returned.
+	ListWorkflowConcurrencyQueueDepth(ctx context.Context, tenantId, workflowId string, limit int) ([]*dbsqlc.ListWorkflowConcurrencyQueueDepthRow, error)
+
 	PopWorkflowRunsRoundRobin(tenantId, workflowVersionId string, maxRuns int) ([]*dbsqlc.WorkflowRun, error)
 
-	// CreateNewWorkflowRun creates a new workflow run for a workflow version.
+	// ListFailedWorkflowRunsForRetry returns a page of failed workflow runs matching a bulk retry filter,
+	// in (createdAt, id) order.
+	ListFailedWorkflowRunsForRetry(ctx context.Context, tenantId string, opts *ListFailedWorkflowRunsForRetryOpts) ([]*dbsqlc.ListFailedWorkflowRunsForRetryRow, error)
+
+	// CreateWorkflowRunBulkRetry creates a new pending bulk retry.
+	CreateWorkflowRunBulkRetry(tenantId string, opts *CreateWorkflowRunBulkRetryOpts) (*db.WorkflowRunBulkRetryModel, error)
+
+	// GetWorkflowRunBulkRetryById returns a bulk retry by id.
+	GetWorkflowRunBulkRetryById(tenantId, bulkRetryId string) (*db.WorkflowRunBulkRetryModel, error)
+
+	// UpdateWorkflowRunBulkRetry updates the status and progress of a bulk retry.
+	UpdateWorkflowRunBulkRetry(tenantId, bulkRetryId string, opts *UpdateWorkflowRunBulkRetryOpts) (*db.WorkflowRunBulkRetryModel, error)
+
+	// CreateWorkflowRunSLABreaches records a breach for up to limit workflow runs, across all tenants, which
+	// exceeded the SLA of their workflow version, and returns the new breaches. A run breaches its SLA at most once.
+	CreateWorkflowRunSLABreaches(ctx context.Context, limit int) ([]*dbsqlc.CreateWorkflowRunSLABreachesRow, error)
+
+	// ListWorkflowRunSLABreaches returns the SLA breaches of a workflow, most recent first.
+	ListWorkflowRunSLABreaches(tenantId, workflowId string, opts *ListWorkflowRunSLABreachesOpts) (*ListWorkflowRunSLABreachesResult, error)
+
+	// ListWorkflowRunMetrics returns the number of runs of a tenant which succeeded and failed in the time range, and
+	// their total duration, grouped by workflow and time bucket. Purged runs are included from their hourly rollups.
+	ListWorkflowRunMetrics(tenantId string, opts *ListWorkflowRunMetricsOpts) ([]*dbsqlc.ListWorkflowRunMetricsRow, error)
+
+	// CreateNewWorkflowRun creates a new workflow run for a workflow version. It returns ErrWorkflowPaused if the
+	// workflow or its tenant is paused and rejects triggers.
 	CreateNewWorkflowRun(ctx context.Context, tenantId string, opts *CreateWorkflowRunOpts) (*db.WorkflowRunModel, error)
 
 	// GetWorkflowRunById returns a workflow run by id.
 	GetWorkflowRunById(tenantId, runId string) (*db.WorkflowRunModel, error)
 
+	// BufferWorkflowRunIfPaused holds back a workflow run if its workflow or tenant is paused, or its workflow is in
+	// a maintenance window, until the pause is lifted or the window ends. It returns whether the run was buffered.
+	BufferWorkflowRunIfPaused(ctx context.Context, tenantId, workflowRunId string) (bool, error)
+
+	// AddWorkflowRunBudgetUsage adds step executions and retries to the usage of a workflow run. It returns nil if
+	// the workflow version of the run has no budget, in which case the usage of the run isn't tracked.
+	AddWorkflowRunBudgetUsage(ctx context.Context, tenantId, workflowRunId string, opts *AddWorkflowRunBudgetUsageOpts) (*dbsqlc.AddWorkflowRunBudgetUsageRow, error)
+
+	// UpdateWorkflowRunConcurrencyGroupComponents sets the values of the key expressions of the concurrency settings
+	// of a workflow run, which are combined into its concurrency group key.
+	UpdateWorkflowRunConcurrencyGroupComponents(ctx context.Context, tenantId, workflowRunId string, components []string) error
+
+	// GetWorkflowRun returns a workflow run by id, only fetching the requested relations. The workflow
+	// version, get group key run and triggered by relations are always fetched.
+	GetWorkflowRun(tenantId, runId string, opts *GetWorkflowRunOpts) (*db.WorkflowRunModel, error)
+
+	// GetWorkflowRunLastUpdatedAt returns the latest update time of a workflow run, its job runs, step runs
+	// and logs, without hydrating the run.
+	GetWorkflowRunLastUpdatedAt(ctx context.Context, tenantId, runId string) (time.Time, error)
+
 	CreateWorkflowRunPullRequest(tenantId, workflowRunId string, opts *CreateWorkflowRunPullRequestOpts) (*db.GithubPullRequestModel, error)
 
 	ListPullRequestsForWorkflowRun(tenantId, workflowRunId string, opts *ListPullRequestsForWorkflowRunOpts) ([]db.GithubPullRequestModel, error)
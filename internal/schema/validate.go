@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// Compile checks that the given bytes are a valid JSON schema.
+func Compile(schemaBytes []byte) error {
+	_, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(schemaBytes))
+
+	if err != nil {
+		return fmt.Errorf("invalid JSON schema: %w", err)
+	}
+
+	return nil
+}
+
+// Validate validates the given JSON data against a JSON schema, and returns an error which lists every
+// violation if the data doesn't match the schema.
+func Validate(schemaBytes, data []byte) error {
+	s, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(schemaBytes))
+
+	if err != nil {
+		return fmt.Errorf("invalid JSON schema: %w", err)
+	}
+
+	res, err := s.Validate(gojsonschema.NewBytesLoader(data))
+
+	if err != nil {
+		return fmt.Errorf("could not validate data: %w", err)
+	}
+
+	if res.Valid() {
+		return nil
+	}
+
+	violations := make([]string, 0, len(res.Errors()))
+
+	for _, resErr := range res.Errors() {
+		violations = append(violations, resErr.String())
+	}
+
+	return fmt.Errorf("%s", strings.Join(violations, "; "))
+}
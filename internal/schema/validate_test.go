@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	schemaBytes := []byte(`{
+		"type": "object",
+		"properties": {
+			"region": {"type": "string"},
+			"retries": {"type": "integer", "minimum": 0}
+		},
+		"required": ["region"]
+	}`)
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			data: `{"region":"us-east-1","retries":3}`,
+		},
+		{
+			name:    "missing required property",
+			data:    `{"retries":3}`,
+			wantErr: true,
+		},
+		{
+			name:    "wrong type",
+			data:    `{"region":"us-east-1","retries":"3"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(schemaBytes, []byte(tt.data))
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCompile(t *testing.T) {
+	if err := Compile([]byte(`{"type":"object"}`)); err != nil {
+		t.Errorf("Compile() returned an error for a valid schema: %v", err)
+	}
+
+	if err := Compile([]byte(`{"type":"not-a-type"}`)); err == nil {
+		t.Errorf("Compile() returned no error for an invalid schema")
+	}
+}
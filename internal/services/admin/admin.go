@@ -3,9 +3,15 @@ package admin
 import (
 	"fmt"
 
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/logger"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/backpressure"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/features"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/limits"
 )
 
 type AdminService interface {
@@ -17,6 +23,10 @@ type AdminServiceImpl struct {
 
 	repo repository.Repository
 	mq   msgqueue.MessageQueue
+	bp   backpressure.Checker
+	ff   features.Flags
+	pl   *limits.PayloadLimits
+	l    *zerolog.Logger
 }
 
 type AdminServiceOpt func(*AdminServiceOpts)
@@ -24,10 +34,18 @@ type AdminServiceOpt func(*AdminServiceOpts)
 type AdminServiceOpts struct {
 	repo repository.Repository
 	mq   msgqueue.MessageQueue
+	bp   backpressure.Checker
+	ff   features.Flags
+	pl   *limits.PayloadLimits
+	l    *zerolog.Logger
 }
 
 func defaultAdminServiceOpts() *AdminServiceOpts {
-	return &AdminServiceOpts{}
+	logger := logger.NewDefaultLogger("admin")
+
+	return &AdminServiceOpts{
+		l: &logger,
+	}
 }
 
 func WithRepository(r repository.Repository) AdminServiceOpt {
@@ -42,6 +60,36 @@ func WithMessageQueue(mq msgqueue.MessageQueue) AdminServiceOpt {
 	}
 }
 
+// WithBackpressure sets the checker which adds backpressure hints to triggers, and rejects them when the
+// engine is shedding load. If not set, triggers are always accepted.
+func WithBackpressure(bp backpressure.Checker) AdminServiceOpt {
+	return func(opts *AdminServiceOpts) {
+		opts.bp = bp
+	}
+}
+
+// WithFeatureFlags sets the flags which decide whether triggers of a tenant are shed. If not set, all behaviors
+// are enabled.
+func WithFeatureFlags(ff features.Flags) AdminServiceOpt {
+	return func(opts *AdminServiceOpts) {
+		opts.ff = ff
+	}
+}
+
+// WithPayloadLimits sets the limits which the inputs of triggered and scheduled workflow runs are checked against.
+// If not set, inputs are not limited.
+func WithPayloadLimits(pl *limits.PayloadLimits) AdminServiceOpt {
+	return func(opts *AdminServiceOpts) {
+		opts.pl = pl
+	}
+}
+
+func WithLogger(l *zerolog.Logger) AdminServiceOpt {
+	return func(opts *AdminServiceOpts) {
+		opts.l = l
+	}
+}
+
 func NewAdminService(fs ...AdminServiceOpt) (AdminService, error) {
 	opts := defaultAdminServiceOpts()
 
@@ -60,5 +108,9 @@ func NewAdminService(fs ...AdminServiceOpt) (AdminService, error) {
 	return &AdminServiceImpl{
 		repo: opts.repo,
 		mq:   opts.mq,
+		bp:   opts.bp,
+		ff:   opts.ff,
+		pl:   opts.pl,
+		l:    opts.l,
 	}, nil
 }
@@ -0,0 +1,76 @@
+package admin
+
+import (
+	"strconv"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/backpressure"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/features"
+)
+
+// ErrorReasonTriggerShed is the reason of the error info which is attached to triggers that are rejected
+// because the engine is shedding load.
+const ErrorReasonTriggerShed = "TRIGGER_SHED"
+
+// checkBackpressure returns the backpressure hint for the tenant, and an error with status ResourceExhausted if
+// the trigger should be rejected.
+func (a *AdminServiceImpl) checkBackpressure(tenantId string, priority bool) (*backpressure.Hint, error) {
+	if a.bp == nil {
+		return nil, nil
+	}
+
+	hint, err := a.bp.Check(tenantId)
+
+	if err != nil {
+		// backpressure is best-effort, so the trigger is accepted if the queue depth can't be checked
+		a.l.Warn().Err(err).Msg("could not check backpressure")
+		return nil, nil
+	}
+
+	if hint.Reject(priority) && (a.ff == nil || a.ff.Enabled(tenantId, features.TriggerShedding)) {
+		return hint, shedError(hint)
+	}
+
+	return hint, nil
+}
+
+func shedError(hint *backpressure.Hint) error {
+	st := status.New(codes.ResourceExhausted, "the queue of the tenant is backed up, so only priority triggers are accepted")
+
+	withDetails, err := st.WithDetails(
+		&errdetails.RetryInfo{
+			RetryDelay: durationpb.New(time.Duration(hint.RetryAfterSeconds()) * time.Second),
+		},
+		&errdetails.ErrorInfo{
+			Reason: ErrorReasonTriggerShed,
+			Domain: "hatchet.run",
+			Metadata: map[string]string{
+				"queueDepth": strconv.FormatInt(hint.QueueDepth, 10),
+			},
+		},
+	)
+
+	if err != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
+
+func setBackpressureHint(res *contracts.TriggerWorkflowResponse, hint *backpressure.Hint) {
+	if hint == nil {
+		return
+	}
+
+	queueDepth := hint.QueueDepth
+	retryAfterSeconds := hint.RetryAfterSeconds()
+
+	res.QueueDepth = &queueDepth
+	res.RetryAfterSeconds = &retryAfterSeconds
+}
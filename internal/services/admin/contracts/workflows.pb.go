@@ -127,15 +127,23 @@ type CreateWorkflowVersionOpts struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name              string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                    // (required) the workflow name
-	Description       string                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                                      // (optional) the workflow description
-	Version           string                   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`                                              // (required) the workflow version
-	EventTriggers     []string                 `protobuf:"bytes,4,rep,name=event_triggers,json=eventTriggers,proto3" json:"event_triggers,omitempty"`             // (optional) event triggers for the workflow
-	CronTriggers      []string                 `protobuf:"bytes,5,rep,name=cron_triggers,json=cronTriggers,proto3" json:"cron_triggers,omitempty"`                // (optional) cron triggers for the workflow
-	ScheduledTriggers []*timestamppb.Timestamp `protobuf:"bytes,6,rep,name=scheduled_triggers,json=scheduledTriggers,proto3" json:"scheduled_triggers,omitempty"` // (optional) scheduled triggers for the workflow
-	Jobs              []*CreateWorkflowJobOpts `protobuf:"bytes,7,rep,name=jobs,proto3" json:"jobs,omitempty"`                                                    // (required) the workflow jobs
-	Concurrency       *WorkflowConcurrencyOpts `protobuf:"bytes,8,opt,name=concurrency,proto3" json:"concurrency,omitempty"`                                      // (optional) the workflow concurrency options
-	ScheduleTimeout   *string                  `protobuf:"bytes,9,opt,name=schedule_timeout,json=scheduleTimeout,proto3,oneof" json:"schedule_timeout,omitempty"` // (optional) the timeout for the schedule
+	Name               string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                              // (required) the workflow name
+	Description        string                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                                                // (optional) the workflow description
+	Version            string                   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`                                                        // (required) the workflow version
+	EventTriggers      []string                 `protobuf:"bytes,4,rep,name=event_triggers,json=eventTriggers,proto3" json:"event_triggers,omitempty"`                       // (optional) event triggers for the workflow
+	CronTriggers       []string                 `protobuf:"bytes,5,rep,name=cron_triggers,json=cronTriggers,proto3" json:"cron_triggers,omitempty"`                          // (optional) cron triggers for the workflow
+	ScheduledTriggers  []*timestamppb.Timestamp `protobuf:"bytes,6,rep,name=scheduled_triggers,json=scheduledTriggers,proto3" json:"scheduled_triggers,omitempty"`           // (optional) scheduled triggers for the workflow
+	Jobs               []*CreateWorkflowJobOpts `protobuf:"bytes,7,rep,name=jobs,proto3" json:"jobs,omitempty"`                                                              // (required) the workflow jobs
+	Concurrency        *WorkflowConcurrencyOpts `protobuf:"bytes,8,opt,name=concurrency,proto3" json:"concurrency,omitempty"`                                                // (optional) the workflow concurrency options
+	ScheduleTimeout    *string                  `protobuf:"bytes,9,opt,name=schedule_timeout,json=scheduleTimeout,proto3,oneof" json:"schedule_timeout,omitempty"`           // (optional) the timeout for the schedule
+	Sla                *string                  `protobuf:"bytes,10,opt,name=sla,proto3,oneof" json:"sla,omitempty"`                                                         // (optional) the expected maximum duration of a workflow run
+	DefaultInput       *string                  `protobuf:"bytes,11,opt,name=default_input,json=defaultInput,proto3,oneof" json:"default_input,omitempty"`                   // (optional) the default input, assuming string representation of a JSON object, which is deep-merged with the input of every run
+	InputSchema        *string                  `protobuf:"bytes,12,opt,name=input_schema,json=inputSchema,proto3,oneof" json:"input_schema,omitempty"`                      // (optional) a JSON schema which the merged input of every run is validated against
+	PinToBuild         *bool                    `protobuf:"varint,13,opt,name=pin_to_build,json=pinToBuild,proto3,oneof" json:"pin_to_build,omitempty"`                      // (optional) whether runs are pinned to the build id of the worker which starts them
+	AssignmentStrategy *string                  `protobuf:"bytes,14,opt,name=assignment_strategy,json=assignmentStrategy,proto3,oneof" json:"assignment_strategy,omitempty"` // (optional) the strategy for assigning step runs to workers, which overrides the strategy of the tenant
+	MaxStepExecutions  *int32                   `protobuf:"varint,15,opt,name=max_step_executions,json=maxStepExecutions,proto3,oneof" json:"max_step_executions,omitempty"` // (optional) the maximum number of step runs a run may queue, including retries, after which the run fails
+	MaxStepRetries     *int32                   `protobuf:"varint,16,opt,name=max_step_retries,json=maxStepRetries,proto3,oneof" json:"max_step_retries,omitempty"`          // (optional) the maximum number of retries of the step runs of a run, after which the run fails
+	CostCenter         *string                  `protobuf:"bytes,17,opt,name=cost_center,json=costCenter,proto3,oneof" json:"cost_center,omitempty"`                         // (optional) the cost center which the compute of the runs of the workflow is attributed to
 }
 
 func (x *CreateWorkflowVersionOpts) Reset() {
@@ -233,14 +241,71 @@ func (x *CreateWorkflowVersionOpts) GetScheduleTimeout() string {
 	return ""
 }
 
+func (x *CreateWorkflowVersionOpts) GetSla() string {
+	if x != nil && x.Sla != nil {
+		return *x.Sla
+	}
+	return ""
+}
+
+func (x *CreateWorkflowVersionOpts) GetDefaultInput() string {
+	if x != nil && x.DefaultInput != nil {
+		return *x.DefaultInput
+	}
+	return ""
+}
+
+func (x *CreateWorkflowVersionOpts) GetInputSchema() string {
+	if x != nil && x.InputSchema != nil {
+		return *x.InputSchema
+	}
+	return ""
+}
+
+func (x *CreateWorkflowVersionOpts) GetPinToBuild() bool {
+	if x != nil && x.PinToBuild != nil {
+		return *x.PinToBuild
+	}
+	return false
+}
+
+func (x *CreateWorkflowVersionOpts) GetAssignmentStrategy() string {
+	if x != nil && x.AssignmentStrategy != nil {
+		return *x.AssignmentStrategy
+	}
+	return ""
+}
+
+func (x *CreateWorkflowVersionOpts) GetMaxStepExecutions() int32 {
+	if x != nil && x.MaxStepExecutions != nil {
+		return *x.MaxStepExecutions
+	}
+	return 0
+}
+
+func (x *CreateWorkflowVersionOpts) GetMaxStepRetries() int32 {
+	if x != nil && x.MaxStepRetries != nil {
+		return *x.MaxStepRetries
+	}
+	return 0
+}
+
+func (x *CreateWorkflowVersionOpts) GetCostCenter() string {
+	if x != nil && x.CostCenter != nil {
+		return *x.CostCenter
+	}
+	return ""
+}
+
 type WorkflowConcurrencyOpts struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Action        string                   `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`                                                                   // (required) the action id for getting the concurrency group
-	MaxRuns       int32                    `protobuf:"varint,2,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`                                                 // (optional) the maximum number of concurrent workflow runs, default 1
-	LimitStrategy ConcurrencyLimitStrategy `protobuf:"varint,3,opt,name=limit_strategy,json=limitStrategy,proto3,enum=ConcurrencyLimitStrategy" json:"limit_strategy,omitempty"` // (optional) the strategy to use when the concurrency limit is reached, default CANCEL_IN_PROGRESS
+	Action         string                   `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`                                                                   // (optional) the action id for getting the concurrency group, required if there are no key expressions
+	MaxRuns        int32                    `protobuf:"varint,2,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`                                                 // (optional) the maximum number of concurrent workflow runs, default 1
+	LimitStrategy  ConcurrencyLimitStrategy `protobuf:"varint,3,opt,name=limit_strategy,json=limitStrategy,proto3,enum=ConcurrencyLimitStrategy" json:"limit_strategy,omitempty"` // (optional) the strategy to use when the concurrency limit is reached, default CANCEL_IN_PROGRESS
+	KeyExpressions []string                 `protobuf:"bytes,4,rep,name=key_expressions,json=keyExpressions,proto3" json:"key_expressions,omitempty"`                             // (optional) expressions which the engine evaluates to compute the concurrency group, instead of running the action
 }
 
 func (x *WorkflowConcurrencyOpts) Reset() {
@@ -296,6 +361,13 @@ func (x *WorkflowConcurrencyOpts) GetLimitStrategy() ConcurrencyLimitStrategy {
 	return ConcurrencyLimitStrategy_CANCEL_IN_PROGRESS
 }
 
+func (x *WorkflowConcurrencyOpts) GetKeyExpressions() []string {
+	if x != nil {
+		return x.KeyExpressions
+	}
+	return nil
+}
+
 // CreateWorkflowJobOpts represents options to create a workflow job.
 type CreateWorkflowJobOpts struct {
 	state         protoimpl.MessageState
@@ -374,13 +446,24 @@ type CreateWorkflowStepOpts struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReadableId string   `protobuf:"bytes,1,opt,name=readable_id,json=readableId,proto3" json:"readable_id,omitempty"` // (required) the step name
-	Action     string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`                           // (required) the step action id
-	Timeout    string   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`                         // (optional) the step timeout
-	Inputs     string   `protobuf:"bytes,4,opt,name=inputs,proto3" json:"inputs,omitempty"`                           // (optional) the step inputs, assuming string representation of JSON
-	Parents    []string `protobuf:"bytes,5,rep,name=parents,proto3" json:"parents,omitempty"`                         // (optional) the step parents. if none are passed in, this is a root step
-	UserData   string   `protobuf:"bytes,6,opt,name=user_data,json=userData,proto3" json:"user_data,omitempty"`       // (optional) the custom step user data, assuming string representation of JSON
-	Retries    int32    `protobuf:"varint,7,opt,name=retries,proto3" json:"retries,omitempty"`                        // (optional) the number of retries for the step, default 0
+	ReadableId        string                          `protobuf:"bytes,1,opt,name=readable_id,json=readableId,proto3" json:"readable_id,omitempty"`                         // (required) the step name
+	Action            string                          `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`                                                   // (required) the step action id
+	Timeout           string                          `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`                                                 // (optional) the step timeout
+	Inputs            string                          `protobuf:"bytes,4,opt,name=inputs,proto3" json:"inputs,omitempty"`                                                   // (optional) the step inputs, assuming string representation of JSON
+	Parents           []string                        `protobuf:"bytes,5,rep,name=parents,proto3" json:"parents,omitempty"`                                                 // (optional) the step parents. if none are passed in, this is a root step
+	UserData          string                          `protobuf:"bytes,6,opt,name=user_data,json=userData,proto3" json:"user_data,omitempty"`                               // (optional) the custom step user data, assuming string representation of JSON
+	Retries           int32                           `protobuf:"varint,7,opt,name=retries,proto3" json:"retries,omitempty"`                                                // (optional) the number of retries for the step, default 0
+	Cpu               float64                         `protobuf:"fixed64,8,opt,name=cpu,proto3" json:"cpu,omitempty"`                                                       // (optional) the number of cpu cores a worker must advertise to run the step
+	MemoryMb          int32                           `protobuf:"varint,9,opt,name=memory_mb,json=memoryMb,proto3" json:"memory_mb,omitempty"`                              // (optional) the memory in megabytes a worker must advertise to run the step
+	Gpu               int32                           `protobuf:"varint,10,opt,name=gpu,proto3" json:"gpu,omitempty"`                                                       // (optional) the number of gpus a worker must advertise to run the step
+	CancelGracePeriod string                          `protobuf:"bytes,11,opt,name=cancel_grace_period,json=cancelGracePeriod,proto3" json:"cancel_grace_period,omitempty"` // (optional) the amount of time a worker may take to finish the step after it was cancelled
+	MaxTimeout        string                          `protobuf:"bytes,12,opt,name=max_timeout,json=maxTimeout,proto3" json:"max_timeout,omitempty"`                        // (optional) the maximum duration of the step when the worker extends its timeout
+	PreflightChecks   []*CreateStepPreflightCheckOpts `protobuf:"bytes,13,rep,name=preflight_checks,json=preflightChecks,proto3" json:"preflight_checks,omitempty"`         // (optional) checks of the dependencies of the step, which must pass before a step run is assigned to a worker
+	JoinStrategy      string                          `protobuf:"bytes,14,opt,name=join_strategy,json=joinStrategy,proto3" json:"join_strategy,omitempty"`                  // (optional) how many parents must succeed before the step runs, one of ALL, ANY or QUORUM, default ALL
+	JoinQuorum        int32                           `protobuf:"varint,15,opt,name=join_quorum,json=joinQuorum,proto3" json:"join_quorum,omitempty"`                       // (optional) the number of parents which must succeed before the step runs, for the QUORUM join strategy
+	ScheduleTimeout   *string                         `protobuf:"bytes,16,opt,name=schedule_timeout,json=scheduleTimeout,proto3,oneof" json:"schedule_timeout,omitempty"`   // (optional) the amount of time for step runs to wait to be scheduled before timing out, which overrides the schedule timeout of the workflow
+	OnTimeout         *string                         `protobuf:"bytes,17,opt,name=on_timeout,json=onTimeout,proto3,oneof" json:"on_timeout,omitempty"`                     // (optional) the action id of a handler which runs on any worker when a step run times out, with the input of the step run
+	CostCenter        *string                         `protobuf:"bytes,18,opt,name=cost_center,json=costCenter,proto3,oneof" json:"cost_center,omitempty"`                  // (optional) the cost center which the compute of the step runs is attributed to, which overrides the cost center of the workflow
 }
 
 func (x *CreateWorkflowStepOpts) Reset() {
@@ -464,6 +547,147 @@ func (x *CreateWorkflowStepOpts) GetRetries() int32 {
 	return 0
 }
 
+func (x *CreateWorkflowStepOpts) GetCpu() float64 {
+	if x != nil {
+		return x.Cpu
+	}
+	return 0
+}
+
+func (x *CreateWorkflowStepOpts) GetMemoryMb() int32 {
+	if x != nil {
+		return x.MemoryMb
+	}
+	return 0
+}
+
+func (x *CreateWorkflowStepOpts) GetGpu() int32 {
+	if x != nil {
+		return x.Gpu
+	}
+	return 0
+}
+
+func (x *CreateWorkflowStepOpts) GetCancelGracePeriod() string {
+	if x != nil {
+		return x.CancelGracePeriod
+	}
+	return ""
+}
+
+func (x *CreateWorkflowStepOpts) GetMaxTimeout() string {
+	if x != nil {
+		return x.MaxTimeout
+	}
+	return ""
+}
+
+func (x *CreateWorkflowStepOpts) GetPreflightChecks() []*CreateStepPreflightCheckOpts {
+	if x != nil {
+		return x.PreflightChecks
+	}
+	return nil
+}
+
+func (x *CreateWorkflowStepOpts) GetJoinStrategy() string {
+	if x != nil {
+		return x.JoinStrategy
+	}
+	return ""
+}
+
+func (x *CreateWorkflowStepOpts) GetJoinQuorum() int32 {
+	if x != nil {
+		return x.JoinQuorum
+	}
+	return 0
+}
+
+func (x *CreateWorkflowStepOpts) GetScheduleTimeout() string {
+	if x != nil && x.ScheduleTimeout != nil {
+		return *x.ScheduleTimeout
+	}
+	return ""
+}
+
+func (x *CreateWorkflowStepOpts) GetOnTimeout() string {
+	if x != nil && x.OnTimeout != nil {
+		return *x.OnTimeout
+	}
+	return ""
+}
+
+func (x *CreateWorkflowStepOpts) GetCostCenter() string {
+	if x != nil && x.CostCenter != nil {
+		return *x.CostCenter
+	}
+	return ""
+}
+
+// CreateStepPreflightCheckOpts represents options to create a check of a dependency of a step.
+type CreateStepPreflightCheckOpts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind    string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`       // (required) the kind of check, one of HTTP, TCP or EXPRESSION
+	Target  string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`   // (required) the URL of HTTP checks, the address of TCP checks, or the expression of EXPRESSION checks
+	Timeout string `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"` // (optional) the timeout of HTTP and TCP checks, default 5s
+}
+
+func (x *CreateStepPreflightCheckOpts) Reset() {
+	*x = CreateStepPreflightCheckOpts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workflows_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateStepPreflightCheckOpts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStepPreflightCheckOpts) ProtoMessage() {}
+
+func (x *CreateStepPreflightCheckOpts) ProtoReflect() protoreflect.Message {
+	mi := &file_workflows_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStepPreflightCheckOpts.ProtoReflect.Descriptor instead.
+func (*CreateStepPreflightCheckOpts) Descriptor() ([]byte, []int) {
+	return file_workflows_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateStepPreflightCheckOpts) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreateStepPreflightCheckOpts) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *CreateStepPreflightCheckOpts) GetTimeout() string {
+	if x != nil {
+		return x.Timeout
+	}
+	return ""
+}
+
 // ListWorkflowsRequest is the request for ListWorkflows.
 type ListWorkflowsRequest struct {
 	state         protoimpl.MessageState
@@ -474,7 +698,7 @@ type ListWorkflowsRequest struct {
 func (x *ListWorkflowsRequest) Reset() {
 	*x = ListWorkflowsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workflows_proto_msgTypes[5]
+		mi := &file_workflows_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -487,7 +711,7 @@ func (x *ListWorkflowsRequest) String() string {
 func (*ListWorkflowsRequest) ProtoMessage() {}
 
 func (x *ListWorkflowsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_workflows_proto_msgTypes[5]
+	mi := &file_workflows_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -500,7 +724,7 @@ func (x *ListWorkflowsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListWorkflowsRequest.ProtoReflect.Descriptor instead.
 func (*ListWorkflowsRequest) Descriptor() ([]byte, []int) {
-	return file_workflows_proto_rawDescGZIP(), []int{5}
+	return file_workflows_proto_rawDescGZIP(), []int{6}
 }
 
 type ScheduleWorkflowRequest struct {
@@ -517,7 +741,7 @@ type ScheduleWorkflowRequest struct {
 func (x *ScheduleWorkflowRequest) Reset() {
 	*x = ScheduleWorkflowRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workflows_proto_msgTypes[6]
+		mi := &file_workflows_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -530,7 +754,7 @@ func (x *ScheduleWorkflowRequest) String() string {
 func (*ScheduleWorkflowRequest) ProtoMessage() {}
 
 func (x *ScheduleWorkflowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_workflows_proto_msgTypes[6]
+	mi := &file_workflows_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -543,7 +767,7 @@ func (x *ScheduleWorkflowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleWorkflowRequest.ProtoReflect.Descriptor instead.
 func (*ScheduleWorkflowRequest) Descriptor() ([]byte, []int) {
-	return file_workflows_proto_rawDescGZIP(), []int{6}
+	return file_workflows_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ScheduleWorkflowRequest) GetWorkflowId() string {
@@ -579,7 +803,7 @@ type ListWorkflowsResponse struct {
 func (x *ListWorkflowsResponse) Reset() {
 	*x = ListWorkflowsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workflows_proto_msgTypes[7]
+		mi := &file_workflows_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -592,7 +816,7 @@ func (x *ListWorkflowsResponse) String() string {
 func (*ListWorkflowsResponse) ProtoMessage() {}
 
 func (x *ListWorkflowsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_workflows_proto_msgTypes[7]
+	mi := &file_workflows_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -605,7 +829,7 @@ func (x *ListWorkflowsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListWorkflowsResponse.ProtoReflect.Descriptor instead.
 func (*ListWorkflowsResponse) Descriptor() ([]byte, []int) {
-	return file_workflows_proto_rawDescGZIP(), []int{7}
+	return file_workflows_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListWorkflowsResponse) GetWorkflows() []*Workflow {
@@ -627,7 +851,7 @@ type ListWorkflowsForEventRequest struct {
 func (x *ListWorkflowsForEventRequest) Reset() {
 	*x = ListWorkflowsForEventRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workflows_proto_msgTypes[8]
+		mi := &file_workflows_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -640,7 +864,7 @@ func (x *ListWorkflowsForEventRequest) String() string {
 func (*ListWorkflowsForEventRequest) ProtoMessage() {}
 
 func (x *ListWorkflowsForEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_workflows_proto_msgTypes[8]
+	mi := &file_workflows_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -653,7 +877,7 @@ func (x *ListWorkflowsForEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListWorkflowsForEventRequest.ProtoReflect.Descriptor instead.
 func (*ListWorkflowsForEventRequest) Descriptor() ([]byte, []int) {
-	return file_workflows_proto_rawDescGZIP(), []int{8}
+	return file_workflows_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListWorkflowsForEventRequest) GetEventKey() string {
@@ -681,7 +905,7 @@ type Workflow struct {
 func (x *Workflow) Reset() {
 	*x = Workflow{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workflows_proto_msgTypes[9]
+		mi := &file_workflows_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -694,7 +918,7 @@ func (x *Workflow) String() string {
 func (*Workflow) ProtoMessage() {}
 
 func (x *Workflow) ProtoReflect() protoreflect.Message {
-	mi := &file_workflows_proto_msgTypes[9]
+	mi := &file_workflows_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -707,7 +931,7 @@ func (x *Workflow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Workflow.ProtoReflect.Descriptor instead.
 func (*Workflow) Descriptor() ([]byte, []int) {
-	return file_workflows_proto_rawDescGZIP(), []int{9}
+	return file_workflows_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Workflow) GetId() string {
@@ -778,7 +1002,7 @@ type WorkflowVersion struct {
 func (x *WorkflowVersion) Reset() {
 	*x = WorkflowVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workflows_proto_msgTypes[10]
+		mi := &file_workflows_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -791,7 +1015,7 @@ func (x *WorkflowVersion) String() string {
 func (*WorkflowVersion) ProtoMessage() {}
 
 func (x *WorkflowVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_workflows_proto_msgTypes[10]
+	mi := &file_workflows_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -804,7 +1028,7 @@ func (x *WorkflowVersion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkflowVersion.ProtoReflect.Descriptor instead.
 func (*WorkflowVersion) Descriptor() ([]byte, []int) {
-	return file_workflows_proto_rawDescGZIP(), []int{10}
+	return file_workflows_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *WorkflowVersion) GetId() string {
@@ -881,7 +1105,7 @@ type WorkflowTriggers struct {
 func (x *WorkflowTriggers) Reset() {
 	*x = WorkflowTriggers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workflows_proto_msgTypes[11]
+		mi := &file_workflows_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -894,7 +1118,7 @@ func (x *WorkflowTriggers) String() string {
 func (*WorkflowTriggers) ProtoMessage() {}
 
 func (x *WorkflowTriggers) ProtoReflect() protoreflect.Message {
-	mi := &file_workflows_proto_msgTypes[11]
+	mi := &file_workflows_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -907,7 +1131,7 @@ func (x *WorkflowTriggers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkflowTriggers.ProtoReflect.Descriptor instead.
 func (*WorkflowTriggers) Descriptor() ([]byte, []int) {
-	return file_workflows_proto_rawDescGZIP(), []int{11}
+	return file_workflows_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *WorkflowTriggers) GetId() string {
@@ -972,7 +1196,7 @@ type WorkflowTriggerEventRef struct {
 func (x *WorkflowTriggerEventRef) Reset() {
 	*x = WorkflowTriggerEventRef{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workflows_proto_msgTypes[12]
+		mi := &file_workflows_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -985,7 +1209,7 @@ func (x *WorkflowTriggerEventRef) String() string {
 func (*WorkflowTriggerEventRef) ProtoMessage() {}
 
 func (x *WorkflowTriggerEventRef) ProtoReflect() protoreflect.Message {
-	mi := &file_workflows_proto_msgTypes[12]
+	mi := &file_workflows_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -998,7 +1222,7 @@ func (x *WorkflowTriggerEventRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkflowTriggerEventRef.ProtoReflect.Descriptor instead.
 func (*WorkflowTriggerEventRef) Descriptor() ([]byte, []int) {
-	return file_workflows_proto_rawDescGZIP(), []int{12}
+	return file_workflows_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *WorkflowTriggerEventRef) GetParentId() string {
@@ -1028,7 +1252,7 @@ type WorkflowTriggerCronRef struct {
 func (x *WorkflowTriggerCronRef) Reset() {
 	*x = WorkflowTriggerCronRef{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workflows_proto_msgTypes[13]
+		mi := &file_workflows_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1041,7 +1265,7 @@ func (x *WorkflowTriggerCronRef) String() string {
 func (*WorkflowTriggerCronRef) ProtoMessage() {}
 
 func (x *WorkflowTriggerCronRef) ProtoReflect() protoreflect.Message {
-	mi := &file_workflows_proto_msgTypes[13]
+	mi := &file_workflows_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1054,7 +1278,7 @@ func (x *WorkflowTriggerCronRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkflowTriggerCronRef.ProtoReflect.Descriptor instead.
 func (*WorkflowTriggerCronRef) Descriptor() ([]byte, []int) {
-	return file_workflows_proto_rawDescGZIP(), []int{13}
+	return file_workflows_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *WorkflowTriggerCronRef) GetParentId() string {
@@ -1091,7 +1315,7 @@ type Job struct {
 func (x *Job) Reset() {
 	*x = Job{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workflows_proto_msgTypes[14]
+		mi := &file_workflows_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1104,7 +1328,7 @@ func (x *Job) String() string {
 func (*Job) ProtoMessage() {}
 
 func (x *Job) ProtoReflect() protoreflect.Message {
-	mi := &file_workflows_proto_msgTypes[14]
+	mi := &file_workflows_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1117,7 +1341,7 @@ func (x *Job) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Job.ProtoReflect.Descriptor instead.
 func (*Job) Descriptor() ([]byte, []int) {
-	return file_workflows_proto_rawDescGZIP(), []int{14}
+	return file_workflows_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Job) GetId() string {
@@ -1204,7 +1428,7 @@ type Step struct {
 func (x *Step) Reset() {
 	*x = Step{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workflows_proto_msgTypes[15]
+		mi := &file_workflows_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1217,7 +1441,7 @@ func (x *Step) String() string {
 func (*Step) ProtoMessage() {}
 
 func (x *Step) ProtoReflect() protoreflect.Message {
-	mi := &file_workflows_proto_msgTypes[15]
+	mi := &file_workflows_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1230,7 +1454,7 @@ func (x *Step) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Step.ProtoReflect.Descriptor instead.
 func (*Step) Descriptor() ([]byte, []int) {
-	return file_workflows_proto_rawDescGZIP(), []int{15}
+	return file_workflows_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Step) GetId() string {
@@ -1314,7 +1538,7 @@ type DeleteWorkflowRequest struct {
 func (x *DeleteWorkflowRequest) Reset() {
 	*x = DeleteWorkflowRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workflows_proto_msgTypes[16]
+		mi := &file_workflows_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1327,7 +1551,7 @@ func (x *DeleteWorkflowRequest) String() string {
 func (*DeleteWorkflowRequest) ProtoMessage() {}
 
 func (x *DeleteWorkflowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_workflows_proto_msgTypes[16]
+	mi := &file_workflows_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1340,7 +1564,7 @@ func (x *DeleteWorkflowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteWorkflowRequest.ProtoReflect.Descriptor instead.
 func (*DeleteWorkflowRequest) Descriptor() ([]byte, []int) {
-	return file_workflows_proto_rawDescGZIP(), []int{16}
+	return file_workflows_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DeleteWorkflowRequest) GetWorkflowId() string {
@@ -1361,7 +1585,7 @@ type GetWorkflowByNameRequest struct {
 func (x *GetWorkflowByNameRequest) Reset() {
 	*x = GetWorkflowByNameRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workflows_proto_msgTypes[17]
+		mi := &file_workflows_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1374,7 +1598,7 @@ func (x *GetWorkflowByNameRequest) String() string {
 func (*GetWorkflowByNameRequest) ProtoMessage() {}
 
 func (x *GetWorkflowByNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_workflows_proto_msgTypes[17]
+	mi := &file_workflows_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1387,7 +1611,7 @@ func (x *GetWorkflowByNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWorkflowByNameRequest.ProtoReflect.Descriptor instead.
 func (*GetWorkflowByNameRequest) Descriptor() ([]byte, []int) {
-	return file_workflows_proto_rawDescGZIP(), []int{17}
+	return file_workflows_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetWorkflowByNameRequest) GetName() string {
@@ -1405,12 +1629,17 @@ type TriggerWorkflowRequest struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// (optional) the input data for the workflow
 	Input string `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	// (optional) whether the trigger is a priority, which is not rejected when the engine is shedding load
+	Priority *bool `protobuf:"varint,3,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	// (optional) the metadata of the run, assuming string representation of a JSON object. it is passed to
+	// every step run and the get group key run
+	AdditionalMetadata string `protobuf:"bytes,4,opt,name=additional_metadata,json=additionalMetadata,proto3" json:"additional_metadata,omitempty"`
 }
 
 func (x *TriggerWorkflowRequest) Reset() {
 	*x = TriggerWorkflowRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workflows_proto_msgTypes[18]
+		mi := &file_workflows_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1423,7 +1652,7 @@ func (x *TriggerWorkflowRequest) String() string {
 func (*TriggerWorkflowRequest) ProtoMessage() {}
 
 func (x *TriggerWorkflowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_workflows_proto_msgTypes[18]
+	mi := &file_workflows_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1436,7 +1665,7 @@ func (x *TriggerWorkflowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerWorkflowRequest.ProtoReflect.Descriptor instead.
 func (*TriggerWorkflowRequest) Descriptor() ([]byte, []int) {
-	return file_workflows_proto_rawDescGZIP(), []int{18}
+	return file_workflows_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *TriggerWorkflowRequest) GetName() string {
@@ -1453,18 +1682,38 @@ func (x *TriggerWorkflowRequest) GetInput() string {
 	return ""
 }
 
+func (x *TriggerWorkflowRequest) GetPriority() bool {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return false
+}
+
+func (x *TriggerWorkflowRequest) GetAdditionalMetadata() string {
+	if x != nil {
+		return x.AdditionalMetadata
+	}
+	return ""
+}
+
 type TriggerWorkflowResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	WorkflowRunId string `protobuf:"bytes,1,opt,name=workflow_run_id,json=workflowRunId,proto3" json:"workflow_run_id,omitempty"`
+	// (optional) the number of workflow runs and step runs of the tenant which are waiting to be processed, set
+	// when the queue of the tenant is backed up
+	QueueDepth *int64 `protobuf:"varint,2,opt,name=queue_depth,json=queueDepth,proto3,oneof" json:"queue_depth,omitempty"`
+	// (optional) the suggested number of seconds to wait before the next trigger, set when the queue of the tenant
+	// is backed up
+	RetryAfterSeconds *int64 `protobuf:"varint,3,opt,name=retry_after_seconds,json=retryAfterSeconds,proto3,oneof" json:"retry_after_seconds,omitempty"`
 }
 
 func (x *TriggerWorkflowResponse) Reset() {
 	*x = TriggerWorkflowResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workflows_proto_msgTypes[19]
+		mi := &file_workflows_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1477,7 +1726,7 @@ func (x *TriggerWorkflowResponse) String() string {
 func (*TriggerWorkflowResponse) ProtoMessage() {}
 
 func (x *TriggerWorkflowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_workflows_proto_msgTypes[19]
+	mi := &file_workflows_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1490,7 +1739,7 @@ func (x *TriggerWorkflowResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerWorkflowResponse.ProtoReflect.Descriptor instead.
 func (*TriggerWorkflowResponse) Descriptor() ([]byte, []int) {
-	return file_workflows_proto_rawDescGZIP(), []int{19}
+	return file_workflows_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *TriggerWorkflowResponse) GetWorkflowRunId() string {
@@ -1500,6 +1749,20 @@ func (x *TriggerWorkflowResponse) GetWorkflowRunId() string {
 	return ""
 }
 
+func (x *TriggerWorkflowResponse) GetQueueDepth() int64 {
+	if x != nil && x.QueueDepth != nil {
+		return *x.QueueDepth
+	}
+	return 0
+}
+
+func (x *TriggerWorkflowResponse) GetRetryAfterSeconds() int64 {
+	if x != nil && x.RetryAfterSeconds != nil {
+		return *x.RetryAfterSeconds
+	}
+	return 0
+}
+
 var File_workflows_proto protoreflect.FileDescriptor
 
 var file_workflows_proto_rawDesc = []byte{
@@ -1512,7 +1775,7 @@ var file_workflows_proto_rawDesc = []byte{
 	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x6f, 0x70, 0x74, 0x73,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57,
 	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x70,
-	0x74, 0x73, 0x52, 0x04, 0x6f, 0x70, 0x74, 0x73, 0x22, 0xaf, 0x03, 0x0a, 0x19, 0x43, 0x72, 0x65,
+	0x74, 0x73, 0x52, 0x04, 0x6f, 0x70, 0x74, 0x73, 0x22, 0x90, 0x07, 0x0a, 0x19, 0x43, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x56, 0x65, 0x72, 0x73, 0x69,
 	0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
@@ -1538,40 +1801,108 @@ var file_workflows_proto_rawDesc = []byte{
 	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2e, 0x0a, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
 	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48,
 	0x00, 0x52, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x8e, 0x01, 0x0a, 0x17, 0x57,
-	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x4f, 0x70, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19,
-	0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x6d, 0x61, 0x78, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x19, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x0d, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x22, 0x96, 0x01, 0x0a, 0x15,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x4a, 0x6f,
-	0x62, 0x4f, 0x70, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x74,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72,
-	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x65, 0x70, 0x4f, 0x70, 0x74, 0x73, 0x52, 0x05, 0x73,
-	0x74, 0x65, 0x70, 0x73, 0x22, 0xd4, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57,
-	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x65, 0x70, 0x4f, 0x70, 0x74, 0x73, 0x12,
-	0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x4c,
+	0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x73, 0x6c, 0x61, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x03, 0x73, 0x6c, 0x61, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0b,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x88, 0x01, 0x01, 0x12, 0x25,
+	0x0a, 0x0c, 0x70, 0x69, 0x6e, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x0a, 0x70, 0x69, 0x6e, 0x54, 0x6f, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x13, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x05, 0x52, 0x12, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x48, 0x06, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x53,
+	0x74, 0x65, 0x70, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x2d, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x72, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x48, 0x07, 0x52, 0x0e, 0x6d, 0x61,
+	0x78, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x24, 0x0a, 0x0b, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x43, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x73,
+	0x6c, 0x61, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x70, 0x69, 0x6e, 0x5f, 0x74, 0x6f,
+	0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x42, 0x16,
+	0x0a, 0x14, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x74, 0x65, 0x70, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x63, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x22, 0xb7, 0x01, 0x0a, 0x17,
+	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x4f, 0x70, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x0d, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x27, 0x0a, 0x0f,
+	0x6b, 0x65, 0x79, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x4a, 0x6f, 0x62, 0x4f, 0x70, 0x74, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x2d, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x53,
+	0x74, 0x65, 0x70, 0x4f, 0x70, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x22, 0xa4,
+	0x05, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x53, 0x74, 0x65, 0x70, 0x4f, 0x70, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x61,
+	0x64, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x65, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x5f, 0x6d, 0x62, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x4d, 0x62, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x70, 0x75, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x67, 0x70, 0x75, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x5f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x47, 0x72, 0x61, 0x63, 0x65,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x78,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x48, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x66, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72,
+	0x65, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x70, 0x74, 0x73,
+	0x52, 0x0f, 0x70, 0x72, 0x65, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x71,
+	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6a, 0x6f, 0x69,
+	0x6e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x63,
+	0x6f, 0x73, 0x74, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x88, 0x01,
+	0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x63,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x22, 0x64, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x65, 0x70, 0x50, 0x72, 0x65, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x4f, 0x70, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x4c,
 	0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x17, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
 	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
@@ -1714,56 +2045,70 @@ var file_workflows_proto_rawDesc = []byte{
 	0x6f, 0x77, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66,
 	0x6c, 0x6f, 0x77, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x42, 0x0a, 0x16, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57,
-	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x41, 0x0a, 0x17, 0x54, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f,
-	0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x6f,
-	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x75, 0x6e, 0x49, 0x64, 0x2a, 0x6c, 0x0a, 0x18, 0x43,
-	0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53,
-	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x41, 0x4e, 0x43, 0x45,
-	0x4c, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12,
-	0x0f, 0x0a, 0x0b, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x4e, 0x45, 0x57, 0x45, 0x53, 0x54, 0x10, 0x01,
-	0x12, 0x10, 0x0a, 0x0c, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x4e, 0x45, 0x57, 0x45, 0x53, 0x54,
-	0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x52, 0x4f, 0x55, 0x4e,
-	0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x03, 0x32, 0xcd, 0x03, 0x0a, 0x0f, 0x57, 0x6f,
-	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3e, 0x0a,
-	0x0d, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x15,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b,
-	0x66, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
-	0x0b, 0x50, 0x75, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x13, 0x2e, 0x50,
-	0x75, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x10, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57,
-	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x18, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x10, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x0f, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f,
-	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x17, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x18, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
-	0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x11, 0x47, 0x65, 0x74,
-	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19,
-	0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x42, 0x79, 0x4e, 0x61,
-	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
-	0x66, 0x6c, 0x6f, 0x77, 0x12, 0x4e, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b,
-	0x66, 0x6c, 0x6f, 0x77, 0x73, 0x46, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x46, 0x6f, 0x72,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f,
-	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x16, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57,
-	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09,
-	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x2d,
-	0x64, 0x65, 0x76, 0x2f, 0x68, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x2f, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x16, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x70,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x13, 0x61, 0x64,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xc4, 0x01, 0x0a, 0x17, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0b,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x70, 0x74, 0x68, 0x88,
+	0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x01, 0x52, 0x11, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x2a,
+	0x6c, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x16, 0x0a, 0x12, 0x43,
+	0x41, 0x4e, 0x43, 0x45, 0x4c, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53,
+	0x53, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x4e, 0x45, 0x57, 0x45,
+	0x53, 0x54, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x4e, 0x45,
+	0x57, 0x45, 0x53, 0x54, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f,
+	0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x03, 0x32, 0xcd, 0x03,
+	0x0a, 0x0f, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x73, 0x12, 0x15, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x34, 0x0a, 0x0b, 0x50, 0x75, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x12, 0x13, 0x2e, 0x50, 0x75, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x18, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x0f, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x17, 0x2e, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x42, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e,
+	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x4e, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x46, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x1d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x73, 0x46, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x16, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x09, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x42, 0x42, 0x5a,
+	0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x74, 0x2d, 0x64, 0x65, 0x76, 0x2f, 0x68, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x2f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1779,7 +2124,7 @@ func file_workflows_proto_rawDescGZIP() []byte {
 }
 
 var file_workflows_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_workflows_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_workflows_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_workflows_proto_goTypes = []interface{}{
 	(ConcurrencyLimitStrategy)(0),        // 0: ConcurrencyLimitStrategy
 	(*PutWorkflowRequest)(nil),           // 1: PutWorkflowRequest
@@ -1787,73 +2132,75 @@ var file_workflows_proto_goTypes = []interface{}{
 	(*WorkflowConcurrencyOpts)(nil),      // 3: WorkflowConcurrencyOpts
 	(*CreateWorkflowJobOpts)(nil),        // 4: CreateWorkflowJobOpts
 	(*CreateWorkflowStepOpts)(nil),       // 5: CreateWorkflowStepOpts
-	(*ListWorkflowsRequest)(nil),         // 6: ListWorkflowsRequest
-	(*ScheduleWorkflowRequest)(nil),      // 7: ScheduleWorkflowRequest
-	(*ListWorkflowsResponse)(nil),        // 8: ListWorkflowsResponse
-	(*ListWorkflowsForEventRequest)(nil), // 9: ListWorkflowsForEventRequest
-	(*Workflow)(nil),                     // 10: Workflow
-	(*WorkflowVersion)(nil),              // 11: WorkflowVersion
-	(*WorkflowTriggers)(nil),             // 12: WorkflowTriggers
-	(*WorkflowTriggerEventRef)(nil),      // 13: WorkflowTriggerEventRef
-	(*WorkflowTriggerCronRef)(nil),       // 14: WorkflowTriggerCronRef
-	(*Job)(nil),                          // 15: Job
-	(*Step)(nil),                         // 16: Step
-	(*DeleteWorkflowRequest)(nil),        // 17: DeleteWorkflowRequest
-	(*GetWorkflowByNameRequest)(nil),     // 18: GetWorkflowByNameRequest
-	(*TriggerWorkflowRequest)(nil),       // 19: TriggerWorkflowRequest
-	(*TriggerWorkflowResponse)(nil),      // 20: TriggerWorkflowResponse
-	(*timestamppb.Timestamp)(nil),        // 21: google.protobuf.Timestamp
-	(*wrapperspb.StringValue)(nil),       // 22: google.protobuf.StringValue
+	(*CreateStepPreflightCheckOpts)(nil), // 6: CreateStepPreflightCheckOpts
+	(*ListWorkflowsRequest)(nil),         // 7: ListWorkflowsRequest
+	(*ScheduleWorkflowRequest)(nil),      // 8: ScheduleWorkflowRequest
+	(*ListWorkflowsResponse)(nil),        // 9: ListWorkflowsResponse
+	(*ListWorkflowsForEventRequest)(nil), // 10: ListWorkflowsForEventRequest
+	(*Workflow)(nil),                     // 11: Workflow
+	(*WorkflowVersion)(nil),              // 12: WorkflowVersion
+	(*WorkflowTriggers)(nil),             // 13: WorkflowTriggers
+	(*WorkflowTriggerEventRef)(nil),      // 14: WorkflowTriggerEventRef
+	(*WorkflowTriggerCronRef)(nil),       // 15: WorkflowTriggerCronRef
+	(*Job)(nil),                          // 16: Job
+	(*Step)(nil),                         // 17: Step
+	(*DeleteWorkflowRequest)(nil),        // 18: DeleteWorkflowRequest
+	(*GetWorkflowByNameRequest)(nil),     // 19: GetWorkflowByNameRequest
+	(*TriggerWorkflowRequest)(nil),       // 20: TriggerWorkflowRequest
+	(*TriggerWorkflowResponse)(nil),      // 21: TriggerWorkflowResponse
+	(*timestamppb.Timestamp)(nil),        // 22: google.protobuf.Timestamp
+	(*wrapperspb.StringValue)(nil),       // 23: google.protobuf.StringValue
 }
 var file_workflows_proto_depIdxs = []int32{
 	2,  // 0: PutWorkflowRequest.opts:type_name -> CreateWorkflowVersionOpts
-	21, // 1: CreateWorkflowVersionOpts.scheduled_triggers:type_name -> google.protobuf.Timestamp
+	22, // 1: CreateWorkflowVersionOpts.scheduled_triggers:type_name -> google.protobuf.Timestamp
 	4,  // 2: CreateWorkflowVersionOpts.jobs:type_name -> CreateWorkflowJobOpts
 	3,  // 3: CreateWorkflowVersionOpts.concurrency:type_name -> WorkflowConcurrencyOpts
 	0,  // 4: WorkflowConcurrencyOpts.limit_strategy:type_name -> ConcurrencyLimitStrategy
 	5,  // 5: CreateWorkflowJobOpts.steps:type_name -> CreateWorkflowStepOpts
-	21, // 6: ScheduleWorkflowRequest.schedules:type_name -> google.protobuf.Timestamp
-	10, // 7: ListWorkflowsResponse.workflows:type_name -> Workflow
-	21, // 8: Workflow.created_at:type_name -> google.protobuf.Timestamp
-	21, // 9: Workflow.updated_at:type_name -> google.protobuf.Timestamp
-	22, // 10: Workflow.description:type_name -> google.protobuf.StringValue
-	11, // 11: Workflow.versions:type_name -> WorkflowVersion
-	21, // 12: WorkflowVersion.created_at:type_name -> google.protobuf.Timestamp
-	21, // 13: WorkflowVersion.updated_at:type_name -> google.protobuf.Timestamp
-	12, // 14: WorkflowVersion.triggers:type_name -> WorkflowTriggers
-	15, // 15: WorkflowVersion.jobs:type_name -> Job
-	21, // 16: WorkflowTriggers.created_at:type_name -> google.protobuf.Timestamp
-	21, // 17: WorkflowTriggers.updated_at:type_name -> google.protobuf.Timestamp
-	13, // 18: WorkflowTriggers.events:type_name -> WorkflowTriggerEventRef
-	14, // 19: WorkflowTriggers.crons:type_name -> WorkflowTriggerCronRef
-	21, // 20: Job.created_at:type_name -> google.protobuf.Timestamp
-	21, // 21: Job.updated_at:type_name -> google.protobuf.Timestamp
-	22, // 22: Job.description:type_name -> google.protobuf.StringValue
-	16, // 23: Job.steps:type_name -> Step
-	22, // 24: Job.timeout:type_name -> google.protobuf.StringValue
-	21, // 25: Step.created_at:type_name -> google.protobuf.Timestamp
-	21, // 26: Step.updated_at:type_name -> google.protobuf.Timestamp
-	22, // 27: Step.readable_id:type_name -> google.protobuf.StringValue
-	22, // 28: Step.timeout:type_name -> google.protobuf.StringValue
-	6,  // 29: WorkflowService.ListWorkflows:input_type -> ListWorkflowsRequest
-	1,  // 30: WorkflowService.PutWorkflow:input_type -> PutWorkflowRequest
-	7,  // 31: WorkflowService.ScheduleWorkflow:input_type -> ScheduleWorkflowRequest
-	19, // 32: WorkflowService.TriggerWorkflow:input_type -> TriggerWorkflowRequest
-	18, // 33: WorkflowService.GetWorkflowByName:input_type -> GetWorkflowByNameRequest
-	9,  // 34: WorkflowService.ListWorkflowsForEvent:input_type -> ListWorkflowsForEventRequest
-	17, // 35: WorkflowService.DeleteWorkflow:input_type -> DeleteWorkflowRequest
-	8,  // 36: WorkflowService.ListWorkflows:output_type -> ListWorkflowsResponse
-	11, // 37: WorkflowService.PutWorkflow:output_type -> WorkflowVersion
-	11, // 38: WorkflowService.ScheduleWorkflow:output_type -> WorkflowVersion
-	20, // 39: WorkflowService.TriggerWorkflow:output_type -> TriggerWorkflowResponse
-	10, // 40: WorkflowService.GetWorkflowByName:output_type -> Workflow
-	8,  // 41: WorkflowService.ListWorkflowsForEvent:output_type -> ListWorkflowsResponse
-	10, // 42: WorkflowService.DeleteWorkflow:output_type -> Workflow
-	36, // [36:43] is the sub-list for method output_type
-	29, // [29:36] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
+	6,  // 6: CreateWorkflowStepOpts.preflight_checks:type_name -> CreateStepPreflightCheckOpts
+	22, // 7: ScheduleWorkflowRequest.schedules:type_name -> google.protobuf.Timestamp
+	11, // 8: ListWorkflowsResponse.workflows:type_name -> Workflow
+	22, // 9: Workflow.created_at:type_name -> google.protobuf.Timestamp
+	22, // 10: Workflow.updated_at:type_name -> google.protobuf.Timestamp
+	23, // 11: Workflow.description:type_name -> google.protobuf.StringValue
+	12, // 12: Workflow.versions:type_name -> WorkflowVersion
+	22, // 13: WorkflowVersion.created_at:type_name -> google.protobuf.Timestamp
+	22, // 14: WorkflowVersion.updated_at:type_name -> google.protobuf.Timestamp
+	13, // 15: WorkflowVersion.triggers:type_name -> WorkflowTriggers
+	16, // 16: WorkflowVersion.jobs:type_name -> Job
+	22, // 17: WorkflowTriggers.created_at:type_name -> google.protobuf.Timestamp
+	22, // 18: WorkflowTriggers.updated_at:type_name -> google.protobuf.Timestamp
+	14, // 19: WorkflowTriggers.events:type_name -> WorkflowTriggerEventRef
+	15, // 20: WorkflowTriggers.crons:type_name -> WorkflowTriggerCronRef
+	22, // 21: Job.created_at:type_name -> google.protobuf.Timestamp
+	22, // 22: Job.updated_at:type_name -> google.protobuf.Timestamp
+	23, // 23: Job.description:type_name -> google.protobuf.StringValue
+	17, // 24: Job.steps:type_name -> Step
+	23, // 25: Job.timeout:type_name -> google.protobuf.StringValue
+	22, // 26: Step.created_at:type_name -> google.protobuf.Timestamp
+	22, // 27: Step.updated_at:type_name -> google.protobuf.Timestamp
+	23, // 28: Step.readable_id:type_name -> google.protobuf.StringValue
+	23, // 29: Step.timeout:type_name -> google.protobuf.StringValue
+	7,  // 30: WorkflowService.ListWorkflows:input_type -> ListWorkflowsRequest
+	1,  // 31: WorkflowService.PutWorkflow:input_type -> PutWorkflowRequest
+	8,  // 32: WorkflowService.ScheduleWorkflow:input_type -> ScheduleWorkflowRequest
+	20, // 33: WorkflowService.TriggerWorkflow:input_type -> TriggerWorkflowRequest
+	19, // 34: WorkflowService.GetWorkflowByName:input_type -> GetWorkflowByNameRequest
+	10, // 35: WorkflowService.ListWorkflowsForEvent:input_type -> ListWorkflowsForEventRequest
+	18, // 36: WorkflowService.DeleteWorkflow:input_type -> DeleteWorkflowRequest
+	9,  // 37: WorkflowService.ListWorkflows:output_type -> ListWorkflowsResponse
+	12, // 38: WorkflowService.PutWorkflow:output_type -> WorkflowVersion
+	12, // 39: WorkflowService.ScheduleWorkflow:output_type -> WorkflowVersion
+	21, // 40: WorkflowService.TriggerWorkflow:output_type -> TriggerWorkflowResponse
+	11, // 41: WorkflowService.GetWorkflowByName:output_type -> Workflow
+	9,  // 42: WorkflowService.ListWorkflowsForEvent:output_type -> ListWorkflowsResponse
+	11, // 43: WorkflowService.DeleteWorkflow:output_type -> Workflow
+	37, // [37:44] is the sub-list for method output_type
+	30, // [30:37] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_workflows_proto_init() }
@@ -1923,7 +2270,7 @@ func file_workflows_proto_init() {
 			}
 		}
 		file_workflows_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListWorkflowsRequest); i {
+			switch v := v.(*CreateStepPreflightCheckOpts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1935,7 +2282,7 @@ func file_workflows_proto_init() {
 			}
 		}
 		file_workflows_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScheduleWorkflowRequest); i {
+			switch v := v.(*ListWorkflowsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1947,7 +2294,7 @@ func file_workflows_proto_init() {
 			}
 		}
 		file_workflows_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListWorkflowsResponse); i {
+			switch v := v.(*ScheduleWorkflowRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1959,7 +2306,7 @@ func file_workflows_proto_init() {
 			}
 		}
 		file_workflows_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListWorkflowsForEventRequest); i {
+			switch v := v.(*ListWorkflowsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1971,7 +2318,7 @@ func file_workflows_proto_init() {
 			}
 		}
 		file_workflows_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Workflow); i {
+			switch v := v.(*ListWorkflowsForEventRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1983,7 +2330,7 @@ func file_workflows_proto_init() {
 			}
 		}
 		file_workflows_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkflowVersion); i {
+			switch v := v.(*Workflow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1995,7 +2342,7 @@ func file_workflows_proto_init() {
 			}
 		}
 		file_workflows_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkflowTriggers); i {
+			switch v := v.(*WorkflowVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2007,7 +2354,7 @@ func file_workflows_proto_init() {
 			}
 		}
 		file_workflows_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkflowTriggerEventRef); i {
+			switch v := v.(*WorkflowTriggers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2019,7 +2366,7 @@ func file_workflows_proto_init() {
 			}
 		}
 		file_workflows_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkflowTriggerCronRef); i {
+			switch v := v.(*WorkflowTriggerEventRef); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2031,7 +2378,7 @@ func file_workflows_proto_init() {
 			}
 		}
 		file_workflows_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Job); i {
+			switch v := v.(*WorkflowTriggerCronRef); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2043,7 +2390,7 @@ func file_workflows_proto_init() {
 			}
 		}
 		file_workflows_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Step); i {
+			switch v := v.(*Job); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2055,7 +2402,7 @@ func file_workflows_proto_init() {
 			}
 		}
 		file_workflows_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteWorkflowRequest); i {
+			switch v := v.(*Step); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2067,7 +2414,7 @@ func file_workflows_proto_init() {
 			}
 		}
 		file_workflows_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWorkflowByNameRequest); i {
+			switch v := v.(*DeleteWorkflowRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2079,7 +2426,7 @@ func file_workflows_proto_init() {
 			}
 		}
 		file_workflows_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerWorkflowRequest); i {
+			switch v := v.(*GetWorkflowByNameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2091,6 +2438,18 @@ func file_workflows_proto_init() {
 			}
 		}
 		file_workflows_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerWorkflowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workflows_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TriggerWorkflowResponse); i {
 			case 0:
 				return &v.state
@@ -2104,13 +2463,16 @@ func file_workflows_proto_init() {
 		}
 	}
 	file_workflows_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_workflows_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_workflows_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_workflows_proto_msgTypes[20].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_workflows_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   20,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,377 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
+)
+
+// ErrNoTickersAvailable is returned when a workflow with cron or scheduled triggers is put, but there is no
+// active ticker to run the triggers.
+var ErrNoTickersAvailable = errors.New("no tickers available")
+
+// PutWorkflowVersion creates the workflow, or a new version of the workflow if its checksum has changed, and
+// moves the cron and scheduled triggers from the previous version to the new version. If the checksum has
+// not changed, the latest version is returned, so putting the same workflow again has no effect.
+func PutWorkflowVersion(
+	ctx context.Context,
+	repo repository.Repository,
+	mq msgqueue.MessageQueue,
+	tenantId string,
+	createOpts *repository.CreateWorkflowVersionOpts,
+) (*db.WorkflowVersionModel, error) {
+	// determine if workflow already exists
+	var workflowVersion *db.WorkflowVersionModel
+	var oldWorkflowVersion *db.WorkflowVersionModel
+
+	currWorkflow, err := repo.Workflow().GetWorkflowByName(
+		tenantId,
+		createOpts.Name,
+	)
+
+	var noop bool
+
+	if err != nil {
+		if !errors.Is(err, db.ErrNotFound) {
+			return nil, err
+		}
+
+		// workflow does not exist, create it
+		workflowVersion, err = repo.Workflow().CreateNewWorkflow(
+			tenantId,
+			createOpts,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		oldWorkflowVersion = &currWorkflow.Versions()[0]
+
+		// workflow exists, look at checksum
+		newCS, err := createOpts.Checksum()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if oldWorkflowVersion.Checksum != newCS {
+			workflowVersion, err = repo.Workflow().CreateWorkflowVersion(
+				tenantId,
+				createOpts,
+			)
+
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			noop = true
+
+			workflowVersion = oldWorkflowVersion
+		}
+	}
+
+	if !noop {
+		// if this is a cron-based workflow, assign the workflow run to a ticker
+		triggers, ok := workflowVersion.Triggers()
+
+		if !ok {
+			return nil, fmt.Errorf("workflow version has no triggers")
+		}
+
+		if crons := triggers.Crons(); len(crons) > 0 {
+			within := time.Now().UTC().Add(-6 * time.Second)
+
+			tickers, err := repo.Ticker().ListTickers(&repository.ListTickerOpts{
+				LatestHeartbeatAt: &within,
+				Active:            repository.BoolPtr(true),
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			if len(tickers) == 0 {
+				return nil, ErrNoTickersAvailable
+			}
+
+			numTickers := len(tickers)
+
+			for i, cronTrigger := range crons {
+				cronTriggerCp := cronTrigger
+				ticker := tickers[i%numTickers]
+
+				_, err := repo.Ticker().AddCron(
+					ticker.ID,
+					&cronTriggerCp,
+				)
+
+				if err != nil {
+					return nil, err
+				}
+
+				task, err := cronScheduleTask(&ticker, &cronTriggerCp, workflowVersion)
+
+				if err != nil {
+					return nil, err
+				}
+
+				// send to task queue
+				err = mq.AddMessage(
+					ctx,
+					msgqueue.QueueTypeFromTickerID(ticker.ID),
+					task,
+				)
+
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if schedules := workflowVersion.Scheduled(); len(schedules) > 0 {
+			within := time.Now().UTC().Add(-6 * time.Second)
+
+			tickers, err := repo.Ticker().ListTickers(&repository.ListTickerOpts{
+				LatestHeartbeatAt: &within,
+				Active:            repository.BoolPtr(true),
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			if len(tickers) == 0 {
+				return nil, ErrNoTickersAvailable
+			}
+
+			numTickers := len(tickers)
+
+			for i, scheduledTrigger := range schedules {
+				scheduledTriggerCp := scheduledTrigger
+				ticker := tickers[i%numTickers]
+
+				_, err := repo.Ticker().AddScheduledWorkflow(
+					ticker.ID,
+					&scheduledTriggerCp,
+				)
+
+				if err != nil {
+					return nil, err
+				}
+
+				task, err := workflowScheduleTask(&ticker, &scheduledTriggerCp, workflowVersion)
+
+				if err != nil {
+					return nil, err
+				}
+
+				// send to task queue
+				err = mq.AddMessage(
+					ctx,
+					msgqueue.QueueTypeFromTickerID(ticker.ID),
+					task,
+				)
+
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		// cancel the old workflow version
+		if oldWorkflowVersion != nil {
+			oldTriggers, ok := oldWorkflowVersion.Triggers()
+
+			if !ok {
+				return nil, fmt.Errorf("old workflow version has no triggers")
+			}
+
+			if crons := oldTriggers.Crons(); len(crons) > 0 {
+				for _, cronTrigger := range crons {
+					cronTriggerCp := cronTrigger
+
+					if ticker, ok := cronTrigger.Ticker(); ok {
+						task, err := cronCancelTask(ticker, &cronTriggerCp, workflowVersion)
+
+						if err != nil {
+							return nil, err
+						}
+
+						// send to task queue
+						err = mq.AddMessage(
+							ctx,
+							msgqueue.QueueTypeFromTickerID(ticker.ID),
+							task,
+						)
+
+						if err != nil {
+							return nil, err
+						}
+
+						// remove cron
+						_, err = repo.Ticker().RemoveCron(
+							ticker.ID,
+							&cronTriggerCp,
+						)
+
+						if err != nil {
+							return nil, err
+						}
+					}
+				}
+			}
+
+			if schedules := oldWorkflowVersion.Scheduled(); len(schedules) > 0 {
+				for _, scheduleTrigger := range schedules {
+					scheduleTriggerCp := scheduleTrigger
+
+					if ticker, ok := scheduleTriggerCp.Ticker(); ok {
+						task, err := workflowCancelTask(ticker, &scheduleTriggerCp, workflowVersion)
+
+						if err != nil {
+							return nil, err
+						}
+
+						// only send to task queue if the trigger is in the future
+						if scheduleTriggerCp.TriggerAt.After(time.Now().UTC()) {
+							err = mq.AddMessage(
+								ctx,
+								msgqueue.QueueTypeFromTickerID(ticker.ID),
+								task,
+							)
+
+							if err != nil {
+								return nil, err
+							}
+
+							// remove cron
+							_, err = repo.Ticker().RemoveScheduledWorkflow(
+								ticker.ID,
+								&scheduleTriggerCp,
+							)
+
+							if err != nil {
+								return nil, err
+							}
+						}
+					}
+				}
+			}
+
+			// drop the cached versions of the workflow in the controllers
+			err = mq.AddMessage(
+				ctx,
+				msgqueue.WORKFLOW_PROCESSING_QUEUE,
+				tasktypes.WorkflowUpdatedToTask(tenantId, oldWorkflowVersion.WorkflowID),
+			)
+
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return workflowVersion, nil
+}
+
+// CreateWorkflowOptsFromDefinition returns the options to create a workflow version from a workflow definition,
+// in the same way as a workflow registered by a worker. The jobs are sorted by name, so that the checksum of the
+// options doesn't depend on the order of the definition's jobs.
+func CreateWorkflowOptsFromDefinition(workflow *types.Workflow) (*repository.CreateWorkflowVersionOpts, error) {
+	jobNames := make([]string, 0, len(workflow.Jobs))
+
+	for jobName := range workflow.Jobs {
+		jobNames = append(jobNames, jobName)
+	}
+
+	sort.Strings(jobNames)
+
+	jobs := make([]repository.CreateWorkflowJobOpts, len(jobNames))
+
+	for i, jobName := range jobNames {
+		job := workflow.Jobs[jobName]
+
+		steps := make([]repository.CreateWorkflowStepOpts, len(job.Steps))
+
+		for j, step := range job.Steps {
+			stepCp := step
+
+			parsedAction, err := types.ParseActionID(stepCp.ActionID)
+
+			if err != nil {
+				return nil, fmt.Errorf("step %s: %w", stepCp.ID, err)
+			}
+
+			retries := stepCp.Retries
+
+			steps[j] = repository.CreateWorkflowStepOpts{
+				ReadableId: stepCp.ID,
+				Action:     parsedAction.String(),
+				Timeout:    &stepCp.Timeout,
+				Parents:    stepCp.Parents,
+				Retries:    &retries,
+			}
+		}
+
+		jobCp := job
+
+		jobs[i] = repository.CreateWorkflowJobOpts{
+			Name:        jobName,
+			Description: &jobCp.Description,
+			Timeout:     &jobCp.Timeout,
+			Steps:       steps,
+		}
+	}
+
+	var concurrency *repository.CreateWorkflowConcurrencyOpts
+
+	if workflow.Concurrency != nil {
+		limitStrategy := string(types.CancelInProgress)
+
+		if workflow.Concurrency.LimitStrategy != "" {
+			limitStrategy = string(workflow.Concurrency.LimitStrategy)
+		}
+
+		concurrency = &repository.CreateWorkflowConcurrencyOpts{
+			Action:         workflow.Concurrency.ActionID,
+			KeyExpressions: workflow.Concurrency.KeyExpressions,
+			LimitStrategy:  &limitStrategy,
+		}
+
+		if workflow.Concurrency.MaxRuns != 0 {
+			concurrency.MaxRuns = &workflow.Concurrency.MaxRuns
+		}
+	}
+
+	scheduledTriggers := make([]time.Time, 0, len(workflow.Triggers.Schedules))
+
+	for _, trigger := range workflow.Triggers.Schedules {
+		scheduledTriggers = append(scheduledTriggers, trigger.UTC())
+	}
+
+	opts := &repository.CreateWorkflowVersionOpts{
+		Name:              workflow.Name,
+		Concurrency:       concurrency,
+		Description:       &workflow.Description,
+		Version:           &workflow.Version,
+		EventTriggers:     workflow.Triggers.Events,
+		CronTriggers:      workflow.Triggers.Cron,
+		ScheduledTriggers: scheduledTriggers,
+		Jobs:              jobs,
+	}
+
+	if workflow.SLA != "" {
+		opts.SLA = &workflow.SLA
+	}
+
+	return opts, nil
+}
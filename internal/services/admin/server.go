@@ -16,7 +16,13 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/schema"
 	"github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/concurrency"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/joins"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/limits"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/preflight"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/pkg/client/types"
 )
@@ -24,9 +30,15 @@ import (
 func (a *AdminServiceImpl) GetWorkflowByName(ctx context.Context, req *contracts.GetWorkflowByNameRequest) (*contracts.Workflow, error) {
 	tenant := ctx.Value("tenant").(*db.TenantModel)
 
+	name, err := resolveName(ctx, req.Name)
+
+	if err != nil {
+		return nil, err
+	}
+
 	workflow, err := a.repo.Workflow().GetWorkflowByName(
 		tenant.ID,
-		req.Name,
+		name,
 	)
 
 	if err != nil {
@@ -48,9 +60,25 @@ func (a *AdminServiceImpl) GetWorkflowByName(ctx context.Context, req *contracts
 func (a *AdminServiceImpl) TriggerWorkflow(ctx context.Context, req *contracts.TriggerWorkflowRequest) (*contracts.TriggerWorkflowResponse, error) {
 	tenant := ctx.Value("tenant").(*db.TenantModel)
 
+	if err := a.pl.Check(limits.PayloadKindWorkflowInput, len(req.Input)); err != nil {
+		return nil, err
+	}
+
+	hint, err := a.checkBackpressure(tenant.ID, req.GetPriority())
+
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := resolveName(ctx, req.Name)
+
+	if err != nil {
+		return nil, err
+	}
+
 	workflow, err := a.repo.Workflow().GetWorkflowByName(
 		tenant.ID,
-		req.Name,
+		name,
 	)
 
 	if err != nil {
@@ -70,14 +98,59 @@ func (a *AdminServiceImpl) TriggerWorkflow(ctx context.Context, req *contracts.T
 		return nil, fmt.Errorf("workflow with id %s has no versions", workflow.ID)
 	}
 
+	// the latest version only receives part of the triggers while it's rolled out
+	resolved, err := a.repo.Workflow().ResolveWorkflowVersions(ctx, tenant.ID, []string{workflowVersion.ID})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resolved[0] != workflowVersion.ID {
+		workflowVersion, err = a.repo.Workflow().GetWorkflowVersionById(tenant.ID, resolved[0])
+
+		if err != nil {
+			return nil, fmt.Errorf("could not get workflow version: %w", err)
+		}
+	}
+
 	createOpts, err := repository.GetCreateWorkflowRunOptsFromManual(workflowVersion, []byte(req.Input))
 
 	if err != nil {
+		if errors.Is(err, repository.ErrInvalidWorkflowRunInput) {
+			return nil, status.Error(
+				codes.InvalidArgument,
+				err.Error(),
+			)
+		}
+
 		return nil, err
 	}
 
+	if req.AdditionalMetadata != "" {
+		additionalMetadata := map[string]interface{}{}
+
+		if err := json.Unmarshal([]byte(req.AdditionalMetadata), &additionalMetadata); err != nil {
+			return nil, status.Error(
+				codes.InvalidArgument,
+				"additional metadata must be a JSON object",
+			)
+		}
+
+		createOpts.AdditionalMetadata = []byte(req.AdditionalMetadata)
+	}
+
+	// runs which are triggered with an environment token belong to its environment
+	createOpts.Environment = environmentFromContext(ctx)
+
 	workflowRun, err := a.repo.WorkflowRun().CreateNewWorkflowRun(ctx, tenant.ID, createOpts)
 
+	if errors.Is(err, repository.ErrWorkflowPaused) {
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			err.Error(),
+		)
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("could not create workflow run: %w", err)
 	}
@@ -89,9 +162,13 @@ func (a *AdminServiceImpl) TriggerWorkflow(ctx context.Context, req *contracts.T
 		tasktypes.WorkflowRunQueuedToTask(workflowRun),
 	)
 
-	return &contracts.TriggerWorkflowResponse{
+	res := &contracts.TriggerWorkflowResponse{
 		WorkflowRunId: workflowRun.ID,
-	}, nil
+	}
+
+	setBackpressureHint(res, hint)
+
+	return res, nil
 }
 
 func (a *AdminServiceImpl) PutWorkflow(ctx context.Context, req *contracts.PutWorkflowRequest) (*contracts.WorkflowVersion, error) {
@@ -103,257 +180,24 @@ func (a *AdminServiceImpl) PutWorkflow(ctx context.Context, req *contracts.PutWo
 		return nil, err
 	}
 
-	// determine if workflow already exists
-	var workflowVersion *db.WorkflowVersionModel
-	var oldWorkflowVersion *db.WorkflowVersionModel
-
-	currWorkflow, err := a.repo.Workflow().GetWorkflowByName(
-		tenant.ID,
-		req.Opts.Name,
-	)
-
-	var noop bool
-
-	if err != nil {
-		if !errors.Is(err, db.ErrNotFound) {
-			return nil, err
-		}
-
-		// workflow does not exist, create it
-		workflowVersion, err = a.repo.Workflow().CreateNewWorkflow(
-			tenant.ID,
-			createOpts,
-		)
-
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		oldWorkflowVersion = &currWorkflow.Versions()[0]
-
-		// workflow exists, look at checksum
-		newCS, err := createOpts.Checksum()
-
-		if err != nil {
-			return nil, err
-		}
-
-		if oldWorkflowVersion.Checksum != newCS {
-			workflowVersion, err = a.repo.Workflow().CreateWorkflowVersion(
-				tenant.ID,
-				createOpts,
-			)
-
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			noop = true
-
-			workflowVersion = oldWorkflowVersion
+	// the workflow and its event triggers are registered in the namespace of the token
+	if namespace, ok := ctx.Value("namespace").(string); ok {
+		if err := repository.ResolveWorkflowNamespace(namespace, createOpts); err != nil {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
 		}
 	}
 
-	if !noop {
-		// if this is a cron-based workflow, assign the workflow run to a ticker
-		triggers, ok := workflowVersion.Triggers()
+	workflowVersion, err := PutWorkflowVersion(ctx, a.repo, a.mq, tenant.ID, createOpts)
 
-		if !ok {
+	if err != nil {
+		if errors.Is(err, ErrNoTickersAvailable) {
 			return nil, status.Error(
 				codes.FailedPrecondition,
-				"workflow version has no triggers",
+				err.Error(),
 			)
 		}
 
-		if crons := triggers.Crons(); len(crons) > 0 {
-			within := time.Now().UTC().Add(-6 * time.Second)
-
-			tickers, err := a.repo.Ticker().ListTickers(&repository.ListTickerOpts{
-				LatestHeartbeatAt: &within,
-				Active:            repository.BoolPtr(true),
-			})
-
-			if err != nil {
-				return nil, err
-			}
-
-			if len(tickers) == 0 {
-				return nil, status.Error(
-					codes.FailedPrecondition,
-					"no tickers available",
-				)
-			}
-
-			numTickers := len(tickers)
-
-			for i, cronTrigger := range crons {
-				cronTriggerCp := cronTrigger
-				ticker := tickers[i%numTickers]
-
-				_, err := a.repo.Ticker().AddCron(
-					ticker.ID,
-					&cronTriggerCp,
-				)
-
-				if err != nil {
-					return nil, err
-				}
-
-				task, err := cronScheduleTask(&ticker, &cronTriggerCp, workflowVersion)
-
-				if err != nil {
-					return nil, err
-				}
-
-				// send to task queue
-				err = a.mq.AddMessage(
-					ctx,
-					msgqueue.QueueTypeFromTickerID(ticker.ID),
-					task,
-				)
-
-				if err != nil {
-					return nil, err
-				}
-			}
-		}
-
-		if schedules := workflowVersion.Scheduled(); len(schedules) > 0 {
-			within := time.Now().UTC().Add(-6 * time.Second)
-
-			tickers, err := a.repo.Ticker().ListTickers(&repository.ListTickerOpts{
-				LatestHeartbeatAt: &within,
-				Active:            repository.BoolPtr(true),
-			})
-
-			if err != nil {
-				return nil, err
-			}
-
-			if len(tickers) == 0 {
-				return nil, status.Error(
-					codes.FailedPrecondition,
-					"no tickers available",
-				)
-			}
-
-			numTickers := len(tickers)
-
-			for i, scheduledTrigger := range schedules {
-				scheduledTriggerCp := scheduledTrigger
-				ticker := tickers[i%numTickers]
-
-				_, err := a.repo.Ticker().AddScheduledWorkflow(
-					ticker.ID,
-					&scheduledTriggerCp,
-				)
-
-				if err != nil {
-					return nil, err
-				}
-
-				task, err := workflowScheduleTask(&ticker, &scheduledTriggerCp, workflowVersion)
-
-				if err != nil {
-					return nil, err
-				}
-
-				// send to task queue
-				err = a.mq.AddMessage(
-					ctx,
-					msgqueue.QueueTypeFromTickerID(ticker.ID),
-					task,
-				)
-
-				if err != nil {
-					return nil, err
-				}
-			}
-		}
-
-		// cancel the old workflow version
-		if oldWorkflowVersion != nil {
-			oldTriggers, ok := oldWorkflowVersion.Triggers()
-
-			if !ok {
-				return nil, status.Error(
-					codes.FailedPrecondition,
-					"old workflow version has no triggers",
-				)
-			}
-
-			if crons := oldTriggers.Crons(); len(crons) > 0 {
-				for _, cronTrigger := range crons {
-					cronTriggerCp := cronTrigger
-
-					if ticker, ok := cronTrigger.Ticker(); ok {
-						task, err := cronCancelTask(ticker, &cronTriggerCp, workflowVersion)
-
-						if err != nil {
-							return nil, err
-						}
-
-						// send to task queue
-						err = a.mq.AddMessage(
-							ctx,
-							msgqueue.QueueTypeFromTickerID(ticker.ID),
-							task,
-						)
-
-						if err != nil {
-							return nil, err
-						}
-
-						// remove cron
-						_, err = a.repo.Ticker().RemoveCron(
-							ticker.ID,
-							&cronTriggerCp,
-						)
-
-						if err != nil {
-							return nil, err
-						}
-					}
-				}
-			}
-
-			if schedules := oldWorkflowVersion.Scheduled(); len(schedules) > 0 {
-				for _, scheduleTrigger := range schedules {
-					scheduleTriggerCp := scheduleTrigger
-
-					if ticker, ok := scheduleTriggerCp.Ticker(); ok {
-						task, err := workflowCancelTask(ticker, &scheduleTriggerCp, workflowVersion)
-
-						if err != nil {
-							return nil, err
-						}
-
-						// only send to task queue if the trigger is in the future
-						if scheduleTriggerCp.TriggerAt.After(time.Now().UTC()) {
-							err = a.mq.AddMessage(
-								ctx,
-								msgqueue.QueueTypeFromTickerID(ticker.ID),
-								task,
-							)
-
-							if err != nil {
-								return nil, err
-							}
-
-							// remove cron
-							_, err = a.repo.Ticker().RemoveScheduledWorkflow(
-								ticker.ID,
-								&scheduleTriggerCp,
-							)
-
-							if err != nil {
-								return nil, err
-							}
-						}
-					}
-				}
-			}
-		}
+		return nil, err
 	}
 
 	resp := toWorkflowVersion(workflowVersion)
@@ -364,6 +208,10 @@ func (a *AdminServiceImpl) PutWorkflow(ctx context.Context, req *contracts.PutWo
 func (a *AdminServiceImpl) ScheduleWorkflow(ctx context.Context, req *contracts.ScheduleWorkflowRequest) (*contracts.WorkflowVersion, error) {
 	tenant := ctx.Value("tenant").(*db.TenantModel)
 
+	if err := a.pl.Check(limits.PayloadKindWorkflowInput, len(req.Input)); err != nil {
+		return nil, err
+	}
+
 	currWorkflow, err := a.repo.Workflow().GetWorkflowById(
 		req.WorkflowId,
 	)
@@ -376,6 +224,10 @@ func (a *AdminServiceImpl) ScheduleWorkflow(ctx context.Context, req *contracts.
 		return nil, err
 	}
 
+	if err := checkNamespace(ctx, currWorkflow.Name); err != nil {
+		return nil, err
+	}
+
 	workflowVersion := &currWorkflow.Versions()[0]
 
 	if workflowVersion == nil {
@@ -485,6 +337,19 @@ func (a *AdminServiceImpl) ScheduleWorkflow(ctx context.Context, req *contracts.
 func (a *AdminServiceImpl) DeleteWorkflow(ctx context.Context, req *contracts.DeleteWorkflowRequest) (*contracts.Workflow, error) {
 	tenant := ctx.Value("tenant").(*db.TenantModel)
 
+	// tokens with a namespace can only delete the workflows of the namespace
+	if namespaceFromContext(ctx) != nil {
+		workflow, err := a.repo.Workflow().GetWorkflowById(req.WorkflowId)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := checkNamespace(ctx, workflow.Name); err != nil {
+			return nil, err
+		}
+	}
+
 	workflow, err := a.repo.Workflow().DeleteWorkflow(
 		tenant.ID,
 		req.WorkflowId,
@@ -494,6 +359,17 @@ func (a *AdminServiceImpl) DeleteWorkflow(ctx context.Context, req *contracts.De
 		return nil, err
 	}
 
+	// drop the cached versions of the workflow in the controllers
+	err = a.mq.AddMessage(
+		ctx,
+		msgqueue.WORKFLOW_PROCESSING_QUEUE,
+		tasktypes.WorkflowUpdatedToTask(tenant.ID, workflow.ID),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
 	resp := toWorkflow(workflow)
 
 	return resp, nil
@@ -507,7 +383,10 @@ func (a *AdminServiceImpl) ListWorkflows(
 
 	listResp, err := a.repo.Workflow().ListWorkflows(
 		tenant.ID,
-		&repository.ListWorkflowsOpts{},
+		&repository.ListWorkflowsOpts{
+			Environment: environmentFromContext(ctx),
+			Namespace:   namespaceFromContext(ctx),
+		},
 	)
 
 	if err != nil {
@@ -531,10 +410,18 @@ func (a *AdminServiceImpl) ListWorkflowsForEvent(
 ) (*contracts.ListWorkflowsResponse, error) {
 	tenant := ctx.Value("tenant").(*db.TenantModel)
 
+	eventKey, err := resolveName(ctx, req.EventKey)
+
+	if err != nil {
+		return nil, err
+	}
+
 	listResp, err := a.repo.Workflow().ListWorkflows(
 		tenant.ID,
 		&repository.ListWorkflowsOpts{
-			EventKey: &req.EventKey,
+			EventKey:    &eventKey,
+			Environment: environmentFromContext(ctx),
+			Namespace:   namespaceFromContext(ctx),
 		},
 	)
 
@@ -573,16 +460,93 @@ func getCreateWorkflowOpts(req *contracts.PutWorkflowRequest) (*repository.Creat
 			retries := int(stepCp.Retries)
 
 			steps[j] = repository.CreateWorkflowStepOpts{
-				ReadableId: stepCp.ReadableId,
-				Action:     parsedAction.String(),
-				Timeout:    &stepCp.Timeout,
-				Parents:    stepCp.Parents,
-				Retries:    &retries,
+				ReadableId:      stepCp.ReadableId,
+				Action:          parsedAction.String(),
+				Timeout:         &stepCp.Timeout,
+				Parents:         stepCp.Parents,
+				Retries:         &retries,
+				ScheduleTimeout: stepCp.ScheduleTimeout,
+				CostCenter:      stepCp.CostCenter,
 			}
 
 			if stepCp.UserData != "" {
 				steps[j].UserData = &stepCp.UserData
 			}
+
+			if stepCp.Cpu != 0 {
+				steps[j].Cpu = &stepCp.Cpu
+			}
+
+			if stepCp.MemoryMb != 0 {
+				memoryMb := int(stepCp.MemoryMb)
+				steps[j].MemoryMb = &memoryMb
+			}
+
+			if stepCp.Gpu != 0 {
+				gpu := int(stepCp.Gpu)
+				steps[j].Gpu = &gpu
+			}
+
+			if stepCp.CancelGracePeriod != "" {
+				steps[j].CancelGracePeriod = &stepCp.CancelGracePeriod
+			}
+
+			if stepCp.MaxTimeout != "" {
+				steps[j].MaxTimeout = &stepCp.MaxTimeout
+			}
+
+			if stepCp.OnTimeout != nil {
+				parsedOnTimeout, err := types.ParseActionID(*stepCp.OnTimeout)
+
+				if err != nil {
+					return nil, err
+				}
+
+				onTimeout := parsedOnTimeout.String()
+				steps[j].OnTimeout = &onTimeout
+			}
+
+			for _, check := range stepCp.PreflightChecks {
+				if err := preflight.Validate(check.Kind, check.Target); err != nil {
+					return nil, status.Error(
+						codes.InvalidArgument,
+						fmt.Sprintf("invalid preflight check of step %s: %s", stepCp.ReadableId, err.Error()),
+					)
+				}
+
+				checkOpts := repository.CreateStepPreflightCheckOpts{
+					Kind:   check.Kind,
+					Target: check.Target,
+				}
+
+				if check.Timeout != "" {
+					checkOpts.Timeout = repository.StringPtr(check.Timeout)
+				}
+
+				steps[j].PreflightChecks = append(steps[j].PreflightChecks, checkOpts)
+			}
+
+			if stepCp.JoinStrategy != "" || stepCp.JoinQuorum != 0 {
+				joinStrategy := stepCp.JoinStrategy
+
+				if joinStrategy == "" {
+					joinStrategy = string(dbsqlc.StepJoinStrategyALL)
+				}
+
+				if err := joins.Validate(dbsqlc.StepJoinStrategy(joinStrategy), int(stepCp.JoinQuorum), len(stepCp.Parents)); err != nil {
+					return nil, status.Error(
+						codes.InvalidArgument,
+						fmt.Sprintf("invalid join of step %s: %s", stepCp.ReadableId, err.Error()),
+					)
+				}
+
+				steps[j].JoinStrategy = &joinStrategy
+
+				if stepCp.JoinQuorum != 0 {
+					joinQuorum := int(stepCp.JoinQuorum)
+					steps[j].JoinQuorum = &joinQuorum
+				}
+			}
 		}
 
 		jobs[i] = repository.CreateWorkflowJobOpts{
@@ -599,7 +563,7 @@ func getCreateWorkflowOpts(req *contracts.PutWorkflowRequest) (*repository.Creat
 		scheduledTriggers = append(scheduledTriggers, trigger.AsTime())
 	}
 
-	var concurrency *repository.CreateWorkflowConcurrencyOpts
+	var concurrencyOpts *repository.CreateWorkflowConcurrencyOpts
 
 	if req.Opts.Concurrency != nil {
 		var limitStrategy *string
@@ -608,26 +572,73 @@ func getCreateWorkflowOpts(req *contracts.PutWorkflowRequest) (*repository.Creat
 			limitStrategy = repository.StringPtr(req.Opts.Concurrency.LimitStrategy.String())
 		}
 
-		concurrency = &repository.CreateWorkflowConcurrencyOpts{
-			Action:        req.Opts.Concurrency.Action,
-			LimitStrategy: limitStrategy,
+		// the concurrency group is either computed by an action on a worker, or by the engine from key expressions
+		if keyExpressions := req.Opts.Concurrency.KeyExpressions; len(keyExpressions) > 0 {
+			if req.Opts.Concurrency.Action != "" {
+				return nil, status.Error(
+					codes.InvalidArgument,
+					"concurrency can't have both an action and key expressions",
+				)
+			}
+
+			if _, err := concurrency.CompileCompositeKey(keyExpressions); err != nil {
+				return nil, status.Errorf(
+					codes.InvalidArgument,
+					"invalid concurrency key expressions: %s",
+					err.Error(),
+				)
+			}
+		}
+
+		concurrencyOpts = &repository.CreateWorkflowConcurrencyOpts{
+			Action:         req.Opts.Concurrency.Action,
+			KeyExpressions: req.Opts.Concurrency.KeyExpressions,
+			LimitStrategy:  limitStrategy,
 		}
 
 		if req.Opts.Concurrency.MaxRuns != 0 {
-			concurrency.MaxRuns = &req.Opts.Concurrency.MaxRuns
+			concurrencyOpts.MaxRuns = &req.Opts.Concurrency.MaxRuns
+		}
+	}
+
+	if req.Opts.DefaultInput != nil {
+		defaultInput := map[string]interface{}{}
+
+		if err := json.Unmarshal([]byte(*req.Opts.DefaultInput), &defaultInput); err != nil {
+			return nil, status.Error(
+				codes.InvalidArgument,
+				"default input must be a JSON object",
+			)
+		}
+	}
+
+	if req.Opts.InputSchema != nil {
+		if err := schema.Compile([]byte(*req.Opts.InputSchema)); err != nil {
+			return nil, status.Error(
+				codes.InvalidArgument,
+				err.Error(),
+			)
 		}
 	}
 
 	return &repository.CreateWorkflowVersionOpts{
-		Name:              req.Opts.Name,
-		Concurrency:       concurrency,
-		Description:       &req.Opts.Description,
-		Version:           &req.Opts.Version,
-		EventTriggers:     req.Opts.EventTriggers,
-		CronTriggers:      req.Opts.CronTriggers,
-		ScheduledTriggers: scheduledTriggers,
-		Jobs:              jobs,
-		ScheduleTimeout:   req.Opts.ScheduleTimeout,
+		Name:               req.Opts.Name,
+		Concurrency:        concurrencyOpts,
+		Description:        &req.Opts.Description,
+		Version:            &req.Opts.Version,
+		EventTriggers:      req.Opts.EventTriggers,
+		CronTriggers:       req.Opts.CronTriggers,
+		ScheduledTriggers:  scheduledTriggers,
+		Jobs:               jobs,
+		ScheduleTimeout:    req.Opts.ScheduleTimeout,
+		SLA:                req.Opts.Sla,
+		DefaultInput:       req.Opts.DefaultInput,
+		InputSchema:        req.Opts.InputSchema,
+		PinToBuild:         req.Opts.PinToBuild,
+		AssignmentStrategy: req.Opts.AssignmentStrategy,
+		MaxStepExecutions:  req.Opts.MaxStepExecutions,
+		MaxStepRetries:     req.Opts.MaxStepRetries,
+		CostCenter:         req.Opts.CostCenter,
 	}, nil
 }
 
@@ -855,3 +866,45 @@ func workflowCancelTask(ticker *db.TickerModel, workflowTriggerRef *db.WorkflowT
 		Retries:  3,
 	}, nil
 }
+
+// environmentFromContext returns the environment of the token which authenticated the request, if it has one.
+func environmentFromContext(ctx context.Context) *string {
+	if environment, ok := ctx.Value("environment").(string); ok && environment != "" {
+		return &environment
+	}
+
+	return nil
+}
+
+// namespaceFromContext returns the namespace of the token which authenticated the request, if it has one.
+func namespaceFromContext(ctx context.Context) *string {
+	if namespace, ok := ctx.Value("namespace").(string); ok && namespace != "" {
+		return &namespace
+	}
+
+	return nil
+}
+
+// resolveName resolves a workflow name or an event key to the namespace of the token which authenticated the
+// request.
+func resolveName(ctx context.Context, name string) (string, error) {
+	namespace, _ := ctx.Value("namespace").(string)
+
+	resolved, err := repository.ResolveNamespace(namespace, name)
+
+	if err != nil {
+		return "", status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	return resolved, nil
+}
+
+// checkNamespace returns an error if the token which authenticated the request can't access a workflow with the
+// given name, because the token has a different namespace.
+func checkNamespace(ctx context.Context, name string) error {
+	if namespace, ok := ctx.Value("namespace").(string); ok && namespace != "" && !repository.InNamespace(namespace, name) {
+		return status.Error(codes.PermissionDenied, repository.ErrOutsideNamespace.Error())
+	}
+
+	return nil
+}
@@ -2,7 +2,9 @@ package events
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/rs/zerolog"
@@ -14,6 +16,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/settings"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
 )
@@ -27,15 +30,19 @@ type EventsControllerImpl struct {
 	l    *zerolog.Logger
 	repo repository.Repository
 	dv   datautils.DataDecoderValidator
+
+	// settings resolves the maximum number of workflow runs which an event triggers
+	settings settings.Service
 }
 
 type EventsControllerOpt func(*EventsControllerOpts)
 
 type EventsControllerOpts struct {
-	mq   msgqueue.MessageQueue
-	l    *zerolog.Logger
-	repo repository.Repository
-	dv   datautils.DataDecoderValidator
+	mq       msgqueue.MessageQueue
+	l        *zerolog.Logger
+	repo     repository.Repository
+	dv       datautils.DataDecoderValidator
+	settings settings.Service
 }
 
 func defaultEventsControllerOpts() *EventsControllerOpts {
@@ -70,6 +77,14 @@ func WithDataDecoderValidator(dv datautils.DataDecoderValidator) EventsControlle
 	}
 }
 
+// WithEngineSettings sets the service which resolves the engine settings of tenants. If not set, the controller
+// reads the settings from its repository.
+func WithEngineSettings(s settings.Service) EventsControllerOpt {
+	return func(opts *EventsControllerOpts) {
+		opts.settings = s
+	}
+}
+
 func New(fs ...EventsControllerOpt) (*EventsControllerImpl, error) {
 	opts := defaultEventsControllerOpts()
 
@@ -88,11 +103,16 @@ func New(fs ...EventsControllerOpt) (*EventsControllerImpl, error) {
 	newLogger := opts.l.With().Str("service", "events-controller").Logger()
 	opts.l = &newLogger
 
+	if opts.settings == nil {
+		opts.settings = settings.NewService(opts.repo.EngineSettings(), opts.l)
+	}
+
 	return &EventsControllerImpl{
-		mq:   opts.mq,
-		l:    opts.l,
-		repo: opts.repo,
-		dv:   opts.dv,
+		mq:       opts.mq,
+		l:        opts.l,
+		repo:     opts.repo,
+		dv:       opts.dv,
+		settings: opts.settings,
 	}, nil
 }
 
@@ -105,9 +125,11 @@ func (ec *EventsControllerImpl) Start() (func() error, error) {
 		wg.Add(1)
 		defer wg.Done()
 
-		err := ec.handleTask(ctx, task)
+		taskCtx := msgqueue.ContextForMessage(ctx, task)
+
+		err := ec.handleTask(taskCtx, task)
 		if err != nil {
-			ec.l.Error().Err(err).Msgf("could not handle event task %s", task.ID)
+			msgqueue.Logger(taskCtx, ec.l).Error().Err(err).Msgf("could not handle event task %s", task.ID)
 			return err
 		}
 
@@ -126,6 +148,9 @@ func (ec *EventsControllerImpl) Start() (func() error, error) {
 		if err := cleanupQueue(); err != nil {
 			return fmt.Errorf("could not cleanup event processing queue: %w", err)
 		}
+
+		wg.Wait()
+
 		return nil
 	}
 
@@ -171,6 +196,21 @@ func (ec *EventsControllerImpl) processEvent(ctx context.Context, event *dbsqlc.
 		return fmt.Errorf("could not query workflows for event: %w", err)
 	}
 
+	// an event which matches more workflows than the tenant allows only triggers the first workflows by name, so the
+	// same workflows are triggered when the event is replayed
+	if maxFanOut := ec.settings.Get(tenantId).MaxFanOut; maxFanOut > 0 && len(workflowVersions) > maxFanOut {
+		sort.Slice(workflowVersions, func(i, j int) bool {
+			return workflowVersions[i].WorkflowName < workflowVersions[j].WorkflowName
+		})
+
+		ec.l.Warn().Msgf(
+			"event %s matches %d workflows, which is more than the maximum fan-out of %d, only triggering the first %d",
+			sqlchelpers.UUIDToStr(event.ID), len(workflowVersions), maxFanOut, maxFanOut,
+		)
+
+		workflowVersions = workflowVersions[:maxFanOut]
+	}
+
 	// create a new workflow run in the database
 	var g = new(errgroup.Group)
 
@@ -185,15 +225,26 @@ func (ec *EventsControllerImpl) processEvent(ctx context.Context, event *dbsqlc.
 				return fmt.Errorf("could not get create workflow run opts: %w", err)
 			}
 
+			// the run is traced back to the request which pushed the event by its correlation id
+			if correlationId := msgqueue.CorrelationIDFromContext(ctx); correlationId != "" {
+				createOpts.CorrelationId = &correlationId
+			}
+
 			workflowRun, err := ec.repo.WorkflowRun().CreateNewWorkflowRun(ctx, tenantId, createOpts)
 
+			// the event is still handled for the other workflows, and isn't retried for the paused one
+			if errors.Is(err, repository.ErrWorkflowPaused) {
+				ec.l.Info().Msgf("workflow version %s is paused, skipping event %s", sqlchelpers.UUIDToStr(workflowCp.WorkflowVersion.ID), sqlchelpers.UUIDToStr(event.ID))
+				return nil
+			}
+
 			if err != nil {
 				return fmt.Errorf("could not create workflow run: %w", err)
 			}
 
 			// send to workflow processing queue
 			return ec.mq.AddMessage(
-				context.Background(),
+				ctx,
 				msgqueue.WORKFLOW_PROCESSING_QUEUE,
 				tasktypes.WorkflowRunQueuedToTask(workflowRun),
 			)
@@ -0,0 +1,45 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/budget"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+)
+
+// addBudgetUsage adds to the usage of the workflow run of a step run, and returns whether the run exceeded the budget
+// of its workflow version. The workflows controller is notified of runs which exceed their budget, and fails them.
+func (ec *JobsControllerImpl) addBudgetUsage(ctx context.Context, tenantId string, stepRun *dbsqlc.GetStepRunForEngineRow, opts *repository.AddWorkflowRunBudgetUsageOpts) (bool, error) {
+	workflowRunId := sqlchelpers.UUIDToStr(stepRun.WorkflowRunId)
+
+	usage, err := ec.repo.WorkflowRun().AddWorkflowRunBudgetUsage(ctx, tenantId, workflowRunId, opts)
+
+	if err != nil {
+		return false, fmt.Errorf("could not add workflow run budget usage: %w", err)
+	}
+
+	exceededErr := budget.FromRow(usage).Exceeded()
+
+	if exceededErr == nil {
+		return false, nil
+	}
+
+	msgqueue.Logger(ctx, ec.l).Info().Err(exceededErr).Msgf("workflow run %s exceeded its budget", workflowRunId)
+
+	err = ec.mq.AddMessage(
+		ctx,
+		msgqueue.WORKFLOW_PROCESSING_QUEUE,
+		tasktypes.WorkflowRunBudgetExceededToTask(tenantId, workflowRunId, exceededErr.Error()),
+	)
+
+	if err != nil {
+		return true, fmt.Errorf("could not add workflow run budget exceeded task to task queue: %w", err)
+	}
+
+	return true, nil
+}
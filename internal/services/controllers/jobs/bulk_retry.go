@@ -0,0 +1,195 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/goccy/go-json"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+)
+
+// bulkRetryBatchSize is the number of workflow runs which are re-queued before the progress of a bulk
+// retry is written back.
+const bulkRetryBatchSize = 100
+
+func (ec *JobsControllerImpl) handleWorkflowRunBulkRetry(ctx context.Context, task *msgqueue.Message) error {
+	ctx, span := telemetry.NewSpan(ctx, "handle-workflow-run-bulk-retry")
+	defer span.End()
+
+	payload := tasktypes.WorkflowRunBulkRetryTaskPayload{}
+	metadata := tasktypes.WorkflowRunBulkRetryTaskMetadata{}
+
+	err := ec.dv.DecodeAndValidate(task.Payload, &payload)
+
+	if err != nil {
+		return fmt.Errorf("could not decode bulk retry task payload: %w", err)
+	}
+
+	err = ec.dv.DecodeAndValidate(task.Metadata, &metadata)
+
+	if err != nil {
+		return fmt.Errorf("could not decode bulk retry task metadata: %w", err)
+	}
+
+	bulkRetry, err := ec.repo.WorkflowRun().GetWorkflowRunBulkRetryById(metadata.TenantId, payload.BulkRetryId)
+
+	if err != nil {
+		return fmt.Errorf("could not get bulk retry: %w", err)
+	}
+
+	// the task may be redelivered after the bulk retry has finished
+	if bulkRetry.Status == db.WorkflowRunBulkRetryStatusSucceeded || bulkRetry.Status == db.WorkflowRunBulkRetryStatusFailed {
+		return nil
+	}
+
+	filter := repository.WorkflowRunBulkRetryFilter{}
+
+	if err := json.Unmarshal(bulkRetry.Filter, &filter); err != nil {
+		return ec.failBulkRetry(metadata.TenantId, bulkRetry.ID, fmt.Errorf("could not decode bulk retry filter: %w", err))
+	}
+
+	// counts are reset when a redelivered task starts over. Runs which were already re-queued are no
+	// longer failed, so they are not matched again.
+	var matched, retried, failed int
+	now := time.Now().UTC()
+
+	_, err = ec.repo.WorkflowRun().UpdateWorkflowRunBulkRetry(metadata.TenantId, bulkRetry.ID, &repository.UpdateWorkflowRunBulkRetryOpts{
+		Status:       repository.WorkflowRunBulkRetryStatusPtr(db.WorkflowRunBulkRetryStatusRunning),
+		StartedAt:    &now,
+		MatchedCount: &matched,
+		RetriedCount: &retried,
+		FailedCount:  &failed,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not update bulk retry: %w", err)
+	}
+
+	var after *repository.WorkflowRunExportCursor
+
+	for {
+		runs, err := ec.repo.WorkflowRun().ListFailedWorkflowRunsForRetry(ctx, metadata.TenantId, &repository.ListFailedWorkflowRunsForRetryOpts{
+			Filter: filter,
+			Before: bulkRetry.CreatedAt,
+			After:  after,
+			Limit:  bulkRetryBatchSize,
+		})
+
+		if err != nil {
+			return ec.failBulkRetry(metadata.TenantId, bulkRetry.ID, fmt.Errorf("could not list failed workflow runs: %w", err))
+		}
+
+		for _, run := range runs {
+			matched++
+
+			if err := ec.retryFailedWorkflowRun(ctx, metadata.TenantId, sqlchelpers.UUIDToStr(run.ID)); err != nil {
+				msgqueue.Logger(ctx, ec.l).Err(err).Msgf("could not retry workflow run %s", sqlchelpers.UUIDToStr(run.ID))
+				failed++
+			} else {
+				retried++
+			}
+		}
+
+		_, err = ec.repo.WorkflowRun().UpdateWorkflowRunBulkRetry(metadata.TenantId, bulkRetry.ID, &repository.UpdateWorkflowRunBulkRetryOpts{
+			MatchedCount: &matched,
+			RetriedCount: &retried,
+			FailedCount:  &failed,
+		})
+
+		if err != nil {
+			return fmt.Errorf("could not update bulk retry progress: %w", err)
+		}
+
+		if len(runs) < bulkRetryBatchSize {
+			break
+		}
+
+		last := runs[len(runs)-1]
+
+		after = &repository.WorkflowRunExportCursor{
+			CreatedAt: last.CreatedAt.Time,
+			ID:        sqlchelpers.UUIDToStr(last.ID),
+		}
+	}
+
+	finishedAt := time.Now().UTC()
+
+	_, err = ec.repo.WorkflowRun().UpdateWorkflowRunBulkRetry(metadata.TenantId, bulkRetry.ID, &repository.UpdateWorkflowRunBulkRetryOpts{
+		Status:     repository.WorkflowRunBulkRetryStatusPtr(db.WorkflowRunBulkRetryStatusSucceeded),
+		FinishedAt: &finishedAt,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not update bulk retry: %w", err)
+	}
+
+	return nil
+}
+
+// retryFailedWorkflowRun re-queues every failed step run in a workflow run with its existing input,
+// in the same way as a manual step run rerun.
+func (ec *JobsControllerImpl) retryFailedWorkflowRun(ctx context.Context, tenantId, workflowRunId string) error {
+	stepRuns, err := ec.repo.StepRun().ListStepRuns(tenantId, &repository.ListStepRunsOpts{
+		WorkflowRunId: &workflowRunId,
+		Status:        repository.StepRunStatusPtr(db.StepRunStatusFailed),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not list failed step runs: %w", err)
+	}
+
+	if len(stepRuns) == 0 {
+		return fmt.Errorf("workflow run has no failed step runs")
+	}
+
+	for _, stepRun := range stepRuns {
+		// set the job run and workflow run to running status
+		err = ec.repo.JobRun().SetJobRunStatusRunning(tenantId, stepRun.JobRunID)
+
+		if err != nil {
+			return fmt.Errorf("could not set job run to running: %w", err)
+		}
+
+		engineStepRun, err := ec.repo.StepRun().GetStepRunForEngine(tenantId, stepRun.ID)
+
+		if err != nil {
+			return fmt.Errorf("could not get step run for engine: %w", err)
+		}
+
+		err = ec.mq.AddMessage(
+			ctx,
+			msgqueue.JOB_PROCESSING_QUEUE,
+			tasktypes.StepRunRetryToTask(engineStepRun, nil),
+		)
+
+		if err != nil {
+			return fmt.Errorf("could not add step run retry task to task queue: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (ec *JobsControllerImpl) failBulkRetry(tenantId, bulkRetryId string, cause error) error {
+	finishedAt := time.Now().UTC()
+	errStr := cause.Error()
+
+	_, err := ec.repo.WorkflowRun().UpdateWorkflowRunBulkRetry(tenantId, bulkRetryId, &repository.UpdateWorkflowRunBulkRetryOpts{
+		Status:     repository.WorkflowRunBulkRetryStatusPtr(db.WorkflowRunBulkRetryStatusFailed),
+		Error:      &errStr,
+		FinishedAt: &finishedAt,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not mark bulk retry as failed: %w (cause: %s)", err, errStr)
+	}
+
+	return cause
+}
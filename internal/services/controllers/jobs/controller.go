@@ -11,18 +11,26 @@ import (
 	"github.com/goccy/go-json"
 
 	"github.com/go-co-op/gocron/v2"
+	"github.com/google/uuid"
+	"github.com/hashicorp/golang-lru/v2/expirable"
 	"github.com/rs/zerolog"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/hatchet-dev/hatchet/internal/blobstore"
 	"github.com/hatchet-dev/hatchet/internal/datautils"
 	"github.com/hatchet-dev/hatchet/internal/datautils/merge"
+	"github.com/hatchet-dev/hatchet/internal/datautils/redact"
 	"github.com/hatchet-dev/hatchet/internal/logger"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
-	"github.com/hatchet-dev/hatchet/internal/services/shared/defaults"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/assignment"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/budget"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/leader"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/preflight"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/settings"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
 	"github.com/hatchet-dev/hatchet/internal/telemetry/servertel"
@@ -41,8 +49,35 @@ type JobsControllerImpl struct {
 	dv   datautils.DataDecoderValidator
 	s    gocron.Scheduler
 	a    *hatcheterrors.Wrapped
+
+	// elector makes sure that the requeue and reassign loops only run on one replica
+	elector *leader.Elector
+
+	// requeueTasks are the tasks of the requeue and reassign loops by job id, so their interval can be changed
+	requeueMu       sync.Mutex
+	requeueInterval time.Duration
+	requeueTasks    map[uuid.UUID]func()
+
+	// redactionRules caches the compiled redaction rules of each tenant
+	redactionRules *expirable.LRU[string, *redact.Rules]
+
+	// selector selects the worker which a step run is assigned to
+	selector *assignment.Selector
+
+	// preflight runs the preflight checks of steps before their step runs are assigned
+	preflight *preflight.Checker
+
+	// settings resolves the timeouts and retries of step runs whose step doesn't set them
+	settings settings.Service
+
+	// objectStore stores the archives of tenant exports. If nil, archives are stored in the database.
+	objectStore blobstore.Store
 }
 
+// redactionRulesTTL is how long the redaction rules of a tenant are cached, so changes to the rules take up to
+// this long to apply to new step run inputs and outputs.
+const redactionRulesTTL = 30 * time.Second
+
 type JobsControllerOpt func(*JobsControllerOpts)
 
 type JobsControllerOpts struct {
@@ -51,6 +86,14 @@ type JobsControllerOpts struct {
 	repo    repository.Repository
 	dv      datautils.DataDecoderValidator
 	alerter hatcheterrors.Alerter
+
+	requeueInterval time.Duration
+
+	assignmentOpts []assignment.SelectorOpt
+
+	settings settings.Service
+
+	objectStore blobstore.Store
 }
 
 func defaultJobsControllerOpts() *JobsControllerOpts {
@@ -58,9 +101,10 @@ func defaultJobsControllerOpts() *JobsControllerOpts {
 	alerter := hatcheterrors.NoOpAlerter{}
 
 	return &JobsControllerOpts{
-		l:       &logger,
-		dv:      datautils.NewDataDecoderValidator(),
-		alerter: alerter,
+		l:               &logger,
+		dv:              datautils.NewDataDecoderValidator(),
+		alerter:         alerter,
+		requeueInterval: 5 * time.Second,
 	}
 }
 
@@ -94,6 +138,38 @@ func WithDataDecoderValidator(dv datautils.DataDecoderValidator) JobsControllerO
 	}
 }
 
+// WithRequeueInterval sets the interval of the step run requeue and reassign loops.
+func WithRequeueInterval(interval time.Duration) JobsControllerOpt {
+	return func(opts *JobsControllerOpts) {
+		opts.requeueInterval = interval
+	}
+}
+
+// WithAssignmentStrategy registers a strategy for assigning step runs to workers, which is used for the step runs
+// of tenants and workflows which use the given strategy name. This replaces the built-in strategy of the name if
+// there is one.
+func WithAssignmentStrategy(name dbsqlc.WorkerAssignmentStrategy, strategy assignment.Strategy) JobsControllerOpt {
+	return func(opts *JobsControllerOpts) {
+		opts.assignmentOpts = append(opts.assignmentOpts, assignment.WithStrategy(name, strategy))
+	}
+}
+
+// WithEngineSettings sets the service which resolves the engine settings of tenants. If not set, the controller
+// reads the settings from its repository.
+func WithEngineSettings(s settings.Service) JobsControllerOpt {
+	return func(opts *JobsControllerOpts) {
+		opts.settings = s
+	}
+}
+
+// WithObjectStore sets the object store which the archives of tenant exports are written to. If not set, archives
+// are stored in the database.
+func WithObjectStore(store blobstore.Store) JobsControllerOpt {
+	return func(opts *JobsControllerOpts) {
+		opts.objectStore = store
+	}
+}
+
 func New(fs ...JobsControllerOpt) (*JobsControllerImpl, error) {
 	opts := defaultJobsControllerOpts()
 
@@ -109,10 +185,16 @@ func New(fs ...JobsControllerOpt) (*JobsControllerImpl, error) {
 		return nil, fmt.Errorf("repository is required. use WithRepository")
 	}
 
+	if err := leader.ValidateInterval(opts.requeueInterval); err != nil {
+		return nil, fmt.Errorf("invalid requeue interval: %w", err)
+	}
+
 	newLogger := opts.l.With().Str("service", "jobs-controller").Logger()
 	opts.l = &newLogger
 
-	s, err := gocron.NewScheduler(gocron.WithLocation(time.UTC))
+	elector := leader.NewElector(opts.repo.LeaderLease(), opts.l, "jobs-controller")
+
+	s, err := gocron.NewScheduler(gocron.WithLocation(time.UTC), gocron.WithDistributedElector(elector))
 
 	if err != nil {
 		return nil, fmt.Errorf("could not create scheduler: %w", err)
@@ -121,6 +203,10 @@ func New(fs ...JobsControllerOpt) (*JobsControllerImpl, error) {
 	a := hatcheterrors.NewWrapped(opts.alerter)
 	a.WithData(map[string]interface{}{"service": "jobs-controller"})
 
+	if opts.settings == nil {
+		opts.settings = settings.NewService(opts.repo.EngineSettings(), opts.l)
+	}
+
 	return &JobsControllerImpl{
 		mq:   opts.mq,
 		l:    opts.l,
@@ -128,6 +214,21 @@ func New(fs ...JobsControllerOpt) (*JobsControllerImpl, error) {
 		dv:   opts.dv,
 		s:    s,
 		a:    a,
+
+		elector: elector,
+
+		requeueInterval: opts.requeueInterval,
+		requeueTasks:    map[uuid.UUID]func(){},
+
+		redactionRules: expirable.NewLRU[string, *redact.Rules](1000, nil, redactionRulesTTL),
+
+		selector: assignment.NewSelector(opts.assignmentOpts...),
+
+		preflight: preflight.NewChecker(),
+
+		settings: opts.settings,
+
+		objectStore: opts.objectStore,
 	}, nil
 }
 
@@ -136,24 +237,14 @@ func (jc *JobsControllerImpl) Start() (func() error, error) {
 
 	wg := sync.WaitGroup{}
 
-	_, err := jc.s.NewJob(
-		gocron.DurationJob(time.Second*5),
-		gocron.NewTask(
-			jc.runStepRunRequeue(ctx),
-		),
-	)
+	err := jc.scheduleRequeueTask(jc.runStepRunRequeue(ctx))
 
 	if err != nil {
 		cancel()
 		return nil, fmt.Errorf("could not schedule step run requeue: %w", err)
 	}
 
-	_, err = jc.s.NewJob(
-		gocron.DurationJob(time.Second*5),
-		gocron.NewTask(
-			jc.runStepRunReassign(ctx),
-		),
-	)
+	err = jc.scheduleRequeueTask(jc.runStepRunReassign(ctx))
 
 	if err != nil {
 		cancel()
@@ -166,9 +257,11 @@ func (jc *JobsControllerImpl) Start() (func() error, error) {
 		wg.Add(1)
 		defer wg.Done()
 
-		err := jc.handleTask(context.Background(), task)
+		ctx := msgqueue.ContextForMessage(context.Background(), task)
+
+		err := jc.handleTask(ctx, task)
 		if err != nil {
-			jc.l.Error().Err(err).Msg("could not handle job task")
+			msgqueue.Logger(ctx, jc.l).Error().Err(err).Msg("could not handle job task")
 			return jc.a.WrapErr(fmt.Errorf("could not handle job task: %w", err), map[string]interface{}{"task_id": task.ID}) // nolint: errcheck
 		}
 
@@ -193,6 +286,10 @@ func (jc *JobsControllerImpl) Start() (func() error, error) {
 			return fmt.Errorf("could not shutdown scheduler: %w", err)
 		}
 
+		if err := jc.elector.Release(context.Background()); err != nil {
+			return fmt.Errorf("could not release leader lease: %w", err)
+		}
+
 		wg.Wait()
 
 		return nil
@@ -201,6 +298,48 @@ func (jc *JobsControllerImpl) Start() (func() error, error) {
 	return cleanup, nil
 }
 
+func (jc *JobsControllerImpl) scheduleRequeueTask(task func()) error {
+	jc.requeueMu.Lock()
+	defer jc.requeueMu.Unlock()
+
+	j, err := jc.s.NewJob(
+		gocron.DurationJob(jc.requeueInterval),
+		gocron.NewTask(task),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	jc.requeueTasks[j.ID()] = task
+
+	return nil
+}
+
+// SetRequeueInterval changes the interval of the step run requeue and reassign loops while the controller is running.
+func (jc *JobsControllerImpl) SetRequeueInterval(interval time.Duration) error {
+	if err := leader.ValidateInterval(interval); err != nil {
+		return fmt.Errorf("invalid requeue interval: %w", err)
+	}
+
+	jc.requeueMu.Lock()
+	defer jc.requeueMu.Unlock()
+
+	if interval == jc.requeueInterval {
+		return nil
+	}
+
+	for id, task := range jc.requeueTasks {
+		if _, err := jc.s.Update(id, gocron.DurationJob(interval), gocron.NewTask(task)); err != nil {
+			return fmt.Errorf("could not update requeue interval: %w", err)
+		}
+	}
+
+	jc.requeueInterval = interval
+
+	return nil
+}
+
 func (ec *JobsControllerImpl) handleTask(ctx context.Context, task *msgqueue.Message) error {
 	switch task.ID {
 	case "job-run-queued":
@@ -219,13 +358,49 @@ func (ec *JobsControllerImpl) handleTask(ctx context.Context, task *msgqueue.Mes
 		return ec.handleStepRunCancelled(ctx, task)
 	case "step-run-timed-out":
 		return ec.handleStepRunTimedOut(ctx, task)
+	case "step-run-released":
+		return ec.handleStepRunReleased(ctx, task)
+	case "step-run-timeout-hook":
+		return ec.handleStepRunTimeoutHook(ctx, task)
 	case "ticker-removed":
 		return ec.handleTickerRemoved(ctx, task)
+	case "maintenance-job":
+		return ec.handleMaintenanceJob(ctx, task)
+	case "workflow-run-bulk-retry":
+		return ec.handleWorkflowRunBulkRetry(ctx, task)
+	case "tenant-export":
+		return ec.handleTenantExport(ctx, task)
+	case msgqueue.BatchMessageID:
+		return ec.handleMessageBatch(ctx, task)
 	}
 
 	return fmt.Errorf("unknown task: %s", task.ID)
 }
 
+// handleMessageBatch handles the messages of a batch in order. Messages which fail are added back to the queue
+// one by one, so that they are retried without handling the rest of the batch again.
+func (ec *JobsControllerImpl) handleMessageBatch(ctx context.Context, task *msgqueue.Message) error {
+	messages, err := msgqueue.UnbatchMessage(task)
+
+	if err != nil {
+		return fmt.Errorf("could not unbatch message: %w", err)
+	}
+
+	for _, msg := range messages {
+		msgCtx := msgqueue.ContextForMessage(ctx, msg)
+
+		if err := ec.handleTask(msgCtx, msg); err != nil {
+			msgqueue.Logger(msgCtx, ec.l).Error().Err(err).Msgf("could not handle batched task %s, requeueing", msg.ID)
+
+			if err := ec.mq.AddMessage(msgCtx, msgqueue.JOB_PROCESSING_QUEUE, msg); err != nil {
+				return fmt.Errorf("could not requeue batched task %s: %w", msg.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func (ec *JobsControllerImpl) handleJobRunQueued(ctx context.Context, task *msgqueue.Message) error {
 	ctx, span := telemetry.NewSpan(ctx, "handle-job-run-queued")
 	defer span.End()
@@ -271,7 +446,7 @@ func (ec *JobsControllerImpl) handleJobRunQueued(ctx context.Context, task *msgq
 	err = g.Wait()
 
 	if err != nil {
-		ec.l.Err(err).Msg("could not run step run requeue")
+		msgqueue.Logger(ctx, ec.l).Err(err).Msg("could not run step run requeue")
 		return err
 	}
 
@@ -303,7 +478,7 @@ func (ec *JobsControllerImpl) handleStepRunRetry(ctx context.Context, task *msgq
 		return fmt.Errorf("could not archive step run result: %w", err)
 	}
 
-	ec.l.Error().Err(fmt.Errorf("starting step run retry"))
+	msgqueue.Logger(ctx, ec.l).Error().Err(fmt.Errorf("starting step run retry"))
 
 	stepRun, err := ec.repo.StepRun().GetStepRunForEngine(metadata.TenantId, payload.StepRunId)
 
@@ -365,7 +540,7 @@ func (ec *JobsControllerImpl) handleStepRunRetry(ctx context.Context, task *msgq
 		metadata.TenantId,
 		sqlchelpers.UUIDToStr(stepRun.StepRun.ID),
 		&repository.UpdateStepRunOpts{
-			Input:      inputBytes,
+			Input:      ec.getRedactionRules(metadata.TenantId).RedactJSON(inputBytes),
 			Status:     repository.StepRunStatusPtr(db.StepRunStatusPending),
 			IsRerun:    true,
 			RetryCount: &retryCount,
@@ -472,12 +647,19 @@ func (ec *JobsControllerImpl) runStepRunRequeueTenant(ctx context.Context, tenan
 			// or if the scheduleTimeoutAt is set and the current time is after the scheduleTimeoutAt
 			isTimedOut := !scheduleTimeoutAt.IsZero() && scheduleTimeoutAt.Before(now)
 
+			// step runs which are waiting on a dependency don't time out, and get a new schedule timeout once their
+			// preflight checks pass
+			if stepRunCp.Status == dbsqlc.StepRunStatusWAITINGONDEPENDENCY {
+				isTimedOut = false
+			}
+
 			if isTimedOut {
 				var updateInfo *repository.StepRunUpdateInfo
 
 				innerStepRun, updateInfo, err = ec.repo.StepRun().UpdateStepRun(ctx, tenantId, stepRunId, &repository.UpdateStepRunOpts{
 					CancelledAt:     &now,
 					CancelledReason: repository.StringPtr("SCHEDULING_TIMED_OUT"),
+					CancelledSource: repository.CancellationSourcePtr(db.CancellationSourceTimeout),
 					Status:          repository.StepRunStatusPtr(db.StepRunStatusCancelled),
 				})
 
@@ -485,7 +667,7 @@ func (ec *JobsControllerImpl) runStepRunRequeueTenant(ctx context.Context, tenan
 					return fmt.Errorf("could not update step run %s: %w", stepRunId, err)
 				}
 
-				defer ec.handleStepRunUpdateInfo(innerStepRun, updateInfo)
+				defer ec.handleStepRunUpdateInfo(ctx, innerStepRun, updateInfo)
 
 				return nil
 			}
@@ -619,7 +801,7 @@ func (ec *JobsControllerImpl) queueStepRun(ctx context.Context, tenantId, stepId
 		if stepScheduleTimeout != "" {
 			timeoutDuration, _ = time.ParseDuration(stepScheduleTimeout)
 		} else {
-			timeoutDuration = defaults.DefaultScheduleTimeout
+			timeoutDuration = ec.settings.Get(tenantId).ScheduleTimeout
 		}
 
 		scheduleTimeoutAt := time.Now().UTC().Add(timeoutDuration)
@@ -660,13 +842,22 @@ func (ec *JobsControllerImpl) queueStepRun(ctx context.Context, tenantId, stepId
 				Overrides:   map[string]interface{}{},
 			}
 
+			// the metadata of the workflow run is passed to every step run
+			if additionalMetadata := stepRun.AdditionalMetadata; len(additionalMetadata) > 0 {
+				err := json.Unmarshal(additionalMetadata, &inputData.AdditionalMetadata)
+
+				if err != nil {
+					return fmt.Errorf("could not unmarshal additional metadata: %w", err)
+				}
+			}
+
 			inputDataBytes, err := json.Marshal(inputData)
 
 			if err != nil {
 				return ec.a.WrapErr(fmt.Errorf("could not convert input data to json: %w", err), errData)
 			}
 
-			updateStepOpts.Input = inputDataBytes
+			updateStepOpts.Input = ec.getRedactionRules(tenantId).RedactJSON(inputDataBytes)
 		}
 	}
 
@@ -679,13 +870,34 @@ func (ec *JobsControllerImpl) queueStepRun(ctx context.Context, tenantId, stepId
 
 	if err != nil {
 		if errors.Is(err, repository.ErrStepRunIsNotPending) {
-			ec.l.Debug().Msgf("step run %s is not pending, skipping scheduling", stepRunId)
+			msgqueue.Logger(ctx, ec.l).Debug().Msgf("step run %s is not pending, skipping scheduling", stepRunId)
 			return nil
 		}
 
 		return ec.a.WrapErr(fmt.Errorf("could not update step run: %w", err), errData)
 	}
 
+	// every queued step run, including retries, counts towards the budget of its workflow run. A step run which
+	// exceeds the budget is not scheduled.
+	exceeded, err := ec.addBudgetUsage(ctx, tenantId, stepRun, &repository.AddWorkflowRunBudgetUsageOpts{
+		StepExecutions: 1,
+	})
+
+	if err != nil {
+		return ec.a.WrapErr(err, errData)
+	}
+
+	if exceeded {
+		return ec.a.WrapErr(ec.cancelStepRun(ctx, tenantId, stepRunId, budget.CancelledReason), errData)
+	}
+
+	// the join step run is started, so the unfinished branches of the join are no longer needed
+	if stepRun.StepJoinStrategy != dbsqlc.StepJoinStrategyALL {
+		if err := ec.cancelSupersededStepRuns(ctx, tenantId, stepRunId); err != nil {
+			msgqueue.Logger(ctx, ec.l).Err(err).Msgf("could not cancel superseded step runs of join step run %s", stepRunId)
+		}
+	}
+
 	return ec.a.WrapErr(ec.scheduleStepRun(ctx, tenantId, stepId, stepRunId), errData)
 }
 
@@ -701,11 +913,21 @@ func (ec *JobsControllerImpl) scheduleStepRun(ctx context.Context, tenantId, ste
 
 	servertel.WithStepRunModel(span, stepRun)
 
-	selectedWorkerId, dispatcherId, err := ec.repo.StepRun().AssignStepRunToWorker(tenantId, stepRunId)
+	ready, err := ec.runPreflightChecks(ctx, tenantId, stepRun)
+
+	if err != nil {
+		return fmt.Errorf("could not run preflight checks: %w", err)
+	}
+
+	if !ready {
+		return nil
+	}
+
+	selectedWorkerId, dispatcherId, err := ec.repo.StepRun().AssignStepRunToWorker(tenantId, stepRunId, ec.selector)
 
 	if err != nil {
 		if errors.Is(err, repository.ErrNoWorkerAvailable) {
-			ec.l.Debug().Msgf("no worker available for step run %s, requeueing", stepRunId)
+			msgqueue.Logger(ctx, ec.l).Debug().Msgf("no worker available for step run %s, requeueing", stepRunId)
 			return nil
 		}
 
@@ -720,7 +942,7 @@ func (ec *JobsControllerImpl) scheduleStepRun(ctx context.Context, tenantId, ste
 		return fmt.Errorf("could not assign step run to ticker: %w", err)
 	}
 
-	scheduleTimeoutTask, err := scheduleStepRunTimeoutTask(stepRun)
+	scheduleTimeoutTask, err := ec.scheduleStepRunTimeoutTask(stepRun)
 
 	if err != nil {
 		return fmt.Errorf("could not schedule step run timeout task: %w", err)
@@ -786,7 +1008,7 @@ func (ec *JobsControllerImpl) handleStepRunStarted(ctx context.Context, task *ms
 		return fmt.Errorf("could not update step run: %w", err)
 	}
 
-	defer ec.handleStepRunUpdateInfo(stepRun, updateInfo)
+	defer ec.handleStepRunUpdateInfo(ctx, stepRun, updateInfo)
 
 	return nil
 }
@@ -826,7 +1048,17 @@ func (ec *JobsControllerImpl) handleStepRunFinished(ctx context.Context, task *m
 			stepOutputStr = payload.StepOutputData
 		}
 
-		stepOutput = []byte(stepOutputStr)
+		stepOutput = ec.getRedactionRules(metadata.TenantId).RedactJSON([]byte(stepOutputStr))
+	}
+
+	oldStepRun, err := ec.repo.StepRun().GetStepRunForEngine(metadata.TenantId, payload.StepRunId)
+
+	if err != nil {
+		return fmt.Errorf("could not get step run: %w", err)
+	}
+
+	if isCancelling(oldStepRun) {
+		return ec.finishCancellingStepRun(ctx, metadata.TenantId, payload.StepRunId, finishedAt)
 	}
 
 	stepRun, updateInfo, err := ec.repo.StepRun().UpdateStepRun(ctx, metadata.TenantId, payload.StepRunId, &repository.UpdateStepRunOpts{
@@ -839,7 +1071,9 @@ func (ec *JobsControllerImpl) handleStepRunFinished(ctx context.Context, task *m
 		return fmt.Errorf("could not update step run: %w", err)
 	}
 
-	defer ec.handleStepRunUpdateInfo(stepRun, updateInfo)
+	defer ec.handleStepRunUpdateInfo(ctx, stepRun, updateInfo)
+
+	ec.captureStepRunDiagnostic(ctx, metadata.TenantId, payload.StepRunId)
 
 	// queue the next step runs
 	jobRunId := sqlchelpers.UUIDToStr(stepRun.JobRunId)
@@ -913,26 +1147,59 @@ func (ec *JobsControllerImpl) handleStepRunFailed(ctx context.Context, task *msg
 		return fmt.Errorf("could not get step run: %w", err)
 	}
 
-	// determine if step run should be retried or not
-	shouldRetry := stepRun.StepRun.RetryCount < stepRun.StepRetries
+	if isCancelling(stepRun) {
+		return ec.finishCancellingStepRun(ctx, metadata.TenantId, payload.StepRunId, failedAt)
+	}
+
+	// determine if step run should be retried or not. Steps which don't set retries use the default of the tenant.
+	maxRetries := stepRun.StepRetries
+
+	if maxRetries == 0 {
+		maxRetries = int32(ec.settings.Get(metadata.TenantId).DefaultStepRetries)
+	}
 
-	status := db.StepRunStatusFailed
+	shouldRetry := !payload.NoRetry && stepRun.StepRun.RetryCount < maxRetries
+	budgetExceeded := false
 
 	if shouldRetry {
-		status = db.StepRunStatusPending
+		budgetExceeded, err = ec.addBudgetUsage(ctx, metadata.TenantId, stepRun, &repository.AddWorkflowRunBudgetUsageOpts{
+			StepRetries: 1,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		shouldRetry = !budgetExceeded
 	}
 
-	stepRun, updateInfo, err := ec.repo.StepRun().UpdateStepRun(ctx, metadata.TenantId, payload.StepRunId, &repository.UpdateStepRunOpts{
+	updateOpts := &repository.UpdateStepRunOpts{
 		FinishedAt: &failedAt,
 		Error:      &payload.Error,
-		Status:     repository.StepRunStatusPtr(status),
-	})
+		Status:     repository.StepRunStatusPtr(db.StepRunStatusFailed),
+	}
+
+	if shouldRetry {
+		updateOpts.Status = repository.StepRunStatusPtr(db.StepRunStatusPending)
+	}
+
+	// a step run which would be retried, if not for the budget of its workflow run, is cancelled by the budget
+	if budgetExceeded {
+		updateOpts.Status = repository.StepRunStatusPtr(db.StepRunStatusCancelled)
+		updateOpts.CancelledAt = &failedAt
+		updateOpts.CancelledReason = repository.StringPtr(budget.CancelledReason)
+		updateOpts.CancelledSource = repository.CancellationSourceFromReason(budget.CancelledReason)
+	}
+
+	stepRun, updateInfo, err := ec.repo.StepRun().UpdateStepRun(ctx, metadata.TenantId, payload.StepRunId, updateOpts)
 
 	if err != nil {
 		return fmt.Errorf("could not update step run: %w", err)
 	}
 
-	defer ec.handleStepRunUpdateInfo(stepRun, updateInfo)
+	defer ec.handleStepRunUpdateInfo(ctx, stepRun, updateInfo)
+
+	ec.captureStepRunDiagnostic(ctx, metadata.TenantId, payload.StepRunId)
 
 	// servertel.WithStepRunModel(span, stepRun)
 
@@ -984,7 +1251,13 @@ func (ec *JobsControllerImpl) handleStepRunTimedOut(ctx context.Context, task *m
 		return fmt.Errorf("could not decode step run started task metadata: %w", err)
 	}
 
-	return ec.cancelStepRun(ctx, metadata.TenantId, payload.StepRunId, "TIMED_OUT")
+	err = ec.cancelStepRun(ctx, metadata.TenantId, payload.StepRunId, "TIMED_OUT")
+
+	if err != nil {
+		return err
+	}
+
+	return ec.queueStepRunTimeoutHook(ctx, metadata.TenantId, payload.StepRunId)
 }
 
 func (ec *JobsControllerImpl) handleStepRunCancelled(ctx context.Context, task *msgqueue.Message) error {
@@ -1013,12 +1286,40 @@ func (ec *JobsControllerImpl) cancelStepRun(ctx context.Context, tenantId, stepR
 	ctx, span := telemetry.NewSpan(ctx, "cancel-step-run")
 	defer span.End()
 
+	oldStepRun, err := ec.repo.StepRun().GetStepRunForEngine(tenantId, stepRunId)
+
+	if err != nil {
+		return fmt.Errorf("could not get step run: %w", err)
+	}
+
+	// the worker did not finish the step run within the grace period, so it is force-cancelled
+	if isCancelling(oldStepRun) {
+		return ec.forceCancelStepRun(ctx, tenantId, stepRunId)
+	}
+
+	var gracePeriod time.Duration
+
+	if oldStepRun.StepCancelGracePeriod.Valid {
+		gracePeriod, err = time.ParseDuration(oldStepRun.StepCancelGracePeriod.String)
+
+		if err != nil {
+			return fmt.Errorf("could not parse cancel grace period: %w", err)
+		}
+	}
+
+	isRunning := oldStepRun.StepRun.Status == dbsqlc.StepRunStatusRUNNING || oldStepRun.StepRun.Status == dbsqlc.StepRunStatusASSIGNED
+
+	if gracePeriod > 0 && isRunning && oldStepRun.StepRun.TickerId.Valid {
+		return ec.cancelStepRunWithGracePeriod(ctx, oldStepRun, reason, gracePeriod)
+	}
+
 	// cancel current step run
 	now := time.Now().UTC()
 
 	stepRun, updateInfo, err := ec.repo.StepRun().UpdateStepRun(ctx, tenantId, stepRunId, &repository.UpdateStepRunOpts{
 		CancelledAt:     &now,
 		CancelledReason: repository.StringPtr(reason),
+		CancelledSource: repository.CancellationSourceFromReason(reason),
 		Status:          repository.StepRunStatusPtr(db.StepRunStatusCancelled),
 	})
 
@@ -1026,10 +1327,114 @@ func (ec *JobsControllerImpl) cancelStepRun(ctx context.Context, tenantId, stepR
 		return fmt.Errorf("could not update step run: %w", err)
 	}
 
-	defer ec.handleStepRunUpdateInfo(stepRun, updateInfo)
+	defer ec.handleStepRunUpdateInfo(ctx, stepRun, updateInfo)
 
 	// servertel.WithStepRunModel(span, stepRun)
 
+	// step runs which weren't assigned yet have no worker to notify
+	if !isRunning {
+		return nil
+	}
+
+	return ec.notifyWorkerOfCancellation(ctx, tenantId, stepRun, reason)
+}
+
+// cancelStepRunWithGracePeriod marks a running step run as cancelled without releasing its worker slot, and moves
+// its timeout to the end of the grace period. The worker may finish the step run until then, after which the step
+// run is force-cancelled.
+func (ec *JobsControllerImpl) cancelStepRunWithGracePeriod(ctx context.Context, oldStepRun *dbsqlc.GetStepRunForEngineRow, reason string, gracePeriod time.Duration) error {
+	tenantId := sqlchelpers.UUIDToStr(oldStepRun.StepRun.TenantId)
+	stepRunId := sqlchelpers.UUIDToStr(oldStepRun.StepRun.ID)
+	tickerId := sqlchelpers.UUIDToStr(oldStepRun.StepRun.TickerId)
+
+	now := time.Now().UTC()
+
+	stepRun, updateInfo, err := ec.repo.StepRun().UpdateStepRun(ctx, tenantId, stepRunId, &repository.UpdateStepRunOpts{
+		CancelledAt:     &now,
+		CancelledReason: repository.StringPtr(reason),
+		CancelledSource: repository.CancellationSourceFromReason(reason),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not update step run: %w", err)
+	}
+
+	defer ec.handleStepRunUpdateInfo(ctx, stepRun, updateInfo)
+
+	err = ec.mq.AddMessage(
+		ctx,
+		msgqueue.QueueTypeFromTickerID(tickerId),
+		tasktypes.ScheduleStepRunTimeoutToTask(tenantId, sqlchelpers.UUIDToStr(stepRun.JobRunId), stepRunId, now.Add(gracePeriod)),
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not add schedule step run timeout task to task queue: %w", err)
+	}
+
+	return ec.notifyWorkerOfCancellation(ctx, tenantId, stepRun, reason)
+}
+
+// forceCancelStepRun cancels a step run whose cancellation grace period has expired, which releases its worker slot.
+// The worker was already notified of the cancellation when the grace period started.
+func (ec *JobsControllerImpl) forceCancelStepRun(ctx context.Context, tenantId, stepRunId string) error {
+	now := time.Now().UTC()
+
+	stepRun, updateInfo, err := ec.repo.StepRun().UpdateStepRun(ctx, tenantId, stepRunId, &repository.UpdateStepRunOpts{
+		FinishedAt: &now,
+		Error:      repository.StringPtr("force-cancelled because the step run did not finish within its cancellation grace period"),
+		Status:     repository.StepRunStatusPtr(db.StepRunStatusCancelled),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not update step run: %w", err)
+	}
+
+	ec.handleStepRunUpdateInfo(ctx, stepRun, updateInfo)
+
+	return nil
+}
+
+// finishCancellingStepRun completes the cancellation of a step run which the worker finished within the
+// cancellation grace period. The result of the step run is discarded, so that its dependents are not started.
+func (ec *JobsControllerImpl) finishCancellingStepRun(ctx context.Context, tenantId, stepRunId string, finishedAt time.Time) error {
+	stepRun, updateInfo, err := ec.repo.StepRun().UpdateStepRun(ctx, tenantId, stepRunId, &repository.UpdateStepRunOpts{
+		FinishedAt: &finishedAt,
+		Status:     repository.StepRunStatusPtr(db.StepRunStatusCancelled),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not update step run: %w", err)
+	}
+
+	defer ec.handleStepRunUpdateInfo(ctx, stepRun, updateInfo)
+
+	// cancel the timeout which would force-cancel the step run
+	if stepRun.StepRun.TickerId.Valid {
+		err = ec.mq.AddMessage(
+			ctx,
+			msgqueue.QueueTypeFromTickerID(sqlchelpers.UUIDToStr(stepRun.StepRun.TickerId)),
+			cancelStepRunTimeoutTask(tenantId, stepRunId),
+		)
+
+		if err != nil {
+			return fmt.Errorf("could not add cancel step run timeout task to task queue: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// isCancelling returns true if the step run was cancelled while a worker was running it, and the cancellation
+// grace period of the step hasn't expired yet.
+func isCancelling(stepRun *dbsqlc.GetStepRunForEngineRow) bool {
+	isRunning := stepRun.StepRun.Status == dbsqlc.StepRunStatusRUNNING || stepRun.StepRun.Status == dbsqlc.StepRunStatusASSIGNED
+
+	return isRunning && stepRun.StepRun.CancelledAt.Valid
+}
+
+func (ec *JobsControllerImpl) notifyWorkerOfCancellation(ctx context.Context, tenantId string, stepRun *dbsqlc.GetStepRunForEngineRow, reason string) error {
+	stepRunId := sqlchelpers.UUIDToStr(stepRun.StepRun.ID)
+
 	if !stepRun.StepRun.WorkerId.Valid {
 		return fmt.Errorf("step run has no worker id")
 	}
@@ -1059,7 +1464,33 @@ func (ec *JobsControllerImpl) cancelStepRun(ctx context.Context, tenantId, stepR
 	return nil
 }
 
-func (ec *JobsControllerImpl) handleStepRunUpdateInfo(stepRun *dbsqlc.GetStepRunForEngineRow, updateInfo *repository.StepRunUpdateInfo) {
+// getRedactionRules returns the redaction rules of a tenant. If the rules cannot be loaded, nil is returned, which
+// redacts nothing.
+func (ec *JobsControllerImpl) getRedactionRules(tenantId string) *redact.Rules {
+	if rules, ok := ec.redactionRules.Get(tenantId); ok {
+		return rules
+	}
+
+	tenant, err := ec.repo.Tenant().GetTenantByID(tenantId)
+
+	if err != nil {
+		ec.l.Err(err).Msgf("could not get redaction rules for tenant %s", tenantId)
+		return nil
+	}
+
+	// invalid rules are rejected by the API, but we still apply the valid rules if any are stored
+	rules, err := redact.Compile(tenant.RedactionRules)
+
+	if err != nil {
+		ec.l.Warn().Err(err).Msgf("tenant %s has invalid redaction rules", tenantId)
+	}
+
+	ec.redactionRules.Add(tenantId, rules)
+
+	return rules
+}
+
+func (ec *JobsControllerImpl) handleStepRunUpdateInfo(ctx context.Context, stepRun *dbsqlc.GetStepRunForEngineRow, updateInfo *repository.StepRunUpdateInfo) {
 	defer func() {
 		if r := recover(); r != nil {
 			err, ok := r.(error)
@@ -1068,7 +1499,7 @@ func (ec *JobsControllerImpl) handleStepRunUpdateInfo(stepRun *dbsqlc.GetStepRun
 				err = fmt.Errorf("%v", r)
 			}
 
-			ec.l.Error().Err(err).Msg("recovered from panic")
+			msgqueue.Logger(ctx, ec.l).Error().Err(err).Msg("recovered from panic")
 
 			return
 		}
@@ -1076,7 +1507,7 @@ func (ec *JobsControllerImpl) handleStepRunUpdateInfo(stepRun *dbsqlc.GetStepRun
 
 	if updateInfo.WorkflowRunFinalState {
 		err := ec.mq.AddMessage(
-			context.Background(),
+			ctx,
 			msgqueue.WORKFLOW_PROCESSING_QUEUE,
 			tasktypes.WorkflowRunFinishedToTask(
 				sqlchelpers.UUIDToStr(stepRun.StepRun.TenantId),
@@ -1086,11 +1517,33 @@ func (ec *JobsControllerImpl) handleStepRunUpdateInfo(stepRun *dbsqlc.GetStepRun
 		)
 
 		if err != nil {
-			ec.l.Error().Err(err).Msg("could not add workflow run finished task to task queue")
+			msgqueue.Logger(ctx, ec.l).Error().Err(err).Msg("could not add workflow run finished task to task queue")
 		}
 	}
 }
 
+// captureStepRunDiagnostic captures the diagnostics of the latest attempt of a step run if its workflow run was
+// sampled. Failing to capture diagnostics doesn't fail the step run.
+func (ec *JobsControllerImpl) captureStepRunDiagnostic(ctx context.Context, tenantId, stepRunId string) {
+	diagnostic, err := ec.repo.StepRun().CreateStepRunDiagnostic(ctx, tenantId, stepRunId, telemetry.TraceParent(ctx))
+
+	if err != nil {
+		msgqueue.Logger(ctx, ec.l).Error().Err(err).Msgf("could not capture diagnostics of step run %s", stepRunId)
+		return
+	}
+
+	if diagnostic == nil {
+		return
+	}
+
+	// the span links the trace of the step run to the trace which created its workflow run
+	_, span := telemetry.NewSpanWithLinks(ctx, "step-run-diagnostic", diagnostic.RunTraceParent.String)
+
+	telemetry.WithAttributes(span, servertel.TenantId(tenantId), servertel.StepRunId(stepRunId))
+
+	span.End()
+}
+
 func (ec *JobsControllerImpl) handleTickerRemoved(ctx context.Context, task *msgqueue.Message) error {
 	ctx, span := telemetry.NewSpan(ctx, "handle-ticker-removed")
 	defer span.End()
@@ -1110,7 +1563,7 @@ func (ec *JobsControllerImpl) handleTickerRemoved(ctx context.Context, task *msg
 		return fmt.Errorf("could not decode ticker removed task metadata: %w", err)
 	}
 
-	ec.l.Debug().Msgf("handling ticker removed for ticker %s", payload.TickerId)
+	msgqueue.Logger(ctx, ec.l).Debug().Msgf("handling ticker removed for ticker %s", payload.TickerId)
 
 	// reassign all step runs to a different ticker
 	tickers, err := ec.getValidTickers()
@@ -1142,7 +1595,7 @@ func (ec *JobsControllerImpl) handleTickerRemoved(ctx context.Context, task *msg
 			return fmt.Errorf("could not update step run: %w", err)
 		}
 
-		scheduleTimeoutTask, err := scheduleStepRunTimeoutTask(&stepRunCp)
+		scheduleTimeoutTask, err := ec.scheduleStepRunTimeoutTask(&stepRunCp)
 
 		if err != nil {
 			return fmt.Errorf("could not schedule step run timeout task: %w", err)
@@ -1163,6 +1616,29 @@ func (ec *JobsControllerImpl) handleTickerRemoved(ctx context.Context, task *msg
 	return nil
 }
 
+// handleMaintenanceJob runs a maintenance job outside of its regular schedule.
+func (ec *JobsControllerImpl) handleMaintenanceJob(ctx context.Context, task *msgqueue.Message) error {
+	ctx, span := telemetry.NewSpan(ctx, "handle-maintenance-job")
+	defer span.End()
+
+	payload := tasktypes.MaintenanceJobTaskPayload{}
+
+	err := ec.dv.DecodeAndValidate(task.Payload, &payload)
+
+	if err != nil {
+		return fmt.Errorf("could not decode maintenance job task payload: %w", err)
+	}
+
+	switch payload.Job {
+	case tasktypes.MaintenanceJobStepRunRequeue:
+		ec.runStepRunRequeue(ctx)()
+	case tasktypes.MaintenanceJobStepRunReassign:
+		ec.runStepRunReassign(ctx)()
+	}
+
+	return nil
+}
+
 func (ec *JobsControllerImpl) getValidTickers() ([]db.TickerModel, error) {
 	within := time.Now().UTC().Add(-6 * time.Second)
 
@@ -1201,42 +1677,22 @@ func stepRunAssignedTask(tenantId, stepRunId, workerId, dispatcherId string) *ms
 	}
 }
 
-func scheduleStepRunTimeoutTask(stepRun *db.StepRunModel) (*msgqueue.Message, error) {
-	var durationStr string
+func (ec *JobsControllerImpl) scheduleStepRunTimeoutTask(stepRun *db.StepRunModel) (*msgqueue.Message, error) {
+	duration := ec.settings.Get(stepRun.TenantID).DefaultStepTimeout
 
-	if timeout, ok := stepRun.Step().Timeout(); ok {
-		durationStr = timeout
-	}
-
-	if durationStr == "" {
-		durationStr = defaults.DefaultStepRunTimeout
-	}
+	if timeout, ok := stepRun.Step().Timeout(); ok && timeout != "" {
+		parsed, err := time.ParseDuration(timeout)
 
-	// get a duration
-	duration, err := time.ParseDuration(durationStr)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse duration: %w", err)
+		}
 
-	if err != nil {
-		return nil, fmt.Errorf("could not parse duration: %w", err)
+		duration = parsed
 	}
 
 	timeoutAt := time.Now().UTC().Add(duration)
 
-	payload, _ := datautils.ToJSONMap(tasktypes.ScheduleStepRunTimeoutTaskPayload{
-		StepRunId: stepRun.ID,
-		JobRunId:  stepRun.JobRunID,
-		TimeoutAt: timeoutAt.Format(time.RFC3339),
-	})
-
-	metadata, _ := datautils.ToJSONMap(tasktypes.ScheduleStepRunTimeoutTaskMetadata{
-		TenantId: stepRun.TenantID,
-	})
-
-	return &msgqueue.Message{
-		ID:       "schedule-step-run-timeout",
-		Payload:  payload,
-		Metadata: metadata,
-		Retries:  3,
-	}, nil
+	return tasktypes.ScheduleStepRunTimeoutToTask(stepRun.TenantID, stepRun.JobRunID, stepRun.ID, timeoutAt), nil
 }
 
 func cancelStepRunTimeoutTask(tenantId, stepRunId string) *msgqueue.Message {
@@ -0,0 +1,25 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+)
+
+// cancelSupersededStepRuns cancels the unfinished step runs on the branches of a join step run which started, as
+// their results are no longer needed. Their cancellation is tolerated by the join step run, so it doesn't cancel the
+// job run.
+func (ec *JobsControllerImpl) cancelSupersededStepRuns(ctx context.Context, tenantId, joinStepRunId string) error {
+	stepRunIds, err := ec.repo.StepRun().ListSupersededStepRuns(ctx, tenantId, joinStepRunId)
+
+	if err != nil {
+		return fmt.Errorf("could not list superseded step runs: %w", err)
+	}
+
+	for _, stepRunId := range stepRunIds {
+		if err := ec.cancelStepRun(ctx, tenantId, stepRunId, "JOIN_SATISFIED"); err != nil {
+			return fmt.Errorf("could not cancel superseded step run %s: %w", stepRunId, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/preflight"
+)
+
+// dependencyRecheckInterval is how long a step run waits on its dependencies before its preflight checks are run
+// again.
+const dependencyRecheckInterval = 10 * time.Second
+
+// runPreflightChecks runs the preflight checks of the step of a step run, and returns whether the step run can be
+// assigned to a worker. If a check fails, the step run waits on its dependencies and is requeued, without counting
+// towards its schedule timeout. Once the checks pass again, the step run is pending assignment with a new schedule
+// timeout.
+func (ec *JobsControllerImpl) runPreflightChecks(ctx context.Context, tenantId string, stepRun *db.StepRunModel) (bool, error) {
+	checkModels := stepRun.Step().PreflightChecks()
+	waiting := stepRun.Status == db.StepRunStatusWaitingOnDependency
+
+	if len(checkModels) == 0 && !waiting {
+		return true, nil
+	}
+
+	checks := make([]preflight.Check, len(checkModels))
+	hasExpressions := false
+
+	for i, checkModel := range checkModels {
+		checks[i] = preflight.Check{
+			Kind:   string(checkModel.Kind),
+			Target: checkModel.Target,
+		}
+
+		if timeout, ok := checkModel.Timeout(); ok {
+			checks[i].Timeout, _ = time.ParseDuration(timeout)
+		}
+
+		if checks[i].Kind == preflight.KindEXPRESSION {
+			hasExpressions = true
+		}
+	}
+
+	var health []byte
+
+	if hasExpressions {
+		tenant, err := ec.repo.Tenant().GetTenantByID(tenantId)
+
+		if err != nil {
+			return false, fmt.Errorf("could not get tenant: %w", err)
+		}
+
+		if dependencyHealth, ok := tenant.DependencyHealth(); ok {
+			health = dependencyHealth
+		}
+	}
+
+	if checkErr := ec.preflight.Run(ctx, checks, health); checkErr != nil {
+		msgqueue.Logger(ctx, ec.l).Debug().Err(checkErr).Msgf("step run %s is waiting on a dependency", stepRun.ID)
+
+		requeueAfter := time.Now().UTC().Add(dependencyRecheckInterval)
+
+		_, _, err := ec.repo.StepRun().UpdateStepRun(ctx, tenantId, stepRun.ID, &repository.UpdateStepRunOpts{
+			Status:       repository.StepRunStatusPtr(db.StepRunStatusWaitingOnDependency),
+			RequeueAfter: &requeueAfter,
+		})
+
+		if err != nil {
+			return false, fmt.Errorf("could not update step run: %w", err)
+		}
+
+		return false, nil
+	}
+
+	if waiting {
+		timeoutDuration := ec.settings.Get(tenantId).ScheduleTimeout
+
+		if parsed, err := time.ParseDuration(stepRun.Step().ScheduleTimeout); err == nil {
+			timeoutDuration = parsed
+		}
+
+		scheduleTimeoutAt := time.Now().UTC().Add(timeoutDuration)
+
+		_, _, err := ec.repo.StepRun().UpdateStepRun(ctx, tenantId, stepRun.ID, &repository.UpdateStepRunOpts{
+			Status:            repository.StepRunStatusPtr(db.StepRunStatusPendingAssignment),
+			ScheduleTimeoutAt: &scheduleTimeoutAt,
+		})
+
+		if err != nil {
+			return false, fmt.Errorf("could not update step run: %w", err)
+		}
+	}
+
+	return true, nil
+}
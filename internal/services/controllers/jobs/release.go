@@ -0,0 +1,73 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+)
+
+// handleStepRunReleased moves a step run which its worker released back to pending assignment. The step run is
+// requeued by the requeue loop once its requeue after time passed, like a step run which is waiting for a worker,
+// and the release doesn't count as a retry.
+func (ec *JobsControllerImpl) handleStepRunReleased(ctx context.Context, task *msgqueue.Message) error {
+	ctx, span := telemetry.NewSpan(ctx, "handle-step-run-released")
+	defer span.End()
+
+	payload := tasktypes.StepRunReleasedTaskPayload{}
+	metadata := tasktypes.StepRunReleasedTaskMetadata{}
+
+	err := ec.dv.DecodeAndValidate(task.Payload, &payload)
+
+	if err != nil {
+		return fmt.Errorf("could not decode step run released task payload: %w", err)
+	}
+
+	err = ec.dv.DecodeAndValidate(task.Metadata, &metadata)
+
+	if err != nil {
+		return fmt.Errorf("could not decode step run released task metadata: %w", err)
+	}
+
+	requeueAfter, err := time.Parse(time.RFC3339, payload.RequeueAfter)
+
+	if err != nil {
+		return fmt.Errorf("could not parse requeue after: %w", err)
+	}
+
+	stepRun, err := ec.repo.StepRun().ReleaseStepRun(ctx, metadata.TenantId, payload.StepRunId, payload.WorkerId, requeueAfter)
+
+	if err != nil {
+		// the step run finished, was cancelled or was reassigned before the release was handled
+		if errors.Is(err, pgx.ErrNoRows) {
+			ec.l.Debug().Msgf("step run %s is no longer running on worker %s, not releasing it", payload.StepRunId, payload.WorkerId)
+			return nil
+		}
+
+		return err
+	}
+
+	ec.l.Debug().Msgf("worker %s released step run %s until %s: %s", payload.WorkerId, payload.StepRunId, requeueAfter, payload.Reason)
+
+	// the step run is no longer running, so it shouldn't time out
+	if stepRun.TickerId.Valid {
+		err = ec.mq.AddMessage(
+			ctx,
+			msgqueue.QueueTypeFromTickerID(sqlchelpers.UUIDToStr(stepRun.TickerId)),
+			cancelStepRunTimeoutTask(metadata.TenantId, payload.StepRunId),
+		)
+
+		if err != nil {
+			return fmt.Errorf("could not add cancel step run timeout task to task queue: %w", err)
+		}
+	}
+
+	return nil
+}
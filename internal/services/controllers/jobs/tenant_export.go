@@ -0,0 +1,492 @@
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"math"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/internal/tenantexport"
+)
+
+// tenantExportBatchSize is the number of workflow runs and events which are read per query while an export is
+// written. The progress of the export is written back after each batch.
+const tenantExportBatchSize = 500
+
+// tenantExportRetention is how long the archive of an export can be downloaded before it is deleted.
+const tenantExportRetention = 7 * 24 * time.Hour
+
+// maxTenantExportSize is the maximum size in bytes of the archive of an export which is stored in the database.
+// Such archives are built in memory, so exports which are larger fail.
+const maxTenantExportSize = 256 << 20
+
+// maxStreamedTenantExportSize is the maximum size in bytes of the archive of an export which is streamed to the
+// object store, which is bounded by the size which is recorded for the export.
+const maxStreamedTenantExportSize = math.MaxInt32
+
+// errArchiveUploadStopped is returned when the archive is written after its upload to the object store stopped.
+var errArchiveUploadStopped = errors.New("archive upload stopped")
+
+// tenantExporter writes the sections of the archive of an export, and tracks its progress.
+type tenantExporter struct {
+	ec *JobsControllerImpl
+
+	tenantId string
+	exportId string
+
+	// buf is the archive if it is stored in the database, and upload streams the archive to the object store
+	// otherwise
+	buf    *bytes.Buffer
+	upload *archiveUpload
+
+	archive *archiveWriter
+	maxSize int64
+	w       *tenantexport.Writer
+
+	sectionsDone int
+	records      int
+}
+
+// archiveWriter counts and hashes the bytes of an archive as they are written.
+type archiveWriter struct {
+	w    io.Writer
+	hash hash.Hash
+	size int64
+}
+
+func (a *archiveWriter) Write(p []byte) (int, error) {
+	n, err := a.w.Write(p)
+
+	a.hash.Write(p[:n])
+	a.size += int64(n)
+
+	return n, err
+}
+
+// archiveUpload streams an archive to the object store while it is written.
+type archiveUpload struct {
+	pw   *io.PipeWriter
+	done chan struct{}
+	err  error
+}
+
+func (ec *JobsControllerImpl) startArchiveUpload(ctx context.Context, key string) *archiveUpload {
+	pr, pw := io.Pipe()
+
+	u := &archiveUpload{
+		pw:   pw,
+		done: make(chan struct{}),
+	}
+
+	go func() {
+		defer close(u.done)
+
+		u.err = ec.objectStore.PutStream(ctx, key, pr)
+
+		// the archive can't be written any longer once the upload stopped
+		pr.CloseWithError(errArchiveUploadStopped)
+	}()
+
+	return u
+}
+
+// finish ends the archive and waits for the upload. If cause is set, the upload is aborted. It can be called more
+// than once.
+func (u *archiveUpload) finish(cause error) error {
+	u.pw.CloseWithError(cause)
+
+	<-u.done
+
+	return u.err
+}
+
+func (ec *JobsControllerImpl) handleTenantExport(ctx context.Context, task *msgqueue.Message) error {
+	ctx, span := telemetry.NewSpan(ctx, "handle-tenant-export")
+	defer span.End()
+
+	payload := tasktypes.TenantExportTaskPayload{}
+	metadata := tasktypes.TenantExportTaskMetadata{}
+
+	err := ec.dv.DecodeAndValidate(task.Payload, &payload)
+
+	if err != nil {
+		return fmt.Errorf("could not decode tenant export task payload: %w", err)
+	}
+
+	err = ec.dv.DecodeAndValidate(task.Metadata, &metadata)
+
+	if err != nil {
+		return fmt.Errorf("could not decode tenant export task metadata: %w", err)
+	}
+
+	export, err := ec.repo.TenantExport().GetTenantExportById(payload.ExportId)
+
+	if err != nil {
+		return fmt.Errorf("could not get tenant export: %w", err)
+	}
+
+	// the task may be redelivered after the export has finished
+	if export.Status == db.TenantExportStatusSucceeded || export.Status == db.TenantExportStatusFailed {
+		return nil
+	}
+
+	tenant, err := ec.repo.Tenant().GetTenantByID(metadata.TenantId)
+
+	if err != nil {
+		return fmt.Errorf("could not get tenant: %w", err)
+	}
+
+	e := &tenantExporter{
+		ec:       ec,
+		tenantId: metadata.TenantId,
+		exportId: export.ID,
+	}
+
+	key := tenantExportArchiveKey(metadata.TenantId, export.ID)
+
+	var out io.Writer
+
+	if ec.objectStore != nil {
+		e.upload = ec.startArchiveUpload(ctx, key)
+		e.maxSize = maxStreamedTenantExportSize
+		out = e.upload.pw
+
+		// the upload is aborted if the export stops before the archive is complete
+		defer e.upload.finish(fmt.Errorf("tenant export stopped")) // nolint: errcheck
+	} else {
+		e.buf = &bytes.Buffer{}
+		e.maxSize = maxTenantExportSize
+		out = e.buf
+	}
+
+	e.archive = &archiveWriter{
+		w:    out,
+		hash: sha256.New(),
+	}
+
+	e.w = tenantexport.NewWriter(e.archive, &tenantexport.Manifest{
+		ExportId:  export.ID,
+		TenantId:  metadata.TenantId,
+		CreatedAt: export.CreatedAt,
+	})
+
+	sections := []struct {
+		name  string
+		write func(ctx context.Context) error
+	}{
+		{"tenant", func(ctx context.Context) error {
+			return e.w.WriteJSON(tenantexport.TenantFileName, &tenantexport.Tenant{
+				Id:        tenant.ID,
+				CreatedAt: tenant.CreatedAt,
+				Name:      tenant.Name,
+				Slug:      tenant.Slug,
+			})
+		}},
+		{tenantexport.SectionWorkflows, e.writeWorkflows},
+		{tenantexport.SectionWorkflowVersions, e.writeWorkflowVersions},
+		{tenantexport.SectionSteps, e.writeSteps},
+		{tenantexport.SectionWorkflowRuns, e.writeWorkflowRuns},
+		{tenantexport.SectionEvents, e.writeEvents},
+		{tenantexport.SectionAPITokens, e.writeAPITokens},
+	}
+
+	// progress is reset when a redelivered task starts over
+	startedAt := time.Now().UTC()
+	sectionsTotal := len(sections)
+	zero := 0
+
+	_, err = ec.repo.TenantExport().UpdateTenantExport(metadata.TenantId, export.ID, &repository.UpdateTenantExportOpts{
+		Status:        repository.TenantExportStatusPtr(db.TenantExportStatusRunning),
+		StartedAt:     &startedAt,
+		SectionsDone:  &zero,
+		SectionsTotal: &sectionsTotal,
+		RecordCount:   &zero,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not update tenant export: %w", err)
+	}
+
+	for _, section := range sections {
+		if err := section.write(ctx); err != nil {
+			return e.fail(fmt.Errorf("could not export %s: %w", section.name, err))
+		}
+
+		e.sectionsDone++
+
+		if err := e.progress(); err != nil {
+			return err
+		}
+	}
+
+	if err := e.w.Close(); err != nil {
+		return e.fail(fmt.Errorf("could not close archive: %w", err))
+	}
+
+	if err := e.checkSize(); err != nil {
+		return e.fail(err)
+	}
+
+	opts := &repository.CompleteTenantExportOpts{
+		ArchiveSize:   e.archive.size,
+		ArchiveSHA256: hex.EncodeToString(e.archive.hash.Sum(nil)),
+		ExpiresAt:     time.Now().UTC().Add(tenantExportRetention),
+	}
+
+	if e.upload != nil {
+		if err := e.upload.finish(nil); err != nil {
+			return fmt.Errorf("could not write tenant export archive: %w", err)
+		}
+
+		opts.ArchiveKey = &key
+	} else {
+		opts.Archive = e.buf.Bytes()
+	}
+
+	err = ec.repo.TenantExport().CompleteTenantExport(ctx, export.ID, opts)
+
+	if err != nil {
+		return fmt.Errorf("could not complete tenant export: %w", err)
+	}
+
+	return nil
+}
+
+// tenantExportArchiveKey is the key of the archive of an export in the object store.
+func tenantExportArchiveKey(tenantId, exportId string) string {
+	return fmt.Sprintf("tenant-exports/%s/%s.tar.gz", tenantId, exportId)
+}
+
+func (ec *JobsControllerImpl) failTenantExport(tenantId, exportId string, cause error) error {
+	finishedAt := time.Now().UTC()
+	errStr := cause.Error()
+
+	_, err := ec.repo.TenantExport().UpdateTenantExport(tenantId, exportId, &repository.UpdateTenantExportOpts{
+		Status:     repository.TenantExportStatusPtr(db.TenantExportStatusFailed),
+		Error:      &errStr,
+		FinishedAt: &finishedAt,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not mark tenant export as failed: %w (cause: %s)", err, errStr)
+	}
+
+	return cause
+}
+
+// fail marks the export as failed. If the archive could not be written because its upload to the object store
+// stopped, the export is not marked as failed, so that the task is retried.
+func (e *tenantExporter) fail(cause error) error {
+	if e.upload != nil {
+		if err := e.upload.finish(cause); err != nil && errors.Is(cause, errArchiveUploadStopped) {
+			return fmt.Errorf("could not write tenant export archive: %w", err)
+		}
+	}
+
+	return e.ec.failTenantExport(e.tenantId, e.exportId, cause)
+}
+
+// progress writes the number of sections and records which were written so far back to the export.
+func (e *tenantExporter) progress() error {
+	_, err := e.ec.repo.TenantExport().UpdateTenantExport(e.tenantId, e.exportId, &repository.UpdateTenantExportOpts{
+		SectionsDone: &e.sectionsDone,
+		RecordCount:  &e.records,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not update tenant export progress: %w", err)
+	}
+
+	return nil
+}
+
+func (e *tenantExporter) checkSize() error {
+	if e.archive.size > e.maxSize {
+		return fmt.Errorf("archive is larger than the maximum of %d MB", e.maxSize>>20)
+	}
+
+	return nil
+}
+
+func (e *tenantExporter) writeRecord(section string, record interface{}) error {
+	if err := e.w.WriteRecord(section, record); err != nil {
+		return err
+	}
+
+	e.records++
+
+	return nil
+}
+
+func (e *tenantExporter) writeWorkflows(ctx context.Context) error {
+	rows, err := e.ec.repo.TenantExport().ListWorkflowsForTenantExport(ctx, e.tenantId)
+
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		if err := e.writeRecord(tenantexport.SectionWorkflows, tenantexport.ToWorkflow(row)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *tenantExporter) writeWorkflowVersions(ctx context.Context) error {
+	rows, err := e.ec.repo.TenantExport().ListWorkflowVersionsForTenantExport(ctx, e.tenantId)
+
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		if err := e.writeRecord(tenantexport.SectionWorkflowVersions, tenantexport.ToWorkflowVersion(row)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *tenantExporter) writeSteps(ctx context.Context) error {
+	rows, err := e.ec.repo.TenantExport().ListStepsForTenantExport(ctx, e.tenantId)
+
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		if err := e.writeRecord(tenantexport.SectionSteps, tenantexport.ToStep(row)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeWorkflowRuns writes the workflow runs and their step runs.
+func (e *tenantExporter) writeWorkflowRuns(ctx context.Context) error {
+	opts := &repository.ListWorkflowRunsForExportOpts{
+		Limit: tenantExportBatchSize,
+	}
+
+	for {
+		rows, err := e.ec.repo.WorkflowRun().ListWorkflowRunsForExport(ctx, e.tenantId, opts)
+
+		if err != nil {
+			return err
+		}
+
+		workflowRunIds := make([]string, 0, len(rows))
+
+		for _, row := range rows {
+			if err := e.writeRecord(tenantexport.SectionWorkflowRuns, tenantexport.ToWorkflowRun(row)); err != nil {
+				return err
+			}
+
+			workflowRunIds = append(workflowRunIds, sqlchelpers.UUIDToStr(row.WorkflowRun.ID))
+		}
+
+		if len(workflowRunIds) > 0 {
+			stepRunRows, err := e.ec.repo.TenantExport().ListStepRunsForTenantExport(ctx, e.tenantId, workflowRunIds)
+
+			if err != nil {
+				return err
+			}
+
+			for _, stepRunRow := range stepRunRows {
+				if err := e.writeRecord(tenantexport.SectionStepRuns, tenantexport.ToStepRun(stepRunRow)); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := e.checkSize(); err != nil {
+			return err
+		}
+
+		if err := e.progress(); err != nil {
+			return err
+		}
+
+		if len(rows) < tenantExportBatchSize {
+			return nil
+		}
+
+		last := rows[len(rows)-1]
+
+		opts.After = &repository.WorkflowRunExportCursor{
+			CreatedAt: last.WorkflowRun.CreatedAt.Time,
+			ID:        sqlchelpers.UUIDToStr(last.WorkflowRun.ID),
+		}
+	}
+}
+
+func (e *tenantExporter) writeEvents(ctx context.Context) error {
+	opts := &repository.ListEventsForTenantExportOpts{
+		Limit: tenantExportBatchSize,
+	}
+
+	for {
+		rows, err := e.ec.repo.TenantExport().ListEventsForTenantExport(ctx, e.tenantId, opts)
+
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			if err := e.writeRecord(tenantexport.SectionEvents, tenantexport.ToEvent(row)); err != nil {
+				return err
+			}
+		}
+
+		if err := e.checkSize(); err != nil {
+			return err
+		}
+
+		if err := e.progress(); err != nil {
+			return err
+		}
+
+		if len(rows) < tenantExportBatchSize {
+			return nil
+		}
+
+		last := rows[len(rows)-1]
+
+		opts.After = &repository.TenantExportCursor{
+			CreatedAt: last.CreatedAt.Time,
+			ID:        sqlchelpers.UUIDToStr(last.ID),
+		}
+	}
+}
+
+func (e *tenantExporter) writeAPITokens(ctx context.Context) error {
+	rows, err := e.ec.repo.TenantExport().ListAPITokensForTenantExport(ctx, e.tenantId)
+
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		if err := e.writeRecord(tenantexport.SectionAPITokens, tenantexport.ToAPIToken(row)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/internal/telemetry/servertel"
+)
+
+// queueStepRunTimeoutHook queues the on-timeout action of the step of a step run which timed out, once the step run
+// was killed. A step run which is still within its cancellation grace period may finish by itself, in which case
+// the hook is not run.
+func (ec *JobsControllerImpl) queueStepRunTimeoutHook(ctx context.Context, tenantId, stepRunId string) error {
+	stepRun, err := ec.repo.StepRun().GetStepRunForEngine(tenantId, stepRunId)
+
+	if err != nil {
+		return fmt.Errorf("could not get step run: %w", err)
+	}
+
+	if stepRun == nil || !stepRun.StepOnTimeoutActionId.Valid {
+		return nil
+	}
+
+	// the hook only runs for step runs which a worker was running when they timed out
+	if stepRun.StepRun.Status != dbsqlc.StepRunStatusCANCELLED ||
+		stepRun.StepRun.CancelledReason.String != "TIMED_OUT" ||
+		!stepRun.StepRun.WorkerId.Valid {
+		return nil
+	}
+
+	err = ec.mq.AddMessage(
+		ctx,
+		msgqueue.JOB_PROCESSING_QUEUE,
+		tasktypes.StepRunTimeoutHookToTask(tenantId, stepRunId),
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not add step run timeout hook task to task queue: %w", err)
+	}
+
+	return nil
+}
+
+// handleStepRunTimeoutHook sends the on-timeout action of a step run to a random active worker which registered
+// it. If there is no such worker, an error is returned so that the task is retried.
+func (ec *JobsControllerImpl) handleStepRunTimeoutHook(ctx context.Context, task *msgqueue.Message) error {
+	ctx, span := telemetry.NewSpan(ctx, "handle-step-run-timeout-hook")
+	defer span.End()
+
+	payload := tasktypes.StepRunTimeoutHookTaskPayload{}
+	metadata := tasktypes.StepRunTimeoutHookTaskMetadata{}
+
+	err := ec.dv.DecodeAndValidate(task.Payload, &payload)
+
+	if err != nil {
+		return fmt.Errorf("could not decode step run timeout hook task payload: %w", err)
+	}
+
+	err = ec.dv.DecodeAndValidate(task.Metadata, &metadata)
+
+	if err != nil {
+		return fmt.Errorf("could not decode step run timeout hook task metadata: %w", err)
+	}
+
+	worker, err := ec.repo.StepRun().GetWorkerForStepRunTimeoutHook(ctx, metadata.TenantId, payload.StepRunId)
+
+	if err != nil {
+		// the step no longer has an on-timeout action
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+
+		return fmt.Errorf("could not get worker for step run timeout hook: %w", err)
+	}
+
+	if !worker.WorkerId.Valid {
+		return fmt.Errorf("no active worker has registered the on-timeout action %s", worker.ActionId)
+	}
+
+	workerId := sqlchelpers.UUIDToStr(worker.WorkerId)
+	dispatcherId := sqlchelpers.UUIDToStr(worker.DispatcherId)
+
+	telemetry.WithAttributes(span, servertel.WorkerId(workerId))
+
+	err = ec.mq.AddMessage(
+		ctx,
+		msgqueue.QueueTypeFromDispatcherID(dispatcherId),
+		stepRunTimeoutHookAssignedTask(metadata.TenantId, payload.StepRunId, workerId, dispatcherId, worker.ActionId),
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not add step run timeout hook assigned task to task queue: %w", err)
+	}
+
+	return nil
+}
+
+func stepRunTimeoutHookAssignedTask(tenantId, stepRunId, workerId, dispatcherId, actionId string) *msgqueue.Message {
+	payload, _ := datautils.ToJSONMap(tasktypes.StepRunTimeoutHookAssignedTaskPayload{
+		StepRunId: stepRunId,
+		WorkerId:  workerId,
+		ActionId:  actionId,
+	})
+
+	metadata, _ := datautils.ToJSONMap(tasktypes.StepRunTimeoutHookAssignedTaskMetadata{
+		TenantId:     tenantId,
+		DispatcherId: dispatcherId,
+	})
+
+	return &msgqueue.Message{
+		ID:       "step-run-timeout-hook-assigned",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	}
+}
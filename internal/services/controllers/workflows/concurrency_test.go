@@ -0,0 +1,414 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/testutils/chaos"
+)
+
+const (
+	scenarioTenantId          = "707d0855-80ab-4e1f-a156-f1c4546cbf52"
+	scenarioWorkflowVersionId = "00000000-0000-0000-0000-000000000001"
+)
+
+type scenarioRun struct {
+	id        string
+	groupKey  string
+	status    db.WorkflowRunStatus
+	stepRunId string
+	jobRunId  string
+}
+
+// scenarioRepository stores the workflow runs of a single workflow version in memory, in the order in which they
+// were created.
+type scenarioRepository struct {
+	repository.Repository
+
+	mu   sync.Mutex
+	runs []*scenarioRun
+	ids  int
+
+	// workflowVersion is returned as the workflow version of every run, if set
+	workflowVersion *db.WorkflowVersionModel
+}
+
+func (r *scenarioRepository) addRun(groupKey string, status db.WorkflowRunStatus) *scenarioRun {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	run := &scenarioRun{
+		id:        r.nextId(),
+		groupKey:  groupKey,
+		status:    status,
+		stepRunId: r.nextId(),
+		jobRunId:  r.nextId(),
+	}
+
+	r.runs = append(r.runs, run)
+
+	return run
+}
+
+// finishRun marks the run of the job run as succeeded and returns it.
+func (r *scenarioRepository) finishRun(jobRunId string) *scenarioRun {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, run := range r.runs {
+		if run.jobRunId == jobRunId {
+			run.status = db.WorkflowRunStatusSucceeded
+			return run
+		}
+	}
+
+	return nil
+}
+
+func (r *scenarioRepository) nextId() string {
+	r.ids++
+	return fmt.Sprintf("00000000-0000-0000-0001-%012d", r.ids)
+}
+
+func (r *scenarioRepository) countStatus(status db.WorkflowRunStatus) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	count := 0
+
+	for _, run := range r.runs {
+		if run.status == status {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (r *scenarioRepository) WorkflowRun() repository.WorkflowRunRepository {
+	return &scenarioWorkflowRunRepository{r: r}
+}
+
+func (r *scenarioRepository) StepRun() repository.StepRunRepository {
+	return &scenarioStepRunRepository{r: r}
+}
+
+type scenarioWorkflowRunRepository struct {
+	repository.WorkflowRunRepository
+
+	r *scenarioRepository
+}
+
+func (w *scenarioWorkflowRunRepository) ListWorkflowRuns(tenantId string, opts *repository.ListWorkflowRunsOpts) (*repository.ListWorkflowRunsResult, error) {
+	w.r.mu.Lock()
+	defer w.r.mu.Unlock()
+
+	res := &repository.ListWorkflowRunsResult{}
+
+	for _, run := range w.r.runs {
+		if opts.GroupKey != nil && run.groupKey != *opts.GroupKey {
+			continue
+		}
+
+		if opts.Status != nil && run.status != *opts.Status {
+			continue
+		}
+
+		if opts.Limit != nil && len(res.Rows) >= *opts.Limit {
+			break
+		}
+
+		res.Rows = append(res.Rows, &dbsqlc.ListWorkflowRunsRow{
+			WorkflowRun: dbsqlc.WorkflowRun{
+				ID: sqlchelpers.UUIDFromStr(run.id),
+			},
+		})
+	}
+
+	res.Count = len(res.Rows)
+
+	return res, nil
+}
+
+func (w *scenarioWorkflowRunRepository) PopWorkflowRunsRoundRobin(tenantId, workflowVersionId string, maxRuns int) ([]*dbsqlc.WorkflowRun, error) {
+	w.r.mu.Lock()
+	defer w.r.mu.Unlock()
+
+	running := 0
+
+	for _, run := range w.r.runs {
+		if run.status == db.WorkflowRunStatusRunning {
+			running++
+		}
+	}
+
+	res := []*dbsqlc.WorkflowRun{}
+
+	for _, run := range w.r.runs {
+		if running+len(res) >= maxRuns {
+			break
+		}
+
+		if run.status == db.WorkflowRunStatusQueued {
+			run.status = db.WorkflowRunStatusRunning
+
+			res = append(res, &dbsqlc.WorkflowRun{
+				ID: sqlchelpers.UUIDFromStr(run.id),
+			})
+		}
+	}
+
+	return res, nil
+}
+
+func (w *scenarioWorkflowRunRepository) GetWorkflowRunById(tenantId, runId string) (*db.WorkflowRunModel, error) {
+	w.r.mu.Lock()
+	defer w.r.mu.Unlock()
+
+	for _, run := range w.r.runs {
+		if run.id != runId {
+			continue
+		}
+
+		return &db.WorkflowRunModel{
+			InnerWorkflowRun: db.InnerWorkflowRun{
+				ID:       run.id,
+				TenantID: tenantId,
+				Status:   run.status,
+			},
+			RelationsWorkflowRun: db.RelationsWorkflowRun{
+				WorkflowVersion: w.r.workflowVersion,
+				JobRuns: []db.JobRunModel{
+					{
+						InnerJobRun: db.InnerJobRun{
+							ID:       run.jobRunId,
+							TenantID: tenantId,
+							Status:   db.JobRunStatusPending,
+						},
+						RelationsJobRun: db.RelationsJobRun{
+							Job: &db.JobModel{
+								InnerJob: db.InnerJob{
+									ID:                run.jobRunId,
+									TenantID:          tenantId,
+									WorkflowVersionID: scenarioWorkflowVersionId,
+									Name:              "job",
+								},
+							},
+						},
+					},
+				},
+			},
+		}, nil
+	}
+
+	return nil, db.ErrNotFound
+}
+
+type scenarioStepRunRepository struct {
+	repository.StepRunRepository
+
+	r *scenarioRepository
+}
+
+func (s *scenarioStepRunRepository) ListStepRuns(tenantId string, opts *repository.ListStepRunsOpts) ([]db.StepRunModel, error) {
+	s.r.mu.Lock()
+	defer s.r.mu.Unlock()
+
+	res := []db.StepRunModel{}
+
+	for _, run := range s.r.runs {
+		if opts.WorkflowRunId != nil && run.id != *opts.WorkflowRunId {
+			continue
+		}
+
+		res = append(res, db.StepRunModel{
+			InnerStepRun: db.InnerStepRun{
+				ID:       run.stepRunId,
+				TenantID: tenantId,
+			},
+		})
+	}
+
+	return res, nil
+}
+
+func scenarioWorkflowVersion(maxRuns int, strategy db.ConcurrencyLimitStrategy) *db.WorkflowVersionModel {
+	return &db.WorkflowVersionModel{
+		InnerWorkflowVersion: db.InnerWorkflowVersion{
+			ID: scenarioWorkflowVersionId,
+		},
+		RelationsWorkflowVersion: db.RelationsWorkflowVersion{
+			Concurrency: &db.WorkflowConcurrencyModel{
+				InnerWorkflowConcurrency: db.InnerWorkflowConcurrency{
+					WorkflowVersionID: scenarioWorkflowVersionId,
+					MaxRuns:           maxRuns,
+					LimitStrategy:     strategy,
+				},
+			},
+		},
+	}
+}
+
+type scenario struct {
+	repo     *scenarioRepository
+	recorder *chaos.Recorder
+	wc       *WorkflowsControllerImpl
+}
+
+func newScenario(seed int64, faults chaos.Faults) *scenario {
+	l := zerolog.Nop()
+	repo := &scenarioRepository{}
+	inj := chaos.NewInjector(seed, faults)
+	recorder := chaos.NewRecorder()
+
+	return &scenario{
+		repo:     repo,
+		recorder: recorder,
+		wc: &WorkflowsControllerImpl{
+			mq:   chaos.NewMessageQueue(recorder, inj),
+			l:    &l,
+			repo: chaos.NewRepository(repo, inj),
+			dv:   datautils.NewDataDecoderValidator(),
+
+			clock: clockwork.NewRealClock(),
+		},
+	}
+}
+
+// retry calls the handler until it succeeds, like a message which is redelivered by the queue after it fails.
+func (s *scenario) retry(t *testing.T, handler func() error) {
+	t.Helper()
+
+	for i := 0; i < 100; i++ {
+		if err := handler(); err == nil {
+			return
+		}
+	}
+
+	t.Fatalf("handler did not succeed after 100 attempts")
+}
+
+// cancelledStepRuns returns the ids of the step runs which the controller asked to cancel.
+func (s *scenario) cancelledStepRuns() map[string]bool {
+	res := map[string]bool{}
+
+	for _, m := range s.recorder.Messages("step-run-cancelled") {
+		res[m.Message.Payload["step_run_id"].(string)] = true
+	}
+
+	return res
+}
+
+// queuedJobRuns returns the ids of the job runs which the controller queued.
+func (s *scenario) queuedJobRuns() map[string]bool {
+	res := map[string]bool{}
+
+	for _, m := range s.recorder.Messages("job-run-queued") {
+		res[m.Message.Payload["job_run_id"].(string)] = true
+	}
+
+	return res
+}
+
+func TestCancelInProgress(t *testing.T) {
+	s := newScenario(1, chaos.Faults{})
+
+	oldest := s.repo.addRun("a", db.WorkflowRunStatusRunning)
+	newest := s.repo.addRun("a", db.WorkflowRunStatusRunning)
+	other := s.repo.addRun("b", db.WorkflowRunStatusRunning)
+	queued := s.repo.addRun("a", db.WorkflowRunStatusQueued)
+
+	err := s.wc.queueByCancelInProgress(context.Background(), scenarioTenantId, "a", scenarioWorkflowVersion(1, db.ConcurrencyLimitStrategyCancelInProgress))
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]bool{oldest.stepRunId: true}, s.cancelledStepRuns(), "only the oldest run of the group should be cancelled")
+	assert.NotContains(t, s.cancelledStepRuns(), newest.stepRunId)
+	assert.NotContains(t, s.cancelledStepRuns(), other.stepRunId)
+	assert.Equal(t, map[string]bool{queued.jobRunId: true}, s.queuedJobRuns())
+}
+
+func TestCancelInProgressUnderFaults(t *testing.T) {
+	for seed := int64(0); seed < 20; seed++ {
+		t.Run(fmt.Sprintf("seed %d", seed), func(t *testing.T) {
+			s := newScenario(seed, chaos.Faults{
+				ConflictRate:     0.2,
+				PublishErrorRate: 0.2,
+			})
+
+			oldest := s.repo.addRun("a", db.WorkflowRunStatusRunning)
+			newest := s.repo.addRun("a", db.WorkflowRunStatusRunning)
+			other := s.repo.addRun("b", db.WorkflowRunStatusRunning)
+			queued := s.repo.addRun("a", db.WorkflowRunStatusQueued)
+
+			workflowVersion := scenarioWorkflowVersion(1, db.ConcurrencyLimitStrategyCancelInProgress)
+
+			handler := func() error {
+				return s.wc.queueByCancelInProgress(context.Background(), scenarioTenantId, "a", workflowVersion)
+			}
+
+			s.retry(t, handler)
+
+			// the message is delivered a second time, like a message whose ack was lost
+			s.retry(t, handler)
+
+			// however often the handler is retried, the newest run and the runs of other groups are never cancelled
+			cancelled := s.cancelledStepRuns()
+
+			assert.True(t, cancelled[oldest.stepRunId], "the oldest run should be cancelled")
+			assert.False(t, cancelled[newest.stepRunId], "the newest run should not be cancelled")
+			assert.False(t, cancelled[other.stepRunId], "runs of other groups should not be cancelled")
+			assert.Equal(t, map[string]bool{queued.jobRunId: true}, s.queuedJobRuns())
+		})
+	}
+}
+
+func TestCancelInProgressReturnsPublishErrors(t *testing.T) {
+	s := newScenario(1, chaos.Faults{PublishErrorRate: 1})
+
+	s.repo.addRun("a", db.WorkflowRunStatusRunning)
+	s.repo.addRun("a", db.WorkflowRunStatusQueued)
+
+	err := s.wc.queueByCancelInProgress(context.Background(), scenarioTenantId, "a", scenarioWorkflowVersion(1, db.ConcurrencyLimitStrategyCancelInProgress))
+
+	// the message must be redelivered, so the cancellation can't be lost
+	assert.True(t, errors.Is(err, chaos.ErrPublishFailed), "expected a publish error, got %v", err)
+}
+
+func TestGroupRoundRobinUnderFaults(t *testing.T) {
+	for seed := int64(0); seed < 20; seed++ {
+		t.Run(fmt.Sprintf("seed %d", seed), func(t *testing.T) {
+			s := newScenario(seed, chaos.Faults{
+				ConflictRate:     0.2,
+				PublishErrorRate: 0.2,
+			})
+
+			for i := 0; i < 5; i++ {
+				s.repo.addRun(fmt.Sprintf("group-%d", i%2), db.WorkflowRunStatusQueued)
+			}
+
+			workflowVersion := scenarioWorkflowVersion(2, db.ConcurrencyLimitStrategyGroupRoundRobin)
+
+			for i := 0; i < 5; i++ {
+				// a conflict may fail the handler after runs were popped, which is recovered by the requeue loop
+				// rather than the retry, so only the limit is checked here
+				_ = s.wc.queueByGroupRoundRobin(context.Background(), scenarioTenantId, workflowVersion)
+
+				assert.LessOrEqual(t, s.repo.countStatus(db.WorkflowRunStatusRunning), 2, "the concurrency limit should not be exceeded")
+				assert.LessOrEqual(t, len(s.queuedJobRuns()), 2, "no more runs than the limit should be queued")
+			}
+		})
+	}
+}
@@ -7,16 +7,23 @@ import (
 	"time"
 
 	"github.com/go-co-op/gocron/v2"
+	"github.com/google/uuid"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/jonboulle/clockwork"
 	"github.com/rs/zerolog"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/integrations/vcs"
 	"github.com/hatchet-dev/hatchet/internal/logger"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/features"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/leader"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/settings"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
 )
@@ -31,8 +38,44 @@ type WorkflowsControllerImpl struct {
 	repo repository.Repository
 	dv   datautils.DataDecoderValidator
 	s    gocron.Scheduler
+
+	// clock is the clock of the scheduler and of the requeue and reassign loops, so that they can run on
+	// simulated time in tests
+	clock clockwork.Clock
+
+	// elector makes sure that the requeue and reassign loops only run on one replica
+	elector *leader.Elector
+
+	// requeueTasks are the tasks of the requeue and reassign loops by job id, so their interval can be changed
+	requeueMu       sync.Mutex
+	requeueInterval time.Duration
+	requeueTasks    map[uuid.UUID]func()
+
+	// workflowVersions caches the workflow versions, including their concurrency settings, which are read when
+	// runs of the workflow are queued
+	workflowVersions *expirable.LRU[string, *db.WorkflowVersionModel]
+
+	// groupKeys caches the group keys of the get group key runs by workflow version and input, so runs with the
+	// same input are assigned a group key without a get group key run. It is nil if the cache is disabled.
+	groupKeys *expirable.LRU[string, string]
+
+	// featureFlags decide which tenants use the group key cache. All tenants use it if they are not set.
+	featureFlags features.Flags
+
+	// settings resolves the timeout of get group key runs
+	settings settings.Service
+
+	// vcsProviders are used to report preview environments on pull requests. Preview environments aren't reported
+	// if there are no providers.
+	vcsProviders map[vcs.VCSRepositoryKind]vcs.VCSProvider
 }
 
+// workflowVersionsTTL is how long a workflow version is cached. Workflow versions don't change after they are
+// created, and a controller drops the versions of a workflow when it receives a workflow-updated message. As that
+// message is only delivered to one controller, the TTL bounds how long other controllers keep the versions of a
+// deleted workflow.
+const workflowVersionsTTL = 5 * time.Minute
+
 type WorkflowsControllerOpt func(*WorkflowsControllerOpts)
 
 type WorkflowsControllerOpts struct {
@@ -40,13 +83,22 @@ type WorkflowsControllerOpts struct {
 	l    *zerolog.Logger
 	repo repository.Repository
 	dv   datautils.DataDecoderValidator
+
+	requeueInterval  time.Duration
+	groupKeyCacheTTL time.Duration
+	featureFlags     features.Flags
+	settings         settings.Service
+	clock            clockwork.Clock
+	vcsProviders     map[vcs.VCSRepositoryKind]vcs.VCSProvider
 }
 
 func defaultWorkflowsControllerOpts() *WorkflowsControllerOpts {
 	logger := logger.NewDefaultLogger("workflows-controller")
 	return &WorkflowsControllerOpts{
-		l:  &logger,
-		dv: datautils.NewDataDecoderValidator(),
+		l:               &logger,
+		dv:              datautils.NewDataDecoderValidator(),
+		requeueInterval: 5 * time.Second,
+		clock:           clockwork.NewRealClock(),
 	}
 }
 
@@ -74,6 +126,51 @@ func WithDataDecoderValidator(dv datautils.DataDecoderValidator) WorkflowsContro
 	}
 }
 
+// WithRequeueInterval sets the interval of the get group key run requeue and reassign loops.
+func WithRequeueInterval(interval time.Duration) WorkflowsControllerOpt {
+	return func(opts *WorkflowsControllerOpts) {
+		opts.requeueInterval = interval
+	}
+}
+
+// WithGroupKeyCacheTTL enables the group key cache, which assigns a cached group key to a run whose workflow
+// version and input match a previous get group key run, for the given TTL. It should only be enabled if the get
+// group key functions of the workflows only depend on their input.
+func WithGroupKeyCacheTTL(ttl time.Duration) WorkflowsControllerOpt {
+	return func(opts *WorkflowsControllerOpts) {
+		opts.groupKeyCacheTTL = ttl
+	}
+}
+
+// WithFeatureFlags sets the flags which decide which tenants use the group key cache.
+func WithFeatureFlags(ff features.Flags) WorkflowsControllerOpt {
+	return func(opts *WorkflowsControllerOpts) {
+		opts.featureFlags = ff
+	}
+}
+
+// WithEngineSettings sets the service which resolves the engine settings of tenants. If not set, the controller
+// reads the settings from its repository.
+func WithEngineSettings(s settings.Service) WorkflowsControllerOpt {
+	return func(opts *WorkflowsControllerOpts) {
+		opts.settings = s
+	}
+}
+
+// WithVCSProviders sets the VCS providers which are used to report preview environments on pull requests.
+func WithVCSProviders(vcsProviders map[vcs.VCSRepositoryKind]vcs.VCSProvider) WorkflowsControllerOpt {
+	return func(opts *WorkflowsControllerOpts) {
+		opts.vcsProviders = vcsProviders
+	}
+}
+
+// WithClock sets the clock of the controller. It is only meant to be set by tests.
+func WithClock(clock clockwork.Clock) WorkflowsControllerOpt {
+	return func(opts *WorkflowsControllerOpts) {
+		opts.clock = clock
+	}
+}
+
 func New(fs ...WorkflowsControllerOpt) (*WorkflowsControllerImpl, error) {
 	opts := defaultWorkflowsControllerOpts()
 
@@ -89,14 +186,34 @@ func New(fs ...WorkflowsControllerOpt) (*WorkflowsControllerImpl, error) {
 		return nil, fmt.Errorf("repository is required. use WithRepository")
 	}
 
-	s, err := gocron.NewScheduler(gocron.WithLocation(time.UTC))
+	if err := leader.ValidateInterval(opts.requeueInterval); err != nil {
+		return nil, fmt.Errorf("invalid requeue interval: %w", err)
+	}
+
+	newLogger := opts.l.With().Str("service", "workflows-controller").Logger()
+	opts.l = &newLogger
+
+	elector := leader.NewElector(opts.repo.LeaderLease(), opts.l, "workflows-controller")
+
+	s, err := gocron.NewScheduler(
+		gocron.WithLocation(time.UTC),
+		gocron.WithDistributedElector(elector),
+		gocron.WithClock(opts.clock),
+	)
 
 	if err != nil {
 		return nil, fmt.Errorf("could not create scheduler: %w", err)
 	}
 
-	newLogger := opts.l.With().Str("service", "workflows-controller").Logger()
-	opts.l = &newLogger
+	if opts.settings == nil {
+		opts.settings = settings.NewService(opts.repo.EngineSettings(), opts.l)
+	}
+
+	var groupKeys *expirable.LRU[string, string]
+
+	if opts.groupKeyCacheTTL > 0 {
+		groupKeys = expirable.NewLRU[string, string](10000, nil, opts.groupKeyCacheTTL)
+	}
 
 	return &WorkflowsControllerImpl{
 		mq:   opts.mq,
@@ -104,6 +221,20 @@ func New(fs ...WorkflowsControllerOpt) (*WorkflowsControllerImpl, error) {
 		repo: opts.repo,
 		dv:   opts.dv,
 		s:    s,
+
+		clock: opts.clock,
+
+		elector: elector,
+
+		requeueInterval: opts.requeueInterval,
+		requeueTasks:    map[uuid.UUID]func(){},
+
+		workflowVersions: expirable.NewLRU[string, *db.WorkflowVersionModel](1000, nil, workflowVersionsTTL),
+		groupKeys:        groupKeys,
+		featureFlags:     opts.featureFlags,
+		settings:         opts.settings,
+
+		vcsProviders: opts.vcsProviders,
 	}, nil
 }
 
@@ -114,24 +245,14 @@ func (wc *WorkflowsControllerImpl) Start() (func() error, error) {
 
 	wg := sync.WaitGroup{}
 
-	_, err := wc.s.NewJob(
-		gocron.DurationJob(time.Second*5),
-		gocron.NewTask(
-			wc.runGetGroupKeyRunRequeue(ctx),
-		),
-	)
+	err := wc.scheduleRequeueTask(wc.runGetGroupKeyRunRequeue(ctx))
 
 	if err != nil {
 		cancel()
 		return nil, fmt.Errorf("could not schedule get group key run requeue: %w", err)
 	}
 
-	_, err = wc.s.NewJob(
-		gocron.DurationJob(time.Second*5),
-		gocron.NewTask(
-			wc.runGetGroupKeyRunReassign(ctx),
-		),
-	)
+	err = wc.scheduleRequeueTask(wc.runGetGroupKeyRunReassign(ctx))
 
 	if err != nil {
 		cancel()
@@ -144,9 +265,11 @@ func (wc *WorkflowsControllerImpl) Start() (func() error, error) {
 		wg.Add(1)
 		defer wg.Done()
 
-		err := wc.handleTask(context.Background(), task)
+		ctx := msgqueue.ContextForMessage(context.Background(), task)
+
+		err := wc.handleTask(ctx, task)
 		if err != nil {
-			wc.l.Error().Err(err).Msg("could not handle job task")
+			msgqueue.Logger(ctx, wc.l).Error().Err(err).Msg("could not handle job task")
 			return err
 		}
 
@@ -173,12 +296,59 @@ func (wc *WorkflowsControllerImpl) Start() (func() error, error) {
 			return fmt.Errorf("could not shutdown scheduler: %w", err)
 		}
 
+		if err := wc.elector.Release(context.Background()); err != nil {
+			return fmt.Errorf("could not release leader lease: %w", err)
+		}
+
 		return nil
 	}
 
 	return cleanup, nil
 }
 
+func (wc *WorkflowsControllerImpl) scheduleRequeueTask(task func()) error {
+	wc.requeueMu.Lock()
+	defer wc.requeueMu.Unlock()
+
+	j, err := wc.s.NewJob(
+		gocron.DurationJob(wc.requeueInterval),
+		gocron.NewTask(task),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	wc.requeueTasks[j.ID()] = task
+
+	return nil
+}
+
+// SetRequeueInterval changes the interval of the get group key run requeue and reassign loops while the
+// controller is running.
+func (wc *WorkflowsControllerImpl) SetRequeueInterval(interval time.Duration) error {
+	if err := leader.ValidateInterval(interval); err != nil {
+		return fmt.Errorf("invalid requeue interval: %w", err)
+	}
+
+	wc.requeueMu.Lock()
+	defer wc.requeueMu.Unlock()
+
+	if interval == wc.requeueInterval {
+		return nil
+	}
+
+	for id, task := range wc.requeueTasks {
+		if _, err := wc.s.Update(id, gocron.DurationJob(interval), gocron.NewTask(task)); err != nil {
+			return fmt.Errorf("could not update requeue interval: %w", err)
+		}
+	}
+
+	wc.requeueInterval = interval
+
+	return nil
+}
+
 func (wc *WorkflowsControllerImpl) handleTask(ctx context.Context, task *msgqueue.Message) error {
 	switch task.ID {
 	case "workflow-run-queued":
@@ -191,6 +361,10 @@ func (wc *WorkflowsControllerImpl) handleTask(ctx context.Context, task *msgqueu
 		return wc.handleGroupKeyRunFailed(ctx, task)
 	case "workflow-run-finished":
 		return wc.handleWorkflowRunFinished(ctx, task)
+	case "workflow-run-budget-exceeded":
+		return wc.handleWorkflowRunBudgetExceeded(ctx, task)
+	case "workflow-updated":
+		return wc.handleWorkflowUpdated(ctx, task)
 	}
 
 	return fmt.Errorf("unknown task: %s", task.ID)
@@ -266,28 +440,16 @@ func (wc *WorkflowsControllerImpl) handleGroupKeyRunFinished(ctx context.Context
 		return fmt.Errorf("could not update group key run: %w", err)
 	}
 
-	errGroup := new(errgroup.Group)
-
-	errGroup.Go(func() error {
-		workflowVersionId := sqlchelpers.UUIDToStr(groupKeyRun.WorkflowVersionId)
-		workflowVersion, err := wc.repo.Workflow().GetWorkflowVersionById(metadata.TenantId, workflowVersionId)
+	workflowVersionId := sqlchelpers.UUIDToStr(groupKeyRun.WorkflowVersionId)
 
-		if err != nil {
-			return fmt.Errorf("could not get workflow version: %w", err)
-		}
-
-		concurrency, _ := workflowVersion.Concurrency()
+	if wc.useGroupKeyCache(metadata.TenantId) {
+		wc.groupKeys.Add(groupKeyCacheKey(workflowVersionId, groupKeyRun.GetGroupKeyRun.Input), payload.GroupKey)
+	}
 
-		switch concurrency.LimitStrategy {
-		case db.ConcurrencyLimitStrategyCancelInProgress:
-			err = wc.queueByCancelInProgress(ctx, metadata.TenantId, payload.GroupKey, workflowVersion)
-		case db.ConcurrencyLimitStrategyGroupRoundRobin:
-			err = wc.queueByGroupRoundRobin(ctx, metadata.TenantId, workflowVersion)
-		default:
-			return fmt.Errorf("unimplemented concurrency limit strategy: %s", concurrency.LimitStrategy)
-		}
+	errGroup := new(errgroup.Group)
 
-		return err
+	errGroup.Go(func() error {
+		return wc.queueByGroupKey(ctx, metadata.TenantId, workflowVersionId, payload.GroupKey)
 	})
 
 	// cancel the timeout task
@@ -308,6 +470,53 @@ func (wc *WorkflowsControllerImpl) handleGroupKeyRunFinished(ctx context.Context
 	return errGroup.Wait()
 }
 
+func (wc *WorkflowsControllerImpl) handleWorkflowUpdated(ctx context.Context, task *msgqueue.Message) error {
+	payload := tasktypes.WorkflowUpdatedTaskPayload{}
+	metadata := tasktypes.WorkflowUpdatedTaskMetadata{}
+
+	err := wc.dv.DecodeAndValidate(task.Payload, &payload)
+
+	if err != nil {
+		return fmt.Errorf("could not decode workflow updated task payload: %w", err)
+	}
+
+	err = wc.dv.DecodeAndValidate(task.Metadata, &metadata)
+
+	if err != nil {
+		return fmt.Errorf("could not decode workflow updated task metadata: %w", err)
+	}
+
+	wc.invalidateWorkflowVersions(payload.WorkflowId)
+
+	return nil
+}
+
+// getWorkflowVersion returns a workflow version from the cache, or reads it from the database if it isn't cached.
+func (wc *WorkflowsControllerImpl) getWorkflowVersion(tenantId, workflowVersionId string) (*db.WorkflowVersionModel, error) {
+	if workflowVersion, ok := wc.workflowVersions.Get(workflowVersionId); ok {
+		return workflowVersion, nil
+	}
+
+	workflowVersion, err := wc.repo.Workflow().GetWorkflowVersionById(tenantId, workflowVersionId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	wc.workflowVersions.Add(workflowVersionId, workflowVersion)
+
+	return workflowVersion, nil
+}
+
+// invalidateWorkflowVersions drops the cached versions of a workflow.
+func (wc *WorkflowsControllerImpl) invalidateWorkflowVersions(workflowId string) {
+	for _, workflowVersionId := range wc.workflowVersions.Keys() {
+		if workflowVersion, ok := wc.workflowVersions.Peek(workflowVersionId); ok && workflowVersion.WorkflowID == workflowId {
+			wc.workflowVersions.Remove(workflowVersionId)
+		}
+	}
+}
+
 func (wc *WorkflowsControllerImpl) handleGroupKeyRunFailed(ctx context.Context, task *msgqueue.Message) error {
 	ctx, span := telemetry.NewSpan(ctx, "handle-group-key-run-failed")
 	defer span.End()
@@ -0,0 +1,29 @@
+package workflows
+
+import (
+	"testing"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func TestInvalidateWorkflowVersions(t *testing.T) {
+	wc := &WorkflowsControllerImpl{
+		workflowVersions: expirable.NewLRU[string, *db.WorkflowVersionModel](10, nil, workflowVersionsTTL),
+	}
+
+	for id, workflowId := range map[string]string{"v1": "a", "v2": "a", "v3": "b"} {
+		wc.workflowVersions.Add(id, &db.WorkflowVersionModel{
+			InnerWorkflowVersion: db.InnerWorkflowVersion{
+				ID:         id,
+				WorkflowID: workflowId,
+			},
+		})
+	}
+
+	wc.invalidateWorkflowVersions("a")
+
+	assert.Equal(t, []string{"v3"}, wc.workflowVersions.Keys(), "only the versions of the updated workflow should be dropped")
+}
@@ -0,0 +1,218 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/vcs"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+// reportPreviewEnvironments reports the result of a finished run of a workflow which provisions or tears down
+// preview environments, with a comment on each pull request which the run was triggered for. The url of a
+// provisioned environment is read from the `url` key of the output of the steps of the run.
+func (wc *WorkflowsControllerImpl) reportPreviewEnvironments(ctx context.Context, tenantId string, workflowRun *db.WorkflowRunModel) error {
+	if len(wc.vcsProviders) == 0 {
+		return nil
+	}
+
+	if workflowRun.Status != db.WorkflowRunStatusSucceeded && workflowRun.Status != db.WorkflowRunStatusFailed {
+		return nil
+	}
+
+	workflow, err := wc.repo.Workflow().GetWorkflowById(workflowRun.WorkflowVersion().WorkflowID)
+
+	if err != nil {
+		return fmt.Errorf("could not get workflow: %w", err)
+	}
+
+	deploymentConfig, ok := workflow.DeploymentConfig()
+
+	if !ok {
+		return nil
+	}
+
+	hook, ok := deploymentConfig.PreviewEnvironmentHook()
+
+	if !ok {
+		return nil
+	}
+
+	prs, err := wc.repo.WorkflowRun().ListPullRequestsForWorkflowRun(tenantId, workflowRun.ID, &repository.ListPullRequestsForWorkflowRunOpts{})
+
+	if err != nil {
+		return fmt.Errorf("could not list pull requests: %w", err)
+	}
+
+	if len(prs) == 0 {
+		return nil
+	}
+
+	git, err := vcs.GetVCSRepositoryFromWorkflow(wc.vcsProviders, workflow)
+
+	if err != nil {
+		return fmt.Errorf("could not get VCS repository from workflow: %w", err)
+	}
+
+	for i := range prs {
+		pr := &prs[i]
+
+		switch hook {
+		case db.PreviewEnvironmentHookProvision:
+			err = wc.reportProvisionedEnvironment(tenantId, git, pr, workflow, workflowRun)
+		case db.PreviewEnvironmentHookTeardown:
+			err = wc.reportTornDownEnvironments(tenantId, git, pr, workflowRun)
+		}
+
+		if err != nil {
+			msgqueue.Logger(ctx, wc.l).Err(err).Msgf("could not report preview environment on pull request %s", pr.ID)
+		}
+	}
+
+	return nil
+}
+
+func (wc *WorkflowsControllerImpl) reportProvisionedEnvironment(tenantId string, git vcs.VCSRepository, pr *db.GithubPullRequestModel, workflow *db.WorkflowModel, workflowRun *db.WorkflowRunModel) error {
+	var commentId *int64
+
+	env, err := wc.repo.Github().GetPreviewEnvironment(tenantId, pr.ID, workflow.ID)
+
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		return fmt.Errorf("could not get preview environment: %w", err)
+	} else if err == nil {
+		if id, ok := env.CommentID(); ok {
+			commentId = (*int64)(&id)
+		}
+	}
+
+	opts := &repository.UpsertPreviewEnvironmentOpts{}
+
+	var body string
+
+	if workflowRun.Status == db.WorkflowRunStatusSucceeded {
+		url, err := wc.previewEnvironmentURL(tenantId, workflowRun.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if url != "" {
+			body = fmt.Sprintf("The preview environment of `%s` is ready at %s", workflow.Name, url)
+			opts.URL = &url
+		} else {
+			body = fmt.Sprintf("The preview environment of `%s` is ready.", workflow.Name)
+		}
+	} else {
+		body = fmt.Sprintf("The preview environment of `%s` could not be provisioned, as workflow run `%s` failed.", workflow.Name, workflowRun.ID)
+	}
+
+	newCommentId, err := git.CreateOrUpdatePullRequestComment(pr.PullRequestNumber, commentId, body)
+
+	if err != nil {
+		return err
+	}
+
+	opts.CommentID = &newCommentId
+
+	_, err = wc.repo.Github().UpsertPreviewEnvironment(tenantId, pr.ID, workflow.ID, opts)
+
+	if err != nil {
+		return fmt.Errorf("could not update preview environment: %w", err)
+	}
+
+	return nil
+}
+
+func (wc *WorkflowsControllerImpl) reportTornDownEnvironments(tenantId string, git vcs.VCSRepository, pr *db.GithubPullRequestModel, workflowRun *db.WorkflowRunModel) error {
+	envs, err := wc.repo.Github().ListPreviewEnvironments(tenantId, pr.ID)
+
+	if err != nil {
+		return fmt.Errorf("could not list preview environments: %w", err)
+	}
+
+	for i := range envs {
+		env := &envs[i]
+
+		if _, tornDown := env.TornDownAt(); tornDown {
+			continue
+		}
+
+		var commentId *int64
+
+		if id, ok := env.CommentID(); ok {
+			commentId = (*int64)(&id)
+		}
+
+		opts := &repository.UpsertPreviewEnvironmentOpts{}
+
+		var body string
+
+		if workflowRun.Status == db.WorkflowRunStatusSucceeded {
+			tornDownAt := time.Now().UTC()
+			opts.TornDownAt = &tornDownAt
+
+			body = fmt.Sprintf("The preview environment of `%s` was torn down.", env.Workflow().Name)
+		} else {
+			body = fmt.Sprintf("The preview environment of `%s` could not be torn down, as workflow run `%s` failed.", env.Workflow().Name, workflowRun.ID)
+		}
+
+		newCommentId, err := git.CreateOrUpdatePullRequestComment(pr.PullRequestNumber, commentId, body)
+
+		if err != nil {
+			return err
+		}
+
+		opts.CommentID = &newCommentId
+
+		if _, err := wc.repo.Github().UpsertPreviewEnvironment(tenantId, pr.ID, env.WorkflowID, opts); err != nil {
+			return fmt.Errorf("could not update preview environment: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// previewEnvironmentURL returns the url of the environment which a workflow run provisioned, from the `url` key of
+// the output of its steps. If several steps output a url, the url of the step which finished last is used.
+func (wc *WorkflowsControllerImpl) previewEnvironmentURL(tenantId, workflowRunId string) (string, error) {
+	stepRuns, err := wc.repo.StepRun().ListStepRuns(tenantId, &repository.ListStepRunsOpts{
+		WorkflowRunId: &workflowRunId,
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("could not list step runs: %w", err)
+	}
+
+	var url string
+	var urlFinishedAt time.Time
+
+	for _, stepRun := range stepRuns {
+		output, ok := stepRun.Output()
+
+		if !ok {
+			continue
+		}
+
+		parsed := struct {
+			URL string `json:"url"`
+		}{}
+
+		if err := json.Unmarshal(output, &parsed); err != nil || parsed.URL == "" {
+			continue
+		}
+
+		finishedAt, _ := stepRun.FinishedAt()
+
+		if url == "" || finishedAt.After(urlFinishedAt) {
+			url = parsed.URL
+			urlFinishedAt = finishedAt
+		}
+	}
+
+	return url, nil
+}
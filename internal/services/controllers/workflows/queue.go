@@ -2,6 +2,8 @@ package workflows
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"time"
@@ -15,7 +17,9 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
-	"github.com/hatchet-dev/hatchet/internal/services/shared/defaults"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/budget"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/concurrency"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/features"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
 	"github.com/hatchet-dev/hatchet/internal/telemetry/servertel"
@@ -49,12 +53,33 @@ func (wc *WorkflowsControllerImpl) handleWorkflowRunQueued(ctx context.Context,
 
 	servertel.WithWorkflowRunModel(span, workflowRun)
 
-	wc.l.Info().Msgf("starting workflow run %s", workflowRun.ID)
+	// runs of paused workflows and tenants, and of workflows in a maintenance window, are held back, and are queued
+	// again when the pause is lifted or the window ends
+	buffered, err := wc.repo.WorkflowRun().BufferWorkflowRunIfPaused(ctx, metadata.TenantId, workflowRun.ID)
+
+	if err != nil {
+		return fmt.Errorf("could not buffer workflow run: %w", err)
+	}
+
+	if buffered {
+		msgqueue.Logger(ctx, wc.l).Info().Msgf("workflow run %s is paused or in a maintenance window, buffering it", workflowRun.ID)
+		return nil
+	}
+
+	msgqueue.Logger(ctx, wc.l).Info().Msgf("starting workflow run %s", workflowRun.ID)
+
+	// the concurrency settings are read from the cached workflow version, which is read again when the get group key
+	// run of the workflow run finishes
+	workflowVersion, err := wc.getWorkflowVersion(metadata.TenantId, workflowRun.WorkflowVersionID)
+
+	if err != nil {
+		return fmt.Errorf("could not get workflow version: %w", err)
+	}
 
 	// determine if we should start this workflow run or we need to limit its concurrency
 	// if the workflow has concurrency settings, then we need to check if we can start it
-	if _, hasConcurrency := workflowRun.WorkflowVersion().Concurrency(); hasConcurrency {
-		wc.l.Info().Msgf("workflow %s has concurrency settings", workflowRun.ID)
+	if workflowConcurrency, hasConcurrency := workflowVersion.Concurrency(); hasConcurrency {
+		msgqueue.Logger(ctx, wc.l).Info().Msgf("workflow %s has concurrency settings", workflowRun.ID)
 
 		groupKeyRun, ok := workflowRun.GetGroupKeyRun()
 
@@ -68,6 +93,27 @@ func (wc *WorkflowsControllerImpl) handleWorkflowRunQueued(ctx context.Context,
 			return fmt.Errorf("could not get group key run for engine: %w", err)
 		}
 
+		// group keys which are computed from key expressions don't need a worker
+		if len(workflowConcurrency.KeyExpressions) > 0 {
+			var additionalMetadata []byte
+
+			if data, ok := workflowRun.AdditionalMetadata(); ok {
+				additionalMetadata = []byte(data)
+			}
+
+			return wc.assignExpressionGroupKey(ctx, sqlcGroupKeyRun, workflowConcurrency.KeyExpressions, additionalMetadata)
+		}
+
+		if wc.useGroupKeyCache(metadata.TenantId) {
+			cacheKey := groupKeyCacheKey(workflowRun.WorkflowVersionID, sqlcGroupKeyRun.GetGroupKeyRun.Input)
+
+			if groupKey, ok := wc.groupKeys.Get(cacheKey); ok {
+				msgqueue.Logger(ctx, wc.l).Debug().Msgf("assigning cached group key to workflow run %s", workflowRun.ID)
+
+				return wc.assignGroupKey(ctx, sqlcGroupKeyRun, groupKey)
+			}
+		}
+
 		err = wc.scheduleGetGroupAction(ctx, sqlcGroupKeyRun)
 
 		if err != nil {
@@ -114,11 +160,28 @@ func (wc *WorkflowsControllerImpl) handleWorkflowRunFinished(ctx context.Context
 
 	servertel.WithWorkflowRunModel(span, workflowRun)
 
-	wc.l.Info().Msgf("finishing workflow run %s", workflowRun.ID)
+	msgqueue.Logger(ctx, wc.l).Info().Msgf("finishing workflow run %s", workflowRun.ID)
+
+	// forwarding the finished run to log sinks and event bus subscriptions is best-effort, so it does not block
+	// finishing the workflow run
+	finishedData := workflowRunFinishedData(workflowRun)
+
+	if err := wc.repo.LogSink().CreateLogSinkRecord(ctx, metadata.TenantId, repository.LogSinkEventWorkflowRunFinished, finishedData); err != nil {
+		msgqueue.Logger(ctx, wc.l).Err(err).Msgf("could not create log sink record for workflow run %s", workflowRun.ID)
+	}
+
+	if err := wc.repo.EventBus().CreateEventBusRecord(ctx, metadata.TenantId, repository.EventBusTopicWorkflowRunFinished, finishedData); err != nil {
+		msgqueue.Logger(ctx, wc.l).Err(err).Msgf("could not create event bus record for workflow run %s", workflowRun.ID)
+	}
+
+	// reporting preview environments is best-effort as well, since a failed comment shouldn't retry the message
+	if err := wc.reportPreviewEnvironments(ctx, metadata.TenantId, workflowRun); err != nil {
+		msgqueue.Logger(ctx, wc.l).Err(err).Msgf("could not report preview environments of workflow run %s", workflowRun.ID)
+	}
 
 	// if the workflow run has a concurrency group, then we need to queue any queued workflow runs
 	if concurrency, hasConcurrency := workflowRun.WorkflowVersion().Concurrency(); hasConcurrency {
-		wc.l.Info().Msgf("workflow %s has concurrency settings", workflowRun.ID)
+		msgqueue.Logger(ctx, wc.l).Info().Msgf("workflow %s has concurrency settings", workflowRun.ID)
 
 		switch concurrency.LimitStrategy {
 		case db.ConcurrencyLimitStrategyGroupRoundRobin:
@@ -135,6 +198,104 @@ func (wc *WorkflowsControllerImpl) handleWorkflowRunFinished(ctx context.Context
 	return nil
 }
 
+// handleWorkflowRunBudgetExceeded fails a workflow run which exceeded the budget of its workflow version, by
+// cancelling its unfinished step runs. The run fails once the cancellations resolve, with the BUDGET_EXCEEDED
+// cancellation source.
+func (wc *WorkflowsControllerImpl) handleWorkflowRunBudgetExceeded(ctx context.Context, task *msgqueue.Message) error {
+	ctx, span := telemetry.NewSpan(ctx, "handle-workflow-run-budget-exceeded")
+	defer span.End()
+
+	payload := tasktypes.WorkflowRunBudgetExceededTaskPayload{}
+	metadata := tasktypes.WorkflowRunBudgetExceededTaskMetadata{}
+
+	err := wc.dv.DecodeAndValidate(task.Payload, &payload)
+
+	if err != nil {
+		return fmt.Errorf("could not decode workflow run budget exceeded task payload: %w", err)
+	}
+
+	err = wc.dv.DecodeAndValidate(task.Metadata, &metadata)
+
+	if err != nil {
+		return fmt.Errorf("could not decode workflow run budget exceeded task metadata: %w", err)
+	}
+
+	workflowRun, err := wc.repo.WorkflowRun().GetWorkflowRunById(metadata.TenantId, payload.WorkflowRunId)
+
+	if err != nil {
+		return fmt.Errorf("could not get workflow run: %w", err)
+	}
+
+	servertel.WithWorkflowRunModel(span, workflowRun)
+
+	if workflowRun.Status == db.WorkflowRunStatusSucceeded || workflowRun.Status == db.WorkflowRunStatusFailed {
+		return nil
+	}
+
+	msgqueue.Logger(ctx, wc.l).Info().Msgf("failing workflow run %s: %s", workflowRun.ID, payload.Reason)
+
+	stepRuns, err := wc.repo.StepRun().ListStepRuns(metadata.TenantId, &repository.ListStepRunsOpts{
+		WorkflowRunId: &workflowRun.ID,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not list step runs: %w", err)
+	}
+
+	errGroup := new(errgroup.Group)
+
+	for i := range stepRuns {
+		stepRunCp := stepRuns[i]
+
+		if isFinalStepRunStatus(stepRunCp.Status) {
+			continue
+		}
+
+		// step runs which are already being cancelled finish within their cancellation grace period
+		if _, cancelling := stepRunCp.CancelledAt(); cancelling {
+			continue
+		}
+
+		errGroup.Go(func() error {
+			return wc.mq.AddMessage(
+				ctx,
+				msgqueue.JOB_PROCESSING_QUEUE,
+				getStepRunNotifyCancelTask(metadata.TenantId, stepRunCp.ID, budget.CancelledReason),
+			)
+		})
+	}
+
+	return errGroup.Wait()
+}
+
+func isFinalStepRunStatus(status db.StepRunStatus) bool {
+	return status == db.StepRunStatusSucceeded || status == db.StepRunStatusFailed || status == db.StepRunStatusCancelled
+}
+
+// workflowRunFinishedData returns the data of the log sink and event bus records for a finished workflow run.
+func workflowRunFinishedData(workflowRun *db.WorkflowRunModel) map[string]interface{} {
+	data := map[string]interface{}{
+		"workflowRunId":     workflowRun.ID,
+		"workflowVersionId": workflowRun.WorkflowVersionID,
+		"workflowName":      workflowRun.WorkflowVersion().Workflow().Name,
+		"status":            string(workflowRun.Status),
+	}
+
+	if startedAt, ok := workflowRun.StartedAt(); ok {
+		data["startedAt"] = startedAt
+	}
+
+	if finishedAt, ok := workflowRun.FinishedAt(); ok {
+		data["finishedAt"] = finishedAt
+	}
+
+	if runErr, ok := workflowRun.Error(); ok {
+		data["error"] = runErr
+	}
+
+	return data
+}
+
 func (wc *WorkflowsControllerImpl) scheduleGetGroupAction(
 	ctx context.Context,
 	getGroupKeyRun *dbsqlc.GetGroupKeyRunForEngineRow,
@@ -161,7 +322,7 @@ func (wc *WorkflowsControllerImpl) scheduleGetGroupAction(
 
 	if err != nil {
 		if errors.Is(err, repository.ErrNoWorkerAvailable) {
-			wc.l.Debug().Msgf("no worker available for get group key run %s, requeueing", getGroupKeyRunId)
+			msgqueue.Logger(ctx, wc.l).Debug().Msgf("no worker available for get group key run %s, requeueing", getGroupKeyRunId)
 			return nil
 		}
 
@@ -176,11 +337,11 @@ func (wc *WorkflowsControllerImpl) scheduleGetGroupAction(
 		return fmt.Errorf("could not assign get group key run to ticker: %w", err)
 	}
 
-	scheduleTimeoutTask, err := scheduleGetGroupKeyRunTimeoutTask(tenantId, workflowRunId, getGroupKeyRunId)
+	// get group key runs are part of scheduling the workflow run, so they time out after the schedule timeout of the
+	// workflow version
+	timeoutAt := wc.clock.Now().Add(wc.getGroupKeyRunScheduleTimeout(tenantId, getGroupKeyRun.ScheduleTimeout))
 
-	if err != nil {
-		return fmt.Errorf("could not schedule get group key run timeout task: %w", err)
-	}
+	scheduleTimeoutTask := scheduleGetGroupKeyRunTimeoutTask(timeoutAt, tenantId, workflowRunId, getGroupKeyRunId)
 
 	// send a task to the dispatcher
 	err = wc.mq.AddMessage(
@@ -212,6 +373,129 @@ func (wc *WorkflowsControllerImpl) scheduleGetGroupAction(
 	return nil
 }
 
+// useGroupKeyCache returns whether the group key cache is enabled, and the tenant hasn't opted out of it.
+func (wc *WorkflowsControllerImpl) useGroupKeyCache(tenantId string) bool {
+	if wc.groupKeys == nil {
+		return false
+	}
+
+	return wc.featureFlags == nil || wc.featureFlags.Enabled(tenantId, features.GroupKeyCache)
+}
+
+// groupKeyCacheKey returns the key of the group key cache for the input of a get group key run. Inputs are stored
+// as jsonb, which orders their keys, so equal inputs have equal bytes.
+func groupKeyCacheKey(workflowVersionId string, input []byte) string {
+	h := sha256.New()
+
+	h.Write([]byte(workflowVersionId))
+	h.Write([]byte{0})
+	h.Write(input)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// assignExpressionGroupKey computes the group key of a workflow run from the key expressions of its workflow
+// version, and assigns it without sending the get group key run to a worker. Like a failed get group key action,
+// a key which can't be computed fails the get group key run, and with it the workflow run.
+func (wc *WorkflowsControllerImpl) assignExpressionGroupKey(
+	ctx context.Context,
+	getGroupKeyRun *dbsqlc.GetGroupKeyRunForEngineRow,
+	keyExpressions []string,
+	additionalMetadata []byte,
+) error {
+	tenantId := sqlchelpers.UUIDToStr(getGroupKeyRun.GetGroupKeyRun.TenantId)
+	getGroupKeyRunId := sqlchelpers.UUIDToStr(getGroupKeyRun.GetGroupKeyRun.ID)
+
+	groupKey, components, evalErr := evalGroupKey(keyExpressions, getGroupKeyRun.GetGroupKeyRun.Input, additionalMetadata)
+
+	if evalErr != nil {
+		now := wc.clock.Now().UTC()
+		errStr := fmt.Sprintf("could not compute concurrency group key: %s", evalErr.Error())
+
+		_, err := wc.repo.GetGroupKeyRun().UpdateGetGroupKeyRun(tenantId, getGroupKeyRunId, &repository.UpdateGetGroupKeyRunOpts{
+			FinishedAt: &now,
+			Error:      &errStr,
+			Status:     repository.StepRunStatusPtr(db.StepRunStatusFailed),
+		})
+
+		if err != nil {
+			return fmt.Errorf("could not update get group key run: %w", err)
+		}
+
+		return nil
+	}
+
+	// the components are stored before the key is assigned, so that queued runs always have their components
+	err := wc.repo.WorkflowRun().UpdateWorkflowRunConcurrencyGroupComponents(
+		ctx,
+		tenantId,
+		sqlchelpers.UUIDToStr(getGroupKeyRun.WorkflowRunId),
+		components,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return wc.assignGroupKey(ctx, getGroupKeyRun, groupKey)
+}
+
+func evalGroupKey(keyExpressions []string, input, additionalMetadata []byte) (string, []string, error) {
+	key, err := concurrency.CompileCompositeKey(keyExpressions)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	return key.Eval(input, additionalMetadata)
+}
+
+// assignGroupKey finishes a get group key run with a group key which was computed without sending it to a worker,
+// and queues the workflow runs of the group.
+func (wc *WorkflowsControllerImpl) assignGroupKey(
+	ctx context.Context,
+	getGroupKeyRun *dbsqlc.GetGroupKeyRunForEngineRow,
+	groupKey string,
+) error {
+	tenantId := sqlchelpers.UUIDToStr(getGroupKeyRun.GetGroupKeyRun.TenantId)
+	getGroupKeyRunId := sqlchelpers.UUIDToStr(getGroupKeyRun.GetGroupKeyRun.ID)
+	now := wc.clock.Now().UTC()
+
+	_, err := wc.repo.GetGroupKeyRun().UpdateGetGroupKeyRun(tenantId, getGroupKeyRunId, &repository.UpdateGetGroupKeyRunOpts{
+		StartedAt:  &now,
+		FinishedAt: &now,
+		Status:     repository.StepRunStatusPtr(db.StepRunStatusSucceeded),
+		Output:     &groupKey,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not update get group key run: %w", err)
+	}
+
+	return wc.queueByGroupKey(ctx, tenantId, sqlchelpers.UUIDToStr(getGroupKeyRun.WorkflowVersionId), groupKey)
+}
+
+// queueByGroupKey queues the workflow runs of a workflow version with concurrency settings, after a run of the
+// group has been assigned its group key.
+func (wc *WorkflowsControllerImpl) queueByGroupKey(ctx context.Context, tenantId, workflowVersionId, groupKey string) error {
+	workflowVersion, err := wc.getWorkflowVersion(tenantId, workflowVersionId)
+
+	if err != nil {
+		return fmt.Errorf("could not get workflow version: %w", err)
+	}
+
+	concurrency, _ := workflowVersion.Concurrency()
+
+	switch concurrency.LimitStrategy {
+	case db.ConcurrencyLimitStrategyCancelInProgress:
+		return wc.queueByCancelInProgress(ctx, tenantId, groupKey, workflowVersion)
+	case db.ConcurrencyLimitStrategyGroupRoundRobin:
+		return wc.queueByGroupRoundRobin(ctx, tenantId, workflowVersion)
+	default:
+		return fmt.Errorf("unimplemented concurrency limit strategy: %s", concurrency.LimitStrategy)
+	}
+}
+
 func (wc *WorkflowsControllerImpl) queueWorkflowRunJobs(ctx context.Context, workflowRun *db.WorkflowRunModel) error {
 	ctx, span := telemetry.NewSpan(ctx, "process-event")
 	defer span.End()
@@ -221,14 +505,19 @@ func (wc *WorkflowsControllerImpl) queueWorkflowRunJobs(ctx context.Context, wor
 	var err error
 
 	for i := range jobRuns {
-		err := wc.mq.AddMessage(
-			context.Background(),
+		// job runs of a replay can be copied from the replayed run, in which case they have already succeeded
+		if jobRuns[i].Status != db.JobRunStatusPending {
+			continue
+		}
+
+		addErr := wc.mq.AddMessage(
+			ctx,
 			msgqueue.JOB_PROCESSING_QUEUE,
 			tasktypes.JobRunQueuedToTask(jobRuns[i].Job(), &jobRuns[i]),
 		)
 
-		if err != nil {
-			err = multierror.Append(err, fmt.Errorf("could not add job run to task queue: %w", err))
+		if addErr != nil {
+			err = multierror.Append(err, fmt.Errorf("could not add job run to task queue: %w", addErr))
 		}
 	}
 
@@ -293,14 +582,20 @@ func (ec *WorkflowsControllerImpl) runGetGroupKeyRunRequeueTenant(ctx context.Co
 
 			ec.l.Debug().Msgf("requeueing group key run %s", getGroupKeyRunId)
 
-			now := time.Now().UTC().UTC()
+			now := ec.clock.Now().UTC()
 
 			// if the current time is after the scheduleTimeoutAt, then mark this as timed out
 			scheduleTimeoutAt := getGroupKeyRunCp.ScheduleTimeoutAt.Time
 
+			// get group key runs which were created without a scheduleTimeoutAt time out after the schedule timeout of the
+			// tenant
+			if scheduleTimeoutAt.IsZero() {
+				scheduleTimeoutAt = getGroupKeyRunCp.CreatedAt.Time.Add(ec.settings.Get(tenantId).ScheduleTimeout)
+			}
+
 			// timed out if there was no scheduleTimeoutAt set and the current time is after the get group key run created at time plus the default schedule timeout,
 			// or if the scheduleTimeoutAt is set and the current time is after the scheduleTimeoutAt
-			isTimedOut := !scheduleTimeoutAt.IsZero() && scheduleTimeoutAt.Before(now)
+			isTimedOut := scheduleTimeoutAt.Before(now)
 
 			if isTimedOut {
 				innerGetGroupKeyRun, err = ec.repo.GetGroupKeyRun().UpdateGetGroupKeyRun(tenantId, getGroupKeyRunId, &repository.UpdateGetGroupKeyRunOpts{
@@ -316,7 +611,7 @@ func (ec *WorkflowsControllerImpl) runGetGroupKeyRunRequeueTenant(ctx context.Co
 				return nil
 			}
 
-			requeueAfter := time.Now().UTC().Add(time.Second * 5)
+			requeueAfter := ec.clock.Now().UTC().Add(time.Second * 5)
 
 			innerGetGroupKeyRun, err = ec.repo.GetGroupKeyRun().UpdateGetGroupKeyRun(tenantId, getGroupKeyRunId, &repository.UpdateGetGroupKeyRunOpts{
 				RequeueAfter: &requeueAfter,
@@ -326,13 +621,44 @@ func (ec *WorkflowsControllerImpl) runGetGroupKeyRunRequeueTenant(ctx context.Co
 				return fmt.Errorf("could not update get group key run %s: %w", getGroupKeyRunId, err)
 			}
 
-			return ec.scheduleGetGroupAction(ctx, innerGetGroupKeyRun)
+			return ec.requeueGetGroupKeyRun(ctx, innerGetGroupKeyRun)
 		})
 	}
 
 	return g.Wait()
 }
 
+// requeueGetGroupKeyRun computes the group key of a get group key run whose workflow version has key expressions,
+// for example if the engine stopped before it finished queueing the workflow run, and schedules the get group key
+// action of any other get group key run.
+func (wc *WorkflowsControllerImpl) requeueGetGroupKeyRun(ctx context.Context, getGroupKeyRun *dbsqlc.GetGroupKeyRunForEngineRow) error {
+	tenantId := sqlchelpers.UUIDToStr(getGroupKeyRun.GetGroupKeyRun.TenantId)
+
+	workflowVersion, err := wc.getWorkflowVersion(tenantId, sqlchelpers.UUIDToStr(getGroupKeyRun.WorkflowVersionId))
+
+	if err != nil {
+		return fmt.Errorf("could not get workflow version: %w", err)
+	}
+
+	if workflowConcurrency, ok := workflowVersion.Concurrency(); ok && len(workflowConcurrency.KeyExpressions) > 0 {
+		workflowRun, err := wc.repo.WorkflowRun().GetWorkflowRunById(tenantId, sqlchelpers.UUIDToStr(getGroupKeyRun.WorkflowRunId))
+
+		if err != nil {
+			return fmt.Errorf("could not get workflow run: %w", err)
+		}
+
+		var additionalMetadata []byte
+
+		if data, ok := workflowRun.AdditionalMetadata(); ok {
+			additionalMetadata = []byte(data)
+		}
+
+		return wc.assignExpressionGroupKey(ctx, getGroupKeyRun, workflowConcurrency.KeyExpressions, additionalMetadata)
+	}
+
+	return wc.scheduleGetGroupAction(ctx, getGroupKeyRun)
+}
+
 func (wc *WorkflowsControllerImpl) runGetGroupKeyRunReassign(ctx context.Context) func() {
 	return func() {
 		wc.l.Debug().Msgf("workflows controller: checking get group key run reassign")
@@ -390,7 +716,7 @@ func (ec *WorkflowsControllerImpl) runGetGroupKeyRunReassignTenant(ctx context.C
 
 			ec.l.Debug().Msgf("reassigning group key run %s", getGroupKeyRunId)
 
-			requeueAfter := time.Now().UTC().Add(time.Second * 5)
+			requeueAfter := ec.clock.Now().UTC().Add(time.Second * 5)
 
 			innerGetGroupKeyRun, err = ec.repo.GetGroupKeyRun().UpdateGetGroupKeyRun(tenantId, getGroupKeyRunId, &repository.UpdateGetGroupKeyRunOpts{
 				RequeueAfter: &requeueAfter,
@@ -412,7 +738,7 @@ func (wc *WorkflowsControllerImpl) queueByCancelInProgress(ctx context.Context,
 	ctx, span := telemetry.NewSpan(ctx, "queue-by-cancel-in-progress")
 	defer span.End()
 
-	wc.l.Info().Msgf("handling queue with strategy CANCEL_IN_PROGRESS for %s", groupKey)
+	msgqueue.Logger(ctx, wc.l).Info().Msgf("handling queue with strategy CANCEL_IN_PROGRESS for %s", groupKey)
 
 	concurrency, hasConcurrency := workflowVersion.Concurrency()
 
@@ -508,7 +834,7 @@ func (wc *WorkflowsControllerImpl) queueByGroupRoundRobin(ctx context.Context, t
 	ctx, span := telemetry.NewSpan(ctx, "queue-by-group-round-robin")
 	defer span.End()
 
-	wc.l.Info().Msgf("handling queue with strategy GROUP_ROUND_ROBIN for workflow version %s", workflowVersion.ID)
+	msgqueue.Logger(ctx, wc.l).Info().Msgf("handling queue with strategy GROUP_ROUND_ROBIN for workflow version %s", workflowVersion.ID)
 
 	concurrency, hasConcurrency := workflowVersion.Concurrency()
 
@@ -531,7 +857,7 @@ func (wc *WorkflowsControllerImpl) queueByGroupRoundRobin(ctx context.Context, t
 		errGroup.Go(func() error {
 			workflowRunId := sqlchelpers.UUIDToStr(row.ID)
 
-			wc.l.Info().Msgf("popped workflow run %s", workflowRunId)
+			msgqueue.Logger(ctx, wc.l).Info().Msgf("popped workflow run %s", workflowRunId)
 			workflowRun, err := wc.repo.WorkflowRun().GetWorkflowRunById(tenantId, workflowRunId)
 
 			if err != nil {
@@ -620,22 +946,21 @@ func getStepRunNotifyCancelTask(tenantId, stepRunId, reason string) *msgqueue.Me
 	}
 }
 
-func scheduleGetGroupKeyRunTimeoutTask(tenantId, workflowRunId, getGroupKeyRunId string) (*msgqueue.Message, error) {
-	durationStr := defaults.DefaultStepRunTimeout
-
-	// get a duration
-	duration, err := time.ParseDuration(durationStr)
-
-	if err != nil {
-		return nil, fmt.Errorf("could not parse duration: %w", err)
+// getGroupKeyRunScheduleTimeout returns the schedule timeout of a workflow version, or the schedule timeout of the
+// tenant if the workflow version's can't be parsed.
+func (wc *WorkflowsControllerImpl) getGroupKeyRunScheduleTimeout(tenantId, scheduleTimeout string) time.Duration {
+	if parsed, err := time.ParseDuration(scheduleTimeout); err == nil {
+		return parsed
 	}
 
-	timeoutAt := time.Now().UTC().Add(duration)
+	return wc.settings.Get(tenantId).ScheduleTimeout
+}
 
+func scheduleGetGroupKeyRunTimeoutTask(timeoutAt time.Time, tenantId, workflowRunId, getGroupKeyRunId string) *msgqueue.Message {
 	payload, _ := datautils.ToJSONMap(tasktypes.ScheduleGetGroupKeyRunTimeoutTaskPayload{
 		GetGroupKeyRunId: getGroupKeyRunId,
 		WorkflowRunId:    workflowRunId,
-		TimeoutAt:        timeoutAt.Format(time.RFC3339),
+		TimeoutAt:        timeoutAt.UTC().Format(time.RFC3339),
 	})
 
 	metadata, _ := datautils.ToJSONMap(tasktypes.ScheduleGetGroupKeyRunTimeoutTaskMetadata{
@@ -647,5 +972,5 @@ func scheduleGetGroupKeyRunTimeoutTask(tenantId, workflowRunId, getGroupKeyRunId
 		Payload:  payload,
 		Metadata: metadata,
 		Retries:  3,
-	}, nil
+	}
 }
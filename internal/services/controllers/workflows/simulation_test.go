@@ -0,0 +1,126 @@
+package workflows
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/internal/testutils/sim"
+)
+
+type simulationResult struct {
+	trace      []string
+	maxRunning int
+	started    int
+	finished   int
+	queued     int
+}
+
+// simulateGroupRoundRobin runs a workflow with a GROUP_ROUND_ROBIN concurrency limit on simulated time. Runs arrive
+// at random on a ticker, and a simulated job controller finishes the queued job runs after a random duration, which
+// queues the next runs through the workflow-run-finished handler of the controller.
+func simulateGroupRoundRobin(t *testing.T, seed int64, maxRuns int, duration time.Duration) *simulationResult {
+	t.Helper()
+
+	s := sim.New(seed, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	mq := s.NewMessageQueue(sim.WithLatency(time.Millisecond, 50*time.Millisecond))
+
+	workflowVersion := scenarioWorkflowVersion(maxRuns, db.ConcurrencyLimitStrategyGroupRoundRobin)
+
+	repo := &scenarioRepository{
+		workflowVersion: workflowVersion,
+	}
+
+	l := zerolog.Nop()
+
+	wc := &WorkflowsControllerImpl{
+		mq:    mq,
+		l:     &l,
+		repo:  repo,
+		dv:    datautils.NewDataDecoderValidator(),
+		clock: s.Clock(),
+	}
+
+	res := &simulationResult{}
+
+	_, err := mq.Subscribe(msgqueue.WORKFLOW_PROCESSING_QUEUE, func(task *msgqueue.Message) error {
+		return wc.handleTask(context.Background(), task)
+	}, msgqueue.NoOpHook)
+	require.NoError(t, err)
+
+	// the job controller runs every queued job run for a random duration, and then finishes the workflow run
+	_, err = mq.Subscribe(msgqueue.JOB_PROCESSING_QUEUE, func(task *msgqueue.Message) error {
+		if task.ID != "job-run-queued" {
+			return nil
+		}
+
+		jobRunId := task.Payload["job_run_id"].(string)
+
+		res.started++
+		res.trace = append(res.trace, fmt.Sprintf("%s started %s", s.Now().Format(time.RFC3339Nano), jobRunId))
+
+		if running := repo.countStatus(db.WorkflowRunStatusRunning); running > res.maxRunning {
+			res.maxRunning = running
+		}
+
+		s.After(time.Duration(1+s.Rand().Intn(60))*time.Second, func() {
+			run := repo.finishRun(jobRunId)
+
+			res.finished++
+			res.trace = append(res.trace, fmt.Sprintf("%s finished %s", s.Now().Format(time.RFC3339Nano), jobRunId))
+
+			_ = mq.AddMessage(
+				context.Background(),
+				msgqueue.WORKFLOW_PROCESSING_QUEUE,
+				tasktypes.WorkflowRunFinishedToTask(scenarioTenantId, run.id, string(db.WorkflowRunStatusSucceeded)),
+			)
+		})
+
+		return nil
+	}, msgqueue.NoOpHook)
+	require.NoError(t, err)
+
+	// runs of a few groups arrive at random, and are queued like runs whose group key run has finished
+	s.Every(30*time.Second, func() {
+		for i := s.Rand().Intn(4); i > 0; i-- {
+			repo.addRun(fmt.Sprintf("group-%d", s.Rand().Intn(3)), db.WorkflowRunStatusQueued)
+			res.queued++
+		}
+
+		if err := wc.queueByGroupRoundRobin(context.Background(), scenarioTenantId, workflowVersion); err != nil {
+			t.Errorf("could not queue workflow runs: %v", err)
+		}
+	})
+
+	s.RunFor(duration)
+
+	return res
+}
+
+func TestGroupRoundRobinSimulation(t *testing.T) {
+	wallStart := time.Now()
+
+	res := simulateGroupRoundRobin(t, 1, 3, 6*time.Hour)
+
+	assert.Greater(t, res.queued, 1000, "the simulation should cover enough runs to be meaningful")
+	assert.LessOrEqual(t, res.maxRunning, 3, "the concurrency limit should never be exceeded")
+	assert.Equal(t, 3, res.maxRunning, "the concurrency limit should be reached under load")
+	assert.GreaterOrEqual(t, res.finished, res.queued-10, "the queue should not build up, as the runs are queued again when a run finishes")
+	assert.Less(t, time.Since(wallStart), 30*time.Second, "simulated hours should not take wall clock time")
+}
+
+func TestGroupRoundRobinSimulationIsReproducible(t *testing.T) {
+	first := simulateGroupRoundRobin(t, 7, 2, time.Hour)
+
+	assert.Equal(t, first.trace, simulateGroupRoundRobin(t, 7, 2, time.Hour).trace, "the same seed should produce the same schedule")
+	assert.NotEqual(t, first.trace, simulateGroupRoundRobin(t, 8, 2, time.Hour).trace, "a different seed should produce a different schedule")
+}
@@ -29,6 +29,12 @@ type DispatcherClient interface {
 	SendGroupKeyActionEvent(ctx context.Context, in *GroupKeyActionEvent, opts ...grpc.CallOption) (*ActionEventResponse, error)
 	PutOverridesData(ctx context.Context, in *OverridesData, opts ...grpc.CallOption) (*OverridesDataResponse, error)
 	Unsubscribe(ctx context.Context, in *WorkerUnsubscribeRequest, opts ...grpc.CallOption) (*WorkerUnsubscribeResponse, error)
+	RefreshTimeout(ctx context.Context, in *RefreshTimeoutRequest, opts ...grpc.CallOption) (*RefreshTimeoutResponse, error)
+	GetActionPayload(ctx context.Context, in *GetActionPayloadRequest, opts ...grpc.CallOption) (*GetActionPayloadResponse, error)
+	ListStepRunResults(ctx context.Context, in *ListStepRunResultsRequest, opts ...grpc.CallOption) (*ListStepRunResultsResponse, error)
+	ReleaseStepRun(ctx context.Context, in *ReleaseStepRunRequest, opts ...grpc.CallOption) (*ReleaseStepRunResponse, error)
+	AcquireLock(ctx context.Context, in *AcquireLockRequest, opts ...grpc.CallOption) (*AcquireLockResponse, error)
+	ReleaseLock(ctx context.Context, in *ReleaseLockRequest, opts ...grpc.CallOption) (*ReleaseLockResponse, error)
 }
 
 type dispatcherClient struct {
@@ -148,6 +154,60 @@ func (c *dispatcherClient) Unsubscribe(ctx context.Context, in *WorkerUnsubscrib
 	return out, nil
 }
 
+func (c *dispatcherClient) RefreshTimeout(ctx context.Context, in *RefreshTimeoutRequest, opts ...grpc.CallOption) (*RefreshTimeoutResponse, error) {
+	out := new(RefreshTimeoutResponse)
+	err := c.cc.Invoke(ctx, "/Dispatcher/RefreshTimeout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherClient) GetActionPayload(ctx context.Context, in *GetActionPayloadRequest, opts ...grpc.CallOption) (*GetActionPayloadResponse, error) {
+	out := new(GetActionPayloadResponse)
+	err := c.cc.Invoke(ctx, "/Dispatcher/GetActionPayload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherClient) ListStepRunResults(ctx context.Context, in *ListStepRunResultsRequest, opts ...grpc.CallOption) (*ListStepRunResultsResponse, error) {
+	out := new(ListStepRunResultsResponse)
+	err := c.cc.Invoke(ctx, "/Dispatcher/ListStepRunResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherClient) ReleaseStepRun(ctx context.Context, in *ReleaseStepRunRequest, opts ...grpc.CallOption) (*ReleaseStepRunResponse, error) {
+	out := new(ReleaseStepRunResponse)
+	err := c.cc.Invoke(ctx, "/Dispatcher/ReleaseStepRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherClient) AcquireLock(ctx context.Context, in *AcquireLockRequest, opts ...grpc.CallOption) (*AcquireLockResponse, error) {
+	out := new(AcquireLockResponse)
+	err := c.cc.Invoke(ctx, "/Dispatcher/AcquireLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherClient) ReleaseLock(ctx context.Context, in *ReleaseLockRequest, opts ...grpc.CallOption) (*ReleaseLockResponse, error) {
+	out := new(ReleaseLockResponse)
+	err := c.cc.Invoke(ctx, "/Dispatcher/ReleaseLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DispatcherServer is the server API for Dispatcher service.
 // All implementations must embed UnimplementedDispatcherServer
 // for forward compatibility
@@ -159,6 +219,12 @@ type DispatcherServer interface {
 	SendGroupKeyActionEvent(context.Context, *GroupKeyActionEvent) (*ActionEventResponse, error)
 	PutOverridesData(context.Context, *OverridesData) (*OverridesDataResponse, error)
 	Unsubscribe(context.Context, *WorkerUnsubscribeRequest) (*WorkerUnsubscribeResponse, error)
+	RefreshTimeout(context.Context, *RefreshTimeoutRequest) (*RefreshTimeoutResponse, error)
+	GetActionPayload(context.Context, *GetActionPayloadRequest) (*GetActionPayloadResponse, error)
+	ListStepRunResults(context.Context, *ListStepRunResultsRequest) (*ListStepRunResultsResponse, error)
+	ReleaseStepRun(context.Context, *ReleaseStepRunRequest) (*ReleaseStepRunResponse, error)
+	AcquireLock(context.Context, *AcquireLockRequest) (*AcquireLockResponse, error)
+	ReleaseLock(context.Context, *ReleaseLockRequest) (*ReleaseLockResponse, error)
 	mustEmbedUnimplementedDispatcherServer()
 }
 
@@ -187,6 +253,24 @@ func (UnimplementedDispatcherServer) PutOverridesData(context.Context, *Override
 func (UnimplementedDispatcherServer) Unsubscribe(context.Context, *WorkerUnsubscribeRequest) (*WorkerUnsubscribeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unsubscribe not implemented")
 }
+func (UnimplementedDispatcherServer) RefreshTimeout(context.Context, *RefreshTimeoutRequest) (*RefreshTimeoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshTimeout not implemented")
+}
+func (UnimplementedDispatcherServer) GetActionPayload(context.Context, *GetActionPayloadRequest) (*GetActionPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActionPayload not implemented")
+}
+func (UnimplementedDispatcherServer) ListStepRunResults(context.Context, *ListStepRunResultsRequest) (*ListStepRunResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStepRunResults not implemented")
+}
+func (UnimplementedDispatcherServer) ReleaseStepRun(context.Context, *ReleaseStepRunRequest) (*ReleaseStepRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseStepRun not implemented")
+}
+func (UnimplementedDispatcherServer) AcquireLock(context.Context, *AcquireLockRequest) (*AcquireLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquireLock not implemented")
+}
+func (UnimplementedDispatcherServer) ReleaseLock(context.Context, *ReleaseLockRequest) (*ReleaseLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseLock not implemented")
+}
 func (UnimplementedDispatcherServer) mustEmbedUnimplementedDispatcherServer() {}
 
 // UnsafeDispatcherServer may be embedded to opt out of forward compatibility for this service.
@@ -332,6 +416,114 @@ func _Dispatcher_Unsubscribe_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dispatcher_RefreshTimeout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTimeoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).RefreshTimeout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dispatcher/RefreshTimeout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).RefreshTimeout(ctx, req.(*RefreshTimeoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dispatcher_GetActionPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActionPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).GetActionPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dispatcher/GetActionPayload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).GetActionPayload(ctx, req.(*GetActionPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dispatcher_ListStepRunResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStepRunResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).ListStepRunResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dispatcher/ListStepRunResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).ListStepRunResults(ctx, req.(*ListStepRunResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dispatcher_ReleaseStepRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseStepRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).ReleaseStepRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dispatcher/ReleaseStepRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).ReleaseStepRun(ctx, req.(*ReleaseStepRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dispatcher_AcquireLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).AcquireLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dispatcher/AcquireLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).AcquireLock(ctx, req.(*AcquireLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dispatcher_ReleaseLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).ReleaseLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dispatcher/ReleaseLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).ReleaseLock(ctx, req.(*ReleaseLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Dispatcher_ServiceDesc is the grpc.ServiceDesc for Dispatcher service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -359,6 +551,30 @@ var Dispatcher_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Unsubscribe",
 			Handler:    _Dispatcher_Unsubscribe_Handler,
 		},
+		{
+			MethodName: "RefreshTimeout",
+			Handler:    _Dispatcher_RefreshTimeout_Handler,
+		},
+		{
+			MethodName: "GetActionPayload",
+			Handler:    _Dispatcher_GetActionPayload_Handler,
+		},
+		{
+			MethodName: "ListStepRunResults",
+			Handler:    _Dispatcher_ListStepRunResults_Handler,
+		},
+		{
+			MethodName: "ReleaseStepRun",
+			Handler:    _Dispatcher_ReleaseStepRun_Handler,
+		},
+		{
+			MethodName: "AcquireLock",
+			Handler:    _Dispatcher_AcquireLock_Handler,
+		},
+		{
+			MethodName: "ReleaseLock",
+			Handler:    _Dispatcher_ReleaseLock_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -15,6 +15,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/limits"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
 	"github.com/hatchet-dev/hatchet/internal/telemetry/servertel"
@@ -23,6 +24,12 @@ import (
 type Dispatcher interface {
 	contracts.DispatcherServer
 	Start() (func() error, error)
+
+	// Poll returns the actions which are assigned to a worker which long-polls for them over HTTP.
+	Poll(ctx context.Context, workerId string, wait time.Duration, maxActions int) ([]*contracts.AssignedAction, error)
+
+	// Heartbeat keeps a worker which long-polls for its actions subscribed.
+	Heartbeat(ctx context.Context, workerId string) error
 }
 
 type DispatcherImpl struct {
@@ -33,8 +40,15 @@ type DispatcherImpl struct {
 	l            *zerolog.Logger
 	dv           datautils.DataDecoderValidator
 	repo         repository.Repository
+	pl           *limits.PayloadLimits
 	dispatcherId string
 	workers      sync.Map
+
+	// stepEvents batches the step run events which are sent to the jobs controller
+	stepEvents *msgqueue.Batcher
+
+	// payloadHydrationThreshold is the size above which step run inputs are fetched by workers which support it
+	payloadHydrationThreshold int
 }
 
 type DispatcherOpt func(*DispatcherOpts)
@@ -44,7 +58,13 @@ type DispatcherOpts struct {
 	l            *zerolog.Logger
 	dv           datautils.DataDecoderValidator
 	repo         repository.Repository
+	pl           *limits.PayloadLimits
 	dispatcherId string
+
+	stepEventBatchSize     int
+	stepEventFlushInterval time.Duration
+
+	payloadHydrationThreshold int
 }
 
 func defaultDispatcherOpts() *DispatcherOpts {
@@ -80,6 +100,33 @@ func WithDataDecoderValidator(dv datautils.DataDecoderValidator) DispatcherOpt {
 	}
 }
 
+// WithPayloadLimits sets the limits which step run outputs are checked against. If not set, outputs are not
+// limited.
+func WithPayloadLimits(pl *limits.PayloadLimits) DispatcherOpt {
+	return func(opts *DispatcherOpts) {
+		opts.pl = pl
+	}
+}
+
+// WithStepEventBatching sends the started, finished and failed events of step runs to the jobs controller in
+// batches of up to batchSize events, which wait for up to flushInterval for the batch to fill. If batchSize is
+// less than 2, events are sent one by one.
+func WithStepEventBatching(batchSize int, flushInterval time.Duration) DispatcherOpt {
+	return func(opts *DispatcherOpts) {
+		opts.stepEventBatchSize = batchSize
+		opts.stepEventFlushInterval = flushInterval
+	}
+}
+
+// WithPayloadHydrationThreshold leaves step run inputs larger than threshold bytes out of the actions which are
+// sent to workers that support hydration, which fetch the input with GetActionPayload when they start the step run.
+// If threshold is 0, inputs are always sent in full.
+func WithPayloadHydrationThreshold(threshold int) DispatcherOpt {
+	return func(opts *DispatcherOpts) {
+		opts.payloadHydrationThreshold = threshold
+	}
+}
+
 func WithDispatcherId(dispatcherId string) DispatcherOpt {
 	return func(opts *DispatcherOpts) {
 		opts.dispatcherId = dispatcherId
@@ -116,9 +163,13 @@ func New(fs ...DispatcherOpt) (*DispatcherImpl, error) {
 		l:            opts.l,
 		dv:           opts.dv,
 		repo:         opts.repo,
+		pl:           opts.pl,
 		dispatcherId: opts.dispatcherId,
 		workers:      sync.Map{},
 		s:            s,
+		stepEvents:   msgqueue.NewBatcher(opts.mq, opts.stepEventBatchSize, opts.stepEventFlushInterval),
+
+		payloadHydrationThreshold: opts.payloadHydrationThreshold,
 	}, nil
 }
 
@@ -192,6 +243,9 @@ func (d *DispatcherImpl) Start() (func() error, error) {
 			return true
 		})
 
+		// send the step run events which are waiting for their batch to fill
+		d.stepEvents.Flush()
+
 		err = d.repo.Dispatcher().Delete(dispatcher.ID)
 		if err != nil {
 			return fmt.Errorf("could not delete dispatcher: %w", err)
@@ -218,6 +272,8 @@ func (d *DispatcherImpl) handleTask(ctx context.Context, task *msgqueue.Message)
 		return d.handleStepRunAssignedTask(ctx, task)
 	case "step-run-cancelled":
 		return d.handleStepRunCancelled(ctx, task)
+	case "step-run-timeout-hook-assigned":
+		return d.handleStepRunTimeoutHookAssignedTask(ctx, task)
 	}
 
 	return fmt.Errorf("unknown task: %s", task.ID)
@@ -361,6 +417,52 @@ func (d *DispatcherImpl) handleStepRunCancelled(ctx context.Context, task *msgqu
 	return nil
 }
 
+func (d *DispatcherImpl) handleStepRunTimeoutHookAssignedTask(ctx context.Context, task *msgqueue.Message) error {
+	ctx, span := telemetry.NewSpan(ctx, "step-run-timeout-hook-assigned")
+	defer span.End()
+
+	payload := tasktypes.StepRunTimeoutHookAssignedTaskPayload{}
+	metadata := tasktypes.StepRunTimeoutHookAssignedTaskMetadata{}
+
+	err := d.dv.DecodeAndValidate(task.Payload, &payload)
+
+	if err != nil {
+		return fmt.Errorf("could not decode dispatcher task payload: %w", err)
+	}
+
+	err = d.dv.DecodeAndValidate(task.Metadata, &metadata)
+
+	if err != nil {
+		return fmt.Errorf("could not decode dispatcher task metadata: %w", err)
+	}
+
+	// get the worker for this task
+	w, err := d.GetWorker(payload.WorkerId)
+
+	if err != nil {
+		return fmt.Errorf("could not get worker: %w", err)
+	}
+
+	telemetry.WithAttributes(span, servertel.WorkerId(payload.WorkerId))
+
+	// load the step run from the database
+	stepRun, err := d.repo.StepRun().GetStepRunById(metadata.TenantId, payload.StepRunId)
+
+	if err != nil {
+		return fmt.Errorf("could not get step run: %w", err)
+	}
+
+	servertel.WithStepRunModel(span, stepRun)
+
+	err = w.StartStepRunTimeoutHook(ctx, metadata.TenantId, stepRun, payload.ActionId)
+
+	if err != nil {
+		return fmt.Errorf("could not send step run timeout hook to worker: %w", err)
+	}
+
+	return nil
+}
+
 func (d *DispatcherImpl) runUpdateHeartbeat(ctx context.Context) func() {
 	return func() {
 		d.l.Debug().Msgf("dispatcher: updating heartbeat")
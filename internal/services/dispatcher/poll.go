@@ -0,0 +1,288 @@
+package dispatcher
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
+)
+
+// pollWorkerTimeout is how long a worker which long-polls for its actions stays subscribed after its last poll or
+// heartbeat. Like a worker whose stream disconnects, a worker which doesn't poll within the timeout is marked
+// inactive, and its step runs are reassigned once its heartbeat is stale.
+const pollWorkerTimeout = 60 * time.Second
+
+// maxPendingPollActions is the number of actions which are buffered for a polling worker. Actions which are assigned
+// to a worker with a full buffer are not sent, so that they are retried by the message queue.
+const maxPendingPollActions = 100
+
+// pollStream buffers the actions which are assigned to a worker which long-polls for them, in place of the stream of
+// a worker which listens with gRPC.
+type pollStream struct {
+	actions chan *contracts.AssignedAction
+
+	// finished ends the session of the worker. It's buffered, as the session may have expired before the
+	// dispatcher drains its workers.
+	finished chan bool
+
+	mu sync.Mutex
+
+	// lastActiveAt is when the worker last polled or sent a heartbeat
+	lastActiveAt time.Time
+
+	// polls is the number of polls which are waiting for actions
+	polls int
+
+	closed bool
+}
+
+func newPollStream() *pollStream {
+	return &pollStream{
+		actions:      make(chan *contracts.AssignedAction, maxPendingPollActions),
+		finished:     make(chan bool, 1),
+		lastActiveAt: time.Now().UTC(),
+	}
+}
+
+func (p *pollStream) Send(action *contracts.AssignedAction) error {
+	select {
+	case p.actions <- action:
+		return nil
+	default:
+		return fmt.Errorf("worker has %d pending actions which it hasn't polled", maxPendingPollActions)
+	}
+}
+
+// touch marks the worker as active. It returns false if the stream was closed, in which case the worker needs a new
+// stream.
+func (p *pollStream) touch() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return false
+	}
+
+	p.lastActiveAt = time.Now().UTC()
+
+	return true
+}
+
+// poll waits up to wait for an action, and returns it together with up to maxActions-1 other actions which are
+// already pending.
+func (p *pollStream) poll(ctx context.Context, wait time.Duration, maxActions int) ([]*contracts.AssignedAction, bool) {
+	p.mu.Lock()
+
+	if p.closed {
+		p.mu.Unlock()
+		return nil, false
+	}
+
+	p.polls++
+	p.mu.Unlock()
+
+	defer func() {
+		p.mu.Lock()
+		p.polls--
+		p.lastActiveAt = time.Now().UTC()
+		p.mu.Unlock()
+	}()
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	actions := []*contracts.AssignedAction{}
+
+	select {
+	case action := <-p.actions:
+		actions = append(actions, action)
+	case <-timer.C:
+		return actions, true
+	case <-ctx.Done():
+		return actions, true
+	}
+
+	for len(actions) < maxActions {
+		select {
+		case action := <-p.actions:
+			actions = append(actions, action)
+		default:
+			return actions, true
+		}
+	}
+
+	return actions, true
+}
+
+// closeIfIdle closes the stream if the worker hasn't been active within the poll worker timeout, and returns whether
+// the stream is closed.
+func (p *pollStream) closeIfIdle(now time.Time) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.polls == 0 && p.lastActiveAt.Add(pollWorkerTimeout).Before(now) {
+		p.closed = true
+	}
+
+	return p.closed
+}
+
+func (p *pollStream) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.closed = true
+}
+
+// Poll returns the actions which are assigned to a worker which long-polls for its actions instead of listening with
+// a stream. It waits up to wait for an action, and returns at most maxActions actions. The first poll of a worker
+// subscribes it to the dispatcher, and resends the actions which were assigned to it but which it hasn't started.
+func (s *DispatcherImpl) Poll(ctx context.Context, workerId string, wait time.Duration, maxActions int) ([]*contracts.AssignedAction, error) {
+	tenant := ctx.Value("tenant").(*db.TenantModel)
+
+	for {
+		stream, err := s.getPollStream(ctx, tenant.ID, workerId)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if actions, ok := stream.poll(ctx, wait, maxActions); ok {
+			return actions, nil
+		}
+
+		// the session of the worker expired before the poll, so the worker is subscribed again
+		s.removePollStream(workerId, stream)
+	}
+}
+
+// Heartbeat keeps a worker which long-polls for its actions subscribed while it runs a step run, without waiting for
+// an action.
+func (s *DispatcherImpl) Heartbeat(ctx context.Context, workerId string) error {
+	tenant := ctx.Value("tenant").(*db.TenantModel)
+
+	for {
+		stream, err := s.getPollStream(ctx, tenant.ID, workerId)
+
+		if err != nil {
+			return err
+		}
+
+		if stream.touch() {
+			return nil
+		}
+
+		s.removePollStream(workerId, stream)
+	}
+}
+
+// getPollStream returns the stream of a polling worker, and subscribes the worker if it isn't subscribed.
+func (s *DispatcherImpl) getPollStream(ctx context.Context, tenantId, workerId string) (*pollStream, error) {
+	if w, ok := s.workers.Load(workerId); ok {
+		return toPollStream(w.(subscribedWorker), workerId)
+	}
+
+	worker, err := s.repo.Worker().GetWorkerById(workerId)
+
+	if err != nil || worker.TenantID != tenantId {
+		return nil, status.Errorf(codes.NotFound, "worker %s not found", workerId)
+	}
+
+	if dispatcherId, ok := worker.DispatcherID(); !ok || dispatcherId != s.dispatcherId {
+		_, err = s.repo.Worker().UpdateWorker(tenantId, workerId, &repository.UpdateWorkerOpts{
+			DispatcherId: &s.dispatcherId,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not update worker %s dispatcher: %w", workerId, err)
+		}
+	}
+
+	stream := newPollStream()
+
+	if w, loaded := s.workers.LoadOrStore(workerId, subscribedWorker{stream: stream, finished: stream.finished}); loaded {
+		return toPollStream(w.(subscribedWorker), workerId)
+	}
+
+	go s.runPollSession(tenantId, workerId, stream)
+
+	// actions which were buffered for the worker by its previous session or dispatcher are lost, so the actions
+	// which the worker hasn't started are resent
+	s.resendUnackedAssignments(ctx, tenantId, workerId)
+
+	return stream, nil
+}
+
+// removePollStream unsubscribes a polling worker if it's still subscribed with the given stream.
+func (s *DispatcherImpl) removePollStream(workerId string, stream *pollStream) {
+	s.workers.CompareAndDelete(workerId, subscribedWorker{stream: stream, finished: stream.finished})
+}
+
+func toPollStream(w subscribedWorker, workerId string) (*pollStream, error) {
+	stream, ok := w.stream.(*pollStream)
+
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "worker %s listens for actions with a stream", workerId)
+	}
+
+	return stream, nil
+}
+
+// runPollSession updates the heartbeat of a polling worker while it's active, and unsubscribes it once it stops
+// polling.
+func (s *DispatcherImpl) runPollSession(tenantId, workerId string, stream *pollStream) {
+	defer func() {
+		stream.close()
+		s.removePollStream(workerId, stream)
+
+		inactive := db.WorkerStatusInactive
+
+		_, err := s.repo.Worker().UpdateWorker(tenantId, workerId, &repository.UpdateWorkerOpts{
+			Status: &inactive,
+		})
+
+		if err != nil {
+			s.l.Error().Err(err).Msgf("could not update worker %s status to inactive", workerId)
+		}
+	}()
+
+	timer := time.NewTicker(time.Second)
+	defer timer.Stop()
+
+	var lastHeartbeat time.Time
+
+	for {
+		select {
+		case <-stream.finished:
+			s.l.Debug().Msgf("closing poll session for worker id: %s", workerId)
+			return
+		case <-timer.C:
+			now := time.Now().UTC()
+
+			if stream.closeIfIdle(now) {
+				s.l.Debug().Msgf("poll session of worker id %s has expired", workerId)
+				return
+			}
+
+			if lastHeartbeat.Add(5 * time.Second).Before(now) {
+				_, err := s.repo.Worker().UpdateWorker(tenantId, workerId, &repository.UpdateWorkerOpts{
+					LastHeartbeatAt: &now,
+				})
+
+				if err != nil {
+					s.l.Error().Err(err).Msgf("could not update worker %s heartbeat", workerId)
+					continue
+				}
+
+				lastHeartbeat = now
+			}
+		}
+	}
+}
@@ -2,18 +2,27 @@ package dispatcher
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"sync"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/steebchen/prisma-client-go/runtime/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/hatchet-dev/hatchet/internal/datautils"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/limits"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/protocol"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
 )
@@ -33,12 +42,21 @@ func (d *DispatcherImpl) GetWorker(workerId string) (*subscribedWorker, error) {
 	return &worker, nil
 }
 
+// actionStream sends assigned actions to a worker.
+type actionStream interface {
+	Send(*contracts.AssignedAction) error
+}
+
 type subscribedWorker struct {
-	// stream is the server side of the RPC stream
-	stream contracts.Dispatcher_ListenServer
+	// stream is the server side of the RPC stream, or the buffer of a worker which polls for its actions
+	stream actionStream
 
 	// finished is used to signal closure of a client subscribing goroutine
 	finished chan<- bool
+
+	// hydrationThreshold is the size above which step run inputs are left out of actions, or 0 if the worker
+	// doesn't fetch inputs
+	hydrationThreshold int
 }
 
 func (worker *subscribedWorker) StartStepRun(
@@ -59,7 +77,7 @@ func (worker *subscribedWorker) StartStepRun(
 
 	stepName, _ := stepRun.Step().ReadableID()
 
-	return worker.stream.Send(&contracts.AssignedAction{
+	action := &contracts.AssignedAction{
 		TenantId:      tenantId,
 		JobId:         stepRun.Step().JobID,
 		JobName:       stepRun.Step().Job().Name,
@@ -71,7 +89,71 @@ func (worker *subscribedWorker) StartStepRun(
 		ActionPayload: string(inputBytes),
 		StepName:      stepName,
 		WorkflowRunId: stepRun.JobRun().WorkflowRunID,
-	})
+	}
+
+	// large inputs are fetched by the worker when it starts the step run
+	if worker.hydrationThreshold > 0 && len(inputBytes) > worker.hydrationThreshold {
+		action.ActionPayload = ""
+		action.HydratePayload = true
+	}
+
+	// pass the resource hints of the step, so that workers can size the run
+	if cpu, ok := stepRun.Step().Cpu(); ok {
+		action.Cpu = cpu
+	}
+
+	if memoryMb, ok := stepRun.Step().MemoryMb(); ok {
+		action.MemoryMb = int32(memoryMb)
+	}
+
+	if gpu, ok := stepRun.Step().Gpu(); ok {
+		action.Gpu = int32(gpu)
+	}
+
+	return worker.stream.Send(action)
+}
+
+// StartStepRunTimeoutHook sends the on-timeout action of a step run which timed out to the worker, with the input
+// of the step run. The worker doesn't send events for the action, so the step run is not updated.
+func (worker *subscribedWorker) StartStepRunTimeoutHook(
+	ctx context.Context,
+	tenantId string,
+	stepRun *db.StepRunModel,
+	actionId string,
+) error {
+	ctx, span := telemetry.NewSpan(ctx, "start-step-run-timeout-hook")
+	defer span.End()
+
+	inputBytes := []byte{}
+
+	inputType, ok := stepRun.Input()
+
+	if ok {
+		inputBytes = []byte(inputType)
+	}
+
+	stepName, _ := stepRun.Step().ReadableID()
+
+	action := &contracts.AssignedAction{
+		TenantId:      tenantId,
+		JobId:         stepRun.Step().JobID,
+		JobName:       stepRun.Step().Job().Name,
+		JobRunId:      stepRun.JobRunID,
+		StepId:        stepRun.StepID,
+		StepRunId:     stepRun.ID,
+		ActionType:    contracts.ActionType_START_STEP_RUN_TIMEOUT_HOOK,
+		ActionId:      actionId,
+		ActionPayload: string(inputBytes),
+		StepName:      stepName,
+		WorkflowRunId: stepRun.JobRun().WorkflowRunID,
+	}
+
+	if worker.hydrationThreshold > 0 && len(inputBytes) > worker.hydrationThreshold {
+		action.ActionPayload = ""
+		action.HydratePayload = true
+	}
+
+	return worker.stream.Send(action)
 }
 
 func (worker *subscribedWorker) StartGroupKeyAction(
@@ -131,14 +213,21 @@ func (worker *subscribedWorker) StartGroupKeyAction(
 		return fmt.Errorf("could not get get group key run for workflow run %s", workflowRun.ID)
 	}
 
-	return worker.stream.Send(&contracts.AssignedAction{
+	action := &contracts.AssignedAction{
 		TenantId:         tenantId,
 		WorkflowRunId:    workflowRun.ID,
 		GetGroupKeyRunId: getGroupKeyRun.ID,
 		ActionType:       contracts.ActionType_START_GET_GROUP_KEY,
 		ActionId:         concurrencyFn.ActionID,
 		ActionPayload:    string(inputBytes),
-	})
+	}
+
+	// the payload of a get group key run is the workflow input, so the metadata is sent separately
+	if additionalMetadata, ok := workflowRun.AdditionalMetadata(); ok {
+		action.AdditionalMetadata = string(additionalMetadata)
+	}
+
+	return worker.stream.Send(action)
 }
 
 func (worker *subscribedWorker) CancelStepRun(
@@ -169,17 +258,34 @@ func (s *DispatcherImpl) Register(ctx context.Context, request *contracts.Worker
 
 	s.l.Debug().Msgf("Received register request from ID %s with actions %v", request.WorkerName, request.Actions)
 
+	// workers which don't speak a version which the engine speaks are refused before they are created, so that they
+	// fail on startup instead of on actions which they don't understand
+	protocolVersion, err := protocol.Negotiate(request.ProtocolVersion, request.MinProtocolVersion)
+
+	if err != nil {
+		s.l.Warn().Err(err).Msgf("refused worker %s of tenant %s", request.WorkerName, tenant.ID)
+		return nil, err
+	}
+
 	svcs := request.Services
 
 	if len(svcs) == 0 {
 		svcs = []string{"default"}
 	}
 
+	// workers which authenticate with a SPIFFE ID join the worker pool which the ID is mapped to
+	if service, ok := ctx.Value("service").(string); ok && service != "" {
+		svcs = []string{service}
+	}
+
+	version := int(protocolVersion)
+
 	opts := &repository.CreateWorkerOpts{
-		DispatcherId: s.dispatcherId,
-		Name:         request.WorkerName,
-		Actions:      request.Actions,
-		Services:     svcs,
+		DispatcherId:    s.dispatcherId,
+		Name:            request.WorkerName,
+		Actions:         request.Actions,
+		Services:        svcs,
+		ProtocolVersion: &version,
 	}
 
 	if request.MaxRuns != nil {
@@ -187,6 +293,33 @@ func (s *DispatcherImpl) Register(ctx context.Context, request *contracts.Worker
 		opts.MaxRuns = &mr
 	}
 
+	if request.Cpu != nil {
+		opts.Cpu = request.Cpu
+	}
+
+	if request.MemoryMb != nil {
+		memoryMb := int(*request.MemoryMb)
+		opts.MemoryMb = &memoryMb
+	}
+
+	if request.Gpu != nil {
+		gpu := int(*request.Gpu)
+		opts.Gpu = &gpu
+	}
+
+	if request.BuildId != nil {
+		opts.BuildId = request.BuildId
+	}
+
+	if len(request.Labels) > 0 {
+		opts.Labels = request.Labels
+	}
+
+	// the worker joins the environment of the token it registers with
+	if environment, ok := ctx.Value("environment").(string); ok && environment != "" {
+		opts.Environment = &environment
+	}
+
 	// create a worker in the database
 	worker, err := s.repo.Worker().CreateNewWorker(tenant.ID, opts)
 
@@ -197,11 +330,37 @@ func (s *DispatcherImpl) Register(ctx context.Context, request *contracts.Worker
 
 	s.l.Debug().Msgf("Registered worker with ID: %s", worker.ID)
 
+	// publishing the registration to event bus subscriptions is best-effort, so it does not block the registration
+	registeredData := map[string]interface{}{
+		"workerId":        worker.ID,
+		"workerName":      worker.Name,
+		"dispatcherId":    s.dispatcherId,
+		"actions":         request.Actions,
+		"services":        svcs,
+		"protocolVersion": protocolVersion,
+	}
+
+	if opts.Environment != nil {
+		registeredData["environment"] = *opts.Environment
+	}
+
+	if opts.BuildId != nil {
+		registeredData["buildId"] = *opts.BuildId
+	}
+
+	if err := s.repo.EventBus().CreateEventBusRecord(ctx, tenant.ID, repository.EventBusTopicWorkerRegistered, registeredData); err != nil {
+		s.l.Error().Err(err).Msgf("could not create event bus record for worker %s", worker.ID)
+	}
+
+	sessionToken, _ := worker.SessionToken()
+
 	// return the worker id to the worker
 	return &contracts.WorkerRegisterResponse{
-		TenantId:   worker.TenantID,
-		WorkerId:   worker.ID,
-		WorkerName: worker.Name,
+		TenantId:        worker.TenantID,
+		WorkerId:        worker.ID,
+		WorkerName:      worker.Name,
+		SessionToken:    sessionToken,
+		ProtocolVersion: protocolVersion,
 	}, nil
 }
 
@@ -218,6 +377,17 @@ func (s *DispatcherImpl) Listen(request *contracts.WorkerListenRequest, stream c
 		return err
 	}
 
+	// a worker which passes its session token resumes its session, and is resent the actions it hasn't started
+	resumed := false
+
+	if request.SessionToken != nil {
+		if sessionToken, ok := worker.SessionToken(); !ok || sessionToken != *request.SessionToken {
+			return status.Errorf(codes.PermissionDenied, "invalid session token for worker %s", request.WorkerId)
+		}
+
+		resumed = true
+	}
+
 	// check the worker's dispatcher against the current dispatcher. if they don't match, then update the worker
 	if dispatcherId, ok := worker.DispatcherID(); !ok || dispatcherId != s.dispatcherId {
 		_, err = s.repo.Worker().UpdateWorker(tenant.ID, request.WorkerId, &repository.UpdateWorkerOpts{
@@ -232,7 +402,13 @@ func (s *DispatcherImpl) Listen(request *contracts.WorkerListenRequest, stream c
 
 	fin := make(chan bool)
 
-	s.workers.Store(request.WorkerId, subscribedWorker{stream: stream, finished: fin})
+	subscribed := subscribedWorker{stream: stream, finished: fin}
+
+	if request.HydratePayloads {
+		subscribed.hydrationThreshold = s.payloadHydrationThreshold
+	}
+
+	s.workers.Store(request.WorkerId, subscribed)
 
 	defer func() {
 		// non-blocking send
@@ -291,6 +467,10 @@ func (s *DispatcherImpl) Listen(request *contracts.WorkerListenRequest, stream c
 		}
 	}()
 
+	if resumed {
+		s.resendUnackedAssignments(ctx, tenant.ID, request.WorkerId)
+	}
+
 	// Keep the connection alive for sending messages
 	for {
 		select {
@@ -304,6 +484,57 @@ func (s *DispatcherImpl) Listen(request *contracts.WorkerListenRequest, stream c
 	}
 }
 
+// resendUnackedAssignments resends the actions which were assigned to a worker but which the worker hasn't started,
+// as the assignments may have been sent to a stream which was closed before the worker received them, for example
+// because the previous dispatcher of the worker restarted. Workers ignore actions which they're already running.
+func (s *DispatcherImpl) resendUnackedAssignments(ctx context.Context, tenantId, workerId string) {
+	w, err := s.GetWorker(workerId)
+
+	if err != nil {
+		s.l.Error().Err(err).Msgf("could not get worker %s", workerId)
+		return
+	}
+
+	assignments, err := s.repo.Worker().ListUnackedAssignments(tenantId, workerId)
+
+	if err != nil {
+		s.l.Error().Err(err).Msgf("could not list unacked assignments for worker %s", workerId)
+		return
+	}
+
+	for _, stepRunId := range assignments.StepRunIds {
+		stepRun, err := s.repo.StepRun().GetStepRunById(tenantId, stepRunId)
+
+		if err != nil {
+			s.l.Error().Err(err).Msgf("could not get step run %s", stepRunId)
+			continue
+		}
+
+		if err := w.StartStepRun(ctx, tenantId, stepRun); err != nil {
+			s.l.Error().Err(err).Msgf("could not resend step run %s to worker %s", stepRunId, workerId)
+			return
+		}
+	}
+
+	for _, workflowRunId := range assignments.WorkflowRunIds {
+		workflowRun, err := s.repo.WorkflowRun().GetWorkflowRunById(tenantId, workflowRunId)
+
+		if err != nil {
+			s.l.Error().Err(err).Msgf("could not get workflow run %s", workflowRunId)
+			continue
+		}
+
+		if err := w.StartGroupKeyAction(ctx, tenantId, workflowRun); err != nil {
+			s.l.Error().Err(err).Msgf("could not resend get group key run of workflow run %s to worker %s", workflowRunId, workerId)
+			return
+		}
+	}
+
+	if count := len(assignments.StepRunIds) + len(assignments.WorkflowRunIds); count > 0 {
+		s.l.Debug().Msgf("resent %d unacked assignments to worker %s", count, workerId)
+	}
+}
+
 // SubscribeToWorkflowEvents registers workflow events with the dispatcher
 func (s *DispatcherImpl) SubscribeToWorkflowEvents(request *contracts.SubscribeToWorkflowEventsRequest, stream contracts.Dispatcher_SubscribeToWorkflowEventsServer) error {
 	tenant := stream.Context().Value("tenant").(*db.TenantModel)
@@ -324,25 +555,37 @@ func (s *DispatcherImpl) SubscribeToWorkflowEvents(request *contracts.SubscribeT
 	f := func(task *msgqueue.Message) error {
 		wg.Add(1)
 		defer wg.Done()
-		e, err := s.tenantTaskToWorkflowEvent(task, tenant.ID, request.WorkflowRunId)
+
+		// step run events are published in batches, which reach the tenant's queue as one message
+		tasks, err := msgqueue.UnbatchTenantMessage(task)
 
 		if err != nil {
-			s.l.Error().Err(err).Msgf("could not convert task to workflow event")
-			return nil
-		} else if e == nil {
+			s.l.Error().Err(err).Msgf("could not unbatch task %s", task.ID)
 			return nil
 		}
 
-		// send the task to the client
-		err = stream.Send(e)
+		for _, task := range tasks {
+			e, err := s.tenantTaskToWorkflowEvent(task, tenant.ID, request.WorkflowRunId)
 
-		if err != nil {
-			s.l.Error().Err(err).Msgf("could not send workflow event to client")
-			return nil
-		}
+			if err != nil {
+				s.l.Error().Err(err).Msgf("could not convert task to workflow event")
+				continue
+			} else if e == nil {
+				continue
+			}
+
+			// send the task to the client
+			err = stream.Send(e)
 
-		if e.Hangup {
-			cancel()
+			if err != nil {
+				s.l.Error().Err(err).Msgf("could not send workflow event to client")
+				return nil
+			}
+
+			if e.Hangup {
+				cancel()
+				return nil
+			}
 		}
 
 		return nil
@@ -436,7 +679,14 @@ func (s *DispatcherImpl) Unsubscribe(ctx context.Context, request *contracts.Wor
 	tenant := ctx.Value("tenant").(*db.TenantModel)
 
 	// no matter what, remove the worker from the connection pool
-	defer s.workers.Delete(request.WorkerId)
+	defer func() {
+		if w, ok := s.workers.LoadAndDelete(request.WorkerId); ok {
+			// the session of a polling worker ends with the worker
+			if stream, ok := w.(subscribedWorker).stream.(*pollStream); ok {
+				stream.close()
+			}
+		}
+	}()
 
 	err := s.repo.Worker().DeleteWorker(tenant.ID, request.WorkerId)
 
@@ -450,11 +700,386 @@ func (s *DispatcherImpl) Unsubscribe(ctx context.Context, request *contracts.Wor
 	}, nil
 }
 
+// RefreshTimeout extends the timeout of a running step run. The step run times out after the given duration from now,
+// but never later than the max timeout of the step after the step run started.
+func (s *DispatcherImpl) RefreshTimeout(ctx context.Context, request *contracts.RefreshTimeoutRequest) (*contracts.RefreshTimeoutResponse, error) {
+	tenant := ctx.Value("tenant").(*db.TenantModel)
+
+	if request.StepRunId == "" {
+		return nil, status.Error(codes.InvalidArgument, "step run id is required")
+	}
+
+	incrementTimeoutBy, err := time.ParseDuration(request.IncrementTimeoutBy)
+
+	if err != nil || incrementTimeoutBy <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid timeout increment %q", request.IncrementTimeoutBy)
+	}
+
+	stepRun, err := s.repo.StepRun().GetStepRunForEngine(tenant.ID, request.StepRunId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get step run: %w", err)
+	}
+
+	// step runs in their cancellation grace period are still running, but their timeout can't be extended
+	if stepRun.StepRun.Status != dbsqlc.StepRunStatusRUNNING || stepRun.StepRun.CancelledAt.Valid {
+		return nil, status.Error(codes.FailedPrecondition, "step run is not running")
+	}
+
+	if !stepRun.StepMaxTimeout.Valid {
+		return nil, status.Error(codes.FailedPrecondition, "the step does not have a max timeout, so its timeout can't be extended")
+	}
+
+	maxTimeout, err := time.ParseDuration(stepRun.StepMaxTimeout.String)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not parse step max timeout: %w", err)
+	}
+
+	now := time.Now().UTC()
+	startedAt := now
+
+	if stepRun.StepRun.StartedAt.Valid {
+		startedAt = stepRun.StepRun.StartedAt.Time
+	}
+
+	timeoutAt := now.Add(incrementTimeoutBy)
+
+	if maxTimeoutAt := startedAt.Add(maxTimeout); timeoutAt.After(maxTimeoutAt) {
+		timeoutAt = maxTimeoutAt
+	}
+
+	// refreshing never shortens the current timeout
+	if stepRun.StepRun.TimeoutAt.Valid && !timeoutAt.After(stepRun.StepRun.TimeoutAt.Time) {
+		return &contracts.RefreshTimeoutResponse{
+			TimeoutAt: timestamppb.New(stepRun.StepRun.TimeoutAt.Time),
+		}, nil
+	}
+
+	newTimeoutAt, err := s.repo.StepRun().UpdateStepRunTimeoutAt(tenant.ID, request.StepRunId, timeoutAt)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.FailedPrecondition, "step run is not running")
+		}
+
+		return nil, err
+	}
+
+	var tickerId string
+
+	if stepRun.StepRun.TickerId.Valid {
+		tickerId = sqlchelpers.UUIDToStr(stepRun.StepRun.TickerId)
+	} else {
+		tickerId, err = s.repo.StepRun().AssignStepRunToTicker(tenant.ID, request.StepRunId)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not assign step run to ticker: %w", err)
+		}
+	}
+
+	// the ticker replaces the timeout which it scheduled for the step run
+	err = s.mq.AddMessage(
+		ctx,
+		msgqueue.QueueTypeFromTickerID(tickerId),
+		tasktypes.ScheduleStepRunTimeoutToTask(tenant.ID, sqlchelpers.UUIDToStr(stepRun.StepRun.JobRunId), request.StepRunId, *newTimeoutAt),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not add schedule step run timeout task to task queue: %w", err)
+	}
+
+	return &contracts.RefreshTimeoutResponse{
+		TimeoutAt: timestamppb.New(*newTimeoutAt),
+	}, nil
+}
+
+// GetActionPayload returns the input of a step run whose input was left out of the action which was sent to
+// the worker.
+func (s *DispatcherImpl) GetActionPayload(ctx context.Context, request *contracts.GetActionPayloadRequest) (*contracts.GetActionPayloadResponse, error) {
+	tenant := ctx.Value("tenant").(*db.TenantModel)
+
+	stepRun, err := s.getWorkerStepRun(tenant.ID, request.WorkerId, request.StepRunId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &contracts.GetActionPayloadResponse{}
+
+	if input, ok := stepRun.Input(); ok {
+		resp.ActionPayload = string(input)
+	}
+
+	return resp, nil
+}
+
+// ListStepRunResults returns the results of the step runs in the workflow run of a step run which the worker is
+// running, so that steps can fetch the outputs of other steps instead of receiving them with their input.
+func (s *DispatcherImpl) ListStepRunResults(ctx context.Context, request *contracts.ListStepRunResultsRequest) (*contracts.ListStepRunResultsResponse, error) {
+	tenant := ctx.Value("tenant").(*db.TenantModel)
+
+	stepRun, err := s.getWorkerStepRun(tenant.ID, request.WorkerId, request.StepRunId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	workflowRunId := stepRun.JobRun().WorkflowRunID
+
+	stepRuns, err := s.repo.StepRun().ListStepRuns(tenant.ID, &repository.ListStepRunsOpts{
+		WorkflowRunId: &workflowRunId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list step runs: %w", err)
+	}
+
+	readableIds := make(map[string]bool, len(request.StepReadableIds))
+
+	for _, readableId := range request.StepReadableIds {
+		readableIds[readableId] = true
+	}
+
+	resp := &contracts.ListStepRunResultsResponse{}
+
+	for i := range stepRuns {
+		sr := &stepRuns[i]
+		readableId, _ := sr.Step().ReadableID()
+
+		if len(readableIds) > 0 && !readableIds[readableId] {
+			continue
+		}
+
+		result := &contracts.StepRunResult{
+			StepRunId:      sr.ID,
+			StepReadableId: readableId,
+			JobRunId:       sr.JobRunID,
+			Status:         string(sr.Status),
+		}
+
+		if output, ok := sr.Output(); ok {
+			result.Output = string(output)
+		}
+
+		if runErr, ok := sr.Error(); ok {
+			result.Error = runErr
+		}
+
+		resp.Results = append(resp.Results, result)
+	}
+
+	return resp, nil
+}
+
+// maxReleaseRetryAfter is the longest a worker can ask for a released step run to wait before it's requeued.
+const maxReleaseRetryAfter = 24 * time.Hour
+
+// ReleaseStepRun releases a step run which the worker is running back to the queue, for example because an upstream
+// API is rate limited. The step run is requeued after the retry after duration, and the release doesn't count as a
+// failure or a retry.
+func (s *DispatcherImpl) ReleaseStepRun(ctx context.Context, request *contracts.ReleaseStepRunRequest) (*contracts.ReleaseStepRunResponse, error) {
+	tenant := ctx.Value("tenant").(*db.TenantModel)
+
+	if request.WorkerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "worker id is required")
+	}
+
+	var retryAfter time.Duration
+
+	if request.RetryAfter != "" {
+		var err error
+
+		retryAfter, err = time.ParseDuration(request.RetryAfter)
+
+		if err != nil || retryAfter < 0 || retryAfter > maxReleaseRetryAfter {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid retry after %q, must be a duration between 0s and %s", request.RetryAfter, maxReleaseRetryAfter)
+		}
+	}
+
+	stepRun, err := s.getWorkerStepRun(tenant.ID, request.WorkerId, request.StepRunId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// step runs in their cancellation grace period can't be released, as they shouldn't run again
+	if _, cancelled := stepRun.CancelledAt(); stepRun.Status != db.StepRunStatusRunning || cancelled {
+		return nil, status.Errorf(codes.FailedPrecondition, "step run %s is not running", request.StepRunId)
+	}
+
+	requeueAt := time.Now().UTC().Add(retryAfter)
+
+	err = s.mq.AddMessage(
+		ctx,
+		msgqueue.JOB_PROCESSING_QUEUE,
+		tasktypes.StepRunReleasedToTask(tenant.ID, request.StepRunId, request.WorkerId, requeueAt, request.Reason),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not add step run released task to task queue: %w", err)
+	}
+
+	return &contracts.ReleaseStepRunResponse{
+		RequeueAt: timestamppb.New(requeueAt),
+	}, nil
+}
+
+const (
+	// defaultLockTimeout is how long a named lock is held if the worker doesn't set a timeout.
+	defaultLockTimeout = 5 * time.Minute
+
+	// maxLockTimeout is the longest a named lock can be held without being acquired again.
+	maxLockTimeout = 24 * time.Hour
+
+	// maxLockNameLength is the maximum length of the name of a named lock.
+	maxLockNameLength = 255
+)
+
+// AcquireLock acquires a named lock of the tenant for a step run which the worker is running, or for the workflow
+// run of the step run. The lock is released when it's released by the worker, its holder finishes or the timeout
+// passes. Acquiring a lock which the step run or its workflow run already holds renews it.
+func (s *DispatcherImpl) AcquireLock(ctx context.Context, request *contracts.AcquireLockRequest) (*contracts.AcquireLockResponse, error) {
+	tenant := ctx.Value("tenant").(*db.TenantModel)
+
+	if request.WorkerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "worker id is required")
+	}
+
+	if request.Name == "" || len(request.Name) > maxLockNameLength {
+		return nil, status.Errorf(codes.InvalidArgument, "lock name is required and can be at most %d characters", maxLockNameLength)
+	}
+
+	timeout := defaultLockTimeout
+
+	if request.Timeout != "" {
+		var err error
+
+		timeout, err = time.ParseDuration(request.Timeout)
+
+		if err != nil || timeout <= 0 || timeout > maxLockTimeout {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid timeout %q, must be a positive duration of at most %s", request.Timeout, maxLockTimeout)
+		}
+	}
+
+	stepRun, err := s.getWorkerStepRun(tenant.ID, request.WorkerId, request.StepRunId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// step runs in their cancellation grace period can't acquire locks, as they should only clean up
+	if _, cancelled := stepRun.CancelledAt(); stepRun.Status != db.StepRunStatusRunning || cancelled {
+		return nil, status.Errorf(codes.FailedPrecondition, "step run %s is not running", request.StepRunId)
+	}
+
+	lock, err := s.repo.NamedLock().AcquireNamedLock(ctx, tenant.ID, request.Name, request.StepRunId, request.HoldForWorkflowRun, timeout)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not acquire lock: %w", err)
+	}
+
+	if lock == nil {
+		return &contracts.AcquireLockResponse{
+			Acquired: false,
+		}, nil
+	}
+
+	return &contracts.AcquireLockResponse{
+		Acquired:  true,
+		ExpiresAt: timestamppb.New(lock.ExpiresAt.Time),
+	}, nil
+}
+
+// ReleaseLock releases a named lock which is held by a step run which the worker is running, or by the workflow run
+// of the step run.
+func (s *DispatcherImpl) ReleaseLock(ctx context.Context, request *contracts.ReleaseLockRequest) (*contracts.ReleaseLockResponse, error) {
+	tenant := ctx.Value("tenant").(*db.TenantModel)
+
+	if request.WorkerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "worker id is required")
+	}
+
+	if request.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "lock name is required")
+	}
+
+	// step runs in their cancellation grace period can release their locks
+	if _, err := s.getWorkerStepRun(tenant.ID, request.WorkerId, request.StepRunId); err != nil {
+		return nil, err
+	}
+
+	released, err := s.repo.NamedLock().ReleaseNamedLock(ctx, tenant.ID, request.Name, request.StepRunId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not release lock: %w", err)
+	}
+
+	return &contracts.ReleaseLockResponse{
+		Released: released,
+	}, nil
+}
+
+// getWorkerStepRun returns a step run which is assigned to the worker and which hasn't finished, so that workers
+// can only read the data of the step runs which they run.
+func (s *DispatcherImpl) getWorkerStepRun(tenantId, workerId, stepRunId string) (*db.StepRunModel, error) {
+	if stepRunId == "" {
+		return nil, status.Error(codes.InvalidArgument, "step run id is required")
+	}
+
+	stepRun, err := s.repo.StepRun().GetStepRunById(tenantId, stepRunId)
+
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "step run %s not found", stepRunId)
+		}
+
+		return nil, fmt.Errorf("could not get step run: %w", err)
+	}
+
+	if stepRun.TenantID != tenantId {
+		return nil, status.Errorf(codes.NotFound, "step run %s not found", stepRunId)
+	}
+
+	if assignedWorkerId, ok := stepRun.WorkerID(); !ok || assignedWorkerId != workerId {
+		return nil, status.Errorf(codes.PermissionDenied, "step run %s is not assigned to worker %s", stepRunId, workerId)
+	}
+
+	if stepRun.Status != db.StepRunStatusAssigned && stepRun.Status != db.StepRunStatusRunning {
+		return nil, status.Errorf(codes.FailedPrecondition, "step run %s is not assigned or running", stepRunId)
+	}
+
+	return stepRun, nil
+}
+
+// stepRunContext returns a context which carries the correlation id of the workflow run of the step run, so that
+// the events which the worker sends for the step run are traced back to the request which triggered the run.
+func (s *DispatcherImpl) stepRunContext(ctx context.Context, tenantId, stepRunId string) context.Context {
+	if msgqueue.CorrelationIDFromContext(ctx) != "" {
+		return ctx
+	}
+
+	correlationId, err := s.repo.StepRun().GetStepRunCorrelationId(ctx, tenantId, stepRunId)
+
+	// the event is sent without a correlation id, and the jobs controller handles a step run which doesn't exist
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			s.l.Warn().Err(err).Msgf("could not get correlation id of step run %s", stepRunId)
+		}
+
+		return ctx
+	}
+
+	return msgqueue.WithCorrelationID(ctx, correlationId)
+}
+
 func (s *DispatcherImpl) handleStepRunStarted(ctx context.Context, request *contracts.StepActionEvent) (*contracts.ActionEventResponse, error) {
 	tenant := ctx.Value("tenant").(*db.TenantModel)
 
 	s.l.Debug().Msgf("Received step started event for step run %s", request.StepRunId)
 
+	ctx = s.stepRunContext(ctx, tenant.ID, request.StepRunId)
+
 	startedAt := request.EventTimestamp.AsTime()
 
 	payload, _ := datautils.ToJSONMap(tasktypes.StepRunStartedTaskPayload{
@@ -467,7 +1092,7 @@ func (s *DispatcherImpl) handleStepRunStarted(ctx context.Context, request *cont
 	})
 
 	// send the event to the jobs queue
-	err := s.mq.AddMessage(ctx, msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{
+	err := s.stepEvents.AddMessage(ctx, msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{
 		ID:       "step-run-started",
 		Payload:  payload,
 		Metadata: metadata,
@@ -489,6 +1114,25 @@ func (s *DispatcherImpl) handleStepRunCompleted(ctx context.Context, request *co
 
 	s.l.Debug().Msgf("Received step completed event for step run %s", request.StepRunId)
 
+	ctx = s.stepRunContext(ctx, tenant.ID, request.StepRunId)
+
+	if err := s.pl.Check(limits.PayloadKindStepOutput, len(request.EventPayload)); err != nil {
+		// the step run fails with the reason, instead of running until it times out
+		_, failErr := s.handleStepRunFailed(ctx, &contracts.StepActionEvent{
+			WorkerId:       request.WorkerId,
+			StepRunId:      request.StepRunId,
+			EventTimestamp: request.EventTimestamp,
+			EventType:      contracts.StepActionEventType_STEP_EVENT_TYPE_FAILED,
+			EventPayload:   err.Error(),
+		})
+
+		if failErr != nil {
+			return nil, failErr
+		}
+
+		return nil, err
+	}
+
 	finishedAt := request.EventTimestamp.AsTime()
 
 	payload, _ := datautils.ToJSONMap(tasktypes.StepRunFinishedTaskPayload{
@@ -502,7 +1146,7 @@ func (s *DispatcherImpl) handleStepRunCompleted(ctx context.Context, request *co
 	})
 
 	// send the event to the jobs queue
-	err := s.mq.AddMessage(ctx, msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{
+	err := s.stepEvents.AddMessage(ctx, msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{
 		ID:       "step-run-finished",
 		Payload:  payload,
 		Metadata: metadata,
@@ -524,6 +1168,8 @@ func (s *DispatcherImpl) handleStepRunFailed(ctx context.Context, request *contr
 
 	s.l.Debug().Msgf("Received step failed event for step run %s", request.StepRunId)
 
+	ctx = s.stepRunContext(ctx, tenant.ID, request.StepRunId)
+
 	failedAt := request.EventTimestamp.AsTime()
 
 	payload, _ := datautils.ToJSONMap(tasktypes.StepRunFailedTaskPayload{
@@ -537,7 +1183,7 @@ func (s *DispatcherImpl) handleStepRunFailed(ctx context.Context, request *contr
 	})
 
 	// send the event to the jobs queue
-	err := s.mq.AddMessage(ctx, msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{
+	err := s.stepEvents.AddMessage(ctx, msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{
 		ID:       "step-run-failed",
 		Payload:  payload,
 		Metadata: metadata,
@@ -691,6 +1337,23 @@ func (s *DispatcherImpl) tenantTaskToWorkflowEvent(task *msgqueue.Message, tenan
 		workflowEvent.ResourceType = contracts.ResourceType_RESOURCE_TYPE_STEP_RUN
 		workflowEvent.ResourceId = stepRunId
 		workflowEvent.EventType = contracts.ResourceEventType_RESOURCE_EVENT_TYPE_TIMED_OUT
+	case "step-run-stream-event":
+		// stream events carry their workflow run id, so that every message of a stream doesn't look up its step run
+		if task.Payload["workflow_run_id"].(string) != workflowRunId {
+			return nil, nil
+		}
+
+		workflowEvent.ResourceType = contracts.ResourceType_RESOURCE_TYPE_STEP_RUN
+		workflowEvent.ResourceId = task.Payload["step_run_id"].(string)
+		workflowEvent.EventType = contracts.ResourceEventType_RESOURCE_EVENT_TYPE_STREAM
+		workflowEvent.EventPayload = task.Payload["message"].(string)
+		workflowEvent.StreamIndex = int32(task.Payload["index"].(float64))
+
+		if createdAt, err := time.Parse(time.RFC3339Nano, task.Payload["created_at"].(string)); err == nil {
+			workflowEvent.EventTimestamp = timestamppb.New(createdAt)
+		}
+
+		return workflowEvent, nil
 	case "workflow-run-finished":
 		workflowRunId := task.Payload["workflow_run_id"].(string)
 		workflowEvent.ResourceType = contracts.ResourceType_RESOURCE_TYPE_WORKFLOW_RUN
@@ -0,0 +1,149 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-co-op/gocron/v2"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/logger"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/leader"
+)
+
+const (
+	// records are deleted after the retention period, whether or not they were acknowledged
+	recordRetention = 72 * time.Hour
+
+	deleteBatchSize = 10000
+)
+
+type EventBus interface {
+	Start() (func() error, error)
+}
+
+// EventBusImpl deletes the event bus records which are past their retention period. Records are created by the
+// engine and the dispatcher, and are delivered by the API.
+type EventBusImpl struct {
+	l    *zerolog.Logger
+	repo repository.Repository
+	s    gocron.Scheduler
+
+	// elector makes sure that expired records are only deleted by one replica
+	elector *leader.Elector
+}
+
+type EventBusOpt func(*EventBusOpts)
+
+type EventBusOpts struct {
+	l    *zerolog.Logger
+	repo repository.Repository
+}
+
+func defaultEventBusOpts() *EventBusOpts {
+	logger := logger.NewDefaultLogger("event-bus")
+	return &EventBusOpts{
+		l: &logger,
+	}
+}
+
+func WithRepository(r repository.Repository) EventBusOpt {
+	return func(opts *EventBusOpts) {
+		opts.repo = r
+	}
+}
+
+func WithLogger(l *zerolog.Logger) EventBusOpt {
+	return func(opts *EventBusOpts) {
+		opts.l = l
+	}
+}
+
+func New(fs ...EventBusOpt) (*EventBusImpl, error) {
+	opts := defaultEventBusOpts()
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	if opts.repo == nil {
+		return nil, fmt.Errorf("repository is required. use WithRepository")
+	}
+
+	newLogger := opts.l.With().Str("service", "event-bus").Logger()
+	opts.l = &newLogger
+
+	elector := leader.NewElector(opts.repo.LeaderLease(), opts.l, "event-bus")
+
+	s, err := gocron.NewScheduler(gocron.WithLocation(time.UTC), gocron.WithDistributedElector(elector))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create scheduler: %w", err)
+	}
+
+	return &EventBusImpl{
+		l:    opts.l,
+		repo: opts.repo,
+		s:    s,
+
+		elector: elector,
+	}, nil
+}
+
+func (e *EventBusImpl) Start() (func() error, error) {
+	e.l.Debug().Msg("starting event bus")
+
+	_, err := e.s.NewJob(
+		gocron.DurationJob(time.Minute*5),
+		gocron.NewTask(
+			e.deleteExpiredRecords(),
+		),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not schedule expired record deletion: %w", err)
+	}
+
+	e.s.Start()
+
+	cleanup := func() error {
+		e.l.Debug().Msg("stopping event bus")
+		if err := e.s.Shutdown(); err != nil {
+			return fmt.Errorf("could not shutdown scheduler: %w", err)
+		}
+		if err := e.elector.Release(context.Background()); err != nil {
+			return fmt.Errorf("could not release leader lease: %w", err)
+		}
+		e.l.Debug().Msg("event bus has shutdown")
+		return nil
+	}
+
+	return cleanup, nil
+}
+
+func (e *EventBusImpl) deleteExpiredRecords() func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		e.l.Debug().Msg("deleting expired event bus records")
+
+		before := time.Now().Add(-recordRetention)
+
+		for {
+			deleted, err := e.repo.EventBus().DeleteExpiredEventBusRecords(ctx, before, deleteBatchSize)
+
+			if err != nil {
+				e.l.Err(err).Msg("could not delete expired event bus records")
+				return
+			}
+
+			if deleted < deleteBatchSize {
+				return
+			}
+		}
+	}
+}
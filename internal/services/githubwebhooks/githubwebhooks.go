@@ -0,0 +1,213 @@
+package githubwebhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-co-op/gocron/v2"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/vcs/github"
+	"github.com/hatchet-dev/hatchet/internal/logger"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/leader"
+)
+
+type GithubWebhooks interface {
+	Start() (func() error, error)
+}
+
+// GithubWebhooksImpl reconciles the repository webhooks of the Github repositories which are linked to workflows.
+// Missing webhooks are created and misconfigured webhooks are repaired, and the recent failed deliveries of each
+// webhook are recorded.
+type GithubWebhooksImpl struct {
+	l        *zerolog.Logger
+	repo     repository.Repository
+	provider github.GithubVCSProvider
+	s        gocron.Scheduler
+
+	// elector makes sure that webhooks are only reconciled by one replica
+	elector *leader.Elector
+}
+
+type GithubWebhooksOpt func(*GithubWebhooksOpts)
+
+type GithubWebhooksOpts struct {
+	l        *zerolog.Logger
+	repo     repository.Repository
+	provider *github.GithubVCSProvider
+}
+
+func defaultGithubWebhooksOpts() *GithubWebhooksOpts {
+	logger := logger.NewDefaultLogger("github-webhooks")
+	return &GithubWebhooksOpts{
+		l: &logger,
+	}
+}
+
+func WithRepository(r repository.Repository) GithubWebhooksOpt {
+	return func(opts *GithubWebhooksOpts) {
+		opts.repo = r
+	}
+}
+
+func WithLogger(l *zerolog.Logger) GithubWebhooksOpt {
+	return func(opts *GithubWebhooksOpts) {
+		opts.l = l
+	}
+}
+
+func WithGithubProvider(p github.GithubVCSProvider) GithubWebhooksOpt {
+	return func(opts *GithubWebhooksOpts) {
+		opts.provider = &p
+	}
+}
+
+func New(fs ...GithubWebhooksOpt) (*GithubWebhooksImpl, error) {
+	opts := defaultGithubWebhooksOpts()
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	if opts.repo == nil {
+		return nil, fmt.Errorf("repository is required. use WithRepository")
+	}
+
+	if opts.provider == nil {
+		return nil, fmt.Errorf("github provider is required. use WithGithubProvider")
+	}
+
+	newLogger := opts.l.With().Str("service", "github-webhooks").Logger()
+	opts.l = &newLogger
+
+	elector := leader.NewElector(opts.repo.LeaderLease(), opts.l, "github-webhooks")
+
+	s, err := gocron.NewScheduler(gocron.WithLocation(time.UTC), gocron.WithDistributedElector(elector))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create scheduler: %w", err)
+	}
+
+	return &GithubWebhooksImpl{
+		l:        opts.l,
+		repo:     opts.repo,
+		provider: *opts.provider,
+		s:        s,
+
+		elector: elector,
+	}, nil
+}
+
+func (g *GithubWebhooksImpl) Start() (func() error, error) {
+	g.l.Debug().Msg("starting github webhooks")
+
+	_, err := g.s.NewJob(
+		gocron.DurationJob(time.Minute*15),
+		gocron.NewTask(
+			g.reconcileWebhooks(),
+		),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not schedule webhook reconciliation: %w", err)
+	}
+
+	g.s.Start()
+
+	cleanup := func() error {
+		g.l.Debug().Msg("stopping github webhooks")
+		if err := g.s.Shutdown(); err != nil {
+			return fmt.Errorf("could not shutdown scheduler: %w", err)
+		}
+		if err := g.elector.Release(context.Background()); err != nil {
+			return fmt.Errorf("could not release leader lease: %w", err)
+		}
+		g.l.Debug().Msg("github webhooks has shutdown")
+		return nil
+	}
+
+	return cleanup, nil
+}
+
+func (g *GithubWebhooksImpl) reconcileWebhooks() func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+		defer cancel()
+
+		g.l.Debug().Msg("reconciling github webhooks")
+
+		linkedRepos, err := g.repo.Github().ListLinkedGithubRepositories()
+
+		if err != nil {
+			g.l.Err(err).Msg("could not list linked github repositories")
+			return
+		}
+
+		for _, linked := range linkedRepos {
+			if err := g.reconcileWebhook(ctx, linked); err != nil {
+				g.l.Err(err).Msgf("could not reconcile webhook of %s/%s in tenant %s", linked.RepoOwner, linked.RepoName, linked.TenantId)
+			}
+		}
+	}
+}
+
+func (g *GithubWebhooksImpl) reconcileWebhook(ctx context.Context, linked *repository.LinkedGithubRepository) error {
+	webhook, err := g.repo.Github().ReadGithubWebhook(linked.TenantId, linked.RepoOwner, linked.RepoName)
+
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		return fmt.Errorf("could not read webhook: %w", err)
+	} else if err != nil {
+		// the repository was linked, but its webhook was never stored
+		opts, _, err := repository.NewGithubWebhookCreateOpts(g.provider.GetEncryption(), linked.RepoOwner, linked.RepoName)
+
+		if err != nil {
+			return err
+		}
+
+		webhook, err = g.repo.Github().CreateGithubWebhook(linked.TenantId, opts)
+
+		if err != nil {
+			return fmt.Errorf("could not create webhook: %w", err)
+		}
+	}
+
+	opts := &repository.UpdateGithubWebhookStatusOpts{
+		ReconciledAt: time.Now().UTC(),
+	}
+
+	status, reconcileErr := g.provider.ReconcileWebhook(ctx, int64(linked.InstallationID), webhook)
+
+	if reconcileErr != nil {
+		errStr := reconcileErr.Error()
+		opts.ReconcileError = &errStr
+	} else {
+		if status.Repaired {
+			g.l.Info().Msgf("repaired webhook of %s/%s in tenant %s", linked.RepoOwner, linked.RepoName, linked.TenantId)
+		}
+
+		failedDeliveries, err := json.Marshal(status.FailedDeliveries)
+
+		if err != nil {
+			return fmt.Errorf("could not marshal failed deliveries: %w", err)
+		}
+
+		failedDeliveriesJSON := db.JSON(failedDeliveries)
+
+		opts.HookID = &status.HookID
+		opts.FailedDeliveries = &failedDeliveriesJSON
+	}
+
+	_, err = g.repo.Github().UpdateGithubWebhookStatus(webhook.ID, opts)
+
+	if err != nil {
+		return fmt.Errorf("could not update webhook status: %w", err)
+	}
+
+	return reconcileErr
+}
@@ -0,0 +1,315 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hatchet-dev/hatchet/internal/services/grpc/middleware"
+)
+
+const (
+	// defaultPollWait is how long a poll waits for an action if the worker doesn't set a wait
+	defaultPollWait = 30 * time.Second
+
+	// maxPollWait is the longest wait of a poll, which is shorter than the write timeout of the server
+	maxPollWait = 60 * time.Second
+
+	maxPollActions = 100
+
+	// maxHTTPRequestBytes limits the size of request bodies, which is the max message size of the gRPC server
+	maxHTTPRequestBytes = 4 * 1024 * 1024
+)
+
+// marshalOpts writes the fields of responses which have their default values, so that workers can tell the
+// actions apart without knowing the defaults of the protobuf enums.
+var marshalOpts = protojson.MarshalOptions{
+	EmitUnpopulated: true,
+}
+
+type pollRequest struct {
+	WorkerId string `json:"workerId"`
+
+	// Wait is a duration like 30s
+	Wait string `json:"wait"`
+
+	MaxActions int `json:"maxActions"`
+}
+
+type pollResponse struct {
+	Actions []json.RawMessage `json:"actions"`
+}
+
+type heartbeatRequest struct {
+	WorkerId string `json:"workerId"`
+}
+
+// startHTTP starts the HTTP protocol of the dispatcher, for workers which can't hold a gRPC stream open. Workers
+// long-poll /Dispatcher/Poll for their actions, and call the unary methods of the dispatcher by posting their
+// requests as JSON to /Dispatcher/<method>.
+func (s *Server) startHTTP() (func() error, error) {
+	s.l.Debug().Msgf("starting worker http server on %s:%d", s.bindAddress, s.httpPort)
+
+	authMiddleware := middleware.NewAuthN(s.config)
+
+	mux := http.NewServeMux()
+
+	handle := func(method string, h func(ctx context.Context, body []byte) (any, error)) {
+		mux.HandleFunc("/Dispatcher/"+method, s.httpHandler(authMiddleware, h))
+	}
+
+	d := s.dispatcher
+
+	handle("Register", unary(d.Register))
+	handle("SendStepActionEvent", unary(d.SendStepActionEvent))
+	handle("SendGroupKeyActionEvent", unary(d.SendGroupKeyActionEvent))
+	handle("PutOverridesData", unary(d.PutOverridesData))
+	handle("Unsubscribe", unary(d.Unsubscribe))
+	handle("RefreshTimeout", unary(d.RefreshTimeout))
+	handle("GetActionPayload", unary(d.GetActionPayload))
+	handle("ListStepRunResults", unary(d.ListStepRunResults))
+	handle("ReleaseStepRun", unary(d.ReleaseStepRun))
+	handle("AcquireLock", unary(d.AcquireLock))
+	handle("ReleaseLock", unary(d.ReleaseLock))
+	handle("Poll", s.handlePoll)
+	handle("Heartbeat", s.handleHeartbeat)
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf("%s:%d", s.bindAddress, s.httpPort),
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      maxPollWait + 30*time.Second,
+	}
+
+	if !s.insecure {
+		server.TLSConfig = s.tls
+	}
+
+	lis, err := net.Listen("tcp", server.Addr)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen: %w", err)
+	}
+
+	go func() {
+		var err error
+
+		if s.insecure {
+			err = server.Serve(lis)
+		} else {
+			err = server.ServeTLS(lis, "", "")
+		}
+
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			panic(fmt.Errorf("failed to serve: %w", err))
+		}
+	}()
+
+	cleanup := func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), maxPollWait)
+		defer cancel()
+
+		if err := server.Shutdown(ctx); err != nil {
+			return fmt.Errorf("could not shutdown worker http server: %w", err)
+		}
+
+		return nil
+	}
+
+	return cleanup, nil
+}
+
+// httpHandler authenticates a request like the gRPC server, and writes the response or error of the method as JSON.
+func (s *Server) httpHandler(authMiddleware *middleware.GRPCAuthN, h func(ctx context.Context, body []byte) (any, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if p := recover(); p != nil {
+				s.l.Error().Msgf("recovered from panic: %v. Stack: %s", p, string(debug.Stack()))
+				writeHTTPError(w, status.Errorf(codes.Internal, "An internal error occurred"))
+			}
+		}()
+
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		ctx, err := authMiddleware.AuthenticateHTTP(r)
+
+		if err != nil {
+			writeHTTPError(w, err)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxHTTPRequestBytes))
+
+		if err != nil {
+			writeHTTPError(w, status.Errorf(codes.InvalidArgument, "could not read request body: %s", err))
+			return
+		}
+
+		res, err := h(ctx, body)
+
+		if err != nil {
+			writeHTTPError(w, err)
+			return
+		}
+
+		var resBytes []byte
+
+		if msg, ok := res.(proto.Message); ok {
+			resBytes, err = marshalOpts.Marshal(msg)
+		} else {
+			resBytes, err = json.Marshal(res)
+		}
+
+		if err != nil {
+			writeHTTPError(w, status.Errorf(codes.Internal, "could not marshal response: %s", err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(resBytes) // nolint: errcheck
+	}
+}
+
+// unary adapts a unary method of the dispatcher to a handler which decodes its request from protobuf JSON.
+func unary[T any, Req interface {
+	*T
+	proto.Message
+}, Res proto.Message](f func(context.Context, Req) (Res, error)) func(ctx context.Context, body []byte) (any, error) {
+	return func(ctx context.Context, body []byte) (any, error) {
+		req := Req(new(T))
+
+		if err := protojson.Unmarshal(body, req); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request body: %s", err)
+		}
+
+		return f(ctx, req)
+	}
+}
+
+func (s *Server) handlePoll(ctx context.Context, body []byte) (any, error) {
+	req := &pollRequest{}
+
+	if err := json.Unmarshal(body, req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request body: %s", err)
+	}
+
+	if req.WorkerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "workerId is required")
+	}
+
+	wait := defaultPollWait
+
+	if req.Wait != "" {
+		var err error
+
+		wait, err = time.ParseDuration(req.Wait)
+
+		if err != nil || wait < 0 || wait > maxPollWait {
+			return nil, status.Errorf(codes.InvalidArgument, "wait must be a duration of at most %s", maxPollWait)
+		}
+	}
+
+	maxActions := req.MaxActions
+
+	if maxActions == 0 {
+		maxActions = 1
+	}
+
+	if maxActions < 0 || maxActions > maxPollActions {
+		return nil, status.Errorf(codes.InvalidArgument, "maxActions must be between 1 and %d", maxPollActions)
+	}
+
+	actions, err := s.dispatcher.Poll(ctx, req.WorkerId, wait, maxActions)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pollResponse{
+		Actions: make([]json.RawMessage, 0, len(actions)),
+	}
+
+	for _, action := range actions {
+		actionBytes, err := marshalOpts.Marshal(action)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal action: %w", err)
+		}
+
+		res.Actions = append(res.Actions, actionBytes)
+	}
+
+	return res, nil
+}
+
+func (s *Server) handleHeartbeat(ctx context.Context, body []byte) (any, error) {
+	req := &heartbeatRequest{}
+
+	if err := json.Unmarshal(body, req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request body: %s", err)
+	}
+
+	if req.WorkerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "workerId is required")
+	}
+
+	if err := s.dispatcher.Heartbeat(ctx, req.WorkerId); err != nil {
+		return nil, err
+	}
+
+	return struct{}{}, nil
+}
+
+// writeHTTPError writes the status of an error with the HTTP status code which matches its gRPC code.
+func writeHTTPError(w http.ResponseWriter, err error) {
+	st, _ := status.FromError(err)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatusFromCode(st.Code()))
+
+	_ = json.NewEncoder(w).Encode(map[string]string{ // nolint: errcheck
+		"code":  st.Code().String(),
+		"error": st.Message(),
+	})
+}
+
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted, codes.FailedPrecondition:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
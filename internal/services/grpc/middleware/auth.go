@@ -2,38 +2,86 @@ package middleware
 
 import (
 	"context"
+	"crypto/x509"
+	"net/http"
+	"strings"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
 	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
+	"github.com/hatchet-dev/hatchet/internal/auth/clientcert"
+	"github.com/hatchet-dev/hatchet/internal/auth/token"
 	"github.com/hatchet-dev/hatchet/internal/config/server"
 )
 
 type GRPCAuthN struct {
 	config *server.ServerConfig
 
+	pins *clientcert.Verifier
+
 	l *zerolog.Logger
 }
 
 func NewAuthN(config *server.ServerConfig) *GRPCAuthN {
 	return &GRPCAuthN{
 		config: config,
+		pins:   clientcert.NewVerifier(config.Repository.ClientCertificate().ListClientCertificateFingerprints),
 		l:      config.Logger,
 	}
 }
 
 func (a *GRPCAuthN) Middleware(ctx context.Context) (context.Context, error) {
-	forbidden := status.Errorf(codes.Unauthenticated, "invalid auth token")
-	token, err := auth.AuthFromMD(ctx, "bearer")
+	bearerToken, err := auth.AuthFromMD(ctx, "bearer")
 
 	if err != nil {
 		a.l.Debug().Err(err).Msgf("error getting bearer token from request: %s", err)
+		bearerToken = ""
+	}
+
+	method, _ := grpc.Method(ctx)
+
+	return a.authenticate(ctx, bearerToken, peerCertificate(ctx), strings.HasPrefix(method, "/Dispatcher/"))
+}
+
+// AuthenticateHTTP authenticates a request of a worker which uses the HTTP protocol of the dispatcher. Like the
+// dispatcher's gRPC methods, the request is authenticated with a bearer token or the SPIFFE ID of its client
+// certificate, and the certificate is checked against the pinned certificates of the tenant.
+func (a *GRPCAuthN) AuthenticateHTTP(r *http.Request) (context.Context, error) {
+	bearerToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+	if !ok {
+		bearerToken = ""
+	}
+
+	var cert *x509.Certificate
+
+	if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+		cert = r.TLS.PeerCertificates[0]
+	}
+
+	return a.authenticate(r.Context(), bearerToken, cert, true)
+}
+
+// authenticate returns the context of a request with its tenant, and verifies the pinned certificates of the tenant
+// if verifyPins is set.
+func (a *GRPCAuthN) authenticate(ctx context.Context, bearerToken string, cert *x509.Certificate, verifyPins bool) (context.Context, error) {
+	forbidden := status.Errorf(codes.Unauthenticated, "invalid auth token")
+
+	if bearerToken == "" {
+		// workers which don't send a token can authenticate with the SPIFFE ID of their client certificate
+		if a.config.Auth.SPIFFE != nil {
+			return a.authenticateSPIFFE(ctx, cert, verifyPins)
+		}
+
 		return nil, forbidden
 	}
 
-	tenantId, err := a.config.Auth.JWTManager.ValidateTenantToken(token)
+	validatedToken, err := a.config.Auth.JWTManager.ValidateTenantToken(bearerToken, token.ScopeWorker)
 
 	if err != nil {
 		a.l.Debug().Err(err).Msgf("error validating tenant token: %s", err)
@@ -42,12 +90,93 @@ func (a *GRPCAuthN) Middleware(ctx context.Context) (context.Context, error) {
 	}
 
 	// get the tenant id
-	queriedTenant, err := a.config.Repository.Tenant().GetTenantByID(tenantId)
+	queriedTenant, err := a.config.Repository.Tenant().GetTenantByID(validatedToken.TenantId)
+
+	if err != nil {
+		a.l.Debug().Err(err).Msgf("error getting tenant by id: %s", err)
+		return nil, forbidden
+	}
+
+	if verifyPins {
+		if err := a.verifyPinnedCertificate(queriedTenant.ID, cert); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx = context.WithValue(ctx, "tenant", queriedTenant)
+
+	// workers which register with the token join its environment, and the runs and events which are
+	// created with the token belong to it
+	if validatedToken.Environment != "" {
+		ctx = context.WithValue(ctx, "environment", validatedToken.Environment)
+	}
+
+	// workflow names and event keys without a namespace are resolved to the namespace of the token
+	if validatedToken.Namespace != "" {
+		ctx = context.WithValue(ctx, "namespace", validatedToken.Namespace)
+	}
+
+	return ctx, nil
+}
+
+func (a *GRPCAuthN) authenticateSPIFFE(ctx context.Context, cert *x509.Certificate, verifyPins bool) (context.Context, error) {
+	forbidden := status.Errorf(codes.Unauthenticated, "invalid auth token or SPIFFE ID")
+
+	identity, err := a.config.Auth.SPIFFE.Authenticate(cert)
+
+	if err != nil {
+		a.l.Debug().Err(err).Msgf("error authenticating SPIFFE ID: %s", err)
+		return nil, forbidden
+	}
+
+	queriedTenant, err := a.config.Repository.Tenant().GetTenantByID(identity.TenantId)
 
 	if err != nil {
 		a.l.Debug().Err(err).Msgf("error getting tenant by id: %s", err)
 		return nil, forbidden
 	}
 
-	return context.WithValue(ctx, "tenant", queriedTenant), nil
+	if verifyPins {
+		if err := a.verifyPinnedCertificate(queriedTenant.ID, cert); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx = context.WithValue(ctx, "tenant", queriedTenant)
+
+	// workers which register with the SPIFFE ID join the worker pool which it is mapped to
+	if identity.Service != "" {
+		ctx = context.WithValue(ctx, "service", identity.Service)
+	}
+
+	return ctx, nil
+}
+
+// verifyPinnedCertificate checks that workers of tenants which pin client certificates connect with one of the
+// pinned certificates.
+func (a *GRPCAuthN) verifyPinnedCertificate(tenantId string, cert *x509.Certificate) error {
+	if err := a.pins.Verify(tenantId, cert); err != nil {
+		a.l.Debug().Err(err).Msgf("error verifying client certificate: %s", err)
+		return status.Errorf(codes.PermissionDenied, "client certificate is not pinned for the tenant")
+	}
+
+	return nil
+}
+
+// peerCertificate returns the client certificate which the request was made with, or nil if the request wasn't made
+// with one.
+func peerCertificate(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+
+	if !ok {
+		return nil
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return nil
+	}
+
+	return tlsInfo.State.PeerCertificates[0]
 }
@@ -35,6 +35,7 @@ type Server struct {
 
 	l           *zerolog.Logger
 	port        int
+	httpPort    int
 	bindAddress string
 
 	config     *server.ServerConfig
@@ -51,6 +52,7 @@ type ServerOpts struct {
 	config      *server.ServerConfig
 	l           *zerolog.Logger
 	port        int
+	httpPort    int
 	bindAddress string
 	ingestor    ingestor.Ingestor
 	dispatcher  dispatcher.Dispatcher
@@ -88,6 +90,14 @@ func WithPort(port int) ServerOpt {
 	}
 }
 
+// WithHTTPPort starts the HTTP protocol of the dispatcher on the port, for workers which long-poll for their actions.
+// The protocol is disabled if the port is 0.
+func WithHTTPPort(port int) ServerOpt {
+	return func(opts *ServerOpts) {
+		opts.httpPort = port
+	}
+}
+
 func WithIngestor(i ingestor.Ingestor) ServerOpt {
 	return func(opts *ServerOpts) {
 		opts.ingestor = i
@@ -146,6 +156,7 @@ func NewServer(fs ...ServerOpt) (*Server, error) {
 		l:           opts.l,
 		config:      opts.config,
 		port:        opts.port,
+		httpPort:    opts.httpPort,
 		bindAddress: opts.bindAddress,
 		ingestor:    opts.ingestor,
 		dispatcher:  opts.dispatcher,
@@ -156,7 +167,33 @@ func NewServer(fs ...ServerOpt) (*Server, error) {
 }
 
 func (s *Server) Start() (func() error, error) {
-	return s.startGRPC()
+	cleanupGRPC, err := s.startGRPC()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if s.httpPort == 0 || s.dispatcher == nil {
+		return cleanupGRPC, nil
+	}
+
+	cleanupHTTP, err := s.startHTTP()
+
+	if err != nil {
+		_ = cleanupGRPC() // nolint: errcheck
+		return nil, fmt.Errorf("could not start worker http server: %w", err)
+	}
+
+	cleanup := func() error {
+		// the http server is stopped first, so that the polls of workers end before the dispatcher is stopped
+		if err := cleanupHTTP(); err != nil {
+			return err
+		}
+
+		return cleanupGRPC()
+	}
+
+	return cleanup, nil
 }
 
 func (s *Server) startGRPC() (func() error, error) {
@@ -2,14 +2,19 @@ package health
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/hatchet-dev/hatchet/internal/config/server"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/limits"
 )
 
 type Health struct {
@@ -17,12 +22,21 @@ type Health struct {
 
 	repository repository.Repository
 	queue      msgqueue.MessageQueue
+	reloader   *server.Reloader
+	pools      map[string]*pgxpool.Pool
+	limits     *limits.PayloadLimits
 }
 
-func New(prisma repository.Repository, queue msgqueue.MessageQueue) *Health {
+// New returns the health server. If the reloader is set, the server config can be reloaded by sending a POST
+// request to /config/reload. The stats of the connection pools and the number of rejected payloads are served
+// on /metrics.
+func New(prisma repository.Repository, queue msgqueue.MessageQueue, reloader *server.Reloader, pools map[string]*pgxpool.Pool, payloadLimits *limits.PayloadLimits) *Health {
 	return &Health{
 		repository: prisma,
 		queue:      queue,
+		reloader:   reloader,
+		pools:      pools,
+		limits:     payloadLimits,
 	}
 }
 
@@ -46,6 +60,12 @@ func (h *Health) Start() (func() error, error) {
 		w.WriteHeader(http.StatusOK)
 	})
 
+	mux.HandleFunc("/metrics", h.handleMetrics)
+
+	if h.reloader != nil {
+		mux.HandleFunc("/config/reload", h.handleReload)
+	}
+
 	server := &http.Server{
 		Addr:         ":8733",
 		Handler:      mux,
@@ -74,3 +94,23 @@ func (h *Health) Start() (func() error, error) {
 
 	return cleanup, nil
 }
+
+func (h *Health) handleReload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	changes, err := h.reloader.Reload()
+
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()}) // nolint: errcheck
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string][]server.ConfigChange{"changes": changes}) // nolint: errcheck
+}
@@ -0,0 +1,70 @@
+package health
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/hatchet-dev/hatchet/internal/services/shared/limits"
+)
+
+type poolMetric struct {
+	name  string
+	help  string
+	kind  string
+	value func(s *pgxpool.Stat) float64
+}
+
+var poolMetrics = []poolMetric{
+	{"hatchet_db_pool_max_conns", "Maximum number of connections in the pool.", "gauge", func(s *pgxpool.Stat) float64 { return float64(s.MaxConns()) }},
+	{"hatchet_db_pool_total_conns", "Number of connections in the pool.", "gauge", func(s *pgxpool.Stat) float64 { return float64(s.TotalConns()) }},
+	{"hatchet_db_pool_acquired_conns", "Number of connections which are in use.", "gauge", func(s *pgxpool.Stat) float64 { return float64(s.AcquiredConns()) }},
+	{"hatchet_db_pool_idle_conns", "Number of idle connections.", "gauge", func(s *pgxpool.Stat) float64 { return float64(s.IdleConns()) }},
+	{"hatchet_db_pool_constructing_conns", "Number of connections which are being opened.", "gauge", func(s *pgxpool.Stat) float64 { return float64(s.ConstructingConns()) }},
+	{"hatchet_db_pool_acquires_total", "Number of connections acquired from the pool.", "counter", func(s *pgxpool.Stat) float64 { return float64(s.AcquireCount()) }},
+	{"hatchet_db_pool_empty_acquires_total", "Number of acquires which waited for a connection because the pool was saturated.", "counter", func(s *pgxpool.Stat) float64 { return float64(s.EmptyAcquireCount()) }},
+	{"hatchet_db_pool_canceled_acquires_total", "Number of acquires which were canceled while waiting for a connection.", "counter", func(s *pgxpool.Stat) float64 { return float64(s.CanceledAcquireCount()) }},
+	{"hatchet_db_pool_acquire_duration_seconds_total", "Time spent acquiring connections from the pool.", "counter", func(s *pgxpool.Stat) float64 { return s.AcquireDuration().Seconds() }},
+}
+
+// handleMetrics writes the stats of the connection pools and the number of rejected payloads in the Prometheus
+// text format.
+func (h *Health) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+
+	writePoolMetrics(w, h.pools)
+	writePayloadLimitMetrics(w, h.limits)
+}
+
+func writePoolMetrics(w io.Writer, pools map[string]*pgxpool.Pool) {
+	names := make([]string, 0, len(pools))
+	stats := make(map[string]*pgxpool.Stat, len(pools))
+
+	for name, pool := range pools {
+		names = append(names, name)
+		stats[name] = pool.Stat()
+	}
+
+	sort.Strings(names)
+
+	for _, m := range poolMetrics {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", m.name, m.help, m.name, m.kind)
+
+		for _, name := range names {
+			fmt.Fprintf(w, "%s{pool=%q} %v\n", m.name, name, m.value(stats[name]))
+		}
+	}
+}
+
+func writePayloadLimitMetrics(w io.Writer, payloadLimits *limits.PayloadLimits) {
+	name := "hatchet_payloads_rejected_total"
+
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, "Number of payloads which were rejected because they exceeded their size limit.", name, "counter")
+
+	for _, kind := range limits.PayloadKinds {
+		fmt.Fprintf(w, "%s{kind=%q} %v\n", name, kind, payloadLimits.Rejected(kind))
+	}
+}
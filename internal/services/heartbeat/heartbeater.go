@@ -11,6 +11,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/logger"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/leader"
 )
 
 type Heartbeater interface {
@@ -22,6 +23,9 @@ type HeartbeaterImpl struct {
 	l    *zerolog.Logger
 	repo repository.Repository
 	s    gocron.Scheduler
+
+	// elector makes sure that stale tickers are only removed by one replica
+	elector *leader.Elector
 }
 
 type HeartbeaterOpt func(*HeartbeaterOpts)
@@ -75,7 +79,9 @@ func New(fs ...HeartbeaterOpt) (*HeartbeaterImpl, error) {
 	newLogger := opts.l.With().Str("service", "heartbeater").Logger()
 	opts.l = &newLogger
 
-	s, err := gocron.NewScheduler(gocron.WithLocation(time.UTC))
+	elector := leader.NewElector(opts.repo.LeaderLease(), opts.l, "heartbeater")
+
+	s, err := gocron.NewScheduler(gocron.WithLocation(time.UTC), gocron.WithDistributedElector(elector))
 
 	if err != nil {
 		return nil, fmt.Errorf("could not create scheduler: %w", err)
@@ -86,6 +92,8 @@ func New(fs ...HeartbeaterOpt) (*HeartbeaterImpl, error) {
 		l:    opts.l,
 		repo: opts.repo,
 		s:    s,
+
+		elector: elector,
 	}, nil
 }
 
@@ -110,6 +118,9 @@ func (t *HeartbeaterImpl) Start() (func() error, error) {
 		if err := t.s.Shutdown(); err != nil {
 			return fmt.Errorf("could not shutdown scheduler: %w", err)
 		}
+		if err := t.elector.Release(context.Background()); err != nil {
+			return fmt.Errorf("could not release leader lease: %w", err)
+		}
 		t.l.Debug().Msg("heartbeater has shutdown")
 		return nil
 	}
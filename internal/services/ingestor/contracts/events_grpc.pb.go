@@ -26,6 +26,7 @@ type EventsServiceClient interface {
 	List(ctx context.Context, in *ListEventRequest, opts ...grpc.CallOption) (*ListEventResponse, error)
 	ReplaySingleEvent(ctx context.Context, in *ReplayEventRequest, opts ...grpc.CallOption) (*Event, error)
 	PutLog(ctx context.Context, in *PutLogRequest, opts ...grpc.CallOption) (*PutLogResponse, error)
+	PutStreamEvent(ctx context.Context, in *PutStreamEventRequest, opts ...grpc.CallOption) (*PutStreamEventResponse, error)
 }
 
 type eventsServiceClient struct {
@@ -72,6 +73,15 @@ func (c *eventsServiceClient) PutLog(ctx context.Context, in *PutLogRequest, opt
 	return out, nil
 }
 
+func (c *eventsServiceClient) PutStreamEvent(ctx context.Context, in *PutStreamEventRequest, opts ...grpc.CallOption) (*PutStreamEventResponse, error) {
+	out := new(PutStreamEventResponse)
+	err := c.cc.Invoke(ctx, "/EventsService/PutStreamEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventsServiceServer is the server API for EventsService service.
 // All implementations must embed UnimplementedEventsServiceServer
 // for forward compatibility
@@ -80,6 +90,7 @@ type EventsServiceServer interface {
 	List(context.Context, *ListEventRequest) (*ListEventResponse, error)
 	ReplaySingleEvent(context.Context, *ReplayEventRequest) (*Event, error)
 	PutLog(context.Context, *PutLogRequest) (*PutLogResponse, error)
+	PutStreamEvent(context.Context, *PutStreamEventRequest) (*PutStreamEventResponse, error)
 	mustEmbedUnimplementedEventsServiceServer()
 }
 
@@ -99,6 +110,9 @@ func (UnimplementedEventsServiceServer) ReplaySingleEvent(context.Context, *Repl
 func (UnimplementedEventsServiceServer) PutLog(context.Context, *PutLogRequest) (*PutLogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PutLog not implemented")
 }
+func (UnimplementedEventsServiceServer) PutStreamEvent(context.Context, *PutStreamEventRequest) (*PutStreamEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutStreamEvent not implemented")
+}
 func (UnimplementedEventsServiceServer) mustEmbedUnimplementedEventsServiceServer() {}
 
 // UnsafeEventsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -184,6 +198,24 @@ func _EventsService_PutLog_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventsService_PutStreamEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutStreamEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).PutStreamEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/EventsService/PutStreamEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).PutStreamEvent(ctx, req.(*PutStreamEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EventsService_ServiceDesc is the grpc.ServiceDesc for EventsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,6 +239,10 @@ var EventsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PutLog",
 			Handler:    _EventsService_PutLog_Handler,
 		},
+		{
+			MethodName: "PutStreamEvent",
+			Handler:    _EventsService_PutStreamEvent_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "events.proto",
@@ -2,6 +2,7 @@ package ingestor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/steebchen/prisma-client-go/runtime/types"
@@ -11,6 +12,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/internal/services/ingestor/contracts"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/limits"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
 )
@@ -21,12 +23,19 @@ type Ingestor interface {
 	IngestReplayedEvent(ctx context.Context, tenantId string, replayedEvent *db.EventModel) (*db.EventModel, error)
 }
 
+// ErrIngestionPaused is returned when an event is ingested for a tenant whose ingestion has been
+// paused by an instance admin.
+var ErrIngestionPaused = errors.New("event ingestion is paused for this tenant")
+
 type IngestorOptFunc func(*IngestorOpts)
 
 type IngestorOpts struct {
-	eventRepository repository.EventRepository
-	logRepository   repository.LogsRepository
-	mq              msgqueue.MessageQueue
+	eventRepository       repository.EventRepository
+	logRepository         repository.LogsRepository
+	streamEventRepository repository.StreamEventRepository
+	tenantRepository      repository.TenantRepository
+	mq                    msgqueue.MessageQueue
+	payloadLimits         *limits.PayloadLimits
 }
 
 func WithEventRepository(r repository.EventRepository) IngestorOptFunc {
@@ -41,12 +50,31 @@ func WithLogRepository(r repository.LogsRepository) IngestorOptFunc {
 	}
 }
 
+func WithStreamEventRepository(r repository.StreamEventRepository) IngestorOptFunc {
+	return func(opts *IngestorOpts) {
+		opts.streamEventRepository = r
+	}
+}
+
+func WithTenantRepository(r repository.TenantRepository) IngestorOptFunc {
+	return func(opts *IngestorOpts) {
+		opts.tenantRepository = r
+	}
+}
+
 func WithMessageQueue(mq msgqueue.MessageQueue) IngestorOptFunc {
 	return func(opts *IngestorOpts) {
 		opts.mq = mq
 	}
 }
 
+// WithPayloadLimits sets the limits which event payloads are checked against.
+func WithPayloadLimits(l *limits.PayloadLimits) IngestorOptFunc {
+	return func(opts *IngestorOpts) {
+		opts.payloadLimits = l
+	}
+}
+
 func defaultIngestorOpts() *IngestorOpts {
 	return &IngestorOpts{}
 }
@@ -54,9 +82,12 @@ func defaultIngestorOpts() *IngestorOpts {
 type IngestorImpl struct {
 	contracts.UnimplementedEventsServiceServer
 
-	eventRepository repository.EventRepository
-	logRepository   repository.LogsRepository
-	mq              msgqueue.MessageQueue
+	eventRepository       repository.EventRepository
+	logRepository         repository.LogsRepository
+	streamEventRepository repository.StreamEventRepository
+	tenantRepository      repository.TenantRepository
+	mq                    msgqueue.MessageQueue
+	payloadLimits         *limits.PayloadLimits
 }
 
 func NewIngestor(fs ...IngestorOptFunc) (Ingestor, error) {
@@ -74,14 +105,25 @@ func NewIngestor(fs ...IngestorOptFunc) (Ingestor, error) {
 		return nil, fmt.Errorf("log repository is required. use WithLogRepository")
 	}
 
+	if opts.streamEventRepository == nil {
+		return nil, fmt.Errorf("stream event repository is required. use WithStreamEventRepository")
+	}
+
+	if opts.tenantRepository == nil {
+		return nil, fmt.Errorf("tenant repository is required. use WithTenantRepository")
+	}
+
 	if opts.mq == nil {
 		return nil, fmt.Errorf("task queue is required. use WithMessageQueue")
 	}
 
 	return &IngestorImpl{
-		eventRepository: opts.eventRepository,
-		logRepository:   opts.logRepository,
-		mq:              opts.mq,
+		eventRepository:       opts.eventRepository,
+		logRepository:         opts.logRepository,
+		streamEventRepository: opts.streamEventRepository,
+		tenantRepository:      opts.tenantRepository,
+		mq:                    opts.mq,
+		payloadLimits:         opts.payloadLimits,
 	}, nil
 }
 
@@ -89,6 +131,10 @@ func (i *IngestorImpl) IngestEvent(ctx context.Context, tenantId, key string, da
 	ctx, span := telemetry.NewSpan(ctx, "ingest-event")
 	defer span.End()
 
+	if err := i.checkIngestionPaused(tenantId); err != nil {
+		return nil, err
+	}
+
 	// transform data to a JSON object
 	jsonType, err := datautils.ToJSONType(data)
 
@@ -96,11 +142,33 @@ func (i *IngestorImpl) IngestEvent(ctx context.Context, tenantId, key string, da
 		return nil, fmt.Errorf("could not convert event data to JSON: %w", err)
 	}
 
-	event, err := i.eventRepository.CreateEvent(ctx, &repository.CreateEventOpts{
+	if jsonType != nil {
+		if err := i.payloadLimits.Check(limits.PayloadKindEvent, len(*jsonType)); err != nil {
+			return nil, err
+		}
+	}
+
+	// events which are pushed with a namespace token belong to its namespace
+	namespace, _ := ctx.Value("namespace").(string)
+
+	key, err = repository.ResolveNamespace(namespace, key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &repository.CreateEventOpts{
 		TenantId: tenantId,
 		Key:      key,
 		Data:     jsonType,
-	})
+	}
+
+	// events which are pushed with an environment token belong to its environment
+	if environment, ok := ctx.Value("environment").(string); ok && environment != "" {
+		opts.Environment = &environment
+	}
+
+	event, err := i.eventRepository.CreateEvent(ctx, opts)
 
 	if err != nil {
 		return nil, fmt.Errorf("could not create event: %w", err)
@@ -111,7 +179,7 @@ func (i *IngestorImpl) IngestEvent(ctx context.Context, tenantId, key string, da
 		Value: event.ID,
 	})
 
-	err = i.mq.AddMessage(context.Background(), msgqueue.EVENT_PROCESSING_QUEUE, eventToTask(event))
+	err = i.mq.AddMessage(ctx, msgqueue.EVENT_PROCESSING_QUEUE, eventToTask(event))
 
 	if err != nil {
 		return nil, fmt.Errorf("could not add event to task queue: %w", err)
@@ -124,6 +192,10 @@ func (i *IngestorImpl) IngestReplayedEvent(ctx context.Context, tenantId string,
 	ctx, span := telemetry.NewSpan(ctx, "ingest-replayed-event")
 	defer span.End()
 
+	if err := i.checkIngestionPaused(tenantId); err != nil {
+		return nil, err
+	}
+
 	// transform data to a JSON object
 	var data *types.JSON
 
@@ -131,18 +203,25 @@ func (i *IngestorImpl) IngestReplayedEvent(ctx context.Context, tenantId string,
 		data = &jsonType
 	}
 
-	event, err := i.eventRepository.CreateEvent(ctx, &repository.CreateEventOpts{
+	opts := &repository.CreateEventOpts{
 		TenantId:      tenantId,
 		Key:           replayedEvent.Key,
 		Data:          data,
 		ReplayedEvent: &replayedEvent.ID,
-	})
+	}
+
+	// replays belong to the environment of the replayed event
+	if environment, ok := replayedEvent.Environment(); ok {
+		opts.Environment = &environment
+	}
+
+	event, err := i.eventRepository.CreateEvent(ctx, opts)
 
 	if err != nil {
 		return nil, fmt.Errorf("could not create event: %w", err)
 	}
 
-	err = i.mq.AddMessage(context.Background(), msgqueue.EVENT_PROCESSING_QUEUE, eventToTask(event))
+	err = i.mq.AddMessage(ctx, msgqueue.EVENT_PROCESSING_QUEUE, eventToTask(event))
 
 	if err != nil {
 		return nil, fmt.Errorf("could not add event to task queue: %w", err)
@@ -151,6 +230,20 @@ func (i *IngestorImpl) IngestReplayedEvent(ctx context.Context, tenantId string,
 	return event, nil
 }
 
+func (i *IngestorImpl) checkIngestionPaused(tenantId string) error {
+	tenant, err := i.tenantRepository.GetTenantByID(tenantId)
+
+	if err != nil {
+		return fmt.Errorf("could not get tenant: %w", err)
+	}
+
+	if tenant.IngestionPaused {
+		return ErrIngestionPaused
+	}
+
+	return nil
+}
+
 func eventToTask(e *db.EventModel) *msgqueue.Message {
 	payload, _ := datautils.ToJSONMap(tasktypes.EventTaskPayload{
 		EventId: e.ID,
@@ -3,15 +3,20 @@ package ingestor
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/internal/services/ingestor/contracts"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -29,7 +34,11 @@ func (i *IngestorImpl) Push(ctx context.Context, req *contracts.PushEventRequest
 
 	event, err := i.IngestEvent(ctx, tenant.ID, req.Key, eventDataMap)
 
-	if err != nil {
+	if errors.Is(err, ErrIngestionPaused) {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	} else if errors.Is(err, repository.ErrOutsideNamespace) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	} else if err != nil {
 		return nil, err
 	}
 
@@ -48,14 +57,34 @@ func (i *IngestorImpl) List(ctx context.Context, req *contracts.ListEventRequest
 	offset := int(req.Offset)
 	var keys []string
 
+	namespace, _ := ctx.Value("namespace").(string)
+
 	if req.Key != "" {
-		keys = []string{req.Key}
+		key, err := repository.ResolveNamespace(namespace, req.Key)
+
+		if err != nil {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		keys = []string{key}
 	}
 
-	listResult, err := i.eventRepository.ListEvents(tenant.ID, &repository.ListEventOpts{
+	opts := &repository.ListEventOpts{
 		Keys:   keys,
 		Offset: &offset,
-	})
+	}
+
+	// requests with an environment token only list the events of its environment
+	if environment, ok := ctx.Value("environment").(string); ok && environment != "" {
+		opts.Environment = &environment
+	}
+
+	// requests with a namespace token only list the events of its namespace
+	if namespace != "" {
+		opts.Namespace = &namespace
+	}
+
+	listResult, err := i.eventRepository.ListEvents(tenant.ID, opts)
 
 	if err != nil {
 		return nil, err
@@ -87,9 +116,15 @@ func (i *IngestorImpl) ReplaySingleEvent(ctx context.Context, req *contracts.Rep
 		return nil, err
 	}
 
+	if namespace, ok := ctx.Value("namespace").(string); ok && namespace != "" && !repository.InNamespace(namespace, oldEvent.Key) {
+		return nil, status.Error(codes.PermissionDenied, repository.ErrOutsideNamespace.Error())
+	}
+
 	newEvent, err := i.IngestReplayedEvent(ctx, tenant.ID, oldEvent)
 
-	if err != nil {
+	if errors.Is(err, ErrIngestionPaused) {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	} else if err != nil {
 		return nil, err
 	}
 
@@ -132,6 +167,52 @@ func (i *IngestorImpl) PutLog(ctx context.Context, req *contracts.PutLogRequest)
 	return &contracts.PutLogResponse{}, nil
 }
 
+// PutStreamEvent appends a stream event to the output stream of a running step run, and sends it to the subscribers
+// to the events of its workflow run.
+func (i *IngestorImpl) PutStreamEvent(ctx context.Context, req *contracts.PutStreamEventRequest) (*contracts.PutStreamEventResponse, error) {
+	tenant := ctx.Value("tenant").(*db.TenantModel)
+
+	if req.StepRunId == "" {
+		return nil, status.Error(codes.InvalidArgument, "step run id is required")
+	}
+
+	var createdAt *time.Time
+
+	if req.CreatedAt != nil {
+		t := req.CreatedAt.AsTime()
+		createdAt = &t
+	}
+
+	streamEvent, err := i.streamEventRepository.PutStreamEvent(ctx, tenant.ID, &repository.CreateStreamEventOpts{
+		StepRunId: req.StepRunId,
+		CreatedAt: createdAt,
+		Message:   req.Message,
+	})
+
+	if errors.Is(err, repository.ErrStepRunNotRunning) {
+		return nil, status.Errorf(codes.FailedPrecondition, "step run %s is not running", req.StepRunId)
+	} else if err != nil {
+		return nil, err
+	}
+
+	q, err := msgqueue.TenantEventConsumerQueue(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the stream event is persisted, so subscribers which miss it can list it from the API
+	err = i.mq.AddMessage(ctx, q, tasktypes.StepRunStreamEventToTask(streamEvent))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not add stream event to task queue: %w", err)
+	}
+
+	return &contracts.PutStreamEventResponse{
+		Index: streamEvent.Index,
+	}, nil
+}
+
 func toEventFromSQLC(eventRow *dbsqlc.ListEventsRow) (*contracts.Event, error) {
 	event := eventRow.Event
 
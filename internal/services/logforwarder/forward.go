@@ -0,0 +1,158 @@
+package logforwarder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/logsinks"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+)
+
+const (
+	// the maximum number of sinks which are delivered to in one run
+	maxSinksPerRun = 100
+
+	// the maximum number of batches which are delivered to one sink in one run, so that a sink with a large
+	// backlog does not hold up the other sinks
+	maxBatchesPerSink = 10
+
+	// the maximum time between retries of failed deliveries
+	maxBackoff = 5 * time.Minute
+
+	// records are deleted after the retention period, whether or not they were delivered
+	recordRetention = 72 * time.Hour
+
+	deleteBatchSize = 10000
+)
+
+func (f *LogForwarderImpl) forwardRecords() func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		f.l.Debug().Msg("forwarding log sink records")
+
+		sinks, err := f.repo.LogSink().ListLogSinksToDeliver(ctx, maxSinksPerRun)
+
+		if err != nil {
+			f.l.Err(err).Msg("could not list log sinks to deliver")
+			return
+		}
+
+		for _, sink := range sinks {
+			sinkId := sqlchelpers.UUIDToStr(sink.ID)
+
+			if err := f.forwardSinkRecords(ctx, sink); err != nil {
+				f.l.Warn().Err(err).Msgf("could not deliver records to log sink %s", sinkId)
+
+				backoff := time.Duration(math.Pow(2, float64(sink.Failures))) * time.Second
+
+				if backoff <= 0 || backoff > maxBackoff {
+					backoff = maxBackoff
+				}
+
+				err = f.repo.LogSink().UpdateLogSinkFailed(ctx, sinkId, err, time.Now().Add(backoff))
+
+				if err != nil {
+					f.l.Err(err).Msgf("could not update failed log sink %s", sinkId)
+				}
+			}
+		}
+	}
+}
+
+func (f *LogForwarderImpl) forwardSinkRecords(ctx context.Context, sink *dbsqlc.LogSink) error {
+	sinkId := sqlchelpers.UUIDToStr(sink.ID)
+
+	config, err := f.enc.Decrypt(sink.Config, logsinks.ConfigDataId)
+
+	if err != nil {
+		return fmt.Errorf("could not decrypt config: %w", err)
+	}
+
+	sinkConfig := &logsinks.Config{}
+
+	if err := json.Unmarshal(config, sinkConfig); err != nil {
+		return fmt.Errorf("could not unmarshal config: %w", err)
+	}
+
+	s, err := logsinks.New(logsinks.Kind(sink.Kind), sinkConfig)
+
+	if err != nil {
+		return fmt.Errorf("could not create sink: %w", err)
+	}
+
+	for i := 0; i < maxBatchesPerSink; i++ {
+		records, err := f.repo.LogSink().ListLogSinkRecords(ctx, sinkId, int(sink.BatchSize))
+
+		if err != nil {
+			return fmt.Errorf("could not list records: %w", err)
+		}
+
+		if len(records) == 0 {
+			return nil
+		}
+
+		batch := logsinks.NewBatch(sinkId, toRecords(records))
+
+		if err := s.Deliver(ctx, batch); err != nil {
+			return err
+		}
+
+		err = f.repo.LogSink().UpdateLogSinkDelivered(ctx, sinkId, records[len(records)-1])
+
+		if err != nil {
+			return fmt.Errorf("could not update delivered records: %w", err)
+		}
+
+		if len(records) < int(sink.BatchSize) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (f *LogForwarderImpl) deleteExpiredRecords() func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		f.l.Debug().Msg("deleting expired log sink records")
+
+		before := time.Now().Add(-recordRetention)
+
+		for {
+			deleted, err := f.repo.LogSink().DeleteExpiredLogSinkRecords(ctx, before, deleteBatchSize)
+
+			if err != nil {
+				f.l.Err(err).Msg("could not delete expired log sink records")
+				return
+			}
+
+			if deleted < deleteBatchSize {
+				return
+			}
+		}
+	}
+}
+
+func toRecords(records []*dbsqlc.LogSinkRecord) []logsinks.Record {
+	res := make([]logsinks.Record, 0, len(records))
+
+	for _, record := range records {
+		res = append(res, logsinks.Record{
+			Id:        record.ID,
+			TenantId:  sqlchelpers.UUIDToStr(record.TenantId),
+			Event:     record.Event,
+			CreatedAt: record.CreatedAt.Time,
+			Data:      record.Data,
+		})
+	}
+
+	return res
+}
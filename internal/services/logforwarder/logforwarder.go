@@ -0,0 +1,142 @@
+package logforwarder
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-co-op/gocron/v2"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/encryption"
+	"github.com/hatchet-dev/hatchet/internal/logger"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/leader"
+)
+
+type LogForwarder interface {
+	Start() (func() error, error)
+}
+
+type LogForwarderImpl struct {
+	l    *zerolog.Logger
+	repo repository.Repository
+	enc  encryption.EncryptionService
+	s    gocron.Scheduler
+
+	// elector makes sure that records are only forwarded by one replica, so that batches are delivered in order
+	elector *leader.Elector
+}
+
+type LogForwarderOpt func(*LogForwarderOpts)
+
+type LogForwarderOpts struct {
+	l    *zerolog.Logger
+	repo repository.Repository
+	enc  encryption.EncryptionService
+}
+
+func defaultLogForwarderOpts() *LogForwarderOpts {
+	logger := logger.NewDefaultLogger("log-forwarder")
+	return &LogForwarderOpts{
+		l: &logger,
+	}
+}
+
+func WithRepository(r repository.Repository) LogForwarderOpt {
+	return func(opts *LogForwarderOpts) {
+		opts.repo = r
+	}
+}
+
+func WithEncryption(enc encryption.EncryptionService) LogForwarderOpt {
+	return func(opts *LogForwarderOpts) {
+		opts.enc = enc
+	}
+}
+
+func WithLogger(l *zerolog.Logger) LogForwarderOpt {
+	return func(opts *LogForwarderOpts) {
+		opts.l = l
+	}
+}
+
+func New(fs ...LogForwarderOpt) (*LogForwarderImpl, error) {
+	opts := defaultLogForwarderOpts()
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	if opts.repo == nil {
+		return nil, fmt.Errorf("repository is required. use WithRepository")
+	}
+
+	if opts.enc == nil {
+		return nil, fmt.Errorf("encryption service is required. use WithEncryption")
+	}
+
+	newLogger := opts.l.With().Str("service", "log-forwarder").Logger()
+	opts.l = &newLogger
+
+	elector := leader.NewElector(opts.repo.LeaderLease(), opts.l, "log-forwarder")
+
+	s, err := gocron.NewScheduler(gocron.WithLocation(time.UTC), gocron.WithDistributedElector(elector))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create scheduler: %w", err)
+	}
+
+	return &LogForwarderImpl{
+		l:    opts.l,
+		repo: opts.repo,
+		enc:  opts.enc,
+		s:    s,
+
+		elector: elector,
+	}, nil
+}
+
+func (f *LogForwarderImpl) Start() (func() error, error) {
+	f.l.Debug().Msg("starting log forwarder")
+
+	_, err := f.s.NewJob(
+		gocron.DurationJob(time.Second*5),
+		gocron.NewTask(
+			f.forwardRecords(),
+		),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not schedule record forwarding: %w", err)
+	}
+
+	_, err = f.s.NewJob(
+		gocron.DurationJob(time.Minute*5),
+		gocron.NewTask(
+			f.deleteExpiredRecords(),
+		),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not schedule expired record deletion: %w", err)
+	}
+
+	f.s.Start()
+
+	cleanup := func() error {
+		f.l.Debug().Msg("stopping log forwarder")
+		if err := f.s.Shutdown(); err != nil {
+			return fmt.Errorf("could not shutdown scheduler: %w", err)
+		}
+		if err := f.elector.Release(context.Background()); err != nil {
+			return fmt.Errorf("could not release leader lease: %w", err)
+		}
+		f.l.Debug().Msg("log forwarder has shutdown")
+		return nil
+	}
+
+	return cleanup, nil
+}
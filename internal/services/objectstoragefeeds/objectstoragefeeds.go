@@ -0,0 +1,144 @@
+package objectstoragefeeds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-co-op/gocron/v2"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/encryption"
+	"github.com/hatchet-dev/hatchet/internal/logger"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/leader"
+)
+
+type ObjectStorageFeeds interface {
+	Start() (func() error, error)
+}
+
+type ObjectStorageFeedsImpl struct {
+	l    *zerolog.Logger
+	repo repository.Repository
+	enc  encryption.EncryptionService
+	i    ingestor.Ingestor
+	s    gocron.Scheduler
+
+	// elector makes sure that feeds are only polled by one replica
+	elector *leader.Elector
+}
+
+type ObjectStorageFeedsOpt func(*ObjectStorageFeedsOpts)
+
+type ObjectStorageFeedsOpts struct {
+	l    *zerolog.Logger
+	repo repository.Repository
+	enc  encryption.EncryptionService
+	i    ingestor.Ingestor
+}
+
+func defaultObjectStorageFeedsOpts() *ObjectStorageFeedsOpts {
+	logger := logger.NewDefaultLogger("object-storage-feeds")
+	return &ObjectStorageFeedsOpts{
+		l: &logger,
+	}
+}
+
+func WithRepository(r repository.Repository) ObjectStorageFeedsOpt {
+	return func(opts *ObjectStorageFeedsOpts) {
+		opts.repo = r
+	}
+}
+
+func WithEncryption(enc encryption.EncryptionService) ObjectStorageFeedsOpt {
+	return func(opts *ObjectStorageFeedsOpts) {
+		opts.enc = enc
+	}
+}
+
+func WithIngestor(i ingestor.Ingestor) ObjectStorageFeedsOpt {
+	return func(opts *ObjectStorageFeedsOpts) {
+		opts.i = i
+	}
+}
+
+func WithLogger(l *zerolog.Logger) ObjectStorageFeedsOpt {
+	return func(opts *ObjectStorageFeedsOpts) {
+		opts.l = l
+	}
+}
+
+func New(fs ...ObjectStorageFeedsOpt) (*ObjectStorageFeedsImpl, error) {
+	opts := defaultObjectStorageFeedsOpts()
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	if opts.repo == nil {
+		return nil, fmt.Errorf("repository is required. use WithRepository")
+	}
+
+	if opts.enc == nil {
+		return nil, fmt.Errorf("encryption service is required. use WithEncryption")
+	}
+
+	if opts.i == nil {
+		return nil, fmt.Errorf("ingestor is required. use WithIngestor")
+	}
+
+	newLogger := opts.l.With().Str("service", "object-storage-feeds").Logger()
+	opts.l = &newLogger
+
+	elector := leader.NewElector(opts.repo.LeaderLease(), opts.l, "object-storage-feeds")
+
+	s, err := gocron.NewScheduler(gocron.WithLocation(time.UTC), gocron.WithDistributedElector(elector))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create scheduler: %w", err)
+	}
+
+	return &ObjectStorageFeedsImpl{
+		l:    opts.l,
+		repo: opts.repo,
+		enc:  opts.enc,
+		i:    opts.i,
+		s:    s,
+
+		elector: elector,
+	}, nil
+}
+
+func (o *ObjectStorageFeedsImpl) Start() (func() error, error) {
+	o.l.Debug().Msg("starting object storage feeds")
+
+	_, err := o.s.NewJob(
+		gocron.DurationJob(time.Second*5),
+		gocron.NewTask(
+			o.pollFeeds(),
+		),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not schedule feed polling: %w", err)
+	}
+
+	o.s.Start()
+
+	cleanup := func() error {
+		o.l.Debug().Msg("stopping object storage feeds")
+		if err := o.s.Shutdown(); err != nil {
+			return fmt.Errorf("could not shutdown scheduler: %w", err)
+		}
+		if err := o.elector.Release(context.Background()); err != nil {
+			return fmt.Errorf("could not release leader lease: %w", err)
+		}
+		o.l.Debug().Msg("object storage feeds have shutdown")
+		return nil
+	}
+
+	return cleanup, nil
+}
@@ -0,0 +1,147 @@
+package objectstoragefeeds
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/objectstorage"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+)
+
+const (
+	// the maximum number of feeds which are polled in one run
+	maxFeedsPerRun = 100
+
+	// the maximum number of receives from one feed in one run, so that a feed with a large backlog does not hold up
+	// the other feeds
+	maxReceivesPerFeed = 10
+
+	// the maximum number of messages which are received at once
+	receiveBatchSize = 10
+
+	// the maximum time between retries of failed polls
+	maxBackoff = 5 * time.Minute
+)
+
+func (o *ObjectStorageFeedsImpl) pollFeeds() func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		o.l.Debug().Msg("polling object storage feeds")
+
+		feeds, err := o.repo.ObjectStorageFeed().ListObjectStorageFeedsToPoll(ctx, maxFeedsPerRun)
+
+		if err != nil {
+			o.l.Err(err).Msg("could not list object storage feeds to poll")
+			return
+		}
+
+		for _, feed := range feeds {
+			feedId := sqlchelpers.UUIDToStr(feed.ID)
+
+			received, err := o.pollFeed(ctx, feed)
+
+			if err != nil {
+				o.l.Warn().Err(err).Msgf("could not poll object storage feed %s", feedId)
+
+				backoff := time.Duration(math.Pow(2, float64(feed.Failures))) * time.Second
+
+				if backoff <= 0 || backoff > maxBackoff {
+					backoff = maxBackoff
+				}
+
+				err = o.repo.ObjectStorageFeed().UpdateObjectStorageFeedFailed(ctx, feedId, err, time.Now().Add(backoff))
+
+				if err != nil {
+					o.l.Err(err).Msgf("could not update failed object storage feed %s", feedId)
+				}
+
+				continue
+			}
+
+			if err := o.repo.ObjectStorageFeed().UpdateObjectStorageFeedPolled(ctx, feedId, received); err != nil {
+				o.l.Err(err).Msgf("could not update polled object storage feed %s", feedId)
+			}
+		}
+	}
+}
+
+// pollFeed receives the messages of a feed and creates an event for every created object, and returns whether any
+// messages were received. Messages are only acknowledged once the events of their objects were created, so messages
+// of a failed poll are received again, and events can be created more than once.
+func (o *ObjectStorageFeedsImpl) pollFeed(ctx context.Context, feed *dbsqlc.ObjectStorageFeed) (bool, error) {
+	feedId := sqlchelpers.UUIDToStr(feed.ID)
+	tenantId := sqlchelpers.UUIDToStr(feed.TenantId)
+
+	config, err := o.enc.Decrypt(feed.Config, objectstorage.ConfigDataId)
+
+	if err != nil {
+		return false, fmt.Errorf("could not decrypt config: %w", err)
+	}
+
+	feedConfig := &objectstorage.Config{}
+
+	if err := json.Unmarshal(config, feedConfig); err != nil {
+		return false, fmt.Errorf("could not unmarshal config: %w", err)
+	}
+
+	f, err := objectstorage.New(ctx, objectstorage.Kind(feed.Kind), feedConfig)
+
+	if err != nil {
+		return false, fmt.Errorf("could not create feed: %w", err)
+	}
+
+	received := false
+
+	for i := 0; i < maxReceivesPerFeed; i++ {
+		messages, err := f.Receive(ctx, receiveBatchSize)
+
+		if err != nil {
+			return received, err
+		}
+
+		if len(messages) == 0 {
+			return received, nil
+		}
+
+		received = true
+
+		for _, message := range messages {
+			if message.Err != nil {
+				o.l.Warn().Err(message.Err).Msgf("skipping message of object storage feed %s", feedId)
+				continue
+			}
+
+			for _, object := range message.Objects {
+				if feed.KeyPrefix.Valid && !strings.HasPrefix(object.Key, feed.KeyPrefix.String) {
+					continue
+				}
+
+				data := map[string]interface{}{
+					"object": object,
+					"feed":   feed.Name,
+				}
+
+				if _, err := o.i.IngestEvent(ctx, tenantId, feed.EventKey, data); err != nil {
+					return received, fmt.Errorf("could not ingest event for object %s: %w", object.Key, err)
+				}
+			}
+		}
+
+		if err := f.Ack(ctx, messages); err != nil {
+			return received, err
+		}
+
+		if len(messages) < receiveBatchSize {
+			return received, nil
+		}
+	}
+
+	return received, nil
+}
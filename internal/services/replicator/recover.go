@@ -0,0 +1,74 @@
+package replicator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+)
+
+// Recover queues the workflow runs and job runs of a promoted standby again, because the messages which
+// queued them were sent to the message queue of the old primary. Step runs which were assigned to the
+// workers of the old primary are reassigned by the jobs controller once the workers are considered dead,
+// and the crons of its tickers are rescheduled by the heartbeater.
+func Recover(ctx context.Context, repo repository.Repository, mq msgqueue.MessageQueue) (workflowRuns int, jobRuns int, err error) {
+	workflowRunRows, err := repo.Replication().ListWorkflowRunsToRecover(ctx)
+
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not list workflow runs to recover: %w", err)
+	}
+
+	for _, row := range workflowRunRows {
+		workflowRun, err := repo.WorkflowRun().GetWorkflowRunById(
+			sqlchelpers.UUIDToStr(row.TenantId),
+			sqlchelpers.UUIDToStr(row.ID),
+		)
+
+		if err != nil {
+			return workflowRuns, jobRuns, fmt.Errorf("could not get workflow run: %w", err)
+		}
+
+		err = mq.AddMessage(ctx, msgqueue.WORKFLOW_PROCESSING_QUEUE, tasktypes.WorkflowRunQueuedToTask(workflowRun))
+
+		if err != nil {
+			return workflowRuns, jobRuns, fmt.Errorf("could not add workflow run to queue: %w", err)
+		}
+
+		workflowRuns++
+	}
+
+	jobRunRows, err := repo.Replication().ListJobRunsToRecover(ctx)
+
+	if err != nil {
+		return workflowRuns, jobRuns, fmt.Errorf("could not list job runs to recover: %w", err)
+	}
+
+	for _, row := range jobRunRows {
+		jobRun, err := repo.JobRun().GetJobRunById(
+			sqlchelpers.UUIDToStr(row.TenantId),
+			sqlchelpers.UUIDToStr(row.ID),
+		)
+
+		if err != nil {
+			return workflowRuns, jobRuns, fmt.Errorf("could not get job run: %w", err)
+		}
+
+		// queueing the job run again queues its step runs which are startable but were never started
+		err = mq.AddMessage(ctx, msgqueue.JOB_PROCESSING_QUEUE, tasktypes.JobRunQueuedToTask(jobRun.Job(), jobRun))
+
+		if err != nil {
+			return workflowRuns, jobRuns, fmt.Errorf("could not add job run to queue: %w", err)
+		}
+
+		jobRuns++
+	}
+
+	if _, err := repo.Replication().MarkReplicaRecovered(ctx); err != nil {
+		return workflowRuns, jobRuns, fmt.Errorf("could not mark replica as recovered: %w", err)
+	}
+
+	return workflowRuns, jobRuns, nil
+}
@@ -0,0 +1,106 @@
+package replicator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+// the maximum number of batches which are applied in one run, so that a large backlog doesn't hold the
+// leader lease past the timeout of the run
+const maxBatchesPerRun = 100
+
+func (r *ReplicatorImpl) replicate() func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		r.l.Debug().Msg("replicating the primary's log")
+
+		for i := 0; i < maxBatchesPerRun; i++ {
+			applied, err := r.replicateBatch(ctx)
+
+			if errors.Is(err, repository.ErrReplicaPromoted) {
+				r.l.Debug().Msg("the instance has been promoted, not replicating")
+				return
+			}
+
+			if err != nil {
+				r.l.Err(err).Msg("could not replicate the primary's log")
+				return
+			}
+
+			if applied < r.batchSize {
+				return
+			}
+		}
+	}
+}
+
+// replicateBatch applies the next batch of the primary's log, and returns the number of entries which
+// were read from the log.
+func (r *ReplicatorImpl) replicateBatch(ctx context.Context) (int, error) {
+	state, err := r.repo.Replication().GetReplicationState(ctx)
+
+	if err != nil {
+		return 0, fmt.Errorf("could not get replication state: %w", err)
+	}
+
+	var lastEntryId int64
+
+	if state != nil {
+		if state.Role == dbsqlc.ReplicationRolePRIMARY {
+			return 0, repository.ErrReplicaPromoted
+		}
+
+		lastEntryId = state.LastEntryId
+	}
+
+	entries, err := r.primary.ListReplicationLogEntries(ctx, lastEntryId, r.batchSize)
+
+	if err != nil {
+		return 0, fmt.Errorf("could not list replication log entries: %w", err)
+	}
+
+	contiguous, nextEntryId := contiguousEntries(lastEntryId, entries, r.gapTimeout)
+
+	if nextEntryId == lastEntryId {
+		return 0, nil
+	}
+
+	_, err = r.repo.Replication().ApplyReplicationLogEntries(ctx, nextEntryId, contiguous)
+
+	if err != nil {
+		return 0, err
+	}
+
+	// the entries are only needed by this standby, so they are pruned once they are applied
+	if _, err := r.primary.DeleteReplicationLogEntries(ctx, nextEntryId); err != nil {
+		r.l.Warn().Err(err).Msg("could not prune applied replication log entries")
+	}
+
+	return len(contiguous), nil
+}
+
+// contiguousEntries returns the entries which follow the last applied entry without a gap, and the id of the
+// last of them. An id is missing while the transaction which wrote it hasn't committed, so entries after a
+// gap are held back until the gap is filled, or until the entry after it is older than the gap timeout, at
+// which point the transaction is assumed to have been rolled back.
+func contiguousEntries(lastEntryId int64, entries []*dbsqlc.ReplicationLogEntry, gapTimeout time.Duration) ([]*dbsqlc.ReplicationLogEntry, int64) {
+	res := make([]*dbsqlc.ReplicationLogEntry, 0, len(entries))
+
+	for _, entry := range entries {
+		if entry.ID != lastEntryId+1 && time.Since(entry.CreatedAt.Time) < gapTimeout {
+			break
+		}
+
+		res = append(res, entry)
+		lastEntryId = entry.ID
+	}
+
+	return res, lastEntryId
+}
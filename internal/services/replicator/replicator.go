@@ -0,0 +1,170 @@
+package replicator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-co-op/gocron/v2"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/logger"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/leader"
+)
+
+type Replicator interface {
+	Start() (func() error, error)
+}
+
+type ReplicatorImpl struct {
+	l       *zerolog.Logger
+	repo    repository.Repository
+	primary repository.ReplicationRepository
+	s       gocron.Scheduler
+
+	interval   time.Duration
+	batchSize  int
+	gapTimeout time.Duration
+
+	// elector makes sure that the log is only applied by one replica, so that entries are applied in order
+	elector *leader.Elector
+}
+
+type ReplicatorOpt func(*ReplicatorOpts)
+
+type ReplicatorOpts struct {
+	l          *zerolog.Logger
+	repo       repository.Repository
+	primary    repository.ReplicationRepository
+	interval   time.Duration
+	batchSize  int
+	gapTimeout time.Duration
+}
+
+func defaultReplicatorOpts() *ReplicatorOpts {
+	logger := logger.NewDefaultLogger("replicator")
+	return &ReplicatorOpts{
+		l:          &logger,
+		interval:   time.Second,
+		batchSize:  500,
+		gapTimeout: time.Minute,
+	}
+}
+
+// WithRepository sets the repository of the standby, which the log is applied to.
+func WithRepository(r repository.Repository) ReplicatorOpt {
+	return func(opts *ReplicatorOpts) {
+		opts.repo = r
+	}
+}
+
+// WithPrimaryRepository sets the repository which reads the replication log of the primary.
+func WithPrimaryRepository(r repository.ReplicationRepository) ReplicatorOpt {
+	return func(opts *ReplicatorOpts) {
+		opts.primary = r
+	}
+}
+
+func WithLogger(l *zerolog.Logger) ReplicatorOpt {
+	return func(opts *ReplicatorOpts) {
+		opts.l = l
+	}
+}
+
+func WithInterval(interval time.Duration) ReplicatorOpt {
+	return func(opts *ReplicatorOpts) {
+		opts.interval = interval
+	}
+}
+
+func WithBatchSize(batchSize int) ReplicatorOpt {
+	return func(opts *ReplicatorOpts) {
+		opts.batchSize = batchSize
+	}
+}
+
+func WithGapTimeout(gapTimeout time.Duration) ReplicatorOpt {
+	return func(opts *ReplicatorOpts) {
+		opts.gapTimeout = gapTimeout
+	}
+}
+
+func New(fs ...ReplicatorOpt) (*ReplicatorImpl, error) {
+	opts := defaultReplicatorOpts()
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	if opts.repo == nil {
+		return nil, fmt.Errorf("repository is required. use WithRepository")
+	}
+
+	if opts.primary == nil {
+		return nil, fmt.Errorf("primary repository is required. use WithPrimaryRepository")
+	}
+
+	if err := leader.ValidateInterval(opts.interval); err != nil {
+		return nil, fmt.Errorf("invalid replication interval: %w", err)
+	}
+
+	if opts.batchSize <= 0 {
+		return nil, fmt.Errorf("replication batch size must be positive")
+	}
+
+	newLogger := opts.l.With().Str("service", "replicator").Logger()
+	opts.l = &newLogger
+
+	elector := leader.NewElector(opts.repo.LeaderLease(), opts.l, "replicator")
+
+	s, err := gocron.NewScheduler(gocron.WithLocation(time.UTC), gocron.WithDistributedElector(elector))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create scheduler: %w", err)
+	}
+
+	return &ReplicatorImpl{
+		l:          opts.l,
+		repo:       opts.repo,
+		primary:    opts.primary,
+		s:          s,
+		interval:   opts.interval,
+		batchSize:  opts.batchSize,
+		gapTimeout: opts.gapTimeout,
+
+		elector: elector,
+	}, nil
+}
+
+func (r *ReplicatorImpl) Start() (func() error, error) {
+	r.l.Debug().Msg("starting replicator")
+
+	_, err := r.s.NewJob(
+		gocron.DurationJob(r.interval),
+		gocron.NewTask(
+			r.replicate(),
+		),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not schedule replication: %w", err)
+	}
+
+	r.s.Start()
+
+	cleanup := func() error {
+		r.l.Debug().Msg("stopping replicator")
+		if err := r.s.Shutdown(); err != nil {
+			return fmt.Errorf("could not shutdown scheduler: %w", err)
+		}
+		if err := r.elector.Release(context.Background()); err != nil {
+			return fmt.Errorf("could not release leader lease: %w", err)
+		}
+		r.l.Debug().Msg("replicator has shutdown")
+		return nil
+	}
+
+	return cleanup, nil
+}
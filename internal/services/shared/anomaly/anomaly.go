@@ -0,0 +1,178 @@
+// Package anomaly learns the normal failure rate and duration of the runs of each workflow, and detects the windows
+// of runs which deviate from them, so that regressions surface without thresholds which are tuned per workflow.
+package anomaly
+
+import (
+	"math"
+	"time"
+)
+
+// Kind is the metric which deviated from its baseline.
+type Kind string
+
+const (
+	// KindFailureRate is set when the share of the runs in a window which failed is above the baseline.
+	KindFailureRate Kind = "FAILURE_RATE"
+
+	// KindDuration is set when the mean duration of the runs in a window is above the baseline.
+	KindDuration Kind = "DURATION"
+)
+
+// Baseline is the normal failure rate and duration of the runs of a workflow, which is learned as an exponentially
+// weighted mean and variance over the windows of its runs.
+type Baseline struct {
+	// Windows is the number of windows which the baseline was learned from
+	Windows int
+
+	FailureRateMean     float64
+	FailureRateVariance float64
+
+	// DurationMean is the mean duration of the runs in seconds
+	DurationMean     float64
+	DurationVariance float64
+}
+
+// Window is the runs of a workflow which finished in a window.
+type Window struct {
+	// Finished is the number of runs which succeeded or failed
+	Finished int64
+
+	Failed int64
+
+	// Duration is the mean duration of the finished runs in seconds
+	Duration float64
+}
+
+func (w *Window) FailureRate() float64 {
+	if w.Finished == 0 {
+		return 0
+	}
+
+	return float64(w.Failed) / float64(w.Finished)
+}
+
+// Anomaly is a metric of a window which deviated from the baseline of the workflow.
+type Anomaly struct {
+	Kind Kind
+
+	// Value is the value of the metric in the window
+	Value float64
+
+	// Baseline is the mean of the metric in the baseline
+	Baseline float64
+
+	// Deviation is the number of standard deviations which the value is above the baseline
+	Deviation float64
+}
+
+type Opts struct {
+	// Threshold is the number of standard deviations above the baseline at which a window is anomalous
+	Threshold float64
+
+	// MinWindows is the number of windows which a baseline is learned from before windows are checked against it
+	MinWindows int
+
+	// MinRuns is the minimum number of finished runs in a window for the window to be checked
+	MinRuns int64
+
+	// Weight is the weight of a new window in the baseline, between 0 and 1
+	Weight float64
+}
+
+// LearningPeriod is how long the baseline of a workflow is learned before windows are checked against it.
+const LearningPeriod = 24 * time.Hour
+
+// DefaultOpts returns the options for windows of the given length. Windows are checked once the baseline has
+// been learned for the learning period, against a baseline which is mostly learned from the last 20 windows.
+func DefaultOpts(window time.Duration) *Opts {
+	minWindows := int(math.Ceil(float64(LearningPeriod) / float64(window)))
+
+	if minWindows < 1 {
+		minWindows = 1
+	}
+
+	return &Opts{
+		Threshold:  3,
+		MinWindows: minWindows,
+		MinRuns:    10,
+		Weight:     0.1,
+	}
+}
+
+const (
+	// minFailureRateIncrease is the smallest increase of the failure rate which is anomalous, so that a workflow
+	// which never fails isn't anomalous after a single failure
+	minFailureRateIncrease = 0.05
+
+	// minDurationDeviation is the smallest standard deviation of the duration, relative to its mean, so that
+	// workflows with a steady duration aren't anomalous after small changes
+	minDurationDeviation = 0.1
+)
+
+// Analyze checks a window against a baseline, and returns the baseline which includes the window. The baseline may
+// be nil if the workflow has none yet. Windows with too few runs are not checked, but are still learned from, so
+// that the baseline of a workflow which runs rarely is built up over time.
+func Analyze(baseline *Baseline, window *Window, opts *Opts) (*Baseline, []Anomaly) {
+	if window.Finished == 0 {
+		return baseline, nil
+	}
+
+	failureRate := window.FailureRate()
+
+	if baseline == nil || baseline.Windows == 0 {
+		return &Baseline{
+			Windows:         1,
+			FailureRateMean: failureRate,
+			DurationMean:    window.Duration,
+		}, nil
+	}
+
+	anomalies := []Anomaly{}
+
+	if baseline.Windows >= opts.MinWindows && window.Finished >= opts.MinRuns {
+		// the deviation of the failure rate is at least the sampling error of a window with this many runs, so
+		// that small windows of a workflow with a steady failure rate aren't anomalous
+		p := math.Max(baseline.FailureRateMean, 1/float64(window.Finished))
+		stddev := math.Max(math.Sqrt(baseline.FailureRateVariance), math.Sqrt(p*(1-p)/float64(window.Finished)))
+
+		if increase := failureRate - baseline.FailureRateMean; increase >= minFailureRateIncrease && stddev > 0 {
+			if deviation := increase / stddev; deviation >= opts.Threshold {
+				anomalies = append(anomalies, Anomaly{
+					Kind:      KindFailureRate,
+					Value:     failureRate,
+					Baseline:  baseline.FailureRateMean,
+					Deviation: deviation,
+				})
+			}
+		}
+
+		stddev = math.Max(math.Sqrt(baseline.DurationVariance), baseline.DurationMean*minDurationDeviation)
+
+		if increase := window.Duration - baseline.DurationMean; increase > 0 && stddev > 0 {
+			if deviation := increase / stddev; deviation >= opts.Threshold {
+				anomalies = append(anomalies, Anomaly{
+					Kind:      KindDuration,
+					Value:     window.Duration,
+					Baseline:  baseline.DurationMean,
+					Deviation: deviation,
+				})
+			}
+		}
+	}
+
+	next := &Baseline{
+		Windows: baseline.Windows + 1,
+	}
+
+	next.FailureRateMean, next.FailureRateVariance = update(baseline.FailureRateMean, baseline.FailureRateVariance, failureRate, opts.Weight)
+	next.DurationMean, next.DurationVariance = update(baseline.DurationMean, baseline.DurationVariance, window.Duration, opts.Weight)
+
+	return next, anomalies
+}
+
+// update adds a value to an exponentially weighted mean and variance.
+func update(mean, variance, value, weight float64) (float64, float64) {
+	diff := value - mean
+
+	return mean + weight*diff, (1 - weight) * (variance + weight*diff*diff)
+}
@@ -0,0 +1,76 @@
+package anomaly
+
+import (
+	"testing"
+	"time"
+)
+
+func learn(windows int, window *Window, opts *Opts) *Baseline {
+	var baseline *Baseline
+
+	for i := 0; i < windows; i++ {
+		baseline, _ = Analyze(baseline, window, opts)
+	}
+
+	return baseline
+}
+
+func TestAnalyzeFailureRate(t *testing.T) {
+	opts := DefaultOpts(15 * time.Minute)
+
+	baseline := learn(opts.MinWindows, &Window{Finished: 100, Failed: 2, Duration: 10}, opts)
+
+	if _, anomalies := Analyze(baseline, &Window{Finished: 100, Failed: 3, Duration: 10}, opts); len(anomalies) != 0 {
+		t.Errorf("expected no anomalies for a steady failure rate, got %v", anomalies)
+	}
+
+	_, anomalies := Analyze(baseline, &Window{Finished: 100, Failed: 30, Duration: 10}, opts)
+
+	if len(anomalies) != 1 || anomalies[0].Kind != KindFailureRate {
+		t.Fatalf("expected a failure rate anomaly, got %v", anomalies)
+	}
+
+	if anomalies[0].Value != 0.3 {
+		t.Errorf("expected a failure rate of 0.3, got %f", anomalies[0].Value)
+	}
+}
+
+func TestAnalyzeDuration(t *testing.T) {
+	opts := DefaultOpts(15 * time.Minute)
+
+	baseline := learn(opts.MinWindows, &Window{Finished: 50, Duration: 20}, opts)
+
+	if _, anomalies := Analyze(baseline, &Window{Finished: 50, Duration: 24}, opts); len(anomalies) != 0 {
+		t.Errorf("expected no anomalies for a small change of the duration, got %v", anomalies)
+	}
+
+	if _, anomalies := Analyze(baseline, &Window{Finished: 50, Duration: 10}, opts); len(anomalies) != 0 {
+		t.Errorf("expected no anomalies for faster runs, got %v", anomalies)
+	}
+
+	_, anomalies := Analyze(baseline, &Window{Finished: 50, Duration: 60}, opts)
+
+	if len(anomalies) != 1 || anomalies[0].Kind != KindDuration {
+		t.Errorf("expected a duration anomaly, got %v", anomalies)
+	}
+}
+
+func TestAnalyzeLearning(t *testing.T) {
+	opts := DefaultOpts(15 * time.Minute)
+
+	baseline := learn(opts.MinWindows-1, &Window{Finished: 100, Duration: 5}, opts)
+
+	if _, anomalies := Analyze(baseline, &Window{Finished: 100, Failed: 100, Duration: 500}, opts); len(anomalies) != 0 {
+		t.Errorf("expected no anomalies while the baseline is learned, got %v", anomalies)
+	}
+
+	baseline = learn(opts.MinWindows, &Window{Finished: 100, Duration: 5}, opts)
+
+	if _, anomalies := Analyze(baseline, &Window{Finished: opts.MinRuns - 1, Failed: opts.MinRuns - 1, Duration: 500}, opts); len(anomalies) != 0 {
+		t.Errorf("expected no anomalies for a window with too few runs, got %v", anomalies)
+	}
+
+	if next, _ := Analyze(baseline, &Window{}, opts); next != baseline {
+		t.Errorf("expected an empty window to leave the baseline unchanged")
+	}
+}
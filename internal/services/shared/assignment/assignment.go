@@ -0,0 +1,148 @@
+// Package assignment selects the worker which a step run is assigned to. The strategy which is used for a step run
+// is set on its workflow version, or on its tenant if the workflow version doesn't set one.
+package assignment
+
+import (
+	"math/rand"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+// Strategy selects a worker from the workers which can run a step run. Candidates is never empty.
+type Strategy interface {
+	Select(stepRun *repository.StepRunAssignment, candidates []*repository.WorkerCandidate) *repository.WorkerCandidate
+}
+
+// StrategyFunc is a function which implements Strategy.
+type StrategyFunc func(stepRun *repository.StepRunAssignment, candidates []*repository.WorkerCandidate) *repository.WorkerCandidate
+
+func (f StrategyFunc) Select(stepRun *repository.StepRunAssignment, candidates []*repository.WorkerCandidate) *repository.WorkerCandidate {
+	return f(stepRun, candidates)
+}
+
+// Random selects a random worker, which is how step runs were assigned before strategies were added.
+var Random Strategy = StrategyFunc(func(_ *repository.StepRunAssignment, candidates []*repository.WorkerCandidate) *repository.WorkerCandidate {
+	return candidates[rand.Intn(len(candidates))] // nolint: gosec
+})
+
+// LeastLoaded selects the worker with the fewest assigned and running step runs.
+var LeastLoaded Strategy = StrategyFunc(func(_ *repository.StepRunAssignment, candidates []*repository.WorkerCandidate) *repository.WorkerCandidate {
+	return leastLoaded(candidates)
+})
+
+// RoundRobin selects the worker which was assigned a step run the longest time ago, preferring workers which
+// haven't been assigned one yet.
+var RoundRobin Strategy = StrategyFunc(func(_ *repository.StepRunAssignment, candidates []*repository.WorkerCandidate) *repository.WorkerCandidate {
+	var selected *repository.WorkerCandidate
+
+	for _, candidate := range candidates {
+		if candidate.LastAssignedAt == nil {
+			return candidate
+		}
+
+		if selected == nil || candidate.LastAssignedAt.Before(*selected.LastAssignedAt) {
+			selected = candidate
+		}
+	}
+
+	return selected
+})
+
+// Locality selects the worker whose labels match the most labels of the workers which ran the parents of the step
+// run, so that for example a step runs in the same region or on the same host as the steps it depends on. Ties are
+// broken by load, and step runs without parents are assigned to the least loaded worker.
+var Locality Strategy = StrategyFunc(func(stepRun *repository.StepRunAssignment, candidates []*repository.WorkerCandidate) *repository.WorkerCandidate {
+	bestScore := 0
+	best := make([]*repository.WorkerCandidate, 0, len(candidates))
+
+	for _, candidate := range candidates {
+		score := matchingLabels(candidate.Labels, stepRun.ParentWorkerLabels)
+
+		switch {
+		case score > bestScore:
+			bestScore = score
+			best = append(best[:0], candidate)
+		case score == bestScore:
+			best = append(best, candidate)
+		}
+	}
+
+	return leastLoaded(best)
+})
+
+// Selector selects workers with the strategy of each step run. It implements repository.WorkerSelector.
+type Selector struct {
+	strategies map[dbsqlc.WorkerAssignmentStrategy]Strategy
+	fallback   Strategy
+}
+
+type SelectorOpt func(*Selector)
+
+// WithStrategy sets the strategy which is used for the given name, which replaces the built-in strategy of the
+// name if there is one.
+func WithStrategy(name dbsqlc.WorkerAssignmentStrategy, strategy Strategy) SelectorOpt {
+	return func(s *Selector) {
+		s.strategies[name] = strategy
+	}
+}
+
+// NewSelector returns a selector with the built-in strategies. Step runs with a strategy which the selector doesn't
+// know are assigned to a random worker.
+func NewSelector(fs ...SelectorOpt) *Selector {
+	s := &Selector{
+		strategies: map[dbsqlc.WorkerAssignmentStrategy]Strategy{
+			dbsqlc.WorkerAssignmentStrategyRANDOM:      Random,
+			dbsqlc.WorkerAssignmentStrategyLEASTLOADED: LeastLoaded,
+			dbsqlc.WorkerAssignmentStrategyROUNDROBIN:  RoundRobin,
+			dbsqlc.WorkerAssignmentStrategyLOCALITY:    Locality,
+		},
+		fallback: Random,
+	}
+
+	for _, f := range fs {
+		f(s)
+	}
+
+	return s
+}
+
+func (s *Selector) SelectWorker(stepRun *repository.StepRunAssignment, candidates []*repository.WorkerCandidate) *repository.WorkerCandidate {
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	strategy, ok := s.strategies[stepRun.Strategy]
+
+	if !ok {
+		strategy = s.fallback
+	}
+
+	return strategy.Select(stepRun, candidates)
+}
+
+func leastLoaded(candidates []*repository.WorkerCandidate) *repository.WorkerCandidate {
+	var selected *repository.WorkerCandidate
+
+	for _, candidate := range candidates {
+		if selected == nil || candidate.Load < selected.Load {
+			selected = candidate
+		}
+	}
+
+	return selected
+}
+
+func matchingLabels(labels map[string]string, parentLabels []map[string]string) int {
+	matching := 0
+
+	for _, parent := range parentLabels {
+		for key, value := range parent {
+			if v, ok := labels[key]; ok && v == value {
+				matching++
+			}
+		}
+	}
+
+	return matching
+}
@@ -0,0 +1,118 @@
+package assignment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+func TestSelectWorker(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Minute)
+
+	candidates := []*repository.WorkerCandidate{
+		{WorkerId: "busy", Load: 5, LastAssignedAt: &earlier, Labels: map[string]string{"region": "eu", "host": "a"}},
+		{WorkerId: "idle", Load: 0, LastAssignedAt: &now, Labels: map[string]string{"region": "us"}},
+		{WorkerId: "eu", Load: 2, LastAssignedAt: &now, Labels: map[string]string{"region": "eu"}},
+	}
+
+	tests := []struct {
+		name         string
+		strategy     dbsqlc.WorkerAssignmentStrategy
+		parentLabels []map[string]string
+		candidates   []*repository.WorkerCandidate
+		expected     string
+	}{
+		{
+			name:       "least loaded",
+			strategy:   dbsqlc.WorkerAssignmentStrategyLEASTLOADED,
+			candidates: candidates,
+			expected:   "idle",
+		},
+		{
+			name:       "round robin",
+			strategy:   dbsqlc.WorkerAssignmentStrategyROUNDROBIN,
+			candidates: candidates,
+			expected:   "busy",
+		},
+		{
+			name:     "round robin prefers unassigned workers",
+			strategy: dbsqlc.WorkerAssignmentStrategyROUNDROBIN,
+			candidates: append([]*repository.WorkerCandidate{
+				{WorkerId: "new"},
+			}, candidates...),
+			expected: "new",
+		},
+		{
+			name:         "locality",
+			strategy:     dbsqlc.WorkerAssignmentStrategyLOCALITY,
+			parentLabels: []map[string]string{{"region": "eu", "host": "a"}},
+			candidates:   candidates,
+			expected:     "busy",
+		},
+		{
+			name:         "locality breaks ties by load",
+			strategy:     dbsqlc.WorkerAssignmentStrategyLOCALITY,
+			parentLabels: []map[string]string{{"region": "eu"}},
+			candidates:   candidates,
+			expected:     "eu",
+		},
+		{
+			name:       "locality without parents",
+			strategy:   dbsqlc.WorkerAssignmentStrategyLOCALITY,
+			candidates: candidates,
+			expected:   "idle",
+		},
+		{
+			name:       "single candidate",
+			strategy:   dbsqlc.WorkerAssignmentStrategyRANDOM,
+			candidates: candidates[:1],
+			expected:   "busy",
+		},
+	}
+
+	selector := NewSelector()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected := selector.SelectWorker(&repository.StepRunAssignment{
+				Strategy:           tt.strategy,
+				ParentWorkerLabels: tt.parentLabels,
+			}, tt.candidates)
+
+			if selected == nil {
+				t.Fatalf("expected %s, got no worker", tt.expected)
+			}
+
+			if selected.WorkerId != tt.expected {
+				t.Fatalf("expected %s, got %s", tt.expected, selected.WorkerId)
+			}
+		})
+	}
+}
+
+func TestSelectWorkerNoCandidates(t *testing.T) {
+	selector := NewSelector()
+
+	if selected := selector.SelectWorker(&repository.StepRunAssignment{}, nil); selected != nil {
+		t.Fatalf("expected no worker, got %s", selected.WorkerId)
+	}
+}
+
+func TestWithStrategy(t *testing.T) {
+	last := StrategyFunc(func(_ *repository.StepRunAssignment, candidates []*repository.WorkerCandidate) *repository.WorkerCandidate {
+		return candidates[len(candidates)-1]
+	})
+
+	selector := NewSelector(WithStrategy("CUSTOM", last))
+
+	candidates := []*repository.WorkerCandidate{{WorkerId: "first"}, {WorkerId: "last"}}
+
+	selected := selector.SelectWorker(&repository.StepRunAssignment{Strategy: "CUSTOM"}, candidates)
+
+	if selected.WorkerId != "last" {
+		t.Fatalf("expected last, got %s", selected.WorkerId)
+	}
+}
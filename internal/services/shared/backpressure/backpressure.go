@@ -0,0 +1,147 @@
+// Package backpressure computes hints for slowing down the triggers of a tenant when its queue is backed up,
+// and decides when triggers should be rejected to shed load.
+package backpressure
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+)
+
+// queueDepthTTL is how long the queue depth of a tenant is cached, so that not every trigger counts the queue.
+const queueDepthTTL = 5 * time.Second
+
+// maxRetryAfter caps the suggested retry-after of a hint.
+const maxRetryAfter = time.Minute
+
+// Hint is returned when the queue of a tenant is backed up.
+type Hint struct {
+	// QueueDepth is the number of workflow runs and step runs of the tenant which are waiting to be processed
+	QueueDepth int64
+
+	// RetryAfter is the suggested duration to wait before the next trigger
+	RetryAfter time.Duration
+
+	// Shedding is whether triggers which are not a priority are rejected
+	Shedding bool
+}
+
+// RetryAfterSeconds returns the suggested retry-after rounded up to whole seconds.
+func (h *Hint) RetryAfterSeconds() int64 {
+	return int64((h.RetryAfter + time.Second - 1) / time.Second)
+}
+
+// Reject returns whether a trigger should be rejected. Priority triggers are never rejected.
+func (h *Hint) Reject(priority bool) bool {
+	return h != nil && h.Shedding && !priority
+}
+
+type Checker interface {
+	// Check returns a hint if the queue of the tenant is backed up, or nil otherwise.
+	Check(tenantId string) (*Hint, error)
+
+	// SetOpts replaces the thresholds of the checker while it is in use.
+	SetOpts(opts *CheckerOpts)
+}
+
+type CheckerOpts struct {
+	// WarnQueueDepth is the queue depth above which hints are returned. If 0, hints are only returned
+	// when shedding.
+	WarnQueueDepth int64
+
+	// ShedQueueDepth is the queue depth above which triggers which are not a priority are rejected. If 0,
+	// triggers are never rejected.
+	ShedQueueDepth int64
+
+	// RetryAfter is the suggested retry-after at the lowest threshold. It grows with the queue depth.
+	RetryAfter time.Duration
+}
+
+type checker struct {
+	repo repository.TenantRepository
+	opts atomic.Pointer[CheckerOpts]
+
+	queueDepths *expirable.LRU[string, int64]
+}
+
+func NewChecker(repo repository.TenantRepository, opts *CheckerOpts) Checker {
+	c := &checker{
+		repo:        repo,
+		queueDepths: expirable.NewLRU[string, int64](10000, nil, queueDepthTTL),
+	}
+
+	c.SetOpts(opts)
+
+	return c
+}
+
+func (c *checker) SetOpts(opts *CheckerOpts) {
+	c.opts.Store(opts)
+}
+
+func (c *checker) Check(tenantId string) (*Hint, error) {
+	opts := c.opts.Load()
+	threshold := opts.threshold()
+
+	if threshold == 0 {
+		return nil, nil
+	}
+
+	depth, err := c.getQueueDepth(tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if depth < threshold {
+		return nil, nil
+	}
+
+	// the retry-after grows linearly with the number of times the lowest threshold is exceeded
+	retryAfter := opts.RetryAfter * time.Duration(depth/threshold)
+
+	if retryAfter > maxRetryAfter {
+		retryAfter = maxRetryAfter
+	}
+
+	return &Hint{
+		QueueDepth: depth,
+		RetryAfter: retryAfter,
+		Shedding:   opts.ShedQueueDepth > 0 && depth >= opts.ShedQueueDepth,
+	}, nil
+}
+
+// threshold returns the lowest queue depth at which a hint is returned, or 0 if hints are disabled.
+func (opts *CheckerOpts) threshold() int64 {
+	if opts.WarnQueueDepth > 0 && (opts.ShedQueueDepth <= 0 || opts.WarnQueueDepth < opts.ShedQueueDepth) {
+		return opts.WarnQueueDepth
+	}
+
+	if opts.ShedQueueDepth > 0 {
+		return opts.ShedQueueDepth
+	}
+
+	return 0
+}
+
+func (c *checker) getQueueDepth(tenantId string) (int64, error) {
+	if depth, ok := c.queueDepths.Get(tenantId); ok {
+		return depth, nil
+	}
+
+	row, err := c.repo.GetTenantQueueDepth(tenantId)
+
+	if err != nil {
+		return 0, fmt.Errorf("could not get queue depth: %w", err)
+	}
+
+	depth := row.QueuedRuns + row.QueuedStepRuns
+
+	c.queueDepths.Add(tenantId, depth)
+
+	return depth, nil
+}
@@ -0,0 +1,125 @@
+package backpressure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+type fakeTenantRepository struct {
+	repository.TenantRepository
+
+	depth int64
+}
+
+func (r *fakeTenantRepository) GetTenantQueueDepth(tenantId string) (*dbsqlc.GetTenantQueueDepthRow, error) {
+	return &dbsqlc.GetTenantQueueDepthRow{
+		QueuedRuns:     r.depth / 2,
+		QueuedStepRuns: r.depth - r.depth/2,
+	}, nil
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     CheckerOpts
+		depth    int64
+		expected *Hint
+	}{
+		{
+			name:  "disabled",
+			opts:  CheckerOpts{RetryAfter: time.Second},
+			depth: 1000000,
+		},
+		{
+			name:  "below warn",
+			opts:  CheckerOpts{WarnQueueDepth: 100, ShedQueueDepth: 1000, RetryAfter: time.Second},
+			depth: 99,
+		},
+		{
+			name:     "above warn",
+			opts:     CheckerOpts{WarnQueueDepth: 100, ShedQueueDepth: 1000, RetryAfter: time.Second},
+			depth:    250,
+			expected: &Hint{QueueDepth: 250, RetryAfter: 2 * time.Second},
+		},
+		{
+			name:     "above shed",
+			opts:     CheckerOpts{WarnQueueDepth: 100, ShedQueueDepth: 1000, RetryAfter: time.Second},
+			depth:    1000,
+			expected: &Hint{QueueDepth: 1000, RetryAfter: 10 * time.Second, Shedding: true},
+		},
+		{
+			name:     "only shed",
+			opts:     CheckerOpts{ShedQueueDepth: 1000, RetryAfter: time.Second},
+			depth:    1500,
+			expected: &Hint{QueueDepth: 1500, RetryAfter: time.Second, Shedding: true},
+		},
+		{
+			name:     "retry after is capped",
+			opts:     CheckerOpts{WarnQueueDepth: 10, RetryAfter: 5 * time.Second},
+			depth:    10000,
+			expected: &Hint{QueueDepth: 10000, RetryAfter: maxRetryAfter},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tt.opts
+			c := NewChecker(&fakeTenantRepository{depth: tt.depth}, &opts)
+
+			hint, err := c.Check("tenant")
+
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+
+			if tt.expected == nil {
+				if hint != nil {
+					t.Fatalf("Check() = %+v, want nil", hint)
+				}
+
+				return
+			}
+
+			if hint == nil || *hint != *tt.expected {
+				t.Fatalf("Check() = %+v, want %+v", hint, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSetOpts(t *testing.T) {
+	c := NewChecker(&fakeTenantRepository{depth: 500}, &CheckerOpts{WarnQueueDepth: 1000, RetryAfter: time.Second})
+
+	if hint, _ := c.Check("tenant"); hint != nil {
+		t.Fatalf("Check() = %+v, want nil", hint)
+	}
+
+	c.SetOpts(&CheckerOpts{WarnQueueDepth: 100, RetryAfter: time.Second})
+
+	hint, _ := c.Check("tenant")
+
+	if hint == nil || hint.RetryAfter != 5*time.Second {
+		t.Fatalf("Check() = %+v, want a retry-after of 5s", hint)
+	}
+}
+
+func TestReject(t *testing.T) {
+	var nilHint *Hint
+
+	if nilHint.Reject(false) {
+		t.Errorf("a nil hint should not reject")
+	}
+
+	hint := &Hint{Shedding: true}
+
+	if !hint.Reject(false) {
+		t.Errorf("a non-priority trigger should be rejected when shedding")
+	}
+
+	if hint.Reject(true) {
+		t.Errorf("a priority trigger should not be rejected when shedding")
+	}
+}
@@ -0,0 +1,56 @@
+// Package budget checks the usage of workflow runs against the budget of their workflow versions, which limits the
+// number of step runs a run may queue and retry, to protect against runaway fan-out or retry loops.
+package budget
+
+import (
+	"fmt"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+// CancelledReason is the reason step runs are cancelled with when their workflow run exceeds its budget.
+const CancelledReason = "BUDGET_EXCEEDED"
+
+// Usage is the usage of a workflow run, along with the budget of its workflow version. A limit of 0 is unlimited.
+type Usage struct {
+	StepExecutions int
+	StepRetries    int
+
+	MaxStepExecutions int
+	MaxStepRetries    int
+}
+
+// FromRow returns the usage of a workflow run after it was added to. A nil row is a run without a budget.
+func FromRow(row *dbsqlc.AddWorkflowRunBudgetUsageRow) Usage {
+	if row == nil {
+		return Usage{}
+	}
+
+	u := Usage{
+		StepExecutions: int(row.StepExecutions),
+		StepRetries:    int(row.StepRetries),
+	}
+
+	if row.MaxStepExecutions.Valid {
+		u.MaxStepExecutions = int(row.MaxStepExecutions.Int32)
+	}
+
+	if row.MaxStepRetries.Valid {
+		u.MaxStepRetries = int(row.MaxStepRetries.Int32)
+	}
+
+	return u
+}
+
+// Exceeded returns an error describing the limit which the usage exceeds, or nil if the usage is within the budget.
+func (u Usage) Exceeded() error {
+	if u.MaxStepExecutions > 0 && u.StepExecutions > u.MaxStepExecutions {
+		return fmt.Errorf("workflow run exceeded its budget of %d step executions", u.MaxStepExecutions)
+	}
+
+	if u.MaxStepRetries > 0 && u.StepRetries > u.MaxStepRetries {
+		return fmt.Errorf("workflow run exceeded its budget of %d step retries", u.MaxStepRetries)
+	}
+
+	return nil
+}
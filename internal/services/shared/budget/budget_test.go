@@ -0,0 +1,52 @@
+package budget
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+func TestExceeded(t *testing.T) {
+	tests := []struct {
+		name     string
+		usage    Usage
+		exceeded bool
+	}{
+		{name: "no budget", usage: Usage{StepExecutions: 1000, StepRetries: 1000}},
+		{name: "within executions", usage: Usage{StepExecutions: 10, MaxStepExecutions: 10}},
+		{name: "exceeds executions", usage: Usage{StepExecutions: 11, MaxStepExecutions: 10}, exceeded: true},
+		{name: "within retries", usage: Usage{StepRetries: 3, MaxStepRetries: 3}},
+		{name: "exceeds retries", usage: Usage{StepRetries: 4, MaxStepRetries: 3}, exceeded: true},
+		{name: "exceeds retries without execution limit", usage: Usage{StepExecutions: 50, StepRetries: 4, MaxStepRetries: 3}, exceeded: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.usage.Exceeded()
+
+			if (err != nil) != tt.exceeded {
+				t.Fatalf("expected exceeded to be %v, got %v", tt.exceeded, err)
+			}
+		})
+	}
+}
+
+func TestFromRow(t *testing.T) {
+	if u := FromRow(nil); u != (Usage{}) {
+		t.Fatalf("expected a run without a budget to have no usage, got %+v", u)
+	}
+
+	u := FromRow(&dbsqlc.AddWorkflowRunBudgetUsageRow{
+		StepExecutions:    5,
+		StepRetries:       2,
+		MaxStepExecutions: pgtype.Int4{Int32: 4, Valid: true},
+	})
+
+	expected := Usage{StepExecutions: 5, StepRetries: 2, MaxStepExecutions: 4}
+
+	if u != expected {
+		t.Fatalf("expected %+v, got %+v", expected, u)
+	}
+}
@@ -0,0 +1,85 @@
+package concurrency
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MaxKeyExpressions is the maximum number of expressions of a composite key.
+const MaxKeyExpressions = 4
+
+// CompositeKey computes the concurrency group key of a run from several key expressions, like input.customer_id
+// and additional_metadata.region. Each expression computes one component of the key.
+type CompositeKey struct {
+	exprs []*KeyExpression
+}
+
+// CompileCompositeKey parses the expressions of a composite key, in the order which their components are combined.
+func CompileCompositeKey(exprs []string) (*CompositeKey, error) {
+	if len(exprs) == 0 {
+		return nil, fmt.Errorf("at least one key expression is required")
+	}
+
+	if len(exprs) > MaxKeyExpressions {
+		return nil, fmt.Errorf("at most %d key expressions are allowed", MaxKeyExpressions)
+	}
+
+	res := &CompositeKey{
+		exprs: make([]*KeyExpression, len(exprs)),
+	}
+
+	for i, expr := range exprs {
+		k, err := CompileKey(expr)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid key expression %d: %w", i+1, err)
+		}
+
+		res.exprs[i] = k
+	}
+
+	return res, nil
+}
+
+// Expressions returns the expressions of the key as they were compiled.
+func (k *CompositeKey) Expressions() []string {
+	res := make([]string, len(k.exprs))
+
+	for i, expr := range k.exprs {
+		res[i] = expr.String()
+	}
+
+	return res
+}
+
+// Eval returns the group key of a run from its input and additional metadata, along with the components of the
+// key in the order of the expressions. It returns an error if any of the expressions can't be evaluated.
+func (k *CompositeKey) Eval(input, additionalMetadata []byte) (string, []string, error) {
+	components := make([]string, len(k.exprs))
+
+	for i, expr := range k.exprs {
+		component, err := expr.Eval(input, additionalMetadata)
+
+		if err != nil {
+			return "", nil, err
+		}
+
+		components[i] = component
+	}
+
+	return JoinKey(components), components, nil
+}
+
+// JoinKey combines the components of a composite key into a group key. A single component is the key itself, so
+// that a composite key with one expression computes the same keys as the expression. Several components are
+// encoded as a JSON array, so that components which contain a separator can't produce the key of other components.
+func JoinKey(components []string) string {
+	if len(components) == 1 {
+		return components[0]
+	}
+
+	// marshaling a list of strings can't fail
+	res, _ := json.Marshal(components)
+
+	return string(res)
+}
@@ -0,0 +1,186 @@
+// Package concurrency computes the concurrency group keys of runs from key expressions, and simulates how a
+// concurrency limit would have treated the past runs of a workflow, so that a limit can be tuned before it is
+// applied.
+package concurrency
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// KeyExpression is a compiled expression which computes the concurrency group key of a run from its input and
+// additional metadata.
+//
+// An expression is a path into input or additional_metadata, built from field access (input.customer.id or
+// input["customer id"]) and list indexes (input.items[0]). The value of the path must be a string, a number or a
+// bool.
+type KeyExpression struct {
+	expr string
+	root string
+
+	// keys are string keys of objects and int indexes of lists
+	keys []interface{}
+}
+
+const (
+	rootInput              = "input"
+	rootAdditionalMetadata = "additional_metadata"
+)
+
+// CompileKey parses a key expression.
+func CompileKey(expr string) (*KeyExpression, error) {
+	s := strings.TrimSpace(expr)
+
+	if s == "" {
+		return nil, fmt.Errorf("key expression is empty")
+	}
+
+	root := identPrefix(s)
+
+	if root != rootInput && root != rootAdditionalMetadata {
+		return nil, fmt.Errorf("key expression must start with %s or %s", rootInput, rootAdditionalMetadata)
+	}
+
+	res := &KeyExpression{
+		expr: s,
+		root: root,
+	}
+
+	pos := len(root)
+
+	for pos < len(s) {
+		switch s[pos] {
+		case '.':
+			field := identPrefix(s[pos+1:])
+
+			if field == "" {
+				return nil, fmt.Errorf("expected a field name at position %d", pos+1)
+			}
+
+			res.keys = append(res.keys, field)
+			pos += 1 + len(field)
+		case '[':
+			// the key ends at the first ] after the closing quote of a string key, which may contain a ]
+			end := pos + 1
+
+			if end < len(s) && s[end] == '"' {
+				for end++; end < len(s) && s[end] != '"'; end++ {
+					if s[end] == '\\' {
+						end++
+					}
+				}
+			}
+
+			if end >= len(s) {
+				return nil, fmt.Errorf("unclosed [ at position %d", pos)
+			}
+
+			closing := strings.IndexByte(s[end:], ']')
+
+			if closing == -1 {
+				return nil, fmt.Errorf("unclosed [ at position %d", pos)
+			}
+
+			end += closing
+			key := s[pos+1 : end]
+
+			if strings.HasPrefix(key, `"`) {
+				unquoted, err := strconv.Unquote(key)
+
+				if err != nil {
+					return nil, fmt.Errorf("invalid string key %s at position %d", key, pos+1)
+				}
+
+				res.keys = append(res.keys, unquoted)
+			} else {
+				index, err := strconv.Atoi(key)
+
+				if err != nil || index < 0 {
+					return nil, fmt.Errorf("expected a string key or an index at position %d", pos+1)
+				}
+
+				res.keys = append(res.keys, index)
+			}
+
+			pos = end + 1
+		default:
+			return nil, fmt.Errorf("unexpected %q at position %d", s[pos], pos)
+		}
+	}
+
+	return res, nil
+}
+
+// String returns the expression as it was compiled.
+func (k *KeyExpression) String() string {
+	return k.expr
+}
+
+// Eval returns the key of a run from its input and additional metadata, which are JSON documents. It returns an
+// error if the path doesn't exist, or if its value is not a string, a number or a bool.
+func (k *KeyExpression) Eval(input, additionalMetadata []byte) (string, error) {
+	doc := input
+
+	if k.root == rootAdditionalMetadata {
+		doc = additionalMetadata
+	}
+
+	var v interface{}
+
+	if len(doc) > 0 {
+		if err := json.Unmarshal(doc, &v); err != nil {
+			return "", fmt.Errorf("%s is not valid JSON: %w", k.root, err)
+		}
+	}
+
+	for _, key := range k.keys {
+		switch key := key.(type) {
+		case string:
+			obj, ok := v.(map[string]interface{})
+
+			if !ok {
+				return "", fmt.Errorf("no such key: %s", k.expr)
+			}
+
+			if v, ok = obj[key]; !ok {
+				return "", fmt.Errorf("no such key: %s", k.expr)
+			}
+		case int:
+			arr, ok := v.([]interface{})
+
+			if !ok || key >= len(arr) {
+				return "", fmt.Errorf("no such key: %s", k.expr)
+			}
+
+			v = arr[key]
+		}
+	}
+
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case nil:
+		return "", fmt.Errorf("%s is null", k.expr)
+	default:
+		return "", fmt.Errorf("%s is not a string, a number or a bool", k.expr)
+	}
+}
+
+// identPrefix returns the identifier at the start of s, which is empty if s doesn't start with one.
+func identPrefix(s string) string {
+	for i, r := range s {
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (i > 0 && r >= '0' && r <= '9') {
+			continue
+		}
+
+		return s[:i]
+	}
+
+	return s
+}
@@ -0,0 +1,154 @@
+package concurrency
+
+import (
+	"testing"
+)
+
+func TestKeyExpressionEval(t *testing.T) {
+	input := []byte(`{"customer": {"id": "acme", "tier": 2, "active": true}, "items": [{"sku": "a]b"}], "none": null, "customer id": "spaced"}`)
+	metadata := []byte(`{"region": "eu-west-1"}`)
+
+	tests := []struct {
+		expr     string
+		expected string
+		err      bool
+	}{
+		{expr: "input.customer.id", expected: "acme"},
+		{expr: "input.customer.tier", expected: "2"},
+		{expr: "input.customer.active", expected: "true"},
+		{expr: `input["customer id"]`, expected: "spaced"},
+		{expr: `input.items[0]["sku"]`, expected: "a]b"},
+		{expr: "additional_metadata.region", expected: "eu-west-1"},
+		{expr: " input.customer.id ", expected: "acme"},
+		{expr: "input.customer", err: true},
+		{expr: "input.none", err: true},
+		{expr: "input.missing", err: true},
+		{expr: "input.items[1]", err: true},
+		{expr: "additional_metadata.customer", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			k, err := CompileKey(tt.expr)
+
+			if err != nil {
+				t.Fatalf("could not compile %s: %v", tt.expr, err)
+			}
+
+			key, err := k.Eval(input, metadata)
+
+			if tt.err {
+				if err == nil {
+					t.Fatalf("expected an error, got %q", key)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if key != tt.expected {
+				t.Fatalf("expected %q, got %q", tt.expected, key)
+			}
+		})
+	}
+}
+
+func TestCompileKeyErrors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"customer.id",
+		"inputs.customer",
+		"input.",
+		"input..id",
+		"input[",
+		`input["id"`,
+		"input[-1]",
+		"input[id]",
+		"input.id + 1",
+	} {
+		if _, err := CompileKey(expr); err == nil {
+			t.Errorf("expected an error for %q", expr)
+		}
+	}
+}
+
+func TestKeyExpressionEvalWithoutInput(t *testing.T) {
+	k, err := CompileKey("input.id")
+
+	if err != nil {
+		t.Fatalf("could not compile: %v", err)
+	}
+
+	if _, err := k.Eval(nil, nil); err == nil {
+		t.Fatalf("expected an error for a run without input")
+	}
+}
+
+func TestCompositeKeyEval(t *testing.T) {
+	input := []byte(`{"customer_id": "acme"}`)
+	metadata := []byte(`{"region": "eu"}`)
+
+	k, err := CompileCompositeKey([]string{"input.customer_id", "additional_metadata.region"})
+
+	if err != nil {
+		t.Fatalf("could not compile: %v", err)
+	}
+
+	key, components, err := k.Eval(input, metadata)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if key != `["acme","eu"]` {
+		t.Fatalf("expected the components to be joined, got %q", key)
+	}
+
+	if len(components) != 2 || components[0] != "acme" || components[1] != "eu" {
+		t.Fatalf("expected components [acme eu], got %v", components)
+	}
+
+	if _, _, err := k.Eval(input, nil); err == nil {
+		t.Fatalf("expected an error for a run without the region")
+	}
+}
+
+func TestCompositeKeySingleExpression(t *testing.T) {
+	k, err := CompileCompositeKey([]string{"input.customer_id"})
+
+	if err != nil {
+		t.Fatalf("could not compile: %v", err)
+	}
+
+	key, _, err := k.Eval([]byte(`{"customer_id": "acme"}`), nil)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// a single expression computes the same key as the expression itself
+	if key != "acme" {
+		t.Fatalf("expected %q, got %q", "acme", key)
+	}
+}
+
+func TestJoinKeyIsUnambiguous(t *testing.T) {
+	if JoinKey([]string{"a,b", "c"}) == JoinKey([]string{"a", "b,c"}) {
+		t.Fatalf("expected different components to produce different keys")
+	}
+}
+
+func TestCompileCompositeKeyErrors(t *testing.T) {
+	for _, exprs := range [][]string{
+		nil,
+		{"input.a", "customer.id"},
+		{"input.a", "input.b", "input.c", "input.d", "input.e"},
+	} {
+		if _, err := CompileCompositeKey(exprs); err == nil {
+			t.Errorf("expected an error for %v", exprs)
+		}
+	}
+}
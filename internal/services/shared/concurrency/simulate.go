@@ -0,0 +1,377 @@
+package concurrency
+
+import (
+	"container/heap"
+	"sort"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+)
+
+// Strategy is the strategy of a concurrency limit, which is one of the strategies the engine implements.
+type Strategy string
+
+const (
+	// CancelInProgress cancels the oldest running runs of a group to make room for new runs of the group, so that
+	// each group runs at most MaxRuns runs at a time.
+	CancelInProgress Strategy = "CANCEL_IN_PROGRESS"
+
+	// GroupRoundRobin queues new runs, and starts the queued runs of the groups in turn, so that at most MaxRuns
+	// runs of all groups run at a time.
+	GroupRoundRobin Strategy = "GROUP_ROUND_ROBIN"
+)
+
+// Policy is a concurrency limit.
+type Policy struct {
+	Strategy Strategy
+	MaxRuns  int
+}
+
+// Run is a past run of a workflow.
+type Run struct {
+	Id        string
+	CreatedAt time.Time
+
+	// Duration is how long the run ran for, from when it was started until it finished.
+	Duration time.Duration
+
+	// Key is the concurrency group key of the run.
+	Key string
+}
+
+// Result is how a policy would have treated the runs.
+type Result struct {
+	// Runs is the number of runs which were simulated.
+	Runs int
+
+	// Groups is the number of distinct group keys of the runs.
+	Groups int
+
+	// Started is the number of runs which were started.
+	Started int
+
+	// Cancelled is the number of runs which were cancelled to make room for newer runs.
+	Cancelled int
+
+	// KeyErrors is the number of runs whose key couldn't be computed, which would fail rather than run.
+	KeyErrors int
+
+	// EstimatedDurations is the number of runs which never finished, so their duration was estimated from the runs
+	// which did.
+	EstimatedDurations int
+
+	// Queued is the number of runs which waited for a slot before they were started, or which are still waiting.
+	Queued int
+
+	// StillQueued is the number of runs which are still waiting for a slot at the end of the simulation.
+	StillQueued int
+
+	// MaxRunning is the maximum number of runs which ran at the same time.
+	MaxRunning int
+
+	// MaxQueued is the maximum number of runs which waited for a slot at the same time.
+	MaxQueued int
+
+	// TotalQueueWait and MaxQueueWait are the total and maximum time which the runs that were started waited for
+	// a slot.
+	TotalQueueWait time.Duration
+	MaxQueueWait   time.Duration
+}
+
+// SimulateRows simulates the policy against past runs of a workflow up to the end time. If key is nil, the runs keep
+// the group key which they were assigned, and runs without a group key are in the same group.
+//
+// Runs which were started and finished take as long as they did. Runs which are still running run until at least the
+// end time, and runs which never started take the median duration of the runs which finished.
+func SimulateRows(policy Policy, rows []*dbsqlc.ListWorkflowRunsForConcurrencySimulationRow, key *KeyExpression, end time.Time) *Result {
+	durations := make([]time.Duration, 0, len(rows))
+
+	for _, row := range rows {
+		if row.StartedAt.Valid && row.FinishedAt.Valid {
+			durations = append(durations, max(row.FinishedAt.Time.Sub(row.StartedAt.Time), 0))
+		}
+	}
+
+	var estimate time.Duration
+
+	if len(durations) > 0 {
+		sort.Slice(durations, func(i, j int) bool {
+			return durations[i] < durations[j]
+		})
+
+		estimate = durations[len(durations)/2]
+	}
+
+	runs := make([]Run, 0, len(rows))
+	keyErrors := 0
+	estimated := 0
+
+	for _, row := range rows {
+		run := Run{
+			Id:        sqlchelpers.UUIDToStr(row.ID),
+			CreatedAt: row.CreatedAt.Time,
+			Key:       row.ConcurrencyGroupId.String,
+		}
+
+		if key != nil {
+			k, err := key.Eval(row.Input, row.AdditionalMetadata)
+
+			if err != nil {
+				keyErrors++
+				continue
+			}
+
+			run.Key = k
+		}
+
+		switch {
+		case row.StartedAt.Valid && row.FinishedAt.Valid:
+			run.Duration = max(row.FinishedAt.Time.Sub(row.StartedAt.Time), 0)
+		case row.StartedAt.Valid && row.Status == dbsqlc.WorkflowRunStatusRUNNING:
+			run.Duration = max(end.Sub(row.StartedAt.Time), 0)
+		default:
+			run.Duration = estimate
+			estimated++
+		}
+
+		runs = append(runs, run)
+	}
+
+	res := Simulate(policy, runs, end)
+
+	res.Runs = len(rows)
+	res.KeyErrors = keyErrors
+	res.EstimatedDurations = estimated
+
+	return res
+}
+
+// Simulate replays the runs against the policy in the order they were created, and returns how they would have been
+// treated up to the end time. Runs take as long as they originally did once they are started. A policy with fewer
+// than 1 max runs is treated as 1.
+func Simulate(policy Policy, runs []Run, end time.Time) *Result {
+	if policy.MaxRuns < 1 {
+		policy.MaxRuns = 1
+	}
+
+	sorted := make([]Run, len(runs))
+	copy(sorted, runs)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+	})
+
+	s := &simulation{
+		policy:  policy,
+		res:     &Result{Runs: len(sorted)},
+		running: map[string][]*activeRun{},
+		queued:  map[string][]Run{},
+
+		lastStarted: map[string]int{},
+	}
+
+	keys := map[string]bool{}
+
+	for _, run := range sorted {
+		keys[run.Key] = true
+
+		s.advance(run.CreatedAt)
+		s.arrive(run)
+	}
+
+	s.advance(end)
+
+	s.res.Groups = len(keys)
+	s.res.StillQueued = s.numQueued
+	s.res.Queued += s.numQueued
+
+	return s.res
+}
+
+type activeRun struct {
+	key       string
+	createdAt time.Time
+	finishAt  time.Time
+
+	cancelled bool
+}
+
+type simulation struct {
+	policy Policy
+	res    *Result
+
+	// finishing are the running runs ordered by when they finish
+	finishing  finishHeap
+	numRunning int
+
+	// running are the running runs of each group in the order they were started
+	running map[string][]*activeRun
+
+	// queued are the runs of each group which wait for a slot in the order they were created
+	queued    map[string][]Run
+	numQueued int
+
+	// lastStarted is the sequence number of the last run which each group started
+	lastStarted map[string]int
+}
+
+// advance finishes the runs which finish up to the time, and starts queued runs in the slots they free up.
+func (s *simulation) advance(t time.Time) {
+	for len(s.finishing) > 0 && !s.finishing[0].finishAt.After(t) {
+		run := heap.Pop(&s.finishing).(*activeRun)
+
+		if run.cancelled {
+			continue
+		}
+
+		s.remove(run)
+		s.fill(run.finishAt)
+	}
+}
+
+func (s *simulation) arrive(run Run) {
+	switch s.policy.Strategy {
+	case CancelInProgress:
+		for len(s.running[run.Key]) >= s.policy.MaxRuns {
+			oldest := s.running[run.Key][0]
+			oldest.cancelled = true
+
+			s.remove(oldest)
+			s.res.Cancelled++
+		}
+
+		s.start(run, run.CreatedAt)
+	case GroupRoundRobin:
+		s.queued[run.Key] = append(s.queued[run.Key], run)
+		s.numQueued++
+
+		s.fill(run.CreatedAt)
+
+		if s.numQueued > s.res.MaxQueued {
+			s.res.MaxQueued = s.numQueued
+		}
+	}
+}
+
+// fill starts queued runs while there are free slots. Each round starts the oldest queued run of each group, like
+// the round robin queue of the engine.
+func (s *simulation) fill(t time.Time) {
+	if s.policy.Strategy != GroupRoundRobin {
+		return
+	}
+
+	for s.numQueued > 0 && s.numRunning < s.policy.MaxRuns {
+		keys := make([]string, 0, len(s.queued))
+
+		for key := range s.queued {
+			keys = append(keys, key)
+		}
+
+		// groups take turns, starting with the group which started a run least recently, so that the simulation is
+		// deterministic
+		sort.Slice(keys, func(i, j int) bool {
+			a, b := s.lastStarted[keys[i]], s.lastStarted[keys[j]]
+
+			if a != b {
+				return a < b
+			}
+
+			return keys[i] < keys[j]
+		})
+
+		for _, key := range keys {
+			if s.numRunning >= s.policy.MaxRuns {
+				break
+			}
+
+			run := s.queued[key][0]
+
+			if len(s.queued[key]) == 1 {
+				delete(s.queued, key)
+			} else {
+				s.queued[key] = s.queued[key][1:]
+			}
+
+			s.numQueued--
+
+			wait := t.Sub(run.CreatedAt)
+
+			if wait > 0 {
+				s.res.Queued++
+				s.res.TotalQueueWait += wait
+
+				if wait > s.res.MaxQueueWait {
+					s.res.MaxQueueWait = wait
+				}
+			}
+
+			s.start(run, t)
+		}
+	}
+}
+
+func (s *simulation) start(run Run, t time.Time) {
+	active := &activeRun{
+		key:       run.Key,
+		createdAt: run.CreatedAt,
+		finishAt:  t.Add(run.Duration),
+	}
+
+	heap.Push(&s.finishing, active)
+	s.running[run.Key] = append(s.running[run.Key], active)
+	s.numRunning++
+
+	s.res.Started++
+	s.lastStarted[run.Key] = s.res.Started
+
+	if s.numRunning > s.res.MaxRunning {
+		s.res.MaxRunning = s.numRunning
+	}
+}
+
+// remove removes a run which finished or was cancelled from the running runs. Cancelled runs are skipped when they
+// are popped from the finishing heap.
+func (s *simulation) remove(run *activeRun) {
+	group := s.running[run.key]
+
+	for i := range group {
+		if group[i] == run {
+			group = append(group[:i], group[i+1:]...)
+			break
+		}
+	}
+
+	if len(group) == 0 {
+		delete(s.running, run.key)
+	} else {
+		s.running[run.key] = group
+	}
+
+	s.numRunning--
+}
+
+type finishHeap []*activeRun
+
+func (h finishHeap) Len() int { return len(h) }
+
+func (h finishHeap) Less(i, j int) bool {
+	if h[i].finishAt.Equal(h[j].finishAt) {
+		return h[i].createdAt.Before(h[j].createdAt)
+	}
+
+	return h[i].finishAt.Before(h[j].finishAt)
+}
+
+func (h finishHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *finishHeap) Push(x interface{}) { *h = append(*h, x.(*activeRun)) }
+
+func (h *finishHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+
+	return x
+}
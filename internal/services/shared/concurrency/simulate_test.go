@@ -0,0 +1,143 @@
+package concurrency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+var start = time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+func at(seconds int) time.Time {
+	return start.Add(time.Duration(seconds) * time.Second)
+}
+
+func TestSimulateCancelInProgress(t *testing.T) {
+	runs := []Run{
+		{Id: "1", CreatedAt: at(0), Duration: 10 * time.Second, Key: "a"},
+		{Id: "2", CreatedAt: at(5), Duration: 10 * time.Second, Key: "a"},
+		{Id: "3", CreatedAt: at(6), Duration: 10 * time.Second, Key: "b"},
+		// 2 finished at 15, so 4 doesn't cancel anything
+		{Id: "4", CreatedAt: at(20), Duration: 10 * time.Second, Key: "a"},
+	}
+
+	res := Simulate(Policy{Strategy: CancelInProgress, MaxRuns: 1}, runs, at(60))
+
+	if res.Runs != 4 || res.Groups != 2 || res.Started != 4 {
+		t.Fatalf("unexpected result %+v", res)
+	}
+
+	if res.Cancelled != 1 {
+		t.Fatalf("expected 1 cancelled run, got %d", res.Cancelled)
+	}
+
+	if res.Queued != 0 || res.MaxRunning != 2 {
+		t.Fatalf("unexpected result %+v", res)
+	}
+}
+
+func TestSimulateCancelInProgressMaxRuns(t *testing.T) {
+	runs := []Run{
+		{Id: "1", CreatedAt: at(0), Duration: time.Minute, Key: "a"},
+		{Id: "2", CreatedAt: at(1), Duration: time.Minute, Key: "a"},
+		{Id: "3", CreatedAt: at(2), Duration: time.Minute, Key: "a"},
+		{Id: "4", CreatedAt: at(3), Duration: time.Minute, Key: "a"},
+	}
+
+	res := Simulate(Policy{Strategy: CancelInProgress, MaxRuns: 2}, runs, at(600))
+
+	if res.Cancelled != 2 || res.MaxRunning != 2 {
+		t.Fatalf("unexpected result %+v", res)
+	}
+}
+
+func TestSimulateGroupRoundRobin(t *testing.T) {
+	runs := []Run{
+		{Id: "a1", CreatedAt: at(0), Duration: 10 * time.Second, Key: "a"},
+		{Id: "a2", CreatedAt: at(1), Duration: 10 * time.Second, Key: "a"},
+		{Id: "a3", CreatedAt: at(2), Duration: 10 * time.Second, Key: "a"},
+		{Id: "b1", CreatedAt: at(3), Duration: 10 * time.Second, Key: "b"},
+	}
+
+	res := Simulate(Policy{Strategy: GroupRoundRobin, MaxRuns: 1}, runs, at(25))
+
+	// a1 runs from 0 to 10, then b1 takes its turn before a2 from 10 to 20, and a2 runs from 20 to 30
+	if res.Started != 3 || res.StillQueued != 1 || res.Queued != 3 {
+		t.Fatalf("unexpected result %+v", res)
+	}
+
+	if res.MaxRunning != 1 || res.MaxQueued != 3 {
+		t.Fatalf("unexpected result %+v", res)
+	}
+
+	// b1 waited 7s and a2 waited 19s
+	if res.TotalQueueWait != 26*time.Second || res.MaxQueueWait != 19*time.Second {
+		t.Fatalf("unexpected queue wait %s and %s", res.TotalQueueWait, res.MaxQueueWait)
+	}
+}
+
+func TestSimulateZeroMaxRuns(t *testing.T) {
+	runs := []Run{
+		{Id: "1", CreatedAt: at(0), Duration: time.Second, Key: "a"},
+	}
+
+	res := Simulate(Policy{Strategy: CancelInProgress}, runs, at(10))
+
+	if res.Started != 1 {
+		t.Fatalf("expected a max runs of 0 to be treated as 1, got %+v", res)
+	}
+}
+
+func timestamp(tm time.Time) pgtype.Timestamp {
+	return pgtype.Timestamp{Time: tm, Valid: true}
+}
+
+func TestSimulateRows(t *testing.T) {
+	rows := []*dbsqlc.ListWorkflowRunsForConcurrencySimulationRow{
+		{
+			CreatedAt:  timestamp(at(0)),
+			StartedAt:  timestamp(at(0)),
+			FinishedAt: timestamp(at(30)),
+			Status:     dbsqlc.WorkflowRunStatusSUCCEEDED,
+			Input:      []byte(`{"customer": "acme"}`),
+		},
+		{
+			// never started, so it takes the median duration
+			CreatedAt: timestamp(at(10)),
+			Status:    dbsqlc.WorkflowRunStatusFAILED,
+			Input:     []byte(`{"customer": "acme"}`),
+		},
+		{
+			CreatedAt: timestamp(at(15)),
+			Status:    dbsqlc.WorkflowRunStatusFAILED,
+			Input:     []byte(`{}`),
+		},
+	}
+
+	key, err := CompileKey("input.customer")
+
+	if err != nil {
+		t.Fatalf("could not compile: %v", err)
+	}
+
+	res := SimulateRows(Policy{Strategy: GroupRoundRobin, MaxRuns: 1}, rows, key, at(100))
+
+	if res.Runs != 3 || res.KeyErrors != 1 || res.EstimatedDurations != 1 {
+		t.Fatalf("unexpected result %+v", res)
+	}
+
+	// the second run waits for the first one, and runs for 30s
+	if res.Started != 2 || res.Queued != 1 || res.MaxQueueWait != 20*time.Second {
+		t.Fatalf("unexpected result %+v", res)
+	}
+
+	// without a key expression, the runs keep their group key, which is empty
+	res = SimulateRows(Policy{Strategy: CancelInProgress, MaxRuns: 1}, rows, nil, at(100))
+
+	if res.KeyErrors != 0 || res.Groups != 1 || res.Cancelled != 2 {
+		t.Fatalf("unexpected result %+v", res)
+	}
+}
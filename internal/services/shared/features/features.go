@@ -0,0 +1,190 @@
+// Package features decides which engine behaviors are enabled for a tenant. Every flag has an instance-wide
+// setting, which can be overridden per tenant, so that new behaviors can be rolled out tenant by tenant.
+package features
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/rs/zerolog"
+)
+
+// overridesTTL is how long the overrides of a tenant are cached. Overrides which are changed through the API of
+// another instance take up to this long to be picked up.
+const overridesTTL = 30 * time.Second
+
+// Flag is the name of a feature flag.
+type Flag string
+
+const (
+	// GroupKeyCache reuses the group key of a get group key run for runs with the same workflow version and
+	// input. It only has an effect if the group key cache TTL of the instance is set.
+	GroupKeyCache Flag = "group-key-cache"
+
+	// TriggerShedding rejects triggers which are not a priority while the queue of a tenant is backed up. It only
+	// has an effect if the shed queue depth of the instance is set.
+	TriggerShedding Flag = "trigger-shedding"
+)
+
+// Definition describes a flag.
+type Definition struct {
+	Flag Flag
+
+	Description string
+
+	// Default is whether the flag is enabled when neither the instance nor the tenant set it.
+	Default bool
+}
+
+// Definitions are all the flags which can be set.
+var Definitions = []Definition{
+	{
+		Flag:        GroupKeyCache,
+		Description: "Reuses the group key of a get group key run for runs with the same workflow version and input.",
+		Default:     true,
+	},
+	{
+		Flag:        TriggerShedding,
+		Description: "Rejects triggers which are not a priority while the queue of the tenant is backed up.",
+		Default:     true,
+	},
+}
+
+// Lookup returns the definition of a flag, and false if there is no flag with that name.
+func Lookup(name string) (*Definition, bool) {
+	for i := range Definitions {
+		if string(Definitions[i].Flag) == name {
+			return &Definitions[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// OverrideRepository reads the flags which are overridden for a tenant.
+type OverrideRepository interface {
+	// GetTenantFeatureFlagOverrides returns whether each overridden flag is enabled for the tenant, by name.
+	GetTenantFeatureFlagOverrides(tenantId string) (map[string]bool, error)
+}
+
+type Flags interface {
+	// Enabled returns whether the flag is enabled for the tenant. If the overrides of the tenant can't be read,
+	// the instance-wide setting is used.
+	Enabled(tenantId string, flag Flag) bool
+
+	// InstanceEnabled returns whether the flag is enabled for tenants which don't override it.
+	InstanceEnabled(flag Flag) bool
+
+	// Invalidate drops the cached overrides of a tenant, after they were changed.
+	Invalidate(tenantId string)
+
+	// SetOpts replaces the instance-wide settings while the flags are in use.
+	SetOpts(opts *FlagsOpts)
+}
+
+type FlagsOpts struct {
+	// Enabled are the flags which are enabled for the instance, regardless of their default.
+	Enabled []Flag
+
+	// Disabled are the flags which are disabled for the instance, regardless of their default.
+	Disabled []Flag
+}
+
+// ParseFlags returns the flags with the given names, or an error if one of them doesn't exist.
+func ParseFlags(names []string) ([]Flag, error) {
+	res := make([]Flag, 0, len(names))
+
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+
+		if _, ok := Lookup(name); !ok {
+			return nil, fmt.Errorf("unknown feature flag %q", name)
+		}
+
+		res = append(res, Flag(name))
+	}
+
+	return res, nil
+}
+
+type flags struct {
+	repo OverrideRepository
+	l    *zerolog.Logger
+
+	instance  atomic.Pointer[map[Flag]bool]
+	overrides *expirable.LRU[string, map[string]bool]
+}
+
+func NewFlags(repo OverrideRepository, l *zerolog.Logger, opts *FlagsOpts) Flags {
+	f := &flags{
+		repo:      repo,
+		l:         l,
+		overrides: expirable.NewLRU[string, map[string]bool](10000, nil, overridesTTL),
+	}
+
+	f.SetOpts(opts)
+
+	return f
+}
+
+func (f *flags) SetOpts(opts *FlagsOpts) {
+	instance := make(map[Flag]bool, len(Definitions))
+
+	for _, def := range Definitions {
+		instance[def.Flag] = def.Default
+	}
+
+	for _, flag := range opts.Enabled {
+		instance[flag] = true
+	}
+
+	// a flag which is both enabled and disabled is disabled, which is the safer choice for a rollout
+	for _, flag := range opts.Disabled {
+		instance[flag] = false
+	}
+
+	f.instance.Store(&instance)
+}
+
+func (f *flags) InstanceEnabled(flag Flag) bool {
+	return (*f.instance.Load())[flag]
+}
+
+func (f *flags) Enabled(tenantId string, flag Flag) bool {
+	overrides, err := f.getOverrides(tenantId)
+
+	if err != nil {
+		f.l.Warn().Err(err).Msgf("could not get feature flag overrides of tenant %s", tenantId)
+		return f.InstanceEnabled(flag)
+	}
+
+	if enabled, ok := overrides[string(flag)]; ok {
+		return enabled
+	}
+
+	return f.InstanceEnabled(flag)
+}
+
+func (f *flags) Invalidate(tenantId string) {
+	f.overrides.Remove(tenantId)
+}
+
+func (f *flags) getOverrides(tenantId string) (map[string]bool, error) {
+	if overrides, ok := f.overrides.Get(tenantId); ok {
+		return overrides, nil
+	}
+
+	overrides, err := f.repo.GetTenantFeatureFlagOverrides(tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	f.overrides.Add(tenantId, overrides)
+
+	return overrides, nil
+}
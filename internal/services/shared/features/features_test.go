@@ -0,0 +1,115 @@
+package features
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+type fakeOverrideRepository struct {
+	overrides map[string]map[string]bool
+	err       error
+	calls     int
+}
+
+func (r *fakeOverrideRepository) GetTenantFeatureFlagOverrides(tenantId string) (map[string]bool, error) {
+	r.calls++
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return r.overrides[tenantId], nil
+}
+
+func TestEnabled(t *testing.T) {
+	l := zerolog.Nop()
+
+	repo := &fakeOverrideRepository{
+		overrides: map[string]map[string]bool{
+			"enabled":  {string(GroupKeyCache): true},
+			"disabled": {string(TriggerShedding): false},
+		},
+	}
+
+	f := NewFlags(repo, &l, &FlagsOpts{
+		Disabled: []Flag{GroupKeyCache},
+	})
+
+	tests := []struct {
+		tenantId string
+		flag     Flag
+		expected bool
+	}{
+		{"other", GroupKeyCache, false},
+		{"other", TriggerShedding, true},
+		{"enabled", GroupKeyCache, true},
+		{"enabled", TriggerShedding, true},
+		{"disabled", GroupKeyCache, false},
+		{"disabled", TriggerShedding, false},
+	}
+
+	for _, tt := range tests {
+		if enabled := f.Enabled(tt.tenantId, tt.flag); enabled != tt.expected {
+			t.Errorf("expected %s to be %v for tenant %s, got %v", tt.flag, tt.expected, tt.tenantId, enabled)
+		}
+	}
+
+	// the overrides of each tenant are read once
+	if repo.calls != 3 {
+		t.Fatalf("expected 3 reads of the overrides, got %d", repo.calls)
+	}
+
+	repo.overrides["enabled"] = map[string]bool{}
+	f.Invalidate("enabled")
+
+	if f.Enabled("enabled", GroupKeyCache) {
+		t.Fatalf("expected the override to be dropped after invalidating the tenant")
+	}
+}
+
+func TestEnabledFallsBackToInstance(t *testing.T) {
+	l := zerolog.Nop()
+
+	f := NewFlags(&fakeOverrideRepository{err: errors.New("database is down")}, &l, &FlagsOpts{})
+
+	if !f.Enabled("tenant", TriggerShedding) {
+		t.Fatalf("expected the instance-wide setting when the overrides can't be read")
+	}
+}
+
+func TestSetOpts(t *testing.T) {
+	l := zerolog.Nop()
+
+	f := NewFlags(&fakeOverrideRepository{}, &l, &FlagsOpts{})
+
+	if !f.InstanceEnabled(GroupKeyCache) {
+		t.Fatalf("expected the default of the flag")
+	}
+
+	f.SetOpts(&FlagsOpts{
+		Enabled:  []Flag{GroupKeyCache},
+		Disabled: []Flag{GroupKeyCache},
+	})
+
+	if f.InstanceEnabled(GroupKeyCache) {
+		t.Fatalf("expected a flag which is both enabled and disabled to be disabled")
+	}
+}
+
+func TestParseFlags(t *testing.T) {
+	parsed, err := ParseFlags([]string{"group-key-cache", "", "trigger-shedding"})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(parsed) != 2 || parsed[0] != GroupKeyCache || parsed[1] != TriggerShedding {
+		t.Fatalf("unexpected flags %v", parsed)
+	}
+
+	if _, err := ParseFlags([]string{"unknown"}); err == nil {
+		t.Fatalf("expected an error for an unknown flag")
+	}
+}
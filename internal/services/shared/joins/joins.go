@@ -0,0 +1,242 @@
+// Package joins resolves the step runs of job runs with join steps, which start once any parent or a quorum of
+// parents succeeded, instead of once every parent succeeded.
+package joins
+
+import (
+	"fmt"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+// Validate returns an error if the join strategy and quorum of a step with the given number of parents are invalid.
+func Validate(strategy dbsqlc.StepJoinStrategy, quorum, parents int) error {
+	switch strategy {
+	case dbsqlc.StepJoinStrategyALL, dbsqlc.StepJoinStrategyANY:
+		if quorum != 0 {
+			return fmt.Errorf("a join quorum can only be set for the QUORUM join strategy")
+		}
+	case dbsqlc.StepJoinStrategyQUORUM:
+		if quorum < 1 || quorum > parents {
+			return fmt.Errorf("join quorum must be between 1 and the number of parents (%d), got %d", parents, quorum)
+		}
+	default:
+		return fmt.Errorf("unknown join strategy %q", strategy)
+	}
+
+	if strategy != dbsqlc.StepJoinStrategyALL && parents == 0 {
+		return fmt.Errorf("the %s join strategy requires at least one parent", strategy)
+	}
+
+	return nil
+}
+
+// RequiredParents returns the number of parents which must succeed before a step run with the given join strategy
+// starts.
+func RequiredParents(strategy dbsqlc.StepJoinStrategy, quorum, parents int) int {
+	switch strategy {
+	case dbsqlc.StepJoinStrategyANY:
+		return 1
+	case dbsqlc.StepJoinStrategyQUORUM:
+		return quorum
+	default:
+		return parents
+	}
+}
+
+// StepRun is a step run of a job run.
+type StepRun struct {
+	ID        string
+	ParentIds []string
+	Status    dbsqlc.StepRunStatus
+
+	// Strategy and Quorum are the join strategy and quorum of the step of the step run
+	Strategy dbsqlc.StepJoinStrategy
+	Quorum   int
+
+	// ToleratedByJoin is whether the failure or cancellation of the step run is tolerated
+	ToleratedByJoin bool
+}
+
+func (sr *StepRun) isJoin() bool {
+	return sr.Strategy != dbsqlc.StepJoinStrategyALL
+}
+
+func (sr *StepRun) isFinal() bool {
+	return sr.Status == dbsqlc.StepRunStatusSUCCEEDED || sr.isFailed()
+}
+
+func (sr *StepRun) isFailed() bool {
+	return sr.Status == dbsqlc.StepRunStatusFAILED || sr.Status == dbsqlc.StepRunStatusCANCELLED
+}
+
+// Graph is the DAG of the step runs of a job run.
+type Graph struct {
+	stepRuns map[string]*StepRun
+	children map[string][]string
+}
+
+func NewGraph(stepRuns []*StepRun) *Graph {
+	g := &Graph{
+		stepRuns: make(map[string]*StepRun, len(stepRuns)),
+		children: make(map[string][]string),
+	}
+
+	for _, sr := range stepRuns {
+		g.stepRuns[sr.ID] = sr
+
+		for _, parentId := range sr.ParentIds {
+			g.children[parentId] = append(g.children[parentId], sr.ID)
+		}
+	}
+
+	return g
+}
+
+// HasJoins returns whether any step run of the job run is a join step run.
+func (g *Graph) HasJoins() bool {
+	for _, sr := range g.stepRuns {
+		if sr.isJoin() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Resolution is the result of resolving a failed or cancelled step run.
+type Resolution struct {
+	// Cancelled are the pending step runs which can no longer start, in the order they were cancelled in
+	Cancelled []string
+
+	// Tolerated are the step runs, out of the failed or cancelled step run and the cancelled step runs, whose
+	// failure or cancellation is tolerated, because every path from them leads to a join step run which can still
+	// start or has started
+	Tolerated []string
+}
+
+// Resolve resolves the step runs after the failed or cancelled step run with the given id. Pending step runs after it
+// are cancelled up to the join step runs which can still reach their quorum.
+func (g *Graph) Resolve(stepRunId string) *Resolution {
+	res := &Resolution{}
+	cancelled := map[string]bool{}
+	queue := []string{stepRunId}
+
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+
+		for _, childId := range g.children[curr] {
+			child := g.stepRuns[childId]
+
+			if child.Status != dbsqlc.StepRunStatusPENDING || cancelled[childId] || g.canStart(child, cancelled) {
+				continue
+			}
+
+			cancelled[childId] = true
+			res.Cancelled = append(res.Cancelled, childId)
+			queue = append(queue, childId)
+		}
+	}
+
+	tolerated := map[string]bool{}
+
+	var isTolerated func(id string) bool
+
+	isTolerated = func(id string) bool {
+		if t, ok := tolerated[id]; ok {
+			return t
+		}
+
+		childIds := g.children[id]
+		t := len(childIds) > 0
+
+		for _, childId := range childIds {
+			child := g.stepRuns[childId]
+
+			switch {
+			case cancelled[childId]:
+				t = t && isTolerated(childId)
+			case child.isFailed():
+				t = t && child.ToleratedByJoin
+			case child.isJoin():
+				// the join step run can still start, or has started
+			default:
+				t = false
+			}
+		}
+
+		tolerated[id] = t
+
+		return t
+	}
+
+	for _, id := range append([]string{stepRunId}, res.Cancelled...) {
+		if isTolerated(id) {
+			res.Tolerated = append(res.Tolerated, id)
+		}
+	}
+
+	return res
+}
+
+// canStart returns whether enough parents of the step run can still succeed for it to start, when the given step
+// runs are cancelled.
+func (g *Graph) canStart(sr *StepRun, cancelled map[string]bool) bool {
+	remaining := 0
+
+	for _, parentId := range sr.ParentIds {
+		if parent, ok := g.stepRuns[parentId]; ok && !parent.isFailed() && !cancelled[parentId] {
+			remaining++
+		}
+	}
+
+	return remaining >= RequiredParents(sr.Strategy, sr.Quorum, len(sr.ParentIds))
+}
+
+// Superseded returns the unfinished step runs which are no longer needed because the join step run with the given id
+// started: the parents of the join step run, and their ancestors, whose children are all started join step runs or
+// superseded step runs. The step runs are ordered from the join step run upwards.
+func (g *Graph) Superseded(joinStepRunId string) []string {
+	join, ok := g.stepRuns[joinStepRunId]
+
+	if !ok {
+		return nil
+	}
+
+	var res []string
+	superseded := map[string]bool{}
+	queue := append([]string{}, join.ParentIds...)
+
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+
+		sr, ok := g.stepRuns[curr]
+
+		if !ok || superseded[curr] || sr.isFinal() {
+			continue
+		}
+
+		needed := false
+
+		for _, childId := range g.children[curr] {
+			child := g.stepRuns[childId]
+			started := childId == joinStepRunId || (child.isJoin() && child.Status != dbsqlc.StepRunStatusPENDING)
+
+			if !superseded[childId] && !started {
+				needed = true
+				break
+			}
+		}
+
+		if needed {
+			continue
+		}
+
+		superseded[curr] = true
+		res = append(res, curr)
+		queue = append(queue, sr.ParentIds...)
+	}
+
+	return res
+}
@@ -0,0 +1,222 @@
+package joins
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+const (
+	pending   = dbsqlc.StepRunStatusPENDING
+	running   = dbsqlc.StepRunStatusRUNNING
+	succeeded = dbsqlc.StepRunStatusSUCCEEDED
+	failed    = dbsqlc.StepRunStatusFAILED
+	cancelled = dbsqlc.StepRunStatusCANCELLED
+)
+
+func stepRun(id string, status dbsqlc.StepRunStatus, parentIds ...string) *StepRun {
+	return &StepRun{
+		ID:        id,
+		ParentIds: parentIds,
+		Status:    status,
+		Strategy:  dbsqlc.StepJoinStrategyALL,
+	}
+}
+
+func join(id string, status dbsqlc.StepRunStatus, strategy dbsqlc.StepJoinStrategy, quorum int, parentIds ...string) *StepRun {
+	sr := stepRun(id, status, parentIds...)
+	sr.Strategy = strategy
+	sr.Quorum = quorum
+
+	return sr
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy dbsqlc.StepJoinStrategy
+		quorum   int
+		parents  int
+		valid    bool
+	}{
+		{name: "all", strategy: dbsqlc.StepJoinStrategyALL, valid: true},
+		{name: "all with quorum", strategy: dbsqlc.StepJoinStrategyALL, quorum: 1, parents: 2},
+		{name: "any", strategy: dbsqlc.StepJoinStrategyANY, parents: 2, valid: true},
+		{name: "any without parents", strategy: dbsqlc.StepJoinStrategyANY},
+		{name: "quorum", strategy: dbsqlc.StepJoinStrategyQUORUM, quorum: 2, parents: 3, valid: true},
+		{name: "quorum of every parent", strategy: dbsqlc.StepJoinStrategyQUORUM, quorum: 3, parents: 3, valid: true},
+		{name: "quorum larger than parents", strategy: dbsqlc.StepJoinStrategyQUORUM, quorum: 4, parents: 3},
+		{name: "quorum not set", strategy: dbsqlc.StepJoinStrategyQUORUM, parents: 3},
+		{name: "unknown strategy", strategy: "MAJORITY", parents: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.strategy, tt.quorum, tt.parents)
+
+			if (err == nil) != tt.valid {
+				t.Fatalf("expected valid to be %v, got %v", tt.valid, err)
+			}
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name      string
+		stepRuns  []*StepRun
+		resolved  string
+		cancelled []string
+		tolerated []string
+	}{
+		{
+			name: "chain without joins",
+			stepRuns: []*StepRun{
+				stepRun("a", failed),
+				stepRun("b", pending, "a"),
+				stepRun("c", pending, "b"),
+			},
+			resolved:  "a",
+			cancelled: []string{"b", "c"},
+		},
+		{
+			name: "failed branch of any join",
+			stepRuns: []*StepRun{
+				stepRun("a", failed),
+				stepRun("b", running),
+				join("j", pending, dbsqlc.StepJoinStrategyANY, 0, "a", "b"),
+			},
+			resolved:  "a",
+			tolerated: []string{"a"},
+		},
+		{
+			name: "last branch of any join fails",
+			stepRuns: []*StepRun{
+				{ID: "a", Status: failed, Strategy: dbsqlc.StepJoinStrategyALL, ToleratedByJoin: true},
+				stepRun("b", failed),
+				join("j", pending, dbsqlc.StepJoinStrategyANY, 0, "a", "b"),
+				stepRun("after", pending, "j"),
+			},
+			resolved:  "b",
+			cancelled: []string{"j", "after"},
+		},
+		{
+			name: "multi-step branch of quorum join",
+			stepRuns: []*StepRun{
+				stepRun("a1", cancelled),
+				stepRun("a2", pending, "a1"),
+				stepRun("b", succeeded),
+				stepRun("c", running),
+				join("j", pending, dbsqlc.StepJoinStrategyQUORUM, 2, "a2", "b", "c"),
+			},
+			resolved:  "a1",
+			cancelled: []string{"a2"},
+			tolerated: []string{"a1", "a2"},
+		},
+		{
+			name: "quorum becomes unreachable",
+			stepRuns: []*StepRun{
+				{ID: "a", Status: failed, Strategy: dbsqlc.StepJoinStrategyALL, ToleratedByJoin: true},
+				stepRun("b", failed),
+				stepRun("c", running),
+				join("j", pending, dbsqlc.StepJoinStrategyQUORUM, 2, "a", "b", "c"),
+			},
+			resolved:  "b",
+			cancelled: []string{"j"},
+		},
+		{
+			name: "branch with another dependent",
+			stepRuns: []*StepRun{
+				stepRun("a", failed),
+				stepRun("b", running),
+				join("j", pending, dbsqlc.StepJoinStrategyANY, 0, "a", "b"),
+				stepRun("report", pending, "a"),
+			},
+			resolved:  "a",
+			cancelled: []string{"report"},
+		},
+		{
+			name: "branch of a started join",
+			stepRuns: []*StepRun{
+				stepRun("a", cancelled),
+				stepRun("b", succeeded),
+				join("j", running, dbsqlc.StepJoinStrategyANY, 0, "a", "b"),
+			},
+			resolved:  "a",
+			tolerated: []string{"a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := NewGraph(tt.stepRuns).Resolve(tt.resolved)
+
+			if !reflect.DeepEqual(res.Cancelled, tt.cancelled) {
+				t.Errorf("expected cancelled to be %v, got %v", tt.cancelled, res.Cancelled)
+			}
+
+			if !reflect.DeepEqual(res.Tolerated, tt.tolerated) {
+				t.Errorf("expected tolerated to be %v, got %v", tt.tolerated, res.Tolerated)
+			}
+		})
+	}
+}
+
+func TestSuperseded(t *testing.T) {
+	tests := []struct {
+		name       string
+		stepRuns   []*StepRun
+		superseded []string
+	}{
+		{
+			name: "unfinished branches",
+			stepRuns: []*StepRun{
+				stepRun("a", succeeded),
+				stepRun("b", running),
+				stepRun("c", pending),
+				join("j", dbsqlc.StepRunStatusPENDINGASSIGNMENT, dbsqlc.StepJoinStrategyANY, 0, "a", "b", "c"),
+			},
+			superseded: []string{"b", "c"},
+		},
+		{
+			name: "multi-step branch",
+			stepRuns: []*StepRun{
+				stepRun("a", succeeded),
+				stepRun("b1", running),
+				stepRun("b2", pending, "b1"),
+				join("j", dbsqlc.StepRunStatusPENDINGASSIGNMENT, dbsqlc.StepJoinStrategyANY, 0, "a", "b2"),
+			},
+			superseded: []string{"b2", "b1"},
+		},
+		{
+			name: "branch with another dependent",
+			stepRuns: []*StepRun{
+				stepRun("a", succeeded),
+				stepRun("b", running),
+				join("j", dbsqlc.StepRunStatusPENDINGASSIGNMENT, dbsqlc.StepJoinStrategyANY, 0, "a", "b"),
+				stepRun("report", pending, "b"),
+			},
+		},
+		{
+			name: "branch shared with a pending join",
+			stepRuns: []*StepRun{
+				stepRun("a", succeeded),
+				stepRun("b", running),
+				stepRun("c", succeeded),
+				join("j", dbsqlc.StepRunStatusPENDINGASSIGNMENT, dbsqlc.StepJoinStrategyANY, 0, "a", "b"),
+				join("k", pending, dbsqlc.StepJoinStrategyQUORUM, 2, "b", "c"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			superseded := NewGraph(tt.stepRuns).Superseded("j")
+
+			if !reflect.DeepEqual(superseded, tt.superseded) {
+				t.Errorf("expected superseded to be %v, got %v", tt.superseded, superseded)
+			}
+		})
+	}
+}
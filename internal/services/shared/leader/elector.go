@@ -0,0 +1,92 @@
+// Package leader elects a single instance to run the periodic jobs of a role, like the requeue loops of the
+// controllers, when multiple replicas are running. The leader holds a lease in the database, which it renews
+// whenever a job runs. If the leader dies, another instance takes the lease over once it expires.
+package leader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+)
+
+// LeaseDuration is how long a lease is held without being renewed, which is the longest time that the jobs of a
+// role don't run after its leader dies. It must be longer than the interval of the jobs which renew the lease.
+const LeaseDuration = 15 * time.Second
+
+// ValidateInterval returns an error if jobs which run at the interval can't renew the lease before it expires.
+func ValidateInterval(interval time.Duration) error {
+	if interval <= 0 || interval >= LeaseDuration {
+		return fmt.Errorf("interval must be between 0s and %s", LeaseDuration)
+	}
+
+	return nil
+}
+
+// ErrNotLeader is returned by IsLeader when the lease is held by another instance.
+var ErrNotLeader = errors.New("not the leader")
+
+// Elector implements gocron.Elector, so that the jobs of a scheduler only run on the leader of the role.
+type Elector struct {
+	repo     repository.LeaderLeaseRepository
+	l        *zerolog.Logger
+	role     string
+	leaderId string
+
+	isLeader atomic.Bool
+}
+
+func NewElector(repo repository.LeaderLeaseRepository, l *zerolog.Logger, role string) *Elector {
+	return &Elector{
+		repo:     repo,
+		l:        l,
+		role:     role,
+		leaderId: uuid.New().String(),
+	}
+}
+
+// IsLeader acquires or renews the lease of the role, and returns nil if this instance is the leader.
+func (e *Elector) IsLeader(ctx context.Context) error {
+	acquired, err := e.repo.AcquireLeaderLease(ctx, e.role, e.leaderId, LeaseDuration)
+
+	if err != nil {
+		// if the lease can't be renewed, another instance may take it over, so the job is skipped
+		e.setLeader(false)
+		return fmt.Errorf("could not acquire %s lease: %w", e.role, err)
+	}
+
+	e.setLeader(acquired)
+
+	if !acquired {
+		return ErrNotLeader
+	}
+
+	return nil
+}
+
+// Release releases the lease if this instance is the leader, so another instance can take over immediately.
+func (e *Elector) Release(ctx context.Context) error {
+	if !e.isLeader.Swap(false) {
+		return nil
+	}
+
+	return e.repo.ReleaseLeaderLease(ctx, e.role, e.leaderId)
+}
+
+func (e *Elector) setLeader(isLeader bool) {
+	if e.isLeader.Swap(isLeader) == isLeader {
+		return
+	}
+
+	if isLeader {
+		e.l.Info().Msgf("%s is now the leader for %s", e.leaderId, e.role)
+	} else {
+		e.l.Info().Msgf("%s is no longer the leader for %s", e.leaderId, e.role)
+	}
+}
@@ -0,0 +1,67 @@
+package leader
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// fakeLeaderLeaseRepository holds leases in memory, without expiry
+type fakeLeaderLeaseRepository struct {
+	leases map[string]string
+}
+
+func (r *fakeLeaderLeaseRepository) AcquireLeaderLease(ctx context.Context, role, leaderId string, duration time.Duration) (bool, error) {
+	if holder, ok := r.leases[role]; ok && holder != leaderId {
+		return false, nil
+	}
+
+	r.leases[role] = leaderId
+
+	return true, nil
+}
+
+func (r *fakeLeaderLeaseRepository) ReleaseLeaderLease(ctx context.Context, role, leaderId string) error {
+	if r.leases[role] == leaderId {
+		delete(r.leases, role)
+	}
+
+	return nil
+}
+
+func TestElectorFailover(t *testing.T) {
+	ctx := context.Background()
+	l := zerolog.Nop()
+	repo := &fakeLeaderLeaseRepository{leases: map[string]string{}}
+
+	first := NewElector(repo, &l, "role")
+	second := NewElector(repo, &l, "role")
+
+	if err := first.IsLeader(ctx); err != nil {
+		t.Fatalf("first elector should be the leader, got %v", err)
+	}
+
+	if err := second.IsLeader(ctx); !errors.Is(err, ErrNotLeader) {
+		t.Fatalf("second elector should not be the leader, got %v", err)
+	}
+
+	// releasing a lease which is not held is a no-op
+	if err := second.Release(ctx); err != nil {
+		t.Fatalf("Release() error = %v", err)
+	}
+
+	if err := first.IsLeader(ctx); err != nil {
+		t.Fatalf("first elector should still be the leader, got %v", err)
+	}
+
+	if err := first.Release(ctx); err != nil {
+		t.Fatalf("Release() error = %v", err)
+	}
+
+	if err := second.IsLeader(ctx); err != nil {
+		t.Fatalf("second elector should take over the lease, got %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+// Package limits enforces the maximum sizes of the payloads which the engine accepts, so that large payloads
+// are rejected before they are written to the database or the message queue.
+package limits
+
+import (
+	"fmt"
+	"strconv"
+	"sync/atomic"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorReasonPayloadTooLarge is the reason of the error info which is attached to the gRPC status of a rejected
+// payload.
+const ErrorReasonPayloadTooLarge = "PAYLOAD_TOO_LARGE"
+
+// PayloadKind is the kind of payload which is checked against a limit.
+type PayloadKind string
+
+const (
+	PayloadKindEvent         PayloadKind = "event"
+	PayloadKindWorkflowInput PayloadKind = "workflow_input"
+	PayloadKindStepOutput    PayloadKind = "step_output"
+)
+
+// PayloadKinds are all the kinds of payloads which have a limit.
+var PayloadKinds = []PayloadKind{PayloadKindEvent, PayloadKindWorkflowInput, PayloadKindStepOutput}
+
+func (k PayloadKind) description() string {
+	switch k {
+	case PayloadKindEvent:
+		return "event payload"
+	case PayloadKindWorkflowInput:
+		return "workflow input"
+	case PayloadKindStepOutput:
+		return "step output"
+	default:
+		return string(k)
+	}
+}
+
+// PayloadTooLargeError is returned when a payload is larger than the limit for its kind.
+type PayloadTooLargeError struct {
+	Kind  PayloadKind
+	Size  int
+	Limit int
+}
+
+func (e *PayloadTooLargeError) Error() string {
+	return fmt.Sprintf("%s is %d bytes, which exceeds the limit of %d bytes", e.Kind.description(), e.Size, e.Limit)
+}
+
+// GRPCStatus returns the status which gRPC servers return for the error, so that clients can read the kind, size
+// and limit of the payload from its error info.
+func (e *PayloadTooLargeError) GRPCStatus() *status.Status {
+	st := status.New(codes.InvalidArgument, e.Error())
+
+	withDetails, err := st.WithDetails(
+		&errdetails.ErrorInfo{
+			Reason: ErrorReasonPayloadTooLarge,
+			Domain: "hatchet.run",
+			Metadata: map[string]string{
+				"kind":  string(e.Kind),
+				"size":  strconv.Itoa(e.Size),
+				"limit": strconv.Itoa(e.Limit),
+			},
+		},
+	)
+
+	if err != nil {
+		return st
+	}
+
+	return withDetails
+}
+
+type PayloadLimitsOpts struct {
+	// MaxEventPayloadBytes is the maximum size of the payload of an event. If 0, event payloads are not limited.
+	MaxEventPayloadBytes int
+
+	// MaxWorkflowInputBytes is the maximum size of the input of a triggered workflow run. If 0, workflow inputs
+	// are not limited.
+	MaxWorkflowInputBytes int
+
+	// MaxStepOutputBytes is the maximum size of the output of a step run. If 0, step outputs are not limited.
+	MaxStepOutputBytes int
+}
+
+func (opts *PayloadLimitsOpts) limit(kind PayloadKind) int {
+	switch kind {
+	case PayloadKindEvent:
+		return opts.MaxEventPayloadBytes
+	case PayloadKindWorkflowInput:
+		return opts.MaxWorkflowInputBytes
+	case PayloadKindStepOutput:
+		return opts.MaxStepOutputBytes
+	default:
+		return 0
+	}
+}
+
+// PayloadLimits checks the sizes of payloads and counts the payloads which were rejected. A nil *PayloadLimits
+// accepts every payload.
+type PayloadLimits struct {
+	opts atomic.Pointer[PayloadLimitsOpts]
+
+	rejected map[PayloadKind]*atomic.Int64
+}
+
+func NewPayloadLimits(opts *PayloadLimitsOpts) *PayloadLimits {
+	l := &PayloadLimits{
+		rejected: make(map[PayloadKind]*atomic.Int64, len(PayloadKinds)),
+	}
+
+	for _, kind := range PayloadKinds {
+		l.rejected[kind] = &atomic.Int64{}
+	}
+
+	l.SetOpts(opts)
+
+	return l
+}
+
+// SetOpts replaces the limits while they are in use.
+func (l *PayloadLimits) SetOpts(opts *PayloadLimitsOpts) {
+	l.opts.Store(opts)
+}
+
+// Check returns a *PayloadTooLargeError if the size of a payload exceeds the limit for its kind.
+func (l *PayloadLimits) Check(kind PayloadKind, size int) error {
+	if l == nil {
+		return nil
+	}
+
+	limit := l.opts.Load().limit(kind)
+
+	if limit <= 0 || size <= limit {
+		return nil
+	}
+
+	if counter, ok := l.rejected[kind]; ok {
+		counter.Add(1)
+	}
+
+	return &PayloadTooLargeError{
+		Kind:  kind,
+		Size:  size,
+		Limit: limit,
+	}
+}
+
+// Rejected returns the number of payloads of a kind which were rejected since the engine started.
+func (l *PayloadLimits) Rejected(kind PayloadKind) int64 {
+	if l == nil {
+		return 0
+	}
+
+	if counter, ok := l.rejected[kind]; ok {
+		return counter.Load()
+	}
+
+	return 0
+}
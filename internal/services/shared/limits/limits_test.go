@@ -0,0 +1,98 @@
+package limits
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheck(t *testing.T) {
+	l := NewPayloadLimits(&PayloadLimitsOpts{
+		MaxEventPayloadBytes: 10,
+		MaxStepOutputBytes:   20,
+	})
+
+	if err := l.Check(PayloadKindEvent, 10); err != nil {
+		t.Fatalf("expected payload at the limit to be accepted, got %v", err)
+	}
+
+	// workflow inputs are not limited
+	if err := l.Check(PayloadKindWorkflowInput, 1000000); err != nil {
+		t.Fatalf("expected unlimited payload to be accepted, got %v", err)
+	}
+
+	err := l.Check(PayloadKindStepOutput, 21)
+
+	var tooLarge *PayloadTooLargeError
+
+	if !errors.As(err, &tooLarge) {
+		t.Fatalf("expected a PayloadTooLargeError, got %v", err)
+	}
+
+	if tooLarge.Kind != PayloadKindStepOutput || tooLarge.Size != 21 || tooLarge.Limit != 20 {
+		t.Fatalf("unexpected error %+v", tooLarge)
+	}
+
+	if expected := "step output is 21 bytes, which exceeds the limit of 20 bytes"; err.Error() != expected {
+		t.Fatalf("expected %q, got %q", expected, err.Error())
+	}
+
+	if rejected := l.Rejected(PayloadKindStepOutput); rejected != 1 {
+		t.Fatalf("expected 1 rejected step output, got %d", rejected)
+	}
+
+	if rejected := l.Rejected(PayloadKindEvent); rejected != 0 {
+		t.Fatalf("expected 0 rejected events, got %d", rejected)
+	}
+}
+
+func TestSetOpts(t *testing.T) {
+	l := NewPayloadLimits(&PayloadLimitsOpts{})
+
+	if err := l.Check(PayloadKindEvent, 100); err != nil {
+		t.Fatalf("expected payload to be accepted without limits, got %v", err)
+	}
+
+	l.SetOpts(&PayloadLimitsOpts{MaxEventPayloadBytes: 50})
+
+	if err := l.Check(PayloadKindEvent, 100); err == nil {
+		t.Fatal("expected payload to be rejected after the limit was set")
+	}
+}
+
+func TestNilLimits(t *testing.T) {
+	var l *PayloadLimits
+
+	if err := l.Check(PayloadKindEvent, 100); err != nil {
+		t.Fatalf("expected nil limits to accept every payload, got %v", err)
+	}
+
+	if rejected := l.Rejected(PayloadKindEvent); rejected != 0 {
+		t.Fatalf("expected 0 rejected payloads, got %d", rejected)
+	}
+}
+
+func TestGRPCStatus(t *testing.T) {
+	err := NewPayloadLimits(&PayloadLimitsOpts{MaxEventPayloadBytes: 10}).Check(PayloadKindEvent, 11)
+
+	st, ok := status.FromError(err)
+
+	if !ok || st.Code() != codes.InvalidArgument {
+		t.Fatalf("expected status InvalidArgument, got %v", st)
+	}
+
+	details := st.Details()
+
+	if len(details) != 1 {
+		t.Fatalf("expected 1 detail, got %d", len(details))
+	}
+
+	info, ok := details[0].(*errdetails.ErrorInfo)
+
+	if !ok || info.Reason != ErrorReasonPayloadTooLarge || info.Metadata["kind"] != "event" || info.Metadata["limit"] != "10" {
+		t.Fatalf("unexpected error info %v", details[0])
+	}
+}
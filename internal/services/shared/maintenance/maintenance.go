@@ -0,0 +1,44 @@
+// Package maintenance evaluates the recurring maintenance windows of workflows, during which the runs of a workflow
+// are buffered instead of started.
+package maintenance
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+var parser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// ParseCron parses the cron expression of the start of a maintenance window. Expressions have five fields or are a
+// descriptor like @daily, and are evaluated in UTC.
+func ParseCron(expr string) (cron.Schedule, error) {
+	schedule, err := parser.Parse(expr)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+	}
+
+	return schedule, nil
+}
+
+// InProgressUntil returns the end of the window which is in progress at the given time, for a window which starts
+// at each time of the schedule and lasts for the duration. If windows overlap, the end of the last one is returned.
+// It returns false if no window is in progress.
+func InProgressUntil(schedule cron.Schedule, duration time.Duration, now time.Time) (time.Time, bool) {
+	now = now.UTC()
+
+	// the earliest start of a window which hasn't ended yet
+	start := schedule.Next(now.Add(-duration))
+
+	if start.IsZero() || start.After(now) {
+		return time.Time{}, false
+	}
+
+	for next := schedule.Next(start); !next.IsZero() && !next.After(now); next = schedule.Next(next) {
+		start = next
+	}
+
+	return start.Add(duration), true
+}
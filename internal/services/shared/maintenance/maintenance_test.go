@@ -0,0 +1,93 @@
+package maintenance
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInProgressUntil(t *testing.T) {
+	tests := []struct {
+		name     string
+		cron     string
+		duration time.Duration
+		now      string
+		expected string
+	}{
+		{
+			name:     "in progress",
+			cron:     "0 2 * * *",
+			duration: time.Hour,
+			now:      "2024-04-06T02:30:00Z",
+			expected: "2024-04-06T03:00:00Z",
+		},
+		{
+			name:     "at the start",
+			cron:     "0 2 * * *",
+			duration: time.Hour,
+			now:      "2024-04-06T02:00:00Z",
+			expected: "2024-04-06T03:00:00Z",
+		},
+		{
+			name:     "at the end",
+			cron:     "0 2 * * *",
+			duration: time.Hour,
+			now:      "2024-04-06T03:00:00Z",
+		},
+		{
+			name:     "before the start",
+			cron:     "0 2 * * *",
+			duration: time.Hour,
+			now:      "2024-04-06T01:59:00Z",
+		},
+		{
+			name:     "across midnight",
+			cron:     "0 23 * * SUN",
+			duration: 3 * time.Hour,
+			now:      "2024-04-08T01:00:00Z",
+			expected: "2024-04-08T02:00:00Z",
+		},
+		{
+			name:     "overlapping windows",
+			cron:     "*/10 * * * *",
+			duration: 15 * time.Minute,
+			now:      "2024-04-06T02:12:00Z",
+			expected: "2024-04-06T02:25:00Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseCron(tt.cron)
+
+			if err != nil {
+				t.Fatalf("could not parse cron: %v", err)
+			}
+
+			now, _ := time.Parse(time.RFC3339, tt.now)
+
+			until, ok := InProgressUntil(schedule, tt.duration, now)
+
+			if tt.expected == "" {
+				if ok {
+					t.Fatalf("expected no window in progress, got one until %s", until)
+				}
+
+				return
+			}
+
+			if !ok {
+				t.Fatalf("expected a window in progress until %s, got none", tt.expected)
+			}
+
+			if got := until.Format(time.RFC3339); got != tt.expected {
+				t.Fatalf("expected a window in progress until %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseCronInvalid(t *testing.T) {
+	if _, err := ParseCron("0 0 2 * * *"); err == nil {
+		t.Fatal("expected an error for a cron expression with seconds")
+	}
+}
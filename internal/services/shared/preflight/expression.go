@@ -0,0 +1,635 @@
+package preflight
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Expression is a compiled expression over the dependency health reported by a tenant.
+//
+// A subset of CEL is supported: the health document is the variable health, and expressions are built from field
+// access (health.db.status or health["db"]), has() macros, literals (strings, numbers, true, false and null),
+// comparisons (==, !=, <, <=, >, >=), logical operators (&&, || and !) and parentheses. Function calls other than
+// has(), arithmetic and the in operator are not supported.
+type Expression struct {
+	root node
+}
+
+// Compile parses an expression.
+func Compile(expr string) (*Expression, error) {
+	tokens, err := tokenize(expr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+
+	root, err := p.parseOr()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected %q at position %d", p.peek().text, p.peek().pos)
+	}
+
+	return &Expression{root: root}, nil
+}
+
+// Eval evaluates the expression against the health document, which is the result of unmarshalling JSON into an
+// interface{}. It returns an error if the expression doesn't evaluate to a boolean, for example because a field
+// doesn't exist.
+func (e *Expression) Eval(health interface{}) (bool, error) {
+	v, err := e.root.eval(health)
+
+	if err != nil {
+		return false, err
+	}
+
+	res, ok := v.(bool)
+
+	if !ok {
+		return false, fmt.Errorf("expression evaluated to %s, not a bool", typeName(v))
+	}
+
+	return res, nil
+}
+
+type tokenKind int
+
+const (
+	tokenIdent tokenKind = iota
+	tokenString
+	tokenNumber
+	tokenOp
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+
+	// str is the unquoted value of string tokens
+	str string
+}
+
+func tokenize(expr string) ([]token, error) {
+	var tokens []token
+
+	for i := 0; i < len(expr); {
+		c := expr[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '_' || unicode.IsLetter(rune(c)):
+			j := i + 1
+
+			for j < len(expr) && (expr[j] == '_' || unicode.IsLetter(rune(expr[j])) || unicode.IsDigit(rune(expr[j]))) {
+				j++
+			}
+
+			tokens = append(tokens, token{kind: tokenIdent, text: expr[i:j], pos: i})
+			i = j
+		case unicode.IsDigit(rune(c)) || (c == '-' && i+1 < len(expr) && unicode.IsDigit(rune(expr[i+1]))):
+			j := i + 1
+
+			for j < len(expr) && (unicode.IsDigit(rune(expr[j])) || expr[j] == '.' || expr[j] == 'e' || expr[j] == 'E') {
+				j++
+			}
+
+			tokens = append(tokens, token{kind: tokenNumber, text: expr[i:j], pos: i})
+			i = j
+		case c == '"' || c == '\'':
+			j := i + 1
+
+			var sb strings.Builder
+
+			for ; j < len(expr) && expr[j] != c; j++ {
+				if expr[j] == '\\' && j+1 < len(expr) {
+					j++
+				}
+
+				sb.WriteByte(expr[j])
+			}
+
+			if j >= len(expr) {
+				return nil, fmt.Errorf("unterminated string at position %d", i)
+			}
+
+			tokens = append(tokens, token{kind: tokenString, text: expr[i : j+1], pos: i, str: sb.String()})
+			i = j + 1
+		default:
+			op := ""
+
+			for _, candidate := range []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "!", "(", ")", "[", "]", "."} {
+				if strings.HasPrefix(expr[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+
+			if op == "" {
+				return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+			}
+
+			tokens = append(tokens, token{kind: tokenOp, text: op, pos: i})
+			i += len(op)
+		}
+	}
+
+	return tokens, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	if p.done() {
+		return token{}
+	}
+
+	return p.tokens[p.pos]
+}
+
+func (p *parser) isOp(op string) bool {
+	return !p.done() && p.peek().kind == tokenOp && p.peek().text == op
+}
+
+func (p *parser) expectOp(op string) error {
+	if !p.isOp(op) {
+		if p.done() {
+			return fmt.Errorf("expected %q at end of expression", op)
+		}
+
+		return fmt.Errorf("expected %q at position %d", op, p.peek().pos)
+	}
+
+	p.pos++
+
+	return nil
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isOp("||") {
+		p.pos++
+
+		right, err := p.parseAnd()
+
+		if err != nil {
+			return nil, err
+		}
+
+		left = &logicalNode{or: true, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isOp("&&") {
+		p.pos++
+
+		right, err := p.parseUnary()
+
+		if err != nil {
+			return nil, err
+		}
+
+		left = &logicalNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.isOp("!") {
+		p.pos++
+
+		operand, err := p.parseUnary()
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &notNode{operand: operand}, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parsePrimary()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if p.isOp(op) {
+			p.pos++
+
+			right, err := p.parsePrimary()
+
+			if err != nil {
+				return nil, err
+			}
+
+			return &comparisonNode{op: op, left: left, right: right}, nil
+		}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	if p.done() {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+
+	t := p.peek()
+
+	switch t.kind {
+	case tokenString:
+		p.pos++
+		return &literalNode{value: t.str}, nil
+	case tokenNumber:
+		p.pos++
+
+		f, err := strconv.ParseFloat(t.text, 64)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at position %d", t.text, t.pos)
+		}
+
+		return &literalNode{value: f}, nil
+	case tokenOp:
+		if t.text != "(" {
+			return nil, fmt.Errorf("unexpected %q at position %d", t.text, t.pos)
+		}
+
+		p.pos++
+
+		inner, err := p.parseOr()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expectOp(")"); err != nil {
+			return nil, err
+		}
+
+		return inner, nil
+	}
+
+	switch t.text {
+	case "true", "false":
+		p.pos++
+		return &literalNode{value: t.text == "true"}, nil
+	case "null":
+		p.pos++
+		return &literalNode{value: nil}, nil
+	case "has":
+		p.pos++
+
+		if err := p.expectOp("("); err != nil {
+			return nil, err
+		}
+
+		path, err := p.parsePath()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(path.keys) == 0 {
+			return nil, fmt.Errorf("has() requires a field of health at position %d", t.pos)
+		}
+
+		if err := p.expectOp(")"); err != nil {
+			return nil, err
+		}
+
+		return &hasNode{path: path}, nil
+	}
+
+	return p.parsePath()
+}
+
+func (p *parser) parsePath() (*pathNode, error) {
+	t := p.peek()
+
+	if p.done() || t.kind != tokenIdent {
+		return nil, fmt.Errorf("expected health at position %d", t.pos)
+	}
+
+	if t.text != "health" {
+		return nil, fmt.Errorf("undeclared reference to %q at position %d, only health is declared", t.text, t.pos)
+	}
+
+	p.pos++
+
+	res := &pathNode{}
+
+	for {
+		switch {
+		case p.isOp("."):
+			p.pos++
+
+			field := p.peek()
+
+			if p.done() || field.kind != tokenIdent {
+				return nil, fmt.Errorf("expected a field name at position %d", field.pos)
+			}
+
+			p.pos++
+			res.keys = append(res.keys, field.text)
+		case p.isOp("["):
+			p.pos++
+
+			key := p.peek()
+
+			switch {
+			case key.kind == tokenString:
+				res.keys = append(res.keys, key.str)
+			case key.kind == tokenNumber:
+				index, err := strconv.Atoi(key.text)
+
+				if err != nil || index < 0 {
+					return nil, fmt.Errorf("invalid index %q at position %d", key.text, key.pos)
+				}
+
+				res.keys = append(res.keys, index)
+			default:
+				return nil, fmt.Errorf("expected a string key or an index at position %d", key.pos)
+			}
+
+			p.pos++
+
+			if err := p.expectOp("]"); err != nil {
+				return nil, err
+			}
+		default:
+			return res, nil
+		}
+	}
+}
+
+type node interface {
+	eval(health interface{}) (interface{}, error)
+}
+
+type literalNode struct {
+	value interface{}
+}
+
+func (n *literalNode) eval(_ interface{}) (interface{}, error) {
+	return n.value, nil
+}
+
+type pathNode struct {
+	// keys are string keys of objects and int indexes of arrays
+	keys []interface{}
+}
+
+func (n *pathNode) eval(health interface{}) (interface{}, error) {
+	v, found, err := n.lookup(health)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, fmt.Errorf("no such key: %s", n)
+	}
+
+	return v, nil
+}
+
+func (n *pathNode) lookup(health interface{}) (interface{}, bool, error) {
+	v := health
+
+	for i, key := range n.keys {
+		switch k := key.(type) {
+		case string:
+			obj, ok := v.(map[string]interface{})
+
+			if !ok {
+				return nil, false, fmt.Errorf("%s is %s, not an object", n.prefix(i), typeName(v))
+			}
+
+			if v, ok = obj[k]; !ok {
+				return nil, false, nil
+			}
+		case int:
+			arr, ok := v.([]interface{})
+
+			if !ok {
+				return nil, false, fmt.Errorf("%s is %s, not a list", n.prefix(i), typeName(v))
+			}
+
+			if k >= len(arr) {
+				return nil, false, nil
+			}
+
+			v = arr[k]
+		}
+	}
+
+	return v, true, nil
+}
+
+func (n *pathNode) prefix(length int) string {
+	var sb strings.Builder
+
+	sb.WriteString("health")
+
+	for _, key := range n.keys[:length] {
+		switch k := key.(type) {
+		case string:
+			fmt.Fprintf(&sb, "[%q]", k)
+		case int:
+			fmt.Fprintf(&sb, "[%d]", k)
+		}
+	}
+
+	return sb.String()
+}
+
+func (n *pathNode) String() string {
+	return n.prefix(len(n.keys))
+}
+
+type hasNode struct {
+	path *pathNode
+}
+
+func (n *hasNode) eval(health interface{}) (interface{}, error) {
+	_, found, err := n.path.lookup(health)
+
+	return found, err
+}
+
+type notNode struct {
+	operand node
+}
+
+func (n *notNode) eval(health interface{}) (interface{}, error) {
+	v, err := n.operand.eval(health)
+
+	if err != nil {
+		return nil, err
+	}
+
+	b, ok := v.(bool)
+
+	if !ok {
+		return nil, fmt.Errorf("cannot negate %s", typeName(v))
+	}
+
+	return !b, nil
+}
+
+type logicalNode struct {
+	or          bool
+	left, right node
+}
+
+func (n *logicalNode) eval(health interface{}) (interface{}, error) {
+	left, err := evalBool(n.left, health)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// short-circuit like CEL, so that a guard like has(health.db) && health.db.up doesn't fail
+	if left == n.or {
+		return left, nil
+	}
+
+	return evalBool(n.right, health)
+}
+
+func evalBool(n node, health interface{}) (bool, error) {
+	v, err := n.eval(health)
+
+	if err != nil {
+		return false, err
+	}
+
+	b, ok := v.(bool)
+
+	if !ok {
+		return false, fmt.Errorf("expected a bool operand, got %s", typeName(v))
+	}
+
+	return b, nil
+}
+
+type comparisonNode struct {
+	op          string
+	left, right node
+}
+
+func (n *comparisonNode) eval(health interface{}) (interface{}, error) {
+	left, err := n.left.eval(health)
+
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := n.right.eval(health)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "==":
+		return reflect.DeepEqual(left, right), nil
+	case "!=":
+		return !reflect.DeepEqual(left, right), nil
+	}
+
+	var cmp int
+
+	switch l := left.(type) {
+	case float64:
+		r, ok := right.(float64)
+
+		if !ok {
+			return nil, fmt.Errorf("cannot compare %s and %s", typeName(left), typeName(right))
+		}
+
+		switch {
+		case l < r:
+			cmp = -1
+		case l > r:
+			cmp = 1
+		}
+	case string:
+		r, ok := right.(string)
+
+		if !ok {
+			return nil, fmt.Errorf("cannot compare %s and %s", typeName(left), typeName(right))
+		}
+
+		cmp = strings.Compare(l, r)
+	default:
+		return nil, fmt.Errorf("cannot compare %s and %s", typeName(left), typeName(right))
+	}
+
+	switch n.op {
+	case "<":
+		return cmp < 0, nil
+	case "<=":
+		return cmp <= 0, nil
+	case ">":
+		return cmp > 0, nil
+	default:
+		return cmp >= 0, nil
+	}
+}
+
+func typeName(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "a bool"
+	case float64:
+		return "a number"
+	case string:
+		return "a string"
+	case map[string]interface{}:
+		return "an object"
+	case []interface{}:
+		return "a list"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
@@ -0,0 +1,206 @@
+// Package preflight runs the preflight checks of steps, which check that the dependencies of a step are healthy
+// before a step run is assigned to a worker.
+package preflight
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+)
+
+const (
+	KindHTTP       = "HTTP"
+	KindTCP        = "TCP"
+	KindEXPRESSION = "EXPRESSION"
+)
+
+// DefaultTimeout is the timeout of HTTP and TCP checks which don't set one.
+const DefaultTimeout = 5 * time.Second
+
+// probeResultTTL is how long the result of an HTTP or TCP check is cached, so that a burst of step runs of the
+// same step doesn't probe a dependency once per step run.
+const probeResultTTL = 5 * time.Second
+
+// Check is a preflight check of a step.
+type Check struct {
+	Kind string
+
+	// Target is the URL of HTTP checks, the host:port address of TCP checks, or the expression of EXPRESSION checks
+	Target string
+
+	// Timeout is the timeout of HTTP and TCP checks, or DefaultTimeout if zero
+	Timeout time.Duration
+}
+
+// Validate returns an error if the target of a check of the given kind is invalid.
+func Validate(kind, target string) error {
+	switch kind {
+	case KindHTTP:
+		u, err := url.Parse(target)
+
+		if err != nil {
+			return fmt.Errorf("invalid URL %q: %w", target, err)
+		}
+
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid URL %q: must be an absolute http or https URL", target)
+		}
+	case KindTCP:
+		if _, _, err := net.SplitHostPort(target); err != nil {
+			return fmt.Errorf("invalid address %q: %w", target, err)
+		}
+	case KindEXPRESSION:
+		if _, err := Compile(target); err != nil {
+			return fmt.Errorf("invalid expression %q: %w", target, err)
+		}
+	default:
+		return fmt.Errorf("unknown preflight check kind %q", kind)
+	}
+
+	return nil
+}
+
+// Checker runs preflight checks.
+type Checker struct {
+	client *http.Client
+	dialer *net.Dialer
+
+	// probeResults caches the results of HTTP and TCP checks by kind and target, where a nil error is a pass
+	probeResults *expirable.LRU[string, error]
+}
+
+func NewChecker() *Checker {
+	return &Checker{
+		client: &http.Client{
+			// a redirect is a healthy response, so redirects aren't followed
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		dialer:       &net.Dialer{},
+		probeResults: expirable.NewLRU[string, error](1000, nil, probeResultTTL),
+	}
+}
+
+// Run runs the checks in order, and returns an error which describes the first check which failed. The health
+// document is the dependency health reported by the tenant, as JSON, and is evaluated as an empty object if the
+// tenant hasn't reported it.
+func (c *Checker) Run(ctx context.Context, checks []Check, health []byte) error {
+	var parsedHealth interface{}
+
+	for _, check := range checks {
+		var err error
+
+		switch check.Kind {
+		case KindHTTP, KindTCP:
+			err = c.probe(ctx, check)
+		case KindEXPRESSION:
+			if parsedHealth == nil {
+				parsedHealth = map[string]interface{}{}
+
+				if len(health) > 0 {
+					if err := json.Unmarshal(health, &parsedHealth); err != nil {
+						return fmt.Errorf("could not unmarshal dependency health: %w", err)
+					}
+				}
+			}
+
+			err = evalExpression(check.Target, parsedHealth)
+		default:
+			err = fmt.Errorf("unknown preflight check kind %q", check.Kind)
+		}
+
+		if err != nil {
+			return fmt.Errorf("%s check %q failed: %w", check.Kind, check.Target, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *Checker) probe(ctx context.Context, check Check) error {
+	key := check.Kind + ":" + check.Target
+
+	if err, ok := c.probeResults.Get(key); ok {
+		return err
+	}
+
+	timeout := check.Timeout
+
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var err error
+
+	if check.Kind == KindHTTP {
+		err = c.probeHTTP(ctx, check.Target)
+	} else {
+		err = c.probeTCP(ctx, check.Target)
+	}
+
+	c.probeResults.Add(key, err)
+
+	return err
+}
+
+func (c *Checker) probeHTTP(ctx context.Context, target string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.client.Do(req)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return fmt.Errorf("unhealthy status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (c *Checker) probeTCP(ctx context.Context, target string) error {
+	conn, err := c.dialer.DialContext(ctx, "tcp", target)
+
+	if err != nil {
+		return err
+	}
+
+	return conn.Close()
+}
+
+func evalExpression(expr string, health interface{}) error {
+	compiled, err := Compile(expr)
+
+	if err != nil {
+		return err
+	}
+
+	ok, err := compiled.Eval(health)
+
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return fmt.Errorf("expression evaluated to false")
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package preflight
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestExpression(t *testing.T) {
+	health := []byte(`{"db": {"status": "up", "latencyMs": 12, "replicas": [{"lag": 0}]}, "queue": {"ready": false}}`)
+
+	tests := []struct {
+		name     string
+		expr     string
+		expected bool
+		err      string
+	}{
+		{name: "equal", expr: `health.db.status == "up"`, expected: true},
+		{name: "not equal", expr: `health.db.status != 'up'`, expected: false},
+		{name: "number", expr: `health.db.latencyMs < 100`, expected: true},
+		{name: "index", expr: `health["db"].replicas[0].lag <= 0`, expected: true},
+		{name: "bool field", expr: `health.queue.ready`, expected: false},
+		{name: "not", expr: `!health.queue.ready`, expected: true},
+		{name: "and", expr: `health.db.status == "up" && health.queue.ready`, expected: false},
+		{name: "or", expr: `health.queue.ready || (health.db.latencyMs >= 10 && health.db.latencyMs > -1)`, expected: true},
+		{name: "has", expr: `has(health.db.status)`, expected: true},
+		{name: "has missing", expr: `has(health.cache.status)`, expected: false},
+		{name: "short circuit", expr: `has(health.cache) && health.cache.status == "up"`, expected: false},
+		{name: "missing key compared to null", expr: `health.db.error == null || health.db.status != null`, err: "no such key"},
+		{name: "missing key", expr: `health.cache.status == "up"`, err: "no such key"},
+		{name: "not a bool", expr: `health.db.status`, err: "not a bool"},
+		{name: "mismatched comparison", expr: `health.db.status < 1`, err: "cannot compare"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := evalExpression(tt.expr, mustParse(t, health))
+
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected error containing %q, got %v", tt.err, err)
+				}
+
+				return
+			}
+
+			if (err == nil) != tt.expected {
+				t.Fatalf("expected %v, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestCompileInvalid(t *testing.T) {
+	for _, expr := range []string{
+		``,
+		`health.db.status ==`,
+		`(health.db.up`,
+		`env.db.up`,
+		`health.db.status == "up`,
+		`health.db.up + 1`,
+		`has(health)`,
+		`health.db.up)`,
+	} {
+		if _, err := Compile(expr); err == nil {
+			t.Errorf("expected %q to be invalid", expr)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := []Check{
+		{Kind: KindHTTP, Target: "https://example.com/healthz"},
+		{Kind: KindTCP, Target: "db.internal:5432"},
+		{Kind: KindEXPRESSION, Target: `health.db.up`},
+	}
+
+	for _, check := range valid {
+		if err := Validate(check.Kind, check.Target); err != nil {
+			t.Errorf("expected %s check %q to be valid, got %v", check.Kind, check.Target, err)
+		}
+	}
+
+	invalid := []Check{
+		{Kind: KindHTTP, Target: "example.com/healthz"},
+		{Kind: KindHTTP, Target: "ftp://example.com"},
+		{Kind: KindTCP, Target: "db.internal"},
+		{Kind: KindEXPRESSION, Target: `health.db.up &&`},
+		{Kind: "DNS", Target: "example.com"},
+	}
+
+	for _, check := range invalid {
+		if err := Validate(check.Kind, check.Target); err == nil {
+			t.Errorf("expected %s check %q to be invalid", check.Kind, check.Target)
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer healthy.Close()
+
+	unhealthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unhealthy.Close()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer listener.Close()
+
+	// an address which nothing listens on
+	closedListener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	closedAddr := closedListener.Addr().String()
+	closedListener.Close()
+
+	health := []byte(`{"db": {"up": true}}`)
+
+	tests := []struct {
+		name   string
+		checks []Check
+		passes bool
+	}{
+		{name: "no checks", passes: true},
+		{name: "healthy http", checks: []Check{{Kind: KindHTTP, Target: healthy.URL}}, passes: true},
+		{name: "unhealthy http", checks: []Check{{Kind: KindHTTP, Target: unhealthy.URL}}},
+		{name: "listening tcp", checks: []Check{{Kind: KindTCP, Target: listener.Addr().String()}}, passes: true},
+		{name: "closed tcp", checks: []Check{{Kind: KindTCP, Target: closedAddr}}},
+		{name: "expression", checks: []Check{{Kind: KindEXPRESSION, Target: `health.db.up`}}, passes: true},
+		{
+			name: "all checks must pass",
+			checks: []Check{
+				{Kind: KindEXPRESSION, Target: `health.db.up`},
+				{Kind: KindHTTP, Target: unhealthy.URL},
+			},
+		},
+	}
+
+	checker := NewChecker()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checker.Run(context.Background(), tt.checks, health)
+
+			if (err == nil) != tt.passes {
+				t.Fatalf("expected passes to be %v, got %v", tt.passes, err)
+			}
+		})
+	}
+}
+
+func TestRunWithoutHealth(t *testing.T) {
+	err := NewChecker().Run(context.Background(), []Check{{Kind: KindEXPRESSION, Target: `has(health.db)`}}, nil)
+
+	// a tenant which hasn't reported its dependency health has an empty health document
+	if err == nil || !strings.Contains(err.Error(), "evaluated to false") {
+		t.Fatalf("expected the check to fail, got %v", err)
+	}
+}
+
+func mustParse(t *testing.T, data []byte) interface{} {
+	t.Helper()
+
+	var v interface{}
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatal(err)
+	}
+
+	return v
+}
@@ -0,0 +1,112 @@
+// Package protocol defines the versions of the protocol which workers speak with the dispatcher. A worker sends
+// the range of versions it speaks when it registers, and the dispatcher either agrees on a version which both
+// speak, or refuses the worker with an error which says which side to upgrade.
+package protocol
+
+import (
+	"fmt"
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Version1 is the protocol of workers which don't send their version when they register.
+	Version1 int32 = 1
+
+	// Version2 adds the version exchange, and START_STEP_RUN_TIMEOUT_HOOK actions, which are only sent to workers
+	// which speak it.
+	Version2 int32 = 2
+)
+
+const (
+	// MinVersion is the oldest version which the engine speaks.
+	MinVersion = Version1
+
+	// CurrentVersion is the newest version which the engine speaks.
+	CurrentVersion = Version2
+)
+
+// ErrorReasonIncompatibleVersion is the reason of the error info which is attached to the gRPC status of a worker
+// which was refused.
+const ErrorReasonIncompatibleVersion = "INCOMPATIBLE_PROTOCOL_VERSION"
+
+// IncompatibleVersionError is returned when a worker and the engine don't speak a common version.
+type IncompatibleVersionError struct {
+	// WorkerMinVersion and WorkerMaxVersion are the range of versions which the worker speaks
+	WorkerMinVersion int32
+	WorkerMaxVersion int32
+}
+
+func (e *IncompatibleVersionError) Error() string {
+	if e.WorkerMaxVersion < MinVersion {
+		return fmt.Sprintf(
+			"the worker speaks protocol versions %d to %d, but the engine requires at least version %d: upgrade the Hatchet SDK of the worker",
+			e.WorkerMinVersion, e.WorkerMaxVersion, MinVersion,
+		)
+	}
+
+	return fmt.Sprintf(
+		"the worker requires protocol version %d or newer, but the engine speaks at most version %d: upgrade the Hatchet engine or use an older SDK",
+		e.WorkerMinVersion, CurrentVersion,
+	)
+}
+
+// GRPCStatus returns the status which the dispatcher returns for the error, so that SDKs can read the versions
+// from its error info.
+func (e *IncompatibleVersionError) GRPCStatus() *status.Status {
+	st := status.New(codes.FailedPrecondition, e.Error())
+
+	withDetails, err := st.WithDetails(
+		&errdetails.ErrorInfo{
+			Reason: ErrorReasonIncompatibleVersion,
+			Domain: "hatchet.run",
+			Metadata: map[string]string{
+				"workerMinVersion": strconv.Itoa(int(e.WorkerMinVersion)),
+				"workerMaxVersion": strconv.Itoa(int(e.WorkerMaxVersion)),
+				"engineMinVersion": strconv.Itoa(int(MinVersion)),
+				"engineMaxVersion": strconv.Itoa(int(CurrentVersion)),
+			},
+		},
+	)
+
+	if err != nil {
+		return st
+	}
+
+	return withDetails
+}
+
+// Negotiate returns the newest version which both the engine and a worker speak. The worker sends the newest
+// version it speaks and, optionally, the oldest. Workers which don't send a version speak version 1, and workers
+// which don't send their oldest version speak every version up to their newest.
+func Negotiate(workerMaxVersion, workerMinVersion int32) (int32, error) {
+	if workerMaxVersion <= 0 {
+		workerMaxVersion = Version1
+	}
+
+	if workerMinVersion <= 0 {
+		workerMinVersion = Version1
+	}
+
+	if workerMinVersion > workerMaxVersion {
+		workerMinVersion = workerMaxVersion
+	}
+
+	version := workerMaxVersion
+
+	if version > CurrentVersion {
+		version = CurrentVersion
+	}
+
+	if version < workerMinVersion || version < MinVersion {
+		return 0, &IncompatibleVersionError{
+			WorkerMinVersion: workerMinVersion,
+			WorkerMaxVersion: workerMaxVersion,
+		}
+	}
+
+	return version, nil
+}
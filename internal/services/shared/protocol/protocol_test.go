@@ -0,0 +1,76 @@
+package protocol
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name      string
+		max       int32
+		min       int32
+		want      int32
+		wantError bool
+	}{
+		{name: "worker without a version", want: Version1},
+		{name: "current worker", max: CurrentVersion, min: Version1, want: CurrentVersion},
+		{name: "older worker", max: Version1, want: Version1},
+		{name: "newer worker", max: CurrentVersion + 1, min: Version1, want: CurrentVersion},
+		{name: "newer worker without its min version", max: CurrentVersion + 1, want: CurrentVersion},
+		{name: "worker which requires a newer engine", max: CurrentVersion + 2, min: CurrentVersion + 1, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Negotiate(tt.max, tt.min)
+
+			if tt.wantError {
+				var incompatible *IncompatibleVersionError
+
+				if !errors.As(err, &incompatible) {
+					t.Fatalf("expected an incompatible version error, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("expected version %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestIncompatibleVersionErrorStatus(t *testing.T) {
+	_, err := Negotiate(CurrentVersion+2, CurrentVersion+1)
+
+	st, ok := status.FromError(err)
+
+	if !ok || st.Code() != codes.FailedPrecondition {
+		t.Fatalf("expected a failed precondition status, got %v", err)
+	}
+
+	if len(st.Details()) != 1 {
+		t.Fatalf("expected one detail, got %d", len(st.Details()))
+	}
+
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+
+	if !ok || info.Reason != ErrorReasonIncompatibleVersion {
+		t.Fatalf("expected an incompatible version error info, got %v", st.Details()[0])
+	}
+
+	if info.Metadata["workerMinVersion"] != strconv.Itoa(int(CurrentVersion+1)) {
+		t.Errorf("expected the min version of the worker in the metadata, got %v", info.Metadata)
+	}
+}
@@ -0,0 +1,53 @@
+// Package recurring validates the recurring tasks of tenants, which are lightweight engine actions that the ticker
+// runs on a cron schedule without a workflow.
+package recurring
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Kind is the action which a recurring task runs.
+type Kind string
+
+const (
+	// KindPurgeWorkflowRuns deletes the finished runs of a workflow which are older than the retention of the task.
+	KindPurgeWorkflowRuns Kind = "PURGE_WORKFLOW_RUNS"
+
+	// KindEmitEvent creates an event with the key and data of the task.
+	KindEmitEvent Kind = "EMIT_EVENT"
+)
+
+// MinRetention is the shortest retention of a purge task, so that runs aren't deleted before they can be looked at.
+const MinRetention = time.Hour
+
+var parser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// ParseCron parses the cron expression of the schedule of a task. Expressions have five fields or are a descriptor
+// like @weekly, and are evaluated in UTC.
+func ParseCron(expr string) (cron.Schedule, error) {
+	schedule, err := parser.Parse(expr)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+	}
+
+	return schedule, nil
+}
+
+// ParseRetention parses the retention of a purge task, which is a duration like 168h.
+func ParseRetention(retention string) (time.Duration, error) {
+	d, err := time.ParseDuration(retention)
+
+	if err != nil {
+		return 0, fmt.Errorf("invalid retention %q: %w", retention, err)
+	}
+
+	if d < MinRetention {
+		return 0, fmt.Errorf("retention must be at least %s", MinRetention)
+	}
+
+	return d, nil
+}
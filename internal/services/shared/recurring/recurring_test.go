@@ -0,0 +1,56 @@
+package recurring
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	now := time.Date(2024, 4, 28, 10, 0, 0, 0, time.UTC)
+
+	schedule, err := ParseCron("@weekly")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if next := schedule.Next(now); !next.Equal(time.Date(2024, 5, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected next run on sunday, got %s", next)
+	}
+
+	if _, err := ParseCron("0 * * * * *"); err == nil {
+		t.Errorf("expected an error for an expression with seconds")
+	}
+}
+
+func TestParseRetention(t *testing.T) {
+	tests := []struct {
+		retention string
+		expected  time.Duration
+		wantErr   bool
+	}{
+		{retention: "168h", expected: 168 * time.Hour},
+		{retention: "1h", expected: time.Hour},
+		{retention: "30m", wantErr: true},
+		{retention: "7d", wantErr: true},
+		{retention: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		d, err := ParseRetention(tt.retention)
+
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%q: expected an error", tt.retention)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tt.retention, err)
+		} else if d != tt.expected {
+			t.Errorf("%q: expected %s, got %s", tt.retention, tt.expected, d)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+// Package settings resolves the engine settings of a tenant, which are the defaults of the engine unless the tenant
+// overrides them.
+package settings
+
+import (
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/services/shared/defaults"
+)
+
+// overridesTTL is how long the overrides of a tenant are cached. Overrides which are changed through the API of
+// another instance take up to this long to be picked up.
+const overridesTTL = 30 * time.Second
+
+// Values are the engine settings of a tenant.
+type Values struct {
+	// DefaultStepTimeout is the timeout of step runs whose step doesn't set one.
+	DefaultStepTimeout time.Duration
+
+	// ScheduleTimeout is how long a step run waits to be assigned to a worker if its step doesn't set a schedule
+	// timeout.
+	ScheduleTimeout time.Duration
+
+	// DefaultStepRetries is the number of times step runs are retried if their step doesn't set retries.
+	DefaultStepRetries int
+
+	// MaxFanOut is the maximum number of workflow runs which a single event triggers. 0 is unlimited.
+	MaxFanOut int
+}
+
+// Defaults returns the settings of tenants which don't override any of them.
+func Defaults() Values {
+	// the default is a constant, so it always parses
+	stepTimeout, _ := time.ParseDuration(defaults.DefaultStepRunTimeout)
+
+	return Values{
+		DefaultStepTimeout: stepTimeout,
+		ScheduleTimeout:    defaults.DefaultScheduleTimeout,
+	}
+}
+
+// Overrides are the settings which a tenant overrides. Nil fields use the defaults.
+type Overrides struct {
+	DefaultStepTimeout *time.Duration
+	ScheduleTimeout    *time.Duration
+	DefaultStepRetries *int
+	MaxFanOut          *int
+}
+
+// ParseOverrides returns the overrides from the form they are stored in. Durations are validated before they are
+// stored, so durations which don't parse are ignored.
+func ParseOverrides(defaultStepTimeout, scheduleTimeout *string, defaultStepRetries, maxFanOut *int) *Overrides {
+	res := &Overrides{
+		DefaultStepRetries: defaultStepRetries,
+		MaxFanOut:          maxFanOut,
+	}
+
+	if defaultStepTimeout != nil {
+		if parsed, err := time.ParseDuration(*defaultStepTimeout); err == nil {
+			res.DefaultStepTimeout = &parsed
+		}
+	}
+
+	if scheduleTimeout != nil {
+		if parsed, err := time.ParseDuration(*scheduleTimeout); err == nil {
+			res.ScheduleTimeout = &parsed
+		}
+	}
+
+	return res
+}
+
+// Apply returns the values with the overrides applied.
+func (o *Overrides) Apply(v Values) Values {
+	if o == nil {
+		return v
+	}
+
+	if o.DefaultStepTimeout != nil {
+		v.DefaultStepTimeout = *o.DefaultStepTimeout
+	}
+
+	if o.ScheduleTimeout != nil {
+		v.ScheduleTimeout = *o.ScheduleTimeout
+	}
+
+	if o.DefaultStepRetries != nil {
+		v.DefaultStepRetries = *o.DefaultStepRetries
+	}
+
+	if o.MaxFanOut != nil {
+		v.MaxFanOut = *o.MaxFanOut
+	}
+
+	return v
+}
+
+// OverrideRepository reads the settings which are overridden for a tenant.
+type OverrideRepository interface {
+	// GetTenantEngineSettingsOverrides returns the overrides of the tenant, which are empty if the tenant doesn't
+	// override any settings.
+	GetTenantEngineSettingsOverrides(tenantId string) (*Overrides, error)
+}
+
+type Service interface {
+	// Get returns the settings of the tenant. If the overrides of the tenant can't be read, the defaults are used.
+	Get(tenantId string) Values
+
+	// Invalidate drops the cached overrides of a tenant, after they were changed.
+	Invalidate(tenantId string)
+}
+
+type service struct {
+	repo OverrideRepository
+	l    *zerolog.Logger
+
+	overrides *expirable.LRU[string, *Overrides]
+}
+
+func NewService(repo OverrideRepository, l *zerolog.Logger) Service {
+	return &service{
+		repo:      repo,
+		l:         l,
+		overrides: expirable.NewLRU[string, *Overrides](10000, nil, overridesTTL),
+	}
+}
+
+func (s *service) Get(tenantId string) Values {
+	overrides, err := s.getOverrides(tenantId)
+
+	if err != nil {
+		s.l.Warn().Err(err).Msgf("could not get engine settings of tenant %s", tenantId)
+		return Defaults()
+	}
+
+	return overrides.Apply(Defaults())
+}
+
+func (s *service) Invalidate(tenantId string) {
+	s.overrides.Remove(tenantId)
+}
+
+func (s *service) getOverrides(tenantId string) (*Overrides, error) {
+	if overrides, ok := s.overrides.Get(tenantId); ok {
+		return overrides, nil
+	}
+
+	overrides, err := s.repo.GetTenantEngineSettingsOverrides(tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.overrides.Add(tenantId, overrides)
+
+	return overrides, nil
+}
@@ -0,0 +1,111 @@
+package settings
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type fakeOverrideRepository struct {
+	overrides map[string]*Overrides
+	err       error
+	calls     int
+}
+
+func (r *fakeOverrideRepository) GetTenantEngineSettingsOverrides(tenantId string) (*Overrides, error) {
+	r.calls++
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	if overrides, ok := r.overrides[tenantId]; ok {
+		return overrides, nil
+	}
+
+	return &Overrides{}, nil
+}
+
+func TestGet(t *testing.T) {
+	l := zerolog.Nop()
+
+	stepTimeout := 10 * time.Minute
+	retries := 3
+
+	repo := &fakeOverrideRepository{
+		overrides: map[string]*Overrides{
+			"overridden": {
+				DefaultStepTimeout: &stepTimeout,
+				DefaultStepRetries: &retries,
+			},
+		},
+	}
+
+	s := NewService(repo, &l)
+
+	if v := s.Get("other"); v != Defaults() {
+		t.Fatalf("expected the defaults for a tenant without overrides, got %+v", v)
+	}
+
+	v := s.Get("overridden")
+
+	if v.DefaultStepTimeout != stepTimeout || v.DefaultStepRetries != retries {
+		t.Fatalf("expected the overrides to apply, got %+v", v)
+	}
+
+	if v.ScheduleTimeout != Defaults().ScheduleTimeout || v.MaxFanOut != 0 {
+		t.Fatalf("expected settings which aren't overridden to use the defaults, got %+v", v)
+	}
+
+	s.Get("overridden")
+
+	// the overrides of each tenant are read once
+	if repo.calls != 2 {
+		t.Fatalf("expected 2 reads of the overrides, got %d", repo.calls)
+	}
+
+	delete(repo.overrides, "overridden")
+	s.Invalidate("overridden")
+
+	if v := s.Get("overridden"); v != Defaults() {
+		t.Fatalf("expected the overrides to be dropped after invalidating the tenant, got %+v", v)
+	}
+}
+
+func TestGetFallsBackToDefaults(t *testing.T) {
+	l := zerolog.Nop()
+
+	s := NewService(&fakeOverrideRepository{err: errors.New("database is down")}, &l)
+
+	if v := s.Get("tenant"); v != Defaults() {
+		t.Fatalf("expected the defaults when the overrides can't be read, got %+v", v)
+	}
+}
+
+func TestDefaults(t *testing.T) {
+	if Defaults().DefaultStepTimeout != 5*time.Minute {
+		t.Fatalf("expected the default step timeout to be parsed, got %s", Defaults().DefaultStepTimeout)
+	}
+}
+
+func TestParseOverrides(t *testing.T) {
+	stepTimeout := "10m"
+	scheduleTimeout := "invalid"
+	retries := 3
+
+	o := ParseOverrides(&stepTimeout, &scheduleTimeout, &retries, nil)
+
+	if o.DefaultStepTimeout == nil || *o.DefaultStepTimeout != 10*time.Minute {
+		t.Fatalf("expected the step timeout to be parsed, got %v", o.DefaultStepTimeout)
+	}
+
+	if o.ScheduleTimeout != nil {
+		t.Fatalf("expected a schedule timeout which doesn't parse to be ignored, got %v", *o.ScheduleTimeout)
+	}
+
+	if o.DefaultStepRetries == nil || *o.DefaultStepRetries != retries || o.MaxFanOut != nil {
+		t.Fatalf("unexpected overrides %+v", o)
+	}
+}
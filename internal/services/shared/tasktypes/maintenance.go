@@ -0,0 +1,28 @@
+package tasktypes
+
+import (
+	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+)
+
+const (
+	MaintenanceJobStepRunRequeue  = "step-run-requeue"
+	MaintenanceJobStepRunReassign = "step-run-reassign"
+)
+
+type MaintenanceJobTaskPayload struct {
+	Job string `json:"job" validate:"required,oneof=step-run-requeue step-run-reassign"`
+}
+
+func MaintenanceJobToTask(job string) *msgqueue.Message {
+	payload, _ := datautils.ToJSONMap(MaintenanceJobTaskPayload{
+		Job: job,
+	})
+
+	return &msgqueue.Message{
+		ID:       "maintenance-job",
+		Payload:  payload,
+		Metadata: map[string]interface{}{},
+		Retries:  3,
+	}
+}
@@ -1,6 +1,8 @@
 package tasktypes
 
 import (
+	"time"
+
 	"github.com/hatchet-dev/hatchet/internal/datautils"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
@@ -84,6 +86,9 @@ type StepRunFailedTaskPayload struct {
 	StepRunId string `json:"step_run_id" validate:"required,uuid"`
 	FailedAt  string `json:"failed_at" validate:"required"`
 	Error     string `json:"error" validate:"required"`
+
+	// optional - if set, the step run will not be retried even if it has retries remaining
+	NoRetry bool `json:"no_retry,omitempty"`
 }
 
 type StepRunFailedTaskMetadata struct {
@@ -99,6 +104,38 @@ type StepRunTimedOutTaskMetadata struct {
 	TenantId string `json:"tenant_id" validate:"required,uuid"`
 }
 
+type StepRunReleasedTaskPayload struct {
+	StepRunId    string `json:"step_run_id" validate:"required,uuid"`
+	WorkerId     string `json:"worker_id" validate:"required,uuid"`
+	RequeueAfter string `json:"requeue_after" validate:"required"`
+
+	// optional - why the worker released the step run
+	Reason string `json:"reason,omitempty"`
+}
+
+type StepRunReleasedTaskMetadata struct {
+	TenantId string `json:"tenant_id" validate:"required,uuid"`
+}
+
+type StepRunTimeoutHookTaskPayload struct {
+	StepRunId string `json:"step_run_id" validate:"required,uuid"`
+}
+
+type StepRunTimeoutHookTaskMetadata struct {
+	TenantId string `json:"tenant_id" validate:"required,uuid"`
+}
+
+type StepRunTimeoutHookAssignedTaskPayload struct {
+	StepRunId string `json:"step_run_id" validate:"required,uuid"`
+	WorkerId  string `json:"worker_id" validate:"required,uuid"`
+	ActionId  string `json:"action_id" validate:"required,actionId"`
+}
+
+type StepRunTimeoutHookAssignedTaskMetadata struct {
+	TenantId     string `json:"tenant_id" validate:"required,uuid"`
+	DispatcherId string `json:"dispatcher_id" validate:"required,uuid"`
+}
+
 type StepRunRetryTaskPayload struct {
 	StepRunId string `json:"step_run_id" validate:"required,uuid"`
 	JobRunId  string `json:"job_run_id" validate:"required,uuid"`
@@ -111,6 +148,18 @@ type StepRunRetryTaskMetadata struct {
 	TenantId string `json:"tenant_id" validate:"required,uuid"`
 }
 
+type StepRunStreamEventTaskPayload struct {
+	StepRunId     string `json:"step_run_id" validate:"required,uuid"`
+	WorkflowRunId string `json:"workflow_run_id" validate:"required,uuid"`
+	CreatedAt     string `json:"created_at" validate:"required"`
+	Index         int    `json:"index"`
+	Message       string `json:"message" validate:"required"`
+}
+
+type StepRunStreamEventTaskMetadata struct {
+	TenantId string `json:"tenant_id" validate:"required,uuid"`
+}
+
 func TenantToStepRunRequeueTask(tenant db.TenantModel) *msgqueue.Message {
 	payload, _ := datautils.ToJSONMap(StepRunRequeueTaskPayload{
 		TenantId: tenant.ID,
@@ -128,6 +177,87 @@ func TenantToStepRunRequeueTask(tenant db.TenantModel) *msgqueue.Message {
 	}
 }
 
+func StepRunForceFailedToTask(tenantId, stepRunId string, failedAt time.Time, errorReason string) *msgqueue.Message {
+	payload, _ := datautils.ToJSONMap(StepRunFailedTaskPayload{
+		StepRunId: stepRunId,
+		FailedAt:  failedAt.Format(time.RFC3339),
+		Error:     errorReason,
+		NoRetry:   true,
+	})
+
+	metadata, _ := datautils.ToJSONMap(StepRunFailedTaskMetadata{
+		TenantId: tenantId,
+	})
+
+	return &msgqueue.Message{
+		ID:       "step-run-failed",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	}
+}
+
+// StepRunNotifyCancelToTask returns the message which cancels a step run, which notifies the worker of the step run
+// if it was assigned to one.
+func StepRunNotifyCancelToTask(tenantId, stepRunId, reason string) *msgqueue.Message {
+	payload, _ := datautils.ToJSONMap(StepRunNotifyCancelTaskPayload{
+		StepRunId:       stepRunId,
+		CancelledReason: reason,
+	})
+
+	metadata, _ := datautils.ToJSONMap(StepRunNotifyCancelTaskMetadata{
+		TenantId: tenantId,
+	})
+
+	return &msgqueue.Message{
+		ID:       "step-run-cancelled",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	}
+}
+
+// StepRunReleasedToTask returns the message which requeues a step run which its worker released, once the requeue
+// after time passed.
+func StepRunReleasedToTask(tenantId, stepRunId, workerId string, requeueAfter time.Time, reason string) *msgqueue.Message {
+	payload, _ := datautils.ToJSONMap(StepRunReleasedTaskPayload{
+		StepRunId:    stepRunId,
+		WorkerId:     workerId,
+		RequeueAfter: requeueAfter.Format(time.RFC3339),
+		Reason:       reason,
+	})
+
+	metadata, _ := datautils.ToJSONMap(StepRunReleasedTaskMetadata{
+		TenantId: tenantId,
+	})
+
+	return &msgqueue.Message{
+		ID:       "step-run-released",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	}
+}
+
+// StepRunTimeoutHookToTask returns the message which runs the on-timeout action of the step of a step run which
+// timed out. It is retried while no worker which registered the action is active.
+func StepRunTimeoutHookToTask(tenantId, stepRunId string) *msgqueue.Message {
+	payload, _ := datautils.ToJSONMap(StepRunTimeoutHookTaskPayload{
+		StepRunId: stepRunId,
+	})
+
+	metadata, _ := datautils.ToJSONMap(StepRunTimeoutHookTaskMetadata{
+		TenantId: tenantId,
+	})
+
+	return &msgqueue.Message{
+		ID:       "step-run-timeout-hook",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  5,
+	}
+}
+
 func StepRunRetryToTask(stepRun *dbsqlc.GetStepRunForEngineRow, inputData []byte) *msgqueue.Message {
 	jobRunId := sqlchelpers.UUIDToStr(stepRun.JobRunId)
 	stepRunId := sqlchelpers.UUIDToStr(stepRun.StepRun.ID)
@@ -173,3 +303,26 @@ func StepRunQueuedToTask(stepRun *dbsqlc.GetStepRunForEngineRow) *msgqueue.Messa
 		Retries:  3,
 	}
 }
+
+// StepRunStreamEventToTask returns the message which notifies the subscribers to the events of a tenant of a stream
+// event.
+func StepRunStreamEventToTask(streamEvent *dbsqlc.CreateStreamEventRow) *msgqueue.Message {
+	payload, _ := datautils.ToJSONMap(StepRunStreamEventTaskPayload{
+		StepRunId:     sqlchelpers.UUIDToStr(streamEvent.StepRunId),
+		WorkflowRunId: sqlchelpers.UUIDToStr(streamEvent.WorkflowRunId),
+		CreatedAt:     streamEvent.CreatedAt.Time.Format(time.RFC3339Nano),
+		Index:         int(streamEvent.Index),
+		Message:       streamEvent.Message,
+	})
+
+	metadata, _ := datautils.ToJSONMap(StepRunStreamEventTaskMetadata{
+		TenantId: sqlchelpers.UUIDToStr(streamEvent.TenantId),
+	})
+
+	return &msgqueue.Message{
+		ID:       "step-run-stream-event",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	}
+}
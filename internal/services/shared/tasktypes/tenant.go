@@ -0,0 +1,31 @@
+package tasktypes
+
+import (
+	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+)
+
+type TenantExportTaskPayload struct {
+	ExportId string `json:"export_id" validate:"required,uuid"`
+}
+
+type TenantExportTaskMetadata struct {
+	TenantId string `json:"tenant_id" validate:"required,uuid"`
+}
+
+func TenantExportToTask(tenantId, exportId string) *msgqueue.Message {
+	payload, _ := datautils.ToJSONMap(TenantExportTaskPayload{
+		ExportId: exportId,
+	})
+
+	metadata, _ := datautils.ToJSONMap(TenantExportTaskMetadata{
+		TenantId: tenantId,
+	})
+
+	return &msgqueue.Message{
+		ID:       "tenant-export",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	}
+}
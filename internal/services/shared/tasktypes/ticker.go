@@ -1,5 +1,12 @@
 package tasktypes
 
+import (
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+)
+
 type ScheduleStepRunTimeoutTaskPayload struct {
 	StepRunId string `json:"step_run_id" validate:"required,uuid"`
 	JobRunId  string `json:"job_run_id" validate:"required,uuid"`
@@ -10,6 +17,27 @@ type ScheduleStepRunTimeoutTaskMetadata struct {
 	TenantId string `json:"tenant_id" validate:"required,uuid"`
 }
 
+// ScheduleStepRunTimeoutToTask returns the task which schedules the timeout of a step run on a ticker. A step run
+// which is already scheduled is rescheduled.
+func ScheduleStepRunTimeoutToTask(tenantId, jobRunId, stepRunId string, timeoutAt time.Time) *msgqueue.Message {
+	payload, _ := datautils.ToJSONMap(ScheduleStepRunTimeoutTaskPayload{
+		StepRunId: stepRunId,
+		JobRunId:  jobRunId,
+		TimeoutAt: timeoutAt.Format(time.RFC3339),
+	})
+
+	metadata, _ := datautils.ToJSONMap(ScheduleStepRunTimeoutTaskMetadata{
+		TenantId: tenantId,
+	})
+
+	return &msgqueue.Message{
+		ID:       "schedule-step-run-timeout",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	}
+}
+
 type CancelStepRunTimeoutTaskPayload struct {
 	StepRunId string `json:"step_run_id" validate:"required,uuid"`
 }
@@ -4,6 +4,8 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/datautils"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
 )
 
 type WorkflowRunQueuedTaskPayload struct {
@@ -42,6 +44,87 @@ func WorkflowRunFinishedToTask(tenantId, workflowRunId, status string) *msgqueue
 	}
 }
 
+type WorkflowRunBudgetExceededTaskPayload struct {
+	WorkflowRunId string `json:"workflow_run_id" validate:"required,uuid"`
+	Reason        string `json:"reason" validate:"required"`
+}
+
+type WorkflowRunBudgetExceededTaskMetadata struct {
+	TenantId string `json:"tenant_id" validate:"required,uuid"`
+}
+
+// WorkflowRunBudgetExceededToTask returns the message which is sent when a workflow run exceeds the budget of its
+// workflow version, so that the workflows controller fails the run.
+func WorkflowRunBudgetExceededToTask(tenantId, workflowRunId, reason string) *msgqueue.Message {
+	payload, _ := datautils.ToJSONMap(WorkflowRunBudgetExceededTaskPayload{
+		WorkflowRunId: workflowRunId,
+		Reason:        reason,
+	})
+
+	metadata, _ := datautils.ToJSONMap(WorkflowRunBudgetExceededTaskMetadata{
+		TenantId: tenantId,
+	})
+
+	return &msgqueue.Message{
+		ID:       "workflow-run-budget-exceeded",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	}
+}
+
+type WorkflowUpdatedTaskPayload struct {
+	WorkflowId string `json:"workflow_id" validate:"required,uuid"`
+}
+
+type WorkflowUpdatedTaskMetadata struct {
+	TenantId string `json:"tenant_id" validate:"required,uuid"`
+}
+
+// WorkflowUpdatedToTask returns the message which is sent when a new version of a workflow is created or the
+// workflow is deleted, so that controllers drop the cached versions of the workflow.
+func WorkflowUpdatedToTask(tenantId, workflowId string) *msgqueue.Message {
+	payload, _ := datautils.ToJSONMap(WorkflowUpdatedTaskPayload{
+		WorkflowId: workflowId,
+	})
+
+	metadata, _ := datautils.ToJSONMap(WorkflowUpdatedTaskMetadata{
+		TenantId: tenantId,
+	})
+
+	return &msgqueue.Message{
+		ID:       "workflow-updated",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	}
+}
+
+type WorkflowRunBulkRetryTaskPayload struct {
+	BulkRetryId string `json:"bulk_retry_id" validate:"required,uuid"`
+}
+
+type WorkflowRunBulkRetryTaskMetadata struct {
+	TenantId string `json:"tenant_id" validate:"required,uuid"`
+}
+
+func WorkflowRunBulkRetryToTask(tenantId, bulkRetryId string) *msgqueue.Message {
+	payload, _ := datautils.ToJSONMap(WorkflowRunBulkRetryTaskPayload{
+		BulkRetryId: bulkRetryId,
+	})
+
+	metadata, _ := datautils.ToJSONMap(WorkflowRunBulkRetryTaskMetadata{
+		TenantId: tenantId,
+	})
+
+	return &msgqueue.Message{
+		ID:       "workflow-run-bulk-retry",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	}
+}
+
 func WorkflowRunQueuedToTask(workflowRun *db.WorkflowRunModel) *msgqueue.Message {
 	payload, _ := datautils.ToJSONMap(WorkflowRunQueuedTaskPayload{
 		WorkflowRunId: workflowRun.ID,
@@ -59,3 +142,23 @@ func WorkflowRunQueuedToTask(workflowRun *db.WorkflowRunModel) *msgqueue.Message
 		Retries:  3,
 	}
 }
+
+// ReleasedWorkflowRunToTask returns the message which queues a workflow run which was buffered while its workflow
+// or tenant was paused.
+func ReleasedWorkflowRunToTask(workflowRun *dbsqlc.WorkflowRun) *msgqueue.Message {
+	payload, _ := datautils.ToJSONMap(WorkflowRunQueuedTaskPayload{
+		WorkflowRunId: sqlchelpers.UUIDToStr(workflowRun.ID),
+	})
+
+	metadata, _ := datautils.ToJSONMap(WorkflowRunQueuedTaskMetadata{
+		WorkflowVersionId: sqlchelpers.UUIDToStr(workflowRun.WorkflowVersionId),
+		TenantId:          sqlchelpers.UUIDToStr(workflowRun.TenantId),
+	})
+
+	return &msgqueue.Message{
+		ID:       "workflow-run-queued",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	}
+}
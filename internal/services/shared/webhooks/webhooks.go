@@ -0,0 +1,174 @@
+// Package webhooks records the deliveries of inbound webhooks, and protects webhook handlers against replayed
+// deliveries with a nonce and a timestamp from the signed payload of each delivery.
+package webhooks
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+)
+
+// ErrReplayed is returned when a webhook already received a delivery with the same payload.
+var ErrReplayed = errors.New("webhook delivery was already received")
+
+// ErrExpired is returned when a delivery was sent outside of the replay window.
+var ErrExpired = errors.New("webhook delivery was sent outside of the replay window")
+
+// Delivery is a delivery of a webhook whose signature was validated.
+type Delivery struct {
+	Source    dbsqlc.WebhookDeliverySource
+	TenantId  string
+	WebhookId string
+
+	// DeliveryId is the id of the delivery which was assigned by the source, if any. It is only recorded, as it
+	// is not covered by the signature of the payload.
+	DeliveryId string
+
+	// Event is the event type of the delivery
+	Event string
+
+	// Payload is the signed payload of the delivery
+	Payload []byte
+
+	// SentAt is the time the delivery was sent at, read from the signed payload. Deliveries without a timestamp
+	// are only protected by their nonce.
+	SentAt *time.Time
+}
+
+// Nonce returns the nonce of a delivery with the given payload, which is the SHA-256 digest of the payload.
+func Nonce(payload []byte) string {
+	sum := sha256.Sum256(payload)
+
+	return hex.EncodeToString(sum[:])
+}
+
+type Receiver interface {
+	// Receive records a delivery before it is processed. It returns ErrReplayed if the webhook already received the
+	// delivery, and ErrExpired if the delivery was sent outside of the replay window.
+	Receive(ctx context.Context, d *Delivery) (*dbsqlc.WebhookDelivery, error)
+
+	// Finish records the result of processing a delivery, which failed if err is not nil.
+	Finish(ctx context.Context, delivery *dbsqlc.WebhookDelivery, err error) error
+
+	// SetOpts replaces the options of the receiver while it is in use.
+	SetOpts(opts *ReceiverOpts)
+}
+
+type ReceiverOpts struct {
+	// ReplayWindow is how far the time a delivery was sent at may be from the time it is received, and how long
+	// nonces are remembered at least. If 0, replay protection is disabled and deliveries are only recorded.
+	ReplayWindow time.Duration
+
+	// DeliveryRetention is how long deliveries are kept, which is at least the replay window. If both are 0,
+	// deliveries are never deleted.
+	DeliveryRetention time.Duration
+}
+
+type receiver struct {
+	repo repository.WebhookDeliveryRepository
+	opts atomic.Pointer[ReceiverOpts]
+}
+
+func NewReceiver(repo repository.WebhookDeliveryRepository, opts *ReceiverOpts) Receiver {
+	r := &receiver{
+		repo: repo,
+	}
+
+	r.SetOpts(opts)
+
+	return r
+}
+
+func (r *receiver) SetOpts(opts *ReceiverOpts) {
+	r.opts.Store(opts)
+}
+
+func (r *receiver) Receive(ctx context.Context, d *Delivery) (*dbsqlc.WebhookDelivery, error) {
+	opts := r.opts.Load()
+	now := time.Now().UTC()
+
+	// expired deliveries are deleted as the webhook receives new ones. The nonces of deliveries are kept for the
+	// replay window, so that replays are rejected even if the retention is shorter.
+	if retention := opts.retention(); retention > 0 {
+		if _, err := r.repo.DeleteExpiredWebhookDeliveries(ctx, d.WebhookId, now.Add(-retention)); err != nil {
+			return nil, fmt.Errorf("could not delete expired webhook deliveries: %w", err)
+		}
+	}
+
+	createOpts := &repository.CreateWebhookDeliveryOpts{
+		Source:           d.Source,
+		WebhookId:        d.WebhookId,
+		Event:            d.Event,
+		Nonce:            Nonce(d.Payload),
+		SentAt:           d.SentAt,
+		Status:           dbsqlc.WebhookDeliveryStatusRECEIVED,
+		ReplayProtection: opts.ReplayWindow > 0,
+	}
+
+	if d.DeliveryId != "" {
+		createOpts.DeliveryId = &d.DeliveryId
+	}
+
+	var resErr error
+
+	if opts.ReplayWindow > 0 && d.SentAt != nil && !withinWindow(now, *d.SentAt, opts.ReplayWindow) {
+		resErr = ErrExpired
+
+		createOpts.Status = dbsqlc.WebhookDeliveryStatusREJECTED
+		createOpts.Error = repository.StringPtr(fmt.Sprintf("delivery was sent at %s, outside of the replay window of %s", d.SentAt.UTC().Format(time.RFC3339), opts.ReplayWindow))
+	}
+
+	delivery, err := r.repo.CreateWebhookDelivery(ctx, d.TenantId, createOpts)
+
+	if errors.Is(err, repository.ErrDuplicateWebhookDelivery) {
+		return nil, ErrReplayed
+	} else if err != nil {
+		return nil, fmt.Errorf("could not create webhook delivery: %w", err)
+	}
+
+	if resErr != nil {
+		return nil, resErr
+	}
+
+	return delivery, nil
+}
+
+func (r *receiver) Finish(ctx context.Context, delivery *dbsqlc.WebhookDelivery, err error) error {
+	status := dbsqlc.WebhookDeliveryStatusPROCESSED
+
+	var errMsg *string
+
+	if err != nil {
+		status = dbsqlc.WebhookDeliveryStatusFAILED
+		errMsg = repository.StringPtr(err.Error())
+	}
+
+	return r.repo.UpdateWebhookDeliveryStatus(ctx, sqlchelpers.UUIDToStr(delivery.ID), status, errMsg)
+}
+
+// retention returns how long deliveries are kept, which is at least the replay window.
+func (opts *ReceiverOpts) retention() time.Duration {
+	if opts.DeliveryRetention < opts.ReplayWindow {
+		return opts.ReplayWindow
+	}
+
+	return opts.DeliveryRetention
+}
+
+func withinWindow(now, sentAt time.Time, window time.Duration) bool {
+	diff := now.Sub(sentAt)
+
+	if diff < 0 {
+		diff = -diff
+	}
+
+	return diff <= window
+}
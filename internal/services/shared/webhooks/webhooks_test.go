@@ -0,0 +1,177 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+type fakeWebhookDeliveryRepository struct {
+	repository.WebhookDeliveryRepository
+
+	statuses map[string]dbsqlc.WebhookDeliveryStatus
+	before   time.Time
+}
+
+func newFakeWebhookDeliveryRepository() *fakeWebhookDeliveryRepository {
+	return &fakeWebhookDeliveryRepository{
+		statuses: map[string]dbsqlc.WebhookDeliveryStatus{},
+	}
+}
+
+func (r *fakeWebhookDeliveryRepository) CreateWebhookDelivery(ctx context.Context, tenantId string, opts *repository.CreateWebhookDeliveryOpts) (*dbsqlc.WebhookDelivery, error) {
+	if status, ok := r.statuses[opts.Nonce]; ok && opts.ReplayProtection && status != dbsqlc.WebhookDeliveryStatusFAILED {
+		return nil, repository.ErrDuplicateWebhookDelivery
+	}
+
+	r.statuses[opts.Nonce] = opts.Status
+
+	return &dbsqlc.WebhookDelivery{
+		Nonce:  opts.Nonce,
+		Status: opts.Status,
+	}, nil
+}
+
+func (r *fakeWebhookDeliveryRepository) UpdateWebhookDeliveryStatus(ctx context.Context, id string, status dbsqlc.WebhookDeliveryStatus, errMsg *string) error {
+	for nonce := range r.statuses {
+		r.statuses[nonce] = status
+	}
+
+	return nil
+}
+
+func (r *fakeWebhookDeliveryRepository) DeleteExpiredWebhookDeliveries(ctx context.Context, webhookId string, before time.Time) (int64, error) {
+	r.before = before
+
+	return 0, nil
+}
+
+func delivery(payload string, sentAt *time.Time) *Delivery {
+	return &Delivery{
+		Source:    dbsqlc.WebhookDeliverySourceGITHUB,
+		TenantId:  "tenant",
+		WebhookId: "webhook",
+		Event:     "pull_request",
+		Payload:   []byte(payload),
+		SentAt:    sentAt,
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestReceive(t *testing.T) {
+	now := time.Now().UTC()
+
+	tests := []struct {
+		name     string
+		opts     ReceiverOpts
+		received []string
+		delivery *Delivery
+		expected error
+	}{
+		{
+			name:     "new delivery",
+			opts:     ReceiverOpts{ReplayWindow: 5 * time.Minute},
+			received: []string{"a"},
+			delivery: delivery("b", timePtr(now)),
+		},
+		{
+			name:     "replayed delivery",
+			opts:     ReceiverOpts{ReplayWindow: 5 * time.Minute},
+			received: []string{"a"},
+			delivery: delivery("a", nil),
+			expected: ErrReplayed,
+		},
+		{
+			name:     "replayed delivery without replay protection",
+			received: []string{"a"},
+			delivery: delivery("a", nil),
+		},
+		{
+			name:     "expired delivery",
+			opts:     ReceiverOpts{ReplayWindow: 5 * time.Minute},
+			delivery: delivery("a", timePtr(now.Add(-10*time.Minute))),
+			expected: ErrExpired,
+		},
+		{
+			name:     "delivery from the future",
+			opts:     ReceiverOpts{ReplayWindow: 5 * time.Minute},
+			delivery: delivery("a", timePtr(now.Add(10*time.Minute))),
+			expected: ErrExpired,
+		},
+		{
+			name:     "expired delivery without replay protection",
+			delivery: delivery("a", timePtr(now.Add(-10*time.Minute))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeWebhookDeliveryRepository()
+
+			for _, payload := range tt.received {
+				repo.statuses[Nonce([]byte(payload))] = dbsqlc.WebhookDeliveryStatusPROCESSED
+			}
+
+			_, err := NewReceiver(repo, &tt.opts).Receive(context.Background(), tt.delivery)
+
+			if !errors.Is(err, tt.expected) {
+				t.Fatalf("expected error %v, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestRedeliverFailedDelivery(t *testing.T) {
+	repo := newFakeWebhookDeliveryRepository()
+	r := NewReceiver(repo, &ReceiverOpts{ReplayWindow: 5 * time.Minute})
+
+	d, err := r.Receive(context.Background(), delivery("a", nil))
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if err := r.Finish(context.Background(), d, errors.New("ingestion is paused")); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if _, err := r.Receive(context.Background(), delivery("a", nil)); err != nil {
+		t.Fatalf("expected failed delivery to be redelivered, got %v", err)
+	}
+
+	if err := r.Finish(context.Background(), d, nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if _, err := r.Receive(context.Background(), delivery("a", nil)); !errors.Is(err, ErrReplayed) {
+		t.Fatalf("expected processed delivery to be rejected, got %v", err)
+	}
+}
+
+func TestRetention(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     ReceiverOpts
+		expected time.Duration
+	}{
+		{name: "retention", opts: ReceiverOpts{ReplayWindow: 5 * time.Minute, DeliveryRetention: time.Hour}, expected: time.Hour},
+		{name: "retention shorter than replay window", opts: ReceiverOpts{ReplayWindow: time.Hour, DeliveryRetention: time.Minute}, expected: time.Hour},
+		{name: "no retention", opts: ReceiverOpts{ReplayWindow: 5 * time.Minute}, expected: 5 * time.Minute},
+		{name: "disabled", opts: ReceiverOpts{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if retention := tt.opts.retention(); retention != tt.expected {
+				t.Errorf("expected retention to be %s, got %s", tt.expected, retention)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package ticker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/anomaly"
+)
+
+// AnomalyDetectedEventKey is the key of the event which is emitted when the failure rate or the duration of the
+// runs of a workflow in a window deviates from the baseline of the workflow.
+const AnomalyDetectedEventKey = "anomaly-detected"
+
+// maxAnomalyWorkflowsPerCheck is the maximum number of workflows which are analyzed on each check, so that the
+// workflows of a window are spread over multiple checks.
+const maxAnomalyWorkflowsPerCheck = 1000
+
+// anomalyWindowDelay is how long after the end of a window it is analyzed, so that runs which finished at the end
+// of the window have been written.
+const anomalyWindowDelay = time.Minute
+
+func (t *TickerImpl) runDetectAnomalies(ctx context.Context) func() {
+	return func() {
+		t.l.Debug().Msgf("ticker: detecting workflow anomalies")
+
+		windowEnd := time.Now().UTC().Add(-anomalyWindowDelay).Truncate(t.anomalyWindow)
+		windowStart := windowEnd.Add(-t.anomalyWindow)
+
+		windows, err := t.repo.Workflow().ListWorkflowAnomalyWindows(ctx, windowStart, windowEnd, maxAnomalyWorkflowsPerCheck)
+
+		if err != nil {
+			t.l.Err(err).Msg("could not list workflow anomaly windows")
+			return
+		}
+
+		for _, window := range windows {
+			tenantId := sqlchelpers.UUIDToStr(window.TenantId)
+			workflowId := sqlchelpers.UUIDToStr(window.WorkflowId)
+
+			var baseline *anomaly.Baseline
+
+			if window.Windows.Valid {
+				baseline = &anomaly.Baseline{
+					Windows:             int(window.Windows.Int32),
+					FailureRateMean:     window.FailureRateMean.Float64,
+					FailureRateVariance: window.FailureRateVariance.Float64,
+					DurationMean:        window.DurationMean.Float64,
+					DurationVariance:    window.DurationVariance.Float64,
+				}
+			}
+
+			next, anomalies := anomaly.Analyze(baseline, &anomaly.Window{
+				Finished: window.Finished,
+				Failed:   window.Failed,
+				Duration: window.Duration,
+			}, t.anomalyOpts)
+
+			// the baseline is written before the anomalies are emitted, so if multiple tickers are running, each
+			// window is only emitted by one of them
+			updated, err := t.repo.Workflow().UpsertWorkflowAnomalyBaseline(ctx, tenantId, workflowId, windowEnd, next)
+
+			if err != nil {
+				t.l.Err(err).Msgf("could not update anomaly baseline of workflow %s", workflowId)
+				continue
+			}
+
+			if updated == nil {
+				continue
+			}
+
+			for _, a := range anomalies {
+				data := map[string]interface{}{
+					"workflowId":   workflowId,
+					"workflowName": window.WorkflowName,
+					"kind":         string(a.Kind),
+					"value":        a.Value,
+					"baseline":     a.Baseline,
+					"deviation":    a.Deviation,
+					"runs":         window.Finished,
+					"failedRuns":   window.Failed,
+					"windowStart":  windowStart.Format(time.RFC3339),
+					"windowEnd":    windowEnd.Format(time.RFC3339),
+				}
+
+				if _, err := t.i.IngestEvent(ctx, tenantId, AnomalyDetectedEventKey, data); err != nil {
+					t.l.Err(err).Msgf("could not emit anomaly of workflow %s", workflowId)
+				}
+
+				alertData := map[string]interface{}{
+					"tenantId": tenantId,
+				}
+
+				for k, v := range data {
+					alertData[k] = v
+				}
+
+				t.aa.SendAlert(ctx, anomalyError(window.WorkflowName, windowEnd, a), alertData)
+			}
+		}
+	}
+}
+
+func anomalyError(workflowName string, windowEnd time.Time, a anomaly.Anomaly) error {
+	switch a.Kind {
+	case anomaly.KindFailureRate:
+		return fmt.Errorf(
+			"workflow %s failed %.1f%% of runs in the window ending at %s, above its baseline of %.1f%%",
+			workflowName,
+			a.Value*100,
+			windowEnd.Format(time.RFC3339),
+			a.Baseline*100,
+		)
+	default:
+		return fmt.Errorf(
+			"runs of workflow %s took %.1fs on average in the window ending at %s, above its baseline of %.1fs",
+			workflowName,
+			a.Value,
+			windowEnd.Format(time.RFC3339),
+			a.Baseline,
+		)
+	}
+}
@@ -2,6 +2,7 @@ package ticker
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -78,6 +79,11 @@ func (t *TickerImpl) runCronWorkflow(ctx context.Context, tenantId string, paylo
 
 		workflowRun, err := t.repo.WorkflowRun().CreateNewWorkflowRun(ctx, tenantId, createOpts)
 
+		if errors.Is(err, repository.ErrWorkflowPaused) {
+			t.l.Info().Msg("workflow is paused, skipping cron run")
+			return
+		}
+
 		if err != nil {
 			t.l.Err(err).Msg("could not create workflow run")
 			return
@@ -0,0 +1,34 @@
+package ticker
+
+import (
+	"context"
+)
+
+const idempotencyKeyDeleteBatchSize = 1000
+
+func (t *TickerImpl) runDeleteExpiredIdempotencyKeys(ctx context.Context) func() {
+	return func() {
+		t.l.Debug().Msgf("ticker: deleting expired idempotency keys")
+
+		var total int64
+
+		for {
+			deleted, err := t.repo.IdempotencyKey().DeleteExpiredIdempotencyKeys(ctx, idempotencyKeyDeleteBatchSize)
+
+			if err != nil {
+				t.l.Err(err).Msg("could not delete expired idempotency keys")
+				return
+			}
+
+			total += deleted
+
+			if deleted < idempotencyKeyDeleteBatchSize {
+				break
+			}
+		}
+
+		if total > 0 {
+			t.l.Debug().Msgf("deleted %d expired idempotency keys", total)
+		}
+	}
+}
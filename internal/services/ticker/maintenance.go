@@ -0,0 +1,70 @@
+package ticker
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/maintenance"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+)
+
+func (t *TickerImpl) runMaintenanceWindows(ctx context.Context) func() {
+	return func() {
+		t.l.Debug().Msgf("ticker: checking maintenance windows")
+
+		windows, err := t.repo.Workflow().ListAllWorkflowMaintenanceWindows(ctx)
+
+		if err != nil {
+			t.l.Err(err).Msg("could not list maintenance windows")
+			return
+		}
+
+		now := time.Now().UTC()
+
+		for _, window := range windows {
+			windowId := sqlchelpers.UUIDToStr(window.ID)
+
+			schedule, err := maintenance.ParseCron(window.Cron)
+
+			if err != nil {
+				t.l.Err(err).Msgf("could not parse cron of maintenance window %s", windowId)
+				continue
+			}
+
+			until, ok := maintenance.InProgressUntil(schedule, time.Duration(window.DurationSeconds)*time.Second, now)
+
+			if !ok {
+				continue
+			}
+
+			// starting a maintenance which is already in progress only extends it, so every ticker can do this
+			err = t.repo.Workflow().StartWorkflowMaintenance(ctx, sqlchelpers.UUIDToStr(window.WorkflowId), until)
+
+			if err != nil {
+				t.l.Err(err).Msgf("could not start maintenance window %s", windowId)
+			}
+		}
+
+		// maintenance which has ended is only ended by one ticker, so each buffered run is released once
+		released, err := t.repo.Workflow().EndWorkflowMaintenance(ctx)
+
+		if err != nil {
+			t.l.Err(err).Msg("could not end maintenance windows")
+			return
+		}
+
+		for _, workflowRun := range released {
+			err = t.mq.AddMessage(
+				ctx,
+				msgqueue.WORKFLOW_PROCESSING_QUEUE,
+				tasktypes.ReleasedWorkflowRunToTask(workflowRun),
+			)
+
+			if err != nil {
+				t.l.Err(err).Msgf("could not add workflow run %s to queue", sqlchelpers.UUIDToStr(workflowRun.ID))
+			}
+		}
+	}
+}
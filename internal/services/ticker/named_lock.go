@@ -0,0 +1,22 @@
+package ticker
+
+import (
+	"context"
+)
+
+func (t *TickerImpl) runDeleteReleasedNamedLocks(ctx context.Context) func() {
+	return func() {
+		t.l.Debug().Msgf("ticker: deleting released named locks")
+
+		deleted, err := t.repo.NamedLock().DeleteReleasedNamedLocks(ctx)
+
+		if err != nil {
+			t.l.Err(err).Msg("could not delete released named locks")
+			return
+		}
+
+		if deleted > 0 {
+			t.l.Debug().Msgf("deleted %d released named locks", deleted)
+		}
+	}
+}
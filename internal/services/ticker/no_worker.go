@@ -0,0 +1,63 @@
+package ticker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+)
+
+// NoWorkerAvailableEventKey is the key of the event which is emitted when a step run has waited for a worker with
+// a free slot for longer than the threshold.
+const NoWorkerAvailableEventKey = "no-worker-available"
+
+// maxWaitingForWorkerPerCheck is the maximum number of step runs which are marked as waiting for a worker on each
+// check, so that a backlog of step runs is spread over multiple checks.
+const maxWaitingForWorkerPerCheck = 1000
+
+func (t *TickerImpl) runCheckWaitingForWorker(ctx context.Context) func() {
+	return func() {
+		t.l.Debug().Msgf("ticker: checking step runs waiting for a worker")
+
+		// the step runs are marked in the database before they are emitted, so if multiple tickers are running,
+		// each step run is only emitted by one of them
+		stepRuns, err := t.repo.StepRun().MarkStepRunsWaitingForWorker(ctx, t.noWorkerThreshold, maxWaitingForWorkerPerCheck)
+
+		if err != nil {
+			t.l.Err(err).Msg("could not check step runs waiting for a worker")
+			return
+		}
+
+		for _, stepRun := range stepRuns {
+			tenantId := sqlchelpers.UUIDToStr(stepRun.TenantId)
+			stepRunId := sqlchelpers.UUIDToStr(stepRun.ID)
+
+			data := map[string]interface{}{
+				"stepRunId":      stepRunId,
+				"workflowRunId":  sqlchelpers.UUIDToStr(stepRun.WorkflowRunId),
+				"stepReadableId": stepRun.StepReadableId.String,
+				"actionId":       stepRun.ActionId,
+				"waitingSince":   stepRun.SlotWaitStartedAt.Time.UTC().Format(time.RFC3339),
+			}
+
+			if _, err := t.i.IngestEvent(ctx, tenantId, NoWorkerAvailableEventKey, data); err != nil {
+				t.l.Err(err).Msgf("could not emit no worker available event for step run %s", stepRunId)
+			}
+
+			alertData := map[string]interface{}{
+				"tenantId": tenantId,
+			}
+
+			for k, v := range data {
+				alertData[k] = v
+			}
+
+			t.na.SendAlert(
+				ctx,
+				fmt.Errorf("step run %s has waited for a worker for action %s for longer than %s", stepRunId, stepRun.ActionId, t.noWorkerThreshold),
+				alertData,
+			)
+		}
+	}
+}
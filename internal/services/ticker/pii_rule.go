@@ -0,0 +1,143 @@
+package ticker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/datautils/redact"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+)
+
+// maxPIIRulesPerCheck is the maximum number of PII rules which are purged on each check.
+const maxPIIRulesPerCheck = 20
+
+// maxStepRunsPerPurge is the maximum number of step runs which are purged for a rule at once. If more step runs are
+// due, the rule is purged again on the next check.
+const maxStepRunsPerPurge = 500
+
+// piiPurgeInterval is how often the step runs of a rule are purged when it has caught up with its retention.
+const piiPurgeInterval = time.Minute
+
+func (t *TickerImpl) runPurgePII(ctx context.Context) func() {
+	return func() {
+		t.l.Debug().Msgf("ticker: purging PII")
+
+		// the rules are claimed in the database before they are purged, so if multiple tickers are running, each
+		// rule is only purged by one of them at a time
+		rules, err := t.repo.PIIRule().ClaimPIIRulesToPurge(ctx, maxPIIRulesPerCheck)
+
+		if err != nil {
+			t.l.Err(err).Msg("could not claim PII rules")
+			return
+		}
+
+		for _, rule := range rules {
+			t.purgePIIRule(ctx, rule)
+		}
+	}
+}
+
+func (t *TickerImpl) purgePIIRule(ctx context.Context, rule *dbsqlc.PIIRule) {
+	ruleId := sqlchelpers.UUIDToStr(rule.ID)
+
+	rules, err := redact.Compile([]string{rule.Path})
+
+	if err != nil {
+		// the path is validated when the rule is created, so the rule is left claimed and retried once the claim
+		// expires
+		t.l.Err(err).Msgf("could not compile PII rule %s", ruleId)
+		return
+	}
+
+	retention := time.Duration(rule.RetentionSeconds) * time.Second
+
+	res, err := t.repo.PIIRule().PurgeStepRuns(ctx, rule, &repository.PurgeStepRunsOpts{
+		Before: time.Now().UTC().Add(-retention),
+		Limit:  maxStepRunsPerPurge,
+		Purge:  rules.PurgeJSON,
+		PurgeLookupData: func(data []byte) ([]byte, bool) {
+			return purgeLookupData(rules, data)
+		},
+	})
+
+	if err != nil {
+		t.l.Err(err).Msgf("could not purge step runs of PII rule %s", ruleId)
+		return
+	}
+
+	if res.Purged > 0 {
+		t.l.Debug().Msgf("purged %d records of %d step runs for PII rule %s", res.Purged, res.StepRuns, ruleId)
+	}
+
+	nextPurgeAt := time.Now().UTC().Add(piiPurgeInterval)
+
+	// if the batch was full, there are more step runs which are due
+	if res.StepRuns == maxStepRunsPerPurge {
+		nextPurgeAt = time.Now().UTC()
+	}
+
+	if err := t.repo.PIIRule().UpdatePIIRuleNextPurge(ctx, ruleId, nextPurgeAt); err != nil {
+		t.l.Err(err).Msgf("could not update next purge of PII rule %s", ruleId)
+	}
+}
+
+// purgeLookupData purges the lookup data of a job run, which the inputs of its step runs are built from. The data
+// holds the workflow input and the outputs of the steps, so a rule is matched against it like against a step run
+// input, in which the outputs are the parents, and against each output like against a step run output.
+func purgeLookupData(rules *redact.Rules, data []byte) ([]byte, bool) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	lookup := map[string]interface{}{}
+
+	if err := dec.Decode(&lookup); err != nil {
+		return data, false
+	}
+
+	steps, hasSteps := lookup["steps"]
+
+	if hasSteps {
+		delete(lookup, "steps")
+		lookup["parents"] = steps
+	}
+
+	v, purged := rules.Purge(lookup)
+
+	// a rule which matches the document itself replaces it
+	lookup, ok := v.(map[string]interface{})
+
+	if !ok {
+		return data, false
+	}
+
+	if parents, ok := lookup["parents"]; ok && hasSteps {
+		delete(lookup, "parents")
+		lookup["steps"] = parents
+	}
+
+	if outputs, ok := lookup["steps"].(map[string]interface{}); ok {
+		for name, output := range outputs {
+			var outputPurged bool
+
+			outputs[name], outputPurged = rules.Purge(output)
+
+			purged = purged || outputPurged
+		}
+	}
+
+	if !purged {
+		return data, false
+	}
+
+	res, err := json.Marshal(lookup)
+
+	if err != nil {
+		return data, false
+	}
+
+	return res, true
+}
@@ -0,0 +1,99 @@
+package ticker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/dbquery"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+)
+
+// maxQueryTriggersPerCheck is the maximum number of query triggers which are run on each check, so that a backlog
+// of due triggers is spread over multiple checks and tickers.
+const maxQueryTriggersPerCheck = 20
+
+func (t *TickerImpl) runQueryTriggers(ctx context.Context) func() {
+	return func() {
+		t.l.Debug().Msgf("ticker: running query triggers")
+
+		// the triggers are claimed in the database before they run, so if multiple tickers are running, each run
+		// only happens on one of them
+		triggers, err := t.repo.QueryTrigger().ClaimQueryTriggersToRun(ctx, maxQueryTriggersPerCheck)
+
+		if err != nil {
+			t.l.Err(err).Msg("could not claim query triggers")
+			return
+		}
+
+		wg := sync.WaitGroup{}
+
+		// the queries run against different databases, so a slow database doesn't hold up the other triggers
+		for _, trigger := range triggers {
+			wg.Add(1)
+
+			go func(trigger *dbsqlc.QueryTrigger) {
+				defer wg.Done()
+
+				t.runQueryTrigger(ctx, trigger)
+			}(trigger)
+		}
+
+		wg.Wait()
+	}
+}
+
+func (t *TickerImpl) runQueryTrigger(ctx context.Context, trigger *dbsqlc.QueryTrigger) {
+	triggerId := sqlchelpers.UUIDToStr(trigger.ID)
+
+	runErr := t.runQuery(ctx, trigger)
+
+	if runErr != nil {
+		t.l.Warn().Err(runErr).Msgf("could not run query trigger %s", triggerId)
+	}
+
+	schedule, err := dbquery.ParseSchedule(trigger.Cron)
+
+	if err != nil {
+		t.l.Err(err).Msgf("could not parse cron of query trigger %s", triggerId)
+		return
+	}
+
+	if err := t.repo.QueryTrigger().UpdateQueryTriggerRun(ctx, triggerId, runErr, schedule.Next(time.Now().UTC())); err != nil {
+		t.l.Err(err).Msgf("could not update run of query trigger %s", triggerId)
+	}
+}
+
+// runQuery runs the query of a trigger and creates an event for the rows it returns.
+func (t *TickerImpl) runQuery(ctx context.Context, trigger *dbsqlc.QueryTrigger) error {
+	tenantId := sqlchelpers.UUIDToStr(trigger.TenantId)
+
+	config, err := t.enc.Decrypt(trigger.Config, dbquery.ConfigDataId)
+
+	if err != nil {
+		return fmt.Errorf("could not decrypt config: %w", err)
+	}
+
+	triggerConfig := &dbquery.Config{}
+
+	if err := json.Unmarshal(config, triggerConfig); err != nil {
+		return fmt.Errorf("could not unmarshal config: %w", err)
+	}
+
+	rows, err := dbquery.Run(ctx, triggerConfig, trigger.Query)
+
+	if err != nil {
+		return err
+	}
+
+	for _, data := range dbquery.Events(dbquery.Mode(trigger.Mode), trigger.Name, rows) {
+		if _, err := t.i.IngestEvent(ctx, tenantId, trigger.EventKey, data); err != nil {
+			return fmt.Errorf("could not ingest event: %w", err)
+		}
+	}
+
+	return nil
+}
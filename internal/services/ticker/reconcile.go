@@ -0,0 +1,134 @@
+package ticker
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+)
+
+// RunRepairedEventKey is the key of the event which is emitted when the reconciler repairs a run whose status was
+// inconsistent, so that every repair can be audited by the tenant.
+const RunRepairedEventKey = "run-repaired"
+
+// maxRepairsPerCheck is the maximum number of runs of each kind which are repaired on each check.
+const maxRepairsPerCheck = 100
+
+// repairGracePeriod is how long a run has to be inconsistent before it is repaired, so that runs which are being
+// updated by another engine instance are not repaired.
+const repairGracePeriod = time.Minute
+
+// orphanedStepRunTimeout is how long a step run can be assigned to a worker which doesn't send heartbeats before it
+// is moved back to pending assignment.
+const orphanedStepRunTimeout = 5 * time.Minute
+
+const (
+	repairFinishedJobRun         = "finished-job-run"
+	repairFinishedWorkflowRun    = "finished-workflow-run"
+	repairOrphanedStepRun        = "orphaned-step-run"
+	repairTimedOutGetGroupKeyRun = "timed-out-get-group-key-run"
+)
+
+func (t *TickerImpl) runReconcile(ctx context.Context) func() {
+	return func() {
+		t.l.Debug().Msgf("ticker: reconciling runs")
+
+		// runs are locked while they are repaired, so if multiple tickers are running, each run is only repaired
+		// by one of them
+		t.repairFinishedRuns(ctx)
+		t.repairOrphanedStepRuns(ctx)
+		t.repairTimedOutGetGroupKeyRuns(ctx)
+	}
+}
+
+func (t *TickerImpl) repairFinishedRuns(ctx context.Context) {
+	runs, err := t.repo.Reconciler().RepairFinishedRuns(ctx, repairGracePeriod, maxRepairsPerCheck)
+
+	if err != nil {
+		t.l.Err(err).Msg("could not repair finished runs")
+		return
+	}
+
+	for _, run := range runs {
+		data := map[string]interface{}{
+			"repair":            repairFinishedWorkflowRun,
+			"workflowRunId":     run.WorkflowRunId,
+			"workflowRunStatus": run.WorkflowRunStatus,
+		}
+
+		if run.JobRunId != "" {
+			data["repair"] = repairFinishedJobRun
+			data["jobRunId"] = run.JobRunId
+			data["jobRunStatus"] = run.JobRunStatus
+		}
+
+		t.emitRepair(ctx, run.TenantId, data)
+
+		if run.WorkflowRunFinalState {
+			t.finishWorkflowRun(ctx, run.TenantId, run.WorkflowRunId, run.WorkflowRunStatus)
+		}
+	}
+}
+
+func (t *TickerImpl) repairOrphanedStepRuns(ctx context.Context) {
+	stepRuns, err := t.repo.Reconciler().RepairOrphanedStepRuns(ctx, orphanedStepRunTimeout, maxRepairsPerCheck)
+
+	if err != nil {
+		t.l.Err(err).Msg("could not repair orphaned step runs")
+		return
+	}
+
+	// the step runs are requeued by the jobs controller, as their requeue time has passed
+	for _, stepRun := range stepRuns {
+		t.emitRepair(ctx, stepRun.TenantId, map[string]interface{}{
+			"repair":           repairOrphanedStepRun,
+			"workflowRunId":    stepRun.WorkflowRunId,
+			"stepRunId":        stepRun.StepRunId,
+			"stepReadableId":   stepRun.StepReadableId,
+			"previousWorkerId": stepRun.PreviousWorkerId,
+		})
+	}
+}
+
+func (t *TickerImpl) repairTimedOutGetGroupKeyRuns(ctx context.Context) {
+	getGroupKeyRuns, err := t.repo.Reconciler().RepairTimedOutGetGroupKeyRuns(ctx, repairGracePeriod, maxRepairsPerCheck)
+
+	if err != nil {
+		t.l.Err(err).Msg("could not repair timed out get group key runs")
+		return
+	}
+
+	for _, getGroupKeyRun := range getGroupKeyRuns {
+		t.emitRepair(ctx, getGroupKeyRun.TenantId, map[string]interface{}{
+			"repair":            repairTimedOutGetGroupKeyRun,
+			"workflowRunId":     getGroupKeyRun.WorkflowRunId,
+			"getGroupKeyRunId":  getGroupKeyRun.GetGroupKeyRunId,
+			"workflowRunStatus": getGroupKeyRun.WorkflowRunStatus,
+		})
+
+		t.finishWorkflowRun(ctx, getGroupKeyRun.TenantId, getGroupKeyRun.WorkflowRunId, getGroupKeyRun.WorkflowRunStatus)
+	}
+}
+
+func (t *TickerImpl) emitRepair(ctx context.Context, tenantId string, data map[string]interface{}) {
+	t.l.Warn().Str("tenant_id", tenantId).Fields(data).Msg("ticker: repaired run with an inconsistent status")
+
+	if _, err := t.i.IngestEvent(ctx, tenantId, RunRepairedEventKey, data); err != nil {
+		t.l.Err(err).Msgf("could not emit run repaired event for workflow run %s", data["workflowRunId"])
+	}
+}
+
+// finishWorkflowRun notifies the workflows controller of a workflow run which was finished by a repair, so that it is
+// handled like any other finished workflow run, for example to release its concurrency slot.
+func (t *TickerImpl) finishWorkflowRun(ctx context.Context, tenantId, workflowRunId, status string) {
+	err := t.mq.AddMessage(
+		ctx,
+		msgqueue.WORKFLOW_PROCESSING_QUEUE,
+		tasktypes.WorkflowRunFinishedToTask(tenantId, workflowRunId, status),
+	)
+
+	if err != nil {
+		t.l.Err(err).Msgf("could not add workflow run finished task for workflow run %s", workflowRunId)
+	}
+}
@@ -0,0 +1,113 @@
+package ticker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/recurring"
+)
+
+// maxRecurringTasksPerCheck is the maximum number of recurring tasks which are run on each check, so that a backlog
+// of due tasks is spread over multiple checks and tickers.
+const maxRecurringTasksPerCheck = 20
+
+// purgeWorkflowRunsBatchSize is the number of runs which a purge task deletes in each statement, so that purging a
+// large backlog of runs doesn't hold locks on all of them at once.
+const purgeWorkflowRunsBatchSize = 1000
+
+func (t *TickerImpl) runRecurringTasks(ctx context.Context) func() {
+	return func() {
+		t.l.Debug().Msgf("ticker: running recurring tasks")
+
+		// the tasks are claimed in the database before they run, so if multiple tickers are running, each run only
+		// happens on one of them
+		tasks, err := t.repo.RecurringTask().ClaimRecurringTasksToRun(ctx, maxRecurringTasksPerCheck)
+
+		if err != nil {
+			t.l.Err(err).Msg("could not claim recurring tasks")
+			return
+		}
+
+		for _, task := range tasks {
+			t.runRecurringTask(ctx, task)
+		}
+	}
+}
+
+func (t *TickerImpl) runRecurringTask(ctx context.Context, task *dbsqlc.RecurringTask) {
+	taskId := sqlchelpers.UUIDToStr(task.ID)
+
+	runErr := t.runTaskAction(ctx, task)
+
+	if runErr != nil {
+		t.l.Warn().Err(runErr).Msgf("could not run recurring task %s", taskId)
+	}
+
+	schedule, err := recurring.ParseCron(task.Cron)
+
+	if err != nil {
+		t.l.Err(err).Msgf("could not parse cron of recurring task %s", taskId)
+		return
+	}
+
+	if err := t.repo.RecurringTask().UpdateRecurringTaskRun(ctx, taskId, runErr, schedule.Next(time.Now().UTC())); err != nil {
+		t.l.Err(err).Msgf("could not update run of recurring task %s", taskId)
+	}
+}
+
+// runTaskAction runs the action of a recurring task.
+func (t *TickerImpl) runTaskAction(ctx context.Context, task *dbsqlc.RecurringTask) error {
+	tenantId := sqlchelpers.UUIDToStr(task.TenantId)
+
+	switch recurring.Kind(task.Kind) {
+	case recurring.KindPurgeWorkflowRuns:
+		if !task.WorkflowId.Valid || !task.RetentionSeconds.Valid {
+			return fmt.Errorf("purge task has no workflow or retention")
+		}
+
+		workflowId := sqlchelpers.UUIDToStr(task.WorkflowId)
+		retention := time.Duration(task.RetentionSeconds.Int32) * time.Second
+
+		var purged int64
+
+		for {
+			count, err := t.repo.RecurringTask().PurgeWorkflowRuns(ctx, tenantId, workflowId, retention, purgeWorkflowRunsBatchSize)
+
+			if err != nil {
+				return fmt.Errorf("could not purge workflow runs: %w", err)
+			}
+
+			purged += count
+
+			if count < purgeWorkflowRunsBatchSize {
+				break
+			}
+		}
+
+		t.l.Debug().Msgf("purged %d runs of workflow %s", purged, workflowId)
+	case recurring.KindEmitEvent:
+		if !task.EventKey.Valid {
+			return fmt.Errorf("event task has no event key")
+		}
+
+		data := map[string]interface{}{}
+
+		if len(task.EventData) > 0 {
+			if err := json.Unmarshal(task.EventData, &data); err != nil {
+				return fmt.Errorf("could not unmarshal event data: %w", err)
+			}
+		}
+
+		if _, err := t.i.IngestEvent(ctx, tenantId, task.EventKey.String, data); err != nil {
+			return fmt.Errorf("could not ingest event: %w", err)
+		}
+	default:
+		return fmt.Errorf("unknown recurring task kind %s", task.Kind)
+	}
+
+	return nil
+}
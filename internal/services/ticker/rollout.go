@@ -0,0 +1,67 @@
+package ticker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+)
+
+// RolloutRolledBackEventKey is the key of the event which is emitted when the rollout of a workflow version is
+// rolled back.
+const RolloutRolledBackEventKey = "workflow-rollout-rolled-back"
+
+func (t *TickerImpl) runRollBackFailingRollouts(ctx context.Context) func() {
+	return func() {
+		t.l.Debug().Msgf("ticker: checking failing rollouts")
+
+		// the rollouts are rolled back in the database before they are emitted, so if multiple tickers are
+		// running, each rollback is only emitted by one of them
+		rollouts, err := t.repo.Workflow().RollBackFailingWorkflowRollouts(ctx)
+
+		if err != nil {
+			t.l.Err(err).Msg("could not roll back failing rollouts")
+			return
+		}
+
+		for _, rollout := range rollouts {
+			tenantId := sqlchelpers.UUIDToStr(rollout.TenantId)
+			workflowId := sqlchelpers.UUIDToStr(rollout.WorkflowId)
+
+			data := map[string]interface{}{
+				"workflowId":           workflowId,
+				"workflowName":         rollout.WorkflowName,
+				"fromVersionId":        sqlchelpers.UUIDToStr(rollout.FromVersionId),
+				"toVersionId":          sqlchelpers.UUIDToStr(rollout.ToVersionId),
+				"failureRate":          rollout.FailureRate.Float64,
+				"failureRateThreshold": rollout.FailureRateThreshold,
+				"windowSeconds":        rollout.WindowSeconds,
+				"rolledBackAt":         rollout.FinishedAt.Time.UTC().Format(time.RFC3339),
+			}
+
+			if _, err := t.i.IngestEvent(ctx, tenantId, RolloutRolledBackEventKey, data); err != nil {
+				t.l.Err(err).Msgf("could not emit rollback of workflow %s", workflowId)
+			}
+
+			alertData := map[string]interface{}{
+				"tenantId": tenantId,
+			}
+
+			for k, v := range data {
+				alertData[k] = v
+			}
+
+			t.ra.SendAlert(
+				ctx,
+				fmt.Errorf(
+					"rolled back workflow %s, since its new version failed %.1f%% of runs over the threshold of %.1f%%",
+					rollout.WorkflowName,
+					rollout.FailureRate.Float64*100,
+					rollout.FailureRateThreshold*100,
+				),
+				alertData,
+			)
+		}
+	}
+}
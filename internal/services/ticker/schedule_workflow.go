@@ -2,6 +2,7 @@ package ticker
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -93,6 +94,11 @@ func (t *TickerImpl) runScheduledWorkflow(ctx context.Context, tenantId string,
 
 		workflowRun, err := t.repo.WorkflowRun().CreateNewWorkflowRun(ctx, tenantId, createOpts)
 
+		if errors.Is(err, repository.ErrWorkflowPaused) {
+			t.l.Info().Msg("workflow is paused, skipping scheduled workflow run")
+			return
+		}
+
 		if err != nil {
 			t.l.Err(err).Msg("could not create workflow run")
 			return
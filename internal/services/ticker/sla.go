@@ -0,0 +1,62 @@
+package ticker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+)
+
+// SLABreachedEventKey is the key of the event which is emitted when a workflow run breaches its SLA.
+const SLABreachedEventKey = "sla-breached"
+
+// maxSLABreachesPerCheck is the maximum number of breaches which are recorded on each check, so that a backlog
+// of breaches is spread over multiple checks.
+const maxSLABreachesPerCheck = 1000
+
+func (t *TickerImpl) runCheckSLABreaches(ctx context.Context) func() {
+	return func() {
+		t.l.Debug().Msgf("ticker: checking sla breaches")
+
+		// the breaches are recorded in the database before they are emitted, so if multiple tickers are
+		// running, each breach is only emitted by one of them
+		breaches, err := t.repo.WorkflowRun().CreateWorkflowRunSLABreaches(ctx, maxSLABreachesPerCheck)
+
+		if err != nil {
+			t.l.Err(err).Msg("could not check sla breaches")
+			return
+		}
+
+		for _, breach := range breaches {
+			tenantId := sqlchelpers.UUIDToStr(breach.TenantId)
+			workflowRunId := sqlchelpers.UUIDToStr(breach.WorkflowRunId)
+
+			data := map[string]interface{}{
+				"workflowRunId": workflowRunId,
+				"workflowId":    sqlchelpers.UUIDToStr(breach.WorkflowId),
+				"workflowName":  breach.WorkflowName,
+				"sla":           breach.Sla,
+				"breachedAt":    breach.CreatedAt.Time.UTC().Format(time.RFC3339),
+			}
+
+			if _, err := t.i.IngestEvent(ctx, tenantId, SLABreachedEventKey, data); err != nil {
+				t.l.Err(err).Msgf("could not emit sla breach for workflow run %s", workflowRunId)
+			}
+
+			alertData := map[string]interface{}{
+				"tenantId": tenantId,
+			}
+
+			for k, v := range data {
+				alertData[k] = v
+			}
+
+			t.a.SendAlert(
+				ctx,
+				fmt.Errorf("workflow run %s of workflow %s breached its SLA of %s", workflowRunId, breach.WorkflowName, breach.Sla),
+				alertData,
+			)
+		}
+	}
+}
@@ -37,17 +37,24 @@ func (t *TickerImpl) handleScheduleStepRunTimeout(ctx context.Context, task *msg
 	// schedule the timeout
 	childCtx, cancel := context.WithDeadline(context.Background(), timeoutAt)
 
+	schedule := &timeoutCtx{
+		ctx:    childCtx,
+		cancel: cancel,
+	}
+
+	// store the schedule in the step run map. a step run which is already scheduled is rescheduled, for example
+	// when the timeout is moved to the end of a cancellation grace period, so the previous schedule is cancelled.
+	if prevVal, ok := t.stepRuns.Swap(payload.StepRunId, schedule); ok {
+		prev := prevVal.(*timeoutCtx)
+		prev.ctx = context.WithValue(prev.ctx, "cancelled", true)
+		prev.cancel()
+	}
+
 	go func() {
 		<-childCtx.Done()
-		t.runStepRunTimeout(metadata.TenantId, payload.JobRunId, payload.StepRunId)
+		t.runStepRunTimeout(metadata.TenantId, payload.JobRunId, payload.StepRunId, schedule)
 	}()
 
-	// store the schedule in the step run map
-	t.stepRuns.Store(payload.StepRunId, &timeoutCtx{
-		ctx:    childCtx,
-		cancel: cancel,
-	})
-
 	return nil
 }
 
@@ -86,8 +93,8 @@ func (t *TickerImpl) handleCancelStepRunTimeout(ctx context.Context, task *msgqu
 	return nil
 }
 
-func (t *TickerImpl) runStepRunTimeout(tenantId, jobRunId, stepRunId string) {
-	defer t.stepRuns.Delete(stepRunId)
+func (t *TickerImpl) runStepRunTimeout(tenantId, jobRunId, stepRunId string, schedule *timeoutCtx) {
+	defer t.stepRuns.CompareAndDelete(stepRunId, schedule)
 
 	childTimeoutCtxVal, ok := t.stepRuns.Load(stepRunId)
 
@@ -98,6 +105,11 @@ func (t *TickerImpl) runStepRunTimeout(tenantId, jobRunId, stepRunId string) {
 
 	childTimeoutCtx := childTimeoutCtxVal.(*timeoutCtx)
 
+	if childTimeoutCtx != schedule {
+		t.l.Debug().Msgf("ticker: timeout of %s was rescheduled", stepRunId)
+		return
+	}
+
 	var isCancelled bool
 
 	if cancelledVal := childTimeoutCtx.ctx.Value("cancelled"); cancelledVal != nil {
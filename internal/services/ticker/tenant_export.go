@@ -0,0 +1,35 @@
+package ticker
+
+import (
+	"context"
+)
+
+func (t *TickerImpl) runDeleteExpiredTenantExports(ctx context.Context) func() {
+	return func() {
+		t.l.Debug().Msgf("ticker: deleting expired tenant exports")
+
+		deleted, archiveKeys, err := t.repo.TenantExport().DeleteExpiredTenantExports(ctx)
+
+		if err != nil {
+			t.l.Err(err).Msg("could not delete expired tenant exports")
+			return
+		}
+
+		// the exports are deleted first, so an archive which can't be deleted is left in the object store but can
+		// no longer be downloaded
+		for _, key := range archiveKeys {
+			if t.objectStore == nil {
+				t.l.Warn().Msgf("could not delete tenant export archive %s: no object store is configured", key)
+				continue
+			}
+
+			if err := t.objectStore.Delete(ctx, key); err != nil {
+				t.l.Err(err).Msgf("could not delete tenant export archive %s", key)
+			}
+		}
+
+		if deleted > 0 {
+			t.l.Info().Msgf("deleted %d expired tenant exports", deleted)
+		}
+	}
+}
@@ -10,11 +10,16 @@ import (
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 
+	"github.com/hatchet-dev/hatchet/internal/blobstore"
 	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/encryption"
 	"github.com/hatchet-dev/hatchet/internal/logger"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/anomaly"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	hatcheterrors "github.com/hatchet-dev/hatchet/pkg/errors"
 )
 
 type Ticker interface {
@@ -25,7 +30,20 @@ type TickerImpl struct {
 	mq   msgqueue.MessageQueue
 	l    *zerolog.Logger
 	repo repository.Repository
+	enc  encryption.EncryptionService
 	s    gocron.Scheduler
+	i    ingestor.Ingestor
+	a    hatcheterrors.Alerter
+	ra   hatcheterrors.Alerter
+	na   hatcheterrors.Alerter
+	aa   hatcheterrors.Alerter
+
+	noWorkerThreshold time.Duration
+
+	anomalyWindow time.Duration
+	anomalyOpts   *anomaly.Opts
+
+	objectStore blobstore.Store
 
 	crons              sync.Map
 	scheduledWorkflows sync.Map
@@ -48,8 +66,21 @@ type TickerOpts struct {
 	mq       msgqueue.MessageQueue
 	l        *zerolog.Logger
 	repo     repository.Repository
+	enc      encryption.EncryptionService
+	i        ingestor.Ingestor
+	a        hatcheterrors.Alerter
+	ra       hatcheterrors.Alerter
+	na       hatcheterrors.Alerter
+	aa       hatcheterrors.Alerter
 	tickerId string
 
+	noWorkerThreshold time.Duration
+
+	anomalyWindow    time.Duration
+	anomalyThreshold float64
+
+	objectStore blobstore.Store
+
 	dv datautils.DataDecoderValidator
 }
 
@@ -59,6 +90,10 @@ func defaultTickerOpts() *TickerOpts {
 		l:        &logger,
 		tickerId: uuid.New().String(),
 		dv:       datautils.NewDataDecoderValidator(),
+		a:        hatcheterrors.NoOpAlerter{},
+		ra:       hatcheterrors.NoOpAlerter{},
+		na:       hatcheterrors.NoOpAlerter{},
+		aa:       hatcheterrors.NoOpAlerter{},
 	}
 }
 
@@ -74,6 +109,74 @@ func WithRepository(r repository.Repository) TickerOpt {
 	}
 }
 
+// WithEncryption sets the encryption service which decrypts the connection strings of query triggers.
+func WithEncryption(enc encryption.EncryptionService) TickerOpt {
+	return func(opts *TickerOpts) {
+		opts.enc = enc
+	}
+}
+
+func WithIngestor(i ingestor.Ingestor) TickerOpt {
+	return func(opts *TickerOpts) {
+		opts.i = i
+	}
+}
+
+// WithAlerter sets the alerter which is notified when a workflow run breaches its SLA.
+func WithAlerter(a hatcheterrors.Alerter) TickerOpt {
+	return func(opts *TickerOpts) {
+		opts.a = a
+	}
+}
+
+// WithRolloutAlerter sets the alerter which is notified when a workflow rollout is rolled back.
+func WithRolloutAlerter(a hatcheterrors.Alerter) TickerOpt {
+	return func(opts *TickerOpts) {
+		opts.ra = a
+	}
+}
+
+// WithNoWorkerAlerter sets the alerter which is notified when a step run waits for a worker for longer than the
+// no worker threshold.
+func WithNoWorkerAlerter(a hatcheterrors.Alerter) TickerOpt {
+	return func(opts *TickerOpts) {
+		opts.na = a
+	}
+}
+
+// WithNoWorkerThreshold sets how long a step run waits for a worker with a free slot before it is marked as waiting
+// for a worker. The check is disabled if the threshold is 0.
+func WithNoWorkerThreshold(threshold time.Duration) TickerOpt {
+	return func(opts *TickerOpts) {
+		opts.noWorkerThreshold = threshold
+	}
+}
+
+// WithAnomalyAlerter sets the alerter which is notified when the failure rate or the duration of the runs of a
+// workflow deviates from its baseline.
+func WithAnomalyAlerter(a hatcheterrors.Alerter) TickerOpt {
+	return func(opts *TickerOpts) {
+		opts.aa = a
+	}
+}
+
+// WithAnomalyDetection sets the length of the windows which the baselines of workflows are learned over, and the
+// number of standard deviations above the baseline at which a window is anomalous. Workflows are not analyzed if
+// the window is 0.
+func WithAnomalyDetection(window time.Duration, threshold float64) TickerOpt {
+	return func(opts *TickerOpts) {
+		opts.anomalyWindow = window
+		opts.anomalyThreshold = threshold
+	}
+}
+
+// WithObjectStore sets the object store which the archives of expired tenant exports are deleted from.
+func WithObjectStore(store blobstore.Store) TickerOpt {
+	return func(opts *TickerOpts) {
+		opts.objectStore = store
+	}
+}
+
 func WithLogger(l *zerolog.Logger) TickerOpt {
 	return func(opts *TickerOpts) {
 		opts.l = l
@@ -95,6 +198,14 @@ func New(fs ...TickerOpt) (*TickerImpl, error) {
 		return nil, fmt.Errorf("repository is required. use WithRepository")
 	}
 
+	if opts.i == nil {
+		return nil, fmt.Errorf("ingestor is required. use WithIngestor")
+	}
+
+	if opts.enc == nil {
+		return nil, fmt.Errorf("encryption service is required. use WithEncryption")
+	}
+
 	newLogger := opts.l.With().Str("service", "ticker").Logger()
 	opts.l = &newLogger
 
@@ -104,13 +215,36 @@ func New(fs ...TickerOpt) (*TickerImpl, error) {
 		return nil, fmt.Errorf("could not create scheduler: %w", err)
 	}
 
+	var anomalyOpts *anomaly.Opts
+
+	if opts.anomalyWindow > 0 {
+		anomalyOpts = anomaly.DefaultOpts(opts.anomalyWindow)
+
+		if opts.anomalyThreshold > 0 {
+			anomalyOpts.Threshold = opts.anomalyThreshold
+		}
+	}
+
 	return &TickerImpl{
 		mq:       opts.mq,
 		l:        opts.l,
 		repo:     opts.repo,
+		enc:      opts.enc,
 		s:        s,
+		i:        opts.i,
+		a:        opts.a,
+		ra:       opts.ra,
+		na:       opts.na,
+		aa:       opts.aa,
 		dv:       opts.dv,
 		tickerId: opts.tickerId,
+
+		noWorkerThreshold: opts.noWorkerThreshold,
+
+		anomalyWindow: opts.anomalyWindow,
+		anomalyOpts:   anomalyOpts,
+
+		objectStore: opts.objectStore,
 	}, nil
 }
 
@@ -141,6 +275,154 @@ func (t *TickerImpl) Start() (func() error, error) {
 		return nil, fmt.Errorf("could not create update heartbeat job: %w", err)
 	}
 
+	_, err = t.s.NewJob(
+		gocron.DurationJob(time.Second*10),
+		gocron.NewTask(
+			t.runCheckSLABreaches(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not create check sla breaches job: %w", err)
+	}
+
+	if t.noWorkerThreshold > 0 {
+		_, err = t.s.NewJob(
+			gocron.DurationJob(time.Second*10),
+			gocron.NewTask(
+				t.runCheckWaitingForWorker(ctx),
+			),
+		)
+
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("could not create check waiting for worker job: %w", err)
+		}
+	}
+
+	if t.anomalyWindow > 0 {
+		_, err = t.s.NewJob(
+			gocron.DurationJob(time.Second*60),
+			gocron.NewTask(
+				t.runDetectAnomalies(ctx),
+			),
+		)
+
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("could not create detect anomalies job: %w", err)
+		}
+	}
+
+	_, err = t.s.NewJob(
+		gocron.DurationJob(time.Second*10),
+		gocron.NewTask(
+			t.runRollBackFailingRollouts(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not create roll back failing rollouts job: %w", err)
+	}
+
+	_, err = t.s.NewJob(
+		gocron.DurationJob(time.Second*10),
+		gocron.NewTask(
+			t.runMaintenanceWindows(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not create maintenance windows job: %w", err)
+	}
+
+	_, err = t.s.NewJob(
+		gocron.DurationJob(time.Second*10),
+		gocron.NewTask(
+			t.runQueryTriggers(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not create query triggers job: %w", err)
+	}
+
+	_, err = t.s.NewJob(
+		gocron.DurationJob(time.Second*10),
+		gocron.NewTask(
+			t.runRecurringTasks(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not create recurring tasks job: %w", err)
+	}
+
+	_, err = t.s.NewJob(
+		gocron.DurationJob(time.Second*10),
+		gocron.NewTask(
+			t.runPurgePII(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not create PII purge job: %w", err)
+	}
+
+	_, err = t.s.NewJob(
+		gocron.DurationJob(time.Second*60),
+		gocron.NewTask(
+			t.runReconcile(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not create reconcile job: %w", err)
+	}
+
+	_, err = t.s.NewJob(
+		gocron.DurationJob(time.Second*60),
+		gocron.NewTask(
+			t.runDeleteExpiredTenantExports(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not create tenant export cleanup job: %w", err)
+	}
+
+	_, err = t.s.NewJob(
+		gocron.DurationJob(time.Second*60),
+		gocron.NewTask(
+			t.runDeleteReleasedNamedLocks(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not create named lock cleanup job: %w", err)
+	}
+
+	_, err = t.s.NewJob(
+		gocron.DurationJob(time.Second*60),
+		gocron.NewTask(
+			t.runDeleteExpiredIdempotencyKeys(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not create idempotency key cleanup job: %w", err)
+	}
+
 	t.s.Start()
 
 	wg := sync.WaitGroup{}
@@ -11,6 +11,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/trace"
@@ -79,6 +80,39 @@ func NewSpan(ctx context.Context, name string) (context.Context, trace.Span) {
 	return ctx, span
 }
 
+// NewSpanWithLinks starts a span which links to the spans of the given W3C traceparents, so that work which is
+// traced separately, like the trigger of a workflow run, can be found from the span. Empty or invalid traceparents
+// are skipped.
+func NewSpanWithLinks(ctx context.Context, name string, traceParents ...string) (context.Context, trace.Span) {
+	links := make([]trace.Link, 0, len(traceParents))
+
+	for _, traceParent := range traceParents {
+		if traceParent == "" {
+			continue
+		}
+
+		linkCtx := propagation.TraceContext{}.Extract(context.Background(), propagation.MapCarrier{
+			"traceparent": traceParent,
+		})
+
+		if spanCtx := trace.SpanContextFromContext(linkCtx); spanCtx.IsValid() {
+			links = append(links, trace.Link{SpanContext: spanCtx})
+		}
+	}
+
+	ctx, span := otel.Tracer("").Start(ctx, prefixSpanKey(name), trace.WithLinks(links...))
+	return ctx, span
+}
+
+// TraceParent returns the W3C traceparent of the span in the context, or an empty string if the context has no
+// span, for example because tracing is disabled.
+func TraceParent(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+
+	return carrier.Get("traceparent")
+}
+
 type AttributeKey string
 
 // AttributeKV is a wrapper for otel attributes KV
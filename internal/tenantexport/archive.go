@@ -0,0 +1,208 @@
+// Package tenantexport writes the archives of tenant exports, and signs the URLs which they are downloaded with.
+// An archive is a gzipped tar archive of newline-delimited JSON files, one directory per section, and a
+// manifest.json which lists the files with their number of records and SHA-256 hashes.
+package tenantexport
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Version is the version of the archive format. It is changed when a file or field is changed in a way which
+// readers of older archives don't expect.
+const Version = "v1"
+
+// ManifestFileName is the name of the manifest in the archive. It is written last.
+const ManifestFileName = "manifest.json"
+
+// maxRecordsPerFile is the number of records after which a section continues in a new file, so that large sections
+// can be read without loading them at once.
+const maxRecordsPerFile = 10000
+
+type Manifest struct {
+	Version   string    `json:"version"`
+	ExportId  string    `json:"exportId"`
+	TenantId  string    `json:"tenantId"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	Files []ManifestFile `json:"files"`
+}
+
+type ManifestFile struct {
+	Name string `json:"name"`
+
+	// Records is the number of records in a newline-delimited JSON file. It is 0 for JSON documents.
+	Records int `json:"records"`
+
+	SHA256 string `json:"sha256"`
+}
+
+// Writer writes an archive. Records of a section are buffered until the file which they belong to is full, or the
+// archive is closed.
+type Writer struct {
+	gz *gzip.Writer
+	tw *tar.Writer
+
+	manifest *Manifest
+
+	// sections are the sections which were written to, in the order of their first record
+	sections []*section
+}
+
+type section struct {
+	name    string
+	part    int
+	buf     bytes.Buffer
+	records int
+}
+
+func NewWriter(w io.Writer, manifest *Manifest) *Writer {
+	manifest.Version = Version
+	manifest.Files = []ManifestFile{}
+
+	gz := gzip.NewWriter(w)
+
+	return &Writer{
+		gz:       gz,
+		tw:       tar.NewWriter(gz),
+		manifest: manifest,
+	}
+}
+
+// WriteRecord appends a record to the current file of a section. The files of a section are named
+// <section>/00001.ndjson, <section>/00002.ndjson and so on.
+func (w *Writer) WriteRecord(name string, record interface{}) error {
+	sec := w.section(name)
+
+	if sec.records == maxRecordsPerFile {
+		if err := w.flush(sec); err != nil {
+			return err
+		}
+	}
+
+	enc := json.NewEncoder(&sec.buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(record); err != nil {
+		return fmt.Errorf("could not encode %s record: %w", name, err)
+	}
+
+	sec.records++
+
+	return nil
+}
+
+// WriteJSON writes a JSON document as a file of the archive.
+func (w *Writer) WriteJSON(name string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("could not encode %s: %w", name, err)
+	}
+
+	return w.writeFile(name, data, 0)
+}
+
+// Close writes the remaining records and the manifest, and closes the archive. It doesn't close the underlying
+// writer.
+func (w *Writer) Close() error {
+	for _, sec := range w.sections {
+		if err := w.flush(sec); err != nil {
+			return err
+		}
+	}
+
+	data, err := json.MarshalIndent(w.manifest, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("could not encode manifest: %w", err)
+	}
+
+	// the manifest doesn't list itself
+	if err := w.writeEntry(ManifestFileName, data); err != nil {
+		return err
+	}
+
+	if err := w.tw.Close(); err != nil {
+		return fmt.Errorf("could not close tar archive: %w", err)
+	}
+
+	return w.gz.Close()
+}
+
+func (w *Writer) section(name string) *section {
+	for _, sec := range w.sections {
+		if sec.name == name {
+			return sec
+		}
+	}
+
+	sec := &section{
+		name: name,
+	}
+
+	w.sections = append(w.sections, sec)
+
+	return sec
+}
+
+func (w *Writer) flush(sec *section) error {
+	if sec.records == 0 {
+		return nil
+	}
+
+	sec.part++
+
+	name := fmt.Sprintf("%s/%05d.ndjson", sec.name, sec.part)
+
+	if err := w.writeFile(name, sec.buf.Bytes(), sec.records); err != nil {
+		return err
+	}
+
+	sec.buf.Reset()
+	sec.records = 0
+
+	return nil
+}
+
+func (w *Writer) writeFile(name string, data []byte, records int) error {
+	if err := w.writeEntry(name, data); err != nil {
+		return err
+	}
+
+	sum := sha256.Sum256(data)
+
+	w.manifest.Files = append(w.manifest.Files, ManifestFile{
+		Name:    name,
+		Records: records,
+		SHA256:  hex.EncodeToString(sum[:]),
+	})
+
+	return nil
+}
+
+func (w *Writer) writeEntry(name string, data []byte) error {
+	err := w.tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    int64(len(data)),
+		ModTime: w.manifest.CreatedAt,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not write header of %s: %w", name, err)
+	}
+
+	if _, err := w.tw.Write(data); err != nil {
+		return fmt.Errorf("could not write %s: %w", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+package tenantexport
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+)
+
+// The sections of an archive, in the order in which they are written.
+const (
+	SectionWorkflows        = "workflows"
+	SectionWorkflowVersions = "workflow_versions"
+	SectionSteps            = "steps"
+	SectionWorkflowRuns     = "workflow_runs"
+	SectionStepRuns         = "step_runs"
+	SectionEvents           = "events"
+	SectionAPITokens        = "api_tokens"
+)
+
+// TenantFileName is the name of the file with the tenant itself.
+const TenantFileName = "tenant.json"
+
+type Tenant struct {
+	Id        string    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+}
+
+type Workflow struct {
+	Id          string    `json:"id"`
+	CreatedAt   time.Time `json:"createdAt"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description,omitempty"`
+	Paused      bool      `json:"paused"`
+}
+
+func ToWorkflow(row *dbsqlc.ListWorkflowsForTenantExportRow) *Workflow {
+	return &Workflow{
+		Id:          sqlchelpers.UUIDToStr(row.ID),
+		CreatedAt:   row.CreatedAt.Time,
+		Name:        row.Name,
+		Description: textPtr(row.Description),
+		Paused:      row.Paused,
+	}
+}
+
+type WorkflowVersion struct {
+	Id              string          `json:"id"`
+	CreatedAt       time.Time       `json:"createdAt"`
+	WorkflowId      string          `json:"workflowId"`
+	Version         *string         `json:"version,omitempty"`
+	Order           int64           `json:"order"`
+	Checksum        string          `json:"checksum"`
+	ScheduleTimeout string          `json:"scheduleTimeout"`
+	SLA             *string         `json:"sla,omitempty"`
+	DefaultInput    json.RawMessage `json:"defaultInput,omitempty"`
+	InputSchema     json.RawMessage `json:"inputSchema,omitempty"`
+}
+
+func ToWorkflowVersion(row *dbsqlc.ListWorkflowVersionsForTenantExportRow) *WorkflowVersion {
+	return &WorkflowVersion{
+		Id:              sqlchelpers.UUIDToStr(row.ID),
+		CreatedAt:       row.CreatedAt.Time,
+		WorkflowId:      sqlchelpers.UUIDToStr(row.WorkflowId),
+		Version:         textPtr(row.Version),
+		Order:           row.Order,
+		Checksum:        row.Checksum,
+		ScheduleTimeout: row.ScheduleTimeout,
+		SLA:             textPtr(row.Sla),
+		DefaultInput:    row.DefaultInput,
+		InputSchema:     row.InputSchema,
+	}
+}
+
+type Step struct {
+	Id                string   `json:"id"`
+	WorkflowVersionId string   `json:"workflowVersionId"`
+	JobName           string   `json:"jobName"`
+	ReadableId        *string  `json:"readableId,omitempty"`
+	ActionId          string   `json:"actionId"`
+	Timeout           *string  `json:"timeout,omitempty"`
+	Retries           int32    `json:"retries"`
+	Parents           []string `json:"parents"`
+}
+
+func ToStep(row *dbsqlc.ListStepsForTenantExportRow) *Step {
+	parents := row.Parents
+
+	if parents == nil {
+		parents = []string{}
+	}
+
+	return &Step{
+		Id:                sqlchelpers.UUIDToStr(row.ID),
+		WorkflowVersionId: sqlchelpers.UUIDToStr(row.WorkflowVersionId),
+		JobName:           row.JobName,
+		ReadableId:        textPtr(row.ReadableId),
+		ActionId:          row.ActionId,
+		Timeout:           textPtr(row.Timeout),
+		Retries:           row.Retries,
+		Parents:           parents,
+	}
+}
+
+type WorkflowRun struct {
+	Id                 string          `json:"id"`
+	WorkflowId         string          `json:"workflowId"`
+	WorkflowName       string          `json:"workflowName"`
+	WorkflowVersionId  string          `json:"workflowVersionId"`
+	Status             string          `json:"status"`
+	DisplayName        *string         `json:"displayName,omitempty"`
+	Error              *string         `json:"error,omitempty"`
+	AdditionalMetadata json.RawMessage `json:"additionalMetadata,omitempty"`
+	CreatedAt          time.Time       `json:"createdAt"`
+	StartedAt          *time.Time      `json:"startedAt,omitempty"`
+	FinishedAt         *time.Time      `json:"finishedAt,omitempty"`
+}
+
+func ToWorkflowRun(row *dbsqlc.ListWorkflowRunsForExportRow) *WorkflowRun {
+	return &WorkflowRun{
+		Id:                 sqlchelpers.UUIDToStr(row.WorkflowRun.ID),
+		WorkflowId:         sqlchelpers.UUIDToStr(row.WorkflowId),
+		WorkflowName:       row.WorkflowName,
+		WorkflowVersionId:  sqlchelpers.UUIDToStr(row.WorkflowRun.WorkflowVersionId),
+		Status:             string(row.WorkflowRun.Status),
+		DisplayName:        textPtr(row.WorkflowRun.DisplayName),
+		Error:              textPtr(row.WorkflowRun.Error),
+		AdditionalMetadata: row.WorkflowRun.AdditionalMetadata,
+		CreatedAt:          row.WorkflowRun.CreatedAt.Time,
+		StartedAt:          timePtr(row.WorkflowRun.StartedAt),
+		FinishedAt:         timePtr(row.WorkflowRun.FinishedAt),
+	}
+}
+
+type StepRun struct {
+	Id              string          `json:"id"`
+	WorkflowRunId   string          `json:"workflowRunId"`
+	StepId          string          `json:"stepId"`
+	StepReadableId  *string         `json:"stepReadableId,omitempty"`
+	Status          string          `json:"status"`
+	Input           json.RawMessage `json:"input,omitempty"`
+	Output          json.RawMessage `json:"output,omitempty"`
+	Error           *string         `json:"error,omitempty"`
+	RetryCount      int32           `json:"retryCount"`
+	CreatedAt       time.Time       `json:"createdAt"`
+	StartedAt       *time.Time      `json:"startedAt,omitempty"`
+	FinishedAt      *time.Time      `json:"finishedAt,omitempty"`
+	CancelledAt     *time.Time      `json:"cancelledAt,omitempty"`
+	CancelledReason *string         `json:"cancelledReason,omitempty"`
+}
+
+func ToStepRun(row *dbsqlc.ListStepRunsForTenantExportRow) *StepRun {
+	return &StepRun{
+		Id:              sqlchelpers.UUIDToStr(row.ID),
+		WorkflowRunId:   sqlchelpers.UUIDToStr(row.WorkflowRunId),
+		StepId:          sqlchelpers.UUIDToStr(row.StepId),
+		StepReadableId:  textPtr(row.StepReadableId),
+		Status:          string(row.Status),
+		Input:           row.Input,
+		Output:          row.Output,
+		Error:           textPtr(row.Error),
+		RetryCount:      row.RetryCount,
+		CreatedAt:       row.CreatedAt.Time,
+		StartedAt:       timePtr(row.StartedAt),
+		FinishedAt:      timePtr(row.FinishedAt),
+		CancelledAt:     timePtr(row.CancelledAt),
+		CancelledReason: textPtr(row.CancelledReason),
+	}
+}
+
+type Event struct {
+	Id             string          `json:"id"`
+	CreatedAt      time.Time       `json:"createdAt"`
+	Key            string          `json:"key"`
+	Data           json.RawMessage `json:"data,omitempty"`
+	ReplayedFromId *string         `json:"replayedFromId,omitempty"`
+	Environment    *string         `json:"environment,omitempty"`
+}
+
+func ToEvent(row *dbsqlc.ListEventsForTenantExportRow) *Event {
+	return &Event{
+		Id:             sqlchelpers.UUIDToStr(row.ID),
+		CreatedAt:      row.CreatedAt.Time,
+		Key:            row.Key,
+		Data:           row.Data,
+		ReplayedFromId: uuidPtr(row.ReplayedFromId),
+		Environment:    textPtr(row.Environment),
+	}
+}
+
+// APIToken is the metadata of an API token. Archives never contain the tokens themselves.
+type APIToken struct {
+	Id          string     `json:"id"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
+	Revoked     bool       `json:"revoked"`
+	Name        *string    `json:"name,omitempty"`
+	Scopes      []string   `json:"scopes,omitempty"`
+	Environment *string    `json:"environment,omitempty"`
+	Namespace   *string    `json:"namespace,omitempty"`
+}
+
+func ToAPIToken(row *dbsqlc.ListAPITokensForTenantExportRow) *APIToken {
+	return &APIToken{
+		Id:          sqlchelpers.UUIDToStr(row.ID),
+		CreatedAt:   row.CreatedAt.Time,
+		ExpiresAt:   timePtr(row.ExpiresAt),
+		Revoked:     row.Revoked,
+		Name:        textPtr(row.Name),
+		Scopes:      row.Scopes,
+		Environment: textPtr(row.Environment),
+		Namespace:   textPtr(row.Namespace),
+	}
+}
+
+func textPtr(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+
+	return &t.String
+}
+
+func timePtr(t pgtype.Timestamp) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+
+	return &t.Time
+}
+
+func uuidPtr(u pgtype.UUID) *string {
+	if !u.Valid {
+		return nil
+	}
+
+	s := sqlchelpers.UUIDToStr(u)
+
+	return &s
+}
@@ -0,0 +1,182 @@
+package tenantexport
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/encryption"
+)
+
+func readArchive(t *testing.T, data []byte) (map[string][]byte, []string) {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	tr := tar.NewReader(gz)
+
+	files := make(map[string][]byte)
+	names := make([]string, 0)
+
+	for {
+		hdr, err := tr.Next()
+
+		if err == io.EOF {
+			break
+		}
+
+		require.NoError(t, err)
+
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+
+		files[hdr.Name] = content
+		names = append(names, hdr.Name)
+	}
+
+	return files, names
+}
+
+func countLines(t *testing.T, data []byte) int {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	lines := 0
+
+	for scanner.Scan() {
+		require.True(t, json.Valid(scanner.Bytes()))
+		lines++
+	}
+
+	return lines
+}
+
+func TestWriter(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	w := NewWriter(buf, &Manifest{
+		ExportId:  "b0e4b9d2-3d9c-4b3b-9d0b-6f5d7f6a2c11",
+		TenantId:  "707d0855-80ab-4e1f-a156-f1c4546cbf52",
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	})
+
+	require.NoError(t, w.WriteJSON(TenantFileName, &Tenant{Name: "tenant", Slug: "tenant"}))
+
+	for i := 0; i < maxRecordsPerFile+1; i++ {
+		require.NoError(t, w.WriteRecord(SectionEvents, &Event{Key: "user:create", Data: json.RawMessage(`{"i":1}`)}))
+	}
+
+	require.NoError(t, w.WriteRecord(SectionAPITokens, &APIToken{Revoked: true}))
+	require.NoError(t, w.Close())
+
+	files, names := readArchive(t, buf.Bytes())
+
+	assert.Equal(t, []string{
+		TenantFileName,
+		"events/00001.ndjson",
+		"events/00002.ndjson",
+		"api_tokens/00001.ndjson",
+		ManifestFileName,
+	}, names)
+
+	assert.Equal(t, maxRecordsPerFile, countLines(t, files["events/00001.ndjson"]))
+	assert.Equal(t, 1, countLines(t, files["events/00002.ndjson"]))
+
+	manifest := &Manifest{}
+	require.NoError(t, json.Unmarshal(files[ManifestFileName], manifest))
+
+	assert.Equal(t, Version, manifest.Version)
+	require.Len(t, manifest.Files, 4)
+
+	for _, f := range manifest.Files {
+		sum := sha256.Sum256(files[f.Name])
+		assert.Equal(t, hex.EncodeToString(sum[:]), f.SHA256, f.Name)
+	}
+
+	assert.Equal(t, 0, manifest.Files[0].Records)
+	assert.Equal(t, maxRecordsPerFile, manifest.Files[1].Records)
+	assert.Equal(t, 1, manifest.Files[2].Records)
+}
+
+func TestWriterInterleavedSections(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	w := NewWriter(buf, &Manifest{})
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, w.WriteRecord(SectionWorkflowRuns, &WorkflowRun{Status: "SUCCEEDED"}))
+		require.NoError(t, w.WriteRecord(SectionStepRuns, &StepRun{Status: "SUCCEEDED"}))
+		require.NoError(t, w.WriteRecord(SectionStepRuns, &StepRun{Status: "SUCCEEDED"}))
+	}
+
+	require.NoError(t, w.Close())
+
+	files, names := readArchive(t, buf.Bytes())
+
+	assert.Equal(t, []string{"workflow_runs/00001.ndjson", "step_runs/00001.ndjson", ManifestFileName}, names)
+	assert.Equal(t, 3, countLines(t, files["workflow_runs/00001.ndjson"]))
+	assert.Equal(t, 6, countLines(t, files["step_runs/00001.ndjson"]))
+}
+
+func TestWriterEmpty(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	w := NewWriter(buf, &Manifest{})
+	require.NoError(t, w.Close())
+
+	files, names := readArchive(t, buf.Bytes())
+
+	assert.Equal(t, []string{ManifestFileName}, names)
+
+	manifest := &Manifest{}
+	require.NoError(t, json.Unmarshal(files[ManifestFileName], manifest))
+
+	assert.Empty(t, manifest.Files)
+}
+
+func newTestEncryption(t *testing.T) encryption.EncryptionService {
+	masterKey, privateEc256, publicEc256, err := encryption.GenerateLocalKeys()
+	require.NoError(t, err)
+
+	svc, err := encryption.NewLocalEncryption(masterKey, privateEc256, publicEc256)
+	require.NoError(t, err)
+
+	return svc
+}
+
+func TestDownloadToken(t *testing.T) {
+	enc := newTestEncryption(t)
+	issuer := "https://hatchet.example.com"
+
+	token, err := SignDownloadToken(enc.GetPrivateJWTHandle(), issuer, "707d0855-80ab-4e1f-a156-f1c4546cbf52", "b0e4b9d2-3d9c-4b3b-9d0b-6f5d7f6a2c11", time.Now().Add(time.Minute))
+	require.NoError(t, err)
+
+	exportId, err := VerifyDownloadToken(enc.GetPublicJWTHandle(), issuer, token)
+	require.NoError(t, err)
+
+	assert.Equal(t, "b0e4b9d2-3d9c-4b3b-9d0b-6f5d7f6a2c11", exportId)
+
+	_, err = VerifyDownloadToken(enc.GetPublicJWTHandle(), "https://other.example.com", token)
+	assert.Error(t, err)
+
+	_, err = VerifyDownloadToken(newTestEncryption(t).GetPublicJWTHandle(), issuer, token)
+	assert.Error(t, err)
+}
+
+func TestDownloadTokenExpired(t *testing.T) {
+	enc := newTestEncryption(t)
+	issuer := "https://hatchet.example.com"
+
+	token, err := SignDownloadToken(enc.GetPrivateJWTHandle(), issuer, "707d0855-80ab-4e1f-a156-f1c4546cbf52", "b0e4b9d2-3d9c-4b3b-9d0b-6f5d7f6a2c11", time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+
+	_, err = VerifyDownloadToken(enc.GetPublicJWTHandle(), issuer, token)
+	assert.Error(t, err)
+}
@@ -0,0 +1,80 @@
+package tenantexport
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tink-crypto/tink-go/jwt"
+	"github.com/tink-crypto/tink-go/keyset"
+)
+
+// DownloadTokenTypeHeader is the type header of download tokens, which distinguishes them from API tokens and
+// attestations, so that neither can be used in place of the other.
+const DownloadTokenTypeHeader = "hatchet-export-download+jwt"
+
+const exportIdClaim = "export_id"
+
+// SignDownloadToken signs a token which allows downloading the archive of an export until it expires. The issuer is
+// the URL of the instance.
+func SignDownloadToken(handle *keyset.Handle, issuer, tenantId, exportId string, expiresAt time.Time) (string, error) {
+	typeHeader := DownloadTokenTypeHeader
+	issuedAt := time.Now()
+
+	rawJWT, err := jwt.NewRawJWT(&jwt.RawJWTOptions{
+		TypeHeader: &typeHeader,
+		Issuer:     &issuer,
+		Subject:    &tenantId,
+		IssuedAt:   &issuedAt,
+		ExpiresAt:  &expiresAt,
+		CustomClaims: map[string]interface{}{
+			exportIdClaim: exportId,
+		},
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("could not create raw JWT: %w", err)
+	}
+
+	signer, err := jwt.NewSigner(handle)
+
+	if err != nil {
+		return "", fmt.Errorf("could not create JWT signer: %w", err)
+	}
+
+	return signer.SignAndEncode(rawJWT)
+}
+
+// VerifyDownloadToken verifies a download token with the public JWT keyset of the instance, and returns the id of
+// the export which it allows downloading.
+func VerifyDownloadToken(handle *keyset.Handle, issuer, token string) (string, error) {
+	verifier, err := jwt.NewVerifier(handle)
+
+	if err != nil {
+		return "", fmt.Errorf("could not create JWT verifier: %w", err)
+	}
+
+	typeHeader := DownloadTokenTypeHeader
+
+	validator, err := jwt.NewValidator(&jwt.ValidatorOpts{
+		ExpectedTypeHeader: &typeHeader,
+		ExpectedIssuer:     &issuer,
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("could not create JWT validator: %w", err)
+	}
+
+	verified, err := verifier.VerifyAndDecode(token, validator)
+
+	if err != nil {
+		return "", fmt.Errorf("could not verify download token: %w", err)
+	}
+
+	exportId, err := verified.StringClaim(exportIdClaim)
+
+	if err != nil {
+		return "", fmt.Errorf("download token has no export id: %w", err)
+	}
+
+	return exportId, nil
+}
@@ -0,0 +1,148 @@
+// Package chaos wraps the message queue and the repository with fault injectors, so that tests can check how the
+// controllers behave when messages are slow, lost or delivered twice, and when transactions conflict.
+//
+// The faults are drawn from a seeded source, so a scenario which makes its calls in a fixed order sees the same
+// faults on every run. Calls which are made concurrently draw their faults in the order in which they are made, so
+// scenarios with concurrent calls should assert invariants which hold for any order.
+package chaos
+
+import (
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ErrPublishFailed is returned by AddMessage when a publish error is injected.
+var ErrPublishFailed = errors.New("chaos: publish failed")
+
+// ErrConflict is the error which is returned by the repository when a transaction conflict is injected. It is the
+// error which postgres returns when a deadlock is detected, so it is handled like a real conflict.
+var ErrConflict error = &pgconn.PgError{
+	Severity: "ERROR",
+	Code:     "40P01",
+	Message:  "deadlock detected",
+}
+
+type Fault string
+
+const (
+	FaultLatency      Fault = "latency"
+	FaultDrop         Fault = "drop"
+	FaultDuplicate    Fault = "duplicate"
+	FaultPublishError Fault = "publish_error"
+	FaultConflict     Fault = "conflict"
+)
+
+// Faults are the rates of the injected faults, between 0 (never) and 1 (always).
+type Faults struct {
+	// MaxLatency is the maximum latency which is added to a call. The latency of each call is drawn between 0 and
+	// MaxLatency.
+	MaxLatency time.Duration
+
+	// DropRate is the rate of published messages which are lost without returning an error
+	DropRate float64
+
+	// DuplicateRate is the rate of delivered messages which are delivered a second time
+	DuplicateRate float64
+
+	// PublishErrorRate is the rate of published messages which return ErrPublishFailed
+	PublishErrorRate float64
+
+	// ConflictRate is the rate of repository calls which return ErrConflict
+	ConflictRate float64
+}
+
+// Injector decides which calls fail, and counts the injected faults.
+type Injector struct {
+	mu     sync.Mutex
+	rand   *rand.Rand
+	faults Faults
+	counts map[Fault]int
+}
+
+func NewInjector(seed int64, faults Faults) *Injector {
+	return &Injector{
+		rand:   rand.New(rand.NewSource(seed)), // nolint: gosec
+		faults: faults,
+		counts: map[Fault]int{},
+	}
+}
+
+// SetFaults changes the rates of the faults, for scenarios which inject faults in some of their steps.
+func (i *Injector) SetFaults(faults Faults) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.faults = faults
+}
+
+// Count returns the number of times that a fault was injected.
+func (i *Injector) Count(f Fault) int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.counts[f]
+}
+
+// Delay sleeps for a random latency, up to the max latency.
+func (i *Injector) Delay() {
+	i.mu.Lock()
+
+	if i.faults.MaxLatency <= 0 {
+		i.mu.Unlock()
+		return
+	}
+
+	latency := time.Duration(i.rand.Int63n(int64(i.faults.MaxLatency) + 1))
+	i.counts[FaultLatency]++
+	i.mu.Unlock()
+
+	time.Sleep(latency)
+}
+
+// Drop returns true if a published message should be lost.
+func (i *Injector) Drop() bool {
+	return i.roll(FaultDrop, func(f Faults) float64 { return f.DropRate })
+}
+
+// Duplicate returns true if a delivered message should be delivered again.
+func (i *Injector) Duplicate() bool {
+	return i.roll(FaultDuplicate, func(f Faults) float64 { return f.DuplicateRate })
+}
+
+// PublishError returns ErrPublishFailed if publishing a message should fail.
+func (i *Injector) PublishError() error {
+	if i.roll(FaultPublishError, func(f Faults) float64 { return f.PublishErrorRate }) {
+		return ErrPublishFailed
+	}
+
+	return nil
+}
+
+// Conflict returns ErrConflict if a repository call should fail with a transaction conflict.
+func (i *Injector) Conflict() error {
+	if i.roll(FaultConflict, func(f Faults) float64 { return f.ConflictRate }) {
+		return ErrConflict
+	}
+
+	return nil
+}
+
+func (i *Injector) roll(fault Fault, rate func(f Faults) float64) bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	r := rate(i.faults)
+
+	// a value is always drawn, so that changing one rate doesn't change which calls the other faults hit
+	injected := i.rand.Float64() < r
+
+	if injected {
+		i.counts[fault]++
+	}
+
+	return injected
+}
@@ -0,0 +1,112 @@
+package chaos
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue/inmemory"
+)
+
+func TestInjectorIsDeterministic(t *testing.T) {
+	faults := Faults{DropRate: 0.3, DuplicateRate: 0.3, PublishErrorRate: 0.3, ConflictRate: 0.3}
+
+	draw := func() []bool {
+		inj := NewInjector(42, faults)
+		res := []bool{}
+
+		for i := 0; i < 100; i++ {
+			res = append(res, inj.Drop(), inj.Duplicate(), inj.PublishError() != nil, inj.Conflict() != nil)
+		}
+
+		return res
+	}
+
+	assert.Equal(t, draw(), draw(), "the same seed should inject the same faults")
+}
+
+func TestInjectorRates(t *testing.T) {
+	inj := NewInjector(1, Faults{DropRate: 1})
+
+	for i := 0; i < 10; i++ {
+		assert.True(t, inj.Drop())
+		assert.False(t, inj.Duplicate())
+		assert.NoError(t, inj.Conflict())
+	}
+
+	assert.Equal(t, 10, inj.Count(FaultDrop))
+	assert.Equal(t, 0, inj.Count(FaultDuplicate))
+
+	inj.SetFaults(Faults{ConflictRate: 1})
+
+	assert.False(t, inj.Drop())
+	assert.ErrorIs(t, inj.Conflict(), ErrConflict)
+	assert.Contains(t, ErrConflict.Error(), "deadlock detected", "conflicts should be retried like deadlocks")
+}
+
+func TestMessageQueueFaults(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	cleanup, inner := inmemory.New()
+	defer cleanup() // nolint: errcheck
+
+	inj := NewInjector(7, Faults{})
+	mq := NewMessageQueue(inner, inj)
+
+	mu := sync.Mutex{}
+	received := map[string]int{}
+	wg := sync.WaitGroup{}
+
+	cleanupQueue, err := mq.Subscribe(msgqueue.JOB_PROCESSING_QUEUE, func(task *msgqueue.Message) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		received[task.ID]++
+		wg.Done()
+
+		return nil
+	}, msgqueue.NoOpHook)
+
+	require.NoError(t, err)
+	defer cleanupQueue() // nolint: errcheck
+
+	// a failed publish returns an error, and a dropped publish is lost silently
+	inj.SetFaults(Faults{PublishErrorRate: 1})
+	assert.ErrorIs(t, mq.AddMessage(ctx, msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{ID: "failed"}), ErrPublishFailed)
+
+	inj.SetFaults(Faults{DropRate: 1})
+	assert.NoError(t, mq.AddMessage(ctx, msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{ID: "dropped"}))
+
+	// a duplicated message is handled twice
+	wg.Add(2)
+	inj.SetFaults(Faults{DuplicateRate: 1})
+	require.NoError(t, mq.AddMessage(ctx, msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{ID: "duplicated"}))
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.Equal(t, map[string]int{"duplicated": 2}, received)
+}
+
+func TestRecorder(t *testing.T) {
+	r := NewRecorder()
+
+	require.NoError(t, r.AddMessage(context.Background(), msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{ID: "a"}))
+	require.NoError(t, r.AddMessage(context.Background(), msgqueue.WORKFLOW_PROCESSING_QUEUE, &msgqueue.Message{ID: "b"}))
+
+	assert.Len(t, r.Messages(), 2)
+
+	b := r.Messages("b")
+	require.Len(t, b, 1)
+	assert.Equal(t, msgqueue.WORKFLOW_PROCESSING_QUEUE.Name(), b[0].Queue)
+
+	r.Reset()
+	assert.Empty(t, r.Messages())
+}
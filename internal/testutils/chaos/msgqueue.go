@@ -0,0 +1,128 @@
+package chaos
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+)
+
+// MessageQueue injects latency, dropped and failed publishes, and duplicate deliveries into a message queue.
+type MessageQueue struct {
+	mq  msgqueue.MessageQueue
+	inj *Injector
+}
+
+func NewMessageQueue(mq msgqueue.MessageQueue, inj *Injector) *MessageQueue {
+	return &MessageQueue{
+		mq:  mq,
+		inj: inj,
+	}
+}
+
+func (m *MessageQueue) AddMessage(ctx context.Context, queue msgqueue.Queue, task *msgqueue.Message) error {
+	m.inj.Delay()
+
+	if err := m.inj.PublishError(); err != nil {
+		return err
+	}
+
+	if m.inj.Drop() {
+		return nil
+	}
+
+	return m.mq.AddMessage(ctx, queue, task)
+}
+
+// Subscribe subscribes to the queue. A duplicated message is handled a second time after it was handled
+// successfully, like a message which is redelivered because its ack was lost.
+func (m *MessageQueue) Subscribe(queueType msgqueue.Queue, preAck msgqueue.AckHook, postAck msgqueue.AckHook) (func() error, error) {
+	return m.mq.Subscribe(queueType, func(task *msgqueue.Message) error {
+		m.inj.Delay()
+
+		if err := preAck(task); err != nil {
+			return err
+		}
+
+		if m.inj.Duplicate() {
+			return preAck(task)
+		}
+
+		return nil
+	}, postAck)
+}
+
+func (m *MessageQueue) RegisterTenant(ctx context.Context, tenantId string) error {
+	return m.mq.RegisterTenant(ctx, tenantId)
+}
+
+func (m *MessageQueue) IsReady() bool {
+	return m.mq.IsReady()
+}
+
+// RecordedMessage is a message which was published to a Recorder.
+type RecordedMessage struct {
+	Queue   string
+	Message *msgqueue.Message
+}
+
+// Recorder is a message queue which records the published messages in order instead of delivering them, for
+// scenarios which call the handlers of a controller directly.
+type Recorder struct {
+	mu       sync.Mutex
+	messages []RecordedMessage
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+func (r *Recorder) AddMessage(ctx context.Context, queue msgqueue.Queue, task *msgqueue.Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.messages = append(r.messages, RecordedMessage{
+		Queue:   queue.Name(),
+		Message: task,
+	})
+
+	return nil
+}
+
+func (r *Recorder) Subscribe(queueType msgqueue.Queue, preAck msgqueue.AckHook, postAck msgqueue.AckHook) (func() error, error) {
+	return func() error { return nil }, nil
+}
+
+func (r *Recorder) RegisterTenant(ctx context.Context, tenantId string) error {
+	return nil
+}
+
+func (r *Recorder) IsReady() bool {
+	return true
+}
+
+// Messages returns the published messages with the given id, in the order in which they were published. If no ids
+// are given, all messages are returned.
+func (r *Recorder) Messages(ids ...string) []RecordedMessage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := []RecordedMessage{}
+
+	for _, m := range r.messages {
+		if len(ids) == 0 || slices.Contains(ids, m.Message.ID) {
+			res = append(res, m)
+		}
+	}
+
+	return res
+}
+
+// Reset removes the recorded messages.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.messages = nil
+}
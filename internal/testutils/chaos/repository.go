@@ -0,0 +1,132 @@
+package chaos
+
+import (
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+// Repository injects latency and transaction conflicts into the repositories which the workflows controller uses to
+// enforce concurrency limits: the workflow run, step run and get group key run repositories. The other repositories
+// are passed through.
+type Repository struct {
+	repository.Repository
+
+	inj *Injector
+}
+
+func NewRepository(repo repository.Repository, inj *Injector) *Repository {
+	return &Repository{
+		Repository: repo,
+		inj:        inj,
+	}
+}
+
+func (r *Repository) WorkflowRun() repository.WorkflowRunRepository {
+	return &workflowRunRepository{
+		WorkflowRunRepository: r.Repository.WorkflowRun(),
+		inj:                   r.inj,
+	}
+}
+
+func (r *Repository) StepRun() repository.StepRunRepository {
+	return &stepRunRepository{
+		StepRunRepository: r.Repository.StepRun(),
+		inj:               r.inj,
+	}
+}
+
+func (r *Repository) GetGroupKeyRun() repository.GetGroupKeyRunRepository {
+	return &getGroupKeyRunRepository{
+		GetGroupKeyRunRepository: r.Repository.GetGroupKeyRun(),
+		inj:                      r.inj,
+	}
+}
+
+type workflowRunRepository struct {
+	repository.WorkflowRunRepository
+
+	inj *Injector
+}
+
+func (r *workflowRunRepository) ListWorkflowRuns(tenantId string, opts *repository.ListWorkflowRunsOpts) (*repository.ListWorkflowRunsResult, error) {
+	if err := call(r.inj); err != nil {
+		return nil, err
+	}
+
+	return r.WorkflowRunRepository.ListWorkflowRuns(tenantId, opts)
+}
+
+func (r *workflowRunRepository) PopWorkflowRunsRoundRobin(tenantId, workflowVersionId string, maxRuns int) ([]*dbsqlc.WorkflowRun, error) {
+	if err := call(r.inj); err != nil {
+		return nil, err
+	}
+
+	return r.WorkflowRunRepository.PopWorkflowRunsRoundRobin(tenantId, workflowVersionId, maxRuns)
+}
+
+func (r *workflowRunRepository) GetWorkflowRunById(tenantId, runId string) (*db.WorkflowRunModel, error) {
+	if err := call(r.inj); err != nil {
+		return nil, err
+	}
+
+	return r.WorkflowRunRepository.GetWorkflowRunById(tenantId, runId)
+}
+
+type stepRunRepository struct {
+	repository.StepRunRepository
+
+	inj *Injector
+}
+
+func (r *stepRunRepository) ListStepRuns(tenantId string, opts *repository.ListStepRunsOpts) ([]db.StepRunModel, error) {
+	if err := call(r.inj); err != nil {
+		return nil, err
+	}
+
+	return r.StepRunRepository.ListStepRuns(tenantId, opts)
+}
+
+type getGroupKeyRunRepository struct {
+	repository.GetGroupKeyRunRepository
+
+	inj *Injector
+}
+
+func (r *getGroupKeyRunRepository) GetGroupKeyRunForEngine(tenantId, getGroupKeyRunId string) (*dbsqlc.GetGroupKeyRunForEngineRow, error) {
+	if err := call(r.inj); err != nil {
+		return nil, err
+	}
+
+	return r.GetGroupKeyRunRepository.GetGroupKeyRunForEngine(tenantId, getGroupKeyRunId)
+}
+
+func (r *getGroupKeyRunRepository) UpdateGetGroupKeyRun(tenantId, getGroupKeyRunId string, opts *repository.UpdateGetGroupKeyRunOpts) (*dbsqlc.GetGroupKeyRunForEngineRow, error) {
+	if err := call(r.inj); err != nil {
+		return nil, err
+	}
+
+	return r.GetGroupKeyRunRepository.UpdateGetGroupKeyRun(tenantId, getGroupKeyRunId, opts)
+}
+
+func (r *getGroupKeyRunRepository) AssignGetGroupKeyRunToWorker(tenantId, getGroupKeyRunId string) (workerId string, dispatcherId string, err error) {
+	if err := call(r.inj); err != nil {
+		return "", "", err
+	}
+
+	return r.GetGroupKeyRunRepository.AssignGetGroupKeyRunToWorker(tenantId, getGroupKeyRunId)
+}
+
+func (r *getGroupKeyRunRepository) AssignGetGroupKeyRunToTicker(tenantId, getGroupKeyRunId string) (tickerId string, err error) {
+	if err := call(r.inj); err != nil {
+		return "", err
+	}
+
+	return r.GetGroupKeyRunRepository.AssignGetGroupKeyRunToTicker(tenantId, getGroupKeyRunId)
+}
+
+// call adds latency to a repository call, and returns the conflict which it fails with, if any
+func call(inj *Injector) error {
+	inj.Delay()
+	return inj.Conflict()
+}
@@ -0,0 +1,75 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+	"github.com/hatchet-dev/hatchet/pkg/worker"
+)
+
+func TestCorrelationIdReachesStepRunFailed(t *testing.T) {
+	c := env.NewClient(t)
+
+	env.StartWorker(t, c, func(w *worker.Worker) error {
+		return w.On(worker.NoTrigger(), &worker.WorkflowJob{
+			Name: "e2e-correlation-id",
+			Steps: []*worker.WorkflowStep{
+				worker.Fn(func(ctx worker.HatchetContext) (*output, error) {
+					return nil, fmt.Errorf("failed")
+				}).SetName("step"),
+			},
+		})
+	})
+
+	msgs := env.SubscribeToTenant(t)
+
+	workflow, err := env.Repository.Workflow().GetWorkflowByName(env.TenantId, "e2e-correlation-id")
+	require.NoError(t, err)
+
+	requestId := uuid.New().String()
+
+	api := env.NewAPIClient(t, func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("X-Request-ID", requestId)
+		return nil
+	})
+
+	res, err := api.WorkflowRunCreateWithResponse(
+		context.Background(),
+		uuid.MustParse(workflow.ID),
+		&rest.WorkflowRunCreateParams{},
+		rest.WorkflowRunCreateJSONRequestBody{
+			Input: map[string]interface{}{},
+		},
+	)
+	require.NoError(t, err)
+	require.NotNil(t, res.JSON200, "could not trigger the workflow run: %s", res.Body)
+
+	assert.Equal(t, requestId, res.HTTPResponse.Header.Get("X-Request-ID"))
+
+	env.WaitForStatus(t, res.JSON200.Metadata.Id.String(), 30*time.Second, db.WorkflowRunStatusFailed)
+
+	// the failure is sent by the worker, which doesn't know the request id, so the dispatcher has to restore it
+	timeout := time.After(30 * time.Second)
+
+	for {
+		select {
+		case msg := <-msgs:
+			if msg.ID == "step-run-failed" && msg.CorrelationID() == requestId {
+				return
+			}
+		case <-timeout:
+			t.Fatal("no step-run-failed message carried the correlation id of the request")
+		}
+	}
+}
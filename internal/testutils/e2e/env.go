@@ -0,0 +1,541 @@
+//go:build e2e
+
+// Package e2e runs the engine and the API in-process against Postgres and RabbitMQ containers, so that tests can
+// register workers and assert the full lifecycle of workflow runs. It needs a docker daemon and the development certificates
+// in hack/dev/certs, which are generated by `task generate-certs`.
+//
+// The tests are run with `go test -tags e2e ./internal/testutils/e2e/...`.
+package e2e
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ory/dockertest/v3"
+	"github.com/ory/dockertest/v3/docker"
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-api/api"
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-engine/engine"
+	clientconfig "github.com/hatchet-dev/hatchet/internal/config/client"
+	"github.com/hatchet-dev/hatchet/internal/config/loader"
+	"github.com/hatchet-dev/hatchet/internal/config/server"
+	"github.com/hatchet-dev/hatchet/internal/config/shared"
+	"github.com/hatchet-dev/hatchet/internal/encryption"
+	"github.com/hatchet-dev/hatchet/internal/migrate"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/client"
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+	"github.com/hatchet-dev/hatchet/pkg/worker"
+)
+
+const (
+	postgresUser     = "hatchet"
+	postgresPassword = "hatchet"
+	postgresDb       = "hatchet"
+
+	rabbitmqUser     = "user"
+	rabbitmqPassword = "password"
+
+	// containerExpiry is the number of seconds after which docker removes the containers, in case the tests are
+	// killed before they clean up
+	containerExpiry = 600
+)
+
+// Env is an engine and an API which run in-process, with a tenant and a token for that tenant.
+type Env struct {
+	TenantId string
+	Token    string
+
+	// Repository is the repository of the engine, which tests can use to assert the state of workflow runs
+	Repository repository.Repository
+
+	// MessageQueue is the message queue of the engine, which tests can use to observe the messages of the tenant
+	MessageQueue msgqueue.MessageQueue
+
+	grpcPort int
+	apiPort  int
+	certDir  string
+
+	pool      *dockertest.Pool
+	resources []*dockertest.Resource
+	cleanups  []func() error
+}
+
+// Start starts the Postgres and RabbitMQ containers, applies the migrations, creates a tenant and starts the engine.
+// The engine config is read from the environment, so only one Env can run in a process at a time. The returned
+// function stops the engine and removes the containers.
+func Start(ctx context.Context) (*Env, func() error, error) {
+	_, b, _, _ := runtime.Caller(0)
+	baseDir := path.Join(filepath.Dir(b), "../../..")
+
+	pool, err := dockertest.NewPool("")
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not connect to docker: %w", err)
+	}
+
+	pool.MaxWait = 2 * time.Minute
+
+	env := &Env{
+		certDir: path.Join(baseDir, "hack/dev/certs"),
+		pool:    pool,
+	}
+
+	if err := env.start(ctx); err != nil {
+		return nil, nil, errors.Join(err, env.cleanup())
+	}
+
+	return env, env.cleanup, nil
+}
+
+func (e *Env) start(ctx context.Context) error {
+	postgresPort, err := e.runPostgres()
+
+	if err != nil {
+		return err
+	}
+
+	rabbitmqURL, err := e.runRabbitMQ()
+
+	if err != nil {
+		return err
+	}
+
+	e.grpcPort, err = freePort()
+
+	if err != nil {
+		return err
+	}
+
+	e.apiPort, err = freePort()
+
+	if err != nil {
+		return err
+	}
+
+	// the database config can only be read from a config directory or the environment
+	_ = os.Setenv("DATABASE_POSTGRES_HOST", "127.0.0.1")
+	_ = os.Setenv("DATABASE_POSTGRES_PORT", postgresPort)
+	_ = os.Setenv("DATABASE_POSTGRES_USERNAME", postgresUser)
+	_ = os.Setenv("DATABASE_POSTGRES_PASSWORD", postgresPassword)
+	_ = os.Setenv("DATABASE_POSTGRES_DB_NAME", postgresDb)
+	_ = os.Setenv("DATABASE_POSTGRES_SSL_MODE", "disable")
+	_ = os.Setenv("DATABASE_LOGGER_LEVEL", "warn")
+
+	masterKeyset, privateJWTKeyset, publicJWTKeyset, err := encryption.GenerateLocalKeys()
+
+	if err != nil {
+		return fmt.Errorf("could not generate encryption keys: %w", err)
+	}
+
+	configDir, err := os.MkdirTemp("", "hatchet-e2e")
+
+	if err != nil {
+		return fmt.Errorf("could not create config directory: %w", err)
+	}
+
+	e.cleanups = append(e.cleanups, func() error {
+		return os.RemoveAll(configDir)
+	})
+
+	serverCleanup, sc, err := loader.NewConfigLoader(configDir).LoadServerConfig(func(scf *server.ServerConfigFile) {
+		// the health service is left out, so that its port doesn't conflict with a local engine
+		scf.Services = []string{"ticker", "grpc", "eventscontroller", "jobscontroller", "workflowscontroller", "heartbeater"}
+
+		scf.Runtime.GRPCPort = e.grpcPort
+		scf.Runtime.GRPCBindAddress = "127.0.0.1"
+		scf.Runtime.GRPCBroadcastAddress = fmt.Sprintf("127.0.0.1:%d", e.grpcPort)
+		scf.Runtime.ShutdownWait = 0
+		scf.Runtime.Port = e.apiPort
+		scf.Runtime.ServerURL = fmt.Sprintf("http://127.0.0.1:%d", e.apiPort)
+
+		scf.MessageQueue.Kind = "rabbitmq"
+		scf.MessageQueue.RabbitMQ.URL = rabbitmqURL
+
+		scf.Encryption.MasterKeyset = string(masterKeyset)
+		scf.Encryption.JWT.PrivateJWTKeyset = string(privateJWTKeyset)
+		scf.Encryption.JWT.PublicJWTKeyset = string(publicJWTKeyset)
+
+		scf.TLS.TLSCertFile = path.Join(e.certDir, "cluster.pem")
+		scf.TLS.TLSKeyFile = path.Join(e.certDir, "cluster.key")
+		scf.TLS.TLSRootCAFile = path.Join(e.certDir, "ca.cert")
+
+		scf.Auth.Cookie.Secrets = "e2e-hash e2e-block"
+		scf.Auth.Cookie.Domain = "localhost"
+
+		scf.Logger.Level = "warn"
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not load server config: %w", err)
+	}
+
+	migrations, err := migrate.LoadEmbedded()
+
+	if err != nil {
+		return errors.Join(fmt.Errorf("could not load migrations: %w", err), serverCleanup())
+	}
+
+	if _, err := migrate.NewMigrator(sc.Pools["engine"], migrations).Up(ctx); err != nil {
+		return errors.Join(fmt.Errorf("could not apply migrations: %w", err), serverCleanup())
+	}
+
+	tenant, err := sc.Repository.Tenant().CreateTenant(&repository.CreateTenantOpts{
+		Name: "e2e",
+		Slug: "e2e",
+	})
+
+	if err != nil {
+		return errors.Join(fmt.Errorf("could not create tenant: %w", err), serverCleanup())
+	}
+
+	e.TenantId = tenant.ID
+	e.Repository = sc.Repository
+	e.MessageQueue = sc.MessageQueue
+
+	e.Token, err = sc.Auth.JWTManager.GenerateTenantToken(tenant.ID, "e2e")
+
+	if err != nil {
+		return errors.Join(fmt.Errorf("could not generate token: %w", err), serverCleanup())
+	}
+
+	engineCtx, cancel := context.WithCancel(context.Background())
+	engineErr := make(chan error, 1)
+
+	go func() {
+		engineErr <- engine.RunWithConfig(engineCtx, sc, serverCleanup)
+	}()
+
+	e.cleanups = append(e.cleanups, func() error {
+		cancel()
+		return <-engineErr
+	})
+
+	if err := e.waitForPort(ctx, e.grpcPort, engineErr); err != nil {
+		return err
+	}
+
+	apiCleanup, err := api.RunWithConfig(sc)
+
+	if err != nil {
+		return fmt.Errorf("could not start api: %w", err)
+	}
+
+	e.cleanups = append(e.cleanups, apiCleanup)
+
+	return e.waitForPort(ctx, e.apiPort, engineErr)
+}
+
+// cleanup stops the engine and removes the containers, in the reverse order in which they were started
+func (e *Env) cleanup() error {
+	var err error
+
+	for i := len(e.cleanups) - 1; i >= 0; i-- {
+		err = errors.Join(err, e.cleanups[i]())
+	}
+
+	for _, resource := range e.resources {
+		if purgeErr := e.pool.Purge(resource); purgeErr != nil {
+			err = errors.Join(err, fmt.Errorf("could not remove container %s: %w", resource.Container.Name, purgeErr))
+		}
+	}
+
+	e.cleanups = nil
+	e.resources = nil
+
+	return err
+}
+
+func (e *Env) runPostgres() (port string, err error) {
+	resource, err := e.run(&dockertest.RunOptions{
+		Repository: "postgres",
+		Tag:        "15.6",
+		Cmd:        []string{"postgres", "-c", "max_connections=200"},
+		Env: []string{
+			"POSTGRES_USER=" + postgresUser,
+			"POSTGRES_PASSWORD=" + postgresPassword,
+			"POSTGRES_DB=" + postgresDb,
+		},
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("could not start postgres: %w", err)
+	}
+
+	port = resource.GetPort("5432/tcp")
+	url := fmt.Sprintf("postgresql://%s:%s@127.0.0.1:%s/%s?sslmode=disable", postgresUser, postgresPassword, port, postgresDb)
+
+	err = e.pool.Retry(func() error {
+		conn, err := pgx.Connect(context.Background(), url)
+
+		if err != nil {
+			return err
+		}
+
+		defer conn.Close(context.Background()) // nolint: errcheck
+
+		return conn.Ping(context.Background())
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("postgres did not become ready: %w", err)
+	}
+
+	return port, nil
+}
+
+func (e *Env) runRabbitMQ() (url string, err error) {
+	resource, err := e.run(&dockertest.RunOptions{
+		Repository: "rabbitmq",
+		Tag:        "3-management",
+		Env: []string{
+			"RABBITMQ_DEFAULT_USER=" + rabbitmqUser,
+			"RABBITMQ_DEFAULT_PASS=" + rabbitmqPassword,
+		},
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("could not start rabbitmq: %w", err)
+	}
+
+	url = fmt.Sprintf("amqp://%s:%s@127.0.0.1:%s/", rabbitmqUser, rabbitmqPassword, resource.GetPort("5672/tcp"))
+
+	err = e.pool.Retry(func() error {
+		conn, err := amqp.Dial(url)
+
+		if err != nil {
+			return err
+		}
+
+		return conn.Close()
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("rabbitmq did not become ready: %w", err)
+	}
+
+	return url, nil
+}
+
+func (e *Env) run(opts *dockertest.RunOptions) (*dockertest.Resource, error) {
+	resource, err := e.pool.RunWithOptions(opts, func(config *docker.HostConfig) {
+		config.AutoRemove = true
+		config.RestartPolicy = docker.RestartPolicy{Name: "no"}
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	e.resources = append(e.resources, resource)
+
+	if err := resource.Expire(containerExpiry); err != nil {
+		return nil, fmt.Errorf("could not set container expiry: %w", err)
+	}
+
+	return resource, nil
+}
+
+// waitForPort waits until the grpc server or the API accepts connections on the port, or the engine exits
+func (e *Env) waitForPort(ctx context.Context, port int, engineErr <-chan error) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	addr := net.JoinHostPort("127.0.0.1", strconv.Itoa(port))
+
+	for {
+		conn, err := net.DialTimeout("tcp", addr, time.Second)
+
+		if err == nil {
+			return conn.Close()
+		}
+
+		select {
+		case err := <-engineErr:
+			return fmt.Errorf("engine exited before it was ready: %w", err)
+		case <-ctx.Done():
+			return fmt.Errorf("engine did not become ready: %w", ctx.Err())
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+// NewClient returns a client which connects to the engine with the token of the tenant.
+func (e *Env) NewClient(t *testing.T) client.Client {
+	t.Helper()
+
+	c, err := client.NewFromConfigFile(&clientconfig.ClientConfigFile{
+		TenantId: e.TenantId,
+		Token:    e.Token,
+		HostPort: fmt.Sprintf("127.0.0.1:%d", e.grpcPort),
+		TLS: clientconfig.ClientTLSConfigFile{
+			Base: shared.TLSConfigFile{
+				TLSRootCAFile: path.Join(e.certDir, "ca.cert"),
+			},
+			// the development certificates are issued for the "cluster" host
+			TLSServerName: "cluster",
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("could not create client: %v", err)
+	}
+
+	return c
+}
+
+// NewAPIClient returns a client of the REST API which authenticates with the token of the tenant. The request
+// editors are applied to every request.
+func (e *Env) NewAPIClient(t *testing.T, editors ...rest.RequestEditorFn) *rest.ClientWithResponses {
+	t.Helper()
+
+	opts := []rest.ClientOption{
+		rest.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("Authorization", "Bearer "+e.Token)
+			return nil
+		}),
+	}
+
+	for _, editor := range editors {
+		opts = append(opts, rest.WithRequestEditorFn(editor))
+	}
+
+	c, err := rest.NewClientWithResponses(fmt.Sprintf("http://127.0.0.1:%d", e.apiPort), opts...)
+
+	if err != nil {
+		t.Fatalf("could not create API client: %v", err)
+	}
+
+	return c
+}
+
+// SubscribeToTenant returns the messages of the tenant from when it's called, with batches unbatched. The
+// subscription ends when the test ends.
+func (e *Env) SubscribeToTenant(t *testing.T) <-chan *msgqueue.Message {
+	t.Helper()
+
+	q, err := msgqueue.TenantEventConsumerQueue(e.TenantId)
+
+	if err != nil {
+		t.Fatalf("could not create tenant queue: %v", err)
+	}
+
+	msgs := make(chan *msgqueue.Message, 100)
+
+	cleanup, err := e.MessageQueue.Subscribe(q, func(task *msgqueue.Message) error {
+		tasks, err := msgqueue.UnbatchTenantMessage(task)
+
+		if err != nil {
+			return err
+		}
+
+		for _, msg := range tasks {
+			select {
+			case msgs <- msg:
+			default:
+				// the test isn't reading the messages, so they're dropped instead of blocking the queue
+			}
+		}
+
+		return nil
+	}, msgqueue.NoOpHook)
+
+	if err != nil {
+		t.Fatalf("could not subscribe to tenant queue: %v", err)
+	}
+
+	t.Cleanup(func() {
+		if err := cleanup(); err != nil {
+			t.Errorf("could not unsubscribe from tenant queue: %v", err)
+		}
+	})
+
+	return msgs
+}
+
+// StartWorker registers the workflows with a new worker, and starts it. The worker is stopped when the test ends.
+func (e *Env) StartWorker(t *testing.T, c client.Client, register func(w *worker.Worker) error) {
+	t.Helper()
+
+	w, err := worker.NewWorker(
+		worker.WithClient(c),
+		worker.WithName(t.Name()),
+	)
+
+	if err != nil {
+		t.Fatalf("could not create worker: %v", err)
+	}
+
+	if err := register(w); err != nil {
+		t.Fatalf("could not register workflows: %v", err)
+	}
+
+	cleanup, err := w.Start()
+
+	if err != nil {
+		t.Fatalf("could not start worker: %v", err)
+	}
+
+	t.Cleanup(func() {
+		if err := cleanup(); err != nil {
+			t.Errorf("could not stop worker: %v", err)
+		}
+	})
+}
+
+// WaitForStatus waits until the workflow run has one of the given statuses, and returns it.
+func (e *Env) WaitForStatus(t *testing.T, workflowRunId string, timeout time.Duration, statuses ...db.WorkflowRunStatus) *db.WorkflowRunModel {
+	t.Helper()
+
+	deadline := time.Now().Add(timeout)
+
+	var lastStatus db.WorkflowRunStatus
+
+	for time.Now().Before(deadline) {
+		workflowRun, err := e.Repository.WorkflowRun().GetWorkflowRunById(e.TenantId, workflowRunId)
+
+		if err != nil {
+			t.Fatalf("could not get workflow run %s: %v", workflowRunId, err)
+		}
+
+		for _, status := range statuses {
+			if workflowRun.Status == status {
+				return workflowRun
+			}
+		}
+
+		lastStatus = workflowRun.Status
+
+		time.Sleep(200 * time.Millisecond)
+	}
+
+	t.Fatalf("workflow run %s did not reach %v within %s, last status was %s", workflowRunId, statuses, timeout, lastStatus)
+
+	return nil
+}
+
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		return 0, fmt.Errorf("could not find a free port: %w", err)
+	}
+
+	defer l.Close() // nolint: errcheck
+
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
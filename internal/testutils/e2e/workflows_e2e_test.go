@@ -0,0 +1,193 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
+	"github.com/hatchet-dev/hatchet/pkg/worker"
+)
+
+var env *Env
+
+func TestMain(m *testing.M) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+
+	e, cleanup, err := Start(ctx)
+
+	cancel()
+
+	if err != nil {
+		log.Fatalf("could not start e2e environment: %v", err)
+	}
+
+	env = e
+
+	code := m.Run()
+
+	if err := cleanup(); err != nil {
+		log.Printf("could not clean up e2e environment: %v", err)
+	}
+
+	os.Exit(code)
+}
+
+type output struct {
+	Attempt int32 `json:"attempt"`
+}
+
+func TestConcurrencyCancelInProgress(t *testing.T) {
+	c := env.NewClient(t)
+
+	started := make(chan struct{}, 2)
+	cancelled := make(chan struct{}, 2)
+
+	env.StartWorker(t, c, func(w *worker.Worker) error {
+		return w.On(worker.NoTrigger(), &worker.WorkflowJob{
+			Name: "e2e-cancel-in-progress",
+			Concurrency: worker.Concurrency(func(ctx worker.HatchetContext) (string, error) {
+				return "e2e", nil
+			}).MaxRuns(1).LimitStrategy(types.CancelInProgress),
+			Steps: []*worker.WorkflowStep{
+				worker.Fn(func(ctx worker.HatchetContext) (*output, error) {
+					started <- struct{}{}
+
+					input := map[string]bool{}
+
+					if err := ctx.WorkflowInput(&input); err != nil {
+						return nil, err
+					}
+
+					// the first run blocks until it is cancelled, so that it is in progress when the second run starts
+					if input["block"] {
+						<-ctx.Done()
+						cancelled <- struct{}{}
+
+						return nil, ctx.Err()
+					}
+
+					return &output{}, nil
+				}).SetName("step").SetTimeout("60s"),
+			},
+		})
+	})
+
+	first, err := c.Admin().RunWorkflow("e2e-cancel-in-progress", map[string]bool{"block": true})
+	require.NoError(t, err)
+
+	waitFor(t, started, 30*time.Second, "the first run did not start")
+
+	second, err := c.Admin().RunWorkflow("e2e-cancel-in-progress", map[string]bool{"block": false})
+	require.NoError(t, err)
+
+	waitFor(t, cancelled, 30*time.Second, "the first run was not cancelled")
+
+	env.WaitForStatus(t, second, 30*time.Second, db.WorkflowRunStatusSucceeded)
+
+	run := env.WaitForStatus(t, first, 30*time.Second, db.WorkflowRunStatusSucceeded, db.WorkflowRunStatusFailed)
+	assert.NotEqual(t, db.WorkflowRunStatusSucceeded, run.Status, "the cancelled run should not succeed")
+}
+
+func TestRetries(t *testing.T) {
+	c := env.NewClient(t)
+
+	var attempts atomic.Int32
+
+	env.StartWorker(t, c, func(w *worker.Worker) error {
+		return w.On(worker.NoTrigger(), &worker.WorkflowJob{
+			Name: "e2e-retries",
+			Steps: []*worker.WorkflowStep{
+				worker.Fn(func(ctx worker.HatchetContext) (*output, error) {
+					attempt := attempts.Add(1)
+
+					if attempt < 3 {
+						return nil, fmt.Errorf("attempt %d failed", attempt)
+					}
+
+					return &output{Attempt: attempt}, nil
+				}).SetName("step").SetRetries(2),
+			},
+		})
+	})
+
+	runId, err := c.Admin().RunWorkflow("e2e-retries", map[string]bool{})
+	require.NoError(t, err)
+
+	env.WaitForStatus(t, runId, time.Minute, db.WorkflowRunStatusSucceeded)
+
+	assert.Equal(t, int32(3), attempts.Load(), "the step should succeed on its last retry")
+}
+
+func TestRetriesExhausted(t *testing.T) {
+	c := env.NewClient(t)
+
+	var attempts atomic.Int32
+
+	env.StartWorker(t, c, func(w *worker.Worker) error {
+		return w.On(worker.NoTrigger(), &worker.WorkflowJob{
+			Name: "e2e-retries-exhausted",
+			Steps: []*worker.WorkflowStep{
+				worker.Fn(func(ctx worker.HatchetContext) (*output, error) {
+					return nil, fmt.Errorf("attempt %d failed", attempts.Add(1))
+				}).SetName("step").SetRetries(1),
+			},
+		})
+	})
+
+	runId, err := c.Admin().RunWorkflow("e2e-retries-exhausted", map[string]bool{})
+	require.NoError(t, err)
+
+	env.WaitForStatus(t, runId, time.Minute, db.WorkflowRunStatusFailed)
+
+	assert.Equal(t, int32(2), attempts.Load(), "the step should be attempted once and retried once")
+}
+
+func TestTimeout(t *testing.T) {
+	c := env.NewClient(t)
+
+	once := sync.Once{}
+	cancelled := make(chan struct{})
+
+	env.StartWorker(t, c, func(w *worker.Worker) error {
+		return w.On(worker.NoTrigger(), &worker.WorkflowJob{
+			Name: "e2e-timeout",
+			Steps: []*worker.WorkflowStep{
+				worker.Fn(func(ctx worker.HatchetContext) (*output, error) {
+					<-ctx.Done()
+					once.Do(func() { close(cancelled) })
+
+					return nil, ctx.Err()
+				}).SetName("step").SetTimeout("5s"),
+			},
+		})
+	})
+
+	runId, err := c.Admin().RunWorkflow("e2e-timeout", map[string]bool{})
+	require.NoError(t, err)
+
+	env.WaitForStatus(t, runId, time.Minute, db.WorkflowRunStatusFailed)
+
+	waitFor(t, cancelled, 30*time.Second, "the timed out step was not cancelled on the worker")
+}
+
+func waitFor(t *testing.T, ch <-chan struct{}, timeout time.Duration, msg string) {
+	t.Helper()
+
+	select {
+	case <-ch:
+	case <-time.After(timeout):
+		t.Fatal(msg)
+	}
+}
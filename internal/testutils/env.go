@@ -10,7 +10,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/config/loader"
 )
 
-func Prepare(t *testing.T) {
+func Prepare(t testing.TB) {
 	t.Helper()
 
 	_, b, _, _ := runtime.Caller(0)
@@ -0,0 +1,256 @@
+package sim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+)
+
+// retryDelay matches the TTL of the RabbitMQ dead letter queues
+const retryDelay = 5 * time.Second
+
+type MessageQueueOpt func(*MessageQueueOpts)
+
+type MessageQueueOpts struct {
+	minLatency time.Duration
+	maxLatency time.Duration
+}
+
+func defaultMessageQueueOpts() *MessageQueueOpts {
+	return &MessageQueueOpts{
+		minLatency: time.Millisecond,
+		maxLatency: 10 * time.Millisecond,
+	}
+}
+
+// WithLatency sets the range of the simulated time between publishing a message and delivering it.
+func WithLatency(min, max time.Duration) MessageQueueOpt {
+	return func(opts *MessageQueueOpts) {
+		opts.minLatency = min
+		opts.maxLatency = max
+	}
+}
+
+type subscriber struct {
+	preAck  msgqueue.AckHook
+	postAck msgqueue.AckHook
+}
+
+type queue struct {
+	subscribers []*subscriber
+
+	// next is the index of the subscriber which gets the next message, as competing subscribers get messages
+	// round robin
+	next int
+}
+
+// MessageQueue implements the MessageQueue interface on simulated time. Messages are delivered on the goroutine of the
+// simulation after a latency which only depends on the seed and the message, and rejected messages are redelivered
+// after the retry delay of the RabbitMQ dead letter queues.
+type MessageQueue struct {
+	s *Simulation
+
+	opts *MessageQueueOpts
+
+	mu sync.Mutex
+
+	queues  map[string]*queue
+	fanouts map[string]map[*queue]struct{}
+
+	published int
+	delivered int
+	rejected  int
+}
+
+// NewMessageQueue creates a message queue which delivers messages on the simulation.
+func (s *Simulation) NewMessageQueue(fs ...MessageQueueOpt) *MessageQueue {
+	opts := defaultMessageQueueOpts()
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	return &MessageQueue{
+		s:       s,
+		opts:    opts,
+		queues:  make(map[string]*queue),
+		fanouts: make(map[string]map[*queue]struct{}),
+	}
+}
+
+func (m *MessageQueue) IsReady() bool {
+	return true
+}
+
+// RegisterTenant is a no-op, as tenant queues are created when they are subscribed to.
+func (m *MessageQueue) RegisterTenant(ctx context.Context, tenantId string) error {
+	return nil
+}
+
+// AddMessage schedules the delivery of msg to the queue, and to the queue of every subscriber to the msg's tenant.
+// Messages to queues without subscribers are dropped, as the simulation would never deliver them.
+func (m *MessageQueue) AddMessage(ctx context.Context, q msgqueue.Queue, msg *msgqueue.Message) error {
+	msg.SetCorrelationIDFromContext(ctx)
+
+	body, err := json.Marshal(msg)
+
+	if err != nil {
+		return fmt.Errorf("could not marshal message: %w", err)
+	}
+
+	m.mu.Lock()
+
+	queues := make([]*queue, 0)
+
+	if mq, ok := m.queues[q.Name()]; ok {
+		queues = append(queues, mq)
+	}
+
+	if tenantId := msg.TenantID(); tenantId != "" {
+		for mq := range m.fanouts[tenantId] {
+			queues = append(queues, mq)
+		}
+	}
+
+	m.published++
+
+	m.mu.Unlock()
+
+	for _, mq := range queues {
+		m.deliverAfter(m.latency(q.Name(), body), q.Name(), mq, body, 0)
+	}
+
+	return nil
+}
+
+// Subscribe subscribes to the queue. The hooks are called on the goroutine of the simulation.
+func (m *MessageQueue) Subscribe(
+	q msgqueue.Queue,
+	preAck msgqueue.AckHook,
+	postAck msgqueue.AckHook,
+) (func() error, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var mq *queue
+
+	if key := q.FanoutExchangeKey(); key != "" {
+		// every subscriber to a fanout exchange gets its own queue
+		mq = &queue{}
+
+		if _, ok := m.fanouts[key]; !ok {
+			m.fanouts[key] = make(map[*queue]struct{})
+		}
+
+		m.fanouts[key][mq] = struct{}{}
+	} else {
+		var ok bool
+
+		if mq, ok = m.queues[q.Name()]; !ok {
+			mq = &queue{}
+			m.queues[q.Name()] = mq
+		}
+	}
+
+	sub := &subscriber{
+		preAck:  preAck,
+		postAck: postAck,
+	}
+
+	mq.subscribers = append(mq.subscribers, sub)
+
+	cleanup := func() error {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		for i := range mq.subscribers {
+			if mq.subscribers[i] == sub {
+				mq.subscribers = append(mq.subscribers[:i], mq.subscribers[i+1:]...)
+				break
+			}
+		}
+
+		if key := q.FanoutExchangeKey(); key != "" {
+			delete(m.fanouts[key], mq)
+
+			if len(m.fanouts[key]) == 0 {
+				delete(m.fanouts, key)
+			}
+		}
+
+		return nil
+	}
+
+	return cleanup, nil
+}
+
+// Stats returns the number of published, delivered and rejected messages.
+func (m *MessageQueue) Stats() (published, delivered, rejected int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.published, m.delivered, m.rejected
+}
+
+func (m *MessageQueue) deliverAfter(d time.Duration, queueName string, mq *queue, body []byte, attempts int) {
+	// the order of deliveries at the same time depends on the message rather than on the order of the publishing
+	// goroutines
+	key := m.s.hash([]byte(queueName), body, []byte{byte(attempts)})
+
+	m.s.schedule(m.s.Now().Add(d), key, func() {
+		m.deliver(queueName, mq, body, attempts)
+	})
+}
+
+func (m *MessageQueue) deliver(queueName string, mq *queue, body []byte, attempts int) {
+	m.mu.Lock()
+
+	if len(mq.subscribers) == 0 {
+		m.mu.Unlock()
+		return
+	}
+
+	sub := mq.subscribers[mq.next%len(mq.subscribers)]
+	mq.next++
+
+	m.delivered++
+
+	m.mu.Unlock()
+
+	// each delivery gets its own copy of the message, in the same way as messages read from RabbitMQ
+	msg := &msgqueue.Message{}
+
+	if err := json.Unmarshal(body, msg); err != nil {
+		panic(fmt.Sprintf("could not unmarshal message: %v", err))
+	}
+
+	if err := sub.preAck(msg); err != nil {
+		m.mu.Lock()
+		m.rejected++
+		m.mu.Unlock()
+
+		if attempts+1 > msg.Retries {
+			return
+		}
+
+		m.deliverAfter(retryDelay, queueName, mq, body, attempts+1)
+
+		return
+	}
+
+	_ = sub.postAck(msg)
+}
+
+func (m *MessageQueue) latency(queueName string, body []byte) time.Duration {
+	spread := m.opts.maxLatency - m.opts.minLatency
+
+	if spread <= 0 {
+		return m.opts.minLatency
+	}
+
+	return m.opts.minLatency + time.Duration(m.s.hash([]byte(queueName), body)%uint64(spread))
+}
@@ -0,0 +1,194 @@
+// Package sim runs the scheduling logic of the controllers on simulated time, so that hours of scheduling can be
+// tested in milliseconds. A Simulation has a virtual clock, virtual tickers for the periodic loops of the
+// controllers and a virtual message queue, and runs everything which is scheduled on one goroutine, in the order of
+// the simulated time.
+//
+// A simulation is reproducible: the same seed and the same scenario always run the same events in the same order,
+// even if the handlers publish messages from several goroutines.
+package sim
+
+import (
+	"container/heap"
+	"hash/fnv"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+)
+
+// Simulation runs scheduled events in the order of the simulated time.
+type Simulation struct {
+	mu sync.Mutex
+
+	seed  int64
+	rand  *rand.Rand
+	clock clockwork.FakeClock
+
+	events eventHeap
+	seq    uint64
+}
+
+func New(seed int64, start time.Time) *Simulation {
+	return &Simulation{
+		seed:  seed,
+		rand:  rand.New(rand.NewSource(seed)), // nolint: gosec
+		clock: clockwork.NewFakeClockAt(start),
+	}
+}
+
+// Clock returns the virtual clock of the simulation, to be passed to the services under test.
+func (s *Simulation) Clock() clockwork.Clock {
+	return s.clock
+}
+
+// Now returns the simulated time.
+func (s *Simulation) Now() time.Time {
+	return s.clock.Now()
+}
+
+// Rand returns the seeded source of the simulation. It must only be used by the scenario and by scheduled events,
+// which run on the goroutine of the simulation, so that the values are drawn in a reproducible order.
+func (s *Simulation) Rand() *rand.Rand {
+	return s.rand
+}
+
+// After schedules fn to run after the duration of simulated time.
+func (s *Simulation) After(d time.Duration, fn func()) {
+	s.schedule(s.clock.Now().Add(d), 0, fn)
+}
+
+// Every schedules fn to run at every interval of simulated time, like a ticker of a controller loop. The first run is
+// after one interval.
+func (s *Simulation) Every(interval time.Duration, fn func()) {
+	var tick func()
+
+	tick = func() {
+		fn()
+		s.After(interval, tick)
+	}
+
+	s.After(interval, tick)
+}
+
+// RunFor runs the scheduled events until the duration of simulated time has passed, and advances the clock to the
+// end of the duration. It returns the number of events which were run.
+func (s *Simulation) RunFor(d time.Duration) int {
+	return s.RunUntil(s.clock.Now().Add(d))
+}
+
+// RunUntil runs the scheduled events until the simulated time reaches end, and advances the clock to end. It returns
+// the number of events which were run.
+func (s *Simulation) RunUntil(end time.Time) int {
+	count := 0
+
+	for {
+		s.mu.Lock()
+
+		if len(s.events) == 0 || s.events[0].at.After(end) {
+			s.mu.Unlock()
+			break
+		}
+
+		e := heap.Pop(&s.events).(*event)
+		s.mu.Unlock()
+
+		if e.at.After(s.clock.Now()) {
+			s.clock.Advance(e.at.Sub(s.clock.Now()))
+		}
+
+		e.fn()
+		count++
+	}
+
+	if end.After(s.clock.Now()) {
+		s.clock.Advance(end.Sub(s.clock.Now()))
+	}
+
+	return count
+}
+
+// Pending returns the number of scheduled events which have not run yet.
+func (s *Simulation) Pending() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.events)
+}
+
+// schedule adds an event. Events at the same time are ordered by their key, and then by the order in which they were
+// scheduled. Events which may be scheduled from several goroutines should have distinct keys, so that their order
+// does not depend on the order of the goroutines.
+func (s *Simulation) schedule(at time.Time, key uint64, fn func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.seq++
+
+	heap.Push(&s.events, &event{
+		at:  at,
+		key: key,
+		seq: s.seq,
+		fn:  fn,
+	})
+}
+
+// hash returns a value which only depends on the seed of the simulation and the data, to draw values for events which
+// are scheduled from several goroutines.
+func (s *Simulation) hash(data ...[]byte) uint64 {
+	h := fnv.New64a()
+
+	seed := uint64(s.seed)
+
+	for i := 0; i < 8; i++ {
+		_, _ = h.Write([]byte{byte(seed >> (8 * i))})
+	}
+
+	for _, d := range data {
+		_, _ = h.Write(d)
+	}
+
+	return h.Sum64()
+}
+
+type event struct {
+	at  time.Time
+	key uint64
+	seq uint64
+	fn  func()
+}
+
+type eventHeap []*event
+
+func (h eventHeap) Len() int {
+	return len(h)
+}
+
+func (h eventHeap) Less(i, j int) bool {
+	if !h[i].at.Equal(h[j].at) {
+		return h[i].at.Before(h[j].at)
+	}
+
+	if h[i].key != h[j].key {
+		return h[i].key < h[j].key
+	}
+
+	return h[i].seq < h[j].seq
+}
+
+func (h eventHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *eventHeap) Push(x any) {
+	*h = append(*h, x.(*event))
+}
+
+func (h *eventHeap) Pop() any {
+	old := *h
+	n := len(old)
+	e := old[n-1]
+	*h = old[:n-1]
+
+	return e
+}
@@ -0,0 +1,127 @@
+package sim
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+)
+
+var start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestEveryRunsOnSimulatedTime(t *testing.T) {
+	s := New(1, start)
+
+	ticks := []time.Time{}
+
+	s.Every(5*time.Second, func() {
+		ticks = append(ticks, s.Now())
+	})
+
+	wallStart := time.Now()
+
+	s.RunFor(24 * time.Hour)
+
+	assert.Len(t, ticks, 24*60*60/5)
+	assert.Equal(t, start.Add(5*time.Second), ticks[0])
+	assert.Equal(t, start.Add(24*time.Hour), s.Now())
+	assert.Less(t, time.Since(wallStart), 10*time.Second, "a simulated day should not take wall clock time")
+}
+
+func TestClockTimersFireOnAdvance(t *testing.T) {
+	s := New(1, start)
+
+	fired := make(chan time.Time, 1)
+
+	s.Clock().AfterFunc(time.Minute, func() {
+		fired <- s.Now()
+	})
+
+	s.RunFor(time.Minute)
+
+	select {
+	case at := <-fired:
+		assert.Equal(t, start.Add(time.Minute), at)
+	case <-time.After(time.Second):
+		t.Fatal("timer of the simulated clock did not fire")
+	}
+}
+
+func TestMessageQueueRedeliversRejectedMessages(t *testing.T) {
+	s := New(1, start)
+	mq := s.NewMessageQueue()
+
+	deliveries := []time.Time{}
+
+	_, err := mq.Subscribe(msgqueue.JOB_PROCESSING_QUEUE, func(task *msgqueue.Message) error {
+		deliveries = append(deliveries, s.Now())
+		return fmt.Errorf("rejected")
+	}, msgqueue.NoOpHook)
+	require.NoError(t, err)
+
+	require.NoError(t, mq.AddMessage(context.Background(), msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{
+		ID:      "test",
+		Retries: 2,
+	}))
+
+	s.RunFor(time.Minute)
+
+	require.Len(t, deliveries, 3, "the message should be delivered once and retried twice")
+	assert.Equal(t, retryDelay, deliveries[1].Sub(deliveries[0]))
+
+	published, delivered, rejected := mq.Stats()
+	assert.Equal(t, 1, published)
+	assert.Equal(t, 3, delivered)
+	assert.Equal(t, 3, rejected)
+}
+
+func TestMessageQueueIsReproducible(t *testing.T) {
+	trace := func(seed int64) []string {
+		s := New(seed, start)
+		mq := s.NewMessageQueue(WithLatency(time.Millisecond, time.Second))
+
+		res := []string{}
+
+		_, err := mq.Subscribe(msgqueue.JOB_PROCESSING_QUEUE, func(task *msgqueue.Message) error {
+			res = append(res, fmt.Sprintf("%s %s", s.Now().Format(time.RFC3339Nano), task.ID))
+			return nil
+		}, msgqueue.NoOpHook)
+		require.NoError(t, err)
+
+		s.Every(time.Second, func() {
+			// publish from several goroutines, so the order of publishing differs between runs
+			wg := sync.WaitGroup{}
+
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+
+				go func(i int) {
+					defer wg.Done()
+
+					_ = mq.AddMessage(context.Background(), msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{
+						ID: fmt.Sprintf("%s-%d", s.Now().Format(time.RFC3339), i),
+					})
+				}(i)
+			}
+
+			wg.Wait()
+		})
+
+		s.RunFor(time.Hour)
+
+		return res
+	}
+
+	first := trace(42)
+
+	// the messages of the last tick may still be in flight
+	assert.GreaterOrEqual(t, len(first), 60*60*10-10)
+	assert.Equal(t, first, trace(42), "the same seed should deliver the same messages at the same times")
+	assert.NotEqual(t, first, trace(43), "a different seed should deliver messages in a different order")
+}
@@ -7,7 +7,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/config/loader"
 )
 
-func RunTestWithDatabase(t *testing.T, test func(config *database.Config) error) {
+func RunTestWithDatabase(t testing.TB, test func(config *database.Config) error) {
 	t.Helper()
 	Prepare(t)
 
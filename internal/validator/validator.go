@@ -15,6 +15,9 @@ import (
 
 var NameRegex = regexp.MustCompile("^[a-zA-Z0-9\\.\\-_]+$") //nolint:gosimple
 
+// QualifiedNameRegex matches names which may be prefixed with a namespace, such as payments/process-order.
+var QualifiedNameRegex = regexp.MustCompile("^([a-zA-Z0-9\\.\\-_]+/)?[a-zA-Z0-9\\.\\-_]+$") //nolint:gosimple
+
 var CronRegex = regexp.MustCompile(`(@(annually|yearly|monthly|weekly|daily|hourly|reboot))|(@every (\d+(ns|us|µs|ms|s|m|h))+)|((((\d+,)+\d+|(\d+(\/|-)\d+)|\d+|\*) ?){5,7})`) //nolint:gosimple
 
 func newValidator() *validator.Validate {
@@ -24,6 +27,10 @@ func newValidator() *validator.Validate {
 		return NameRegex.MatchString(fl.Field().String())
 	})
 
+	_ = validate.RegisterValidation("hatchetQualifiedName", func(fl validator.FieldLevel) bool {
+		return QualifiedNameRegex.MatchString(fl.Field().String())
+	})
+
 	_ = validate.RegisterValidation("password", func(fl validator.FieldLevel) bool {
 		return passwordValidation(fl.Field().String())
 	})
@@ -30,6 +30,21 @@ func TestValidatorValidName(t *testing.T) {
 	assert.NoError(t, err, "no error")
 }
 
+type qualifiedNameResource struct {
+	Name string `validate:"hatchetQualifiedName"`
+}
+
+func TestValidatorQualifiedName(t *testing.T) {
+	v := newValidator()
+
+	assert.NoError(t, v.Struct(&qualifiedNameResource{Name: "process-order"}))
+	assert.NoError(t, v.Struct(&qualifiedNameResource{Name: "payments/process-order"}))
+
+	assert.Error(t, v.Struct(&qualifiedNameResource{Name: "payments/"}))
+	assert.Error(t, v.Struct(&qualifiedNameResource{Name: "/process-order"}))
+	assert.Error(t, v.Struct(&qualifiedNameResource{Name: "a/b/process-order"}))
+}
+
 type cronResource struct {
 	Cron string `validate:"cron"`
 }
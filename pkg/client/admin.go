@@ -4,10 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/rs/zerolog"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	admincontracts "github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
@@ -19,8 +23,63 @@ type AdminClient interface {
 	PutWorkflow(workflow *types.Workflow, opts ...PutOptFunc) error
 	ScheduleWorkflow(workflowName string, opts ...ScheduleOptFunc) error
 
-	// RunWorkflow triggers a workflow run and returns the run id
-	RunWorkflow(workflowName string, input interface{}) (string, error)
+	// RunWorkflow triggers a workflow run and returns the run id. If the engine is shedding load, a
+	// *BackpressureError is returned.
+	RunWorkflow(workflowName string, input interface{}, opts ...RunOptFunc) (string, error)
+
+	// RunWorkflowWithContext triggers a workflow run like RunWorkflow, and gives up on the trigger when the context
+	// is done.
+	RunWorkflowWithContext(ctx context.Context, workflowName string, input interface{}, opts ...RunOptFunc) (string, error)
+}
+
+// BackpressureError is returned when a trigger is rejected because the engine is shedding load.
+type BackpressureError struct {
+	// RetryAfter is the suggested duration to wait before the next trigger
+	RetryAfter time.Duration
+
+	// QueueDepth is the number of workflow runs and step runs of the tenant which are waiting to be processed
+	QueueDepth int64
+
+	err error
+}
+
+func (e *BackpressureError) Error() string {
+	return fmt.Sprintf("trigger rejected, retry after %s: %s", e.RetryAfter, e.err.Error())
+}
+
+func (e *BackpressureError) Unwrap() error {
+	return e.err
+}
+
+// errorReasonTriggerShed is the reason of the error info of triggers which are rejected by the engine
+const errorReasonTriggerShed = "TRIGGER_SHED"
+
+func toBackpressureError(err error) (*BackpressureError, bool) {
+	st, ok := status.FromError(err)
+
+	if !ok || st.Code() != codes.ResourceExhausted {
+		return nil, false
+	}
+
+	res := &BackpressureError{
+		err: err,
+	}
+
+	isShed := false
+
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.RetryInfo:
+			res.RetryAfter = d.GetRetryDelay().AsDuration()
+		case *errdetails.ErrorInfo:
+			if d.GetReason() == errorReasonTriggerShed {
+				isShed = true
+				res.QueueDepth, _ = strconv.ParseInt(d.GetMetadata()["queueDepth"], 10, 64)
+			}
+		}
+	}
+
+	return res, isShed
 }
 
 type adminClientImpl struct {
@@ -141,22 +200,78 @@ func (a *adminClientImpl) ScheduleWorkflow(workflowName string, fs ...ScheduleOp
 	return nil
 }
 
-func (a *adminClientImpl) RunWorkflow(workflowName string, input interface{}) (string, error) {
+type runOpts struct {
+	priority           bool
+	additionalMetadata map[string]interface{}
+}
+
+type RunOptFunc func(*runOpts)
+
+// WithPriority marks the trigger as a priority, which is not rejected when the engine is shedding load.
+func WithPriority() RunOptFunc {
+	return func(opts *runOpts) {
+		opts.priority = true
+	}
+}
+
+// WithRunMetadata sets metadata, such as a user id or a trace id, which is passed to every step run of the
+// workflow run and its get group key run.
+func WithRunMetadata(metadata map[string]interface{}) RunOptFunc {
+	return func(opts *runOpts) {
+		opts.additionalMetadata = metadata
+	}
+}
+
+func (a *adminClientImpl) RunWorkflow(workflowName string, input interface{}, fs ...RunOptFunc) (string, error) {
+	return a.RunWorkflowWithContext(context.Background(), workflowName, input, fs...)
+}
+
+func (a *adminClientImpl) RunWorkflowWithContext(ctx context.Context, workflowName string, input interface{}, fs ...RunOptFunc) (string, error) {
+	opts := &runOpts{}
+
+	for _, f := range fs {
+		f(opts)
+	}
+
 	inputBytes, err := json.Marshal(input)
 
 	if err != nil {
 		return "", fmt.Errorf("could not marshal input: %w", err)
 	}
 
-	res, err := a.client.TriggerWorkflow(a.ctx.newContext(context.Background()), &admincontracts.TriggerWorkflowRequest{
+	req := &admincontracts.TriggerWorkflowRequest{
 		Name:  workflowName,
 		Input: string(inputBytes),
-	})
+	}
+
+	if opts.priority {
+		req.Priority = &opts.priority
+	}
+
+	if opts.additionalMetadata != nil {
+		metadataBytes, err := json.Marshal(opts.additionalMetadata)
+
+		if err != nil {
+			return "", fmt.Errorf("could not marshal additional metadata: %w", err)
+		}
+
+		req.AdditionalMetadata = string(metadataBytes)
+	}
+
+	res, err := a.client.TriggerWorkflow(a.ctx.newContext(ctx), req)
 
 	if err != nil {
+		if bpErr, ok := toBackpressureError(err); ok {
+			return "", bpErr
+		}
+
 		return "", fmt.Errorf("could not trigger workflow: %w", err)
 	}
 
+	if res.RetryAfterSeconds != nil {
+		a.l.Debug().Msgf("queue is backed up with %d runs, the engine suggests waiting %ds before the next trigger", res.GetQueueDepth(), res.GetRetryAfterSeconds())
+	}
+
 	return res.WorkflowRunId, nil
 }
 
@@ -169,9 +284,61 @@ func (a *adminClientImpl) getPutRequest(workflow *types.Workflow) (*admincontrac
 		CronTriggers:  workflow.Triggers.Cron,
 	}
 
+	if workflow.SLA != "" {
+		opts.Sla = &workflow.SLA
+	}
+
+	if workflow.ScheduleTimeout != "" {
+		opts.ScheduleTimeout = &workflow.ScheduleTimeout
+	}
+
+	if workflow.PinToBuild {
+		opts.PinToBuild = &workflow.PinToBuild
+	}
+
+	if workflow.AssignmentStrategy != "" {
+		assignmentStrategy := string(workflow.AssignmentStrategy)
+		opts.AssignmentStrategy = &assignmentStrategy
+	}
+
+	if workflow.MaxStepExecutions != 0 {
+		opts.MaxStepExecutions = &workflow.MaxStepExecutions
+	}
+
+	if workflow.MaxStepRetries != 0 {
+		opts.MaxStepRetries = &workflow.MaxStepRetries
+	}
+
+	if workflow.CostCenter != "" {
+		opts.CostCenter = &workflow.CostCenter
+	}
+
+	if workflow.DefaultInput != nil {
+		defaultInputBytes, err := json.Marshal(workflow.DefaultInput)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal default input: %w", err)
+		}
+
+		defaultInput := string(defaultInputBytes)
+		opts.DefaultInput = &defaultInput
+	}
+
+	if workflow.InputSchema != nil {
+		inputSchemaBytes, err := json.Marshal(workflow.InputSchema)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal input schema: %w", err)
+		}
+
+		inputSchema := string(inputSchemaBytes)
+		opts.InputSchema = &inputSchema
+	}
+
 	if workflow.Concurrency != nil {
 		opts.Concurrency = &admincontracts.WorkflowConcurrencyOpts{
-			Action: workflow.Concurrency.ActionID,
+			Action:         workflow.Concurrency.ActionID,
+			KeyExpressions: workflow.Concurrency.KeyExpressions,
 		}
 
 		switch workflow.Concurrency.LimitStrategy {
@@ -208,12 +375,45 @@ func (a *adminClientImpl) getPutRequest(workflow *types.Workflow) (*admincontrac
 			}
 
 			stepOpt := &admincontracts.CreateWorkflowStepOpts{
-				ReadableId: step.ID,
-				Action:     step.ActionID,
-				Timeout:    step.Timeout,
-				Inputs:     string(inputBytes),
-				Parents:    step.Parents,
-				Retries:    int32(step.Retries),
+				ReadableId:        step.ID,
+				Action:            step.ActionID,
+				Timeout:           step.Timeout,
+				Inputs:            string(inputBytes),
+				Parents:           step.Parents,
+				Retries:           int32(step.Retries),
+				CancelGracePeriod: step.CancelGracePeriod,
+				MaxTimeout:        step.MaxTimeout,
+				JoinStrategy:      string(step.JoinStrategy),
+				JoinQuorum:        int32(step.JoinQuorum),
+			}
+
+			if step.ScheduleTimeout != "" {
+				scheduleTimeout := step.ScheduleTimeout
+				stepOpt.ScheduleTimeout = &scheduleTimeout
+			}
+
+			if step.OnTimeout != "" {
+				onTimeout := step.OnTimeout
+				stepOpt.OnTimeout = &onTimeout
+			}
+
+			if step.CostCenter != "" {
+				costCenter := step.CostCenter
+				stepOpt.CostCenter = &costCenter
+			}
+
+			if step.Resources != nil {
+				stepOpt.Cpu = step.Resources.CPU
+				stepOpt.MemoryMb = int32(step.Resources.MemoryMb)
+				stepOpt.Gpu = int32(step.Resources.GPU)
+			}
+
+			for _, check := range step.PreflightChecks {
+				stepOpt.PreflightChecks = append(stepOpt.PreflightChecks, &admincontracts.CreateStepPreflightCheckOpts{
+					Kind:    check.Kind,
+					Target:  check.Target,
+					Timeout: check.Timeout,
+				})
 			}
 
 			stepOpts[i] = stepOpt
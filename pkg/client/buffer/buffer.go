@@ -0,0 +1,511 @@
+// Package buffer buffers event pushes and workflow triggers locally and flushes them to Hatchet in the background,
+// retrying while the engine is unreachable, so that producers keep accepting work through short outages.
+//
+// Submissions are flushed in the order they were buffered, at least once: a submission whose request timed out is
+// sent again, so events and workflow runs may be duplicated if the engine received the first attempt.
+package buffer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/internal/logger"
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+type SubmissionKind string
+
+const (
+	SubmissionKindEvent       SubmissionKind = "event"
+	SubmissionKindWorkflowRun SubmissionKind = "workflowRun"
+)
+
+// Submission is an event push or a workflow trigger which is waiting to be flushed.
+type Submission struct {
+	Kind SubmissionKind `json:"kind"`
+
+	// Key is the key of the event, or the name of the workflow
+	Key string `json:"key"`
+
+	// Payload is the payload of the event, or the input of the workflow run
+	Payload json.RawMessage `json:"payload"`
+
+	Priority bool `json:"priority,omitempty"`
+
+	AdditionalMetadata map[string]interface{} `json:"additionalMetadata,omitempty"`
+
+	BufferedAt time.Time `json:"bufferedAt"`
+}
+
+// DropFunc is called with the submissions which are dropped because the engine rejected them, and the error of
+// the rejection.
+type DropFunc func(s *Submission, err error)
+
+type BufferOpt func(*BufferOpts)
+
+type BufferOpts struct {
+	l *zerolog.Logger
+
+	dir      string
+	maxItems int
+	maxBytes int64
+
+	minRetryInterval time.Duration
+	maxRetryInterval time.Duration
+	flushTimeout     time.Duration
+	sendTimeout      time.Duration
+
+	onDrop DropFunc
+}
+
+func defaultBufferOpts() *BufferOpts {
+	logger := logger.NewDefaultLogger("buffer")
+
+	return &BufferOpts{
+		l:                &logger,
+		maxItems:         10000,
+		maxBytes:         64 * 1024 * 1024,
+		minRetryInterval: time.Second,
+		maxRetryInterval: time.Minute,
+		flushTimeout:     10 * time.Second,
+		sendTimeout:      30 * time.Second,
+	}
+}
+
+func WithLogger(l *zerolog.Logger) BufferOpt {
+	return func(opts *BufferOpts) {
+		opts.l = l
+	}
+}
+
+// WithDirectory keeps the buffered submissions in files in the directory instead of in memory, so that
+// submissions which weren't flushed when the process exits are flushed when it starts again. The directory must
+// only be used by one buffer at a time.
+func WithDirectory(dir string) BufferOpt {
+	return func(opts *BufferOpts) {
+		opts.dir = dir
+	}
+}
+
+// WithMaxItems sets the maximum number of buffered submissions. Defaults to 10000.
+func WithMaxItems(maxItems int) BufferOpt {
+	return func(opts *BufferOpts) {
+		opts.maxItems = maxItems
+	}
+}
+
+// WithMaxBytes sets the maximum total size of the buffered submissions, in bytes. Defaults to 64 MiB.
+func WithMaxBytes(maxBytes int64) BufferOpt {
+	return func(opts *BufferOpts) {
+		opts.maxBytes = maxBytes
+	}
+}
+
+// WithRetryInterval sets the bounds of the exponential backoff between attempts to flush a submission while the
+// engine is unreachable. Defaults to 1 second and 1 minute.
+func WithRetryInterval(min, max time.Duration) BufferOpt {
+	return func(opts *BufferOpts) {
+		opts.minRetryInterval = min
+		opts.maxRetryInterval = max
+	}
+}
+
+// WithFlushTimeout sets how long the cleanup function returned by Start keeps flushing the buffered submissions
+// before it stops. Defaults to 10 seconds.
+func WithFlushTimeout(timeout time.Duration) BufferOpt {
+	return func(opts *BufferOpts) {
+		opts.flushTimeout = timeout
+	}
+}
+
+// WithSendTimeout sets how long the buffer waits for the engine to accept a submission before it retries it.
+// Defaults to 30 seconds.
+func WithSendTimeout(timeout time.Duration) BufferOpt {
+	return func(opts *BufferOpts) {
+		opts.sendTimeout = timeout
+	}
+}
+
+// WithOnDrop sets a function which is called with the submissions which the engine rejected, such as triggers
+// of workflows which don't exist. Rejected submissions are logged and dropped, so that they don't block the
+// submissions behind them.
+func WithOnDrop(f DropFunc) BufferOpt {
+	return func(opts *BufferOpts) {
+		opts.onDrop = f
+	}
+}
+
+// Buffer buffers event pushes and workflow triggers, and flushes them with the client once it's started.
+type Buffer struct {
+	client client.Client
+
+	l *zerolog.Logger
+
+	mu    sync.Mutex
+	store store
+
+	// notify wakes up the flush loop when a submission is buffered
+	notify chan struct{}
+
+	minRetryInterval time.Duration
+	maxRetryInterval time.Duration
+	flushTimeout     time.Duration
+	sendTimeout      time.Duration
+
+	onDrop DropFunc
+}
+
+// New creates a buffer which flushes with the client. Submissions which were left in the directory of the buffer
+// by a previous process are flushed once the buffer is started.
+func New(c client.Client, fs ...BufferOpt) (*Buffer, error) {
+	opts := defaultBufferOpts()
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	if c == nil {
+		return nil, fmt.Errorf("client is required")
+	}
+
+	if opts.maxItems <= 0 || opts.maxBytes <= 0 {
+		return nil, fmt.Errorf("max items and max bytes must be positive")
+	}
+
+	if opts.sendTimeout <= 0 {
+		return nil, fmt.Errorf("send timeout must be positive")
+	}
+
+	if opts.minRetryInterval <= 0 || opts.maxRetryInterval < opts.minRetryInterval {
+		return nil, fmt.Errorf("invalid retry interval: min %s, max %s", opts.minRetryInterval, opts.maxRetryInterval)
+	}
+
+	l := limits{
+		maxItems: opts.maxItems,
+		maxBytes: opts.maxBytes,
+	}
+
+	var s store = newMemoryStore(l)
+
+	if opts.dir != "" {
+		diskStore, err := newDiskStore(opts.dir, l)
+
+		if err != nil {
+			return nil, err
+		}
+
+		s = diskStore
+	}
+
+	return &Buffer{
+		client:           c,
+		l:                opts.l,
+		store:            s,
+		notify:           make(chan struct{}, 1),
+		minRetryInterval: opts.minRetryInterval,
+		maxRetryInterval: opts.maxRetryInterval,
+		flushTimeout:     opts.flushTimeout,
+		sendTimeout:      opts.sendTimeout,
+		onDrop:           opts.onDrop,
+	}, nil
+}
+
+// Push buffers an event. It returns ErrFull if the buffer is full.
+func (b *Buffer) Push(eventKey string, payload interface{}) error {
+	return b.add(SubmissionKindEvent, eventKey, payload, runOpts{})
+}
+
+type runOpts struct {
+	priority           bool
+	additionalMetadata map[string]interface{}
+}
+
+type RunOptFunc func(*runOpts)
+
+// WithPriority marks the trigger as a priority, which is not rejected when the engine is shedding load.
+func WithPriority() RunOptFunc {
+	return func(opts *runOpts) {
+		opts.priority = true
+	}
+}
+
+// WithRunMetadata sets metadata which is passed to every step run of the workflow run.
+func WithRunMetadata(metadata map[string]interface{}) RunOptFunc {
+	return func(opts *runOpts) {
+		opts.additionalMetadata = metadata
+	}
+}
+
+// RunWorkflow buffers a trigger of a workflow. It returns ErrFull if the buffer is full. The id of the workflow
+// run isn't known until the trigger is flushed, so runs which need it should be triggered with the client.
+func (b *Buffer) RunWorkflow(workflowName string, input interface{}, fs ...RunOptFunc) error {
+	opts := runOpts{}
+
+	for _, f := range fs {
+		f(&opts)
+	}
+
+	return b.add(SubmissionKindWorkflowRun, workflowName, input, opts)
+}
+
+func (b *Buffer) add(kind SubmissionKind, key string, payload interface{}, opts runOpts) error {
+	payloadBytes, err := json.Marshal(payload)
+
+	if err != nil {
+		return fmt.Errorf("could not marshal payload: %w", err)
+	}
+
+	data, err := json.Marshal(&Submission{
+		Kind:               kind,
+		Key:                key,
+		Payload:            payloadBytes,
+		Priority:           opts.priority,
+		AdditionalMetadata: opts.additionalMetadata,
+		BufferedAt:         time.Now().UTC(),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not marshal submission: %w", err)
+	}
+
+	b.mu.Lock()
+	err = b.store.push(data)
+	b.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	select {
+	case b.notify <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Len returns the number of buffered submissions.
+func (b *Buffer) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.store.len()
+}
+
+// Start starts flushing the buffered submissions in the background. The returned function keeps flushing for up
+// to the flush timeout, and then stops. It returns an error if submissions which are only buffered in memory
+// weren't flushed.
+func (b *Buffer) Start() (func() error, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		b.flushLoop(ctx)
+	}()
+
+	cleanup := func() error {
+		deadline := time.Now().Add(b.flushTimeout)
+
+		for b.Len() > 0 && time.Now().Before(deadline) {
+			time.Sleep(100 * time.Millisecond)
+		}
+
+		cancel()
+		<-done
+
+		b.mu.Lock()
+		remaining, durable := b.store.len(), b.store.durable()
+		b.mu.Unlock()
+
+		if remaining > 0 && !durable {
+			return fmt.Errorf("could not flush %d buffered submissions", remaining)
+		}
+
+		return nil
+	}
+
+	return cleanup, nil
+}
+
+func (b *Buffer) flushLoop(ctx context.Context) {
+	retryInterval := b.minRetryInterval
+
+	for {
+		b.mu.Lock()
+		data, err := b.store.peek()
+		b.mu.Unlock()
+
+		if err != nil {
+			if errors.Is(err, errCorrupt) {
+				b.l.Error().Msg("dropping buffered submission which can't be decoded")
+				if !b.pop(ctx, retryInterval) {
+					return
+				}
+
+				continue
+			}
+
+			b.l.Error().Err(err).Msg("could not read buffered submission")
+
+			if !sleep(ctx, retryInterval) {
+				return
+			}
+
+			continue
+		}
+
+		if data == nil {
+			select {
+			case <-b.notify:
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		s := &Submission{}
+
+		if err := json.Unmarshal(data, s); err != nil {
+			b.l.Error().Err(err).Msg("dropping buffered submission which can't be decoded")
+			if !b.pop(ctx, retryInterval) {
+				return
+			}
+
+			continue
+		}
+
+		err = b.send(ctx, s)
+
+		if err == nil {
+			retryInterval = b.minRetryInterval
+
+			if !b.pop(ctx, retryInterval) {
+				return
+			}
+
+			continue
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		if !isRetryable(err) {
+			b.l.Error().Err(err).Msgf("dropping buffered %s %s which was rejected", s.Kind, s.Key)
+
+			if b.onDrop != nil {
+				b.onDrop(s, err)
+			}
+
+			if !b.pop(ctx, retryInterval) {
+				return
+			}
+
+			continue
+		}
+
+		wait := retryInterval
+
+		// wait at least as long as the engine asks when it's shedding load
+		var bpErr *client.BackpressureError
+
+		if errors.As(err, &bpErr) && bpErr.RetryAfter > wait {
+			wait = bpErr.RetryAfter
+		}
+
+		b.l.Warn().Err(err).Msgf("could not flush buffered %s %s, retrying in %s", s.Kind, s.Key, wait)
+
+		if !sleep(ctx, wait) {
+			return
+		}
+
+		retryInterval *= 2
+
+		if retryInterval > b.maxRetryInterval {
+			retryInterval = b.maxRetryInterval
+		}
+	}
+}
+
+func (b *Buffer) send(ctx context.Context, s *Submission) error {
+	// a request which hangs is retried like one which fails, so that it doesn't hold up the flush loop
+	ctx, cancel := context.WithTimeout(ctx, b.sendTimeout)
+	defer cancel()
+
+	switch s.Kind {
+	case SubmissionKindEvent:
+		return b.client.Event().Push(ctx, s.Key, s.Payload)
+	case SubmissionKindWorkflowRun:
+		opts := []client.RunOptFunc{}
+
+		if s.Priority {
+			opts = append(opts, client.WithPriority())
+		}
+
+		if s.AdditionalMetadata != nil {
+			opts = append(opts, client.WithRunMetadata(s.AdditionalMetadata))
+		}
+
+		_, err := b.client.Admin().RunWorkflowWithContext(ctx, s.Key, s.Payload, opts...)
+
+		return err
+	default:
+		return fmt.Errorf("unknown submission kind: %s", s.Kind)
+	}
+}
+
+// pop removes the submission at the head of the queue. If it can't be removed, pop waits before the loop reads it
+// again, and returns false if the context is cancelled while it waits.
+func (b *Buffer) pop(ctx context.Context, wait time.Duration) bool {
+	b.mu.Lock()
+	err := b.store.pop()
+	b.mu.Unlock()
+
+	if err != nil {
+		b.l.Error().Err(err).Msg("could not remove buffered submission")
+		return sleep(ctx, wait)
+	}
+
+	return true
+}
+
+// isRetryable returns whether the error is caused by the engine being unreachable or overloaded, rather than by
+// the submission itself.
+func isRetryable(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	st, ok := status.FromError(err)
+
+	if !ok {
+		return false
+	}
+
+	switch st.Code() {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
+
+// sleep waits for the duration, and returns false if the context is cancelled first.
+func sleep(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-time.After(d):
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
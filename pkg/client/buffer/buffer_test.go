@@ -0,0 +1,275 @@
+package buffer
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+type sent struct {
+	kind    SubmissionKind
+	key     string
+	payload string
+}
+
+type fakeClient struct {
+	client.Client
+
+	mu   sync.Mutex
+	sent []sent
+
+	// errs are returned by the next sends, in order
+	errs []error
+
+	// hangs is the number of the next sends which hang until their context is done
+	hangs int
+}
+
+func (c *fakeClient) Event() client.EventClient {
+	return &fakeEventClient{c: c}
+}
+
+func (c *fakeClient) Admin() client.AdminClient {
+	return &fakeAdminClient{c: c}
+}
+
+func (c *fakeClient) send(ctx context.Context, kind SubmissionKind, key string, payload interface{}) error {
+	c.mu.Lock()
+
+	if c.hangs > 0 {
+		c.hangs--
+		c.mu.Unlock()
+
+		<-ctx.Done()
+
+		return ctx.Err()
+	}
+
+	defer c.mu.Unlock()
+
+	if len(c.errs) > 0 {
+		err := c.errs[0]
+		c.errs = c.errs[1:]
+
+		if err != nil {
+			return err
+		}
+	}
+
+	payloadBytes, err := json.Marshal(payload)
+
+	if err != nil {
+		return err
+	}
+
+	c.sent = append(c.sent, sent{kind: kind, key: key, payload: string(payloadBytes)})
+
+	return nil
+}
+
+func (c *fakeClient) sentKeys() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	keys := []string{}
+
+	for _, s := range c.sent {
+		keys = append(keys, s.key)
+	}
+
+	return keys
+}
+
+type fakeEventClient struct {
+	client.EventClient
+
+	c *fakeClient
+}
+
+func (e *fakeEventClient) Push(ctx context.Context, eventKey string, payload interface{}) error {
+	return e.c.send(ctx, SubmissionKindEvent, eventKey, payload)
+}
+
+type fakeAdminClient struct {
+	client.AdminClient
+
+	c *fakeClient
+}
+
+func (a *fakeAdminClient) RunWorkflowWithContext(ctx context.Context, workflowName string, input interface{}, opts ...client.RunOptFunc) (string, error) {
+	return "", a.c.send(ctx, SubmissionKindWorkflowRun, workflowName, input)
+}
+
+func flushed(t *testing.T, b *Buffer) {
+	t.Helper()
+
+	require.Eventually(t, func() bool {
+		return b.Len() == 0
+	}, 5*time.Second, 5*time.Millisecond)
+}
+
+func TestFlushRetriesUnavailableEngine(t *testing.T) {
+	c := &fakeClient{
+		errs: []error{
+			status.Error(codes.Unavailable, "connection refused"),
+			status.Error(codes.Unavailable, "connection refused"),
+		},
+	}
+
+	b, err := New(c, WithRetryInterval(time.Millisecond, 5*time.Millisecond))
+	require.NoError(t, err)
+
+	require.NoError(t, b.Push("user:created", map[string]string{"id": "1"}))
+	require.NoError(t, b.RunWorkflow("send-welcome-email", map[string]string{"id": "1"}))
+	require.NoError(t, b.Push("user:updated", map[string]string{"id": "1"}))
+
+	cleanup, err := b.Start()
+	require.NoError(t, err)
+
+	flushed(t, b)
+	require.NoError(t, cleanup())
+
+	assert.Equal(t, []string{"user:created", "send-welcome-email", "user:updated"}, c.sentKeys())
+	assert.Equal(t, sent{kind: SubmissionKindWorkflowRun, key: "send-welcome-email", payload: `{"id":"1"}`}, c.sent[1])
+}
+
+func TestFlushDropsRejectedSubmissions(t *testing.T) {
+	rejection := status.Error(codes.NotFound, "workflow not found")
+
+	c := &fakeClient{
+		errs: []error{rejection},
+	}
+
+	var dropped []*Submission
+
+	b, err := New(c, WithOnDrop(func(s *Submission, err error) {
+		dropped = append(dropped, s)
+		assert.Equal(t, rejection, err)
+	}))
+	require.NoError(t, err)
+
+	require.NoError(t, b.RunWorkflow("missing", nil))
+	require.NoError(t, b.Push("user:created", nil))
+
+	cleanup, err := b.Start()
+	require.NoError(t, err)
+
+	flushed(t, b)
+	require.NoError(t, cleanup())
+
+	require.Len(t, dropped, 1)
+	assert.Equal(t, "missing", dropped[0].Key)
+	assert.Equal(t, []string{"user:created"}, c.sentKeys())
+}
+
+func TestPushReturnsErrFull(t *testing.T) {
+	b, err := New(&fakeClient{}, WithMaxItems(2))
+	require.NoError(t, err)
+
+	require.NoError(t, b.Push("a", nil))
+	require.NoError(t, b.Push("b", nil))
+	assert.ErrorIs(t, b.Push("c", nil), ErrFull)
+
+	b, err = New(&fakeClient{}, WithMaxBytes(100))
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, b.Push("a", make([]byte, 100)), ErrFull)
+}
+
+func TestDirectoryOutlivesBuffer(t *testing.T) {
+	dir := t.TempDir()
+
+	b, err := New(&fakeClient{}, WithDirectory(dir))
+	require.NoError(t, err)
+
+	require.NoError(t, b.Push("a", nil))
+	require.NoError(t, b.RunWorkflow("b", nil))
+
+	c := &fakeClient{}
+
+	b, err = New(c, WithDirectory(dir))
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, b.Len())
+
+	require.NoError(t, b.Push("c", nil))
+
+	cleanup, err := b.Start()
+	require.NoError(t, err)
+
+	flushed(t, b)
+	require.NoError(t, cleanup())
+
+	assert.Equal(t, []string{"a", "b", "c"}, c.sentKeys())
+}
+
+func TestCleanupReportsUnflushedSubmissions(t *testing.T) {
+	c := &fakeClient{
+		errs: []error{status.Error(codes.Unavailable, "connection refused")},
+	}
+
+	b, err := New(c, WithRetryInterval(time.Hour, time.Hour), WithFlushTimeout(10*time.Millisecond))
+	require.NoError(t, err)
+
+	require.NoError(t, b.Push("a", nil))
+
+	cleanup, err := b.Start()
+	require.NoError(t, err)
+
+	assert.EqualError(t, cleanup(), "could not flush 1 buffered submissions")
+}
+
+func TestFlushRetriesHungTrigger(t *testing.T) {
+	c := &fakeClient{
+		hangs: 1,
+	}
+
+	b, err := New(c, WithSendTimeout(10*time.Millisecond), WithRetryInterval(time.Millisecond, time.Millisecond))
+	require.NoError(t, err)
+
+	require.NoError(t, b.RunWorkflow("send-welcome-email", nil))
+
+	cleanup, err := b.Start()
+	require.NoError(t, err)
+
+	flushed(t, b)
+	require.NoError(t, cleanup())
+
+	assert.Equal(t, []string{"send-welcome-email"}, c.sentKeys())
+}
+
+func TestCleanupStopsHungTrigger(t *testing.T) {
+	c := &fakeClient{
+		hangs: 1,
+	}
+
+	b, err := New(c, WithSendTimeout(time.Hour), WithFlushTimeout(10*time.Millisecond))
+	require.NoError(t, err)
+
+	require.NoError(t, b.RunWorkflow("send-welcome-email", nil))
+
+	cleanup, err := b.Start()
+	require.NoError(t, err)
+
+	stopped := make(chan error)
+
+	go func() {
+		stopped <- cleanup()
+	}()
+
+	select {
+	case err := <-stopped:
+		assert.EqualError(t, err, "could not flush 1 buffered submissions")
+	case <-time.After(5 * time.Second):
+		t.Fatal("cleanup waited for the hung trigger")
+	}
+}
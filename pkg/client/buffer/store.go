@@ -0,0 +1,259 @@
+package buffer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrFull is returned when a submission doesn't fit in the buffer.
+var ErrFull = errors.New("the buffer is full")
+
+// errCorrupt is returned by stores when the submission at the head of the queue can't be decoded.
+var errCorrupt = errors.New("buffered submission is corrupt")
+
+// store is a bounded FIFO queue of submissions. It's not safe for concurrent use.
+type store interface {
+	// push appends a submission to the queue, or returns ErrFull if the queue is at its limits
+	push(data []byte) error
+
+	// peek returns the submission at the head of the queue, or nil if the queue is empty
+	peek() ([]byte, error)
+
+	// pop removes the submission at the head of the queue
+	pop() error
+
+	len() int
+
+	// durable is whether the queue outlives the process
+	durable() bool
+}
+
+type limits struct {
+	maxItems int
+	maxBytes int64
+}
+
+func (l limits) fits(items int, bytes int64) bool {
+	return items < l.maxItems && bytes <= l.maxBytes
+}
+
+type memoryStore struct {
+	limits
+
+	items [][]byte
+	bytes int64
+}
+
+func newMemoryStore(l limits) *memoryStore {
+	return &memoryStore{
+		limits: l,
+	}
+}
+
+func (s *memoryStore) push(data []byte) error {
+	if !s.fits(len(s.items), s.bytes+int64(len(data))) {
+		return ErrFull
+	}
+
+	s.items = append(s.items, data)
+	s.bytes += int64(len(data))
+
+	return nil
+}
+
+func (s *memoryStore) peek() ([]byte, error) {
+	if len(s.items) == 0 {
+		return nil, nil
+	}
+
+	return s.items[0], nil
+}
+
+func (s *memoryStore) pop() error {
+	if len(s.items) == 0 {
+		return nil
+	}
+
+	s.bytes -= int64(len(s.items[0]))
+	s.items[0] = nil
+	s.items = s.items[1:]
+
+	return nil
+}
+
+func (s *memoryStore) len() int {
+	return len(s.items)
+}
+
+func (s *memoryStore) durable() bool {
+	return false
+}
+
+// diskStore keeps each submission in its own file in a directory, named by its position in the queue, so that
+// submissions which weren't flushed before the process exited are flushed when it starts again.
+type diskStore struct {
+	limits
+
+	dir string
+
+	// seqs are the positions of the files in the queue, in order
+	seqs  []uint64
+	sizes map[uint64]int64
+	bytes int64
+}
+
+const diskStoreExt = ".json"
+
+func newDiskStore(dir string, l limits) (*diskStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("could not create buffer directory: %w", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read buffer directory: %w", err)
+	}
+
+	s := &diskStore{
+		limits: l,
+		dir:    dir,
+		sizes:  map[uint64]int64{},
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+
+		// remove the temporary files of writes which were interrupted
+		if strings.HasPrefix(name, ".tmp-") {
+			_ = os.Remove(filepath.Join(dir, name))
+			continue
+		}
+
+		if entry.IsDir() || !strings.HasSuffix(name, diskStoreExt) {
+			continue
+		}
+
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, diskStoreExt), 10, 64)
+
+		if err != nil {
+			continue
+		}
+
+		info, err := entry.Info()
+
+		if err != nil {
+			return nil, fmt.Errorf("could not stat buffered submission %s: %w", name, err)
+		}
+
+		s.seqs = append(s.seqs, seq)
+		s.sizes[seq] = info.Size()
+		s.bytes += info.Size()
+	}
+
+	sort.Slice(s.seqs, func(i, j int) bool {
+		return s.seqs[i] < s.seqs[j]
+	})
+
+	return s, nil
+}
+
+func (s *diskStore) path(seq uint64) string {
+	// the sequence is zero-padded so that the files sort in queue order
+	return filepath.Join(s.dir, fmt.Sprintf("%020d%s", seq, diskStoreExt))
+}
+
+func (s *diskStore) push(data []byte) error {
+	if !s.fits(len(s.seqs), s.bytes+int64(len(data))) {
+		return ErrFull
+	}
+
+	seq := uint64(1)
+
+	if len(s.seqs) > 0 {
+		seq = s.seqs[len(s.seqs)-1] + 1
+	}
+
+	// write to a temporary file and rename it, so that a crash never leaves a partial submission in the queue
+	f, err := os.CreateTemp(s.dir, ".tmp-*")
+
+	if err != nil {
+		return fmt.Errorf("could not create buffered submission: %w", err)
+	}
+
+	defer os.Remove(f.Name()) // nolint: errcheck
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return fmt.Errorf("could not write buffered submission: %w", err)
+	}
+
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("could not sync buffered submission: %w", err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("could not close buffered submission: %w", err)
+	}
+
+	if err := os.Rename(f.Name(), s.path(seq)); err != nil {
+		return fmt.Errorf("could not commit buffered submission: %w", err)
+	}
+
+	s.seqs = append(s.seqs, seq)
+	s.sizes[seq] = int64(len(data))
+	s.bytes += int64(len(data))
+
+	return nil
+}
+
+func (s *diskStore) peek() ([]byte, error) {
+	if len(s.seqs) == 0 {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(s.path(s.seqs[0]))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read buffered submission: %w", err)
+	}
+
+	if !json.Valid(data) {
+		return nil, errCorrupt
+	}
+
+	return data, nil
+}
+
+func (s *diskStore) pop() error {
+	if len(s.seqs) == 0 {
+		return nil
+	}
+
+	seq := s.seqs[0]
+
+	if err := os.Remove(s.path(seq)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("could not remove buffered submission: %w", err)
+	}
+
+	s.seqs = s.seqs[1:]
+	s.bytes -= s.sizes[seq]
+	delete(s.sizes, seq)
+
+	return nil
+}
+
+func (s *diskStore) len() int {
+	return len(s.seqs)
+}
+
+func (s *diskStore) durable() bool {
+	return true
+}
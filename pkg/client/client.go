@@ -158,7 +158,8 @@ func newFromOpts(opts *ClientOpts) (Client, error) {
 		return nil, fmt.Errorf("tls config is required")
 	}
 
-	if opts.token == "" {
+	// clients without a token authenticate with their client certificate
+	if opts.token == "" && len(opts.tls.Certificates) == 0 && opts.tls.GetClientCertificate == nil {
 		return nil, fmt.Errorf("token is required")
 	}
 
@@ -18,6 +18,11 @@ func newContextLoader(token string) *contextLoader {
 }
 
 func (c *contextLoader) newContext(ctx context.Context) context.Context {
+	// clients without a token authenticate with their client certificate
+	if c.Token == "" {
+		return ctx
+	}
+
 	md := grpcMetadata.New(map[string]string{
 		"authorization": "Bearer " + c.Token,
 	})
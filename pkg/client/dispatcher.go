@@ -16,6 +16,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	dispatchercontracts "github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/protocol"
 	"github.com/hatchet-dev/hatchet/internal/validator"
 )
 
@@ -25,6 +26,30 @@ type DispatcherClient interface {
 	SendStepActionEvent(ctx context.Context, in *ActionEvent) (*ActionEventResponse, error)
 
 	SendGroupKeyActionEvent(ctx context.Context, in *ActionEvent) (*ActionEventResponse, error)
+
+	// RefreshTimeout extends the timeout of a running step run, up to the max timeout of the step.
+	RefreshTimeout(ctx context.Context, stepRunId string, incrementTimeoutBy string) error
+
+	// HydrateAction fetches the payload of an action which the dispatcher left out because of its size. It
+	// does nothing if the action has its payload.
+	HydrateAction(ctx context.Context, action *Action) error
+
+	// ListStepRunResults lists the results of the step runs in the workflow run of a step run which the worker
+	// is running, optionally only for the steps with the given readable ids.
+	ListStepRunResults(ctx context.Context, workerId, stepRunId string, stepReadableIds ...string) ([]*StepRunResult, error)
+
+	// ReleaseStepRun releases a step run which the worker is running back to the queue, to be requeued after the
+	// retry after duration. The release doesn't count as a failure or a retry of the step run.
+	ReleaseStepRun(ctx context.Context, workerId, stepRunId string, retryAfter time.Duration, reason string) error
+
+	// AcquireLock acquires a named lock of the tenant for a step run which the worker is running, or for its
+	// workflow run, until the timeout passes. A zero timeout uses the default timeout of the engine. It returns
+	// false if the lock is held by another step run or workflow run.
+	AcquireLock(ctx context.Context, workerId, stepRunId, name string, timeout time.Duration, holdForWorkflowRun bool) (bool, error)
+
+	// ReleaseLock releases a named lock which is held by a step run which the worker is running, or by its workflow
+	// run. It returns false if the lock isn't held by either.
+	ReleaseLock(ctx context.Context, workerId, stepRunId, name string) (bool, error)
 }
 
 const (
@@ -38,6 +63,17 @@ type GetActionListenerRequest struct {
 	Services   []string
 	Actions    []string
 	MaxRuns    *int
+
+	// the resources advertised by the worker, which are matched against the resource hints of steps
+	Cpu      *float64
+	MemoryMb *int
+	Gpu      *int
+
+	// the build id of the worker's code, which runs of workflows with build pinning are pinned to
+	BuildId *string
+
+	// the labels of the worker, which the locality assignment strategy matches against
+	Labels map[string]string
 }
 
 // ActionPayload unmarshals the action payload into the target. It also validates the resulting target.
@@ -46,9 +82,10 @@ type ActionPayload func(target interface{}) error
 type ActionType string
 
 const (
-	ActionTypeStartStepRun     ActionType = "START_STEP_RUN"
-	ActionTypeCancelStepRun    ActionType = "CANCEL_STEP_RUN"
-	ActionTypeStartGetGroupKey ActionType = "START_GET_GROUP_KEY"
+	ActionTypeStartStepRun            ActionType = "START_STEP_RUN"
+	ActionTypeCancelStepRun           ActionType = "CANCEL_STEP_RUN"
+	ActionTypeStartGetGroupKey        ActionType = "START_GET_GROUP_KEY"
+	ActionTypeStartStepRunTimeoutHook ActionType = "START_STEP_RUN_TIMEOUT_HOOK"
 )
 
 type Action struct {
@@ -87,11 +124,52 @@ type Action struct {
 
 	// the action type
 	ActionType ActionType
+
+	// the number of cpu cores the step requires, or 0 if the step has no cpu hint
+	Cpu float64
+
+	// the memory in megabytes the step requires, or 0 if the step has no memory hint
+	MemoryMb int
+
+	// the number of gpus the step requires, or 0 if the step has no gpu hint
+	Gpu int
+
+	// the metadata of the workflow run, which is only set for get group key runs. For step runs, the
+	// metadata is part of the action payload.
+	AdditionalMetadata []byte
+
+	// whether the dispatcher left out the action payload because of its size, in which case the payload is
+	// fetched with HydrateAction
+	HydratePayload bool
+}
+
+// StepRunResult is the result of a step run in the workflow run of a running step run.
+type StepRunResult struct {
+	StepRunId string
+
+	StepReadableId string
+
+	JobRunId string
+
+	// the status of the step run, for example SUCCEEDED
+	Status string
+
+	// the output of the step run as a JSON object, if the step run succeeded
+	Output []byte
+
+	// the error of the step run, if the step run failed
+	Error string
 }
 
 type WorkerActionListener interface {
+	// Actions streams the actions assigned to the worker. The channel is closed when the context is cancelled, or
+	// when the listener can't receive actions anymore, in which case Err returns the reason.
 	Actions(ctx context.Context) (<-chan *Action, error)
 
+	// Err returns the error which closed the actions channel, or nil if it was closed because its context was
+	// cancelled. It's only set once the channel is closed.
+	Err() error
+
 	Unregister() error
 }
 
@@ -156,11 +234,18 @@ type actionListenerImpl struct {
 
 	workerId string
 
+	// sessionToken resumes the worker's session when the listener reconnects. it's empty if the engine doesn't
+	// support resuming sessions
+	sessionToken string
+
 	l *zerolog.Logger
 
 	v validator.Validator
 
 	ctx *contextLoader
+
+	// err is the error which closed the actions channel
+	err error
 }
 
 func (d *dispatcherClientImpl) newActionListener(ctx context.Context, req *GetActionListenerRequest) (*actionListenerImpl, error) {
@@ -170,9 +255,11 @@ func (d *dispatcherClientImpl) newActionListener(ctx context.Context, req *GetAc
 	}
 
 	registerReq := &dispatchercontracts.WorkerRegisterRequest{
-		WorkerName: req.WorkerName,
-		Actions:    req.Actions,
-		Services:   req.Services,
+		WorkerName:         req.WorkerName,
+		Actions:            req.Actions,
+		Services:           req.Services,
+		ProtocolVersion:    protocol.CurrentVersion,
+		MinProtocolVersion: protocol.MinVersion,
 	}
 
 	if req.MaxRuns != nil {
@@ -180,18 +267,54 @@ func (d *dispatcherClientImpl) newActionListener(ctx context.Context, req *GetAc
 		registerReq.MaxRuns = &mr
 	}
 
+	if req.Cpu != nil {
+		registerReq.Cpu = req.Cpu
+	}
+
+	if req.MemoryMb != nil {
+		memoryMb := int32(*req.MemoryMb)
+		registerReq.MemoryMb = &memoryMb
+	}
+
+	if req.Gpu != nil {
+		gpu := int32(*req.Gpu)
+		registerReq.Gpu = &gpu
+	}
+
+	if req.BuildId != nil {
+		registerReq.BuildId = req.BuildId
+	}
+
+	if len(req.Labels) > 0 {
+		registerReq.Labels = req.Labels
+	}
+
 	// register the worker
 	resp, err := d.client.Register(d.ctx.newContext(ctx), registerReq)
 
 	if err != nil {
+		// the engine refuses workers which don't speak a common protocol version, with a message which says which
+		// side to upgrade
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			return nil, fmt.Errorf("could not register the worker: %s", st.Message())
+		}
+
 		return nil, fmt.Errorf("could not register the worker: %w", err)
 	}
 
-	d.l.Debug().Msgf("Registered worker with id: %s", resp.WorkerId)
+	// engines which predate the version exchange don't return a version, and speak version 1
+	protocolVersion := resp.ProtocolVersion
+
+	if protocolVersion == 0 {
+		protocolVersion = protocol.Version1
+	}
+
+	d.l.Debug().Msgf("Registered worker with id: %s, protocol version: %d", resp.WorkerId, protocolVersion)
 
 	// subscribe to the worker
 	listener, err := d.client.Listen(d.ctx.newContext(ctx), &dispatchercontracts.WorkerListenRequest{
-		WorkerId: resp.WorkerId,
+		WorkerId:        resp.WorkerId,
+		HydratePayloads: true,
 	})
 
 	if err != nil {
@@ -202,6 +325,7 @@ func (d *dispatcherClientImpl) newActionListener(ctx context.Context, req *GetAc
 		client:       d.client,
 		listenClient: listener,
 		workerId:     resp.WorkerId,
+		sessionToken: resp.SessionToken,
 		l:            d.l,
 		v:            d.v,
 		tenantId:     d.tenantId,
@@ -215,6 +339,9 @@ func (a *actionListenerImpl) Actions(ctx context.Context) (<-chan *Action, error
 	a.l.Debug().Msgf("Starting to listen for actions")
 
 	go func() {
+		// the error is set before the channel is closed, so it's visible to readers of the closed channel
+		defer close(ch)
+
 		for {
 			assignedAction, err := a.listenClient.Recv()
 
@@ -223,12 +350,8 @@ func (a *actionListenerImpl) Actions(ctx context.Context) (<-chan *Action, error
 				if ctx.Err() != nil {
 					a.l.Debug().Msgf("Context cancelled, closing channel")
 
-					defer close(ch)
-					err := a.listenClient.CloseSend()
-
-					if err != nil {
+					if err := a.listenClient.CloseSend(); err != nil {
 						a.l.Error().Msgf("Failed to close send: %v", err)
-						panic(fmt.Errorf("failed to close send: %w", err))
 					}
 
 					return
@@ -242,15 +365,21 @@ func (a *actionListenerImpl) Actions(ctx context.Context) (<-chan *Action, error
 					err = a.retrySubscribe(ctx)
 
 					if err != nil {
+						if ctx.Err() != nil {
+							return
+						}
+
 						a.l.Error().Msgf("Failed to subscribe: %v", err)
-						panic(fmt.Errorf("failed to subscribe: %w", err))
+						a.err = fmt.Errorf("failed to subscribe: %w", err)
+						return
 					}
 
 					continue
 				}
 
 				a.l.Error().Msgf("Failed to receive message: %v", err)
-				panic(fmt.Errorf("failed to receive message: %w", err))
+				a.err = fmt.Errorf("failed to receive message: %w", err)
+				return
 			}
 
 			var actionType ActionType
@@ -262,6 +391,8 @@ func (a *actionListenerImpl) Actions(ctx context.Context) (<-chan *Action, error
 				actionType = ActionTypeCancelStepRun
 			case dispatchercontracts.ActionType_START_GET_GROUP_KEY:
 				actionType = ActionTypeStartGetGroupKey
+			case dispatchercontracts.ActionType_START_STEP_RUN_TIMEOUT_HOOK:
+				actionType = ActionTypeStartStepRunTimeoutHook
 			default:
 				a.l.Error().Msgf("Unknown action type: %s", assignedAction.ActionType)
 				continue
@@ -275,7 +406,7 @@ func (a *actionListenerImpl) Actions(ctx context.Context) (<-chan *Action, error
 				unquoted = assignedAction.ActionPayload
 			}
 
-			ch <- &Action{
+			action := &Action{
 				TenantId:         assignedAction.TenantId,
 				WorkflowRunId:    assignedAction.WorkflowRunId,
 				GetGroupKeyRunId: assignedAction.GetGroupKeyRunId,
@@ -288,6 +419,20 @@ func (a *actionListenerImpl) Actions(ctx context.Context) (<-chan *Action, error
 				ActionId:         assignedAction.ActionId,
 				ActionType:       actionType,
 				ActionPayload:    []byte(unquoted),
+				Cpu:              assignedAction.Cpu,
+				MemoryMb:         int(assignedAction.MemoryMb),
+				Gpu:              int(assignedAction.Gpu),
+				HydratePayload:   assignedAction.HydratePayload,
+			}
+
+			if assignedAction.AdditionalMetadata != "" {
+				action.AdditionalMetadata = []byte(assignedAction.AdditionalMetadata)
+			}
+
+			select {
+			case ch <- action:
+			case <-ctx.Done():
+				return
 			}
 		}
 	}()
@@ -295,15 +440,32 @@ func (a *actionListenerImpl) Actions(ctx context.Context) (<-chan *Action, error
 	return ch, nil
 }
 
+func (a *actionListenerImpl) Err() error {
+	return a.err
+}
+
 func (a *actionListenerImpl) retrySubscribe(ctx context.Context) error {
 	retries := 0
 
 	for retries < DefaultActionListenerRetryCount {
-		time.Sleep(DefaultActionListenerRetryInterval)
+		select {
+		case <-time.After(DefaultActionListenerRetryInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
 
-		listenClient, err := a.client.Listen(a.ctx.newContext(ctx), &dispatchercontracts.WorkerListenRequest{
-			WorkerId: a.workerId,
-		})
+		listenReq := &dispatchercontracts.WorkerListenRequest{
+			WorkerId:        a.workerId,
+			HydratePayloads: true,
+		}
+
+		// resume the session, so that the dispatcher resends the actions which were assigned while the
+		// listener was disconnected
+		if a.sessionToken != "" {
+			listenReq.SessionToken = &a.sessionToken
+		}
+
+		listenClient, err := a.client.Listen(a.ctx.newContext(ctx), listenReq)
 
 		if err != nil {
 			retries++
@@ -429,3 +591,118 @@ func (d *dispatcherClientImpl) SendGroupKeyActionEvent(ctx context.Context, in *
 		WorkerId: resp.WorkerId,
 	}, nil
 }
+
+func (d *dispatcherClientImpl) RefreshTimeout(ctx context.Context, stepRunId string, incrementTimeoutBy string) error {
+	_, err := d.client.RefreshTimeout(d.ctx.newContext(ctx), &dispatchercontracts.RefreshTimeoutRequest{
+		StepRunId:          stepRunId,
+		IncrementTimeoutBy: incrementTimeoutBy,
+	})
+
+	return err
+}
+
+func (d *dispatcherClientImpl) HydrateAction(ctx context.Context, action *Action) error {
+	if !action.HydratePayload {
+		return nil
+	}
+
+	resp, err := d.client.GetActionPayload(d.ctx.newContext(ctx), &dispatchercontracts.GetActionPayloadRequest{
+		WorkerId:  action.WorkerId,
+		StepRunId: action.StepRunId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not get action payload: %w", err)
+	}
+
+	unquoted, err := strconv.Unquote(resp.ActionPayload)
+
+	if err != nil {
+		unquoted = resp.ActionPayload
+	}
+
+	action.ActionPayload = []byte(unquoted)
+	action.HydratePayload = false
+
+	return nil
+}
+
+func (d *dispatcherClientImpl) ListStepRunResults(ctx context.Context, workerId, stepRunId string, stepReadableIds ...string) ([]*StepRunResult, error) {
+	resp, err := d.client.ListStepRunResults(d.ctx.newContext(ctx), &dispatchercontracts.ListStepRunResultsRequest{
+		WorkerId:        workerId,
+		StepRunId:       stepRunId,
+		StepReadableIds: stepReadableIds,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list step run results: %w", err)
+	}
+
+	res := make([]*StepRunResult, len(resp.Results))
+
+	for i, result := range resp.Results {
+		res[i] = &StepRunResult{
+			StepRunId:      result.StepRunId,
+			StepReadableId: result.StepReadableId,
+			JobRunId:       result.JobRunId,
+			Status:         result.Status,
+			Error:          result.Error,
+		}
+
+		if result.Output != "" {
+			res[i].Output = []byte(result.Output)
+		}
+	}
+
+	return res, nil
+}
+
+func (d *dispatcherClientImpl) ReleaseStepRun(ctx context.Context, workerId, stepRunId string, retryAfter time.Duration, reason string) error {
+	_, err := d.client.ReleaseStepRun(d.ctx.newContext(ctx), &dispatchercontracts.ReleaseStepRunRequest{
+		WorkerId:   workerId,
+		StepRunId:  stepRunId,
+		RetryAfter: retryAfter.String(),
+		Reason:     reason,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not release step run: %w", err)
+	}
+
+	return nil
+}
+
+func (d *dispatcherClientImpl) AcquireLock(ctx context.Context, workerId, stepRunId, name string, timeout time.Duration, holdForWorkflowRun bool) (bool, error) {
+	req := &dispatchercontracts.AcquireLockRequest{
+		WorkerId:           workerId,
+		StepRunId:          stepRunId,
+		Name:               name,
+		HoldForWorkflowRun: holdForWorkflowRun,
+	}
+
+	if timeout != 0 {
+		req.Timeout = timeout.String()
+	}
+
+	resp, err := d.client.AcquireLock(d.ctx.newContext(ctx), req)
+
+	if err != nil {
+		return false, fmt.Errorf("could not acquire lock: %w", err)
+	}
+
+	return resp.Acquired, nil
+}
+
+func (d *dispatcherClientImpl) ReleaseLock(ctx context.Context, workerId, stepRunId, name string) (bool, error) {
+	resp, err := d.client.ReleaseLock(d.ctx.newContext(ctx), &dispatchercontracts.ReleaseLockRequest{
+		WorkerId:  workerId,
+		StepRunId: stepRunId,
+		Name:      name,
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("could not release lock: %w", err)
+	}
+
+	return resp.Released, nil
+}
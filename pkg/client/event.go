@@ -14,6 +14,9 @@ import (
 
 type EventClient interface {
 	Push(ctx context.Context, eventKey string, payload interface{}) error
+
+	// PutStreamEvent appends a message to the output stream of a running step run.
+	PutStreamEvent(ctx context.Context, stepRunId string, message string) error
 }
 
 type eventClientImpl struct {
@@ -57,3 +60,13 @@ func (a *eventClientImpl) Push(ctx context.Context, eventKey string, payload int
 
 	return nil
 }
+
+func (a *eventClientImpl) PutStreamEvent(ctx context.Context, stepRunId string, message string) error {
+	_, err := a.client.PutStreamEvent(a.ctx.newContext(ctx), &eventcontracts.PutStreamEventRequest{
+		StepRunId: stepRunId,
+		CreatedAt: timestamppb.Now(),
+		Message:   message,
+	})
+
+	return err
+}
@@ -51,8 +51,8 @@ func GetClientConfigFromConfigFile(cf *client.ClientConfigFile) (res *client.Cli
 		return nil, fmt.Errorf("could not load config from viper: %w", err)
 	}
 
-	// if token is empty, throw an error
-	if cf.Token == "" {
+	// if token is empty, throw an error, unless the client authenticates with its certificate, like a SPIFFE SVID
+	if cf.Token == "" && !hasClientCertificate(&cf.TLS) {
 		return nil, fmt.Errorf("API token is required. Set it via the HATCHET_CLIENT_TOKEN environment variable.")
 	}
 
@@ -76,6 +76,15 @@ func GetClientConfigFromConfigFile(cf *client.ClientConfigFile) (res *client.Cli
 		return nil, fmt.Errorf("GRPC broadcast address is required. Set it via the HATCHET_CLIENT_HOST_PORT environment variable.")
 	}
 
+	tenantId := cf.TenantId
+
+	// if the tenant id is not set, use the tenant the token was issued for
+	if tenantId == "" {
+		if tokenTenantId, err := getTenantIdFromJWT(cf.Token); err == nil {
+			tenantId = tokenTenantId
+		}
+	}
+
 	tlsServerName := cf.TLS.TLSServerName
 
 	// if the tls server name is empty, parse the domain from the host:port
@@ -97,7 +106,7 @@ func GetClientConfigFromConfigFile(cf *client.ClientConfigFile) (res *client.Cli
 	}
 
 	return &client.ClientConfig{
-		TenantId:             cf.TenantId,
+		TenantId:             tenantId,
 		TLSConfig:            tlsConf,
 		Token:                cf.Token,
 		ServerURL:            serverURL,
@@ -105,6 +114,10 @@ func GetClientConfigFromConfigFile(cf *client.ClientConfigFile) (res *client.Cli
 	}, nil
 }
 
+func hasClientCertificate(cf *client.ClientTLSConfigFile) bool {
+	return cf.Base.TLSStrategy == "mtls" && (cf.Base.TLSCert != "" || cf.Base.TLSCertFile != "")
+}
+
 func parseDomain(domain string) (*url.URL, error) {
 	if !strings.HasPrefix(domain, "http://") && !strings.HasPrefix(domain, "https://") {
 		domain = "https://" + domain
@@ -34,6 +34,21 @@ func getAddressesFromJWT(token string) (*addresses, error) {
 	}, nil
 }
 
+// getTenantIdFromJWT returns the tenant id of an API token, which is stored as its subject.
+func getTenantIdFromJWT(token string) (string, error) {
+	claims, err := extractClaimsFromJWT(token)
+	if err != nil {
+		return "", err
+	}
+
+	tenantId, ok := claims["sub"].(string)
+	if !ok {
+		return "", fmt.Errorf("sub claim not found")
+	}
+
+	return tenantId, nil
+}
+
 func extractClaimsFromJWT(token string) (map[string]interface{}, error) {
 	parts := strings.Split(token, ".")
 	if len(parts) != 3 {
@@ -15,4 +15,10 @@ func TestExtractClaimsFromJWT(t *testing.T) {
 
 	assert.Equal(t, claims["server_url"], "https://app.dev.hatchet-tools.com")
 	assert.Equal(t, claims["grpc_broadcast_address"], "127.0.0.1:7070")
+
+	tenantId, err := getTenantIdFromJWT(token)
+
+	assert.Nil(t, err)
+
+	assert.Equal(t, "707d0855-80ab-4e1f-a156-f1c4546cbf52", tenantId)
 }
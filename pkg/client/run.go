@@ -24,12 +24,20 @@ const (
 	StepRunEventTypeFailed    StepRunEventType = "STEP_RUN_EVENT_TYPE_FAILED"
 	StepRunEventTypeCancelled StepRunEventType = "STEP_RUN_EVENT_TYPE_CANCELLED"
 	StepRunEventTypeTimedOut  StepRunEventType = "STEP_RUN_EVENT_TYPE_TIMED_OUT"
+	StepRunEventTypeStream    StepRunEventType = "STEP_RUN_EVENT_TYPE_STREAM"
 )
 
 type StepRunEvent struct {
 	Type StepRunEventType
 
 	Payload []byte
+
+	// ResourceId is the id of the step run of the event, or of the workflow run when the workflow run finished
+	ResourceId string
+
+	// StreamIndex is the index of the message in the output stream of the step run, for stream events. Stream
+	// events can arrive out of order, so subscribers which need the messages in order should sort them by index.
+	StreamIndex int
 }
 
 type ClientEventListener interface {
@@ -85,11 +93,15 @@ func (r *runClientImpl) On(ctx context.Context, workflowRunId string, handler Ru
 			eventType = StepRunEventTypeCancelled
 		case dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_TIMED_OUT:
 			eventType = StepRunEventTypeTimedOut
+		case dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_STREAM:
+			eventType = StepRunEventTypeStream
 		}
 
 		if err := handler(&StepRunEvent{
-			Type:    eventType,
-			Payload: []byte(event.EventPayload),
+			Type:        eventType,
+			Payload:     []byte(event.EventPayload),
+			ResourceId:  event.ResourceId,
+			StreamIndex: int(event.StreamIndex),
 		}); err != nil {
 			return err
 		}
@@ -18,6 +18,34 @@ type Workflow struct {
 
 	Description string `yaml:"description,omitempty"`
 
+	// (optional) the expected maximum duration of a workflow run, for example 10m
+	SLA string `yaml:"sla,omitempty"`
+
+	// (optional) the amount of time step runs wait to be assigned to a worker before timing out, for example 10m
+	ScheduleTimeout string `yaml:"scheduleTimeout,omitempty"`
+
+	// (optional) the input values which are deep-merged with the input of every run. Values in the input of
+	// a run take precedence over the defaults.
+	DefaultInput map[string]interface{} `yaml:"defaultInput,omitempty"`
+
+	// (optional) a JSON schema which the merged input of every run is validated against
+	InputSchema map[string]interface{} `yaml:"inputSchema,omitempty"`
+
+	// (optional) whether runs are pinned to the build id of the worker which starts them
+	PinToBuild bool `yaml:"pinToBuild,omitempty"`
+
+	// (optional) the strategy for assigning step runs to workers, which overrides the strategy of the tenant
+	AssignmentStrategy WorkerAssignmentStrategy `yaml:"assignmentStrategy,omitempty"`
+
+	// (optional) the maximum number of step runs a run may queue, including retries, after which the run fails
+	MaxStepExecutions int32 `yaml:"maxStepExecutions,omitempty"`
+
+	// (optional) the maximum number of retries of the step runs of a run, after which the run fails
+	MaxStepRetries int32 `yaml:"maxStepRetries,omitempty"`
+
+	// (optional) the cost center which the compute of the runs of the workflow is attributed to
+	CostCenter string `yaml:"costCenter,omitempty"`
+
 	Triggers WorkflowTriggers `yaml:"triggers"`
 
 	Jobs map[string]WorkflowJob `yaml:"jobs"`
@@ -30,9 +58,30 @@ const (
 	GroupRoundRobin  WorkflowConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
 )
 
+type WorkerAssignmentStrategy string
+
+const (
+	AssignRandom      WorkerAssignmentStrategy = "RANDOM"
+	AssignLeastLoaded WorkerAssignmentStrategy = "LEAST_LOADED"
+	AssignRoundRobin  WorkerAssignmentStrategy = "ROUND_ROBIN"
+	AssignLocality    WorkerAssignmentStrategy = "LOCALITY"
+)
+
+type StepJoinStrategy string
+
+const (
+	JoinAll    StepJoinStrategy = "ALL"
+	JoinAny    StepJoinStrategy = "ANY"
+	JoinQuorum StepJoinStrategy = "QUORUM"
+)
+
 type WorkflowConcurrency struct {
 	ActionID string `yaml:"action,omitempty"`
 
+	// KeyExpressions are expressions which the engine evaluates on the input and additional metadata of a run,
+	// instead of the action, and combines into the group key of the run.
+	KeyExpressions []string `yaml:"keyExpressions,omitempty"`
+
 	MaxRuns int32 `yaml:"maxRuns,omitempty"`
 
 	LimitStrategy WorkflowConcurrencyLimitStrategy `yaml:"limitStrategy,omitempty"`
@@ -76,6 +125,59 @@ type WorkflowStep struct {
 	With     map[string]interface{} `yaml:"with,omitempty"`
 	Parents  []string               `yaml:"parents,omitempty"`
 	Retries  int                    `yaml:"retries"`
+
+	// (optional) the resources which a worker must advertise to run the step
+	Resources *WorkflowStepResources `yaml:"resources,omitempty"`
+
+	// (optional) the amount of time the step may take to finish after it was cancelled, for example 30s
+	CancelGracePeriod string `yaml:"cancelGracePeriod,omitempty"`
+
+	// (optional) the maximum duration of the step when it extends its timeout, for example 2h
+	MaxTimeout string `yaml:"maxTimeout,omitempty"`
+
+	// (optional) the checks of the dependencies of the step, which must pass before the step is assigned to a worker
+	PreflightChecks []WorkflowStepPreflightCheck `yaml:"preflightChecks,omitempty"`
+
+	// (optional) how many parents must succeed before the step runs, by default every parent
+	JoinStrategy StepJoinStrategy `yaml:"joinStrategy,omitempty"`
+
+	// (optional) the number of parents which must succeed before the step runs, for the QUORUM join strategy
+	JoinQuorum int `yaml:"joinQuorum,omitempty"`
+
+	// (optional) the amount of time the step waits to be assigned to a worker before timing out, which overrides
+	// the schedule timeout of the workflow
+	ScheduleTimeout string `yaml:"scheduleTimeout,omitempty"`
+
+	// (optional) the action id of a handler which runs on any worker which registered it when the step times out,
+	// with the input of the step
+	OnTimeout string `yaml:"onTimeout,omitempty"`
+
+	// (optional) the cost center which the compute of the step is attributed to, which overrides the cost center
+	// of the workflow
+	CostCenter string `yaml:"costCenter,omitempty"`
+}
+
+type WorkflowStepPreflightCheck struct {
+	// the kind of the check: HTTP, TCP or EXPRESSION
+	Kind string `yaml:"kind"`
+
+	// the URL of HTTP checks, the host:port address of TCP checks, or the expression of EXPRESSION checks, which is
+	// evaluated against the dependency health reported by the tenant, for example health.db.status == "up"
+	Target string `yaml:"target"`
+
+	// (optional) the timeout of HTTP and TCP checks, for example 5s
+	Timeout string `yaml:"timeout,omitempty"`
+}
+
+type WorkflowStepResources struct {
+	// the number of cpu cores
+	CPU float64 `yaml:"cpu,omitempty"`
+
+	// the memory in megabytes
+	MemoryMb int `yaml:"memoryMb,omitempty"`
+
+	// the number of gpus
+	GPU int `yaml:"gpu,omitempty"`
 }
 
 func ParseYAML(ctx context.Context, yamlBytes []byte) (Workflow, error) {
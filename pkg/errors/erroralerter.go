@@ -2,6 +2,7 @@ package errors
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/hatchet-dev/hatchet/internal/datautils/merge"
 )
@@ -14,6 +15,26 @@ type NoOpAlerter struct{}
 
 func (s NoOpAlerter) SendAlert(ctx context.Context, err error, data map[string]interface{}) {}
 
+// ReloadableAlerter sends alerts to an alerter which can be replaced while it is in use.
+type ReloadableAlerter struct {
+	a atomic.Pointer[Alerter]
+}
+
+func NewReloadableAlerter(a Alerter) *ReloadableAlerter {
+	r := &ReloadableAlerter{}
+	r.Set(a)
+
+	return r
+}
+
+func (r *ReloadableAlerter) Set(a Alerter) {
+	r.a.Store(&a)
+}
+
+func (r *ReloadableAlerter) SendAlert(ctx context.Context, err error, data map[string]interface{}) {
+	(*r.a.Load()).SendAlert(ctx, err, data)
+}
+
 type Wrapped struct {
 	a    Alerter
 	data map[string]interface{}
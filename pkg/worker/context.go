@@ -3,7 +3,10 @@ package worker
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/hatchet-dev/hatchet/pkg/client"
 )
@@ -17,9 +20,61 @@ type HatchetContext interface {
 
 	StepOutput(step string, target interface{}) error
 
+	// StepRunResults fetches the results of the step runs in the workflow run from the engine, optionally only for
+	// the steps with the given readable ids. Unlike StepOutput, it can read the outputs of steps which aren't
+	// parents of the step.
+	StepRunResults(steps ...string) ([]*client.StepRunResult, error)
+
+	// FetchStepOutput fetches the output of a step in the workflow run from the engine, for outputs which are too
+	// large to read from the input of the step run.
+	FetchStepOutput(step string, target interface{}) error
+
 	TriggeredByEvent() bool
 
 	WorkflowInput(target interface{}) error
+
+	// AdditionalMetadata returns the metadata which was set when the workflow run was triggered.
+	AdditionalMetadata() map[string]interface{}
+
+	// RefreshTimeout extends the timeout of the step run to the given duration from now, for example 5m. The
+	// timeout can be extended up to the max timeout of the step.
+	RefreshTimeout(incrementTimeoutBy string) error
+
+	// StreamEvent appends a chunk of output to the output stream of the step run, which is sent to the subscribers
+	// of the workflow run before the step run finishes.
+	StreamEvent(message string) error
+
+	// ReleaseStepRun releases the step run back to the queue, for example because an upstream API is rate limited,
+	// so that it's retried on any worker after the given duration without counting as a failure. The context is
+	// cancelled once the step run is released, and the step should return, as its result is ignored.
+	ReleaseStepRun(retryAfter time.Duration, reason string) error
+
+	// AcquireLock acquires a named lock of the tenant, which other step runs can't acquire until it's released with
+	// ReleaseLock, its holder finishes or its timeout passes. If the lock is held by another step run or workflow
+	// run, it waits up to opts.Wait for the lock to be free, and returns ErrLockHeld if it isn't.
+	AcquireLock(name string, opts *LockOpts) error
+
+	// ReleaseLock releases a named lock which the step run, or its workflow run, holds.
+	ReleaseLock(name string) error
+}
+
+// ErrLockHeld is returned by AcquireLock if the lock is held by another step run or workflow run.
+var ErrLockHeld = errors.New("lock is held by another step run or workflow run")
+
+// lockPollInterval is how often AcquireLock tries to acquire a lock which is held while it waits.
+const lockPollInterval = time.Second
+
+type LockOpts struct {
+	// (optional) the duration after which the lock is released if it wasn't released before. Defaults to the
+	// default timeout of the engine, which is 5 minutes. Acquiring the lock again renews it.
+	Timeout time.Duration
+
+	// (optional) how long to wait for the lock to be free if it's held. Defaults to not waiting.
+	Wait time.Duration
+
+	// (optional) whether the lock is held until the workflow run finishes, instead of the step run, so that the
+	// later steps of the workflow run hold it as well.
+	HoldForWorkflowRun bool
 }
 
 // TODO: move this into proto definitions
@@ -38,9 +93,10 @@ type JobRunLookupData struct {
 }
 
 type StepRunData struct {
-	Input       map[string]interface{} `json:"input"`
-	TriggeredBy TriggeredBy            `json:"triggered_by"`
-	Parents     map[string]StepData    `json:"parents"`
+	Input              map[string]interface{} `json:"input"`
+	TriggeredBy        TriggeredBy            `json:"triggered_by"`
+	Parents            map[string]StepData    `json:"parents"`
+	AdditionalMetadata map[string]interface{} `json:"additional_metadata,omitempty"`
 }
 
 type StepData map[string]interface{}
@@ -49,12 +105,23 @@ type hatchetContext struct {
 	context.Context
 	action   *client.Action
 	stepData *StepRunData
+	client   client.Client
+
+	// cancel cancels the context of the step run once it was released
+	cancel context.CancelFunc
+
+	// streamMu orders the stream events of the step run in the order they were sent
+	streamMu sync.Mutex
 }
 
-func newHatchetContext(ctx context.Context, action *client.Action) (HatchetContext, error) {
+func newHatchetContext(ctx context.Context, action *client.Action, client client.Client) (HatchetContext, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
 	c := &hatchetContext{
 		Context: ctx,
 		action:  action,
+		client:  client,
+		cancel:  cancel,
 	}
 
 	if action.GetGroupKeyRunId != "" {
@@ -90,6 +157,37 @@ func (h *hatchetContext) StepOutput(step string, target interface{}) error {
 	return fmt.Errorf("step %s not found in action payload", step)
 }
 
+func (h *hatchetContext) StepRunResults(steps ...string) ([]*client.StepRunResult, error) {
+	if h.action.StepRunId == "" {
+		return nil, fmt.Errorf("step run results can only be listed by step runs")
+	}
+
+	return h.client.Dispatcher().ListStepRunResults(h.Context, h.action.WorkerId, h.action.StepRunId, steps...)
+}
+
+func (h *hatchetContext) FetchStepOutput(step string, target interface{}) error {
+	results, err := h.StepRunResults(step)
+
+	if err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		if result.Status != "SUCCEEDED" {
+			continue
+		}
+
+		// steps without a return value have no output
+		if len(result.Output) == 0 {
+			return nil
+		}
+
+		return json.Unmarshal(result.Output, target)
+	}
+
+	return fmt.Errorf("step %s has not succeeded in this workflow run", step)
+}
+
 func (h *hatchetContext) TriggeredByEvent() bool {
 	return h.stepData.TriggeredBy == TriggeredByEvent
 }
@@ -98,6 +196,98 @@ func (h *hatchetContext) WorkflowInput(target interface{}) error {
 	return toTarget(h.stepData.Input, target)
 }
 
+func (h *hatchetContext) AdditionalMetadata() map[string]interface{} {
+	return h.stepData.AdditionalMetadata
+}
+
+func (h *hatchetContext) RefreshTimeout(incrementTimeoutBy string) error {
+	if h.action.StepRunId == "" {
+		return fmt.Errorf("the timeout can only be refreshed for step runs")
+	}
+
+	return h.client.Dispatcher().RefreshTimeout(h.Context, h.action.StepRunId, incrementTimeoutBy)
+}
+
+func (h *hatchetContext) ReleaseStepRun(retryAfter time.Duration, reason string) error {
+	if h.action.StepRunId == "" {
+		return fmt.Errorf("only step runs can be released")
+	}
+
+	err := h.client.Dispatcher().ReleaseStepRun(h.Context, h.action.WorkerId, h.action.StepRunId, retryAfter, reason)
+
+	if err != nil {
+		return err
+	}
+
+	// the worker doesn't send a finished or failed event for step runs whose context was cancelled
+	h.cancel()
+
+	return nil
+}
+
+func (h *hatchetContext) AcquireLock(name string, opts *LockOpts) error {
+	if h.action.StepRunId == "" {
+		return fmt.Errorf("locks can only be acquired by step runs")
+	}
+
+	if opts == nil {
+		opts = &LockOpts{}
+	}
+
+	deadline := time.Now().Add(opts.Wait)
+
+	for {
+		acquired, err := h.client.Dispatcher().AcquireLock(h.Context, h.action.WorkerId, h.action.StepRunId, name, opts.Timeout, opts.HoldForWorkflowRun)
+
+		if err != nil {
+			return err
+		}
+
+		if acquired {
+			return nil
+		}
+
+		if !time.Now().Add(lockPollInterval).Before(deadline) {
+			return ErrLockHeld
+		}
+
+		select {
+		case <-h.Context.Done():
+			return h.Context.Err()
+		case <-time.After(lockPollInterval):
+		}
+	}
+}
+
+func (h *hatchetContext) ReleaseLock(name string) error {
+	if h.action.StepRunId == "" {
+		return fmt.Errorf("locks can only be released by step runs")
+	}
+
+	released, err := h.client.Dispatcher().ReleaseLock(h.Context, h.action.WorkerId, h.action.StepRunId, name)
+
+	if err != nil {
+		return err
+	}
+
+	if !released {
+		return fmt.Errorf("lock %s is not held by the step run or its workflow run", name)
+	}
+
+	return nil
+}
+
+func (h *hatchetContext) StreamEvent(message string) error {
+	if h.action.StepRunId == "" {
+		return fmt.Errorf("stream events can only be sent by step runs")
+	}
+
+	h.streamMu.Lock()
+	defer h.streamMu.Unlock()
+
+	return h.client.Event().PutStreamEvent(h.Context, h.action.StepRunId, message)
+}
+
 func (h *hatchetContext) populateStepDataForGroupKeyRun() error {
 	if h.stepData != nil {
 		return nil
@@ -115,6 +305,14 @@ func (h *hatchetContext) populateStepDataForGroupKeyRun() error {
 		Input: inputData,
 	}
 
+	if len(h.action.AdditionalMetadata) > 0 {
+		err := json.Unmarshal(h.action.AdditionalMetadata, &h.stepData.AdditionalMetadata)
+
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
 )
 
 type testHatchetContext struct {
@@ -22,6 +25,14 @@ func (c *testHatchetContext) StepOutput(step string, target interface{}) error {
 	return nil
 }
 
+func (c *testHatchetContext) StepRunResults(steps ...string) ([]*client.StepRunResult, error) {
+	return nil, nil
+}
+
+func (c *testHatchetContext) FetchStepOutput(step string, target interface{}) error {
+	return nil
+}
+
 func (c *testHatchetContext) TriggeredByEvent() bool {
 	return false
 }
@@ -30,6 +41,30 @@ func (c *testHatchetContext) WorkflowInput(target interface{}) error {
 	return nil
 }
 
+func (c *testHatchetContext) AdditionalMetadata() map[string]interface{} {
+	return nil
+}
+
+func (c *testHatchetContext) RefreshTimeout(incrementTimeoutBy string) error {
+	return nil
+}
+
+func (c *testHatchetContext) StreamEvent(message string) error {
+	return nil
+}
+
+func (c *testHatchetContext) ReleaseStepRun(retryAfter time.Duration, reason string) error {
+	return nil
+}
+
+func (c *testHatchetContext) AcquireLock(name string, opts *LockOpts) error {
+	return nil
+}
+
+func (c *testHatchetContext) ReleaseLock(name string) error {
+	return nil
+}
+
 func TestAddMiddleware(t *testing.T) {
 	m := middlewares{}
 	middlewareFunc := func(ctx HatchetContext, next func(HatchetContext) error) error {
@@ -77,6 +77,14 @@ type Worker struct {
 
 	cancelConcurrencyMap sync.Map
 
+	// runningMap contains the ids of the step runs and get group key runs which the worker is running
+	runningMap sync.Map
+
+	// runningWg tracks the handlers of the actions which the worker is running
+	runningWg sync.WaitGroup
+
+	shutdownTimeout time.Duration
+
 	services sync.Map
 
 	alerter errors.Alerter
@@ -84,6 +92,14 @@ type Worker struct {
 	middlewares *middlewares
 
 	maxRuns *int
+
+	cpu      *float64
+	memoryMb *int
+	gpu      *int
+
+	buildId *string
+
+	labels map[string]string
 }
 
 type WorkerOpt func(*WorkerOpts)
@@ -96,6 +112,13 @@ type WorkerOpts struct {
 	integrations []integrations.Integration
 	alerter      errors.Alerter
 	maxRuns      *int
+	cpu          *float64
+	memoryMb     *int
+	gpu          *int
+	buildId      *string
+	labels       map[string]string
+
+	shutdownTimeout time.Duration
 }
 
 func defaultWorkerOpts() *WorkerOpts {
@@ -106,6 +129,8 @@ func defaultWorkerOpts() *WorkerOpts {
 		l:            &logger,
 		integrations: []integrations.Integration{},
 		alerter:      errors.NoOpAlerter{},
+
+		shutdownTimeout: 30 * time.Second,
 	}
 }
 
@@ -139,6 +164,56 @@ func WithMaxRuns(maxRuns int) WorkerOpt {
 	}
 }
 
+// WithCpu advertises the number of cpu cores of the worker. Steps with a higher cpu hint are not
+// assigned to the worker.
+func WithCpu(cpu float64) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.cpu = &cpu
+	}
+}
+
+// WithMemoryMb advertises the memory of the worker in megabytes. Steps with a higher memory hint are
+// not assigned to the worker.
+func WithMemoryMb(memoryMb int) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.memoryMb = &memoryMb
+	}
+}
+
+// WithGpu advertises the number of gpus of the worker. Steps with a higher gpu hint are not assigned
+// to the worker.
+func WithGpu(gpu int) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.gpu = &gpu
+	}
+}
+
+// WithBuildId sets the build id of the worker's code, such as a commit sha. Runs of workflows with
+// PinToBuild set are pinned to the build id of the worker which starts them, so their remaining steps
+// are not assigned to workers of other builds during a deploy.
+func WithBuildId(buildId string) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.buildId = &buildId
+	}
+}
+
+// WithLabels sets the labels of the worker, such as its region or host. Step runs of workflows with the
+// LOCALITY assignment strategy are assigned to the workers whose labels match the most labels of the workers
+// which ran their parent steps.
+func WithLabels(labels map[string]string) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.labels = labels
+	}
+}
+
+// WithShutdownTimeout sets how long Run waits for the handlers of running actions to return after their contexts
+// are cancelled, before it unregisters the worker. Defaults to 30 seconds.
+func WithShutdownTimeout(timeout time.Duration) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.shutdownTimeout = timeout
+	}
+}
+
 // NewWorker creates a new worker instance
 func NewWorker(fs ...WorkerOpt) (*Worker, error) {
 	opts := defaultWorkerOpts()
@@ -159,6 +234,13 @@ func NewWorker(fs ...WorkerOpt) (*Worker, error) {
 		alerter:     opts.alerter,
 		middlewares: mws,
 		maxRuns:     opts.maxRuns,
+		cpu:         opts.cpu,
+		memoryMb:    opts.memoryMb,
+		gpu:         opts.gpu,
+		buildId:     opts.buildId,
+		labels:      opts.labels,
+
+		shutdownTimeout: opts.shutdownTimeout,
 	}
 
 	// register all integrations
@@ -271,6 +353,84 @@ func (w *Worker) registerAction(service, verb string, method any) error {
 func (w *Worker) Start() (func() error, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 
+	listener, actionCh, err := w.listen(ctx)
+
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	go w.runActions(ctx, actionCh)
+
+	cleanup := func() error {
+		cancel()
+
+		w.l.Debug().Msgf("worker %s is stopping...", w.name)
+
+		err := listener.Unregister()
+		if err != nil {
+			return fmt.Errorf("could not unregister worker: %w", err)
+		}
+
+		w.l.Debug().Msgf("worker %s stopped", w.name)
+
+		return nil
+	}
+
+	return cleanup, nil
+}
+
+// Run registers the worker and runs the actions assigned to it until the context is cancelled, so that a service
+// can run the worker in-process alongside its other components. The worker reconnects to the engine if its stream
+// drops, and returns an error if it can't register or reconnect.
+//
+// When the context is cancelled or the worker can't reconnect, the contexts of the running actions are cancelled,
+// and Run waits up to the shutdown timeout for their handlers to return before it unregisters the worker. Step runs
+// which didn't finish are reassigned by the engine.
+func (w *Worker) Run(ctx context.Context) error {
+	listenCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	listener, actionCh, err := w.listen(listenCtx)
+
+	if err != nil {
+		return err
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		w.runActions(listenCtx, actionCh)
+	}()
+
+	var runErr error
+
+	select {
+	case <-ctx.Done():
+	case <-done:
+		if err := listener.Err(); err != nil {
+			runErr = fmt.Errorf("worker %s lost its connection to the engine: %w", w.name, err)
+		}
+	}
+
+	cancel()
+	<-done
+
+	w.l.Debug().Msgf("worker %s is stopping...", w.name)
+
+	w.drain()
+
+	if err := listener.Unregister(); err != nil && runErr == nil {
+		runErr = fmt.Errorf("could not unregister worker: %w", err)
+	}
+
+	w.l.Debug().Msgf("worker %s stopped", w.name)
+
+	return runErr
+}
+
+func (w *Worker) listen(ctx context.Context) (client.WorkerActionListener, <-chan *client.Action, error) {
 	actionNames := []string{}
 
 	for _, action := range w.actions {
@@ -281,56 +441,79 @@ func (w *Worker) Start() (func() error, error) {
 		WorkerName: w.name,
 		Actions:    actionNames,
 		MaxRuns:    w.maxRuns,
+		Cpu:        w.cpu,
+		MemoryMb:   w.memoryMb,
+		Gpu:        w.gpu,
+		BuildId:    w.buildId,
+		Labels:     w.labels,
 	})
 
 	if err != nil {
-		cancel()
-		return nil, fmt.Errorf("could not get action listener: %w", err)
+		return nil, nil, fmt.Errorf("could not get action listener: %w", err)
 	}
 
 	actionCh, err := listener.Actions(ctx)
 
 	if err != nil {
-		cancel()
-		return nil, fmt.Errorf("could not get action channel: %w", err)
+		return nil, nil, fmt.Errorf("could not get action channel: %w", err)
 	}
 
-	go func() {
-		for {
-			select {
-			case action := <-actionCh:
-				go func(action *client.Action) {
-					err := w.executeAction(context.Background(), action)
-
-					if err != nil {
-						w.l.Error().Err(err).Msgf("could not execute action: %s", action.ActionId)
-					}
+	return listener, actionCh, nil
+}
 
-					w.l.Debug().Msgf("action %s completed", action.ActionId)
-				}(action)
-			case <-ctx.Done():
-				w.l.Debug().Msgf("worker %s received context done, stopping", w.name)
+// runActions runs the actions from the channel until the context is cancelled or the channel is closed.
+func (w *Worker) runActions(ctx context.Context, actionCh <-chan *client.Action) {
+	for {
+		select {
+		case action, ok := <-actionCh:
+			if !ok {
+				w.l.Debug().Msgf("action listener of worker %s closed, stopping", w.name)
 				return
 			}
-		}
-	}()
 
-	cleanup := func() error {
-		cancel()
+			w.runningWg.Add(1)
 
-		w.l.Debug().Msgf("worker %s is stopping...", w.name)
+			go func(action *client.Action) {
+				defer w.runningWg.Done()
 
-		err := listener.Unregister()
-		if err != nil {
-			return fmt.Errorf("could not unregister worker: %w", err)
-		}
+				err := w.executeAction(context.Background(), action)
 
-		w.l.Debug().Msgf("worker %s stopped", w.name)
+				if err != nil {
+					w.l.Error().Err(err).Msgf("could not execute action: %s", action.ActionId)
+				}
 
-		return nil
+				w.l.Debug().Msgf("action %s completed", action.ActionId)
+			}(action)
+		case <-ctx.Done():
+			w.l.Debug().Msgf("worker %s received context done, stopping", w.name)
+			return
+		}
 	}
+}
 
-	return cleanup, nil
+// drain cancels the contexts of the step runs and get group key runs which the worker is running, and waits up to
+// the shutdown timeout for their handlers to return.
+func (w *Worker) drain() {
+	cancelAll := func(_, cancel any) bool {
+		cancel.(context.CancelFunc)()
+		return true
+	}
+
+	w.cancelMap.Range(cancelAll)
+	w.cancelConcurrencyMap.Range(cancelAll)
+
+	drained := make(chan struct{})
+
+	go func() {
+		w.runningWg.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+	case <-time.After(w.shutdownTimeout):
+		w.l.Warn().Msgf("worker %s stopped before its running actions returned", w.name)
+	}
 }
 
 func (w *Worker) executeAction(ctx context.Context, assignedAction *client.Action) error {
@@ -341,12 +524,35 @@ func (w *Worker) executeAction(ctx context.Context, assignedAction *client.Actio
 		return w.cancelStepRun(ctx, assignedAction)
 	case client.ActionTypeStartGetGroupKey:
 		return w.startGetGroupKey(ctx, assignedAction)
+	case client.ActionTypeStartStepRunTimeoutHook:
+		return w.startStepRunTimeoutHook(ctx, assignedAction)
 	default:
 		return fmt.Errorf("unknown action type: %s", assignedAction.ActionType)
 	}
 }
 
 func (w *Worker) startStepRun(ctx context.Context, assignedAction *client.Action) error {
+	// when the worker reconnects, the dispatcher resends the step runs which it hasn't seen start, which may
+	// include step runs that are already running
+	if _, running := w.runningMap.LoadOrStore(assignedAction.StepRunId, true); running {
+		w.l.Debug().Msgf("step run %s is already running, skipping", assignedAction.StepRunId)
+		return nil
+	}
+
+	// the step run is removed from the running step runs before its final event is sent, since the engine may
+	// assign it to the worker again, to retry it, as soon as it receives the event
+	finish := sync.OnceFunc(func() {
+		w.runningMap.Delete(assignedAction.StepRunId)
+		w.cancelMap.Delete(assignedAction.StepRunId)
+	})
+
+	defer finish()
+
+	// fetch the input if the dispatcher left it out because of its size
+	if err := w.client.Dispatcher().HydrateAction(ctx, assignedAction); err != nil {
+		return err
+	}
+
 	// send a message that the step run started
 	_, err := w.client.Dispatcher().SendStepActionEvent(
 		ctx,
@@ -370,10 +576,11 @@ func (w *Worker) startStepRun(ctx context.Context, assignedAction *client.Action
 	}
 
 	runContext, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
 	w.cancelMap.Store(assignedAction.StepRunId, cancel)
 
-	hCtx, err := newHatchetContext(runContext, assignedAction)
+	hCtx, err := newHatchetContext(runContext, assignedAction, w.client)
 
 	if err != nil {
 		return fmt.Errorf("could not create hatchet context: %w", err)
@@ -431,6 +638,8 @@ func (w *Worker) startStepRun(ctx context.Context, assignedAction *client.Action
 
 				failureEvent.EventPayload = err.Error()
 
+				finish()
+
 				_, err := w.client.Dispatcher().SendStepActionEvent(
 					ctx,
 					failureEvent,
@@ -450,6 +659,8 @@ func (w *Worker) startStepRun(ctx context.Context, assignedAction *client.Action
 				return fmt.Errorf("could not create finished event: %w", err)
 			}
 
+			finish()
+
 			_, err = w.client.Dispatcher().SendStepActionEvent(
 				ctx,
 				finishedEvent,
@@ -465,6 +676,20 @@ func (w *Worker) startStepRun(ctx context.Context, assignedAction *client.Action
 }
 
 func (w *Worker) startGetGroupKey(ctx context.Context, assignedAction *client.Action) error {
+	if _, running := w.runningMap.LoadOrStore(assignedAction.GetGroupKeyRunId, true); running {
+		w.l.Debug().Msgf("get group key run %s is already running, skipping", assignedAction.GetGroupKeyRunId)
+		return nil
+	}
+
+	// the get group key run is removed from the running runs before its final event is sent, since the engine may
+	// assign it to the worker again as soon as it receives the event
+	finish := sync.OnceFunc(func() {
+		w.runningMap.Delete(assignedAction.GetGroupKeyRunId)
+		w.cancelConcurrencyMap.Delete(assignedAction.WorkflowRunId)
+	})
+
+	defer finish()
+
 	// send a message that the step run started
 	_, err := w.client.Dispatcher().SendGroupKeyActionEvent(
 		ctx,
@@ -487,10 +712,11 @@ func (w *Worker) startGetGroupKey(ctx context.Context, assignedAction *client.Ac
 	}
 
 	runContext, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
 	w.cancelConcurrencyMap.Store(assignedAction.WorkflowRunId, cancel)
 
-	hCtx, err := newHatchetContext(runContext, assignedAction)
+	hCtx, err := newHatchetContext(runContext, assignedAction, w.client)
 
 	if err != nil {
 		return fmt.Errorf("could not create hatchet context: %w", err)
@@ -511,6 +737,8 @@ func (w *Worker) startGetGroupKey(ctx context.Context, assignedAction *client.Ac
 
 		failureEvent.EventPayload = err.Error()
 
+		finish()
+
 		_, err := w.client.Dispatcher().SendGroupKeyActionEvent(
 			ctx,
 			failureEvent,
@@ -530,6 +758,8 @@ func (w *Worker) startGetGroupKey(ctx context.Context, assignedAction *client.Ac
 		return fmt.Errorf("could not create finished event: %w", err)
 	}
 
+	finish()
+
 	_, err = w.client.Dispatcher().SendGroupKeyActionEvent(
 		ctx,
 		finishedEvent,
@@ -542,6 +772,60 @@ func (w *Worker) startGetGroupKey(ctx context.Context, assignedAction *client.Ac
 	return nil
 }
 
+// startStepRunTimeoutHook runs the on-timeout handler of a step run which timed out, with the input of the step
+// run. The step run has already been cancelled, so no events are sent for the handler, and its errors are only
+// logged and alerted.
+func (w *Worker) startStepRunTimeoutHook(ctx context.Context, assignedAction *client.Action) error {
+	// fetch the input if the dispatcher left it out because of its size
+	if err := w.client.Dispatcher().HydrateAction(ctx, assignedAction); err != nil {
+		return err
+	}
+
+	action, ok := w.actions[assignedAction.ActionId]
+
+	if !ok {
+		return fmt.Errorf("on-timeout handler %s not found", assignedAction.ActionId)
+	}
+
+	arg, err := decodeArgsToInterface(reflect.TypeOf(action.MethodFn()))
+
+	if err != nil {
+		return fmt.Errorf("could not decode args to interface: %w", err)
+	}
+
+	hCtx, err := newHatchetContext(context.Background(), assignedAction, w.client)
+
+	if err != nil {
+		return fmt.Errorf("could not create hatchet context: %w", err)
+	}
+
+	args := []any{hCtx}
+
+	if arg != nil {
+		args = append(args, arg)
+	}
+
+	runResults := action.Run(args...)
+
+	if runResults[len(runResults)-1] != nil {
+		err = runResults[len(runResults)-1].(error)
+	}
+
+	if err != nil {
+		w.alerter.SendAlert(context.Background(), err, map[string]interface{}{
+			"actionId":   assignedAction.ActionId,
+			"workerId":   assignedAction.WorkerId,
+			"stepRunId":  assignedAction.StepRunId,
+			"jobName":    assignedAction.JobName,
+			"actionType": assignedAction.ActionType,
+		})
+
+		return fmt.Errorf("on-timeout handler of step run %s failed: %w", assignedAction.StepRunId, err)
+	}
+
+	return nil
+}
+
 func (w *Worker) cancelStepRun(ctx context.Context, assignedAction *client.Action) error {
 	cancel, ok := w.cancelMap.Load(assignedAction.StepRunId)
 
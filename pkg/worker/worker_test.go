@@ -0,0 +1,274 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+type fakeClient struct {
+	client.Client
+
+	dispatcher *fakeDispatcher
+}
+
+func (c *fakeClient) Dispatcher() client.DispatcherClient {
+	return c.dispatcher
+}
+
+type fakeDispatcher struct {
+	client.DispatcherClient
+
+	listener *fakeListener
+
+	mu     sync.Mutex
+	events []client.ActionEventType
+
+	// onEvent is called with the step run events before they're recorded
+	onEvent func(in *client.ActionEvent)
+}
+
+func (d *fakeDispatcher) GetActionListener(ctx context.Context, req *client.GetActionListenerRequest) (client.WorkerActionListener, error) {
+	return d.listener, nil
+}
+
+func (d *fakeDispatcher) HydrateAction(ctx context.Context, action *client.Action) error {
+	return nil
+}
+
+func (d *fakeDispatcher) SendStepActionEvent(ctx context.Context, in *client.ActionEvent) (*client.ActionEventResponse, error) {
+	if d.onEvent != nil {
+		d.onEvent(in)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.events = append(d.events, in.EventType)
+
+	return &client.ActionEventResponse{}, nil
+}
+
+func (d *fakeDispatcher) sentEvents() []client.ActionEventType {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return append([]client.ActionEventType{}, d.events...)
+}
+
+type fakeListener struct {
+	ch  chan *client.Action
+	err error
+
+	unregistered bool
+}
+
+func (l *fakeListener) Actions(ctx context.Context) (<-chan *client.Action, error) {
+	return l.ch, nil
+}
+
+func (l *fakeListener) Err() error {
+	return l.err
+}
+
+func (l *fakeListener) Unregister() error {
+	l.unregistered = true
+	return nil
+}
+
+func newTestWorker(t *testing.T, opts ...WorkerOpt) (*Worker, *fakeDispatcher) {
+	t.Helper()
+
+	d := &fakeDispatcher{
+		listener: &fakeListener{ch: make(chan *client.Action)},
+	}
+
+	w, err := NewWorker(append([]WorkerOpt{WithClient(&fakeClient{dispatcher: d})}, opts...)...)
+	require.NoError(t, err)
+
+	return w, d
+}
+
+func TestRunCancelsRunningStepRunsOnShutdown(t *testing.T) {
+	w, d := newTestWorker(t)
+
+	started := make(chan struct{})
+	stopped := make(chan struct{})
+
+	err := w.RegisterAction("default:wait", func(ctx HatchetContext) error {
+		close(started)
+		<-ctx.Done()
+		close(stopped)
+		return ctx.Err()
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	runErr := make(chan error)
+
+	go func() {
+		runErr <- w.Run(ctx)
+	}()
+
+	d.listener.ch <- &client.Action{
+		StepRunId:  "step-run-1",
+		ActionId:   "default:wait",
+		ActionType: client.ActionTypeStartStepRun,
+	}
+
+	<-started
+
+	cancel()
+
+	select {
+	case err := <-runErr:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run didn't return after its context was cancelled")
+	}
+
+	select {
+	case <-stopped:
+	default:
+		t.Fatal("Run returned before the step run's handler returned")
+	}
+
+	assert.True(t, d.listener.unregistered)
+
+	// the cancelled step run is reassigned by the engine, so it's neither completed nor failed
+	assert.Equal(t, []client.ActionEventType{client.ActionEventTypeStarted}, d.sentEvents())
+}
+
+func TestRunReturnsListenerError(t *testing.T) {
+	w, d := newTestWorker(t)
+
+	d.listener.err = errors.New("failed to subscribe")
+	close(d.listener.ch)
+
+	err := w.Run(context.Background())
+
+	assert.ErrorIs(t, err, d.listener.err)
+	assert.True(t, d.listener.unregistered)
+}
+
+func TestRunStopsWaitingAfterShutdownTimeout(t *testing.T) {
+	w, d := newTestWorker(t, WithShutdownTimeout(10*time.Millisecond))
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+
+	err := w.RegisterAction("default:stuck", func(ctx HatchetContext) error {
+		close(started)
+		<-release
+		return nil
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	runErr := make(chan error)
+
+	go func() {
+		runErr <- w.Run(ctx)
+	}()
+
+	d.listener.ch <- &client.Action{
+		StepRunId:  "step-run-1",
+		ActionId:   "default:stuck",
+		ActionType: client.ActionTypeStartStepRun,
+	}
+
+	<-started
+
+	cancel()
+
+	select {
+	case err := <-runErr:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run didn't return after the shutdown timeout")
+	}
+}
+
+func TestStepRunReassignedBeforeFinalEventReturns(t *testing.T) {
+	w, d := newTestWorker(t)
+
+	runs := make(chan struct{}, 2)
+
+	err := w.RegisterAction("default:retry", func(ctx HatchetContext) error {
+		runs <- struct{}{}
+		return errors.New("failed")
+	})
+	require.NoError(t, err)
+
+	action := &client.Action{
+		StepRunId:  "step-run-1",
+		ActionId:   "default:retry",
+		ActionType: client.ActionTypeStartStepRun,
+	}
+
+	reassigned := make(chan struct{})
+
+	// the engine retries the step run as soon as it receives the failure, before the worker has finished sending it
+	d.onEvent = func(in *client.ActionEvent) {
+		if in.EventType != client.ActionEventTypeFailed {
+			return
+		}
+
+		select {
+		case <-reassigned:
+			return
+		default:
+			close(reassigned)
+		}
+
+		d.listener.ch <- action
+
+		select {
+		case <-time.After(5 * time.Second):
+		case <-waitForRuns(runs, 2):
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go w.Run(ctx) // nolint: errcheck
+
+	d.listener.ch <- action
+
+	require.Eventually(t, func() bool {
+		return len(d.sentEvents()) == 4
+	}, 5*time.Second, 5*time.Millisecond, "the retry of the step run was skipped")
+
+	assert.Equal(t, []client.ActionEventType{
+		client.ActionEventTypeStarted,
+		client.ActionEventTypeStarted,
+		client.ActionEventTypeFailed,
+		client.ActionEventTypeFailed,
+	}, d.sentEvents())
+}
+
+// waitForRuns returns a channel which is closed once n runs were received.
+func waitForRuns(runs <-chan struct{}, n int) <-chan struct{} {
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		for i := 0; i < n; i++ {
+			<-runs
+		}
+	}()
+
+	return done
+}
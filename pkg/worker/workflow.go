@@ -132,6 +132,41 @@ type WorkflowJob struct {
 
 	Timeout string
 
+	// (optional) the expected maximum duration of a workflow run, for example "10m". Runs which take longer
+	// emit an sla-breached event, even if they eventually succeed.
+	SLA string
+
+	// (optional) the amount of time step runs wait to be assigned to a worker before they time out, for example
+	// "10m". Steps can override it with SetScheduleTimeout.
+	ScheduleTimeout string
+
+	// (optional) the input values which are deep-merged with the input of every run, so callers only need to
+	// send the values which differ from the defaults
+	DefaultInput map[string]interface{}
+
+	// (optional) a JSON schema which the merged input of every run is validated against
+	InputSchema map[string]interface{}
+
+	// (optional) whether runs are pinned to the build id of the worker which starts them, so that a deploy
+	// doesn't run the remaining steps of a run on workers with a different build. See WithBuildId.
+	PinToBuild bool
+
+	// (optional) the strategy for assigning the step runs of the workflow to workers, which overrides the
+	// strategy of the tenant. See WithLabels for the LOCALITY strategy.
+	AssignmentStrategy types.WorkerAssignmentStrategy
+
+	// (optional) the maximum number of step runs a run may queue, including retries. Runs which exceed it
+	// are failed, which protects against runaway fan-out.
+	MaxStepExecutions int32
+
+	// (optional) the maximum number of retries of the step runs of a run. Runs which exceed it are failed,
+	// which protects against retry loops.
+	MaxStepRetries int32
+
+	// (optional) the cost center which the compute of the runs of the workflow is attributed to. Steps can
+	// override it with SetCostCenter.
+	CostCenter string
+
 	Concurrency *WorkflowConcurrency
 
 	// The steps that are run in the job
@@ -139,9 +174,10 @@ type WorkflowJob struct {
 }
 
 type WorkflowConcurrency struct {
-	fn            GetWorkflowConcurrencyGroupFn
-	maxRuns       *int32
-	limitStrategy *types.WorkflowConcurrencyLimitStrategy
+	fn             GetWorkflowConcurrencyGroupFn
+	keyExpressions []string
+	maxRuns        *int32
+	limitStrategy  *types.WorkflowConcurrencyLimitStrategy
 }
 
 func Concurrency(fn GetWorkflowConcurrencyGroupFn) *WorkflowConcurrency {
@@ -150,6 +186,15 @@ func Concurrency(fn GetWorkflowConcurrencyGroupFn) *WorkflowConcurrency {
 	}
 }
 
+// ConcurrencyExpressions computes the group key of a run from expressions on its input and additional metadata,
+// like `input.customer_id` or `additional_metadata.region`, instead of a function which runs on a worker. The
+// engine combines the values of multiple expressions into one group key, and stores each value separately.
+func ConcurrencyExpressions(keyExpressions ...string) *WorkflowConcurrency {
+	return &WorkflowConcurrency{
+		keyExpressions: keyExpressions,
+	}
+}
+
 func (c *WorkflowConcurrency) MaxRuns(maxRuns int32) *WorkflowConcurrency {
 	c.maxRuns = &maxRuns
 	return c
@@ -172,13 +217,26 @@ func (j *WorkflowJob) ToWorkflow(svcName string) types.Workflow {
 	}
 
 	w := types.Workflow{
-		Name: j.Name,
-		Jobs: jobs,
+		Name:               j.Name,
+		SLA:                j.SLA,
+		ScheduleTimeout:    j.ScheduleTimeout,
+		DefaultInput:       j.DefaultInput,
+		InputSchema:        j.InputSchema,
+		PinToBuild:         j.PinToBuild,
+		AssignmentStrategy: j.AssignmentStrategy,
+		MaxStepExecutions:  j.MaxStepExecutions,
+		MaxStepRetries:     j.MaxStepRetries,
+		CostCenter:         j.CostCenter,
+		Jobs:               jobs,
 	}
 
 	if j.Concurrency != nil {
-		w.Concurrency = &types.WorkflowConcurrency{
-			ActionID: "concurrency:" + getFnName(j.Concurrency.fn),
+		w.Concurrency = &types.WorkflowConcurrency{}
+
+		if j.Concurrency.fn != nil {
+			w.Concurrency.ActionID = "concurrency:" + getFnName(j.Concurrency.fn)
+		} else {
+			w.Concurrency.KeyExpressions = j.Concurrency.keyExpressions
 		}
 
 		if j.Concurrency.maxRuns != nil {
@@ -232,9 +290,13 @@ func (j *WorkflowJob) ToActionMap(svcName string) map[string]any {
 		actionId := step.GetActionId(svcName, i)
 
 		res[actionId] = step.Function
+
+		if step.OnTimeout != nil {
+			res[step.GetOnTimeoutActionId(svcName, i)] = step.OnTimeout
+		}
 	}
 
-	if j.Concurrency != nil {
+	if j.Concurrency != nil && j.Concurrency.fn != nil {
 		res["concurrency:"+getFnName(j.Concurrency.fn)] = j.Concurrency.fn
 	}
 
@@ -255,6 +317,37 @@ type WorkflowStep struct {
 	Parents []string
 
 	Retries int
+
+	// (optional) the resources which a worker must advertise to run the step
+	Resources *types.WorkflowStepResources
+
+	// (optional) the amount of time the step may take to finish after it was cancelled, before the engine
+	// force-cancels it and releases the worker slot
+	CancelGracePeriod string
+
+	// (optional) the maximum duration of the step when it extends its timeout with ctx.RefreshTimeout
+	MaxTimeout string
+
+	// (optional) the checks of the dependencies of the step, which must pass before the step is assigned to a worker
+	PreflightChecks []types.WorkflowStepPreflightCheck
+
+	// (optional) how many parents must succeed before the step runs, by default every parent
+	JoinStrategy types.StepJoinStrategy
+
+	// (optional) the number of parents which must succeed before the step runs, for the QUORUM join strategy
+	JoinQuorum int
+
+	// (optional) the amount of time the step waits to be assigned to a worker before it times out, which
+	// overrides the schedule timeout of the workflow
+	ScheduleTimeout string
+
+	// (optional) a function which runs on any worker when the step times out, with the input of the step, so that
+	// the step can clean up after itself. It has the same signature as the step function, and its output is ignored.
+	OnTimeout any
+
+	// (optional) the cost center which the compute of the step is attributed to, which overrides the cost center
+	// of the workflow
+	CostCenter string
 }
 
 func Fn(f any) *WorkflowStep {
@@ -279,6 +372,58 @@ func (w *WorkflowStep) SetRetries(retries int) *WorkflowStep {
 	return w
 }
 
+func (w *WorkflowStep) SetResources(resources types.WorkflowStepResources) *WorkflowStep {
+	w.Resources = &resources
+	return w
+}
+
+func (w *WorkflowStep) SetCancelGracePeriod(gracePeriod string) *WorkflowStep {
+	w.CancelGracePeriod = gracePeriod
+	return w
+}
+
+func (w *WorkflowStep) SetMaxTimeout(maxTimeout string) *WorkflowStep {
+	w.MaxTimeout = maxTimeout
+	return w
+}
+
+func (w *WorkflowStep) SetScheduleTimeout(scheduleTimeout string) *WorkflowStep {
+	w.ScheduleTimeout = scheduleTimeout
+	return w
+}
+
+// SetOnTimeout sets a function which runs on any worker when the step times out, with the input of the step.
+func (w *WorkflowStep) SetOnTimeout(fn any) *WorkflowStep {
+	w.OnTimeout = fn
+	return w
+}
+
+// SetCostCenter attributes the compute of the step to a cost center other than the cost center of the workflow.
+func (w *WorkflowStep) SetCostCenter(costCenter string) *WorkflowStep {
+	w.CostCenter = costCenter
+	return w
+}
+
+func (w *WorkflowStep) AddPreflightCheck(check types.WorkflowStepPreflightCheck) *WorkflowStep {
+	w.PreflightChecks = append(w.PreflightChecks, check)
+	return w
+}
+
+// SetJoinAny runs the step once any of its parents succeeded, instead of once every parent succeeded.
+func (w *WorkflowStep) SetJoinAny() *WorkflowStep {
+	w.JoinStrategy = types.JoinAny
+	w.JoinQuorum = 0
+	return w
+}
+
+// SetJoinQuorum runs the step once the given number of its parents succeeded, instead of once every parent
+// succeeded.
+func (w *WorkflowStep) SetJoinQuorum(quorum int) *WorkflowStep {
+	w.JoinStrategy = types.JoinQuorum
+	w.JoinQuorum = quorum
+	return w
+}
+
 func (w *WorkflowStep) AddParents(parents ...string) *WorkflowStep {
 	w.Parents = append(w.Parents, parents...)
 	return w
@@ -303,9 +448,15 @@ func (w *WorkflowStep) ToWorkflow(svcName string) types.Workflow {
 func (w *WorkflowStep) ToActionMap(svcName string) map[string]any {
 	step := *w
 
-	return map[string]any{
+	res := map[string]any{
 		step.GetActionId(svcName, 0): w.Function,
 	}
+
+	if w.OnTimeout != nil {
+		res[step.GetOnTimeoutActionId(svcName, 0)] = w.OnTimeout
+	}
+
+	return res
 }
 
 type Step struct {
@@ -328,12 +479,24 @@ func (w *WorkflowStep) ToWorkflowStep(svcName string, index int) (*Step, error)
 	res.Id = w.GetStepId(index)
 
 	res.APIStep = types.WorkflowStep{
-		Name:     res.Id,
-		ID:       w.GetStepId(index),
-		Timeout:  w.Timeout,
-		ActionID: w.GetActionId(svcName, index),
-		Parents:  []string{},
-		Retries:  w.Retries,
+		Name:              res.Id,
+		ID:                w.GetStepId(index),
+		Timeout:           w.Timeout,
+		ActionID:          w.GetActionId(svcName, index),
+		Parents:           []string{},
+		Retries:           w.Retries,
+		Resources:         w.Resources,
+		CancelGracePeriod: w.CancelGracePeriod,
+		MaxTimeout:        w.MaxTimeout,
+		PreflightChecks:   w.PreflightChecks,
+		JoinStrategy:      w.JoinStrategy,
+		JoinQuorum:        w.JoinQuorum,
+		ScheduleTimeout:   w.ScheduleTimeout,
+		CostCenter:        w.CostCenter,
+	}
+
+	if w.OnTimeout != nil {
+		res.APIStep.OnTimeout = w.GetOnTimeoutActionId(svcName, index)
 	}
 
 	inputs, err := decodeFnArgTypes(fnType)
@@ -398,6 +561,11 @@ func (w *WorkflowStep) GetActionId(svcName string, index int) string {
 	return fmt.Sprintf("%s:%s", svcName, stepId)
 }
 
+// GetOnTimeoutActionId returns the action id of the on-timeout function of the step.
+func (w *WorkflowStep) GetOnTimeoutActionId(svcName string, index int) string {
+	return w.GetActionId(svcName, index) + "-on-timeout"
+}
+
 func getFnName(fn any) string {
 	fnInfo := runtime.FuncForPC(reflect.ValueOf(fn).Pointer())
 	fnName := fnInfo.Name()
@@ -74,3 +74,62 @@ func TestFnToWorkflow(t *testing.T) {
 
 	assert.Equal(t, "TestFnToWorkflow-func1", workflow.Name)
 }
+
+func TestOnTimeoutActionMap(t *testing.T) {
+	step := Fn(func(ctx context.Context, input *actionInput) (result *stepOneOutput, err error) {
+		return nil, nil
+	}).SetName("provision").SetOnTimeout(func(ctx context.Context, input *actionInput) (result *stepOneOutput, err error) {
+		return nil, nil
+	})
+
+	actions := step.ToActionMap("default")
+
+	assert.Len(t, actions, 2)
+	assert.Contains(t, actions, "default:provision-on-timeout")
+
+	workflow := step.ToWorkflow("default")
+
+	assert.Equal(t, "default:provision-on-timeout", workflow.Jobs["provision"].Steps[0].OnTimeout)
+}
+
+func TestConcurrencyExpressionsToWorkflow(t *testing.T) {
+	testJob := WorkflowJob{
+		Name:        "test",
+		Concurrency: ConcurrencyExpressions("input.customer_id", "additional_metadata.region").MaxRuns(2),
+		Steps: []*WorkflowStep{
+			Fn(func(ctx context.Context, input *actionInput) (result *stepOneOutput, err error) {
+				return nil, nil
+			}).SetName("one"),
+		},
+	}
+
+	workflow := testJob.ToWorkflow("default")
+
+	assert.Empty(t, workflow.Concurrency.ActionID)
+	assert.Equal(t, []string{"input.customer_id", "additional_metadata.region"}, workflow.Concurrency.KeyExpressions)
+	assert.Equal(t, int32(2), workflow.Concurrency.MaxRuns)
+
+	// expressions are evaluated by the engine, so there is no concurrency action
+	assert.Len(t, testJob.ToActionMap("default"), 1)
+}
+
+func TestCostCenterToWorkflow(t *testing.T) {
+	testJob := WorkflowJob{
+		Name:       "test",
+		CostCenter: "platform",
+		Steps: []*WorkflowStep{
+			Fn(func(ctx context.Context, input *actionInput) (result *stepOneOutput, err error) {
+				return nil, nil
+			}).SetName("one"),
+			Fn(func(ctx context.Context, input *actionInput) (result *stepOneOutput, err error) {
+				return nil, nil
+			}).SetName("two").SetCostCenter("ml-research"),
+		},
+	}
+
+	workflow := testJob.ToWorkflow("default")
+
+	assert.Equal(t, "platform", workflow.CostCenter)
+	assert.Empty(t, workflow.Jobs["test"].Steps[0].CostCenter)
+	assert.Equal(t, "ml-research", workflow.Jobs["test"].Steps[1].CostCenter)
+}
@@ -0,0 +1,8 @@
+// Package prisma embeds the migrations of the database schema, so that the binaries can apply them and verify
+// that the database is up to date.
+package prisma
+
+import "embed"
+
+//go:embed migrations/*/migration.sql
+var Migrations embed.FS
@@ -0,0 +1,2 @@
+-- AlterTable
+ALTER TABLE "Tenant" ADD COLUMN     "ingestionPaused" BOOLEAN NOT NULL DEFAULT false;
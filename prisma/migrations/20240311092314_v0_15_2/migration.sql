@@ -0,0 +1,26 @@
+-- CreateEnum
+CREATE TYPE "WorkflowRunBulkRetryStatus" AS ENUM ('PENDING', 'RUNNING', 'SUCCEEDED', 'FAILED');
+
+-- CreateTable
+CREATE TABLE "WorkflowRunBulkRetry" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "status" "WorkflowRunBulkRetryStatus" NOT NULL DEFAULT 'PENDING',
+    "filter" JSONB NOT NULL,
+    "matchedCount" INTEGER NOT NULL DEFAULT 0,
+    "retriedCount" INTEGER NOT NULL DEFAULT 0,
+    "failedCount" INTEGER NOT NULL DEFAULT 0,
+    "error" TEXT,
+    "startedAt" TIMESTAMP(3),
+    "finishedAt" TIMESTAMP(3),
+
+    CONSTRAINT "WorkflowRunBulkRetry_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowRunBulkRetry_id_key" ON "WorkflowRunBulkRetry"("id");
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRunBulkRetry" ADD CONSTRAINT "WorkflowRunBulkRetry_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,2 @@
+-- AlterTable
+ALTER TABLE "APIToken" ADD COLUMN     "scopes" TEXT[];
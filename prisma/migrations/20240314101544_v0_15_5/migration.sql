@@ -0,0 +1,6 @@
+-- AlterTable
+ALTER TABLE "WorkflowRun" ADD COLUMN     "debug" BOOLEAN NOT NULL DEFAULT false,
+ADD COLUMN     "replayOfId" UUID;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRun" ADD CONSTRAINT "WorkflowRun_replayOfId_fkey" FOREIGN KEY ("replayOfId") REFERENCES "WorkflowRun"("id") ON DELETE SET NULL ON UPDATE CASCADE;
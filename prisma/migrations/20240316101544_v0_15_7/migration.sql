@@ -0,0 +1,29 @@
+-- AlterTable
+ALTER TABLE "WorkflowVersion" ADD COLUMN     "sla" TEXT;
+
+-- CreateTable
+CREATE TABLE "WorkflowRunSLABreach" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "workflowId" UUID NOT NULL,
+    "workflowRunId" UUID NOT NULL,
+    "sla" TEXT NOT NULL,
+
+    CONSTRAINT "WorkflowRunSLABreach_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowRunSLABreach_id_key" ON "WorkflowRunSLABreach"("id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowRunSLABreach_workflowRunId_key" ON "WorkflowRunSLABreach"("workflowRunId");
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRunSLABreach" ADD CONSTRAINT "WorkflowRunSLABreach_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRunSLABreach" ADD CONSTRAINT "WorkflowRunSLABreach_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRunSLABreach" ADD CONSTRAINT "WorkflowRunSLABreach_workflowRunId_fkey" FOREIGN KEY ("workflowRunId") REFERENCES "WorkflowRun"("id") ON DELETE CASCADE ON UPDATE CASCADE;
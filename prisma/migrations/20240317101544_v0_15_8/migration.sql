@@ -0,0 +1,13 @@
+-- CreateTable
+CREATE TABLE "LeaderLease" (
+    "role" TEXT NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "leaderId" TEXT NOT NULL,
+    "expiresAt" TIMESTAMP(3) NOT NULL,
+
+    CONSTRAINT "LeaderLease_pkey" PRIMARY KEY ("role")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "LeaderLease_role_key" ON "LeaderLease"("role");
@@ -0,0 +1,8 @@
+-- AlterTable
+ALTER TABLE "StepRun" ADD COLUMN     "assignedAt" TIMESTAMP(3),
+ADD COLUMN     "queuedAt" TIMESTAMP(3),
+ADD COLUMN     "resultPersistedAt" TIMESTAMP(3),
+ADD COLUMN     "slotWaitStartedAt" TIMESTAMP(3);
+
+-- CreateIndex
+CREATE INDEX "StepRun_tenantId_resultPersistedAt_idx" ON "StepRun"("tenantId", "resultPersistedAt");
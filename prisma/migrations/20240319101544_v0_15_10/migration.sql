@@ -0,0 +1,55 @@
+-- CreateEnum
+CREATE TYPE "LogSinkKind" AS ENUM ('HTTP', 'S3', 'DATADOG');
+
+-- CreateTable
+CREATE TABLE "LogSink" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "kind" "LogSinkKind" NOT NULL,
+    "destination" TEXT NOT NULL,
+    "config" BYTEA NOT NULL,
+    "enabled" BOOLEAN NOT NULL DEFAULT true,
+    "batchSize" INTEGER NOT NULL DEFAULT 100,
+    "lastRecordId" BIGINT NOT NULL DEFAULT 0,
+    "lastDeliveredAt" TIMESTAMP(3),
+    "lastError" TEXT,
+    "failures" INTEGER NOT NULL DEFAULT 0,
+    "nextAttemptAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "LogSink_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "LogSinkRecord" (
+    "id" BIGSERIAL NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "event" TEXT NOT NULL,
+    "data" JSONB NOT NULL,
+
+    CONSTRAINT "LogSinkRecord_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "LogSink_id_key" ON "LogSink"("id");
+
+-- CreateIndex
+CREATE INDEX "LogSink_enabled_nextAttemptAt_idx" ON "LogSink"("enabled", "nextAttemptAt");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "LogSink_tenantId_name_key" ON "LogSink"("tenantId", "name");
+
+-- CreateIndex
+CREATE INDEX "LogSinkRecord_tenantId_id_idx" ON "LogSinkRecord"("tenantId", "id");
+
+-- CreateIndex
+CREATE INDEX "LogSinkRecord_createdAt_idx" ON "LogSinkRecord"("createdAt");
+
+-- AddForeignKey
+ALTER TABLE "LogSink" ADD CONSTRAINT "LogSink_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "LogSinkRecord" ADD CONSTRAINT "LogSinkRecord_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,54 @@
+-- CreateEnum
+CREATE TYPE "ReplicationOperation" AS ENUM ('INSERT', 'UPDATE', 'DELETE');
+
+-- CreateEnum
+CREATE TYPE "ReplicationRole" AS ENUM ('PRIMARY', 'STANDBY');
+
+-- CreateTable
+CREATE TABLE "ReplicationLogEntry" (
+    "id" BIGSERIAL NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "table" TEXT NOT NULL,
+    "operation" "ReplicationOperation" NOT NULL,
+    "data" JSONB NOT NULL,
+
+    CONSTRAINT "ReplicationLogEntry_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "ReplicationState" (
+    "id" INTEGER NOT NULL DEFAULT 1,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "role" "ReplicationRole" NOT NULL DEFAULT 'STANDBY',
+    "lastEntryId" BIGINT NOT NULL DEFAULT 0,
+    "lastAppliedAt" TIMESTAMP(3),
+    "promotedAt" TIMESTAMP(3),
+    "recoveredAt" TIMESTAMP(3),
+
+    CONSTRAINT "ReplicationState_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE INDEX "ReplicationLogEntry_createdAt_idx" ON "ReplicationLogEntry"("createdAt");
+
+-- Records a mutation of a replicated table in the replication log. The triggers which call this function
+-- are attached with `hatchet-admin replication enable`, so instances which are not replicated don't pay for it.
+-- The entries use the clock time rather than the transaction time, so that the replicator can tell how long
+-- a missing entry has been missing.
+CREATE OR REPLACE FUNCTION log_replicated_row()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        INSERT INTO "ReplicationLogEntry" ("createdAt", "table", "operation", "data")
+        VALUES (clock_timestamp(), TG_TABLE_NAME, 'DELETE', to_jsonb(OLD));
+
+        RETURN OLD;
+    END IF;
+
+    INSERT INTO "ReplicationLogEntry" ("createdAt", "table", "operation", "data")
+    VALUES (clock_timestamp(), TG_TABLE_NAME, TG_OP::"ReplicationOperation", to_jsonb(NEW));
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,44 @@
+-- CreateEnum
+CREATE TYPE "WorkflowRolloutStatus" AS ENUM ('PENDING', 'ACTIVE', 'COMPLETED', 'ROLLED_BACK');
+
+-- CreateTable
+CREATE TABLE "WorkflowRollout" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "workflowId" UUID NOT NULL,
+    "percentage" INTEGER NOT NULL,
+    "failureRateThreshold" DOUBLE PRECISION NOT NULL,
+    "windowSeconds" INTEGER NOT NULL,
+    "minRuns" INTEGER NOT NULL,
+    "status" "WorkflowRolloutStatus" NOT NULL DEFAULT 'PENDING',
+    "fromVersionId" UUID,
+    "toVersionId" UUID,
+    "startedAt" TIMESTAMP(3),
+    "finishedAt" TIMESTAMP(3),
+    "failureRate" DOUBLE PRECISION,
+
+    CONSTRAINT "WorkflowRollout_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowRollout_id_key" ON "WorkflowRollout"("id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowRollout_workflowId_key" ON "WorkflowRollout"("workflowId");
+
+-- CreateIndex
+CREATE INDEX "WorkflowRollout_status_idx" ON "WorkflowRollout"("status");
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRollout" ADD CONSTRAINT "WorkflowRollout_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRollout" ADD CONSTRAINT "WorkflowRollout_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRollout" ADD CONSTRAINT "WorkflowRollout_fromVersionId_fkey" FOREIGN KEY ("fromVersionId") REFERENCES "WorkflowVersion"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRollout" ADD CONSTRAINT "WorkflowRollout_toVersionId_fkey" FOREIGN KEY ("toVersionId") REFERENCES "WorkflowVersion"("id") ON DELETE SET NULL ON UPDATE CASCADE;
@@ -0,0 +1,9 @@
+-- AlterTable
+ALTER TABLE "Step" ADD COLUMN     "cpu" DOUBLE PRECISION,
+ADD COLUMN     "gpu" INTEGER,
+ADD COLUMN     "memoryMb" INTEGER;
+
+-- AlterTable
+ALTER TABLE "Worker" ADD COLUMN     "cpu" DOUBLE PRECISION,
+ADD COLUMN     "gpu" INTEGER,
+ADD COLUMN     "memoryMb" INTEGER;
@@ -0,0 +1,3 @@
+-- AlterTable
+ALTER TABLE "WorkflowVersion" ADD COLUMN     "defaultInput" JSONB,
+ADD COLUMN     "inputSchema" JSONB;
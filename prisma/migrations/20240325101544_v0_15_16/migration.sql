@@ -0,0 +1,3 @@
+-- AlterTable
+ALTER TABLE "StepRunResultArchive" ADD COLUMN     "retryCount" INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN     "workerId" UUID;
@@ -0,0 +1,93 @@
+-- AlterTable
+ALTER TABLE "WorkflowRun" ADD COLUMN     "stepRunsCancelled" INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN     "stepRunsFailed" INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN     "stepRunsRunning" INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN     "stepRunsSucceeded" INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN     "stepRunsTotal" INTEGER NOT NULL DEFAULT 0;
+
+-- Keeps the step run counts of a workflow run up to date when its step runs are created, change status or are
+-- deleted, so that the progress of workflow runs can be listed without counting their step runs.
+CREATE OR REPLACE FUNCTION rollup_step_run_status()
+RETURNS TRIGGER AS $$
+DECLARE
+    job_run_id UUID;
+    old_status "StepRunStatus";
+    new_status "StepRunStatus";
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD."status" = NEW."status" THEN
+        RETURN NEW;
+    END IF;
+
+    IF TG_OP = 'DELETE' THEN
+        job_run_id := OLD."jobRunId";
+    ELSE
+        job_run_id := NEW."jobRunId";
+    END IF;
+
+    IF TG_OP <> 'INSERT' THEN
+        old_status := OLD."status";
+    END IF;
+
+    IF TG_OP <> 'DELETE' THEN
+        new_status := NEW."status";
+    END IF;
+
+    UPDATE "WorkflowRun" wr
+    SET
+        "stepRunsTotal" = wr."stepRunsTotal"
+            + (CASE WHEN TG_OP = 'INSERT' THEN 1 ELSE 0 END)
+            - (CASE WHEN TG_OP = 'DELETE' THEN 1 ELSE 0 END),
+        "stepRunsRunning" = wr."stepRunsRunning"
+            + (CASE WHEN new_status IN ('RUNNING', 'ASSIGNED') THEN 1 ELSE 0 END)
+            - (CASE WHEN old_status IN ('RUNNING', 'ASSIGNED') THEN 1 ELSE 0 END),
+        "stepRunsSucceeded" = wr."stepRunsSucceeded"
+            + (CASE WHEN new_status = 'SUCCEEDED' THEN 1 ELSE 0 END)
+            - (CASE WHEN old_status = 'SUCCEEDED' THEN 1 ELSE 0 END),
+        "stepRunsFailed" = wr."stepRunsFailed"
+            + (CASE WHEN new_status = 'FAILED' THEN 1 ELSE 0 END)
+            - (CASE WHEN old_status = 'FAILED' THEN 1 ELSE 0 END),
+        "stepRunsCancelled" = wr."stepRunsCancelled"
+            + (CASE WHEN new_status = 'CANCELLED' THEN 1 ELSE 0 END)
+            - (CASE WHEN old_status = 'CANCELLED' THEN 1 ELSE 0 END)
+    FROM "JobRun" jr
+    WHERE
+        jr."id" = job_run_id AND
+        wr."id" = jr."workflowRunId";
+
+    IF TG_OP = 'DELETE' THEN
+        RETURN OLD;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER rollup_step_run_status_after_insert_or_delete
+AFTER INSERT OR DELETE ON "StepRun"
+FOR EACH ROW EXECUTE FUNCTION rollup_step_run_status();
+
+CREATE TRIGGER rollup_step_run_status_after_update
+AFTER UPDATE OF "status" ON "StepRun"
+FOR EACH ROW EXECUTE FUNCTION rollup_step_run_status();
+
+-- Backfill the step run counts of existing workflow runs
+UPDATE "WorkflowRun" wr
+SET
+    "stepRunsTotal" = counts."total",
+    "stepRunsRunning" = counts."running",
+    "stepRunsSucceeded" = counts."succeeded",
+    "stepRunsFailed" = counts."failed",
+    "stepRunsCancelled" = counts."cancelled"
+FROM (
+    SELECT
+        jr."workflowRunId",
+        count(*) AS "total",
+        count(*) FILTER (WHERE sr."status" IN ('RUNNING', 'ASSIGNED')) AS "running",
+        count(*) FILTER (WHERE sr."status" = 'SUCCEEDED') AS "succeeded",
+        count(*) FILTER (WHERE sr."status" = 'FAILED') AS "failed",
+        count(*) FILTER (WHERE sr."status" = 'CANCELLED') AS "cancelled"
+    FROM "StepRun" sr
+    JOIN "JobRun" jr ON jr."id" = sr."jobRunId"
+    GROUP BY jr."workflowRunId"
+) counts
+WHERE wr."id" = counts."workflowRunId";
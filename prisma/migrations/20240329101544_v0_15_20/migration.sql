@@ -0,0 +1,41 @@
+-- CreateEnum
+CREATE TYPE "CancellationSource" AS ENUM ('CONCURRENCY', 'USER', 'TIMEOUT', 'PARENT_CANCELLED');
+
+-- AlterTable
+ALTER TABLE "StepRun" ADD COLUMN     "cancelledSource" "CancellationSource";
+
+-- AlterTable
+ALTER TABLE "WorkflowRun" ADD COLUMN     "cancelledSource" "CancellationSource";
+
+-- Backfill the cancellation source of existing step runs from their cancellation reason
+UPDATE "StepRun"
+SET "cancelledSource" = CASE
+    WHEN "cancelledReason" = 'CANCELLED_BY_CONCURRENCY_LIMIT' THEN 'CONCURRENCY'
+    WHEN "cancelledReason" = 'CANCELLED_BY_USER' THEN 'USER'
+    WHEN "cancelledReason" IN ('TIMED_OUT', 'SCHEDULING_TIMED_OUT') THEN 'TIMEOUT'
+    WHEN "cancelledReason" IN ('PREVIOUS_STEP_TIMED_OUT', 'PREVIOUS_STEP_CANCELLED') THEN 'PARENT_CANCELLED'
+    ELSE NULL
+END::"CancellationSource"
+WHERE "status" = 'CANCELLED' AND "cancelledReason" IS NOT NULL;
+
+-- Backfill the cancellation source of failed workflow runs without failed job runs from the step run which was
+-- cancelled first, preferring step runs which were not cancelled because of a previous step run
+UPDATE "WorkflowRun" wr
+SET "cancelledSource" = (
+    SELECT sr."cancelledSource"
+    FROM "StepRun" sr
+    JOIN "JobRun" jr ON sr."jobRunId" = jr."id"
+    WHERE
+        jr."workflowRunId" = wr."id" AND
+        sr."status" = 'CANCELLED' AND
+        sr."cancelledSource" IS NOT NULL
+    ORDER BY sr."cancelledSource" = 'PARENT_CANCELLED', sr."cancelledAt" ASC NULLS LAST
+    LIMIT 1
+)
+WHERE
+    wr."status" = 'FAILED' AND
+    NOT EXISTS (
+        SELECT 1
+        FROM "JobRun" jr
+        WHERE jr."workflowRunId" = wr."id" AND jr."status" = 'FAILED'
+    );
@@ -0,0 +1,26 @@
+-- CreateTable
+CREATE TABLE "WorkflowTriggerLink" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "workflowId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "tokenHash" TEXT NOT NULL,
+    "defaultInput" JSONB,
+    "lastTriggeredAt" TIMESTAMP(3),
+
+    CONSTRAINT "WorkflowTriggerLink_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowTriggerLink_id_key" ON "WorkflowTriggerLink"("id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowTriggerLink_workflowId_name_key" ON "WorkflowTriggerLink"("workflowId", "name");
+
+-- AddForeignKey
+ALTER TABLE "WorkflowTriggerLink" ADD CONSTRAINT "WorkflowTriggerLink_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowTriggerLink" ADD CONSTRAINT "WorkflowTriggerLink_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow"("id") ON DELETE CASCADE ON UPDATE CASCADE;
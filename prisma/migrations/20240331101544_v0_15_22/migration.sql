@@ -0,0 +1,14 @@
+-- AlterTable
+ALTER TABLE "APIToken" ADD COLUMN     "environment" TEXT;
+
+-- AlterTable
+ALTER TABLE "Event" ADD COLUMN     "environment" TEXT;
+
+-- AlterTable
+ALTER TABLE "Worker" ADD COLUMN     "environment" TEXT;
+
+-- AlterTable
+ALTER TABLE "WorkflowRun" ADD COLUMN     "environment" TEXT;
+
+-- CreateIndex
+CREATE INDEX "WorkflowRun_tenantId_environment_idx" ON "WorkflowRun"("tenantId", "environment");
@@ -0,0 +1,2 @@
+-- AlterTable
+ALTER TABLE "APIToken" ADD COLUMN     "namespace" TEXT;
@@ -0,0 +1,8 @@
+-- AlterTable
+ALTER TABLE "Worker" ADD COLUMN     "buildId" TEXT;
+
+-- AlterTable
+ALTER TABLE "WorkflowRun" ADD COLUMN     "buildId" TEXT;
+
+-- AlterTable
+ALTER TABLE "WorkflowVersion" ADD COLUMN     "pinToBuild" BOOLEAN NOT NULL DEFAULT false;
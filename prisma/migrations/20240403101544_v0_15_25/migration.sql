@@ -0,0 +1,12 @@
+-- CreateEnum
+CREATE TYPE "WorkerAssignmentStrategy" AS ENUM ('RANDOM', 'LEAST_LOADED', 'ROUND_ROBIN', 'LOCALITY');
+
+-- AlterTable
+ALTER TABLE "Tenant" ADD COLUMN     "assignmentStrategy" "WorkerAssignmentStrategy" NOT NULL DEFAULT 'RANDOM';
+
+-- AlterTable
+ALTER TABLE "Worker" ADD COLUMN     "labels" JSONB,
+ADD COLUMN     "lastAssignedAt" TIMESTAMP(3);
+
+-- AlterTable
+ALTER TABLE "WorkflowVersion" ADD COLUMN     "assignmentStrategy" "WorkerAssignmentStrategy";
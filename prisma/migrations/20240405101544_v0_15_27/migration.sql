@@ -0,0 +1,13 @@
+-- CreateEnum
+CREATE TYPE "PausedTriggerBehavior" AS ENUM ('REJECT', 'BUFFER');
+
+-- AlterTable
+ALTER TABLE "Tenant" ADD COLUMN     "paused" BOOLEAN NOT NULL DEFAULT false,
+ADD COLUMN     "pausedTriggerBehavior" "PausedTriggerBehavior" NOT NULL DEFAULT 'REJECT';
+
+-- AlterTable
+ALTER TABLE "Workflow" ADD COLUMN     "paused" BOOLEAN NOT NULL DEFAULT false,
+ADD COLUMN     "pausedTriggerBehavior" "PausedTriggerBehavior" NOT NULL DEFAULT 'REJECT';
+
+-- AlterTable
+ALTER TABLE "WorkflowRun" ADD COLUMN     "bufferedAt" TIMESTAMP(3);
@@ -0,0 +1,28 @@
+-- AlterTable
+ALTER TABLE "Workflow" ADD COLUMN     "maintenanceUntil" TIMESTAMP(3);
+
+-- CreateTable
+CREATE TABLE "WorkflowMaintenanceWindow" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "workflowId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "cron" TEXT NOT NULL,
+    "durationSeconds" INTEGER NOT NULL,
+
+    CONSTRAINT "WorkflowMaintenanceWindow_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowMaintenanceWindow_id_key" ON "WorkflowMaintenanceWindow"("id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowMaintenanceWindow_workflowId_name_key" ON "WorkflowMaintenanceWindow"("workflowId", "name");
+
+-- AddForeignKey
+ALTER TABLE "WorkflowMaintenanceWindow" ADD CONSTRAINT "WorkflowMaintenanceWindow_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowMaintenanceWindow" ADD CONSTRAINT "WorkflowMaintenanceWindow_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow"("id") ON DELETE CASCADE ON UPDATE CASCADE;
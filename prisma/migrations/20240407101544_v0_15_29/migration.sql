@@ -0,0 +1,27 @@
+-- CreateEnum
+CREATE TYPE "StepPreflightCheckKind" AS ENUM ('HTTP', 'TCP', 'EXPRESSION');
+
+-- AlterEnum
+ALTER TYPE "StepRunStatus" ADD VALUE 'WAITING_ON_DEPENDENCY';
+
+-- AlterTable
+ALTER TABLE "Tenant" ADD COLUMN     "dependencyHealth" JSONB;
+
+-- CreateTable
+CREATE TABLE "StepPreflightCheck" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "stepId" UUID NOT NULL,
+    "kind" "StepPreflightCheckKind" NOT NULL,
+    "target" TEXT NOT NULL,
+    "timeout" TEXT,
+
+    CONSTRAINT "StepPreflightCheck_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "StepPreflightCheck_id_key" ON "StepPreflightCheck"("id");
+
+-- AddForeignKey
+ALTER TABLE "StepPreflightCheck" ADD CONSTRAINT "StepPreflightCheck_stepId_fkey" FOREIGN KEY ("stepId") REFERENCES "Step"("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,12 @@
+-- CreateEnum
+CREATE TYPE "StepJoinStrategy" AS ENUM ('ALL', 'ANY', 'QUORUM');
+
+-- AlterEnum
+ALTER TYPE "CancellationSource" ADD VALUE 'JOIN_SATISFIED';
+
+-- AlterTable
+ALTER TABLE "Step" ADD COLUMN     "joinQuorum" INTEGER,
+ADD COLUMN     "joinStrategy" "StepJoinStrategy" NOT NULL DEFAULT 'ALL';
+
+-- AlterTable
+ALTER TABLE "StepRun" ADD COLUMN     "toleratedByJoin" BOOLEAN NOT NULL DEFAULT false;
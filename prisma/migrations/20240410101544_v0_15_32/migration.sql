@@ -0,0 +1,10 @@
+-- AlterEnum
+ALTER TYPE "CancellationSource" ADD VALUE 'BUDGET_EXCEEDED';
+
+-- AlterTable
+ALTER TABLE "WorkflowRun" ADD COLUMN     "stepExecutions" INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN     "stepRetries" INTEGER NOT NULL DEFAULT 0;
+
+-- AlterTable
+ALTER TABLE "WorkflowVersion" ADD COLUMN     "maxStepExecutions" INTEGER,
+ADD COLUMN     "maxStepRetries" INTEGER;
@@ -0,0 +1,42 @@
+-- CreateTable
+CREATE TABLE "EventBusSubscription" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "topics" TEXT[],
+    "ackedRecordId" BIGINT NOT NULL DEFAULT 0,
+    "lastPolledAt" TIMESTAMP(3),
+
+    CONSTRAINT "EventBusSubscription_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "EventBusRecord" (
+    "id" BIGSERIAL NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "topic" TEXT NOT NULL,
+    "data" JSONB NOT NULL,
+
+    CONSTRAINT "EventBusRecord_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "EventBusSubscription_id_key" ON "EventBusSubscription"("id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "EventBusSubscription_tenantId_name_key" ON "EventBusSubscription"("tenantId", "name");
+
+-- CreateIndex
+CREATE INDEX "EventBusRecord_tenantId_id_idx" ON "EventBusRecord"("tenantId", "id");
+
+-- CreateIndex
+CREATE INDEX "EventBusRecord_createdAt_idx" ON "EventBusRecord"("createdAt");
+
+-- AddForeignKey
+ALTER TABLE "EventBusSubscription" ADD CONSTRAINT "EventBusSubscription_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "EventBusRecord" ADD CONSTRAINT "EventBusRecord_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,21 @@
+-- CreateTable
+CREATE TABLE "GithubAppCredentials" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "appId" INTEGER NOT NULL,
+    "appSlug" TEXT NOT NULL,
+    "settingsURL" TEXT NOT NULL,
+    "clientId" TEXT NOT NULL,
+    "clientSecret" BYTEA NOT NULL,
+    "webhookSecret" BYTEA NOT NULL,
+    "privateKey" BYTEA NOT NULL,
+
+    CONSTRAINT "GithubAppCredentials_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "GithubAppCredentials_id_key" ON "GithubAppCredentials"("id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "GithubAppCredentials_appId_key" ON "GithubAppCredentials"("appId");
@@ -0,0 +1,5 @@
+-- AlterTable
+ALTER TABLE "GithubWebhook" ADD COLUMN     "failedDeliveries" JSONB,
+ADD COLUMN     "hookId" BIGINT,
+ADD COLUMN     "reconcileError" TEXT,
+ADD COLUMN     "reconciledAt" TIMESTAMP(3);
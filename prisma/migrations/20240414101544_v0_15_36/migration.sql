@@ -0,0 +1,35 @@
+-- CreateEnum
+CREATE TYPE "PreviewEnvironmentHook" AS ENUM ('PROVISION', 'TEARDOWN');
+
+-- AlterTable
+ALTER TABLE "WorkflowDeploymentConfig" ADD COLUMN     "previewEnvironmentHook" "PreviewEnvironmentHook";
+
+-- CreateTable
+CREATE TABLE "GithubPreviewEnvironment" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "pullRequestId" UUID NOT NULL,
+    "workflowId" UUID NOT NULL,
+    "url" TEXT,
+    "commentId" BIGINT,
+    "tornDownAt" TIMESTAMP(3),
+
+    CONSTRAINT "GithubPreviewEnvironment_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "GithubPreviewEnvironment_id_key" ON "GithubPreviewEnvironment"("id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "GithubPreviewEnvironment_pullRequestId_workflowId_key" ON "GithubPreviewEnvironment"("pullRequestId", "workflowId");
+
+-- AddForeignKey
+ALTER TABLE "GithubPreviewEnvironment" ADD CONSTRAINT "GithubPreviewEnvironment_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "GithubPreviewEnvironment" ADD CONSTRAINT "GithubPreviewEnvironment_pullRequestId_fkey" FOREIGN KEY ("pullRequestId") REFERENCES "GithubPullRequest"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "GithubPreviewEnvironment" ADD CONSTRAINT "GithubPreviewEnvironment_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow"("id") ON DELETE CASCADE ON UPDATE CASCADE;
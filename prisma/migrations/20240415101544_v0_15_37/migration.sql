@@ -0,0 +1,21 @@
+-- CreateTable
+CREATE TABLE "GiteaWebhook" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "repositoryOwner" TEXT NOT NULL,
+    "repositoryName" TEXT NOT NULL,
+    "signingSecret" BYTEA NOT NULL,
+
+    CONSTRAINT "GiteaWebhook_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "GiteaWebhook_id_key" ON "GiteaWebhook"("id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "GiteaWebhook_tenantId_repositoryOwner_repositoryName_key" ON "GiteaWebhook"("tenantId", "repositoryOwner", "repositoryName");
+
+-- AddForeignKey
+ALTER TABLE "GiteaWebhook" ADD CONSTRAINT "GiteaWebhook_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
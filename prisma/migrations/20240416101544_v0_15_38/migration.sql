@@ -0,0 +1,35 @@
+-- CreateEnum
+CREATE TYPE "WebhookDeliverySource" AS ENUM ('GITHUB', 'GITEA');
+
+-- CreateEnum
+CREATE TYPE "WebhookDeliveryStatus" AS ENUM ('RECEIVED', 'PROCESSED', 'FAILED', 'REJECTED');
+
+-- CreateTable
+CREATE TABLE "WebhookDelivery" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "source" "WebhookDeliverySource" NOT NULL,
+    "webhookId" UUID NOT NULL,
+    "deliveryId" TEXT,
+    "event" TEXT NOT NULL,
+    "nonce" TEXT NOT NULL,
+    "sentAt" TIMESTAMP(3),
+    "status" "WebhookDeliveryStatus" NOT NULL DEFAULT 'RECEIVED',
+    "error" TEXT,
+
+    CONSTRAINT "WebhookDelivery_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WebhookDelivery_id_key" ON "WebhookDelivery"("id");
+
+-- CreateIndex
+CREATE INDEX "WebhookDelivery_webhookId_createdAt_idx" ON "WebhookDelivery"("webhookId", "createdAt");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WebhookDelivery_webhookId_nonce_key" ON "WebhookDelivery"("webhookId", "nonce");
+
+-- AddForeignKey
+ALTER TABLE "WebhookDelivery" ADD CONSTRAINT "WebhookDelivery_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,35 @@
+-- CreateEnum
+CREATE TYPE "ObjectStorageFeedKind" AS ENUM ('SQS', 'PUBSUB');
+
+-- CreateTable
+CREATE TABLE "ObjectStorageFeed" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "kind" "ObjectStorageFeedKind" NOT NULL,
+    "source" TEXT NOT NULL,
+    "config" BYTEA NOT NULL,
+    "eventKey" TEXT NOT NULL,
+    "keyPrefix" TEXT,
+    "enabled" BOOLEAN NOT NULL DEFAULT true,
+    "lastReceivedAt" TIMESTAMP(3),
+    "lastError" TEXT,
+    "failures" INTEGER NOT NULL DEFAULT 0,
+    "nextAttemptAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "ObjectStorageFeed_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "ObjectStorageFeed_id_key" ON "ObjectStorageFeed"("id");
+
+-- CreateIndex
+CREATE INDEX "ObjectStorageFeed_enabled_nextAttemptAt_idx" ON "ObjectStorageFeed"("enabled", "nextAttemptAt");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "ObjectStorageFeed_tenantId_name_key" ON "ObjectStorageFeed"("tenantId", "name");
+
+-- AddForeignKey
+ALTER TABLE "ObjectStorageFeed" ADD CONSTRAINT "ObjectStorageFeed_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
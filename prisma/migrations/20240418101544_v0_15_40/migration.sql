@@ -0,0 +1,34 @@
+-- CreateEnum
+CREATE TYPE "QueryTriggerMode" AS ENUM ('PER_ROW', 'NON_EMPTY');
+
+-- CreateTable
+CREATE TABLE "QueryTrigger" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "config" BYTEA NOT NULL,
+    "query" TEXT NOT NULL,
+    "cron" TEXT NOT NULL,
+    "mode" "QueryTriggerMode" NOT NULL DEFAULT 'PER_ROW',
+    "eventKey" TEXT NOT NULL,
+    "enabled" BOOLEAN NOT NULL DEFAULT true,
+    "lastRunAt" TIMESTAMP(3),
+    "lastError" TEXT,
+    "nextRunAt" TIMESTAMP(3) NOT NULL,
+
+    CONSTRAINT "QueryTrigger_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "QueryTrigger_id_key" ON "QueryTrigger"("id");
+
+-- CreateIndex
+CREATE INDEX "QueryTrigger_enabled_nextRunAt_idx" ON "QueryTrigger"("enabled", "nextRunAt");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "QueryTrigger_tenantId_name_key" ON "QueryTrigger"("tenantId", "name");
+
+-- AddForeignKey
+ALTER TABLE "QueryTrigger" ADD CONSTRAINT "QueryTrigger_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,25 @@
+-- CreateTable
+CREATE TABLE "ClientCertificate" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "fingerprint" TEXT NOT NULL,
+    "subject" TEXT,
+    "expiresAt" TIMESTAMP(3),
+
+    CONSTRAINT "ClientCertificate_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "ClientCertificate_id_key" ON "ClientCertificate"("id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "ClientCertificate_tenantId_fingerprint_key" ON "ClientCertificate"("tenantId", "fingerprint");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "ClientCertificate_tenantId_name_key" ON "ClientCertificate"("tenantId", "name");
+
+-- AddForeignKey
+ALTER TABLE "ClientCertificate" ADD CONSTRAINT "ClientCertificate_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,26 @@
+-- CreateTable
+CREATE TABLE "PIIRule" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "path" TEXT NOT NULL,
+    "retentionSeconds" INTEGER NOT NULL,
+    "purgedUntil" TIMESTAMP(3),
+    "purgedUntilStepRunId" UUID,
+    "nextPurgeAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "PIIRule_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "PIIRule_id_key" ON "PIIRule"("id");
+
+-- CreateIndex
+CREATE INDEX "PIIRule_nextPurgeAt_idx" ON "PIIRule"("nextPurgeAt");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "PIIRule_tenantId_path_key" ON "PIIRule"("tenantId", "path");
+
+-- AddForeignKey
+ALTER TABLE "PIIRule" ADD CONSTRAINT "PIIRule_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
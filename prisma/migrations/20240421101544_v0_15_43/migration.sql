@@ -0,0 +1,50 @@
+-- CreateEnum
+CREATE TYPE "TenantExportStatus" AS ENUM ('PENDING', 'RUNNING', 'SUCCEEDED', 'FAILED');
+
+-- CreateTable
+CREATE TABLE "TenantExport" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "status" "TenantExportStatus" NOT NULL DEFAULT 'PENDING',
+    "sectionsDone" INTEGER NOT NULL DEFAULT 0,
+    "sectionsTotal" INTEGER NOT NULL DEFAULT 0,
+    "recordCount" INTEGER NOT NULL DEFAULT 0,
+    "error" TEXT,
+    "startedAt" TIMESTAMP(3),
+    "finishedAt" TIMESTAMP(3),
+    "archiveSize" INTEGER,
+    "archiveSha256" TEXT,
+    "expiresAt" TIMESTAMP(3),
+
+    CONSTRAINT "TenantExport_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "TenantExportArchive" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "exportId" UUID NOT NULL,
+    "data" BYTEA NOT NULL,
+
+    CONSTRAINT "TenantExportArchive_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "TenantExport_id_key" ON "TenantExport"("id");
+
+-- CreateIndex
+CREATE INDEX "TenantExport_expiresAt_idx" ON "TenantExport"("expiresAt");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "TenantExportArchive_id_key" ON "TenantExportArchive"("id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "TenantExportArchive_exportId_key" ON "TenantExportArchive"("exportId");
+
+-- AddForeignKey
+ALTER TABLE "TenantExport" ADD CONSTRAINT "TenantExport_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "TenantExportArchive" ADD CONSTRAINT "TenantExportArchive_exportId_fkey" FOREIGN KEY ("exportId") REFERENCES "TenantExport"("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,20 @@
+-- CreateTable
+CREATE TABLE "TenantFeatureFlag" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "flag" TEXT NOT NULL,
+    "enabled" BOOLEAN NOT NULL,
+
+    CONSTRAINT "TenantFeatureFlag_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "TenantFeatureFlag_id_key" ON "TenantFeatureFlag"("id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "TenantFeatureFlag_tenantId_flag_key" ON "TenantFeatureFlag"("tenantId", "flag");
+
+-- AddForeignKey
+ALTER TABLE "TenantFeatureFlag" ADD CONSTRAINT "TenantFeatureFlag_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
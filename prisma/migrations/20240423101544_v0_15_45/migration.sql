@@ -0,0 +1,22 @@
+-- CreateTable
+CREATE TABLE "TenantEngineSettings" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "defaultStepTimeout" TEXT,
+    "scheduleTimeout" TEXT,
+    "defaultStepRetries" INTEGER,
+    "maxFanOut" INTEGER,
+
+    CONSTRAINT "TenantEngineSettings_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "TenantEngineSettings_id_key" ON "TenantEngineSettings"("id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "TenantEngineSettings_tenantId_key" ON "TenantEngineSettings"("tenantId");
+
+-- AddForeignKey
+ALTER TABLE "TenantEngineSettings" ADD CONSTRAINT "TenantEngineSettings_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
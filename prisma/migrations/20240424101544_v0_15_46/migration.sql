@@ -0,0 +1,5 @@
+-- CreateEnum
+CREATE TYPE "StepRunSubStatus" AS ENUM ('WAITING_FOR_WORKER');
+
+-- AlterTable
+ALTER TABLE "StepRun" ADD COLUMN     "subStatus" "StepRunSubStatus";
@@ -0,0 +1,5 @@
+-- AlterTable
+ALTER TABLE "WorkflowConcurrency" ADD COLUMN     "keyExpressions" TEXT[];
+
+-- AlterTable
+ALTER TABLE "WorkflowRun" ADD COLUMN     "concurrencyGroupComponents" TEXT[];
@@ -0,0 +1,26 @@
+-- CreateTable
+CREATE TABLE "NamedLock" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "stepRunId" UUID NOT NULL,
+    "workflowRunId" UUID NOT NULL,
+    "holdsWorkflowRun" BOOLEAN NOT NULL DEFAULT false,
+    "expiresAt" TIMESTAMP(3) NOT NULL,
+
+    CONSTRAINT "NamedLock_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "NamedLock_id_key" ON "NamedLock"("id");
+
+-- CreateIndex
+CREATE INDEX "NamedLock_expiresAt_idx" ON "NamedLock"("expiresAt");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "NamedLock_tenantId_name_key" ON "NamedLock"("tenantId", "name");
+
+-- AddForeignKey
+ALTER TABLE "NamedLock" ADD CONSTRAINT "NamedLock_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,38 @@
+-- CreateEnum
+CREATE TYPE "RecurringTaskKind" AS ENUM ('PURGE_WORKFLOW_RUNS', 'EMIT_EVENT');
+
+-- CreateTable
+CREATE TABLE "RecurringTask" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "kind" "RecurringTaskKind" NOT NULL,
+    "cron" TEXT NOT NULL,
+    "workflowId" UUID,
+    "retentionSeconds" INTEGER,
+    "eventKey" TEXT,
+    "eventData" JSONB,
+    "enabled" BOOLEAN NOT NULL DEFAULT true,
+    "lastRunAt" TIMESTAMP(3),
+    "lastError" TEXT,
+    "nextRunAt" TIMESTAMP(3) NOT NULL,
+
+    CONSTRAINT "RecurringTask_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "RecurringTask_id_key" ON "RecurringTask"("id");
+
+-- CreateIndex
+CREATE INDEX "RecurringTask_enabled_nextRunAt_idx" ON "RecurringTask"("enabled", "nextRunAt");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "RecurringTask_tenantId_name_key" ON "RecurringTask"("tenantId", "name");
+
+-- AddForeignKey
+ALTER TABLE "RecurringTask" ADD CONSTRAINT "RecurringTask_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "RecurringTask" ADD CONSTRAINT "RecurringTask_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow"("id") ON DELETE CASCADE ON UPDATE CASCADE;
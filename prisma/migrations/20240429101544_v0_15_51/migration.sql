@@ -0,0 +1,5 @@
+-- AlterTable
+ALTER TABLE "Step" ADD COLUMN     "costCenter" TEXT;
+
+-- AlterTable
+ALTER TABLE "WorkflowVersion" ADD COLUMN     "costCenter" TEXT;
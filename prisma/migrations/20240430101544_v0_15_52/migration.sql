@@ -0,0 +1,24 @@
+-- CreateTable
+CREATE TABLE "WorkflowAnomalyBaseline" (
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "workflowId" UUID NOT NULL,
+    "windowEnd" TIMESTAMP(3) NOT NULL,
+    "windows" INTEGER NOT NULL,
+    "failureRateMean" DOUBLE PRECISION NOT NULL,
+    "failureRateVariance" DOUBLE PRECISION NOT NULL,
+    "durationMean" DOUBLE PRECISION NOT NULL,
+    "durationVariance" DOUBLE PRECISION NOT NULL,
+
+    CONSTRAINT "WorkflowAnomalyBaseline_pkey" PRIMARY KEY ("workflowId")
+);
+
+-- CreateIndex
+CREATE INDEX "WorkflowRun_finishedAt_idx" ON "WorkflowRun"("finishedAt");
+
+-- AddForeignKey
+ALTER TABLE "WorkflowAnomalyBaseline" ADD CONSTRAINT "WorkflowAnomalyBaseline_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowAnomalyBaseline" ADD CONSTRAINT "WorkflowAnomalyBaseline_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow"("id") ON DELETE CASCADE ON UPDATE CASCADE;
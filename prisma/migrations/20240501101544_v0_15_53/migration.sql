@@ -0,0 +1,53 @@
+-- AlterTable
+ALTER TABLE "Workflow" ADD COLUMN     "diagnosticsSampleRate" INTEGER NOT NULL DEFAULT 0;
+
+-- CreateTable
+CREATE TABLE "StepRunDiagnostic" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "stepRunId" UUID NOT NULL,
+    "retryCount" INTEGER NOT NULL,
+    "workerId" UUID,
+    "input" JSONB,
+    "output" JSONB,
+    "error" TEXT,
+    "queuedAt" TIMESTAMP(3),
+    "slotWaitStartedAt" TIMESTAMP(3),
+    "assignedAt" TIMESTAMP(3),
+    "startedAt" TIMESTAMP(3),
+    "finishedAt" TIMESTAMP(3),
+    "resultPersistedAt" TIMESTAMP(3),
+    "traceParent" TEXT,
+    "runTraceParent" TEXT,
+
+    CONSTRAINT "StepRunDiagnostic_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "WorkflowRunDiagnostics" (
+    "workflowRunId" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "traceParent" TEXT,
+
+    CONSTRAINT "WorkflowRunDiagnostics_pkey" PRIMARY KEY ("workflowRunId")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "StepRunDiagnostic_id_key" ON "StepRunDiagnostic"("id");
+
+-- CreateIndex
+CREATE INDEX "StepRunDiagnostic_stepRunId_idx" ON "StepRunDiagnostic"("stepRunId");
+
+-- AddForeignKey
+ALTER TABLE "StepRunDiagnostic" ADD CONSTRAINT "StepRunDiagnostic_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "StepRunDiagnostic" ADD CONSTRAINT "StepRunDiagnostic_stepRunId_fkey" FOREIGN KEY ("stepRunId") REFERENCES "StepRun"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRunDiagnostics" ADD CONSTRAINT "WorkflowRunDiagnostics_workflowRunId_fkey" FOREIGN KEY ("workflowRunId") REFERENCES "WorkflowRun"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRunDiagnostics" ADD CONSTRAINT "WorkflowRunDiagnostics_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,22 @@
+-- CreateTable
+CREATE TABLE "WorkflowRunRollup" (
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "workflowId" UUID NOT NULL,
+    "hour" TIMESTAMP(3) NOT NULL,
+    "succeeded" INTEGER NOT NULL DEFAULT 0,
+    "failed" INTEGER NOT NULL DEFAULT 0,
+    "durationSeconds" DOUBLE PRECISION NOT NULL DEFAULT 0,
+
+    CONSTRAINT "WorkflowRunRollup_pkey" PRIMARY KEY ("workflowId","hour")
+);
+
+-- CreateIndex
+CREATE INDEX "WorkflowRunRollup_tenantId_hour_idx" ON "WorkflowRunRollup"("tenantId", "hour");
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRunRollup" ADD CONSTRAINT "WorkflowRunRollup_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRunRollup" ADD CONSTRAINT "WorkflowRunRollup_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow"("id") ON DELETE CASCADE ON UPDATE CASCADE;
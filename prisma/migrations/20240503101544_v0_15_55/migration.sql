@@ -0,0 +1,2 @@
+-- AlterTable
+ALTER TABLE "TenantExport" ADD COLUMN     "archiveKey" TEXT;
@@ -0,0 +1,15 @@
+-- DropIndex
+DROP INDEX "LogSinkRecord_tenantId_id_idx";
+
+-- AlterTable
+ALTER TABLE "LogSink" ADD COLUMN     "lastRecordXid" BIGINT NOT NULL DEFAULT 0;
+
+-- AlterTable
+ALTER TABLE "LogSinkRecord" ADD COLUMN     "xid" BIGINT NOT NULL DEFAULT (pg_current_xact_id())::text::bigint;
+
+-- CreateIndex
+CREATE INDEX "LogSinkRecord_tenantId_xid_id_idx" ON "LogSinkRecord"("tenantId" ASC, "xid" ASC, "id" ASC);
+
+-- The existing records share the transaction id of the migration, so the cursors of the sinks are moved to it to
+-- keep the records which were not delivered yet.
+UPDATE "LogSink" SET "lastRecordXid" = COALESCE((SELECT MIN("xid") FROM "LogSinkRecord"), 0);